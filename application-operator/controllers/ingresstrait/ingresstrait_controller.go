@@ -9,6 +9,7 @@ import (
 	"fmt"
 	"istio.io/api/security/v1beta1"
 	v1beta12 "istio.io/api/type/v1beta1"
+	"os"
 	"reflect"
 	"strings"
 	"time"
@@ -67,6 +68,9 @@ const (
 	serviceKind               = "Service"
 	clusterIPNone             = "None"
 	verrazzanoClusterIssuer   = "verrazzano-cluster-issuer"
+	clusterIssuerKind         = "ClusterIssuer"
+	certIssuerNameEnvVar      = "CERT_ISSUER_NAME"
+	certIssuerKindEnvVar      = "CERT_ISSUER_KIND"
 	httpServiceNamePrefix     = "http"
 	weblogicOperatorSelector  = "weblogic.createdByOperator"
 	wlProxySSLHeader          = "WL-Proxy-SSL"
@@ -484,10 +488,7 @@ func (r *Reconciler) createGatewayCertificate(ctx context.Context, trait *vzapi.
 		certificate.Spec = certapiv1.CertificateSpec{
 			DNSNames:   hostsForTrait,
 			SecretName: secretName,
-			IssuerRef: certv1.ObjectReference{
-				Name: verrazzanoClusterIssuer,
-				Kind: "ClusterIssuer",
-			},
+			IssuerRef:  getCertificateIssuer(),
 		}
 
 		return nil
@@ -505,6 +506,19 @@ func (r *Reconciler) createGatewayCertificate(ctx context.Context, trait *vzapi.
 	return secretName
 }
 
+// getCertificateIssuer returns the issuer of the gateway certificates, which is the issuer that the platform
+// operator configures for the Verrazzano ingresses, or the Verrazzano ClusterIssuer by default
+func getCertificateIssuer() certv1.ObjectReference {
+	issuer := certv1.ObjectReference{Name: verrazzanoClusterIssuer, Kind: clusterIssuerKind}
+	if name := os.Getenv(certIssuerNameEnvVar); len(name) > 0 {
+		issuer.Name = name
+		if kind := os.Getenv(certIssuerKindEnvVar); len(kind) > 0 {
+			issuer.Kind = kind
+		}
+	}
+	return issuer
+}
+
 // validateConfiguredSecret ensures that a secret is specified and the trait rules specify a "hosts" setting.  The
 // specification of a secret implies that a certificate was created for specific hosts that differ than the host names
 // generated by the runtime (when no hosts are specified).
@@ -33,6 +33,7 @@ import (
 	asserts "github.com/stretchr/testify/assert"
 	vzapi "github.com/verrazzano/verrazzano/application-operator/apis/oam/v1alpha1"
 	"github.com/verrazzano/verrazzano/application-operator/constants"
+	"github.com/verrazzano/verrazzano/application-operator/controllers/reconcileresults"
 	"github.com/verrazzano/verrazzano/application-operator/mocks"
 	vzconst "github.com/verrazzano/verrazzano/pkg/constants"
 	"go.uber.org/zap"
@@ -4123,6 +4124,42 @@ func TestDeleteCertAndSecretWhenIngressTraitIsDeleted(t *testing.T) {
 	assert.Equal(time.Duration(0), result.RequeueAfter)
 }
 
+// TestCreateGatewayCertificateIssuer tests the issuer of the gateway certificate
+// GIVEN an ingress trait without a secret
+// WHEN the gateway certificate is created with and without an issuer configured by the platform operator
+// THEN the certificate is issued by the configured issuer, or by the Verrazzano ClusterIssuer by default
+func TestCreateGatewayCertificateIssuer(t *testing.T) {
+	assert := asserts.New(t)
+	trait := &vzapi.IngressTrait{ObjectMeta: metav1.ObjectMeta{Namespace: testNamespace, Name: testTraitName}}
+	tests := []struct {
+		name     string
+		env      map[string]string
+		expected string
+		kind     string
+	}{
+		{name: "default", expected: verrazzanoClusterIssuer, kind: clusterIssuerKind},
+		{name: "external ClusterIssuer", env: map[string]string{certIssuerNameEnvVar: "vault-issuer", certIssuerKindEnvVar: "ClusterIssuer"},
+			expected: "vault-issuer", kind: "ClusterIssuer"},
+		{name: "external Issuer", env: map[string]string{certIssuerNameEnvVar: "vault-issuer", certIssuerKindEnvVar: "Issuer"},
+			expected: "vault-issuer", kind: "Issuer"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for key, value := range tt.env {
+				t.Setenv(key, value)
+			}
+			reconciler := createReconcilerWithFake()
+			secretName := reconciler.createGatewayCertificate(context.TODO(), trait, []string{"hello.example.com"}, &reconcileresults.ReconcileResults{}, vzlog.DefaultLogger())
+			assert.Equal(buildCertificateSecretName(trait), secretName)
+
+			cert := &certapiv1.Certificate{}
+			assert.NoError(reconciler.Get(context.TODO(), types.NamespacedName{Namespace: istioSystemNamespace, Name: buildCertificateName(trait)}, cert))
+			assert.Equal(tt.expected, cert.Spec.IssuerRef.Name)
+			assert.Equal(tt.kind, cert.Spec.IssuerRef.Kind)
+		})
+	}
+}
+
 func createReconcilerWithFake(initObjs ...client.Object) Reconciler {
 	cli := fake.NewClientBuilder().WithScheme(newScheme()).WithObjects(initObjs...).Build()
 	reconciler := newIngressTraitReconciler(cli)
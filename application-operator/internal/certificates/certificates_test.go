@@ -59,7 +59,14 @@ func TestSetupCertificates(t *testing.T) {
 func TestSetupCertificatesFail(t *testing.T) {
 	a := assert.New(t)
 
-	_, err := SetupCertificates("bad-dir")
+	// The output directory is a regular file, so the directory cannot be created even when running as root
+	dir, err := ioutil.TempDir("", "certs")
+	a.NoError(err, "error should not be returned creating temporary directory")
+	defer os.RemoveAll(dir)
+	badDir := fmt.Sprintf("%s/%s", dir, "bad-dir")
+	a.NoError(ioutil.WriteFile(badDir, []byte{}, 0600))
+
+	_, err = SetupCertificates(badDir)
 	a.Error(err, "error should be returned setting up certificates")
 }
 
@@ -26,8 +26,7 @@ TEST_STATUS=$?
 cat ./coverage.raw.cov |\
   grep -v "zz_generated.deepcopy" |\
   grep -v "mocks" |\
-  grep -v "e2e" |\
-  grep -v "generated_assets" > coverage.cov
+  grep -v "e2e" > coverage.cov
 
 # Display the global code coverage.  This generates the total number the badge uses
 go tool cover -func=coverage.cov
@@ -0,0 +1,56 @@
+// Copyright (c) 2022, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package grafana
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/url"
+
+	"github.com/verrazzano/verrazzano/pkg/httputil"
+)
+
+// Client is a client for the folder, dashboard and user REST API of Grafana
+type Client struct {
+	// api is the client of Grafana, like https://grafana.vmi.system.default.example.com
+	api *httputil.APIClient
+}
+
+// APIError is returned when the Grafana REST API responds with an unexpected status code
+type APIError = httputil.APIError
+
+// errorResponse is the body Grafana returns with error status codes
+type errorResponse struct {
+	Message string `json:"message"`
+}
+
+// NewClient returns a client for Grafana at the base URL that authenticates with basic auth if the username is
+// not empty
+func NewClient(baseURL string, httpClient *http.Client, username string, password string) *Client {
+	api := httputil.NewAPIClient("Grafana", baseURL, httpClient, parseError)
+	if len(username) > 0 {
+		api.SetBasicAuth(username, password)
+	}
+	return &Client{api: api}
+}
+
+// IsNotFound returns true if the error is an APIError with the status code 404
+func IsNotFound(err error) bool {
+	return httputil.IsNotFound(err)
+}
+
+// send sends a request with an optional JSON body and decodes the JSON response into out if it is not nil
+func (c *Client) send(method string, reqPath string, query url.Values, in interface{}, out interface{}) error {
+	_, err := c.api.Send(method, reqPath, query, in, out)
+	return err
+}
+
+// parseError returns the message of a Grafana error response
+func parseError(data []byte) (string, string) {
+	errResp := errorResponse{}
+	if err := json.Unmarshal(data, &errResp); err != nil {
+		return "", ""
+	}
+	return "", errResp.Message
+}
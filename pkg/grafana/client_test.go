@@ -0,0 +1,144 @@
+// Copyright (c) 2022, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package grafana_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/verrazzano/verrazzano/pkg/grafana"
+	"github.com/verrazzano/verrazzano/pkg/grafana/fake"
+)
+
+const (
+	testUsername = "verrazzano"
+	testPassword = "vzpw"
+)
+
+func newClient() (*grafana.Client, *fake.Server) {
+	server := fake.NewServer(testUsername, testPassword)
+	return grafana.NewClient(server.URL+"/", server.Client(), testUsername, testPassword), server
+}
+
+// TestUnauthorized tests calling Grafana with the wrong credentials
+// GIVEN a Grafana server
+//  WHEN I call GetFolders with a wrong password
+//  THEN an unauthorized error with the message of Grafana is returned
+func TestUnauthorized(t *testing.T) {
+	server := fake.NewServer(testUsername, testPassword)
+	defer server.Close()
+
+	_, err := grafana.NewClient(server.URL, server.Client(), testUsername, "wrong").GetFolders()
+	assert.Error(t, err)
+	apiErr, ok := err.(*grafana.APIError)
+	assert.True(t, ok)
+	assert.Equal(t, http.StatusUnauthorized, apiErr.StatusCode)
+	assert.Equal(t, "Grafana GET /api/folders returned status code 401: Unauthorized", err.Error())
+}
+
+// TestFolders tests creating folders and replacing their permissions
+// GIVEN a Grafana server without folders
+//  WHEN I create folders, create a folder with an existing title and replace the permissions of a folder
+//  THEN the folders are listed, the duplicate folder is rejected and the permissions are replaced
+func TestFolders(t *testing.T) {
+	asserts := assert.New(t)
+	client, server := newClient()
+	defer server.Close()
+
+	folder, err := client.CreateFolder("team-a", "Team A")
+	asserts.NoError(err)
+	asserts.Equal("team-a", folder.UID)
+	asserts.NotZero(folder.ID)
+	_, err = client.CreateFolder("", "Team B")
+	asserts.NoError(err)
+	_, err = client.CreateFolder("", "Team A")
+	asserts.Error(err)
+	asserts.Contains(err.Error(), "same name already exists")
+
+	folders, err := client.GetFolders()
+	asserts.NoError(err)
+	asserts.Len(folders, 2)
+	asserts.Equal("Team B", folders[1].Title)
+
+	permissions := []grafana.FolderPermission{{UserID: 7, Permission: grafana.PermissionEdit}}
+	asserts.NoError(client.SetFolderPermissions("team-a", permissions))
+	actual, err := client.GetFolderPermissions("team-a")
+	asserts.NoError(err)
+	asserts.Equal(permissions, actual)
+	asserts.True(grafana.IsNotFound(client.SetFolderPermissions("missing", nil)))
+}
+
+// TestLookupUser tests looking up users by login
+// GIVEN a Grafana server with a user
+//  WHEN I look up the user and a user that has not signed in
+//  THEN the user is returned, and the second lookup fails with not found
+func TestLookupUser(t *testing.T) {
+	client, server := newClient()
+	defer server.Close()
+	expected := server.AddUser("alice")
+
+	user, err := client.LookupUser("alice")
+	assert.NoError(t, err)
+	assert.Equal(t, expected, *user)
+
+	_, err = client.LookupUser("bob")
+	assert.True(t, grafana.IsNotFound(err))
+}
+
+// TestDashboards tests saving, getting, searching and deleting dashboards
+// GIVEN a Grafana server with a folder
+//  WHEN I save a tagged dashboard in the folder, save it again without overwrite, get it, search for it and delete it
+//  THEN the dashboard is returned with its folder, found by its tag, and not found once it is deleted
+func TestDashboards(t *testing.T) {
+	asserts := assert.New(t)
+	client, server := newClient()
+	defer server.Close()
+	_, err := client.CreateFolder("apps", "Apps")
+	asserts.NoError(err)
+
+	model := map[string]interface{}{"uid": "hello", "title": "Hello", "tags": []string{"managed"}}
+	resp, err := client.SaveDashboard(grafana.SaveDashboardRequest{Model: model, FolderUID: "apps", Overwrite: true})
+	asserts.NoError(err)
+	asserts.Equal("hello", resp.UID)
+	asserts.Equal(1, resp.Version)
+	_, err = client.SaveDashboard(grafana.SaveDashboardRequest{Model: model})
+	asserts.Error(err)
+	_, err = client.SaveDashboard(grafana.SaveDashboardRequest{Model: map[string]interface{}{"title": "Lost"}, FolderUID: "missing"})
+	asserts.Contains(err.Error(), "folder not found")
+
+	dashboard, err := client.GetDashboard("hello")
+	asserts.NoError(err)
+	asserts.Equal("Hello", dashboard.Model["title"])
+	asserts.Equal("apps", dashboard.Meta.FolderUID)
+	asserts.Equal("Apps", dashboard.Meta.FolderTitle)
+	asserts.False(dashboard.Meta.Provisioned)
+
+	results, err := client.SearchDashboards("managed")
+	asserts.NoError(err)
+	asserts.Equal([]grafana.SearchResult{{UID: "hello", Title: "Hello", Type: grafana.DashboardSearchType, Tags: []string{"managed"}, FolderUID: "apps"}}, results)
+	results, err = client.SearchDashboards("other")
+	asserts.NoError(err)
+	asserts.Empty(results)
+
+	asserts.NoError(client.DeleteDashboard("hello"))
+	_, err = client.GetDashboard("hello")
+	asserts.True(grafana.IsNotFound(err))
+	asserts.True(grafana.IsNotFound(client.DeleteDashboard("hello")))
+}
+
+// TestFailedRequest tests a request that fails with an unexpected status code
+// GIVEN a Grafana server whose dashboard requests fail
+//  WHEN I get a dashboard
+//  THEN an error with the status code and the message of Grafana is returned
+func TestFailedRequest(t *testing.T) {
+	client, server := newClient()
+	defer server.Close()
+	server.FailRequests(http.MethodGet, "/api/dashboards/", http.StatusBadGateway)
+
+	_, err := client.GetDashboard("hello")
+	assert.Error(t, err)
+	assert.False(t, grafana.IsNotFound(err))
+	assert.Equal(t, "Grafana GET /api/dashboards/uid/hello returned status code 502: injected failure", err.Error())
+}
@@ -0,0 +1,97 @@
+// Copyright (c) 2022, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package grafana
+
+import (
+	"net/http"
+	"net/url"
+	"path"
+)
+
+// DashboardSearchType is the search type of dashboards, as opposed to folders
+const DashboardSearchType = "dash-db"
+
+// Dashboard is a dashboard and its metadata
+type Dashboard struct {
+	// Model is the JSON model of the dashboard
+	Model map[string]interface{} `json:"dashboard"`
+	Meta  DashboardMeta          `json:"meta"`
+}
+
+// DashboardMeta is the metadata Grafana keeps for a dashboard
+type DashboardMeta struct {
+	URL         string `json:"url,omitempty"`
+	FolderUID   string `json:"folderUid,omitempty"`
+	FolderTitle string `json:"folderTitle,omitempty"`
+	// Provisioned is true for dashboards that Grafana loads from files, they can not be saved through the API
+	Provisioned bool `json:"provisioned,omitempty"`
+}
+
+// SaveDashboardRequest is the body of a request to create or update a dashboard
+type SaveDashboardRequest struct {
+	// Model is the JSON model of the dashboard, a dashboard is created if its uid is empty or does not exist
+	Model map[string]interface{} `json:"dashboard"`
+	// FolderUID is the folder of the dashboard, the dashboard is saved in the General folder if it is empty
+	FolderUID string `json:"folderUid,omitempty"`
+	// Overwrite replaces a dashboard with the same uid or title instead of failing
+	Overwrite bool   `json:"overwrite"`
+	Message   string `json:"message,omitempty"`
+}
+
+// SaveDashboardResponse is the response of saving a dashboard
+type SaveDashboardResponse struct {
+	ID      int64  `json:"id"`
+	UID     string `json:"uid"`
+	URL     string `json:"url"`
+	Status  string `json:"status"`
+	Version int    `json:"version"`
+}
+
+// SearchResult is a dashboard or folder returned by a search
+type SearchResult struct {
+	UID         string   `json:"uid"`
+	Title       string   `json:"title"`
+	URL         string   `json:"url,omitempty"`
+	Type        string   `json:"type"`
+	Tags        []string `json:"tags,omitempty"`
+	FolderUID   string   `json:"folderUid,omitempty"`
+	FolderTitle string   `json:"folderTitle,omitempty"`
+}
+
+// GetDashboard returns the dashboard, the error satisfies IsNotFound if the dashboard does not exist
+func (c *Client) GetDashboard(uid string) (*Dashboard, error) {
+	dashboard := &Dashboard{}
+	if err := c.send(http.MethodGet, dashboardPath(uid), nil, nil, dashboard); err != nil {
+		return nil, err
+	}
+	return dashboard, nil
+}
+
+// SaveDashboard creates or updates a dashboard
+func (c *Client) SaveDashboard(req SaveDashboardRequest) (*SaveDashboardResponse, error) {
+	resp := &SaveDashboardResponse{}
+	if err := c.send(http.MethodPost, "/api/dashboards/db", nil, req, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// DeleteDashboard deletes the dashboard, the error satisfies IsNotFound if the dashboard does not exist
+func (c *Client) DeleteDashboard(uid string) error {
+	return c.send(http.MethodDelete, dashboardPath(uid), nil, nil, nil)
+}
+
+// SearchDashboards returns the dashboards that have the tag
+func (c *Client) SearchDashboards(tag string) ([]SearchResult, error) {
+	var results []SearchResult
+	query := url.Values{"type": []string{DashboardSearchType}, "tag": []string{tag}}
+	if err := c.send(http.MethodGet, "/api/search", query, nil, &results); err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
+func dashboardPath(uid string) string {
+	return path.Join("/api/dashboards/uid", url.PathEscape(uid))
+}
@@ -0,0 +1,342 @@
+// Copyright (c) 2022, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package fake
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/verrazzano/verrazzano/pkg/grafana"
+	httpfake "github.com/verrazzano/verrazzano/pkg/httputil/fake"
+)
+
+// Server is an in-memory stand-in for the parts of the Grafana folder, dashboard and user REST API used by
+// Verrazzano
+type Server struct {
+	*httpfake.Server
+
+	username    string
+	password    string
+	nextID      int64
+	users       map[string]grafana.User
+	folders     []grafana.Folder
+	permissions map[string][]grafana.FolderPermission
+	dashboards  map[string]*dashboard
+	saves       int
+}
+
+// dashboard is a dashboard saved in the server
+type dashboard struct {
+	model       map[string]interface{}
+	folderUID   string
+	provisioned bool
+}
+
+// NewServer starts an HTTP server that requires basic auth with the username and password, the caller must Close it
+func NewServer(username string, password string) *Server {
+	s := newServer(username, password)
+	s.Start()
+	return s
+}
+
+// NewTLSServer starts an HTTPS server like NewServer, the certificate of the server is returned by Certificate
+func NewTLSServer(username string, password string) *Server {
+	s := newServer(username, password)
+	s.StartTLS()
+	return s
+}
+
+func newServer(username string, password string) *Server {
+	s := &Server{
+		username:    username,
+		password:    password,
+		users:       map[string]grafana.User{},
+		permissions: map[string][]grafana.FolderPermission{},
+		dashboards:  map[string]*dashboard{},
+	}
+	s.Server = httpfake.NewServer(s.serveHTTP, writeError)
+	return s
+}
+
+// AddUser adds a user with the login, like a user signing in through the auth proxy, and returns it
+func (s *Server) AddUser(login string) grafana.User {
+	s.Lock()
+	defer s.Unlock()
+	s.nextID++
+	user := grafana.User{ID: s.nextID, Login: login}
+	s.users[login] = user
+	return user
+}
+
+// AddDashboard adds a dashboard in the General folder, provisioned dashboards can not be saved or deleted through
+// the API
+func (s *Server) AddDashboard(model map[string]interface{}, provisioned bool) {
+	s.Lock()
+	defer s.Unlock()
+	s.nextID++
+	model["id"] = s.nextID
+	s.dashboards[model["uid"].(string)] = &dashboard{model: model, provisioned: provisioned}
+}
+
+// Dashboard returns the model and the folder UID of the dashboard, the model is nil if the dashboard does not exist
+func (s *Server) Dashboard(uid string) (map[string]interface{}, string) {
+	s.Lock()
+	defer s.Unlock()
+	d, ok := s.dashboards[uid]
+	if !ok {
+		return nil, ""
+	}
+	return copyModel(d.model), d.folderUID
+}
+
+// DashboardUIDs returns the UIDs of the dashboards, sorted
+func (s *Server) DashboardUIDs() []string {
+	s.Lock()
+	defer s.Unlock()
+	var uids []string
+	for uid := range s.dashboards {
+		uids = append(uids, uid)
+	}
+	sort.Strings(uids)
+	return uids
+}
+
+// Folders returns the folders in the order they were created
+func (s *Server) Folders() []grafana.Folder {
+	s.Lock()
+	defer s.Unlock()
+	return append([]grafana.Folder{}, s.folders...)
+}
+
+// FolderPermissions returns the permissions of the folder
+func (s *Server) FolderPermissions(uid string) []grafana.FolderPermission {
+	s.Lock()
+	defer s.Unlock()
+	return append([]grafana.FolderPermission{}, s.permissions[uid]...)
+}
+
+// Saves returns the number of dashboards that have been saved through the API
+func (s *Server) Saves() int {
+	s.Lock()
+	defer s.Unlock()
+	return s.saves
+}
+
+func (s *Server) serveHTTP(w http.ResponseWriter, req *http.Request) {
+	if username, password, ok := req.BasicAuth(); !ok || username != s.username || password != s.password {
+		writeError(w, http.StatusUnauthorized, "Unauthorized")
+		return
+	}
+
+	segs := httpfake.PathSegments(req.URL.Path, "/api/")
+	route := req.Method + " " + strings.Join(segs, "/")
+	switch {
+	case route == "GET folders":
+		httpfake.WriteJSON(w, http.StatusOK, append([]grafana.Folder{}, s.folders...))
+	case route == "POST folders":
+		s.createFolder(w, req)
+	case len(segs) == 3 && segs[0] == "folders" && segs[2] == "permissions":
+		s.serveFolderPermissions(w, req, segs[1])
+	case route == "GET users/lookup":
+		s.lookupUser(w, req)
+	case route == "GET search":
+		s.search(w, req)
+	case route == "POST dashboards/db":
+		s.saveDashboard(w, req)
+	case len(segs) == 3 && segs[0] == "dashboards" && segs[1] == "uid":
+		s.serveDashboard(w, req, segs[2])
+	default:
+		writeError(w, http.StatusNotFound, "Not found")
+	}
+}
+
+func (s *Server) createFolder(w http.ResponseWriter, req *http.Request) {
+	folder := grafana.Folder{}
+	if !s.ReadJSON(w, req, &folder) {
+		return
+	}
+	for _, existing := range s.folders {
+		if existing.Title == folder.Title || existing.UID == folder.UID {
+			writeError(w, http.StatusConflict, "a folder or dashboard in the general folder with the same name already exists")
+			return
+		}
+	}
+	s.nextID++
+	folder.ID = s.nextID
+	if folder.UID == "" {
+		folder.UID = fmt.Sprintf("folder-%d", folder.ID)
+	}
+	folder.URL = fmt.Sprintf("/dashboards/f/%s/", folder.UID)
+	s.folders = append(s.folders, folder)
+	s.permissions[folder.UID] = []grafana.FolderPermission{
+		{Role: "Viewer", Permission: grafana.PermissionView},
+		{Role: "Editor", Permission: grafana.PermissionEdit},
+	}
+	httpfake.WriteJSON(w, http.StatusOK, folder)
+}
+
+func (s *Server) serveFolderPermissions(w http.ResponseWriter, req *http.Request, uid string) {
+	permissions, ok := s.permissions[uid]
+	if !ok {
+		writeError(w, http.StatusNotFound, "Folder not found")
+		return
+	}
+	switch req.Method {
+	case http.MethodGet:
+		httpfake.WriteJSON(w, http.StatusOK, permissions)
+	case http.MethodPost:
+		body := struct {
+			Items []grafana.FolderPermission `json:"items"`
+		}{}
+		if !s.ReadJSON(w, req, &body) {
+			return
+		}
+		s.permissions[uid] = body.Items
+		httpfake.WriteJSON(w, http.StatusOK, map[string]string{"message": "Folder permissions updated"})
+	default:
+		writeError(w, http.StatusMethodNotAllowed, "Method not allowed")
+	}
+}
+
+func (s *Server) lookupUser(w http.ResponseWriter, req *http.Request) {
+	user, ok := s.users[req.URL.Query().Get("loginOrEmail")]
+	if !ok {
+		writeError(w, http.StatusNotFound, "user not found")
+		return
+	}
+	httpfake.WriteJSON(w, http.StatusOK, user)
+}
+
+func (s *Server) search(w http.ResponseWriter, req *http.Request) {
+	tag := req.URL.Query().Get("tag")
+	results := []grafana.SearchResult{}
+	for uid, d := range s.dashboards {
+		tags := getTags(d.model)
+		if len(tag) > 0 && !containsString(tags, tag) {
+			continue
+		}
+		title, _ := d.model["title"].(string)
+		results = append(results, grafana.SearchResult{UID: uid, Title: title, Type: grafana.DashboardSearchType, Tags: tags, FolderUID: d.folderUID})
+	}
+	sort.Slice(results, func(i, j int) bool { return results[i].UID < results[j].UID })
+	httpfake.WriteJSON(w, http.StatusOK, results)
+}
+
+func (s *Server) saveDashboard(w http.ResponseWriter, req *http.Request) {
+	save := grafana.SaveDashboardRequest{}
+	if !s.ReadJSON(w, req, &save) {
+		return
+	}
+	if title, _ := save.Model["title"].(string); len(title) == 0 {
+		writeError(w, http.StatusBadRequest, "Dashboard title cannot be empty")
+		return
+	}
+	if save.FolderUID != "" && s.findFolder(save.FolderUID) < 0 {
+		writeError(w, http.StatusBadRequest, "folder not found")
+		return
+	}
+	uid, _ := save.Model["uid"].(string)
+	if uid == "" {
+		uid = fmt.Sprintf("dashboard-%d", s.nextID+1)
+	}
+	version := 1
+	if existing, ok := s.dashboards[uid]; ok {
+		if existing.provisioned {
+			writeError(w, http.StatusBadRequest, "Cannot save provisioned dashboard")
+			return
+		}
+		if !save.Overwrite {
+			writeError(w, http.StatusPreconditionFailed, "A dashboard with the same uid already exists")
+			return
+		}
+		if v, ok := existing.model["version"].(int); ok {
+			version = v + 1
+		}
+	}
+	s.nextID++
+	model := copyModel(save.Model)
+	model["id"] = s.nextID
+	model["uid"] = uid
+	model["version"] = version
+	s.dashboards[uid] = &dashboard{model: model, folderUID: save.FolderUID}
+	s.saves++
+	httpfake.WriteJSON(w, http.StatusOK, grafana.SaveDashboardResponse{ID: s.nextID, UID: uid, URL: dashboardURL(uid), Status: "success", Version: version})
+}
+
+func (s *Server) serveDashboard(w http.ResponseWriter, req *http.Request, uid string) {
+	d, ok := s.dashboards[uid]
+	if !ok {
+		writeError(w, http.StatusNotFound, "Dashboard not found")
+		return
+	}
+	switch req.Method {
+	case http.MethodGet:
+		meta := grafana.DashboardMeta{URL: dashboardURL(uid), FolderUID: d.folderUID, Provisioned: d.provisioned}
+		if i := s.findFolder(d.folderUID); i >= 0 {
+			meta.FolderTitle = s.folders[i].Title
+		}
+		httpfake.WriteJSON(w, http.StatusOK, grafana.Dashboard{Model: d.model, Meta: meta})
+	case http.MethodDelete:
+		if d.provisioned {
+			writeError(w, http.StatusBadRequest, "provisioned dashboard cannot be deleted")
+			return
+		}
+		delete(s.dashboards, uid)
+		httpfake.WriteJSON(w, http.StatusOK, map[string]string{"message": "Dashboard deleted"})
+	default:
+		writeError(w, http.StatusMethodNotAllowed, "Method not allowed")
+	}
+}
+
+func (s *Server) findFolder(uid string) int {
+	for i, folder := range s.folders {
+		if folder.UID == uid {
+			return i
+		}
+	}
+	return -1
+}
+
+func dashboardURL(uid string) string {
+	return fmt.Sprintf("/d/%s", uid)
+}
+
+func getTags(model map[string]interface{}) []string {
+	var tags []string
+	switch t := model["tags"].(type) {
+	case []interface{}:
+		for _, tag := range t {
+			if s, ok := tag.(string); ok {
+				tags = append(tags, s)
+			}
+		}
+	case []string:
+		tags = append(tags, t...)
+	}
+	return tags
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+// copyModel returns a deep copy of the model, as it would be decoded from JSON
+func copyModel(model map[string]interface{}) map[string]interface{} {
+	data, _ := json.Marshal(model)
+	out := map[string]interface{}{}
+	_ = json.Unmarshal(data, &out)
+	return out
+}
+
+func writeError(w http.ResponseWriter, code int, message string) {
+	httpfake.WriteJSON(w, code, map[string]string{"message": message})
+}
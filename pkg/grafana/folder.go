@@ -0,0 +1,102 @@
+// Copyright (c) 2022, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package grafana
+
+import (
+	"net/http"
+	"net/url"
+	"path"
+)
+
+const (
+	// PermissionView lets a user or role view the dashboards of a folder
+	PermissionView = 1
+
+	// PermissionEdit lets a user or role create, edit and delete the dashboards of a folder
+	PermissionEdit = 2
+
+	// PermissionAdmin lets a user or role edit the dashboards and the permissions of a folder
+	PermissionAdmin = 4
+)
+
+// Folder is a Grafana folder of dashboards
+type Folder struct {
+	ID    int64  `json:"id,omitempty"`
+	UID   string `json:"uid"`
+	Title string `json:"title"`
+	URL   string `json:"url,omitempty"`
+}
+
+// FolderPermission grants a permission on a folder to a user, a team or an organization role, exactly one of
+// UserID, TeamID and Role is set
+type FolderPermission struct {
+	UserID     int64  `json:"userId,omitempty"`
+	TeamID     int64  `json:"teamId,omitempty"`
+	Role       string `json:"role,omitempty"`
+	Permission int    `json:"permission"`
+}
+
+// User is a Grafana user
+type User struct {
+	ID    int64  `json:"id"`
+	Login string `json:"login"`
+	Email string `json:"email,omitempty"`
+	Name  string `json:"name,omitempty"`
+}
+
+// folderPermissionsRequest is the body of a request to replace the permissions of a folder
+type folderPermissionsRequest struct {
+	Items []FolderPermission `json:"items"`
+}
+
+// GetFolders returns the folders the user can view
+func (c *Client) GetFolders() ([]Folder, error) {
+	var folders []Folder
+	if err := c.send(http.MethodGet, "/api/folders", nil, nil, &folders); err != nil {
+		return nil, err
+	}
+	return folders, nil
+}
+
+// CreateFolder creates a folder with the UID and title, Grafana generates the UID if it is empty.  Folder titles are
+// unique, creating a folder with the title of an existing folder fails.
+func (c *Client) CreateFolder(uid string, title string) (*Folder, error) {
+	folder := &Folder{}
+	if err := c.send(http.MethodPost, "/api/folders", nil, Folder{UID: uid, Title: title}, folder); err != nil {
+		return nil, err
+	}
+	return folder, nil
+}
+
+// GetFolderPermissions returns the permissions of the folder
+func (c *Client) GetFolderPermissions(uid string) ([]FolderPermission, error) {
+	var permissions []FolderPermission
+	if err := c.send(http.MethodGet, folderPermissionsPath(uid), nil, nil, &permissions); err != nil {
+		return nil, err
+	}
+	return permissions, nil
+}
+
+// SetFolderPermissions replaces the permissions of the folder, organization admins keep access to every folder
+func (c *Client) SetFolderPermissions(uid string, permissions []FolderPermission) error {
+	if permissions == nil {
+		permissions = []FolderPermission{}
+	}
+	return c.send(http.MethodPost, folderPermissionsPath(uid), nil, folderPermissionsRequest{Items: permissions}, nil)
+}
+
+// LookupUser returns the user with the login or email, the error satisfies IsNotFound if Grafana has no such user.
+// Users that sign in through the auth proxy only exist once they have signed in.
+func (c *Client) LookupUser(loginOrEmail string) (*User, error) {
+	user := &User{}
+	query := url.Values{"loginOrEmail": []string{loginOrEmail}}
+	if err := c.send(http.MethodGet, "/api/users/lookup", query, nil, user); err != nil {
+		return nil, err
+	}
+	return user, nil
+}
+
+func folderPermissionsPath(uid string) string {
+	return path.Join("/api/folders", url.PathEscape(uid), "permissions")
+}
@@ -0,0 +1,587 @@
+// Copyright (c) 2020, 2022, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package helm
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/verrazzano/verrazzano/pkg/log/vzlog"
+	"github.com/verrazzano/verrazzano/pkg/metricsutils"
+	"go.uber.org/zap"
+	"helm.sh/helm/v3/pkg/action"
+	"helm.sh/helm/v3/pkg/chart"
+	"helm.sh/helm/v3/pkg/chart/loader"
+	"helm.sh/helm/v3/pkg/chartutil"
+	"helm.sh/helm/v3/pkg/cli"
+	"helm.sh/helm/v3/pkg/cli/values"
+	"helm.sh/helm/v3/pkg/getter"
+	kubefake "helm.sh/helm/v3/pkg/kube/fake"
+	"helm.sh/helm/v3/pkg/registry"
+	"helm.sh/helm/v3/pkg/release"
+	"helm.sh/helm/v3/pkg/storage"
+	"helm.sh/helm/v3/pkg/storage/driver"
+	"sigs.k8s.io/yaml"
+)
+
+// Debug is set from a platform-operator arg and logs the debug output of the Helm actions
+var Debug bool
+
+// Helm chart status values: unknown, deployed, uninstalled, superseded, failed, uninstalling, pending-install, pending-upgrade or pending-rollback
+const ChartNotFound = "NotFound"
+const ChartStatusDeployed = "deployed"
+const ChartStatusPendingInstall = "pending-install"
+const ChartStatusPendingUpgrade = "pending-upgrade"
+const ChartStatusPendingRollback = "pending-rollback"
+const ChartStatusFailed = "failed"
+
+// defaultTimeout is the time Helm waits for the Kubernetes operations of an action, it is the default of the Helm CLI
+const defaultTimeout = 5 * time.Minute
+
+// defaultMaxHistory is the maximum number of revisions saved per release, it is the default of the Helm CLI upgrade command
+const defaultMaxHistory = 10
+
+// ChartStatusFnType - Package-level var and functions to allow overriding GetChartStatus for unit test purposes
+type ChartStatusFnType func(releaseName string, namespace string) (string, error)
+
+// HelmOverrides contains all of the overrides that gets passed to the Helm install and upgrade actions
+type HelmOverrides struct {
+	SetOverrides       string // for --set
+	SetStringOverrides string // for --set-string
+	SetFileOverrides   string // for --set-file
+	FileOverride       string // for -f
+}
+
+var chartStatusFn ChartStatusFnType = getChartStatus
+
+// SetChartStatusFunction Override the chart status function for unit testing
+func SetChartStatusFunction(f ChartStatusFnType) {
+	chartStatusFn = f
+}
+
+// SetDefaultChartStatusFunction Reset the chart status function
+func SetDefaultChartStatusFunction() {
+	chartStatusFn = getChartStatus
+}
+
+// ReleaseAppVersionFnType - Package-level var and functions to allow overriding GetReleaseAppVersion for unit test purposes
+type ReleaseAppVersionFnType func(releaseName string, namespace string) (string, error)
+
+var releaseAppVersionFn ReleaseAppVersionFnType = getReleaseAppVersion
+
+// SetReleaseAppVersionFunction Override the GetReleaseAppVersion for unit testing
+func SetReleaseAppVersionFunction(f ReleaseAppVersionFnType) {
+	releaseAppVersionFn = f
+}
+
+// SetDefaultReleaseAppVersionFunction Reset the GetReleaseAppVersion function
+func SetDefaultReleaseAppVersionFunction() {
+	releaseAppVersionFn = getReleaseAppVersion
+}
+
+// Package-level var and functions to allow overriding getReleaseState for unit test purposes
+type releaseStateFnType func(releaseName string, namespace string) (string, error)
+
+var releaseStateFn releaseStateFnType = getReleaseState
+
+// SetChartStateFunction Override the chart state function for unit testing
+func SetChartStateFunction(f releaseStateFnType) {
+	releaseStateFn = f
+}
+
+// SetDefaultChartStateFunction Reset the chart state function
+func SetDefaultChartStateFunction() {
+	releaseStateFn = getChartStatus
+}
+
+// ActionConfigFnType - Package-level var and functions to allow overriding the Helm action configuration for unit test purposes
+type ActionConfigFnType func(log vzlog.VerrazzanoLogger, settings *cli.EnvSettings, namespace string) (*action.Configuration, error)
+
+var actionConfigFn ActionConfigFnType = getActionConfig
+
+// SetActionConfigFunction Override the Helm action configuration function for unit testing
+func SetActionConfigFunction(f ActionConfigFnType) {
+	actionConfigFn = f
+}
+
+// SetDefaultActionConfigFunction Reset the Helm action configuration function
+func SetDefaultActionConfigFunction() {
+	actionConfigFn = getActionConfig
+}
+
+// CreateActionConfig returns a Helm action configuration function for unit testing.  The configurations share an
+// in-memory release storage that initially holds the given releases, and use a fake Kubernetes client.
+func CreateActionConfig(releases ...*release.Release) ActionConfigFnType {
+	mem := driver.NewMemory()
+	for _, rel := range releases {
+		// The releases are stored with the key format of the Helm storage
+		_ = mem.Create(fmt.Sprintf("sh.helm.release.v1.%s.v%d", rel.Name, rel.Version), rel)
+	}
+	return func(log vzlog.VerrazzanoLogger, settings *cli.EnvSettings, namespace string) (*action.Configuration, error) {
+		mem.SetNamespace(namespace)
+		return &action.Configuration{
+			Releases:     storage.Init(mem),
+			KubeClient:   &kubefake.PrintingKubeClient{Out: ioutil.Discard},
+			Capabilities: chartutil.DefaultCapabilities,
+			Log:          debugLog(log),
+		}, nil
+	}
+}
+
+// getActionConfig returns the Helm action configuration for the namespace, the releases are stored in the cluster
+func getActionConfig(log vzlog.VerrazzanoLogger, settings *cli.EnvSettings, namespace string) (*action.Configuration, error) {
+	actionConfig := &action.Configuration{}
+	if err := actionConfig.Init(settings.RESTClientGetter(), namespace, os.Getenv("HELM_DRIVER"), debugLog(log)); err != nil {
+		return nil, err
+	}
+	registryClient, err := registry.NewClient(registry.ClientOptDebug(Debug), registry.ClientOptCredentialsFile(settings.RegistryConfig))
+	if err != nil {
+		return nil, err
+	}
+	actionConfig.RegistryClient = registryClient
+	return actionConfig, nil
+}
+
+// newSettings returns the Helm environment settings for the namespace
+func newSettings(namespace string) *cli.EnvSettings {
+	settings := cli.New()
+	settings.Debug = Debug
+	if namespace != "" {
+		settings.SetNamespace(namespace)
+	}
+	return settings
+}
+
+// newActionConfig returns the Helm action configuration for the namespace
+func newActionConfig(log vzlog.VerrazzanoLogger, namespace string) (*action.Configuration, error) {
+	settings := newSettings(namespace)
+	return actionConfigFn(log, settings, settings.Namespace())
+}
+
+// debugLog returns the logger for the debug output of the Helm actions, the output is only logged in Helm debug mode
+func debugLog(log vzlog.VerrazzanoLogger) action.DebugLog {
+	return func(format string, v ...interface{}) {
+		if Debug {
+			log.Infof(format, v...)
+		}
+	}
+}
+
+// isReleaseNotFound returns true if the error is caused by a release that does not exist
+func isReleaseNotFound(err error) bool {
+	return errors.Is(err, driver.ErrReleaseNotFound)
+}
+
+// GetValues returns the user supplied values of an installed Helm release as YAML.
+func GetValues(log vzlog.VerrazzanoLogger, releaseName string, namespace string) ([]byte, error) {
+	// The values of the installed release will be used as a file override of the Helm upgrade.
+	valuesMap, err := GetValuesMap(log, releaseName, namespace)
+	if err != nil {
+		return nil, err
+	}
+	return yaml.Marshal(valuesMap)
+}
+
+// GetValuesMap returns the user supplied values of an installed Helm release as a map of Objects.
+func GetValuesMap(log vzlog.VerrazzanoLogger, releaseName string, namespace string) (map[string]interface{}, error) {
+	actionConfig, err := newActionConfig(log, namespace)
+	if err != nil {
+		return nil, err
+	}
+	log.Debugf("Getting the Helm values of release %s/%s", namespace, releaseName)
+	start := time.Now()
+	valuesMap, err := action.NewGetValues(actionConfig).Run(releaseName)
+	metricsutils.ObserveCommandDuration("helm", "get values", start, err)
+	if err != nil {
+		log.Errorf("Failed to get Helm values for %s: %v", releaseName, err)
+		return nil, err
+	}
+	if valuesMap == nil {
+		valuesMap = map[string]interface{}{}
+	}
+
+	//  Log get values output
+	log.Debugf("Successfully fetched Helm get values %s", releaseName)
+	return valuesMap, nil
+}
+
+// GetManifest returns the manifest of the resources of an installed Helm release, the resources are separate YAML documents
+func GetManifest(log vzlog.VerrazzanoLogger, releaseName string, namespace string) ([]byte, error) {
+	actionConfig, err := newActionConfig(log, namespace)
+	if err != nil {
+		return nil, err
+	}
+	log.Debugf("Getting the Helm manifest of release %s/%s", namespace, releaseName)
+	start := time.Now()
+	rel, err := action.NewGet(actionConfig).Run(releaseName)
+	metricsutils.ObserveCommandDuration("helm", "get manifest", start, err)
+	if err != nil {
+		log.Errorf("Failed to get Helm manifest for %s: %v", releaseName, err)
+		return nil, err
+	}
+	return []byte(rel.Manifest), nil
+}
+
+// Upgrade will upgrade a Helm release with the specified charts, the release is installed if it does not exist.  The
+// override files array are in order with the first files in the array have lower precedence than latter files.
+func Upgrade(log vzlog.VerrazzanoLogger, releaseName string, namespace string, chartDir string, wait bool, dryRun bool, overrides []HelmOverrides) (stdout []byte, stderr []byte, err error) {
+	// Do not reuse the values of the installed release.  Instead, the values retrieved from GetValues are passed
+	// as a file override. This is a workaround to avoid a failed Helm upgrade that results from a nil reference.
+	// The nil reference occurs when a default value is added to a new chart and new chart references the new value.
+	vals, err := MergeOverrides(namespace, overrides)
+	if err != nil {
+		log.Errorf("Failed to merge the Helm overrides for release %s: %v", releaseName, err)
+		return nil, []byte(err.Error()), err
+	}
+	chrt, err := loader.Load(chartDir)
+	if err != nil {
+		log.Errorf("Failed to load the Helm chart %s for release %s: %v", chartDir, releaseName, err)
+		return nil, []byte(err.Error()), err
+	}
+
+	return runHelm(log, releaseName, namespace, "upgrade", func(actionConfig *action.Configuration) (string, error) {
+		return upgradeRelease(actionConfig, releaseName, namespace, chrt, vals, wait, dryRun)
+	})
+}
+
+// MergeOverrides returns the values of the overrides, merged with the precedence of the Helm CLI: files, then set,
+// then set-string, then set-file overrides
+func MergeOverrides(namespace string, overrides []HelmOverrides) (map[string]interface{}, error) {
+	valueOpts := values.Options{}
+	for _, override := range overrides {
+		if len(override.FileOverride) > 0 {
+			valueOpts.ValueFiles = append(valueOpts.ValueFiles, override.FileOverride)
+		}
+		if len(override.SetOverrides) > 0 {
+			valueOpts.Values = append(valueOpts.Values, override.SetOverrides)
+		}
+		if len(override.SetStringOverrides) > 0 {
+			valueOpts.StringValues = append(valueOpts.StringValues, override.SetStringOverrides)
+		}
+		if len(override.SetFileOverrides) > 0 {
+			valueOpts.FileValues = append(valueOpts.FileValues, override.SetFileOverrides)
+		}
+	}
+	return valueOpts.MergeValues(getter.All(newSettings(namespace)))
+}
+
+// upgradeRelease upgrades the release, or installs it if it has no history, like the Helm CLI upgrade --install command
+func upgradeRelease(actionConfig *action.Configuration, releaseName string, namespace string, chrt *chart.Chart, vals map[string]interface{}, wait bool, dryRun bool) (string, error) {
+	history := action.NewHistory(actionConfig)
+	history.Max = 1
+	if _, err := history.Run(releaseName); isReleaseNotFound(err) {
+		install := action.NewInstall(actionConfig)
+		install.ReleaseName = releaseName
+		install.Namespace = namespace
+		install.Wait = wait
+		install.DryRun = dryRun
+		install.Timeout = defaultTimeout
+		rel, err := install.Run(chrt, vals)
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("Release %s has been installed, revision %d", rel.Name, rel.Version), nil
+	}
+
+	upgrade := action.NewUpgrade(actionConfig)
+	upgrade.Namespace = namespace
+	upgrade.Wait = wait
+	upgrade.DryRun = dryRun
+	upgrade.Timeout = defaultTimeout
+	upgrade.MaxHistory = defaultMaxHistory
+	rel, err := upgrade.Run(releaseName, chrt, vals)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("Release %s has been upgraded, revision %d", rel.Name, rel.Version), nil
+}
+
+// Uninstall will uninstall the release in the specified namespace
+func Uninstall(log vzlog.VerrazzanoLogger, releaseName string, namespace string, dryRun bool) (stdout []byte, stderr []byte, err error) {
+	return runHelm(log, releaseName, namespace, "uninstall", func(actionConfig *action.Configuration) (string, error) {
+		uninstall := action.NewUninstall(actionConfig)
+		uninstall.DryRun = dryRun
+		uninstall.Timeout = defaultTimeout
+		if _, err := uninstall.Run(releaseName); err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("Release %s has been uninstalled", releaseName), nil
+	})
+}
+
+// Rollback will roll the release in the specified namespace back to its last deployed revision.  The previous revision
+// is not used since it may be a failed or pending revision of an earlier upgrade attempt.
+func Rollback(log vzlog.VerrazzanoLogger, releaseName string, namespace string, dryRun bool) (stdout []byte, stderr []byte, err error) {
+	return runHelm(log, releaseName, namespace, "rollback", func(actionConfig *action.Configuration) (string, error) {
+		version, err := lastDeployedRevision(actionConfig, releaseName)
+		if err != nil {
+			return "", err
+		}
+		rollback := action.NewRollback(actionConfig)
+		rollback.Version = version
+		rollback.Wait = true
+		rollback.DryRun = dryRun
+		rollback.Timeout = defaultTimeout
+		if err := rollback.Run(releaseName); err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("Release %s has been rolled back to revision %d", releaseName, version), nil
+	})
+}
+
+// lastDeployedRevision returns the latest revision of the release, older than the current revision, that was
+// deployed.  A deployed revision is superseded once a later revision is deployed, failed and pending revisions
+// were never deployed and are skipped.
+func lastDeployedRevision(actionConfig *action.Configuration, releaseName string) (int, error) {
+	releases, err := action.NewHistory(actionConfig).Run(releaseName)
+	if err != nil {
+		return 0, err
+	}
+	current := 0
+	for _, rel := range releases {
+		if rel.Version > current {
+			current = rel.Version
+		}
+	}
+	version := 0
+	for _, rel := range releases {
+		if rel.Version == current || rel.Version < version || rel.Info == nil {
+			continue
+		}
+		if rel.Info.Status == release.StatusDeployed || rel.Info.Status == release.StatusSuperseded {
+			version = rel.Version
+		}
+	}
+	if version == 0 {
+		return 0, fmt.Errorf("release %s has no deployed revision to roll back to", releaseName)
+	}
+	return version, nil
+}
+
+// Pull downloads a chart from a registry and unpacks it in the destination directory
+func Pull(log vzlog.VerrazzanoLogger, chartURL string, version string, destDir string) (stdout []byte, stderr []byte, err error) {
+	settings := newSettings("")
+	actionConfig, err := actionConfigFn(log, settings, settings.Namespace())
+	if err != nil {
+		return nil, []byte(err.Error()), err
+	}
+	pull := action.NewPullWithOpts(action.WithConfig(actionConfig))
+	pull.Settings = settings
+	pull.Version = version
+	pull.Untar = true
+	pull.UntarDir = destDir
+	log.Progressf("Pulling Helm chart %s", chartURL)
+
+	start := time.Now()
+	out, err := pull.Run(chartURL)
+	metricsutils.ObserveCommandDuration("helm", "pull", start, err)
+	if err != nil {
+		log.Errorf("Failed to pull Helm chart %s: %v", chartURL, err)
+		return []byte(out), []byte(err.Error()), err
+	}
+	return []byte(out), nil, nil
+}
+
+// runHelm is a helper function to run a Helm action on a release and return a result, the error message is returned as stderr
+func runHelm(log vzlog.VerrazzanoLogger, releaseName string, namespace string, operation string, actionFn func(actionConfig *action.Configuration) (string, error)) (stdout []byte, stderr []byte, err error) {
+	// Try to upgrade several times.  Sometimes upgrade fails with "already exists" or "no deployed release".
+	// We have seen from tests that doing a retry will eventually succeed if these 2 errors occur.
+	const maxRetry = 5
+	for i := 1; i <= maxRetry; i++ {
+		if i == 1 {
+			log.Progressf("Running Helm %s for release %s", operation, releaseName)
+		} else {
+			log.Progressf("Re-running Helm %s for release %s", operation, releaseName)
+		}
+
+		start := time.Now()
+		var out string
+		actionConfig, err := newActionConfig(log, namespace)
+		if err == nil {
+			out, err = actionFn(actionConfig)
+		}
+		metricsutils.ObserveCommandDuration("helm", operation, start, err)
+		if err == nil {
+			log.Debugf("Successfully ran Helm %s for release %s", operation, releaseName)
+			return []byte(out), nil, nil
+		}
+		if i == 1 || i == maxRetry {
+			log.Errorf("Failed running Helm %s for release %s: %v", operation, releaseName, err)
+			return nil, []byte(err.Error()), err
+		}
+		log.Infof("Failed running Helm %s for release %s. Retrying %d of %d", operation, releaseName, i+1, maxRetry)
+	}
+
+	return nil, nil, nil
+}
+
+// IsReleaseFailed Returns true if the chart release state is marked 'failed'
+func IsReleaseFailed(releaseName string, namespace string) (bool, error) {
+	log := zap.S()
+	releaseStatus, err := releaseStateFn(releaseName, namespace)
+	if err != nil {
+		log.Errorf("Getting status for chart %s/%s failed with stderr: %v\n", namespace, releaseName, err)
+		return false, err
+	}
+	return releaseStatus == ChartStatusFailed, nil
+}
+
+// IsReleaseDeployed returns true if the release is deployed
+func IsReleaseDeployed(releaseName string, namespace string) (found bool, err error) {
+	log := zap.S()
+	releaseStatus, err := chartStatusFn(releaseName, namespace)
+	if err != nil {
+		log.Errorf("Getting status for chart %s/%s failed with stderr: %v\n", namespace, releaseName, err)
+		return false, err
+	}
+	switch releaseStatus {
+	case ChartNotFound:
+		log.Debugf("Chart %s/%s not found", namespace, releaseName)
+	case ChartStatusDeployed:
+		return true, nil
+	}
+	return false, nil
+}
+
+// IsReleaseInstalled returns true if the release is installed
+func IsReleaseInstalled(releaseName string, namespace string) (found bool, err error) {
+	log := zap.S()
+
+	rel, err := getRelease(releaseName, namespace)
+	if err == nil {
+		log.Debugf("Helm release %s/%s has status %s", namespace, releaseName, rel.Info.Status)
+		return true, nil
+	}
+
+	if isReleaseNotFound(err) {
+		return false, nil
+	}
+	log.Errorf("Helm status for release %s failed with error: %v\n", releaseName, err)
+	return false, err
+}
+
+// getRelease returns the latest revision of a release using the Helm status action
+func getRelease(releaseName string, namespace string) (*release.Release, error) {
+	actionConfig, err := newActionConfig(vzlog.DefaultLogger(), namespace)
+	if err != nil {
+		return nil, err
+	}
+	return action.NewStatus(actionConfig).Run(releaseName)
+}
+
+// getChartStatus returns the Helm deployment status of the specified chart as a string
+func getChartStatus(releaseName string, namespace string) (string, error) {
+	rel, err := getRelease(releaseName, namespace)
+	if err != nil {
+		if isReleaseNotFound(err) {
+			return ChartNotFound, nil
+		}
+		return "", fmt.Errorf("helm status for release %s failed with error: %v", releaseName, err)
+	}
+
+	if rel.Info != nil {
+		return strings.TrimSpace(rel.Info.Status.String()), nil
+	}
+	return "", fmt.Errorf("No chart status found for %s/%s", namespace, releaseName)
+}
+
+// getReleaseState returns the state of a specific release/namespace from the list of releases
+func getReleaseState(releaseName string, namespace string) (string, error) {
+	releases, err := getReleases(namespace)
+	if err != nil {
+		if isReleaseNotFound(err) {
+			return ChartNotFound, nil
+		}
+		return "", err
+	}
+
+	var status string
+	for _, rel := range releases {
+		if rel.Name == releaseName {
+			status = rel.Info.Status.String()
+			break
+		}
+	}
+	return strings.TrimSpace(status), nil
+}
+
+// GetReleaseAppVersion - public function to execute releaseAppVersionFn
+func GetReleaseAppVersion(releaseName string, namespace string) (string, error) {
+	return releaseAppVersionFn(releaseName, namespace)
+}
+
+//GetReleaseStringValues - Returns a subset of Helm release values as a map of strings
+func GetReleaseStringValues(log vzlog.VerrazzanoLogger, valueKeys []string, releaseName string, namespace string) (map[string]string, error) {
+	values, err := GetReleaseValues(log, valueKeys, releaseName, namespace)
+	if err != nil {
+		return map[string]string{}, err
+	}
+	returnVals := map[string]string{}
+	for key, val := range values {
+		returnVals[key] = fmt.Sprintf("%v", val)
+	}
+	return returnVals, err
+}
+
+//GetReleaseValues - Returns a subset of Helm release values as a map of objects
+func GetReleaseValues(log vzlog.VerrazzanoLogger, valueKeys []string, releaseName string, namespace string) (map[string]interface{}, error) {
+	isDeployed, err := IsReleaseDeployed(releaseName, namespace)
+	if err != nil {
+		return map[string]interface{}{}, err
+	}
+	var values = map[string]interface{}{}
+	if isDeployed {
+		valuesMap, err := GetValuesMap(log, releaseName, namespace)
+		if err != nil {
+			return map[string]interface{}{}, err
+		}
+		for _, valueKey := range valueKeys {
+			if mapVal, ok := valuesMap[valueKey]; ok {
+				log.Debugf("Found value for %s: %v", valueKey, mapVal)
+				values[valueKey] = mapVal
+			}
+		}
+	}
+	return values, nil
+}
+
+// getReleaseAppVersion returns the app version of the chart of a specific release/namespace from the list of releases
+func getReleaseAppVersion(releaseName string, namespace string) (string, error) {
+	releases, err := getReleases(namespace)
+	if err != nil {
+		if isReleaseNotFound(err) {
+			return ChartNotFound, nil
+		}
+		return "", err
+	}
+
+	var appVersion string
+	for _, rel := range releases {
+		if rel.Name == releaseName && rel.Chart != nil && rel.Chart.Metadata != nil {
+			appVersion = rel.Chart.Metadata.AppVersion
+			break
+		}
+	}
+	return strings.TrimSpace(appVersion), nil
+}
+
+// getReleases returns the latest revision of the releases of the namespace in any state, including the pending states
+func getReleases(namespace string) ([]*release.Release, error) {
+	actionConfig, err := newActionConfig(vzlog.DefaultLogger(), namespace)
+	if err != nil {
+		return nil, err
+	}
+	list := action.NewList(actionConfig)
+	list.All = true
+	list.SetStateMask()
+	releases, err := list.Run()
+	if err != nil {
+		return nil, fmt.Errorf("helm list for namespace %s failed with error: %w", namespace, err)
+	}
+	return releases, nil
+}
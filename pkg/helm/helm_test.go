@@ -0,0 +1,667 @@
+// Copyright (c) 2020, 2022, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package helm
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/verrazzano/verrazzano/pkg/log/vzlog"
+	"helm.sh/helm/v3/pkg/action"
+	"helm.sh/helm/v3/pkg/chart/loader"
+	"helm.sh/helm/v3/pkg/chartutil"
+	"helm.sh/helm/v3/pkg/cli"
+	"helm.sh/helm/v3/pkg/release"
+	"sigs.k8s.io/yaml"
+)
+
+const ns = "verrazzano-system"
+const chartdir = "testdata"
+const releaseName = "weblogic-operator"
+const missingRelease = "no-release"
+
+// newTestRelease returns a release in the test namespace with the given revision, status and values
+func newTestRelease(name string, version int, status release.Status, values map[string]interface{}) *release.Release {
+	rel := release.Mock(&release.MockReleaseOptions{Name: name, Namespace: ns, Version: version, Status: status})
+	rel.Config = values
+	return rel
+}
+
+// failingActionConfig returns an error instead of a Helm action configuration
+func failingActionConfig(log vzlog.VerrazzanoLogger, settings *cli.EnvSettings, namespace string) (*action.Configuration, error) {
+	return nil, fmt.Errorf("unexpected Helm error")
+}
+
+// getTestRelease returns the latest revision of the release from the storage of the action configuration function
+func getTestRelease(t *testing.T, configFn ActionConfigFnType, name string) *release.Release {
+	cfg, err := configFn(vzlog.DefaultLogger(), cli.New(), ns)
+	assert.NoError(t, err)
+	rel, err := cfg.Releases.Last(name)
+	assert.NoError(t, err)
+	return rel
+}
+
+// TestGetValues tests the Helm get values action
+// GIVEN an installed release
+//  WHEN I call GetValues
+//  THEN the user supplied values of the release are returned as YAML
+func TestGetValues(t *testing.T) {
+	assert := assert.New(t)
+	SetActionConfigFunction(CreateActionConfig(newTestRelease(releaseName, 1, release.StatusDeployed,
+		map[string]interface{}{"image": "weblogic-operator:3.3.0", "replicas": 2})))
+	defer SetDefaultActionConfigFunction()
+
+	stdout, err := GetValues(vzlog.DefaultLogger(), releaseName, ns)
+	assert.NoError(err, "GetValues returned an error")
+	assert.Equal("image: weblogic-operator:3.3.0\nreplicas: 2\n", string(stdout))
+
+	_, err = GetValues(vzlog.DefaultLogger(), missingRelease, ns)
+	assert.Error(err, "GetValues should have returned an error")
+}
+
+// TestUpgrade tests the Helm upgrade action
+// GIVEN an installed release and a set of file, set and set-string overrides
+//  WHEN I call Upgrade
+//  THEN a new revision of the release is deployed with the overrides merged in the Helm CLI order
+func TestUpgrade(t *testing.T) {
+	assert := assert.New(t)
+	configFn := CreateActionConfig(newTestRelease(releaseName, 1, release.StatusDeployed, map[string]interface{}{"replicas": 1}))
+	SetActionConfigFunction(configFn)
+	defer SetDefaultActionConfigFunction()
+
+	overrideFile := filepath.Join(t.TempDir(), "my-override.yaml")
+	assert.NoError(os.WriteFile(overrideFile, []byte("replicas: 2\nimage: weblogic-operator:3.3.0\ntag: 1.0\n"), 0600))
+	overrides := []HelmOverrides{
+		{SetOverrides: "replicas=3"},
+		{FileOverride: overrideFile},
+		{SetStringOverrides: "tag=2.0"},
+	}
+
+	stdout, stderr, err := Upgrade(vzlog.DefaultLogger(), releaseName, ns, chartdir, false, false, overrides)
+	assert.NoError(err, "Upgrade returned an error")
+	assert.Len(stderr, 0, "Upgrade stderr should be empty")
+	assert.NotZero(stdout, "Upgrade stdout should not be empty")
+
+	rel := getTestRelease(t, configFn, releaseName)
+	assert.Equal(2, rel.Version)
+	assert.Equal(release.StatusDeployed, rel.Info.Status)
+	assert.Equal("testChart", rel.Chart.Name())
+	assert.Equal(map[string]interface{}{"replicas": int64(3), "image": "weblogic-operator:3.3.0", "tag": "2.0"}, rel.Config)
+}
+
+// TestUpgradeInstall tests the Helm upgrade action
+// GIVEN a release that is not installed
+//  WHEN I call Upgrade
+//  THEN the release is installed
+func TestUpgradeInstall(t *testing.T) {
+	assert := assert.New(t)
+	configFn := CreateActionConfig()
+	SetActionConfigFunction(configFn)
+	defer SetDefaultActionConfigFunction()
+
+	_, _, err := Upgrade(vzlog.DefaultLogger(), releaseName, ns, chartdir, true, false, []HelmOverrides{{SetOverrides: "replicas=1"}})
+	assert.NoError(err, "Upgrade returned an error")
+
+	rel := getTestRelease(t, configFn, releaseName)
+	assert.Equal(1, rel.Version)
+	assert.Equal(ns, rel.Namespace)
+	assert.Equal(release.StatusDeployed, rel.Info.Status)
+
+	found, err := IsReleaseInstalled(releaseName, ns)
+	assert.NoError(err)
+	assert.True(found)
+}
+
+// TestUpgradeDryRun tests the Helm upgrade action
+// GIVEN an installed release
+//  WHEN I call Upgrade with dry run
+//  THEN no new revision of the release is deployed
+func TestUpgradeDryRun(t *testing.T) {
+	assert := assert.New(t)
+	configFn := CreateActionConfig(newTestRelease(releaseName, 1, release.StatusDeployed, nil))
+	SetActionConfigFunction(configFn)
+	defer SetDefaultActionConfigFunction()
+
+	_, _, err := Upgrade(vzlog.DefaultLogger(), releaseName, ns, chartdir, false, true, nil)
+	assert.NoError(err, "Upgrade returned an error")
+	assert.Equal(1, getTestRelease(t, configFn, releaseName).Version)
+}
+
+// TestUpgradeFail tests the Helm upgrade action failure condition
+// GIVEN a set of upgrade parameters and a Helm configuration that fails
+//  WHEN I call Upgrade
+//  THEN the Helm upgrade returns an error, and the error message as stderr
+func TestUpgradeFail(t *testing.T) {
+	assert := assert.New(t)
+	SetActionConfigFunction(failingActionConfig)
+	defer SetDefaultActionConfigFunction()
+
+	stdout, stderr, err := Upgrade(vzlog.DefaultLogger(), releaseName, ns, chartdir, false, false, nil)
+	assert.Error(err, "Upgrade should have returned an error")
+	assert.Len(stdout, 0, "Upgrade stdout should be empty")
+	assert.Equal("unexpected Helm error", string(stderr))
+
+	// The chart can not be loaded
+	_, _, err = Upgrade(vzlog.DefaultLogger(), releaseName, ns, "no-chart", false, false, nil)
+	assert.Error(err, "Upgrade should have returned an error")
+
+	// The override file does not exist
+	_, _, err = Upgrade(vzlog.DefaultLogger(), releaseName, ns, chartdir, false, false, []HelmOverrides{{FileOverride: "no-file.yaml"}})
+	assert.Error(err, "Upgrade should have returned an error")
+}
+
+// TestMergeOverrides tests the MergeOverrides fn
+// GIVEN file, set, set-string and set-file overrides
+//  WHEN MergeOverrides is called
+//  THEN the values of all the overrides are merged, with the precedence of the Helm CLI
+func TestMergeOverrides(t *testing.T) {
+	dir := t.TempDir()
+	valuesFile := filepath.Join(dir, "values.yaml")
+	assert.NoError(t, os.WriteFile(valuesFile, []byte("replicas: 1\nname: foo\n"), 0600))
+	setFile := filepath.Join(dir, "cert.pem")
+	assert.NoError(t, os.WriteFile(setFile, []byte("cert"), 0600))
+
+	vals, err := MergeOverrides(ns, []HelmOverrides{
+		{FileOverride: valuesFile},
+		{SetOverrides: "replicas=2"},
+		{SetStringOverrides: "version=1.0"},
+		{SetFileOverrides: "tls.cert=" + setFile},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{
+		"replicas": int64(2),
+		"name":     "foo",
+		"version":  "1.0",
+		"tls":      map[string]interface{}{"cert": "cert"},
+	}, vals)
+}
+
+// TestUninstall tests the Helm Uninstall fn
+// GIVEN an installed release
+//  WHEN I call Uninstall
+//  THEN the function returns no error and the release is no longer installed
+func TestUninstall(t *testing.T) {
+	assert := assert.New(t)
+	SetActionConfigFunction(CreateActionConfig(newTestRelease(releaseName, 1, release.StatusDeployed, nil)))
+	defer SetDefaultActionConfigFunction()
+
+	_, _, err := Uninstall(vzlog.DefaultLogger(), releaseName, ns, false)
+	assert.NoError(err)
+
+	found, err := IsReleaseInstalled(releaseName, ns)
+	assert.NoError(err)
+	assert.False(found)
+}
+
+// TestUninstallError tests the Helm Uninstall fn
+// GIVEN a release that is not installed
+//  WHEN I call Uninstall
+//  THEN the function returns an error
+func TestUninstallError(t *testing.T) {
+	SetActionConfigFunction(CreateActionConfig())
+	defer SetDefaultActionConfigFunction()
+
+	_, stderr, err := Uninstall(vzlog.DefaultLogger(), releaseName, ns, false)
+	assert.Error(t, err)
+	assert.NotZero(t, stderr)
+}
+
+// TestRollback tests the Helm Rollback fn
+// GIVEN a release with two revisions
+//  WHEN I call Rollback
+//  THEN the function returns no error and the release is rolled back to the previous revision
+func TestRollback(t *testing.T) {
+	assert := assert.New(t)
+	configFn := CreateActionConfig(
+		newTestRelease(releaseName, 1, release.StatusSuperseded, map[string]interface{}{"replicas": 1}),
+		newTestRelease(releaseName, 2, release.StatusFailed, map[string]interface{}{"replicas": 2}))
+	SetActionConfigFunction(configFn)
+	defer SetDefaultActionConfigFunction()
+
+	_, _, err := Rollback(vzlog.DefaultLogger(), releaseName, ns, false)
+	assert.NoError(err)
+
+	rel := getTestRelease(t, configFn, releaseName)
+	assert.Equal(3, rel.Version)
+	assert.Equal(release.StatusDeployed, rel.Info.Status)
+	assert.Equal(map[string]interface{}{"replicas": 1}, rel.Config)
+}
+
+// TestRollbackSkipsFailedRevisions tests the Helm Rollback fn
+// GIVEN a release whose revisions after the last deployed one are failed
+//  WHEN I call Rollback
+//  THEN the release is rolled back to the last deployed revision, not to the previous failed revision
+func TestRollbackSkipsFailedRevisions(t *testing.T) {
+	assert := assert.New(t)
+	configFn := CreateActionConfig(
+		newTestRelease(releaseName, 1, release.StatusSuperseded, map[string]interface{}{"replicas": 1}),
+		newTestRelease(releaseName, 2, release.StatusDeployed, map[string]interface{}{"replicas": 2}),
+		newTestRelease(releaseName, 3, release.StatusFailed, map[string]interface{}{"replicas": 3}),
+		newTestRelease(releaseName, 4, release.StatusFailed, map[string]interface{}{"replicas": 4}))
+	SetActionConfigFunction(configFn)
+	defer SetDefaultActionConfigFunction()
+
+	stdout, _, err := Rollback(vzlog.DefaultLogger(), releaseName, ns, false)
+	assert.NoError(err)
+	assert.Contains(string(stdout), "revision 2")
+
+	rel := getTestRelease(t, configFn, releaseName)
+	assert.Equal(5, rel.Version)
+	assert.Equal(release.StatusDeployed, rel.Info.Status)
+	assert.Equal(map[string]interface{}{"replicas": 2}, rel.Config)
+}
+
+// TestRollbackError tests the Helm Rollback fn
+// GIVEN a release with a single revision
+//  WHEN I call Rollback
+//  THEN the function returns an error
+func TestRollbackError(t *testing.T) {
+	SetActionConfigFunction(CreateActionConfig(newTestRelease(releaseName, 1, release.StatusDeployed, nil)))
+	defer SetDefaultActionConfigFunction()
+
+	_, _, err := Rollback(vzlog.DefaultLogger(), releaseName, ns, false)
+	assert.Error(t, err)
+}
+
+// TestPull tests the Helm Pull fn
+// GIVEN a chart in a registry
+//  WHEN I call Pull
+//  THEN the chart version is pulled and unpacked in the destination directory
+func TestPull(t *testing.T) {
+	assert := assert.New(t)
+	chrt, err := loader.Load(chartdir)
+	assert.NoError(err)
+	archive, err := chartutil.Save(chrt, t.TempDir())
+	assert.NoError(err)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.ServeFile(w, r, archive)
+	}))
+	defer server.Close()
+
+	SetActionConfigFunction(CreateActionConfig())
+	defer SetDefaultActionConfigFunction()
+
+	destDir := t.TempDir()
+	_, _, err = Pull(vzlog.DefaultLogger(), server.URL+"/charts/testChart-0.8.0.tgz", "0.8.0", destDir)
+	assert.NoError(err)
+	info, err := GetChartInfo(filepath.Join(destDir, "testChart"))
+	assert.NoError(err)
+	assert.Equal("0.8.0", info.Version)
+
+	_, stderr, err := Pull(vzlog.DefaultLogger(), server.URL+"/charts/testChart-0.8.0.tgz", "0.8.0", destDir)
+	assert.Error(err, "Pull should fail when the chart is already unpacked")
+	assert.NotZero(stderr)
+}
+
+// TestGetManifest tests the Helm GetManifest fn
+// GIVEN an installed release
+//  WHEN I call GetManifest
+//  THEN the manifest of the release is returned
+func TestGetManifest(t *testing.T) {
+	rel := newTestRelease(releaseName, 1, release.StatusDeployed, nil)
+	rel.Manifest = "kind: Deployment"
+	SetActionConfigFunction(CreateActionConfig(rel))
+	defer SetDefaultActionConfigFunction()
+
+	manifest, err := GetManifest(vzlog.DefaultLogger(), releaseName, ns)
+	assert.NoError(t, err)
+	assert.Equal(t, "kind: Deployment", string(manifest))
+
+	_, err = GetManifest(vzlog.DefaultLogger(), missingRelease, ns)
+	assert.Error(t, err)
+}
+
+// TestIsReleaseInstalled tests checking if a Helm release is installed
+// GIVEN a release name and namespace
+//  WHEN I call IsReleaseInstalled
+//  THEN the function returns success and found equal true
+func TestIsReleaseInstalled(t *testing.T) {
+	assert := assert.New(t)
+	SetActionConfigFunction(CreateActionConfig(newTestRelease(releaseName, 1, release.StatusFailed, nil)))
+	defer SetDefaultActionConfigFunction()
+
+	found, err := IsReleaseInstalled(releaseName, ns)
+	assert.NoError(err, "IsReleaseInstalled returned an error")
+	assert.True(found, "Release not found")
+}
+
+// TestIsReleaseNotInstalled tests checking if a Helm release is not installed
+// GIVEN a release name and namespace
+//  WHEN I call IsReleaseInstalled
+//  THEN the function returns success and the correct found status
+func TestIsReleaseNotInstalled(t *testing.T) {
+	assert := assert.New(t)
+	SetActionConfigFunction(CreateActionConfig(newTestRelease(releaseName, 1, release.StatusDeployed, nil)))
+	defer SetDefaultActionConfigFunction()
+
+	found, err := IsReleaseInstalled(missingRelease, ns)
+	assert.NoError(err, "IsReleaseInstalled returned an error")
+	assert.False(found, "Release should not be found")
+}
+
+// TestIsReleaseInstalledFailed tests failure when checking if a Helm release is installed
+// GIVEN a release name and namespace
+//  WHEN I call IsReleaseInstalled and Helm returns an error
+//  THEN the function returns a failure
+func TestIsReleaseInstalledFailed(t *testing.T) {
+	assert := assert.New(t)
+	SetActionConfigFunction(failingActionConfig)
+	defer SetDefaultActionConfigFunction()
+
+	found, err := IsReleaseInstalled(releaseName, ns)
+	assert.Error(err, "IsReleaseInstalled should have returned an error")
+	assert.False(found, "Release should not be found")
+}
+
+// TestIsReleaseDeployed tests checking if a Helm release is installed
+// GIVEN a release name and namespace
+//  WHEN I call IsReleaseDeployed
+//  THEN the function returns success and found equal true
+func TestIsReleaseDeployed(t *testing.T) {
+	assert := assert.New(t)
+	SetChartStatusFunction(func(releaseName string, namespace string) (string, error) {
+		return ChartStatusDeployed, nil
+	})
+	defer SetDefaultChartStatusFunction()
+
+	found, err := IsReleaseDeployed(releaseName, ns)
+	assert.NoError(err, "IsReleaseInstalled returned an error")
+	assert.True(found, "Release not found")
+}
+
+// TestIsReleaseNotDeployed tests checking if a Helm release is not installed
+// GIVEN a release name and namespace
+//  WHEN I call IsReleaseDeployed
+//  THEN the function returns success and the correct found status
+func TestIsReleaseNotDeployed(t *testing.T) {
+	assert := assert.New(t)
+	SetChartStatusFunction(func(releaseName string, namespace string) (string, error) {
+		return ChartNotFound, nil
+	})
+	defer SetDefaultChartStatusFunction()
+
+	found, err := IsReleaseDeployed(missingRelease, ns)
+	assert.NoError(err, "IsReleaseInstalled returned an error")
+	assert.False(found, "Release should not be found")
+}
+
+// TestIsReleaseFailedChartNotFound tests checking if a Helm release is in a failed state
+// GIVEN a release name and namespace
+//  WHEN I call IsReleaseFailed and the status is ChartNotFound
+//  THEN the function returns false and no error
+func TestIsReleaseFailedChartNotFound(t *testing.T) {
+	assert := assert.New(t)
+	SetChartStateFunction(func(releaseName string, namespace string) (string, error) {
+		return ChartNotFound, nil
+	})
+	defer SetDefaultChartStateFunction()
+
+	failed, err := IsReleaseFailed("foo", "bar")
+	assert.NoError(err, "IsReleaseInstalled returned an error")
+	assert.False(failed, "ReleaseFailed should be false")
+}
+
+// TestIsReleaseFailedChartNotFound tests checking if a Helm release is in a failed state
+// GIVEN a release name and namespace
+//  WHEN I call IsReleaseFailed and the status is deployed
+//  THEN the function returns false and no error
+func TestIsReleaseFailedChartDeployed(t *testing.T) {
+	assert := assert.New(t)
+	SetChartStateFunction(func(releaseName string, namespace string) (string, error) {
+		return ChartStatusDeployed, nil
+	})
+	defer SetDefaultChartStateFunction()
+
+	failed, err := IsReleaseFailed("foo", "bar")
+	assert.NoError(err, "IsReleaseInstalled returned an error")
+	assert.False(failed, "ReleaseFailed should be false")
+}
+
+// TestIsReleaseFailed tests checking if a Helm release is in a failed state
+// GIVEN a release name and namespace
+//  WHEN I call IsReleaseFailed and the status is failed
+//  THEN the function returns false and no error
+func TestIsReleaseFailed(t *testing.T) {
+	assert := assert.New(t)
+	SetChartStateFunction(func(releaseName string, namespace string) (string, error) {
+		return ChartStatusFailed, nil
+	})
+	defer SetDefaultChartStateFunction()
+
+	failed, err := IsReleaseFailed("foo", "bar")
+	assert.NoError(err, "IsReleaseInstalled returned an error")
+	assert.True(failed, "ReleaseFailed should be true")
+}
+
+// TestIsReleaseFailedError tests checking if a Helm release is in a failed state
+// GIVEN a release name and namespace
+//  WHEN I call IsReleaseFailed and the an error is returned by the state function
+//  THEN the function returns false and an error
+func TestIsReleaseFailedError(t *testing.T) {
+	assert := assert.New(t)
+	SetChartStateFunction(func(releaseName string, namespace string) (string, error) {
+		return "", fmt.Errorf("Unexpected error")
+	})
+	defer SetDefaultChartStateFunction()
+
+	failed, err := IsReleaseFailed("foo", "bar")
+	assert.Error(err, "IsReleaseInstalled returned an error")
+	assert.False(failed)
+}
+
+// Test_getReleaseStateDeployed tests the getReleaseState fn
+// GIVEN a call to getReleaseState
+//  WHEN the chart state is deployed
+//  THEN the function returns ChartStatusDeployed and no error
+func Test_getReleaseStateDeployed(t *testing.T) {
+	SetActionConfigFunction(CreateActionConfig(
+		newTestRelease(releaseName, 1, release.StatusSuperseded, nil),
+		newTestRelease(releaseName, 2, release.StatusDeployed, nil)))
+	defer SetDefaultActionConfigFunction()
+
+	state, err := getReleaseState(releaseName, ns)
+	assert.NoError(t, err)
+	assert.Equalf(t, ChartStatusDeployed, state, "unpexected state: %s", state)
+}
+
+// Test_getReleaseStatePendingInstall tests the getReleaseState fn
+// GIVEN a call to getReleaseState
+//  WHEN the chart state is pending-install
+//  THEN the function returns ChartStatusPendingInstall and no error
+func Test_getReleaseStatePendingInstall(t *testing.T) {
+	SetActionConfigFunction(CreateActionConfig(newTestRelease(releaseName, 1, release.StatusPendingInstall, nil)))
+	defer SetDefaultActionConfigFunction()
+
+	state, err := getReleaseState(releaseName, ns)
+	assert.NoError(t, err)
+	assert.Equalf(t, ChartStatusPendingInstall, state, "unpexected state: %s", state)
+}
+
+// Test_getReleaseStateChartNotFound tests the getReleaseState fn
+// GIVEN a call to getReleaseState
+//  WHEN the chart/release can not be found
+//  THEN the function returns "" and no error
+func Test_getReleaseStateChartNotFound(t *testing.T) {
+	SetActionConfigFunction(CreateActionConfig(newTestRelease("other", 1, release.StatusDeployed, nil)))
+	defer SetDefaultActionConfigFunction()
+
+	state, err := getReleaseState(releaseName, ns)
+	assert.NoError(t, err)
+	assert.Equalf(t, "", state, "unpexected state: %s", state)
+}
+
+// Test_getChartStatusDeployed tests the getChartStatus fn
+// GIVEN a call to getChartStatus
+//  WHEN Helm returns a deployed state
+//  THEN the function returns "deployed" and no error
+func Test_getChartStatusDeployed(t *testing.T) {
+	SetActionConfigFunction(CreateActionConfig(newTestRelease(releaseName, 1, release.StatusDeployed, nil)))
+	defer SetDefaultActionConfigFunction()
+
+	state, err := getChartStatus(releaseName, ns)
+	assert.NoError(t, err)
+	assert.Equalf(t, ChartStatusDeployed, state, "unpexected state: %s", state)
+}
+
+// Test_getChartStatusChartNotFound tests the getChartStatus fn
+// GIVEN a call to getChartStatus
+//  WHEN the Chart is not found
+//  THEN the function returns chart not found and no error
+func Test_getChartStatusChartNotFound(t *testing.T) {
+	SetActionConfigFunction(CreateActionConfig())
+	defer SetDefaultActionConfigFunction()
+
+	state, err := getChartStatus(releaseName, ns)
+	assert.NoError(t, err)
+	assert.Equalf(t, ChartNotFound, state, "unpexected state: %s", state)
+}
+
+// Test_getChartStatusUnexpectedHelmError tests the getChartStatus fn
+// GIVEN a call to getChartStatus
+//  WHEN Helm returns an error
+//  THEN the function returns an error
+func Test_getChartStatusUnexpectedHelmError(t *testing.T) {
+	SetActionConfigFunction(failingActionConfig)
+	defer SetDefaultActionConfigFunction()
+
+	state, err := getChartStatus(releaseName, ns)
+	assert.Error(t, err)
+	assert.Equalf(t, "", state, "unpexected state: %s", state)
+}
+
+// newExternalDNSRelease returns a deployed release with the values of the external DNS chart
+func newExternalDNSRelease(t *testing.T) *release.Release {
+	values := map[string]interface{}{}
+	assert.NoError(t, yaml.Unmarshal([]byte(`
+domainFilters:
+  - my.domain.io
+triggerLoopOnEvent: true
+txtOwnerId: v8o-default-my-verrazzano-3201314693
+txtPrefix: _v8o-default-my-verrazzano-3201314693-
+zoneIDFilters:
+  - ocid1.dns-zone.oc1..blahblahblah
+`), &values))
+	rel := newTestRelease("external-dns", 1, release.StatusDeployed, values)
+	rel.Namespace = "cert-manager"
+	return rel
+}
+
+// TestGetReleaseValue tests the GetReleaseValues fn
+// GIVEN a call to GetReleaseValues
+//  WHEN a valid helm release and namespace are deployed
+//  THEN the function returns the value/true/nil if the helm key exists, or ""/false/nil if it doesn't
+func TestGetReleaseValue(t *testing.T) {
+	SetActionConfigFunction(CreateActionConfig(newExternalDNSRelease(t)))
+	defer SetDefaultActionConfigFunction()
+
+	keys := []string{"txtOwnerId", "zoneIDFilters", "foo"}
+	value, err := GetReleaseValues(vzlog.DefaultLogger(), keys, "external-dns", "cert-manager")
+
+	expectedMap := map[string]interface{}{
+		"txtOwnerId":    "v8o-default-my-verrazzano-3201314693",
+		"zoneIDFilters": []interface{}{"ocid1.dns-zone.oc1..blahblahblah"},
+	}
+	assert.NoError(t, err)
+	assert.Equal(t, expectedMap, value, "Map did not contain expected values")
+}
+
+// TestGetReleaseStringValue tests the GetReleaseStringValues fn
+// GIVEN a call to GetReleaseStringValues
+//  WHEN a valid helm release and namespace are deployed
+//  THEN the function returns the value/true/nil if the helm key exists, or ""/false/nil if it doesn't
+func TestGetReleaseStringValue(t *testing.T) {
+	SetActionConfigFunction(CreateActionConfig(newExternalDNSRelease(t)))
+	defer SetDefaultActionConfigFunction()
+
+	keys := []string{"txtOwnerId", "zoneIDFilters", "foo"}
+	value, err := GetReleaseStringValues(vzlog.DefaultLogger(), keys, "external-dns", "cert-manager")
+
+	expectedMap := map[string]string{
+		"txtOwnerId":    "v8o-default-my-verrazzano-3201314693",
+		"zoneIDFilters": "[ocid1.dns-zone.oc1..blahblahblah]",
+	}
+	assert.NoError(t, err)
+	assert.Equal(t, expectedMap, value, "Map did not contain expected values")
+}
+
+// TestGetReleaseValueReleaseNotFound tests the GetReleaseValues fn
+// GIVEN a call to GetReleaseValues
+//  WHEN a the helm release is NOT deployed
+//  THEN the function returns the value/true/nil if the helm key exists, or ""/false/nil if it doesn't
+func TestGetReleaseValueReleaseNotFound(t *testing.T) {
+	SetActionConfigFunction(CreateActionConfig())
+	defer SetDefaultActionConfigFunction()
+
+	keys := []string{"txtOwnerId", "external-dns"}
+	values, err := GetReleaseValues(vzlog.DefaultLogger(), keys, "external-dns", "cert-manager")
+	assert.NoErrorf(t, err, "Unexpected error: %v", err)
+	assert.Equal(t, map[string]interface{}{}, values, "Found unexpected release value")
+
+	expectedErr := fmt.Errorf("Helm error")
+	SetChartStatusFunction(func(releaseName string, namespace string) (string, error) {
+		return ChartNotFound, expectedErr
+	})
+	defer SetDefaultChartStatusFunction()
+	values, helmErr := GetReleaseValues(vzlog.DefaultLogger(), keys, "external-dns", "cert-manager")
+	assert.Equal(t, map[string]interface{}{}, values, "Found unexpected release values")
+	assert.Error(t, helmErr, "Did not get expected error")
+	assert.Equal(t, expectedErr, helmErr)
+}
+
+// Test_GetReleaseAppVersion tests the GetReleaseAppVersion function
+// GIVEN a call to GetReleaseAppVersion
+//  WHEN varying the inputs and underlying status
+//  THEN test the expected result is returned
+func Test_GetReleaseAppVersion(t *testing.T) {
+	type args struct {
+		releaseName string
+		namespace   string
+		releases    []*release.Release
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    string
+		wantErr bool
+	}{
+		{
+			name: "Test GetReleaseAppVersion when app_version exists",
+			want: "1.0",
+			args: args{
+				releaseName: "verrazzano",
+				namespace:   ns,
+				releases:    []*release.Release{newTestRelease("verrazzano", 1, release.StatusDeployed, nil)},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Test GetReleaseAppVersion when app_version does not exist",
+			want: "",
+			args: args{
+				releaseName: "verrazzano",
+				namespace:   ns,
+				releases:    []*release.Release{newTestRelease("unknown", 1, release.StatusDeployed, nil)},
+			},
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			SetActionConfigFunction(CreateActionConfig(tt.args.releases...))
+			defer SetDefaultActionConfigFunction()
+			got, err := GetReleaseAppVersion(tt.args.releaseName, tt.args.namespace)
+			if !tt.wantErr {
+				assert.NoError(t, err)
+				assert.Equalf(t, tt.want, got, "GetReleaseAppVersion(%v, %v)", tt.args.releaseName, tt.args.namespace)
+			} else {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
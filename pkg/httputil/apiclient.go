@@ -0,0 +1,172 @@
+// Copyright (c) 2022, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package httputil
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+const (
+	contentTypeHeader   = "Content-Type"
+	authorizationHeader = "Authorization"
+	applicationJSON     = "application/json"
+)
+
+// ErrorParser returns the type and the message of the error response body of a REST API
+type ErrorParser func(body []byte) (errType string, message string)
+
+// APIClient is a client for a JSON REST API, it is wrapped by the clients of the Verrazzano components that have one
+type APIClient struct {
+	// server is the name of the server used in the error messages, like Grafana
+	server string
+	// baseURL is the URL of the server including the context path
+	baseURL     string
+	httpClient  *http.Client
+	parseError  ErrorParser
+	username    string
+	password    string
+	bearerToken string
+}
+
+// APIError is returned when a REST API responds with an unexpected status code
+type APIError struct {
+	Server     string
+	Method     string
+	Path       string
+	StatusCode int
+	// Type is the type or code of the error, if the server returns one
+	Type    string
+	Message string
+}
+
+// NewAPIClient returns a client for the REST API of the server at the base URL.  The error parser gets the type and
+// message of the error responses, if it is nil the message is the response body.
+func NewAPIClient(server string, baseURL string, httpClient *http.Client, parseError ErrorParser) *APIClient {
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+	return &APIClient{
+		server:     server,
+		baseURL:    strings.TrimSuffix(baseURL, "/"),
+		httpClient: httpClient,
+		parseError: parseError,
+	}
+}
+
+// Error returns the error message
+func (e *APIError) Error() string {
+	msg := fmt.Sprintf("%s %s %s returned status code %d", e.Server, e.Method, e.Path, e.StatusCode)
+	if len(e.Message) > 0 {
+		msg = fmt.Sprintf("%s: %s", msg, e.Message)
+	}
+	return msg
+}
+
+// IsNotFound returns true if the error is an APIError with the status code 404
+func IsNotFound(err error) bool {
+	return HasStatusCode(err, http.StatusNotFound)
+}
+
+// HasStatusCode returns true if the error is an APIError with the status code
+func HasStatusCode(err error, code int) bool {
+	var apiErr *APIError
+	return errors.As(err, &apiErr) && apiErr.StatusCode == code
+}
+
+// BaseURL returns the base URL of the server
+func (c *APIClient) BaseURL() string {
+	return c.baseURL
+}
+
+// SetBasicAuth authenticates the requests sent with Send using basic auth
+func (c *APIClient) SetBasicAuth(username string, password string) {
+	c.username = username
+	c.password = password
+}
+
+// SetBearerToken authenticates the requests sent with Send using the bearer token
+func (c *APIClient) SetBearerToken(token string) {
+	c.bearerToken = token
+}
+
+// HasCredentials returns true if the requests sent with Send are authenticated
+func (c *APIClient) HasCredentials() bool {
+	return len(c.username) > 0 || len(c.bearerToken) > 0
+}
+
+// Send sends an authenticated request with an optional JSON body to the path of the REST API and decodes the JSON
+// response into out if it is not nil.  Any 2xx status code is valid if no valid codes are given.
+func (c *APIClient) Send(method string, reqPath string, query url.Values, in interface{}, out interface{}, validCodes ...int) (*http.Response, error) {
+	reqURL := c.baseURL + reqPath
+	if len(query) > 0 {
+		reqURL = reqURL + "?" + query.Encode()
+	}
+	var body io.Reader
+	if in != nil {
+		data, err := json.Marshal(in)
+		if err != nil {
+			return nil, err
+		}
+		body = bytes.NewReader(data)
+	}
+	req, err := http.NewRequest(method, reqURL, body)
+	if err != nil {
+		return nil, err
+	}
+	if in != nil {
+		req.Header.Set(contentTypeHeader, applicationJSON)
+	}
+	if len(c.bearerToken) > 0 {
+		req.Header.Set(authorizationHeader, "Bearer "+c.bearerToken)
+	} else if len(c.username) > 0 {
+		req.SetBasicAuth(c.username, c.password)
+	}
+	return c.Do(req, reqPath, out, validCodes...)
+}
+
+// Do sends the request, checks the status code and decodes the JSON response into out if it is not nil.  The path
+// is the path of the request used in the errors.  Any 2xx status code is valid if no valid codes are given.
+func (c *APIClient) Do(req *http.Request, reqPath string, out interface{}, validCodes ...int) (*http.Response, error) {
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	data, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if !isValidCode(validCodes, resp.StatusCode) {
+		apiErr := &APIError{Server: c.server, Method: req.Method, Path: reqPath, StatusCode: resp.StatusCode}
+		if c.parseError != nil {
+			apiErr.Type, apiErr.Message = c.parseError(data)
+		}
+		if len(apiErr.Message) == 0 {
+			apiErr.Message = strings.TrimSpace(string(data))
+		}
+		return nil, apiErr
+	}
+	if out != nil && len(data) > 0 {
+		if err := json.Unmarshal(data, out); err != nil {
+			return nil, fmt.Errorf("Failed decoding the response of %s %s %s: %v", c.server, req.Method, reqPath, err)
+		}
+	}
+	return resp, nil
+}
+
+// isValidCode returns true if the status code is one of the valid codes, or a 2xx code if there are none
+func isValidCode(validCodes []int, code int) bool {
+	if len(validCodes) == 0 {
+		return code >= 200 && code <= 299
+	}
+	return integerSliceContains(validCodes, code)
+}
@@ -0,0 +1,103 @@
+// Copyright (c) 2022, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package httputil_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/verrazzano/verrazzano/pkg/httputil"
+)
+
+type testObject struct {
+	Name string `json:"name"`
+}
+
+// newTestServer returns a server that echoes the JSON body of the requests to /api/objects with the credentials of
+// the request appended to the name, and returns an error
+// response with the status code 404 for the other paths
+func newTestServer() *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/objects" {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"type":"not_found","message":"object not found"}`))
+			return
+		}
+		obj := testObject{}
+		_ = json.NewDecoder(r.Body).Decode(&obj)
+		if username, _, ok := r.BasicAuth(); ok {
+			obj.Name = obj.Name + "-" + username
+		} else {
+			obj.Name = obj.Name + "-" + r.Header.Get("Authorization")
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(obj)
+	}))
+}
+
+// parseTestError returns the type and message of the error responses of the test server
+func parseTestError(data []byte) (string, string) {
+	errResp := struct {
+		Type    string `json:"type"`
+		Message string `json:"message"`
+	}{}
+	_ = json.Unmarshal(data, &errResp)
+	return errResp.Type, errResp.Message
+}
+
+// TestAPIClientSend tests sending requests to a REST API
+// GIVEN a REST API server
+//  WHEN I send a request with basic auth and a JSON body
+//  THEN the request is authenticated and the JSON response is decoded
+func TestAPIClientSend(t *testing.T) {
+	asserts := assert.New(t)
+	server := newTestServer()
+	defer server.Close()
+
+	api := httputil.NewAPIClient("Test", server.URL+"/api/", server.Client(), parseTestError)
+	asserts.False(api.HasCredentials())
+	api.SetBasicAuth("user", "pw")
+	asserts.True(api.HasCredentials())
+	out := testObject{}
+	resp, err := api.Send(http.MethodPost, "/objects", nil, testObject{Name: "obj"}, &out)
+	asserts.NoError(err)
+	asserts.Equal(http.StatusOK, resp.StatusCode)
+	asserts.Equal("obj-user", out.Name)
+
+	api.SetBearerToken("token")
+	_, err = api.Send(http.MethodPost, "/objects", nil, testObject{Name: "obj"}, &out, http.StatusOK)
+	asserts.NoError(err)
+	asserts.Equal("obj-Bearer token", out.Name)
+
+	// The status code is not one of the valid codes
+	_, err = api.Send(http.MethodPost, "/objects", nil, testObject{Name: "obj"}, &out, http.StatusCreated)
+	asserts.Error(err)
+	asserts.True(httputil.HasStatusCode(err, http.StatusOK))
+}
+
+// TestAPIClientError tests the error returned for a failed request
+// GIVEN a REST API server
+//  WHEN I send a request for a path that does not exist
+//  THEN an APIError with the status code and the parsed type and message of the error response is returned
+func TestAPIClientError(t *testing.T) {
+	asserts := assert.New(t)
+	server := newTestServer()
+	defer server.Close()
+
+	_, err := httputil.NewAPIClient("Test", server.URL, server.Client(), parseTestError).Send(http.MethodGet, "/missing", nil, nil, nil)
+	asserts.Error(err)
+	asserts.True(httputil.IsNotFound(err))
+	apiErr, ok := err.(*httputil.APIError)
+	asserts.True(ok)
+	asserts.Equal("not_found", apiErr.Type)
+	asserts.Equal("Test GET /missing returned status code 404: object not found", err.Error())
+
+	// Without an error parser the message is the response body
+	_, err = httputil.NewAPIClient("Test", server.URL, server.Client(), nil).Send(http.MethodGet, "/missing", nil, nil, nil)
+	asserts.Error(err)
+	asserts.Contains(err.Error(), `returned status code 404: {"type":"not_found","message":"object not found"}`)
+}
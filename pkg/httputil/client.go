@@ -0,0 +1,38 @@
+// Copyright (c) 2022, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package httputil
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"net/http"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// caCertKey is the key of the CA certificate in the secret of a certificate issued by cert-manager
+const caCertKey = "ca.crt"
+
+// NewCertSecretClient returns an HTTP client of an ingress that trusts the CA of the certificate in the secret.
+// Certificates issued by a public CA, like Let's Encrypt, have no ca.crt and are trusted by the system pool, the
+// system pool is also used if the secret does not exist.
+func NewCertSecretClient(c client.Reader, certSecret types.NamespacedName, timeout time.Duration) (*http.Client, error) {
+	tlsConfig := &tls.Config{MinVersion: tls.VersionTLS12}
+	secret := &corev1.Secret{}
+	if err := c.Get(context.TODO(), certSecret, secret); client.IgnoreNotFound(err) != nil {
+		return nil, err
+	}
+	if rootCA := secret.Data[caCertKey]; len(rootCA) > 0 {
+		tlsConfig.RootCAs = x509.NewCertPool()
+		tlsConfig.RootCAs.AppendCertsFromPEM(rootCA)
+	}
+	return &http.Client{
+		Transport: &http.Transport{TLSClientConfig: tlsConfig},
+		Timeout:   timeout,
+	}, nil
+}
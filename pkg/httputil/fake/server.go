@@ -0,0 +1,89 @@
+// Copyright (c) 2022, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package fake
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync"
+)
+
+// ErrorWriter writes an error response in the format of the REST API that a fake stands in for
+type ErrorWriter func(w http.ResponseWriter, code int, message string)
+
+// Server is the HTTP server of the in-memory fakes of the REST APIs used by Verrazzano.  The requests are served one
+// at a time while the server is locked, and the requests that a test injected a failure for fail before they reach
+// the handler.  The fakes lock the server to read or change their state.
+type Server struct {
+	*httptest.Server
+	sync.Mutex
+
+	handler    http.HandlerFunc
+	writeError ErrorWriter
+	failures   map[string]int
+}
+
+// NewServer returns a server that serves the requests with the handler, the fake starts it with Start or StartTLS
+// and the caller must Close it
+func NewServer(handler http.HandlerFunc, writeError ErrorWriter) *Server {
+	s := &Server{
+		handler:    handler,
+		writeError: writeError,
+		failures:   map[string]int{},
+	}
+	s.Server = httptest.NewUnstartedServer(http.HandlerFunc(s.serveHTTP))
+	return s
+}
+
+// FailRequests makes the requests with the method, whose path starts with the prefix, fail with the status code
+func (s *Server) FailRequests(method string, pathPrefix string, statusCode int) {
+	s.Lock()
+	defer s.Unlock()
+	s.failures[method+" "+pathPrefix] = statusCode
+}
+
+// ReadJSON decodes the JSON body of the request, a bad request is written if the body is not valid
+func (s *Server) ReadJSON(w http.ResponseWriter, req *http.Request, out interface{}) bool {
+	if err := json.NewDecoder(req.Body).Decode(out); err != nil {
+		s.writeError(w, http.StatusBadRequest, err.Error())
+		return false
+	}
+	return true
+}
+
+func (s *Server) serveHTTP(w http.ResponseWriter, req *http.Request) {
+	s.Lock()
+	defer s.Unlock()
+
+	for key, code := range s.failures {
+		segs := strings.SplitN(key, " ", 2)
+		if req.Method == segs[0] && strings.HasPrefix(req.URL.Path, segs[1]) {
+			s.writeError(w, code, "injected failure")
+			return
+		}
+	}
+	s.handler(w, req)
+}
+
+// PathSegments returns the unescaped segments of the path below the prefix
+func PathSegments(path string, prefix string) []string {
+	var segs []string
+	for _, seg := range strings.Split(strings.TrimPrefix(path, prefix), "/") {
+		if len(seg) > 0 {
+			unescaped, _ := url.PathUnescape(seg)
+			segs = append(segs, unescaped)
+		}
+	}
+	return segs
+}
+
+// WriteJSON writes the body as a JSON response with the status code
+func WriteJSON(w http.ResponseWriter, code int, body interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	_ = json.NewEncoder(w).Encode(body)
+}
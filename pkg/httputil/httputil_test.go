@@ -3,11 +3,18 @@
 package httputil_test
 
 import (
+	"encoding/pem"
 	"net/http"
+	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/verrazzano/verrazzano/pkg/httputil"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 )
 
 func TestExtractTokenFromResponseBody(t *testing.T) {
@@ -55,3 +62,32 @@ func TestValidateResponseCode(t *testing.T) {
 	asserts.NoError(err)
 
 }
+
+// TestNewCertSecretClient tests the NewCertSecretClient function
+// GIVEN the secret of a certificate with a CA, and a secret that does not exist
+// WHEN NewCertSecretClient is called
+// THEN the client trusts the CA of the certificate, or the system pool if the secret does not exist
+func TestNewCertSecretClient(t *testing.T) {
+	asserts := assert.New(t)
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer server.Close()
+	caCert := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+	c := fake.NewClientBuilder().WithObjects(&corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "verrazzano-system", Name: "system-tls"},
+		Data:       map[string][]byte{"ca.crt": caCert},
+	}).Build()
+
+	hc, err := httputil.NewCertSecretClient(c, types.NamespacedName{Namespace: "verrazzano-system", Name: "system-tls"}, time.Minute)
+	asserts.NoError(err)
+	asserts.Equal(time.Minute, hc.Timeout)
+	// The test server has a certificate for example.com
+	hc.Transport.(*http.Transport).TLSClientConfig.ServerName = "example.com"
+	response, err := hc.Get(server.URL)
+	asserts.NoError(err)
+	asserts.Equal(http.StatusOK, response.StatusCode)
+	response.Body.Close()
+
+	hc, err = httputil.NewCertSecretClient(c, types.NamespacedName{Namespace: "verrazzano-system", Name: "missing"}, time.Minute)
+	asserts.NoError(err)
+	asserts.Nil(hc.Transport.(*http.Transport).TLSClientConfig.RootCAs)
+}
@@ -0,0 +1,261 @@
+// Copyright (c) 2021, 2022, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package istio
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/verrazzano/verrazzano/pkg/log/vzlog"
+	"github.com/verrazzano/verrazzano/pkg/metricsutils"
+	vzyaml "github.com/verrazzano/verrazzano/pkg/yaml"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	clipkg "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	// IstioOperatorName is the name of the IstioOperator resource that describes the Verrazzano Istio installation
+	IstioOperatorName = "verrazzano-istio"
+
+	// IstioOperatorNamespace is the namespace of the IstioOperator resource
+	IstioOperatorNamespace = "istio-system"
+)
+
+// IstioOperatorGVK is the GroupVersionKind of the IstioOperator resource reconciled by the in-cluster Istio operator
+var IstioOperatorGVK = schema.GroupVersionKind{Group: "install.istio.io", Version: "v1alpha1", Kind: "IstioOperator"}
+
+// InstallStatus is the state that the Istio operator reports for the installation and each of its components
+type InstallStatus string
+
+const (
+	StatusNone           InstallStatus = "NONE"
+	StatusUpdating       InstallStatus = "UPDATING"
+	StatusReconciling    InstallStatus = "RECONCILING"
+	StatusHealthy        InstallStatus = "HEALTHY"
+	StatusError          InstallStatus = "ERROR"
+	StatusActionRequired InstallStatus = "ACTION_REQUIRED"
+)
+
+// ComponentStatus is the status of a single Istio component, like Pilot or IngressGateways
+type ComponentStatus struct {
+	Status InstallStatus `json:"status,omitempty"`
+	Error  string        `json:"error,omitempty"`
+}
+
+// OperatorStatus is the status of the IstioOperator resource
+type OperatorStatus struct {
+	Status          InstallStatus              `json:"status,omitempty"`
+	Message         string                     `json:"message,omitempty"`
+	ComponentStatus map[string]ComponentStatus `json:"componentStatus,omitempty"`
+}
+
+// Install does an Istio installation by applying an IstioOperator built from one or more IstioOperator YAML overlays
+func Install(log vzlog.VerrazzanoLogger, client clipkg.Client, overrideStrings string, overlays ...string) error {
+	return applyIstioOperator(log, client, "install", overrideStrings, overlays...)
+}
+
+// Upgrade function gets called from istio_component to perform istio upgrade
+func Upgrade(log vzlog.VerrazzanoLogger, client clipkg.Client, overrideStrings string, overlays ...string) error {
+	return applyIstioOperator(log, client, "upgrade", overrideStrings, overlays...)
+}
+
+// Uninstall deletes the IstioOperator, the Istio operator then removes the Istio control plane
+func Uninstall(log vzlog.VerrazzanoLogger, client clipkg.Client) error {
+	start := time.Now()
+	err := client.Delete(context.TODO(), newIstioOperator())
+	if errors.IsNotFound(err) {
+		err = nil
+	}
+	metricsutils.ObserveCommandDuration("istio", "uninstall", start, err)
+	if err != nil {
+		return log.ErrorfNewErr("Failed deleting IstioOperator %s/%s: %v", IstioOperatorNamespace, IstioOperatorName, err)
+	}
+	return nil
+}
+
+// IsInstalled returns true if the Istio operator reports the installation as healthy
+func IsInstalled(log vzlog.VerrazzanoLogger, client clipkg.Client) (bool, error) {
+	status, err := GetStatus(client)
+	if errors.IsNotFound(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	if status.Status == StatusError {
+		return false, fmt.Errorf("IstioOperator %s/%s failed: %s", IstioOperatorNamespace, IstioOperatorName, status.errorMessage())
+	}
+	if status.Status != StatusHealthy {
+		log.Debugf("IstioOperator %s/%s status is %q", IstioOperatorNamespace, IstioOperatorName, status.Status)
+		return false, nil
+	}
+	return true, nil
+}
+
+// GetStatus returns the status of the IstioOperator resource
+func GetStatus(client clipkg.Client) (*OperatorStatus, error) {
+	iop := newIstioOperator()
+	if err := client.Get(context.TODO(), types.NamespacedName{Namespace: IstioOperatorNamespace, Name: IstioOperatorName}, iop); err != nil {
+		return nil, err
+	}
+	status := OperatorStatus{}
+	statusMap, found, err := unstructured.NestedMap(iop.Object, "status")
+	if err != nil || !found {
+		return &status, err
+	}
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(statusMap, &status); err != nil {
+		return nil, err
+	}
+	return &status, nil
+}
+
+// BuildIstioOperator merges the IstioOperator YAML overlays, the latter overlays have precedence, then applies
+// the comma separated path=value override strings to the spec of the result
+func BuildIstioOperator(overrideStrings string, overlays ...string) (*unstructured.Unstructured, error) {
+	merged, err := vzyaml.ReplacementMerge(overlays...)
+	if err != nil {
+		return nil, err
+	}
+	iopMap := map[string]interface{}{}
+	if err := yaml.Unmarshal([]byte(merged), &iopMap); err != nil {
+		return nil, err
+	}
+	spec, ok := iopMap["spec"].(map[string]interface{})
+	if !ok {
+		spec = map[string]interface{}{}
+	}
+	if len(overrideStrings) > 0 {
+		for _, override := range strings.Split(overrideStrings, ",") {
+			segs := strings.SplitN(override, "=", 2)
+			if len(segs) != 2 {
+				return nil, fmt.Errorf("Invalid Istio override %q, expected path=value", override)
+			}
+			if err := setPath(spec, strings.Split(segs[0], "."), parseValue(segs[1])); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	iop := newIstioOperator()
+	iop.Object["spec"] = spec
+	return iop, nil
+}
+
+// applyIstioOperator creates or updates the IstioOperator, the Istio operator reconciles the installation from it
+func applyIstioOperator(log vzlog.VerrazzanoLogger, client clipkg.Client, operationName string, overrideStrings string, overlays ...string) error {
+	desired, err := BuildIstioOperator(overrideStrings, overlays...)
+	if err != nil {
+		return log.ErrorfNewErr("Failed building IstioOperator for %s: %v", operationName, err)
+	}
+	log.Progressf("Applying IstioOperator %s/%s for %s", IstioOperatorNamespace, IstioOperatorName, operationName)
+	start := time.Now()
+	iop := newIstioOperator()
+	_, err = controllerutil.CreateOrUpdate(context.TODO(), client, iop, func() error {
+		iop.Object["spec"] = desired.Object["spec"]
+		return nil
+	})
+	metricsutils.ObserveCommandDuration("istio", operationName, start, err)
+	if err != nil {
+		return log.ErrorfNewErr("Failed applying IstioOperator %s/%s: %v", IstioOperatorNamespace, IstioOperatorName, err)
+	}
+	return nil
+}
+
+// newIstioOperator returns an empty Verrazzano IstioOperator
+func newIstioOperator() *unstructured.Unstructured {
+	iop := &unstructured.Unstructured{}
+	iop.SetGroupVersionKind(IstioOperatorGVK)
+	iop.SetNamespace(IstioOperatorNamespace)
+	iop.SetName(IstioOperatorName)
+	return iop
+}
+
+// setPath sets the value at the path, a path segment like name[0] addresses an element of a list
+func setPath(node map[string]interface{}, path []string, value interface{}) error {
+	name, index, err := parsePathSegment(path[0])
+	if err != nil {
+		return err
+	}
+	last := len(path) == 1
+	if index < 0 {
+		if last {
+			node[name] = value
+			return nil
+		}
+		child, ok := node[name].(map[string]interface{})
+		if !ok {
+			child = map[string]interface{}{}
+			node[name] = child
+		}
+		return setPath(child, path[1:], value)
+	}
+
+	list, _ := node[name].([]interface{})
+	for len(list) <= index {
+		list = append(list, nil)
+	}
+	node[name] = list
+	if last {
+		list[index] = value
+		return nil
+	}
+	child, ok := list[index].(map[string]interface{})
+	if !ok {
+		child = map[string]interface{}{}
+		list[index] = child
+	}
+	return setPath(child, path[1:], value)
+}
+
+// parsePathSegment returns the name and the list index of a path segment, the index is -1 if there is none
+func parsePathSegment(seg string) (string, int, error) {
+	open := strings.Index(seg, "[")
+	if open < 0 || !strings.HasSuffix(seg, "]") {
+		return seg, -1, nil
+	}
+	index, err := strconv.Atoi(seg[open+1 : len(seg)-1])
+	if err != nil || index < 0 {
+		return "", -1, fmt.Errorf("Invalid list index in Istio override path segment %q", seg)
+	}
+	return seg[:open], index, nil
+}
+
+// parseValue converts booleans and integers the same way istioctl --set does, everything else is a string
+func parseValue(val string) interface{} {
+	switch val {
+	case "true":
+		return true
+	case "false":
+		return false
+	}
+	if i, err := strconv.ParseInt(val, 10, 64); err == nil {
+		return i
+	}
+	return val
+}
+
+// errorMessage returns the message and the errors of the components that failed
+func (s OperatorStatus) errorMessage() string {
+	var compErrs []string
+	for name, comp := range s.ComponentStatus {
+		if comp.Status == StatusError {
+			compErrs = append(compErrs, fmt.Sprintf("%s: %s", name, comp.Error))
+		}
+	}
+	sort.Strings(compErrs)
+	if len(s.Message) > 0 {
+		compErrs = append([]string{s.Message}, compErrs...)
+	}
+	return strings.Join(compErrs, ", ")
+}
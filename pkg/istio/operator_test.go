@@ -0,0 +1,225 @@
+// Copyright (c) 2021, 2022, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package istio
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/verrazzano/verrazzano/pkg/log/vzlog"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clipkg "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+const baseOverlay = `
+apiVersion: install.istio.io/v1alpha1
+kind: IstioOperator
+spec:
+  profile: default
+  components:
+    egressGateways:
+      - name: istio-egressgateway
+        enabled: true
+  values:
+    global:
+      hub: ghcr.io/verrazzano
+      tag: 1.13.2
+`
+
+const userOverlay = `
+apiVersion: install.istio.io/v1alpha1
+kind: IstioOperator
+spec:
+  components:
+    egressGateways:
+      - name: istio-egressgateway
+        enabled: true
+        k8s:
+          replicaCount: 2
+  values:
+    global:
+      tag: 1.13.3
+`
+
+// TestBuildIstioOperator tests building the IstioOperator
+// GIVEN IstioOperator overlays and override strings
+//  WHEN I call BuildIstioOperator
+//  THEN the overlays are merged in order and the override strings are set in the spec
+func TestBuildIstioOperator(t *testing.T) {
+	assert := assert.New(t)
+
+	iop, err := BuildIstioOperator("values.pilot.image=ghcr.io/verrazzano/pilot:1.13.2,values.global.imagePullSecrets[0]=verrazzano-container-registry,values.pilot.autoscaleEnabled=false,values.pilot.replicaCount=3", baseOverlay, userOverlay)
+	assert.NoError(err)
+	assert.Equal(IstioOperatorGVK, iop.GroupVersionKind())
+	assert.Equal(IstioOperatorNamespace, iop.GetNamespace())
+	assert.Equal(IstioOperatorName, iop.GetName())
+
+	profile, _, _ := unstructured.NestedString(iop.Object, "spec", "profile")
+	assert.Equal("default", profile)
+	hub, _, _ := unstructured.NestedString(iop.Object, "spec", "values", "global", "hub")
+	assert.Equal("ghcr.io/verrazzano", hub)
+	tag, _, _ := unstructured.NestedString(iop.Object, "spec", "values", "global", "tag")
+	assert.Equal("1.13.3", tag)
+	gateways, _, _ := unstructured.NestedSlice(iop.Object, "spec", "components", "egressGateways")
+	assert.Len(gateways, 1)
+	replicas, _, _ := unstructured.NestedFieldNoCopy(gateways[0].(map[string]interface{}), "k8s", "replicaCount")
+	assert.EqualValues(2, replicas)
+
+	image, _, _ := unstructured.NestedString(iop.Object, "spec", "values", "pilot", "image")
+	assert.Equal("ghcr.io/verrazzano/pilot:1.13.2", image)
+	secrets, _, _ := unstructured.NestedSlice(iop.Object, "spec", "values", "global", "imagePullSecrets")
+	assert.Equal([]interface{}{"verrazzano-container-registry"}, secrets)
+	autoscale, _, _ := unstructured.NestedBool(iop.Object, "spec", "values", "pilot", "autoscaleEnabled")
+	assert.False(autoscale)
+	replicaCount, _, _ := unstructured.NestedInt64(iop.Object, "spec", "values", "pilot", "replicaCount")
+	assert.Equal(int64(3), replicaCount)
+}
+
+// TestBuildIstioOperatorInvalidOverride tests building the IstioOperator with an invalid override
+// GIVEN an override string without a value or with an invalid list index
+//  WHEN I call BuildIstioOperator
+//  THEN an error is returned
+func TestBuildIstioOperatorInvalidOverride(t *testing.T) {
+	assert := assert.New(t)
+
+	_, err := BuildIstioOperator("values.pilot.image", baseOverlay)
+	assert.Error(err)
+	_, err = BuildIstioOperator("values.global.imagePullSecrets[x]=secret", baseOverlay)
+	assert.Error(err)
+}
+
+// TestInstall tests installing Istio
+// GIVEN IstioOperator overlays
+//  WHEN I call Install
+//  THEN the IstioOperator is created
+func TestInstall(t *testing.T) {
+	assert := assert.New(t)
+	client := fake.NewClientBuilder().WithScheme(runtime.NewScheme()).Build()
+
+	err := Install(vzlog.DefaultLogger(), client, "values.pilot.image=pilot", baseOverlay)
+	assert.NoError(err)
+
+	iop := getIstioOperator(t, client)
+	image, _, _ := unstructured.NestedString(iop.Object, "spec", "values", "pilot", "image")
+	assert.Equal("pilot", image)
+}
+
+// TestUpgrade tests upgrading Istio
+// GIVEN an existing IstioOperator
+//  WHEN I call Upgrade
+//  THEN the IstioOperator spec is replaced
+func TestUpgrade(t *testing.T) {
+	assert := assert.New(t)
+	existing := newIstioOperator()
+	existing.Object["spec"] = map[string]interface{}{"revision": "old"}
+	client := fake.NewClientBuilder().WithScheme(runtime.NewScheme()).WithObjects(existing).Build()
+
+	err := Upgrade(vzlog.DefaultLogger(), client, "", baseOverlay, userOverlay)
+	assert.NoError(err)
+
+	iop := getIstioOperator(t, client)
+	_, found, _ := unstructured.NestedString(iop.Object, "spec", "revision")
+	assert.False(found)
+	tag, _, _ := unstructured.NestedString(iop.Object, "spec", "values", "global", "tag")
+	assert.Equal("1.13.3", tag)
+}
+
+// TestUninstall tests uninstalling Istio
+// GIVEN an existing IstioOperator
+//  WHEN I call Uninstall
+//  THEN the IstioOperator is deleted, and a second call does not fail
+func TestUninstall(t *testing.T) {
+	assert := assert.New(t)
+	client := fake.NewClientBuilder().WithScheme(runtime.NewScheme()).WithObjects(newIstioOperator()).Build()
+
+	assert.NoError(Uninstall(vzlog.DefaultLogger(), client))
+	err := client.Get(context.TODO(), types.NamespacedName{Namespace: IstioOperatorNamespace, Name: IstioOperatorName}, newIstioOperator())
+	assert.True(errors.IsNotFound(err))
+	assert.NoError(Uninstall(vzlog.DefaultLogger(), client))
+}
+
+// TestIsInstalled tests checking the Istio installation
+// GIVEN an IstioOperator with a HEALTHY status
+//  WHEN I call IsInstalled
+//  THEN true is returned
+func TestIsInstalled(t *testing.T) {
+	assert := assert.New(t)
+	client := fake.NewClientBuilder().WithScheme(runtime.NewScheme()).WithObjects(newIstioOperatorWithStatus(StatusHealthy, nil)).Build()
+
+	installed, err := IsInstalled(vzlog.DefaultLogger(), client)
+	assert.NoError(err)
+	assert.True(installed)
+}
+
+// TestIsInstalledReconciling tests checking the Istio installation while it is in progress
+// GIVEN an IstioOperator with a RECONCILING status or without a status
+//  WHEN I call IsInstalled
+//  THEN false is returned without an error
+func TestIsInstalledReconciling(t *testing.T) {
+	assert := assert.New(t)
+	client := fake.NewClientBuilder().WithScheme(runtime.NewScheme()).WithObjects(newIstioOperatorWithStatus(StatusReconciling, nil)).Build()
+
+	installed, err := IsInstalled(vzlog.DefaultLogger(), client)
+	assert.NoError(err)
+	assert.False(installed)
+
+	client = fake.NewClientBuilder().WithScheme(runtime.NewScheme()).WithObjects(newIstioOperator()).Build()
+	installed, err = IsInstalled(vzlog.DefaultLogger(), client)
+	assert.NoError(err)
+	assert.False(installed)
+}
+
+// TestIsInstalledNotFound tests checking the Istio installation
+// GIVEN no IstioOperator
+//  WHEN I call IsInstalled
+//  THEN false is returned without an error
+func TestIsInstalledNotFound(t *testing.T) {
+	assert := assert.New(t)
+	client := fake.NewClientBuilder().WithScheme(runtime.NewScheme()).Build()
+
+	installed, err := IsInstalled(vzlog.DefaultLogger(), client)
+	assert.NoError(err)
+	assert.False(installed)
+}
+
+// TestIsInstalledError tests checking a failed Istio installation
+// GIVEN an IstioOperator with an ERROR status
+//  WHEN I call IsInstalled
+//  THEN false and an error with the failed component are returned
+func TestIsInstalledError(t *testing.T) {
+	assert := assert.New(t)
+	client := fake.NewClientBuilder().WithScheme(runtime.NewScheme()).WithObjects(newIstioOperatorWithStatus(StatusError, map[string]interface{}{
+		"Pilot":           map[string]interface{}{"status": string(StatusHealthy)},
+		"IngressGateways": map[string]interface{}{"status": string(StatusError), "error": "service not ready"},
+	})).Build()
+
+	installed, err := IsInstalled(vzlog.DefaultLogger(), client)
+	assert.False(installed)
+	assert.Error(err)
+	assert.Contains(err.Error(), "IngressGateways: service not ready")
+	assert.NotContains(err.Error(), "Pilot")
+}
+
+// newIstioOperatorWithStatus returns an IstioOperator with the status and the component statuses
+func newIstioOperatorWithStatus(status InstallStatus, componentStatus map[string]interface{}) *unstructured.Unstructured {
+	iop := newIstioOperator()
+	iop.Object["status"] = map[string]interface{}{
+		"status":          string(status),
+		"componentStatus": componentStatus,
+	}
+	return iop
+}
+
+// getIstioOperator gets the Verrazzano IstioOperator from the client
+func getIstioOperator(t *testing.T, client clipkg.Client) *unstructured.Unstructured {
+	iop := newIstioOperator()
+	err := client.Get(context.TODO(), types.NamespacedName{Namespace: IstioOperatorNamespace, Name: IstioOperatorName}, iop)
+	assert.NoError(t, err)
+	return iop
+}
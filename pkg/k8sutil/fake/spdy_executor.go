@@ -5,6 +5,7 @@ package fake
 
 import (
 	"bytes"
+	"io"
 	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/remotecommand"
 	"net/url"
@@ -13,6 +14,9 @@ import (
 // PodSTDOUT can be used to output arbitrary strings during unit testing
 var PodSTDOUT = ""
 
+// PodSTDIN receives what is streamed to the stdin of a command during unit testing
+var PodSTDIN = &bytes.Buffer{}
+
 //NewPodExecutor should be used instead of remotecommand.NewSPDYExecutor in unit tests
 func NewPodExecutor(config *rest.Config, method string, url *url.URL) (remotecommand.Executor, error) {
 	return &dummyExecutor{method: method, url: url}, nil
@@ -24,8 +28,13 @@ type dummyExecutor struct {
 	url    *url.URL
 }
 
-//Stream on a dummyExecutor sets stdout to PodSTDOUT
+//Stream on a dummyExecutor copies stdin to PodSTDIN and sets stdout to PodSTDOUT
 func (f *dummyExecutor) Stream(options remotecommand.StreamOptions) error {
+	if options.Stdin != nil {
+		if _, err := io.Copy(PodSTDIN, options.Stdin); err != nil {
+			return err
+		}
+	}
 	if options.Stdout != nil {
 		buf := new(bytes.Buffer)
 		buf.WriteString(PodSTDOUT)
@@ -8,6 +8,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
 	"os"
 	"path/filepath"
 
@@ -282,6 +283,41 @@ func ExecPod(client kubernetes.Interface, cfg *rest.Config, pod *v1.Pod, contain
 	return stdout.String(), stderr.String(), nil
 }
 
+// ExecPodStream runs a remote command in a pod without a terminal, so that binary data can be streamed to the stdin
+// of the command and from its stdout.  A nil stdin or stdout is not attached.
+func ExecPodStream(client kubernetes.Interface, cfg *rest.Config, pod *v1.Pod, container string, command []string, stdin io.Reader, stdout io.Writer) error {
+	stderr := &bytes.Buffer{}
+	request := client.
+		CoreV1().
+		RESTClient().
+		Post().
+		Namespace(pod.Namespace).
+		Resource("pods").
+		Name(pod.Name).
+		SubResource("exec").
+		VersionedParams(&v1.PodExecOptions{
+			Container: container,
+			Command:   command,
+			Stdin:     stdin != nil,
+			Stdout:    stdout != nil,
+			Stderr:    true,
+			TTY:       false,
+		}, scheme.ParameterCodec)
+	executor, err := NewPodExecutor(cfg, "POST", request.URL())
+	if err != nil {
+		return err
+	}
+	err = executor.Stream(remotecommand.StreamOptions{
+		Stdin:  stdin,
+		Stdout: stdout,
+		Stderr: stderr,
+	})
+	if err != nil {
+		return fmt.Errorf("error running command %s on %v/%v: %v: %s", command, pod.Namespace, pod.Name, err, stderr.String())
+	}
+	return nil
+}
+
 // GetGoClient returns a go-client
 func GetGoClient(log ...vzlog.VerrazzanoLogger) (kubernetes.Interface, error) {
 	var logger vzlog.VerrazzanoLogger
@@ -8,6 +8,7 @@ import (
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"os"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -304,3 +305,25 @@ func TestExecPod(t *testing.T) {
 	assert.Nil(t, err)
 	assert.Equal(t, spdyfake.PodSTDOUT, stdout)
 }
+
+// TestExecPodStream tests streaming data to and from a command on a remote pod
+// GIVEN a pod in a cluster, a command to run on that pod and data to stream to its stdin
+//  WHEN ExecPodStream is called
+//  THEN the data is streamed to the stdin of the command and its stdout is written to the writer
+func TestExecPodStream(t *testing.T) {
+	k8sutil.NewPodExecutor = spdyfake.NewPodExecutor
+	spdyfake.PodSTDOUT = "foobar"
+	spdyfake.PodSTDIN.Reset()
+	cfg, client := spdyfake.NewClientsetConfig()
+	pod := &v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "ns",
+			Name:      "name",
+		},
+	}
+	stdout := &strings.Builder{}
+	err := k8sutil.ExecPodStream(client, cfg, pod, "container", []string{"cat"}, strings.NewReader("input"), stdout)
+	assert.Nil(t, err)
+	assert.Equal(t, "input", spdyfake.PodSTDIN.String())
+	assert.Equal(t, spdyfake.PodSTDOUT, stdout.String())
+}
@@ -0,0 +1,137 @@
+// Copyright (c) 2022, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package keycloak
+
+import (
+	"net/url"
+	"path"
+)
+
+// GetRealm returns the realm, the error satisfies IsNotFound if the realm does not exist
+func (c *AdminClient) GetRealm(realm string) (*Realm, error) {
+	out := Realm{}
+	if err := c.get(url.PathEscape(realm), nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// CreateRealm creates the realm
+func (c *AdminClient) CreateRealm(realm Realm) error {
+	_, err := c.create("", realm)
+	return err
+}
+
+// UpdateRealm updates the non-empty fields of the realm
+func (c *AdminClient) UpdateRealm(realm Realm) error {
+	return c.put(url.PathEscape(realm.Realm), realm)
+}
+
+// GetGroups returns the top-level groups of the realm and their subgroups
+func (c *AdminClient) GetGroups(realm string) ([]Group, error) {
+	var out []Group
+	if err := c.get(path.Join(url.PathEscape(realm), "groups"), nil, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// CreateGroup creates a top-level group and returns its ID
+func (c *AdminClient) CreateGroup(realm string, group Group) (string, error) {
+	return c.create(path.Join(url.PathEscape(realm), "groups"), group)
+}
+
+// CreateChildGroup creates a subgroup of the parent group and returns its ID
+func (c *AdminClient) CreateChildGroup(realm string, parentID string, group Group) (string, error) {
+	return c.create(path.Join(url.PathEscape(realm), "groups", parentID, "children"), group)
+}
+
+// GetRealmRoles returns the realm roles
+func (c *AdminClient) GetRealmRoles(realm string) ([]Role, error) {
+	var out []Role
+	if err := c.get(path.Join(url.PathEscape(realm), "roles"), nil, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// GetRealmRole returns the realm role, the error satisfies IsNotFound if the role does not exist
+func (c *AdminClient) GetRealmRole(realm string, name string) (*Role, error) {
+	out := Role{}
+	if err := c.get(path.Join(url.PathEscape(realm), "roles", url.PathEscape(name)), nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// CreateRealmRole creates the realm role
+func (c *AdminClient) CreateRealmRole(realm string, role Role) error {
+	_, err := c.create(path.Join(url.PathEscape(realm), "roles"), role)
+	return err
+}
+
+// GetGroupRealmRoles returns the realm roles mapped to the group
+func (c *AdminClient) GetGroupRealmRoles(realm string, groupID string) ([]Role, error) {
+	var out []Role
+	if err := c.get(path.Join(url.PathEscape(realm), "groups", groupID, "role-mappings/realm"), nil, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AddGroupRealmRoles maps the realm roles to the group, mapping a role that is already mapped is not an error
+func (c *AdminClient) AddGroupRealmRoles(realm string, groupID string, roles []Role) error {
+	return c.post(path.Join(url.PathEscape(realm), "groups", groupID, "role-mappings/realm"), roles)
+}
+
+// GetUsers returns the users of the realm with the exact username
+func (c *AdminClient) GetUsers(realm string, username string) ([]User, error) {
+	var users []User
+	query := url.Values{"username": []string{username}, "exact": []string{"true"}}
+	if err := c.get(path.Join(url.PathEscape(realm), "users"), query, &users); err != nil {
+		return nil, err
+	}
+	// Older Keycloak versions ignore the exact parameter and return every user whose name contains the username
+	var out []User
+	for _, user := range users {
+		if user.Username == username {
+			out = append(out, user)
+		}
+	}
+	return out, nil
+}
+
+// CreateUser creates the user and returns its ID
+func (c *AdminClient) CreateUser(realm string, user User) (string, error) {
+	return c.create(path.Join(url.PathEscape(realm), "users"), user)
+}
+
+// ResetPassword sets a permanent password for the user
+func (c *AdminClient) ResetPassword(realm string, userID string, password string) error {
+	cred := Credential{Type: "password", Value: password}
+	return c.put(path.Join(url.PathEscape(realm), "users", userID, "reset-password"), cred)
+}
+
+// GetClients returns the clients of the realm with the client ID, or all of the clients if the client ID is empty
+func (c *AdminClient) GetClients(realm string, clientID string) ([]Client, error) {
+	var out []Client
+	var query url.Values
+	if len(clientID) > 0 {
+		query = url.Values{"clientId": []string{clientID}}
+	}
+	if err := c.get(path.Join(url.PathEscape(realm), "clients"), query, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// CreateClient creates the client and returns its ID
+func (c *AdminClient) CreateClient(realm string, client Client) (string, error) {
+	return c.create(path.Join(url.PathEscape(realm), "clients"), client)
+}
+
+// UpdateClient updates the client with the ID
+func (c *AdminClient) UpdateClient(realm string, client Client) error {
+	return c.put(path.Join(url.PathEscape(realm), "clients", client.ID), client)
+}
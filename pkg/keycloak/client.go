@@ -0,0 +1,150 @@
+// Copyright (c) 2022, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package keycloak
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+
+	"github.com/verrazzano/verrazzano/pkg/httputil"
+)
+
+const (
+	// MasterRealm is the Keycloak realm of the admin users
+	MasterRealm = "master"
+
+	// AdminCLIClientID is the public client used to get an admin access token with the password grant
+	AdminCLIClientID = "admin-cli"
+
+	contentTypeHeader = "Content-Type"
+	locationHeader    = "Location"
+	formURLEncoded    = "application/x-www-form-urlencoded"
+)
+
+// AdminClient is a client for the Keycloak admin REST API
+type AdminClient struct {
+	// api is the client of the Keycloak server including the context path, like https://keycloak.example.com/auth
+	api *httputil.APIClient
+}
+
+// APIError is returned when the Keycloak admin REST API responds with an unexpected status code
+type APIError = httputil.APIError
+
+// tokenResponse is the response of the OpenID Connect token endpoint
+type tokenResponse struct {
+	AccessToken string `json:"access_token"`
+}
+
+// errorResponse is the body Keycloak returns with most error status codes
+type errorResponse struct {
+	Error            string `json:"error,omitempty"`
+	ErrorDescription string `json:"error_description,omitempty"`
+	ErrorMessage     string `json:"errorMessage,omitempty"`
+}
+
+// NewAdminClient returns a client for the Keycloak server at the base URL, the client must call Login before
+// calling the admin REST API
+func NewAdminClient(baseURL string, httpClient *http.Client) *AdminClient {
+	return &AdminClient{
+		api: httputil.NewAPIClient("Keycloak", baseURL, httpClient, parseError),
+	}
+}
+
+// IsNotFound returns true if the error is an APIError with the status code 404
+func IsNotFound(err error) bool {
+	return httputil.IsNotFound(err)
+}
+
+// IsConflict returns true if the error is an APIError with the status code 409, Keycloak returns it when
+// an object with the same name already exists
+func IsConflict(err error) bool {
+	return httputil.HasStatusCode(err, http.StatusConflict)
+}
+
+// IsUnauthorized returns true if the error is an APIError with the status code 401
+func IsUnauthorized(err error) bool {
+	return httputil.HasStatusCode(err, http.StatusUnauthorized)
+}
+
+// Login gets an access token for the admin user of the master realm, the token is used for the subsequent calls
+func (c *AdminClient) Login(username string, password string) error {
+	form := url.Values{}
+	form.Set("grant_type", "password")
+	form.Set("client_id", AdminCLIClientID)
+	form.Set("username", username)
+	form.Set("password", password)
+
+	reqPath := path.Join("/realms", MasterRealm, "protocol/openid-connect/token")
+	req, err := http.NewRequest(http.MethodPost, c.api.BaseURL()+reqPath, strings.NewReader(form.Encode()))
+	if err != nil {
+		return err
+	}
+	req.Header.Set(contentTypeHeader, formURLEncoded)
+
+	token := tokenResponse{}
+	if _, err := c.api.Do(req, reqPath, &token, http.StatusOK); err != nil {
+		return err
+	}
+	if len(token.AccessToken) == 0 {
+		return fmt.Errorf("Keycloak returned an empty access token for user %s", username)
+	}
+	c.api.SetBearerToken(token.AccessToken)
+	return nil
+}
+
+// get sends a GET request to the admin REST API and decodes the JSON response into out
+func (c *AdminClient) get(reqPath string, query url.Values, out interface{}) error {
+	_, err := c.send(http.MethodGet, reqPath, query, nil, out, http.StatusOK)
+	return err
+}
+
+// create sends a POST request to the admin REST API and returns the ID of the created object, Keycloak
+// returns it as the last segment of the Location header
+func (c *AdminClient) create(reqPath string, in interface{}) (string, error) {
+	resp, err := c.send(http.MethodPost, reqPath, nil, in, nil, http.StatusCreated)
+	if err != nil {
+		return "", err
+	}
+	return path.Base(resp.Header.Get(locationHeader)), nil
+}
+
+// post sends a POST request to the admin REST API for an action that does not create an object
+func (c *AdminClient) post(reqPath string, in interface{}) error {
+	_, err := c.send(http.MethodPost, reqPath, nil, in, nil, http.StatusNoContent, http.StatusCreated, http.StatusOK)
+	return err
+}
+
+// put sends a PUT request to the admin REST API
+func (c *AdminClient) put(reqPath string, in interface{}) error {
+	_, err := c.send(http.MethodPut, reqPath, nil, in, nil, http.StatusNoContent, http.StatusOK)
+	return err
+}
+
+// send sends an authorized request with an optional JSON body to the admin REST API
+func (c *AdminClient) send(method string, reqPath string, query url.Values, in interface{}, out interface{}, validCodes ...int) (*http.Response, error) {
+	if !c.api.HasCredentials() {
+		return nil, errors.New("Keycloak client is not logged in")
+	}
+	return c.api.Send(method, path.Join("/admin/realms", reqPath), query, in, out, validCodes...)
+}
+
+// parseError returns the error code and the message of a Keycloak error response
+func parseError(data []byte) (string, string) {
+	errResp := errorResponse{}
+	if err := json.Unmarshal(data, &errResp); err != nil {
+		return "", ""
+	}
+	switch {
+	case len(errResp.ErrorMessage) > 0:
+		return errResp.Error, errResp.ErrorMessage
+	case len(errResp.ErrorDescription) > 0:
+		return errResp.Error, errResp.ErrorDescription
+	}
+	return errResp.Error, errResp.Error
+}
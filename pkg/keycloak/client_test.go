@@ -0,0 +1,193 @@
+// Copyright (c) 2022, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package keycloak_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/verrazzano/verrazzano/pkg/keycloak"
+	"github.com/verrazzano/verrazzano/pkg/keycloak/fake"
+)
+
+const (
+	testAdminUser     = "keycloakadmin"
+	testAdminPassword = "adminpw"
+	testRealm         = "test-realm"
+)
+
+// TestLogin tests logging into Keycloak
+// GIVEN a Keycloak server
+//  WHEN I call Login with the admin credentials or with a wrong password
+//  THEN the login succeeds, or fails with an unauthorized error
+func TestLogin(t *testing.T) {
+	assert := assert.New(t)
+	server := fake.NewServer(testAdminUser, testAdminPassword)
+	defer server.Close()
+
+	kc := keycloak.NewAdminClient(server.BaseURL(), server.Client())
+	err := kc.Login(testAdminUser, "wrong")
+	assert.Error(err)
+	assert.True(keycloak.IsUnauthorized(err))
+	assert.Contains(err.Error(), "Invalid user credentials")
+
+	assert.NoError(kc.Login(testAdminUser, testAdminPassword))
+	realm, err := kc.GetRealm(keycloak.MasterRealm)
+	assert.NoError(err)
+	assert.Equal(keycloak.MasterRealm, realm.Realm)
+}
+
+// TestNotLoggedIn tests calling the admin API without logging in
+// GIVEN a Keycloak client that has not logged in
+//  WHEN I call GetRealm
+//  THEN an error is returned without sending a request
+func TestNotLoggedIn(t *testing.T) {
+	server := fake.NewServer(testAdminUser, testAdminPassword)
+	defer server.Close()
+
+	_, err := keycloak.NewAdminClient(server.BaseURL(), server.Client()).GetRealm(keycloak.MasterRealm)
+	assert.Error(t, err)
+}
+
+// TestRealm tests creating, getting and updating a realm
+// GIVEN a Keycloak server
+//  WHEN I create a realm, create it again and update it
+//  THEN the realm is created, the second create fails with a conflict, and only the set fields are updated
+func TestRealm(t *testing.T) {
+	assert := assert.New(t)
+	kc, server := newLoggedInClient(t)
+	defer server.Close()
+
+	_, err := kc.GetRealm(testRealm)
+	assert.True(keycloak.IsNotFound(err))
+
+	disabled := false
+	assert.NoError(kc.CreateRealm(keycloak.Realm{Realm: testRealm, Enabled: &disabled}))
+	assert.True(keycloak.IsConflict(kc.CreateRealm(keycloak.Realm{Realm: testRealm})))
+
+	assert.NoError(kc.UpdateRealm(keycloak.Realm{Realm: testRealm, LoginTheme: "oracle"}))
+	realm, err := kc.GetRealm(testRealm)
+	assert.NoError(err)
+	assert.Equal("oracle", realm.LoginTheme)
+	assert.False(*realm.Enabled)
+}
+
+// TestGroupsAndRoles tests creating groups and mapping roles to them
+// GIVEN a Keycloak realm
+//  WHEN I create a group, a child group and a role, and map the role to the group
+//  THEN the group tree has the child group and the group has the role mapping
+func TestGroupsAndRoles(t *testing.T) {
+	assert := assert.New(t)
+	kc, server := newLoggedInClient(t)
+	defer server.Close()
+	assert.NoError(kc.CreateRealm(keycloak.Realm{Realm: testRealm}))
+
+	parentID, err := kc.CreateGroup(testRealm, keycloak.Group{Name: "parent"})
+	assert.NoError(err)
+	assert.NotEmpty(parentID)
+	childID, err := kc.CreateChildGroup(testRealm, parentID, keycloak.Group{Name: "child"})
+	assert.NoError(err)
+	_, err = kc.CreateChildGroup(testRealm, parentID, keycloak.Group{Name: "child"})
+	assert.True(keycloak.IsConflict(err))
+
+	groups, err := kc.GetGroups(testRealm)
+	assert.NoError(err)
+	child := keycloak.FindGroup(groups, "child")
+	assert.NotNil(child)
+	assert.Equal(childID, child.ID)
+	assert.Equal("/parent/child", child.Path)
+	assert.Nil(keycloak.FindGroup(groups, "missing"))
+	assert.Equal(childID, keycloak.FindGroupByPath(groups, "/parent/child").ID)
+	assert.Nil(keycloak.FindGroupByPath(groups, "/child"))
+
+	assert.NoError(kc.CreateRealmRole(testRealm, keycloak.Role{Name: "access"}))
+	role, err := kc.GetRealmRole(testRealm, "access")
+	assert.NoError(err)
+	assert.NoError(kc.AddGroupRealmRoles(testRealm, parentID, []keycloak.Role{*role}))
+	assert.NoError(kc.AddGroupRealmRoles(testRealm, parentID, []keycloak.Role{*role}))
+	mapped, err := kc.GetGroupRealmRoles(testRealm, parentID)
+	assert.NoError(err)
+	assert.Len(mapped, 1)
+	assert.Equal("access", mapped[0].Name)
+}
+
+// TestUsers tests creating a user and setting its password
+// GIVEN a Keycloak realm with a group
+//  WHEN I create users and get them by username
+//  THEN only the user with the exact username is returned and the password is set
+func TestUsers(t *testing.T) {
+	assert := assert.New(t)
+	kc, server := newLoggedInClient(t)
+	defer server.Close()
+	assert.NoError(kc.CreateRealm(keycloak.Realm{Realm: testRealm}))
+	_, err := kc.CreateGroup(testRealm, keycloak.Group{Name: "users"})
+	assert.NoError(err)
+
+	_, err = kc.CreateUser(testRealm, keycloak.User{Username: "user", Enabled: true, Groups: []string{"/missing"}})
+	assert.Error(err)
+	id, err := kc.CreateUser(testRealm, keycloak.User{Username: "user", Enabled: true, Groups: []string{"/users"}})
+	assert.NoError(err)
+	_, err = kc.CreateUser(testRealm, keycloak.User{Username: "user-2", Enabled: true})
+	assert.NoError(err)
+
+	users, err := kc.GetUsers(testRealm, "user")
+	assert.NoError(err)
+	assert.Len(users, 1)
+	assert.Equal(id, users[0].ID)
+
+	assert.NoError(kc.ResetPassword(testRealm, id, "userpw"))
+	assert.Equal("userpw", server.Password(testRealm, "user"))
+}
+
+// TestClients tests creating and updating a client
+// GIVEN a Keycloak realm
+//  WHEN I create a client, get it by client ID and update it
+//  THEN the client has the updated redirect URIs
+func TestClients(t *testing.T) {
+	assert := assert.New(t)
+	kc, server := newLoggedInClient(t)
+	defer server.Close()
+	assert.NoError(kc.CreateRealm(keycloak.Realm{Realm: testRealm}))
+
+	id, err := kc.CreateClient(testRealm, keycloak.Client{ClientID: "app", RedirectURIs: []string{"https://old/*"}})
+	assert.NoError(err)
+	clients, err := kc.GetClients(testRealm, "app")
+	assert.NoError(err)
+	assert.Len(clients, 1)
+	assert.Equal(id, clients[0].ID)
+
+	clients[0].RedirectURIs = []string{"https://new/*"}
+	assert.NoError(kc.UpdateClient(testRealm, clients[0]))
+	clients, err = kc.GetClients(testRealm, "")
+	assert.NoError(err)
+	assert.Equal([]string{"https://new/*"}, clients[0].RedirectURIs)
+}
+
+// TestAPIError tests the error returned for a failed request
+// GIVEN a Keycloak server that fails the requests for groups
+//  WHEN I call GetGroups
+//  THEN an APIError with the status code and the Keycloak error message is returned
+func TestAPIError(t *testing.T) {
+	assert := assert.New(t)
+	kc, server := newLoggedInClient(t)
+	defer server.Close()
+	server.FailRequests(http.MethodGet, "/admin/realms/master/groups", http.StatusInternalServerError)
+
+	_, err := kc.GetGroups(keycloak.MasterRealm)
+	assert.Error(err)
+	apiErr, ok := err.(*keycloak.APIError)
+	assert.True(ok)
+	assert.Equal(http.StatusInternalServerError, apiErr.StatusCode)
+	assert.Equal("injected failure", apiErr.Message)
+	assert.False(keycloak.IsNotFound(err))
+}
+
+// newLoggedInClient starts a Keycloak server and returns a client that is logged in as the admin
+func newLoggedInClient(t *testing.T) (*keycloak.AdminClient, *fake.Server) {
+	server := fake.NewServer(testAdminUser, testAdminPassword)
+	kc := keycloak.NewAdminClient(server.BaseURL(), server.Client())
+	assert.NoError(t, kc.Login(testAdminUser, testAdminPassword))
+	return kc, server
+}
@@ -0,0 +1,462 @@
+// Copyright (c) 2022, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package fake
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	httpfake "github.com/verrazzano/verrazzano/pkg/httputil/fake"
+	"github.com/verrazzano/verrazzano/pkg/keycloak"
+)
+
+const (
+	contextPath = "/auth"
+	accessToken = "fake-access-token"
+)
+
+// Server is an in-memory stand-in for the parts of the Keycloak admin REST API used by Verrazzano
+type Server struct {
+	*httpfake.Server
+
+	nextID int
+	realms map[string]*realm
+}
+
+// realm holds the objects of one realm
+type realm struct {
+	rep        keycloak.Realm
+	groups     []*keycloak.Group
+	roles      []keycloak.Role
+	groupRoles map[string][]keycloak.Role
+	users      []keycloak.User
+	passwords  map[string]string
+	clients    []keycloak.Client
+}
+
+// NewServer starts a server with a master realm that has the admin user, the caller must Close it
+func NewServer(adminUser string, adminPassword string) *Server {
+	s := &Server{realms: map[string]*realm{}}
+	master := s.newRealm(keycloak.Realm{Realm: keycloak.MasterRealm, Enabled: boolPtr(true)})
+	master.users = append(master.users, keycloak.User{ID: s.newID(), Username: adminUser, Enabled: true})
+	master.passwords[adminUser] = adminPassword
+	s.Server = httpfake.NewServer(s.serveHTTP, writeError)
+	s.Start()
+	return s
+}
+
+// BaseURL returns the URL of the server including the Keycloak context path
+func (s *Server) BaseURL() string {
+	return s.URL + contextPath
+}
+
+// FailRequests makes the requests with the method, whose path below the context path starts with the prefix,
+// fail with the status code
+func (s *Server) FailRequests(method string, pathPrefix string, statusCode int) {
+	s.Server.FailRequests(method, contextPath+pathPrefix, statusCode)
+}
+
+// Realm returns a copy of the realm, or nil if it does not exist
+func (s *Server) Realm(name string) *keycloak.Realm {
+	s.Lock()
+	defer s.Unlock()
+	r, ok := s.realms[name]
+	if !ok {
+		return nil
+	}
+	rep := r.rep
+	return &rep
+}
+
+// Password returns the password of the user of the realm
+func (s *Server) Password(realmName string, username string) string {
+	s.Lock()
+	defer s.Unlock()
+	if r, ok := s.realms[realmName]; ok {
+		return r.passwords[username]
+	}
+	return ""
+}
+
+func (s *Server) serveHTTP(w http.ResponseWriter, req *http.Request) {
+	reqPath := strings.TrimPrefix(req.URL.Path, contextPath)
+
+	if reqPath == "/realms/"+keycloak.MasterRealm+"/protocol/openid-connect/token" && req.Method == http.MethodPost {
+		s.token(w, req)
+		return
+	}
+	if !strings.HasPrefix(reqPath, "/admin/realms") {
+		writeError(w, http.StatusNotFound, "unknown path")
+		return
+	}
+	if req.Header.Get("Authorization") != "Bearer "+accessToken {
+		writeError(w, http.StatusUnauthorized, "HTTP 401 Unauthorized")
+		return
+	}
+
+	segs := httpfake.PathSegments(reqPath, "/admin/realms")
+	if len(segs) == 0 {
+		if req.Method == http.MethodPost {
+			s.createRealm(w, req)
+			return
+		}
+		writeError(w, http.StatusMethodNotAllowed, "")
+		return
+	}
+	r, ok := s.realms[segs[0]]
+	if !ok {
+		writeError(w, http.StatusNotFound, "Realm not found.")
+		return
+	}
+	if len(segs) == 1 {
+		s.serveRealm(w, req, r)
+		return
+	}
+	switch segs[1] {
+	case "groups":
+		s.serveGroups(w, req, r, segs[2:])
+	case "roles":
+		s.serveRoles(w, req, r, segs[2:])
+	case "users":
+		s.serveUsers(w, req, r, segs[2:])
+	case "clients":
+		s.serveClients(w, req, r, segs[2:])
+	default:
+		writeError(w, http.StatusNotFound, "unknown path")
+	}
+}
+
+func (s *Server) token(w http.ResponseWriter, req *http.Request) {
+	if err := req.ParseForm(); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	master := s.realms[keycloak.MasterRealm]
+	username := req.PostForm.Get("username")
+	password, ok := master.passwords[username]
+	if !ok || password != req.PostForm.Get("password") || req.PostForm.Get("client_id") != keycloak.AdminCLIClientID {
+		httpfake.WriteJSON(w, http.StatusUnauthorized, map[string]string{"error": "invalid_grant", "error_description": "Invalid user credentials"})
+		return
+	}
+	httpfake.WriteJSON(w, http.StatusOK, map[string]string{"access_token": accessToken})
+}
+
+func (s *Server) createRealm(w http.ResponseWriter, req *http.Request) {
+	rep := keycloak.Realm{}
+	if !s.ReadJSON(w, req, &rep) {
+		return
+	}
+	if _, ok := s.realms[rep.Realm]; ok {
+		writeError(w, http.StatusConflict, "Conflict detected. See logs for details")
+		return
+	}
+	s.newRealm(rep)
+	writeCreated(w, req, rep.Realm)
+}
+
+func (s *Server) serveRealm(w http.ResponseWriter, req *http.Request, r *realm) {
+	switch req.Method {
+	case http.MethodGet:
+		httpfake.WriteJSON(w, http.StatusOK, r.rep)
+	case http.MethodPut:
+		rep := keycloak.Realm{}
+		if !s.ReadJSON(w, req, &rep) {
+			return
+		}
+		if rep.Enabled != nil {
+			r.rep.Enabled = rep.Enabled
+		}
+		if len(rep.PasswordPolicy) > 0 {
+			r.rep.PasswordPolicy = rep.PasswordPolicy
+		}
+		if len(rep.LoginTheme) > 0 {
+			r.rep.LoginTheme = rep.LoginTheme
+		}
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		writeError(w, http.StatusMethodNotAllowed, "")
+	}
+}
+
+func (s *Server) serveGroups(w http.ResponseWriter, req *http.Request, r *realm, segs []string) {
+	switch {
+	case len(segs) == 0 && req.Method == http.MethodGet:
+		httpfake.WriteJSON(w, http.StatusOK, copyGroups(r.groups))
+	case len(segs) == 0 && req.Method == http.MethodPost:
+		group := keycloak.Group{}
+		if !s.ReadJSON(w, req, &group) {
+			return
+		}
+		for _, existing := range r.groups {
+			if existing.Name == group.Name {
+				writeError(w, http.StatusConflict, fmt.Sprintf("Top level group named '%s' already exists.", group.Name))
+				return
+			}
+		}
+		group = s.newGroup("", group)
+		r.groups = append(r.groups, &group)
+		writeCreated(w, req, group.ID)
+	case len(segs) == 2 && segs[1] == "children" && req.Method == http.MethodPost:
+		parent := findGroupByID(r.groups, segs[0])
+		if parent == nil {
+			writeError(w, http.StatusNotFound, "Could not find group by id")
+			return
+		}
+		group := keycloak.Group{}
+		if !s.ReadJSON(w, req, &group) {
+			return
+		}
+		for _, existing := range parent.SubGroups {
+			if existing.Name == group.Name {
+				writeError(w, http.StatusConflict, fmt.Sprintf("Sibling group named '%s' already exists.", group.Name))
+				return
+			}
+		}
+		group = s.newGroup(parent.Path, group)
+		parent.SubGroups = append(parent.SubGroups, group)
+		writeCreated(w, req, group.ID)
+	case len(segs) == 3 && segs[1] == "role-mappings" && segs[2] == "realm":
+		if findGroupByID(r.groups, segs[0]) == nil {
+			writeError(w, http.StatusNotFound, "Could not find group by id")
+			return
+		}
+		s.serveGroupRoles(w, req, r, segs[0])
+	default:
+		writeError(w, http.StatusNotFound, "unknown path")
+	}
+}
+
+// newGroup returns the group with a new ID and the path below the parent path
+func (s *Server) newGroup(parentPath string, group keycloak.Group) keycloak.Group {
+	group.ID = s.newID()
+	group.Path = parentPath + "/" + group.Name
+	group.SubGroups = nil
+	return group
+}
+
+func (s *Server) serveGroupRoles(w http.ResponseWriter, req *http.Request, r *realm, groupID string) {
+	switch req.Method {
+	case http.MethodGet:
+		httpfake.WriteJSON(w, http.StatusOK, r.groupRoles[groupID])
+	case http.MethodPost:
+		var roles []keycloak.Role
+		if !s.ReadJSON(w, req, &roles) {
+			return
+		}
+		for _, role := range roles {
+			if findRole(r.roles, role.Name) == nil {
+				writeError(w, http.StatusNotFound, "Could not find role")
+				return
+			}
+			if findRole(r.groupRoles[groupID], role.Name) == nil {
+				r.groupRoles[groupID] = append(r.groupRoles[groupID], *findRole(r.roles, role.Name))
+			}
+		}
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		writeError(w, http.StatusMethodNotAllowed, "")
+	}
+}
+
+func (s *Server) serveRoles(w http.ResponseWriter, req *http.Request, r *realm, segs []string) {
+	switch {
+	case len(segs) == 0 && req.Method == http.MethodGet:
+		httpfake.WriteJSON(w, http.StatusOK, r.roles)
+	case len(segs) == 0 && req.Method == http.MethodPost:
+		role := keycloak.Role{}
+		if !s.ReadJSON(w, req, &role) {
+			return
+		}
+		if findRole(r.roles, role.Name) != nil {
+			writeError(w, http.StatusConflict, fmt.Sprintf("Role with name %s already exists", role.Name))
+			return
+		}
+		role.ID = s.newID()
+		role.ContainerID = r.rep.ID
+		r.roles = append(r.roles, role)
+		writeCreated(w, req, role.Name)
+	case len(segs) == 1 && req.Method == http.MethodGet:
+		role := findRole(r.roles, segs[0])
+		if role == nil {
+			writeError(w, http.StatusNotFound, "Could not find role")
+			return
+		}
+		httpfake.WriteJSON(w, http.StatusOK, role)
+	default:
+		writeError(w, http.StatusNotFound, "unknown path")
+	}
+}
+
+func (s *Server) serveUsers(w http.ResponseWriter, req *http.Request, r *realm, segs []string) {
+	switch {
+	case len(segs) == 0 && req.Method == http.MethodGet:
+		username := req.URL.Query().Get("username")
+		users := []keycloak.User{}
+		for _, user := range r.users {
+			if strings.Contains(user.Username, username) {
+				user.Groups = nil
+				users = append(users, user)
+			}
+		}
+		httpfake.WriteJSON(w, http.StatusOK, users)
+	case len(segs) == 0 && req.Method == http.MethodPost:
+		user := keycloak.User{}
+		if !s.ReadJSON(w, req, &user) {
+			return
+		}
+		for _, existing := range r.users {
+			if existing.Username == user.Username {
+				writeError(w, http.StatusConflict, "User exists with same username")
+				return
+			}
+		}
+		for _, groupPath := range user.Groups {
+			if findGroupByPath(r.groups, groupPath) == nil {
+				writeError(w, http.StatusBadRequest, fmt.Sprintf("Group %s not found", groupPath))
+				return
+			}
+		}
+		user.ID = s.newID()
+		r.users = append(r.users, user)
+		writeCreated(w, req, user.ID)
+	case len(segs) == 2 && segs[1] == "reset-password" && req.Method == http.MethodPut:
+		cred := keycloak.Credential{}
+		if !s.ReadJSON(w, req, &cred) {
+			return
+		}
+		for _, user := range r.users {
+			if user.ID == segs[0] {
+				r.passwords[user.Username] = cred.Value
+				w.WriteHeader(http.StatusNoContent)
+				return
+			}
+		}
+		writeError(w, http.StatusNotFound, "User not found")
+	default:
+		writeError(w, http.StatusNotFound, "unknown path")
+	}
+}
+
+func (s *Server) serveClients(w http.ResponseWriter, req *http.Request, r *realm, segs []string) {
+	switch {
+	case len(segs) == 0 && req.Method == http.MethodGet:
+		clientID := req.URL.Query().Get("clientId")
+		clients := []keycloak.Client{}
+		for _, client := range r.clients {
+			if len(clientID) == 0 || client.ClientID == clientID {
+				clients = append(clients, client)
+			}
+		}
+		httpfake.WriteJSON(w, http.StatusOK, clients)
+	case len(segs) == 0 && req.Method == http.MethodPost:
+		client := keycloak.Client{}
+		if !s.ReadJSON(w, req, &client) {
+			return
+		}
+		for _, existing := range r.clients {
+			if existing.ClientID == client.ClientID {
+				writeError(w, http.StatusConflict, fmt.Sprintf("Client %s already exists", client.ClientID))
+				return
+			}
+		}
+		client.ID = s.newID()
+		r.clients = append(r.clients, client)
+		writeCreated(w, req, client.ID)
+	case len(segs) == 1 && req.Method == http.MethodPut:
+		client := keycloak.Client{}
+		if !s.ReadJSON(w, req, &client) {
+			return
+		}
+		for i := range r.clients {
+			if r.clients[i].ID == segs[0] {
+				client.ID = segs[0]
+				r.clients[i] = client
+				w.WriteHeader(http.StatusNoContent)
+				return
+			}
+		}
+		writeError(w, http.StatusNotFound, "Could not find client")
+	default:
+		writeError(w, http.StatusNotFound, "unknown path")
+	}
+}
+
+func (s *Server) newRealm(rep keycloak.Realm) *realm {
+	rep.ID = rep.Realm
+	r := &realm{
+		rep:        rep,
+		groupRoles: map[string][]keycloak.Role{},
+		passwords:  map[string]string{},
+	}
+	s.realms[rep.Realm] = r
+	return r
+}
+
+func (s *Server) newID() string {
+	s.nextID++
+	return fmt.Sprintf("id-%d", s.nextID)
+}
+
+// copyGroups returns the group tree the way Keycloak returns it
+func copyGroups(groups []*keycloak.Group) []keycloak.Group {
+	out := []keycloak.Group{}
+	for _, group := range groups {
+		out = append(out, *group)
+	}
+	return out
+}
+
+func findGroupByID(groups []*keycloak.Group, id string) *keycloak.Group {
+	for _, group := range groups {
+		if found := findSubGroup(group, func(g *keycloak.Group) bool { return g.ID == id }); found != nil {
+			return found
+		}
+	}
+	return nil
+}
+
+func findGroupByPath(groups []*keycloak.Group, groupPath string) *keycloak.Group {
+	for _, group := range groups {
+		if found := findSubGroup(group, func(g *keycloak.Group) bool { return g.Path == groupPath }); found != nil {
+			return found
+		}
+	}
+	return nil
+}
+
+func findSubGroup(group *keycloak.Group, match func(*keycloak.Group) bool) *keycloak.Group {
+	if match(group) {
+		return group
+	}
+	for i := range group.SubGroups {
+		if found := findSubGroup(&group.SubGroups[i], match); found != nil {
+			return found
+		}
+	}
+	return nil
+}
+
+func findRole(roles []keycloak.Role, name string) *keycloak.Role {
+	for i := range roles {
+		if roles[i].Name == name {
+			return &roles[i]
+		}
+	}
+	return nil
+}
+
+func writeCreated(w http.ResponseWriter, req *http.Request, id string) {
+	w.Header().Set("Location", fmt.Sprintf("http://%s%s/%s", req.Host, strings.TrimSuffix(req.URL.Path, "/"), url.PathEscape(id)))
+	w.WriteHeader(http.StatusCreated)
+}
+
+func writeError(w http.ResponseWriter, code int, msg string) {
+	httpfake.WriteJSON(w, code, map[string]string{"errorMessage": msg})
+}
+
+func boolPtr(b bool) *bool {
+	return &b
+}
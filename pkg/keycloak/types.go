@@ -0,0 +1,116 @@
+// Copyright (c) 2022, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package keycloak
+
+// Realm is the subset of the Keycloak RealmRepresentation managed by Verrazzano, empty fields are left
+// unchanged when a realm is updated
+type Realm struct {
+	ID             string `json:"id,omitempty"`
+	Realm          string `json:"realm,omitempty"`
+	Enabled        *bool  `json:"enabled,omitempty"`
+	PasswordPolicy string `json:"passwordPolicy,omitempty"`
+	LoginTheme     string `json:"loginTheme,omitempty"`
+}
+
+// Group is a Keycloak GroupRepresentation
+type Group struct {
+	ID        string  `json:"id,omitempty"`
+	Name      string  `json:"name,omitempty"`
+	Path      string  `json:"path,omitempty"`
+	SubGroups []Group `json:"subGroups,omitempty"`
+}
+
+// Role is a Keycloak RoleRepresentation
+type Role struct {
+	ID          string `json:"id,omitempty"`
+	Name        string `json:"name,omitempty"`
+	Description string `json:"description,omitempty"`
+	Composite   bool   `json:"composite,omitempty"`
+	ClientRole  bool   `json:"clientRole,omitempty"`
+	ContainerID string `json:"containerId,omitempty"`
+}
+
+// User is the subset of the Keycloak UserRepresentation managed by Verrazzano
+type User struct {
+	ID       string `json:"id,omitempty"`
+	Username string `json:"username,omitempty"`
+	Enabled  bool   `json:"enabled"`
+	// Groups are the group paths of a new user, like /verrazzano-users/verrazzano-admins
+	Groups []string `json:"groups,omitempty"`
+}
+
+// Credential is a Keycloak CredentialRepresentation
+type Credential struct {
+	Type      string `json:"type"`
+	Value     string `json:"value"`
+	Temporary bool   `json:"temporary"`
+}
+
+// ProtocolMapper is a Keycloak ProtocolMapperRepresentation
+type ProtocolMapper struct {
+	ID              string            `json:"id,omitempty"`
+	Name            string            `json:"name"`
+	Protocol        string            `json:"protocol"`
+	ProtocolMapper  string            `json:"protocolMapper"`
+	ConsentRequired bool              `json:"consentRequired"`
+	Config          map[string]string `json:"config,omitempty"`
+}
+
+// Client is the subset of the Keycloak ClientRepresentation used by Verrazzano
+type Client struct {
+	ID                                 string            `json:"id,omitempty"`
+	ClientID                           string            `json:"clientId"`
+	Enabled                            bool              `json:"enabled"`
+	RootURL                            *string           `json:"rootUrl,omitempty"`
+	AdminURL                           *string           `json:"adminUrl,omitempty"`
+	SurrogateAuthRequired              bool              `json:"surrogateAuthRequired"`
+	AlwaysDisplayInConsole             bool              `json:"alwaysDisplayInConsole"`
+	ClientAuthenticatorType            string            `json:"clientAuthenticatorType,omitempty"`
+	Secret                             string            `json:"secret,omitempty"`
+	RedirectURIs                       []string          `json:"redirectUris"`
+	WebOrigins                         []string          `json:"webOrigins"`
+	NotBefore                          int               `json:"notBefore"`
+	BearerOnly                         bool              `json:"bearerOnly"`
+	ConsentRequired                    bool              `json:"consentRequired"`
+	StandardFlowEnabled                bool              `json:"standardFlowEnabled"`
+	ImplicitFlowEnabled                bool              `json:"implicitFlowEnabled"`
+	DirectAccessGrantsEnabled          bool              `json:"directAccessGrantsEnabled"`
+	ServiceAccountsEnabled             bool              `json:"serviceAccountsEnabled"`
+	PublicClient                       bool              `json:"publicClient"`
+	FrontchannelLogout                 bool              `json:"frontchannelLogout"`
+	Protocol                           string            `json:"protocol,omitempty"`
+	Attributes                         map[string]string `json:"attributes,omitempty"`
+	AuthenticationFlowBindingOverrides map[string]string `json:"authenticationFlowBindingOverrides,omitempty"`
+	FullScopeAllowed                   bool              `json:"fullScopeAllowed"`
+	NodeReRegistrationTimeout          int               `json:"nodeReRegistrationTimeout"`
+	ProtocolMappers                    []ProtocolMapper  `json:"protocolMappers,omitempty"`
+	DefaultClientScopes                []string          `json:"defaultClientScopes,omitempty"`
+	OptionalClientScopes               []string          `json:"optionalClientScopes,omitempty"`
+}
+
+// FindGroup returns the group with the name from the group tree, or nil if there is none
+func FindGroup(groups []Group, name string) *Group {
+	for i := range groups {
+		if groups[i].Name == name {
+			return &groups[i]
+		}
+		if group := FindGroup(groups[i].SubGroups, name); group != nil {
+			return group
+		}
+	}
+	return nil
+}
+
+// FindGroupByPath returns the group with the path, like /parent/child, from the group tree, or nil if there is none
+func FindGroupByPath(groups []Group, groupPath string) *Group {
+	for i := range groups {
+		if groups[i].Path == groupPath {
+			return &groups[i]
+		}
+		if group := FindGroupByPath(groups[i].SubGroups, groupPath); group != nil {
+			return group
+		}
+	}
+	return nil
+}
@@ -0,0 +1,43 @@
+// Copyright (c) 2022, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package metricsutils
+
+import (
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	// commandMetricsNamespace is the namespace of the command metrics, they are exported by the platform operator
+	commandMetricsNamespace = "vpo"
+
+	// CommandResultSuccess is the result label value of a command that succeeded
+	CommandResultSuccess = "success"
+
+	// CommandResultFailure is the result label value of a command that failed
+	CommandResultFailure = "failure"
+)
+
+var commandDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+	Namespace: commandMetricsNamespace,
+	Name:      "command_duration_seconds",
+	Help:      "The latency of the Helm and Istio operations run by Verrazzano",
+	Buckets:   prometheus.ExponentialBuckets(0.1, 2, 14),
+}, []string{"command", "operation", "result"})
+
+// RegisterCommandMetrics registers the command metrics with the registerer, the metrics are only exported by the
+// processes that register them
+func RegisterCommandMetrics(registerer prometheus.Registerer) {
+	registerer.MustRegister(commandDuration)
+}
+
+// ObserveCommandDuration records the latency of a command operation that started at the start time
+func ObserveCommandDuration(command string, operation string, start time.Time, err error) {
+	result := CommandResultSuccess
+	if err != nil {
+		result = CommandResultFailure
+	}
+	commandDuration.WithLabelValues(command, operation, result).Observe(time.Since(start).Seconds())
+}
@@ -0,0 +1,39 @@
+// Copyright (c) 2022, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package metricsutils
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestObserveCommandDuration tests the ObserveCommandDuration function
+// GIVEN a helm command that succeeded and one that failed
+// WHEN the command latencies are observed
+// THEN there is a latency histogram for each result
+func TestObserveCommandDuration(t *testing.T) {
+	ObserveCommandDuration("helm", "upgrade", time.Now(), nil)
+	ObserveCommandDuration("helm", "upgrade", time.Now(), fmt.Errorf("helm failed"))
+	assert.Equal(t, 2, testutil.CollectAndCount(commandDuration))
+}
+
+// TestRegisterCommandMetrics tests the RegisterCommandMetrics function
+// GIVEN a registry
+// WHEN the command metrics are registered and a command latency is observed
+// THEN the registry exports the latency in the vpo namespace
+func TestRegisterCommandMetrics(t *testing.T) {
+	registry := prometheus.NewRegistry()
+	RegisterCommandMetrics(registry)
+	ObserveCommandDuration("helm", "upgrade", time.Now(), nil)
+
+	families, err := registry.Gather()
+	assert.NoError(t, err)
+	assert.Len(t, families, 1)
+	assert.Equal(t, "vpo_command_duration_seconds", families[0].GetName())
+}
@@ -0,0 +1,151 @@
+// Copyright (c) 2022, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package opensearch_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/verrazzano/verrazzano/pkg/opensearch"
+	"github.com/verrazzano/verrazzano/pkg/opensearch/fake"
+)
+
+const (
+	testUsername   = "verrazzano"
+	testPassword   = "vzpw"
+	testRepository = "verrazzano"
+)
+
+var testIndices = []string{"verrazzano-system", "verrazzano-application-hello", ".opendistro-ism-config"}
+
+func newS3Repository() opensearch.Repository {
+	return opensearch.Repository{
+		Type: opensearch.S3RepositoryType,
+		Settings: opensearch.RepositorySettings{
+			Bucket:          "vz-snapshots",
+			BasePath:        "prod",
+			Endpoint:        "http://minio.minio.svc:9000",
+			PathStyleAccess: "true",
+		},
+	}
+}
+
+// newClientWithRepository returns a client of a server that has the S3 repository
+func newClientWithRepository(t *testing.T) (*opensearch.Client, *fake.Server) {
+	server := fake.NewServer(testUsername, testPassword, testIndices...)
+	client := opensearch.NewClient(server.URL, server.Client(), testUsername, testPassword)
+	assert.NoError(t, client.PutRepository(testRepository, newS3Repository()))
+	return client, server
+}
+
+// TestUnauthorized tests calling OpenSearch with the wrong credentials
+// GIVEN an OpenSearch server
+//  WHEN I call GetRepository with a wrong password
+//  THEN an unauthorized error with the reason of OpenSearch is returned
+func TestUnauthorized(t *testing.T) {
+	server := fake.NewServer(testUsername, testPassword)
+	defer server.Close()
+
+	_, err := opensearch.NewClient(server.URL, server.Client(), testUsername, "wrong").GetRepository(testRepository)
+	assert.Error(t, err)
+	apiErr, ok := err.(*opensearch.APIError)
+	assert.True(t, ok)
+	assert.Equal(t, http.StatusUnauthorized, apiErr.StatusCode)
+	assert.Equal(t, "security_exception", apiErr.Type)
+	assert.Contains(t, err.Error(), "missing authentication credentials")
+}
+
+// TestRepository tests registering and getting a snapshot repository
+// GIVEN an OpenSearch server without repositories
+//  WHEN I get the repository, register it and get it again
+//  THEN the first get fails with not found, and the second get returns the registered settings
+func TestRepository(t *testing.T) {
+	asserts := assert.New(t)
+	server := fake.NewServer(testUsername, testPassword)
+	defer server.Close()
+	client := opensearch.NewClient(server.URL+"/", server.Client(), testUsername, testPassword)
+
+	_, err := client.GetRepository(testRepository)
+	asserts.True(opensearch.IsNotFound(err))
+	asserts.Contains(err.Error(), "[verrazzano] missing")
+
+	asserts.NoError(client.PutRepository(testRepository, newS3Repository()))
+	repo, err := client.GetRepository(testRepository)
+	asserts.NoError(err)
+	asserts.Equal(newS3Repository(), *repo)
+
+	err = client.PutRepository(testRepository, opensearch.Repository{Type: "fs"})
+	asserts.Error(err)
+	asserts.False(opensearch.IsNotFound(err))
+}
+
+// TestSnapshots tests creating, listing and deleting snapshots
+// GIVEN an OpenSearch server with a repository
+//  WHEN I create snapshots with and without waiting, list them and delete one
+//  THEN the snapshots are created with the matching indices, listed in order and deleted
+func TestSnapshots(t *testing.T) {
+	asserts := assert.New(t)
+	client, server := newClientWithRepository(t)
+	defer server.Close()
+
+	snapshot, err := client.CreateSnapshot(testRepository, "first", opensearch.CreateSnapshotRequest{Indices: "verrazzano-*"}, true)
+	asserts.NoError(err)
+	asserts.Equal("first", snapshot.Snapshot)
+	asserts.Equal(opensearch.SnapshotStateSuccess, snapshot.State)
+	asserts.Equal([]string{"verrazzano-system", "verrazzano-application-hello"}, snapshot.Indices)
+
+	snapshot, err = client.CreateSnapshot(testRepository, "second", opensearch.CreateSnapshotRequest{}, false)
+	asserts.NoError(err)
+	asserts.Nil(snapshot)
+
+	_, err = client.CreateSnapshot(testRepository, "second", opensearch.CreateSnapshotRequest{}, false)
+	asserts.Error(err)
+	asserts.Contains(err.Error(), "snapshot with the same name already exists")
+
+	snapshots, err := client.GetSnapshots(testRepository)
+	asserts.NoError(err)
+	asserts.Len(snapshots, 2)
+	asserts.Equal(testIndices, snapshots[1].Indices)
+
+	asserts.NoError(client.DeleteSnapshot(testRepository, "first"))
+	asserts.Equal([]string{"second"}, server.SnapshotNames(testRepository))
+	asserts.True(opensearch.IsNotFound(client.DeleteSnapshot(testRepository, "first")))
+
+	_, err = client.GetSnapshots("missing")
+	asserts.True(opensearch.IsNotFound(err))
+}
+
+// TestRestoreSnapshot tests restoring a snapshot
+// GIVEN an OpenSearch server with a snapshot
+//  WHEN I restore the snapshot and a snapshot that does not exist
+//  THEN the restore request is sent with the indices and rename options, and the second restore fails
+func TestRestoreSnapshot(t *testing.T) {
+	asserts := assert.New(t)
+	client, server := newClientWithRepository(t)
+	defer server.Close()
+	_, err := client.CreateSnapshot(testRepository, "nightly", opensearch.CreateSnapshotRequest{}, true)
+	asserts.NoError(err)
+
+	request := opensearch.RestoreSnapshotRequest{Indices: "verrazzano-system", RenamePattern: "(.+)", RenameReplacement: "restored-$1"}
+	asserts.NoError(client.RestoreSnapshot(testRepository, "nightly", request, true))
+	asserts.Equal([]fake.Restore{{Repository: testRepository, Snapshot: "nightly", Request: request}}, server.Restores())
+
+	err = client.RestoreSnapshot(testRepository, "missing", opensearch.RestoreSnapshotRequest{}, false)
+	asserts.True(opensearch.IsNotFound(err))
+}
+
+// TestFailedRequest tests a request that fails without an OpenSearch error body
+// GIVEN an OpenSearch server whose snapshot requests fail
+//  WHEN I create a snapshot
+//  THEN an error with the status code is returned
+func TestFailedRequest(t *testing.T) {
+	client, server := newClientWithRepository(t)
+	defer server.Close()
+	server.FailRequests(http.MethodPut, "/_snapshot/verrazzano/", http.StatusServiceUnavailable)
+
+	_, err := client.CreateSnapshot(testRepository, "nightly", opensearch.CreateSnapshotRequest{}, true)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "OpenSearch PUT /_snapshot/verrazzano/nightly returned status code 503: injected failure")
+}
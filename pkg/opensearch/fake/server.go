@@ -0,0 +1,242 @@
+// Copyright (c) 2022, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package fake
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	httpfake "github.com/verrazzano/verrazzano/pkg/httputil/fake"
+	"github.com/verrazzano/verrazzano/pkg/opensearch"
+)
+
+// Server is an in-memory stand-in for the parts of the OpenSearch snapshot REST API used by Verrazzano
+type Server struct {
+	*httpfake.Server
+
+	username     string
+	password     string
+	indices      []string
+	repositories map[string]opensearch.Repository
+	snapshots    map[string][]opensearch.Snapshot
+	restores     []Restore
+	now          time.Time
+}
+
+// Restore records a request to restore a snapshot
+type Restore struct {
+	Repository string
+	Snapshot   string
+	Request    opensearch.RestoreSnapshotRequest
+}
+
+// NewServer starts an HTTP server with the indices that requires basic auth with the username and password, the
+// caller must Close it
+func NewServer(username string, password string, indices ...string) *Server {
+	s := newServer(username, password, indices)
+	s.Start()
+	return s
+}
+
+// NewTLSServer starts an HTTPS server like NewServer, the certificate of the server is returned by Certificate
+func NewTLSServer(username string, password string, indices ...string) *Server {
+	s := newServer(username, password, indices)
+	s.StartTLS()
+	return s
+}
+
+func newServer(username string, password string, indices []string) *Server {
+	s := &Server{
+		username:     username,
+		password:     password,
+		indices:      indices,
+		repositories: map[string]opensearch.Repository{},
+		snapshots:    map[string][]opensearch.Snapshot{},
+		now:          time.Date(2022, 10, 17, 3, 0, 0, 0, time.UTC),
+	}
+	s.Server = httpfake.NewServer(s.serveHTTP, func(w http.ResponseWriter, code int, message string) {
+		writeError(w, code, "exception", message)
+	})
+	return s
+}
+
+// Repository returns a copy of the repository, or nil if it does not exist
+func (s *Server) Repository(name string) *opensearch.Repository {
+	s.Lock()
+	defer s.Unlock()
+	repo, ok := s.repositories[name]
+	if !ok {
+		return nil
+	}
+	return &repo
+}
+
+// SnapshotNames returns the names of the snapshots of the repository in the order they were taken
+func (s *Server) SnapshotNames(repo string) []string {
+	s.Lock()
+	defer s.Unlock()
+	var names []string
+	for _, snapshot := range s.snapshots[repo] {
+		names = append(names, snapshot.Snapshot)
+	}
+	return names
+}
+
+// Restores returns the restore requests
+func (s *Server) Restores() []Restore {
+	s.Lock()
+	defer s.Unlock()
+	return append([]Restore{}, s.restores...)
+}
+
+func (s *Server) serveHTTP(w http.ResponseWriter, req *http.Request) {
+	if username, password, ok := req.BasicAuth(); !ok || username != s.username || password != s.password {
+		writeError(w, http.StatusUnauthorized, "security_exception", "missing authentication credentials")
+		return
+	}
+
+	segs := httpfake.PathSegments(req.URL.Path, "/_snapshot")
+	if !strings.HasPrefix(req.URL.Path, "/_snapshot/") || len(segs) == 0 {
+		writeError(w, http.StatusNotFound, "unknown_path_exception", "unknown path")
+		return
+	}
+	switch {
+	case len(segs) == 1:
+		s.serveRepository(w, req, segs[0])
+	case len(segs) == 2 && segs[1] == "_all" && req.Method == http.MethodGet:
+		s.getSnapshots(w, segs[0])
+	case len(segs) == 2:
+		s.serveSnapshot(w, req, segs[0], segs[1])
+	case len(segs) == 3 && segs[2] == "_restore" && req.Method == http.MethodPost:
+		s.restoreSnapshot(w, req, segs[0], segs[1])
+	default:
+		writeError(w, http.StatusNotFound, "unknown_path_exception", "unknown path")
+	}
+}
+
+func (s *Server) serveRepository(w http.ResponseWriter, req *http.Request, name string) {
+	switch req.Method {
+	case http.MethodGet:
+		repo, ok := s.repositories[name]
+		if !ok {
+			writeError(w, http.StatusNotFound, "repository_missing_exception", fmt.Sprintf("[%s] missing", name))
+			return
+		}
+		httpfake.WriteJSON(w, http.StatusOK, map[string]opensearch.Repository{name: repo})
+	case http.MethodPut:
+		repo := opensearch.Repository{}
+		if !s.ReadJSON(w, req, &repo) {
+			return
+		}
+		if repo.Type != opensearch.S3RepositoryType || len(repo.Settings.Bucket) == 0 {
+			writeError(w, http.StatusInternalServerError, "repository_exception", fmt.Sprintf("[%s] failed to create repository", name))
+			return
+		}
+		s.repositories[name] = repo
+		httpfake.WriteJSON(w, http.StatusOK, map[string]bool{"acknowledged": true})
+	default:
+		writeError(w, http.StatusMethodNotAllowed, "method_not_allowed_exception", "")
+	}
+}
+
+func (s *Server) getSnapshots(w http.ResponseWriter, repo string) {
+	if _, ok := s.repositories[repo]; !ok {
+		writeError(w, http.StatusNotFound, "repository_missing_exception", fmt.Sprintf("[%s] missing", repo))
+		return
+	}
+	httpfake.WriteJSON(w, http.StatusOK, map[string][]opensearch.Snapshot{"snapshots": append([]opensearch.Snapshot{}, s.snapshots[repo]...)})
+}
+
+func (s *Server) serveSnapshot(w http.ResponseWriter, req *http.Request, repo string, name string) {
+	if _, ok := s.repositories[repo]; !ok {
+		writeError(w, http.StatusNotFound, "repository_missing_exception", fmt.Sprintf("[%s] missing", repo))
+		return
+	}
+	index := s.findSnapshot(repo, name)
+	switch req.Method {
+	case http.MethodPut:
+		if index >= 0 {
+			writeError(w, http.StatusBadRequest, "invalid_snapshot_name_exception", fmt.Sprintf("[%s:%s] Invalid snapshot name [%s], snapshot with the same name already exists", repo, name, name))
+			return
+		}
+		create := opensearch.CreateSnapshotRequest{}
+		if !s.ReadJSON(w, req, &create) {
+			return
+		}
+		s.now = s.now.Add(time.Minute)
+		snapshot := opensearch.Snapshot{
+			Snapshot:  name,
+			UUID:      fmt.Sprintf("uuid-%s", name),
+			State:     opensearch.SnapshotStateSuccess,
+			Indices:   s.matchIndices(create.Indices),
+			StartTime: s.now.Format(time.RFC3339),
+			EndTime:   s.now.Add(time.Second).Format(time.RFC3339),
+		}
+		s.snapshots[repo] = append(s.snapshots[repo], snapshot)
+		if req.URL.Query().Get("wait_for_completion") == "true" {
+			httpfake.WriteJSON(w, http.StatusOK, map[string]opensearch.Snapshot{"snapshot": snapshot})
+			return
+		}
+		httpfake.WriteJSON(w, http.StatusOK, map[string]bool{"accepted": true})
+	case http.MethodDelete:
+		if index < 0 {
+			writeError(w, http.StatusNotFound, "snapshot_missing_exception", fmt.Sprintf("[%s:%s] is missing", repo, name))
+			return
+		}
+		s.snapshots[repo] = append(s.snapshots[repo][:index], s.snapshots[repo][index+1:]...)
+		httpfake.WriteJSON(w, http.StatusOK, map[string]bool{"acknowledged": true})
+	default:
+		writeError(w, http.StatusMethodNotAllowed, "method_not_allowed_exception", "")
+	}
+}
+
+func (s *Server) restoreSnapshot(w http.ResponseWriter, req *http.Request, repo string, name string) {
+	if s.findSnapshot(repo, name) < 0 {
+		writeError(w, http.StatusNotFound, "snapshot_restore_exception", fmt.Sprintf("[%s:%s] snapshot does not exist", repo, name))
+		return
+	}
+	restore := opensearch.RestoreSnapshotRequest{}
+	if !s.ReadJSON(w, req, &restore) {
+		return
+	}
+	s.restores = append(s.restores, Restore{Repository: repo, Snapshot: name, Request: restore})
+	httpfake.WriteJSON(w, http.StatusOK, map[string]bool{"accepted": true})
+}
+
+func (s *Server) findSnapshot(repo string, name string) int {
+	for i, snapshot := range s.snapshots[repo] {
+		if snapshot.Snapshot == name {
+			return i
+		}
+	}
+	return -1
+}
+
+// matchIndices returns the indices of the server that match the comma separated index patterns, which only
+// support a trailing wildcard
+func (s *Server) matchIndices(patterns string) []string {
+	if len(patterns) == 0 {
+		return append([]string{}, s.indices...)
+	}
+	var matched []string
+	for _, index := range s.indices {
+		for _, pattern := range strings.Split(patterns, ",") {
+			if index == pattern || (strings.HasSuffix(pattern, "*") && strings.HasPrefix(index, strings.TrimSuffix(pattern, "*"))) {
+				matched = append(matched, index)
+				break
+			}
+		}
+	}
+	return matched
+}
+
+func writeError(w http.ResponseWriter, code int, errType string, reason string) {
+	body := map[string]interface{}{
+		"error":  map[string]string{"type": errType, "reason": reason},
+		"status": code,
+	}
+	httpfake.WriteJSON(w, code, body)
+}
@@ -0,0 +1,130 @@
+// Copyright (c) 2022, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package opensearch
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"path"
+)
+
+const (
+	// S3RepositoryType is the type of the snapshot repositories of the repository-s3 plugin
+	S3RepositoryType = "s3"
+
+	// SnapshotStateSuccess is the state of a snapshot that completed
+	SnapshotStateSuccess = "SUCCESS"
+)
+
+// Repository is a snapshot repository
+type Repository struct {
+	Type     string             `json:"type"`
+	Settings RepositorySettings `json:"settings"`
+}
+
+// RepositorySettings are the settings of an S3 snapshot repository, OpenSearch returns all of the settings as strings
+type RepositorySettings struct {
+	Bucket          string `json:"bucket"`
+	BasePath        string `json:"base_path,omitempty"`
+	Endpoint        string `json:"endpoint,omitempty"`
+	Region          string `json:"region,omitempty"`
+	PathStyleAccess string `json:"path_style_access,omitempty"`
+}
+
+// Snapshot is the information of a snapshot
+type Snapshot struct {
+	Snapshot  string   `json:"snapshot"`
+	UUID      string   `json:"uuid,omitempty"`
+	State     string   `json:"state,omitempty"`
+	Indices   []string `json:"indices,omitempty"`
+	StartTime string   `json:"start_time,omitempty"`
+	EndTime   string   `json:"end_time,omitempty"`
+}
+
+// CreateSnapshotRequest is the body of a request to create a snapshot
+type CreateSnapshotRequest struct {
+	// Indices is a comma separated list of index patterns, all of the indices are included if it is empty
+	Indices            string `json:"indices,omitempty"`
+	IgnoreUnavailable  bool   `json:"ignore_unavailable,omitempty"`
+	IncludeGlobalState bool   `json:"include_global_state"`
+}
+
+// RestoreSnapshotRequest is the body of a request to restore a snapshot
+type RestoreSnapshotRequest struct {
+	// Indices is a comma separated list of index patterns, all of the indices of the snapshot are restored if it
+	// is empty
+	Indices            string `json:"indices,omitempty"`
+	IncludeGlobalState bool   `json:"include_global_state"`
+	RenamePattern      string `json:"rename_pattern,omitempty"`
+	RenameReplacement  string `json:"rename_replacement,omitempty"`
+}
+
+// snapshotResponse is the response of creating a snapshot and waiting for it to complete
+type snapshotResponse struct {
+	Snapshot *Snapshot `json:"snapshot,omitempty"`
+}
+
+// snapshotsResponse is the response of listing the snapshots of a repository
+type snapshotsResponse struct {
+	Snapshots []Snapshot `json:"snapshots"`
+}
+
+// GetRepository returns the repository, the error satisfies IsNotFound if the repository does not exist
+func (c *Client) GetRepository(name string) (*Repository, error) {
+	out := map[string]Repository{}
+	if err := c.send(http.MethodGet, repositoryPath(name), nil, nil, &out); err != nil {
+		return nil, err
+	}
+	repo, ok := out[name]
+	if !ok {
+		return nil, fmt.Errorf("OpenSearch did not return snapshot repository %s", name)
+	}
+	return &repo, nil
+}
+
+// PutRepository registers or updates the repository, OpenSearch verifies that all of its nodes can write to it
+func (c *Client) PutRepository(name string, repo Repository) error {
+	return c.send(http.MethodPut, repositoryPath(name), nil, repo, nil)
+}
+
+// CreateSnapshot creates a snapshot, if wait is true it returns the snapshot once it has completed, otherwise it
+// returns nil once the snapshot has started
+func (c *Client) CreateSnapshot(repo string, name string, req CreateSnapshotRequest, wait bool) (*Snapshot, error) {
+	query := url.Values{"wait_for_completion": []string{fmt.Sprint(wait)}}
+	out := snapshotResponse{}
+	if err := c.send(http.MethodPut, snapshotPath(repo, name), query, req, &out); err != nil {
+		return nil, err
+	}
+	return out.Snapshot, nil
+}
+
+// GetSnapshots returns the snapshots of the repository in the order they were taken
+func (c *Client) GetSnapshots(repo string) ([]Snapshot, error) {
+	out := snapshotsResponse{}
+	if err := c.send(http.MethodGet, snapshotPath(repo, "_all"), nil, nil, &out); err != nil {
+		return nil, err
+	}
+	return out.Snapshots, nil
+}
+
+// DeleteSnapshot deletes the snapshot, the error satisfies IsNotFound if the snapshot does not exist
+func (c *Client) DeleteSnapshot(repo string, name string) error {
+	return c.send(http.MethodDelete, snapshotPath(repo, name), nil, nil, nil)
+}
+
+// RestoreSnapshot restores the indices of the snapshot, if wait is true it returns once they are restored.  Indices
+// that are open can not be restored unless they are renamed.
+func (c *Client) RestoreSnapshot(repo string, name string, req RestoreSnapshotRequest, wait bool) error {
+	query := url.Values{"wait_for_completion": []string{fmt.Sprint(wait)}}
+	return c.send(http.MethodPost, path.Join(snapshotPath(repo, name), "_restore"), query, req, nil)
+}
+
+func repositoryPath(repo string) string {
+	return path.Join("/_snapshot", url.PathEscape(repo))
+}
+
+func snapshotPath(repo string, name string) string {
+	return path.Join(repositoryPath(repo), url.PathEscape(name))
+}
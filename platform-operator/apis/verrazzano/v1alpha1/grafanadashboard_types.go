@@ -0,0 +1,90 @@
+// Copyright (c) 2022, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// GrafanaDashboardState identifies whether a dashboard is in Grafana
+type GrafanaDashboardState string
+
+const (
+	// GrafanaDashboardStatePending means the dashboard is waiting for Grafana to be ready
+	GrafanaDashboardStatePending GrafanaDashboardState = "Pending"
+
+	// GrafanaDashboardStateProvisioned means the dashboard is in Grafana and matches the resource
+	GrafanaDashboardStateProvisioned GrafanaDashboardState = "Provisioned"
+
+	// GrafanaDashboardStateFailed means the dashboard could not be provisioned, it is retried when the resource
+	// changes and periodically
+	GrafanaDashboardStateFailed GrafanaDashboardState = "Failed"
+)
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:path=grafanadashboards
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Folder",type="string",JSONPath=".status.folder",description="The Grafana folder of the dashboard"
+// +kubebuilder:printcolumn:name="State",type="string",JSONPath=".status.state",description="Whether the dashboard is in Grafana"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+// +genclient
+
+// GrafanaDashboard is the Schema for the grafanadashboards API.  The dashboard is provisioned in the Grafana of
+// Verrazzano, in a folder named after the project of its namespace, or after the namespace if it is not part of a
+// project.  The users of a project can view the dashboards in the folders of the project, and its admins can edit
+// them.  The dashboard is removed from Grafana when the resource is deleted.
+type GrafanaDashboard struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   GrafanaDashboardSpec   `json:"spec,omitempty"`
+	Status GrafanaDashboardStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// GrafanaDashboardList contains a list of GrafanaDashboard
+type GrafanaDashboardList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []GrafanaDashboard `json:"items"`
+}
+
+// GrafanaDashboardSpec defines the dashboard and the folder it is placed in
+type GrafanaDashboardSpec struct {
+	// Dashboard is the JSON model of the dashboard, as exported from Grafana.  The uid of the model is kept so that
+	// links between dashboards work, a uid is generated if it is empty.
+	Dashboard string `json:"dashboard"`
+	// Folder is the title of the Grafana folder of the dashboard.  It defaults to the name of the project of the
+	// namespace, or to the namespace if it is not part of a project.  The folders of other projects and namespaces,
+	// and the folders of the platform dashboards, cannot be used.
+	// +optional
+	Folder string `json:"folder,omitempty"`
+}
+
+// GrafanaDashboardStatus is the outcome of provisioning the dashboard in Grafana
+type GrafanaDashboardStatus struct {
+	// State is whether the dashboard is in Grafana
+	// +optional
+	State GrafanaDashboardState `json:"state,omitempty"`
+	// Message describes why the dashboard is pending or failed
+	// +optional
+	Message string `json:"message,omitempty"`
+	// UID is the uid of the dashboard in Grafana
+	// +optional
+	UID string `json:"uid,omitempty"`
+	// Folder is the title of the Grafana folder of the dashboard
+	// +optional
+	Folder string `json:"folder,omitempty"`
+	// URL is the URL of the dashboard in Grafana
+	// +optional
+	URL string `json:"url,omitempty"`
+	// ObservedGeneration is the generation of the resource that the status applies to
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+}
+
+func init() {
+	SchemeBuilder.Register(&GrafanaDashboard{}, &GrafanaDashboardList{})
+}
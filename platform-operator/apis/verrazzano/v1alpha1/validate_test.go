@@ -704,6 +704,9 @@ func TestValidateInProgress(t *testing.T) {
 	err := ValidateInProgress(&vzOld)
 	assert.NoError(t, err)
 
+	vzOld.Status.State = VzStateDegraded
+	assert.NoError(t, ValidateInProgress(&vzOld))
+
 	vzOld.Status.State = VzStateUninstalling
 	err = ValidateInProgress(&vzOld)
 	if assert.Error(t, err) {
@@ -717,6 +720,21 @@ func TestValidateInProgress(t *testing.T) {
 	}
 }
 
+// TestIsInstalledState tests the IsVzInstalledState and IsComponentInstalledState functions
+// GIVEN the Verrazzano and component states
+// WHEN the functions are called
+// THEN ensure only the ready and degraded states are installed states
+func TestIsInstalledState(t *testing.T) {
+	assert.True(t, IsVzInstalledState(VzStateReady))
+	assert.True(t, IsVzInstalledState(VzStateDegraded))
+	assert.False(t, IsVzInstalledState(VzStateReconciling))
+	assert.False(t, IsVzInstalledState(VzStateFailed))
+	assert.True(t, IsComponentInstalledState(CompStateReady))
+	assert.True(t, IsComponentInstalledState(CompStateDegraded))
+	assert.False(t, IsComponentInstalledState(CompStateInstalling))
+	assert.False(t, IsComponentInstalledState(CompStateDisabled))
+}
+
 // TestValidateEnable tests that a component can be enabled when Verrazzano is ready or installing
 // GIVEN various Verrrazzano resource states
 // THEN ensure TestValidateInProgress returns correctly
@@ -0,0 +1,529 @@
+// Copyright (c) 2022, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package v1alpha1
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/verrazzano/verrazzano/platform-operator/apis/verrazzano/v1beta1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"sigs.k8s.io/controller-runtime/pkg/conversion"
+)
+
+const (
+	// ConvertedInstallArgsAnnotation is the annotation of a v1beta1 Verrazzano resource that records the v1alpha1
+	// install args that were converted to values overrides, so that they are restored when the resource is converted
+	// back to v1alpha1
+	ConvertedInstallArgsAnnotation = "install.verrazzano.io/converted-install-args"
+
+	// nginxExternalIPsArg is the NGINX install arg of the controller service external IPs
+	nginxExternalIPsArg = "controller.service.externalIPs"
+
+	// istioExternalIPsArg is the Istio install arg of the ingress gateway service external IPs
+	istioExternalIPsArg = "gateways.istio-ingressgateway.externalIPs"
+
+	// The keys of the components in the ConvertedInstallArgsAnnotation
+	verrazzanoArgsKey    = "verrazzano"
+	elasticsearchArgsKey = "elasticsearch"
+	ingressArgsKey       = "ingress"
+	istioArgsKey         = "istio"
+	keycloakArgsKey      = "keycloak"
+	mysqlArgsKey         = "mysql"
+)
+
+// convertedInstallArgs are the install args of a component and the values override they were converted to
+type convertedInstallArgs struct {
+	InstallArgs []InstallArgs         `json:"installArgs"`
+	Values      *apiextensionsv1.JSON `json:"values,omitempty"`
+}
+
+var _ conversion.Convertible = &Verrazzano{}
+
+// ConvertTo converts this Verrazzano to the v1beta1 Hub version.  The install args of the components are converted to
+// an equivalent values override, the external IPs install args of NGINX and Istio are converted to the ExternalIPs
+// fields.
+func (v *Verrazzano) ConvertTo(dstRaw conversion.Hub) error {
+	dst := dstRaw.(*v1beta1.Verrazzano)
+	v.ObjectMeta.DeepCopyInto(&dst.ObjectMeta)
+	delete(dst.Annotations, ConvertedInstallArgsAnnotation)
+	// The fields that are the same in both versions are converted through their JSON representation, the install
+	// args are dropped since v1beta1 has no such fields
+	if err := convertJSON(v.Spec, &dst.Spec); err != nil {
+		return err
+	}
+	if err := convertJSON(v.Status, &dst.Status); err != nil {
+		return err
+	}
+
+	converted := map[string]convertedInstallArgs{}
+	comps := v.Spec.Components
+	if comps.Verrazzano != nil && len(comps.Verrazzano.InstallArgs) > 0 {
+		values, err := convertArgsToOverride(comps.Verrazzano.InstallArgs, helmArgsToValues, &dst.Spec.Components.Verrazzano.InstallOverrides, false)
+		if err != nil {
+			return err
+		}
+		converted[verrazzanoArgsKey] = convertedInstallArgs{InstallArgs: comps.Verrazzano.InstallArgs, Values: values}
+	}
+	if comps.Elasticsearch != nil && len(comps.Elasticsearch.ESInstallArgs) > 0 {
+		values, err := convertArgsToOverride(comps.Elasticsearch.ESInstallArgs, helmArgsToValues, &dst.Spec.Components.Elasticsearch.InstallOverrides, false)
+		if err != nil {
+			return err
+		}
+		converted[elasticsearchArgsKey] = convertedInstallArgs{InstallArgs: comps.Elasticsearch.ESInstallArgs, Values: values}
+	}
+	if comps.Ingress != nil && len(comps.Ingress.NGINXInstallArgs) > 0 {
+		args, externalIPs := splitExternalIPsArg(comps.Ingress.NGINXInstallArgs, nginxExternalIPsArg)
+		dst.Spec.Components.Ingress.ExternalIPs = externalIPs
+		values, err := convertArgsToOverride(args, helmArgsToValues, &dst.Spec.Components.Ingress.InstallOverrides, false)
+		if err != nil {
+			return err
+		}
+		converted[ingressArgsKey] = convertedInstallArgs{InstallArgs: comps.Ingress.NGINXInstallArgs, Values: values}
+	}
+	if comps.Istio != nil && len(comps.Istio.IstioInstallArgs) > 0 {
+		args, externalIPs := splitExternalIPsArg(comps.Istio.IstioInstallArgs, istioExternalIPsArg)
+		if externalIPs != nil {
+			if dst.Spec.Components.Istio.Ingress == nil {
+				dst.Spec.Components.Istio.Ingress = &v1beta1.IstioIngressSection{}
+			}
+			dst.Spec.Components.Istio.Ingress.ExternalIPs = externalIPs
+		}
+		// The IstioOperator YAML built from the install args is overridden by the Istio overrides, so the converted
+		// override is the first one
+		values, err := convertArgsToOverride(args, istioArgsToValues, &dst.Spec.Components.Istio.InstallOverrides, true)
+		if err != nil {
+			return err
+		}
+		converted[istioArgsKey] = convertedInstallArgs{InstallArgs: comps.Istio.IstioInstallArgs, Values: values}
+	}
+	if comps.Keycloak != nil && len(comps.Keycloak.KeycloakInstallArgs) > 0 {
+		values, err := convertArgsToOverride(comps.Keycloak.KeycloakInstallArgs, helmArgsToValues, &dst.Spec.Components.Keycloak.InstallOverrides, false)
+		if err != nil {
+			return err
+		}
+		converted[keycloakArgsKey] = convertedInstallArgs{InstallArgs: comps.Keycloak.KeycloakInstallArgs, Values: values}
+	}
+	if comps.Keycloak != nil && len(comps.Keycloak.MySQL.MySQLInstallArgs) > 0 {
+		values, err := convertArgsToOverride(comps.Keycloak.MySQL.MySQLInstallArgs, helmArgsToValues, &dst.Spec.Components.Keycloak.MySQL.InstallOverrides, false)
+		if err != nil {
+			return err
+		}
+		converted[mysqlArgsKey] = convertedInstallArgs{InstallArgs: comps.Keycloak.MySQL.MySQLInstallArgs, Values: values}
+	}
+
+	if len(converted) == 0 {
+		return nil
+	}
+	data, err := json.Marshal(converted)
+	if err != nil {
+		return err
+	}
+	if dst.Annotations == nil {
+		dst.Annotations = map[string]string{}
+	}
+	dst.Annotations[ConvertedInstallArgsAnnotation] = string(data)
+	return nil
+}
+
+// ConvertFrom converts the v1beta1 Hub version to this Verrazzano.  The install args that were converted to values
+// overrides are restored if the overrides were not changed, the ExternalIPs fields of NGINX and Istio are converted to
+// install args, and the values overrides of Elasticsearch are converted to install args.
+func (v *Verrazzano) ConvertFrom(srcRaw conversion.Hub) error {
+	src := srcRaw.(*v1beta1.Verrazzano)
+	src.ObjectMeta.DeepCopyInto(&v.ObjectMeta)
+	delete(v.Annotations, ConvertedInstallArgsAnnotation)
+	if len(v.Annotations) == 0 {
+		v.Annotations = nil
+	}
+	if err := convertJSON(src.Spec, &v.Spec); err != nil {
+		return err
+	}
+	if err := convertJSON(src.Status, &v.Status); err != nil {
+		return err
+	}
+
+	converted := map[string]convertedInstallArgs{}
+	if data, ok := src.Annotations[ConvertedInstallArgsAnnotation]; ok {
+		if err := json.Unmarshal([]byte(data), &converted); err != nil {
+			return fmt.Errorf("Failed to unmarshal the %s annotation: %v", ConvertedInstallArgsAnnotation, err)
+		}
+	}
+
+	comps := &v.Spec.Components
+	if comps.Verrazzano != nil {
+		comps.Verrazzano.InstallArgs = restoreArgs(converted[verrazzanoArgsKey], &comps.Verrazzano.InstallOverrides, false)
+	}
+	if comps.Elasticsearch != nil {
+		// Elasticsearch has no overrides in v1alpha1, the values overrides are converted to install args
+		overrides := InstallOverrides{}
+		if err := convertJSON(src.Spec.Components.Elasticsearch.InstallOverrides, &overrides); err != nil {
+			return err
+		}
+		args := restoreArgs(converted[elasticsearchArgsKey], &overrides, false)
+		valueArgs, err := overridesToArgs(overrides.ValueOverrides)
+		if err != nil {
+			return err
+		}
+		comps.Elasticsearch.ESInstallArgs = append(args, valueArgs...)
+	}
+	if comps.Ingress != nil {
+		args := restoreArgs(converted[ingressArgsKey], &comps.Ingress.InstallOverrides, false)
+		comps.Ingress.NGINXInstallArgs = setExternalIPsArg(args, nginxExternalIPsArg, src.Spec.Components.Ingress.ExternalIPs)
+	}
+	if comps.Istio != nil {
+		args := restoreArgs(converted[istioArgsKey], &comps.Istio.InstallOverrides, true)
+		var externalIPs []string
+		if src.Spec.Components.Istio.Ingress != nil {
+			externalIPs = src.Spec.Components.Istio.Ingress.ExternalIPs
+		}
+		comps.Istio.IstioInstallArgs = setExternalIPsArg(args, istioExternalIPsArg, externalIPs)
+		if comps.Istio.Ingress != nil && reflect.DeepEqual(*comps.Istio.Ingress, IstioIngressSection{}) {
+			comps.Istio.Ingress = nil
+		}
+	}
+	if comps.Keycloak != nil {
+		comps.Keycloak.KeycloakInstallArgs = restoreArgs(converted[keycloakArgsKey], &comps.Keycloak.InstallOverrides, false)
+		comps.Keycloak.MySQL.MySQLInstallArgs = restoreArgs(converted[mysqlArgsKey], &comps.Keycloak.MySQL.InstallOverrides, false)
+	}
+	return nil
+}
+
+// convertJSON converts a value to a value of another type that has the same JSON representation
+func convertJSON(src interface{}, dst interface{}) error {
+	data, err := json.Marshal(src)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, dst)
+}
+
+// convertArgsToOverride converts install args to a values override and adds it as the first or the last override of
+// the component, and returns the values
+func convertArgsToOverride(args []InstallArgs, toValues func([]InstallArgs) (map[string]interface{}, error), overrides *v1beta1.InstallOverrides, first bool) (*apiextensionsv1.JSON, error) {
+	values, err := toValues(args)
+	if err != nil {
+		return nil, err
+	}
+	if len(values) == 0 {
+		return nil, nil
+	}
+	data, err := json.Marshal(values)
+	if err != nil {
+		return nil, err
+	}
+	override := v1beta1.Overrides{Values: &apiextensionsv1.JSON{Raw: data}}
+	if first {
+		overrides.ValueOverrides = append([]v1beta1.Overrides{override}, overrides.ValueOverrides...)
+	} else {
+		overrides.ValueOverrides = append(overrides.ValueOverrides, override)
+	}
+	return override.Values, nil
+}
+
+// restoreArgs returns the install args that were converted to a values override, and removes the override.  No install
+// args are returned if the override was changed or removed, the override is then kept.
+func restoreArgs(converted convertedInstallArgs, overrides *InstallOverrides, first bool) []InstallArgs {
+	if len(converted.InstallArgs) == 0 {
+		return nil
+	}
+	if converted.Values == nil {
+		// All the install args were converted to fields
+		return converted.InstallArgs
+	}
+	count := len(overrides.ValueOverrides)
+	if count == 0 {
+		return nil
+	}
+	index := count - 1
+	if first {
+		index = 0
+	}
+	override := overrides.ValueOverrides[index]
+	if override.ConfigMapRef != nil || override.SecretRef != nil || !isSameJSON(override.Values, converted.Values) {
+		return nil
+	}
+	overrides.ValueOverrides = append(overrides.ValueOverrides[:index], overrides.ValueOverrides[index+1:]...)
+	if len(overrides.ValueOverrides) == 0 {
+		overrides.ValueOverrides = nil
+	}
+	return converted.InstallArgs
+}
+
+// isSameJSON returns true if the JSON values are semantically equal
+func isSameJSON(a *apiextensionsv1.JSON, b *apiextensionsv1.JSON) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	var aValue, bValue interface{}
+	if err := json.Unmarshal(a.Raw, &aValue); err != nil {
+		return false
+	}
+	if err := json.Unmarshal(b.Raw, &bValue); err != nil {
+		return false
+	}
+	return reflect.DeepEqual(aValue, bValue)
+}
+
+// splitExternalIPsArg returns the install args without the external IPs arg, and the external IPs
+func splitExternalIPsArg(args []InstallArgs, name string) ([]InstallArgs, []string) {
+	var others []InstallArgs
+	var externalIPs []string
+	for _, arg := range args {
+		if arg.Name != name {
+			others = append(others, arg)
+			continue
+		}
+		externalIPs = arg.ValueList
+		if len(externalIPs) == 0 && len(arg.Value) > 0 {
+			externalIPs = []string{arg.Value}
+		}
+	}
+	return others, externalIPs
+}
+
+// setExternalIPsArg sets the external IPs arg in the install args, replacing an existing arg, or removes the arg if
+// there are no external IPs
+func setExternalIPsArg(args []InstallArgs, name string, externalIPs []string) []InstallArgs {
+	var result []InstallArgs
+	found := false
+	for _, arg := range args {
+		if arg.Name != name {
+			result = append(result, arg)
+			continue
+		}
+		if len(externalIPs) > 0 && !found {
+			if !reflect.DeepEqual(arg.ValueList, externalIPs) && !reflect.DeepEqual([]string{arg.Value}, externalIPs) {
+				arg = InstallArgs{Name: name, ValueList: externalIPs}
+			}
+			result = append(result, arg)
+			found = true
+		}
+	}
+	if len(externalIPs) > 0 && !found {
+		result = append(result, InstallArgs{Name: name, ValueList: externalIPs})
+	}
+	return result
+}
+
+// helmArgsToValues converts install args to the Helm values they are set to with the --set and --set-string Helm
+// arguments
+func helmArgsToValues(args []InstallArgs) (map[string]interface{}, error) {
+	values := map[string]interface{}{}
+	for _, arg := range args {
+		if len(arg.Value) > 0 {
+			var value interface{} = arg.Value
+			if !arg.SetString {
+				value = typedValue(arg.Value)
+			}
+			if err := setValue(values, arg.Name, value); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		for i, item := range arg.ValueList {
+			if err := setValue(values, fmt.Sprintf("%s[%d]", arg.Name, i), typedValue(item)); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return values, nil
+}
+
+// istioArgsToValues converts install args to an IstioOperator, where each arg is a value of the IstioOperator
+// spec.values
+func istioArgsToValues(args []InstallArgs) (map[string]interface{}, error) {
+	values := map[string]interface{}{}
+	for _, arg := range args {
+		var value interface{}
+		switch {
+		case len(arg.ValueList) > 1:
+			var list []interface{}
+			for _, item := range arg.ValueList {
+				list = append(list, typedValue(item))
+			}
+			value = list
+		case len(arg.ValueList) == 1:
+			value = typedValue(arg.ValueList[0])
+		default:
+			value = typedValue(arg.Value)
+		}
+		if err := setValue(values, arg.Name, value); err != nil {
+			return nil, err
+		}
+	}
+	if len(values) == 0 {
+		return values, nil
+	}
+	return map[string]interface{}{
+		"apiVersion": "install.istio.io/v1alpha1",
+		"kind":       "IstioOperator",
+		"spec": map[string]interface{}{
+			"values": values,
+		},
+	}, nil
+}
+
+// typedValue returns the value of an install arg with the type Helm gives to a --set value
+func typedValue(value string) interface{} {
+	switch strings.ToLower(value) {
+	case "true":
+		return true
+	case "false":
+		return false
+	case "null":
+		return nil
+	}
+	// Leading zeros are kept as a string
+	if value == "0" || !strings.HasPrefix(value, "0") {
+		if i, err := strconv.ParseInt(value, 10, 64); err == nil {
+			return i
+		}
+	}
+	return value
+}
+
+// setValue sets a value in nested values at the path of an install arg name, for example
+// controller.service.annotations.service\.beta\.kubernetes\.io/oci-load-balancer-shape or nodes[0].name
+func setValue(values map[string]interface{}, name string, value interface{}) error {
+	segments, err := splitArgName(name)
+	if err != nil {
+		return err
+	}
+	current := values
+	for i, segment := range segments {
+		key, index, err := parseSegment(segment)
+		if err != nil {
+			return fmt.Errorf("Invalid install arg name %s: %v", name, err)
+		}
+		last := i == len(segments)-1
+		if index < 0 {
+			if last {
+				current[key] = value
+				return nil
+			}
+			next, ok := current[key].(map[string]interface{})
+			if !ok {
+				next = map[string]interface{}{}
+				current[key] = next
+			}
+			current = next
+			continue
+		}
+		list, _ := current[key].([]interface{})
+		for len(list) <= index {
+			list = append(list, nil)
+		}
+		current[key] = list
+		if last {
+			list[index] = value
+			return nil
+		}
+		next, ok := list[index].(map[string]interface{})
+		if !ok {
+			next = map[string]interface{}{}
+			list[index] = next
+		}
+		current = next
+	}
+	return nil
+}
+
+// splitArgName splits an install arg name at the dots, except for dots that are escaped with a backslash or are in a
+// quoted part of the name
+func splitArgName(name string) ([]string, error) {
+	var segments []string
+	var b strings.Builder
+	quoted := false
+	for i := 0; i < len(name); i++ {
+		c := name[i]
+		switch {
+		case c == '\\' && i+1 < len(name) && name[i+1] == '.':
+			b.WriteByte('.')
+			i++
+		case c == '"':
+			quoted = !quoted
+		case c == '.' && !quoted:
+			segments = append(segments, b.String())
+			b.Reset()
+		default:
+			b.WriteByte(c)
+		}
+	}
+	segments = append(segments, b.String())
+	for _, segment := range segments {
+		if len(segment) == 0 {
+			return nil, fmt.Errorf("Invalid install arg name %s", name)
+		}
+	}
+	return segments, nil
+}
+
+// parseSegment returns the key and the list index of a segment of an install arg name, the index is -1 when the
+// segment is not a list element
+func parseSegment(segment string) (string, int, error) {
+	open := strings.Index(segment, "[")
+	if open < 0 || !strings.HasSuffix(segment, "]") {
+		return segment, -1, nil
+	}
+	index, err := strconv.Atoi(segment[open+1 : len(segment)-1])
+	if err != nil || index < 0 {
+		return "", 0, fmt.Errorf("invalid list index in %s", segment)
+	}
+	return segment[:open], index, nil
+}
+
+// overridesToArgs converts values overrides to install args, one for each value
+func overridesToArgs(overrides []Overrides) ([]InstallArgs, error) {
+	var args []InstallArgs
+	for _, override := range overrides {
+		if override.ConfigMapRef != nil || override.SecretRef != nil {
+			return nil, fmt.Errorf("Only values overrides are supported for the Elasticsearch component")
+		}
+		if override.Values == nil {
+			continue
+		}
+		var values map[string]interface{}
+		if err := json.Unmarshal(override.Values.Raw, &values); err != nil {
+			return nil, err
+		}
+		args = append(args, flattenValues("", values)...)
+	}
+	return args, nil
+}
+
+// flattenValues returns an install arg for each value in nested values, in the order of the names
+func flattenValues(prefix string, values map[string]interface{}) []InstallArgs {
+	var keys []string
+	for key := range values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	var args []InstallArgs
+	for _, key := range keys {
+		name := strings.ReplaceAll(key, ".", "\\.")
+		if len(prefix) > 0 {
+			name = prefix + "." + name
+		}
+		switch value := values[key].(type) {
+		case map[string]interface{}:
+			args = append(args, flattenValues(name, value)...)
+		case []interface{}:
+			var list []string
+			for _, item := range value {
+				list = append(list, fmt.Sprint(item))
+			}
+			args = append(args, InstallArgs{Name: name, ValueList: list})
+		case nil:
+			args = append(args, InstallArgs{Name: name, Value: "null"})
+		default:
+			args = append(args, InstallArgs{Name: name, Value: fmt.Sprint(value)})
+		}
+	}
+	return args
+}
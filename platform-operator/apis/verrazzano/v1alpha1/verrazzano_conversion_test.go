@@ -0,0 +1,205 @@
+// Copyright (c) 2022, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package v1alpha1
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/verrazzano/verrazzano/platform-operator/apis/verrazzano/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// newConversionTestVerrazzano returns a v1alpha1 Verrazzano resource with install args for every component that has them
+func newConversionTestVerrazzano() *Verrazzano {
+	return &Verrazzano{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "verrazzano", Labels: map[string]string{"app": "test"}},
+		Spec: VerrazzanoSpec{
+			Profile: Dev,
+			Components: ComponentSpec{
+				Verrazzano: &VerrazzanoComponent{
+					InstallArgs: []InstallArgs{{Name: "console.enabled", Value: "false"}},
+				},
+				Elasticsearch: &ElasticsearchComponent{
+					ESInstallArgs: []InstallArgs{
+						{Name: "nodes.master.replicas", Value: "3"},
+						{Name: "nodes.master.requests.memory", Value: "1G"},
+					},
+				},
+				Ingress: &IngressNginxComponent{
+					Type: NodePort,
+					NGINXInstallArgs: []InstallArgs{
+						{Name: "controller.service.annotations.service\\.beta\\.kubernetes\\.io/oci-load-balancer-shape", Value: "10Mbps"},
+						{Name: nginxExternalIPsArg, ValueList: []string{"1.2.3.4"}},
+						{Name: "controller.replicaCount", Value: "2", SetString: true},
+					},
+				},
+				Istio: &IstioComponent{
+					IstioInstallArgs: []InstallArgs{
+						{Name: "global.proxy.resources.requests.cpu", Value: "100m"},
+						{Name: istioExternalIPsArg, ValueList: []string{"5.6.7.8"}},
+					},
+				},
+				Keycloak: &KeycloakComponent{
+					KeycloakInstallArgs: []InstallArgs{{Name: "replicas", Value: "2"}},
+					MySQL: MySQLComponent{
+						MySQLInstallArgs: []InstallArgs{{Name: "persistence.enabled", Value: "true"}},
+						InstallOverrides: InstallOverrides{
+							ValueOverrides: []Overrides{{ConfigMapRef: &corev1.ConfigMapKeySelector{Key: "values.yaml"}}},
+						},
+					},
+				},
+			},
+		},
+		Status: VerrazzanoStatus{State: VzStateReady, Version: "1.4.0"},
+	}
+}
+
+// assertValues asserts that an override has the values of the JSON string
+func assertValues(t *testing.T, expected string, override v1beta1.Overrides) {
+	assert.NotNil(t, override.Values)
+	var expectedValues, actualValues interface{}
+	assert.NoError(t, json.Unmarshal([]byte(expected), &expectedValues))
+	assert.NoError(t, json.Unmarshal(override.Values.Raw, &actualValues))
+	assert.Equal(t, expectedValues, actualValues)
+}
+
+// TestConvertTo tests the ConvertTo function
+// GIVEN a v1alpha1 Verrazzano resource with install args
+// WHEN the resource is converted to v1beta1
+// THEN the install args are converted to values overrides and the external IPs fields
+func TestConvertTo(t *testing.T) {
+	asserts := assert.New(t)
+	vz := newConversionTestVerrazzano()
+	dst := &v1beta1.Verrazzano{}
+	asserts.NoError(vz.ConvertTo(dst))
+
+	asserts.Equal("verrazzano", dst.Name)
+	asserts.Equal("test", dst.Labels["app"])
+	asserts.Contains(dst.Annotations, ConvertedInstallArgsAnnotation)
+	asserts.Equal(v1beta1.Dev, dst.Spec.Profile)
+	asserts.Equal(v1beta1.VzStateReady, dst.Status.State)
+
+	comps := dst.Spec.Components
+	asserts.Len(comps.Verrazzano.ValueOverrides, 1)
+	assertValues(t, `{"console": {"enabled": false}}`, comps.Verrazzano.ValueOverrides[0])
+	asserts.Len(comps.Elasticsearch.ValueOverrides, 1)
+	assertValues(t, `{"nodes": {"master": {"replicas": 3, "requests": {"memory": "1G"}}}}`, comps.Elasticsearch.ValueOverrides[0])
+
+	asserts.Equal([]string{"1.2.3.4"}, comps.Ingress.ExternalIPs)
+	asserts.Len(comps.Ingress.ValueOverrides, 1)
+	assertValues(t, `{"controller": {"replicaCount": "2", "service": {"annotations": {"service.beta.kubernetes.io/oci-load-balancer-shape": "10Mbps"}}}}`, comps.Ingress.ValueOverrides[0])
+
+	asserts.Equal([]string{"5.6.7.8"}, comps.Istio.Ingress.ExternalIPs)
+	asserts.Len(comps.Istio.ValueOverrides, 1)
+	assertValues(t, `{"apiVersion": "install.istio.io/v1alpha1", "kind": "IstioOperator", "spec": {"values": {"global": {"proxy": {"resources": {"requests": {"cpu": "100m"}}}}}}}`, comps.Istio.ValueOverrides[0])
+
+	asserts.Len(comps.Keycloak.ValueOverrides, 1)
+	assertValues(t, `{"replicas": 2}`, comps.Keycloak.ValueOverrides[0])
+	// The converted override is the last one, since the install args take precedence over the other overrides
+	asserts.Len(comps.Keycloak.MySQL.ValueOverrides, 2)
+	asserts.NotNil(comps.Keycloak.MySQL.ValueOverrides[0].ConfigMapRef)
+	assertValues(t, `{"persistence": {"enabled": true}}`, comps.Keycloak.MySQL.ValueOverrides[1])
+}
+
+// TestConvertRoundTrip tests the ConvertTo and ConvertFrom functions
+// GIVEN a v1alpha1 Verrazzano resource with install args
+// WHEN the resource is converted to v1beta1 and back to v1alpha1
+// THEN the resource is the same as the original resource
+func TestConvertRoundTrip(t *testing.T) {
+	asserts := assert.New(t)
+	vz := newConversionTestVerrazzano()
+	hub := &v1beta1.Verrazzano{}
+	asserts.NoError(vz.ConvertTo(hub))
+	actual := &Verrazzano{}
+	asserts.NoError(actual.ConvertFrom(hub))
+	asserts.Equal(vz, actual)
+}
+
+// TestConvertFromChangedOverrides tests the ConvertFrom function
+// GIVEN a v1beta1 Verrazzano resource that was converted from v1alpha1 install args, and then changed
+// WHEN the resource is converted to v1alpha1
+// THEN the changed overrides are kept, and the external IPs and the Elasticsearch overrides are converted to install args
+func TestConvertFromChangedOverrides(t *testing.T) {
+	asserts := assert.New(t)
+	hub := &v1beta1.Verrazzano{}
+	asserts.NoError(newConversionTestVerrazzano().ConvertTo(hub))
+	hub.Spec.Components.Verrazzano.ValueOverrides[0].Values = &apiextensionsv1.JSON{Raw: []byte(`{"console": {"enabled": true}}`)}
+	hub.Spec.Components.Ingress.ExternalIPs = []string{"4.3.2.1"}
+	hub.Spec.Components.Istio.Ingress.ExternalIPs = nil
+	hub.Spec.Components.Elasticsearch.ValueOverrides = append(hub.Spec.Components.Elasticsearch.ValueOverrides,
+		v1beta1.Overrides{Values: &apiextensionsv1.JSON{Raw: []byte(`{"nodes": {"data": {"replicas": 2}}}`)}})
+
+	actual := &Verrazzano{}
+	asserts.NoError(actual.ConvertFrom(hub))
+	comps := actual.Spec.Components
+	asserts.Empty(comps.Verrazzano.InstallArgs)
+	asserts.Len(comps.Verrazzano.ValueOverrides, 1)
+	asserts.Contains(comps.Ingress.NGINXInstallArgs, InstallArgs{Name: nginxExternalIPsArg, ValueList: []string{"4.3.2.1"}})
+	asserts.Len(comps.Ingress.NGINXInstallArgs, 3)
+	asserts.Equal([]InstallArgs{{Name: "global.proxy.resources.requests.cpu", Value: "100m"}}, comps.Istio.IstioInstallArgs)
+	asserts.Nil(comps.Istio.Ingress)
+	asserts.Equal([]InstallArgs{
+		{Name: "nodes.master.replicas", Value: "3"},
+		{Name: "nodes.master.requests.memory", Value: "1G"},
+		{Name: "nodes.data.replicas", Value: "2"},
+	}, comps.Elasticsearch.ESInstallArgs)
+}
+
+// TestConvertFromElasticsearchRefs tests the ConvertFrom function
+// GIVEN a v1beta1 Verrazzano resource with a ConfigMap override for Elasticsearch
+// WHEN the resource is converted to v1alpha1
+// THEN an error is returned since v1alpha1 only supports install args for Elasticsearch
+func TestConvertFromElasticsearchRefs(t *testing.T) {
+	hub := &v1beta1.Verrazzano{
+		Spec: v1beta1.VerrazzanoSpec{
+			Components: v1beta1.ComponentSpec{
+				Elasticsearch: &v1beta1.ElasticsearchComponent{
+					InstallOverrides: v1beta1.InstallOverrides{
+						ValueOverrides: []v1beta1.Overrides{{ConfigMapRef: &corev1.ConfigMapKeySelector{Key: "values.yaml"}}},
+					},
+				},
+			},
+		},
+	}
+	assert.Error(t, (&Verrazzano{}).ConvertFrom(hub))
+}
+
+// TestHelmArgsToValues tests the helmArgsToValues function
+// GIVEN install args with typed values, string values, lists and list indexes
+// WHEN the install args are converted to values
+// THEN the values have the types Helm gives to the --set arguments
+func TestHelmArgsToValues(t *testing.T) {
+	values, err := helmArgsToValues([]InstallArgs{
+		{Name: "a.bool", Value: "True"},
+		{Name: "a.int", Value: "10"},
+		{Name: "a.zero", Value: "0"},
+		{Name: "a.leadingZero", Value: "007"},
+		{Name: "a.string", Value: "10", SetString: true},
+		{Name: "a.null", Value: "null"},
+		{Name: "a.list", ValueList: []string{"x", "1"}},
+		{Name: "a.items[1].name", Value: "second"},
+		{Name: `a."b.c"`, Value: "quoted"},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{
+		"a": map[string]interface{}{
+			"bool":        true,
+			"int":         int64(10),
+			"zero":        int64(0),
+			"leadingZero": "007",
+			"string":      "10",
+			"null":        nil,
+			"list":        []interface{}{"x", int64(1)},
+			"items":       []interface{}{nil, map[string]interface{}{"name": "second"}},
+			"b.c":         "quoted",
+		},
+	}, values)
+
+	_, err = helmArgsToValues([]InstallArgs{{Name: "a..b", Value: "x"}})
+	assert.Error(t, err)
+}
@@ -75,6 +75,22 @@ type VerrazzanoSpec struct {
 	// +optional
 	Security SecuritySpec `json:"security,omitempty"`
 
+	// UpgradeRollback specifies the policy for rolling back components that fail to upgrade
+	// +optional
+	UpgradeRollback *UpgradeRollbackSpec `json:"upgradeRollback,omitempty"`
+
+	// ComponentTimeouts overrides how long the install or upgrade of a component can take before the component is failed
+	// +optional
+	ComponentTimeouts []ComponentTimeout `json:"componentTimeouts,omitempty"`
+
+	// DriftDetection specifies the policy for detecting and remediating configuration drift of the Helm based components
+	// +optional
+	DriftDetection *DriftDetectionSpec `json:"driftDetection,omitempty"`
+
+	// HealthCheck specifies how the installed components are checked for health
+	// +optional
+	HealthCheck *HealthCheckSpec `json:"healthCheck,omitempty"`
+
 	// DefaultVolumeSource Defines the type of volume to be used for persistence, if not explicitly declared by a component;
 	// at present only EmptyDirVolumeSource or PersistentVolumeClaimVolumeSource are supported. If PersistentVolumeClaimVolumeSource
 	// is used, it must reference a VolumeClaimSpecTemplate in the VolumeClaimSpecTemplates section.
@@ -106,6 +122,159 @@ type SecuritySpec struct {
 	// MonitorSubjects specifies subjects that should be bound to the verrazzano-monitor role
 	// +optional
 	MonitorSubjects []rbacv1.Subject `json:"monitorSubjects,omitempty"`
+	// Identity specifies Keycloak realms, groups, roles, users and OIDC clients that Verrazzano creates and keeps
+	// up to date in addition to its own Keycloak configuration
+	// +optional
+	Identity *IdentitySpec `json:"identity,omitempty"`
+}
+
+// IdentitySpec defines the Keycloak content declared in the Verrazzano resource.  Objects removed from the
+// declaration are not deleted from Keycloak.
+type IdentitySpec struct {
+	// Realms specifies the Keycloak realms.  The verrazzano-system realm can be listed to add content to it but
+	// cannot be disabled, the master realm cannot be listed.
+	// +optional
+	// +patchMergeKey=name
+	// +patchStrategy=merge,retainKeys
+	Realms []IdentityRealm `json:"realms,omitempty" patchStrategy:"merge,retainKeys" patchMergeKey:"name"`
+}
+
+// IdentityRealm defines a Keycloak realm and its content
+type IdentityRealm struct {
+	// Name of the realm
+	Name string `json:"name"`
+	// Enabled specifies whether users can log into the realm.  Default is true.
+	// +optional
+	Enabled *bool `json:"enabled,omitempty"`
+	// PasswordPolicy is the Keycloak password policy of the realm, like "length(12) and notUsername"
+	// +optional
+	PasswordPolicy string `json:"passwordPolicy,omitempty"`
+	// LoginTheme is the name of the Keycloak login theme of the realm
+	// +optional
+	LoginTheme string `json:"loginTheme,omitempty"`
+	// Roles specifies the realm roles
+	// +optional
+	Roles []IdentityRole `json:"roles,omitempty"`
+	// Groups specifies the groups and the realm roles mapped to them
+	// +optional
+	Groups []IdentityGroup `json:"groups,omitempty"`
+	// Users specifies the users of the realm
+	// +optional
+	Users []IdentityUser `json:"users,omitempty"`
+	// Clients specifies the OpenID Connect clients of the realm
+	// +optional
+	Clients []OIDCClient `json:"clients,omitempty"`
+}
+
+// IdentityRole defines a Keycloak realm role
+type IdentityRole struct {
+	// Name of the role
+	Name string `json:"name"`
+	// Description of the role
+	// +optional
+	Description string `json:"description,omitempty"`
+}
+
+// IdentityGroup defines a Keycloak group
+type IdentityGroup struct {
+	// Path of the group, like /my-users/my-admins.  The parent groups are created when they are not declared.
+	Path string `json:"path"`
+	// Roles are the names of the realm roles mapped to the group
+	// +optional
+	Roles []string `json:"roles,omitempty"`
+}
+
+// IdentityUser defines a Keycloak user
+type IdentityUser struct {
+	// Username of the user
+	Username string `json:"username"`
+	// Enabled specifies whether the user can log in.  Default is true.
+	// +optional
+	Enabled *bool `json:"enabled,omitempty"`
+	// Groups are the paths of the groups the user is a member of when the user is created
+	// +optional
+	Groups []string `json:"groups,omitempty"`
+	// PasswordSecret is the name of a secret in the namespace of the Verrazzano resource with the initial
+	// password of the user in the password key.  The password is only set when the user is created.
+	// +optional
+	PasswordSecret string `json:"passwordSecret,omitempty"`
+}
+
+// OIDCClient defines a Keycloak OpenID Connect client
+type OIDCClient struct {
+	// ClientID is the OpenID Connect client ID
+	ClientID string `json:"clientId"`
+	// PublicClient specifies whether the client is public.  A confidential client authenticates with a secret.
+	// Default is false.
+	// +optional
+	PublicClient bool `json:"publicClient,omitempty"`
+	// RedirectURIs are the valid redirect URIs of the client
+	// +optional
+	RedirectURIs []string `json:"redirectUris,omitempty"`
+	// WebOrigins are the allowed CORS origins of the client
+	// +optional
+	WebOrigins []string `json:"webOrigins,omitempty"`
+	// StandardFlowEnabled turns on the authorization code flow.  Default is true.
+	// +optional
+	StandardFlowEnabled *bool `json:"standardFlowEnabled,omitempty"`
+	// DirectAccessGrantsEnabled turns on the resource owner password credentials grant.  Default is false.
+	// +optional
+	DirectAccessGrantsEnabled bool `json:"directAccessGrantsEnabled,omitempty"`
+	// SecretName is the name of a secret in the namespace of the Verrazzano resource with the secret of a
+	// confidential client in the clientSecret key.  Keycloak generates the secret when it is not specified.
+	// +optional
+	SecretName string `json:"secretName,omitempty"`
+}
+
+// UpgradeRollbackSpec defines the policy for rolling back a Helm based component to its previous release revision
+// when the component upgrade fails
+type UpgradeRollbackSpec struct {
+	// Enabled turns on rolling back Helm based components to their previous release revision when the upgrade fails.  Default is false.
+	// +optional
+	Enabled bool `json:"enabled,omitempty"`
+	// MaxUpgradeAttempts is the number of failed upgrade attempts of a component before it is rolled back.  Default is 3.
+	// +optional
+	MaxUpgradeAttempts int `json:"maxUpgradeAttempts,omitempty"`
+	// ReadyTimeout is how long to wait for an upgraded component to be ready before it is rolled back.  Default is 15m.
+	// +optional
+	ReadyTimeout *metav1.Duration `json:"readyTimeout,omitempty"`
+}
+
+// ComponentTimeout defines how long the install and upgrade of a component can take before the component is failed
+type ComponentTimeout struct {
+	// Name of the component, for example keycloak
+	Name string `json:"name"`
+	// Install is how long the component install can take.  The default depends on the component, it is 30m for most components.
+	// +optional
+	Install *metav1.Duration `json:"install,omitempty"`
+	// Upgrade is how long the component upgrade can take.  The default depends on the component, it is 30m for most components.
+	// +optional
+	Upgrade *metav1.Duration `json:"upgrade,omitempty"`
+}
+
+// DriftDetectionSpec defines how the Helm based components are checked for configuration drift, that is for release
+// values and workloads that no longer match the configuration of the Verrazzano resource
+type DriftDetectionSpec struct {
+	// Enabled turns on the periodic drift detection of the components that are ready.  Default is true.
+	// +optional
+	Enabled *bool `json:"enabled,omitempty"`
+	// Interval is how often each component is checked for drift.  Default is 10m.
+	// +optional
+	Interval *metav1.Duration `json:"interval,omitempty"`
+	// AutoRemediate turns on reinstalling a component with the configuration of the Verrazzano resource when drift is
+	// detected.  Default is false.
+	// +optional
+	AutoRemediate bool `json:"autoRemediate,omitempty"`
+}
+
+// HealthCheckSpec defines how the installed components are checked for health after they are ready
+type HealthCheckSpec struct {
+	// Enabled turns on the periodic health check of the installed components.  Default is true.
+	// +optional
+	Enabled *bool `json:"enabled,omitempty"`
+	// Interval is how often each component is checked for health.  Default is 1m.
+	// +optional
+	Interval *metav1.Duration `json:"interval,omitempty"`
 }
 
 // VolumeClaimSpecTemplate Contains common PVC configuration that can be referenced from Components; these
@@ -169,6 +338,12 @@ type ComponentStatusDetails struct {
 	LastReconciledGeneration int64 `json:"lastReconciledGeneration,omitempty"`
 	// The generation of the VZ resource the Component is currently being reconciled against
 	ReconcilingGeneration int64 `json:"reconcilingGeneration,omitempty"`
+	// The time the Component started installing, used to enforce the install timeout
+	InstallStartTime *metav1.Time `json:"installStartTime,omitempty"`
+	// The time the Component was last checked for configuration drift
+	LastDriftCheckTime *metav1.Time `json:"lastDriftCheckTime,omitempty"`
+	// The time the Component was last checked for health
+	LastHealthCheckTime *metav1.Time `json:"lastHealthCheckTime,omitempty"`
 }
 
 // ConditionType identifies the condition of the install/uninstall/upgrade which can be checked with kubectl wait
@@ -207,6 +382,18 @@ const (
 
 	// CondUpgradeComplete means the upgrade has completed successfully
 	CondUpgradeComplete ConditionType = "UpgradeComplete"
+
+	// CondUpgradeRolledBack means a failed component upgrade was rolled back to the previous release revision
+	CondUpgradeRolledBack ConditionType = "UpgradeRolledBack"
+
+	// CondPreflightFailed means the upgrade is blocked because upgrade preflight checks failed
+	CondPreflightFailed ConditionType = "PreflightFailed"
+
+	// CondConfigurationDrift means the deployed configuration of a component no longer matches the Verrazzano resource
+	CondConfigurationDrift ConditionType = "ConfigurationDrift"
+
+	// CondDegraded means an installed component is no longer healthy
+	CondDegraded ConditionType = "Degraded"
 )
 
 // Condition describes current state of an install.
@@ -244,6 +431,9 @@ const (
 
 	// VzStateReconciling is the state when a resource is in progress reconciling
 	VzStateReconciling VzStateType = "Reconciling"
+
+	// VzStateDegraded is the state when Verrazzano is installed but one or more components are degraded
+	VzStateDegraded VzStateType = "Degraded"
 )
 
 // CompStateType identifies the state of a component
@@ -273,8 +463,22 @@ const (
 
 	// CompStateFailed is the state when an install/uninstall/upgrade has failed
 	CompStateFailed CompStateType = "Failed"
+
+	// CompStateDegraded is the state when an installed component that was ready is no longer healthy
+	CompStateDegraded CompStateType = "Degraded"
 )
 
+// IsVzInstalledState returns true if the Verrazzano state is one of the states of an installed Verrazzano that is not
+// being reconciled
+func IsVzInstalledState(state VzStateType) bool {
+	return state == VzStateReady || state == VzStateDegraded
+}
+
+// IsComponentInstalledState returns true if the component state is one of the states of an installed component
+func IsComponentInstalledState(state CompStateType) bool {
+	return state == CompStateReady || state == CompStateDegraded
+}
+
 // ComponentSpec contains a set of components used by Verrazzano
 type ComponentSpec struct {
 	// CertManager contains the CertManager component configuration
@@ -289,6 +493,10 @@ type ComponentSpec struct {
 	// +optional
 	ApplicationOperator *ApplicationOperatorComponent `json:"applicationOperator,omitempty"`
 
+	// Alertmanager configuration
+	// +optional
+	Alertmanager *AlertmanagerComponent `json:"alertmanager,omitempty"`
+
 	// AuthProxy configuration
 	// +optional
 	AuthProxy *AuthProxyComponent `json:"authProxy,omitempty"`
@@ -391,6 +599,9 @@ type ElasticsearchComponent struct {
 	ESInstallArgs []InstallArgs                 `json:"installArgs,omitempty" patchStrategy:"merge,retainKeys" patchMergeKey:"name"`
 	Policies      []vmov1.IndexManagementPolicy `json:"policies,omitempty"`
 	Nodes         []OpenSearchNode              `json:"nodes,omitempty"`
+	// Snapshot specifies the snapshot repository of OpenSearch and the snapshots that are taken on a schedule
+	// +optional
+	Snapshot *OpenSearchSnapshot `json:"snapshot,omitempty"`
 }
 
 //OpenSearchNode specifies a node group in the OpenSearch cluster
@@ -406,6 +617,44 @@ type OpenSearchNodeStorage struct {
 	Size string `json:"size"`
 }
 
+// OpenSearchSnapshot defines an S3 compatible snapshot repository that is registered with OpenSearch, and the
+// snapshots of the indices that are taken on a schedule
+type OpenSearchSnapshot struct {
+	// Repository is the S3 compatible snapshot repository
+	Repository OpenSearchSnapshotRepository `json:"repository"`
+	// Schedule of the snapshots in cron format, like "0 3 * * *".  No snapshots are taken if it is empty.
+	// +optional
+	Schedule string `json:"schedule,omitempty"`
+	// RetentionCount is the number of scheduled snapshots kept in the repository.  Default is 7.  Snapshots that
+	// are not taken on the schedule are never deleted.
+	// +optional
+	RetentionCount *int32 `json:"retentionCount,omitempty"`
+	// Indices are the index patterns included in the scheduled snapshots.  Default is all of the indices.
+	// +optional
+	Indices []string `json:"indices,omitempty"`
+}
+
+// OpenSearchSnapshotRepository defines the S3 compatible bucket of the OpenSearch snapshots
+type OpenSearchSnapshotRepository struct {
+	// Name of the repository in OpenSearch.  Default is "verrazzano".
+	// +optional
+	Name string `json:"name,omitempty"`
+	// Endpoint is the URL of the S3 compatible service, like https://objectstorage.example.com.  The bucket is
+	// addressed with path style access, as required by MinIO.
+	Endpoint string `json:"endpoint"`
+	// Bucket is the name of the bucket
+	Bucket string `json:"bucket"`
+	// BasePath is the folder of the snapshots in the bucket
+	// +optional
+	BasePath string `json:"basePath,omitempty"`
+	// Region is the region of the bucket, required by some S3 compatible services
+	// +optional
+	Region string `json:"region,omitempty"`
+	// CredentialsSecret is the name of a secret in the verrazzano-install namespace with the accessKey and
+	// secretKey keys.  OpenSearch loads the credentials when its pods start.
+	CredentialsSecret string `json:"credentialsSecret"`
+}
+
 // KibanaComponent specifies the Kibana configuration.
 type KibanaComponent struct {
 	// +optional
@@ -445,6 +694,77 @@ type PrometheusNodeExporterComponent struct {
 	InstallOverrides `json:",inline"`
 }
 
+// AlertmanagerComponent specifies the Alertmanager configuration.  Alertmanager receives the alerts of the
+// Prometheus Operator and sends them to the receivers.
+type AlertmanagerComponent struct {
+	// +optional
+	Enabled *bool `json:"enabled,omitempty"`
+	// Receivers are the destinations of the alerts.  An alert is sent to every receiver whose severities match the
+	// severity of the alert.
+	// +optional
+	// +patchMergeKey=name
+	// +patchStrategy=merge,retainKeys
+	Receivers []AlertmanagerReceiver `json:"receivers,omitempty" patchStrategy:"merge,retainKeys" patchMergeKey:"name"`
+	// DefaultRules enables the alert rules of the Verrazzano components.  Default is true.
+	// +optional
+	DefaultRules     *bool `json:"defaultRules,omitempty"`
+	InstallOverrides `json:",inline"`
+}
+
+// AlertmanagerReceiver defines a destination of the alerts, exactly one of webhook, email and local is specified
+type AlertmanagerReceiver struct {
+	// Name of the receiver
+	Name string `json:"name"`
+	// Severities are the severities of the alerts that are sent to the receiver, like critical or warning.  Default
+	// is all of the alerts.
+	// +optional
+	Severities []string `json:"severities,omitempty"`
+	// SendResolved sends a notification when an alert is resolved.  Default is true.
+	// +optional
+	SendResolved *bool `json:"sendResolved,omitempty"`
+	// Webhook posts the alerts to an HTTP endpoint
+	// +optional
+	Webhook *AlertmanagerWebhook `json:"webhook,omitempty"`
+	// Email sends the alerts through an SMTP server
+	// +optional
+	Email *AlertmanagerEmail `json:"email,omitempty"`
+	// Local keeps the alerts in Alertmanager without sending them, they are read with the Alertmanager API.  It
+	// stands in for a webhook in tests.
+	// +optional
+	Local *AlertmanagerLocal `json:"local,omitempty"`
+}
+
+// AlertmanagerWebhook defines an HTTP endpoint that the alerts are posted to
+type AlertmanagerWebhook struct {
+	// URL of the endpoint
+	URL string `json:"url"`
+	// CredentialsSecret is the name of a secret in the verrazzano-install namespace with the username and password
+	// keys, that are sent with basic authentication
+	// +optional
+	CredentialsSecret string `json:"credentialsSecret,omitempty"`
+}
+
+// AlertmanagerEmail defines the SMTP server and the addresses of the alert emails
+type AlertmanagerEmail struct {
+	// To are the addresses that the alerts are sent to
+	To []string `json:"to"`
+	// From is the sender address
+	From string `json:"from"`
+	// Smarthost is the host and port of the SMTP server, like smtp.example.com:587
+	Smarthost string `json:"smarthost"`
+	// CredentialsSecret is the name of a secret in the verrazzano-install namespace with the username and password
+	// keys of the SMTP server
+	// +optional
+	CredentialsSecret string `json:"credentialsSecret,omitempty"`
+	// RequireTLS requires STARTTLS with the SMTP server.  Default is true.
+	// +optional
+	RequireTLS *bool `json:"requireTLS,omitempty"`
+}
+
+// AlertmanagerLocal keeps the alerts in Alertmanager, it has no settings
+type AlertmanagerLocal struct {
+}
+
 // PrometheusOperatorComponent specifies the Prometheus Operator configuration
 type PrometheusOperatorComponent struct {
 	// +optional
@@ -647,10 +967,54 @@ type MySQLComponent struct {
 	// is used, it must reference a VolumeClaimSpecTemplate in the VolumeClaimSpecTemplates section.
 	// +optional
 	// +patchStrategy=replace
-	VolumeSource     *corev1.VolumeSource `json:"volumeSource,omitempty" patchStrategy:"replace"`
+	VolumeSource *corev1.VolumeSource `json:"volumeSource,omitempty" patchStrategy:"replace"`
+	// Backup specifies scheduled backups of the Keycloak database, and the backup that is restored when Keycloak
+	// is installed
+	// +optional
+	Backup           *MySQLBackupSpec `json:"backup,omitempty"`
 	InstallOverrides `json:",inline"`
 }
 
+// MySQLBackupSpec defines logical backups of the Keycloak MySQL database.  The backups are written either to a
+// persistent volume claim or to an S3 compatible bucket.
+type MySQLBackupSpec struct {
+	// Enabled turns on the scheduled backups.  Default is false.
+	// +optional
+	Enabled bool `json:"enabled,omitempty"`
+	// Schedule of the backups in cron format.  Default is "0 2 * * *", every day at 2 AM.
+	// +optional
+	Schedule string `json:"schedule,omitempty"`
+	// RetentionCount is the number of backups kept in the persistent volume claim.  Default is 7.  Backups in
+	// a bucket are expired by the lifecycle rules of the bucket.
+	// +optional
+	RetentionCount *int32 `json:"retentionCount,omitempty"`
+	// PersistentVolumeClaim is the name of an existing persistent volume claim in the keycloak namespace
+	// that the backups are written to
+	// +optional
+	PersistentVolumeClaim string `json:"persistentVolumeClaim,omitempty"`
+	// S3 specifies an S3 compatible bucket that the backups are uploaded to
+	// +optional
+	S3 *MySQLBackupS3Spec `json:"s3,omitempty"`
+	// RestoreBackupName is the name of a backup, like keycloak-20221017020000.sql.gz, that is restored before
+	// Keycloak is installed.  The backup is only restored by the first install of Keycloak.
+	// +optional
+	RestoreBackupName string `json:"restoreBackupName,omitempty"`
+}
+
+// MySQLBackupS3Spec defines the S3 compatible bucket of the MySQL backups
+type MySQLBackupS3Spec struct {
+	// Endpoint is the URL of the S3 compatible service, like https://objectstorage.example.com
+	Endpoint string `json:"endpoint"`
+	// Bucket is the name of the bucket
+	Bucket string `json:"bucket"`
+	// Prefix is the folder of the backups in the bucket
+	// +optional
+	Prefix string `json:"prefix,omitempty"`
+	// CredentialsSecret is the name of a secret in the verrazzano-install namespace with the accessKey and
+	// secretKey keys
+	CredentialsSecret string `json:"credentialsSecret"`
+}
+
 // RancherComponent specifies the Rancher configuration
 type RancherComponent struct {
 	// +optional
@@ -741,6 +1105,18 @@ type CA struct {
 	ClusterResourceNamespace string `json:"clusterResourceNamespace"`
 }
 
+// IssuerRef identifies an existing cert-manager ClusterIssuer or Issuer, like one backed by Vault or by an ACME
+// DNS01 webhook.  The Verrazzano ingresses request their certificates from it.
+type IssuerRef struct {
+	// Name of the issuer
+	Name string `json:"name"`
+	// Kind of the issuer, either ClusterIssuer or Issuer.  An Issuer must exist in every namespace of the Verrazzano
+	// ingresses and certificates: verrazzano-system, istio-system, and keycloak and cattle-system when Keycloak and
+	// Rancher are enabled.  Default is ClusterIssuer.
+	// +optional
+	Kind string `json:"kind,omitempty"`
+}
+
 // Certificate represents the type of cert issuer for an install
 // Only one of its members may be specified.
 type Certificate struct {
@@ -750,6 +1126,9 @@ type Certificate struct {
 	// CA cert issuer
 	// +optional
 	CA CA `json:"ca,omitempty"`
+	// IssuerRef references an existing cert-manager issuer that is not managed by Verrazzano
+	// +optional
+	IssuerRef IssuerRef `json:"issuerRef,omitempty"`
 }
 
 // OciPrivateKeyFileName is the private key file name
@@ -0,0 +1,83 @@
+// Copyright (c) 2022, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:path=verrazzanoaddons
+// +kubebuilder:resource:shortName=vzaddon;vzaddons
+// +kubebuilder:printcolumn:name="Namespace",type="string",JSONPath=".spec.targetNamespace",description="The namespace the add-on is installed in"
+// +genclient
+
+// VerrazzanoAddon is the Schema for the verrazzanoaddons API.  An add-on is a Helm chart that is installed, upgraded
+// and uninstalled as a component of the Verrazzano resource in the same namespace.  The status of the add-on is
+// reported in the component status of the Verrazzano resource.
+type VerrazzanoAddon struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec VerrazzanoAddonSpec `json:"spec,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// VerrazzanoAddonList contains a list of VerrazzanoAddon
+type VerrazzanoAddonList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []VerrazzanoAddon `json:"items"`
+}
+
+// VerrazzanoAddonSpec defines the Helm chart of an add-on and how it is installed
+type VerrazzanoAddonSpec struct {
+	// Chart is the Helm chart of the add-on
+	Chart AddonChart `json:"chart"`
+	// TargetNamespace is the namespace the add-on is installed in
+	TargetNamespace string `json:"targetNamespace"`
+	// Dependencies are the names of the components that must be ready before the add-on is installed
+	// +optional
+	Dependencies []string `json:"dependencies,omitempty"`
+	// ValueOverrides are the Helm value overrides of the add-on, ConfigMaps and Secrets are read from the namespace
+	// of the add-on
+	// +optional
+	ValueOverrides []Overrides `json:"overrides,omitempty"`
+	// ReadinessTargets are the workloads that must be ready for the add-on to be ready
+	// +optional
+	ReadinessTargets *AddonReadinessTargets `json:"readinessTargets,omitempty"`
+}
+
+// AddonChart is the location of the Helm chart of an add-on, either a directory in the operator image or a chart
+// in an OCI registry
+type AddonChart struct {
+	// Path is the directory of the chart in the operator image
+	// +optional
+	Path string `json:"path,omitempty"`
+	// URL is the OCI reference of the chart, for example oci://registry.local/charts/mychart
+	// +optional
+	URL string `json:"url,omitempty"`
+	// Version is the version of the chart in the OCI registry
+	// +optional
+	Version string `json:"version,omitempty"`
+}
+
+// AddonReadinessTargets are the workloads that must be ready for an add-on to be ready, the workloads are in the
+// target namespace of the add-on
+type AddonReadinessTargets struct {
+	// Deployments are the names of the Deployments that must be ready
+	// +optional
+	Deployments []string `json:"deployments,omitempty"`
+	// StatefulSets are the names of the StatefulSets that must be ready
+	// +optional
+	StatefulSets []string `json:"statefulSets,omitempty"`
+	// DaemonSets are the names of the DaemonSets that must be ready
+	// +optional
+	DaemonSets []string `json:"daemonSets,omitempty"`
+}
+
+func init() {
+	SchemeBuilder.Register(&VerrazzanoAddon{}, &VerrazzanoAddonList{})
+}
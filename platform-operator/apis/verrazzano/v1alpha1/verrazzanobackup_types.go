@@ -0,0 +1,117 @@
+// Copyright (c) 2022, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// BackupPhase identifies the progress of a backup or a restore
+type BackupPhase string
+
+const (
+	// BackupPhaseInProgress means the backup or restore has started and has not finished
+	BackupPhaseInProgress BackupPhase = "InProgress"
+
+	// BackupPhaseCompleted means the backup was written to the storage, or all the resources were restored
+	BackupPhaseCompleted BackupPhase = "Completed"
+
+	// BackupPhaseFailed means the backup or restore failed and is not retried
+	BackupPhaseFailed BackupPhase = "Failed"
+)
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:path=verrazzanobackups
+// +kubebuilder:resource:shortName=vzbackup;vzbackups
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Restore From",type="string",JSONPath=".spec.restoreFrom",description="The backup that is restored"
+// +kubebuilder:printcolumn:name="Phase",type="string",JSONPath=".status.phase",description="The phase of the backup or restore"
+// +kubebuilder:printcolumn:name="Location",type="string",JSONPath=".status.location",description="The location of the backup tarball"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+// +genclient
+
+// VerrazzanoBackup is the Schema for the verrazzanobackups API.  A backup writes the Verrazzano resource, its
+// override ConfigMaps and Secrets, the managed clusters, projects, multicluster resources and OAM applications to a
+// tarball on a persistent volume claim or in an S3 compatible bucket.  A backup with restoreFrom set recreates the
+// resources of an existing tarball instead.  Backups are created in the verrazzano-install namespace.
+type VerrazzanoBackup struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   VerrazzanoBackupSpec   `json:"spec,omitempty"`
+	Status VerrazzanoBackupStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// VerrazzanoBackupList contains a list of VerrazzanoBackup
+type VerrazzanoBackupList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []VerrazzanoBackup `json:"items"`
+}
+
+// VerrazzanoBackupSpec defines where the backup tarball is kept, and whether it is written or restored
+type VerrazzanoBackupSpec struct {
+	// Storage is where the backup tarball is written, or read from for a restore.  The tarball holds the backed up
+	// Secrets, including the managed cluster agent and registration secrets, unencrypted, so access to the persistent
+	// volume claim or bucket must be restricted like access to the Secrets, and buckets should encrypt their objects.
+	Storage BackupStorage `json:"storage"`
+	// RestoreFrom is the name of the backup whose tarball is restored.  A backup is taken when it is empty.
+	// +optional
+	RestoreFrom string `json:"restoreFrom,omitempty"`
+}
+
+// BackupStorage is a persistent volume claim or an S3 compatible bucket, exactly one must be set
+type BackupStorage struct {
+	// PersistentVolumeClaim is the name of a claim in the verrazzano-install namespace
+	// +optional
+	PersistentVolumeClaim string `json:"persistentVolumeClaim,omitempty"`
+	// S3 is an S3 compatible bucket
+	// +optional
+	S3 *BackupS3Storage `json:"s3,omitempty"`
+}
+
+// BackupS3Storage is an S3 compatible bucket and the secret with its credentials
+type BackupS3Storage struct {
+	// Endpoint is the URL of the S3 compatible endpoint
+	Endpoint string `json:"endpoint"`
+	// Bucket is the name of the bucket
+	Bucket string `json:"bucket"`
+	// Prefix is prepended to the name of the tarball in the bucket
+	// +optional
+	Prefix string `json:"prefix,omitempty"`
+	// CredentialsSecret is the name of a secret in the verrazzano-install namespace with the accessKey and secretKey
+	// of the bucket
+	CredentialsSecret string `json:"credentialsSecret"`
+}
+
+// VerrazzanoBackupStatus is the progress and outcome of a backup or restore
+type VerrazzanoBackupStatus struct {
+	// Phase is the phase of the backup or restore
+	// +optional
+	Phase BackupPhase `json:"phase,omitempty"`
+	// Message describes what the backup or restore is waiting for, or why it failed
+	// +optional
+	Message string `json:"message,omitempty"`
+	// Location is the location of the backup tarball
+	// +optional
+	Location string `json:"location,omitempty"`
+	// ResourceCount is the number of resources in the backup tarball
+	// +optional
+	ResourceCount int `json:"resourceCount,omitempty"`
+	// RestoredSteps are the steps of a restore that are done, in the order they were restored
+	// +optional
+	RestoredSteps []string `json:"restoredSteps,omitempty"`
+	// StartTime is the time the backup or restore started
+	// +optional
+	StartTime *metav1.Time `json:"startTime,omitempty"`
+	// CompletionTime is the time the backup or restore completed or failed
+	// +optional
+	CompletionTime *metav1.Time `json:"completionTime,omitempty"`
+}
+
+func init() {
+	SchemeBuilder.Register(&VerrazzanoBackup{}, &VerrazzanoBackupList{})
+}
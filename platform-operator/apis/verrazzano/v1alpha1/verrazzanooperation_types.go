@@ -0,0 +1,128 @@
+// Copyright (c) 2022, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// OperationType identifies the kind of operation done on a Verrazzano resource
+type OperationType string
+
+const (
+	// OperationInstall is the install of Verrazzano
+	OperationInstall OperationType = "Install"
+
+	// OperationUpgrade is the upgrade of Verrazzano to a new version
+	OperationUpgrade OperationType = "Upgrade"
+
+	// OperationUpdate is the reconcile of an installed Verrazzano after the Verrazzano resource was updated
+	OperationUpdate OperationType = "Update"
+
+	// OperationUninstall is the uninstall of Verrazzano
+	OperationUninstall OperationType = "Uninstall"
+)
+
+// OperationResult identifies the outcome of an operation, or of the part of an operation done for a component
+type OperationResult string
+
+const (
+	// OperationInProgress means the operation has not finished
+	OperationInProgress OperationResult = "InProgress"
+
+	// OperationSucceeded means the operation finished successfully
+	OperationSucceeded OperationResult = "Succeeded"
+
+	// OperationFailed means the operation failed
+	OperationFailed OperationResult = "Failed"
+
+	// OperationInterrupted means another operation was started before the operation finished
+	OperationInterrupted OperationResult = "Interrupted"
+)
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:path=verrazzanooperations
+// +kubebuilder:resource:shortName=vzop;vzops
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Operation",type="string",JSONPath=".spec.operation",description="The type of the operation"
+// +kubebuilder:printcolumn:name="Result",type="string",JSONPath=".status.result",description="The result of the operation"
+// +kubebuilder:printcolumn:name="Target Version",type="string",JSONPath=".spec.targetVersion",description="The Verrazzano version after the operation"
+// +kubebuilder:printcolumn:name="Start Time",type="date",JSONPath=".status.startTime",description="The time the operation started"
+// +genclient
+
+// VerrazzanoOperation is the Schema for the verrazzanooperations API.  An operation is created by the Verrazzano
+// platform operator for each install, upgrade, update or uninstall of a Verrazzano resource, in the namespace of the
+// resource, and records the history of that operation.
+type VerrazzanoOperation struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   VerrazzanoOperationSpec   `json:"spec,omitempty"`
+	Status VerrazzanoOperationStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// VerrazzanoOperationList contains a list of VerrazzanoOperation
+type VerrazzanoOperationList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []VerrazzanoOperation `json:"items"`
+}
+
+// VerrazzanoOperationSpec identifies the operation and the Verrazzano resource it was done on
+type VerrazzanoOperationSpec struct {
+	// Verrazzano is the name of the Verrazzano resource
+	Verrazzano string `json:"verrazzano"`
+	// Operation is the type of the operation
+	Operation OperationType `json:"operation"`
+	// Generation is the generation of the Verrazzano resource when the operation started
+	// +optional
+	Generation int64 `json:"generation,omitempty"`
+	// SourceVersion is the Verrazzano version before the operation, empty for an install
+	// +optional
+	SourceVersion string `json:"sourceVersion,omitempty"`
+	// TargetVersion is the Verrazzano version after the operation, empty for an uninstall
+	// +optional
+	TargetVersion string `json:"targetVersion,omitempty"`
+}
+
+// VerrazzanoOperationStatus is the progress and outcome of an operation
+type VerrazzanoOperationStatus struct {
+	// Result is the result of the operation
+	Result OperationResult `json:"result,omitempty"`
+	// Message is the message of the condition that finished the operation
+	// +optional
+	Message string `json:"message,omitempty"`
+	// StartTime is the time the operation started
+	// +optional
+	StartTime *metav1.Time `json:"startTime,omitempty"`
+	// EndTime is the time the operation finished
+	// +optional
+	EndTime *metav1.Time `json:"endTime,omitempty"`
+	// Components is the outcome of the operation for each component that was part of it
+	// +optional
+	Components []OperationComponentStatus `json:"components,omitempty"`
+}
+
+// OperationComponentStatus is the outcome of an operation for a component
+type OperationComponentStatus struct {
+	// Name is the name of the component
+	Name string `json:"name"`
+	// Result is the result of the operation for the component
+	Result OperationResult `json:"result,omitempty"`
+	// Message is the message of the last condition of the component during the operation
+	// +optional
+	Message string `json:"message,omitempty"`
+	// StartTime is the time the component operation started
+	// +optional
+	StartTime *metav1.Time `json:"startTime,omitempty"`
+	// EndTime is the time the component operation finished
+	// +optional
+	EndTime *metav1.Time `json:"endTime,omitempty"`
+}
+
+func init() {
+	SchemeBuilder.Register(&VerrazzanoOperation{}, &VerrazzanoOperationList{})
+}
@@ -13,6 +13,7 @@ import (
 	"k8s.io/api/core/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
 	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -31,6 +32,179 @@ func (in *Acme) DeepCopy() *Acme {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AddonChart) DeepCopyInto(out *AddonChart) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AddonChart.
+func (in *AddonChart) DeepCopy() *AddonChart {
+	if in == nil {
+		return nil
+	}
+	out := new(AddonChart)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AddonReadinessTargets) DeepCopyInto(out *AddonReadinessTargets) {
+	*out = *in
+	if in.Deployments != nil {
+		in, out := &in.Deployments, &out.Deployments
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.StatefulSets != nil {
+		in, out := &in.StatefulSets, &out.StatefulSets
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.DaemonSets != nil {
+		in, out := &in.DaemonSets, &out.DaemonSets
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AddonReadinessTargets.
+func (in *AddonReadinessTargets) DeepCopy() *AddonReadinessTargets {
+	if in == nil {
+		return nil
+	}
+	out := new(AddonReadinessTargets)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AlertmanagerComponent) DeepCopyInto(out *AlertmanagerComponent) {
+	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Receivers != nil {
+		in, out := &in.Receivers, &out.Receivers
+		*out = make([]AlertmanagerReceiver, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.DefaultRules != nil {
+		in, out := &in.DefaultRules, &out.DefaultRules
+		*out = new(bool)
+		**out = **in
+	}
+	in.InstallOverrides.DeepCopyInto(&out.InstallOverrides)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlertmanagerComponent.
+func (in *AlertmanagerComponent) DeepCopy() *AlertmanagerComponent {
+	if in == nil {
+		return nil
+	}
+	out := new(AlertmanagerComponent)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AlertmanagerEmail) DeepCopyInto(out *AlertmanagerEmail) {
+	*out = *in
+	if in.To != nil {
+		in, out := &in.To, &out.To
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.RequireTLS != nil {
+		in, out := &in.RequireTLS, &out.RequireTLS
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlertmanagerEmail.
+func (in *AlertmanagerEmail) DeepCopy() *AlertmanagerEmail {
+	if in == nil {
+		return nil
+	}
+	out := new(AlertmanagerEmail)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AlertmanagerLocal) DeepCopyInto(out *AlertmanagerLocal) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlertmanagerLocal.
+func (in *AlertmanagerLocal) DeepCopy() *AlertmanagerLocal {
+	if in == nil {
+		return nil
+	}
+	out := new(AlertmanagerLocal)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AlertmanagerReceiver) DeepCopyInto(out *AlertmanagerReceiver) {
+	*out = *in
+	if in.Severities != nil {
+		in, out := &in.Severities, &out.Severities
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.SendResolved != nil {
+		in, out := &in.SendResolved, &out.SendResolved
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Webhook != nil {
+		in, out := &in.Webhook, &out.Webhook
+		*out = new(AlertmanagerWebhook)
+		**out = **in
+	}
+	if in.Email != nil {
+		in, out := &in.Email, &out.Email
+		*out = new(AlertmanagerEmail)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Local != nil {
+		in, out := &in.Local, &out.Local
+		*out = new(AlertmanagerLocal)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlertmanagerReceiver.
+func (in *AlertmanagerReceiver) DeepCopy() *AlertmanagerReceiver {
+	if in == nil {
+		return nil
+	}
+	out := new(AlertmanagerReceiver)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AlertmanagerWebhook) DeepCopyInto(out *AlertmanagerWebhook) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlertmanagerWebhook.
+func (in *AlertmanagerWebhook) DeepCopy() *AlertmanagerWebhook {
+	if in == nil {
+		return nil
+	}
+	out := new(AlertmanagerWebhook)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ApplicationOperatorComponent) DeepCopyInto(out *ApplicationOperatorComponent) {
 	*out = *in
@@ -94,6 +268,41 @@ func (in *AuthProxyKubernetesSection) DeepCopy() *AuthProxyKubernetesSection {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackupS3Storage) DeepCopyInto(out *BackupS3Storage) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupS3Storage.
+func (in *BackupS3Storage) DeepCopy() *BackupS3Storage {
+	if in == nil {
+		return nil
+	}
+	out := new(BackupS3Storage)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackupStorage) DeepCopyInto(out *BackupStorage) {
+	*out = *in
+	if in.S3 != nil {
+		in, out := &in.S3, &out.S3
+		*out = new(BackupS3Storage)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupStorage.
+func (in *BackupStorage) DeepCopy() *BackupStorage {
+	if in == nil {
+		return nil
+	}
+	out := new(BackupStorage)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CA) DeepCopyInto(out *CA) {
 	*out = *in
@@ -136,6 +345,7 @@ func (in *Certificate) DeepCopyInto(out *Certificate) {
 	*out = *in
 	out.Acme = in.Acme
 	out.CA = in.CA
+	out.IssuerRef = in.IssuerRef
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Certificate.
@@ -207,6 +417,11 @@ func (in *ComponentSpec) DeepCopyInto(out *ComponentSpec) {
 		*out = new(ApplicationOperatorComponent)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Alertmanager != nil {
+		in, out := &in.Alertmanager, &out.Alertmanager
+		*out = new(AlertmanagerComponent)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.AuthProxy != nil {
 		in, out := &in.AuthProxy, &out.AuthProxy
 		*out = new(AuthProxyComponent)
@@ -337,6 +552,18 @@ func (in *ComponentStatusDetails) DeepCopyInto(out *ComponentStatusDetails) {
 		*out = make([]Condition, len(*in))
 		copy(*out, *in)
 	}
+	if in.InstallStartTime != nil {
+		in, out := &in.InstallStartTime, &out.InstallStartTime
+		*out = (*in).DeepCopy()
+	}
+	if in.LastDriftCheckTime != nil {
+		in, out := &in.LastDriftCheckTime, &out.LastDriftCheckTime
+		*out = (*in).DeepCopy()
+	}
+	if in.LastHealthCheckTime != nil {
+		in, out := &in.LastHealthCheckTime, &out.LastHealthCheckTime
+		*out = (*in).DeepCopy()
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ComponentStatusDetails.
@@ -378,6 +605,31 @@ func (in ComponentStatusMap) DeepCopy() ComponentStatusMap {
 	return *out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ComponentTimeout) DeepCopyInto(out *ComponentTimeout) {
+	*out = *in
+	if in.Install != nil {
+		in, out := &in.Install, &out.Install
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.Upgrade != nil {
+		in, out := &in.Upgrade, &out.Upgrade
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ComponentTimeout.
+func (in *ComponentTimeout) DeepCopy() *ComponentTimeout {
+	if in == nil {
+		return nil
+	}
+	out := new(ComponentTimeout)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Condition) DeepCopyInto(out *Condition) {
 	*out = *in
@@ -445,6 +697,31 @@ func (in *DNSComponent) DeepCopy() *DNSComponent {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DriftDetectionSpec) DeepCopyInto(out *DriftDetectionSpec) {
+	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Interval != nil {
+		in, out := &in.Interval, &out.Interval
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DriftDetectionSpec.
+func (in *DriftDetectionSpec) DeepCopy() *DriftDetectionSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(DriftDetectionSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ElasticsearchComponent) DeepCopyInto(out *ElasticsearchComponent) {
 	*out = *in
@@ -474,6 +751,11 @@ func (in *ElasticsearchComponent) DeepCopyInto(out *ElasticsearchComponent) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Snapshot != nil {
+		in, out := &in.Snapshot, &out.Snapshot
+		*out = new(OpenSearchSnapshot)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ElasticsearchComponent.
@@ -555,89 +837,331 @@ func (in *GrafanaComponent) DeepCopy() *GrafanaComponent {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *IngressNginxComponent) DeepCopyInto(out *IngressNginxComponent) {
+func (in *GrafanaDashboard) DeepCopyInto(out *GrafanaDashboard) {
 	*out = *in
-	if in.NGINXInstallArgs != nil {
-		in, out := &in.NGINXInstallArgs, &out.NGINXInstallArgs
-		*out = make([]InstallArgs, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	out.Spec = in.Spec
+	out.Status = in.Status
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GrafanaDashboard.
+func (in *GrafanaDashboard) DeepCopy() *GrafanaDashboard {
+	if in == nil {
+		return nil
 	}
-	if in.Ports != nil {
-		in, out := &in.Ports, &out.Ports
-		*out = make([]v1.ServicePort, len(*in))
+	out := new(GrafanaDashboard)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *GrafanaDashboard) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GrafanaDashboardList) DeepCopyInto(out *GrafanaDashboardList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]GrafanaDashboard, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
-	if in.Enabled != nil {
-		in, out := &in.Enabled, &out.Enabled
-		*out = new(bool)
-		**out = **in
-	}
-	in.InstallOverrides.DeepCopyInto(&out.InstallOverrides)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IngressNginxComponent.
-func (in *IngressNginxComponent) DeepCopy() *IngressNginxComponent {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GrafanaDashboardList.
+func (in *GrafanaDashboardList) DeepCopy() *GrafanaDashboardList {
 	if in == nil {
 		return nil
 	}
-	out := new(IngressNginxComponent)
+	out := new(GrafanaDashboardList)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *GrafanaDashboardList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *InstallArgs) DeepCopyInto(out *InstallArgs) {
+func (in *GrafanaDashboardSpec) DeepCopyInto(out *GrafanaDashboardSpec) {
 	*out = *in
-	if in.ValueList != nil {
-		in, out := &in.ValueList, &out.ValueList
-		*out = make([]string, len(*in))
-		copy(*out, *in)
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstallArgs.
-func (in *InstallArgs) DeepCopy() *InstallArgs {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GrafanaDashboardSpec.
+func (in *GrafanaDashboardSpec) DeepCopy() *GrafanaDashboardSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(InstallArgs)
+	out := new(GrafanaDashboardSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *InstallOverrides) DeepCopyInto(out *InstallOverrides) {
+func (in *GrafanaDashboardStatus) DeepCopyInto(out *GrafanaDashboardStatus) {
 	*out = *in
-	if in.MonitorChanges != nil {
-		in, out := &in.MonitorChanges, &out.MonitorChanges
-		*out = new(bool)
-		**out = **in
-	}
-	if in.ValueOverrides != nil {
-		in, out := &in.ValueOverrides, &out.ValueOverrides
-		*out = make([]Overrides, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstallOverrides.
-func (in *InstallOverrides) DeepCopy() *InstallOverrides {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GrafanaDashboardStatus.
+func (in *GrafanaDashboardStatus) DeepCopy() *GrafanaDashboardStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(InstallOverrides)
+	out := new(GrafanaDashboardStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *InstanceInfo) DeepCopyInto(out *InstanceInfo) {
+func (in *HealthCheckSpec) DeepCopyInto(out *HealthCheckSpec) {
+	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Interval != nil {
+		in, out := &in.Interval, &out.Interval
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HealthCheckSpec.
+func (in *HealthCheckSpec) DeepCopy() *HealthCheckSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(HealthCheckSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IdentityGroup) DeepCopyInto(out *IdentityGroup) {
+	*out = *in
+	if in.Roles != nil {
+		in, out := &in.Roles, &out.Roles
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IdentityGroup.
+func (in *IdentityGroup) DeepCopy() *IdentityGroup {
+	if in == nil {
+		return nil
+	}
+	out := new(IdentityGroup)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IdentityRealm) DeepCopyInto(out *IdentityRealm) {
+	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Roles != nil {
+		in, out := &in.Roles, &out.Roles
+		*out = make([]IdentityRole, len(*in))
+		copy(*out, *in)
+	}
+	if in.Groups != nil {
+		in, out := &in.Groups, &out.Groups
+		*out = make([]IdentityGroup, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Users != nil {
+		in, out := &in.Users, &out.Users
+		*out = make([]IdentityUser, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Clients != nil {
+		in, out := &in.Clients, &out.Clients
+		*out = make([]OIDCClient, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IdentityRealm.
+func (in *IdentityRealm) DeepCopy() *IdentityRealm {
+	if in == nil {
+		return nil
+	}
+	out := new(IdentityRealm)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IdentityRole) DeepCopyInto(out *IdentityRole) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IdentityRole.
+func (in *IdentityRole) DeepCopy() *IdentityRole {
+	if in == nil {
+		return nil
+	}
+	out := new(IdentityRole)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IdentitySpec) DeepCopyInto(out *IdentitySpec) {
+	*out = *in
+	if in.Realms != nil {
+		in, out := &in.Realms, &out.Realms
+		*out = make([]IdentityRealm, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IdentitySpec.
+func (in *IdentitySpec) DeepCopy() *IdentitySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(IdentitySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IdentityUser) DeepCopyInto(out *IdentityUser) {
+	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Groups != nil {
+		in, out := &in.Groups, &out.Groups
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IdentityUser.
+func (in *IdentityUser) DeepCopy() *IdentityUser {
+	if in == nil {
+		return nil
+	}
+	out := new(IdentityUser)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IngressNginxComponent) DeepCopyInto(out *IngressNginxComponent) {
+	*out = *in
+	if in.NGINXInstallArgs != nil {
+		in, out := &in.NGINXInstallArgs, &out.NGINXInstallArgs
+		*out = make([]InstallArgs, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Ports != nil {
+		in, out := &in.Ports, &out.Ports
+		*out = make([]v1.ServicePort, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	in.InstallOverrides.DeepCopyInto(&out.InstallOverrides)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IngressNginxComponent.
+func (in *IngressNginxComponent) DeepCopy() *IngressNginxComponent {
+	if in == nil {
+		return nil
+	}
+	out := new(IngressNginxComponent)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InstallArgs) DeepCopyInto(out *InstallArgs) {
+	*out = *in
+	if in.ValueList != nil {
+		in, out := &in.ValueList, &out.ValueList
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstallArgs.
+func (in *InstallArgs) DeepCopy() *InstallArgs {
+	if in == nil {
+		return nil
+	}
+	out := new(InstallArgs)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InstallOverrides) DeepCopyInto(out *InstallOverrides) {
+	*out = *in
+	if in.MonitorChanges != nil {
+		in, out := &in.MonitorChanges, &out.MonitorChanges
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ValueOverrides != nil {
+		in, out := &in.ValueOverrides, &out.ValueOverrides
+		*out = make([]Overrides, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstallOverrides.
+func (in *InstallOverrides) DeepCopy() *InstallOverrides {
+	if in == nil {
+		return nil
+	}
+	out := new(InstallOverrides)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InstanceInfo) DeepCopyInto(out *InstanceInfo) {
 	*out = *in
 	if in.ConsoleURL != nil {
 		in, out := &in.ConsoleURL, &out.ConsoleURL
@@ -691,6 +1215,21 @@ func (in *InstanceInfo) DeepCopy() *InstanceInfo {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IssuerRef) DeepCopyInto(out *IssuerRef) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IssuerRef.
+func (in *IssuerRef) DeepCopy() *IssuerRef {
+	if in == nil {
+		return nil
+	}
+	out := new(IssuerRef)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *IstioComponent) DeepCopyInto(out *IstioComponent) {
 	*out = *in
@@ -908,6 +1447,46 @@ func (in *KubeStateMetricsComponent) DeepCopy() *KubeStateMetricsComponent {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MySQLBackupS3Spec) DeepCopyInto(out *MySQLBackupS3Spec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MySQLBackupS3Spec.
+func (in *MySQLBackupS3Spec) DeepCopy() *MySQLBackupS3Spec {
+	if in == nil {
+		return nil
+	}
+	out := new(MySQLBackupS3Spec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MySQLBackupSpec) DeepCopyInto(out *MySQLBackupSpec) {
+	*out = *in
+	if in.RetentionCount != nil {
+		in, out := &in.RetentionCount, &out.RetentionCount
+		*out = new(int32)
+		**out = **in
+	}
+	if in.S3 != nil {
+		in, out := &in.S3, &out.S3
+		*out = new(MySQLBackupS3Spec)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MySQLBackupSpec.
+func (in *MySQLBackupSpec) DeepCopy() *MySQLBackupSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(MySQLBackupSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MySQLComponent) DeepCopyInto(out *MySQLComponent) {
 	*out = *in
@@ -923,6 +1502,11 @@ func (in *MySQLComponent) DeepCopyInto(out *MySQLComponent) {
 		*out = new(v1.VolumeSource)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Backup != nil {
+		in, out := &in.Backup, &out.Backup
+		*out = new(MySQLBackupSpec)
+		(*in).DeepCopyInto(*out)
+	}
 	in.InstallOverrides.DeepCopyInto(&out.InstallOverrides)
 }
 
@@ -972,6 +1556,36 @@ func (in *OCI) DeepCopy() *OCI {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OIDCClient) DeepCopyInto(out *OIDCClient) {
+	*out = *in
+	if in.RedirectURIs != nil {
+		in, out := &in.RedirectURIs, &out.RedirectURIs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.WebOrigins != nil {
+		in, out := &in.WebOrigins, &out.WebOrigins
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.StandardFlowEnabled != nil {
+		in, out := &in.StandardFlowEnabled, &out.StandardFlowEnabled
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OIDCClient.
+func (in *OIDCClient) DeepCopy() *OIDCClient {
+	if in == nil {
+		return nil
+	}
+	out := new(OIDCClient)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *OciLoggingConfiguration) DeepCopyInto(out *OciLoggingConfiguration) {
 	*out = *in
@@ -1033,10 +1647,74 @@ func (in *OpenSearchNodeStorage) DeepCopy() *OpenSearchNodeStorage {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Overrides) DeepCopyInto(out *Overrides) {
+func (in *OpenSearchSnapshot) DeepCopyInto(out *OpenSearchSnapshot) {
 	*out = *in
-	if in.ConfigMapRef != nil {
-		in, out := &in.ConfigMapRef, &out.ConfigMapRef
+	out.Repository = in.Repository
+	if in.RetentionCount != nil {
+		in, out := &in.RetentionCount, &out.RetentionCount
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Indices != nil {
+		in, out := &in.Indices, &out.Indices
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenSearchSnapshot.
+func (in *OpenSearchSnapshot) DeepCopy() *OpenSearchSnapshot {
+	if in == nil {
+		return nil
+	}
+	out := new(OpenSearchSnapshot)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpenSearchSnapshotRepository) DeepCopyInto(out *OpenSearchSnapshotRepository) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenSearchSnapshotRepository.
+func (in *OpenSearchSnapshotRepository) DeepCopy() *OpenSearchSnapshotRepository {
+	if in == nil {
+		return nil
+	}
+	out := new(OpenSearchSnapshotRepository)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OperationComponentStatus) DeepCopyInto(out *OperationComponentStatus) {
+	*out = *in
+	if in.StartTime != nil {
+		in, out := &in.StartTime, &out.StartTime
+		*out = (*in).DeepCopy()
+	}
+	if in.EndTime != nil {
+		in, out := &in.EndTime, &out.EndTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OperationComponentStatus.
+func (in *OperationComponentStatus) DeepCopy() *OperationComponentStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(OperationComponentStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Overrides) DeepCopyInto(out *Overrides) {
+	*out = *in
+	if in.ConfigMapRef != nil {
+		in, out := &in.ConfigMapRef, &out.ConfigMapRef
 		*out = new(v1.ConfigMapKeySelector)
 		(*in).DeepCopyInto(*out)
 	}
@@ -1200,6 +1878,11 @@ func (in *SecuritySpec) DeepCopyInto(out *SecuritySpec) {
 		*out = make([]rbacv1.Subject, len(*in))
 		copy(*out, *in)
 	}
+	if in.Identity != nil {
+		in, out := &in.Identity, &out.Identity
+		*out = new(IdentitySpec)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecuritySpec.
@@ -1212,6 +1895,26 @@ func (in *SecuritySpec) DeepCopy() *SecuritySpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UpgradeRollbackSpec) DeepCopyInto(out *UpgradeRollbackSpec) {
+	*out = *in
+	if in.ReadyTimeout != nil {
+		in, out := &in.ReadyTimeout, &out.ReadyTimeout
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UpgradeRollbackSpec.
+func (in *UpgradeRollbackSpec) DeepCopy() *UpgradeRollbackSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(UpgradeRollbackSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Verrazzano) DeepCopyInto(out *Verrazzano) {
 	*out = *in
@@ -1267,6 +1970,200 @@ func (in *VerrazzanoComponent) DeepCopy() *VerrazzanoComponent {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VerrazzanoAddon) DeepCopyInto(out *VerrazzanoAddon) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VerrazzanoAddon.
+func (in *VerrazzanoAddon) DeepCopy() *VerrazzanoAddon {
+	if in == nil {
+		return nil
+	}
+	out := new(VerrazzanoAddon)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VerrazzanoAddon) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VerrazzanoAddonList) DeepCopyInto(out *VerrazzanoAddonList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]VerrazzanoAddon, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VerrazzanoAddonList.
+func (in *VerrazzanoAddonList) DeepCopy() *VerrazzanoAddonList {
+	if in == nil {
+		return nil
+	}
+	out := new(VerrazzanoAddonList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VerrazzanoAddonList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VerrazzanoAddonSpec) DeepCopyInto(out *VerrazzanoAddonSpec) {
+	*out = *in
+	out.Chart = in.Chart
+	if in.Dependencies != nil {
+		in, out := &in.Dependencies, &out.Dependencies
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ValueOverrides != nil {
+		in, out := &in.ValueOverrides, &out.ValueOverrides
+		*out = make([]Overrides, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ReadinessTargets != nil {
+		in, out := &in.ReadinessTargets, &out.ReadinessTargets
+		*out = new(AddonReadinessTargets)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VerrazzanoAddonSpec.
+func (in *VerrazzanoAddonSpec) DeepCopy() *VerrazzanoAddonSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(VerrazzanoAddonSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VerrazzanoBackup) DeepCopyInto(out *VerrazzanoBackup) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VerrazzanoBackup.
+func (in *VerrazzanoBackup) DeepCopy() *VerrazzanoBackup {
+	if in == nil {
+		return nil
+	}
+	out := new(VerrazzanoBackup)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VerrazzanoBackup) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VerrazzanoBackupList) DeepCopyInto(out *VerrazzanoBackupList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]VerrazzanoBackup, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VerrazzanoBackupList.
+func (in *VerrazzanoBackupList) DeepCopy() *VerrazzanoBackupList {
+	if in == nil {
+		return nil
+	}
+	out := new(VerrazzanoBackupList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VerrazzanoBackupList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VerrazzanoBackupSpec) DeepCopyInto(out *VerrazzanoBackupSpec) {
+	*out = *in
+	in.Storage.DeepCopyInto(&out.Storage)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VerrazzanoBackupSpec.
+func (in *VerrazzanoBackupSpec) DeepCopy() *VerrazzanoBackupSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(VerrazzanoBackupSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VerrazzanoBackupStatus) DeepCopyInto(out *VerrazzanoBackupStatus) {
+	*out = *in
+	if in.RestoredSteps != nil {
+		in, out := &in.RestoredSteps, &out.RestoredSteps
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.StartTime != nil {
+		in, out := &in.StartTime, &out.StartTime
+		*out = (*in).DeepCopy()
+	}
+	if in.CompletionTime != nil {
+		in, out := &in.CompletionTime, &out.CompletionTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VerrazzanoBackupStatus.
+func (in *VerrazzanoBackupStatus) DeepCopy() *VerrazzanoBackupStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(VerrazzanoBackupStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VerrazzanoList) DeepCopyInto(out *VerrazzanoList) {
 	*out = *in
@@ -1299,11 +2196,137 @@ func (in *VerrazzanoList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VerrazzanoOperation) DeepCopyInto(out *VerrazzanoOperation) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	out.Spec = in.Spec
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VerrazzanoOperation.
+func (in *VerrazzanoOperation) DeepCopy() *VerrazzanoOperation {
+	if in == nil {
+		return nil
+	}
+	out := new(VerrazzanoOperation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VerrazzanoOperation) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VerrazzanoOperationList) DeepCopyInto(out *VerrazzanoOperationList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]VerrazzanoOperation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VerrazzanoOperationList.
+func (in *VerrazzanoOperationList) DeepCopy() *VerrazzanoOperationList {
+	if in == nil {
+		return nil
+	}
+	out := new(VerrazzanoOperationList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VerrazzanoOperationList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VerrazzanoOperationSpec) DeepCopyInto(out *VerrazzanoOperationSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VerrazzanoOperationSpec.
+func (in *VerrazzanoOperationSpec) DeepCopy() *VerrazzanoOperationSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(VerrazzanoOperationSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VerrazzanoOperationStatus) DeepCopyInto(out *VerrazzanoOperationStatus) {
+	*out = *in
+	if in.StartTime != nil {
+		in, out := &in.StartTime, &out.StartTime
+		*out = (*in).DeepCopy()
+	}
+	if in.EndTime != nil {
+		in, out := &in.EndTime, &out.EndTime
+		*out = (*in).DeepCopy()
+	}
+	if in.Components != nil {
+		in, out := &in.Components, &out.Components
+		*out = make([]OperationComponentStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VerrazzanoOperationStatus.
+func (in *VerrazzanoOperationStatus) DeepCopy() *VerrazzanoOperationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(VerrazzanoOperationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VerrazzanoSpec) DeepCopyInto(out *VerrazzanoSpec) {
 	*out = *in
 	in.Components.DeepCopyInto(&out.Components)
 	in.Security.DeepCopyInto(&out.Security)
+	if in.UpgradeRollback != nil {
+		in, out := &in.UpgradeRollback, &out.UpgradeRollback
+		*out = new(UpgradeRollbackSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ComponentTimeouts != nil {
+		in, out := &in.ComponentTimeouts, &out.ComponentTimeouts
+		*out = make([]ComponentTimeout, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.DriftDetection != nil {
+		in, out := &in.DriftDetection, &out.DriftDetection
+		*out = new(DriftDetectionSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.HealthCheck != nil {
+		in, out := &in.HealthCheck, &out.HealthCheck
+		*out = new(HealthCheckSpec)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.DefaultVolumeSource != nil {
 		in, out := &in.DefaultVolumeSource, &out.DefaultVolumeSource
 		*out = new(v1.VolumeSource)
@@ -0,0 +1,8 @@
+// Copyright (c) 2022, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+// +groupGoName=Verrazzano
+// +groupName=install.verrazzano.io
+package v1beta1
+
+// Needed to generate correct API group for the clients
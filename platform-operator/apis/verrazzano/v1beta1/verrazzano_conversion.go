@@ -0,0 +1,13 @@
+// Copyright (c) 2022, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package v1beta1
+
+import (
+	"sigs.k8s.io/controller-runtime/pkg/conversion"
+)
+
+var _ conversion.Hub = &Verrazzano{}
+
+// Hub marks v1beta1 as the version that the other versions of the Verrazzano resource are converted to and from
+func (*Verrazzano) Hub() {}
@@ -0,0 +1,1135 @@
+// Copyright (c) 2022, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package v1beta1
+
+import (
+	vmov1 "github.com/verrazzano/verrazzano-monitoring-operator/pkg/apis/vmcontroller/v1"
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ProfileType is the type of install profile.
+type ProfileType string
+
+const (
+	// Dev identifies the development install profile
+	Dev ProfileType = "dev"
+	// Prod identifies the production install profile
+	Prod ProfileType = "prod"
+	// ManagedCluster identifies the production managed-cluster install profile
+	ManagedCluster ProfileType = "managed-cluster"
+)
+const (
+	// LoadBalancer is an ingress type of LoadBalancer.  This is the default value.
+	LoadBalancer IngressType = "LoadBalancer"
+	// NodePort is an ingress type of NodePort.
+	NodePort IngressType = "NodePort"
+)
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:path=verrazzanos
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:shortName=vz;vzs
+// +kubebuilder:printcolumn:name="Status",type="string",JSONPath=".status.conditions[-1:].type",description="The current status of the install/uninstall"
+// +kubebuilder:printcolumn:name="Version",type="string",JSONPath=".status.version",description="The current version of the Verrazzano installation"
+// +kubebuilder:storageversion
+// +genclient
+
+// Verrazzano is the Schema for the verrazzanos API
+type Verrazzano struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   VerrazzanoSpec   `json:"spec,omitempty"`
+	Status VerrazzanoStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// VerrazzanoList contains a list of Verrazzano
+type VerrazzanoList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Verrazzano `json:"items"`
+}
+
+// VerrazzanoSpec defines the desired state of Verrazzano
+type VerrazzanoSpec struct {
+	// Version is the Verrazzano version
+	// +optional
+	Version string `json:"version,omitempty"`
+	// Profile is the name of the profile to install.  Default is "prod".
+	// +optional
+	Profile ProfileType `json:"profile,omitempty"`
+	// EnvironmentName identifies install environment.  Default environment name is "default".
+	// +optional
+	EnvironmentName string `json:"environmentName,omitempty"`
+	// Core specifies core Verrazzano configuration
+	// +optional
+	// +patchStrategy=merge
+	Components ComponentSpec `json:"components,omitempty" patchStrategy:"merge"`
+
+	// Security specifies Verrazzano security configuration
+	// +optional
+	Security SecuritySpec `json:"security,omitempty"`
+
+	// UpgradeRollback specifies the policy for rolling back components that fail to upgrade
+	// +optional
+	UpgradeRollback *UpgradeRollbackSpec `json:"upgradeRollback,omitempty"`
+
+	// ComponentTimeouts overrides how long the install or upgrade of a component can take before the component is failed
+	// +optional
+	ComponentTimeouts []ComponentTimeout `json:"componentTimeouts,omitempty"`
+
+	// DriftDetection specifies the policy for detecting and remediating configuration drift of the Helm based components
+	// +optional
+	DriftDetection *DriftDetectionSpec `json:"driftDetection,omitempty"`
+
+	// HealthCheck specifies how the installed components are checked for health
+	// +optional
+	HealthCheck *HealthCheckSpec `json:"healthCheck,omitempty"`
+
+	// DefaultVolumeSource Defines the type of volume to be used for persistence, if not explicitly declared by a component;
+	// at present only EmptyDirVolumeSource or PersistentVolumeClaimVolumeSource are supported. If PersistentVolumeClaimVolumeSource
+	// is used, it must reference a VolumeClaimSpecTemplate in the VolumeClaimSpecTemplates section.
+	// +optional
+	// +patchStrategy=replace
+	DefaultVolumeSource *corev1.VolumeSource `json:"defaultVolumeSource,omitempty" patchStrategy:"replace"`
+
+	// VolumeClaimSpecTemplates Defines a named set of PVC configurations that can be referenced from components using persistent volumes.
+	// +optional
+	// +patchStrategy=merge,retainKeys
+	VolumeClaimSpecTemplates []VolumeClaimSpecTemplate `json:"volumeClaimSpecTemplates,omitempty" patchStrategy:"merge,retainKeys" patchMergeKey:"name"`
+}
+
+// CommonKubernetesSpec - Kubernetes resources that are common to a subgroup of components
+type CommonKubernetesSpec struct {
+	// Replicas specifies the number of pod instances to run
+	// +optional
+	Replicas uint32 `json:"replicas,omitempty"`
+	// Affinity specifies the group of affinity scheduling rules
+	// +optional
+	Affinity *corev1.Affinity `json:"affinity,omitempty"`
+}
+
+// SecuritySpec defines the security configuration for Verrazzano
+type SecuritySpec struct {
+	// AdminSubjects specifies subjects that should be bound to the verrazzano-admin role
+	// +optional
+	AdminSubjects []rbacv1.Subject `json:"adminSubjects,omitempty"`
+	// MonitorSubjects specifies subjects that should be bound to the verrazzano-monitor role
+	// +optional
+	MonitorSubjects []rbacv1.Subject `json:"monitorSubjects,omitempty"`
+	// Identity specifies Keycloak realms, groups, roles, users and OIDC clients that Verrazzano creates and keeps
+	// up to date in addition to its own Keycloak configuration
+	// +optional
+	Identity *IdentitySpec `json:"identity,omitempty"`
+}
+
+// IdentitySpec defines the Keycloak content declared in the Verrazzano resource.  Objects removed from the
+// declaration are not deleted from Keycloak.
+type IdentitySpec struct {
+	// Realms specifies the Keycloak realms.  The verrazzano-system realm can be listed to add content to it but
+	// cannot be disabled, the master realm cannot be listed.
+	// +optional
+	// +patchMergeKey=name
+	// +patchStrategy=merge,retainKeys
+	Realms []IdentityRealm `json:"realms,omitempty" patchStrategy:"merge,retainKeys" patchMergeKey:"name"`
+}
+
+// IdentityRealm defines a Keycloak realm and its content
+type IdentityRealm struct {
+	// Name of the realm
+	Name string `json:"name"`
+	// Enabled specifies whether users can log into the realm.  Default is true.
+	// +optional
+	Enabled *bool `json:"enabled,omitempty"`
+	// PasswordPolicy is the Keycloak password policy of the realm, like "length(12) and notUsername"
+	// +optional
+	PasswordPolicy string `json:"passwordPolicy,omitempty"`
+	// LoginTheme is the name of the Keycloak login theme of the realm
+	// +optional
+	LoginTheme string `json:"loginTheme,omitempty"`
+	// Roles specifies the realm roles
+	// +optional
+	Roles []IdentityRole `json:"roles,omitempty"`
+	// Groups specifies the groups and the realm roles mapped to them
+	// +optional
+	Groups []IdentityGroup `json:"groups,omitempty"`
+	// Users specifies the users of the realm
+	// +optional
+	Users []IdentityUser `json:"users,omitempty"`
+	// Clients specifies the OpenID Connect clients of the realm
+	// +optional
+	Clients []OIDCClient `json:"clients,omitempty"`
+}
+
+// IdentityRole defines a Keycloak realm role
+type IdentityRole struct {
+	// Name of the role
+	Name string `json:"name"`
+	// Description of the role
+	// +optional
+	Description string `json:"description,omitempty"`
+}
+
+// IdentityGroup defines a Keycloak group
+type IdentityGroup struct {
+	// Path of the group, like /my-users/my-admins.  The parent groups are created when they are not declared.
+	Path string `json:"path"`
+	// Roles are the names of the realm roles mapped to the group
+	// +optional
+	Roles []string `json:"roles,omitempty"`
+}
+
+// IdentityUser defines a Keycloak user
+type IdentityUser struct {
+	// Username of the user
+	Username string `json:"username"`
+	// Enabled specifies whether the user can log in.  Default is true.
+	// +optional
+	Enabled *bool `json:"enabled,omitempty"`
+	// Groups are the paths of the groups the user is a member of when the user is created
+	// +optional
+	Groups []string `json:"groups,omitempty"`
+	// PasswordSecret is the name of a secret in the namespace of the Verrazzano resource with the initial
+	// password of the user in the password key.  The password is only set when the user is created.
+	// +optional
+	PasswordSecret string `json:"passwordSecret,omitempty"`
+}
+
+// OIDCClient defines a Keycloak OpenID Connect client
+type OIDCClient struct {
+	// ClientID is the OpenID Connect client ID
+	ClientID string `json:"clientId"`
+	// PublicClient specifies whether the client is public.  A confidential client authenticates with a secret.
+	// Default is false.
+	// +optional
+	PublicClient bool `json:"publicClient,omitempty"`
+	// RedirectURIs are the valid redirect URIs of the client
+	// +optional
+	RedirectURIs []string `json:"redirectUris,omitempty"`
+	// WebOrigins are the allowed CORS origins of the client
+	// +optional
+	WebOrigins []string `json:"webOrigins,omitempty"`
+	// StandardFlowEnabled turns on the authorization code flow.  Default is true.
+	// +optional
+	StandardFlowEnabled *bool `json:"standardFlowEnabled,omitempty"`
+	// DirectAccessGrantsEnabled turns on the resource owner password credentials grant.  Default is false.
+	// +optional
+	DirectAccessGrantsEnabled bool `json:"directAccessGrantsEnabled,omitempty"`
+	// SecretName is the name of a secret in the namespace of the Verrazzano resource with the secret of a
+	// confidential client in the clientSecret key.  Keycloak generates the secret when it is not specified.
+	// +optional
+	SecretName string `json:"secretName,omitempty"`
+}
+
+// UpgradeRollbackSpec defines the policy for rolling back a Helm based component to its previous release revision
+// when the component upgrade fails
+type UpgradeRollbackSpec struct {
+	// Enabled turns on rolling back Helm based components to their previous release revision when the upgrade fails.  Default is false.
+	// +optional
+	Enabled bool `json:"enabled,omitempty"`
+	// MaxUpgradeAttempts is the number of failed upgrade attempts of a component before it is rolled back.  Default is 3.
+	// +optional
+	MaxUpgradeAttempts int `json:"maxUpgradeAttempts,omitempty"`
+	// ReadyTimeout is how long to wait for an upgraded component to be ready before it is rolled back.  Default is 15m.
+	// +optional
+	ReadyTimeout *metav1.Duration `json:"readyTimeout,omitempty"`
+}
+
+// ComponentTimeout defines how long the install and upgrade of a component can take before the component is failed
+type ComponentTimeout struct {
+	// Name of the component, for example keycloak
+	Name string `json:"name"`
+	// Install is how long the component install can take.  The default depends on the component, it is 30m for most components.
+	// +optional
+	Install *metav1.Duration `json:"install,omitempty"`
+	// Upgrade is how long the component upgrade can take.  The default depends on the component, it is 30m for most components.
+	// +optional
+	Upgrade *metav1.Duration `json:"upgrade,omitempty"`
+}
+
+// DriftDetectionSpec defines how the Helm based components are checked for configuration drift, that is for release
+// values and workloads that no longer match the configuration of the Verrazzano resource
+type DriftDetectionSpec struct {
+	// Enabled turns on the periodic drift detection of the components that are ready.  Default is true.
+	// +optional
+	Enabled *bool `json:"enabled,omitempty"`
+	// Interval is how often each component is checked for drift.  Default is 10m.
+	// +optional
+	Interval *metav1.Duration `json:"interval,omitempty"`
+	// AutoRemediate turns on reinstalling a component with the configuration of the Verrazzano resource when drift is
+	// detected.  Default is false.
+	// +optional
+	AutoRemediate bool `json:"autoRemediate,omitempty"`
+}
+
+// HealthCheckSpec defines how the installed components are checked for health after they are ready
+type HealthCheckSpec struct {
+	// Enabled turns on the periodic health check of the installed components.  Default is true.
+	// +optional
+	Enabled *bool `json:"enabled,omitempty"`
+	// Interval is how often each component is checked for health.  Default is 1m.
+	// +optional
+	Interval *metav1.Duration `json:"interval,omitempty"`
+}
+
+// VolumeClaimSpecTemplate Contains common PVC configuration that can be referenced from Components; these
+// do not actually result in generated PVCs, but can used to provide common configuration to components that
+// declare a PersistentVolumeClaimVolumeSource
+type VolumeClaimSpecTemplate struct {
+	// Metadata about the PersistentVolumeClaimSpec template.
+	// +kubebuilder:pruning:PreserveUnknownFields
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	// Spec The configuration specs for the template
+	Spec corev1.PersistentVolumeClaimSpec `json:"spec,omitempty"`
+}
+
+// InstanceInfo details of installed Verrazzano instance maintained in status field
+type InstanceInfo struct {
+	// ConsoleURL The Console URL for this Verrazzano installation
+	ConsoleURL *string `json:"consoleUrl,omitempty"`
+	// KeyCloakURL The KeyCloak URL for this Verrazzano installation
+	KeyCloakURL *string `json:"keyCloakUrl,omitempty"`
+	// RancherURL The Rancher URL for this Verrazzano installation
+	RancherURL *string `json:"rancherUrl,omitempty"`
+	// ElasticURL The Elasticsearch URL for this Verrazzano installation
+	ElasticURL *string `json:"elasticUrl,omitempty"`
+	// KibanaURL The Kibana URL for this Verrazzano installation
+	KibanaURL *string `json:"kibanaUrl,omitempty"`
+	// GrafanaURL The Grafana URL for this Verrazzano installation
+	GrafanaURL *string `json:"grafanaUrl,omitempty"`
+	// PrometheusURL The Prometheus URL for this Verrazzano installation
+	PrometheusURL *string `json:"prometheusUrl,omitempty"`
+	// KialiURL The Kiali URL for this Verrazzano installation
+	KialiURL *string `json:"kialiUrl,omitempty"`
+}
+
+// VerrazzanoStatus defines the observed state of Verrazzano
+type VerrazzanoStatus struct {
+	// The version of Verrazzano that is installed
+	Version string `json:"version,omitempty"`
+	// The Verrazzano instance info
+	VerrazzanoInstance *InstanceInfo `json:"instance,omitempty"`
+	// The latest available observations of an object's current state.
+	Conditions []Condition `json:"conditions,omitempty"`
+	// State of the Verrazzano custom resource
+	State VzStateType `json:"state,omitempty"`
+	// States of the individual installed components
+	Components ComponentStatusMap `json:"components,omitempty"`
+}
+
+type ComponentStatusMap map[string]*ComponentStatusDetails
+
+// ComponentStatusDetails defines the observed state of a Verrazzano component
+type ComponentStatusDetails struct {
+	// Name of the component
+	Name string `json:"name,omitempty"`
+	// Information about the current state of a component
+	Conditions []Condition `json:"conditions,omitempty"`
+	// The version of Verrazzano that is installed
+	State CompStateType `json:"state,omitempty"`
+	// The version of Verrazzano that is installed
+	Version string `json:"version,omitempty"`
+	// The generation of the last VZ resource the Component was successfully reconciled against
+	LastReconciledGeneration int64 `json:"lastReconciledGeneration,omitempty"`
+	// The generation of the VZ resource the Component is currently being reconciled against
+	ReconcilingGeneration int64 `json:"reconcilingGeneration,omitempty"`
+	// The time the Component started installing, used to enforce the install timeout
+	InstallStartTime *metav1.Time `json:"installStartTime,omitempty"`
+	// The time the Component was last checked for configuration drift
+	LastDriftCheckTime *metav1.Time `json:"lastDriftCheckTime,omitempty"`
+	// The time the Component was last checked for health
+	LastHealthCheckTime *metav1.Time `json:"lastHealthCheckTime,omitempty"`
+}
+
+// ConditionType identifies the condition of the install/uninstall/upgrade which can be checked with kubectl wait
+type ConditionType string
+
+const (
+	// CondPreInstall means an install about to start.
+	CondPreInstall ConditionType = "PreInstall"
+
+	// CondInstallStarted means an install is in progress.
+	CondInstallStarted ConditionType = "InstallStarted"
+
+	// CondInstallComplete means the install job has completed its execution successfully
+	CondInstallComplete ConditionType = "InstallComplete"
+
+	// CondInstallFailed means the install job has failed during execution.
+	CondInstallFailed ConditionType = "InstallFailed"
+
+	// CondUninstallStarted means an uninstall is in progress.
+	CondUninstallStarted ConditionType = "UninstallStarted"
+
+	// CondUninstallComplete means the uninstall job has completed its execution successfully
+	CondUninstallComplete ConditionType = "UninstallComplete"
+
+	// CondUninstallFailed means the uninstall job has failed during execution.
+	CondUninstallFailed ConditionType = "UninstallFailed"
+
+	// CondUpgradeStarted means that an upgrade has been started.
+	CondUpgradeStarted ConditionType = "UpgradeStarted"
+
+	// CondUpgradePaused means that an upgrade has been paused awaiting a VZ version update.
+	CondUpgradePaused ConditionType = "UpgradePaused"
+
+	// CondUpgradeFailed means the upgrade has failed during execution.
+	CondUpgradeFailed ConditionType = "UpgradeFailed"
+
+	// CondUpgradeComplete means the upgrade has completed successfully
+	CondUpgradeComplete ConditionType = "UpgradeComplete"
+
+	// CondUpgradeRolledBack means a failed component upgrade was rolled back to the previous release revision
+	CondUpgradeRolledBack ConditionType = "UpgradeRolledBack"
+
+	// CondPreflightFailed means the upgrade is blocked because upgrade preflight checks failed
+	CondPreflightFailed ConditionType = "PreflightFailed"
+
+	// CondConfigurationDrift means the deployed configuration of a component no longer matches the Verrazzano resource
+	CondConfigurationDrift ConditionType = "ConfigurationDrift"
+
+	// CondDegraded means an installed component is no longer healthy
+	CondDegraded ConditionType = "Degraded"
+)
+
+// Condition describes current state of an install.
+type Condition struct {
+	// Type of condition.
+	Type ConditionType `json:"type"`
+	// Status of the condition, one of True, False, Unknown.
+	Status corev1.ConditionStatus `json:"status"`
+	// Last time the condition transitioned from one status to another.
+	// +optional
+	LastTransitionTime string `json:"lastTransitionTime,omitempty"`
+	// Human readable message indicating details about last transition.
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
+// type VzStateType string identifies the state of a Verrazzano installation
+type VzStateType string
+
+const (
+	// VzStateUninstalling is the state when an uninstall is in progress
+	VzStateUninstalling VzStateType = "Uninstalling"
+
+	// VzStateUpgrading is the state when an upgrade is in progress
+	VzStateUpgrading VzStateType = "Upgrading"
+
+	// VzStatePaused is the state when an upgrade is paused due to version mismatch
+	VzStatePaused VzStateType = "Paused"
+
+	// VzStateReady is the state when a Verrazzano resource can perform an uninstall or upgrade
+	VzStateReady VzStateType = "Ready"
+
+	// VzStateFailed is the state when an install/uninstall/upgrade has failed
+	VzStateFailed VzStateType = "Failed"
+
+	// VzStateReconciling is the state when a resource is in progress reconciling
+	VzStateReconciling VzStateType = "Reconciling"
+
+	// VzStateDegraded is the state when Verrazzano is installed but one or more components are degraded
+	VzStateDegraded VzStateType = "Degraded"
+)
+
+// CompStateType identifies the state of a component
+type CompStateType string
+
+const (
+	// CompStateDisabled is the state for when a component is not currently installed
+	CompStateDisabled CompStateType = "Disabled"
+
+	// CompStatePreInstalling is the state when an install is about to be started
+	CompStatePreInstalling CompStateType = "PreInstalling"
+
+	// CompStateInstalling is the state when an install is in progress
+	CompStateInstalling CompStateType = "Installing"
+
+	// CompStateUninstalling is the state when an uninstall is in progress
+	CompStateUninstalling CompStateType = "Uninstalling"
+
+	// CompStateUpgrading is the state when an upgrade is in progress
+	CompStateUpgrading CompStateType = "Upgrading"
+
+	// CompStateError is the state when a Verrazzano resource has experienced an error that may leave it in an unstable state
+	CompStateError CompStateType = "Error"
+
+	// CompStateReady is the state when a Verrazzano resource can perform an uninstall or upgrade
+	CompStateReady CompStateType = "Ready"
+
+	// CompStateFailed is the state when an install/uninstall/upgrade has failed
+	CompStateFailed CompStateType = "Failed"
+
+	// CompStateDegraded is the state when an installed component that was ready is no longer healthy
+	CompStateDegraded CompStateType = "Degraded"
+)
+
+// ComponentSpec contains a set of components used by Verrazzano
+type ComponentSpec struct {
+	// CertManager contains the CertManager component configuration
+	// +optional
+	CertManager *CertManagerComponent `json:"certManager,omitempty"`
+
+	// CoherenceOperator configuration
+	// +optional
+	CoherenceOperator *CoherenceOperatorComponent `json:"coherenceOperator,omitempty"`
+
+	// ApplicationOperator configuration
+	// +optional
+	ApplicationOperator *ApplicationOperatorComponent `json:"applicationOperator,omitempty"`
+
+	// Alertmanager configuration
+	// +optional
+	Alertmanager *AlertmanagerComponent `json:"alertmanager,omitempty"`
+
+	// AuthProxy configuration
+	// +optional
+	AuthProxy *AuthProxyComponent `json:"authProxy,omitempty"`
+
+	// OAM configuration
+	// +optional
+	OAM *OAMComponent `json:"oam,omitempty"`
+
+	// Console configuration
+	// +optional
+	Console *ConsoleComponent `json:"console,omitempty"`
+
+	// DNS contains the DNS component configuration
+	// +optional
+	// +patchStrategy=replace
+	DNS *DNSComponent `json:"dns,omitempty" patchStrategy:"replace"`
+
+	// Elasticsearch configuration
+	// +optional
+	Elasticsearch *ElasticsearchComponent `json:"elasticsearch,omitempty"`
+
+	// Fluentd configuration
+	// +optional
+	Fluentd *FluentdComponent `json:"fluentd,omitempty"`
+
+	// Grafana configuration
+	// +optional
+	Grafana *GrafanaComponent `json:"grafana,omitempty"`
+
+	// Ingress contains the ingress-nginx component configuration
+	// +optional
+	Ingress *IngressNginxComponent `json:"ingress,omitempty"`
+
+	// Istio contains the istio component configuration
+	// +optional
+	Istio *IstioComponent `json:"istio,omitempty"`
+
+	// JaegerOperator configuration
+	// +optional
+	JaegerOperator *JaegerOperatorComponent `json:"jaegerOperator,omitempty"`
+
+	// Kiali contains the Kiali component configuration
+	// +optional
+	Kiali *KialiComponent `json:"kiali,omitempty"`
+
+	// Keycloak contains the Keycloak component configuration
+	// +optional
+	Keycloak *KeycloakComponent `json:"keycloak,omitempty"`
+
+	// Grafana configuration
+	// +optional
+	Kibana *KibanaComponent `json:"kibana,omitempty"`
+
+	// KubeStateMetrics configuration
+	// +optional
+	KubeStateMetrics *KubeStateMetricsComponent `json:"kubeStateMetrics,omitempty"`
+
+	// Prometheus configuration
+	// +optional
+	Prometheus *PrometheusComponent `json:"prometheus,omitempty"`
+
+	// PrometheusAdapter configuration
+	// +optional
+	PrometheusAdapter *PrometheusAdapterComponent `json:"prometheusAdapter,omitempty"`
+
+	// PrometheusNodeExporter configuration
+	// +optional
+	PrometheusNodeExporter *PrometheusNodeExporterComponent `json:"prometheusNodeExporter,omitempty"`
+
+	// PrometheusOperator configuration
+	// +optional
+	PrometheusOperator *PrometheusOperatorComponent `json:"prometheusOperator,omitempty"`
+
+	// PrometheusPushgateway configuration
+	// +optional
+	PrometheusPushgateway *PrometheusPushgatewayComponent `json:"prometheusPushgateway,omitempty"`
+
+	// Rancher configuration
+	// +optional
+	Rancher *RancherComponent `json:"rancher,omitempty"`
+
+	// WebLogicOperator configuration
+	// +optional
+	WebLogicOperator *WebLogicOperatorComponent `json:"weblogicOperator,omitempty"`
+
+	// Verrazzano configuration
+	// +optional
+	Verrazzano *VerrazzanoComponent `json:"verrazzano,omitempty"`
+}
+
+// ElasticsearchComponent specifies the Elasticsearch configuration.
+type ElasticsearchComponent struct {
+	// +optional
+	Enabled *bool `json:"enabled,omitempty"`
+
+	Policies []vmov1.IndexManagementPolicy `json:"policies,omitempty"`
+	Nodes    []OpenSearchNode              `json:"nodes,omitempty"`
+	// Snapshot specifies the snapshot repository of OpenSearch and the snapshots that are taken on a schedule
+	// +optional
+	Snapshot         *OpenSearchSnapshot `json:"snapshot,omitempty"`
+	InstallOverrides `json:",inline"`
+}
+
+// OpenSearchNode specifies a node group in the OpenSearch cluster
+type OpenSearchNode struct {
+	Name      string                       `json:"name,omitempty"`
+	Replicas  int32                        `json:"replicas,omitempty"`
+	Roles     []vmov1.NodeRole             `json:"roles,omitempty"`
+	Storage   *OpenSearchNodeStorage       `json:"storage,omitempty"`
+	Resources *corev1.ResourceRequirements `json:"resources,omitempty"`
+}
+
+type OpenSearchNodeStorage struct {
+	Size string `json:"size"`
+}
+
+// OpenSearchSnapshot defines an S3 compatible snapshot repository that is registered with OpenSearch, and the
+// snapshots of the indices that are taken on a schedule
+type OpenSearchSnapshot struct {
+	// Repository is the S3 compatible snapshot repository
+	Repository OpenSearchSnapshotRepository `json:"repository"`
+	// Schedule of the snapshots in cron format, like "0 3 * * *".  No snapshots are taken if it is empty.
+	// +optional
+	Schedule string `json:"schedule,omitempty"`
+	// RetentionCount is the number of scheduled snapshots kept in the repository.  Default is 7.  Snapshots that
+	// are not taken on the schedule are never deleted.
+	// +optional
+	RetentionCount *int32 `json:"retentionCount,omitempty"`
+	// Indices are the index patterns included in the scheduled snapshots.  Default is all of the indices.
+	// +optional
+	Indices []string `json:"indices,omitempty"`
+}
+
+// OpenSearchSnapshotRepository defines the S3 compatible bucket of the OpenSearch snapshots
+type OpenSearchSnapshotRepository struct {
+	// Name of the repository in OpenSearch.  Default is "verrazzano".
+	// +optional
+	Name string `json:"name,omitempty"`
+	// Endpoint is the URL of the S3 compatible service, like https://objectstorage.example.com.  The bucket is
+	// addressed with path style access, as required by MinIO.
+	Endpoint string `json:"endpoint"`
+	// Bucket is the name of the bucket
+	Bucket string `json:"bucket"`
+	// BasePath is the folder of the snapshots in the bucket
+	// +optional
+	BasePath string `json:"basePath,omitempty"`
+	// Region is the region of the bucket, required by some S3 compatible services
+	// +optional
+	Region string `json:"region,omitempty"`
+	// CredentialsSecret is the name of a secret in the verrazzano-install namespace with the accessKey and
+	// secretKey keys.  OpenSearch loads the credentials when its pods start.
+	CredentialsSecret string `json:"credentialsSecret"`
+}
+
+// KibanaComponent specifies the Kibana configuration.
+type KibanaComponent struct {
+	// +optional
+	Enabled *bool `json:"enabled,omitempty"`
+}
+
+// KubeStateMetricsComponent specifies the kube-state-metrics configuration.
+type KubeStateMetricsComponent struct {
+	// +optional
+	Enabled          *bool `json:"enabled,omitempty"`
+	InstallOverrides `json:",inline"`
+}
+
+// GrafanaComponent specifies the Grafana configuration.
+type GrafanaComponent struct {
+	// +optional
+	Enabled *bool `json:"enabled,omitempty"`
+}
+
+// PrometheusComponent specifies the Prometheus configuration.
+type PrometheusComponent struct {
+	// +optional
+	Enabled *bool `json:"enabled,omitempty"`
+}
+
+// PrometheusAdapterComponent specifies the Prometheus Adapter configuration.
+type PrometheusAdapterComponent struct {
+	// +optional
+	Enabled          *bool `json:"enabled,omitempty"`
+	InstallOverrides `json:",inline"`
+}
+
+// PrometheusNodeExporterComponent specifies the Prometheus Node Exporter configuration.
+type PrometheusNodeExporterComponent struct {
+	// +optional
+	Enabled          *bool `json:"enabled,omitempty"`
+	InstallOverrides `json:",inline"`
+}
+
+// AlertmanagerComponent specifies the Alertmanager configuration.  Alertmanager receives the alerts of the
+// Prometheus Operator and sends them to the receivers.
+type AlertmanagerComponent struct {
+	// +optional
+	Enabled *bool `json:"enabled,omitempty"`
+	// Receivers are the destinations of the alerts.  An alert is sent to every receiver whose severities match the
+	// severity of the alert.
+	// +optional
+	// +patchMergeKey=name
+	// +patchStrategy=merge,retainKeys
+	Receivers []AlertmanagerReceiver `json:"receivers,omitempty" patchStrategy:"merge,retainKeys" patchMergeKey:"name"`
+	// DefaultRules enables the alert rules of the Verrazzano components.  Default is true.
+	// +optional
+	DefaultRules     *bool `json:"defaultRules,omitempty"`
+	InstallOverrides `json:",inline"`
+}
+
+// AlertmanagerReceiver defines a destination of the alerts, exactly one of webhook, email and local is specified
+type AlertmanagerReceiver struct {
+	// Name of the receiver
+	Name string `json:"name"`
+	// Severities are the severities of the alerts that are sent to the receiver, like critical or warning.  Default
+	// is all of the alerts.
+	// +optional
+	Severities []string `json:"severities,omitempty"`
+	// SendResolved sends a notification when an alert is resolved.  Default is true.
+	// +optional
+	SendResolved *bool `json:"sendResolved,omitempty"`
+	// Webhook posts the alerts to an HTTP endpoint
+	// +optional
+	Webhook *AlertmanagerWebhook `json:"webhook,omitempty"`
+	// Email sends the alerts through an SMTP server
+	// +optional
+	Email *AlertmanagerEmail `json:"email,omitempty"`
+	// Local keeps the alerts in Alertmanager without sending them, they are read with the Alertmanager API.  It
+	// stands in for a webhook in tests.
+	// +optional
+	Local *AlertmanagerLocal `json:"local,omitempty"`
+}
+
+// AlertmanagerWebhook defines an HTTP endpoint that the alerts are posted to
+type AlertmanagerWebhook struct {
+	// URL of the endpoint
+	URL string `json:"url"`
+	// CredentialsSecret is the name of a secret in the verrazzano-install namespace with the username and password
+	// keys, that are sent with basic authentication
+	// +optional
+	CredentialsSecret string `json:"credentialsSecret,omitempty"`
+}
+
+// AlertmanagerEmail defines the SMTP server and the addresses of the alert emails
+type AlertmanagerEmail struct {
+	// To are the addresses that the alerts are sent to
+	To []string `json:"to"`
+	// From is the sender address
+	From string `json:"from"`
+	// Smarthost is the host and port of the SMTP server, like smtp.example.com:587
+	Smarthost string `json:"smarthost"`
+	// CredentialsSecret is the name of a secret in the verrazzano-install namespace with the username and password
+	// keys of the SMTP server
+	// +optional
+	CredentialsSecret string `json:"credentialsSecret,omitempty"`
+	// RequireTLS requires STARTTLS with the SMTP server.  Default is true.
+	// +optional
+	RequireTLS *bool `json:"requireTLS,omitempty"`
+}
+
+// AlertmanagerLocal keeps the alerts in Alertmanager, it has no settings
+type AlertmanagerLocal struct {
+}
+
+// PrometheusOperatorComponent specifies the Prometheus Operator configuration
+type PrometheusOperatorComponent struct {
+	// +optional
+	Enabled          *bool `json:"enabled,omitempty"`
+	InstallOverrides `json:",inline"`
+}
+
+// PrometheusPushgatewayComponent specifies the Prometheus Pushgateway configuration.
+type PrometheusPushgatewayComponent struct {
+	// +optional
+	Enabled          *bool `json:"enabled,omitempty"`
+	InstallOverrides `json:",inline"`
+}
+
+// CertManagerComponent specifies the core CertManagerComponent config.
+type CertManagerComponent struct {
+	// Certificate used for an install
+	// +optional
+	// +patchStrategy=replace
+	Certificate Certificate `json:"certificate,omitempty" patchStrategy:"replace"`
+	// +optional
+	Enabled          *bool `json:"enabled,omitempty"`
+	InstallOverrides `json:",inline"`
+}
+
+// CoherenceOperatorComponent specifies the Coherence Operator configuration
+type CoherenceOperatorComponent struct {
+	// +optional
+	Enabled          *bool `json:"enabled,omitempty"`
+	InstallOverrides `json:",inline"`
+}
+
+// ApplicationOperatorComponent specifies the Application Operator configuration
+type ApplicationOperatorComponent struct {
+	// +optional
+	Enabled          *bool `json:"enabled,omitempty"`
+	InstallOverrides `json:",inline"`
+}
+
+// AuthProxyKubernetesSection specifies the Kubernetes resources that can be customized for AuthProxy.
+type AuthProxyKubernetesSection struct {
+	CommonKubernetesSpec `json:",inline"`
+}
+
+// AuthProxyComponent specifies the AuthProxy configuration
+type AuthProxyComponent struct {
+	// +optional
+	Enabled *bool `json:"enabled,omitempty"`
+	// +optional
+	Kubernetes       *AuthProxyKubernetesSection `json:"kubernetes,omitempty"`
+	InstallOverrides `json:",inline"`
+}
+
+// OAMComponent specifies the OAM configuration
+type OAMComponent struct {
+	// +optional
+	Enabled          *bool `json:"enabled,omitempty"`
+	InstallOverrides `json:",inline"`
+}
+
+// VerrazzanoComponent specifies the Verrazzano configuration
+type VerrazzanoComponent struct {
+	// +optional
+	Enabled          *bool `json:"enabled,omitempty"`
+	InstallOverrides `json:",inline"`
+}
+
+// KialiComponent specifies the Kiali configuration
+type KialiComponent struct {
+	// +optional
+	Enabled          *bool `json:"enabled,omitempty"`
+	InstallOverrides `json:",inline"`
+}
+
+// ConsoleComponent specifies the Console UI configuration
+type ConsoleComponent struct {
+	// +optional
+	Enabled          *bool `json:"enabled,omitempty"`
+	InstallOverrides `json:",inline"`
+}
+
+// DNSComponent specifies the DNS configuration
+type DNSComponent struct {
+	// DNS type of wildcard.  This is the default.
+	// +optional
+	Wildcard *Wildcard `json:"wildcard,omitempty"`
+	// DNS type of OCI (Oracle Cloud Infrastructure)
+	// +optional
+	OCI *OCI `json:"oci,omitempty"`
+	// DNS type of external. For example, OLCNE uses this type.
+	// +optional
+	External         *External `json:"external,omitempty"`
+	InstallOverrides `json:",inline"`
+}
+
+// IngressNginxComponent specifies the ingress-nginx configuration
+type IngressNginxComponent struct {
+	// Type of ingress.  Default is LoadBalancer
+	// +optional
+	Type IngressType `json:"type,omitempty"`
+	// ExternalIPs are the external IP addresses of the NGINX controller service, required for the NodePort type
+	// +optional
+	ExternalIPs []string `json:"externalIPs,omitempty"`
+	// Ports to be used for NGINX
+	// +optional
+	Ports []corev1.ServicePort `json:"ports,omitempty"`
+	// +optional
+	Enabled          *bool `json:"enabled,omitempty"`
+	InstallOverrides `json:",inline"`
+}
+
+// IstioIngressSection specifies the specific config options available for the Istio Ingress Gateways.
+type IstioIngressSection struct {
+	// Type of ingress.  Default is LoadBalancer
+	// +optional
+	Type IngressType `json:"type,omitempty"`
+	// Ports to be used for Istio Ingress Gateway
+	// +optional
+	Ports []corev1.ServicePort `json:"ports,omitempty"`
+	// ExternalIPs are the external IP addresses of the Istio Ingress Gateway service
+	// +optional
+	ExternalIPs []string `json:"externalIPs,omitempty"`
+	// +optional
+	Kubernetes *IstioKubernetesSection `json:"kubernetes,omitempty"`
+}
+
+// IstioEgressSection specifies the specific config options available for the Istio Egress Gateways.
+type IstioEgressSection struct {
+	// +optional
+	Kubernetes *IstioKubernetesSection `json:"kubernetes,omitempty"`
+}
+
+// IstioKubernetesSection specifies the Kubernetes resources that can be customized for Istio.
+type IstioKubernetesSection struct {
+	CommonKubernetesSpec `json:",inline"`
+}
+
+// IstioComponent specifies the Istio configuration
+type IstioComponent struct {
+	// +optional
+	InstallOverrides `json:",inline"`
+	// +optional
+	Enabled *bool `json:"enabled,omitempty"`
+	// +optional
+	InjectionEnabled *bool `json:"injectionEnabled,omitempty"`
+	// +optional
+	Ingress *IstioIngressSection `json:"ingress,omitempty"`
+	// +optional
+	Egress *IstioEgressSection `json:"egress,omitempty"`
+}
+
+// IsInjectionEnabled is istio sidecar injection enabled check
+func (c *IstioComponent) IsInjectionEnabled() bool {
+	if c.Enabled == nil || *c.Enabled {
+		return c.InjectionEnabled == nil || *c.InjectionEnabled
+	}
+	return c.InjectionEnabled != nil && *c.InjectionEnabled
+}
+
+// JaegerOperatorComponent specifies the Jaeger Operator configuration
+type JaegerOperatorComponent struct {
+	// +optional
+	Enabled *bool `json:"enabled,omitempty"`
+}
+
+// KeycloakComponent specifies the Keycloak configuration
+type KeycloakComponent struct {
+	// MySQL contains the MySQL component configuration needed for Keycloak
+	// +optional
+	MySQL MySQLComponent `json:"mysql,omitempty"`
+	// +optional
+	Enabled          *bool `json:"enabled,omitempty"`
+	InstallOverrides `json:",inline"`
+}
+
+// MySQLComponent specifies the MySQL configuration
+type MySQLComponent struct {
+	// VolumeSource Defines the type of volume to be used for persistence; at present only EmptyDirVolumeSource or
+	// PersistentVolumeClaimVolumeSource are supported. If PersistentVolumeClaimVolumeSource
+	// is used, it must reference a VolumeClaimSpecTemplate in the VolumeClaimSpecTemplates section.
+	// +optional
+	// +patchStrategy=replace
+	VolumeSource *corev1.VolumeSource `json:"volumeSource,omitempty" patchStrategy:"replace"`
+	// Backup specifies scheduled backups of the Keycloak database, and the backup that is restored when Keycloak
+	// is installed
+	// +optional
+	Backup           *MySQLBackupSpec `json:"backup,omitempty"`
+	InstallOverrides `json:",inline"`
+}
+
+// MySQLBackupSpec defines logical backups of the Keycloak MySQL database.  The backups are written either to a
+// persistent volume claim or to an S3 compatible bucket.
+type MySQLBackupSpec struct {
+	// Enabled turns on the scheduled backups.  Default is false.
+	// +optional
+	Enabled bool `json:"enabled,omitempty"`
+	// Schedule of the backups in cron format.  Default is "0 2 * * *", every day at 2 AM.
+	// +optional
+	Schedule string `json:"schedule,omitempty"`
+	// RetentionCount is the number of backups kept in the persistent volume claim.  Default is 7.  Backups in
+	// a bucket are expired by the lifecycle rules of the bucket.
+	// +optional
+	RetentionCount *int32 `json:"retentionCount,omitempty"`
+	// PersistentVolumeClaim is the name of an existing persistent volume claim in the keycloak namespace
+	// that the backups are written to
+	// +optional
+	PersistentVolumeClaim string `json:"persistentVolumeClaim,omitempty"`
+	// S3 specifies an S3 compatible bucket that the backups are uploaded to
+	// +optional
+	S3 *MySQLBackupS3Spec `json:"s3,omitempty"`
+	// RestoreBackupName is the name of a backup, like keycloak-20221017020000.sql.gz, that is restored before
+	// Keycloak is installed.  The backup is only restored by the first install of Keycloak.
+	// +optional
+	RestoreBackupName string `json:"restoreBackupName,omitempty"`
+}
+
+// MySQLBackupS3Spec defines the S3 compatible bucket of the MySQL backups
+type MySQLBackupS3Spec struct {
+	// Endpoint is the URL of the S3 compatible service, like https://objectstorage.example.com
+	Endpoint string `json:"endpoint"`
+	// Bucket is the name of the bucket
+	Bucket string `json:"bucket"`
+	// Prefix is the folder of the backups in the bucket
+	// +optional
+	Prefix string `json:"prefix,omitempty"`
+	// CredentialsSecret is the name of a secret in the verrazzano-install namespace with the accessKey and
+	// secretKey keys
+	CredentialsSecret string `json:"credentialsSecret"`
+}
+
+// RancherComponent specifies the Rancher configuration
+type RancherComponent struct {
+	// +optional
+	Enabled          *bool `json:"enabled,omitempty"`
+	InstallOverrides `json:",inline"`
+}
+
+// FluentdComponent specifies the Fluentd DaemonSet configuration
+type FluentdComponent struct {
+	// Specifies whether Fluentd is deployed or not on a cluster.  Default is true.
+	// +optional
+	Enabled *bool `json:"enabled,omitempty"`
+	// +optional
+	// +patchStrategy=merge,retainKeys
+	ExtraVolumeMounts []VolumeMount `json:"extraVolumeMounts,omitempty" patchStrategy:"merge,retainKeys" patchMergeKey:"source"`
+	// +optional
+	ElasticsearchURL string `json:"elasticsearchURL,omitempty"`
+	// +optional
+	ElasticsearchSecret string `json:"elasticsearchSecret,omitempty"`
+
+	// Configuration for integration with OCI (Oracle Cloud Infrastructure) Logging Service
+	// +optional
+	OCI              *OciLoggingConfiguration `json:"oci,omitempty"`
+	InstallOverrides `json:",inline"`
+}
+
+// WebLogicOperatorComponent specifies the WebLogic Operator configuration
+type WebLogicOperatorComponent struct {
+	// +optional
+	Enabled          *bool `json:"enabled,omitempty"`
+	InstallOverrides `json:",inline"`
+}
+
+// VolumeMount defines a hostPath type Volume mount
+type VolumeMount struct {
+	// Source hostPath
+	Source string `json:"source"`
+	// Destination path on the Container, defaults to source hostPath
+	// +optional
+	Destination string `json:"destination,omitempty"`
+	// ReadOnly defaults to true
+	// +optional
+	ReadOnly *bool `json:"readOnly,omitempty"`
+}
+
+// ProviderType identifies Acme provider type.
+type ProviderType string
+
+const (
+	// LetsEncrypt is a Let's Encrypt provider
+	LetsEncrypt ProviderType = "LetsEncrypt"
+)
+
+// Acme identifies the ACME cert issuer.
+type Acme struct {
+	// Type of provider for ACME cert issuer.
+	Provider ProviderType `json:"provider"`
+	// email address
+	// +optional
+	EmailAddress string `json:"emailAddress,omitempty"`
+	// environment
+	// +optional
+	Environment string `json:"environment,omitempty"`
+}
+
+// CA identifies the CA cert issuer.
+type CA struct {
+	// Name of secret for CA cert issuer
+	SecretName string `json:"secretName"`
+	// Namespace where secret is located for CA cert issuer
+	ClusterResourceNamespace string `json:"clusterResourceNamespace"`
+}
+
+// IssuerRef identifies an existing cert-manager ClusterIssuer or Issuer, like one backed by Vault or by an ACME
+// DNS01 webhook.  The Verrazzano ingresses request their certificates from it.
+type IssuerRef struct {
+	// Name of the issuer
+	Name string `json:"name"`
+	// Kind of the issuer, either ClusterIssuer or Issuer.  An Issuer must exist in every namespace of the Verrazzano
+	// ingresses and certificates: verrazzano-system, istio-system, and keycloak and cattle-system when Keycloak and
+	// Rancher are enabled.  Default is ClusterIssuer.
+	// +optional
+	Kind string `json:"kind,omitempty"`
+}
+
+// Certificate represents the type of cert issuer for an install
+// Only one of its members may be specified.
+type Certificate struct {
+	// ACME cert issuer
+	// +optional
+	Acme Acme `json:"acme,omitempty"`
+	// CA cert issuer
+	// +optional
+	CA CA `json:"ca,omitempty"`
+	// IssuerRef references an existing cert-manager issuer that is not managed by Verrazzano
+	// +optional
+	IssuerRef IssuerRef `json:"issuerRef,omitempty"`
+}
+
+// OciPrivateKeyFileName is the private key file name
+const OciPrivateKeyFileName = "oci_api_key.pem"
+
+// OciConfigSecretFile is the name of the OCI configuration yaml file
+const OciConfigSecretFile = "oci.yaml"
+
+// Wildcard DNS type
+type Wildcard struct {
+	// DNS wildcard domain (nip.io, sslip.io, etc.)
+	Domain string `json:"domain"`
+}
+
+// OCI DNS type
+type OCI struct {
+	OCIConfigSecret        string `json:"ociConfigSecret"`
+	DNSZoneCompartmentOCID string `json:"dnsZoneCompartmentOCID"`
+	DNSZoneOCID            string `json:"dnsZoneOCID"`
+	DNSZoneName            string `json:"dnsZoneName"`
+	DNSScope               string `json:"dnsScope,omitempty"`
+}
+
+// External DNS type
+type External struct {
+	// DNS suffix appended to EnviromentName to form DNS name
+	Suffix string `json:"suffix"`
+}
+
+// IngressType is the type of ingress.
+type IngressType string
+
+func init() {
+	SchemeBuilder.Register(&Verrazzano{}, &VerrazzanoList{})
+}
+
+// OCI Logging configuration for Fluentd DaemonSet
+type OciLoggingConfiguration struct {
+	DefaultAppLogID string `json:"defaultAppLogId"`
+	SystemLogID     string `json:"systemLogId"`
+	APISecret       string `json:"apiSecret,omitempty"`
+}
+
+// InstallOverrides are used to pass install overrides to components
+type InstallOverrides struct {
+	MonitorChanges *bool       `json:"monitorChanges,omitempty"`
+	ValueOverrides []Overrides `json:"overrides,omitempty"`
+}
+
+// Overrides stores the specified overrides
+type Overrides struct {
+	ConfigMapRef *corev1.ConfigMapKeySelector `json:"configMapRef,omitempty"`
+	SecretRef    *corev1.SecretKeySelector    `json:"secretRef,omitempty"`
+	Values       *apiextensionsv1.JSON        `json:"values,omitempty"`
+}
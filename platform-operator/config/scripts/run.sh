@@ -31,16 +31,6 @@ function dump-install-logs {
   exit $exitStatus
 }
 
-# Add uninstall logs to STDOUT so that they can be viewed after the job completes
-function dump-uninstall-logs {
-  exitStatus=$1
-  echo "*************************************************************"
-  echo " Dumping the uninstall logs contained in uninstall/build/logs"
-  echo "*************************************************************"
-  cat platform-operator/scripts/uninstall/build/logs/*
-  exit $exitStatus
-}
-
 # The same docker image is shared between the verrazzano-platform-operator and
 # the installation jobs that the operator creates.  The default mode is to run
 # the verrazzano-platform-operator.
@@ -56,10 +46,10 @@ elif [ "${MODE}" == "INSTALL" ]; then
   echo "*************************************************************"
   exit 0
 elif [ "${MODE}" == "UNINSTALL" ]; then
-  # Create a kubeconfig and run the installation
-  create-kubeconfig
-  ./platform-operator/scripts/uninstall/uninstall-verrazzano.sh -f || dump-uninstall-logs 1
-  dump-uninstall-logs 0
+  echo "*************************************************************"
+  echo " UNINSTALL is a NOOP                              "
+  echo "*************************************************************"
+  exit 0
 else
   # Run the operator
   /usr/local/bin/verrazzano-platform-operator $*
@@ -125,12 +125,31 @@ const VerrazzanoVersion1_1_0 = "1.1.0"
 // VerrazzanoVersion1_3_0 is the Verrazzano version string for 1.2.0
 const VerrazzanoVersion1_3_0 = "1.3.0"
 
+// VerrazzanoVersion1_4_0 is the Verrazzano version string for 1.4.0
+const VerrazzanoVersion1_4_0 = "1.4.0"
+
 // UpgradeRetryVersion is the restart version annotation field
 const UpgradeRetryVersion = "verrazzano.io/upgrade-retry-version"
 
 // ObservedUpgradeRetryVersion is the previous restart version annotation field
 const ObservedUpgradeRetryVersion = "verrazzano.io/observed-upgrade-retry-version"
 
+// PlanRequestAnnotation is the annotation of the Verrazzano resource that requests a change plan, the value
+// identifies the request
+const PlanRequestAnnotation = "verrazzano.io/plan-request"
+
+// PreflightRequestAnnotation is the annotation of the Verrazzano resource that requests a run of the upgrade
+// preflight checks, the value identifies the request
+const PreflightRequestAnnotation = "verrazzano.io/preflight-request"
+
+// VerrazzanoOperationLabel is the label of a VerrazzanoOperation that has the name of the Verrazzano resource the
+// operation was done on
+const VerrazzanoOperationLabel = "verrazzano.io/verrazzano-resource"
+
+// VerrazzanoOperationStartLabel is the label of a VerrazzanoOperation that has the start time of the operation in
+// nanoseconds since the epoch, it orders the operations that started within the same second
+const VerrazzanoOperationStartLabel = "verrazzano.io/operation-start"
+
 // NGINXControllerServiceName is the nginx ingress controller name
 const NGINXControllerServiceName = "ingress-controller-ingress-nginx-controller"
 
@@ -140,6 +159,9 @@ const InstallOperation = "install"
 // UpgradeOperation is the install string
 const UpgradeOperation = "upgrade"
 
+// UninstallOperation is the uninstall string
+const UninstallOperation = "uninstall"
+
 // InitializeOperation is the initialize string
 const InitializeOperation = "initialize"
 
@@ -191,3 +213,9 @@ const VerrazzanoComponentLabelKey = "verrazzano-component"
 
 // IstioAppLabel is the label used for Verrazzano Istio components
 const IstioAppLabel = "verrazzano.io/istio"
+
+// GrafanaDashboardLabel is the label, with the value "true", of ConfigMaps whose JSON keys are Grafana dashboards
+const GrafanaDashboardLabel = "grafana.verrazzano.io/dashboard"
+
+// GrafanaFolderAnnotation is the annotation with the title of the Grafana folder of the dashboards of a ConfigMap
+const GrafanaFolderAnnotation = "grafana.verrazzano.io/folder"
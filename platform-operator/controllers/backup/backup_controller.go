@@ -0,0 +1,492 @@
+// Copyright (c) 2022, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package backup
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	vzctrl "github.com/verrazzano/verrazzano/pkg/controller"
+	"github.com/verrazzano/verrazzano/pkg/k8sutil"
+	"github.com/verrazzano/verrazzano/pkg/log/vzlog"
+	installv1alpha1 "github.com/verrazzano/verrazzano/platform-operator/apis/verrazzano/v1alpha1"
+	"github.com/verrazzano/verrazzano/platform-operator/constants"
+	"go.uber.org/zap"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+const (
+	// backupLabel is the label with the name of the backup on its job and pod
+	backupLabel = "install.verrazzano.io/backup"
+
+	// tarballWrittenMessage is the status message of a backup once the tarball is streamed to the job
+	tarballWrittenMessage = "Writing the backup tarball"
+
+	// maxNameLength keeps the job name, which is a label value of its pods, under 63 characters
+	maxNameLength = 52
+)
+
+// VerrazzanoBackupReconciler reconciles VerrazzanoBackup resources.  A backup runs a job with the storage volume and
+// streams the tarball of the resources to it, the job then copies it to the storage.  A restore runs a job with the
+// tarball of the storage volume, reads the tarball from it, then recreates the resources in the order of the backup
+// steps.  The tarball is not staged in the cluster, so that its size is not limited and the backed up secrets are not
+// copied to another resource.
+type VerrazzanoBackupReconciler struct {
+	client.Client
+	Scheme *runtime.Scheme
+	log    vzlog.VerrazzanoLogger
+
+	// restoring are the tarballs of the restores in progress, by restore name
+	restoring map[types.NamespacedName]restoreTarball
+}
+
+// restoreTarball has the resources of the tarball of a restore in progress
+type restoreTarball struct {
+	// uid is the UID of the restore, a restore that is recreated with the same name reads the tarball again
+	uid       types.UID
+	resources map[string][]unstructured.Unstructured
+}
+
+// streamReader records the error of the stream it reads, to tell a failed stream apart from an invalid tarball
+type streamReader struct {
+	r   io.Reader
+	err error
+}
+
+// SetupWithManager creates a new controller and adds it to the manager
+func (r *VerrazzanoBackupReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&installv1alpha1.VerrazzanoBackup{}).
+		Owns(&batchv1.Job{}).
+		Complete(r)
+}
+
+// Reconcile the VerrazzanoBackup
+func (r *VerrazzanoBackupReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	if ctx == nil {
+		ctx = context.TODO()
+	}
+
+	backup := &installv1alpha1.VerrazzanoBackup{}
+	if err := r.Get(ctx, req.NamespacedName, backup); err != nil {
+		if errors.IsNotFound(err) {
+			// The restore was deleted, drop its tarball
+			delete(r.restoring, req.NamespacedName)
+			return reconcile.Result{}, nil
+		}
+		zap.S().Errorf("Failed to fetch VerrazzanoBackup resource: %v", err)
+		return newRequeueWithDelay(), err
+	}
+	if !backup.DeletionTimestamp.IsZero() {
+		delete(r.restoring, req.NamespacedName)
+		return reconcile.Result{}, nil
+	}
+	if backup.Status.Phase == installv1alpha1.BackupPhaseCompleted || backup.Status.Phase == installv1alpha1.BackupPhaseFailed {
+		return reconcile.Result{}, nil
+	}
+
+	log, err := vzlog.EnsureResourceLogger(&vzlog.ResourceConfig{
+		Name:           backup.Name,
+		Namespace:      backup.Namespace,
+		ID:             string(backup.UID),
+		Generation:     backup.Generation,
+		ControllerName: "VerrazzanoBackup",
+	})
+	if err != nil {
+		zap.S().Errorf("Failed to create resource logger for VerrazzanoBackup controller: %v", err)
+		return newRequeueWithDelay(), err
+	}
+	r.log = log
+
+	if err := validateBackup(backup); err != nil {
+		return r.fail(ctx, backup, err.Error())
+	}
+	if backup.Status.Phase == "" {
+		now := metav1.Now()
+		backup.Status.Phase = installv1alpha1.BackupPhaseInProgress
+		backup.Status.StartTime = &now
+		backup.Status.Location = getLocation(backup.Spec.Storage, backup.Name)
+		if backup.Spec.RestoreFrom != "" {
+			backup.Status.Location = getLocation(backup.Spec.Storage, backup.Spec.RestoreFrom)
+		}
+		if err := r.Status().Update(ctx, backup); err != nil {
+			return newRequeueWithDelay(), err
+		}
+	}
+
+	if backup.Spec.RestoreFrom == "" {
+		return r.reconcileBackup(ctx, backup)
+	}
+	return r.reconcileRestore(ctx, backup)
+}
+
+// reconcileBackup starts the job, streams the tarball to it once it runs, then waits for the job to copy it to the
+// storage
+func (r *VerrazzanoBackupReconciler) reconcileBackup(ctx context.Context, backup *installv1alpha1.VerrazzanoBackup) (ctrl.Result, error) {
+	job, err := r.getJob(ctx, backup)
+	if err != nil {
+		return newRequeueWithDelay(), err
+	}
+	if job == nil {
+		podSpec, err := buildUploadPodSpec(ctx, r.Client, backup)
+		if err != nil {
+			r.log.ErrorfThrottled("Failed building the job of backup %s: %v", backup.Name, err)
+			return newRequeueWithDelay(), err
+		}
+		if err := r.createJob(ctx, backup, podSpec); err != nil {
+			return newRequeueWithDelay(), err
+		}
+		r.log.Progressf("Backup %s is waiting for job %s to start", backup.Name, jobName(backup))
+		return r.updateMessage(ctx, backup, "Waiting for the backup job to start")
+	}
+
+	switch result, message := getJobResult(job); result {
+	case batchv1.JobComplete:
+		r.log.Infof("Backup %s wrote %d resources to %s", backup.Name, backup.Status.ResourceCount, backup.Status.Location)
+		return r.complete(ctx, backup)
+	case batchv1.JobFailed:
+		return r.fail(ctx, backup, fmt.Sprintf("Failed writing the backup tarball, see the logs of job %s: %s", job.Name, message))
+	}
+	if backup.Status.Message == tarballWrittenMessage {
+		return newRequeueWithDelay(), nil
+	}
+
+	pod, err := r.getJobPod(ctx, backup)
+	if err != nil || pod == nil {
+		return newRequeueWithDelay(), err
+	}
+	resources, err := collectResources(ctx, r.Client)
+	if err != nil {
+		r.log.ErrorfThrottled("Failed collecting the resources of backup %s: %v", backup.Name, err)
+		return newRequeueWithDelay(), err
+	}
+	if err := r.streamTarball(pod, backup, resources); err != nil {
+		r.log.ErrorfThrottled("Failed streaming the tarball of backup %s to pod %s, retrying: %v", backup.Name, pod.Name, err)
+		return newRequeueWithDelay(), nil
+	}
+	r.log.Progressf("Backup %s collected %d resources, writing the tarball to %s", backup.Name, countResources(resources), backup.Status.Location)
+	backup.Status.ResourceCount = countResources(resources)
+	return r.updateMessage(ctx, backup, tarballWrittenMessage)
+}
+
+// reconcileRestore reads the tarball from the job, then restores the steps that are not done yet, in order
+func (r *VerrazzanoBackupReconciler) reconcileRestore(ctx context.Context, backup *installv1alpha1.VerrazzanoBackup) (ctrl.Result, error) {
+	var resources map[string][]unstructured.Unstructured
+	if tarball, ok := r.restoring[client.ObjectKeyFromObject(backup)]; ok && tarball.uid == backup.UID {
+		resources = tarball.resources
+	}
+	if resources == nil {
+		var result ctrl.Result
+		var err error
+		if resources, result, err = r.readRestoreTarball(ctx, backup); resources == nil {
+			return result, err
+		}
+	}
+
+	backup.Status.ResourceCount = countResources(resources)
+	for _, step := range backupSteps {
+		if isStepRestored(backup, step.name) {
+			continue
+		}
+		if step.waitForVerrazzano {
+			ready, err := r.isVerrazzanoReady(ctx)
+			if err != nil {
+				return newRequeueWithDelay(), err
+			}
+			if !ready {
+				r.log.Progressf("Restore %s is waiting for Verrazzano to be ready before restoring the %s", backup.Name, step.name)
+				return r.updateMessage(ctx, backup, "Waiting for Verrazzano to be ready")
+			}
+		}
+		if err := restoreResources(ctx, r.Client, resources[step.name]); err != nil {
+			if meta.IsNoMatchError(err) {
+				r.log.Progressf("Restore %s is waiting for the CRDs of the %s: %v", backup.Name, step.name, err)
+				return r.updateMessage(ctx, backup, fmt.Sprintf("Waiting for the CRDs of the %s", step.name))
+			}
+			r.log.ErrorfThrottled("Failed restoring the %s of backup %s: %v", step.name, backup.Spec.RestoreFrom, err)
+			return r.updateMessage(ctx, backup, fmt.Sprintf("Failed restoring the %s, retrying: %v", step.name, err))
+		}
+		r.log.Oncef("Restore %s restored %d %s", backup.Name, len(resources[step.name]), step.name)
+		backup.Status.RestoredSteps = append(backup.Status.RestoredSteps, step.name)
+		backup.Status.Message = fmt.Sprintf("Restored the %s", step.name)
+		if err := r.Status().Update(ctx, backup); err != nil {
+			return newRequeueWithDelay(), err
+		}
+	}
+
+	r.log.Infof("Restore %s restored %d resources from %s", backup.Name, backup.Status.ResourceCount, backup.Status.Location)
+	return r.complete(ctx, backup)
+}
+
+// readRestoreTarball starts the job that makes the tarball available and reads the tarball from it once it runs.
+// The resources are kept until the restore is done or deleted, they are nil while the job starts.
+func (r *VerrazzanoBackupReconciler) readRestoreTarball(ctx context.Context, backup *installv1alpha1.VerrazzanoBackup) (map[string][]unstructured.Unstructured, ctrl.Result, error) {
+	job, err := r.getJob(ctx, backup)
+	if err != nil {
+		return nil, newRequeueWithDelay(), err
+	}
+	if job == nil {
+		podSpec, err := buildDownloadPodSpec(ctx, r.Client, backup)
+		if err != nil {
+			r.log.ErrorfThrottled("Failed building the job of restore %s: %v", backup.Name, err)
+			return nil, newRequeueWithDelay(), err
+		}
+		if err := r.createJob(ctx, backup, podSpec); err != nil {
+			return nil, newRequeueWithDelay(), err
+		}
+		r.log.Progressf("Restore %s is reading the tarball from %s", backup.Name, backup.Status.Location)
+		result, err := r.updateMessage(ctx, backup, "Reading the backup tarball")
+		return nil, result, err
+	}
+	switch jobResult, message := getJobResult(job); jobResult {
+	case batchv1.JobFailed:
+		result, err := r.fail(ctx, backup, fmt.Sprintf("Failed reading the backup tarball, see the logs of job %s: %s", job.Name, message))
+		return nil, result, err
+	case batchv1.JobComplete:
+		// The tarball was read before the operator restarted, it is read again by a new job
+		if err := r.Delete(ctx, job, client.PropagationPolicy(metav1.DeletePropagationBackground)); client.IgnoreNotFound(err) != nil {
+			return nil, newRequeueWithDelay(), err
+		}
+		return nil, newRequeueWithDelay(), nil
+	}
+
+	pod, err := r.getJobPod(ctx, backup)
+	if err != nil || pod == nil {
+		return nil, newRequeueWithDelay(), err
+	}
+	resources, retry, err := r.readTarballFromPod(pod, backup)
+	if retry {
+		r.log.ErrorfThrottled("Failed reading the tarball of restore %s from pod %s, retrying: %v", backup.Name, pod.Name, err)
+		return nil, newRequeueWithDelay(), nil
+	}
+	if err != nil {
+		result, err := r.fail(ctx, backup, err.Error())
+		return nil, result, err
+	}
+	if err := r.execJobPod(pod, []string{"touch", storageMountPath + "/" + markerName(backup)}, nil, nil); err != nil {
+		r.log.ErrorfThrottled("Failed completing job %s of restore %s, retrying: %v", job.Name, backup.Name, err)
+		return nil, newRequeueWithDelay(), nil
+	}
+	if r.restoring == nil {
+		r.restoring = map[types.NamespacedName]restoreTarball{}
+	}
+	r.restoring[client.ObjectKeyFromObject(backup)] = restoreTarball{uid: backup.UID, resources: resources}
+	return resources, ctrl.Result{}, nil
+}
+
+// readTarballFromPod decodes the tarball of the restore while it is streamed from the storage volume of the pod of
+// the restore job, the tarball is not buffered.  It returns true with the error if streaming the tarball failed and
+// can be retried, or false with the error if the tarball is not valid.
+func (r *VerrazzanoBackupReconciler) readTarballFromPod(pod *corev1.Pod, backup *installv1alpha1.VerrazzanoBackup) (map[string][]unstructured.Unstructured, bool, error) {
+	pr, pw := io.Pipe()
+	stream := &streamReader{r: pr}
+	var resources map[string][]unstructured.Unstructured
+	readErr := make(chan error, 1)
+	go func() {
+		var err error
+		resources, err = readTarball(stream)
+		if err == nil {
+			// Read the end of the stream, so that the command completes
+			_, err = io.Copy(io.Discard, stream)
+		}
+		// Stop the command if the tarball is not valid
+		pr.CloseWithError(err)
+		readErr <- err
+	}()
+	execErr := r.execJobPod(pod, []string{"cat", tarballPath(backup.Spec.RestoreFrom)}, nil, pw)
+	pw.CloseWithError(execErr)
+	err := <-readErr
+	if err != nil && stream.err == nil {
+		return nil, false, err
+	}
+	if execErr != nil {
+		return nil, true, execErr
+	}
+	if err != nil {
+		return nil, true, err
+	}
+	return resources, false, nil
+}
+
+// streamTarball streams the tarball of the resources to the storage volume of the pod of the backup job, then
+// touches the marker file that tells the job that the tarball is complete
+func (r *VerrazzanoBackupReconciler) streamTarball(pod *corev1.Pod, backup *installv1alpha1.VerrazzanoBackup, resources map[string][]unstructured.Unstructured) error {
+	pr, pw := io.Pipe()
+	writeErr := make(chan error, 1)
+	go func() {
+		err := writeTarball(pw, resources)
+		pw.CloseWithError(err)
+		writeErr <- err
+	}()
+	execErr := r.execJobPod(pod, []string{"/bin/bash", "-c", fmt.Sprintf(`cat > "%s.tmp"`, tarballPath(backup.Name))}, pr, nil)
+	// Stop the writer if the command did not read the whole tarball
+	pr.Close()
+	if err := <-writeErr; err != nil {
+		return fmt.Errorf("Failed writing the backup tarball: %v", err)
+	}
+	if execErr != nil {
+		return execErr
+	}
+	return r.execJobPod(pod, []string{"touch", storageMountPath + "/" + markerName(backup)}, nil, nil)
+}
+
+// execJobPod runs a command in the container of the pod of the job, streaming stdin to the command and its stdout
+func (r *VerrazzanoBackupReconciler) execJobPod(pod *corev1.Pod, command []string, stdin io.Reader, stdout io.Writer) error {
+	cfg, cli, err := k8sutil.ClientConfig()
+	if err != nil {
+		return err
+	}
+	return k8sutil.ExecPodStream(cli, cfg, pod, pod.Spec.Containers[0].Name, command, stdin, stdout)
+}
+
+// getJobPod returns the running pod of the job of the backup, or nil if it is not running yet
+func (r *VerrazzanoBackupReconciler) getJobPod(ctx context.Context, backup *installv1alpha1.VerrazzanoBackup) (*corev1.Pod, error) {
+	pods := &corev1.PodList{}
+	if err := r.List(ctx, pods, client.InNamespace(backup.Namespace), client.MatchingLabels{backupLabel: backup.Name}); err != nil {
+		return nil, err
+	}
+	for i := range pods.Items {
+		if pods.Items[i].Status.Phase == corev1.PodRunning {
+			return &pods.Items[i], nil
+		}
+	}
+	return nil, nil
+}
+
+// isVerrazzanoReady returns true if there is a Verrazzano resource and it is installed, either ready or degraded
+func (r *VerrazzanoBackupReconciler) isVerrazzanoReady(ctx context.Context) (bool, error) {
+	vzList := installv1alpha1.VerrazzanoList{}
+	if err := r.List(ctx, &vzList); err != nil {
+		return false, err
+	}
+	return len(vzList.Items) > 0 && installv1alpha1.IsVzInstalledState(vzList.Items[0].Status.State), nil
+}
+
+// getJob returns the job of the backup, or nil if it does not exist
+func (r *VerrazzanoBackupReconciler) getJob(ctx context.Context, backup *installv1alpha1.VerrazzanoBackup) (*batchv1.Job, error) {
+	job := &batchv1.Job{}
+	if err := r.Get(ctx, client.ObjectKey{Namespace: backup.Namespace, Name: jobName(backup)}, job); err != nil {
+		if errors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return job, nil
+}
+
+// createJob creates the job of the backup, owned by the backup
+func (r *VerrazzanoBackupReconciler) createJob(ctx context.Context, backup *installv1alpha1.VerrazzanoBackup, podSpec corev1.PodSpec) error {
+	job := newJob(backup, podSpec)
+	if err := controllerutil.SetControllerReference(backup, job, r.Scheme); err != nil {
+		return err
+	}
+	if err := r.Create(ctx, job); err != nil {
+		r.log.ErrorfThrottled("Failed creating job %s/%s: %v", job.Namespace, job.Name, err)
+		return err
+	}
+	return nil
+}
+
+// updateMessage updates the status message and requeues the backup
+func (r *VerrazzanoBackupReconciler) updateMessage(ctx context.Context, backup *installv1alpha1.VerrazzanoBackup, message string) (ctrl.Result, error) {
+	backup.Status.Message = message
+	if err := r.Status().Update(ctx, backup); err != nil {
+		return newRequeueWithDelay(), err
+	}
+	return newRequeueWithDelay(), nil
+}
+
+// complete sets the phase of the backup to Completed
+func (r *VerrazzanoBackupReconciler) complete(ctx context.Context, backup *installv1alpha1.VerrazzanoBackup) (ctrl.Result, error) {
+	delete(r.restoring, client.ObjectKeyFromObject(backup))
+	now := metav1.Now()
+	backup.Status.Phase = installv1alpha1.BackupPhaseCompleted
+	backup.Status.Message = ""
+	backup.Status.CompletionTime = &now
+	if err := r.Status().Update(ctx, backup); err != nil {
+		return newRequeueWithDelay(), err
+	}
+	return ctrl.Result{}, nil
+}
+
+// fail sets the phase of the backup to Failed, the backup is not retried
+func (r *VerrazzanoBackupReconciler) fail(ctx context.Context, backup *installv1alpha1.VerrazzanoBackup, message string) (ctrl.Result, error) {
+	r.log.Errorf("VerrazzanoBackup %s/%s failed: %s", backup.Namespace, backup.Name, message)
+	delete(r.restoring, client.ObjectKeyFromObject(backup))
+	now := metav1.Now()
+	backup.Status.Phase = installv1alpha1.BackupPhaseFailed
+	backup.Status.Message = message
+	backup.Status.CompletionTime = &now
+	if err := r.Status().Update(ctx, backup); err != nil {
+		return newRequeueWithDelay(), err
+	}
+	return ctrl.Result{}, nil
+}
+
+// Read reads from the stream and records its error
+func (s *streamReader) Read(p []byte) (int, error) {
+	n, err := s.r.Read(p)
+	if err != nil && err != io.EOF {
+		s.err = err
+	}
+	return n, err
+}
+
+// validateBackup checks that the backup has a single valid storage and a valid name
+func validateBackup(backup *installv1alpha1.VerrazzanoBackup) error {
+	if backup.Namespace != constants.VerrazzanoInstallNamespace {
+		return fmt.Errorf("VerrazzanoBackup resources must be created in namespace %s", constants.VerrazzanoInstallNamespace)
+	}
+	if len(backup.Name) > maxNameLength {
+		return fmt.Errorf("VerrazzanoBackup names must have at most %d characters", maxNameLength)
+	}
+	storage := backup.Spec.Storage
+	if storage.PersistentVolumeClaim == "" && storage.S3 == nil {
+		return fmt.Errorf("VerrazzanoBackup storage requires a persistentVolumeClaim or an s3 bucket")
+	}
+	if storage.PersistentVolumeClaim != "" && storage.S3 != nil {
+		return fmt.Errorf("VerrazzanoBackup storage can not have both a persistentVolumeClaim and an s3 bucket")
+	}
+	if storage.S3 != nil && (storage.S3.Endpoint == "" || storage.S3.Bucket == "" || storage.S3.CredentialsSecret == "") {
+		return fmt.Errorf("VerrazzanoBackup s3 storage requires an endpoint, a bucket and a credentialsSecret")
+	}
+	if strings.Contains(backup.Spec.RestoreFrom, "/") {
+		return fmt.Errorf("VerrazzanoBackup restoreFrom %s must be a backup name, not a path", backup.Spec.RestoreFrom)
+	}
+	return nil
+}
+
+// isStepRestored returns true if the step has been restored
+func isStepRestored(backup *installv1alpha1.VerrazzanoBackup, step string) bool {
+	for _, restored := range backup.Status.RestoredSteps {
+		if restored == step {
+			return true
+		}
+	}
+	return false
+}
+
+// jobName returns the name of the job that copies the tarball of the backup
+func jobName(backup *installv1alpha1.VerrazzanoBackup) string {
+	return fmt.Sprintf("vzbackup-%s", backup.Name)
+}
+
+// Create a new Result that will cause a reconcile requeue after a short delay
+func newRequeueWithDelay() ctrl.Result {
+	return vzctrl.NewRequeueWithDelay(3, 5, time.Second)
+}
@@ -0,0 +1,360 @@
+// Copyright (c) 2022, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package backup
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/verrazzano/verrazzano/pkg/k8sutil"
+	k8sutilfake "github.com/verrazzano/verrazzano/pkg/k8sutil/fake"
+	vzapi "github.com/verrazzano/verrazzano/platform-operator/apis/verrazzano/v1alpha1"
+	"github.com/verrazzano/verrazzano/platform-operator/constants"
+	"github.com/verrazzano/verrazzano/platform-operator/internal/config"
+	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+const (
+	testBackupName   = "nightly"
+	testOperatorTag  = "ghcr.io/verrazzano/verrazzano-platform-operator:1.4.0"
+	testBomFilePath  = "../verrazzano/testdata/test_bom.json"
+	testS3ClientName = "ghcr.io/verrazzano/mc:RELEASE.2022-08-11T00-30-48Z"
+)
+
+// newOperatorDeployment returns the platform operator deployment, whose image the jobs run
+func newOperatorDeployment() *appsv1.Deployment {
+	return &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Name: operatorName, Namespace: constants.VerrazzanoInstallNamespace},
+		Spec: appsv1.DeploymentSpec{
+			Template: corev1.PodTemplateSpec{
+				Spec: corev1.PodSpec{
+					Containers:       []corev1.Container{{Name: operatorName, Image: testOperatorTag}},
+					ImagePullSecrets: []corev1.LocalObjectReference{{Name: "verrazzano-container-registry"}},
+				},
+			},
+		},
+	}
+}
+
+// newBackup returns a backup to the persistent volume claim, or a restore from it when restoreFrom is set
+func newBackup(name string, restoreFrom string) *vzapi.VerrazzanoBackup {
+	return &vzapi.VerrazzanoBackup{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: constants.VerrazzanoInstallNamespace},
+		Spec: vzapi.VerrazzanoBackupSpec{
+			Storage:     vzapi.BackupStorage{PersistentVolumeClaim: "vz-backups"},
+			RestoreFrom: restoreFrom,
+		},
+	}
+}
+
+func newS3Storage() vzapi.BackupStorage {
+	return vzapi.BackupStorage{S3: &vzapi.BackupS3Storage{
+		Endpoint:          "https://objectstorage.example.com",
+		Bucket:            "vz-backups",
+		Prefix:            "/prod/",
+		CredentialsSecret: "vz-backup-credentials",
+	}}
+}
+
+func newBackupReconciler(c client.Client) *VerrazzanoBackupReconciler {
+	return &VerrazzanoBackupReconciler{Client: c, Scheme: newScheme()}
+}
+
+func reconcileBackup(t *testing.T, r *VerrazzanoBackupReconciler, name string) *vzapi.VerrazzanoBackup {
+	nsn := types.NamespacedName{Namespace: constants.VerrazzanoInstallNamespace, Name: name}
+	_, err := r.Reconcile(context.TODO(), ctrl.Request{NamespacedName: nsn})
+	assert.NoError(t, err)
+	backup := &vzapi.VerrazzanoBackup{}
+	assert.NoError(t, r.Get(context.TODO(), nsn, backup))
+	return backup
+}
+
+func getJob(t *testing.T, c client.Client, name string) *batchv1.Job {
+	job := &batchv1.Job{}
+	assert.NoError(t, c.Get(context.TODO(), types.NamespacedName{Namespace: constants.VerrazzanoInstallNamespace, Name: "vzbackup-" + name}, job))
+	return job
+}
+
+// finishJob sets the job condition that the job controller sets when the job completes or fails
+func finishJob(t *testing.T, c client.Client, name string, conditionType batchv1.JobConditionType) {
+	job := getJob(t, c, name)
+	job.Status.Conditions = []batchv1.JobCondition{{Type: conditionType, Status: corev1.ConditionTrue, Message: "BackoffLimitExceeded"}}
+	assert.NoError(t, c.Status().Update(context.TODO(), job))
+}
+
+// newJobPod returns the running pod of the job of a backup
+func newJobPod(name string) *corev1.Pod {
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "vzbackup-" + name + "-abcde",
+			Namespace: constants.VerrazzanoInstallNamespace,
+			Labels:    map[string]string{backupLabel: name},
+		},
+		Spec:   corev1.PodSpec{Containers: []corev1.Container{{Name: "upload"}}},
+		Status: corev1.PodStatus{Phase: corev1.PodRunning},
+	}
+}
+
+// setFakeExecutor makes the commands run in the job pods read stdin into PodSTDIN and write PodSTDOUT to stdout
+func setFakeExecutor(stdout string) func() {
+	newPodExecutor := k8sutil.NewPodExecutor
+	clientConfig := k8sutil.ClientConfig
+	k8sutil.NewPodExecutor = k8sutilfake.NewPodExecutor
+	k8sutil.ClientConfig = func() (*rest.Config, kubernetes.Interface, error) {
+		config, k := k8sutilfake.NewClientsetConfig()
+		return config, k, nil
+	}
+	k8sutilfake.PodSTDIN.Reset()
+	k8sutilfake.PodSTDOUT = stdout
+	return func() {
+		k8sutil.NewPodExecutor = newPodExecutor
+		k8sutil.ClientConfig = clientConfig
+		k8sutilfake.PodSTDOUT = ""
+	}
+}
+
+// TestReconcileBackup tests a backup to a persistent volume claim
+// GIVEN a VerrazzanoBackup without restoreFrom
+// WHEN the backup is reconciled, reconciled again once the job runs, and after the job completes
+// THEN the tarball is streamed to the pod of the job, and the backup completes once the job completes
+func TestReconcileBackup(t *testing.T) {
+	asserts := assert.New(t)
+	defer setFakeExecutor("")()
+	objects := append(newBackedUpObjects(), newOperatorDeployment(), newBackup(testBackupName, ""))
+	c := fake.NewClientBuilder().WithScheme(newScheme()).WithObjects(objects...).Build()
+	r := newBackupReconciler(c)
+
+	backup := reconcileBackup(t, r, testBackupName)
+	asserts.Equal(vzapi.BackupPhaseInProgress, backup.Status.Phase)
+	asserts.Equal("pvc://vz-backups/nightly.tar.gz", backup.Status.Location)
+	asserts.NotNil(backup.Status.StartTime)
+
+	job := getJob(t, c, testBackupName)
+	asserts.Equal(testBackupName, job.OwnerReferences[0].Name)
+	podSpec := job.Spec.Template.Spec
+	asserts.Equal(testOperatorTag, podSpec.Containers[0].Image)
+	asserts.Equal("verrazzano-container-registry", podSpec.ImagePullSecrets[0].Name)
+	asserts.False(*podSpec.AutomountServiceAccountToken)
+	asserts.Equal("vz-backups", podSpec.Volumes[0].PersistentVolumeClaim.ClaimName)
+	asserts.Contains(podSpec.Containers[0].Env, corev1.EnvVar{Name: "BACKUP_NAME", Value: testBackupName})
+	asserts.Contains(podSpec.Containers[0].Env, corev1.EnvVar{Name: "MARKER", Value: ".nightly.done"})
+
+	// The pod of the job is not running yet
+	backup = reconcileBackup(t, r, testBackupName)
+	asserts.Equal(0, backup.Status.ResourceCount)
+	asserts.Zero(k8sutilfake.PodSTDIN.Len())
+
+	asserts.NoError(c.Create(context.TODO(), newJobPod(testBackupName)))
+	backup = reconcileBackup(t, r, testBackupName)
+	asserts.Equal(13, backup.Status.ResourceCount)
+	asserts.Equal(tarballWrittenMessage, backup.Status.Message)
+	resources, err := readTarball(k8sutilfake.PodSTDIN)
+	asserts.NoError(err)
+	asserts.Equal(13, countResources(resources))
+
+	// The tarball is streamed once
+	k8sutilfake.PodSTDIN.Reset()
+	backup = reconcileBackup(t, r, testBackupName)
+	asserts.Equal(vzapi.BackupPhaseInProgress, backup.Status.Phase)
+	asserts.Zero(k8sutilfake.PodSTDIN.Len())
+
+	finishJob(t, c, testBackupName, batchv1.JobComplete)
+	backup = reconcileBackup(t, r, testBackupName)
+	asserts.Equal(vzapi.BackupPhaseCompleted, backup.Status.Phase)
+	asserts.NotNil(backup.Status.CompletionTime)
+}
+
+// TestReconcileBackupS3Failed tests a backup to a bucket whose upload fails
+// GIVEN a VerrazzanoBackup to an S3 compatible bucket
+// WHEN the backup is reconciled, and reconciled again after the job fails
+// THEN the job uploads the tarball with the S3 client, and the backup fails once the job fails
+func TestReconcileBackupS3Failed(t *testing.T) {
+	asserts := assert.New(t)
+	config.SetDefaultBomFilePath(testBomFilePath)
+	defer config.SetDefaultBomFilePath("")
+	backup := newBackup(testBackupName, "")
+	backup.Spec.Storage = newS3Storage()
+	c := fake.NewClientBuilder().WithScheme(newScheme()).WithObjects(newTestVZ(), newOperatorDeployment(), backup).Build()
+	r := newBackupReconciler(c)
+
+	backup = reconcileBackup(t, r, testBackupName)
+	asserts.Equal("s3://vz-backups/prod/nightly.tar.gz", backup.Status.Location)
+	podSpec := getJob(t, c, testBackupName).Spec.Template.Spec
+	asserts.Len(podSpec.Volumes, 1)
+	upload := podSpec.Containers[0]
+	asserts.Equal(testS3ClientName, upload.Image)
+	asserts.Contains(upload.Env, corev1.EnvVar{Name: "S3_PREFIX", Value: "prod/"})
+	asserts.Equal("vz-backup-credentials", upload.Env[5].ValueFrom.SecretKeyRef.Name)
+	asserts.Contains(upload.Args[0], "mc --config-dir /tmp/mc cp")
+
+	finishJob(t, c, testBackupName, batchv1.JobFailed)
+	backup = reconcileBackup(t, r, testBackupName)
+	asserts.Equal(vzapi.BackupPhaseFailed, backup.Status.Phase)
+	asserts.Contains(backup.Status.Message, "BackoffLimitExceeded")
+
+	// A failed backup is not retried
+	backup = reconcileBackup(t, r, testBackupName)
+	asserts.Equal(vzapi.BackupPhaseFailed, backup.Status.Phase)
+}
+
+// TestReconcileRestore tests a restore from a bucket
+// GIVEN a VerrazzanoBackup with restoreFrom set, on a cluster without Verrazzano
+// WHEN the restore is reconciled until the tarball is read from the job, Verrazzano is restored and becomes ready
+// THEN the steps are restored in order and the resources that need Verrazzano wait until it is ready
+func TestReconcileRestore(t *testing.T) {
+	asserts := assert.New(t)
+	config.SetDefaultBomFilePath(testBomFilePath)
+	defer config.SetDefaultBomFilePath("")
+
+	// Write the tarball of a cluster that is backed up
+	source := fake.NewClientBuilder().WithScheme(newScheme()).WithObjects(newBackedUpObjects()...).Build()
+	resources, err := collectResources(context.TODO(), source)
+	asserts.NoError(err)
+	tarball := &bytes.Buffer{}
+	asserts.NoError(writeTarball(tarball, resources))
+	defer setFakeExecutor(tarball.String())()
+
+	restore := newBackup("restore-nightly", testBackupName)
+	restore.Spec.Storage = newS3Storage()
+	c := fake.NewClientBuilder().WithScheme(newScheme()).WithObjects(newOperatorDeployment(), restore).Build()
+	r := newBackupReconciler(c)
+
+	restore = reconcileBackup(t, r, restore.Name)
+	asserts.Equal("s3://vz-backups/prod/nightly.tar.gz", restore.Status.Location)
+	asserts.Equal("Reading the backup tarball", restore.Status.Message)
+	podSpec := getJob(t, c, restore.Name).Spec.Template.Spec
+	asserts.Equal(testS3ClientName, podSpec.InitContainers[0].Image)
+	asserts.Equal(testOperatorTag, podSpec.Containers[0].Image)
+	asserts.Contains(podSpec.Containers[0].Env, corev1.EnvVar{Name: "BACKUP_NAME", Value: testBackupName})
+	asserts.Contains(podSpec.Containers[0].Env, corev1.EnvVar{Name: "MARKER", Value: ".restore-nightly.done"})
+
+	// The tarball is read from the pod of the job
+	asserts.NoError(c.Create(context.TODO(), newJobPod(restore.Name)))
+	restore = reconcileBackup(t, r, restore.Name)
+	asserts.Equal([]string{namespacesStep, overridesStep, verrazzanoStep}, restore.Status.RestoredSteps)
+	asserts.Equal("Waiting for Verrazzano to be ready", restore.Status.Message)
+	vz := &vzapi.Verrazzano{}
+	asserts.NoError(c.Get(context.TODO(), types.NamespacedName{Namespace: testVZNamespace, Name: "verrazzano"}, vz))
+	asserts.Equal(vzapi.Prod, vz.Spec.Profile)
+	cm := &corev1.ConfigMap{}
+	asserts.NoError(c.Get(context.TODO(), types.NamespacedName{Namespace: testVZNamespace, Name: testOverrideCM}, cm))
+	_, err = getResource(context.TODO(), c, projectGVK, constants.VerrazzanoMultiClusterNamespace, "hello-project")
+	asserts.NoError(err)
+
+	// The job completes once the tarball is read, a restarted operator reads the tarball again with a new job
+	finishJob(t, c, restore.Name, batchv1.JobComplete)
+	reconcileBackup(t, newBackupReconciler(c), restore.Name)
+	err = c.Get(context.TODO(), types.NamespacedName{Namespace: constants.VerrazzanoInstallNamespace, Name: "vzbackup-" + restore.Name}, &batchv1.Job{})
+	asserts.True(errors.IsNotFound(err))
+
+	vz.Status.State = vzapi.VzStateReady
+	asserts.NoError(c.Status().Update(context.TODO(), vz))
+	restore = reconcileBackup(t, r, restore.Name)
+	asserts.Equal(vzapi.BackupPhaseCompleted, restore.Status.Phase)
+	asserts.Len(restore.Status.RestoredSteps, len(backupSteps))
+	for _, check := range []*unstructured.Unstructured{
+		newUnstructured(vmcGVK, constants.VerrazzanoMultiClusterNamespace, testVMCName),
+		newUnstructured(projectGVK, constants.VerrazzanoMultiClusterNamespace, "hello-project"),
+		newUnstructured(oamGVKs[1], testAppNamespace, "hello-app"),
+	} {
+		restored, err := getResource(context.TODO(), c, check.GroupVersionKind(), check.GetNamespace(), check.GetName())
+		asserts.NoError(err)
+		asserts.NotNil(restored, check.GetName())
+	}
+	asserts.Empty(r.restoring)
+}
+
+// TestReconcileRestoreDeleted tests a restore that is deleted while it waits for Verrazzano
+// GIVEN a VerrazzanoBackup with restoreFrom set whose tarball was read from the job
+// WHEN the restore is deleted and reconciled
+// THEN the resources of its tarball are dropped
+func TestReconcileRestoreDeleted(t *testing.T) {
+	asserts := assert.New(t)
+	source := fake.NewClientBuilder().WithScheme(newScheme()).WithObjects(newBackedUpObjects()...).Build()
+	resources, err := collectResources(context.TODO(), source)
+	asserts.NoError(err)
+	tarball := &bytes.Buffer{}
+	asserts.NoError(writeTarball(tarball, resources))
+	defer setFakeExecutor(tarball.String())()
+
+	restore := newBackup("restore-nightly", testBackupName)
+	c := fake.NewClientBuilder().WithScheme(newScheme()).WithObjects(newOperatorDeployment(), restore, newJobPod(restore.Name)).Build()
+	r := newBackupReconciler(c)
+
+	reconcileBackup(t, r, restore.Name)
+	restore = reconcileBackup(t, r, restore.Name)
+	asserts.Equal("Waiting for Verrazzano to be ready", restore.Status.Message)
+	asserts.Len(r.restoring, 1)
+
+	asserts.NoError(c.Delete(context.TODO(), restore))
+	_, err = r.Reconcile(context.TODO(), ctrl.Request{NamespacedName: client.ObjectKeyFromObject(restore)})
+	asserts.NoError(err)
+	asserts.Empty(r.restoring)
+}
+
+// TestReconcileRestoreInvalidTarball tests a restore of a tarball that can not be read
+// GIVEN a VerrazzanoBackup with restoreFrom set whose tarball is not valid
+// WHEN the restore is reconciled until the tarball is read from the job
+// THEN the restore fails
+func TestReconcileRestoreInvalidTarball(t *testing.T) {
+	defer setFakeExecutor("not a tarball")()
+	restore := newBackup("restore-nightly", testBackupName)
+	c := fake.NewClientBuilder().WithScheme(newScheme()).WithObjects(newOperatorDeployment(), restore, newJobPod(restore.Name)).Build()
+	r := newBackupReconciler(c)
+
+	restore = reconcileBackup(t, r, restore.Name)
+	assert.Equal(t, vzapi.BackupPhaseInProgress, restore.Status.Phase)
+	restore = reconcileBackup(t, r, restore.Name)
+	assert.Equal(t, vzapi.BackupPhaseFailed, restore.Status.Phase)
+}
+
+// TestValidateBackup tests the validation of VerrazzanoBackup resources
+// GIVEN valid and invalid VerrazzanoBackup resources
+// WHEN I call validateBackup
+// THEN an error is returned for the invalid resources
+func TestValidateBackup(t *testing.T) {
+	tests := []struct {
+		name   string
+		modify func(backup *vzapi.VerrazzanoBackup)
+		valid  bool
+	}{
+		{name: "pvc", modify: func(backup *vzapi.VerrazzanoBackup) {}, valid: true},
+		{name: "s3", modify: func(backup *vzapi.VerrazzanoBackup) { backup.Spec.Storage = newS3Storage() }, valid: true},
+		{name: "other namespace", modify: func(backup *vzapi.VerrazzanoBackup) { backup.Namespace = "default" }},
+		{name: "long name", modify: func(backup *vzapi.VerrazzanoBackup) {
+			backup.Name = "a-backup-name-that-is-too-long-for-the-name-of-its-job"
+		}},
+		{name: "no storage", modify: func(backup *vzapi.VerrazzanoBackup) { backup.Spec.Storage = vzapi.BackupStorage{} }},
+		{name: "pvc and s3", modify: func(backup *vzapi.VerrazzanoBackup) { backup.Spec.Storage.S3 = newS3Storage().S3 }},
+		{name: "s3 without bucket", modify: func(backup *vzapi.VerrazzanoBackup) {
+			backup.Spec.Storage = newS3Storage()
+			backup.Spec.Storage.S3.Bucket = ""
+		}},
+		{name: "restore path", modify: func(backup *vzapi.VerrazzanoBackup) { backup.Spec.RestoreFrom = "../nightly" }},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			backup := newBackup(testBackupName, "")
+			tt.modify(backup)
+			if tt.valid {
+				assert.NoError(t, validateBackup(backup))
+			} else {
+				assert.Error(t, validateBackup(backup))
+			}
+		})
+	}
+}
@@ -0,0 +1,259 @@
+// Copyright (c) 2022, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package backup
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/verrazzano/verrazzano/pkg/bom"
+	installv1alpha1 "github.com/verrazzano/verrazzano/platform-operator/apis/verrazzano/v1alpha1"
+	"github.com/verrazzano/verrazzano/platform-operator/constants"
+	"github.com/verrazzano/verrazzano/platform-operator/internal/config"
+	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// operatorName is the name of the platform operator deployment, the jobs run the operator image
+	operatorName = "verrazzano-platform-operator"
+
+	// s3ClientSubcomponent is the BOM subcomponent with the S3 client image
+	s3ClientSubcomponent = "mysql-backup-client"
+
+	storageVolumeName = "storage"
+	storageMountPath  = "/storage"
+	s3AccessKey       = "accessKey"
+	s3SecretKey       = "secretKey"
+)
+
+// scriptHeader makes the job scripts fail on the first error
+const scriptHeader = `set -eo pipefail
+`
+
+// waitForOperatorScript waits up to 30 minutes for the operator to stream the tarball to the storage volume, or to
+// read it from the storage volume, and to touch the marker file
+const waitForOperatorScript = `end=$((SECONDS+1800))
+until [ -f "` + storageMountPath + `/${MARKER}" ]; do
+  [ ${SECONDS} -lt ${end} ] || { echo "Timed out waiting for the platform operator"; exit 1; }
+  sleep 2
+done
+rm -f "` + storageMountPath + `/${MARKER}"
+`
+
+// moveToVolumeScript names the tarball streamed to the persistent volume claim once it is complete
+const moveToVolumeScript = `mv "` + storageMountPath + `/${BACKUP_NAME}.tar.gz.tmp" "` + storageMountPath + `/${BACKUP_NAME}.tar.gz"
+echo "Wrote backup ${BACKUP_NAME}.tar.gz"
+`
+
+// s3AliasScript configures the bucket endpoint and credentials of the S3 client
+const s3AliasScript = `mc --config-dir /tmp/mc alias set backup "${S3_ENDPOINT}" "${S3_ACCESS_KEY}" "${S3_SECRET_KEY}" >/dev/null
+`
+
+// uploadScript uploads the streamed tarball to the bucket
+const uploadScript = `mc --config-dir /tmp/mc cp "` + storageMountPath + `/${BACKUP_NAME}.tar.gz.tmp" "backup/${S3_BUCKET}/${S3_PREFIX}${BACKUP_NAME}.tar.gz"
+echo "Uploaded backup ${BACKUP_NAME}.tar.gz"
+`
+
+// downloadScript downloads the tarball from the bucket to the storage volume
+const downloadScript = `mc --config-dir /tmp/mc cp "backup/${S3_BUCKET}/${S3_PREFIX}${BACKUP_NAME}.tar.gz" ` + storageMountPath + `/
+`
+
+// checkTarballScript fails the restore job if the tarball does not exist, instead of waiting for the operator
+const checkTarballScript = `[ -f "` + storageMountPath + `/${BACKUP_NAME}.tar.gz" ] || { echo "Backup ${BACKUP_NAME}.tar.gz not found"; exit 1; }
+`
+
+// buildUploadPodSpec returns the spec of the pod that receives the tarball streamed by the operator on the persistent
+// volume claim, or uploads it to the bucket
+func buildUploadPodSpec(ctx context.Context, c client.Client, backup *installv1alpha1.VerrazzanoBackup) (corev1.PodSpec, error) {
+	operator, err := getOperatorDeployment(ctx, c)
+	if err != nil {
+		return corev1.PodSpec{}, err
+	}
+	upload := corev1.Container{
+		Name:         "upload",
+		Image:        operator.Spec.Template.Spec.Containers[0].Image,
+		Command:      []string{"/bin/bash", "-c"},
+		Args:         []string{scriptHeader + waitForOperatorScript + moveToVolumeScript},
+		Env:          []corev1.EnvVar{{Name: "BACKUP_NAME", Value: backup.Name}, {Name: "MARKER", Value: markerName(backup)}},
+		VolumeMounts: []corev1.VolumeMount{{Name: storageVolumeName, MountPath: storageMountPath}},
+	}
+	if s3 := backup.Spec.Storage.S3; s3 != nil {
+		if upload.Image, err = getS3ClientImage(); err != nil {
+			return corev1.PodSpec{}, err
+		}
+		upload.Args = []string{scriptHeader + waitForOperatorScript + s3AliasScript + uploadScript}
+		upload.Env = append(upload.Env, s3Env(s3)...)
+	}
+	return newJobPodSpec(operator, backup, upload), nil
+}
+
+// buildDownloadPodSpec returns the spec of the pod that keeps the tarball on the persistent volume claim, or the
+// tarball downloaded from the bucket, available until the operator has read it
+func buildDownloadPodSpec(ctx context.Context, c client.Client, backup *installv1alpha1.VerrazzanoBackup) (corev1.PodSpec, error) {
+	operator, err := getOperatorDeployment(ctx, c)
+	if err != nil {
+		return corev1.PodSpec{}, err
+	}
+	restore := corev1.Container{
+		Name:    "restore",
+		Image:   operator.Spec.Template.Spec.Containers[0].Image,
+		Command: []string{"/bin/bash", "-c"},
+		Args:    []string{scriptHeader + checkTarballScript + waitForOperatorScript},
+		Env: []corev1.EnvVar{
+			{Name: "BACKUP_NAME", Value: backup.Spec.RestoreFrom},
+			{Name: "MARKER", Value: markerName(backup)},
+		},
+		VolumeMounts: []corev1.VolumeMount{{Name: storageVolumeName, MountPath: storageMountPath}},
+	}
+	podSpec := newJobPodSpec(operator, backup, restore)
+	if s3 := backup.Spec.Storage.S3; s3 != nil {
+		image, err := getS3ClientImage()
+		if err != nil {
+			return corev1.PodSpec{}, err
+		}
+		podSpec.InitContainers = []corev1.Container{{
+			Name:            "download",
+			Image:           image,
+			ImagePullPolicy: corev1.PullIfNotPresent,
+			Command:         []string{"/bin/bash", "-c"},
+			Args:            []string{scriptHeader + s3AliasScript + downloadScript},
+			Env:             append([]corev1.EnvVar{{Name: "BACKUP_NAME", Value: backup.Spec.RestoreFrom}}, s3Env(s3)...),
+			VolumeMounts:    []corev1.VolumeMount{{Name: storageVolumeName, MountPath: storageMountPath}},
+		}}
+	}
+	return podSpec, nil
+}
+
+// newJobPodSpec returns a pod spec with the container and the storage volume, which is the persistent volume claim,
+// or a temporary volume when the tarball is kept in a bucket
+func newJobPodSpec(operator *appsv1.Deployment, backup *installv1alpha1.VerrazzanoBackup, container corev1.Container) corev1.PodSpec {
+	volume := corev1.Volume{Name: storageVolumeName}
+	if claim := backup.Spec.Storage.PersistentVolumeClaim; claim != "" {
+		volume.PersistentVolumeClaim = &corev1.PersistentVolumeClaimVolumeSource{ClaimName: claim}
+	} else {
+		volume.EmptyDir = &corev1.EmptyDirVolumeSource{}
+	}
+	container.ImagePullPolicy = corev1.PullIfNotPresent
+	automountToken := false
+	return corev1.PodSpec{
+		RestartPolicy:                corev1.RestartPolicyNever,
+		AutomountServiceAccountToken: &automountToken,
+		ImagePullSecrets:             operator.Spec.Template.Spec.ImagePullSecrets,
+		Containers:                   []corev1.Container{container},
+		Volumes:                      []corev1.Volume{volume},
+	}
+}
+
+// newJob returns the job that runs the pod of the backup or restore
+func newJob(backup *installv1alpha1.VerrazzanoBackup, podSpec corev1.PodSpec) *batchv1.Job {
+	backoffLimit := int32(2)
+	labels := map[string]string{backupLabel: backup.Name}
+	return &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      jobName(backup),
+			Namespace: backup.Namespace,
+			Labels:    labels,
+		},
+		Spec: batchv1.JobSpec{
+			BackoffLimit: &backoffLimit,
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{Labels: labels},
+				Spec:       podSpec,
+			},
+		},
+	}
+}
+
+// getJobResult returns JobComplete or JobFailed and the message of the condition once the job has finished, or an
+// empty condition type while it runs
+func getJobResult(job *batchv1.Job) (batchv1.JobConditionType, string) {
+	for _, condition := range job.Status.Conditions {
+		if condition.Status == corev1.ConditionTrue && (condition.Type == batchv1.JobComplete || condition.Type == batchv1.JobFailed) {
+			return condition.Type, condition.Message
+		}
+	}
+	return "", ""
+}
+
+// getOperatorDeployment returns the platform operator deployment, whose image and image pull secrets the jobs use
+func getOperatorDeployment(ctx context.Context, c client.Client) (*appsv1.Deployment, error) {
+	operator := &appsv1.Deployment{}
+	if err := c.Get(ctx, client.ObjectKey{Namespace: constants.VerrazzanoInstallNamespace, Name: operatorName}, operator); err != nil {
+		return nil, fmt.Errorf("Failed getting the platform operator deployment: %v", err)
+	}
+	if len(operator.Spec.Template.Spec.Containers) == 0 {
+		return nil, fmt.Errorf("The platform operator deployment has no containers")
+	}
+	return operator, nil
+}
+
+// getS3ClientImage returns the S3 client image of the BOM
+func getS3ClientImage() (string, error) {
+	bomFile, err := bom.NewBom(config.GetDefaultBOMFilePath())
+	if err != nil {
+		return "", err
+	}
+	images, err := bomFile.GetImageNameList(s3ClientSubcomponent)
+	if err != nil {
+		return "", err
+	}
+	if len(images) != 1 {
+		return "", fmt.Errorf("Expected 1 %s image, got %d", s3ClientSubcomponent, len(images))
+	}
+	return images[0], nil
+}
+
+// s3Env returns the environment variables with the bucket and its credentials
+func s3Env(s3 *installv1alpha1.BackupS3Storage) []corev1.EnvVar {
+	prefix := strings.Trim(s3.Prefix, "/")
+	if prefix != "" {
+		prefix = prefix + "/"
+	}
+	secretEnv := func(name string, key string) corev1.EnvVar {
+		return corev1.EnvVar{
+			Name: name,
+			ValueFrom: &corev1.EnvVarSource{
+				SecretKeyRef: &corev1.SecretKeySelector{
+					LocalObjectReference: corev1.LocalObjectReference{Name: s3.CredentialsSecret},
+					Key:                  key,
+				},
+			},
+		}
+	}
+	return []corev1.EnvVar{
+		{Name: "S3_ENDPOINT", Value: s3.Endpoint},
+		{Name: "S3_BUCKET", Value: s3.Bucket},
+		{Name: "S3_PREFIX", Value: prefix},
+		secretEnv("S3_ACCESS_KEY", s3AccessKey),
+		secretEnv("S3_SECRET_KEY", s3SecretKey),
+	}
+}
+
+// tarballPath returns the path of the tarball of a backup on the storage volume of the job
+func tarballPath(backupName string) string {
+	return fmt.Sprintf("%s/%s.tar.gz", storageMountPath, backupName)
+}
+
+// markerName returns the name of the file that the operator touches on the storage volume once it has streamed the
+// tarball to the job, or read it from the job
+func markerName(backup *installv1alpha1.VerrazzanoBackup) string {
+	return fmt.Sprintf(".%s.done", backup.Name)
+}
+
+// getLocation returns the location of the tarball of the backup
+func getLocation(storage installv1alpha1.BackupStorage, backupName string) string {
+	if storage.S3 != nil {
+		prefix := strings.Trim(storage.S3.Prefix, "/")
+		if prefix != "" {
+			prefix = prefix + "/"
+		}
+		return fmt.Sprintf("s3://%s/%s%s.tar.gz", storage.S3.Bucket, prefix, backupName)
+	}
+	return fmt.Sprintf("pvc://%s/%s.tar.gz", storage.PersistentVolumeClaim, backupName)
+}
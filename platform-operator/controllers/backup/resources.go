@@ -0,0 +1,378 @@
+// Copyright (c) 2022, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package backup
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"context"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"time"
+
+	vzconst "github.com/verrazzano/verrazzano/pkg/constants"
+	clustersv1alpha1 "github.com/verrazzano/verrazzano/platform-operator/apis/clusters/v1alpha1"
+	installv1alpha1 "github.com/verrazzano/verrazzano/platform-operator/apis/verrazzano/v1alpha1"
+	"github.com/verrazzano/verrazzano/platform-operator/constants"
+	"github.com/verrazzano/verrazzano/platform-operator/controllers/clusters"
+	"github.com/verrazzano/verrazzano/platform-operator/controllers/verrazzano/component/registry"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	utilyaml "k8s.io/apimachinery/pkg/util/yaml"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	namespacesStep      = "namespaces"
+	overridesStep       = "overrides"
+	verrazzanoStep      = "verrazzano"
+	managedClustersStep = "managedclusters"
+	projectsStep        = "projects"
+	multiclusterStep    = "multicluster"
+	applicationsStep    = "applications"
+)
+
+var (
+	namespaceGVK  = schema.GroupVersionKind{Version: "v1", Kind: "Namespace"}
+	configMapGVK  = schema.GroupVersionKind{Version: "v1", Kind: "ConfigMap"}
+	secretGVK     = schema.GroupVersionKind{Version: "v1", Kind: "Secret"}
+	verrazzanoGVK = installv1alpha1.SchemeGroupVersion.WithKind("Verrazzano")
+	vmcGVK        = clustersv1alpha1.SchemeGroupVersion.WithKind("VerrazzanoManagedCluster")
+	projectGVK    = schema.GroupVersionKind{Group: "clusters.verrazzano.io", Version: "v1alpha1", Kind: "VerrazzanoProject"}
+	mcGVKs        = []schema.GroupVersionKind{
+		{Group: "clusters.verrazzano.io", Version: "v1alpha1", Kind: "MultiClusterConfigMap"},
+		{Group: "clusters.verrazzano.io", Version: "v1alpha1", Kind: "MultiClusterSecret"},
+		{Group: "clusters.verrazzano.io", Version: "v1alpha1", Kind: "MultiClusterComponent"},
+		{Group: "clusters.verrazzano.io", Version: "v1alpha1", Kind: "MultiClusterApplicationConfiguration"},
+	}
+	oamGVKs = []schema.GroupVersionKind{
+		{Group: "core.oam.dev", Version: "v1alpha2", Kind: "Component"},
+		{Group: "core.oam.dev", Version: "v1alpha2", Kind: "ApplicationConfiguration"},
+	}
+)
+
+// backupStep is a group of resources that are restored together.  The steps are restored in order, so the resources
+// of a step can depend on the resources of the steps before it.
+type backupStep struct {
+	name string
+	// collect returns the resources of the step, nil for the namespaces which are collected from the other steps
+	collect func(ctx context.Context, c client.Client, vzList []installv1alpha1.Verrazzano) ([]unstructured.Unstructured, error)
+	// waitForVerrazzano is true if the resources can only be restored once Verrazzano is ready, the CRDs and
+	// webhooks of the resources are installed by Verrazzano
+	waitForVerrazzano bool
+}
+
+// backupSteps are the steps in restore order
+var backupSteps = []backupStep{
+	{name: namespacesStep},
+	{name: overridesStep, collect: collectOverrides},
+	{name: verrazzanoStep, collect: collectVerrazzano},
+	{name: managedClustersStep, collect: collectManagedClusters, waitForVerrazzano: true},
+	{name: projectsStep, collect: listKinds(projectGVK), waitForVerrazzano: true},
+	{name: multiclusterStep, collect: listKinds(mcGVKs...), waitForVerrazzano: true},
+	{name: applicationsStep, collect: listKinds(oamGVKs...), waitForVerrazzano: true},
+}
+
+// metadataFields are removed from the backed up resources, they are set by the cluster the resources are restored to
+var metadataFields = []string{"uid", "resourceVersion", "generation", "creationTimestamp", "deletionTimestamp",
+	"deletionGracePeriodSeconds", "managedFields", "selfLink", "ownerReferences", "finalizers"}
+
+// collectResources returns the resources of each backup step
+func collectResources(ctx context.Context, c client.Client) (map[string][]unstructured.Unstructured, error) {
+	vzList := installv1alpha1.VerrazzanoList{}
+	if err := c.List(ctx, &vzList); err != nil {
+		return nil, fmt.Errorf("Failed listing the Verrazzano resources: %v", err)
+	}
+	resources := map[string][]unstructured.Unstructured{}
+	for _, step := range backupSteps {
+		if step.collect == nil {
+			continue
+		}
+		objects, err := step.collect(ctx, c, vzList.Items)
+		if err != nil {
+			return nil, err
+		}
+		for i := range objects {
+			cleanResource(&objects[i])
+		}
+		resources[step.name] = objects
+	}
+
+	namespaces, err := collectNamespaces(ctx, c, resources)
+	if err != nil {
+		return nil, err
+	}
+	resources[namespacesStep] = namespaces
+	return resources, nil
+}
+
+// collectVerrazzano returns the Verrazzano resources
+func collectVerrazzano(_ context.Context, _ client.Client, vzList []installv1alpha1.Verrazzano) ([]unstructured.Unstructured, error) {
+	var objects []unstructured.Unstructured
+	for i := range vzList {
+		object, err := runtime.DefaultUnstructuredConverter.ToUnstructured(&vzList[i])
+		if err != nil {
+			return nil, fmt.Errorf("Failed converting Verrazzano resource %s/%s: %v", vzList[i].Namespace, vzList[i].Name, err)
+		}
+		u := unstructured.Unstructured{Object: object}
+		u.SetGroupVersionKind(verrazzanoGVK)
+		objects = append(objects, u)
+	}
+	return objects, nil
+}
+
+// collectOverrides returns the ConfigMaps and Secrets referenced by the overrides of the components of the Verrazzano
+// resources
+func collectOverrides(ctx context.Context, c client.Client, vzList []installv1alpha1.Verrazzano) ([]unstructured.Unstructured, error) {
+	var objects []unstructured.Unstructured
+	found := map[string]bool{}
+	for i := range vzList {
+		vz := &vzList[i]
+		for _, comp := range registry.GetComponents() {
+			for _, override := range comp.GetOverrides(vz) {
+				gvk, name := configMapGVK, ""
+				if override.ConfigMapRef != nil {
+					name = override.ConfigMapRef.Name
+				} else if override.SecretRef != nil {
+					gvk, name = secretGVK, override.SecretRef.Name
+				}
+				key := fmt.Sprintf("%s/%s/%s", gvk.Kind, vz.Namespace, name)
+				if name == "" || found[key] {
+					continue
+				}
+				found[key] = true
+				object, err := getResource(ctx, c, gvk, vz.Namespace, name)
+				if err != nil {
+					return nil, err
+				}
+				if object != nil {
+					objects = append(objects, *object)
+				}
+			}
+		}
+	}
+	return objects, nil
+}
+
+// collectManagedClusters returns the VerrazzanoManagedClusters, each preceded by its CA, agent, registration and
+// manifest secrets
+func collectManagedClusters(ctx context.Context, c client.Client, _ []installv1alpha1.Verrazzano) ([]unstructured.Unstructured, error) {
+	vmcList := clustersv1alpha1.VerrazzanoManagedClusterList{}
+	if err := c.List(ctx, &vmcList, client.InNamespace(constants.VerrazzanoMultiClusterNamespace)); err != nil {
+		if meta.IsNoMatchError(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("Failed listing the VerrazzanoManagedClusters: %v", err)
+	}
+	var objects []unstructured.Unstructured
+	for i := range vmcList.Items {
+		vmc := &vmcList.Items[i]
+		secretNames := []string{vmc.Spec.CASecret, clusters.GetAgentSecretName(vmc.Name),
+			clusters.GetRegistrationSecretName(vmc.Name), clusters.GetManifestSecretName(vmc.Name)}
+		for _, name := range secretNames {
+			if name == "" {
+				continue
+			}
+			object, err := getResource(ctx, c, secretGVK, vmc.Namespace, name)
+			if err != nil {
+				return nil, err
+			}
+			if object != nil {
+				objects = append(objects, *object)
+			}
+		}
+		object, err := runtime.DefaultUnstructuredConverter.ToUnstructured(vmc)
+		if err != nil {
+			return nil, fmt.Errorf("Failed converting VerrazzanoManagedCluster %s: %v", vmc.Name, err)
+		}
+		u := unstructured.Unstructured{Object: object}
+		u.SetGroupVersionKind(vmcGVK)
+		objects = append(objects, u)
+	}
+	return objects, nil
+}
+
+// listKinds returns a collect function that lists the resources of the kinds in all namespaces.  Resources owned by
+// another resource are skipped, their owner recreates them.  Kinds whose CRD is not installed have no resources.
+func listKinds(gvks ...schema.GroupVersionKind) func(context.Context, client.Client, []installv1alpha1.Verrazzano) ([]unstructured.Unstructured, error) {
+	return func(ctx context.Context, c client.Client, _ []installv1alpha1.Verrazzano) ([]unstructured.Unstructured, error) {
+		var objects []unstructured.Unstructured
+		for _, gvk := range gvks {
+			list := unstructured.UnstructuredList{}
+			list.SetGroupVersionKind(gvk.GroupVersion().WithKind(gvk.Kind + "List"))
+			if err := c.List(ctx, &list); err != nil {
+				if meta.IsNoMatchError(err) {
+					continue
+				}
+				return nil, fmt.Errorf("Failed listing the %s resources: %v", gvk.Kind, err)
+			}
+			for _, item := range list.Items {
+				if len(item.GetOwnerReferences()) > 0 {
+					continue
+				}
+				item.SetGroupVersionKind(gvk)
+				objects = append(objects, item)
+			}
+		}
+		return objects, nil
+	}
+}
+
+// collectNamespaces returns the namespaces of the collected resources, except the system namespaces that Kubernetes and
+// Verrazzano create
+func collectNamespaces(ctx context.Context, c client.Client, resources map[string][]unstructured.Unstructured) ([]unstructured.Unstructured, error) {
+	names := map[string]bool{}
+	for _, objects := range resources {
+		for _, object := range objects {
+			if ns := object.GetNamespace(); ns != "" && ns != "default" && !strings.HasPrefix(ns, "kube-") {
+				names[ns] = true
+			}
+		}
+	}
+	var sorted []string
+	for name := range names {
+		sorted = append(sorted, name)
+	}
+	sort.Strings(sorted)
+
+	var namespaces []unstructured.Unstructured
+	for _, name := range sorted {
+		ns, err := getResource(ctx, c, namespaceGVK, "", name)
+		if err != nil {
+			return nil, err
+		}
+		if ns == nil || ns.GetLabels()[vzconst.LabelVerrazzanoNamespace] != "" {
+			continue
+		}
+		cleanResource(ns)
+		unstructured.RemoveNestedField(ns.Object, "spec")
+		namespaces = append(namespaces, *ns)
+	}
+	return namespaces, nil
+}
+
+// getResource returns the resource, or nil if it does not exist
+func getResource(ctx context.Context, c client.Client, gvk schema.GroupVersionKind, namespace string, name string) (*unstructured.Unstructured, error) {
+	object := &unstructured.Unstructured{}
+	object.SetGroupVersionKind(gvk)
+	if err := c.Get(ctx, client.ObjectKey{Namespace: namespace, Name: name}, object); err != nil {
+		if errors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("Failed getting %s %s/%s: %v", gvk.Kind, namespace, name, err)
+	}
+	object.SetGroupVersionKind(gvk)
+	return object, nil
+}
+
+// cleanResource removes the status and the metadata that is owned by the cluster from the resource
+func cleanResource(object *unstructured.Unstructured) {
+	unstructured.RemoveNestedField(object.Object, "status")
+	for _, field := range metadataFields {
+		unstructured.RemoveNestedField(object.Object, "metadata", field)
+	}
+}
+
+// writeTarball streams the resources of each step to a YAML file of a gzipped tarball, in restore order
+func writeTarball(w io.Writer, resources map[string][]unstructured.Unstructured) error {
+	gz := gzip.NewWriter(w)
+	tw := tar.NewWriter(gz)
+	for _, step := range backupSteps {
+		var content []byte
+		for _, object := range resources[step.name] {
+			objectYAML, err := yaml.Marshal(object.Object)
+			if err != nil {
+				return fmt.Errorf("Failed marshalling %s %s/%s: %v", object.GetKind(), object.GetNamespace(), object.GetName(), err)
+			}
+			content = append(content, []byte("---\n")...)
+			content = append(content, objectYAML...)
+		}
+		header := &tar.Header{Name: step.name + ".yaml", Mode: 0600, Size: int64(len(content)), ModTime: time.Now()}
+		if err := tw.WriteHeader(header); err != nil {
+			return err
+		}
+		if _, err := tw.Write(content); err != nil {
+			return err
+		}
+	}
+	if err := tw.Close(); err != nil {
+		return err
+	}
+	return gz.Close()
+}
+
+// readTarball returns the resources of each step of a tarball written by writeTarball
+func readTarball(r io.Reader) (map[string][]unstructured.Unstructured, error) {
+	gz, err := gzip.NewReader(r)
+	if err != nil {
+		return nil, fmt.Errorf("Failed reading the backup tarball: %v", err)
+	}
+	tr := tar.NewReader(gz)
+	resources := map[string][]unstructured.Unstructured{}
+	for {
+		header, err := tr.Next()
+		if err == io.EOF {
+			return resources, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("Failed reading the backup tarball: %v", err)
+		}
+		step := strings.TrimSuffix(header.Name, ".yaml")
+		decoder := utilyaml.NewYAMLOrJSONDecoder(tr, 4096)
+		for {
+			object := map[string]interface{}{}
+			if err := decoder.Decode(&object); err != nil {
+				if err == io.EOF {
+					break
+				}
+				return nil, fmt.Errorf("Failed reading %s of the backup tarball: %v", header.Name, err)
+			}
+			if len(object) > 0 {
+				resources[step] = append(resources[step], unstructured.Unstructured{Object: object})
+			}
+		}
+	}
+}
+
+// countResources returns the number of resources of all the steps
+func countResources(resources map[string][]unstructured.Unstructured) int {
+	count := 0
+	for _, objects := range resources {
+		count += len(objects)
+	}
+	return count
+}
+
+// restoreResources creates the resources, or updates them if they exist.  Namespaces that exist are not updated.
+func restoreResources(ctx context.Context, c client.Client, objects []unstructured.Unstructured) error {
+	for i := range objects {
+		desired := objects[i].DeepCopy()
+		existing := &unstructured.Unstructured{}
+		existing.SetGroupVersionKind(desired.GroupVersionKind())
+		err := c.Get(ctx, client.ObjectKeyFromObject(desired), existing)
+		if errors.IsNotFound(err) {
+			if err := c.Create(ctx, desired); err != nil {
+				return err
+			}
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		if desired.GroupVersionKind() == namespaceGVK {
+			continue
+		}
+		desired.SetResourceVersion(existing.GetResourceVersion())
+		if err := c.Update(ctx, desired); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,189 @@
+// Copyright (c) 2022, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package backup
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	vzconst "github.com/verrazzano/verrazzano/pkg/constants"
+	clustersv1alpha1 "github.com/verrazzano/verrazzano/platform-operator/apis/clusters/v1alpha1"
+	vzapi "github.com/verrazzano/verrazzano/platform-operator/apis/verrazzano/v1alpha1"
+	"github.com/verrazzano/verrazzano/platform-operator/constants"
+	"github.com/verrazzano/verrazzano/platform-operator/controllers/clusters"
+	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+const (
+	testVZNamespace  = "vz-config"
+	testAppNamespace = "hello"
+	testOverrideCM   = "prometheus-overrides"
+	testOverrideSec  = "prometheus-secret-overrides"
+	testVMCName      = "managed1"
+)
+
+func newScheme() *runtime.Scheme {
+	scheme := runtime.NewScheme()
+	_ = corev1.AddToScheme(scheme)
+	_ = appsv1.AddToScheme(scheme)
+	_ = batchv1.AddToScheme(scheme)
+	_ = vzapi.AddToScheme(scheme)
+	_ = clustersv1alpha1.AddToScheme(scheme)
+	return scheme
+}
+
+// newTestVZ returns a Verrazzano resource with a ConfigMap and a Secret override
+func newTestVZ() *vzapi.Verrazzano {
+	return &vzapi.Verrazzano{
+		ObjectMeta: metav1.ObjectMeta{Name: "verrazzano", Namespace: testVZNamespace, UID: "vz-uid", ResourceVersion: "7"},
+		Spec: vzapi.VerrazzanoSpec{
+			Profile: vzapi.Prod,
+			Components: vzapi.ComponentSpec{PrometheusOperator: &vzapi.PrometheusOperatorComponent{
+				InstallOverrides: vzapi.InstallOverrides{
+					ValueOverrides: []vzapi.Overrides{
+						{ConfigMapRef: &corev1.ConfigMapKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: testOverrideCM}}},
+						{SecretRef: &corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: testOverrideSec}}},
+					},
+				},
+			}},
+		},
+		Status: vzapi.VerrazzanoStatus{State: vzapi.VzStateReady},
+	}
+}
+
+// newUnstructured returns a resource of a kind that is not in the scheme of the operator
+func newUnstructured(gvk schema.GroupVersionKind, namespace string, name string) *unstructured.Unstructured {
+	u := &unstructured.Unstructured{Object: map[string]interface{}{"spec": map[string]interface{}{"owner": name}}}
+	u.SetGroupVersionKind(gvk)
+	u.SetNamespace(namespace)
+	u.SetName(name)
+	return u
+}
+
+// newBackedUpObjects returns the resources of a Verrazzano cluster with overrides, a managed cluster, a project, a
+// multicluster component and an application
+func newBackedUpObjects() []client.Object {
+	ownedComponent := newUnstructured(oamGVKs[0], testAppNamespace, "hello-owned")
+	ownedComponent.SetOwnerReferences([]metav1.OwnerReference{{APIVersion: "v1", Kind: "ConfigMap", Name: "owner", UID: "owner-uid"}})
+	return []client.Object{
+		newTestVZ(),
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: testVZNamespace}},
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: testAppNamespace, Labels: map[string]string{"istio-injection": "enabled"}}},
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: constants.VerrazzanoMultiClusterNamespace,
+			Labels: map[string]string{vzconst.LabelVerrazzanoNamespace: constants.VerrazzanoMultiClusterNamespace}}},
+		&corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: testOverrideCM, Namespace: testVZNamespace}, Data: map[string]string{"values.yaml": "replicas: 2"}},
+		&corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: testOverrideSec, Namespace: testVZNamespace}, Data: map[string][]byte{"values.yaml": []byte("password: secret")}},
+		&clustersv1alpha1.VerrazzanoManagedCluster{
+			ObjectMeta: metav1.ObjectMeta{Name: testVMCName, Namespace: constants.VerrazzanoMultiClusterNamespace},
+			Spec:       clustersv1alpha1.VerrazzanoManagedClusterSpec{CASecret: "ca-secret-managed1"},
+		},
+		&corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "ca-secret-managed1", Namespace: constants.VerrazzanoMultiClusterNamespace}},
+		&corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: clusters.GetAgentSecretName(testVMCName), Namespace: constants.VerrazzanoMultiClusterNamespace}},
+		&corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: clusters.GetRegistrationSecretName(testVMCName), Namespace: constants.VerrazzanoMultiClusterNamespace}},
+		newUnstructured(projectGVK, constants.VerrazzanoMultiClusterNamespace, "hello-project"),
+		newUnstructured(mcGVKs[2], testAppNamespace, "hello-mc-component"),
+		newUnstructured(oamGVKs[0], testAppNamespace, "hello-component"),
+		newUnstructured(oamGVKs[1], testAppNamespace, "hello-app"),
+		ownedComponent,
+	}
+}
+
+// getNames returns the kind and name of each resource
+func getNames(objects []unstructured.Unstructured) []string {
+	var names []string
+	for _, object := range objects {
+		names = append(names, object.GetKind()+"/"+object.GetName())
+	}
+	return names
+}
+
+// TestCollectResources tests collecting the resources of a backup
+// GIVEN a cluster with Verrazzano, override sources, a managed cluster, a project and applications
+// WHEN I collect the resources and write them to a tarball, and read the tarball back
+// THEN each step has its resources without the metadata owned by the cluster, and the tarball has the same resources
+func TestCollectResources(t *testing.T) {
+	asserts := assert.New(t)
+	c := fake.NewClientBuilder().WithScheme(newScheme()).WithObjects(newBackedUpObjects()...).Build()
+
+	resources, err := collectResources(context.TODO(), c)
+	asserts.NoError(err)
+	asserts.Equal([]string{"Namespace/hello", "Namespace/vz-config"}, getNames(resources[namespacesStep]))
+	asserts.Equal([]string{"ConfigMap/" + testOverrideCM, "Secret/" + testOverrideSec}, getNames(resources[overridesStep]))
+	asserts.Equal([]string{"Verrazzano/verrazzano"}, getNames(resources[verrazzanoStep]))
+	asserts.Equal([]string{"Secret/ca-secret-managed1", "Secret/" + clusters.GetAgentSecretName(testVMCName),
+		"Secret/" + clusters.GetRegistrationSecretName(testVMCName), "VerrazzanoManagedCluster/" + testVMCName},
+		getNames(resources[managedClustersStep]))
+	asserts.Equal([]string{"VerrazzanoProject/hello-project"}, getNames(resources[projectsStep]))
+	asserts.Equal([]string{"MultiClusterComponent/hello-mc-component"}, getNames(resources[multiclusterStep]))
+	asserts.Equal([]string{"Component/hello-component", "ApplicationConfiguration/hello-app"}, getNames(resources[applicationsStep]))
+
+	vz := resources[verrazzanoStep][0]
+	asserts.Empty(vz.GetUID())
+	asserts.Empty(vz.GetResourceVersion())
+	_, found := vz.Object["status"]
+	asserts.False(found)
+	asserts.Equal("install.verrazzano.io/v1alpha1", vz.GetAPIVersion())
+	asserts.Equal("enabled", resources[namespacesStep][0].GetLabels()["istio-injection"])
+
+	tarball := &bytes.Buffer{}
+	asserts.NoError(writeTarball(tarball, resources))
+	restored, err := readTarball(tarball)
+	asserts.NoError(err)
+	asserts.Equal(countResources(resources), countResources(restored))
+	for _, step := range backupSteps {
+		asserts.Equal(getNames(resources[step.name]), getNames(restored[step.name]), step.name)
+	}
+	asserts.Equal(string(vzapi.Prod), restored[verrazzanoStep][0].Object["spec"].(map[string]interface{})["profile"])
+}
+
+// TestReadTarballInvalid tests reading a tarball that was not written by a backup
+// GIVEN bytes that are not a gzipped tarball
+// WHEN I read the tarball
+// THEN an error is returned
+func TestReadTarballInvalid(t *testing.T) {
+	_, err := readTarball(strings.NewReader("not a tarball"))
+	assert.Error(t, err)
+}
+
+// TestRestoreResources tests restoring resources
+// GIVEN resources of which some exist
+// WHEN I restore the resources
+// THEN missing resources are created, existing resources are updated and existing namespaces are kept
+func TestRestoreResources(t *testing.T) {
+	asserts := assert.New(t)
+	c := fake.NewClientBuilder().WithScheme(newScheme()).WithObjects(
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: testVZNamespace, Labels: map[string]string{"kept": "true"}}},
+		&corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: testOverrideCM, Namespace: testVZNamespace}, Data: map[string]string{"values.yaml": "replicas: 1"}},
+	).Build()
+
+	namespace := newUnstructured(namespaceGVK, "", testVZNamespace)
+	delete(namespace.Object, "spec")
+	configMap := newUnstructured(configMapGVK, testVZNamespace, testOverrideCM)
+	delete(configMap.Object, "spec")
+	configMap.Object["data"] = map[string]interface{}{"values.yaml": "replicas: 2"}
+	project := newUnstructured(projectGVK, constants.VerrazzanoMultiClusterNamespace, "hello-project")
+
+	asserts.NoError(restoreResources(context.TODO(), c, []unstructured.Unstructured{*namespace, *configMap, *project}))
+
+	ns := &corev1.Namespace{}
+	asserts.NoError(c.Get(context.TODO(), client.ObjectKey{Name: testVZNamespace}, ns))
+	asserts.Equal("true", ns.Labels["kept"])
+	cm := &corev1.ConfigMap{}
+	asserts.NoError(c.Get(context.TODO(), client.ObjectKey{Namespace: testVZNamespace, Name: testOverrideCM}, cm))
+	asserts.Equal("replicas: 2", cm.Data["values.yaml"])
+	restoredProject, err := getResource(context.TODO(), c, projectGVK, constants.VerrazzanoMultiClusterNamespace, "hello-project")
+	asserts.NoError(err)
+	asserts.NotNil(restoredProject)
+}
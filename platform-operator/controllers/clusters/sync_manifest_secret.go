@@ -87,6 +87,13 @@ func (r *VerrazzanoManagedClusterReconciler) updateRancherStatus(ctx context.Con
 	}
 	vmc.Status.RancherRegistration.Status = status
 	vmc.Status.RancherRegistration.Message = message
+	if r.EventRecorder != nil {
+		eventType := corev1.EventTypeNormal
+		if status == clusterapi.RegistrationFailed {
+			eventType = corev1.EventTypeWarning
+		}
+		r.EventRecorder.Event(vmc, eventType, "RancherRegistration"+string(status), message)
+	}
 	err := r.Status().Update(ctx, vmc)
 	if err != nil {
 		r.log.Errorf("Failed to update Rancher registration status for VMC %s: %v", vmc.Name, err)
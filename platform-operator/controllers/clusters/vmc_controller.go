@@ -15,12 +15,14 @@ import (
 	vzstring "github.com/verrazzano/verrazzano/pkg/string"
 	clustersv1alpha1 "github.com/verrazzano/verrazzano/platform-operator/apis/clusters/v1alpha1"
 	"github.com/verrazzano/verrazzano/platform-operator/constants"
+	"github.com/verrazzano/verrazzano/platform-operator/internal/metrics"
 	"go.uber.org/zap"
 	corev1 "k8s.io/api/core/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
@@ -34,8 +36,9 @@ const finalizerName = "managedcluster.verrazzano.io"
 // contains the kubeconfig to be used by the Multi-Cluster Agent to access the admin cluster.
 type VerrazzanoManagedClusterReconciler struct {
 	client.Client
-	Scheme *runtime.Scheme
-	log    vzlog.VerrazzanoLogger
+	Scheme        *runtime.Scheme
+	EventRecorder record.EventRecorder
+	log           vzlog.VerrazzanoLogger
 }
 
 // bindingParams used to mutate the RoleBinding
@@ -101,6 +104,7 @@ func (r *VerrazzanoManagedClusterReconciler) doReconcile(ctx context.Context, lo
 			if err := r.reconcileManagedClusterDelete(ctx, vmc); err != nil {
 				return reconcile.Result{}, err
 			}
+			metrics.DeleteManagedClusterAgentConnectTime(vmc.Name)
 
 			// Remove the finalizer and update the Verrazzano resource if the deletion has finished.
 			log.Infof("Removing finalizer %s", finalizerName)
@@ -122,6 +126,11 @@ func (r *VerrazzanoManagedClusterReconciler) doReconcile(ctx context.Context, lo
 		}
 	}
 
+	// Record when the agent last connected, so that an alert fires when a managed cluster is disconnected
+	if vmc.Status.LastAgentConnectTime != nil {
+		metrics.SetManagedClusterAgentConnectTime(vmc.Name, vmc.Status.LastAgentConnectTime.Time)
+	}
+
 	// Sync the service account
 	log.Debugf("Syncing the ServiceAccount for VMC %s", vmc.Name)
 	err := r.syncServiceAccount(vmc)
@@ -311,6 +320,7 @@ func (r *VerrazzanoManagedClusterReconciler) updateStatus(ctx context.Context, v
 		}
 	}
 	if !conditionExists {
+		r.recordConditionEvent(vmc, condition)
 
 		if matchingCondition == nil {
 			vmc.Status.Conditions = append(vmc.Status.Conditions, condition)
@@ -340,6 +350,21 @@ func (r *VerrazzanoManagedClusterReconciler) updateStatus(ctx context.Context, v
 	return r.Status().Update(ctx, vmc)
 }
 
+// recordConditionEvent records an Event on the VMC for a condition that changed.  A condition with a
+// false status is recorded as a Warning Event.
+func (r *VerrazzanoManagedClusterReconciler) recordConditionEvent(vmc *clustersv1alpha1.VerrazzanoManagedCluster, condition clustersv1alpha1.Condition) {
+	if r.EventRecorder == nil {
+		return
+	}
+	eventType := corev1.EventTypeNormal
+	reason := string(condition.Type)
+	if condition.Status == corev1.ConditionFalse {
+		eventType = corev1.EventTypeWarning
+		reason = "Not" + reason
+	}
+	r.EventRecorder.Event(vmc, eventType, reason, condition.Message)
+}
+
 // Create a new Result that will cause a reconcile requeue after a short delay
 func newRequeueWithDelay() ctrl.Result {
 	return vzctrl.NewRequeueWithDelay(2, 3, time.Second)
@@ -36,6 +36,7 @@ import (
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
@@ -2028,3 +2029,21 @@ func validateScrapeConfig(t *testing.T, scrapeConfig *gabs.Container, caBasePath
 		asserts.Equal(caBasePath+"ca-test", scrapeConfig.Search("tls_config", "ca_file").Data(), "Wrong cert path")
 	}
 }
+
+// TestRecordConditionEvent tests the recordConditionEvent method for the following use case
+// GIVEN a VMC whose Ready condition changes
+// WHEN the condition Event is recorded
+// THEN a Normal Event is recorded when the VMC is ready and a Warning Event when it is not ready
+func TestRecordConditionEvent(t *testing.T) {
+	asserts := assert.New(t)
+	recorder := record.NewFakeRecorder(10)
+	reconciler := VerrazzanoManagedClusterReconciler{EventRecorder: recorder}
+	vmc := &clustersapi.VerrazzanoManagedCluster{
+		ObjectMeta: metav1.ObjectMeta{Namespace: constants.VerrazzanoMultiClusterNamespace, Name: testManagedCluster},
+	}
+
+	reconciler.recordConditionEvent(vmc, clustersapi.Condition{Type: clustersapi.ConditionReady, Status: corev1.ConditionTrue, Message: "Ready"})
+	reconciler.recordConditionEvent(vmc, clustersapi.Condition{Type: clustersapi.ConditionReady, Status: corev1.ConditionFalse, Message: "Failed to create the agent secret"})
+	asserts.Equal("Normal Ready Ready", <-recorder.Events)
+	asserts.Equal("Warning NotReady Failed to create the agent secret", <-recorder.Events)
+}
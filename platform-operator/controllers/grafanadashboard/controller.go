@@ -0,0 +1,288 @@
+// Copyright (c) 2022, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package grafanadashboard
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"time"
+
+	vzctrl "github.com/verrazzano/verrazzano/pkg/controller"
+	"github.com/verrazzano/verrazzano/pkg/grafana"
+	"github.com/verrazzano/verrazzano/pkg/httputil"
+	"github.com/verrazzano/verrazzano/pkg/log/vzlog"
+	installv1alpha1 "github.com/verrazzano/verrazzano/platform-operator/apis/verrazzano/v1alpha1"
+	"github.com/verrazzano/verrazzano/platform-operator/constants"
+	"github.com/verrazzano/verrazzano/platform-operator/controllers/verrazzano/component/common"
+	vzgrafana "github.com/verrazzano/verrazzano/platform-operator/controllers/verrazzano/component/grafana"
+	"github.com/verrazzano/verrazzano/platform-operator/controllers/verrazzano/component/spi"
+	"github.com/verrazzano/verrazzano/platform-operator/internal/vzconfig"
+	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+)
+
+const (
+	// managedTag is the tag of the dashboards managed by Verrazzano, managed dashboards without a source are deleted
+	managedTag = "verrazzano-managed"
+
+	// resyncInterval is how often the dashboards are synced, which picks up project changes and users that signed in
+	resyncInterval = 5 * time.Minute
+
+	// grafanaCertificateName is the secret of the certificate of the Grafana ingress
+	grafanaCertificateName = "system-tls-grafana"
+	grafanaRequestTimeout  = 30 * time.Second
+)
+
+// syncRequest is the request of every event, the dashboards are synced together since they share folders
+var syncRequest = reconcile.Request{NamespacedName: types.NamespacedName{Namespace: constants.VerrazzanoSystemNamespace, Name: "grafana-dashboards"}}
+
+// httpClient returns the URL and HTTP client of the REST API, unit tests set it to call a fake server
+var httpClient = common.NewHTTPClientHook(getGrafanaHTTPClient)
+
+// GrafanaDashboardReconciler provisions the dashboards of GrafanaDashboard resources, and of ConfigMaps labelled
+// with grafana.verrazzano.io/dashboard, in the Grafana of Verrazzano.  Each JSON key of a ConfigMap is a dashboard,
+// and the grafana.verrazzano.io/folder annotation sets the folder of its dashboards.  Dashboards are saved through
+// the Grafana ingress, and the managed dashboards that no longer have a source are deleted.
+type GrafanaDashboardReconciler struct {
+	client.Client
+	Scheme *runtime.Scheme
+	log    vzlog.VerrazzanoLogger
+}
+
+// SetupWithManager creates a new controller and adds it to the manager
+func (r *GrafanaDashboardReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	c, err := controller.New("grafanadashboard", mgr, controller.Options{Reconciler: r})
+	if err != nil {
+		return err
+	}
+	syncHandler := handler.EnqueueRequestsFromMapFunc(func(client.Object) []reconcile.Request {
+		return []reconcile.Request{syncRequest}
+	})
+	if err := c.Watch(&source.Kind{Type: &installv1alpha1.GrafanaDashboard{}}, syncHandler, predicate.GenerationChangedPredicate{}); err != nil {
+		return err
+	}
+	if err := c.Watch(&source.Kind{Type: &corev1.ConfigMap{}}, syncHandler, predicate.Funcs{
+		CreateFunc: func(e event.CreateEvent) bool { return isDashboardConfigMap(e.Object) },
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			return isDashboardConfigMap(e.ObjectOld) || isDashboardConfigMap(e.ObjectNew)
+		},
+		DeleteFunc:  func(e event.DeleteEvent) bool { return isDashboardConfigMap(e.Object) },
+		GenericFunc: func(e event.GenericEvent) bool { return false },
+	}); err != nil {
+		return err
+	}
+	// Sync as soon as Grafana becomes ready, instead of waiting for the next resync
+	return c.Watch(&source.Kind{Type: &installv1alpha1.Verrazzano{}}, syncHandler, predicate.Funcs{
+		CreateFunc: func(e event.CreateEvent) bool { return false },
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			return isGrafanaReady(e.ObjectOld.(*installv1alpha1.Verrazzano)) != isGrafanaReady(e.ObjectNew.(*installv1alpha1.Verrazzano))
+		},
+		DeleteFunc:  func(e event.DeleteEvent) bool { return false },
+		GenericFunc: func(e event.GenericEvent) bool { return false },
+	})
+}
+
+// Reconcile syncs all of the dashboards, whichever resource changed
+func (r *GrafanaDashboardReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	if ctx == nil {
+		ctx = context.TODO()
+	}
+
+	log, err := vzlog.EnsureResourceLogger(&vzlog.ResourceConfig{
+		Name:           syncRequest.Name,
+		Namespace:      syncRequest.Namespace,
+		ID:             syncRequest.String(),
+		ControllerName: "GrafanaDashboard",
+	})
+	if err != nil {
+		zap.S().Errorf("Failed to create resource logger for GrafanaDashboard controller: %v", err)
+		return newRequeueWithDelay(), err
+	}
+	r.log = log
+
+	if err := r.syncDashboards(ctx); err != nil {
+		return newRequeueWithDelay(), err
+	}
+	return ctrl.Result{RequeueAfter: resyncInterval}, nil
+}
+
+// syncDashboards provisions the dashboards in Grafana once it is ready, and updates the status of the
+// GrafanaDashboard resources
+func (r *GrafanaDashboardReconciler) syncDashboards(ctx context.Context) error {
+	sources, err := collectSources(ctx, r.Client)
+	if err != nil {
+		r.log.ErrorfThrottled("Failed collecting the Grafana dashboards: %v", err)
+		return err
+	}
+
+	vzList := installv1alpha1.VerrazzanoList{}
+	if err := r.List(ctx, &vzList); err != nil {
+		return err
+	}
+	if len(vzList.Items) == 0 {
+		return r.updatePending(ctx, sources, "Waiting for Verrazzano to be installed")
+	}
+	vz := &vzList.Items[0]
+	compContext, err := spi.NewContext(r.log, r.Client, vz, false)
+	if err != nil {
+		return err
+	}
+	if !vzconfig.IsGrafanaEnabled(compContext.EffectiveCR()) {
+		return r.updatePending(ctx, sources, "Grafana is not enabled")
+	}
+	if !isGrafanaReady(vz) {
+		r.log.Progressf("Waiting for Grafana to be ready before provisioning %d dashboards", len(sources))
+		return r.updatePending(ctx, sources, "Waiting for Grafana to be ready")
+	}
+
+	projects, err := getProjects(ctx, r.Client)
+	if err != nil {
+		r.log.ErrorfThrottled("Failed getting the Verrazzano projects: %v", err)
+		return err
+	}
+	resolveFolders(sources, projects)
+
+	s, err := r.newSyncer(compContext)
+	if err != nil {
+		r.log.ErrorfThrottled("Failed creating the Grafana client: %v", err)
+		return err
+	}
+	syncErr := s.sync(sources)
+	for _, source := range sources {
+		if source.err != nil && source.resource == nil {
+			r.log.ErrorfThrottled("Failed provisioning the Grafana dashboard of %s: %v", source.key, source.err)
+		}
+	}
+	if err := r.updateStatuses(ctx, sources); err != nil {
+		return err
+	}
+	return syncErr
+}
+
+// newSyncer returns a syncer that calls Grafana through its ingress, as the Verrazzano user
+func (r *GrafanaDashboardReconciler) newSyncer(ctx spi.ComponentContext) (*syncer, error) {
+	vmiSecret := &corev1.Secret{}
+	if err := r.Get(context.TODO(), types.NamespacedName{Namespace: constants.VerrazzanoSystemNamespace, Name: constants.VMISecret}, vmiSecret); err != nil {
+		return nil, err
+	}
+	baseURL, hc, err := httpClient.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &syncer{
+		client:  grafana.NewClient(baseURL, hc, string(vmiSecret.Data["username"]), string(vmiSecret.Data["password"])),
+		baseURL: baseURL,
+		log:     r.log,
+	}, nil
+}
+
+// updatePending sets the state of the GrafanaDashboard resources to Pending
+func (r *GrafanaDashboardReconciler) updatePending(ctx context.Context, sources []*dashboardSource, message string) error {
+	for _, source := range sources {
+		if source.resource == nil {
+			continue
+		}
+		status := installv1alpha1.GrafanaDashboardStatus{
+			State:              installv1alpha1.GrafanaDashboardStatePending,
+			Message:            message,
+			ObservedGeneration: source.resource.Generation,
+		}
+		if err := r.updateStatus(ctx, source.resource, status); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// updateStatuses sets the state of the GrafanaDashboard resources to Provisioned, or to Failed with the error
+func (r *GrafanaDashboardReconciler) updateStatuses(ctx context.Context, sources []*dashboardSource) error {
+	for _, source := range sources {
+		if source.resource == nil {
+			continue
+		}
+		status := installv1alpha1.GrafanaDashboardStatus{
+			State:              installv1alpha1.GrafanaDashboardStateProvisioned,
+			UID:                source.uid,
+			Folder:             source.folder,
+			URL:                source.url,
+			ObservedGeneration: source.resource.Generation,
+		}
+		if source.err != nil {
+			status.State = installv1alpha1.GrafanaDashboardStateFailed
+			status.Message = source.err.Error()
+			status.URL = ""
+		}
+		if err := r.updateStatus(ctx, source.resource, status); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// updateStatus updates the status of the GrafanaDashboard if it changed
+func (r *GrafanaDashboardReconciler) updateStatus(ctx context.Context, resource *installv1alpha1.GrafanaDashboard, status installv1alpha1.GrafanaDashboardStatus) error {
+	if reflect.DeepEqual(resource.Status, status) {
+		return nil
+	}
+	if status.State == installv1alpha1.GrafanaDashboardStateFailed {
+		r.log.ErrorfThrottled("Failed provisioning the Grafana dashboard of GrafanaDashboard %s/%s: %s", resource.Namespace, resource.Name, status.Message)
+	}
+	resource.Status = status
+	if err := r.Status().Update(ctx, resource); err != nil {
+		r.log.ErrorfThrottled("Failed updating the status of GrafanaDashboard %s/%s: %v", resource.Namespace, resource.Name, err)
+		return err
+	}
+	return nil
+}
+
+// isDashboardConfigMap returns true if the object has the label of the ConfigMaps of dashboards
+func isDashboardConfigMap(obj client.Object) bool {
+	return obj.GetLabels()[constants.GrafanaDashboardLabel] == "true"
+}
+
+// isGrafanaReady returns true if the Grafana component of the Verrazzano resource is installed, either ready or
+// degraded
+func isGrafanaReady(vz *installv1alpha1.Verrazzano) bool {
+	comp, ok := vz.Status.Components[vzgrafana.ComponentName]
+	return ok && comp != nil && installv1alpha1.IsComponentInstalledState(comp.State)
+}
+
+// getGrafanaURL returns the URL of the Grafana ingress
+func getGrafanaURL(ctx spi.ComponentContext) (string, error) {
+	dnsSuffix, err := vzconfig.GetDNSSuffix(ctx.Client(), ctx.EffectiveCR())
+	if err != nil {
+		return "", ctx.Log().ErrorfNewErr("Failed getting DNS suffix: %v", err)
+	}
+	return fmt.Sprintf("https://grafana.vmi.system.%s.%s", vzconfig.GetEnvName(ctx.EffectiveCR()), dnsSuffix), nil
+}
+
+// getGrafanaHTTPClient returns the URL of the Grafana ingress and a client that trusts its certificate
+func getGrafanaHTTPClient(ctx spi.ComponentContext) (string, *http.Client, error) {
+	baseURL, err := getGrafanaURL(ctx)
+	if err != nil {
+		return "", nil, err
+	}
+	hc, err := httputil.NewCertSecretClient(ctx.Client(), types.NamespacedName{Namespace: constants.VerrazzanoSystemNamespace, Name: grafanaCertificateName}, grafanaRequestTimeout)
+	if err != nil {
+		return "", nil, err
+	}
+	return baseURL, hc, nil
+}
+
+// Create a new Result that will cause a reconcile requeue after a short delay
+func newRequeueWithDelay() ctrl.Result {
+	return vzctrl.NewRequeueWithDelay(3, 5, time.Second)
+}
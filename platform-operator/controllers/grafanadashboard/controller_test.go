@@ -0,0 +1,316 @@
+// Copyright (c) 2022, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package grafanadashboard
+
+import (
+	"context"
+	"encoding/pem"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/verrazzano/verrazzano/pkg/grafana"
+	grafanafake "github.com/verrazzano/verrazzano/pkg/grafana/fake"
+	vzapi "github.com/verrazzano/verrazzano/platform-operator/apis/verrazzano/v1alpha1"
+	"github.com/verrazzano/verrazzano/platform-operator/constants"
+	"github.com/verrazzano/verrazzano/platform-operator/controllers/verrazzano/component/spi"
+	"github.com/verrazzano/verrazzano/platform-operator/internal/config"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+const (
+	testUsername     = "verrazzano"
+	testPassword     = "vzpw"
+	testProfilesPath = "../../manifests/profiles"
+	weblogicUID      = "XKwKBuBna"
+)
+
+func newScheme() *runtime.Scheme {
+	scheme := runtime.NewScheme()
+	_ = corev1.AddToScheme(scheme)
+	_ = vzapi.AddToScheme(scheme)
+	return scheme
+}
+
+// newVZ returns a Verrazzano resource, whose Grafana component is ready if grafanaReady is true
+func newVZ(grafanaReady bool) *vzapi.Verrazzano {
+	state := vzapi.CompStateInstalling
+	if grafanaReady {
+		state = vzapi.CompStateReady
+	}
+	return &vzapi.Verrazzano{
+		ObjectMeta: metav1.ObjectMeta{Name: "verrazzano", Namespace: "default"},
+		Spec: vzapi.VerrazzanoSpec{
+			EnvironmentName: "prod",
+			Components: vzapi.ComponentSpec{
+				DNS: &vzapi.DNSComponent{External: &vzapi.External{Suffix: "example.com"}},
+			},
+		},
+		Status: vzapi.VerrazzanoStatus{
+			Components: vzapi.ComponentStatusMap{"grafana": &vzapi.ComponentStatusDetails{Name: "grafana", State: state}},
+		},
+	}
+}
+
+func newVMISecret() *corev1.Secret {
+	return &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Namespace: constants.VerrazzanoSystemNamespace, Name: constants.VMISecret},
+		Data:       map[string][]byte{"username": []byte(testUsername), "password": []byte(testPassword)},
+	}
+}
+
+func newDashboard(namespace string, name string, folder string, model string) *vzapi.GrafanaDashboard {
+	return &vzapi.GrafanaDashboard{
+		ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name, Generation: 1},
+		Spec:       vzapi.GrafanaDashboardSpec{Dashboard: model, Folder: folder},
+	}
+}
+
+// newWebLogicConfigMap returns a labelled ConfigMap of the verrazzano-system namespace in the WebLogic folder
+func newWebLogicConfigMap() *corev1.ConfigMap {
+	return &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:   constants.VerrazzanoSystemNamespace,
+			Name:        "verrazzano-dashboards-weblogic",
+			Labels:      map[string]string{constants.GrafanaDashboardLabel: "true"},
+			Annotations: map[string]string{constants.GrafanaFolderAnnotation: "WebLogic"},
+		},
+		Data: map[string]string{
+			"weblogic_dashboard.json": `{"id": 24, "uid": "XKwKBuBna", "title": "WebLogic Server Dashboard", "tags": ["weblogic"]}`,
+			"README.md":               "not a dashboard",
+		},
+	}
+}
+
+// newProject returns a VerrazzanoProject with the namespace, an admin and monitor user, and a monitor group
+func newProject(name string, namespace string) *unstructured.Unstructured {
+	project := &unstructured.Unstructured{Object: map[string]interface{}{
+		"spec": map[string]interface{}{
+			"template": map[string]interface{}{
+				"namespaces": []interface{}{
+					map[string]interface{}{"metadata": map[string]interface{}{"name": namespace}},
+				},
+				"security": map[string]interface{}{
+					"projectAdminSubjects": []interface{}{
+						map[string]interface{}{"kind": "User", "name": "alice"},
+					},
+					"projectMonitorSubjects": []interface{}{
+						map[string]interface{}{"kind": "User", "name": "bob"},
+						map[string]interface{}{"kind": "Group", "name": "operators"},
+					},
+				},
+			},
+		},
+	}}
+	project.SetAPIVersion("clusters.verrazzano.io/v1alpha1")
+	project.SetKind("VerrazzanoProject")
+	project.SetNamespace(constants.VerrazzanoMultiClusterNamespace)
+	project.SetName(name)
+	return project
+}
+
+// newReconciler returns a reconciler that calls the fake Grafana server
+func newReconciler(server *grafanafake.Server, objects ...client.Object) GrafanaDashboardReconciler {
+	httpClient.Set(func(ctx spi.ComponentContext) (string, *http.Client, error) {
+		return server.URL, server.Client(), nil
+	})
+	c := fake.NewClientBuilder().WithScheme(newScheme()).WithObjects(objects...).Build()
+	return GrafanaDashboardReconciler{Client: c, Scheme: newScheme()}
+}
+
+func getDashboard(t *testing.T, c client.Client, namespace string, name string) *vzapi.GrafanaDashboard {
+	dashboard := &vzapi.GrafanaDashboard{}
+	assert.NoError(t, c.Get(context.TODO(), types.NamespacedName{Namespace: namespace, Name: name}, dashboard))
+	return dashboard
+}
+
+// TestReconcileDashboards tests provisioning the dashboards of GrafanaDashboards and labelled ConfigMaps
+// GIVEN a GrafanaDashboard in the namespace of a project, a labelled ConfigMap with a folder, and a managed dashboard
+//   without a source in Grafana
+// WHEN the dashboards are reconciled twice
+// THEN the dashboards are saved in their folders once, the folder of the project is limited to its users, the
+//   dashboard without a source is deleted and the GrafanaDashboard is provisioned
+func TestReconcileDashboards(t *testing.T) {
+	asserts := assert.New(t)
+	config.TestProfilesDir = testProfilesPath
+	defer func() { config.TestProfilesDir = "" }()
+	server := grafanafake.NewServer(testUsername, testPassword)
+	defer server.Close()
+	defer httpClient.Reset()
+	alice := server.AddUser("alice")
+	bob := server.AddUser("bob")
+	server.AddDashboard(map[string]interface{}{"uid": "stale", "title": "Removed", "tags": []string{managedTag}}, false)
+	r := newReconciler(server, newVZ(true), newVMISecret(), newWebLogicConfigMap(), newProject("apps", "hello"),
+		newDashboard("hello", "hello-dashboard", "", `{"id": 3, "title": "Hello", "panels": [{"type": "graph"}]}`))
+
+	result, err := r.Reconcile(context.TODO(), syncRequest)
+	asserts.NoError(err)
+	asserts.Equal(resyncInterval, result.RequeueAfter)
+
+	folders := server.Folders()
+	asserts.Len(folders, 2)
+	asserts.Equal("WebLogic", folders[0].Title)
+	asserts.Equal([]grafana.FolderPermission{
+		{UserID: alice.ID, Permission: grafana.PermissionEdit},
+		{UserID: bob.ID, Permission: grafana.PermissionView},
+	}, server.FolderPermissions(folders[1].UID))
+	asserts.Len(server.FolderPermissions(folders[0].UID), 2)
+
+	dashboard := getDashboard(t, r.Client, "hello", "hello-dashboard")
+	asserts.Equal(vzapi.GrafanaDashboardStateProvisioned, dashboard.Status.State)
+	asserts.Equal("apps", dashboard.Status.Folder)
+	asserts.Equal(generateUID("GrafanaDashboard hello/hello-dashboard"), dashboard.Status.UID)
+	asserts.Equal(server.URL+"/d/"+dashboard.Status.UID, dashboard.Status.URL)
+	asserts.Equal(int64(1), dashboard.Status.ObservedGeneration)
+	model, folderUID := server.Dashboard(dashboard.Status.UID)
+	asserts.Equal(folders[1].UID, folderUID)
+	asserts.Equal([]interface{}{managedTag}, model["tags"])
+
+	model, folderUID = server.Dashboard(weblogicUID)
+	asserts.Equal(folders[0].UID, folderUID)
+	asserts.Equal([]interface{}{"weblogic", managedTag}, model["tags"])
+	asserts.ElementsMatch([]string{weblogicUID, dashboard.Status.UID}, server.DashboardUIDs())
+	asserts.Equal(2, server.Saves())
+
+	// Nothing is saved when the dashboards have not changed
+	_, err = r.Reconcile(context.TODO(), syncRequest)
+	asserts.NoError(err)
+	asserts.Equal(2, server.Saves())
+	asserts.Len(server.Folders(), 2)
+}
+
+// TestReconcilePending tests reconciling dashboards before Grafana is ready
+// GIVEN a GrafanaDashboard and a Verrazzano resource whose Grafana is not ready
+// WHEN the dashboards are reconciled
+// THEN the GrafanaDashboard is pending and Grafana is not called
+func TestReconcilePending(t *testing.T) {
+	asserts := assert.New(t)
+	config.TestProfilesDir = testProfilesPath
+	defer func() { config.TestProfilesDir = "" }()
+	server := grafanafake.NewServer(testUsername, testPassword)
+	defer server.Close()
+	defer httpClient.Reset()
+	server.FailRequests(http.MethodGet, "/", http.StatusInternalServerError)
+	r := newReconciler(server, newVZ(false), newVMISecret(), newDashboard("hello", "hello-dashboard", "", `{"title": "Hello"}`))
+
+	result, err := r.Reconcile(context.TODO(), syncRequest)
+	asserts.NoError(err)
+	asserts.Equal(resyncInterval, result.RequeueAfter)
+	dashboard := getDashboard(t, r.Client, "hello", "hello-dashboard")
+	asserts.Equal(vzapi.GrafanaDashboardStatePending, dashboard.Status.State)
+	asserts.Equal("Waiting for Grafana to be ready", dashboard.Status.Message)
+}
+
+// TestReconcileConflicts tests dashboards that can not be provisioned
+// GIVEN GrafanaDashboards with invalid JSON, with the uid of a platform dashboard, with the uid of a dashboard that
+//   is not managed by Verrazzano, with the folder of a project they are not part of, with a platform folder, and with
+//   the folder of another namespace
+// WHEN the dashboards are reconciled
+// THEN the GrafanaDashboards fail with the reason, the platform and unmanaged dashboards are not changed and the
+//   dashboards that do not conflict are provisioned
+func TestReconcileConflicts(t *testing.T) {
+	asserts := assert.New(t)
+	config.TestProfilesDir = testProfilesPath
+	defer func() { config.TestProfilesDir = "" }()
+	server := grafanafake.NewServer(testUsername, testPassword)
+	defer server.Close()
+	defer httpClient.Reset()
+	server.AddDashboard(map[string]interface{}{"uid": "handmade", "title": "Handmade"}, false)
+	r := newReconciler(server, newVZ(true), newVMISecret(), newWebLogicConfigMap(), newProject("apps", "hello"),
+		newDashboard("hello", "valid", "", `{"title": "Valid"}`),
+		newDashboard("hello", "invalid", "", `{"title": `),
+		newDashboard("hello", "untitled", "", `{"panels": []}`),
+		newDashboard("hello", "copy", "", `{"uid": "XKwKBuBna", "title": "Copy"}`),
+		newDashboard("other", "handmade", "", `{"uid": "handmade", "title": "Handmade"}`),
+		newDashboard("other", "intruder", "apps", `{"title": "Intruder"}`),
+		newDashboard("other", "platform", "WebLogic", `{"title": "Platform"}`),
+		newDashboard("other", "neighbour", "", `{"title": "Neighbour"}`),
+		newDashboard("third", "squatter", "other", `{"title": "Squatter"}`))
+
+	_, err := r.Reconcile(context.TODO(), syncRequest)
+	asserts.Error(err)
+
+	expected := map[string]string{
+		"invalid":  "The dashboard is not a valid JSON object",
+		"untitled": "The dashboard has no title",
+		"copy":     "The dashboard uid XKwKBuBna is already used by ConfigMap verrazzano-system/verrazzano-dashboards-weblogic/weblogic_dashboard.json",
+	}
+	for name, message := range expected {
+		dashboard := getDashboard(t, r.Client, "hello", name)
+		asserts.Equal(vzapi.GrafanaDashboardStateFailed, dashboard.Status.State, name)
+		asserts.Contains(dashboard.Status.Message, message, name)
+	}
+	dashboard := getDashboard(t, r.Client, "other", "handmade")
+	asserts.Equal(vzapi.GrafanaDashboardStateFailed, dashboard.Status.State)
+	asserts.Equal("Grafana has a dashboard with uid handmade that is not managed by Verrazzano", dashboard.Status.Message)
+	dashboard = getDashboard(t, r.Client, "other", "intruder")
+	asserts.Equal(vzapi.GrafanaDashboardStateFailed, dashboard.Status.State)
+	asserts.Equal("The Grafana folder apps belongs to project apps", dashboard.Status.Message)
+	dashboard = getDashboard(t, r.Client, "other", "platform")
+	asserts.Equal(vzapi.GrafanaDashboardStateFailed, dashboard.Status.State)
+	asserts.Equal("The Grafana folder WebLogic belongs to namespace verrazzano-system", dashboard.Status.Message)
+	dashboard = getDashboard(t, r.Client, "third", "squatter")
+	asserts.Equal(vzapi.GrafanaDashboardStateFailed, dashboard.Status.State)
+	asserts.Equal("The Grafana folder other belongs to namespace other", dashboard.Status.Message)
+	asserts.Equal(vzapi.GrafanaDashboardStateProvisioned, getDashboard(t, r.Client, "other", "neighbour").Status.State)
+	asserts.Equal(vzapi.GrafanaDashboardStateProvisioned, getDashboard(t, r.Client, "hello", "valid").Status.State)
+
+	model, _ := server.Dashboard(weblogicUID)
+	asserts.Equal("WebLogic Server Dashboard", model["title"])
+	model, _ = server.Dashboard("handmade")
+	asserts.Nil(model["tags"])
+}
+
+// TestReconcileGrafanaFailed tests reconciling dashboards when Grafana fails
+// GIVEN a GrafanaDashboard and a Grafana that fails to list its folders
+// WHEN the dashboards are reconciled
+// THEN an error is returned so that the sync is retried, and the GrafanaDashboard fails with the reason
+func TestReconcileGrafanaFailed(t *testing.T) {
+	asserts := assert.New(t)
+	config.TestProfilesDir = testProfilesPath
+	defer func() { config.TestProfilesDir = "" }()
+	server := grafanafake.NewServer(testUsername, testPassword)
+	defer server.Close()
+	defer httpClient.Reset()
+	server.FailRequests(http.MethodGet, "/api/folders", http.StatusBadGateway)
+	r := newReconciler(server, newVZ(true), newVMISecret(), newDashboard("hello", "hello-dashboard", "", `{"title": "Hello"}`))
+
+	result, err := r.Reconcile(context.TODO(), syncRequest)
+	asserts.Error(err)
+	asserts.True(result.Requeue)
+	dashboard := getDashboard(t, r.Client, "hello", "hello-dashboard")
+	asserts.Equal(vzapi.GrafanaDashboardStateFailed, dashboard.Status.State)
+	asserts.Contains(dashboard.Status.Message, "Failed getting the Grafana folders")
+	asserts.Equal("hello", dashboard.Status.Folder)
+}
+
+// TestGetGrafanaHTTPClient tests the getGrafanaHTTPClient function
+// GIVEN the certificate secret of the Grafana ingress
+// WHEN I call getGrafanaHTTPClient
+// THEN the URL of the ingress is returned with a client that trusts the CA of the certificate
+func TestGetGrafanaHTTPClient(t *testing.T) {
+	asserts := assert.New(t)
+	server := grafanafake.NewTLSServer(testUsername, testPassword)
+	defer server.Close()
+	caCert := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+	c := fake.NewClientBuilder().WithScheme(newScheme()).WithObjects(&corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Namespace: constants.VerrazzanoSystemNamespace, Name: grafanaCertificateName},
+		Data:       map[string][]byte{"ca.crt": caCert},
+	}).Build()
+
+	baseURL, hc, err := getGrafanaHTTPClient(spi.NewFakeContext(c, newVZ(true), false))
+	asserts.NoError(err)
+	asserts.Equal("https://grafana.vmi.system.prod.example.com", baseURL)
+	// The fake server has a certificate for example.com
+	hc.Transport.(*http.Transport).TLSClientConfig.ServerName = "example.com"
+	folders, err := grafana.NewClient(server.URL, hc, testUsername, testPassword).GetFolders()
+	asserts.NoError(err)
+	asserts.Empty(folders)
+}
@@ -0,0 +1,288 @@
+// Copyright (c) 2022, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package grafanadashboard
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	installv1alpha1 "github.com/verrazzano/verrazzano/platform-operator/apis/verrazzano/v1alpha1"
+	"github.com/verrazzano/verrazzano/platform-operator/constants"
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// grafanaUIDLength is the longest uid Grafana accepts for dashboards and folders
+const grafanaUIDLength = 40
+
+// platformFolders are the Grafana folders of the platform dashboards, they are reserved for the verrazzano-system
+// namespace
+var platformFolders = []string{"General", "Verrazzano", "Coherence", "Helidon", "WebLogic"}
+
+var projectListGVK = schema.GroupVersionKind{Group: "clusters.verrazzano.io", Version: "v1alpha1", Kind: "VerrazzanoProjectList"}
+
+// dashboardSource is a dashboard of a GrafanaDashboard, or of a key of a labelled ConfigMap
+type dashboardSource struct {
+	// key identifies the source, the uid of a dashboard without one is generated from it
+	key       string
+	namespace string
+	// resource is the GrafanaDashboard of the dashboard, it is nil for ConfigMaps
+	resource *installv1alpha1.GrafanaDashboard
+	// folderOverride is the folder of the resource or ConfigMap, the folder is defaulted if it is empty
+	folderOverride string
+	model          map[string]interface{}
+	uid            string
+	title          string
+	folder         string
+	project        *project
+	url            string
+	// err is why the dashboard is not provisioned
+	err error
+}
+
+// project is a VerrazzanoProject, its users get access to the folders of the dashboards in its namespaces
+type project struct {
+	name     string
+	admins   []rbacv1.Subject
+	monitors []rbacv1.Subject
+}
+
+// collectSources returns the dashboards of the GrafanaDashboards and labelled ConfigMaps.  The dashboards of the
+// verrazzano-system namespace come first, so that they keep their folders and uids when other dashboards conflict
+// with them.
+func collectSources(ctx context.Context, c client.Client) ([]*dashboardSource, error) {
+	var sources []*dashboardSource
+	dashboardList := installv1alpha1.GrafanaDashboardList{}
+	if err := c.List(ctx, &dashboardList); err != nil {
+		return nil, err
+	}
+	for i := range dashboardList.Items {
+		resource := &dashboardList.Items[i]
+		if !resource.DeletionTimestamp.IsZero() {
+			continue
+		}
+		source := &dashboardSource{
+			key:            fmt.Sprintf("GrafanaDashboard %s/%s", resource.Namespace, resource.Name),
+			namespace:      resource.Namespace,
+			resource:       resource,
+			folderOverride: resource.Spec.Folder,
+		}
+		source.parse(resource.Spec.Dashboard)
+		sources = append(sources, source)
+	}
+
+	cmList := corev1.ConfigMapList{}
+	if err := c.List(ctx, &cmList, client.MatchingLabels{constants.GrafanaDashboardLabel: "true"}); err != nil {
+		return nil, err
+	}
+	for _, cm := range cmList.Items {
+		if !cm.DeletionTimestamp.IsZero() {
+			continue
+		}
+		for dataKey, data := range cm.Data {
+			if !strings.HasSuffix(dataKey, ".json") {
+				continue
+			}
+			source := &dashboardSource{
+				key:            fmt.Sprintf("ConfigMap %s/%s/%s", cm.Namespace, cm.Name, dataKey),
+				namespace:      cm.Namespace,
+				folderOverride: cm.Annotations[constants.GrafanaFolderAnnotation],
+			}
+			source.parse(data)
+			sources = append(sources, source)
+		}
+	}
+
+	sort.SliceStable(sources, func(i, j int) bool {
+		iSystem := sources[i].namespace == constants.VerrazzanoSystemNamespace
+		jSystem := sources[j].namespace == constants.VerrazzanoSystemNamespace
+		if iSystem != jSystem {
+			return iSystem
+		}
+		return sources[i].key < sources[j].key
+	})
+	return sources, nil
+}
+
+// parse decodes the JSON model of the dashboard and prepares it to be saved.  The id is dropped since it is
+// specific to a Grafana instance, and the uid of the model is kept so that links between dashboards work.
+func (s *dashboardSource) parse(data string) {
+	model := map[string]interface{}{}
+	if err := json.Unmarshal([]byte(data), &model); err != nil {
+		s.err = fmt.Errorf("The dashboard is not a valid JSON object: %v", err)
+		return
+	}
+	title, _ := model["title"].(string)
+	if len(title) == 0 {
+		s.err = fmt.Errorf("The dashboard has no title")
+		return
+	}
+	uid, _ := model["uid"].(string)
+	if len(uid) == 0 {
+		uid = generateUID(s.key)
+	}
+	if len(uid) > grafanaUIDLength {
+		s.err = fmt.Errorf("The dashboard uid %s is longer than %d characters", uid, grafanaUIDLength)
+		return
+	}
+	delete(model, "id")
+	delete(model, "version")
+	model["uid"] = uid
+	model["tags"] = addManagedTag(model["tags"])
+	s.model = model
+	s.uid = uid
+	s.title = title
+}
+
+// addManagedTag returns the tags of a dashboard model with the tag of the dashboards managed by Verrazzano
+func addManagedTag(tags interface{}) []interface{} {
+	list, _ := tags.([]interface{})
+	for _, tag := range list {
+		if tag == managedTag {
+			return list
+		}
+	}
+	return append(list, managedTag)
+}
+
+// generateUID returns a uid that Grafana accepts, derived from the key
+func generateUID(key string) string {
+	return fmt.Sprintf("%x", sha256.Sum256([]byte(key)))[:grafanaUIDLength]
+}
+
+// getProjects returns the projects of the namespaces, a namespace that is in more than one project belongs to the
+// first project by name
+func getProjects(ctx context.Context, c client.Client) (map[string]*project, error) {
+	projects := map[string]*project{}
+	list := unstructured.UnstructuredList{}
+	list.SetGroupVersionKind(projectListGVK)
+	if err := c.List(ctx, &list, client.InNamespace(constants.VerrazzanoMultiClusterNamespace)); err != nil {
+		if meta.IsNoMatchError(err) {
+			return projects, nil
+		}
+		return nil, err
+	}
+	sort.Slice(list.Items, func(i, j int) bool { return list.Items[i].GetName() < list.Items[j].GetName() })
+	for _, item := range list.Items {
+		p := &project{
+			name:     item.GetName(),
+			admins:   getSubjects(item, "projectAdminSubjects"),
+			monitors: getSubjects(item, "projectMonitorSubjects"),
+		}
+		namespaces, _, _ := unstructured.NestedSlice(item.Object, "spec", "template", "namespaces")
+		for _, ns := range namespaces {
+			nsMap, ok := ns.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			name, _, _ := unstructured.NestedString(nsMap, "metadata", "name")
+			if _, exists := projects[name]; len(name) > 0 && !exists {
+				projects[name] = p
+			}
+		}
+	}
+	return projects, nil
+}
+
+// getSubjects returns the subjects of the security template of the project
+func getSubjects(item unstructured.Unstructured, field string) []rbacv1.Subject {
+	var subjects []rbacv1.Subject
+	list, _, _ := unstructured.NestedSlice(item.Object, "spec", "template", "security", field)
+	for _, s := range list {
+		sMap, ok := s.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		kind, _, _ := unstructured.NestedString(sMap, "kind")
+		name, _, _ := unstructured.NestedString(sMap, "name")
+		subjects = append(subjects, rbacv1.Subject{Kind: kind, Name: name})
+	}
+	return subjects
+}
+
+// resolveFolders sets the folder and project of the dashboards, and fails the dashboards that conflict with
+// dashboards before them.  The folders of the platform dashboards are reserved for the verrazzano-system namespace.
+// A folder named after a project, or after a namespace, belongs to that project or namespace, and any other folder
+// belongs to the project or namespace of its first dashboard.  Dashboards with the same uid, or with the same title
+// in a folder, would overwrite each other.
+func resolveFolders(sources []*dashboardSource, projects map[string]*project) {
+	owners := map[string]string{}
+	for _, folder := range platformFolders {
+		owners[folder] = namespaceOwner(constants.VerrazzanoSystemNamespace)
+	}
+	for namespace, p := range projects {
+		owners[p.name] = projectOwner(p)
+		if _, ok := owners[namespace]; !ok {
+			owners[namespace] = projectOwner(p)
+		}
+	}
+	for _, source := range sources {
+		if source.err != nil {
+			continue
+		}
+		source.project = projects[source.namespace]
+		source.folder = source.folderOverride
+		if len(source.folder) == 0 {
+			source.folder = source.namespace
+			if source.project != nil {
+				source.folder = source.project.name
+			}
+		}
+		if _, ok := owners[source.namespace]; !ok {
+			owners[source.namespace] = source.owner()
+		}
+	}
+	for _, source := range sources {
+		if _, ok := owners[source.folder]; !ok && source.err == nil {
+			owners[source.folder] = source.owner()
+		}
+	}
+
+	uids := map[string]string{}
+	titles := map[string]string{}
+	for _, source := range sources {
+		if source.err != nil {
+			continue
+		}
+		if owner := owners[source.folder]; owner != source.owner() {
+			source.err = fmt.Errorf("The Grafana folder %s belongs to %s", source.folder, owner)
+			continue
+		}
+		if key, ok := uids[source.uid]; ok {
+			source.err = fmt.Errorf("The dashboard uid %s is already used by %s", source.uid, key)
+			continue
+		}
+		titleKey := source.folder + "/" + source.title
+		if key, ok := titles[titleKey]; ok {
+			source.err = fmt.Errorf("The Grafana folder %s already has a dashboard titled %s from %s", source.folder, source.title, key)
+			continue
+		}
+		uids[source.uid] = source.key
+		titles[titleKey] = source.key
+	}
+}
+
+// owner returns the project of the dashboard, or its namespace when the namespace is not part of a project
+func (s *dashboardSource) owner() string {
+	if s.project != nil {
+		return projectOwner(s.project)
+	}
+	return namespaceOwner(s.namespace)
+}
+
+func projectOwner(p *project) string {
+	return fmt.Sprintf("project %s", p.name)
+}
+
+func namespaceOwner(namespace string) string {
+	return fmt.Sprintf("namespace %s", namespace)
+}
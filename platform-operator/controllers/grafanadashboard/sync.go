@@ -0,0 +1,255 @@
+// Copyright (c) 2022, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package grafanadashboard
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+
+	"github.com/verrazzano/verrazzano/pkg/grafana"
+	"github.com/verrazzano/verrazzano/pkg/log/vzlog"
+	rbacv1 "k8s.io/api/rbac/v1"
+)
+
+// syncer provisions the dashboards in Grafana
+type syncer struct {
+	client  *grafana.Client
+	baseURL string
+	log     vzlog.VerrazzanoLogger
+}
+
+// sync creates the folders of the dashboards, saves the dashboards that changed and deletes the managed dashboards
+// that no longer have a source.  The dashboards that could not be provisioned have an error, and the first error
+// from Grafana is returned so that the sync is retried.
+func (s *syncer) sync(sources []*dashboardSource) error {
+	var syncErr error
+	failAll := func(err error) error {
+		for _, source := range sources {
+			if source.err == nil {
+				source.err = err
+			}
+		}
+		return err
+	}
+
+	folders, err := s.client.GetFolders()
+	if err != nil {
+		return failAll(fmt.Errorf("Failed getting the Grafana folders: %v", err))
+	}
+	folderUIDs := map[string]string{}
+	for _, folder := range folders {
+		folderUIDs[folder.Title] = folder.UID
+	}
+	owners := getFolders(sources)
+	var titles []string
+	for title := range owners {
+		titles = append(titles, title)
+	}
+	sort.Strings(titles)
+	for _, title := range titles {
+		if err := s.ensureFolder(title, owners[title], folderUIDs); err != nil {
+			for _, source := range sources {
+				if source.err == nil && source.folder == title {
+					source.err = err
+				}
+			}
+			if syncErr == nil {
+				syncErr = err
+			}
+		}
+	}
+
+	keep := map[string]bool{}
+	for _, source := range sources {
+		if len(source.uid) > 0 {
+			keep[source.uid] = true
+		}
+		if source.err != nil {
+			continue
+		}
+		if err := s.saveDashboard(source, folderUIDs[source.folder]); err != nil {
+			source.err = err
+			if syncErr == nil {
+				syncErr = err
+			}
+		}
+	}
+
+	managed, err := s.client.SearchDashboards(managedTag)
+	if err != nil {
+		return fmt.Errorf("Failed searching the Grafana dashboards managed by Verrazzano: %v", err)
+	}
+	for _, result := range managed {
+		if keep[result.UID] {
+			continue
+		}
+		if err := s.client.DeleteDashboard(result.UID); err != nil && !grafana.IsNotFound(err) {
+			s.log.ErrorfThrottled("Failed deleting Grafana dashboard %s: %v", result.Title, err)
+			if syncErr == nil {
+				syncErr = err
+			}
+			continue
+		}
+		s.log.Oncef("Deleted Grafana dashboard %s, it no longer has a GrafanaDashboard or ConfigMap", result.Title)
+	}
+	return syncErr
+}
+
+// getFolders returns the folders of the dashboards and the projects they belong to
+func getFolders(sources []*dashboardSource) map[string]*project {
+	folders := map[string]*project{}
+	for _, source := range sources {
+		if source.err == nil {
+			folders[source.folder] = source.project
+		}
+	}
+	return folders
+}
+
+// ensureFolder creates the folder if there is no folder with its title, and limits the folders of projects to the
+// users of the project
+func (s *syncer) ensureFolder(title string, owner *project, folderUIDs map[string]string) error {
+	uid, ok := folderUIDs[title]
+	if !ok {
+		folder, err := s.client.CreateFolder(generateUID("folder "+title), title)
+		if err != nil {
+			return fmt.Errorf("Failed creating Grafana folder %s: %v", title, err)
+		}
+		s.log.Oncef("Created Grafana folder %s", title)
+		uid = folder.UID
+		folderUIDs[title] = uid
+	}
+	if owner == nil {
+		return nil
+	}
+
+	desired, err := s.getProjectPermissions(owner)
+	if err != nil {
+		return err
+	}
+	current, err := s.client.GetFolderPermissions(uid)
+	if err != nil {
+		return fmt.Errorf("Failed getting the permissions of Grafana folder %s: %v", title, err)
+	}
+	sortPermissions(current)
+	if reflect.DeepEqual(desired, current) || (len(desired) == 0 && len(current) == 0) {
+		return nil
+	}
+	if err := s.client.SetFolderPermissions(uid, desired); err != nil {
+		return fmt.Errorf("Failed setting the permissions of Grafana folder %s: %v", title, err)
+	}
+	s.log.Oncef("Updated the permissions of Grafana folder %s for the users of project %s", title, owner.name)
+	return nil
+}
+
+// getProjectPermissions returns the permissions of the users of the project, admins can edit the dashboards and
+// monitors can view them.  Grafana only knows the users that have signed in, the others get their permissions on a
+// later sync.  Groups are not supported since the auth proxy of Grafana does not pass them.
+func (s *syncer) getProjectPermissions(p *project) ([]grafana.FolderPermission, error) {
+	permissions := map[int64]int{}
+	add := func(subjects []rbacv1.Subject, permission int) error {
+		for _, subject := range subjects {
+			if subject.Kind != rbacv1.UserKind {
+				s.log.Oncef("Project %s %s subject %s has no Grafana permissions, only user subjects are supported", p.name, subject.Kind, subject.Name)
+				continue
+			}
+			user, err := s.client.LookupUser(subject.Name)
+			if grafana.IsNotFound(err) {
+				s.log.Debugf("Project %s user %s has not signed in to Grafana yet", p.name, subject.Name)
+				continue
+			}
+			if err != nil {
+				return fmt.Errorf("Failed looking up Grafana user %s: %v", subject.Name, err)
+			}
+			if permissions[user.ID] < permission {
+				permissions[user.ID] = permission
+			}
+		}
+		return nil
+	}
+	if err := add(p.admins, grafana.PermissionEdit); err != nil {
+		return nil, err
+	}
+	if err := add(p.monitors, grafana.PermissionView); err != nil {
+		return nil, err
+	}
+
+	var result []grafana.FolderPermission
+	for userID, permission := range permissions {
+		result = append(result, grafana.FolderPermission{UserID: userID, Permission: permission})
+	}
+	sortPermissions(result)
+	return result, nil
+}
+
+func sortPermissions(permissions []grafana.FolderPermission) {
+	sort.Slice(permissions, func(i, j int) bool {
+		a, b := permissions[i], permissions[j]
+		if a.UserID != b.UserID {
+			return a.UserID < b.UserID
+		}
+		if a.TeamID != b.TeamID {
+			return a.TeamID < b.TeamID
+		}
+		return a.Role < b.Role
+	})
+}
+
+// saveDashboard saves the dashboard if it does not exist or has changed.  Dashboards that are not managed by
+// Verrazzano are never overwritten.
+func (s *syncer) saveDashboard(source *dashboardSource, folderUID string) error {
+	existing, err := s.client.GetDashboard(source.uid)
+	if err != nil && !grafana.IsNotFound(err) {
+		return fmt.Errorf("Failed getting Grafana dashboard %s: %v", source.uid, err)
+	}
+	if existing != nil {
+		if existing.Meta.Provisioned {
+			return fmt.Errorf("Grafana has a dashboard with uid %s that is provisioned from a file", source.uid)
+		}
+		if !isManaged(existing.Model) {
+			return fmt.Errorf("Grafana has a dashboard with uid %s that is not managed by Verrazzano", source.uid)
+		}
+		if existing.Meta.FolderUID == folderUID && isUnchanged(existing.Model, source.model) {
+			source.url = s.baseURL + existing.Meta.URL
+			return nil
+		}
+	}
+
+	resp, err := s.client.SaveDashboard(grafana.SaveDashboardRequest{
+		Model:     source.model,
+		FolderUID: folderUID,
+		Overwrite: existing != nil,
+		Message:   fmt.Sprintf("Updated from %s", source.key),
+	})
+	if err != nil {
+		return fmt.Errorf("Failed saving Grafana dashboard %s: %v", source.title, err)
+	}
+	s.log.Oncef("Saved Grafana dashboard %s from %s in folder %s", source.title, source.key, source.folder)
+	source.url = s.baseURL + resp.URL
+	return nil
+}
+
+// isManaged returns true if the dashboard model has the tag of the dashboards managed by Verrazzano
+func isManaged(model map[string]interface{}) bool {
+	tags, _ := model["tags"].([]interface{})
+	for _, tag := range tags {
+		if tag == managedTag {
+			return true
+		}
+	}
+	return false
+}
+
+// isUnchanged returns true if the saved model matches the desired model, ignoring the id and version that Grafana
+// sets
+func isUnchanged(saved map[string]interface{}, desired map[string]interface{}) bool {
+	compared := map[string]interface{}{}
+	for k, v := range saved {
+		if k != "id" && k != "version" {
+			compared[k] = v
+		}
+	}
+	return reflect.DeepEqual(compared, desired)
+}
@@ -0,0 +1,118 @@
+// Copyright (c) 2022, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package verrazzano
+
+import (
+	"context"
+
+	"github.com/verrazzano/verrazzano/pkg/log/vzlog"
+	vzstring "github.com/verrazzano/verrazzano/pkg/string"
+	installv1alpha1 "github.com/verrazzano/verrazzano/platform-operator/apis/verrazzano/v1alpha1"
+	vzconst "github.com/verrazzano/verrazzano/platform-operator/constants"
+	"github.com/verrazzano/verrazzano/platform-operator/controllers/verrazzano/component/addon"
+	"github.com/verrazzano/verrazzano/platform-operator/controllers/verrazzano/component/registry"
+	"github.com/verrazzano/verrazzano/platform-operator/controllers/verrazzano/component/spi"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+)
+
+// loadAddonComponents registers a component for each VerrazzanoAddon resource in the namespace of the Verrazzano
+// resource, so that the add-ons are installed, upgraded and uninstalled along with the built-in components
+func (r *Reconciler) loadAddonComponents(log vzlog.VerrazzanoLogger, vz *installv1alpha1.Verrazzano) error {
+	if err := r.finalizeAddons(log, vz); err != nil {
+		return err
+	}
+	comps, err := addon.GetComponents(log, r.Client, vz.Namespace)
+	if err != nil {
+		return err
+	}
+	if err := registry.SetAddonComponents(vz.Namespace, comps); err != nil {
+		// The conflicting add-ons are ignored, the other components are still reconciled
+		log.ErrorfThrottled("Failed to register the add-ons in namespace %s: %v", vz.Namespace, err)
+	}
+	return nil
+}
+
+// finalizeAddons adds the add-on finalizer to the VerrazzanoAddon resources in the namespace of the Verrazzano
+// resource.  The Helm release of a deleted add-on is uninstalled and its component status removed before the
+// finalizer is removed, so that deleting the add-on does not leave the release behind.
+func (r *Reconciler) finalizeAddons(log vzlog.VerrazzanoLogger, vz *installv1alpha1.Verrazzano) error {
+	addons := installv1alpha1.VerrazzanoAddonList{}
+	if err := r.List(context.TODO(), &addons, client.InNamespace(vz.Namespace)); err != nil {
+		return log.ErrorfNewErr("Failed to list the add-ons in namespace %s: %v", vz.Namespace, err)
+	}
+	for i := range addons.Items {
+		a := &addons.Items[i]
+		if a.DeletionTimestamp.IsZero() {
+			if vzstring.SliceContainsString(a.Finalizers, addon.FinalizerName) {
+				continue
+			}
+			log.Debugf("Adding finalizer %s to add-on %s/%s", addon.FinalizerName, a.Namespace, a.Name)
+			a.Finalizers = append(a.Finalizers, addon.FinalizerName)
+			if err := r.Update(context.TODO(), a); err != nil {
+				return log.ErrorfNewErr("Failed to add the finalizer to add-on %s/%s: %v", a.Namespace, a.Name, err)
+			}
+			continue
+		}
+		if !vzstring.SliceContainsString(a.Finalizers, addon.FinalizerName) {
+			continue
+		}
+		spiCtx, err := spi.NewContext(log, r.Client, vz, false)
+		if err != nil {
+			return err
+		}
+		log.Oncef("Uninstalling the Helm release of deleted add-on %s/%s", a.Namespace, a.Name)
+		if err := addon.Uninstall(spiCtx.Init(a.Name).Operation(vzconst.UninstallOperation), a); err != nil {
+			return log.ErrorfNewErr("Failed to uninstall add-on %s/%s: %v", a.Namespace, a.Name, err)
+		}
+		if _, ok := vz.Status.Components[a.Name]; ok {
+			delete(vz.Status.Components, a.Name)
+			if err := r.updateVerrazzanoStatus(log, vz); err != nil {
+				return err
+			}
+		}
+		a.Finalizers = vzstring.RemoveStringFromSlice(a.Finalizers, addon.FinalizerName)
+		if err := r.Update(context.TODO(), a); err != nil {
+			return log.ErrorfNewErr("Failed to remove the finalizer from add-on %s/%s: %v", a.Namespace, a.Name, err)
+		}
+	}
+	return nil
+}
+
+// watchAddons triggers reconciles for the Verrazzano resource when the VerrazzanoAddon resources in its namespace
+// are created, deleted or their spec is updated.  An updated add-on is marked as a watched component so that its
+// chart and overrides are applied again, a deleted add-on is uninstalled before its finalizer is removed.
+func (r *Reconciler) watchAddons(namespace string, name string, log vzlog.VerrazzanoLogger) error {
+	log.Debugf("Watching for add-ons to activate reconcile for Verrazzano CR %s/%s", namespace, name)
+	return r.Controller.Watch(
+		&source.Kind{Type: &installv1alpha1.VerrazzanoAddon{}},
+		createReconcileEventHandler(namespace, name),
+		predicate.Funcs{
+			CreateFunc: func(e event.CreateEvent) bool {
+				return e.Object.GetNamespace() == namespace
+			},
+			UpdateFunc: func(e event.UpdateEvent) bool {
+				if e.ObjectNew.GetNamespace() != namespace {
+					return false
+				}
+				if e.ObjectNew.GetDeletionTimestamp() != nil {
+					return e.ObjectOld.GetDeletionTimestamp() == nil
+				}
+				if e.ObjectNew.GetGeneration() == e.ObjectOld.GetGeneration() {
+					return false
+				}
+				log.Debugf("Add-on %s/%s updated", e.ObjectNew.GetNamespace(), e.ObjectNew.GetName())
+				r.AddWatch(e.ObjectNew.GetName())
+				return true
+			},
+			DeleteFunc: func(e event.DeleteEvent) bool {
+				return false
+			},
+			GenericFunc: func(e event.GenericEvent) bool {
+				return false
+			},
+		})
+}
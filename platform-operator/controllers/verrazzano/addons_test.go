@@ -0,0 +1,111 @@
+// Copyright (c) 2022, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package verrazzano
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/verrazzano/verrazzano/pkg/helm"
+	"github.com/verrazzano/verrazzano/pkg/log/vzlog"
+	vzapi "github.com/verrazzano/verrazzano/platform-operator/apis/verrazzano/v1alpha1"
+	"github.com/verrazzano/verrazzano/platform-operator/controllers/verrazzano/component/addon"
+	helmcomp "github.com/verrazzano/verrazzano/platform-operator/controllers/verrazzano/component/helm"
+	"github.com/verrazzano/verrazzano/platform-operator/controllers/verrazzano/component/registry"
+	"github.com/verrazzano/verrazzano/platform-operator/internal/config"
+	"helm.sh/helm/v3/pkg/release"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	k8scheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+// TestLoadAddonComponents tests the loadAddonComponents func
+// GIVEN VerrazzanoAddon resources in the namespace of the Verrazzano resource
+// WHEN the add-on components are loaded
+// THEN a component is registered for every add-on, except for the add-on that has the name of a built-in component,
+// and the add-on finalizer is added to the add-ons
+func TestLoadAddonComponents(t *testing.T) {
+	asserts := assert.New(t)
+	_ = vzapi.AddToScheme(k8scheme.Scheme)
+	defer registry.SetAddonComponents("verrazzano", nil)
+
+	c := fake.NewClientBuilder().WithScheme(k8scheme.Scheme).WithObjects(newAddon("my-addon"), newAddon("istio")).Build()
+	vz := &vzapi.Verrazzano{ObjectMeta: metav1.ObjectMeta{Namespace: "verrazzano", Name: "test"}}
+
+	builtIn := len(registry.GetComponents())
+	reconciler := newVerrazzanoReconciler(c)
+	asserts.NoError(reconciler.loadAddonComponents(vzlog.DefaultLogger(), vz))
+
+	comps := registry.GetComponents()
+	asserts.Len(comps, builtIn+1)
+	asserts.Equal("my-addon", comps[builtIn].Name())
+	found, _ := registry.FindComponent("my-addon")
+	asserts.True(found)
+
+	actual := vzapi.VerrazzanoAddon{}
+	asserts.NoError(c.Get(context.TODO(), types.NamespacedName{Namespace: "verrazzano", Name: "my-addon"}, &actual))
+	asserts.Equal([]string{addon.FinalizerName}, actual.Finalizers)
+}
+
+// TestLoadAddonComponentsDeletedAddon tests the loadAddonComponents func
+// GIVEN a VerrazzanoAddon resource that is being deleted
+// WHEN the add-on components are loaded
+// THEN the Helm release of the add-on is uninstalled, its component status and finalizer are removed, and no
+// component is registered for the add-on
+func TestLoadAddonComponentsDeletedAddon(t *testing.T) {
+	asserts := assert.New(t)
+	_ = vzapi.AddToScheme(k8scheme.Scheme)
+	defer registry.SetAddonComponents("verrazzano", nil)
+	config.TestProfilesDir = "../../manifests/profiles"
+	defer func() { config.TestProfilesDir = "" }()
+
+	helm.SetActionConfigFunction(helm.CreateActionConfig(release.Mock(&release.MockReleaseOptions{Name: "my-addon", Namespace: "addons"})))
+	defer helm.SetDefaultActionConfigFunction()
+	var uninstalled []string
+	helmcomp.SetUninstallFunc(func(log vzlog.VerrazzanoLogger, releaseName string, namespace string, dryRun bool) (stdout []byte, stderr []byte, err error) {
+		uninstalled = append(uninstalled, namespace+"/"+releaseName)
+		return nil, nil, nil
+	})
+	defer helmcomp.SetDefaultUninstallFunc()
+
+	deleted := newAddon("my-addon")
+	deleteTime := metav1.Now()
+	deleted.DeletionTimestamp = &deleteTime
+	deleted.Finalizers = []string{addon.FinalizerName, "other"}
+	vz := &vzapi.Verrazzano{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "verrazzano", Name: "test"},
+		Status: vzapi.VerrazzanoStatus{
+			Components: map[string]*vzapi.ComponentStatusDetails{
+				"my-addon": {Name: "my-addon", State: vzapi.CompStateReady},
+			},
+		},
+	}
+	c := fake.NewClientBuilder().WithScheme(k8scheme.Scheme).WithObjects(vz, deleted).Build()
+
+	builtIn := len(registry.GetComponents())
+	reconciler := newVerrazzanoReconciler(c)
+	asserts.NoError(reconciler.loadAddonComponents(vzlog.DefaultLogger(), vz))
+
+	asserts.Equal([]string{"addons/my-addon"}, uninstalled)
+	asserts.Len(registry.GetComponents(), builtIn)
+	actualAddon := vzapi.VerrazzanoAddon{}
+	asserts.NoError(c.Get(context.TODO(), types.NamespacedName{Namespace: "verrazzano", Name: "my-addon"}, &actualAddon))
+	asserts.Equal([]string{"other"}, actualAddon.Finalizers)
+	actual := vzapi.Verrazzano{}
+	asserts.NoError(c.Get(context.TODO(), types.NamespacedName{Namespace: "verrazzano", Name: "test"}, &actual))
+	asserts.NotContains(actual.Status.Components, "my-addon")
+}
+
+// newAddon returns an add-on in the namespace of the Verrazzano resource
+func newAddon(name string) *vzapi.VerrazzanoAddon {
+	return &vzapi.VerrazzanoAddon{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "verrazzano", Name: name},
+		Spec: vzapi.VerrazzanoAddonSpec{
+			Chart:           vzapi.AddonChart{Path: "/charts/" + name},
+			TargetNamespace: "addons",
+		},
+	}
+}
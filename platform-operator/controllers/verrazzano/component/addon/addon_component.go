@@ -0,0 +1,241 @@
+// Copyright (c) 2022, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package addon
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+
+	helmcli "github.com/verrazzano/verrazzano/pkg/helm"
+	"github.com/verrazzano/verrazzano/pkg/log/vzlog"
+	vzapi "github.com/verrazzano/verrazzano/platform-operator/apis/verrazzano/v1alpha1"
+	"github.com/verrazzano/verrazzano/platform-operator/controllers/verrazzano/component/helm"
+	"github.com/verrazzano/verrazzano/platform-operator/controllers/verrazzano/component/spi"
+	"github.com/verrazzano/verrazzano/platform-operator/internal/k8s/status"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+)
+
+// pullFuncSig is the signature of the function that pulls a chart from an OCI registry, needed for unit testing
+type pullFuncSig func(log vzlog.VerrazzanoLogger, chartURL string, version string, destDir string) (stdout []byte, stderr []byte, err error)
+
+var pullFunc pullFuncSig = helmcli.Pull
+
+// SetPullFunc sets the function that pulls a chart from an OCI registry, for unit testing
+func SetPullFunc(f pullFuncSig) {
+	pullFunc = f
+}
+
+// SetDefaultPullFunc resets the function that pulls a chart from an OCI registry
+func SetDefaultPullFunc() {
+	pullFunc = helmcli.Pull
+}
+
+// chartsDir is the directory the OCI charts of the add-ons are pulled to
+var chartsDir = filepath.Join(os.TempDir(), "verrazzano-addons")
+
+// FinalizerName is the finalizer that keeps a VerrazzanoAddon resource until the Helm release of the add-on is uninstalled
+const FinalizerName = "addons.verrazzano.io"
+
+// addonComponent is a component for a Helm chart declared by a VerrazzanoAddon resource
+type addonComponent struct {
+	helm.HelmComponent
+
+	// chart is the location of the chart of the add-on
+	chart vzapi.AddonChart
+
+	// readinessTargets are the workloads that must be ready for the add-on to be ready
+	readinessTargets *vzapi.AddonReadinessTargets
+}
+
+// Verify that addonComponent implements Component
+var _ spi.Component = addonComponent{}
+
+// NewComponent returns a component for the add-on, the name of the add-on is the name of the component
+func NewComponent(addon *vzapi.VerrazzanoAddon) spi.Component {
+	overrides := addon.Spec.ValueOverrides
+	return addonComponent{
+		HelmComponent: helm.HelmComponent{
+			ReleaseName:             addon.Name,
+			JSONName:                addon.Name,
+			ChartDir:                getChartDir(addon),
+			ChartNamespace:          addon.Spec.TargetNamespace,
+			IgnoreNamespaceOverride: true,
+			IgnoreImageOverrides:    true,
+			SupportsOperatorInstall: true,
+			Dependencies:            addon.Spec.Dependencies,
+			GetInstallOverridesFunc: func(_ *vzapi.Verrazzano) []vzapi.Overrides {
+				return overrides
+			},
+		},
+		chart:            addon.Spec.Chart,
+		readinessTargets: addon.Spec.ReadinessTargets,
+	}
+}
+
+// GetComponents returns a component for each valid VerrazzanoAddon resource in the namespace, ordered by name.
+// The add-ons that are being deleted are skipped and an error is logged for each add-on that is not valid.
+func GetComponents(log vzlog.VerrazzanoLogger, cli client.Client, namespace string) ([]spi.Component, error) {
+	addons := vzapi.VerrazzanoAddonList{}
+	if err := cli.List(context.TODO(), &addons, client.InNamespace(namespace)); err != nil {
+		return nil, log.ErrorfNewErr("Failed to list the add-ons in namespace %s: %v", namespace, err)
+	}
+	sort.Slice(addons.Items, func(i, j int) bool {
+		return addons.Items[i].Name < addons.Items[j].Name
+	})
+	var comps []spi.Component
+	for i := range addons.Items {
+		addon := &addons.Items[i]
+		if !addon.DeletionTimestamp.IsZero() {
+			continue
+		}
+		if err := validateAddon(addon); err != nil {
+			log.ErrorfThrottled("Skipping add-on %s/%s: %v", addon.Namespace, addon.Name, err)
+			continue
+		}
+		comps = append(comps, NewComponent(addon))
+	}
+	return comps, nil
+}
+
+// Uninstall uninstalls the Helm release of a deleted add-on, an add-on that is not valid was never installed
+func Uninstall(ctx spi.ComponentContext, addon *vzapi.VerrazzanoAddon) error {
+	if err := validateAddon(addon); err != nil {
+		return nil
+	}
+	return NewComponent(addon).Uninstall(ctx)
+}
+
+// validateAddon checks that the add-on has a target namespace and exactly one chart location
+func validateAddon(addon *vzapi.VerrazzanoAddon) error {
+	if len(addon.Spec.TargetNamespace) == 0 {
+		return fmt.Errorf("the target namespace is not set")
+	}
+	chart := addon.Spec.Chart
+	if len(chart.Path) == 0 && len(chart.URL) == 0 {
+		return fmt.Errorf("either the chart path or the chart URL must be set")
+	}
+	if len(chart.Path) > 0 && len(chart.URL) > 0 {
+		return fmt.Errorf("only one of the chart path and the chart URL can be set")
+	}
+	return nil
+}
+
+// getChartDir returns the chart directory of the add-on, an OCI chart is pulled to a directory for the chart version
+func getChartDir(addon *vzapi.VerrazzanoAddon) string {
+	chart := addon.Spec.Chart
+	if len(chart.Path) > 0 {
+		return chart.Path
+	}
+	return filepath.Join(getPullDir(addon.Name, chart.Version), path.Base(chart.URL))
+}
+
+// getPullDir returns the directory an OCI chart of the add-on is pulled to
+func getPullDir(addonName string, version string) string {
+	if len(version) == 0 {
+		version = "latest"
+	}
+	return filepath.Join(chartsDir, addonName, version)
+}
+
+// IsEnabled returns true, an add-on is installed as long as its VerrazzanoAddon resource exists
+func (c addonComponent) IsEnabled(_ *vzapi.Verrazzano) bool {
+	return true
+}
+
+// IsReady returns true if the Helm release and the readiness targets of the add-on are ready.  The app version of
+// the release is only compared to the chart when the chart has been pulled, the chart is pulled by PreInstall and
+// PreUpgrade and is gone after a restart of the operator.
+func (c addonComponent) IsReady(ctx spi.ComponentContext) bool {
+	if c.isChartPulled() {
+		if !c.HelmComponent.IsReady(ctx) {
+			return false
+		}
+	} else if !ctx.IsDryRun() {
+		if deployed, _ := helmcli.IsReleaseDeployed(c.ReleaseName, c.ChartNamespace); !deployed {
+			return false
+		}
+	}
+	if c.readinessTargets == nil {
+		return true
+	}
+	prefix := fmt.Sprintf("Component %s", c.Name())
+	return status.DeploymentsAreReady(ctx.Log(), ctx.Client(), c.getTargetNames(c.readinessTargets.Deployments), 1, prefix) &&
+		status.StatefulSetsAreReady(ctx.Log(), ctx.Client(), c.getTargetNames(c.readinessTargets.StatefulSets), 1, prefix) &&
+		status.DaemonSetsAreReady(ctx.Log(), ctx.Client(), c.getTargetNames(c.readinessTargets.DaemonSets), 1, prefix)
+}
+
+// PreInstall pulls the chart of the add-on and creates the target namespace
+func (c addonComponent) PreInstall(ctx spi.ComponentContext) error {
+	if ctx.IsDryRun() {
+		ctx.Log().Debugf("Add-on %s PreInstall dry run", c.Name())
+		return nil
+	}
+	if err := c.ensureChart(ctx.Log()); err != nil {
+		return err
+	}
+	ns := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: c.ChartNamespace}}
+	if _, err := controllerutil.CreateOrUpdate(context.TODO(), ctx.Client(), ns, func() error {
+		return nil
+	}); err != nil {
+		return ctx.Log().ErrorfNewErr("Failed to create or update the %s namespace of add-on %s: %v", c.ChartNamespace, c.Name(), err)
+	}
+	return nil
+}
+
+// PreUpgrade pulls the chart of the add-on
+func (c addonComponent) PreUpgrade(ctx spi.ComponentContext) error {
+	if ctx.IsDryRun() {
+		return nil
+	}
+	return c.ensureChart(ctx.Log())
+}
+
+// Reconcile applies the chart and the values overrides of the add-on when its VerrazzanoAddon resource changed
+func (c addonComponent) Reconcile(ctx spi.ComponentContext) error {
+	if err := c.PreInstall(ctx); err != nil {
+		return err
+	}
+	return c.Install(ctx)
+}
+
+// ensureChart pulls the OCI chart of the add-on if it has not been pulled yet
+func (c addonComponent) ensureChart(log vzlog.VerrazzanoLogger) error {
+	if c.isChartPulled() {
+		return nil
+	}
+	pullDir := getPullDir(c.Name(), c.chart.Version)
+	if err := os.MkdirAll(pullDir, 0700); err != nil {
+		return log.ErrorfNewErr("Failed to create the directory %s for the chart of add-on %s: %v", pullDir, c.Name(), err)
+	}
+	if _, stderr, err := pullFunc(log, c.chart.URL, c.chart.Version, pullDir); err != nil {
+		return log.ErrorfNewErr("Failed to pull chart %s for add-on %s: %s", c.chart.URL, c.Name(), string(stderr))
+	}
+	return nil
+}
+
+// isChartPulled returns true if the chart of the add-on is a local chart or the OCI chart has been pulled
+func (c addonComponent) isChartPulled() bool {
+	if len(c.chart.URL) == 0 {
+		return true
+	}
+	_, err := os.Stat(c.ChartDir)
+	return err == nil
+}
+
+// getTargetNames returns the namespaced names of the readiness targets, which are in the target namespace
+func (c addonComponent) getTargetNames(names []string) []types.NamespacedName {
+	var nsns []types.NamespacedName
+	for _, name := range names {
+		nsns = append(nsns, types.NamespacedName{Namespace: c.ChartNamespace, Name: name})
+	}
+	return nsns
+}
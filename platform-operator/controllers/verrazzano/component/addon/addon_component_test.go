@@ -0,0 +1,192 @@
+// Copyright (c) 2022, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package addon
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/verrazzano/verrazzano/pkg/helm"
+	"github.com/verrazzano/verrazzano/pkg/log/vzlog"
+	vzapi "github.com/verrazzano/verrazzano/platform-operator/apis/verrazzano/v1alpha1"
+	"github.com/verrazzano/verrazzano/platform-operator/controllers/verrazzano/component/spi"
+	corev1 "k8s.io/api/core/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	k8scheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+const testNamespace = "verrazzano-install"
+
+// newAddon returns an add-on for the chart
+func newAddon(name string, chart vzapi.AddonChart) *vzapi.VerrazzanoAddon {
+	return &vzapi.VerrazzanoAddon{
+		ObjectMeta: metav1.ObjectMeta{Namespace: testNamespace, Name: name},
+		Spec: vzapi.VerrazzanoAddonSpec{
+			Chart:           chart,
+			TargetNamespace: "addon-ns",
+		},
+	}
+}
+
+func newScheme() *runtime.Scheme {
+	scheme := runtime.NewScheme()
+	_ = k8scheme.AddToScheme(scheme)
+	_ = vzapi.AddToScheme(scheme)
+	return scheme
+}
+
+// TestNewComponent tests the NewComponent function
+// GIVEN an add-on
+//  WHEN I call NewComponent
+//  THEN a Helm based component with the chart, namespace, dependencies and overrides of the add-on is returned
+func TestNewComponent(t *testing.T) {
+	a := assert.New(t)
+	addon := newAddon("my-addon", vzapi.AddonChart{Path: "/charts/my-addon"})
+	addon.Spec.Dependencies = []string{"istio"}
+	addon.Spec.ValueOverrides = []vzapi.Overrides{{Values: &apiextensionsv1.JSON{Raw: []byte(`{"replicas": 2}`)}}}
+
+	comp := NewComponent(addon).(addonComponent)
+	a.Equal("my-addon", comp.Name())
+	a.Equal("my-addon", comp.GetJSONName())
+	a.Equal("/charts/my-addon", comp.ChartDir)
+	a.Equal("addon-ns", comp.ChartNamespace)
+	a.Equal([]string{"istio"}, comp.GetDependencies())
+	a.Equal(addon.Spec.ValueOverrides, comp.GetOverrides(&vzapi.Verrazzano{}))
+	a.True(comp.IsEnabled(&vzapi.Verrazzano{}))
+	a.True(comp.IsOperatorInstallSupported())
+
+	comp = NewComponent(newAddon("oci-addon", vzapi.AddonChart{URL: "oci://registry.local/charts/mychart", Version: "1.0.0"})).(addonComponent)
+	a.Equal(filepath.Join(chartsDir, "oci-addon", "1.0.0", "mychart"), comp.ChartDir)
+}
+
+// TestGetComponents tests the GetComponents function
+// GIVEN add-ons in a namespace, one of which is not valid and one of which is being deleted
+//  WHEN I call GetComponents
+//  THEN a component is returned for each valid add-on that is not being deleted, ordered by name
+func TestGetComponents(t *testing.T) {
+	a := assert.New(t)
+	invalid := newAddon("invalid", vzapi.AddonChart{Path: "/charts/a", URL: "oci://registry.local/charts/b"})
+	other := newAddon("other", vzapi.AddonChart{Path: "/charts/other"})
+	other.Namespace = "other-ns"
+	deleted := newAddon("deleted", vzapi.AddonChart{Path: "/charts/deleted"})
+	deleteTime := metav1.Now()
+	deleted.DeletionTimestamp = &deleteTime
+	deleted.Finalizers = []string{FinalizerName}
+	cli := fake.NewClientBuilder().WithScheme(newScheme()).WithObjects(
+		newAddon("b-addon", vzapi.AddonChart{Path: "/charts/b"}),
+		newAddon("a-addon", vzapi.AddonChart{Path: "/charts/a"}),
+		invalid,
+		other,
+		deleted,
+	).Build()
+
+	comps, err := GetComponents(vzlog.DefaultLogger(), cli, testNamespace)
+	a.NoError(err)
+	a.Len(comps, 2)
+	a.Equal("a-addon", comps[0].Name())
+	a.Equal("b-addon", comps[1].Name())
+}
+
+// TestValidateAddon tests the validateAddon function
+// GIVEN add-ons with and without a valid chart and target namespace
+//  WHEN I call validateAddon
+//  THEN an error is returned for the add-ons that are not valid
+func TestValidateAddon(t *testing.T) {
+	a := assert.New(t)
+	a.NoError(validateAddon(newAddon("a", vzapi.AddonChart{Path: "/charts/a"})))
+	a.NoError(validateAddon(newAddon("a", vzapi.AddonChart{URL: "oci://registry.local/charts/a"})))
+	a.Error(validateAddon(newAddon("a", vzapi.AddonChart{})))
+	a.Error(validateAddon(newAddon("a", vzapi.AddonChart{Path: "/charts/a", URL: "oci://registry.local/charts/a"})))
+	noNamespace := newAddon("a", vzapi.AddonChart{Path: "/charts/a"})
+	noNamespace.Spec.TargetNamespace = ""
+	a.Error(validateAddon(noNamespace))
+}
+
+// TestPreInstall tests the PreInstall function
+// GIVEN an add-on with an OCI chart
+//  WHEN I call PreInstall
+//  THEN the chart is pulled once and the target namespace is created
+func TestPreInstall(t *testing.T) {
+	a := assert.New(t)
+	oldChartsDir := chartsDir
+	chartsDir = t.TempDir()
+	defer func() { chartsDir = oldChartsDir }()
+
+	pulls := 0
+	SetPullFunc(func(log vzlog.VerrazzanoLogger, chartURL string, version string, destDir string) (stdout []byte, stderr []byte, err error) {
+		pulls++
+		a.Equal("oci://registry.local/charts/mychart", chartURL)
+		a.Equal("1.0.0", version)
+		return nil, nil, os.MkdirAll(filepath.Join(destDir, "mychart"), 0700)
+	})
+	defer SetDefaultPullFunc()
+
+	comp := NewComponent(newAddon("my-addon", vzapi.AddonChart{URL: "oci://registry.local/charts/mychart", Version: "1.0.0"}))
+	cli := fake.NewClientBuilder().WithScheme(newScheme()).Build()
+	ctx := spi.NewFakeContext(cli, &vzapi.Verrazzano{}, false)
+	a.NoError(comp.PreInstall(ctx))
+	a.NoError(comp.PreUpgrade(ctx))
+	a.Equal(1, pulls)
+	a.NoError(cli.Get(context.TODO(), types.NamespacedName{Name: "addon-ns"}, &corev1.Namespace{}))
+}
+
+// TestIsReady tests the IsReady function
+// GIVEN an add-on with readiness targets
+//  WHEN I call IsReady
+//  THEN false is returned until the readiness targets exist and are ready
+func TestIsReady(t *testing.T) {
+	a := assert.New(t)
+	helm.SetChartStatusFunction(func(releaseName string, namespace string) (string, error) {
+		return helm.ChartStatusDeployed, nil
+	})
+	defer helm.SetDefaultChartStatusFunction()
+	helm.SetChartInfoFunction(func(chartDir string) (helm.ChartInfo, error) {
+		return helm.ChartInfo{AppVersion: "1.0"}, nil
+	})
+	defer helm.SetDefaultChartInfoFunction()
+	helm.SetReleaseAppVersionFunction(func(releaseName string, namespace string) (string, error) {
+		return "1.0", nil
+	})
+	defer helm.SetDefaultReleaseAppVersionFunction()
+
+	addon := newAddon("my-addon", vzapi.AddonChart{Path: "/charts/my-addon"})
+	comp := NewComponent(addon)
+	ctx := spi.NewFakeContext(fake.NewClientBuilder().WithScheme(newScheme()).Build(), &vzapi.Verrazzano{}, false)
+	a.True(comp.IsReady(ctx))
+
+	addon.Spec.ReadinessTargets = &vzapi.AddonReadinessTargets{Deployments: []string{"my-deployment"}}
+	comp = NewComponent(addon)
+	a.False(comp.IsReady(ctx))
+}
+
+// TestIsReadyDoesNotPullChart tests the IsReady function
+// GIVEN an add-on with an OCI chart that has not been pulled
+//  WHEN I call IsReady
+//  THEN the chart is not pulled and the add-on is ready when its Helm release is deployed
+func TestIsReadyDoesNotPullChart(t *testing.T) {
+	a := assert.New(t)
+	oldChartsDir := chartsDir
+	chartsDir = t.TempDir()
+	defer func() { chartsDir = oldChartsDir }()
+	SetPullFunc(func(log vzlog.VerrazzanoLogger, chartURL string, version string, destDir string) (stdout []byte, stderr []byte, err error) {
+		a.Fail("the chart should not be pulled")
+		return nil, nil, nil
+	})
+	defer SetDefaultPullFunc()
+	helm.SetChartStatusFunction(func(releaseName string, namespace string) (string, error) {
+		return helm.ChartStatusDeployed, nil
+	})
+	defer helm.SetDefaultChartStatusFunction()
+
+	comp := NewComponent(newAddon("my-addon", vzapi.AddonChart{URL: "oci://registry.local/charts/mychart", Version: "1.0.0"}))
+	ctx := spi.NewFakeContext(fake.NewClientBuilder().WithScheme(newScheme()).Build(), &vzapi.Verrazzano{}, false)
+	a.True(comp.IsReady(ctx))
+}
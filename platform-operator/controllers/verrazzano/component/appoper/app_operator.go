@@ -12,10 +12,15 @@ import (
 	oamv1alpha2 "github.com/crossplane/oam-kubernetes-runtime/apis/core/v1alpha2"
 	"github.com/verrazzano/verrazzano/pkg/bom"
 	"github.com/verrazzano/verrazzano/platform-operator/constants"
+	"github.com/verrazzano/verrazzano/platform-operator/controllers/verrazzano/component/certmanager"
+	"github.com/verrazzano/verrazzano/platform-operator/controllers/verrazzano/component/common"
 	"github.com/verrazzano/verrazzano/platform-operator/controllers/verrazzano/component/spi"
 	"github.com/verrazzano/verrazzano/platform-operator/internal/config"
 	"github.com/verrazzano/verrazzano/platform-operator/internal/k8s/status"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
@@ -98,9 +103,57 @@ func AppendApplicationOperatorOverrides(compContext spi.ComponentContext, _ stri
 		Value: weblogicMonitoringExporterImage,
 	})
 
+	// The gateway certificates of the applications are issued by the external issuer of the Verrazzano ingresses
+	if cm := compContext.EffectiveCR().Spec.Components.CertManager; cm != nil && cm.Certificate.IssuerRef != (vzapi.IssuerRef{}) {
+		kvs = append(kvs, bom.KeyValue{Key: "certIssuer.name", Value: cm.Certificate.IssuerRef.Name})
+		kvs = append(kvs, bom.KeyValue{Key: "certIssuer.kind", Value: certmanager.GetIssuerKind(cm.Certificate.IssuerRef)})
+	}
+
 	return kvs, nil
 }
 
+// applicationResourceKinds are the kinds of the application resources deleted when Verrazzano is deleted, the
+// multicluster resources are deleted before the OAM resources they create
+var applicationResourceKinds = []schema.GroupVersionKind{
+	{Group: "clusters.verrazzano.io", Version: "v1alpha1", Kind: "VerrazzanoManagedCluster"},
+	{Group: "clusters.verrazzano.io", Version: "v1alpha1", Kind: "VerrazzanoProject"},
+	{Group: "clusters.verrazzano.io", Version: "v1alpha1", Kind: "MultiClusterApplicationConfiguration"},
+	{Group: "clusters.verrazzano.io", Version: "v1alpha1", Kind: "MultiClusterComponent"},
+	{Group: "clusters.verrazzano.io", Version: "v1alpha1", Kind: "MultiClusterConfigMap"},
+	{Group: "clusters.verrazzano.io", Version: "v1alpha1", Kind: "MultiClusterLoggingScope"},
+	{Group: "clusters.verrazzano.io", Version: "v1alpha1", Kind: "MultiClusterSecret"},
+	{Group: "core.oam.dev", Version: "v1alpha2", Kind: "ApplicationConfiguration"},
+	{Group: "core.oam.dev", Version: "v1alpha2", Kind: "Component"},
+}
+
+// managedClusterSecrets are the secrets created when a managed cluster is registered with an admin cluster
+var managedClusterSecrets = []string{
+	"verrazzano-cluster-agent",
+	"verrazzano-cluster-registration",
+	"verrazzano-cluster-elasticsearch",
+}
+
+// preUninstall deletes the multicluster and OAM application resources when Verrazzano is deleted, while the
+// application operator and the OAM runtime are still running to process their finalizers.  Nothing is deleted when
+// the component is only disabled.
+func preUninstall(ctx spi.ComponentContext, _ string, namespace string) error {
+	if ctx.IsDryRun() || !common.IsVerrazzanoBeingDeleted(ctx) {
+		return nil
+	}
+	for _, name := range managedClusterSecrets {
+		secret := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name}}
+		if err := common.DeleteObjects(ctx, secret); err != nil {
+			return err
+		}
+	}
+	for _, gvk := range applicationResourceKinds {
+		if err := common.DeleteAllResources(ctx, gvk, false); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // isApplicationOperatorReady checks if the application operator deployment is ready
 func isApplicationOperatorReady(ctx spi.ComponentContext) bool {
 	deployments := []types.NamespacedName{
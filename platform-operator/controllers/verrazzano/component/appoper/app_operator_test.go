@@ -11,14 +11,19 @@ import (
 	oam "github.com/crossplane/oam-kubernetes-runtime/apis/core"
 	oamv1alpha2 "github.com/crossplane/oam-kubernetes-runtime/apis/core/v1alpha2"
 	"github.com/stretchr/testify/assert"
+	"github.com/verrazzano/verrazzano/pkg/bom"
+	vzapi "github.com/verrazzano/verrazzano/platform-operator/apis/verrazzano/v1alpha1"
 	"github.com/verrazzano/verrazzano/platform-operator/constants"
 	"github.com/verrazzano/verrazzano/platform-operator/controllers/verrazzano/component/spi"
 	"github.com/verrazzano/verrazzano/platform-operator/internal/config"
 	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	k8scheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 )
 
@@ -37,7 +42,7 @@ func TestAppendAppOperatorOverrides(t *testing.T) {
 	const expectedIstioProxyImage = "ghcr.io/verrazzano/proxyv2:1.7.3"
 	const expectedWeblogicMonitoringExporterImage = "ghcr.io/oracle/weblogic-monitoring-exporter:2.0.4"
 
-	kvs, err := AppendApplicationOperatorOverrides(nil, "", "", "", nil)
+	kvs, err := AppendApplicationOperatorOverrides(spi.NewFakeContext(nil, &vzapi.Verrazzano{}, false), "", "", "", nil)
 	a.NoError(err, "AppendApplicationOperatorOverrides returned an error ")
 	a.Len(kvs, 3, "AppendApplicationOperatorOverrides returned an unexpected number of Key:Value pairs")
 	a.Equalf("fluentdImage", kvs[0].Key, "Did not get expected fluentdImage Key")
@@ -51,7 +56,7 @@ func TestAppendAppOperatorOverrides(t *testing.T) {
 	_ = os.Setenv(constants.VerrazzanoAppOperatorImageEnvVar, customImage)
 	defer func() { _ = os.Unsetenv(constants.RegistryOverrideEnvVar) }()
 
-	kvs, err = AppendApplicationOperatorOverrides(nil, "", "", "", nil)
+	kvs, err = AppendApplicationOperatorOverrides(spi.NewFakeContext(nil, &vzapi.Verrazzano{}, false), "", "", "", nil)
 	a.NoError(err, "AppendApplicationOperatorOverrides returned an error ")
 	a.Len(kvs, 4, "AppendApplicationOperatorOverrides returned wrong number of Key:Value pairs")
 	a.Equalf("image", kvs[0].Key, "Did not get expected image Key")
@@ -64,6 +69,24 @@ func TestAppendAppOperatorOverrides(t *testing.T) {
 	a.Equalf(expectedWeblogicMonitoringExporterImage, kvs[3].Value, "Did not get expected weblogicMonitoringExporterImage Value")
 }
 
+// TestAppendAppOperatorOverridesIssuerRef tests the overrides of the issuer of the application gateway certificates
+// GIVEN a Verrazzano CR with a certificate IssuerRef
+//  WHEN I call AppendApplicationOperatorOverrides
+//  THEN the name and kind of the issuer are set
+func TestAppendAppOperatorOverridesIssuerRef(t *testing.T) {
+	a := assert.New(t)
+
+	config.SetDefaultBomFilePath(testBomFilePath)
+
+	vz := &vzapi.Verrazzano{Spec: vzapi.VerrazzanoSpec{Components: vzapi.ComponentSpec{CertManager: &vzapi.CertManagerComponent{
+		Certificate: vzapi.Certificate{IssuerRef: vzapi.IssuerRef{Name: "vault-issuer", Kind: "Issuer"}},
+	}}}}
+	kvs, err := AppendApplicationOperatorOverrides(spi.NewFakeContext(nil, vz, false), "", "", "", nil)
+	a.NoError(err, "AppendApplicationOperatorOverrides returned an error ")
+	a.Contains(kvs, bom.KeyValue{Key: "certIssuer.name", Value: "vault-issuer"})
+	a.Contains(kvs, bom.KeyValue{Key: "certIssuer.kind", Value: "Issuer"})
+}
+
 // TestIsApplicationOperatorReady tests the isApplicationOperatorReady function
 // GIVEN a call to isApplicationOperatorReady
 //  WHEN the deployment object has enough replicas available
@@ -214,3 +237,27 @@ func checkWorkloadDefinition(t *testing.T, trait *oamv1alpha2.WorkloadDefinition
 	assert.Contains(t, trait.Annotations[helmReleaseNameAnnotation], ComponentName)
 	assert.Contains(t, trait.Annotations[helmReleaseNamespaceAnnotation], ComponentNamespace)
 }
+
+// TestPreUninstall tests the preUninstall function
+// GIVEN an OAM application and the managed cluster secrets
+//  WHEN preUninstall is called
+//  THEN they are deleted only when Verrazzano is deleted
+func TestPreUninstall(t *testing.T) {
+	scheme := k8scheme.Scheme
+	_ = oam.AddToScheme(scheme)
+	appConfig := &oamv1alpha2.ApplicationConfiguration{ObjectMeta: metav1.ObjectMeta{Namespace: "hello", Name: "hello-app"}}
+	secret := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Namespace: ComponentNamespace, Name: "verrazzano-cluster-agent"}}
+
+	// The component is disabled, nothing is deleted
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(appConfig, secret).Build()
+	assert.NoError(t, preUninstall(spi.NewFakeContext(c, &vzapi.Verrazzano{}, false), ComponentName, ComponentNamespace))
+	assert.NoError(t, c.Get(context.TODO(), client.ObjectKeyFromObject(appConfig), &oamv1alpha2.ApplicationConfiguration{}))
+	assert.NoError(t, c.Get(context.TODO(), client.ObjectKeyFromObject(secret), &corev1.Secret{}))
+
+	// Verrazzano is deleted, the application resources are deleted
+	now := metav1.Now()
+	vz := &vzapi.Verrazzano{ObjectMeta: metav1.ObjectMeta{DeletionTimestamp: &now}}
+	assert.NoError(t, preUninstall(spi.NewFakeContext(c, vz, false), ComponentName, ComponentNamespace))
+	assert.True(t, errors.IsNotFound(c.Get(context.TODO(), client.ObjectKeyFromObject(appConfig), &oamv1alpha2.ApplicationConfiguration{})))
+	assert.True(t, errors.IsNotFound(c.Get(context.TODO(), client.ObjectKeyFromObject(secret), &corev1.Secret{})))
+}
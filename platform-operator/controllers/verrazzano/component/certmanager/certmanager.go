@@ -35,10 +35,13 @@ import (
 	"github.com/verrazzano/verrazzano/pkg/k8sutil"
 	"github.com/verrazzano/verrazzano/pkg/security/password"
 	vzapi "github.com/verrazzano/verrazzano/platform-operator/apis/verrazzano/v1alpha1"
+	"github.com/verrazzano/verrazzano/platform-operator/controllers/verrazzano/component/common"
 	"github.com/verrazzano/verrazzano/platform-operator/controllers/verrazzano/component/spi"
 	"github.com/verrazzano/verrazzano/platform-operator/internal/config"
 	"github.com/verrazzano/verrazzano/platform-operator/internal/k8s/status"
+	"github.com/verrazzano/verrazzano/platform-operator/internal/vzconfig"
 	v1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	crtclient "sigs.k8s.io/controller-runtime/pkg/client"
@@ -78,6 +81,14 @@ const (
 
 	extraArgsKey  = "extraArgs[0]"
 	acmeSolverArg = "--acme-http01-solver-image="
+
+	// Overrides of the issuer that the ingress-shim uses for the ingresses
+	defaultIssuerNameKey = "ingressShim.defaultIssuerName"
+	defaultIssuerKindKey = "ingressShim.defaultIssuerKind"
+
+	// Kinds of the issuers that an IssuerRef may reference
+	ClusterIssuerKind = "ClusterIssuer"
+	IssuerKind        = "Issuer"
 )
 
 type authenticationType string
@@ -381,6 +392,13 @@ func AppendOverrides(compContext spi.ComponentContext, _ string, _ string, _ str
 		ns := compContext.EffectiveCR().Spec.Components.CertManager.Certificate.CA.ClusterResourceNamespace
 		kvs = append(kvs, bom.KeyValue{Key: clusterResourceNamespaceKey, Value: ns})
 	}
+
+	// The ingresses request their certificates from an external issuer instead of the Verrazzano ClusterIssuer
+	if isExternalIssuer(compContext.EffectiveCR()) {
+		issuerRef := compContext.EffectiveCR().Spec.Components.CertManager.Certificate.IssuerRef
+		kvs = append(kvs, bom.KeyValue{Key: defaultIssuerNameKey, Value: issuerRef.Name})
+		kvs = append(kvs, bom.KeyValue{Key: defaultIssuerKindKey, Value: GetIssuerKind(issuerRef)})
+	}
 	return kvs, nil
 }
 
@@ -477,9 +495,23 @@ func isCA(compContext spi.ComponentContext) (bool, error) {
 	return validateConfiguration(compContext.EffectiveCR())
 }
 
+// isExternalIssuer returns true if the certificates are issued by an existing issuer that is not managed by Verrazzano
+func isExternalIssuer(cr *vzapi.Verrazzano) bool {
+	cm := cr.Spec.Components.CertManager
+	return cm != nil && cm.Certificate.IssuerRef != vzapi.IssuerRef{}
+}
+
+// GetIssuerKind returns the kind of the issuer referenced by an IssuerRef, ClusterIssuer by default
+func GetIssuerKind(issuerRef vzapi.IssuerRef) string {
+	if issuerRef.Kind == "" {
+		return ClusterIssuerKind
+	}
+	return issuerRef.Kind
+}
+
 // validateConfiguration Checks if the configuration is valid and is a CA configuration
 // - returns true if it is a CA configuration, false if not
-// - returns an error if both CA and ACME settings are configured
+// - returns an error if more than one of the CA, ACME and IssuerRef settings are configured
 func validateConfiguration(cr *vzapi.Verrazzano) (isCA bool, err error) {
 	components := cr.Spec.Components
 	if components.CertManager == nil {
@@ -492,6 +524,12 @@ func validateConfiguration(cr *vzapi.Verrazzano) (isCA bool, err error) {
 	if caNotEmpty && acmeNotEmpty {
 		return false, errors.New("Certificate object Acme and CA cannot be simultaneously populated")
 	}
+	if isExternalIssuer(cr) {
+		if caNotEmpty || acmeNotEmpty {
+			return false, errors.New("Certificate object IssuerRef cannot be populated with Acme or CA")
+		}
+		return false, validateIssuerRefConfiguration(components.CertManager.Certificate.IssuerRef)
+	}
 	if caNotEmpty {
 		if err := validateCAConfiguration(components.CertManager.Certificate.CA); err != nil {
 			return true, err
@@ -503,11 +541,113 @@ func validateConfiguration(cr *vzapi.Verrazzano) (isCA bool, err error) {
 		}
 		return false, nil
 	}
-	return false, errors.New("Either Acme or CA certificate authorities must be configured")
+	return false, errors.New("Either Acme or CA certificate authorities or an IssuerRef must be configured")
+}
+
+// validateIssuerRefConfiguration checks the kind of the referenced issuer, and that it is not one of the issuers
+// that Verrazzano manages and cleans up
+func validateIssuerRefConfiguration(issuerRef vzapi.IssuerRef) error {
+	if issuerRef.Name == "" {
+		return errors.New("Certificate IssuerRef requires a name")
+	}
+	kind := GetIssuerKind(issuerRef)
+	if kind != ClusterIssuerKind && kind != IssuerKind {
+		return fmt.Errorf("Invalid certificate IssuerRef kind %s, it must be %s or %s", kind, ClusterIssuerKind, IssuerKind)
+	}
+	if issuerRef.Name == verrazzanoClusterIssuerName || issuerRef.Name == caSelfSignedIssuerName {
+		return fmt.Errorf("Certificate IssuerRef %s is managed by Verrazzano, it cannot be referenced", issuerRef.Name)
+	}
+	return nil
+}
+
+// checkIssuerConfiguration returns an error if the certificate issuer configuration is not valid, or if the
+// Verrazzano ClusterIssuer exists and is not ready.  Unlike validateConfiguration, the CA secret is read with the
+// component context client.
+func checkIssuerConfiguration(compContext spi.ComponentContext) error {
+	cli := compContext.Client()
+	cr := compContext.EffectiveCR()
+	if cr.Spec.Components.CertManager != nil {
+		certConfig := cr.Spec.Components.CertManager.Certificate
+		if certConfig.Acme != (vzapi.Acme{}) {
+			if err := validateAcmeConfiguration(certConfig.Acme); err != nil {
+				return fmt.Errorf("Invalid ACME certificate configuration: %v", err)
+			}
+		} else if certConfig.CA != (vzapi.CA{}) && !isDefaultCAConfiguration(certConfig.CA) {
+			secret := v1.Secret{}
+			nsn := types.NamespacedName{Namespace: certConfig.CA.ClusterResourceNamespace, Name: certConfig.CA.SecretName}
+			if err := cli.Get(context.TODO(), nsn, &secret); err != nil {
+				return fmt.Errorf("Failed getting the CA secret %v of the certificate configuration: %v", nsn, err)
+			}
+		} else if certConfig.IssuerRef != (vzapi.IssuerRef{}) {
+			if err := validateIssuerRefConfiguration(certConfig.IssuerRef); err != nil {
+				return fmt.Errorf("Invalid IssuerRef certificate configuration: %v", err)
+			}
+			return checkExternalIssuerReady(cli, cr, certConfig.IssuerRef)
+		}
+	}
+
+	issuer := certv1.ClusterIssuer{}
+	if err := cli.Get(context.TODO(), types.NamespacedName{Name: verrazzanoClusterIssuerName}, &issuer); err != nil {
+		if k8serrors.IsNotFound(err) {
+			return nil
+		}
+		return fmt.Errorf("Failed getting the ClusterIssuer %s: %v", verrazzanoClusterIssuerName, err)
+	}
+	if !cmutil.IssuerHasCondition(&issuer, certv1.IssuerCondition{Type: certv1.IssuerConditionReady, Status: certmetav1.ConditionTrue}) {
+		return fmt.Errorf("ClusterIssuer %s is not ready", verrazzanoClusterIssuerName)
+	}
+	return nil
+}
+
+// checkExternalIssuerReady returns an error if the referenced ClusterIssuer does not exist or is not ready.  An Issuer
+// is checked in every namespace that has Verrazzano ingresses or certificates.
+func checkExternalIssuerReady(cli crtclient.Client, cr *vzapi.Verrazzano, issuerRef vzapi.IssuerRef) error {
+	if GetIssuerKind(issuerRef) == IssuerKind {
+		for _, namespace := range getIssuerNamespaces(cr) {
+			issuer := certv1.Issuer{}
+			nsn := types.NamespacedName{Namespace: namespace, Name: issuerRef.Name}
+			if err := cli.Get(context.TODO(), nsn, &issuer); err != nil {
+				return fmt.Errorf("Failed getting the Issuer %v: %v", nsn, err)
+			}
+			if !cmutil.IssuerHasCondition(&issuer, certv1.IssuerCondition{Type: certv1.IssuerConditionReady, Status: certmetav1.ConditionTrue}) {
+				return fmt.Errorf("Issuer %v is not ready", nsn)
+			}
+		}
+		return nil
+	}
+	issuer := certv1.ClusterIssuer{}
+	if err := cli.Get(context.TODO(), types.NamespacedName{Name: issuerRef.Name}, &issuer); err != nil {
+		return fmt.Errorf("Failed getting the ClusterIssuer %s: %v", issuerRef.Name, err)
+	}
+	if !cmutil.IssuerHasCondition(&issuer, certv1.IssuerCondition{Type: certv1.IssuerConditionReady, Status: certmetav1.ConditionTrue}) {
+		return fmt.Errorf("ClusterIssuer %s is not ready", issuerRef.Name)
+	}
+	return nil
+}
+
+// getIssuerNamespaces returns the namespaces of the Verrazzano ingresses, and the istio-system namespace of the
+// certificates of the application ingresses
+func getIssuerNamespaces(cr *vzapi.Verrazzano) []string {
+	namespaces := []string{constants.VerrazzanoSystemNamespace}
+	if vzconfig.IsApplicationOperatorEnabled(cr) {
+		namespaces = append(namespaces, constants.IstioSystemNamespace)
+	}
+	if vzconfig.IsKeycloakEnabled(cr) {
+		namespaces = append(namespaces, constants.KeycloakNamespace)
+	}
+	if vzconfig.IsRancherEnabled(cr) {
+		namespaces = append(namespaces, constants.RancherSystemNamespace)
+	}
+	return namespaces
+}
+
+// isDefaultCAConfiguration returns true for the default self-signed CA, the secret of which is created by cert-manager
+func isDefaultCAConfiguration(ca vzapi.CA) bool {
+	return ca.SecretName == constants.DefaultVerrazzanoCASecretName && ca.ClusterResourceNamespace == ComponentNamespace
 }
 
 func validateCAConfiguration(ca vzapi.CA) error {
-	if ca.SecretName == constants.DefaultVerrazzanoCASecretName && ca.ClusterResourceNamespace == ComponentNamespace {
+	if isDefaultCAConfiguration(ca) {
 		// if it's the default self-signed config the secret won't exist until created by CertManager
 		return nil
 	}
@@ -567,6 +707,10 @@ func isLetsEncryptStaging(compContext spi.ComponentContext) bool {
 // - returns OperationResultUpdated/nil if the CI is updated
 func createOrUpdateAcmeResources(compContext spi.ComponentContext) (opResult controllerutil.OperationResult, err error) {
 	opResult = controllerutil.OperationResultNone
+	if isExternalIssuer(compContext.EffectiveCR()) {
+		compContext.Log().Oncef("The certificate issuer is managed externally, skipping the ACME ClusterIssuer")
+		return opResult, nil
+	}
 	// Create a lookup object
 	getCIObject, err := createAcmeCusterIssuerLookupObject(compContext.Log())
 	if err != nil {
@@ -672,6 +816,10 @@ func createAcmeCusterIssuerLookupObject(log vzlog.VerrazzanoLogger) (*unstructur
 // - returns OperationResultCreated/nil if the CI is created (initial install)
 // - returns OperationResultUpdated/nil if the CI is updated
 func createOrUpdateCAResources(compContext spi.ComponentContext) (controllerutil.OperationResult, error) {
+	if isExternalIssuer(compContext.EffectiveCR()) {
+		compContext.Log().Oncef("The certificate issuer is managed externally, skipping the CA ClusterIssuer")
+		return controllerutil.OperationResultNone, nil
+	}
 	vzCertCA := compContext.EffectiveCR().Spec.Components.CertManager.Certificate.CA
 
 	// if the CA cert secret does not exist, create the Issuer and Certificate resources
@@ -801,14 +949,18 @@ func extractCommonNameFromCertSecret(secret *v1.Secret) (string, error) {
 	return cert.Issuer.CommonName, nil
 }
 
+// cleanupUnusedResources deletes the Verrazzano issuer resources that are not used by the certificate configuration.
+// When an external issuer is configured, all of them are deleted, including the Verrazzano ClusterIssuer once no
+// certificate uses it; the external issuer is never deleted since it cannot have the name of a Verrazzano issuer.
 func cleanupUnusedResources(compContext spi.ComponentContext, isCAValue bool) error {
+	externalIssuer := isExternalIssuer(compContext.EffectiveCR())
 	defaultCANotUsed := func() bool {
-		// We're not using the default CA if we're either configured for ACME or it's a Customer-provided CA
-		return !isCAValue || compContext.EffectiveCR().Spec.Components.CertManager.Certificate.CA.SecretName != defaultCACertificateSecretName
+		// We're not using the default CA if we're either configured for ACME, an external issuer or it's a Customer-provided CA
+		return !isCAValue || externalIssuer || compContext.EffectiveCR().Spec.Components.CertManager.Certificate.CA.SecretName != defaultCACertificateSecretName
 	}
 	client := compContext.Client()
 	log := compContext.Log()
-	if isCAValue {
+	if isCAValue || externalIssuer {
 		log.Oncef("Clean up ACME issuer secret")
 		// clean up ACME secret if present
 		if err := deleteObject(client, caAcmeSecretName, ComponentNamespace, &v1.Secret{}); err != nil {
@@ -831,9 +983,38 @@ func cleanupUnusedResources(compContext spi.ComponentContext, isCAValue bool) er
 			return err
 		}
 	}
+	if externalIssuer {
+		inUse, err := isClusterIssuerInUse(client)
+		if err != nil {
+			return err
+		}
+		if inUse {
+			log.Progressf("Waiting for the certificates to stop using the ClusterIssuer %s before deleting it", verrazzanoClusterIssuerName)
+			return nil
+		}
+		log.Oncef("Clean up the Verrazzano ClusterIssuer")
+		if err := deleteObject(client, verrazzanoClusterIssuerName, "", &certv1.ClusterIssuer{}); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
+// isClusterIssuerInUse returns true if a certificate is still issued by the Verrazzano ClusterIssuer, like the
+// certificates of the application ingresses until the application operator moves them to the external issuer
+func isClusterIssuerInUse(client crtclient.Client) (bool, error) {
+	certs := certv1.CertificateList{}
+	if err := client.List(context.TODO(), &certs); err != nil {
+		return false, err
+	}
+	for _, cert := range certs.Items {
+		if cert.Spec.IssuerRef.Name == verrazzanoClusterIssuerName && (cert.Spec.IssuerRef.Kind == "" || cert.Spec.IssuerRef.Kind == ClusterIssuerKind) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
 func deleteObject(client crtclient.Client, name string, namespace string, object crtclient.Object) error {
 	object.SetName(name)
 	object.SetNamespace(namespace)
@@ -850,3 +1031,23 @@ func GetOverrides(effectiveCR *vzapi.Verrazzano) []vzapi.Overrides {
 	}
 	return []vzapi.Overrides{}
 }
+
+// postUninstall deletes the cert-manager leader election ConfigMaps and the cert-manager namespace.  The cert-manager
+// CRDs are only deleted with Verrazzano because they hold the certificates of the applications.
+func postUninstall(compContext spi.ComponentContext, _ string, namespace string) error {
+	if compContext.IsDryRun() {
+		return nil
+	}
+	if common.IsVerrazzanoBeingDeleted(compContext) {
+		if err := common.DeleteCRDsMatching(compContext, `\.cert-manager\.io$`); err != nil {
+			return err
+		}
+	}
+	err := common.DeleteObjects(compContext,
+		&v1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Namespace: "kube-system", Name: "cert-manager-controller"}},
+		&v1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Namespace: "kube-system", Name: "cert-manager-cainjector-leader-election"}})
+	if err != nil {
+		return err
+	}
+	return common.DeleteNamespace(compContext, namespace)
+}
@@ -53,6 +53,7 @@ func NewComponent() spi.Component {
 			AppendOverridesFunc:     AppendOverrides,
 			MinVerrazzanoVersion:    constants.VerrazzanoVersion1_0_0,
 			Dependencies:            []string{},
+			PostUninstallFunc:       postUninstall,
 			GetInstallOverridesFunc: GetOverrides,
 		},
 	}
@@ -83,6 +84,15 @@ func (c certManagerComponent) ValidateUpdate(old *vzapi.Verrazzano, new *vzapi.V
 	return c.HelmComponent.ValidateUpdate(old, new)
 }
 
+// PreflightChecks verifies that the Helm release has no pending operation and that the certificate issuer is valid
+func (c certManagerComponent) PreflightChecks(compContext spi.ComponentContext) []error {
+	errs := c.HelmComponent.PreflightChecks(compContext)
+	if err := checkIssuerConfiguration(compContext); err != nil {
+		errs = append(errs, err)
+	}
+	return errs
+}
+
 // ValidateInstall checks if the specified new Verrazzano CR is valid for this component to be installed
 func (c certManagerComponent) ValidateInstall(vz *vzapi.Verrazzano) error {
 	// Do not allow any changes except to enable the component post-install
@@ -131,6 +141,7 @@ func (c certManagerComponent) PreInstall(compContext spi.ComponentContext) error
 // PostInstall applies necessary cert-manager resources after the install has occurred
 // In the case of an Acme cert, we install Acme resources
 // In the case of a CA cert, we install CA resources
+// In the case of an external issuer, there are no resources to install
 func (c certManagerComponent) PostInstall(compContext spi.ComponentContext) error {
 	// If it is a dry-run, do nothing
 	if compContext.IsDryRun() {
@@ -157,6 +168,11 @@ func (c certManagerComponent) createOrUpdateClusterIssuer(compContext spi.Compon
 	if err != nil {
 		return compContext.Log().ErrorfNewErr("Failed to verify the config type: %v", err)
 	}
+	if isExternalIssuer(compContext.EffectiveCR()) {
+		// The ingress-shim reissues the ingress certificates with the external issuer, only the resources of a
+		// previous configuration are cleaned up
+		return cleanupUnusedResources(compContext, isCAValue)
+	}
 	var opResult controllerutil.OperationResult
 	if !isCAValue {
 		// Create resources needed for Acme certificates
@@ -16,6 +16,7 @@ import (
 	certv1fake "github.com/jetstack/cert-manager/pkg/client/clientset/versioned/fake"
 	certv1client "github.com/jetstack/cert-manager/pkg/client/clientset/versioned/typed/certmanager/v1"
 	"github.com/stretchr/testify/assert"
+	vzconst "github.com/verrazzano/verrazzano/pkg/constants"
 	"github.com/verrazzano/verrazzano/pkg/k8sutil"
 	"github.com/verrazzano/verrazzano/pkg/log/vzlog"
 	"github.com/verrazzano/verrazzano/platform-operator/controllers/verrazzano/component/spi"
@@ -28,6 +29,7 @@ import (
 	v1 "k8s.io/client-go/kubernetes/typed/core/v1"
 	"math/big"
 	"net"
+	clipkg "sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 	"testing"
 	"time"
@@ -424,6 +426,87 @@ func TestClusterIssuerUpdated(t *testing.T) {
 	asserts.NotNil(otherReq)
 }
 
+// TestPreflightChecks tests the PreflightChecks function
+// GIVEN a call to PreflightChecks
+//  WHEN for various issuer configurations
+//  THEN an error is returned if the issuer configuration is not valid or the ClusterIssuer is not ready
+func TestPreflightChecks(t *testing.T) {
+	badAcme := acme
+	badAcme.EmailAddress = "notAnEmailAddress"
+	newClusterIssuer := func(status cmmeta.ConditionStatus) *certv1.ClusterIssuer {
+		return &certv1.ClusterIssuer{
+			ObjectMeta: metav1.ObjectMeta{Name: verrazzanoClusterIssuerName},
+			Status: certv1.IssuerStatus{
+				Conditions: []certv1.IssuerCondition{{Type: certv1.IssuerConditionReady, Status: status}},
+			},
+		}
+	}
+	newExternalIssuer := func(name string, namespace string, status cmmeta.ConditionStatus) clipkg.Object {
+		issuerStatus := certv1.IssuerStatus{
+			Conditions: []certv1.IssuerCondition{{Type: certv1.IssuerConditionReady, Status: status}},
+		}
+		if namespace == "" {
+			return &certv1.ClusterIssuer{ObjectMeta: metav1.ObjectMeta{Name: name}, Status: issuerStatus}
+		}
+		return &certv1.Issuer{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace}, Status: issuerStatus}
+	}
+	caSecret := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: ca.SecretName, Namespace: ca.ClusterResourceNamespace}}
+
+	tests := []struct {
+		name   string
+		cert   vzapi.Certificate
+		objs   []clipkg.Object
+		errMsg string
+	}{
+		{name: "ready CA issuer", cert: vzapi.Certificate{CA: ca}, objs: []clipkg.Object{caSecret, newClusterIssuer(cmmeta.ConditionTrue)}},
+		{name: "issuer not created yet", cert: vzapi.Certificate{Acme: acme}},
+		{name: "missing CA secret", cert: vzapi.Certificate{CA: ca}, errMsg: "Failed getting the CA secret testNamespace/testSecret"},
+		{name: "invalid ACME configuration", cert: vzapi.Certificate{Acme: badAcme}, errMsg: "Invalid ACME certificate configuration"},
+		{name: "issuer not ready", cert: vzapi.Certificate{Acme: acme}, objs: []clipkg.Object{newClusterIssuer(cmmeta.ConditionFalse)},
+			errMsg: "ClusterIssuer verrazzano-cluster-issuer is not ready"},
+		{name: "ready external ClusterIssuer", cert: vzapi.Certificate{IssuerRef: issuerRef}, objs: []clipkg.Object{newExternalIssuer(issuerRef.Name, "", cmmeta.ConditionTrue)}},
+		{name: "missing external ClusterIssuer", cert: vzapi.Certificate{IssuerRef: issuerRef}, errMsg: "Failed getting the ClusterIssuer vault-issuer"},
+		{name: "external ClusterIssuer not ready", cert: vzapi.Certificate{IssuerRef: issuerRef}, objs: []clipkg.Object{newExternalIssuer(issuerRef.Name, "", cmmeta.ConditionFalse)},
+			errMsg: "ClusterIssuer vault-issuer is not ready"},
+		{name: "ready external Issuer", cert: vzapi.Certificate{IssuerRef: vzapi.IssuerRef{Name: issuerRef.Name, Kind: IssuerKind}},
+			objs: []clipkg.Object{
+				newExternalIssuer(issuerRef.Name, vzconst.VerrazzanoSystemNamespace, cmmeta.ConditionTrue),
+				newExternalIssuer(issuerRef.Name, vzconst.IstioSystemNamespace, cmmeta.ConditionTrue),
+				newExternalIssuer(issuerRef.Name, vzconst.KeycloakNamespace, cmmeta.ConditionTrue),
+				newExternalIssuer(issuerRef.Name, vzconst.RancherSystemNamespace, cmmeta.ConditionTrue),
+			}},
+		{name: "external Issuer missing in an ingress namespace", cert: vzapi.Certificate{IssuerRef: vzapi.IssuerRef{Name: issuerRef.Name, Kind: IssuerKind}},
+			objs: []clipkg.Object{
+				newExternalIssuer(issuerRef.Name, vzconst.VerrazzanoSystemNamespace, cmmeta.ConditionTrue),
+				newExternalIssuer(issuerRef.Name, vzconst.IstioSystemNamespace, cmmeta.ConditionTrue),
+				newExternalIssuer(issuerRef.Name, vzconst.KeycloakNamespace, cmmeta.ConditionTrue),
+			},
+			errMsg: "Failed getting the Issuer cattle-system/vault-issuer"},
+		{name: "external Issuer not ready in an ingress namespace", cert: vzapi.Certificate{IssuerRef: vzapi.IssuerRef{Name: issuerRef.Name, Kind: IssuerKind}},
+			objs: []clipkg.Object{
+				newExternalIssuer(issuerRef.Name, vzconst.VerrazzanoSystemNamespace, cmmeta.ConditionTrue),
+				newExternalIssuer(issuerRef.Name, vzconst.IstioSystemNamespace, cmmeta.ConditionFalse),
+			},
+			errMsg: "Issuer istio-system/vault-issuer is not ready"},
+		{name: "invalid IssuerRef kind", cert: vzapi.Certificate{IssuerRef: vzapi.IssuerRef{Name: issuerRef.Name, Kind: "Secret"}},
+			errMsg: "Invalid IssuerRef certificate configuration"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			localvz := defaultVZConfig.DeepCopy()
+			localvz.Spec.Components.CertManager.Certificate = tt.cert
+			client := fake.NewClientBuilder().WithScheme(testScheme).WithObjects(tt.objs...).Build()
+			errs := fakeComponent.PreflightChecks(spi.NewFakeContext(client, localvz, false))
+			if len(tt.errMsg) == 0 {
+				assert.Empty(t, errs)
+				return
+			}
+			assert.Len(t, errs, 1)
+			assert.Contains(t, errs[0].Error(), tt.errMsg)
+		})
+	}
+}
+
 // TestDryRun tests the behavior when DryRun is enabled, mainly for code coverage
 // GIVEN a call to PostInstall/PostUpgrade/PreInstall
 //  WHEN the ComponentContext has DryRun set to true
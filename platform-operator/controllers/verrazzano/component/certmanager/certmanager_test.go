@@ -15,6 +15,7 @@ import (
 	"testing"
 
 	certv1 "github.com/jetstack/cert-manager/pkg/apis/certmanager/v1"
+	certmetav1 "github.com/jetstack/cert-manager/pkg/apis/meta/v1"
 	"github.com/stretchr/testify/assert"
 	"github.com/verrazzano/verrazzano/pkg/bom"
 	vzapi "github.com/verrazzano/verrazzano/platform-operator/apis/verrazzano/v1alpha1"
@@ -48,6 +49,11 @@ var acme = vzapi.Acme{
 	Environment:  letsEncryptStaging,
 }
 
+// Default external issuer object
+var issuerRef = vzapi.IssuerRef{
+	Name: "vault-issuer",
+}
+
 // Default Verrazzano object
 var defaultVZConfig = &vzapi.Verrazzano{
 	Spec: vzapi.VerrazzanoSpec{
@@ -289,6 +295,75 @@ func TestIsCABothPopulated(t *testing.T) {
 	assert.Error(t, err)
 }
 
+// TestIsCAExternalIssuer tests the isCA function
+// GIVEN a call to isCA
+// WHEN the Certificate IssuerRef is populated
+// THEN false is returned, and an error if the IssuerRef is not valid or is populated with CA or Acme
+func TestIsCAExternalIssuer(t *testing.T) {
+	tests := []struct {
+		name      string
+		cert      vzapi.Certificate
+		expectErr bool
+	}{
+		{name: "ClusterIssuer", cert: vzapi.Certificate{IssuerRef: issuerRef}},
+		{name: "Issuer", cert: vzapi.Certificate{IssuerRef: vzapi.IssuerRef{Name: "vault-issuer", Kind: IssuerKind}}},
+		{name: "with CA", cert: vzapi.Certificate{IssuerRef: issuerRef, CA: ca}, expectErr: true},
+		{name: "with Acme", cert: vzapi.Certificate{IssuerRef: issuerRef, Acme: acme}, expectErr: true},
+		{name: "no name", cert: vzapi.Certificate{IssuerRef: vzapi.IssuerRef{Kind: ClusterIssuerKind}}, expectErr: true},
+		{name: "invalid kind", cert: vzapi.Certificate{IssuerRef: vzapi.IssuerRef{Name: "vault-issuer", Kind: "Secret"}}, expectErr: true},
+		{name: "Verrazzano ClusterIssuer", cert: vzapi.Certificate{IssuerRef: vzapi.IssuerRef{Name: verrazzanoClusterIssuerName}}, expectErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			localvz := defaultVZConfig.DeepCopy()
+			localvz.Spec.Components.CertManager.Certificate = tt.cert
+			client := fake.NewClientBuilder().WithScheme(testScheme).Build()
+			isCAValue, err := isCA(spi.NewFakeContext(client, localvz, false, profileDir))
+			assert.False(t, isCAValue)
+			if tt.expectErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+// TestAppendCertManagerOverridesWithIssuerRef tests the AppendOverrides fn
+// GIVEN a call to AppendOverrides
+// WHEN a VZ spec is passed with an external Issuer
+// THEN the ingress-shim uses the external Issuer
+func TestAppendCertManagerOverridesWithIssuerRef(t *testing.T) {
+	config.SetDefaultBomFilePath(testBomFile)
+	localvz := defaultVZConfig.DeepCopy()
+	localvz.Spec.Components.CertManager.Certificate.IssuerRef = vzapi.IssuerRef{Name: "vault-issuer", Kind: IssuerKind}
+	kvs, err := AppendOverrides(spi.NewFakeContext(nil, localvz, false), ComponentName, ComponentNamespace, "", []bom.KeyValue{})
+	assert.NoError(t, err)
+	assert.Len(t, kvs, 2)
+	assert.Contains(t, kvs, bom.KeyValue{Key: defaultIssuerNameKey, Value: "vault-issuer"})
+	assert.Contains(t, kvs, bom.KeyValue{Key: defaultIssuerKindKey, Value: IssuerKind})
+}
+
+// TestCreateResourcesExternalIssuer tests the createOrUpdateCAResources and createOrUpdateAcmeResources functions
+// GIVEN an external ClusterIssuer is configured
+// WHEN a call is made to create the CA or ACME resources
+// THEN the call succeeds and no issuer resources are created
+func TestCreateResourcesExternalIssuer(t *testing.T) {
+	localvz := defaultVZConfig.DeepCopy()
+	localvz.Spec.Components.CertManager.Certificate.IssuerRef = issuerRef
+
+	client := fake.NewClientBuilder().WithScheme(testScheme).Build()
+	opResult, err := createOrUpdateCAResources(spi.NewFakeContext(client, localvz, false, profileDir))
+	assert.NoError(t, err)
+	assert.Equal(t, controllerutil.OperationResultNone, opResult)
+	opResult, err = createOrUpdateAcmeResources(spi.NewFakeContext(client, localvz, false, profileDir))
+	assert.NoError(t, err)
+	assert.Equal(t, controllerutil.OperationResultNone, opResult)
+
+	assertNotFound(t, client, verrazzanoClusterIssuerName, "", &certv1.ClusterIssuer{})
+	assertNotFound(t, client, caSelfSignedIssuerName, ComponentNamespace, &certv1.Issuer{})
+}
+
 // TestCreateCAResources tests the createOrUpdateCAResources function.
 func TestCreateCAResources(t *testing.T) {
 	// GIVEN that a secret with the cluster CA certificate does not exist
@@ -459,6 +534,60 @@ func TestCustomCAConfigCleanupUnusedResources(t *testing.T) {
 	assertNotFound(t, client, caSelfSignedIssuerName, ComponentNamespace, &certv1.Issuer{})
 }
 
+// TestExternalIssuerCleanupUnusedResources tests the cleanupUnusedResources function
+// GIVEN a call to cleanupUnusedResources
+// WHEN an external ClusterIssuer is configured and there are leftover default, ACME and Verrazzano ClusterIssuer resources
+// THEN no error is returned and all of the Verrazzano issuer resources are deleted without affecting the external ClusterIssuer
+func TestExternalIssuerCleanupUnusedResources(t *testing.T) {
+	vz := defaultVZConfig.DeepCopy()
+	vz.Spec.Components.CertManager.Certificate = vzapi.Certificate{IssuerRef: issuerRef}
+
+	client := fake.NewClientBuilder().WithScheme(testScheme).
+		WithObjects(&certv1.ClusterIssuer{ObjectMeta: metav1.ObjectMeta{Name: issuerRef.Name}}).
+		WithObjects(&certv1.ClusterIssuer{ObjectMeta: metav1.ObjectMeta{Name: verrazzanoClusterIssuerName}}).
+		WithObjects(createACMEResources()...).
+		WithObjects(createDefaultIssuerResources()...).
+		Build()
+
+	fakeContext := spi.NewFakeContext(client, vz, false, profileDir)
+	assert.NoError(t, cleanupUnusedResources(fakeContext, false))
+	assertFound(t, client, issuerRef.Name, "", &certv1.ClusterIssuer{})
+	assertNotFound(t, client, verrazzanoClusterIssuerName, "", &certv1.ClusterIssuer{})
+	assertNotFound(t, client, caAcmeSecretName, ComponentNamespace, &v1.Secret{})
+	assertNotFound(t, client, defaultCACertificateSecretName, ComponentNamespace, &v1.Secret{})
+	assertNotFound(t, client, caCertificateName, ComponentNamespace, &certv1.Certificate{})
+	assertNotFound(t, client, caSelfSignedIssuerName, ComponentNamespace, &certv1.Issuer{})
+}
+
+// TestExternalIssuerCleanupClusterIssuerInUse tests the cleanupUnusedResources function
+// GIVEN a call to cleanupUnusedResources
+// WHEN an external ClusterIssuer is configured and an application certificate still uses the Verrazzano ClusterIssuer
+// THEN no error is returned and the Verrazzano ClusterIssuer is kept until the certificate uses another issuer
+func TestExternalIssuerCleanupClusterIssuerInUse(t *testing.T) {
+	vz := defaultVZConfig.DeepCopy()
+	vz.Spec.Components.CertManager.Certificate = vzapi.Certificate{IssuerRef: issuerRef}
+	appCert := &certv1.Certificate{
+		ObjectMeta: metav1.ObjectMeta{Name: "hello-cert", Namespace: "istio-system"},
+		Spec: certv1.CertificateSpec{
+			SecretName: "hello-secret",
+			IssuerRef:  certmetav1.ObjectReference{Name: verrazzanoClusterIssuerName, Kind: ClusterIssuerKind},
+		},
+	}
+
+	client := fake.NewClientBuilder().WithScheme(testScheme).
+		WithObjects(&certv1.ClusterIssuer{ObjectMeta: metav1.ObjectMeta{Name: verrazzanoClusterIssuerName}}, appCert).
+		Build()
+
+	fakeContext := spi.NewFakeContext(client, vz, false, profileDir)
+	assert.NoError(t, cleanupUnusedResources(fakeContext, false))
+	assertFound(t, client, verrazzanoClusterIssuerName, "", &certv1.ClusterIssuer{})
+
+	appCert.Spec.IssuerRef.Name = issuerRef.Name
+	assert.NoError(t, client.Update(context.TODO(), appCert))
+	assert.NoError(t, cleanupUnusedResources(fakeContext, false))
+	assertNotFound(t, client, verrazzanoClusterIssuerName, "", &certv1.ClusterIssuer{})
+}
+
 func assertNotFound(t *testing.T, client clipkg.WithWatch, name string, namespace string, obj clipkg.Object) {
 	err := client.Get(context.TODO(), types.NamespacedName{Name: name, Namespace: namespace}, obj)
 	assert.Error(t, err)
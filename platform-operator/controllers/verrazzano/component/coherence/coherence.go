@@ -7,8 +7,11 @@ import (
 	"fmt"
 	vzapi "github.com/verrazzano/verrazzano/platform-operator/apis/verrazzano/v1alpha1"
 
+	"github.com/verrazzano/verrazzano/platform-operator/controllers/verrazzano/component/common"
 	"github.com/verrazzano/verrazzano/platform-operator/controllers/verrazzano/component/spi"
 	"github.com/verrazzano/verrazzano/platform-operator/internal/k8s/status"
+	adminv1 "k8s.io/api/admissionregistration/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 )
 
@@ -31,3 +34,13 @@ func GetOverrides(effectiveCR *vzapi.Verrazzano) []vzapi.Overrides {
 	}
 	return []vzapi.Overrides{}
 }
+
+// postUninstall deletes the Coherence operator webhook configurations, the operator creates them at runtime
+func postUninstall(ctx spi.ComponentContext, _ string, _ string) error {
+	if ctx.IsDryRun() {
+		return nil
+	}
+	return common.DeleteObjects(ctx,
+		&adminv1.ValidatingWebhookConfiguration{ObjectMeta: metav1.ObjectMeta{Name: "coherence-operator-validating-webhook-configuration"}},
+		&adminv1.MutatingWebhookConfiguration{ObjectMeta: metav1.ObjectMeta{Name: "coherence-operator-mutating-webhook-configuration"}})
+}
@@ -0,0 +1,40 @@
+// Copyright (c) 2022, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package common
+
+import (
+	"net/http"
+
+	"github.com/verrazzano/verrazzano/platform-operator/controllers/verrazzano/component/spi"
+)
+
+// HTTPClientFunc returns the base URL of the REST API of a component and an HTTP client to call it
+type HTTPClientFunc func(ctx spi.ComponentContext) (string, *http.Client, error)
+
+// HTTPClientHook is the HTTPClientFunc used to call the REST API of a component, unit tests set it to call a fake
+// server and reset it to the default function when they are done
+type HTTPClientHook struct {
+	defaultFunc HTTPClientFunc
+	f           HTTPClientFunc
+}
+
+// NewHTTPClientHook returns a hook that calls the default function until it is set
+func NewHTTPClientHook(defaultFunc HTTPClientFunc) *HTTPClientHook {
+	return &HTTPClientHook{defaultFunc: defaultFunc, f: defaultFunc}
+}
+
+// Get returns the base URL of the REST API and an HTTP client to call it
+func (h *HTTPClientHook) Get(ctx spi.ComponentContext) (string, *http.Client, error) {
+	return h.f(ctx)
+}
+
+// Set replaces the function of the hook
+func (h *HTTPClientHook) Set(f HTTPClientFunc) {
+	h.f = f
+}
+
+// Reset restores the default function of the hook
+func (h *HTTPClientHook) Reset() {
+	h.f = h.defaultFunc
+}
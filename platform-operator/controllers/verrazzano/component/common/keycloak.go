@@ -0,0 +1,54 @@
+// Copyright (c) 2022, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package common
+
+import (
+	"context"
+
+	"github.com/verrazzano/verrazzano/pkg/security/password"
+	"github.com/verrazzano/verrazzano/platform-operator/constants"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// KeycloakAdminUser is the user of the Keycloak administrator in the master realm
+	KeycloakAdminUser = "keycloakadmin"
+
+	// KeycloakAdminSecret is the secret with the credentials of the Keycloak administrator
+	KeycloakAdminSecret = "keycloak-http"
+)
+
+// EnsureKeycloakAdminSecret creates the secret with the credentials of the Keycloak administrator if it does not
+// exist and returns it
+func EnsureKeycloakAdminSecret(cli client.Client) (*corev1.Secret, error) {
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      KeycloakAdminSecret,
+			Namespace: constants.KeycloakNamespace,
+		},
+	}
+	err := cli.Get(context.TODO(), types.NamespacedName{Namespace: secret.Namespace, Name: secret.Name}, secret)
+	if err == nil {
+		return secret, nil
+	}
+	if !apierrors.IsNotFound(err) {
+		return nil, err
+	}
+	pw, err := password.GeneratePassword(15)
+	if err != nil {
+		return nil, err
+	}
+	secret.Data = map[string][]byte{
+		"username": []byte(KeycloakAdminUser),
+		"password": []byte(pw),
+	}
+	if err := cli.Create(context.TODO(), secret); err != nil {
+		return nil, err
+	}
+	return secret, nil
+}
@@ -0,0 +1,56 @@
+// Copyright (c) 2022, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package common
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/verrazzano/verrazzano/platform-operator/constants"
+	"github.com/verrazzano/verrazzano/platform-operator/mocks"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	k8scheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+// TestEnsureKeycloakAdminSecret tests the EnsureKeycloakAdminSecret function
+// GIVEN a cluster without the Keycloak admin secret, or with the secret
+//  WHEN EnsureKeycloakAdminSecret is called
+//  THEN the secret is created with a generated password, and an existing secret is returned unchanged
+func TestEnsureKeycloakAdminSecret(t *testing.T) {
+	c := fake.NewClientBuilder().WithScheme(k8scheme.Scheme).Build()
+
+	secret, err := EnsureKeycloakAdminSecret(c)
+	assert.NoError(t, err)
+	assert.Equal(t, KeycloakAdminUser, string(secret.Data["username"]))
+	assert.NotEmpty(t, secret.Data["password"])
+	password := string(secret.Data["password"])
+
+	secret, err = EnsureKeycloakAdminSecret(c)
+	assert.NoError(t, err)
+	assert.Equal(t, password, string(secret.Data["password"]))
+	actual := &corev1.Secret{}
+	assert.NoError(t, c.Get(context.TODO(), types.NamespacedName{Namespace: constants.KeycloakNamespace, Name: KeycloakAdminSecret}, actual))
+	assert.Equal(t, password, string(actual.Data["password"]))
+}
+
+// TestEnsureKeycloakAdminSecretGetFailed tests the EnsureKeycloakAdminSecret function
+// GIVEN a cluster where the Keycloak admin secret can't be read
+//  WHEN EnsureKeycloakAdminSecret is called
+//  THEN the error is returned and the secret is not replaced
+func TestEnsureKeycloakAdminSecretGetFailed(t *testing.T) {
+	mocker := gomock.NewController(t)
+	mock := mocks.NewMockClient(mocker)
+	mock.EXPECT().
+		Get(gomock.Any(), types.NamespacedName{Namespace: constants.KeycloakNamespace, Name: KeycloakAdminSecret}, gomock.Not(gomock.Nil())).
+		Return(fmt.Errorf("unavailable"))
+
+	_, err := EnsureKeycloakAdminSecret(mock)
+	assert.Error(t, err)
+	mocker.Finish()
+}
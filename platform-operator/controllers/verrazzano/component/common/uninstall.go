@@ -0,0 +1,171 @@
+// Copyright (c) 2022, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package common
+
+import (
+	"context"
+	"regexp"
+
+	"github.com/verrazzano/verrazzano/platform-operator/controllers/verrazzano/component/spi"
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	clipkg "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// IsVerrazzanoBeingDeleted returns true if the component is uninstalled because the Verrazzano resource is deleted,
+// as opposed to the component being disabled.  The cleanup of application resources is only done in that case.
+func IsVerrazzanoBeingDeleted(ctx spi.ComponentContext) bool {
+	return !ctx.ActualCR().DeletionTimestamp.IsZero()
+}
+
+// DeleteObjects deletes the objects, the objects that do not exist are ignored
+func DeleteObjects(ctx spi.ComponentContext, objs ...clipkg.Object) error {
+	for _, obj := range objs {
+		if err := ctx.Client().Delete(context.TODO(), obj); clipkg.IgnoreNotFound(err) != nil {
+			return ctx.Log().ErrorfNewErr("Failed deleting %T %s: %v", obj, clipkg.ObjectKeyFromObject(obj), err)
+		}
+	}
+	return nil
+}
+
+// DeleteNamespace removes the finalizers of the namespace and deletes it
+func DeleteNamespace(ctx spi.ComponentContext, name string) error {
+	ns := &corev1.Namespace{}
+	err := ctx.Client().Get(context.TODO(), clipkg.ObjectKey{Name: name}, ns)
+	if errors.IsNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return ctx.Log().ErrorfNewErr("Failed getting namespace %s: %v", name, err)
+	}
+	if err := removeFinalizers(ctx, ns); err != nil {
+		return err
+	}
+	return DeleteObjects(ctx, ns)
+}
+
+// DeleteNamespacesMatching removes the finalizers of the namespaces whose name matches the regular expression and
+// deletes them
+func DeleteNamespacesMatching(ctx spi.ComponentContext, nameRegexp string) error {
+	re := regexp.MustCompile(nameRegexp)
+	nsList := corev1.NamespaceList{}
+	if err := ctx.Client().List(context.TODO(), &nsList); err != nil {
+		return ctx.Log().ErrorfNewErr("Failed listing namespaces: %v", err)
+	}
+	for i := range nsList.Items {
+		if !re.MatchString(nsList.Items[i].Name) {
+			continue
+		}
+		if err := DeleteNamespace(ctx, nsList.Items[i].Name); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// DeleteCRDsMatching removes the finalizers of the CRDs whose name matches the regular expression and deletes them.
+// Deleting a CRD deletes all of its custom resources.
+func DeleteCRDsMatching(ctx spi.ComponentContext, nameRegexp string, exclude ...string) error {
+	re := regexp.MustCompile(nameRegexp)
+	crdList := apiextensionsv1.CustomResourceDefinitionList{}
+	if err := ctx.Client().List(context.TODO(), &crdList); err != nil {
+		return ctx.Log().ErrorfNewErr("Failed listing CRDs: %v", err)
+	}
+	for i := range crdList.Items {
+		crd := &crdList.Items[i]
+		if !re.MatchString(crd.Name) || containsString(exclude, crd.Name) {
+			continue
+		}
+		if err := removeFinalizers(ctx, crd); err != nil {
+			return err
+		}
+		if err := DeleteObjects(ctx, crd); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// DeleteClusterRolesMatching deletes the ClusterRoles and ClusterRoleBindings whose name matches the regular
+// expression, except the ones in the exclude list
+func DeleteClusterRolesMatching(ctx spi.ComponentContext, nameRegexp string, exclude ...string) error {
+	re := regexp.MustCompile(nameRegexp)
+	crbList := rbacv1.ClusterRoleBindingList{}
+	if err := ctx.Client().List(context.TODO(), &crbList); err != nil {
+		return ctx.Log().ErrorfNewErr("Failed listing ClusterRoleBindings: %v", err)
+	}
+	for i := range crbList.Items {
+		if re.MatchString(crbList.Items[i].Name) && !containsString(exclude, crbList.Items[i].Name) {
+			if err := DeleteObjects(ctx, &crbList.Items[i]); err != nil {
+				return err
+			}
+		}
+	}
+	crList := rbacv1.ClusterRoleList{}
+	if err := ctx.Client().List(context.TODO(), &crList); err != nil {
+		return ctx.Log().ErrorfNewErr("Failed listing ClusterRoles: %v", err)
+	}
+	for i := range crList.Items {
+		if re.MatchString(crList.Items[i].Name) && !containsString(exclude, crList.Items[i].Name) {
+			if err := DeleteObjects(ctx, &crList.Items[i]); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// DeleteAllResources deletes the custom resources of the kind in all namespaces.  When removeFinalizers is true
+// the finalizers of the resources are removed first, for resources whose controller is already uninstalled.
+// Nothing is done if the kind is not installed in the cluster.
+func DeleteAllResources(ctx spi.ComponentContext, gvk schema.GroupVersionKind, removeFinalizersFirst bool) error {
+	list := unstructured.UnstructuredList{}
+	list.SetGroupVersionKind(gvk.GroupVersion().WithKind(gvk.Kind + "List"))
+	err := ctx.Client().List(context.TODO(), &list)
+	if meta.IsNoMatchError(err) || errors.IsNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return ctx.Log().ErrorfNewErr("Failed listing %s resources: %v", gvk.Kind, err)
+	}
+	for i := range list.Items {
+		if removeFinalizersFirst {
+			if err := removeFinalizers(ctx, &list.Items[i]); err != nil {
+				return err
+			}
+		}
+		if err := DeleteObjects(ctx, &list.Items[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// removeFinalizers removes the metadata finalizers of the object
+func removeFinalizers(ctx spi.ComponentContext, obj clipkg.Object) error {
+	if len(obj.GetFinalizers()) == 0 {
+		return nil
+	}
+	patch := clipkg.MergeFrom(obj.DeepCopyObject().(clipkg.Object))
+	obj.SetFinalizers(nil)
+	if err := ctx.Client().Patch(context.TODO(), obj, patch); clipkg.IgnoreNotFound(err) != nil {
+		return ctx.Log().ErrorfNewErr("Failed removing the finalizers of %T %s: %v", obj, clipkg.ObjectKeyFromObject(obj), err)
+	}
+	return nil
+}
+
+// containsString returns true if the slice contains the string
+func containsString(slice []string, s string) bool {
+	for _, item := range slice {
+		if item == s {
+			return true
+		}
+	}
+	return false
+}
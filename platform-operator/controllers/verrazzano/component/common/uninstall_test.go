@@ -0,0 +1,100 @@
+// Copyright (c) 2022, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package common
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	vzapi "github.com/verrazzano/verrazzano/platform-operator/apis/verrazzano/v1alpha1"
+	"github.com/verrazzano/verrazzano/platform-operator/controllers/verrazzano/component/spi"
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	k8scheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+// TestDeleteNamespace tests the DeleteNamespace function
+// GIVEN a namespace with finalizers
+//  WHEN DeleteNamespace is called
+//  THEN the namespace is deleted, and a namespace that does not exist is ignored
+func TestDeleteNamespace(t *testing.T) {
+	ns := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "ingress-nginx", Finalizers: []string{"controller.cattle.io/namespace-auth"}}}
+	c := fake.NewClientBuilder().WithScheme(k8scheme.Scheme).WithObjects(ns).Build()
+	ctx := spi.NewFakeContext(c, &vzapi.Verrazzano{}, false)
+
+	assert.NoError(t, DeleteNamespace(ctx, "ingress-nginx"))
+	err := c.Get(context.TODO(), types.NamespacedName{Name: "ingress-nginx"}, &corev1.Namespace{})
+	assert.True(t, errors.IsNotFound(err))
+
+	assert.NoError(t, DeleteNamespace(ctx, "missing"))
+}
+
+// TestDeleteCRDsMatching tests the DeleteCRDsMatching function
+// GIVEN CRDs of several groups
+//  WHEN DeleteCRDsMatching is called
+//  THEN only the matching CRDs that are not excluded are deleted
+func TestDeleteCRDsMatching(t *testing.T) {
+	scheme := runtime.NewScheme()
+	_ = apiextensionsv1.AddToScheme(scheme)
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+		&apiextensionsv1.CustomResourceDefinition{ObjectMeta: metav1.ObjectMeta{Name: "certificates.cert-manager.io"}},
+		&apiextensionsv1.CustomResourceDefinition{ObjectMeta: metav1.ObjectMeta{Name: "issuers.cert-manager.io", Finalizers: []string{"test"}}},
+		&apiextensionsv1.CustomResourceDefinition{ObjectMeta: metav1.ObjectMeta{Name: "orders.acme.cert-manager.io"}},
+		&apiextensionsv1.CustomResourceDefinition{ObjectMeta: metav1.ObjectMeta{Name: "domains.weblogic.oracle"}},
+	).Build()
+	ctx := spi.NewFakeContext(c, &vzapi.Verrazzano{}, false)
+
+	assert.NoError(t, DeleteCRDsMatching(ctx, `\.cert-manager\.io$`, "orders.acme.cert-manager.io"))
+
+	crds := apiextensionsv1.CustomResourceDefinitionList{}
+	assert.NoError(t, c.List(context.TODO(), &crds))
+	var names []string
+	for _, crd := range crds.Items {
+		names = append(names, crd.Name)
+	}
+	assert.ElementsMatch(t, []string{"orders.acme.cert-manager.io", "domains.weblogic.oracle"}, names)
+}
+
+// TestDeleteClusterRolesMatching tests the DeleteClusterRolesMatching function
+// GIVEN ClusterRoles and ClusterRoleBindings
+//  WHEN DeleteClusterRolesMatching is called
+//  THEN only the matching ones that are not excluded are deleted
+func TestDeleteClusterRolesMatching(t *testing.T) {
+	c := fake.NewClientBuilder().WithScheme(k8scheme.Scheme).WithObjects(
+		&rbacv1.ClusterRole{ObjectMeta: metav1.ObjectMeta{Name: "verrazzano-admin"}},
+		&rbacv1.ClusterRole{ObjectMeta: metav1.ObjectMeta{Name: "verrazzano-managed-cluster"}},
+		&rbacv1.ClusterRole{ObjectMeta: metav1.ObjectMeta{Name: "cluster-admin"}},
+		&rbacv1.ClusterRoleBinding{ObjectMeta: metav1.ObjectMeta{Name: "verrazzano-admin"}},
+	).Build()
+	ctx := spi.NewFakeContext(c, &vzapi.Verrazzano{}, false)
+
+	assert.NoError(t, DeleteClusterRolesMatching(ctx, "verrazzano", "verrazzano-managed-cluster"))
+
+	roles := rbacv1.ClusterRoleList{}
+	assert.NoError(t, c.List(context.TODO(), &roles))
+	assert.Len(t, roles.Items, 2)
+	bindings := rbacv1.ClusterRoleBindingList{}
+	assert.NoError(t, c.List(context.TODO(), &bindings))
+	assert.Len(t, bindings.Items, 0)
+}
+
+// TestIsVerrazzanoBeingDeleted tests the IsVerrazzanoBeingDeleted function
+// GIVEN a Verrazzano resource
+//  WHEN IsVerrazzanoBeingDeleted is called
+//  THEN true is returned only when the resource has a deletion timestamp
+func TestIsVerrazzanoBeingDeleted(t *testing.T) {
+	c := fake.NewClientBuilder().WithScheme(k8scheme.Scheme).Build()
+	assert.False(t, IsVerrazzanoBeingDeleted(spi.NewFakeContext(c, &vzapi.Verrazzano{}, false)))
+
+	now := metav1.Now()
+	vz := &vzapi.Verrazzano{ObjectMeta: metav1.ObjectMeta{DeletionTimestamp: &now}}
+	assert.True(t, IsVerrazzanoBeingDeleted(spi.NewFakeContext(c, vz, false)))
+}
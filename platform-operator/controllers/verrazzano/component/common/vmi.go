@@ -36,6 +36,10 @@ const (
 	vmoComponentName      = "verrazzano-monitoring-operator"
 	vmoComponentNamespace = constants.VerrazzanoSystemNamespace
 	defaultStorageSize    = "50Gi"
+
+	// keys of the credentials secret of the OpenSearch snapshot repository
+	snapshotAccessKey = "accessKey"
+	snapshotSecretKey = "secretKey"
 )
 
 // ResourceRequestValues defines the storage information that will be passed to VMI instance
@@ -107,6 +111,36 @@ func CreateOrUpdateVMI(ctx spi.ComponentContext, updateFunc VMIMutateFunc) error
 	return nil
 }
 
+// VMIRemoveFunc is the function used to remove a component from the VMI
+type VMIRemoveFunc func(vmi *vmov1.VerrazzanoMonitoringInstance)
+
+// RemoveFromVMI removes a component from the VMI resource, the VMO then deletes the resources of the component.  The
+// VMI is deleted once none of Grafana, OpenSearch and OpenSearch Dashboards is left in it.
+func RemoveFromVMI(ctx spi.ComponentContext, removeFunc VMIRemoveFunc) error {
+	if ctx.IsDryRun() {
+		ctx.Log().Debugf("RemoveFromVMI() dry run for %s", ctx.GetComponent())
+		return nil
+	}
+	vmi := NewVMI()
+	if err := ctx.Client().Get(context.TODO(), client.ObjectKeyFromObject(vmi), vmi); err != nil {
+		if errors.IsNotFound(err) {
+			return nil
+		}
+		return ctx.Log().ErrorfNewErr("Failed to get VMI: %v", err)
+	}
+	removeFunc(vmi)
+	if !vmi.Spec.Grafana.Enabled && !vmi.Spec.Elasticsearch.Enabled && !vmi.Spec.Kibana.Enabled {
+		if err := ctx.Client().Delete(context.TODO(), vmi); err != nil && !errors.IsNotFound(err) {
+			return ctx.Log().ErrorfNewErr("Failed to delete VMI: %v", err)
+		}
+		return nil
+	}
+	if err := ctx.Client().Update(context.TODO(), vmi); err != nil {
+		return ctx.Log().ErrorfNewErr("Failed to update VMI: %v", err)
+	}
+	return nil
+}
+
 // EnsureVMISecret creates or updates the VMI secret
 func EnsureVMISecret(cli client.Client) error {
 	secret := &corev1.Secret{
@@ -157,8 +191,21 @@ func EnsureGrafanaAdminSecret(cli client.Client) error {
 	return nil
 }
 
-// EnsureBackupSecret creates or updates the VMI backup secret
-func EnsureBackupSecret(cli client.Client) error {
+// EnsureBackupSecret creates or updates the VMI backup secret.  OpenSearch loads the keys of the secret into its
+// keystore as the credentials of the S3 snapshot repositories, so they are copied from the credentials secret of the
+// snapshot repository of the CR when there is one.
+func EnsureBackupSecret(cli client.Client, cr *vzapi.Verrazzano) error {
+	var credentials *corev1.Secret
+	if cr != nil && cr.Spec.Components.Elasticsearch != nil && cr.Spec.Components.Elasticsearch.Snapshot != nil {
+		name := cr.Spec.Components.Elasticsearch.Snapshot.Repository.CredentialsSecret
+		credentials = &corev1.Secret{}
+		if err := cli.Get(context.TODO(), types.NamespacedName{Namespace: constants.VerrazzanoInstallNamespace, Name: name}, credentials); err != nil {
+			if errors.IsNotFound(err) {
+				return ctrlerrors.RetryableError{Source: "opensearch", Cause: fmt.Errorf("Waiting for the snapshot credentials secret %s/%s to exist", constants.VerrazzanoInstallNamespace, name)}
+			}
+			return err
+		}
+	}
 	secret := &corev1.Secret{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      constants.VMIBackupSecretName,
@@ -167,6 +214,14 @@ func EnsureBackupSecret(cli client.Client) error {
 		Data: map[string][]byte{},
 	}
 	if _, err := controllerruntime.CreateOrUpdate(context.TODO(), cli, secret, func() error {
+		if secret.Data == nil {
+			secret.Data = map[string][]byte{}
+		}
+		if credentials != nil {
+			secret.Data[constants.ObjectStoreAccessKey] = credentials.Data[snapshotAccessKey]
+			secret.Data[constants.ObjectStoreAccessSecretKey] = credentials.Data[snapshotSecretKey]
+			return nil
+		}
 		// Populating dummy keys for access and secret key so that they are never empty
 		if secret.Data[constants.ObjectStoreAccessKey] == nil || secret.Data[constants.ObjectStoreAccessSecretKey] == nil {
 			key, err := password.GeneratePassword(32)
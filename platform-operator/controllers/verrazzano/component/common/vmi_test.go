@@ -8,11 +8,14 @@ import (
 	"testing"
 
 	vmov1 "github.com/verrazzano/verrazzano-monitoring-operator/pkg/apis/vmcontroller/v1"
+	ctrlerrors "github.com/verrazzano/verrazzano/pkg/controller/errors"
 	vzapi "github.com/verrazzano/verrazzano/platform-operator/apis/verrazzano/v1alpha1"
+	"github.com/verrazzano/verrazzano/platform-operator/constants"
 	"github.com/verrazzano/verrazzano/platform-operator/controllers/verrazzano/component/spi"
 
 	"github.com/stretchr/testify/assert"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -204,6 +207,39 @@ func TestReassociateResources(t *testing.T) {
 	assert.NotContains(t, service.Annotations["helm.sh/resource-policy"], "keep")
 }
 
+// TestRemoveFromVMI tests the RemoveFromVMI function
+// GIVEN a VMI with Grafana and OpenSearch enabled
+//  WHEN I call RemoveFromVMI for Grafana and then for OpenSearch
+//  THEN only Grafana is removed from the VMI first, and the VMI is deleted once OpenSearch is removed too
+func TestRemoveFromVMI(t *testing.T) {
+	scheme := runtime.NewScheme()
+	_ = vmov1.AddToScheme(scheme)
+	vmi := NewVMI()
+	vmi.Spec.Grafana.Enabled = true
+	vmi.Spec.Elasticsearch.Enabled = true
+	fakeClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(vmi).Build()
+	ctx := spi.NewFakeContext(fakeClient, &vzapi.Verrazzano{}, false)
+
+	assert.NoError(t, RemoveFromVMI(ctx, func(vmi *vmov1.VerrazzanoMonitoringInstance) {
+		vmi.Spec.Grafana = vmov1.Grafana{}
+	}))
+	actual := &vmov1.VerrazzanoMonitoringInstance{}
+	assert.NoError(t, fakeClient.Get(context.TODO(), types.NamespacedName{Namespace: vmi.Namespace, Name: vmi.Name}, actual))
+	assert.False(t, actual.Spec.Grafana.Enabled)
+	assert.True(t, actual.Spec.Elasticsearch.Enabled)
+
+	assert.NoError(t, RemoveFromVMI(ctx, func(vmi *vmov1.VerrazzanoMonitoringInstance) {
+		vmi.Spec.Elasticsearch = vmov1.Elasticsearch{}
+	}))
+	err := fakeClient.Get(context.TODO(), types.NamespacedName{Namespace: vmi.Namespace, Name: vmi.Name}, actual)
+	assert.True(t, errors.IsNotFound(err))
+
+	// The VMI no longer exists
+	assert.NoError(t, RemoveFromVMI(ctx, func(vmi *vmov1.VerrazzanoMonitoringInstance) {
+		vmi.Spec.Kibana = vmov1.Kibana{}
+	}))
+}
+
 // TestExportVmoHelmChart tests the VMO exportVMOHelmChart function
 // GIVEN a VMO component
 //  WHEN I call exportVMOHelmChart with a VMO service resource
@@ -231,6 +267,43 @@ func TestExportVmoHelmChart(t *testing.T) {
 	assert.Contains(t, service.Annotations["helm.sh/resource-policy"], "keep")
 }
 
+// TestEnsureBackupSecret tests the EnsureBackupSecret function
+// GIVEN a CR with and without an OpenSearch snapshot repository
+//  WHEN I call EnsureBackupSecret
+//  THEN the backup secret has placeholder keys, which are replaced by the keys of the snapshot credentials secret
+//   once it exists
+func TestEnsureBackupSecret(t *testing.T) {
+	asserts := assert.New(t)
+	fakeClient := fake.NewClientBuilder().WithScheme(scheme2.Scheme).Build()
+	backupSecret := &corev1.Secret{}
+	backupSecretName := types.NamespacedName{Namespace: constants.VerrazzanoSystemNamespace, Name: constants.VMIBackupSecretName}
+
+	asserts.NoError(EnsureBackupSecret(fakeClient, &vzapi.Verrazzano{}))
+	asserts.NoError(fakeClient.Get(context.TODO(), backupSecretName, backupSecret))
+	placeholder := backupSecret.Data[constants.ObjectStoreAccessKey]
+	asserts.Len(placeholder, 32)
+	asserts.NoError(EnsureBackupSecret(fakeClient, nil))
+	asserts.NoError(fakeClient.Get(context.TODO(), backupSecretName, backupSecret))
+	asserts.Equal(placeholder, backupSecret.Data[constants.ObjectStoreAccessKey])
+
+	vz := &vzapi.Verrazzano{Spec: vzapi.VerrazzanoSpec{Components: vzapi.ComponentSpec{
+		Elasticsearch: &vzapi.ElasticsearchComponent{Snapshot: &vzapi.OpenSearchSnapshot{
+			Repository: vzapi.OpenSearchSnapshotRepository{CredentialsSecret: "minio-credentials"},
+		}},
+	}}}
+	err := EnsureBackupSecret(fakeClient, vz)
+	asserts.IsType(ctrlerrors.RetryableError{}, err)
+
+	asserts.NoError(fakeClient.Create(context.TODO(), &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Namespace: constants.VerrazzanoInstallNamespace, Name: "minio-credentials"},
+		Data:       map[string][]byte{"accessKey": []byte("minio"), "secretKey": []byte("minio123")},
+	}))
+	asserts.NoError(EnsureBackupSecret(fakeClient, vz))
+	asserts.NoError(fakeClient.Get(context.TODO(), backupSecretName, backupSecret))
+	asserts.Equal("minio", string(backupSecret.Data[constants.ObjectStoreAccessKey]))
+	asserts.Equal("minio123", string(backupSecret.Data[constants.ObjectStoreAccessSecretKey]))
+}
+
 func TestIsMultiNodeCluster(t *testing.T) {
 	mkVZ := func(enabled bool) *vzapi.Verrazzano {
 		return &vzapi.Verrazzano{
@@ -73,7 +73,7 @@ func TestValidateUpdate(t *testing.T) {
 			"allow update when going from enabled -> disabled",
 			&testVZConsoleEnabled,
 			&testVZConsoleDisabled,
-			true,
+			false,
 		},
 	}
 
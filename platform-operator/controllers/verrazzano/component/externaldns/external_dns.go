@@ -10,10 +10,12 @@ import (
 	"github.com/verrazzano/verrazzano/pkg/helm"
 	vzapi "github.com/verrazzano/verrazzano/platform-operator/apis/verrazzano/v1alpha1"
 	"github.com/verrazzano/verrazzano/platform-operator/constants"
+	"github.com/verrazzano/verrazzano/platform-operator/controllers/verrazzano/component/common"
 	"github.com/verrazzano/verrazzano/platform-operator/controllers/verrazzano/component/spi"
 	"github.com/verrazzano/verrazzano/platform-operator/internal/k8s/status"
 	"hash/fnv"
 	v1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -178,3 +180,13 @@ func GetOverrides(effectiveCR *vzapi.Verrazzano) []vzapi.Overrides {
 	}
 	return []vzapi.Overrides{}
 }
+
+// postUninstall deletes the external-dns ClusterRole and ClusterRoleBinding
+func postUninstall(compContext spi.ComponentContext, _ string, _ string) error {
+	if compContext.IsDryRun() {
+		return nil
+	}
+	return common.DeleteObjects(compContext,
+		&rbacv1.ClusterRole{ObjectMeta: metav1.ObjectMeta{Name: ComponentName}},
+		&rbacv1.ClusterRoleBinding{ObjectMeta: metav1.ObjectMeta{Name: ComponentName}})
+}
@@ -40,6 +40,7 @@ func NewComponent() spi.Component {
 			AppendOverridesFunc:     AppendOverrides,
 			MinVerrazzanoVersion:    constants.VerrazzanoVersion1_0_0,
 			Dependencies:            []string{},
+			PostUninstallFunc:       postUninstall,
 			GetInstallOverridesFunc: GetOverrides,
 		},
 	}
@@ -4,10 +4,10 @@
 package externaldns
 
 import (
+	"encoding/json"
 	"github.com/verrazzano/verrazzano/pkg/helm"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
-	"os/exec"
 	"strings"
 	"testing"
 
@@ -16,6 +16,7 @@ import (
 	vzapi "github.com/verrazzano/verrazzano/platform-operator/apis/verrazzano/v1alpha1"
 	"github.com/verrazzano/verrazzano/platform-operator/constants"
 	"github.com/verrazzano/verrazzano/platform-operator/controllers/verrazzano/component/spi"
+	"helm.sh/helm/v3/pkg/release"
 	appsv1 "k8s.io/api/apps/v1"
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -77,18 +78,6 @@ func init() {
 	_ = vzapi.AddToScheme(testScheme)
 }
 
-// genericTestRunner is used to run generic OS commands with expected results
-type genericTestRunner struct {
-	stdOut []byte
-	stdErr []byte
-	err    error
-}
-
-// Run genericTestRunner executor
-func (r genericTestRunner) Run(_ *exec.Cmd) (stdout []byte, stderr []byte, err error) {
-	return r.stdOut, r.stdErr, r.err
-}
-
 // TestIsExternalDNSEnabled tests the IsEnabled fn
 // GIVEN a call to IsEnabled
 // WHEN OCI DNS is enabled
@@ -137,12 +126,8 @@ func TestAppendExternalDNSOverrides(t *testing.T) {
 	localvz := vz.DeepCopy()
 	localvz.Spec.Components.DNS.OCI = oci
 
-	helm.SetCmdRunner(genericTestRunner{
-		stdOut: []byte(""),
-		stdErr: []byte{},
-		err:    nil,
-	})
-	defer helm.SetDefaultRunner()
+	helm.SetActionConfigFunction(helm.CreateActionConfig())
+	defer helm.SetDefaultActionConfigFunction()
 
 	helm.SetChartStatusFunction(func(releaseName string, namespace string) (string, error) {
 		return helm.ChartNotFound, nil
@@ -247,12 +232,11 @@ func TestOwnerIDTextPrefix_HelmValueExists(t *testing.T) {
 }
 `)
 
-	helm.SetCmdRunner(genericTestRunner{
-		stdOut: jsonOut,
-		stdErr: []byte{},
-		err:    nil,
-	})
-	defer helm.SetDefaultRunner()
+	rel := release.Mock(&release.MockReleaseOptions{Name: ComponentName, Namespace: ComponentNamespace})
+	rel.Config = map[string]interface{}{}
+	assert.NoError(t, json.Unmarshal(jsonOut, &rel.Config))
+	helm.SetActionConfigFunction(helm.CreateActionConfig(rel))
+	defer helm.SetDefaultActionConfigFunction()
 
 	helm.SetChartStatusFunction(func(releaseName string, namespace string) (string, error) {
 		return helm.ChartStatusDeployed, nil
@@ -280,12 +264,8 @@ func TestOwnerIDTextPrefix_HelmValueExists(t *testing.T) {
 //  WHEN no stored helm values exist
 //  THEN the function returns the generated values and no error
 func Test_getOrBuildOwnerID_NoHelmValueExists(t *testing.T) {
-	helm.SetCmdRunner(genericTestRunner{
-		stdOut: []byte(""),
-		stdErr: []byte{},
-		err:    nil,
-	})
-	defer helm.SetDefaultRunner()
+	helm.SetActionConfigFunction(helm.CreateActionConfig())
+	defer helm.SetDefaultActionConfigFunction()
 
 	helm.SetChartStatusFunction(func(releaseName string, namespace string) (string, error) {
 		return helm.ChartNotFound, nil
@@ -5,7 +5,6 @@ package fluentd
 
 import (
 	"context"
-	"fmt"
 	vzapi "github.com/verrazzano/verrazzano/platform-operator/apis/verrazzano/v1alpha1"
 	vzconst "github.com/verrazzano/verrazzano/platform-operator/constants"
 	"github.com/verrazzano/verrazzano/platform-operator/controllers/verrazzano/component/helm"
@@ -79,24 +78,12 @@ func (f fluentdComponent) ValidateInstall(vz *vzapi.Verrazzano) error {
 
 // ValidateUpdate checks if the specified new Verrazzano CR is valid for this component to be updated
 func (f fluentdComponent) ValidateUpdate(old *vzapi.Verrazzano, new *vzapi.Verrazzano) error {
-	// Do not allow disabling active components
-	if err := f.checkEnabled(old, new); err != nil {
-		return err
-	}
 	if err := validateFluentd(new); err != nil {
 		return err
 	}
 	return f.HelmComponent.ValidateUpdate(old, new)
 }
 
-func (f fluentdComponent) checkEnabled(old *vzapi.Verrazzano, new *vzapi.Verrazzano) error {
-	// Do not allow disabling of any component post-install for now
-	if f.IsEnabled(old) && !f.IsEnabled(new) {
-		return fmt.Errorf("disabling component %s is not allowed", ComponentJSONName)
-	}
-	return nil
-}
-
 // PostInstall - post-install, clean up temp files
 func (f fluentdComponent) PostInstall(ctx spi.ComponentContext) error {
 	cleanTempFiles(ctx)
@@ -5,66 +5,87 @@ package grafana
 
 import (
 	"context"
+	"io/ioutil"
+	"path/filepath"
 	"strings"
 
 	globalconst "github.com/verrazzano/verrazzano/pkg/constants"
 	"github.com/verrazzano/verrazzano/platform-operator/controllers/verrazzano/component/spi"
+	"github.com/verrazzano/verrazzano/platform-operator/internal/config"
 	"github.com/verrazzano/verrazzano/platform-operator/internal/vzconfig"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	controllerruntime "sigs.k8s.io/controller-runtime"
 )
 
-var dashboardList = []string{
-	"manifests/dashboards/vmi_dashboard_provider.yml",
-	"manifests/dashboards/weblogic/weblogic_dashboard.json",
-	"manifests/dashboards/coherence/elastic-data-summary-dashboard.json",
-	"manifests/dashboards/coherence/persistence-summary-dashboard.json",
-	"manifests/dashboards/coherence/cache-details-dashboard.json",
-	"manifests/dashboards/coherence/members-summary-dashboard.json",
-	"manifests/dashboards/coherence/kubernetes-summary-dashboard.json",
-	"manifests/dashboards/coherence/coherence-dashboard-main.json",
-	"manifests/dashboards/coherence/caches-summary-dashboard.json",
-	"manifests/dashboards/coherence/service-details-dashboard.json",
-	"manifests/dashboards/coherence/proxy-servers-summary-dashboard.json",
-	"manifests/dashboards/coherence/federation-details-dashboard.json",
-	"manifests/dashboards/coherence/federation-summary-dashboard.json",
-	"manifests/dashboards/coherence/services-summary-dashboard.json",
-	"manifests/dashboards/coherence/http-servers-summary-dashboard.json",
-	"manifests/dashboards/coherence/proxy-server-detail-dashboard.json",
-	"manifests/dashboards/coherence/alerts-dashboard.json",
-	"manifests/dashboards/coherence/member-details-dashboard.json",
-	"manifests/dashboards/coherence/machines-summary-dashboard.json",
-	"manifests/dashboards/helidon/helidon_dashboard.json",
-	"manifests/dashboards/system/system_dashboard.json",
-	"manifests/dashboards/system/opensearch_dashboard.json",
-}
+const (
+	// dashboardProviderFile is the file provider of Grafana, the VMI mounts the system dashboards ConfigMap in the
+	// directory it loads dashboards from
+	dashboardProviderFile = "vmi_dashboard_provider.yml"
+
+	// dashboardKeyPrefix is the prefix of the keys of the system dashboards ConfigMap
+	dashboardKeyPrefix = "dashboards-"
+)
+
+// platformDashboardDirs are the directories of the platform dashboards
+var platformDashboardDirs = []string{"coherence", "helidon", "system", "weblogic"}
 
+// createGrafanaConfigMaps creates the system dashboards ConfigMap with the dashboard provider and the platform
+// dashboards, which Grafana loads from files.  The GrafanaDashboard controller only provisions the dashboards of
+// users.
 func createGrafanaConfigMaps(ctx spi.ComponentContext) error {
 	if !vzconfig.IsGrafanaEnabled(ctx.EffectiveCR()) {
 		return nil
 	}
 
-	// Create the ConfigMap for Grafana Dashboards
-	dashboards := systemDashboardsCM()
-	_, err := controllerruntime.CreateOrUpdate(context.TODO(), ctx.Client(), dashboards, func() error {
-		for _, dashboard := range dashboardList {
-			content, err := Asset(dashboard)
-			if err != nil {
-				return ctx.Log().ErrorfNewErr("failed to create grafana configmaps: %v", err)
-			}
-			dashboards.Data[dashboardName(dashboard)] = string(content)
+	dashboardsDir := config.GetDashboardsDir()
+	provider, err := ioutil.ReadFile(filepath.Join(dashboardsDir, dashboardProviderFile))
+	if err != nil {
+		return ctx.Log().ErrorfNewErr("Failed reading the Grafana dashboard provider: %v", err)
+	}
+	data := map[string]string{dashboardKeyPrefix + dashboardProviderFile: string(provider)}
+	for _, dir := range platformDashboardDirs {
+		dashboards, err := readDashboards(filepath.Join(dashboardsDir, dir))
+		if err != nil {
+			return ctx.Log().ErrorfNewErr("Failed reading the %s dashboards: %v", dir, err)
 		}
+		for file, content := range dashboards {
+			data[dashboardName(dir, file)] = content
+		}
+	}
+
+	dashboards := systemDashboardsCM()
+	_, err = controllerruntime.CreateOrUpdate(context.TODO(), ctx.Client(), dashboards, func() error {
+		dashboards.Data = data
 		return nil
 	})
 	return err
 }
 
-//dashboardName individual dashboards live in the configmap as files of the format:
-// 'dashboard-<component>-<dashboard>.json'
-func dashboardName(dashboard string) string {
-	dashboardNoManifest := strings.Replace(dashboard, "manifests/", "", -1)
-	return strings.Replace(dashboardNoManifest, "/", "-", -1)
+// dashboardName individual dashboards live in the configmap as files of the format:
+// 'dashboards-<component>-<dashboard>.json'
+func dashboardName(dir string, file string) string {
+	return dashboardKeyPrefix + dir + "-" + file
+}
+
+// readDashboards returns the JSON dashboards of the directory, keyed by their file names
+func readDashboards(dir string) (map[string]string, error) {
+	files, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	data := map[string]string{}
+	for _, file := range files {
+		if file.IsDir() || !strings.HasSuffix(file.Name(), ".json") {
+			continue
+		}
+		content, err := ioutil.ReadFile(filepath.Join(dir, file.Name()))
+		if err != nil {
+			return nil, err
+		}
+		data[file.Name()] = string(content)
+	}
+	return data, nil
 }
 
 func systemDashboardsCM() *corev1.ConfigMap {
@@ -4,19 +4,54 @@
 package grafana
 
 import (
+	"context"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	vzapi "github.com/verrazzano/verrazzano/platform-operator/apis/verrazzano/v1alpha1"
+	"github.com/verrazzano/verrazzano/platform-operator/controllers/verrazzano/component/spi"
+	"github.com/verrazzano/verrazzano/platform-operator/internal/config"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 )
 
-// TestDashboardNames verifies dashboard filenames in the configmap follow the expected format
-// GIVEN the list of dashboards
-//  WHEN I call dashboardName
-//  THEN the name is formatted correctly
-func TestDashboardNames(t *testing.T) {
-	for _, dashboard := range dashboardList {
-		name := dashboardName(dashboard)
-		assert.NotContainsf(t, name, "manifest/", "should not contain manifests directory")
-		assert.NotContainsf(t, name, "/", "forward slashes should be removed")
+// TestCreateGrafanaConfigMaps tests the createGrafanaConfigMaps function
+// GIVEN the platform dashboards and a system dashboards ConfigMap with a dashboard that was removed
+//  WHEN I call createGrafanaConfigMaps
+//  THEN the system dashboards ConfigMap has the dashboard provider and the platform dashboards, and the removed
+//   dashboard is gone
+func TestCreateGrafanaConfigMaps(t *testing.T) {
+	asserts := assert.New(t)
+	oldConfig := config.Get()
+	defer config.Set(oldConfig)
+	config.Set(config.OperatorConfig{
+		VerrazzanoRootDir: rootRelativePath,
+	})
+	c := fake.NewClientBuilder().WithScheme(testScheme).WithObjects(&corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "system-dashboards", Namespace: ComponentNamespace},
+		Data:       map[string]string{"dashboards-system-removed_dashboard.json": "{}"},
+	}).Build()
+
+	asserts.NoError(createGrafanaConfigMaps(spi.NewFakeContext(c, &vzapi.Verrazzano{}, false, profilesRelativePath)))
+
+	cm := &corev1.ConfigMap{}
+	asserts.NoError(c.Get(context.TODO(), types.NamespacedName{Name: "system-dashboards", Namespace: ComponentNamespace}, cm))
+	asserts.Contains(cm.Data["dashboards-vmi_dashboard_provider.yml"], "/etc/grafana/provisioning/dashboards")
+	asserts.Contains(cm.Data["dashboards-weblogic-weblogic_dashboard.json"], "WebLogic Server Dashboard")
+	asserts.NotContains(cm.Data, "dashboards-system-removed_dashboard.json")
+
+	expected := map[string]int{"coherence": 17, "helidon": 1, "system": 2, "weblogic": 1}
+	counts := map[string]int{}
+	for key := range cm.Data {
+		for dir := range expected {
+			if strings.HasPrefix(key, "dashboards-"+dir+"-") {
+				counts[dir]++
+			}
+		}
 	}
+	asserts.Equal(expected, counts)
+	asserts.Len(cm.Data, 22)
 }
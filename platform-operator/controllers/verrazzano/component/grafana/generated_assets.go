@@ -1,742 +0,0 @@
-// Copyright (C) 2022, Oracle and/or its affiliates.
-// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
-// Code generated by go-bindata. (@generated) DO NOT EDIT.
-
- //Package grafana generated by go-bindata.// sources:
-// manifests/dashboards/coherence/alerts-dashboard.json
-// manifests/dashboards/coherence/cache-details-dashboard.json
-// manifests/dashboards/coherence/caches-summary-dashboard.json
-// manifests/dashboards/coherence/coherence-dashboard-main.json
-// manifests/dashboards/coherence/elastic-data-summary-dashboard.json
-// manifests/dashboards/coherence/federation-details-dashboard.json
-// manifests/dashboards/coherence/federation-summary-dashboard.json
-// manifests/dashboards/coherence/http-servers-summary-dashboard.json
-// manifests/dashboards/coherence/kubernetes-summary-dashboard.json
-// manifests/dashboards/coherence/machines-summary-dashboard.json
-// manifests/dashboards/coherence/member-details-dashboard.json
-// manifests/dashboards/coherence/members-summary-dashboard.json
-// manifests/dashboards/coherence/persistence-summary-dashboard.json
-// manifests/dashboards/coherence/proxy-server-detail-dashboard.json
-// manifests/dashboards/coherence/proxy-servers-summary-dashboard.json
-// manifests/dashboards/coherence/service-details-dashboard.json
-// manifests/dashboards/coherence/services-summary-dashboard.json
-// manifests/dashboards/helidon/helidon_dashboard.json
-// manifests/dashboards/system/opensearch_dashboard.json
-// manifests/dashboards/system/system_dashboard.json
-// manifests/dashboards/vmi_dashboard_provider.yml
-// manifests/dashboards/weblogic/weblogic_dashboard.json
-package grafana
-
-import (
-	"bytes"
-	"compress/gzip"
-	"fmt"
-	"io"
-	"io/ioutil"
-	"os"
-	"path/filepath"
-	"strings"
-	"time"
-)
-
-func bindataRead(data []byte, name string) ([]byte, error) {
-	gz, err := gzip.NewReader(bytes.NewBuffer(data))
-	if err != nil {
-		return nil, fmt.Errorf("read %q: %v", name, err)
-	}
-
-	var buf bytes.Buffer
-	_, err = io.Copy(&buf, gz)
-	clErr := gz.Close()
-
-	if err != nil {
-		return nil, fmt.Errorf("read %q: %v", name, err)
-	}
-	if clErr != nil {
-		return nil, err
-	}
-
-	return buf.Bytes(), nil
-}
-
-type asset struct {
-	bytes []byte
-	info  os.FileInfo
-}
-
-type bindataFileInfo struct {
-	name    string
-	size    int64
-	mode    os.FileMode
-	modTime time.Time
-}
-
-// Name return file name
-func (fi bindataFileInfo) Name() string {
-	return fi.name
-}
-
-// Size return file size
-func (fi bindataFileInfo) Size() int64 {
-	return fi.size
-}
-
-// Mode return file mode
-func (fi bindataFileInfo) Mode() os.FileMode {
-	return fi.mode
-}
-
-// ModTime return file modify time
-func (fi bindataFileInfo) ModTime() time.Time {
-	return fi.modTime
-}
-
-// IsDir return file whether a directory
-func (fi bindataFileInfo) IsDir() bool {
-	return fi.mode&os.ModeDir != 0
-}
-
-// Sys return file is sys mode
-func (fi bindataFileInfo) Sys() interface{} {
-	return nil
-}
-
-var _manifestsDashboardsCoherenceAlertsDashboardJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x9d\x6d\x6f\xdb\x38\xf2\xc0\xdf\xf7\x53\x10\x04\xfe\x7f\x24\x87\x74\x63\x27\x4d\x1b\x04\xd9\x02\xcd\xb6\xdd\x5b\x60\x7b\x5b\xb4\xd9\x7d\x71\x6d\x20\xd0\xd2\x58\x22\x42\x91\x5a\x92\x72\xe2\xe6\x72\x9f\xfd\x40\xea\x89\x7a\x70\x9e\x9d\xda\x29\xfb\xa6\xf1\x50\x26\x87\xd4\x70\x66\x7e\x26\x29\x5d\x3c\x43\x08\x13\xce\x85\x26\x9a\x0a\xae\xf0\x01\x32\x22\x84\x30\xa3\x4a\xe3\x03\xf4\xc5\x7e\x42\xa5\xd4\x96\x4c\x72\xca\xf4\x6f\x1c\x1f\xa0\xf1\x56\x23\x8d\x88\x26\x4a\xe4\x32\x04\x7c\x80\xf0\x47\x29\x52\xd0\x09\xe4\x0a\x3b\xd7\x00\x27\x13\x66\xca\xb5\xcc\xc1\x91\x27\x34\x1a\x90\xd2\x50\xf0\x5f\x04\x13\xd2\x54\x28\xe3\x09\xd9\x18\x6d\xa1\x9d\xf1\x78\x0b\xed\xec\xed\x6d\xa1\xf1\xa6\x5b\x35\x27\xa9\x6d\xf8\x4d\xd3\x17\xf4\xff\xe8\x0d\x03\xa9\x5b\x2a\xe8\x79\x66\xaf\x8b\x88\x4a\x26\x82\xc8\x08\x97\x65\x97\xf6\xff\x93\x67\x08\x5d\x9a\xcb\x31\x44\x54\x77\xb4\xc5\x31\x07\xfd\x5b\x84\x0f\x10\xcf\x19\x2b\x24\x92\x64\xc9\xb1\x10\x4c\xd3\x0c\x1f\xa0\x91\x15\x52\x73\xc9\xce\xbe\xfd\x9b\x51\x7e\xaa\xea\x71\xac\x46\xd1\xf6\xad\xad\xc6\x56\x5d\xc4\x43\x96\x47\xf0\x17\x91\xe6\x7b\x53\xc2\x54\x3d\x28\xf8\x14\x20\x3b\xa6\x69\x77\xb0\xb0\x26\xb1\x6d\xe4\xa4\x91\x50\x6d\x75\xc7\x47\x24\x3c\x45\x5a\xa0\x0f\x84\x72\xf4\xb6\xdf\x5c\x35\x20\x46\xd1\x46\x9a\x4b\x66\x84\xdb\xd1\x76\x28\x92\xe7\x29\xa1\xbc\x18\x27\x33\x4a\xb6\x11\x9c\x11\x0e\x6c\xa0\x67\xa1\xe0\x1a\xb8\x31\x1d\x7c\x18\x02\xd7\x20\x5f\x1f\x26\xbb\xaf\x8f\x13\xaa\x50\xdd\x5b\x44\x15\xca\x72\x09\x6c\x8e\x72\x05\x91\xd1\x2f\x06\x0e\x92\x68\x30\x82\x69\xce\x10\xb1\xb7\x0e\x4d\x20\x24\xb9\x02\x24\xa6\x88\x20\x46\x53\x5a\xdc\x5c\x44\x39\xfa\x55\x92\x29\xe1\x04\xe9\x84\xe8\xc3\x89\xdc\x7e\xfd\x95\x47\x02\x14\xe2\x42\xa3\x30\x97\x12\xb8\x66\x73\x44\x18\x13\x67\x68\x2a\x24\xca\x15\xe5\x71\x55\xef\x59\x02\xbc\x94\x68\x48\x33\x66\x5a\x9e\x11\x49\xcd\x2d\x57\x3f\x7d\xe5\x65\x85\xc7\x09\x28\x40\x54\x43\xaa\x90\xe0\x48\xb7\x7b\x41\x24\xd8\xd6\x52\x20\x5c\x9b\x4e\x4c\xa0\xe8\x8f\x69\xae\xe8\x10\x43\xa9\xe0\x54\x0b\x49\x79\xfc\xd3\xe1\x76\xb2\xfb\xfa\x70\xbb\x1c\x96\x66\xb4\x63\x49\xa3\x8f\xa2\x99\x7c\xc5\xa4\xc0\x07\xe8\x85\x63\xb9\x67\xc6\xa8\x5c\xc1\x79\x65\x70\xe5\xe7\xb9\xf9\x5c\x59\x73\x63\x4e\x91\x5b\x4f\x63\x8f\x8d\xa9\xa4\xc2\xce\x3e\x9c\xe8\x94\xe1\xbe\x01\x7d\x7a\xf7\xe6\xed\x87\x77\x7d\x83\xd1\x70\xae\x4b\xa3\xd8\x6a\x9b\x80\x1d\xe2\x76\x67\x42\xc1\x23\x5a\x79\x98\x2f\xb5\xdc\xf5\x2b\x85\x87\x98\x11\x96\x13\x6d\xe7\x7c\xbb\xc8\x9a\x9c\x24\x69\xb7\x82\xe2\xdf\xa8\x23\x39\xd9\xea\x7e\xb9\x52\x3b\xd6\xb8\x55\x74\xd9\xbe\x12\x8b\xcc\x98\xe1\xb0\x02\x55\x1d\x84\x47\x57\x57\xf2\x77\x0e\x72\x7e\xcb\x2e\xe0\x37\xb8\xab\x34\x42\x78\x2f\x1d\x92\x72\x71\x86\xbb\x3d\xbe\x52\x21\x09\x51\x1e\xc2\x35\xa3\xba\x78\xd0\x18\x51\xd7\x0c\x5b\x75\x65\xd1\x73\xa7\xec\xb2\xfe\xfb\xc4\x8d\x04\xe7\x10\xe6\xc6\x1e\xde\x49\x29\xe4\x67\x4d\x74\x31\xb0\xc6\x72\x28\x8f\x5d\x8f\x3d\x2d\x02\xc0\x28\x6d\x09\x25\xfc\x9d\x03\x0f\xcd\x20\xe3\xf1\xa8\xe5\xe2\x13\xc2\x23\x66\xfb\x3a\x1e\x08\x10\x9f\xc0\x4c\x3f\xf4\x16\x32\x22\x75\x2e\x41\x15\x41\xa2\x15\x4b\xc4\x5b\xa2\x49\xad\x94\x38\x6d\x17\x6a\x3a\xa5\x61\x1d\x2e\xbf\x9c\xf4\xa6\x1c\x61\x94\x28\x1b\xb9\xec\x94\x6e\x0a\x26\x43\x3e\xdd\xb8\x93\xdf\x81\xc7\xda\x4c\xf8\xf1\xa8\x25\x87\x81\xcb\x21\xa4\x29\xb1\x9e\xb7\xb9\x76\x4a\x19\x73\xfb\xbb\xd8\xa3\xec\x77\x3c\xca\xfe\x35\x0e\xe5\xc5\xb0\x43\xd9\x69\x1c\x0a\xc4\xc0\xa3\x76\x43\x64\x16\x77\xf5\x36\x3e\xa0\xf0\xc9\xfd\x40\x9f\x92\xf3\x81\xcb\x53\xca\x07\xa4\x2a\x11\x67\xfd\x1a\xb4\xd0\x84\x0d\x5c\x6d\xbc\x89\x1d\x43\x73\x7d\xaf\x27\x8c\xf2\xba\xb0\x25\x3c\xa3\x51\x71\x37\xfa\x6e\xb3\xae\xdb\x9d\x47\x4e\x18\x3f\x40\xf8\x03\xa4\x13\x90\x0a\x7d\xce\xd3\x94\xc8\xf9\x40\xd0\x45\xfd\x38\xdf\xee\xd0\xe2\x50\x8f\x5a\xbe\xf9\x86\x4d\xf5\x93\x9e\x56\x71\x27\xd8\x17\x75\x3e\x57\x45\x9d\xdb\x9d\xcf\xcf\x7b\x99\x53\x33\xc7\x9b\x98\x62\x12\xa4\x8f\x82\x72\xfd\xa1\x0c\x2e\x46\xd0\xc4\x90\x0c\xa4\x99\x85\x24\x86\x9e\x7d\x67\xe6\x5b\x92\x44\x34\x37\xc3\xb2\xd7\x96\xf7\xa7\x83\x04\x1e\x81\xb4\xb3\x1d\x4f\x99\x68\xe6\x31\x56\x20\x29\xa8\x3f\x66\x20\x25\x8d\xa0\x13\xf3\x54\x46\x42\x18\x9a\x75\x4a\x93\xf0\xb4\xd7\x8a\xd2\x90\x65\x10\xfd\x4e\x79\x5f\x61\x4d\x64\x0c\xfa\x0a\xe3\x80\xf3\xcc\xaa\x47\x66\xf1\x46\x04\x4c\x93\x8d\x19\xf0\x48\xc8\x83\x50\x24\x20\x81\x87\x10\x84\x2c\x57\x1a\x64\x50\x8e\x75\x10\x55\xde\x29\x08\x45\xce\xf5\x97\xbd\xf4\x64\x73\xb3\x7d\xd3\xa6\x42\xa6\xc4\xa6\x59\x9a\xa6\x10\x14\xbd\xed\x5a\x98\x06\x39\xb3\x13\x03\x0f\x97\xbc\x27\x61\x11\xea\xc6\xad\xe2\x62\x4e\xbf\xaf\x5b\x28\x7d\xe6\x2f\x85\x96\x8e\xef\x6c\xd7\x2a\x61\x6a\xb3\x63\xfc\xe6\x2a\xd3\xd0\x89\x04\x95\x08\x16\x5d\x31\x64\xa1\x71\x9e\x95\xe9\x84\x92\x6a\x1a\x12\xd6\xe9\x7f\xe1\xf2\x7a\x73\x83\x15\xf7\xa8\x27\x17\x59\x19\xfd\x5b\x52\xeb\x20\x9c\xbc\x69\x50\x61\x9a\xc2\x7b\x29\x52\x27\xef\xaf\xe5\x9f\x20\xae\xe3\x40\xab\xe0\x73\x42\xa7\xba\xff\x8d\x2a\xa7\xea\x05\xa1\x0d\x13\x62\xd1\x1e\x4a\x29\xdf\x74\x72\xad\x9a\x2c\x2e\x5c\x17\x48\x24\x44\x7d\x27\xa8\x84\xcd\xba\x46\x5d\xff\x17\x54\xd3\x9f\xf2\x88\xce\x68\x94\x13\x86\x7b\xbe\xb0\x4e\x8f\x0c\xd0\x34\x0a\x9c\x93\x73\xda\x89\x22\x93\x3c\x3c\x2d\xec\xdd\xed\x9b\x93\x47\x9a\xee\x0f\xa0\x59\xe7\xea\x61\x47\x5e\x3b\xec\x81\xb0\x3a\x27\xe7\x70\x95\x0f\x1e\x08\x8c\xa8\x3d\x53\x54\x22\x64\xc7\x00\x18\x99\xc0\xc0\x04\x61\x22\x3e\x22\x0a\x7a\x33\xa3\x08\x55\xed\xae\xd4\xb1\x0a\x8f\xda\x95\x38\x5d\x6c\xcc\x6b\x6b\x58\xfb\x1b\x29\xd9\x6b\xf7\x4e\x6a\xf6\xc4\xa5\x9e\xd6\xad\x5d\x31\x0f\xe6\x7d\x5b\x20\x8c\xc6\x43\x51\xda\xca\x7f\x87\x59\xad\xf5\x33\xb7\xd2\x15\xe0\x85\x57\x1e\x18\x96\x0a\x0c\x26\x03\xbc\xb2\xfe\x25\xf2\xc2\x9d\xd1\xe0\xd8\x64\x91\xe8\x9f\x40\x32\xf4\x7f\xe8\x4f\x45\x62\x58\x33\x36\x78\x38\x0c\xd8\xbf\x19\x06\x38\x2a\xdd\x85\x03\x7a\x29\xff\xf2\x41\xc0\x75\x72\xab\x42\x02\x3e\x9d\x5f\xd7\x74\xbe\x97\xc8\xa7\x90\x0a\x39\x0f\x12\x20\x59\xf5\x77\x6e\x1c\x49\x60\x7f\x16\xdc\x46\x37\xff\x42\x4a\xce\x37\xd1\x64\xbe\x61\x3a\x4c\xbe\x7d\x23\x5c\x54\x94\xb0\x55\xfe\xbf\x89\xfe\x81\xc6\xa3\xd1\xf7\xc3\x82\x8a\x07\xfe\x45\x52\x38\x40\x17\x17\xa5\x5e\x97\x97\x4f\x06\x0b\x5e\x3d\x32\x17\xf4\x23\x90\x67\x81\x25\xb1\x40\xe9\xb3\x1e\x80\x06\x70\x6f\x12\x7a\x1c\x58\x33\x1c\xd8\xb9\x39\x0e\x30\x8f\x03\x8b\x54\x5a\xd1\xf5\x03\x97\x07\x76\x6f\xc3\x03\xef\x25\x00\xfa\x98\xcc\x95\x09\x33\xe8\x83\x8d\xce\x3f\x2c\x11\x8c\x5f\xde\x0c\x09\xf6\x3d\x11\x5c\x45\x04\x24\x4c\x4c\xcd\x8f\xb2\x38\x70\xc3\xb6\x6e\x87\x13\x65\xa5\x0d\x4f\x74\x04\x1e\x28\x1e\x14\x28\x84\x0a\xa6\x12\x20\xc8\x4a\x37\x54\x41\x82\xa2\xdf\x60\x88\x27\x84\x0a\xac\xc5\x0f\x7e\x61\x13\xa1\xc9\x1c\x0d\x21\x05\x2a\x6f\xe3\x77\x67\x8a\x21\x9f\x6b\xd8\xa2\xd4\x6f\x0d\xd9\x82\x2d\x60\x8b\x9d\x47\x66\x8b\xa1\x91\xf5\x74\x71\x4f\xba\x18\x2f\x9d\x2e\xfc\x5a\x43\x29\x5a\x5b\xb8\x78\xb2\x4b\x0d\xe3\x1f\x8a\x2d\x4c\xce\x15\x28\xfb\xc9\xa5\x8b\x87\xdd\x9d\xf4\x27\x9f\x10\x46\x78\x08\x11\xfa\x0c\x72\x46\xc3\xb5\xdb\x9f\xb4\xbc\xad\x48\xe3\x9d\x5e\x4f\x2c\x71\xbc\xbc\x17\x71\x3c\xed\xbd\x48\xb5\x11\x3d\x02\x6f\xdc\xb4\xad\x5b\xf1\x86\x2a\x2b\xad\x79\xa3\x2b\xf0\xbc\x71\x57\xde\xb0\x5b\x8b\x36\x06\xa9\xa3\x1c\xe4\x20\x23\x52\x17\xa1\x31\xc8\x6b\xcf\x74\x61\x6e\xe0\xcf\xff\xfd\x8a\xdf\x52\xa5\x25\x9d\xe4\x1a\xa2\x5f\x48\x98\xc0\x7f\xde\x43\x64\xf7\x70\x17\x1f\xbf\xe2\xcb\x45\x2b\x18\xc6\xdd\x6d\xa2\xd7\x68\xf4\x1d\x37\x35\xbd\xe3\x11\xe1\x31\x48\xc7\xd5\xae\x1d\x5b\xac\xc8\x76\xa6\x81\xa8\xe5\xc9\xe2\xf1\xf7\x30\x3d\x4c\xca\xbe\xe6\x64\xd1\xd6\xb3\x67\xfd\x1e\x2c\xd0\x1a\x80\xc5\x48\x79\xb2\xb8\x9e\x2c\x6e\xb5\x98\xe1\x84\xbb\x06\x2d\xc8\x5a\xa1\x45\x04\x2a\x94\x34\x33\xcd\xb7\xa2\xff\x12\xf7\x3d\x2d\x62\x8e\xf1\xfd\x96\x39\x3c\x74\x78\xe8\xf8\x91\xa1\xa3\x07\x1c\x35\x68\x04\x44\x29\x1a\xf3\x14\xb8\x0e\x12\x62\xbd\x62\xae\x82\x50\x44\x80\x0e\x7f\x6e\x9f\xf9\xf5\xbc\xb0\xae\xbc\x30\x14\x8b\x3c\x30\x78\x60\xf0\xc0\xe0\x81\xe1\x6e\xc0\xb0\x12\x2b\x11\xab\x72\xe8\xe1\xe5\x1d\xce\x43\xff\x9a\x13\x19\x51\xc2\xd1\x27\x08\xc5\xcc\xc6\xd1\x6b\x17\x1e\xb8\x08\x22\xa2\xc9\x6a\x21\xc2\x52\x4f\x47\xf7\x36\x41\x2d\xe4\x83\x5d\xcf\x07\xad\x38\xe5\x0f\x48\x23\xcf\x06\x77\x3f\x20\xcd\x45\x04\x41\x6c\x7c\x54\x20\x0b\x07\xb5\x52\x27\xa3\x07\xbc\xa7\x67\x83\x7b\x1d\x8d\x1e\x8a\x47\xfe\x90\xb4\xa3\xb0\x3f\x24\xed\x37\x2e\x79\x5c\xb8\xae\x0b\xf8\xc8\xe3\xc2\x52\x71\xe1\x18\x64\x4a\x79\xf9\xc0\x3d\x0f\x0c\xd7\xed\x61\xda\x59\xf0\x80\xb6\xf1\x0b\xcf\x0b\xad\x38\xe5\x0f\x4d\x78\x66\x78\x38\x66\xd0\xa5\x97\x5a\xad\xe7\x29\x0d\x3a\xd1\x05\xdc\x70\xe4\xb9\xe1\x76\xdc\xd0\x0a\x4c\x9e\x1c\x1c\x85\x3d\x39\x78\x72\x58\x32\x39\x8c\xbb\x79\xf4\xc2\x24\x78\xcd\x8e\x53\xaf\x06\x39\xac\xcc\x71\xea\x5b\x9d\x6d\xa8\x1e\x17\xf2\x59\x0b\x49\x62\x40\xd5\x6f\xb3\xd7\x51\xc3\x90\x16\x4f\x1c\x1b\xba\xdb\x90\x16\x62\xc3\xfd\x9e\xc3\xfa\xe4\x4f\x5b\xfb\x85\x06\x0f\x0d\xce\xf0\x5e\xb3\x09\xa9\x7a\x0a\xab\x3d\x2a\x7d\x67\x3a\xb8\x21\x03\xac\xdd\xfa\xc0\xa2\x73\xcc\xe3\xab\x14\x7e\xf8\x3c\x7f\x41\x14\xf1\x49\xbd\x4f\xea\x7d\x52\xef\x97\x03\xd6\xf7\x19\x49\x8f\xb4\x1c\xf0\x28\xc7\x98\xcb\x83\x80\x54\x70\x64\x5b\xf7\x4b\x03\x37\xd8\x4b\xb4\x30\xc9\x7f\x75\xaf\x24\xff\x89\xaf\x0d\xf8\x14\xdf\xa7\xf8\xce\xf0\xde\x60\x5d\x60\x5a\x3b\xa7\x20\x02\xa5\xcb\x1f\x89\xcb\xa3\x06\x2b\xb2\x34\xd0\x73\xa0\x6b\x87\x0b\x2b\xb2\x2c\xd0\x8f\x44\x7e\x29\x00\x79\x6a\xf0\xd4\x50\x55\xe0\xa9\xe1\xda\x2e\xac\xf2\x52\xc0\x5a\x6e\x22\xfa\x23\xd7\xb1\xa0\x3c\x46\x47\x73\x0d\xe8\x88\x84\xa7\x4c\xc4\x68\x1d\x19\x61\x89\x3f\xf9\xef\xf7\x7a\x52\xd0\xc0\x83\x3e\xee\x28\xa1\x11\xfc\x1b\xa4\x78\xf2\x9c\xf0\x51\x8a\xf3\xb9\x3d\x6c\xf8\x48\xb4\x70\xab\x06\x6f\xc5\x0c\x99\xa9\xb9\x7f\x46\xb9\x11\x7b\x80\x78\x00\x80\xe8\xaf\x0e\x08\xce\x21\xb4\xa8\x90\x12\x4e\x62\x90\x81\x28\xfd\x58\x30\x99\x6b\x08\x26\x85\x1f\xfb\x7e\xec\x70\x71\x61\x1c\xec\x1a\x3e\x22\x75\x45\x58\x61\x30\x2c\x79\x44\xf0\x88\xf0\xa4\x11\xa1\x7e\xa3\xb7\x84\xa9\xf1\x01\xd8\x79\xb2\x0c\x56\x61\x02\x29\xf9\x0b\xa4\x2a\x1e\xba\x52\x3c\xf7\x04\x2b\x3d\x67\x65\xc0\x92\xc5\x23\x62\xda\xaf\x20\xc7\xe5\x3b\xb5\x4d\xc6\xd8\x7b\xa3\x7c\xf3\x8e\x75\x5d\x04\xd1\xb2\x7c\x5a\xcc\x66\x93\x42\x3f\xdf\xad\x7f\x89\xc6\x5a\x94\x42\xdc\xfa\x5e\x46\xc3\x53\x27\x6d\xae\xb4\x0f\x2a\x9f\xe9\x1a\x27\xde\x73\xd6\x07\xdd\x1f\xb2\x5b\x8f\xd0\x71\x33\x7a\x77\x4d\x00\x8f\xdd\x0f\xbb\x23\xb7\xc4\x99\x9d\x3b\xce\xdf\xe3\x32\xdc\x9e\x54\x7d\x30\xbe\x5f\x64\x5d\xcc\x5a\xdc\x8a\x5b\xf1\x4b\xb7\x62\xb7\x95\x9d\x17\xee\x07\xe7\x9d\xee\xaf\x22\x57\xdf\x4a\x97\xd6\xf0\x7d\x13\xd6\x21\xe3\xf2\xe6\xd5\xab\xab\x55\xb8\x2b\x5f\x9d\xdf\x49\x58\x70\x6e\x73\x50\x6c\xd2\x10\xd2\xbc\x5b\x1f\xcf\x1a\x03\x79\x76\xf9\xec\x7f\x01\x00\x00\xff\xff\x66\xdd\x44\xe7\x55\x80\x00\x00")
-
-func manifestsDashboardsCoherenceAlertsDashboardJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_manifestsDashboardsCoherenceAlertsDashboardJson,
-		"manifests/dashboards/coherence/alerts-dashboard.json",
-	)
-}
-
-func manifestsDashboardsCoherenceAlertsDashboardJson() (*asset, error) {
-	bytes, err := manifestsDashboardsCoherenceAlertsDashboardJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "manifests/dashboards/coherence/alerts-dashboard.json", size: 32853, mode: os.FileMode(420), modTime: time.Unix(1647457998, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _manifestsDashboardsCoherenceCacheDetailsDashboardJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x5d\x7b\x73\xdb\x28\xbb\xff\x3f\x9f\x82\xd1\xf6\xbc\x6f\x7b\x8e\x93\x58\x4e\xdc\x5c\xa6\xdd\x33\x69\xb2\x6d\xf3\x4e\x93\xed\x6e\xb3\x9d\xe9\x6c\x3b\x1e\x2c\x61\x9b\x89\x04\x3e\x80\x52\x3b\x9d\xec\x67\x3f\x03\x48\x16\xba\xd8\xb1\x6b\x3b\x96\x6d\xfe\xc8\xc4\x02\x84\xe0\xf9\x3d\x37\xe0\x01\x7e\xec\x00\xe0\x40\x42\xa8\x80\x02\x53\xc2\x9d\x53\x20\x93\x00\x70\x02\xcc\x85\x73\x0a\xfe\x56\x4f\x20\x4e\x55\x39\xed\x08\x07\xe2\x92\x38\xa7\xc0\xad\xa5\xa9\x3e\x14\x90\xd3\x88\x79\xc8\x39\x05\xce\x47\x46\x43\x24\x7a\x28\xe2\x8e\x51\x06\x11\xd8\x0e\x64\xbe\x60\x11\x32\xd2\x7b\xd8\x2f\x49\xc5\x1e\x25\xe7\x34\xa0\x4c\x56\xc8\xba\x6d\xf8\xbc\x5e\x03\x0d\xd7\xad\x81\x46\xb3\x59\x03\xee\x0b\xb3\x6a\x02\x43\xf5\xe1\xb3\xb4\x2f\xe0\x5f\xe0\x2c\x40\x4c\x64\x9a\x20\x86\x7d\x55\xce\x87\xbc\xd7\xa6\x90\xf9\x4e\x9c\xf7\xa0\xfe\x7f\xdb\x01\xe0\x41\x16\x77\x7c\xc4\x3d\x86\xfb\xb2\x26\x59\x5e\xd5\xe1\x20\x1f\x8b\x5c\x17\x9c\x2e\x41\xe2\xd2\x77\x4e\x01\x89\x82\x40\xa7\x30\xd8\xef\xdd\x50\x1a\x08\xdc\x77\x4e\x41\x5d\x25\x62\x59\xa4\xd1\xd4\xbf\x05\x62\x30\xae\xd9\x6d\xbe\x3c\xac\x1f\x1c\x1f\x37\x8f\x1b\x47\x8a\x9e\x4e\x80\xc9\x2d\x1f\x91\x3e\x21\xbc\x22\x47\xb6\xe5\xb5\x51\x16\xf1\x82\xc8\x47\x9f\x21\xe3\x39\x32\x3a\xb7\x08\xf5\x6f\x70\x98\x27\xaf\x23\x60\x57\x7d\xe3\x5b\x9a\x82\x85\xea\x98\xf3\x06\x7a\xb7\x40\x50\x70\x0e\xbd\x1e\xe2\xe0\x53\x14\x86\x90\x0d\xc1\x45\xf1\xbb\x62\xd4\x47\xc7\x48\x8c\x29\x2c\xbb\x91\xa6\x46\x2c\x90\x89\xfb\xfe\xbe\x47\x7b\xbb\x9e\xaa\x7a\x97\xeb\xaa\xf7\xb3\x8f\xbb\x39\x6c\x1e\x6a\x2b\x26\xc4\x15\xc4\x64\xa1\xdd\x0f\x21\x26\xf2\x07\x62\x88\x78\x28\xed\xaf\xca\x28\xef\x34\xe4\x17\x8c\xf6\x7d\xfa\x9d\xe4\x3b\x90\x90\x03\x0d\x04\x62\x04\x06\x20\xfb\xe5\x39\x48\x62\x0a\x77\x4e\x5a\x4a\xa8\x75\x76\x07\x71\x20\x85\x23\x25\x15\x2f\x92\xc5\x4f\xf3\x76\x12\xb1\x53\x95\x39\x7d\x48\x50\x50\xc2\xf7\x1e\x25\x02\x11\xa9\x8b\x9c\x57\x1e\x22\x02\xb1\x5f\x5f\xf5\x0e\x7e\x55\xdc\x09\x2e\x90\x80\x38\xe0\x60\x17\x3c\xfb\x84\xd8\x1d\xf6\xd0\x35\x0c\x11\xd8\x07\xcf\x54\xbe\x7c\x78\xb5\xdf\x3b\xf8\xf5\xd5\x7e\xfc\x6a\xda\xa2\x2e\xc3\xfe\x47\x9a\x6a\x3c\xad\x89\xa4\x90\x1a\xea\xe2\xbb\x7c\x3e\x34\x12\x06\x89\x40\xc7\xcf\x43\xf9\x9c\xa8\x90\x94\xe8\x52\xd8\x0f\x8e\x47\xcf\x23\x91\x4e\xe9\x16\x52\xa5\xf3\x9c\x9e\x08\x03\xa7\x48\xcd\x22\xe5\x04\x1a\x88\x72\xde\x50\xe2\x23\x81\xa4\x91\x30\xf4\x50\x4c\xbd\x80\x32\xc9\xc8\x5d\x46\x23\x22\x9b\xd5\x81\x01\x47\xd9\xfc\xcf\x30\x88\x50\x79\x56\x8e\x11\x7e\x69\x9c\x9c\x78\x87\x2f\x4d\x8d\xaa\x34\x73\xe3\xe0\xa8\x06\xdc\xc6\x49\x0d\x1c\xd6\x6b\xa0\xbe\x77\x7c\x92\xd1\xce\xbf\xf8\x87\x87\xf0\x00\x16\xb9\x27\xa7\x65\x6f\x7a\x08\x70\x7c\x8f\x00\xed\x00\x12\x85\x6d\xc4\xe4\x2f\x44\x04\xc3\x88\x03\x4c\x80\xe8\x21\xa0\xba\xbb\x97\x12\xa8\x43\x59\x08\x15\x7f\x10\x4a\x90\x01\x30\x8c\xba\x28\x0b\x6f\x08\x07\x49\x5f\xdd\xba\x09\x63\x88\x49\x92\x61\x26\xf3\x1e\xfd\x9e\xa7\x8b\x84\xa4\xc7\x10\xef\xd1\xc0\xff\x00\xdb\x9a\x67\xc7\x96\xb8\x82\xec\x16\x8d\x44\xaf\xc0\x28\x63\x99\xb0\x99\x63\xc2\xc7\x78\xb0\x31\x86\x07\x5d\x43\x11\x08\xc4\xee\x60\x90\xe7\x90\x32\xde\x84\xfd\x3e\x26\xdd\x1b\xcd\x79\x6e\x59\x7a\x96\x2f\xd2\xc6\x1b\xa6\xf8\x4e\x12\x54\xea\x4f\xc5\xb9\x35\xb3\xc8\x5d\x02\xc2\x28\xf1\xa1\x36\xb9\x32\x06\x49\xf7\x91\xca\x1a\x69\x65\x05\x3e\x0b\xe1\xe0\x02\x0a\xf8\x91\x62\x22\x78\x16\x7d\x47\x52\x43\x65\x5c\xc5\x22\xe9\x51\x42\x90\x27\x90\xa1\xea\x65\x99\x1b\xf9\xe5\x1c\xf1\xfa\x94\x8b\x0e\x1e\x64\x25\x36\x4e\x7c\x4b\x89\xf8\x84\xef\x55\x95\xcd\xfa\x7f\x19\xf9\x0c\x15\xdf\x51\x69\x63\x5f\x51\xdd\xbf\x82\xfd\x09\x64\xef\x30\x1a\x2a\x21\x88\x82\x20\x4b\x20\xa1\x1b\xee\x5c\xef\x9f\xe5\x32\xe8\xe8\x85\x09\xb4\xe3\x7d\xc8\x6e\x03\x4c\x72\xb2\xd4\xc1\x41\x90\x75\xcf\x0e\xdc\x1a\x70\xdd\xe3\x1a\x70\x8f\x4f\xa4\x12\x70\x8f\x33\x4a\xa0\x23\xbf\x53\x14\x15\x59\xb3\x59\x8f\xae\xa6\x51\xaf\x01\xf7\xe4\x20\x53\x81\x29\x8d\x05\x76\x57\x7e\xd9\x39\x0d\xa2\x90\xe4\xf4\x27\x64\x5d\x24\x26\x10\x0e\x0d\xfa\xea\xdb\x3c\x0a\x9f\xdf\x21\xe2\x53\x76\x3a\xb2\xcd\x2d\xa5\x6c\x5a\x52\x27\xfd\xb8\x43\x8c\xc1\xfb\x7b\x48\x68\xcb\x0b\x22\x2e\x10\x7b\xfd\xcf\x57\xe7\xd9\xdd\x7d\xfc\xf4\xd5\xa9\x01\x33\xe3\x5c\xff\x96\x36\xe8\xab\x53\x4b\xab\xe4\xda\x50\xbd\xfe\xe7\xdf\xa6\xcd\xfa\x77\x4d\xb2\xba\x7e\x31\x31\x5d\x5f\x9d\x87\x17\x59\xc4\x52\x5d\x27\x70\xa8\xaa\xc2\x88\x67\x8b\x24\x72\xfe\x16\x7a\x42\x11\xd5\xcd\x64\x07\xa8\x8b\x88\xff\x76\x54\x4f\xf6\x65\x86\x3a\xca\x97\x75\xce\x26\x71\xc4\x48\xbb\xf1\x1c\xa9\x13\xeb\xa5\x8d\xb3\xe2\xe4\x82\x1d\xe3\x98\x74\x03\xc4\x05\x4c\xc5\x58\x8b\xb0\xc9\xfc\xc7\x26\xf3\xab\xdc\xc9\xcc\x4f\x95\x17\xf6\x7a\x4a\xbe\x4f\x34\xc6\xa3\xac\xaf\x0a\x5e\xc7\x2a\xc8\x8b\x18\x93\x8e\xc8\x86\xdb\xe0\x4b\xe2\x63\x0f\x0a\xc4\x41\x88\x42\xca\x86\x20\x12\x38\xc0\xf7\xc8\x07\xed\x61\x6a\x7f\x13\xa3\xbc\x07\xbe\x92\x6b\x2a\xd0\x29\xb8\xec\xa8\xdc\x88\x60\xb1\xeb\xc1\xc0\x8b\x02\x28\x28\x03\x98\x03\x42\x05\x78\x73\x79\x7d\xf6\xe7\x17\x40\x99\x2a\x14\x8b\x40\x92\x09\xc1\x05\xe6\x82\xe1\x76\x24\x90\xaf\x79\x27\x2e\x51\x93\xc5\xb5\xd9\xf7\x63\x57\x2f\x84\x43\xd0\x46\x00\x13\x8f\x32\x86\x3c\x51\xea\x09\xf8\xc8\x6b\x0f\x85\x21\x1a\x9b\xed\x0d\x1c\x4e\xe7\x0d\x34\x4e\xac\x37\x60\xbd\x01\xeb\x0d\xcc\xe9\x0d\x48\x15\xc7\x5b\x4a\xbf\xac\xc8\x29\x00\x6b\xec\x15\x5c\x29\xab\x62\xfd\x82\xca\xfb\x05\x5b\x6b\x4d\x8f\xa7\x1c\x5b\xd7\xad\x35\xb5\xd6\xd4\x5a\xd3\x9f\xb6\xa6\x5c\x50\x06\xbb\xa8\x15\x42\x02\xbb\x88\xb5\x30\xf1\xd1\xa0\x25\xa8\x80\x81\xb6\xb1\x4b\xb7\xae\x4a\x3b\x6f\xc8\x98\xfb\x52\x52\xcf\x5a\xd7\x9f\xb3\xae\x99\xf5\x8f\xa7\x35\xae\x5b\x34\x69\xed\x36\xa6\xb4\xac\x0d\x6b\x59\xad\x65\xb5\x96\x75\x61\x96\x95\x50\xd2\xa2\x7d\x81\x43\x7c\x8f\xfc\xd6\xff\x45\x88\x0d\x5b\x1e\x8d\x88\xb0\x16\x76\x9c\x85\x75\x6b\x6e\xbd\x5e\x2f\xb1\xb3\x37\xd2\x3f\x01\xd7\x94\xec\xfe\x9e\x50\x14\xfc\x11\x65\x9b\x6c\xcd\x6e\xe5\xcd\x6e\xb8\x2d\xa3\x59\xf7\xe5\x94\x46\xf7\xd0\x1a\x5d\x6b\x74\xad\xd1\x5d\xaa\xd1\xd5\x83\xdb\x10\x07\x01\xb6\xa3\xdb\xc5\xd9\xde\x21\x50\x71\x6c\xd6\xfc\x56\xdc\xfc\xfa\xc8\xc3\x21\x54\x76\x30\xd3\xea\x2d\x34\xcb\x8d\x69\x23\xb8\x9a\xd6\x2c\x5b\xb3\x6c\xcd\xf2\x34\x66\x39\x84\x83\xc7\xcd\x72\x08\x07\xb1\x31\xf6\x23\x1d\x85\x6f\xed\xf1\x23\xf6\xb8\x59\xaf\x8f\xb3\xc8\x57\x70\x10\x5b\xe0\x8b\x98\x9a\xdb\x62\x85\x61\x80\x21\x3f\x4f\x8c\xe6\x8f\x94\x8f\xdb\x3a\xd4\x3e\x6b\x77\x7d\xc8\x7b\x1f\x10\xe9\x8a\x9e\xd2\x5d\x99\x74\x54\x2c\x2e\x45\x33\xa3\xb8\xc7\xda\x99\x93\x9c\x9d\x79\x34\x5a\xfd\xa8\xdc\xce\x1c\xa6\xdf\xd2\x0c\x94\xfd\x14\xbc\xeb\x96\x48\x7e\x42\xa0\x62\x4e\x08\x07\x65\xa9\x98\x94\xa4\xc6\x5a\x21\xb7\xfd\x47\xb9\xca\x25\xa5\x15\x42\x7c\xac\x16\x91\xca\xa8\xb0\xd3\x41\x26\x7e\xc7\xbe\x26\xfe\x24\xbb\x59\xb0\x24\x19\x5d\xec\xf4\x11\xf3\x10\x11\xb0\x5b\x5c\x91\xef\xcb\xb7\x18\xf4\x71\xc4\x4d\xeb\xaf\xd3\x8b\x00\x33\x44\x7c\xc4\x90\x52\x5b\x9d\x80\x1a\x92\xa1\x25\xfe\x77\xa9\x8c\xb0\x8f\x72\xed\xe3\x7d\xe8\xa1\x32\x3e\xe2\x02\x7a\xb7\xf9\x6e\x73\x81\xfa\x7d\xe4\x7f\xd0\x9a\x3f\xdb\x80\x6a\x05\xc2\x08\x8c\xd8\xeb\xaf\x4e\x1b\x7a\xb7\x5f\x9d\x45\x47\xc2\x16\x95\xe0\x8c\x1a\xb4\x34\x70\x05\x8c\xd5\xa6\x63\x1c\xa1\x8a\xaf\x85\x82\x15\x99\xa7\xd2\x75\xcb\x2c\x71\xdf\x4c\x6d\xaa\x32\xdb\xb8\x42\xf4\x56\x7b\x54\x19\x87\x4f\xa6\xff\x89\xba\xf1\x6e\xc7\xdc\x0b\x9f\x7a\xb8\x53\x70\x11\x73\x01\x4c\x67\xc4\x07\x66\xa3\x41\x87\x32\x63\xbf\x91\x61\xff\x46\xfb\xc3\x7e\x98\xca\x0e\x32\xe4\x17\xd5\x1d\xa7\x4c\xe4\xb4\xb5\xd2\x74\xad\xc4\x8a\x62\xe2\xe3\x3b\xec\x47\x70\x64\xbf\x1e\x0a\x96\x56\xed\x3c\x4c\x1b\x30\x80\x03\x9c\x33\x19\xed\xc8\xbb\xd5\x62\x6f\x76\xd1\xd8\x8f\x24\xcc\x81\x74\xea\xbe\xe7\x4a\x97\xab\xec\x91\x6a\xfe\xfb\x5b\xa1\x89\x43\x38\x98\x34\xe4\x98\x10\x6c\xa4\xb9\x46\x0e\xd4\x0a\xed\x90\x19\xb4\xfb\x06\x72\x54\x60\x33\x6d\x80\x0a\xc5\xb5\x05\x72\xea\xd9\xda\x8d\xee\x3c\x2a\xc6\x69\x4b\x79\x4f\x62\xb6\xc4\x66\x16\x92\x4b\xdb\x59\x90\x88\x61\x11\x76\x18\xe0\x6e\x99\xe9\x55\xe9\x1f\xd0\xdd\xa8\xd1\x3b\x66\xa5\xb3\xba\x3c\x19\xe3\x33\xa3\xc7\xf3\xe8\xfe\xe1\xd2\x1d\xb9\xa0\xd4\x59\x92\x09\xef\xa4\x2d\xd6\xbe\x49\x7d\x09\x5e\x94\xfb\xb2\xc0\xe1\x3d\xec\xfb\x88\x7c\xd2\x1a\x31\xdf\x3d\xed\x63\x35\x36\xcb\xc7\xca\x76\x71\x51\x4e\x16\xed\x67\xb7\xa2\x8f\xd8\xe3\xc3\xa8\xaa\x42\x8b\xaa\xef\x98\x65\xbf\xb2\x3c\xcf\x2c\xef\x48\xa0\x3b\xec\xa9\x41\xee\xd3\xac\xf7\x8e\xf3\x22\xc0\x2e\x58\xa3\xe6\x02\xda\xe9\x70\x24\xc0\xb3\x56\x2b\xf1\x66\xe6\x88\xb9\x9e\xdb\xff\xfc\x2d\x26\x0a\x9f\x7b\x28\xff\x14\xfe\xd1\xa8\xb5\xd6\x31\xca\x34\x78\x5e\xc7\x68\xe9\xee\x86\xf5\x8a\xcc\xf4\xa9\xbd\x22\x4f\x4d\x59\xe6\x84\xe5\x71\x77\xa6\x63\x4c\x7e\xb9\x75\x73\xf6\x6b\xac\x97\x72\x34\xab\x97\xd2\x68\x16\xd8\x4d\x6f\x04\x3b\x9a\x64\x9f\xfb\xb0\x8b\xe2\x96\x65\xe4\x9c\x7b\x8c\x2a\x4f\x2b\x3b\xc9\xd0\xa3\xdf\xdf\x23\xe8\x2b\x93\x99\xcd\xd1\x92\x6b\x74\xc2\xa3\x41\xfe\xd8\x18\xc4\xbd\xb1\x7e\x06\x17\xc3\x60\x92\x6c\x28\x7f\x54\xad\x0b\x66\x65\x53\xd3\x1f\xa5\x0a\xf4\xcb\x97\x2f\x5f\x76\xaf\xae\x76\x2f\x2e\xc0\xfb\xf7\xa7\x61\x78\xca\x73\xaa\xb4\x0f\x85\x40\x8c\x94\xd7\x95\x28\x11\xed\xe0\x3d\x3e\xd0\x1f\x35\x2b\xb3\x01\x36\x21\x00\x65\xb1\xdb\x53\xe0\xe3\x92\x15\x38\x95\xae\x97\xdc\x0e\x9b\x35\xd0\x3c\xd4\x7f\xf5\xbd\x93\xec\xb8\x7c\xba\x85\xb9\xb4\x5c\xb3\x5e\x03\xee\x51\xa3\x06\x64\xad\xf5\xbd\x93\xa3\x17\x8e\x51\xea\xdb\x3c\xa4\x34\xd6\xf8\xea\x39\xa1\x2e\x5d\x93\xca\xd3\x5f\x2d\xe3\x81\x5f\xf2\x0b\x1d\xe5\x56\x2c\x83\x8f\x3e\x31\x22\xfb\x5e\x44\x70\x1a\x65\x3b\x3d\x72\x7a\xa1\xf9\x1d\x12\x7c\x9b\xf1\x2b\x2a\xe0\x99\x20\x7c\x53\x01\x08\x3f\x46\x16\xc2\x39\x20\x3c\x5f\x29\x84\xda\x9f\x7c\x8f\xb7\x1b\xc2\x79\xb4\xe8\x45\x05\xf0\xbb\xc2\x9c\xe7\x87\x46\xdb\x85\xe0\x9c\x42\xf8\xdb\x4a\x41\x7c\x8f\x05\xf8\xc8\x68\x1b\xb6\x71\x80\xc5\x70\x3c\x8e\x8e\x87\xf2\x71\x0b\x1b\x87\x64\x63\x0e\x18\xdf\x4e\x82\x31\xdb\xa3\xfa\xde\x51\x33\xdf\xcb\xfa\xde\x49\x7d\x7c\x97\xa6\x01\x3e\x9e\x24\x53\x8f\xd3\xe3\x7f\x85\x8a\x35\x6e\x97\xf8\x66\x41\x57\xc7\xeb\xe5\x47\xf8\x71\x7a\x7a\xe8\xa3\x73\xc1\x70\x10\x00\x9f\x7e\x27\x40\x50\x10\x2a\x22\x8e\xce\x30\x51\x13\x23\x3f\x5a\x2d\x29\x31\x0f\x4e\xa1\x9a\xbf\xb2\xa7\x05\xaa\x77\x77\xe3\x77\xff\xf7\x0e\xb2\x5d\x63\x9a\xeb\xb5\x39\xe7\xf5\x2f\x99\xa9\x01\xd3\x79\xe5\xdf\x98\x92\x6f\xc3\x12\xe4\xe7\x55\x3e\x7a\x76\x60\x7a\xee\xfb\x3c\x9a\x00\x04\x71\x3f\xb3\xf5\x26\x43\x78\x07\x46\x82\x6e\x00\x93\x8e\xe3\x3a\x03\x96\xe2\x9c\xe8\xec\x10\x71\xc1\x30\xe9\x2e\x06\xa2\x8f\x2c\x22\xdb\x6d\xdf\xe7\xf1\xd0\xde\x55\xc1\x43\x2b\x59\x7c\xdf\x2e\x04\xf3\xd3\x8f\xb3\x20\xf8\x7e\xb1\x08\x8e\xd6\xbf\xa7\x47\x71\x03\x90\x9b\x42\xef\xed\xef\xfd\xf7\xfe\x72\x8d\xd1\x4e\xae\x69\xeb\x73\x76\x20\x28\x0b\x99\xaa\x81\x4c\x58\xd5\xc3\x0b\xd0\x1e\x82\xe7\xc5\xa6\xd4\x62\xf7\x64\x7c\xa8\x8f\x3a\xf3\x3a\x93\x89\x09\x17\x50\xad\x4a\x17\x1c\xa1\xc5\x87\xa7\x8e\xe1\xff\xca\x45\xa8\x81\xf6\xb0\x8c\xbc\x15\xa4\xee\xfb\x85\x50\x57\x87\xa7\x49\x09\xb1\x0c\x3e\x33\x04\x6f\x16\x08\x41\x3f\xb2\x10\x94\x12\xf9\x7c\x21\x44\xee\x3d\x49\xfc\xe5\xfa\x90\x77\x4a\x0e\xbf\x58\x08\xf1\xfb\x6a\x78\x61\xc9\x3f\x33\xf9\xdf\x2d\x84\xfc\xa1\x9a\xbd\xb5\xe4\x9f\x99\xfc\xbf\x6d\x9b\xea\x01\xfb\xe0\xf9\x86\xf4\xe4\x7f\xca\x83\xd5\xd6\x50\x22\xc0\x84\xb8\xb5\x15\x08\xc5\xdb\x89\xa3\xad\xcc\xc5\x2b\x7a\x6a\x02\x12\x1f\x9c\x79\x1e\xe2\x1c\x9c\xd3\x88\x08\x3d\x77\xaa\xf3\x4a\x43\xcb\x18\x24\x5c\xf6\xb1\xd8\xc3\xf4\x26\x0d\x95\xbc\x03\xa6\x8d\xe5\x79\x8a\x48\x9d\x83\x71\xdb\x7f\x47\xa7\x77\xd8\x48\x1d\x00\x96\x16\xa9\x93\xc6\x7b\x80\x2b\xb5\x19\x74\x03\xa6\x54\x36\x24\xec\x23\x9c\x65\x26\x2c\x0d\xfa\xb0\x38\x6e\x4a\xec\x87\x45\xb2\x52\x21\x20\xb3\x88\xa3\x19\x00\x62\x61\xac\x58\x1c\xc8\x4c\x8a\xd5\x46\x01\x2c\x27\x0a\xc0\x86\x01\x00\x1b\x06\xb0\x41\x61\x00\x56\xcb\x57\x2a\x1a\x60\x26\x15\x3f\xfe\x28\x97\x02\x8a\xb3\xc6\xfa\x95\x50\x7e\x1e\x20\x8b\x6c\xb1\x74\x85\xbf\xc0\xdd\x0b\xd9\x0e\xb9\xf9\x1e\xba\xf5\x39\xe3\xfc\x66\x32\xec\x1b\x20\xa9\x9b\x1b\x3b\x90\x2e\xb0\x3e\xd5\x19\x55\x9b\x37\x0f\xbf\xe8\x75\x56\x8b\xc4\x53\x2c\xb7\x5a\x2a\x57\x61\xd5\xd5\xa2\x50\x85\xc5\x57\x8b\xc2\xaa\xd6\x60\xd3\xd3\x29\x56\x75\x66\xe4\xd8\xf3\x34\xd6\x08\x85\xc9\xc7\x53\x4c\x5e\xf4\xbb\xc1\x21\x5a\x93\x35\x3f\xf3\xda\xb8\xe9\xd6\xfc\x4e\x76\x72\xbc\x19\xaf\xf9\x1d\x8c\x9e\xed\x9a\x1f\x58\xda\x9a\xdf\x07\xcc\x05\x22\x88\x81\x3f\x51\x17\x73\xa1\xe5\x9a\x6f\xf6\xbc\xd2\x16\x6e\xe5\xb7\x33\xd7\x76\xe6\xda\xe4\x30\x3b\x73\xbd\xea\xc9\x98\xaa\xcd\x5c\x8f\x39\x5e\x6b\x2d\xc9\xff\xf3\x3b\xa0\x5e\xce\xa1\xe1\x17\x1c\xf2\x31\x2b\x80\xd7\x94\x80\xf1\x97\x71\x15\xc0\x8c\x4f\x03\x9f\x1e\xcf\x75\x9c\xbd\x9e\xc7\x60\x4f\x8c\xfc\xc8\x76\x68\xf1\xb3\xd7\xb3\x82\x3f\x03\xf0\x9b\x2f\xc5\x55\x0a\x14\x99\xcd\x4d\xd3\x27\x2a\x6f\xfb\x89\x59\xd5\x8a\x10\xf9\x89\xad\xa8\x23\x55\x3c\xe6\x6a\xa6\x02\xa6\x5b\xa1\x8c\x0f\xe6\xc0\x74\x86\x63\x43\x56\xbc\x94\x38\xd3\x02\xb2\x85\x7d\x32\xec\x13\x23\x01\x1e\x87\xdd\x2e\x21\x57\xf8\x40\xa0\xd5\x7a\xcb\xc9\x70\x47\x6f\xa8\xd8\x00\xf0\xc6\xb9\x3f\x4b\x3f\xaa\x61\x56\xca\x7f\xa0\xde\x2d\x07\x1f\x11\xf1\x0b\x63\xd7\xb5\x24\xfc\x4a\xdc\xd4\xcb\x2a\x60\xf8\x8e\x41\x62\x5e\x2f\x67\x31\x9c\x09\xc3\xff\xac\x55\x2c\x4e\xb2\x0a\x18\xc4\x0b\x06\x2d\x66\x2e\x18\xd8\x25\xc0\x27\x38\xcd\x23\x81\xa0\x1a\xd7\x03\x2c\x92\xf4\x3d\xec\x17\xae\x80\x78\x1a\x4c\xe6\x0d\x8c\x4a\x30\x29\xbb\xe7\x78\x63\xe0\x59\x3a\x0a\xf3\x86\x4c\x25\x28\x58\x04\x56\x15\x30\x95\x20\x50\x9d\x2b\xdb\x9e\x96\xfa\x45\xd2\x56\x2d\x78\xa7\x22\xf7\xb1\x57\x03\xae\xc5\x6d\xe0\x5f\x04\x28\x36\xb2\x6a\x95\x51\x86\xd5\xbc\x7d\x69\xad\x20\x98\xf7\x24\xb5\x3c\x04\x81\x1c\x5f\xb6\xfa\x7a\x8e\xc0\x22\x30\x05\x02\x97\x4b\x41\xa0\xab\x47\xf8\x16\x81\x29\x10\xf8\xcf\x54\x01\x9e\x3a\x80\xf3\x93\xa6\x36\xb8\x30\x42\x89\x2a\x1f\xda\x39\xf3\x71\x2e\x87\x63\x6e\xd9\x3e\x18\x5d\x34\x69\x43\x3b\x01\xb0\x17\x2f\x55\x70\x6e\xce\x5e\xbc\xb4\x7a\xfc\xaa\x75\x02\x8f\xbd\x78\x69\x7b\x0f\xdf\xd9\x66\x08\xd7\x37\x98\xca\x5e\xbc\x54\xc5\x70\x2a\x7b\xf1\x92\xbd\x78\xc9\x6e\x5c\xb1\x1b\x57\xec\xc6\x95\x2a\x72\xe9\x1a\x6e\x5c\xb1\x37\x2f\x55\xe9\xac\xa5\xd9\xac\xfb\x06\xa0\xb6\xb9\xe7\xee\x54\xe0\xce\x9e\x0e\xa3\x44\xac\xdb\x44\xf0\x62\x2e\xed\xa9\xcc\xb5\x32\x6b\x89\xc1\x86\xdd\x2b\x53\x2d\x0c\x36\xef\x62\x99\x6a\xd1\xf7\x49\xcf\x38\xaa\xc4\x45\x0e\x6b\x49\xff\x8d\xb9\xdb\x64\x6a\xea\x57\xff\x72\x93\xe9\xbb\x52\xfd\xdb\x4d\xa6\xef\xcb\x3a\x5e\x6f\xf2\x56\xf6\xee\x89\xee\x37\xd9\x89\x5b\x20\xdb\x28\x1d\x6f\x99\x7f\x50\xd7\x03\x2a\x87\x7b\x3d\x14\xc2\xcf\x88\x71\x4c\xa5\xbb\xee\x1e\xeb\x64\x31\xd4\x0d\xf5\x21\xbb\xd5\x25\x05\xec\xa6\x1e\xb5\xe3\x43\xde\x6b\x53\xc8\x7c\x67\x54\xbd\x40\x61\x3f\x80\x42\x0e\x6d\x93\x85\x67\x27\xc0\x5c\x18\x6e\xb8\xb1\x1c\x0d\x83\x40\x0d\xc2\x0a\xa3\x1d\xc7\x8b\x18\x43\x24\xbb\x7a\x2d\x9b\x84\x02\xe4\x09\xe4\x97\x46\x4a\x0b\x34\x50\xa0\x5c\xcb\x11\x58\x26\xe7\x2e\xfe\x88\x53\xaa\xaf\xe4\xb8\x13\x72\x1a\x31\x0f\xe9\xd1\x34\x0d\x91\xe8\xa1\xc8\x1c\x6e\x3a\x3e\xea\x60\x82\x85\x26\x90\x13\xc0\x36\x0a\x5a\xaa\x5a\x5e\xc2\x97\xa6\x92\x4e\x22\xbb\xcd\xd0\x00\x4c\xbc\x20\xf2\xd1\x59\x61\x31\x1f\xc4\x55\x3f\x3a\xf1\xe2\x84\x51\x20\x70\x91\x0c\x8e\x94\x27\x35\x47\x71\x5f\x9c\x9a\x70\x68\x5f\x9f\x6f\x91\x1d\x71\x39\x2a\xf0\x6e\xd6\x6e\xa5\x8c\xe4\x66\x52\xbb\x68\x90\x93\x0c\x87\xdf\xe2\xfe\x5f\x2c\xf8\x34\x24\x5e\x49\x8b\xe3\x28\x05\x93\x3e\x02\x76\x15\x5f\xf0\x3f\x92\x96\x39\xd9\xdc\x42\x17\x64\x5a\x79\xe1\x58\x18\x74\x1f\x8d\x8c\x88\xa3\x1b\x5d\x51\x69\xec\xc3\x4f\x73\xe9\x42\x19\x2b\xaf\x9a\x35\x0e\xd3\x8e\x4b\x1f\x46\xba\x79\x26\x86\xcc\x03\x34\xe2\xc8\xf3\xa4\x21\x3f\xc3\x90\x86\x65\x98\x83\x25\x97\x42\x10\xcb\xca\x4f\xcf\xca\xb1\x43\xd0\xd2\x06\x7c\x11\x81\xa6\x0f\xda\x99\x58\x0c\xab\xc7\x4e\x0a\xc8\xb3\xeb\x63\x5c\x6e\x38\x37\x8b\xe3\xf2\xa7\xa1\x95\x95\x82\x15\x28\xf4\x05\xdf\x4e\xfd\xa8\xc3\xbd\x50\x21\xd1\xee\xe9\xac\x22\x52\xf4\x66\xe7\x37\x03\x55\x20\xe3\x86\xca\x8f\xfa\xff\x6d\x27\x96\x03\x47\x60\x85\x62\xec\xda\x77\x18\x0d\xf5\xa2\xc7\xf7\xdd\x83\x7a\x18\x57\xe7\x08\x1a\x27\x3a\x99\xf7\xfa\xd8\xbb\x55\x51\xaa\xf1\xdb\x31\xc5\x5a\xc9\xa0\xcb\x9c\xac\x77\x9a\xa9\x50\x39\x6e\xdd\x78\x38\x30\x1f\xdc\x30\xfd\xdd\x34\x7e\xbb\xe6\x43\xda\x32\x99\xd3\x4b\x7f\x37\x8c\xdf\xae\xaf\x07\x07\xdf\x92\x3e\xe0\x50\xef\xa6\x8b\xd9\xf2\xd1\xaf\x98\x15\xbf\x34\x2b\x36\xbf\xd2\x38\x34\x1f\xd2\x8d\xe3\xce\x91\x6f\xb6\x37\x69\x4b\x86\x7c\xf7\x72\x5c\x93\xa0\x69\xc4\x53\xa7\x5e\x99\x92\xc7\x24\xa2\xfa\x62\x34\x3a\x53\xe5\x23\x15\x78\xec\x78\xb4\xb7\xab\xc4\x25\x59\xce\xd6\xb9\x77\xe9\xe0\x6f\xe7\x61\xe7\xff\x03\x00\x00\xff\xff\xc3\x42\x59\x4e\x0f\xdc\x00\x00")
-
-func manifestsDashboardsCoherenceCacheDetailsDashboardJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_manifestsDashboardsCoherenceCacheDetailsDashboardJson,
-		"manifests/dashboards/coherence/cache-details-dashboard.json",
-	)
-}
-
-func manifestsDashboardsCoherenceCacheDetailsDashboardJson() (*asset, error) {
-	bytes, err := manifestsDashboardsCoherenceCacheDetailsDashboardJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "manifests/dashboards/coherence/cache-details-dashboard.json", size: 56335, mode: os.FileMode(420), modTime: time.Unix(1647457998, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _manifestsDashboardsCoherenceCachesSummaryDashboardJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x5c\x6d\x6f\xdb\x38\xf2\x7f\x9f\x4f\x41\xf0\xdf\xff\xa2\x3d\x38\x8d\xe5\xc4\xdb\x6d\xd0\xf6\x90\x36\xd7\x6b\x81\x76\xaf\x77\x49\x0b\x14\xdb\xc2\xa0\xa5\xb1\x4d\x84\x22\xbd\x24\xe5\xc4\x29\x72\x9f\xfd\x40\x52\x0f\xa4\x24\x3b\x71\xe2\x64\xd3\xd6\x2f\x82\x58\xc3\x07\x0d\x67\x7e\x33\x1c\xce\x48\xfa\xb6\x85\x10\x26\x9c\x0b\x4d\x34\x15\x5c\xe1\x7d\x64\x48\x08\x61\x46\x95\xc6\xfb\xe8\x0f\x7b\x85\x72\xaa\x6d\x19\x66\x94\xe9\xb7\x1c\xef\xa3\xa8\x53\x51\x13\xa2\x89\x12\x99\x8c\x01\xef\x23\xfc\x41\x8a\x14\xf4\x04\x32\x85\xbd\x3e\xc0\xc9\x90\x99\x76\x2d\x33\xf0\xe8\x13\x9a\xb4\x50\x69\x2c\xf8\x2b\xc1\x84\x34\x13\xca\xf1\x90\x3c\xec\x76\x50\x2f\x8a\x3a\xa8\xd7\xef\x77\x50\xf4\xc8\x9f\x9a\x93\xd4\xde\xf8\xa0\x5a\x0b\xfa\x05\x1d\x30\x90\x3a\x60\x41\xcf\xa7\xb6\x5f\x42\xd4\x64\x28\x88\x4c\x70\xde\x76\x61\xff\x7f\xdd\x42\xe8\xc2\x74\xc7\x90\x50\x5d\xe3\x16\x8f\x39\xe8\xb7\x09\xde\x47\x3c\x63\xcc\x51\x24\x99\x4e\x8e\x85\x60\x9a\x4e\xf1\x3e\xea\x5a\x22\x35\x5d\x7a\xf9\x6f\x0d\xd2\xb2\x63\xc4\xd5\xff\x75\xb7\xdf\xed\x47\xbb\xd1\x5e\xd7\xb5\x32\xca\x4f\x54\x29\xe5\x42\xc6\x76\xe5\x21\x93\x9d\xb2\x89\xc7\x2c\x4b\xe0\x13\x91\xaa\x26\x31\x7c\x02\x30\x3d\xa6\x69\x5d\x92\x58\x93\xb1\xbd\xc7\xd7\x8a\x42\xb5\x5d\x18\x7e\x49\xe2\x13\xa4\x05\x7a\x4f\x28\x47\x87\xcd\xbb\x15\xd2\x32\x7c\x56\xd4\x4c\x32\x43\xdc\x49\x76\x62\x31\xd9\x4e\x09\xe5\xe6\x07\x48\xe0\x31\x6c\x97\x3c\xdb\x06\x27\xdd\x8b\x4e\xb8\x3e\xa2\x0e\xa5\x98\x26\xe2\x94\xd7\x79\x2d\x56\x0e\x67\x1a\x24\x27\x0c\x85\x77\xbe\xc1\xea\x7d\xa4\xd6\x54\xdf\x22\x98\x83\x19\xa1\xcc\xa8\xbf\x92\x8a\x6a\x8a\x25\xa9\xda\xb6\x0a\x0c\xd9\xc9\xf0\x94\x70\x60\x2d\x9a\x8d\x05\xd7\xc0\x8d\x61\xe1\x67\x31\x70\x0d\xf2\xc5\xb3\xc9\xee\x8b\x57\x24\x9e\x80\x42\x47\x59\x9a\x12\x39\x47\xdb\xe8\xc1\x2b\x96\x29\x0d\xf2\x77\x92\xc2\xb3\x9d\xc9\xee\x8b\x67\x3b\x79\xf7\x8a\x8b\xb1\xa4\xc9\x07\x51\x99\xac\x33\x25\x03\x3d\x0f\xef\xa7\xe6\x7a\xcf\x23\x9c\x15\x30\xcd\xaf\xe7\xe6\xba\xb0\x81\x4a\xd0\x06\xc2\x4f\x77\xcb\xeb\x12\xa8\x95\xac\x52\x61\x8d\x16\x4f\x74\xca\x70\x53\x82\x4d\x69\x69\x38\xd3\xed\x78\x88\x05\xcb\x52\x5e\x9b\x7f\x24\xb8\x3e\xa2\xe7\x76\x68\xd4\xed\xfe\xff\x15\x16\xfe\x64\xd5\x85\xf7\xda\x17\xbe\xd7\x5f\xb6\xf0\x29\x19\x43\xce\x58\xe1\x07\x2c\x5d\xc5\x52\x30\x56\xc7\x9f\x9a\x88\xd3\x37\x40\x12\x90\x8d\x16\x21\x75\xb8\x86\x58\xb0\xba\x57\x05\x15\xe7\xe3\x1a\xac\x2a\x3d\x67\x10\x62\xbb\x9a\xcb\x58\x19\xa3\xc4\x34\x63\x6b\x16\x1d\xbf\x29\x21\x1a\x5e\x0b\x99\x12\x8b\xc4\xcf\x9f\x3f\x7f\xde\x7e\xff\x7e\xfb\xf0\x10\xbd\x79\xb3\x9f\xa6\xfb\x4a\x85\xdd\xa7\x44\x1b\x73\x6c\x9f\xab\x50\xef\x84\x26\x09\x70\x5c\x36\x5d\x74\x2e\x61\xcb\x82\x3e\x9c\x2b\x36\xee\xfe\xbd\x03\x96\x2f\xdb\xaa\x31\x5c\xaf\xa5\xdb\xad\xa1\xb7\xd7\xef\xa0\xfe\x9e\xfb\xeb\x3e\x7e\xfa\x28\x98\xb8\xec\xb5\xfb\xa4\x83\xa2\xde\xd3\x0e\xda\xeb\x9a\x6e\xbf\x2d\xe8\xd7\xef\x76\x50\xf4\xa4\xd7\x31\x48\x30\xb3\x3d\x79\x84\xbd\x5e\x5f\x6f\x22\xcb\x04\x62\x9a\x12\xeb\x1b\x7a\x41\x83\xf5\x74\xf5\x4d\x30\xa7\x57\x3b\x0c\x3e\x94\x94\x31\x64\x7c\xa7\x71\xdc\xb1\x91\x21\x4a\x40\x13\xca\x14\x1a\x09\x89\x1e\x7c\x1b\x0c\x62\x60\xec\x02\x37\x66\xf9\x18\xf8\x6d\x3b\x74\x3b\x1f\xfa\xf7\x19\x91\xdb\x9e\xcf\x79\xee\x3b\xa0\x5f\x4c\xe3\x11\xc8\x19\x8d\xc1\x35\xe6\xf7\x18\x44\x17\xb6\xcd\x6a\x32\x68\xa9\xdd\x3d\x25\xd3\x29\xe5\xe3\x63\x07\x95\x68\x11\xb8\xec\x26\x1e\x82\x6b\x22\x41\x4d\x04\x4b\x42\x1b\x0c\x80\xa7\xb4\xa4\x7c\x1c\x8e\xcb\x38\xb5\xea\x50\x13\x63\x64\x57\xc7\x64\xbe\xca\x0d\x2a\x7d\xdc\xac\x8c\x4a\xe5\xa4\x78\x3d\x5c\xe6\x83\x03\x64\xb6\x81\x2f\x87\xde\x02\xcc\x5e\x0b\x7f\x65\x14\x33\x50\x6d\x38\xb8\x0a\x18\x79\x96\x0e\x41\xae\x07\x8c\x1f\x24\xb5\xe1\xc0\x91\x16\x92\x8c\x37\xa0\x5c\x55\x9d\x9f\x08\xcb\xd6\xac\xc2\x04\xe2\xe1\x5c\x83\x5a\x75\x9b\x43\xef\x21\x15\x72\xfe\x33\xab\x30\xba\xb6\x0a\xd1\xff\x1d\xdc\x13\x2d\xda\xb0\xef\x27\xd6\x61\xf7\x06\x3a\x7c\xb9\x5e\x1d\x72\xc1\x61\x05\xfd\x7d\x02\x29\xc9\xf9\x39\xe1\x02\xe5\xbb\x44\x38\x2d\x61\x74\x6c\xb9\x25\x99\x16\x3f\x80\x8a\x17\xb9\x4e\x4f\x2f\xb3\x52\x24\x83\xb8\x4d\x24\x77\x1d\x7b\xfd\x1c\x52\xdf\x79\xfc\xb7\x9d\xdb\x0d\x2b\xb6\x6a\xac\x61\x4d\xe4\x18\xf4\x92\x93\x22\x9c\x4d\x6d\x92\x4d\x65\xe9\xc3\x19\xf0\x44\xc8\xfd\x2a\x14\xb2\xe7\x84\x81\xb9\x99\x1a\x58\xaf\xf9\xad\x09\x9c\xe7\xff\xfd\x82\x1f\xcc\xce\xf3\xab\x2f\xb8\x83\xfc\x06\x2f\x2c\xfb\x82\x3b\x9a\x82\x7c\xfe\x05\x0f\x49\x7c\xf2\x05\x5f\x3c\x42\xc3\x39\x7a\xd8\x9c\xb1\x83\x1a\xc1\x58\x07\x99\xf3\x41\xa8\x25\x3c\x2a\xfd\x98\xcb\xd6\x05\x8d\x94\x2b\x4d\x6c\xb6\xa5\x11\xbf\x52\xae\x41\xce\x08\x7b\x4d\x62\x6d\x33\x8c\xa1\xfb\x62\x30\x06\x9e\x54\x4e\x32\x9c\x57\xc2\xc8\xa6\x01\xf1\xc1\xe5\xf0\xbe\x82\x6c\x15\x3d\x87\x8d\x50\x4b\xa1\xbe\x5c\x8a\xe5\x22\xbf\xe4\x36\xe4\x77\x54\x69\x2f\xd3\x24\x09\x57\x86\xf9\x26\xeb\x55\x16\xca\x92\xb7\x50\x5b\x5a\xd2\x78\xa2\x57\x85\x6b\xf9\x56\x65\x5a\x86\x2e\xdf\x38\x22\x4c\x55\xe9\x9b\x84\xa8\xc9\x3b\xe0\x63\x3d\x31\x0b\xed\x06\x74\x68\xe9\x0e\x2a\x96\x74\x9a\xa7\x82\xf1\xd1\x44\x9c\x2a\x44\x90\x4d\x20\x23\x31\xca\x4f\xf2\x12\x18\xd1\x90\xa0\xd4\x86\x8b\x28\x53\x90\x3c\xae\xd6\x30\xa2\x2c\x48\x10\x2d\xce\x84\x45\xdd\x5a\x2a\x2c\xea\x5d\x92\x0a\x7b\xba\x55\xc3\x71\x9e\x03\xac\x6e\xe6\xd4\x17\xde\xcb\x6e\x9b\x07\xea\xb8\x3d\xaf\x4f\x66\xe3\xba\x1c\x8c\xf3\xce\xa4\x84\x16\x00\xe1\x94\x9c\xb5\x10\x29\x6f\x99\x43\xd2\xf1\x44\x1f\xb9\xb2\x41\xbd\x4d\x4d\xc4\x69\x73\x1e\x2d\x34\x61\x2d\xbd\x67\x26\x3a\x51\x8b\x72\x6c\x8c\x72\x68\xa4\x9a\x0d\xf1\x94\x26\x4e\xf1\x3e\xb5\x9e\x29\x34\xfb\xd6\x07\x41\xb9\xce\xf7\x31\x4b\xa8\xd4\x39\x05\x19\x03\xd7\xe6\x68\x57\x47\xcb\xd4\x8c\x92\x24\xa1\x99\x99\xb2\x1f\xd2\x9b\xe0\x92\xc0\x13\x90\x36\xc5\x88\x47\x4c\x78\x26\xa1\x40\x52\x50\xff\x32\xbe\x80\x26\x50\xe3\x4f\x4d\x49\x0c\x6d\x18\x56\x9a\xc4\xf5\x63\x3f\x56\x1a\xa6\x53\x48\xde\x51\xde\xe4\xf7\x5e\x6f\x31\x0b\x9d\x1b\x4d\xad\x1f\xa4\xa0\x16\xb9\xb8\x1a\x5a\x3c\x1f\xd7\x74\x60\x2b\x7a\xbf\xc5\xe7\xc2\xb5\x6d\x2f\xca\x25\x0e\x06\x29\xe1\x64\x0c\x72\x40\x79\x02\x67\x03\x6b\x09\x4e\xda\x37\x93\xf3\x2d\x49\xf6\x8a\xf2\x7b\x6b\x16\xb3\x5c\x7e\xcb\x77\x92\xf6\xb0\xcb\xf2\xfe\x5a\x8a\xb4\x9e\xde\x37\xf4\xff\xc0\x38\xaf\x92\xd6\x06\x1c\x4d\xe8\x48\x37\x47\x04\x7b\xd5\x21\xd1\x24\xe7\x17\x7d\x54\x7e\x42\x07\xeb\x32\x93\xf6\xcd\xf7\x63\x44\x42\xd2\xf4\x64\x79\xdd\xa0\x5b\x77\x62\x83\x62\x8b\xa3\x3c\xa1\x33\x9a\x64\x84\xe1\x86\x43\x2b\xfa\xd8\x7d\xa7\x62\xe0\x8c\x9c\xd1\xda\x36\x32\xcc\xe2\x13\x67\xd2\x61\xf8\x5d\x16\x7e\x74\x58\x0a\x28\x4a\xb0\xb5\xde\xed\xde\xb8\xf4\xba\x7f\x7c\x6d\xb0\x38\x27\x67\xcb\xca\x1a\x15\xca\xca\x63\x7c\x88\x13\x32\x84\x16\xeb\x64\x62\xfc\x92\xa8\xe6\x41\xd5\x6d\x3a\x8d\x13\x86\xdb\x76\x1a\x64\x6f\x35\x97\x9a\x66\xc5\xa8\x8b\xd0\x5b\xb9\x6c\xdc\x61\xad\x7c\x5a\x23\x5b\x62\x03\xf3\xa6\xda\x8b\x83\x70\x7d\x9f\xb4\xf4\x77\x30\x2b\xb9\xde\xf2\x27\xbd\xad\x8a\xde\xe5\x71\x4c\x40\x58\x12\xc8\xf4\x97\x16\x33\xff\x82\x9a\x9e\xaf\x9b\x1f\xa7\xa8\xb7\x29\xa0\x6c\x0a\x28\xf7\xa6\x80\xe2\x42\x84\x4d\xf9\xe4\xbb\x2f\x9f\x6c\x74\x57\x34\x7c\x8f\x4f\x09\xf4\xd6\xfb\x94\x40\xdc\x44\xc4\x5d\x3b\x96\x0d\x28\xdb\x40\xd9\x3c\xd2\x79\x78\xba\xc3\x07\x42\xd6\xa9\xe9\x4d\xdd\xa8\xa1\x94\x4d\xdd\x68\x53\x37\x72\x1d\xfe\xda\xe4\xd3\x2a\x45\x0e\xb7\x03\xde\xab\x32\xc7\xc1\x0a\x65\x8e\x3c\x90\xf5\x9f\x3e\xb8\xed\x62\x47\x70\xac\x5d\xb5\xd6\x71\xd9\xbb\x00\x97\x56\x43\x2c\x4c\x72\xad\xc1\x8c\xc6\xee\x81\x7e\x12\x4b\xa1\x14\x22\x2c\x6f\x52\x88\x70\x5b\x2b\x19\x82\x54\xcb\xea\x24\x86\xf0\x4f\x49\x12\xea\xea\x0e\xdd\x2b\x24\x1e\xa2\x5a\xe2\xe1\xd2\x67\x89\xa3\x66\xe2\xc1\x9d\x9e\x8f\x5c\x1e\xb4\x2e\x24\x57\x5e\xd9\xab\xb2\x12\x3f\x71\x79\x25\x94\xcc\xba\xea\x2b\x62\x1a\xbe\xd3\x52\x62\xf3\x5d\x39\x55\x83\xa3\x4d\x4d\x66\x81\xfb\x2e\xac\x70\x10\x8b\x8c\xeb\x1b\xfb\xee\x6d\x74\xf7\x77\x45\x62\x34\x52\xa0\xd1\x83\xc1\xa0\x70\xe1\xf7\xb4\x36\x74\x6c\xdd\xdf\x3f\x0a\xc7\x77\xad\x1d\xe4\xee\xca\x1b\x8e\x5b\xb7\x53\x35\x79\xde\x14\x37\xae\x55\xdc\x58\x52\x33\x58\x47\x65\x23\x7c\x92\xef\x9e\x96\x35\x5a\x99\xbc\xbb\x9a\x46\xf9\x36\x97\x84\x91\x31\x26\xb3\xe6\xdd\xae\x43\x36\x56\xf1\x04\x52\xf2\x09\xa4\xca\x5f\xee\xfb\xcd\x91\xf5\x9c\xe5\xef\x86\x49\xf7\xe2\x5a\xf8\x06\x5a\xf8\xf6\x99\x9b\x5e\x43\x3a\x65\x44\x9b\x03\xd1\x15\x5e\xbf\x24\x8c\xb9\xcc\x5c\x03\x8d\xd5\xce\x1f\x28\x4c\x01\x83\x58\x5b\x63\x6b\xba\x2c\xfb\x52\xd6\x3e\xc2\xbf\x0b\x5e\x0b\x80\x67\xf9\x4d\x70\xeb\x51\xec\x4a\xef\x7b\x26\x30\xa2\x9c\x16\x41\x9e\xc5\xc6\xc0\x19\x47\x4b\xdc\x1e\xbc\xce\x99\xbf\x11\xea\xbb\x81\xfc\xdd\xbf\x83\x46\x39\xc6\xb7\x8c\xa5\xc7\x75\x9c\x66\x4c\xd3\x16\x1c\x14\x2f\x8f\x96\xfb\x88\x3f\xa8\x8a\x22\xfc\x33\x16\xfe\x33\x03\x39\x5f\x75\x59\x15\x90\xa2\x80\x3a\x86\xb3\x9a\x5d\x63\x75\x42\xa7\x1f\x25\x3b\x9a\xf3\xb8\x2d\x08\x6b\xba\x49\x4d\xc6\x16\x17\xea\xdf\x05\x67\x38\x6c\x6d\x2c\xc1\xd0\xda\x3b\xe7\x7e\xd4\xad\xd1\x6b\xc8\x14\x1c\xbb\x89\x5a\xab\x57\xd7\x46\xe9\x5a\x81\x55\x7f\xb8\xc4\xe9\xe1\xaa\x4f\xd9\x5d\x94\x11\xc4\x4a\x80\xac\x2b\xa8\x44\xe4\xab\x82\x91\xeb\x00\xb2\xbd\x1e\xb3\x2a\x24\x6f\x45\x20\xeb\x86\x72\xb4\x81\xf2\xba\x35\xb7\x2c\x16\xee\xa0\x99\xdb\xba\xda\x50\xde\xbb\x01\xca\x8b\x1d\x71\x15\x94\x17\x83\x5b\xc6\xde\x29\xd4\x57\x17\xd8\xc6\xa1\x2f\x0c\x3b\x8a\xc8\xe2\xc0\x3b\x92\xa3\x20\xb0\x78\x30\x18\x10\xc6\x6e\x29\xbc\xa8\x1f\x2c\x5d\x26\x70\x20\xe1\xcf\x0c\x94\x1e\xa4\xe4\x6c\x90\x64\xee\xd3\x0c\x37\x46\x46\xfd\x11\xea\x4b\xed\x68\x51\xf8\x92\x97\xcf\xd4\x2a\xd6\xd3\x36\xe6\x66\x56\x73\xb7\xb2\xda\x98\xd0\x77\x62\x42\xeb\x7a\x59\x61\x9d\x06\xe3\x3e\x9c\xd1\x66\x2e\xb5\x11\xe5\x5e\xd3\x18\x70\xc3\x1d\xe6\x16\x85\xf2\x83\x5a\x86\xfd\x5f\x7d\x68\x47\xbb\x8f\xb6\xe4\xe7\xde\x91\x4b\x4d\x61\x2e\x4e\xb7\x77\xbb\x69\x3e\x1d\xd6\x22\x27\xe2\x60\xdc\x94\xc6\x27\x36\xc3\x9a\x8f\xce\x25\x56\xa6\xf7\xfc\x4c\x0b\xee\x7b\x39\xa9\xa8\xeb\x5d\xec\xfa\x17\x51\x5a\xfd\xee\x7b\xbf\x23\xff\xa2\xe2\xcc\xb4\x78\xa9\xa6\x9e\xf7\x3b\xca\x3f\x30\xf3\xb5\x58\x03\x4d\x61\xe0\xa1\xed\xd2\xbb\xf8\x13\xff\xea\x4f\xec\xdf\xa5\xb7\xe7\x5f\x78\xdf\xee\x79\x92\xf8\xfc\x16\xbc\x04\xe2\x3b\x17\xbc\xfa\x56\x8b\x57\x73\xaa\x8e\x2c\xe1\x77\x69\xc2\x2f\x04\xe1\xcc\x96\x30\x70\xf9\x98\x88\xda\x56\xae\xa3\x6b\x9e\x95\xb9\x91\xde\xd6\xc5\xd6\xff\x02\x00\x00\xff\xff\x15\xa2\xe6\x29\x6a\x4a\x00\x00")
-
-func manifestsDashboardsCoherenceCachesSummaryDashboardJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_manifestsDashboardsCoherenceCachesSummaryDashboardJson,
-		"manifests/dashboards/coherence/caches-summary-dashboard.json",
-	)
-}
-
-func manifestsDashboardsCoherenceCachesSummaryDashboardJson() (*asset, error) {
-	bytes, err := manifestsDashboardsCoherenceCachesSummaryDashboardJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "manifests/dashboards/coherence/caches-summary-dashboard.json", size: 19050, mode: os.FileMode(420), modTime: time.Unix(1647457998, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _manifestsDashboardsCoherenceCoherenceDashboardMainJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x7d\x6b\x73\xd4\xb8\xb6\xf6\x77\x7e\x85\xca\x03\x53\xb0\xdf\x0e\xb4\x3b\x69\x48\xa8\x61\x76\x41\xf2\x32\xb3\xeb\x10\x60\x43\x98\xaa\x39\x33\x94\x4b\xb1\x95\x6e\x55\x6c\xab\xb7\x24\x77\xd2\x70\x38\xbf\xfd\x94\x24\xdf\x64\xd9\x7d\x75\xdf\x12\x7d\xa0\x48\x4b\xbe\x48\x5a\x4b\x6b\x3d\xeb\xa2\xe5\xef\x0f\x00\x70\x60\x1c\x13\x0e\x39\x26\x31\x73\x5e\x02\xd1\x04\x80\x13\x62\xc6\x9d\x97\xe0\x2f\xf9\x0b\xa4\xad\xb2\xe7\x32\xc1\x21\xff\x57\xec\xbc\x04\x6e\xa7\x68\x0d\x20\x87\x8c\x24\xd4\x47\xce\x4b\xe0\x7c\xa4\x24\x42\x7c\x88\x12\xe6\x94\xae\x41\x31\xbc\x0c\x45\x3f\xa7\x09\x2a\xb5\x0f\x71\x50\xd3\x8a\x7d\x12\x9f\x92\x90\x50\xf1\x40\x3a\xb8\x84\x8f\xbb\x1d\xd0\x73\xdd\x0e\xe8\xf5\xfb\x1d\xe0\x3e\x29\x3f\x3a\x86\x91\x7c\xf1\xeb\x62\x2e\xe0\x67\xf0\x3a\x44\x94\x6b\x43\xe0\x93\x91\xbc\x2e\x80\x6c\x78\x49\x20\x0d\x9c\xb4\xef\x47\xc7\x9c\xe9\x2a\x73\x42\xb7\x23\x39\x70\x7f\x08\xe3\x01\x62\x8f\xc7\x28\x0e\x08\x7d\xe9\x93\x21\xa2\x28\xf6\x91\xe7\x87\x09\xe3\x88\x7a\x0c\x7f\x43\xdf\xc7\x88\x52\xf8\xed\x1b\x8c\x49\xd6\xfe\xea\x7f\xff\x76\x1e\x8e\xbf\xa5\xbf\xfe\x76\x3a\x60\x9e\x6b\xca\x1d\xa7\xea\xef\xf7\x30\x42\x7f\x3b\x3f\xfe\x72\xa3\xaf\xda\x8a\xa5\x8b\x7e\x05\x43\x36\x7d\xd5\xe5\x6a\x9f\x3c\x57\xff\xf4\x55\x0f\x71\x84\x05\x97\xb8\xdd\x6e\x0d\x2d\x3e\x0f\xc9\x0d\x48\x47\x01\x3e\xe3\x6f\x08\x9c\xaa\xc5\x28\x3f\x82\x0d\xc9\x8d\x64\xa6\xf2\x13\x38\x1c\xfc\x17\x9a\x30\xb9\x7e\xea\x7e\x47\xef\x15\x5d\x7f\x7d\x2d\xb7\xa1\x5b\xfe\x96\xd0\x08\x8a\xe1\x38\xda\xd5\x98\x87\xa8\xe8\xaa\x19\x4f\xf0\xb4\x8e\x43\xe4\x5b\x4a\xed\x09\x43\x7f\xc0\x30\x11\x8f\xba\xc0\x51\xbe\x74\xdb\x62\x1f\x86\xe8\x18\xfb\xc8\x23\x37\x31\x0a\xbc\x11\xa4\x1c\x4b\xae\xf7\x46\x14\x47\x90\x4e\xe6\x62\xa9\x29\xec\xf2\xbc\xcb\xb6\xc2\x2f\x1f\xb3\x99\x80\x0b\x0a\x63\x76\x85\xe8\x42\xec\x22\x9f\x35\x8b\x57\x74\x8e\x98\xf1\x46\x8d\x1f\x32\x6a\xcb\xff\xbf\x3e\x48\xe9\xee\xa0\x00\xf3\x0a\x1d\x9d\x41\x8c\xf8\xbf\x02\xe7\x25\x88\x93\x30\x54\x2d\x14\x8e\x86\x17\x84\x84\x1c\x8f\xb2\x19\x38\x58\x5c\xd2\x3b\x51\x7f\x73\x44\xa5\xec\x12\x4b\xd4\x3f\xe9\xb9\xc7\xdd\xc3\x6e\xff\xa8\x77\x28\x7b\x43\x1c\x5f\xb3\x5c\x24\x67\x7c\x26\x09\xa0\x4b\xb4\x4e\xde\x15\xfb\x61\x12\xa0\x3f\x20\x65\x15\x0e\x73\xae\x11\x1a\xa5\x6c\xac\xb5\x1b\xeb\xa5\x56\x4b\xbc\xe0\x1c\xc6\x70\x80\xaa\x32\xd5\xe1\xf9\x84\x9c\x52\x63\xba\x68\x62\xcc\x45\x6b\x42\x43\xd1\xf8\x2c\x78\xe6\x93\xe1\x01\x54\x0f\x7a\x50\xda\x3e\xf9\xa4\x20\x3b\xa3\x64\x14\x90\x9b\xb8\x3a\xc0\x6c\xba\xe8\x96\x23\x1a\xc3\x10\xe8\xaf\x58\x61\xca\xe5\x8d\x5b\x51\x0e\x35\xab\xf1\x7a\x0c\x71\x28\x68\x0e\xce\xb2\xab\x99\x39\xff\xa0\xe8\x7b\x90\x31\x8e\x7c\x98\x33\x82\x31\x0a\x6b\xc8\xe9\x93\x98\xa3\x58\x32\xe6\x2f\x3e\x8a\x39\xa2\xbf\xfe\x32\x3c\xfc\x35\x13\x5b\x1f\xc6\x62\xdf\xa3\x1b\x70\x00\x1e\x9e\x66\xc2\xe0\x0f\x44\x19\x26\xf1\x2f\xcf\x86\x87\xbf\xfe\xf2\x2c\xbd\xab\x18\xcc\x4c\x39\xe4\x0c\x28\x0e\x3e\x92\x42\xfb\xab\x0d\x2f\x18\xb3\xb4\x11\x6e\xc4\xef\xa3\x52\xc3\x6d\x65\x1b\x4e\xc4\xef\xaa\x3c\x54\x0c\xee\x76\x73\xac\x50\xf0\x71\xb1\xaa\x11\x91\xb2\xc5\x19\xf2\x28\x74\xcc\xb5\x36\xd7\x55\x88\xfb\x7a\xce\xf1\x49\x18\xc2\x11\x43\x41\x95\xcc\x2b\xac\x82\xbb\xe8\x2a\xf4\x1a\x56\xc1\x2d\x56\xa1\x42\x7f\xa0\xa9\x8e\x32\x0f\xbe\xc5\xa1\xd0\x7f\xba\x56\x2b\x5f\x70\x61\x8a\x38\x00\x9c\x2b\x12\x06\x88\x6a\x02\x28\xef\xab\x9b\x68\x3e\xd9\xe7\x1d\xbd\x4d\x4c\xd8\xed\x55\x1a\x2b\x93\xce\x27\x7e\x58\x6a\xfa\xa1\xbd\x33\x65\x03\xed\xe9\x25\x7d\x50\x69\xae\x72\x08\xc8\xf4\x44\xd3\x6a\x90\x38\x9c\x28\xc9\xf4\x21\xce\x37\xa4\xa9\xae\x52\x0d\x22\x35\x6b\x42\xa9\xd8\x68\x7a\x27\xa1\xfc\x03\x0d\xe4\x1b\x5c\xbd\x87\x43\x5e\xbc\xfd\x2f\x7d\xea\x52\x94\xe1\x78\xe0\x94\x9a\xf5\xb1\xe7\xbc\x7c\xaa\x5e\x6b\x42\xd3\x12\x77\xcb\xc7\x49\x00\xfe\xa0\x66\x31\xf7\x8c\x4d\x8c\xc6\xb9\xf8\xe4\xc5\xee\xf0\x89\x81\x59\x37\xc2\x27\x9f\x90\x9f\xb3\x09\xf8\x2c\x1e\x5a\x83\x9e\x67\xf0\xcc\x14\xed\x55\x55\xe2\xe9\x53\x28\xb9\x99\x2d\x56\xb5\xb5\xda\xa8\x5c\x3d\x6c\xd2\x2e\x27\x35\x72\xb5\x66\xda\xb5\x5a\xb4\xc0\xbd\x8b\xac\x07\xf4\x87\x48\xa0\x09\x92\xf0\xca\xde\x11\x6b\x45\xe8\x1b\xe8\x5f\x0f\x28\x49\x62\x73\xc5\x64\xbf\xb4\x25\xea\xbb\x2a\x68\xe4\xa7\xde\xc9\x89\x7f\xf4\xbc\x8c\x49\x15\xce\x3e\x7c\xd1\x01\x6e\xef\xa4\x03\x8e\xba\x1d\xd0\x7d\x7a\x7c\xa2\x61\xed\x9f\x82\xa3\x23\x78\x08\x4d\x08\x33\x9b\x60\x01\x62\x3e\xc5\xa3\x14\x89\x4a\x5c\xce\x00\x1f\x22\x10\x27\xd1\x25\xa2\x80\x5c\x65\x96\x03\x88\x90\x68\x61\x85\x05\xe5\x5c\xe5\xc0\x3a\x26\x71\x69\x49\x07\x30\x19\x20\x9d\x07\x22\x78\x9b\x2d\x83\x6e\x11\x44\x38\xce\x3a\xba\x15\xf0\x5f\x63\x82\xf0\x21\x45\x6c\x48\xc2\xe0\x1d\xbc\x54\xb4\x6f\xbc\xe2\x1c\xd2\x6b\x94\x43\x43\x83\x9b\x1a\x39\xb5\x5f\xe1\xd4\x59\x8c\x7a\x54\xcf\xa8\x27\xfd\x12\x50\xe5\x88\x8e\x61\x58\x65\x1e\x1d\xe5\x83\xaa\xbc\x2f\xd0\xb8\x5a\x78\xf0\x39\x89\x84\xb9\x57\xa0\x50\x5d\x3c\xe8\x90\x3b\xa5\xd6\x01\x53\x37\x3d\xab\xfc\x3e\xc8\x95\xc5\x3f\x1f\x7a\x5e\x42\x43\x8f\xe3\x08\x79\x54\x88\x9d\x9f\x1f\x7a\x1e\x0c\x43\x6f\x0c\x29\x16\xb0\x97\x4d\x93\x33\x11\x1c\x8d\x70\x3c\xb8\x50\xbb\xc8\xad\x6b\x9f\x32\xc7\xcc\x1e\x1c\x0b\x16\x00\x9c\x00\x89\xf6\xb4\x69\x8d\x33\xb6\x99\xa9\x20\xb3\x87\xc9\x49\x4c\x7f\x58\x6f\xea\x8c\x6e\xcf\x20\x87\x1f\x09\x8e\x39\xd3\xf9\xd5\x11\xf4\x93\x1d\xe7\x29\x8c\xf5\x49\x1c\x23\x9f\xa3\x92\x29\x26\xae\xb9\x10\x6f\xae\x90\x7b\x44\x18\xbf\xc2\xb7\x3a\xca\x4d\x1b\xdf\x92\x98\x7f\xc6\xdf\xe4\x23\xfb\xdd\x47\xa5\x7e\x8a\xcc\x7b\x64\x5b\xe3\x2d\x72\xfa\xe7\x70\x34\x65\xd9\xaf\x28\x89\xe4\xb6\x4d\xc2\xb0\xa2\x63\xd4\xc0\x9d\xf7\xcf\x5e\x57\x3a\x48\x7e\xc3\x94\xb5\x63\x23\x48\xaf\x43\x1c\x57\x76\xff\x15\x0e\x43\xdd\x73\x70\xe8\x76\x80\xeb\x1e\x77\x80\x7b\x7c\x22\x24\x9a\x7b\xac\x49\xb4\x2b\xf1\x1e\x73\x73\x8b\x27\x97\x9f\xa3\x1e\xd3\xeb\x76\x80\x7b\x72\xf8\xa4\xea\x3c\x68\xf2\xdb\x38\xd2\x80\x3f\x25\x61\x12\xc5\x15\x9b\x03\xd2\x01\xe2\x53\x16\x2e\xf7\xd5\x90\x24\xe6\x2d\x38\xfa\x9a\xbd\x32\x4f\x00\x70\x2a\xf0\x2d\x13\xb6\x72\xa7\x32\x44\x71\x15\x20\xe0\x98\x71\x28\x0d\x4a\xdd\xc5\x54\x92\x41\x6f\xa1\xcf\x89\x09\x65\x28\xba\x92\xc0\xd0\x79\x3d\x15\x53\x64\xb2\x95\x55\x96\x0d\x47\xe8\xad\x62\x29\x8d\xe3\x45\xfb\xe7\x21\xbe\x32\xb6\x82\xa1\xa5\x53\x11\x67\x6a\x65\x86\xe3\x41\x88\x04\xd2\x2a\xfa\xc6\xca\x37\x57\xb0\xff\x71\x99\xfd\x65\xef\x74\xf6\x27\xd2\x85\xf1\x6a\x4e\xce\xcf\x64\xc6\x4c\xe6\x97\x17\xbe\x4f\x85\x50\x66\x73\xac\x17\x52\x68\x66\xef\xae\x20\x0a\x1f\x47\x50\xea\xe6\x6e\x13\xcc\x48\x11\xaf\xd2\x49\x20\x40\x23\x48\x79\x42\xd1\xbd\xc7\x16\x47\xf3\x61\x8b\xe3\xee\xfc\xd8\xc2\x6a\x6a\xab\xa9\xef\xb3\xa6\x86\xe3\xc1\xe3\x00\x85\x1c\x36\x6b\xeb\x14\x1a\x7b\xb9\x1c\xf2\xa4\x7a\x5f\x35\xac\xd2\x8f\xbe\x3e\x79\x02\x7e\x7d\x05\xba\x1b\x54\xe4\x21\x1a\xa0\x38\xa8\x0d\x8f\x2d\xa9\xe6\xbb\x4f\xfb\x9d\x5e\x1b\xba\x3e\x15\xfa\x67\xb9\xb4\xb7\xda\xfe\x7e\x68\xfb\x0b\xcd\x9d\x90\x46\x33\x19\xb8\xc1\x7c\x08\x50\x1c\x08\x21\x48\x51\x00\x8a\xc0\xe6\x7d\x87\x01\xc7\x73\xba\x18\x7a\xad\xb9\x18\x3e\x67\x44\x59\xc8\xc7\x90\x91\x32\x77\x32\x54\x1b\xac\x97\x61\xca\x8c\x2c\x76\xb1\xd8\x65\x3a\x76\x69\xf0\x32\xe4\x82\xd2\x83\x8c\xe1\x41\x1c\xa1\x98\x7b\x43\xe8\x09\xbd\x99\x30\xcf\x27\xc1\xaa\xce\x07\xf0\xcb\x2b\x3d\x9d\xa3\x35\xd8\x62\x62\x92\x8d\x03\x1a\xb7\x1d\x38\xf3\xff\x0b\xcd\x95\x49\xcf\x5d\x00\x34\xdd\xfd\x81\x33\x7b\x10\x0f\xb9\x20\x1c\x86\x20\x61\x28\xc8\xc3\x20\x43\x04\x47\x00\xfa\x94\x30\x06\x60\x18\x4e\x0d\x8b\x04\xc8\xbf\x9c\xf0\x32\x63\xdc\x69\xdc\x52\x8e\xff\x4e\x03\x2e\xfd\x05\x62\x23\x16\x03\x58\x0c\xb0\x0f\x18\xa0\x76\x27\xad\x0e\x01\x58\x12\x99\x00\x20\x42\x11\xa1\x13\x4f\x48\x22\x0f\x5e\x71\x44\xbd\x81\xef\x09\x21\xb5\x6a\xcc\x61\xaf\x1d\x15\x35\xd9\x64\x5f\x84\xe0\xce\x62\x0c\xbf\x0b\xc1\xfd\x5a\x2c\x17\xf8\xed\x74\x17\x54\xf5\x5e\xf9\x1e\xf6\x46\x59\xc3\x3c\x5b\xd3\x6a\xec\xd9\x1a\xfb\xf9\x7c\x1a\xfb\xf0\xd8\x6a\x6c\xab\xb1\xad\xc6\x6e\x43\x63\xa7\x7f\x27\x0c\x0e\x90\x17\xc1\xdb\x3d\x52\xda\x2d\xa9\x65\x25\xa8\xcb\x7a\xd9\xaa\xe3\x3b\xa1\x8e\xf3\x58\x80\xdb\xa4\xa3\x3f\x22\xea\xa3\x98\xc3\x01\x02\xe4\x4a\x69\x66\xb5\x1f\x94\x91\x0d\x53\x78\x36\xaf\xba\x1e\xa9\xc7\xad\x43\x5b\x57\x4e\x69\x99\xca\xba\xe9\x82\x75\xe9\xea\xde\xbc\x99\x87\x56\x55\x5b\x55\x7d\xb7\x54\xf5\xba\x1c\xec\x9b\xb5\xae\xc1\x33\xb0\x71\x70\x00\xfe\x21\x18\x7f\xa3\xb9\x84\xed\x5b\xf6\xfd\x6e\xe7\xb8\x3b\x8f\xcf\x5e\xc3\x17\xe7\x4a\xaf\x7c\x11\x4b\x09\x1e\xdd\x17\x84\x01\x43\x0c\xd9\x69\x86\x07\xbe\x17\xbb\xe5\x52\x9d\x93\xac\x9e\xe8\x60\xc3\x77\x28\x1e\xf0\xa1\x76\xe4\x46\xb6\xa3\xba\xcb\x97\x33\xc9\xe3\x40\xf7\xa1\xa7\x2a\xdf\x54\xf2\xe0\x31\xe3\x84\x0a\x82\xa9\xd3\xd2\x81\xbc\x37\xc0\x4c\xfe\x78\xf2\x14\x7c\x0c\x11\x64\x08\x30\x84\x80\xdc\xfe\x4c\x5e\x20\x8f\xdb\x32\x10\x60\x8a\x7c\x1e\x4e\xc0\x25\x0a\xc9\x0d\xb8\x22\x14\x5c\x52\x04\xaf\x03\x72\x13\x83\xcb\x09\xf8\x44\x42\x54\x86\x11\x58\x4a\x27\x57\x6b\xf8\x8d\xc2\x00\xab\x53\x99\xdd\xd9\x3a\xfb\xb8\xa2\xb3\x35\x0f\x78\xdd\x69\x81\x13\x43\x8a\x0d\x71\x10\xa0\xf8\xb3\xda\x82\xd5\x05\x57\x67\x5e\x8e\x0a\xcd\x2d\xf7\x96\x3e\x0e\x18\xe2\x41\xfc\x9a\x5d\xd4\x9f\x2e\x87\xe3\x41\x8d\x04\xce\x58\xc8\xb8\x3c\x82\xb7\x35\x8d\x38\xae\x79\x46\x03\x4e\x12\x14\xaf\xb9\x5a\x72\x70\x23\x28\x12\x1a\xc1\x38\xc5\x2b\x1a\x6f\x70\xc0\xb5\xf3\x43\x75\xe0\xc5\x50\xe7\x9a\x42\x74\xc8\x48\x2f\x78\x91\xb3\xf2\xbb\xfc\x51\xc6\x80\x46\x39\x4e\x35\x68\x32\x12\x6f\x12\x5c\x92\xb0\x32\x6a\x53\xed\x26\x09\x29\x8a\x03\x44\xd5\x01\xb5\xab\x90\x94\x84\x8d\x12\xbb\x1f\x84\x84\xc7\x01\xaa\xcc\x89\x8d\xa0\x8f\xea\xb6\x26\xe3\xd0\xbf\x36\xde\xc2\x38\x1a\x8d\x50\xf0\x4e\xe9\x6c\xbd\x6f\x47\xd5\xe1\x12\x2a\xa9\xa5\x18\xb2\xe6\x16\x4e\xd5\x84\xe1\x18\x06\x8d\xda\xaa\x01\xb7\xee\x93\x03\xa0\xa5\x85\xd4\x0d\x79\xb5\x92\x0d\x0b\xf8\x66\x6e\x75\xaf\x9d\xe6\x6b\x8e\xce\x7f\x42\x83\x74\x57\x57\x6e\x98\xfb\xc4\x81\x20\xfb\x19\xe2\x10\x87\xb5\x35\x0e\xbe\x97\x45\x1d\xa4\xc6\x31\xf3\xf4\x6c\x68\x45\xc8\x4b\x39\xe7\x65\x18\x03\xc7\x01\x1e\xe3\x20\x81\xa1\x51\x7c\x26\xc7\x21\x52\x79\x15\x03\xb8\x85\xb7\xb8\x22\xab\x2e\x13\xff\x5a\x6d\x60\xfd\xe0\x6e\x7e\x98\x5e\x4c\xbb\x0c\x9b\x53\x13\xaa\x72\x75\xbd\xc0\xce\x05\x73\x8d\x14\x9c\xc0\xdb\x69\x66\xdf\x14\xb7\xb9\x62\x17\x61\x2a\xd7\x1d\x37\x0e\xc9\xe0\x0d\x64\xc8\xe0\x2f\xa5\x7d\x8c\xcb\x95\xfe\x31\x9a\x4d\x3f\x5e\xf3\xe6\x2c\x86\xca\x86\x82\x68\x5b\x18\x67\xd9\x88\xa9\xdb\x04\x13\x93\xf0\x52\xb5\xd7\x28\x53\xd9\xfe\x0e\x8d\xf3\x51\x3f\x28\x3f\x74\xb7\x21\xa1\x2c\xfd\xc2\x87\x08\xa4\x18\x04\x84\x04\x06\x00\x8e\x91\x84\x7d\x65\x48\x08\xfd\xa1\x80\x05\x1b\x46\x6c\x46\x12\xc3\x52\x90\xad\xbf\x08\x64\x33\xc8\xbb\x0c\x66\xab\x5e\x5e\x0f\xda\x28\x1e\x0c\xf9\xe7\xfa\xfa\x3e\xdb\x06\x74\xf5\x3b\xb7\x54\x94\x46\xf1\xc3\x82\xe7\x60\xd3\xbb\x8a\x83\xb0\x95\x86\xd6\x72\x54\xef\x25\x0a\xd5\xe8\xdb\x0a\x08\x85\xe3\x81\x09\x9f\x08\xf3\xd8\x84\x71\x14\x79\x42\x5a\x78\xa9\xb4\x58\xd9\x39\x72\x39\x01\x8f\xcd\x67\x74\x32\xd1\xb3\x4a\x68\xc5\xac\x32\xd1\x1a\xea\xfa\xfe\x3d\x1d\xdf\x8f\x1f\xbb\x0e\xb6\x52\x11\xff\x4e\x88\xf8\xd7\x8a\x68\x16\x6b\x2d\x85\xb5\x4a\xc1\x95\x5e\x03\x57\x4e\x41\x36\x15\x86\x5b\x0a\xd7\x54\x53\x4b\x2d\xfc\x9a\x0f\x7e\xf9\xd2\x43\x5d\xd9\x3b\x4b\x7a\xd2\x64\xc0\x4c\x1a\x8e\x40\xa9\x2f\xfc\x0d\x05\x42\x8c\xd1\x8a\x6b\xab\xe4\xc2\x74\xbb\x65\x1f\x66\x23\x30\x7a\x31\x0b\x18\x55\x5d\x59\xee\x0b\x83\x87\x15\xf6\xe9\xf5\x4c\xdd\x5e\xcc\x7c\x04\x07\x28\x1d\x9a\x26\x34\x98\x4f\x89\x44\x77\xba\x4a\x19\x92\x9b\xdf\x11\x54\xe5\x77\xf4\x1e\x25\x0f\x4a\xb3\xf0\x49\x58\x19\xa6\x58\xc3\x26\x84\xc2\xf8\x24\x9c\xb6\xe1\x24\x6a\x96\x2b\xaf\x6f\xf8\x12\x5f\x38\x30\xe1\x44\xef\x0a\x20\x2f\xd5\x1b\xfc\xf3\xcf\x3f\xff\x3c\x38\x3f\x3f\x38\x3b\x03\xbf\xff\xfe\x32\x8a\x5e\xb2\x8a\xbe\x18\x41\xce\x11\x8d\xeb\x5f\x93\x09\x2d\x05\x37\x67\x3b\x20\xf2\x11\xcb\x4c\xb7\x2f\x0c\x05\x73\x0f\x5b\x06\x90\x53\xdc\x62\x6c\xa0\x9a\xe8\xb2\x6c\x57\xe1\xe4\xa3\x7e\x07\xf4\x8f\xd4\xbf\xee\xd3\x13\x5d\x67\xce\x17\x74\x2e\xae\xeb\x77\x3b\xc0\x7d\xd1\xeb\x00\xf1\xd4\xee\xd3\x93\x17\x4f\x9a\xeb\x27\x2d\xb8\xd4\x8d\x02\xb4\x21\xf4\x59\xa5\x8f\x0c\x15\x83\x9f\xaa\xf1\xb4\x7a\x65\xaa\xd1\x4f\x9d\x7d\xab\x80\xd4\x18\xeb\x86\xf3\x82\xd4\x3d\x87\xb7\x96\xb8\xb5\xc4\xed\xae\x40\xdc\x37\x5b\x27\xee\x27\x12\xce\x2f\x6c\xee\x17\x61\x97\xda\xb5\xd4\x58\xcf\x79\x88\xca\x38\xc5\xf1\xa0\x9e\xa8\x0a\xc0\xcc\x4f\x51\x55\xda\x04\x9c\x92\xa4\x5a\x81\xd0\x52\xb6\x85\x2d\x7b\xda\xee\x96\x5d\x94\xba\xb9\xaa\x2d\x45\x98\x17\x21\x6e\x1a\x02\x9e\x9f\xbe\x35\x14\x59\x85\xc0\x26\xbb\xec\xca\xce\x4d\xe9\x7b\x36\x8d\xbe\xfa\x84\x9e\xf6\xab\x53\x7c\x7a\x3c\xa5\xfc\xe2\x1c\xdc\x90\xfa\x5f\xe4\xcf\xf9\x79\xe2\x8f\xdc\xa3\x90\x85\x46\xee\xf6\xbe\x6f\x22\x74\x89\x98\xa6\x93\x65\xbb\x42\xf9\xde\x13\xe4\xd9\xd3\x7f\x3c\x5b\xaf\xe4\x7c\x50\x19\xda\xca\xc7\x9e\xd7\x9a\x26\xd5\xe4\x09\x14\xf8\xa1\xd9\x0d\x28\xfd\xf7\x5a\xe7\xda\xd3\xa6\x4e\x5b\x0c\x44\xb7\x9b\xdd\xb6\x37\x4b\xb8\xcb\xb1\xfc\xfd\x59\xc5\x37\xfb\xc9\x88\x5b\x49\xc2\x9c\x3e\x22\xf0\x77\xbc\x4b\x84\x3d\x9b\x5e\x56\x7a\xd9\x7a\x09\xca\x81\x59\xe0\xe5\x37\x2a\x1f\xaf\x14\x0d\x90\x9f\xe4\x20\x34\x32\x27\x5e\xfa\x32\x87\x68\x7e\x00\x76\x3e\xca\x7d\x9e\x1d\x6a\x80\x03\x04\x8a\x30\x9e\xe0\x84\xb4\xea\x61\xd5\x63\xbb\x7a\x68\x7b\xa6\x07\xd7\x08\x6d\xd7\xb8\x70\xe7\x88\x6d\xf7\x6a\xcb\x0f\x96\xf2\xaf\x37\x15\xf2\xb6\x69\x8a\xbb\x1f\x20\xde\xfb\x34\xc5\x3b\xa5\x4e\xd6\x9e\x11\x28\x44\xfa\x4b\xf0\xfd\xbb\x18\x4a\x63\x78\x7a\xfe\xd4\xff\xf5\x86\xa7\xf5\x13\x02\x59\x86\xb8\x8d\x4f\xaf\x18\x9f\x36\xec\x64\xe3\xbc\x9e\xb4\x19\x75\x3e\x5a\x2e\x06\xec\xb8\xfa\x63\x6c\xa4\x7a\x07\x22\xd5\x43\x04\x38\x19\x01\xf0\xf0\x82\x8c\xde\xe1\x08\xf3\xac\xbc\x37\xe0\x43\xc8\xc1\x10\x8e\x91\x4c\x00\x0c\xc9\x0d\x62\x1c\x8c\x86\x13\x86\x7d\x18\x66\xa7\x42\x1e\x15\x25\x1c\x56\x8b\x68\x3f\xaf\xe0\xa1\xe3\x0a\x1c\x32\x3e\xe5\xd4\x70\xa0\xd2\xed\x1e\x4d\x53\xeb\xeb\x0e\x68\x1f\x96\x86\x79\x4f\x03\xda\x6f\x29\x42\xb5\xe9\xe5\xeb\xf2\xb2\xef\x63\x14\x65\x79\x2f\xfb\x02\x2e\xf6\xe3\xae\xe1\x63\xef\x77\xb7\xe1\x64\x4f\xb3\x41\xef\xb6\x23\xb7\x25\x5e\x90\xdf\x4d\xac\xf1\x17\x88\xf6\xe2\x23\x95\xce\x19\xc5\x61\x08\xe4\x61\x39\x4e\xf2\xf4\xeb\x34\xd1\xa8\xb2\xf1\xc4\xad\x5f\xa6\x26\xda\xfe\x73\x0c\xe9\x41\x09\xb9\xbe\xaa\xfd\xfc\x12\x58\x80\x57\xa3\x3a\x92\x6f\x3a\xe0\x67\x83\x3b\x06\x5d\x6c\x70\x67\xc7\x08\xb2\xcb\xc1\x9d\x4b\xc2\x39\x89\xae\x1f\xe7\xe0\xb0\x03\x9a\x32\xbe\xaf\x28\x42\x5e\x86\x0e\x33\x2b\xba\x85\x4f\xeb\x3c\x03\x75\x6f\x93\x1e\xa1\x75\xbc\x6e\x76\x9a\x79\xcb\x7e\xfd\x95\x8d\xf8\xa5\xcc\xf6\xc2\xcf\x3b\x2a\x23\xff\x4c\x8b\x1c\x00\xf0\x31\x03\xfa\x15\x24\xb7\xaa\xe7\x77\xc3\x66\xcb\x10\x0f\x86\xc2\x6e\x39\xfd\xf8\x45\x25\xb6\x3f\x02\x64\x8c\xa8\x76\xa6\x49\x18\xe1\x40\x1e\x21\x59\xaf\x09\x53\xad\x14\xdf\x6c\xc2\x1c\x6e\xd3\x84\xe9\x59\x13\x46\xf1\xd2\xdd\x56\x4d\x5b\x85\xab\xd9\xb7\xa4\xe4\x79\x5e\x59\xfb\xe1\xe1\x77\xcf\xf3\x51\x18\xfe\x98\x09\x5d\xe5\xbd\x07\xe9\xbd\x53\x81\xeb\xcf\xa2\x53\xd1\x52\xf5\xd5\xbf\x63\x5e\x44\x5b\xc3\x14\x9b\x46\x4b\x69\xf1\x72\xcb\x99\xa5\xcb\x57\x34\xaa\x63\xc3\xc2\xd9\x34\x51\x3f\x52\xe2\x23\xc6\x72\x1d\x65\x73\x13\xb7\xea\x35\xb1\x89\x89\xbb\x6a\x2a\x59\xdb\x75\xc7\x08\xb2\xcb\xb6\x2b\x27\x23\xcd\x70\x65\x49\xf4\x18\x8e\x07\x9e\x80\xff\xf2\xd4\x78\xad\x19\x3b\x52\xb2\xd8\xf3\x47\x89\x3c\xbd\xbc\xea\x77\xe5\x1e\x7a\xea\x70\xfa\x57\xf0\x64\x8a\x69\x29\x91\xc5\x5d\xb6\x2c\x85\x5a\x43\x41\x6e\xa3\x81\x03\x90\x9d\x36\xfe\x24\x56\x67\xdf\xad\xcb\x72\x69\xd4\x47\xaa\x7a\xda\xd6\xac\x4c\xa3\x4a\x78\xb3\x99\xb9\xd5\xa3\x9f\x36\x52\x66\xcd\xcc\xcd\x9b\x99\xd6\xce\x04\xd6\xce\x54\x37\xdc\x3b\x3b\xf3\x51\x51\x65\x75\xc9\xd3\xe6\xd6\xc8\x6c\xcd\xc8\x34\x43\xf3\xc7\x5b\x09\xcd\x5b\x33\x53\x75\x58\x33\x73\x77\x09\xb2\x4f\x66\x26\x1c\x0f\x66\x99\x99\xeb\xca\x7e\x2e\x8c\xcd\x27\xe0\x19\x58\x60\x10\x2d\xe7\x3c\x97\xc6\x71\x9f\x6d\xde\xcc\x64\x3c\x28\xab\x5d\xf0\xe8\xae\xd8\xbe\x03\x8a\x20\x17\xc6\x2f\x50\x87\x85\x7e\x3b\x05\xc2\x34\xda\x9e\xe1\x5b\xcd\x10\x3d\xec\x1a\xb6\xa4\xb4\x7b\x5f\x6c\x35\x41\xb4\x92\xda\x9d\x5a\xbe\xf5\xdf\x4c\xb8\xf3\xa6\x2f\x30\xd3\xcb\xee\x9a\x7a\xb3\xf6\x6f\xfe\x0e\x6b\xff\xde\x49\xd6\xdc\x1b\xfb\x57\xd3\x53\x96\xb4\xed\x91\x76\xa6\xf1\xbb\x38\x40\x8f\x16\xa9\xc3\x65\x6d\x58\x83\x24\xd6\x86\xdd\x31\x82\xec\xb2\x0d\x0b\x2a\x46\x2c\x4b\xa2\xc7\x01\x0a\x39\x34\xcd\xc6\x81\xef\xf9\x24\x0c\x91\x2f\xec\x05\x69\x5a\x6e\xd2\x58\x04\xbf\x82\x6e\xbb\x06\xe3\x36\xcd\x42\xdd\x6e\x3a\x00\x77\xcc\x2e\xf4\x87\xf2\x9b\x80\x38\x96\xcd\x17\x90\x5d\xab\xba\x7a\xbb\x93\x80\xdb\x64\x21\xba\xee\xc9\x36\x4d\x44\x1b\x19\xb5\x91\x51\x6b\x19\xd6\x30\x98\xb5\x0c\x6b\xae\xda\x61\xd6\xdc\x1f\xcb\x30\x57\x4e\x96\xae\xb5\x74\x5d\xbe\xe8\x6b\xbb\x98\x37\x26\x31\xb2\x86\x61\x23\x7d\xad\x61\xb8\x7f\x04\xb9\x23\x86\x21\x53\x7a\xd1\xe3\x90\x5d\x7b\xb2\x28\xa8\x35\x0c\x9b\x96\x7f\xa6\x61\x58\xd8\x4a\xfb\x67\x15\xa6\x00\x89\x81\xfd\xb1\x0b\x8d\x94\xd9\xc6\xd0\xe1\x56\x0f\x66\x5a\xbb\xd0\xda\x85\xad\x83\x6f\x6b\x53\xed\x17\x59\x97\x32\xf7\x53\xe5\xbc\xdc\x81\xdb\xf4\x66\xcd\xde\x4f\x29\xa6\xdb\xf4\x3f\xb7\x5e\x44\xc6\x5a\x86\x3b\xcf\x9d\xd6\x32\xdc\x0b\xfa\x5a\xcb\x70\xff\x08\x62\x2d\xc3\x15\x2c\x43\xa1\x3a\xd6\x61\x17\x9a\x9c\xb9\x3d\x8b\x31\x37\xb5\x2a\x26\xe3\x7a\x2c\xc6\x59\x35\xdb\x35\x1b\x66\x0d\x25\xdb\x4d\x95\x53\xff\xd9\x4d\xc1\x67\xe0\x12\xfa\xd7\x21\x19\x80\x11\xa2\xa9\x93\x60\xbd\x5f\x26\x9f\x99\x8c\xfa\xdc\x30\xd3\xe6\x28\xde\xee\x96\x52\x55\xeb\xca\xb4\xcf\xa8\xc3\x5e\xed\x19\xe2\x00\xfd\x37\xa2\x64\xb5\xaf\x92\x2f\x59\xa3\xbd\x3e\xc5\x35\x2b\xd2\xae\xcf\x7e\xc5\x6f\x8f\xa7\x8e\x94\xc5\x3e\x3d\xae\x6e\x2a\xbe\x3c\xae\xff\x5e\xef\x87\xc7\x01\x64\xe0\x9b\x20\x4c\x7e\x99\xad\x2f\xbf\x4a\x7d\x79\x4d\xe5\xa4\xa2\x60\x7d\x85\xe1\x94\x0f\x72\xaa\xaa\x59\xe5\x13\xe4\xf3\x7f\x68\x5c\x0e\x64\xe7\x0b\xb9\x4b\x55\xf5\x26\x95\xcf\x6f\x26\xa0\xe2\xd3\xb1\x85\xdc\x97\x2b\xe4\xde\x6d\xe0\xbd\xb5\x57\x46\x5f\xa5\x80\xfb\x2e\x8c\x7f\xe7\x2a\xbb\x6f\xfd\xe3\x38\x4b\x21\xad\xcb\x49\xe6\x66\xda\x2c\xd2\x32\x92\xba\x96\x43\x5a\x87\x2b\x21\xad\xca\x8e\x9e\x05\xb4\xf6\xe2\x5b\x38\x33\x50\x56\x6e\x7c\x2c\x04\xb3\x52\x16\x29\x70\x56\xb5\x61\xbd\x40\xcb\x02\xac\x3d\x04\x58\xd2\x94\xdf\x2e\xb4\x12\x43\xd8\x3b\x60\x55\x0d\xab\x58\x64\x65\x91\x95\x45\x56\x7b\x81\xac\x3e\x52\x72\x3b\x01\x1f\x12\x3e\x20\x38\x16\x9b\x99\xa3\x7c\x67\x5f\xd6\xec\xec\x0d\xe0\x2c\x33\x49\x62\x39\xa0\x75\x6c\x81\x56\xde\x3a\x17\xd0\x52\xbc\x20\x48\xbe\xb0\x53\x6b\x24\x6e\x35\x31\x57\xd1\xbc\x31\x0f\x97\x05\x5e\xed\x00\x2f\x9f\xc4\x71\x7a\xf8\x2a\x82\x31\x1c\x20\xea\x91\x54\x4a\x78\x97\x13\x8e\x2c\x18\xdb\x11\x30\xb6\xa8\x04\xb7\xd8\xac\x55\x6c\x16\x20\x5f\x6c\x07\x66\xe1\xd9\x1e\xc1\xb3\x76\x72\x52\x73\xc7\x84\xcc\x3f\x55\x09\xa8\x49\x7c\x09\x43\x18\xfb\x28\x00\x23\x48\x39\x96\x2a\xa8\xdd\xa4\x52\xe3\x03\xce\xd5\x10\xe0\x51\x43\x1d\xd6\xfe\x56\xcb\xb0\x96\xf8\xe5\xde\x16\xa3\xf9\x52\x70\xc7\xc7\x9c\x3b\x96\x4a\x3f\x71\x7c\x54\xc2\x3a\x45\x77\x53\x02\xca\x3e\xd6\x47\x5c\x4f\xc6\x97\x3e\x9b\x03\xb7\x3a\xbf\x15\x8b\x23\x2e\x99\x92\x6a\x0b\x14\xb5\x92\x97\x2a\x21\xef\x9b\x10\xc6\x06\x54\x2e\xae\xb1\xb9\xab\x6d\xf3\xb0\xcd\x71\x34\x88\x62\x73\x1c\x77\x8c\x20\xbb\x9c\xe3\x58\xfb\xa9\xc3\x2c\xf2\x51\xc0\x48\xaf\x00\x97\xab\x59\xdd\x1d\x20\xe6\x27\xda\xcf\xb0\x60\xb2\xcb\x84\xa3\xe0\x14\xfa\x43\xf4\x3f\x6f\x51\x80\x28\xcc\x7e\xa6\x06\x7a\x9d\x7d\x2e\xcd\xf3\x5d\x3b\x0d\xf7\x66\xae\xdc\xc6\x2c\x6b\xf1\x73\xc2\x46\xc8\xd7\xb2\x1b\xa7\x03\xb4\x9d\x3d\x0a\x37\x77\x81\x14\x26\xe6\x0d\xe3\x00\x50\xe4\x23\x3c\x46\x01\x88\x10\x63\x70\x80\xd8\xe6\x0e\xc5\x19\xf6\x8b\xd6\x30\xcd\x80\x79\xd1\xdf\xa6\x01\x63\x4f\xc5\xd9\x53\x71\xb6\x5a\x4a\x0d\x83\xd9\x93\x7d\x35\x57\xed\x30\x6b\xee\xcd\x99\xb8\xf7\x88\xdf\x10\x7a\x0d\xce\x53\x1d\x65\xa9\x5b\x4b\x5d\x7b\x32\x6e\x2f\xe8\x6b\xad\xc6\xfd\x23\xc8\x2e\x5b\x8d\x75\xdf\x1d\x34\xac\xc8\x98\x04\xc2\x84\x94\xe1\x35\x4f\xa0\xff\x15\xe3\xb5\xe0\xff\x99\x9f\xc9\xd7\xde\x91\x19\x16\xab\xbe\x07\x1c\xcc\x78\x51\x1b\x93\x21\x57\x57\x0c\x71\x90\x1d\xf3\x03\x07\x60\x43\xb3\xab\xbc\x78\xd3\x5f\xaf\xd8\x66\xcd\x99\x4c\xa9\xef\x41\xc1\x99\xdc\x12\xd6\xa6\xe2\x43\x7f\x88\x58\x27\xf3\x17\x33\x69\x4f\xa7\xc8\xfd\x06\xf3\xa1\xf6\xc1\xc6\x73\x78\x0b\xfe\x9d\x20\x3a\x91\xb5\x57\x37\x1c\x08\xec\x37\x54\x97\x39\x7e\xbe\x4d\x3b\xfa\xc8\xda\xd1\x05\x57\x9c\x25\x14\x4a\x96\xbb\xd3\x9a\xf6\x7e\x55\x89\xb7\x5e\x92\xed\x07\xf3\xee\x97\x27\x65\x0d\x01\x69\xcb\xbe\xa5\xcb\x57\x74\xf2\xed\x70\xf0\xd8\x88\x37\x6d\x97\xf9\x64\xd4\xc9\xb2\x5e\xe9\xf2\x35\xb3\x9e\x04\xb3\xf3\x4b\xba\x3a\xae\xf4\x0e\x53\xbe\x14\x8f\xaa\xf0\xab\xb4\x6e\x5e\x65\x66\xce\x72\x1c\x6a\xb2\xc4\xa6\xdd\x20\xd6\x53\x65\x50\xc5\x7a\xaa\x76\x8c\x20\x7b\xef\xa9\x62\x9c\xd0\xf4\xb3\xa0\xde\x7f\x84\x6d\xe4\x05\xa9\x6d\xe4\x45\x38\xc4\x6c\xcd\x65\x35\x72\x7b\xbe\xa3\x0c\xfc\x7d\x2d\xf5\x9b\xf9\x54\x2e\xf4\x0c\x01\x29\x9c\x2b\xde\x88\x5d\x4c\x6c\xa8\x77\xb9\xc4\x24\x40\xcc\x74\xae\x28\xee\x05\xe4\x0a\xbc\x27\xf1\xc1\x87\x11\xc7\x11\xfe\x86\x02\x39\x45\x8c\xd6\x9d\x76\x6d\xa4\x2d\x34\xba\x5b\xb6\x9a\xb6\x60\xf3\xae\xad\xbf\xc5\xfa\x5b\x2c\x45\x81\xf5\xb7\xa8\x3e\xeb\x6f\xb9\x93\xec\x6b\x5d\x2a\xd6\xa5\x72\x0f\x5c\x2a\xd6\x87\xb2\x1f\x3c\x69\x7d\x28\xfb\x47\x90\x3b\xe3\x43\x89\x49\xec\x91\xcc\x1c\x4e\xbd\x29\x6d\x14\xc4\x5e\xdc\x89\xb2\xf7\x3e\x94\xc2\x09\x51\xeb\x63\x68\xcd\x85\xb2\xdd\xa2\xd7\x6b\x28\xeb\x33\x3b\x3b\x65\xb9\xb2\x3e\xa5\xdc\x95\x56\xcb\xfa\x18\x35\xa9\xb7\x5b\xd7\x67\xa1\x3a\xd5\xb6\x26\x4e\xdb\x35\x71\xea\xd3\x00\x07\x09\xa4\x81\x47\x91\x4f\xc6\x88\xb6\x22\x51\x1b\xd3\x1c\xd7\xf0\xaa\x22\xe9\x30\x13\xa6\xa0\x51\x36\x37\xd7\xd7\x69\xe9\xeb\x04\x7b\x53\x45\xfa\x37\x41\x08\x0c\x63\xf0\x49\xd1\x42\x17\xfa\xb6\x98\x4e\x9b\xc5\x74\xe4\x09\x02\x9d\x6d\x76\xa6\x90\xce\x7d\xae\x97\x73\xf7\xc1\x89\x19\xcc\x59\x0e\x9d\xbc\xb0\xe8\xc4\xa2\x93\xb4\x63\x3b\xe8\x84\x23\x1a\xe1\x18\x72\xb4\x09\x7c\xd2\xf2\xcb\x4c\x84\x62\x01\xca\xfc\x00\xe5\x22\x25\x46\xa5\x66\x81\x85\x28\x16\xa2\xdc\x41\x88\xf2\x20\x7d\xac\xd8\x90\x62\xaf\x89\x69\x1f\x76\x15\xe3\x3b\xcc\x1f\xa2\x08\xfe\x81\x28\x53\xb9\x25\xbd\x9e\x6a\xe6\x13\xb5\x67\x02\x48\xaf\xd5\x95\x1c\x0e\x8a\x1d\xe7\x70\x14\x8d\x42\xc8\x71\x3c\xc8\xc7\xe8\x84\x98\xf1\x12\xcb\x68\x23\x0f\x55\xec\xdb\xe0\xcc\x42\xa5\x6b\x04\x62\x28\x44\x3e\x97\x1b\xcf\x8c\xaf\x72\x74\x2b\x69\xf7\xde\xe0\xb0\x71\xfa\x12\xa7\xd6\x99\x3b\x1b\x41\x49\xce\xbe\xc2\x31\xce\x32\x6d\x24\x2f\x78\x6a\xa3\xd4\xb8\xf3\xca\xde\x56\x09\x42\x2a\x22\x01\xc7\x7e\x98\x04\xe8\xb5\x91\x9e\x52\x62\xb3\xe9\x0e\x7f\x27\x4a\x42\x8e\x6b\x88\x9e\x6e\x74\x27\xd7\x18\xe5\x9b\x0a\x7c\x50\x76\xc5\x3a\xd2\xbf\xb9\xe8\xb4\x0a\xae\x71\xb5\xd6\x01\xba\xad\xa8\x0e\x87\x5d\xe3\xd1\x17\x1a\x7e\x9e\xc4\x7e\x1d\xbc\x32\x45\x26\x87\x03\xc9\x17\xec\xdf\xd9\xc8\x1c\xbd\xd7\x98\x82\x68\xab\xbf\x38\x95\xa9\x6a\x8e\xa5\x8e\x84\xa1\x0b\xf5\xa0\xda\x6c\x9e\xa5\xb9\xb4\x55\xc6\xaa\xd6\x14\x56\x74\xf0\x18\xfe\x86\xe6\x80\x0a\x3f\x72\xac\xb0\x10\x43\x56\x09\x84\xe3\x40\xd2\xf4\xc0\xad\x63\xd3\xd3\x6c\x74\xcb\x70\x69\x7d\xa8\x78\x51\x3e\x5d\xcb\x2a\xb5\xcd\xdf\xae\xe5\xef\xb6\x29\x37\x0d\x0a\x77\xc0\x58\x29\xaf\x3a\xd6\xef\xb5\xcd\xfa\x99\xa2\x5c\x84\xf5\xb3\x9b\x6b\xee\xdd\x28\xff\x2f\xbe\x8a\x56\xf4\xcf\x03\x50\x8c\x08\x5c\x86\x4f\x5e\x57\x0b\xcf\xe6\xf0\x44\x15\xef\x5f\x13\x48\x69\x28\x8f\x47\xd1\x7f\x12\xc4\xb8\x4c\x1b\xcf\x12\xc6\x57\xe6\x9a\x6a\xed\xf9\x99\x1b\xaf\x02\x82\xa6\xef\xbb\xac\xde\xdc\x22\xdb\xad\xee\x9e\xd5\xb6\xd9\x66\x17\xd0\xee\xb9\xc6\x3d\x37\x73\x5f\x55\x12\x29\xaa\xbb\x0c\x80\xaf\x9b\xd1\x6e\xd0\x1f\xa2\x76\xa4\xf2\x7a\xf7\x97\xcc\x9a\xaa\xdd\x5d\x95\xc7\xe4\xba\xcc\xb8\x61\x45\x0d\xb6\xc6\x95\xb2\x1b\x69\x25\xe5\x75\xd8\xa0\xba\x0e\xeb\xb5\xd6\xa2\xb6\x46\xce\x83\x17\x64\x04\xde\x03\x99\x38\xb2\x88\x98\xcf\x92\x4d\x1a\x78\xb1\x34\xf4\xef\xba\x50\x98\xe6\x59\x28\x4d\xdf\xa8\x76\x9e\x2f\x80\x5b\x16\x28\x3f\x3a\x73\xbd\xa9\xba\xca\x8d\xeb\xdc\xb0\xd2\x73\xbf\x68\xda\x94\xfa\x8d\x6f\xea\xb7\xfc\x26\xb7\xdb\xbc\x7a\xdd\xb6\xdf\xd5\x3c\x2d\x57\x9f\x57\xfe\x77\xad\xa0\x72\x3b\x87\x9d\x7e\xc7\xed\x76\xb4\x07\xce\x10\x0d\xd9\xe6\xf5\x13\xc6\x49\x94\x3b\x7a\xe5\xff\x42\xd7\xc8\xf9\x29\x1f\x6d\xee\x25\xbb\x52\x4e\x6d\x27\x26\x37\x07\x87\xdd\x28\x7d\x99\xc3\x49\xda\xe8\x68\xf7\x8d\xb0\x7f\x2d\x23\x2d\xe9\xdd\xa9\x54\xcb\xdd\xfe\x65\x6e\x77\xfa\x25\x6f\xb6\xdb\x2d\xfd\x38\x2c\xff\x70\xa3\xe2\xef\x7e\xe9\x6f\xb7\xfc\xa3\x18\x99\xe8\x29\x39\xa9\x7b\xa5\xbf\xdd\x40\x4d\xf9\x6b\x36\x07\x1c\x21\xcf\xdc\x85\xcd\x6f\x29\x3f\xf8\x79\xf9\xc1\xe5\xb7\xf4\x8e\xca\x3f\x8a\xaf\x6b\x39\x2f\x82\xf2\x78\xb3\xb1\x68\xcb\xf7\x8d\xc4\x28\x97\xb8\xa5\xbc\xb5\xdc\xa0\xcb\x3f\xda\x05\xce\x21\x56\xb6\x99\x93\xc8\x10\xa5\x23\x0f\x5c\xe4\x8d\xc5\x87\xb6\x0c\x7f\x67\xf1\xca\x71\xee\x47\x75\x1f\xfc\x78\xf0\x7f\x01\x00\x00\xff\xff\x3c\xc4\x20\xdd\x33\x48\x01\x00")
-
-func manifestsDashboardsCoherenceCoherenceDashboardMainJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_manifestsDashboardsCoherenceCoherenceDashboardMainJson,
-		"manifests/dashboards/coherence/coherence-dashboard-main.json",
-	)
-}
-
-func manifestsDashboardsCoherenceCoherenceDashboardMainJson() (*asset, error) {
-	bytes, err := manifestsDashboardsCoherenceCoherenceDashboardMainJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "manifests/dashboards/coherence/coherence-dashboard-main.json", size: 84019, mode: os.FileMode(420), modTime: time.Unix(1647457998, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _manifestsDashboardsCoherenceElasticDataSummaryDashboardJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x5c\x6b\x6f\xdb\x3a\xd2\xfe\xde\x5f\x41\xf0\xf4\x7d\xd1\x03\x38\x5b\xcb\x4e\x9a\x0b\x7a\x0a\xa4\xe9\xc9\xe2\x2c\x9a\x6e\xb7\xc9\xe9\x87\xdd\x16\x06\x23\x8d\x2d\x6e\x28\x52\x4b\x52\x8e\x93\x22\xfb\xdb\x17\xa4\x6e\xa4\xa4\xd8\x6e\x7a\xc9\x4d\x9f\x12\x0d\xa9\xd1\x70\xf8\xcc\xcc\x43\x89\xe6\x97\x27\x08\x61\xc2\xb9\xd0\x44\x53\xc1\x15\xde\x43\x46\x84\x10\x66\x54\x69\xbc\x87\xfe\x65\xaf\x50\x21\xb5\x2d\xa7\x19\x65\xfa\x0f\x8e\xf7\x50\x30\xa8\xa5\x11\xd1\x44\x89\x4c\x86\x80\xf7\x10\x7e\x2f\x45\x02\x3a\x86\x4c\x61\xa7\x0f\x70\x72\xca\x4c\xbb\x96\x19\x38\xf2\x98\x46\x1d\x52\x1a\x0a\x7e\x20\x98\x90\x46\xa1\x9c\x9d\x92\x67\xc3\x01\x1a\x05\xc1\x00\x8d\xb6\xb6\x06\x28\xf8\xd5\x55\xcd\x49\x62\x1f\xbc\x5f\x8f\x05\xfd\x3f\xda\x67\x20\xb5\x67\x82\xbe\x48\x6d\xbf\x88\xa8\xf8\x54\x10\x19\xe1\xa2\xed\xca\xfe\xfd\xfc\x04\xa1\x2b\xd3\x1d\x47\xa0\x42\x49\x53\xa3\xc9\xf4\xb7\x3a\x30\x44\x54\x37\x86\x80\x67\x1c\xf4\x1f\x11\xde\x43\x3c\x63\x2c\x97\x48\x92\xc6\x27\x42\x30\x4d\x53\xbc\x87\x86\x56\x48\x4d\x97\x71\xf1\xbf\x06\x49\x0a\xcd\xc1\xd6\xee\x28\xd8\x19\x6d\x6f\x6d\x6f\x16\xad\x8c\xf2\x33\x55\xb9\xbe\x74\xbc\x75\x87\x6f\xf9\xa0\x6a\xe2\x21\xcb\x22\xf8\x48\xa4\x6a\xb8\x11\x9f\x01\xa4\x27\x34\x69\xba\x17\x6b\x32\xb3\xcf\xf8\x5c\x4b\xa8\xb6\x03\xc3\xaf\x49\x78\x86\xb4\x40\x47\x84\x72\xf4\xa6\xfd\xb4\xd2\x85\xc6\xce\x5a\x9a\x49\x66\x84\xcf\xa3\xe7\xa1\x88\x37\x12\x42\xb9\xf9\x07\x24\xf0\x10\x36\x2a\x9b\x6d\x43\xee\xf2\xab\x81\x3f\x3e\xa2\xde\x48\x91\x46\xe2\x9c\x37\x6d\x2d\x47\x0e\x0b\x0d\x92\x13\x86\xfc\x27\x7f\xc3\xe8\x5d\xf8\x36\xf0\xd0\xe1\x98\xfd\x39\xa1\xcc\x4c\x7f\xed\x15\xd5\x76\x4b\x54\xb7\x3d\x29\x81\x65\x95\xe1\x94\x70\x60\x1d\x33\x1b\x0a\xae\x81\x9b\x68\xc3\x2f\x43\xe0\x1a\xe4\xab\x97\xf1\xf8\xd5\xef\x8c\x28\x4d\x43\xf4\x86\x68\x82\x8e\xb3\x24\x21\xf2\x02\x6d\xa0\xa7\x07\x2c\x53\x1a\xe4\x3b\x92\xc0\xcb\xe7\xf1\xf8\xd5\xcb\xe7\xc5\x4d\xb5\x2d\x2b\x83\x11\xcf\x24\x8d\xde\x8b\x3a\xde\xf3\x38\xc4\x7b\x68\xe4\x04\xcb\xb9\xb9\xde\x74\x04\x8b\x12\xce\xc5\xf5\x85\xb9\x2e\x03\xa8\x9e\x90\xc8\xd5\x53\xe3\xb9\x76\x69\x22\x6c\xc0\xe3\x58\x27\x0c\xb7\x1d\xdd\x76\xaa\x86\x85\xee\x86\x4d\x48\xc2\x18\xcc\x1c\x8b\x4c\x3b\x41\x58\x38\x96\x09\x69\xe0\x3c\x93\x22\xe3\xc6\xaa\x29\x61\x0a\xfc\xf6\x8f\x84\x65\xd0\xdd\xd4\xc0\xc8\x2f\xa3\xdd\xdd\x70\xf3\x85\x9b\x4e\x6c\x5a\x1a\x8d\xb7\x07\x28\x18\xed\x0e\xd0\xe6\x70\x80\x86\x7f\xd9\xd9\xf5\x52\xd3\x2f\xd1\xe6\x26\x19\x93\x36\xb0\x56\xcf\x52\x04\x21\x4d\x88\xc5\x4c\xe5\x76\x3c\x15\x32\x21\x16\x2d\x5c\x70\x70\xa6\x94\x64\x33\xf0\x27\x34\x21\x8b\x72\x78\xc1\xd0\x9d\xb8\x84\xf2\xb2\xc1\x15\xab\x58\x9c\x37\x5d\x61\x66\x21\x96\xa0\x62\xc1\xa2\xb7\xe4\x34\x47\xf0\xb5\x3d\x8e\x88\x3c\x83\x2a\x10\x5b\xd0\xb8\x16\x76\x5b\x0d\xd8\xbd\x58\x81\xba\x51\x37\xea\xb6\x9d\xac\xa0\x41\xce\x09\x6b\x62\xa2\x0b\x8d\x24\x4d\x29\x9f\x9d\xe4\x58\x0b\xba\xe4\x3e\x12\x6a\xdb\x9d\xca\x33\x37\xfe\x34\x79\xd3\x62\x75\xe0\x76\x99\x97\x73\x50\x09\xaf\x06\xcb\x95\x49\xc2\x67\x2b\x94\x8d\x6a\x65\x2d\x64\x25\x64\x61\xb2\xc6\x7b\x41\xb9\x56\xfe\xe4\x63\xe3\x0d\xdb\x70\x54\x04\x61\x28\x38\x87\x50\x83\x93\xe2\x4d\x9f\x13\xf3\xe4\x86\xf3\x52\xa1\xf4\x94\x2e\xfc\x18\x2d\x84\x87\x82\xeb\x63\x7a\x69\x55\x6e\x0d\xff\xcf\x69\x97\xd0\xbe\xc7\xca\xae\xbd\xc5\x0e\xff\x88\xa4\x4b\xdc\x3e\x95\x22\xb1\x31\x90\x31\xe6\x3b\x48\xe7\x86\xe3\x77\xcf\xf7\x1b\x0d\xa2\xba\x61\x89\xef\x54\x4a\xe4\x19\xa3\xbc\x11\x4a\x53\xca\x98\xcf\x46\xc6\xc1\x00\x05\xc1\xce\x00\x05\x3b\xbb\x26\xec\x83\x1d\x2f\xec\xa7\xe6\x39\xed\x48\x31\x9a\x5d\x3d\xb9\x9a\xd1\x70\x80\x82\xdd\xb1\xa7\xc0\x0d\xc6\x16\xda\x2d\x0d\x39\x10\x2c\x4b\x78\x23\x63\x12\x39\x03\xbd\xc4\x71\xb0\x48\xed\xb3\x55\x96\x3c\x9b\x03\x8f\x84\xdc\xab\x0a\xf5\xe4\xdf\x22\x33\x15\x76\x32\xa5\x0c\x26\xa1\xc8\xb8\xfe\x32\x07\x29\xc9\xe5\x25\xe1\x62\x12\xe6\x95\xe7\xb7\xff\x7e\xc2\x4f\xe7\x97\xc5\xd5\x27\x3c\x40\x6e\x83\x53\x9e\x3e\xe1\x81\x01\xb4\x11\x7f\x20\xc9\xdf\x72\xdd\x1f\x8e\x3e\xe1\xab\x5f\xfd\x99\xa9\x53\x9a\xa6\x09\x4c\x14\x48\x0a\xca\xef\x52\xc6\xf3\x21\x09\xb5\x75\x5e\xe0\x35\x33\x98\x01\x8f\x0e\x2b\x3d\xfe\xcd\x12\xa6\x96\xa2\xe1\xfd\x65\x33\x5f\x25\x31\xd5\x70\x69\x59\x97\x3e\xec\x1f\xa1\x62\x14\xe8\x90\x32\x50\xe8\x4f\x05\x1d\xcc\x48\x51\x3e\x63\xa0\x34\xa9\x43\x37\x0f\x5b\x17\xf0\x3b\x2e\xe0\x6d\xeb\x72\xc0\x0b\xc3\x25\xf1\x6f\x6b\x62\xbd\xcc\x12\x2b\xe1\x6e\x3b\xbe\x2b\xd2\x4e\x98\x49\x69\x98\xc8\xa3\xae\xb4\xde\xc0\x6a\x64\x46\x10\x9e\x5e\x68\x50\x8f\xa4\xe0\xbe\x58\xaf\xe0\xee\xf6\x05\xb7\x2f\xb8\x7d\xc1\xfd\xe6\x82\xab\x85\x26\x6c\x62\xf2\xd4\x44\xd1\x4b\xe8\xab\x6e\x5d\x75\x4f\x8c\x6b\x90\xa9\xbd\x7d\xb5\x2d\xda\x1e\x50\xb5\x7d\xe4\xeb\xda\x60\xb4\x5e\x9d\xdd\xe9\xeb\x6c\x5f\x67\xfb\x3a\x7b\xf7\x16\xb6\x87\x8c\xa8\xf8\xfe\x17\x59\x3b\x8c\x7e\x71\xfb\xe0\xcb\x6d\xbf\xb8\xb5\x55\x77\x67\xbd\xaa\x1b\x0c\xfb\xb2\xdb\x97\xdd\xbe\xec\xde\xd1\xe5\xed\x03\xa9\xbd\xf9\x02\x37\xaf\xc0\x8f\xa9\xe6\x12\x46\x89\x3a\x28\x2b\xe4\x97\x1a\x8c\xa7\xf9\xa7\x7c\xbf\x94\x46\x44\xc5\x6f\x81\xcf\x74\xec\x27\x66\x23\x87\xae\xee\xab\xaa\xa2\x89\x40\x2f\x3f\x1b\xc1\x5f\x25\x89\x68\xfe\x31\x7e\xb8\xba\xce\xec\x36\xea\x8c\xb7\x9a\xeb\xfa\x6c\xb9\xdd\x0a\xbc\x98\x46\x11\xf0\xe3\x1c\x9a\xcd\x41\xd8\x22\xb4\x59\xd7\x1a\x8b\x46\xdf\x0c\x32\x9f\x75\x64\x85\xd2\xef\xad\x4d\x35\x09\x59\x74\x74\x4f\x28\xef\x90\x16\x09\xa3\xa1\xc1\x86\x72\x47\x6f\x3b\xef\xd7\x96\x6a\x93\xa6\x5a\xdb\x33\x8c\xf0\x9c\x46\xf9\x8c\x2e\xab\xa8\xad\x1a\xe3\x65\x69\x2c\x52\x7f\xf3\x52\x05\x80\xb7\x95\xaa\x96\x41\x29\xc8\x10\xb8\x26\xb3\x36\x69\x4b\xcd\x93\x0c\x0e\x32\xe5\x72\x89\x5c\xde\x9e\x24\x09\x3c\x02\x09\x36\x09\x4e\x99\x70\x42\x34\x4f\x38\x7f\x37\xc9\x8e\x46\xd0\x18\x93\x4a\x49\x08\x5d\x80\x56\x9a\x84\x67\xad\xa7\x28\x0d\x69\x0a\xd1\xdb\xbc\x90\xf8\x6d\x77\x39\x47\x37\x5f\x41\xa2\xd3\x0b\xf4\xac\xad\x7a\x80\xcc\x0d\x37\xcf\xdf\xed\xe4\xfc\x95\x99\x3d\x4f\xc2\x26\xfd\xa2\x0f\xfb\x47\xd7\xe4\xf9\xd7\x6b\xe7\x79\x6f\x63\x55\x02\x87\x39\xbb\xf0\xc8\x8f\x91\x7f\x80\x59\x81\xdc\xc6\x0d\xc7\x31\x9d\xb6\xe8\x52\xe7\x87\xc8\x63\x83\x23\xa7\x64\x54\xfb\xce\xbe\xb8\x91\x4c\x24\x44\xed\x58\x56\x42\xea\x46\x96\xb2\x61\x3c\x29\x0b\x0f\xe5\x11\x9d\xd3\x28\x23\x55\xca\xbf\x6a\x15\x27\xbb\xdd\xad\x36\x60\x41\x16\xb4\x11\x8a\xa7\x59\x78\x96\xe3\xd3\x1d\x8e\xb3\x0f\xc8\x8c\xb8\x63\x37\x5f\xa3\x77\x77\x3e\xaa\xf2\x4e\x47\x90\x5f\x90\xc5\x32\xaa\xbd\x64\xfd\x93\x63\xc4\x2c\x50\x5a\x76\x98\x06\x31\x7b\x4d\x14\xb4\x40\x95\x67\xd7\x56\xf7\x3c\xbd\xe2\xa1\xaf\xdd\x19\xce\x4a\x1e\x5f\x5b\xaa\x62\x33\x67\x3f\xd0\xcc\x96\xb8\xd3\xce\x16\xfa\x2f\xda\xd3\x4e\x18\x9d\x75\xd5\x15\x2b\x7f\x0b\xf3\xca\xe8\x27\xae\xd2\x47\xc8\x12\x5a\x2f\x81\x6f\x42\x13\xb6\x7a\x9a\xd0\xd3\x84\x7b\x4b\x13\xda\x4b\xb9\x7b\x40\x14\xac\xd1\x77\x9c\x2a\xf8\x2f\x76\x7b\xb2\xd0\x93\x85\x9e\x2c\xfc\x30\xb2\xd0\xfd\x5d\xfb\x16\xde\x33\x04\x2f\x6e\xc0\x20\x82\xd1\xf7\xa4\x10\x31\x8d\xe0\x9f\x20\xc5\x75\xe4\xa2\x29\xec\xb9\xc5\xfd\xe2\x16\x8d\x1f\xd4\xac\x49\x2d\xae\xa5\x15\xa1\x48\x52\x12\x1a\x27\xfe\xc8\x7d\xc7\x68\x03\xdd\xb6\x05\x62\x3a\x55\xa0\xd1\xd3\xc9\xa4\x24\x1e\xe8\xf6\xc8\xcc\x41\x35\x66\x74\x60\xc6\xbc\xc6\xfb\xed\x6b\x8a\xce\xca\x09\x86\x45\x4c\x32\xa5\xe9\x1c\x7e\x96\xa7\x97\x4d\xf6\xcf\xb7\xe6\x4e\xcd\xfb\xef\xd5\xf0\xd1\x9a\x10\xb8\x2b\x7c\xd6\x7d\xf5\x55\x9b\xae\xd0\x06\xda\x67\x0c\xbd\x13\x91\xfb\x11\xbb\xe7\xb7\xeb\xf3\xdb\x2e\xee\x82\x7e\x32\x9d\xbc\xe7\xac\xd7\xfd\xb4\xda\xd3\xde\xdb\x78\x71\x76\x33\xde\x3b\xee\x79\x6f\x25\xed\x79\xef\x83\xe0\xbd\xad\xf7\x69\xb7\xc0\x7c\xdb\x36\xdc\x29\x0e\xf4\xa0\xb8\x6f\xdb\xd7\xb7\xcb\x7e\xef\xf8\xdc\xdf\x5f\xfe\xeb\xbf\xcf\xed\x19\x70\xcf\x80\x6f\xc3\xcc\xdb\x67\xc0\xd5\xa9\x2a\x12\xa6\x26\xf8\xcc\xb0\xc7\xc3\x1c\xf9\x58\x85\x31\x24\xe4\x23\x48\x95\x1f\xb2\x33\x1a\xe5\x62\x7d\xc1\x8a\x33\x5a\x64\x7e\x80\x8c\x7f\x12\x8c\x7f\x0a\x4c\xae\x5e\x43\x92\x32\xa2\x29\x9f\xad\x73\x36\x12\x61\xac\xdc\x09\xdd\x40\x6b\xcd\x17\xbd\x39\x53\xc0\xf2\xbd\xb2\x2d\x07\xb8\x9b\xf1\xdc\xdf\x43\x21\xe4\xee\xc6\xeb\x2c\x4e\x6b\x1d\xc6\x14\xc1\x94\x72\x5a\x9e\x6f\x64\xe1\x31\xc9\x83\xa7\xe3\xab\x9b\xb7\x91\xb4\x38\xae\xc9\x4d\x13\xc5\x19\x3c\xfb\x96\xf2\x37\x22\xb2\x44\x1e\xfe\x58\xa9\x45\x45\xd1\x70\xb5\x26\x19\xd3\xb4\x03\x07\xd5\x7e\xe8\xb2\x00\xb9\x37\xd5\xf4\xb3\x4e\xa2\x08\xe1\xff\x64\x20\x2f\xbe\x76\x58\x35\x90\x02\x4f\x3a\x83\x45\xa3\xde\x60\x75\x46\xd3\x3f\x25\x3b\xbe\xe0\x61\x17\x79\x6f\xa7\x51\x4d\x66\x16\x17\xea\x1f\xa5\x65\xd8\x6f\x6d\x0d\xc1\xc8\xba\x3b\x17\x79\x36\x1f\xa3\xd3\x90\x29\x38\xc9\x15\x75\x6e\xf1\xbd\x31\x4a\xbf\x2b\xb0\x1a\x84\xa4\x98\x87\x75\xbf\x24\x5f\x55\xd4\xe3\xab\x00\xd9\x9c\x20\xca\x23\x3b\xa7\x1b\x41\x17\x4c\x0f\x4a\xeb\x6e\x82\x52\x87\x0d\x7d\x03\x4e\x7f\x88\x97\xbe\x37\xbe\x83\x1e\xdf\xdf\x7b\xe6\x96\x30\xeb\xab\x01\x9a\xe7\xf5\xac\x0b\xfa\xa3\xef\x0d\xfd\xb2\x76\x7e\x0d\xf4\xcb\x9b\x3b\xee\xfd\xa9\xf8\xff\x7a\x2f\x3e\xd0\xd4\x6f\xff\xd6\x47\x1a\xea\xfc\x24\xbc\x82\xc4\x54\x3f\x78\x11\xe7\x1b\xe3\x61\x52\xa8\x2b\x7f\xd4\x22\xce\xb1\x77\x5f\x4a\xc3\x33\xfb\x9e\xa5\xb8\xbb\xf0\x58\xb5\x96\x73\x69\x35\xde\x72\x16\x20\xc1\xd0\xb9\x18\xbb\x17\x41\x52\xff\xbf\xe5\xfc\x1f\xb8\x17\xb5\x65\xa6\xc5\x59\x57\x8c\x9c\xff\x83\xe2\xd4\xbe\xcf\xe5\x18\xcc\xfa\xd1\x81\xdc\xca\xa7\xb8\x8a\x5f\xb8\x8a\xdd\xa7\x8c\x36\xdd\x0b\xe7\x57\x1a\xdb\x91\x6b\x6f\x69\x8b\xe7\xbe\x4b\xc1\xa1\x9a\xcd\x7a\x31\x57\xc7\x5b\xe7\x61\x7f\xfe\xe1\x8b\x38\xb3\x6f\x2b\x71\x28\xe2\x0d\xc8\xbb\x6f\x98\x7c\xb5\xa1\xf2\xee\x79\xa7\x39\x91\x94\x9c\x32\x50\x6d\xba\x5a\x9b\x34\xaf\x99\xf1\x93\xab\xff\x05\x00\x00\xff\xff\xca\x8f\x92\x7e\x04\x54\x00\x00")
-
-func manifestsDashboardsCoherenceElasticDataSummaryDashboardJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_manifestsDashboardsCoherenceElasticDataSummaryDashboardJson,
-		"manifests/dashboards/coherence/elastic-data-summary-dashboard.json",
-	)
-}
-
-func manifestsDashboardsCoherenceElasticDataSummaryDashboardJson() (*asset, error) {
-	bytes, err := manifestsDashboardsCoherenceElasticDataSummaryDashboardJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "manifests/dashboards/coherence/elastic-data-summary-dashboard.json", size: 21508, mode: os.FileMode(420), modTime: time.Unix(1647457998, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _manifestsDashboardsCoherenceFederationDetailsDashboardJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x5d\xfb\x53\x1b\x39\xf2\xff\x9d\xbf\x42\xa5\x6f\xbe\xbb\x9b\x2b\x7b\x63\x1b\x08\x09\x95\xdd\x2b\x02\x79\xd5\x85\x24\x07\x49\xae\x72\x97\x94\x4b\xcc\x34\xb6\x8a\x19\x69\x4e\x92\x0d\x84\xf2\xfe\xed\x57\xd2\xbc\x34\x0f\x83\x1f\xe3\x60\x88\x7e\xa0\xf0\x48\x1a\x4d\x4b\x9f\xee\x56\xab\xd5\xd3\x73\xb5\x81\x10\x26\x8c\x71\x45\x14\xe5\x4c\xe2\x5d\xa4\x8b\x10\xc2\x01\x95\x0a\xef\xa2\xff\x98\x2b\x94\x94\x9a\x9a\x93\x11\x0d\xd4\x1b\x86\x77\x51\xb7\x95\x97\xfa\x44\x11\xc9\x47\xc2\x03\xbc\x8b\xf0\x07\xc1\x43\x50\x43\x18\x49\x6c\xb5\x01\x46\x4e\x02\x5d\xaf\xc4\x08\xac\xf2\x21\xf5\x6b\x4a\xa9\xc7\xd9\x3e\x0f\xb8\xd0\x1d\x8a\xc1\x09\xf9\xad\xd3\x42\xbd\x6e\xb7\x85\x7a\xdb\xdb\x2d\xd4\x7d\x68\x77\xcd\x48\x68\x1e\xbc\x97\x8f\x05\xfd\x82\xf6\x02\x10\xaa\x40\x82\xba\x8c\x4c\x3b\x9f\xc8\xe1\x09\x27\xc2\xc7\x49\xdd\xc4\xfc\xff\xb6\x81\xd0\x44\x37\xc7\x3e\x48\x4f\xd0\x48\xf7\xa4\xdb\x9b\x3e\x30\xf8\x54\x95\x86\x80\x07\x0c\xd4\x1b\x1f\xef\x22\x36\x0a\x82\xb8\x44\x90\x68\xf8\x91\xf3\x40\xd1\x08\xef\xa2\x8e\x29\xa4\xba\xc9\x66\x37\xfe\xad\x40\x90\xa4\xe7\xee\xf6\xd3\x5e\xf7\xc9\x56\x67\x6b\x73\xe7\xe9\x63\x53\x1b\x50\x76\x26\xb3\xa9\x4f\x27\xde\x4c\x47\x91\xf2\x56\x56\xc5\xbc\x60\xe4\xc3\x67\x22\x64\x69\x1a\xf1\x19\x40\xf4\x91\x86\xe5\xe9\xc5\x8a\x0c\xcc\x33\xbe\xe5\x25\x54\x99\x81\xe1\xe7\xc4\x3b\x43\x1f\x39\x7a\x09\x7e\x42\x26\x3a\x1e\x85\x21\x11\x97\xf9\x13\x55\x36\x3a\x6c\x15\x26\x73\xab\x07\x90\x97\x8e\x44\xa0\x0b\x1f\xf9\x8f\x3c\x3e\x6c\x9f\x66\x9d\xb6\x65\xd2\xa9\x69\x38\x69\xdd\xe6\x68\x15\x47\x87\x84\x32\x74\x50\x7d\xda\x2c\x83\x0a\x09\x65\xfa\x07\x08\x60\x1e\xb4\x33\x9a\x4d\x45\xfd\xf8\x88\x3c\x10\x3c\xf2\xf9\x39\x2b\xd3\x9a\x8e\x1c\x2e\x14\x08\x46\x02\x54\x7c\xf2\x12\xa3\xb7\x85\xb5\xc4\xfd\x35\x13\xb3\x37\x26\x34\xd0\xcc\x9e\xcf\x8a\xac\x4e\x8b\x9f\xd7\x6d\xa4\x62\x64\x3a\xc3\x11\x61\x10\xd4\xf0\xb1\xc7\x99\x02\xa6\x75\x0b\x7e\xe6\x01\x53\x20\xfe\x7c\x36\xdc\xfc\xd3\xe2\xb6\x03\x50\x84\x06\x12\xb5\xd1\x83\x63\x10\x63\xea\xc1\x3b\x12\x42\x0b\x1d\x80\x54\x94\xd1\xb8\x51\x1b\x3d\x88\xaf\xcd\xe5\xb3\x47\xc3\xcd\x3f\x9f\x3d\x4a\xfa\xcb\xc9\xbc\x51\x2b\xe1\x81\xa0\xfe\x07\x9e\x2b\xbe\x58\x21\xe1\x5d\xd4\xb3\xb4\xc6\xb9\xbe\xde\xb2\x0a\x2e\x52\xb9\x4e\xae\x2f\xf5\x75\xaa\x49\x72\xac\x7c\xbb\x9f\x5c\xb0\xf3\xd9\x0e\xb9\xd1\x7c\x78\xa8\xc2\x00\x57\x31\xa8\xce\xb7\x82\x0b\x55\xcf\x51\x1e\x0f\x46\x21\x2b\xf5\x7f\xf3\xf8\x4f\x39\x53\xc7\xf4\xbb\xa9\xef\x76\x3a\xff\x3f\xc3\xcc\x3c\x9e\x77\x66\x7a\xf5\x33\xb3\x75\xdd\xcc\x44\x64\x00\x09\x5d\xa9\x62\x35\xe5\xd2\x13\x3c\x08\xca\x2c\x2e\x87\xfc\xfc\x35\x10\x1f\x44\xa5\x86\x0b\x55\x1c\x82\xc7\xf5\xed\x4f\xec\xb5\x0b\xa4\x97\xdc\x57\xa1\x54\xaa\xcb\x00\x8a\xe2\x93\xf7\xa5\x05\x39\xa0\x44\x57\x63\x23\x79\xad\x52\xd5\xc0\x88\x32\x19\x29\x5e\xac\xf2\x89\x82\x97\x5c\x84\xc4\xc8\xc1\x97\x2f\x5f\xbe\xb4\x0f\x0f\xdb\x07\x07\xe8\xf5\xeb\xdd\x30\xdc\x95\xb2\xd8\x3c\x22\x4a\x2b\x83\xfa\xc7\xa4\xac\x31\xa4\xbe\x0f\x0c\x67\x55\x93\xd6\x0d\x14\x5b\xf2\x33\x33\xe1\x9e\x5e\x90\x0f\x63\xae\xb5\x71\xc9\x2b\x8b\x73\x65\xca\xcd\xe2\xdd\xdb\xda\x6e\xa1\xed\xad\xf8\xaf\xf3\xfb\xd3\x87\x85\x8e\xb3\x56\x9b\x3b\x2d\xd4\xed\x3d\x6d\xa1\xad\x8e\x6e\xf6\x64\x4a\xbb\xed\x4e\x0b\x75\x77\x7a\x2d\xa4\x7b\xed\xfc\xfe\x74\xe7\x21\xb6\x5a\x7d\x5b\x66\xb2\x7d\xf0\x68\x48\x8c\xea\xea\x15\x2a\x42\x12\x45\x94\x0d\x3e\xc6\xf3\xdd\x9d\x86\x90\x31\x45\x8a\x08\x0d\x05\xc8\x21\x0f\xfc\x22\x8f\xd7\xa1\x57\xa8\x1b\x31\x6a\x48\x96\x43\xcd\xc4\xb3\x03\x9b\x68\x4d\x07\xea\x0c\xa0\x9a\xd5\xb5\x6c\x7b\x26\xe5\xb9\x0d\x87\x0f\x04\x0d\x02\xa4\xd7\x6b\x6d\x2c\xc8\x78\x82\x91\x9f\xac\x54\xa7\x5c\xa0\x07\x57\xfd\xbe\x07\x41\x30\xc1\x95\x7e\x3e\x15\xac\x85\xe4\xe6\x76\x72\xf3\xdf\xc7\x44\xb4\xad\x75\xee\x8f\xac\xa3\x5f\x74\xcd\x7e\x30\x92\x0a\x44\x5c\x63\x5d\xe0\x45\x58\x33\xb3\x51\xfa\xb2\x8e\x45\x66\xe1\x53\xa9\x04\x65\x83\x66\xf8\x74\x7f\x24\x04\x30\x85\x0e\x88\x22\xe8\x88\x28\xc7\xb1\x76\xf3\x25\xd5\xd0\x67\x12\x8c\x00\xfd\xdf\xde\xfc\x10\xb3\x51\x78\x02\xa2\x1e\xe2\xc3\x13\xaa\xe4\x1c\x10\x1b\x2a\x1d\xaa\x8d\xa1\x1a\x11\xa1\xa8\x47\x23\xc2\x54\x5f\x55\xe6\x76\x59\x74\xe7\x15\xe0\xe7\x97\x0a\x24\x3a\xd6\xe6\xbc\xc3\xb8\x0e\xe3\xee\x12\x92\xfb\xbc\x59\x6c\x7d\xf0\x4e\x34\x5c\x73\xc0\xfb\x82\x29\x41\x1d\xc0\xab\x02\x78\xff\x76\x85\xf7\x08\x3c\x2e\x7c\x87\xee\x8a\xd0\x3d\xb8\x5d\x74\x0f\x41\x4a\x32\x70\xc2\xbb\x2a\x78\x5f\xdc\x2e\xbc\x6f\x89\x54\xe8\x08\xa2\x80\x7a\x44\x01\xda\x0b\x02\x34\x97\x03\xc2\x01\x3d\x2b\xd0\x2f\x9b\x05\x3a\x9c\x67\x01\x3e\x56\x44\x8d\xa4\x03\xb5\x16\xd4\xce\x12\xa0\xbe\x6a\x76\xe3\x1b\x81\xf0\x2a\x6a\x76\xac\x1f\x75\x48\xa2\xea\x9c\x5f\x15\xae\x50\xe2\xd5\xdd\x45\xf8\xfd\x3f\x4a\xf3\x99\x76\xa3\x2b\x3b\xb8\x50\x35\x69\xcd\xd6\xe7\xbf\xf6\x8e\xde\xbd\x79\xf7\xea\x9a\x8e\xbb\x8b\x75\xfc\xe2\xe8\xe8\xfd\xd1\x35\xdd\xf6\x4a\xdd\xda\x2c\x30\xbb\x08\xbc\xe3\x3e\xa0\x37\xfe\xfd\x96\x81\x69\x4c\x6d\x7b\x15\xb9\x0f\x7d\xea\xaf\x76\xd5\xd9\x28\x51\x87\x15\x11\x03\x50\xd7\xb8\xbf\xe1\x22\x32\xe7\xb3\x72\x14\xfe\x36\x06\xe6\x73\xb1\x9b\x3b\x99\xf2\xc3\xbe\xbe\x9f\xfb\x9a\xfb\x8a\x2b\x12\xf4\xcd\x66\xa4\x2f\x81\x29\x74\x35\x06\x21\xc8\xf7\xef\x84\xf1\xbe\x17\xbb\xb8\xfe\xf8\xeb\x2b\x7e\x30\xfe\x9e\x5c\x7d\xc5\x2d\x64\x57\x58\x7e\xb0\xaf\xb8\xc5\x48\x08\xa6\xd8\x72\x68\x7f\xc5\xad\x8a\xb3\xeb\x8f\xbf\x7e\xb5\x0f\x94\x7e\x9d\x3c\x3c\xb9\x44\xbf\x55\x9f\xdd\x42\x95\x5b\x5b\x88\x99\x23\xa8\xf2\xf6\xbb\x85\x12\x5c\x8a\x4c\x80\x4f\x33\x8d\x16\x9f\x17\x17\x2a\x29\x93\x8a\x98\x13\xb0\x8a\xd7\x91\x32\x05\x62\x4c\x82\x97\xc4\x53\xe6\xe0\xbb\xa8\xc8\x02\x18\x00\xf3\x73\x75\x59\xec\x57\xc0\xa9\x39\x88\xc6\xcf\x6f\x5e\x62\x16\xc7\x0d\xe2\x4d\xa1\x43\x6e\x15\xc8\xed\xaf\x12\xb9\x50\x0e\xee\x07\x6a\x6f\xd6\x0b\xb4\x17\xab\x04\x4d\xc4\xbb\xf4\xfb\x01\xdc\xba\x89\xdb\xc1\x6a\x90\x13\xe9\xbe\xac\x4f\x82\x20\xc1\x51\xd1\x10\xfa\x21\x0d\x02\x2a\x1d\x8a\xcd\xa2\xf8\x72\x76\x14\xc9\x78\x30\x33\x8a\xd2\x6c\xbe\x1c\x58\xcd\x82\xf5\xea\x5a\x73\x33\x0d\x7c\xb1\x66\xcb\x0e\x3a\x12\x84\x49\x4d\x7e\x95\xf8\x3c\x40\xc6\x14\x6f\xa0\xba\x98\x2b\xbd\xa7\xd8\x4f\xb7\x00\x57\x79\x8c\xc7\x49\x4d\x30\x95\x4f\xe4\xf0\x2d\xb0\x81\x1a\xea\x91\x76\x0a\xe5\x26\x1e\xe4\x94\x04\xd2\x6e\x7e\x53\xa0\x4d\x5d\x40\x61\x8c\x07\x35\xf1\x2c\xdd\x42\xc1\x2b\x41\x7c\x1a\x87\x49\xe5\xcf\x9e\x1a\x91\x63\x07\xb3\x9c\xeb\xbe\xec\xe0\xa5\xba\x88\x9c\x27\x1b\x25\x79\x49\xe2\x0f\x8e\x41\xdb\x76\x95\xd1\x99\x70\x9d\x9d\xfc\x32\xe1\x84\xe2\x38\x62\x06\x28\x12\x47\xc6\x83\x72\x67\x7a\x1b\x16\x9f\xfb\x56\x43\x3e\x43\x72\x51\xd3\x3c\xa4\xac\xa6\x54\x0e\xf9\x79\xb5\x07\xa3\x6c\x6b\x5a\x9b\x5d\xa9\x9c\x16\xe4\x13\x50\x56\x33\x6a\x5d\x7a\x4e\xfd\x98\x03\xec\xd2\x72\xac\x92\xde\x67\x7e\xe0\x94\xa9\x64\xdf\x69\x0a\xf2\x89\xe1\x51\x31\xb6\x36\x63\x98\xb7\x59\x57\x15\x8a\x12\x8f\x02\x19\x40\x85\xac\x48\x3f\x49\xb3\xc7\x48\xdf\xbb\x5d\x2c\xaf\x8e\x42\x00\xf3\x41\x98\xc0\x28\x7c\x1a\xf0\xdc\x49\x81\xa5\x01\xfb\xbd\x56\x32\xd4\x87\xd2\x98\x64\x44\x3c\xa8\x13\x00\xa9\x88\x77\x56\x79\x8a\x54\x10\x45\xe0\xbf\xa5\xac\x4a\xf0\xcc\x9b\xc8\x3b\xb7\x81\x44\xa8\x8d\xee\x1e\xd1\xfc\xf4\x54\x82\x42\x0f\xfa\xfd\x54\x90\xd1\xd4\x15\x42\x5b\x2d\x31\x9f\x94\xd7\x89\x1a\x15\x80\x16\x58\x26\xde\x71\x1f\x76\xd1\xd5\x55\xb2\x56\x4d\x26\x53\xd6\x8d\xbd\x6b\xd7\x8d\x7a\x3f\x88\x21\xff\xa5\xe0\x61\x39\x88\x50\x97\x1f\xc1\x20\x91\xca\xd2\x0d\xc7\x43\x7a\xaa\xaa\x77\xa4\x2b\x13\x51\xc4\x9c\xa9\x20\x14\x81\x40\x87\x50\xf0\xae\x58\x81\xd1\x57\xb6\xaa\x22\x02\xfc\xaa\xb2\x4a\x62\x13\x3b\x65\x3d\xd5\x4f\x17\x33\xca\x7c\x3a\xa6\xfe\x88\x04\xb8\xa2\x21\xd2\x36\x26\xda\x3c\x27\xe0\x82\x5c\xd0\x92\xaa\x39\x19\x79\x67\xb1\xfc\x15\x1d\x62\x59\xf4\xa9\x2a\x9e\x1c\xa4\xc1\xf4\xa5\xd6\xf5\x0a\x37\x53\xac\x35\x4a\xec\x92\x5c\x5c\x17\x3a\x69\xb9\xbd\x2a\x9e\xba\x9c\x05\xb3\x73\xeb\x22\x1b\x91\x13\xa8\x61\xbe\x80\x0f\x9e\x13\x59\xf5\x00\xc7\x0b\x4b\xe5\x29\xf1\xca\x82\x3b\xc5\x4e\xac\x81\xde\x68\x58\xe6\x74\xc6\xbe\xb4\x5a\x22\x2b\xcf\x5d\x88\xcc\x4a\x71\x2d\x9d\x15\xd9\xb8\xac\x32\x44\xea\x3e\x2d\x2f\x92\xa6\xfc\x2d\x8c\x33\xa2\x37\xec\x4e\xd7\xcb\x9c\xfa\x11\x56\x53\xa1\x60\x51\xb3\xa9\xfb\xd8\xd9\x4d\xce\x6e\x5a\x23\xbb\x69\x2d\x1c\xb8\x73\x1b\x4e\xeb\x41\xb5\x33\x9d\x96\x30\x9d\xec\x70\x32\x67\x3c\x2d\x69\x3c\xcd\x64\x78\x38\xeb\xc8\x59\x47\x79\xc1\x8a\x7d\x4a\xdd\xc7\x8b\x58\x47\xce\xab\x84\x9c\x75\xb4\x46\xd6\xd1\x5a\x9c\xb7\xcd\xef\x57\x5a\x0f\xb2\xab\xe6\x91\xb3\x8e\xf2\x0a\x67\x1d\x39\xeb\xc8\x59\x47\x68\x16\xeb\xa8\x6c\xef\x34\x7e\x16\x57\x0d\xb8\xbb\x1d\x8f\xd2\x62\x46\x53\x6f\xf5\x46\xd3\x90\xfa\xf0\x6f\x10\x7c\xfd\xcc\xa9\x82\xe5\xec\xac\xa9\xb5\xb6\xa6\x40\x08\x2e\xfa\x02\x64\xc4\x99\x84\x5b\x0b\xa6\x40\xed\xbb\x49\xf6\x5a\xb9\x9b\x5e\xe8\x49\x41\x47\xe9\xa4\xa0\x45\x0c\xac\x29\x2b\xe1\x72\x31\x8d\x23\x46\xbc\x33\xf0\x6f\x2d\x52\x07\x4d\x0b\xd5\xb9\x31\xfe\x66\xe5\x98\x25\x6f\x81\xa1\x4f\xf1\x14\xcd\x8c\xd9\xf3\x75\x31\x8a\x35\xcf\x49\x44\x98\x8f\x3e\xb1\xf6\x9e\x19\x43\xfa\x66\x9b\xb3\x8c\x97\x3c\x74\xed\x4c\x61\xcb\x35\x36\x99\x97\xa3\xfe\x7e\xda\xd2\x8b\x27\xd4\x7a\x2f\xe8\x80\xae\x63\x2a\xad\xde\x56\x85\xad\x63\xc3\x77\xf3\x3a\x13\xef\x27\x49\xa6\x55\xdd\x31\x98\xb9\x79\x72\xdd\xd4\xac\x3a\x9b\xd6\x8e\x45\xe4\x4f\x9a\x4d\x2b\x11\xa6\xc4\xa8\xb8\xdf\xef\xab\xdd\x85\x84\x5a\x4d\x26\x2a\x72\x09\xb5\x1a\x07\x75\xcd\x52\x51\x1d\x58\xd1\xd9\x0e\xe5\xc6\x50\xbe\x7d\xd1\xfd\x90\xbf\x15\x81\x5c\x32\xaa\x66\xd1\x5d\x3e\x19\x55\x93\x48\xc7\xc9\xa8\x8e\xc0\x03\x3a\x86\x7b\xfe\xc2\xf8\xad\x24\x4d\x68\x38\x95\xdc\x12\x09\xa9\x1c\xc8\x2b\x03\xb9\xe1\xac\x63\x8b\x26\xa5\x72\x08\xaf\x0c\xe1\x5b\x4e\x3b\x96\x25\xa6\xd2\x10\x8f\xa9\x83\x78\x05\x10\xdf\x72\xee\xb1\x64\x2b\xfc\x41\x70\x0f\xa4\xa4\x6c\x80\x0e\x20\x20\x97\x0b\x01\x9d\xe4\x93\x99\x1d\xeb\x1a\x74\x96\x01\xbb\xca\x3a\xeb\x62\x7d\xcd\x92\x88\xac\x38\xa0\x6e\xa7\xd3\x29\x0f\xb2\xb7\xad\x0b\xa7\x8e\xa9\xf1\x94\x56\xf7\x5b\xda\xa7\x41\x6a\xc1\xf6\xe8\xf7\xbf\x3d\x5a\xad\x78\x6e\x94\x48\x6b\x2e\x8b\x0f\x37\x82\x5d\x78\x95\x51\x24\x0b\xf5\x1d\xca\x4d\xb1\x86\x29\x44\x1a\x3c\x23\x2d\x60\x94\xbe\x35\xe1\x50\x5a\xb3\xbc\x4a\x05\x94\xd2\xe0\x4d\x87\xd2\x9a\xe5\x50\x2a\xa0\x14\xca\x81\x43\xa8\xf1\xb4\x3b\xd5\xc5\x2a\x3d\xaf\x8b\xad\xc8\xe6\xd2\x7f\xac\xf1\x99\xde\xe6\x94\x2f\xe4\x74\xf3\xc8\x31\x77\xa8\xe7\x0e\xf5\xd6\x74\xa7\x7a\x47\x4f\x06\xdc\xa1\x9e\xfb\x4a\x4e\x5e\xe7\x8e\x26\xef\x0b\xaf\xde\x19\x05\xe4\x8e\x26\x7f\x96\xa3\x49\x87\x6c\x73\xc8\x7e\xae\xfa\x83\x7f\xf4\xf7\xf5\x5c\x4e\xf2\x1f\x98\x93\xfc\x26\x30\x3e\xea\xcd\x39\x72\x87\xff\x77\xeb\x3b\x72\x0b\x1c\xfe\xc7\x40\x4f\x7f\x0d\xc0\x61\x7d\x4f\x62\x00\x4a\x2f\x3e\x3b\x5c\x1b\xde\x97\x85\x66\x7e\x0b\xdb\xb2\xd9\xf7\x65\xf1\xcd\x85\x6d\xd9\xb4\xcd\x97\xd9\x99\xc5\x60\x16\xb7\x6c\x0b\xed\xca\xc2\x1a\xae\x68\xda\x8e\xdb\x28\x61\xe5\x4e\xce\xaa\xbe\xe4\x3e\xf5\x5b\x09\x0b\xad\x95\x53\x79\x55\x47\x68\xd6\x1b\x86\x0e\xa9\xc6\x8f\xd1\xaa\x12\x57\x72\xff\x27\x69\x30\xd2\x77\x98\x1a\x3b\x0d\xb8\xe5\x0c\x04\x2b\x48\x36\x70\xf3\x29\xc3\x42\xe9\x2b\xad\xaf\xf4\xbb\xac\x02\x2e\xab\xc0\x6a\xb2\x0a\xb8\x17\xbf\x13\x91\x73\x2f\x7e\x17\xb5\xbf\x7b\xeb\xbb\x46\x91\xb9\xb7\xbe\xe7\xa1\xfe\x9e\xbc\xf5\xbd\x91\x74\xab\x65\x55\x8b\xa1\x1e\xf5\x66\x27\x96\x07\x2c\xbd\x21\x84\xe4\x33\x08\x19\x7f\x5e\xa4\xd7\x8b\x8b\xd5\x65\x2c\x4e\x3e\x11\x67\x71\x4b\x45\x06\x39\x9f\x18\xfb\xe5\x84\x13\xe1\xe3\xac\x7b\x05\x61\x14\x10\xa5\xf7\x4c\x29\xd9\x38\xa0\x52\x59\xcc\x55\x18\x4c\xf0\x39\xf9\xe0\x63\x89\x87\x73\x1b\xa0\x80\xa5\x84\x00\x3c\x65\x44\xb4\x34\x01\xd6\xa7\x25\xdf\x71\x56\x32\x4a\xb3\xaf\x4a\xd6\x5a\xf9\x37\x1b\x5c\x86\x8b\x4e\x29\xa3\xe9\xf7\x57\x0c\x77\xf4\x63\x91\xaa\xd1\xe1\xb6\xea\x36\x56\x4b\x49\x79\x50\xe6\x05\x23\x1f\xf6\x2a\x71\x16\xb6\xd8\x7c\xce\xba\xad\x89\x20\xc0\xe1\x28\x50\xb4\x86\x0f\x12\x95\x80\xb3\xe5\xcb\xbe\x29\xb7\x28\xec\xed\x2e\xfe\xef\x08\xc4\xe5\xbc\xc3\xca\x19\xa9\x5b\x28\x1d\xc0\x45\x49\xe8\xb1\x3c\xa3\xd1\x27\x11\x1c\x5f\x32\xaf\xce\x1e\xab\x2a\x57\x45\x06\x86\x2f\xe4\x3f\x53\xca\x70\xb1\xb6\x32\x04\x5d\x56\xdf\x38\xd1\xbe\xf1\x18\xad\x8a\x91\x84\x8f\x71\x47\x86\xa0\xb2\x4e\x6c\x88\x4b\x2b\x9b\xa0\x94\x49\x3f\x08\x1a\x12\x71\x59\x1b\x1b\x92\xb1\x6b\xa9\xd1\x6a\x98\xb7\x64\x37\x25\x58\xf7\x25\xfd\x0e\x33\xd8\x46\x93\xcc\x38\x9a\x8b\xe9\xcb\x4c\x40\x99\x6f\xf8\xa6\xdd\xad\x13\x85\xfd\x94\xba\x45\x24\xa1\xfe\x40\x7f\x5e\x59\x58\xc9\x2c\x35\x2d\x43\xdd\x9f\x4b\x86\x8e\xc1\xe3\xcc\xbf\x51\x8a\x2a\xcd\x7e\x88\x1c\xfd\x98\x0f\xf9\x4c\x62\x97\xcd\x0a\x65\xcf\xda\xd7\xcc\x23\x75\x53\x6e\x5b\x4e\xea\x6e\x6f\x4e\xdd\x6a\xb7\x94\xa4\x26\xe9\x84\xc0\xdf\x27\xde\x70\x9a\x71\x56\x6a\x74\x8f\xa4\x74\x8a\x97\x60\x52\xe3\x82\x5d\xa1\x24\x27\xee\x07\x54\x5e\x09\x6f\x12\x65\xcb\x6d\x71\xd7\x44\x79\xd1\x89\xbf\xa7\xe2\x6e\xfe\xeb\xed\xff\x24\xde\xac\x51\x03\x70\xb2\x4d\x3b\x8d\x7d\x30\x98\xf1\xf3\xf6\x66\x27\x4c\xba\xc3\x8a\x27\x85\xb8\x70\x5f\x44\xbd\x33\xe3\x1c\x4c\xee\x4e\x66\x2c\x4b\x35\x69\xbb\x13\xf0\xb6\xe5\x78\xe9\x76\xac\x8b\x4d\xfb\xa2\x1b\xe6\xbf\xb7\xad\xdf\x5d\xfb\x22\xa7\x4c\xd7\x58\xfe\x94\x9e\xf5\xbb\xeb\xc7\xda\xe3\x5b\x3a\x06\x1a\x42\xdf\xe2\xd7\x1b\x9f\x62\x77\xfc\xd8\xee\xd8\x7e\x4a\x6f\xcb\xbe\xc8\xc3\x46\xf0\x8e\x6f\xd3\x9b\xd2\x52\x98\xbe\xef\x9c\xe5\x69\xc5\x72\xc7\x55\x6e\xe8\xd6\x24\x60\x3b\xc8\xb6\xdb\xe6\xa6\x91\xf1\xb0\x63\x8f\x0f\xdb\xb9\x30\xa5\x47\xab\x71\x93\x31\x11\x94\x9c\xc4\xe1\xf5\xa5\xcd\x78\x4e\xce\x38\xdb\xf7\x77\x37\x26\xff\x0b\x00\x00\xff\xff\xf1\x68\x69\x23\xd3\x9d\x00\x00")
-
-func manifestsDashboardsCoherenceFederationDetailsDashboardJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_manifestsDashboardsCoherenceFederationDetailsDashboardJson,
-		"manifests/dashboards/coherence/federation-details-dashboard.json",
-	)
-}
-
-func manifestsDashboardsCoherenceFederationDetailsDashboardJson() (*asset, error) {
-	bytes, err := manifestsDashboardsCoherenceFederationDetailsDashboardJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "manifests/dashboards/coherence/federation-details-dashboard.json", size: 40403, mode: os.FileMode(420), modTime: time.Unix(1647457998, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _manifestsDashboardsCoherenceFederationSummaryDashboardJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x5d\x6d\x6f\xdc\xb6\xb2\xfe\xee\x5f\x41\x28\xbd\x45\x73\xb1\x8e\x57\xfb\xe2\xd8\x46\xd2\x0b\xc7\x6e\xda\xa2\x71\x92\x6b\xbb\x39\x08\xda\x60\x41\x4b\xdc\x5d\xc2\x12\xa9\x43\x52\x6b\x3b\x86\xcf\x6f\x3f\x20\xf5\x46\x8a\xda\x37\xaf\x12\x3b\x29\x3f\x04\xb1\x48\x69\x48\xce\x0c\xe7\x79\x38\xa2\xb8\xb7\x5b\x00\x78\x90\x10\x2a\xa0\xc0\x94\x70\xef\x00\xc8\x22\x00\xbc\x08\x73\xe1\x1d\x80\xbf\xd4\x15\xc8\x4b\x55\xcd\x45\x8a\x23\xf1\x3b\xf1\x0e\x80\xdf\xa9\x4a\x43\x28\x20\xa7\x29\x0b\x90\x77\x00\xbc\xf7\x8c\xc6\x48\x4c\x51\xca\x3d\xed\x1e\x44\xe0\x45\x24\xeb\x05\x4b\x91\x56\x3e\xc5\x61\x43\x29\x0e\x28\x39\xa2\x11\x65\x52\x20\x9b\x5c\xc0\x9f\xba\x1d\xd0\xf3\xfd\x0e\xe8\x0d\x87\x1d\xe0\x3f\xd5\x45\x13\x18\xab\x86\x0f\xab\xb1\x80\x1f\xc1\x61\x84\x98\x30\xba\x20\x6e\x12\x75\x5f\x08\xf9\xf4\x82\x42\x16\x7a\x79\xdd\x5d\xc7\x1e\xe9\x26\x63\x42\xd7\x89\xea\x78\x30\x85\x64\x82\xf8\x4f\x33\x44\x42\xca\x0e\x02\x3a\x45\x0c\x91\x00\x8d\x82\x28\xe5\x02\xb1\x11\xc7\x9f\xd1\xed\x0c\x31\x06\x3f\x7f\x86\x84\x16\xe5\x2f\xff\xf3\xb7\xf7\xc3\xec\x73\x7e\xf5\xb7\xd7\x01\x7a\xc5\x51\xf6\xf7\x5b\x18\xa3\xbf\xbd\xbb\xbf\xfc\xf8\x93\xa1\x8d\x5c\xa1\x63\x18\xf1\xc5\x1a\x55\x9a\xdc\xdf\xcd\xfe\x99\x1a\x8d\x70\x8c\xa5\x07\xf8\xdd\x6e\x83\x9e\xcf\xa6\xf4\x0a\xe4\xbd\x00\x67\xf8\x33\x02\x47\xd9\x40\x75\x11\x7c\x4a\xaf\x94\xa3\xe8\x12\x04\x9c\xfc\x81\x6e\xb8\xd2\x4d\xf6\xbc\x67\xd6\xca\xaa\xbf\x3e\xe9\x65\x58\x44\xe8\x35\x65\x31\x94\xfd\xf1\x1a\x5a\x0d\x9f\x35\xd9\x58\xc9\x7a\x28\xf3\x72\xc4\x66\x38\x40\x23\x7a\x45\x50\x38\x4a\x20\x13\x58\x79\xe5\x28\x61\x38\x86\xec\x66\x53\x93\xef\x76\xf9\x83\xd8\xfc\x7d\x31\x12\x70\xce\x20\xe1\x63\xc4\xd6\x32\xb9\x92\xb5\x9e\xbd\x97\xb4\xd8\x68\x6d\xf5\xff\xa7\xad\xdc\xee\x5e\x88\x78\xc0\x70\x22\x85\xc8\x5b\xd5\xe3\x1e\x0a\xb1\xa8\x19\xd7\x9b\x10\x24\x7e\x0f\xbd\x03\x40\xd2\x28\xca\x4a\x18\x4c\xa6\xe7\x94\x46\x02\x27\xc5\xb0\x3c\x2c\x6f\xe9\xf9\xd9\xdf\x02\x31\x98\x4b\xf6\x87\xfb\xbd\xfe\x70\xdf\xdf\x1f\xf8\xbd\xa1\xaa\x8d\x30\xb9\xe4\x65\x1c\x2d\x9c\x4f\x59\xc5\x0c\x43\x9d\xb2\x8a\x04\x51\x1a\xa2\x0f\x90\xf1\x9a\xdb\x79\x97\x08\x25\xe7\x38\xae\xbb\xa3\xad\xc4\x4c\x85\xb2\x81\x57\x30\xb8\x04\x82\x82\x13\x88\x09\x38\xb6\x5b\x2b\xb4\x27\xfb\x59\x95\xa6\x2c\x92\x85\x3b\xe1\x4e\x40\xa7\xdb\x31\xc4\x64\xa7\xf4\xec\xed\xb2\xcf\xaa\x22\x53\x79\x3e\xbd\xca\xf1\x41\x7e\xcc\x68\x12\xd2\x2b\x52\xef\x6b\x31\x72\x74\x2d\x10\x23\x30\x02\x66\xcb\x1b\x8c\x5e\x9f\xd8\xb5\xe0\xde\xa0\x98\xc3\x19\xc4\x91\x34\x7f\xa5\x15\x6e\xab\x25\xac\xea\xb6\x0a\xc7\x52\xc2\xbc\x04\x12\x14\x35\x58\x36\xa0\x44\x20\xa2\x1c\xf7\x45\x80\x88\x40\xec\xe7\x17\xd3\xfe\xcf\xaf\x51\x98\xbb\x09\x38\x4b\x63\x19\x00\xc0\x36\xd0\xe7\xf4\x8b\x9d\x69\xff\xe7\x17\x3b\xf9\x23\x55\x4f\x26\x0c\x87\xef\x69\x05\xcc\xd9\x5c\x97\xee\xa7\xcd\x81\x2b\x79\x3d\xd0\x0a\xae\x6b\x33\xf0\x46\x5e\xd7\x43\x61\xee\xc6\xe5\x65\xe9\xab\x95\xba\x62\xaa\x82\x8a\x37\x15\x71\xe4\xd9\x4a\xb4\x15\x26\xd0\xb5\x68\x76\x89\x80\x46\x69\x4c\x6a\xf2\xc7\x94\x08\x19\xc7\xe5\xa3\x7e\xb7\xfb\x3f\x2b\x8c\x7b\xb7\x36\x6e\xbf\xb7\x64\xdc\xbd\xe6\x71\x0f\x16\x8d\x3b\x81\x13\x94\xf7\xab\x88\x04\xaa\x9c\x07\x8c\x46\x51\xdd\x03\x65\xd0\xfb\x0d\xc1\x10\x31\xab\x86\x32\x61\x0e\x21\xa0\x51\xad\x8f\x32\x38\xe5\xcf\x59\x3d\xe5\xe2\x26\x42\xa6\x77\x57\xb2\xe4\x3c\x8b\x30\x54\x71\x55\x4d\x8c\x4e\xad\x6a\xa2\x66\x1a\x4c\x05\x35\xab\x42\x28\xb4\xf8\xfa\xf1\xe3\xc7\x8f\xdb\x27\x27\xdb\xc7\xc7\xe0\xb7\xdf\x0e\xe2\xf8\x80\x73\xf3\xf6\x04\x0a\x39\x57\x9b\x9b\x29\x0c\x3f\xc5\x61\x88\x88\x57\x56\xdd\x75\x96\xf4\xf8\x18\x71\x81\x49\x16\x39\x57\xed\x78\x20\x81\xec\x24\xf3\x49\xdd\x2e\x55\xa5\xa9\x2b\x55\x9e\x81\xde\x60\xd8\x01\xc3\x41\xf6\xaf\xfb\x6c\xff\xa9\x21\xb8\xbc\xab\xff\xbc\x03\xfc\xde\x7e\x07\x0c\xba\xf2\xb6\xbd\x39\xf7\x0d\xbb\x1d\xe0\x3f\xef\x75\x80\x94\xda\x7d\xb6\xff\xfc\xa9\xa7\xdd\xf5\x69\x13\x65\x87\x28\xc0\x31\x54\x91\xa5\x67\x54\xc4\x30\x49\x30\x99\x9c\x67\xfa\xf6\xe7\x59\xa8\x06\xb0\xd2\x42\x53\x86\xf8\x94\x46\x61\x1d\x68\x35\xeb\x71\xc1\x30\x99\x98\xcf\xa5\x44\x71\x01\xe9\xdd\x4c\xac\x61\xd8\xb3\x8c\xf9\x38\xa3\xae\x60\x54\x05\x7e\x75\x72\x99\x97\x57\xa4\xc3\x3b\x66\x38\x8a\x80\x84\x53\x89\xe5\xe3\x0a\x4b\x42\x24\x20\x8e\x38\x18\x53\x06\xc2\x6a\x42\x81\x1f\x6e\x47\xa3\x00\x45\xd1\xc8\xbf\xf3\x2c\xc1\x7f\x1a\xe8\x5e\x49\xdb\xce\xa5\xfd\xdf\x0c\xb2\xed\xdc\x8a\x12\x9a\x5e\x6a\xd2\x7e\x94\x75\x1a\x6c\xbd\xd4\x31\x4c\x55\x6a\xf3\xba\x7a\xb0\x57\xeb\xc6\x8a\xce\x6c\xd1\xe9\x87\xf5\xec\x13\x14\x5f\x98\xec\xd3\x79\x76\xe5\xd9\xdd\x26\xcf\xae\x2d\x47\x6c\xd7\x9e\xeb\x9e\xf7\x72\x98\x0f\x30\x4a\x11\x78\x72\xb8\xbe\x9f\x90\x54\xda\xb6\x1d\x3f\x51\xbd\x74\x4e\x52\xdd\xbe\x21\xa6\xa9\xd5\x73\x80\x13\x48\xc4\x48\x58\xba\xfd\xda\xd6\x3d\x13\x50\xa4\xdf\x79\x10\xd8\xd0\x60\xf9\x34\x3c\xfa\x12\xe1\xda\xa8\x9a\xc9\x86\x4e\x60\x62\xab\xf0\xd6\xb8\x02\xf9\xca\xe4\x00\x78\xef\xfe\xa8\xa9\xa7\x10\x23\x2b\xbb\x9e\x51\x75\xd7\x59\x4d\xe6\xbf\x0e\x4f\xdf\xfe\xfe\xf6\xd7\x05\x82\xfd\xfb\x09\xfe\xe5\xf4\xf4\xdd\xe9\x02\xb1\xbd\x9a\x58\xdd\xa2\x5b\xf5\x52\x6d\x15\x0c\xd9\x04\x89\x05\xeb\x8a\x32\xbd\x45\x53\x22\xec\xe4\x56\xc5\x19\x46\x1a\xeb\x18\x09\x2a\x60\x34\x8a\xf9\x64\xc4\x11\x11\x60\xc3\x14\xd7\xd3\x8b\x1b\xf0\x93\x2d\xa2\x03\x2c\x56\xd0\x01\x92\xf5\x76\x40\x3d\x4e\x98\x13\xc1\x1b\x97\x11\x2b\xcb\xfb\x18\x95\x98\x70\x01\xd5\xba\xdd\x22\x63\x58\x2e\xc9\x67\x30\x7a\x0d\x03\xa1\xf2\x68\xa6\xdf\x47\x68\x82\x48\x58\x85\x43\x53\x2e\x43\x63\x95\x50\xf2\x0e\x97\x87\x98\x42\xeb\x70\x36\x59\x59\xe7\x5c\x45\xa3\x8d\x75\x0d\xbe\x2f\x65\x1f\x79\x8b\xbc\xbf\x48\x5f\x68\x54\x55\xcf\xfd\xa8\x2c\x23\x65\xb1\xdd\x79\x2d\xd9\x28\x8b\xb7\xc0\x43\xe6\x39\x8c\x82\x05\x89\x8e\xbd\x87\x4c\x74\xf4\x5c\xa2\xe3\x1d\xc3\x13\x4c\x8a\x97\x25\xdf\x37\x6f\x70\xb9\x0e\x67\xd4\xaf\x91\xeb\xa0\xd9\x9c\x9a\x9b\x5f\x70\x69\x8e\x0d\x9c\xfa\x58\x7b\x5b\xe6\x1c\xbb\xba\xfd\x9b\x8f\x56\xef\x2b\xca\x06\x5c\x8e\xe2\xb1\xe5\x28\xda\xb4\xf4\x13\x90\xe7\x2a\xc1\x29\x0a\x10\x9e\x59\x82\x9d\xb1\x1b\xb3\x96\xeb\xe4\x37\x1e\x36\x0b\xf5\x7d\x9b\x73\xde\x64\xd4\x6c\xb0\xf3\xec\x7f\x77\xbe\xac\x09\xb6\x6a\x5d\x6b\x33\x6d\x92\x11\x18\x2d\x63\xc2\xd4\x3c\x45\xe1\x57\x5f\xc9\x3f\xaa\x75\xfb\xe1\x4a\xeb\xf6\x6c\x45\xd5\xe2\x92\x1d\x06\x53\x24\x57\x7c\x34\x15\xf5\x85\xb0\x9a\x21\xaf\x60\x70\x39\x61\x34\x25\x61\xfd\x95\x46\x56\xff\x21\xcf\xc7\x35\x54\xd5\xb6\xa6\x3c\xe9\xed\xef\x07\x83\x5d\x7d\x03\xd3\x0a\xb3\xc8\x7b\x12\x0e\x06\xb0\x0f\xed\xfd\x2c\x95\xb9\xcc\xec\xa8\x37\x81\xe9\x04\x99\x2b\xf2\x18\x5e\x17\x1d\x35\x37\x78\xc5\x98\x14\x15\x7a\xb1\x5c\xed\x37\xec\x28\x2b\xa7\xd9\x1b\x78\x91\x6d\x81\x99\x7b\xc7\x09\x64\x97\xa8\xdc\xc9\x93\xdf\x70\xb7\x3c\xf7\x31\xa8\xe5\x3e\x96\x6d\x6d\xd9\xb3\x44\xab\xcc\x87\xff\xbc\xba\xce\xdd\xb3\x6e\xde\xa6\x3d\x2f\xcd\x18\xa0\x97\x2f\x08\x01\xc5\x66\x39\x95\xa2\x95\x2b\x18\x95\xca\x35\xbc\xbd\xc8\xde\xfa\x4b\xa3\x7d\x21\x8c\x41\x32\x59\x22\xac\xb7\x60\xe2\xc4\xf0\xfa\x18\x0a\xf8\x9e\x62\xa2\xa2\x97\x66\x7d\x4f\x6a\x43\x55\xe4\x10\xe1\x05\x94\x10\x14\x08\xa4\x6d\x12\x93\xf7\x9c\x67\x19\x69\x43\x79\x09\xe5\x62\x8c\xaf\xcd\x9d\x40\x79\xe1\x6b\x2d\xe3\x35\xd4\x13\x5e\x09\x43\xf6\x33\xaa\x6c\xee\x23\x6a\xf8\x56\x96\xdf\xd0\xd4\x98\xd1\x38\x8b\xef\x51\x54\x43\x85\x3c\x95\xfe\x76\xa7\xfe\x56\x90\x96\x0f\x2c\xd0\x1d\x4f\x20\xbb\x8c\x30\xa9\xcd\xa5\x31\x8e\x22\x73\x5b\x65\xdf\xef\x00\xdf\xdf\xeb\x00\x7f\x6f\x5f\xce\x60\x7f\xcf\x98\xc1\xe3\x54\xa5\xd3\xea\x53\x45\x4a\xd6\xe5\x64\x62\x7a\x12\x2b\xf7\xfb\x86\x00\x7d\x36\x5a\xee\xae\x82\xdb\x91\xca\x3c\xd6\xf6\x65\xad\x0a\x59\x3c\x8d\xd7\xcc\xf3\x23\x22\x18\x46\xbc\x9d\x5c\xff\x5c\x10\xc2\xb1\xc2\x2b\x8c\x78\x1d\x8a\xbe\x3c\xde\xe8\x7c\xc2\x6b\xd8\xfe\xf6\x4b\xa6\x01\x70\x86\x88\xb0\x01\x87\x63\x32\x89\x10\x17\x50\xab\x53\xb3\x55\xf7\xf3\x3d\xdd\xcf\x9b\xdf\x66\x19\xe6\xa2\x2a\x47\xf2\x72\x45\x17\x2f\xdf\x13\x2d\xf3\x72\x75\xe3\xdb\x3c\xda\x04\x29\x63\x72\x44\x0e\x2b\xbf\x07\xac\x1c\xac\x88\x95\x7d\x87\x95\x0e\x2b\x1d\x56\x7e\x19\xac\x64\x28\xa0\x2c\xfc\x27\x63\xe5\x69\xa6\x01\x87\x95\x45\x9d\xc3\xca\xc7\x87\x95\x7b\x2b\x62\x65\xd7\x61\xa5\xc3\x4a\x87\x95\x5f\x06\x2b\x5b\xdb\x3f\xf6\x4d\xe2\xe4\x09\xe2\x1c\x4e\xdc\xa2\xd2\x01\xe5\x63\x06\x4a\x6b\xef\xd9\x3c\xa4\x1c\x38\xa4\x74\x48\xe9\x90\xb2\x0d\xa4\x34\x5e\x19\x16\xc9\xd7\xd6\x5e\x1b\x7e\x93\x60\x59\x24\x60\x4f\x73\x2d\x38\xbc\x74\x78\xf9\x38\xf1\x72\x77\x45\xbc\xdc\x73\x78\xe9\xf0\xd2\xe1\x65\xeb\x78\x59\x24\x60\xff\xd9\x78\x59\x24\x61\x1d\x5e\x3a\xbc\x7c\xd4\x78\xd9\x5b\x75\x87\x8f\xef\xf0\xd2\xe1\xa5\xc3\xcb\xd6\xf1\xb2\xd5\x2d\xa9\xdf\x24\x56\x96\x89\xd8\x7f\x1a\x58\xaa\x2d\xe6\x47\x05\xb4\xdd\x56\x2e\x78\xd1\x70\x28\x5b\x08\xf9\xf4\x0d\x22\x13\x31\x35\xdf\x8b\xc9\x72\x64\x41\x87\x9a\x54\x46\xc8\x95\x05\xbf\x32\x18\xe2\xec\xcc\xb4\xee\x72\xe8\xd8\xab\x41\xc7\xd2\xf3\xbf\x7c\xfb\xbb\xd8\xec\x1b\xce\xb3\xcc\xf3\xea\x7d\xcc\x4e\x45\xab\xb6\xc3\x64\xde\x66\x76\x04\xce\x26\x0d\x53\xbd\x50\xab\x75\x5a\x64\x0c\xaf\x1b\x0a\x31\x69\x90\x91\x07\x81\xda\xbd\x6a\x52\x36\xdc\xad\xac\x3a\x17\x7e\x65\xe8\xb1\xc7\x27\x4b\xaf\x70\x98\x59\x6c\x11\x4c\x5a\xc0\x61\x84\x5e\x8f\x26\xe6\x91\xb1\xb9\xdd\x05\x7c\x53\x8a\xb2\x7a\x94\x20\x16\x20\x22\xe0\xc4\x26\x53\x89\x6c\x49\x7a\x42\x2a\x9f\x1d\x9a\xe5\xf6\x28\x18\x22\x21\x62\xea\x43\x64\x6f\x1c\x51\x6d\x06\x66\x01\xe5\x9d\x8c\x59\x38\x44\xb5\x31\xf1\x04\x06\xa8\xc9\x61\xb9\x80\x41\xfd\xf3\x44\x8f\x0b\x94\x24\x28\x7c\x93\x81\x83\xd9\x81\x2f\xf8\x06\xec\xe2\x46\xe4\xfb\x2a\x37\xfe\x18\x60\x1b\x6c\xd2\xfc\xa6\x91\x1f\xd0\xf1\x98\x23\x01\x7e\x18\x8d\x8a\x90\xfe\x14\xdc\x1b\x0d\xec\x50\xbf\x26\x4e\x68\xdf\x6c\x1e\x80\xdb\x5b\xc9\xbd\xee\xee\x36\x06\x0f\xe3\xd8\xcd\x18\xbd\xce\x78\x8a\x41\xa3\x64\xf9\x29\x9a\xe4\xd3\xa5\xf6\xc0\xd9\x14\x8f\x2d\xe2\x55\xc2\xd0\xb9\x34\x09\x78\x25\x4d\x52\x7f\x25\x58\x7e\x33\x7b\xab\x87\x0f\xc8\x50\x68\x07\x90\xfc\xfb\xfc\x6e\x3d\x76\x8c\x0a\x2c\xc3\x24\xc4\x33\x1c\xa6\x30\xb2\x8e\xcc\x2d\xf1\x4e\x9d\x85\x5a\x75\xe0\x1a\x5e\xe3\xda\xf4\xbf\x48\x83\xcb\x6c\x52\x98\x9f\x1a\x95\x07\x49\x0a\xf3\xbb\xfa\x82\xff\xd6\xee\x6e\x0e\x82\x65\xb0\x6b\x08\x2c\x37\xf0\x7a\x11\x67\xd7\x3e\x28\xb2\xbe\x81\xaa\x3c\x30\x44\x81\xf2\x7d\xd3\x25\x22\xb9\x0a\xb2\x7d\x2f\xa2\x93\x57\x90\xdb\x9f\x89\x65\xb1\xde\x6a\x25\x8b\xf6\x5e\xd7\x14\xa2\x0d\x74\xe9\x52\x41\x1b\xc3\x60\xce\x00\x16\xf4\xde\xea\xd0\xbd\xfa\x6f\x15\x37\x0e\xc0\x9a\x2f\x37\xb6\xa7\x14\x5f\xac\xd5\x11\x4d\x95\xbf\x41\xb3\xb2\xd3\x5b\xba\xd0\xef\x8d\xab\x58\xef\x51\xef\x45\x56\x1a\x3f\x73\xd1\x38\xad\x63\x30\x8e\xc1\x6c\xc2\x60\x56\x5e\x35\x66\xcc\xa1\xad\x75\x23\xd8\x06\x0f\xd5\xb2\xcd\x5b\x1e\x90\xb6\x64\x9f\x3b\x7e\x93\x8c\xa5\x61\xed\xec\x58\xcb\xca\xac\x65\x5e\xee\x17\x3c\x26\x5a\xb2\x52\x27\x1d\xfb\x78\x8c\xec\xc3\x3a\x29\xdd\x3e\x22\x7e\x39\xf9\xf0\xf7\x1d\xcf\x70\x3c\xe3\x81\x32\x25\xad\xed\x15\xbe\x57\xa2\xa4\xad\xd6\x5d\x9a\xa4\x2a\xdf\x88\x74\xcc\xdb\x3c\xed\x38\x87\xe3\x1c\x8e\x73\x3c\x0a\xce\x61\x9f\x5a\x7a\x1f\xd2\xd1\x1b\x38\xd2\xe1\x48\xc7\xd7\x4d\x6e\xb4\xf9\x4a\x1c\xac\x93\xdb\x68\xb5\x61\x97\xd9\x68\x8d\x64\xb8\xe4\x86\x23\x1a\x8e\x68\x80\x47\x4a\x34\xac\xe4\x86\xbd\x85\x70\x05\x9e\xe1\x3b\x9e\xe1\x78\xc6\x03\x25\x37\x5a\x3d\x34\xe4\x5e\x09\x8e\x36\x7b\xd0\x94\xe4\x70\x39\x8e\xf5\xe9\xc7\x9c\x83\x54\x1c\xf3\x70\xcc\xc3\x31\x8f\x47\xc1\x3c\xec\x14\xc7\xbd\xa8\x47\xd7\x51\x0f\x47\x3d\xbe\x6e\x8a\xa3\xed\xaf\xe4\xd6\xc9\x72\xb4\xde\xb6\xcb\x74\xb4\x45\x35\x5c\xa2\xc3\xd1\x0d\x47\x37\xc0\x23\xa5\x1b\xf5\x44\x47\x7f\xf7\x1e\x6c\x63\xd7\x91\x0d\x47\x36\x1e\x28\xcf\xd1\xea\x41\xe2\xf7\xca\x73\xb4\xd9\x03\x97\xe7\x00\xad\x90\x8f\x39\x87\xab\x3b\xe2\xe1\x88\x87\x23\x1e\x8f\x82\x78\x58\x79\x8e\x7b\x31\x8f\x5e\xcf\x51\x0f\x47\x3d\xbe\x6e\x9e\xa3\xed\xd3\xf3\xd6\xc9\x73\xb4\xde\xb6\xcb\x73\xb4\x45\x35\x5c\x9e\xc3\xd1\x8d\xef\x88\x6e\x6c\xe5\x62\xe5\xb4\x93\x33\x4a\x8e\xba\xdf\xcd\x66\xbe\xc7\x83\x29\x8a\xe1\x07\xc4\x38\xa6\xa4\x3c\x79\x30\xfb\x1d\x76\xf5\x2d\x34\x64\x97\xd9\x9d\x02\x4e\x2a\xab\x2b\xbe\x71\x41\x21\x0b\xbd\x52\xbc\x40\x71\x12\x41\x81\xc9\xa4\xec\xb6\x17\x61\x2e\x34\x57\x31\x06\x13\x15\x67\x51\xd5\x3c\xb2\x02\x75\xc3\x64\x1c\x45\xd9\x69\x45\x96\x02\xf4\x83\x51\x28\x41\x73\x4e\x46\x69\xfc\x51\x4d\x05\x9f\x9c\xa6\x2c\x50\xf7\xbc\x67\x34\x46\x62\x8a\x52\x3d\x2e\x7a\x21\x1a\x63\x82\x45\xa6\xa0\xcc\x3b\x46\xd9\x04\x69\xf8\x81\x45\xe3\x28\x9f\x29\x0e\xeb\x87\x6d\x61\x12\x44\x69\x88\x0e\xad\x5f\xd5\xd7\x67\xc7\x87\x52\x6c\xc3\xef\xc5\x7b\x71\x1a\x09\xdc\xe0\x07\xe5\x89\x54\x05\x62\xe8\x0f\x55\xe4\x41\xff\x49\x4c\xef\xdf\x29\x62\x37\xeb\x0e\xab\x72\x24\xdf\x28\x9d\xa0\xeb\xda\xdc\xf6\xf8\x25\x4e\xfe\x64\xd1\xd9\x0d\x09\x9a\xb8\x97\x1d\x2a\x05\x9c\x28\xbf\xe0\xff\x5f\xf4\xcc\x33\x6b\xad\x21\xc8\xb2\xe6\x9b\xf3\x58\x9a\x8d\x51\xab\x48\x39\x3a\xcf\x04\x35\x1e\xb2\x74\x6f\x2f\x6d\xd5\xb1\x6a\x3c\x22\xb7\xc3\x88\xe3\xcf\x68\x05\xaa\x70\x57\x72\x85\xb5\x1c\xb2\x6e\x20\x4c\x42\x65\xd3\x6d\xbf\xc9\x4d\x8f\x8a\xde\xdd\xc7\x4b\x35\xfa\xb2\x81\x9f\x7e\x11\x2d\xb5\xed\xdf\xbe\xf3\xef\xb6\x2d\xb7\x88\x0a\x77\xc0\x2c\xc3\xb3\x26\xd7\xef\xb5\xed\xfa\x05\x76\xae\xe3\xfa\xc5\xc3\x0d\xcf\x7e\x55\xff\x5f\x5f\x8b\x2e\xf4\xaf\x42\x50\xac\x9f\x45\x2e\xf8\x49\x7f\x0e\x39\xe9\x37\xb3\x93\x75\xc3\x75\xe9\x9e\xe7\x34\x01\x6f\xc1\x1b\x1c\x63\xb1\x8e\x63\x9e\xd3\xc4\x7a\x46\x73\x48\xad\xeb\xfa\xc8\x97\x90\x33\x6d\xf8\xbe\x57\xab\x28\x15\xe0\xeb\xbf\x02\x7e\xd7\x59\xa9\xa5\xba\x96\xe7\xea\x79\x8e\xa6\x57\x6e\x68\xd1\x90\x86\x73\x5b\x1a\xb6\xdc\x92\xdf\x9d\xaf\xbd\x6e\xdb\x6d\xcd\x1f\x96\x6f\x8e\xab\xfc\xbb\x31\x5a\xf9\x9d\x7e\x67\xd8\xf1\xbb\x1d\x43\xe0\x92\xd0\x50\x4c\xde\x20\xe5\x82\xc6\x9e\xb1\x9a\x91\xcb\xd1\xbb\x6c\xb9\x81\xe3\xea\xa8\xce\xea\x58\x50\x7a\xb5\xdd\xef\xc6\x79\x63\xc5\xd1\x9f\xf4\xca\x33\x9e\x4b\x70\x70\xa9\x32\x59\xf9\xd3\x79\x54\x2b\x33\x27\xba\xb7\x7b\x43\xed\x17\xc6\xfd\xae\x76\xd1\xd7\x2f\xfc\xb8\xfa\x7b\xa8\xfd\xed\xeb\x17\x55\xcf\x64\x8d\xb6\xbe\xef\x69\x7f\xfb\x61\x36\xe4\x4f\xc5\x18\x70\x8c\x46\xf6\x2c\x9c\xdf\x8a\x2e\x78\x57\x17\xac\xb7\xd2\x1b\xe8\x17\x5a\xae\xe3\x79\xa8\xf7\xb7\xe8\x8b\xa1\xbe\xcf\x94\xa0\x32\xe2\x56\x59\x94\x0a\x13\x5f\x97\x49\x2f\x70\x96\xc6\x31\x64\x37\xe0\xb8\x5c\x30\xaa\x87\x52\x95\xf4\xf5\x02\x3a\xdd\xae\x32\x64\xdb\x3c\xbb\x39\xbb\x65\x56\x2d\x4a\xb7\xee\xfe\x1b\x00\x00\xff\xff\x4c\xa3\x2f\xea\xa2\xa6\x00\x00")
-
-func manifestsDashboardsCoherenceFederationSummaryDashboardJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_manifestsDashboardsCoherenceFederationSummaryDashboardJson,
-		"manifests/dashboards/coherence/federation-summary-dashboard.json",
-	)
-}
-
-func manifestsDashboardsCoherenceFederationSummaryDashboardJson() (*asset, error) {
-	bytes, err := manifestsDashboardsCoherenceFederationSummaryDashboardJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "manifests/dashboards/coherence/federation-summary-dashboard.json", size: 42658, mode: os.FileMode(420), modTime: time.Unix(1647457998, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _manifestsDashboardsCoherenceHttpServersSummaryDashboardJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x5b\x7b\x6f\xdb\x38\x12\xff\x3f\x9f\x82\xe0\xf5\x16\xdb\x83\xbd\x91\xec\xb8\x6d\x8c\xb6\x87\x34\xd9\x5e\x17\x68\xf7\x7a\x9b\xb4\x40\xef\x5a\x18\x8c\x34\xb6\x88\x50\xa4\x4a\x52\xce\x0b\xbe\xcf\x7e\x20\xf5\xa2\x1e\x4e\xe2\x24\x6e\x7d\xdb\xfc\x11\xc4\x1a\x3e\x34\x8f\xdf\x0c\x87\x43\xf1\x72\x0b\x21\x4c\x38\x17\x9a\x68\x2a\xb8\xc2\x63\x64\x48\x08\x61\x46\x95\xc6\x63\xf4\x1f\xfb\x84\x72\xaa\x6d\x39\x4e\x29\xd3\xbf\x71\x3c\x46\x7e\xaf\xa2\x86\x44\x13\x25\x52\x19\x00\x1e\x23\xfc\x5e\x8a\x18\x74\x04\xa9\xc2\x4e\x1f\xe0\xe4\x98\x99\x76\x2d\x53\x70\xe8\x11\x0d\x3b\xa8\x34\x10\x7c\x5f\x30\x21\xcd\x84\x72\x76\x4c\x7e\xf6\x7a\x68\xe0\xfb\x3d\x34\x18\x8d\x7a\xc8\x7f\xec\x4e\xcd\x49\x6c\x5f\xbc\x57\xc9\x82\x7e\x42\x7b\x0c\xa4\xae\xb1\xa0\xcf\x13\xdb\x2f\x24\x2a\x3a\x16\x44\x86\x38\x6f\x5b\xd8\xff\x5f\xb6\x10\x5a\x98\xee\x18\x42\xaa\x1b\xdc\xe2\x19\x07\xfd\x5b\x88\xc7\x88\xa7\x8c\x65\x14\x49\x92\xe8\x48\x08\xa6\x69\x82\xc7\xc8\xb3\x44\x6a\xba\x0c\x87\xd9\x6f\x0d\xd2\xb2\x63\xd4\x35\xda\x1d\xf8\xcf\x06\x23\x7f\xf0\xe4\x49\xd6\xca\x28\x3f\x51\xa5\x96\x0b\x1d\x5b\xc9\xeb\x4c\xf6\xca\x26\x1e\xb0\x34\x84\x8f\x44\xaa\x86\xc6\xf0\x09\x40\x72\x44\xe3\xa6\x26\xb1\x26\x33\xfb\x8e\x2f\x15\x85\x6a\x2b\x18\x7e\x45\x82\x13\xa4\x05\x7a\x47\x28\x47\x07\xed\xb7\x15\xda\x32\x7c\x56\xd4\x54\x32\x43\xdc\x0e\xb7\x03\x11\xf5\x63\x42\xb9\xf9\x01\x12\x78\x00\xfd\x92\x67\xdb\x90\x69\x77\xd1\xab\xcb\x47\xd4\x81\x14\x49\x28\x4e\x79\x93\xd7\x42\x72\x38\xd3\x20\x39\x61\xa8\xfe\xe6\x3b\x48\xef\x22\xb5\x61\xfa\x0e\xc5\xec\xcd\x09\x65\xc6\xfc\x95\x56\x54\x5b\x2d\x61\xd5\xb6\x55\x60\xc8\x4e\x86\x13\xc2\x81\x75\x58\x36\x10\x5c\x03\x37\x8e\x85\x9f\x07\xc0\x35\xc8\x97\xcf\xa3\xe1\xcb\x37\x47\x47\xef\xd1\x21\xc8\x39\x48\x85\x0e\xd3\x38\x26\xf2\x1c\xf5\xd1\xa3\x7d\x96\x2a\x0d\xf2\x77\x12\xc3\xf3\xed\x68\xf8\xf2\xf9\x76\x3e\xa8\xe2\xe5\x5a\xbf\xc3\x33\x49\xc3\xf7\xa2\x72\xed\xcc\xe5\xf0\x18\x0d\x1c\xbf\x38\x35\xcf\x3b\x0e\xe1\xac\x80\x73\xfe\x7c\x6e\x9e\x0b\x5f\xa9\x0c\x62\xa1\x3e\x2a\x9f\x4b\x40\x57\x3a\x8d\x85\x75\x6e\x1c\xe9\x98\xe1\xb6\xa6\xdb\x5a\xd5\x70\xa6\xbb\x71\x13\x08\x96\xc6\xbc\x31\xff\xf5\x0a\x98\x0a\xae\x0f\xe9\x85\x6d\xf7\x3d\xef\xaf\x37\x50\xcd\x93\x55\x55\x33\x58\xa2\x9a\xe1\x55\xaa\x49\xc8\x0c\x72\xc6\x8a\x88\x62\xe9\x2a\x90\x82\xb1\x26\x92\x55\x24\x4e\xdf\x00\x09\x41\xb6\x5a\x84\xd4\x75\x19\x02\xc1\x9a\xf1\x19\x54\x80\xc7\x68\x4a\x98\x82\x16\xaf\x4a\x9f\x33\xa8\xbb\x49\x35\x99\x71\x58\x46\x89\x69\xc6\xd6\xc3\x7a\x8d\xa6\x99\x75\x59\x92\x6a\x51\x6f\x0a\x89\x86\xd7\x42\xc6\xc4\xe2\xfd\xd3\xa7\x4f\x9f\xfa\xef\xde\xf5\x0f\x0e\xd0\x9b\x37\xe3\x38\x1e\x2b\x55\xef\x9e\x10\x6d\x9c\xbe\xfb\x35\x05\x38\x22\x1a\x86\xc0\x71\xd9\xb4\xe8\x5d\xc3\xf1\x3b\x88\x8f\x41\xa2\x7d\x91\x72\x7d\x63\xce\x03\xb3\xec\xbc\xcb\x80\xeb\x5a\xa6\x6a\xac\x2b\xcb\xd2\xed\x12\x35\xd8\x19\xf5\xd0\x68\x27\xfb\xf3\x7e\xd9\x7d\x5c\x9b\xb8\xec\x35\x7c\xda\x43\xfe\x60\xb7\x87\x76\x3c\xd3\xed\xd9\x92\x7e\x23\xaf\x87\xfc\xa7\x83\x1e\x32\xb3\x7a\xbf\xec\x3e\x7d\x8c\x9d\x5e\x5f\xee\xa2\xed\x10\x02\x1a\x13\x1b\xa3\xbc\x5a\x43\x4c\x92\x84\xf2\xd9\x51\xa6\x70\x7f\x99\x89\x3e\x12\x96\x02\xfa\xcb\x5e\xc3\x4c\x91\x04\x15\x09\x16\xd6\x91\x5e\x33\x21\x4f\x8d\x45\xea\xe3\x52\x4e\x2d\xdb\x2a\x32\x50\xbe\xb9\x75\x4d\xcc\xa4\xc1\xcd\x21\xf9\x63\x19\x76\x50\x6b\xb0\x4b\x69\x33\xcb\xca\xe9\x55\x0a\x83\x0f\x24\x65\x0c\x99\xc5\xd9\x64\x06\x2a\x53\x30\x0a\x41\x13\xca\x14\x9a\x0a\x89\x1e\x5d\x4e\x26\x01\x30\xb6\xc0\xad\x79\x3e\xd4\x52\x83\x7c\x70\x3f\x1f\xfc\xf7\x39\x91\xfd\xdc\x62\x66\x49\x7b\x51\x4e\xf4\x93\x69\x71\x16\xbb\x17\xee\xca\x87\x6f\x03\x4f\xde\x1a\x78\x13\x68\x2a\x2d\x29\x9f\xdd\x0f\x34\x8f\x84\x26\x0c\xfd\x01\x5f\x53\x50\x5a\x3d\x20\xd4\xe9\x7e\x3f\xa1\xe7\xd5\xf7\x0d\x3d\xbf\x4a\x29\xee\xb2\xae\xe0\xb9\x11\xa3\xa3\x7d\x99\x7d\x3b\x2c\x72\x17\x03\xb7\xe1\xb2\x61\xf6\xdd\xbf\xca\xbe\x75\x81\xfc\xa6\x84\x83\xe5\xc2\xac\x03\x0b\x7b\xf3\x59\xe1\xe9\x68\xa5\x14\xe9\xc7\xf2\xf6\xc1\x1d\xd0\x70\x70\xbf\xde\x1e\xab\x15\xcc\xfb\x81\x93\x54\x47\x68\x5f\x70\x0e\x81\xd9\xcc\xa3\x3d\xad\x21\x4e\xbe\x45\x54\xff\x7f\xf4\xfa\xb5\xd9\xb9\x2e\x90\xd7\x94\xd0\xbf\x9b\xd7\x73\xc1\x61\x05\x54\x7c\x04\x29\xc9\xc5\x05\xe1\x02\xe5\xd9\xca\x9f\xdb\xed\x97\xd9\xd7\xb1\xe1\xbc\x54\xc9\x24\xe8\x52\xc9\xb7\xce\xc1\x7e\x78\x83\x6c\xff\xf2\xb7\xed\xf5\xa6\x49\x5b\x0d\xd6\xb0\x26\x72\x06\xfa\x8a\x62\x02\x9c\x25\xb6\xa4\x1b\x98\xd4\xe9\xe7\x39\xf0\x50\xc8\x71\x59\x40\x9c\x04\x65\x94\x9d\xc4\x84\x93\x19\xc8\x89\x36\xa9\xf4\x44\x66\x0b\xec\xc4\x8e\xbb\x6c\x43\xed\xc5\x7f\x3f\xe3\x47\xf3\x8b\xfc\xe9\x33\xee\x21\xb7\xc1\xd9\x50\x7c\xc6\xbd\x44\x0a\x2d\x02\xc1\x4c\x53\xa4\x75\xf2\x19\x2f\x1e\xa3\xe3\x73\xf4\x73\x7b\xda\x1e\x32\x7b\x89\xc7\xa8\xae\x8e\x69\x19\x18\xb3\x2a\x71\xad\x91\x72\xa5\x89\xad\xf2\xb5\x36\x5b\x94\x6b\x90\x73\xc2\x5e\x93\x40\xdb\xca\x76\x3d\x1e\x32\x98\x01\x0f\xab\xa8\x5b\x9f\x57\xc2\xd4\x96\x9f\xf1\xde\xf5\x3e\x50\x68\x59\xa5\xf1\x83\x8e\x6f\xa3\xe3\x57\xeb\xd2\x31\x98\x8d\xc3\x83\x86\x11\xde\xbf\x7f\x0d\x93\x39\x48\x32\x83\x12\xc7\x9a\xc6\xf0\x63\x2b\xf9\xe0\xca\x58\x5d\x54\xe1\x6b\x07\x10\x6f\xa9\xd2\x4e\x59\x5e\x12\xae\x8c\x0c\x6d\x09\xaa\x92\xbd\x25\x6f\xa1\xae\xb3\x1e\xb3\x16\xef\x17\x2b\xe8\x65\x55\x73\x3e\xee\x38\xc4\x09\x89\x8a\xde\x02\x9f\xe9\xc8\x88\xeb\xd5\xe8\xb6\x3e\x6d\xcb\xd7\x0e\xf9\xba\xd2\x7f\xd7\x56\x14\x4f\x29\xab\x55\xc7\x2d\xe1\x1f\x92\x84\x34\x3b\x9a\xa9\x7a\x2e\x3d\x1f\xd8\xad\x94\x6d\xcf\x07\x7c\xf7\x2c\xa5\xeb\x7c\xe0\xd9\x56\x03\xe8\x79\x25\xfb\x10\x24\xed\x90\x2c\x3b\x3c\x78\x5a\x3e\x67\xc6\xae\xf3\x41\xe6\xb3\xe6\x38\x93\xac\xa4\x52\x42\x07\xa0\x70\x4c\xce\x3a\x88\x94\x77\xcc\xa1\x22\x71\xda\xee\x6b\xa3\x57\x47\x6f\x5b\xc7\x28\x4c\xd9\x12\x93\x51\xde\x21\x9f\xa1\x9e\xd2\x30\xb3\xb3\x4b\x6d\x1e\x91\x98\x64\xec\xbd\xa0\x5c\x17\x55\x13\x43\xa8\xec\x2b\x92\xfa\xb1\x75\x09\x8b\xb7\xe5\x54\x2d\x8e\x12\x90\x01\x70\x4d\x66\xd0\x62\x2b\x31\x6f\x32\x40\x48\xcd\xd8\x51\x9d\xde\x96\x42\x02\x0f\x41\xda\xf3\x18\x3c\x65\xc2\x71\x1a\x65\xcd\xfa\x4f\x13\x22\x68\x08\x0d\x99\x54\x42\x02\xe8\x82\xb9\xd2\x24\x38\x69\xbd\x45\x69\x48\x12\x08\xdf\x52\xde\x66\xf8\xc6\xc9\xd6\xc6\xa4\x00\xa8\x8f\x36\x87\x17\x31\x9d\x2a\xd0\xe8\xd1\x64\x52\x04\xdb\xe5\xb1\x9b\xc6\x30\xc9\xcc\xda\x8c\xe0\xd9\xc8\x76\x0c\x5e\x31\x80\x5f\x5e\x9a\xd5\x63\xb1\xb8\x41\xce\xd7\x0e\xe4\xdd\x59\xbd\xe5\xfa\xb5\x14\x71\xf3\x84\xd1\xd0\xff\x80\x59\xee\x3b\x8d\x01\x87\x11\x9d\xea\xf6\x08\x77\xa9\x28\xca\xda\xdb\x48\x41\xe0\xac\x07\xe5\x31\xc2\xa5\x1b\x4d\x88\x84\xb0\x1d\x4f\xf2\x53\x4b\xaf\x19\x4a\x26\xc5\xaa\x42\x79\x48\xe7\x34\x4c\x09\xc3\x2d\x27\x2e\xfa\xd8\x0f\x30\x2a\x06\xce\xc8\x19\x6d\x44\x83\xe3\x34\x38\xc9\x5c\xa4\xbe\xb1\x2b\x0f\xa6\x75\xbd\x60\x57\x7c\x4a\xd2\xe8\xdd\x1d\x13\xcb\xd8\xd7\x11\x67\xce\xc9\xd9\x55\x67\xaa\x4b\xab\xa4\x15\xe6\x24\x7c\x6d\xd6\x98\x18\x39\x86\x0e\xa8\x31\x31\x7b\x45\x54\xbb\xbe\x92\x05\xfd\xd6\x8e\x36\x0b\xfb\x8d\x02\x8a\x2b\xe2\xb5\x89\x59\xc5\x64\xb6\x21\xec\x64\xb2\xf5\xde\x5b\xb1\xd9\x22\xe7\x7c\xba\x47\xd9\x5d\x3e\x71\xde\xc6\x42\x51\x01\x68\x2e\x61\x96\xfe\x16\xe6\x25\xd7\x5b\xee\xa4\x0f\x29\x8d\x4d\x69\x6a\x84\x5b\xe7\x34\xbb\xf7\x99\xd3\x44\x34\x84\x7f\x83\x14\xcb\xb2\x9d\xe6\x44\x0f\xe9\xce\x43\xba\x53\xa6\x18\x12\x54\x22\xb8\x82\x89\x7f\x76\xf6\x9d\xb3\x9d\x6f\xc2\x4a\x3b\xd9\xf9\xfe\xb9\x0e\xf2\x3d\xaf\xef\xef\xee\xde\xbd\xce\xb5\x92\x9a\x07\x9b\x63\xf1\x75\xb2\xb2\x91\xe9\x2d\x1a\x78\x5e\x7f\xb0\xd4\xe4\x2b\x94\xdd\x56\xd2\xf3\x70\x73\x4c\xbe\x4e\x56\x36\xd2\xc9\x87\x9e\xd7\x1f\x2e\xb5\xf8\x0a\x65\xc0\x95\xd4\xbc\xb3\x39\x16\x5f\x27\x2b\x9b\xe9\xe4\x3b\x9e\xd7\xdf\x59\x6a\xf2\x83\x35\x99\x7c\xb4\x39\x26\x5f\x27\x2b\x1b\xe9\xe4\x23\xcf\xeb\x8f\x96\x5a\xfc\xd7\xcd\xaa\x5e\x64\x46\x42\xfb\x22\x04\xf5\x50\xbe\x58\x53\xf9\xe2\x8a\xca\xc0\x43\xf9\xe2\x1b\x96\x2f\xca\x5b\x29\x12\xa6\xc6\xd1\x8c\xd8\x43\x2f\xc3\x3d\x56\x41\x04\x31\xf9\x08\x52\x65\x97\x94\x06\x83\x8c\xac\xcf\x59\x7e\xc7\x45\x66\x17\x70\xea\x37\x69\xea\xb7\x68\xb2\xe9\x35\xc4\x09\x23\x9a\xf2\xd9\x4d\xae\x91\x11\xc6\xec\x27\x3f\x6d\xac\x56\xdb\xfd\x9a\xcd\x14\x30\x08\xb4\x75\xc5\x86\x02\x50\x7e\x69\x64\x8c\xf0\xef\x82\x37\x4e\xd8\xe6\xf9\x4b\x70\xe7\x9a\x73\xa3\x7b\x6b\x21\x4c\x29\xa7\xf9\x2d\xae\x0c\x1e\x93\xcc\x75\x3a\x0e\x01\x6b\xd7\xd2\xf2\x9b\x6d\x6e\x90\xc8\xef\x30\xed\xb5\x2e\x78\xb8\xee\x71\xe5\xb7\x45\x38\x4e\x99\xa6\x1d\x38\x28\x2e\xc1\x95\x4b\x8b\x3b\xa8\xaa\x1d\xb8\x5f\x7d\xe0\xaf\x29\xc8\xf3\x55\xc5\xaa\x80\xe4\xd7\xa8\x33\x38\x6b\x38\x37\x56\x27\x34\xf9\x20\xd9\xe1\x39\x0f\xba\x4a\x2f\xed\x20\xaa\xc9\xcc\xe2\x42\xfd\xab\xe0\x0c\xd7\x5b\x5b\x22\x18\x5a\x77\xe7\x3c\xca\x66\x32\x3a\x0d\xa9\x82\xa3\x6c\xa2\xce\xfb\x30\xb7\x46\xe9\xbd\x02\xab\x99\x6c\x64\x76\x98\x28\x7a\x71\x93\x23\xed\x45\x99\x54\xac\x04\xc8\xa6\x81\x28\x0f\xad\x4d\xfb\x7e\x17\x4c\xf7\x0b\xee\x6e\x83\xd2\xee\x4f\xfc\x57\xc5\xe9\x5a\xb4\x74\xdf\xf8\xf6\x1f\xf0\x7d\xdf\x96\xbb\x22\x67\x5e\xf4\xd0\x3c\x5b\xcf\xba\xa0\x3f\xb8\x6f\xe8\x17\x6b\xe7\x2a\xd0\x2f\x06\x77\x8c\xfd\xa6\xf8\x5f\x5d\x8b\x7f\xd2\xd0\x6f\xff\x57\xb7\xbf\x75\x76\x93\x38\x4f\x62\xa6\xd9\x1e\x04\x73\x71\xda\x1f\x7a\x71\x3e\x1d\xd6\x22\x27\xe2\xda\xb8\x84\x06\x27\xb6\x48\x9e\x8f\xce\x35\x56\xee\xd5\xdc\xa4\x1a\x8f\x9c\xed\x87\xef\x39\x0f\x43\xf7\xc1\x8f\xab\xdf\x23\xe7\xb7\xef\x3e\x54\x9c\x99\x16\x67\x57\x31\x70\x7e\xfb\xf9\xad\xe7\x2f\x85\x0c\x66\x83\xe8\x40\xee\xda\xb7\xb8\x13\x3f\x71\x27\x76\xdf\x32\xd8\x71\x1f\x9c\x0b\xe5\x4f\x43\x97\xdf\x82\x97\x9a\xfa\x2e\x04\xaf\x2e\x06\x57\x1b\xb7\xca\xdf\x3a\x2f\x4b\xd7\x2f\xaf\xe3\xd4\x1e\x36\xe1\x40\x44\x7d\xb3\x69\xb6\x37\xd1\x40\xaa\xbe\xca\xba\x67\x9d\xe6\x44\x52\x72\x9c\x5d\x7c\x6d\xa4\xab\x15\x4b\xf3\x2a\x33\xde\x5a\xfc\x2f\x00\x00\xff\xff\x40\x57\x9f\xd7\x2f\x41\x00\x00")
-
-func manifestsDashboardsCoherenceHttpServersSummaryDashboardJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_manifestsDashboardsCoherenceHttpServersSummaryDashboardJson,
-		"manifests/dashboards/coherence/http-servers-summary-dashboard.json",
-	)
-}
-
-func manifestsDashboardsCoherenceHttpServersSummaryDashboardJson() (*asset, error) {
-	bytes, err := manifestsDashboardsCoherenceHttpServersSummaryDashboardJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "manifests/dashboards/coherence/http-servers-summary-dashboard.json", size: 16687, mode: os.FileMode(420), modTime: time.Unix(1647457998, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _manifestsDashboardsCoherenceKubernetesSummaryDashboardJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x5a\x7b\x4f\x1c\x39\x12\xff\x9f\x4f\x61\x59\x7b\xab\x44\x9a\x49\xa6\x07\x06\x96\x51\x36\x27\x16\x14\x65\xb5\xb0\xc7\x1d\x49\xa4\x28\x41\x23\x4f\x77\x4d\xb7\x85\xdb\xee\xb3\xdd\x03\x03\xe2\x3e\xfb\xc9\x76\x3f\xdc\x0f\x06\x26\x90\x7b\xec\xf2\x07\x62\xba\x6c\x57\x97\xab\x7e\x55\xe5\xaa\xf6\xcd\x16\x42\x98\x70\x2e\x34\xd1\x54\x70\x85\xa7\xc8\x90\x10\xc2\x8c\x2a\x8d\xa7\xe8\x8b\x7d\x42\x05\xd5\x8e\xcc\x73\xca\xf4\xaf\x1c\x4f\x51\x30\xa8\xa9\x11\xd1\x44\x89\x5c\x86\x80\xa7\x08\x9f\x4a\x91\x82\x4e\x20\x57\xd8\x9b\x03\x9c\xcc\x99\x19\xd7\x32\x07\x8f\x9e\xd0\xa8\x87\x4a\x43\xc1\x0f\x05\x13\xd2\x30\x94\xf1\x9c\xbc\x18\x0d\xd0\x38\x08\x06\x68\x3c\x99\x0c\x50\xf0\xd2\x67\xcd\x49\x6a\x5f\x7c\x50\xef\x05\xfd\x88\x0e\x18\x48\xdd\x10\x41\xaf\x32\x3b\x2f\x22\x2a\x99\x0b\x22\x23\x5c\x8c\xdd\x0e\xba\x3b\x7d\xcc\x9e\xe0\x2a\xb3\x82\x87\x09\xe1\x31\xa8\x17\x4b\xe0\x91\x90\xd3\x50\x24\x20\x81\x87\x30\x0b\x59\xae\x34\xc8\x99\xa2\xd7\x70\xb3\x04\x29\xc9\xf5\x35\xe1\xa2\xa4\xff\xfc\xaf\xaf\xf8\x87\xe5\x75\xf1\xf4\x15\x0f\x90\x3f\x70\xe8\x7e\xff\x4e\x52\xf8\x8a\x6f\xbf\x04\xe9\x79\x43\x1b\x85\x42\x17\x84\xa9\xf5\x1a\xb5\x9a\xdc\xdf\x75\x7f\x4d\x8d\x32\x9a\x52\x83\x80\x60\x34\xea\xd1\xf3\x59\x22\x2e\x51\x21\x05\x3a\xa3\xd7\x80\x0e\xdd\x46\x7d\x16\x2a\x11\x97\x16\x28\x3e\x07\x4d\xe2\xdf\x60\xa5\xac\x6e\xdc\x7a\xdc\x1c\x35\x43\x5f\xce\x7d\x1a\xd5\x0c\xde\x09\x99\x12\x23\x0f\xee\x79\x6b\xf4\xaa\xcf\xc6\x96\x57\x69\x5e\xfb\xff\x7c\xab\x30\x34\x86\x88\xea\x96\xe1\x70\xcc\x41\xff\x1a\xe1\x29\xe2\x39\x63\x8e\x22\x49\x96\x7c\x10\x82\x69\x9a\x95\xdb\xc0\xd4\x4c\xf9\xc9\xfd\xd4\x20\x2d\xd8\x8c\x9e\x26\xbb\x3b\xbb\x3b\xa3\x20\xd8\xde\x09\xb6\xed\x28\xa3\xfc\x42\x55\x3e\x54\xe2\xca\x5a\xa1\x09\xc1\x41\x35\xc4\x43\x96\x47\xf0\x89\x48\xd5\x42\x14\xbe\x00\xc8\x3e\xd0\xb4\x8d\xb4\xae\xc2\x9c\xba\xcc\x0b\x7e\x21\xe1\x05\xd2\x02\x9d\x10\xca\xd1\x51\xf7\x6d\xa5\x9e\x8c\x9c\x35\x35\x97\xcc\x10\x5f\x47\xaf\x43\x91\x0c\x53\x42\xf9\xeb\x0a\xb4\xc3\x4a\x66\x3b\xe0\x94\x5b\x78\x4e\xb5\x3f\xa2\x8e\xa4\xc8\x22\x71\xc9\xdb\xb2\x96\x3b\x87\x2b\x0d\x92\x13\x86\x9a\x6f\x7e\xc4\xee\x7d\x9f\x6d\x39\x76\x8f\x62\x0e\x96\x84\x32\x63\xfd\x5a\x2b\xaa\xab\x96\xa8\x1e\xdb\x2a\x21\x64\x99\xe1\x8c\x70\x60\x3d\x96\x0d\x05\xd7\xc0\x2d\x48\xdf\x84\xc0\x35\xc8\xb7\x6f\x92\xed\xb7\xbf\xe5\x73\x90\x1c\x34\x28\x74\x96\xa7\x29\x91\x2b\x34\x44\xbe\x0b\xbf\x79\x9d\x6c\xbf\x7d\xf3\xba\x58\x52\x4b\x12\x4b\x1a\x9d\x8a\x3a\x28\x3b\xdf\xc6\x53\x34\xf6\xd0\x7e\x69\x9e\x77\x3c\xc2\x55\xcb\xe1\x56\xe6\xb9\x1d\xe5\x1c\x8a\x2b\x3e\x35\x56\x6b\x75\xa5\xc2\x06\x11\x9c\xe8\x94\xe1\xae\x12\xbb\x0a\xd3\x70\xa5\xfb\x21\x11\x0a\x96\xa7\xbc\xc5\x7f\x21\xb8\x36\x3e\x6c\x96\x06\xa3\xd1\x5f\x1e\xb0\xef\xbd\x4d\xf7\x3d\xee\xdf\xf7\xce\xba\x7d\x67\x24\x86\x42\xae\x32\x10\x58\xba\x0a\xa5\x60\xac\x8d\x40\x13\xe3\xde\x03\x89\x40\x76\x46\x84\xd4\xcd\x2d\x84\x82\xb5\x64\x8c\x40\x85\xc5\xba\x8e\xa4\x4a\xaf\x18\x34\xd1\x5d\xf3\x32\x7e\xc6\x28\xb1\x61\xd4\x3a\xc6\xc0\x1f\x8a\x88\xf6\x02\xe6\xe7\xcf\x9f\x3f\x0f\x4f\x4e\x86\x47\x47\xe8\xfd\xfb\x69\x9a\x4e\x95\x6a\x4e\xcf\x88\x36\x0e\xd9\xcf\xab\xb4\x6e\x42\xa3\x08\x38\xae\x86\x6e\x07\xf7\x88\x75\x2a\x22\x64\xd0\xdd\x64\x17\x9a\xfc\x73\xe2\xa0\xe5\xab\xb7\x1e\x6c\x6e\xd9\xd2\x5d\xae\xda\x99\x0c\xd0\x64\xc7\xfd\x8d\x5e\xed\xbf\x6c\x30\xae\x66\x6d\xef\x0d\x50\x30\xde\x1f\xa0\x9d\x91\x99\xf6\xd3\x1d\xf3\x26\xa3\x01\x0a\xf6\xc6\x03\x64\xb8\x8e\x5e\xed\xef\xbd\xc4\xde\xac\xf3\xc7\xa8\x33\x82\x90\xa6\xc4\x06\x88\x71\x63\xc0\x86\xbb\xf6\x49\xa1\xa0\xd7\x59\x06\x1f\x49\xca\x18\x32\x01\xd4\x44\xef\x14\xd2\x39\x48\x14\x81\x26\x94\x29\xb4\x10\x12\xa1\x1f\x6e\x66\xb3\x10\x18\xbb\xc5\x1d\x3e\x1f\x9b\xe1\xdb\x2e\x1e\x16\x8b\xff\xba\x24\x72\xe8\xc5\x9d\x9f\xfd\x20\xf4\xa3\x19\x3c\xb1\xf3\xdd\x58\xff\x3b\x52\x92\x65\x94\xc7\x1f\x1c\x2a\x82\xbb\x70\x94\x89\xa8\x05\xa3\x44\x82\x4a\x04\x8b\xda\xe9\xdd\x83\x98\xd2\x92\xf2\xb8\xb9\x2e\xe7\xf6\x14\x62\xfc\x4c\xea\x0d\xd0\xf7\x8c\xba\x0d\x2d\xf6\x89\xb0\xbc\xed\xfa\x0f\xb0\x59\x11\x16\x9e\xc4\x66\x5e\xa2\x34\x10\x54\x19\x09\x9f\xa3\xc7\xa6\x76\xe4\xfd\x9a\x7b\xf6\xbf\xff\x71\xbb\x6d\x6e\x2e\x9e\x9b\x60\xfd\x34\xe6\xfa\x54\x15\xa0\x65\x55\xd7\xe4\x4b\x18\x8d\xad\x98\x24\xd7\xe2\x0f\x60\xdb\xbb\x8c\xe5\x19\xa4\x5b\x93\xff\x77\x3d\xea\xb0\xac\xc2\xd0\x27\x90\xca\x14\x9d\x7f\x16\x33\x74\x37\xfb\xd4\xba\xdf\x6a\x49\x87\x35\x91\x31\xe8\x35\xa7\xef\xb2\xbf\x43\x96\xf1\x77\xed\xed\xbc\x44\xf3\x15\x7a\xd1\xe5\x31\x40\x99\x88\x06\xa8\xd0\xce\x00\x55\x61\xbf\x69\x15\xbc\xa8\x22\x98\xeb\x77\x34\x06\x29\x57\x9a\xd8\x82\xb5\x73\x26\xa5\xa6\x16\x5d\x12\xf6\x8e\x84\xda\x36\x8c\x9a\x81\x8b\x41\x0c\x3c\xaa\xc3\x63\x93\xaf\x84\x85\x6d\xa4\xe0\x83\xb5\x3a\x2e\x4b\x49\x2f\xef\x9b\xa2\xe1\x98\x2a\xed\x55\x97\x92\x70\x65\x76\xd1\xdd\x83\xd7\xe9\x31\x64\x4b\xed\x74\x23\x8c\xfb\x1c\x96\xf0\xbf\xa9\xcb\xab\xb9\x6b\x33\x34\x7a\x64\xb6\xe6\x3f\x06\x1e\xeb\xc4\x76\xbe\x1a\x74\xe8\x4e\x5f\x50\x5b\x10\x06\xf7\x57\xae\xfb\xad\xca\x35\x18\xdf\x53\xb9\xee\x17\x8f\xad\xca\x75\xb7\xae\x5c\xad\x05\x9a\x6f\x22\xcb\xb8\xa7\xed\x17\xe6\x52\x42\x8f\x95\x71\x4a\xae\x7a\x88\x94\xf7\xf0\x30\x65\x6e\x77\xae\x16\x9a\xb0\x9e\xd9\x4b\x73\x96\x54\x77\x15\xb6\x8c\x72\xe8\x74\x78\x0c\xf1\x92\x46\x4e\xf1\xeb\xca\x73\x13\xdb\x4e\x05\xe5\xba\x88\x75\x96\x50\x43\x22\x03\x19\x02\xd7\x24\xee\x34\x40\x71\x66\x56\x49\x12\xd1\xdc\xb0\x9c\x34\xe9\x5d\xe3\x4a\xe0\x11\x48\x5b\xd7\xe3\x05\x13\x1e\x24\x15\x48\x0a\xea\x6f\xc6\x29\x69\x04\x2d\xf9\xac\x13\xf6\x61\x48\x69\x12\x5e\x74\xde\xa2\x34\x64\x19\x44\xc7\x94\x77\x05\x7e\x5c\x10\x12\x6a\x96\x49\x11\x82\x52\xb3\x30\xcb\x67\x4c\x90\xe8\x7b\xc6\xa2\xbb\xe3\x0e\x4d\x61\xe6\x54\xd6\x8e\x3e\x2e\xc4\x74\xe3\xc7\x86\xc1\xe7\x54\x44\x53\x74\x73\x93\x89\xe8\xf6\xf6\x9b\x02\x51\x7f\x36\xb1\x92\xbf\x93\x22\x6d\x77\x82\x0c\xfd\x1f\x10\x17\x1f\x4c\x5a\x0b\xce\x12\xba\xd0\xdd\x15\x45\xa8\x33\xf1\xed\xd4\x59\x05\x1d\x9e\x7e\x44\xc7\x82\xf8\x1d\xd9\xaa\x09\x70\xe3\xbb\x1e\x91\x10\x75\x9d\xaf\xe8\x2f\x8d\xda\x7e\x37\x2b\xa3\x22\xe5\x11\x5d\xd2\x28\x27\xac\xf3\x91\xa3\x8a\x9c\xb6\xc3\x5d\x0b\x70\x45\xae\x68\x2b\x7a\xcd\xf3\xf0\xc2\x81\xb0\x79\xaa\xa8\xfa\x83\xba\xd9\x32\x2a\x3f\x11\xb4\x66\xf7\x07\x90\x2a\x50\x7c\x39\xef\x88\xb8\x22\x57\xeb\xda\x5f\xde\xd9\xa1\x73\xdc\xa9\xc1\x57\x84\x03\x9b\xf7\x9b\x18\x22\x73\x60\x7d\x6b\x99\x88\x7f\x21\xaa\x7b\x54\x77\xc1\x12\x07\xad\x76\x88\x8d\x96\x78\xd4\xa4\x7a\x9b\xbd\xf7\x7c\x57\xcb\xea\x0e\x25\x4f\x21\x65\x67\xba\x13\xb3\x43\x2e\xe4\xb4\x51\x67\x8d\x7f\xac\xba\xa8\x28\xeb\x81\x76\xe4\xb7\xf4\x63\x58\x56\x52\x6f\xf9\x4c\xff\x5f\x93\x73\x83\xb0\x26\x3b\xef\x3d\x67\xe7\xe7\xec\x5c\xe9\xb9\xcc\xce\x9d\xcc\x9c\x42\x2a\xe4\x6a\x96\x00\xc9\xca\xdf\xb9\x22\x31\xcc\x72\x05\x8f\xcd\xd1\xe8\x39\x0d\x3f\x30\x0d\xbf\x07\x92\xa1\x13\xab\x7e\xf4\xd1\xa8\xff\x39\x0f\x7f\xbf\x3c\x1c\x41\x38\x5f\xe9\x36\xf4\x9e\x36\xbd\x3d\x67\xe1\x06\xfd\xee\x2c\x5c\x7d\xc8\x96\xb0\x30\x2e\x67\xb6\xbd\x3d\x72\xb6\xc1\x2a\x4c\x20\x25\x65\x8b\x69\x8a\x02\x77\xd5\xc1\x7e\x8d\x74\x9f\xc5\xa5\xfb\x66\xdf\xfc\xf8\xde\xfc\xf0\xee\xd8\x6b\x48\x33\x46\x34\xe5\xf1\x43\xee\x15\x11\xc6\xdc\x37\x88\x0e\x66\xeb\x14\xd9\xb0\x99\x02\x06\xa1\xb6\x2e\xd9\x52\x00\x2a\x3e\x46\x4f\x11\xfe\x5d\xf0\x56\xd3\x63\x59\xbc\x04\xf7\xf6\xde\x1e\x74\xe9\x27\x82\x05\xe5\xb4\xb8\xf8\xe1\xe0\x31\x73\x2e\xd4\x53\x1d\xf5\xdd\xcc\xf1\x83\x45\x71\xed\xe1\xa0\xf3\x6d\xd9\x43\xde\xfa\xfe\x2c\x4e\x73\xa6\x69\x0f\x0e\xca\xdb\x3a\x55\xee\xf0\x17\x89\x4c\x77\x42\x27\x42\xf8\x9f\x39\xc8\xd5\xa6\xdb\xaa\x81\x14\x34\xa8\x31\x5c\xb5\xd2\x0a\x56\x17\x34\xfb\x28\xd9\xd9\x8a\x87\x7d\x27\x99\x6e\x30\xd5\x24\xb6\xb8\x50\x7f\x2f\x25\xbb\xf7\xc2\x10\x89\xef\x98\x5c\x44\x5b\xb7\x47\x6f\x20\x57\xf0\xc1\x31\xf2\xbd\xb0\xef\x4e\xd8\x66\x28\x7d\x52\x60\x3d\xae\xc9\x78\x5b\x9d\x1a\x36\x02\x64\xdb\x40\x15\x22\x5d\x3b\x3a\xe1\x21\x7c\x0b\x22\xbd\x43\xcb\x23\x30\xf9\x5d\x34\xf2\xd4\x58\x0e\x9e\xb1\xfc\xd4\x96\x5b\x77\x00\xae\x7a\xe2\x7d\x30\x1f\x7f\x23\xcc\xfb\xbf\xba\xdc\x8f\xf2\x72\x71\xcf\xda\xff\x28\xd4\x37\x57\xd8\x1f\x34\xa2\xdb\xff\xf5\x35\x50\xed\xee\x14\x16\x67\x93\x85\x2b\x32\x30\x17\x97\xc3\x60\x92\x16\xec\xb0\x16\x05\x11\x37\xd6\x65\x34\xbc\xb0\xf5\x67\xb1\xba\xd0\xd8\xac\xac\x9a\xfc\x33\x33\x9e\x78\x17\x0c\x83\x91\xf7\xb0\xed\x3f\x04\x69\xfd\x7b\xe2\xfd\x0e\xfc\x87\xed\x91\x3f\xe2\x15\x0d\x63\xef\x77\x50\xdc\x7f\x3c\x2f\xf7\x60\xaa\x3f\x0f\x72\xf7\xbe\xc5\x67\xbc\xeb\x33\xf6\xdf\x32\xde\xf1\x1f\xbc\x46\xe6\x5e\xe4\xcb\x5b\xca\xd2\x50\xdf\xb5\xe0\xf5\x3d\xc2\xba\x32\xab\xfd\xad\xe7\xda\x64\xf3\x12\x2b\xce\x6d\xd3\x05\x87\x22\x19\x5e\x54\x93\x87\xca\x4d\x76\x53\x96\xf5\x39\x76\xeb\xf6\xdf\x01\x00\x00\xff\xff\x82\x8d\x61\x3b\xee\x2e\x00\x00")
-
-func manifestsDashboardsCoherenceKubernetesSummaryDashboardJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_manifestsDashboardsCoherenceKubernetesSummaryDashboardJson,
-		"manifests/dashboards/coherence/kubernetes-summary-dashboard.json",
-	)
-}
-
-func manifestsDashboardsCoherenceKubernetesSummaryDashboardJson() (*asset, error) {
-	bytes, err := manifestsDashboardsCoherenceKubernetesSummaryDashboardJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "manifests/dashboards/coherence/kubernetes-summary-dashboard.json", size: 12014, mode: os.FileMode(420), modTime: time.Unix(1647457998, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _manifestsDashboardsCoherenceMachinesSummaryDashboardJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x5b\x7b\x4f\x1c\x39\x12\xff\x9f\x4f\x61\xf9\x76\x4f\xc9\x69\x80\x99\x81\x81\x05\xe5\x22\x11\xd8\x28\xab\x0b\x7b\xb9\x83\x8d\x14\x25\x68\x64\xba\x6b\xba\x2d\xdc\x76\xaf\xed\x1e\x18\x10\xf7\xd9\x4f\xb6\xfb\xe1\x7e\x30\x0c\x19\x08\xc9\xc2\x1f\x88\xe9\x72\xb7\xbb\x5c\xf5\xab\x47\x57\xd9\x57\x2b\x08\x61\xc2\xb9\xd0\x44\x53\xc1\x15\xde\x45\x86\x84\x10\x66\x54\x69\xbc\x8b\x3e\xdb\x2b\x94\x53\xed\xc8\x69\x46\x99\xfe\x8d\xe3\x5d\x34\xe8\x55\xd4\x90\x68\xa2\x44\x26\x03\xc0\xbb\x08\x7f\x90\x22\x01\x1d\x43\xa6\xb0\x77\x0f\x70\x72\xca\xcc\xb8\x96\x19\x78\xf4\x98\x86\x1d\x54\x1a\x08\xbe\x2f\x98\x90\x66\x42\x19\x9d\x92\x17\xfd\x1e\x1a\x0e\x06\x3d\x34\x1c\x8d\x7a\x68\xf0\xd2\x9f\x9a\x93\xc4\xbe\x78\xaf\x5a\x0b\xfa\x3b\xda\x63\x20\x75\x8d\x05\x3d\x4b\xed\x7d\x21\x51\xf1\xa9\x20\x32\xc4\xf9\xd8\x75\xaf\xbd\xd2\x65\xd6\x04\x17\xa9\x65\x3c\x88\x09\x8f\x40\xbd\x98\x02\x0f\x85\xdc\x0d\x44\x0c\x12\x78\x00\xe3\x80\x65\x4a\x83\x1c\x2b\x7a\x09\x57\x53\x90\x92\x5c\x5e\x12\x2e\x0a\xfa\x3f\xff\xf7\x05\xff\x34\xbd\xcc\xaf\xbe\xe0\x1e\xf2\x07\xf6\xdd\xef\xdf\x49\x02\x5f\xf0\xf5\xe7\x41\x72\x52\x93\x46\x2e\xd0\x09\x61\x6a\xbe\x44\xad\x24\x77\xb6\xdc\x5f\x5d\xa2\x8c\x26\xd4\x20\x60\xd0\xef\x77\xc8\xf9\x28\x16\xe7\x28\xe7\x02\x1d\xd1\x4b\x40\xfb\x6e\xa1\xfe\x14\x2a\x16\xe7\x16\x28\xfe\x0c\x9a\x44\xff\x82\x99\xb2\xb2\x71\xcf\xe3\xfa\xa8\x19\xfa\x7c\xe2\xd3\xa8\x66\xf0\x56\xc8\x84\x18\x7e\x70\xc7\x5b\xc3\xb5\x2e\x1d\xdb\xb9\x0a\xf5\xda\xff\x27\x2b\xb9\xa2\x31\x84\x54\x37\x14\x87\x23\x0e\xfa\xb7\x10\xef\x22\x9e\x31\xe6\x28\x92\xa4\xf1\xb1\x10\x4c\xd3\xb4\x58\x06\xa6\xe6\x96\xe1\x8e\xfb\xad\x41\x5a\xb4\x19\x41\x8d\xb6\x36\xb7\x36\x87\x9b\x5b\x83\xfe\xc8\x5a\x06\x66\x94\x9f\xa9\xd2\x88\x0a\x60\x59\x35\xd4\x31\xd8\x2b\x87\x78\xc0\xb2\x10\x3e\x12\xa9\x1a\x90\xc2\x67\x00\xe9\x31\x4d\x9a\x50\x6b\x4b\xcc\xc9\xcb\xbc\xe0\x0d\x09\xce\x90\x16\xe8\x90\x50\x8e\x0e\xda\x6f\x2b\x04\x65\xf8\xac\xa8\x99\x64\x86\xb8\x1e\xae\x07\x22\x5e\x4d\x08\xe5\xeb\x25\x6a\x57\x4b\x9e\xed\x80\x93\x6e\x6e\x3a\xe5\xfa\x88\x3a\x90\x22\x0d\xc5\x39\x6f\xf2\x5a\xac\x1c\x2e\x34\x48\x4e\x18\xaa\xbf\x79\x89\xd5\xfb\x46\xdb\xb0\xec\x0e\xc1\xec\x4d\x09\x65\x46\xfd\x95\x54\x54\x5b\x2c\x61\x35\xb6\x52\x60\xc8\x4e\x86\x53\xc2\x81\x75\x68\x36\x10\x5c\x03\xb7\x28\x7d\x15\x00\xd7\x20\x5f\xbf\x8a\x37\x5e\x1f\x92\x20\xa6\x1c\x14\x3a\xca\x92\x84\xc8\x19\x5a\x45\x1f\x41\x2a\x2a\xf8\x2e\xfa\x69\xbf\x90\x6d\x4e\x7a\xb5\x1e\x6f\xbc\x7e\xb5\x9e\x3f\x5e\x71\x15\x49\x1a\x7e\x10\x95\x87\x76\x86\x6e\xa0\xe8\x41\xff\xdc\x5c\x6f\x7a\x84\x8b\x86\xf5\xcd\xcc\x75\xd3\xe5\x39\x48\xef\x94\xcf\x55\xc0\xad\x64\x97\x08\xeb\x52\x70\xac\x13\x86\xdb\x12\x6d\x4b\x4f\xc3\x85\xee\xc6\x47\x20\x58\x96\xf0\xc6\xfc\x13\xc1\xb5\xb1\x68\xf3\xe8\xa0\xdf\xff\x79\x81\x85\x6f\xdf\x75\xe1\xc3\xee\x85\x0f\x07\xf3\x16\x9e\x92\x08\x72\xc6\x0a\xbf\x60\xe9\x2a\x90\x82\xb1\x26\x1e\x8d\xcb\x7b\x07\x24\x04\xd9\x1a\x11\x52\xd7\xd7\x10\x08\xd6\x98\xd4\xc0\x17\x54\x50\xb8\xee\x16\xbb\x4a\xcf\x18\xd4\xf1\x5e\xcd\x67\x2c\x8f\x51\x62\x3d\xab\x35\x95\x9e\x3f\x14\x12\xed\xf9\xd0\x4f\x9f\x3e\x7d\x5a\x3d\x3c\x5c\x3d\x38\x40\xef\xde\xed\x26\xc9\xae\x52\xf5\xdb\x53\xa2\x8d\x89\x76\xcf\x55\xa8\x38\xa6\x61\x08\x1c\x97\x43\xd7\xbd\xdb\xd8\x12\x9a\x30\xf4\x21\x9e\x29\x1a\x10\x86\x0e\x21\x11\x72\x56\x9f\x3b\x30\xf1\xe9\xd0\x81\xad\x2e\x9a\x62\xb0\xbe\x7e\x4b\x77\xb1\x6c\x73\xd4\x43\xa3\x4d\xf7\xd7\x5f\xdb\x79\x59\x9b\xb8\xbc\x6b\x63\xbb\x87\x06\xc3\x9d\x1e\xda\xec\x9b\xdb\x7e\xb9\xe1\xbe\x51\xbf\x87\x06\xdb\xc3\x1e\x32\xb3\xf6\xd7\x76\xb6\x5f\x62\xef\xae\x93\x65\x64\x1b\x42\x40\x13\x62\xfd\xc7\xb0\x36\x90\x90\x34\xa5\x3c\x3a\x76\xe2\x1d\xdc\xa4\x90\x8f\x84\x65\x80\xfe\xb6\xd7\x50\x4a\x2c\x41\xc5\x82\x85\xcd\xf8\xe9\x29\x8c\x67\xc9\x69\x2d\xde\x1a\x77\xcf\x6d\x98\x37\x5c\x9d\xce\x34\xa8\x3b\xa8\xf3\x88\x6a\x78\xd6\xde\x1d\xb5\xa7\x5a\x42\x5b\x44\x73\x4a\x4b\xca\xa3\x6e\xcd\xa9\xd8\xb8\x96\xc5\xd5\xf6\x56\x02\xa0\xa3\x73\x92\xa2\xa3\x94\x04\xcf\x1a\xbc\xab\x06\x73\xfb\x7b\xf3\xe8\xf6\x67\x15\xd9\xf0\xa6\xe8\xe7\x9b\xf5\x89\xa7\x86\xf3\x8e\xf1\x67\x95\x3a\x95\xee\xcf\x53\x69\x7d\x45\x6b\xc3\x51\x73\x91\x6b\xa3\xfe\xcd\x0b\x5a\x04\x02\x29\x48\x93\xf6\xd9\xcb\xc5\x51\x90\x67\x97\xcf\x66\x5c\x0c\xd8\x8f\x8a\xd6\xd7\x6f\x3e\xf0\x87\xfb\xba\xc1\x5f\x83\x92\xa4\x4b\xd2\xdf\xda\x7b\x57\xdf\x2e\x1f\xa4\x08\x40\x29\xa3\xca\x27\xac\xfb\xfe\x12\xf6\x7e\x70\xbf\x2e\x9c\x0b\x0e\x4b\xba\xef\xa7\xac\xc9\x65\x3c\xf7\xaf\x8f\x1e\x8c\x3f\x96\x25\xbc\xa2\x2e\x56\x9f\x9a\x30\x1a\x59\x8e\x49\xa6\xc5\x5f\x40\xcd\x37\xe9\xcd\xd3\x4d\xbb\xaa\xf9\xb8\xae\xf3\x69\x48\x7d\x7d\xed\x1f\xeb\xf7\x6b\x0d\x4d\x39\xaf\x34\x58\xc3\x9a\xc8\x08\xf4\x9c\xc2\x44\x51\x0d\x27\xd3\xa8\x5d\x09\x17\x6a\x4c\x8a\xa0\x36\x4e\xcb\xa0\xb6\x64\x51\xfc\x25\x3a\x9d\xa1\x17\xed\x39\x7a\x28\x0f\xe3\x75\x6d\xe0\x49\xe9\xb3\x5c\x61\xb8\x36\xd8\x5d\x50\xb7\x25\x4b\xa5\x89\xad\xf8\xd5\xeb\xff\x76\x48\x83\x9c\x92\x8e\x74\xa3\x18\x79\x4b\x02\x6d\x8b\xf1\x75\xef\xc6\x20\x02\x1e\x56\x3e\xb4\xfe\xb0\x84\x89\x2d\x52\xe3\x83\xdb\x91\x7f\x9b\xd8\x27\x12\x60\xac\xce\x49\x3a\x56\xe6\x4b\xf0\x3e\x7a\x11\x7f\x79\xb1\xbf\x59\x5e\xec\x5a\x68\xc2\xc6\x69\x1e\xf9\xc7\x89\x8d\xfc\xcf\xc2\x5f\x40\xf8\x7b\xf7\x84\xf9\x67\xd9\xdf\x5d\xf6\xbf\xfe\xc8\xb2\x47\xeb\xe8\xd9\x1a\xef\x1b\x11\xfb\x73\x73\x82\xa2\x35\xb4\x9f\x49\x09\x5c\x97\xdd\xe2\xa2\x1b\xe6\xb5\x8c\x24\xe1\xca\xac\xbe\xbd\x76\xaf\x99\x6b\xc8\x2b\xa8\xab\xdf\x68\xf2\xbb\xfd\x22\x61\xbb\xaa\xda\x25\xa7\xae\x91\x58\x93\x99\xed\xea\xbd\x07\x1e\xe9\xd8\x36\xb7\x6b\x74\x68\xdf\x3e\xa1\xb6\xc9\x53\xb5\x88\x6e\x6c\x47\x0d\xfc\xed\x10\xe7\x86\xe0\x77\xe6\xba\xfa\x51\x3b\x2b\x0d\x73\xca\x1b\x71\x5b\xe5\xb5\x53\x44\xfd\x5d\x64\x1a\x75\xf4\xf6\x03\x27\xe5\xf6\x46\x84\x84\x5c\x74\xdc\x9e\xd0\x66\x73\x36\x6f\x5e\xb5\xa9\xd6\x3e\x3a\xa6\xb0\xc5\xc4\xa2\x51\xdb\x5a\x09\xb3\x2a\x6e\xf4\xc0\x0c\xf1\x9c\x86\x4e\xf4\x3e\xb5\xd9\x74\x33\xf9\xf8\x07\x41\xb9\x2e\x2a\x97\x86\x50\x81\x22\x2f\x94\x91\xa8\x65\x12\x38\x35\x4f\x49\x12\xd2\xcc\x4c\x39\xaa\xd3\xdb\xea\x95\xc0\x43\x90\xb6\x5b\x87\x27\x4c\xe8\xea\x1d\x0a\x24\x05\xf5\x6f\x63\xbe\x34\x84\x06\x7f\x36\x5d\xea\x42\x91\xd2\x24\x68\x15\x9f\xb0\xd2\x90\xa6\x10\xbe\xa7\xbc\xcd\xf0\xd2\xb9\xf3\x77\x18\xd0\x68\x02\x63\x27\xbf\x6e\xb7\xb3\xb4\x43\xba\xba\xca\x79\xb8\xbe\x46\xab\xa8\x2c\x89\x3d\x5c\xd6\xf0\x3d\x06\x89\x6f\x2c\x65\xdb\xbc\x5d\x20\x29\x6e\x47\x82\xee\xcf\x4f\xbb\x80\xb7\x52\x24\xcd\xce\xba\xa1\xff\x17\xa2\x7c\x3f\x5a\xe3\x81\xa3\x98\x4e\x74\xfb\x89\x3c\xd6\xcc\x2d\xa9\x61\x5d\xee\xe4\xb9\xf2\x9d\x1e\x91\x10\x76\x38\x43\xd7\xaf\xef\x37\x3d\xde\xb8\x88\x48\x94\x87\x74\x4a\xc3\x8c\xb0\xd6\x1e\xb2\x32\x6a\xd9\x0d\x44\x15\x03\x17\xe4\x82\x36\x22\xc7\x69\x16\x9c\x39\xf3\x6f\x6c\x04\x28\x36\x5c\xe8\x7a\xfb\xbd\xd8\x81\xd5\xb8\xbb\xdb\x75\x97\x2e\xfa\xf3\x49\x8b\xc5\x19\xb9\x98\xb7\x95\xc0\xab\x34\xb4\x8a\x23\x15\x06\xcb\x3a\x59\x1d\x47\xe4\x14\xda\x5b\x1b\xcc\x80\x88\xde\x10\xd5\xae\xe7\xb9\x18\xd5\xba\xdd\x05\x29\xdc\xaf\xcf\xee\x2d\xf5\x56\xb3\xae\x38\x75\x35\x8c\x07\x64\xb3\x45\xce\xf9\xf4\x77\x72\x74\x59\xc7\xac\x8d\x89\xa2\x54\xd8\x8c\xb8\x96\xfe\x1e\xa6\x25\xd7\x2b\xfe\xa4\xdf\x34\x2d\xba\x01\x1e\xf7\x9a\x2e\xd5\x08\xf3\xf2\xa5\xed\xf9\xf9\x92\x91\xda\x9e\x3a\xce\xb7\xfb\xb5\x84\x7a\x7f\xe9\x54\x93\xfa\x9c\x4f\x7d\xef\xf9\x14\x99\x46\x63\x31\x05\x39\x36\x5e\xb6\x33\xf0\xab\x99\xd2\x90\x8c\x99\x20\xe1\x98\x4c\x41\x92\xe8\x5e\x76\xeb\xfe\x38\x61\x7f\x80\x0e\x29\xcf\x34\xa0\xbd\x69\x74\x3f\xf9\xd5\xa3\x08\x7d\xf4\x43\x09\x7d\x94\x0b\x5d\xcd\x91\xfa\x1d\x0b\x91\x8f\x03\xf5\x1f\x4b\xec\x83\x45\xe4\x3e\xbf\xea\xf1\xed\x72\xdd\xf7\x82\x84\x68\xcf\xe9\x49\x3d\x27\xb9\x0f\x97\xe4\xda\xb6\x7e\x67\xe6\xd8\xc0\xde\x53\x4c\x6f\xeb\x7c\x3e\x60\x76\x5b\x6e\xbe\x97\x30\x31\x36\x66\x56\xbd\xd1\x77\xc0\xc7\x2a\x88\x21\x21\xf9\x0e\x7a\xb3\xb0\x5f\x1c\x59\xcf\x58\xbe\x95\x5f\xba\x73\x06\xf5\x03\x03\xf5\xc3\x02\x6e\x7a\x0d\x49\xca\x88\xa6\x3c\x5a\xe4\x30\x14\x61\xcc\xee\x40\x68\x83\xb5\xca\x20\x6b\x2a\x53\xc0\x20\xd0\xd6\x16\xdb\xb5\x5c\xbb\x67\x7e\x17\xe1\xdf\x5b\x88\x9b\xe6\x2f\xc1\x9d\x4e\x7f\xa1\x93\x4a\x21\x4c\x28\xa7\xf9\x61\x15\x87\x8e\xb1\xb3\x9d\x0e\xdf\xdc\x75\x9c\xc8\xf7\x12\xf9\x51\x8d\xbd\xd6\x0e\x78\xdf\x3a\xe6\x6e\x89\xc0\x49\xc6\x34\xed\xc0\x41\x71\xc4\xa8\x8c\x31\xfe\x43\x22\xd5\x2d\x5f\x89\x10\xfe\x33\x03\x39\xbb\xeb\xb2\x2a\x20\x0d\x6a\xd4\x08\x2e\x1a\xb6\x8d\xd5\x19\x4d\xff\x90\xec\x68\xc6\x83\xae\x3c\xbf\xed\x45\x35\x89\x2c\x2e\xd4\x7f\x0a\xce\x70\x7d\xb4\x7d\xca\x89\x44\x37\xdc\x9c\xbb\x59\xb7\x46\x6f\x20\x53\x70\xec\x26\xea\x3c\x2d\xf0\xd5\x28\xbd\x2b\xb0\x6e\x83\x47\x53\x5c\x25\x3e\xca\xa3\x2f\x5f\x03\x0f\x2f\xd3\x58\x02\x20\xcb\x1d\xca\xbb\x2e\x93\x9f\x07\x05\xd6\xe0\x69\x03\x6b\xb8\x04\xb0\x8a\x90\x70\x17\x60\x35\x0e\x64\x3d\x1a\xba\xe6\x65\xd6\x3d\x34\x75\xdc\x3d\x7b\xb4\xc5\xe2\x6e\x11\x5a\xf7\x18\xeb\x8e\xac\x8d\x8d\x5e\x3f\x8d\xc7\x84\xb1\xda\x4e\xac\x07\xc3\xf1\x4d\xf1\xf3\x08\xe4\x94\x06\xf5\x33\xb5\xb7\xa1\xb7\xeb\x99\xe5\x50\xab\xdc\x8c\x63\x09\x7f\x66\xa0\xf4\x38\x21\x17\xe3\x30\x73\xc7\x5e\x97\x46\xb1\x61\xfb\x29\x40\x78\x05\xd5\x8e\x1f\x6b\x77\x94\x35\x4f\x2f\x27\xee\xc3\x10\x73\x71\xbe\xba\xd1\x4f\xf2\xe9\xb0\x16\x39\x11\xd7\x9e\x4b\x69\x70\x66\x0b\x6c\xf9\xd3\xb9\xc4\xc6\xc5\x97\xaf\xff\xbd\x83\x47\xde\x97\xe1\xa0\xef\x5d\x6c\xf8\x17\x83\xa4\xfa\x3d\xf2\x7e\x0f\xfc\x8b\x8a\x33\x33\xe2\x7d\xf0\x0d\xbd\xdf\x83\xfc\xd8\xed\x49\xb1\x06\xf3\x05\xef\xe1\xef\xd6\xb7\xf8\x13\x6f\xf9\x13\xfb\x6f\x19\x6e\xfa\x17\xde\x89\xe6\xed\xd0\xe7\xb7\xe0\xa5\x26\xbe\x4b\xc1\xab\x13\xab\xde\x2e\x85\x32\x64\xb4\x4e\xeb\xd6\x4f\x4e\xe3\xcc\x16\x9d\xb1\x3b\x1c\xed\x6e\x5d\x55\xee\x56\x77\xc3\xb4\xfa\x0c\x59\xb9\xfe\x7f\x00\x00\x00\xff\xff\x32\x8e\xed\x2c\x62\x41\x00\x00")
-
-func manifestsDashboardsCoherenceMachinesSummaryDashboardJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_manifestsDashboardsCoherenceMachinesSummaryDashboardJson,
-		"manifests/dashboards/coherence/machines-summary-dashboard.json",
-	)
-}
-
-func manifestsDashboardsCoherenceMachinesSummaryDashboardJson() (*asset, error) {
-	bytes, err := manifestsDashboardsCoherenceMachinesSummaryDashboardJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "manifests/dashboards/coherence/machines-summary-dashboard.json", size: 16738, mode: os.FileMode(420), modTime: time.Unix(1647457998, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _manifestsDashboardsCoherenceMemberDetailsDashboardJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x5d\x69\x6f\xd4\xba\xde\x7f\xdf\x4f\x61\xe5\x70\x8e\xe0\xd1\xb4\x9d\xa5\xd3\x4d\xc0\x23\x68\xc5\xe1\xe8\x52\x2e\x87\x16\x24\x2e\xa0\x91\x27\x71\x27\x56\x13\x3b\xc7\x76\xa6\x9d\xa2\xde\xcf\x7e\x65\x67\x73\xec\xcc\xd2\x59\x3a\x43\xc9\x0b\x44\xc7\x4e\x1c\xfb\xbf\xfd\x7e\xde\x7f\x6c\x01\xe0\x40\x42\xa8\x80\x02\x53\xc2\x9d\x63\x20\x93\x00\x70\x02\xcc\x85\x73\x0c\xbe\xaa\x5f\x20\x4d\x55\x39\xfd\x18\x07\xe2\x2f\xe2\x1c\x83\x56\xa3\x48\xf5\xa0\x80\x9c\xc6\xcc\x45\xce\x31\x70\x3e\x30\x1a\x22\xe1\xa3\x98\x3b\xda\x33\x88\xc0\x7e\x20\xf3\x05\x8b\x91\x96\xee\x63\xaf\x22\x15\xbb\x94\x9c\xd0\x80\x32\x59\x20\x1b\xf4\xe1\xd3\x66\x03\xb4\x5b\xad\x06\x68\x77\xbb\x0d\xd0\x7a\xa6\x17\x4d\x60\xa8\x3e\xfc\xaa\x68\x0b\xf8\x03\xbc\x0a\x10\x13\xa5\x2a\x88\x51\xa4\x9e\xf3\x20\xf7\xfb\x14\x32\xcf\x49\xf3\xee\x1a\x76\x4b\x17\x69\x13\xba\x89\x54\xc5\x5d\x1f\x92\x01\xe2\x4f\x87\x88\x78\x94\x1d\xbb\xd4\x47\x0c\x11\x17\xf5\xdc\x20\xe6\x02\xb1\x1e\xc7\xb7\xe8\xc7\x10\x31\x06\x6f\x6f\x21\xa1\x59\xfa\x8b\xff\x7e\x73\x9e\x0c\x6f\xd3\x5f\xdf\x9c\x06\xd0\x33\x4e\x92\xbf\xdf\xc3\x10\x7d\x73\xee\xbe\xb6\xc2\xef\x25\x69\xa4\x02\xbd\x84\x01\x9f\x2c\x51\x25\xc9\xa3\xfd\xe4\x5f\x59\xa2\x01\x0e\xb1\xb4\x80\x56\xb3\x59\x21\xe7\x73\x9f\x5e\x83\xb4\x16\xe0\x1c\xdf\x22\x70\x92\x34\x54\x2f\x82\xfb\xf4\x5a\x19\x8a\x5e\x82\x80\x83\x7f\xa1\x11\x57\xb2\x49\xde\x77\xca\xb9\x32\xeb\xeb\x77\x3d\x0d\x8b\x00\xbd\xa1\x2c\x84\xb2\x3e\x4e\xc5\x57\xbd\x9d\x2a\x1d\xab\xb2\x32\xf5\xaa\xff\xbf\x6f\xa5\x8a\x76\x3c\xc4\x5d\x86\x23\x69\x28\xf2\x51\xf5\xba\x83\x3c\x2c\x0c\x6d\x3a\x03\x82\xc4\x5f\x9e\x73\x0c\x48\x1c\x04\x49\x0a\x83\x91\x7f\x41\x69\x20\x70\x94\xb5\xcd\xc1\xf2\x91\xce\x7e\xf2\xb7\x40\x0c\xa6\x25\xb7\xba\x47\xed\xd6\x61\xab\xb3\xdf\xec\xec\xef\xa9\xdc\x00\x93\x2b\x9e\x7b\x56\x66\x6d\x4a\x37\x65\xc3\x6c\xe4\x59\xc4\x0d\x62\x0f\x7d\x86\x8c\x1b\x76\xe6\x5c\x21\x14\x5d\xe0\xd0\xb4\x3f\x5b\x8c\x89\x10\xe5\x07\x5e\x43\xf7\x0a\x08\x0a\xce\x50\xd8\x47\x8c\x83\xf3\x38\x0c\x21\x1b\x81\x53\xfb\xc3\x99\x20\x65\x95\x8b\xd4\x98\x05\x32\x71\xd7\xdb\x75\xa9\xbf\x1d\x26\xc5\x6c\xf3\xa4\x98\x5d\xe3\xf7\xb6\xe1\x68\xa9\x9b\xad\xb1\xd9\x10\x93\xb9\xdb\x0a\x31\xd9\xcd\x3d\xb8\x68\x9a\xca\xa8\x6e\x1f\xe4\xa7\x8c\x46\x1e\xbd\x26\x66\x5d\xb3\x96\xa3\x1b\x81\x18\x81\x01\x28\x7f\x79\x81\xd6\xeb\x01\xcc\x88\x72\x15\x82\x79\x35\x84\x38\x90\x56\x5f\x48\x85\xdb\x62\xf1\x8a\xbc\xad\xcc\x9f\x54\x61\x4e\x04\x09\x0a\x2a\x0c\xda\xa5\x44\x20\xa2\x3c\xf6\xb9\x8b\x88\x40\xec\xe5\x73\xbf\xf3\x32\x31\x3b\x70\x8a\x04\xc4\x01\x07\xdb\xe0\x49\x92\x22\x43\xd9\xf3\x5d\xbf\xf3\xf2\xf9\x6e\xfa\x74\x51\x89\xa9\x71\xd8\x19\x30\xec\x7d\xa0\x05\x7c\xa9\x44\xdf\x39\x06\x6d\x2d\x2e\x5c\xcb\xdf\x7b\x5a\xc2\x8d\x11\x9a\x46\xf2\xb7\x89\x07\x89\x6b\x77\xf7\xf3\xdf\xb9\x03\x17\xc2\x0c\xa9\x8a\xb7\x8e\x2f\xc2\xc0\xb1\x45\x6c\x8b\x53\xa0\x1b\x51\x6d\x30\x2e\x0d\x02\x18\x71\xe4\x99\xf1\x7b\x11\x29\xb4\xee\x2b\x85\x76\xb5\x14\xda\xdd\xfc\x77\xa1\xf6\x0a\x9b\x3a\x89\x19\x43\x44\x80\x6a\x35\xdb\xe2\x60\xf4\x7a\x8c\x34\xa0\xeb\x23\x69\xeb\x34\x16\x5a\x0c\xce\x24\x45\x99\x74\xeb\x01\xa3\x31\xb1\xe5\xa5\xf2\x3f\xc3\x20\xb6\xa0\x30\xc9\x32\x7c\xe5\xb7\xf6\xd1\x91\xbb\xb7\xaf\x03\x49\x02\x91\x9d\x83\x06\x68\xb5\x8f\x1a\x60\xaf\xd9\x00\xcd\x9d\xc3\xa3\x12\x4c\xfe\xe6\xed\xed\xc1\x0e\xb4\x1d\x6c\xba\xba\x2e\x73\x48\xf3\x90\xdb\x1f\x09\xa4\xab\x12\xc6\x03\x54\x56\x64\x08\x6f\xb2\xd6\x94\x31\x39\xc4\x24\xcb\x68\x1a\xe8\x5b\x41\x02\x84\xcf\x10\xf7\x69\xe0\xbd\x83\xfd\x44\x83\x63\x9f\x38\x83\xec\x0a\xe5\xf1\xc7\x32\x89\xb1\xe6\xd6\x35\xcc\xad\x3b\xc5\xda\x3a\x63\xac\xed\x50\x8b\x86\x02\xb1\x21\x0c\x4c\x1b\xa8\xf2\x45\x18\x45\x98\x0c\x2e\x12\xd3\x6a\x55\xa5\x97\x35\x5f\x54\x5e\xe3\x37\x43\x29\x50\x89\x17\xca\x53\x1b\xfa\x23\xc3\x4c\x09\x79\xe2\x5d\x63\x72\x61\x4c\x72\x94\xc9\x85\xb5\x8b\xc2\x2c\x4b\x0a\xe1\xcd\x29\x14\xf0\x03\xc5\x44\xf0\xb2\xf6\x1d\x29\x0d\x95\x71\x96\x86\x20\x97\x12\x82\x5c\x81\x34\x68\x93\xcf\x5c\xc8\x2f\x1b\xc2\x8b\x28\x17\x97\xf8\xa6\x1c\xa1\xd2\xc4\x37\x94\x08\x49\xb0\x64\x66\xb7\xf9\xbb\x96\xcf\x90\xfd\x8e\x4a\x1b\xfb\x8a\x6a\xfe\x19\x8c\x26\x88\xfd\x92\xd1\x50\xbe\x27\xeb\x57\x16\x90\x48\x2a\xee\xbc\xdf\x7d\x65\x64\xd0\xfc\x85\x09\xb2\xe3\x11\x64\x57\x01\x26\x86\x2f\x5d\xe2\x20\x28\x33\xe1\x4e\xab\x01\x5a\xad\xc3\x06\x68\x1d\x1e\x49\x37\x6f\x1d\x96\xdc\xfc\x52\x7e\xc7\x76\x15\x59\xb2\x5e\x4e\x52\x4c\xbb\xd9\x00\xad\xa3\xce\x33\x93\x0b\x8f\x73\x24\x45\x3a\x4f\x68\x10\x87\xc4\x80\x0b\xc8\x06\x48\x4c\x90\x5b\xd6\xcb\xb0\x7a\x17\x21\x0a\x29\x1b\xf5\x7c\x04\xa3\xec\xef\x98\xc3\x01\xea\xc5\x1c\x79\x8b\x75\x37\x1a\x09\xc5\x53\x19\x45\x50\xff\xe6\xdc\x01\x50\xd6\x50\x11\xdf\x04\x0e\x51\x8f\x23\x86\x4b\xfd\x03\xe5\xd7\x5c\x40\x45\x12\xca\xdd\x27\xcd\xe5\xdf\x40\x57\x28\xf9\xb6\x4a\xd9\x01\x1a\x20\xe2\x15\xbd\x82\x72\xb9\x0c\x5d\x2a\xd2\xee\xbc\x9a\x64\x1c\x79\xa0\xe3\x86\xd8\x33\x1c\xfb\xc4\x91\x07\xde\x22\x18\xd9\x90\xc5\x31\x19\x04\x88\x0b\x58\x38\x74\xe2\xcc\xba\x1b\x1c\xea\x6e\xa0\x72\x27\xbb\x01\x95\x1d\x0a\xe7\xc5\x8c\x1e\x90\xc5\x8e\xa9\x4e\xa0\x1e\x7c\x9f\x06\x23\x37\xc1\xe6\x1a\x6f\x1f\x2f\xde\x9a\xec\xae\x3b\x23\xde\x1e\xd4\x78\x5b\xe3\xed\xe3\xc2\x5b\x55\xc0\x5a\x01\x37\x84\x37\x35\xde\xa6\xd2\x9d\x86\xb7\x67\xf0\xa6\x86\xdb\x2c\xef\x3e\x70\x5b\x1a\x07\xda\x34\xb4\x8d\x10\x73\x11\x11\x31\xc1\x62\x66\xc0\x9d\x19\x6e\x8d\x61\xf7\x0d\xe8\xdd\x1e\xcd\x88\xb6\x47\x35\xda\xd6\x68\x5b\xa3\xed\xcf\xd3\xbd\xdd\x05\x35\xea\x2f\x8c\xfa\x3b\xdd\x66\x63\xe7\xb0\x59\x01\xfe\x12\xf8\x81\xea\x71\xff\x5e\xe3\xff\xd2\xf1\x3f\x05\xf2\xfb\xe2\x7f\x4a\x1b\x6a\xfc\x4f\x12\x67\xc1\xff\xd6\xde\x6c\x04\xa0\xd3\xac\x09\x40\x4d\x00\x6a\x02\x30\x17\x01\x20\xd4\x43\xbd\x28\xee\x07\x98\xfb\x88\xf5\x78\xec\xba\x88\xf3\x1e\x83\x62\xc1\xc5\x34\xe3\x71\xf7\xe7\x86\xdd\xc3\xc6\xce\x51\xb7\x02\x75\x3f\x64\x32\x04\xe7\x89\x0c\x6b\xec\xad\xb1\xb7\x48\xfe\xd9\xb0\x77\xc6\xce\x77\xa7\x55\x63\x6f\x8d\xbd\x35\xf6\xce\x8f\xbd\x0c\xb9\x08\x0f\x6b\xe8\x5d\x00\x7a\x3f\xa6\x22\xfc\xe5\x90\x57\x59\xa3\x11\x5b\x67\x01\xb5\xa2\xcd\xad\xa6\xde\xe8\xb1\x70\x71\x68\xc0\xc5\xd4\x75\x6f\x87\x63\xe0\xa2\x33\x09\x16\x22\x38\x40\x69\xc5\x4a\x41\x90\xbb\x8c\x2a\xd7\x2e\x31\x04\xe9\xad\x6f\x11\xf4\x10\xb3\x72\x28\x13\xe5\x36\xb8\x34\x30\x97\xd9\x23\xee\x8e\xf5\x75\x2e\x46\xc1\x24\x04\x82\x01\x86\xca\x24\xd5\xea\xd1\x86\x91\x35\x50\x01\x02\xc6\x82\x96\xb3\x3c\x28\xb4\xd5\xd7\x5f\xbe\x7c\xf9\xb2\x7d\x76\xb6\x7d\x7a\x0a\xde\xbe\x3d\x0e\xc3\x63\x6e\xb8\x5e\x04\x85\x40\x8c\x54\x7f\x26\x33\x6c\x1f\x7b\x1e\x22\xce\x54\x98\xcb\x6b\x7c\x8e\xd8\x10\xbb\xb3\x57\x5a\xf1\xb0\x14\xb8\x74\xa5\x80\x31\x24\x0d\x14\xac\x6c\xaf\xdb\x00\xdd\xbd\xe4\x5f\x73\xa7\xc4\xc9\xc0\x8c\xdc\xad\x78\xae\x2b\xe3\xf5\x41\xbb\x01\x64\xa9\xcd\x9d\xa3\x83\x67\x8e\xf6\xd4\xf7\x45\x04\xed\x21\x17\x87\x50\xf1\xac\x76\x39\x84\x61\x72\x55\x15\xf9\x64\x7a\xb1\x6e\xdd\x39\x65\x38\x08\x80\x47\xaf\x89\xe4\x0f\x3c\x11\x30\xf0\xd2\x15\x9b\x97\x94\x81\x27\x3f\x7a\x3d\x17\x05\xc1\x9d\x63\x95\xf3\xa9\xb4\x30\x3a\x7d\x79\x3b\x7d\xf9\xff\x87\x90\x6d\xa7\x1a\x93\x11\xe1\x45\x5e\xd0\x1f\x32\x47\x0b\xfa\x2f\x74\x04\x28\x7f\x65\x0c\xd9\x32\x6d\x8c\x58\x2f\x96\xa2\xef\xd7\xef\x95\xf6\x47\x62\x09\x2f\xe5\xf7\x14\x47\x3e\x56\x0e\xca\xc4\x3d\x4c\xb3\xb6\x49\xed\xf1\x71\x36\x39\xa3\x36\xd7\xab\xc9\x6c\xd9\xf2\x85\xcf\x10\xf4\xc0\x09\x8d\x89\xa8\xb5\x5b\xa9\xdd\xe6\x3c\xda\x55\x5d\x49\xf0\x9b\xc9\xb6\x17\xd4\x32\xa1\x04\xdd\x43\xc9\x7f\x79\x01\xaa\x35\x3c\x5d\xc3\x56\x0b\xef\xa7\xe4\xd7\x6b\x55\x72\xaa\xdf\x4f\x02\x07\xf8\x36\xd9\x8b\x35\x8f\x96\x53\xf6\x3b\xbb\xa2\x2b\x54\xb3\x88\xa6\x6d\xbb\xd9\x94\x40\x9d\x6a\xf9\x64\x92\x96\xcb\x0d\xda\xe9\x36\xcd\x36\xee\x1c\x35\xc7\x37\x68\x16\xab\xd0\x47\xb7\x66\x37\x8e\xcf\x79\x27\x35\xdb\xc1\xf8\xb8\x43\xc0\x38\x55\x6b\xea\xb4\xfb\xed\xf7\x77\x5f\x2e\x18\x26\x83\x9a\x53\x2d\x45\x21\xbb\x3b\xff\xb7\xbb\x5a\x32\xb4\x65\x54\x6d\xf6\x01\xa1\xb1\xa3\x31\x6a\xef\xec\xc3\x8e\xc3\x9c\x4e\x37\xaa\xac\xda\x3c\x0e\xed\x5d\xd8\x69\xcf\xa5\x27\x14\x60\xf4\x5c\x49\x08\x56\x34\x8a\xf5\x0c\xf4\x47\xe0\xa9\x5d\x76\x03\xc8\x3e\xcc\x33\xf0\x12\x34\x37\x49\xb2\xaf\x96\x2b\x59\xec\x05\xa8\x16\x6f\x21\xde\xd7\xb3\x8b\xf7\xe9\xa6\x5b\xee\x36\xf8\x19\x2c\xe0\x19\xd8\x05\x9b\x2e\xca\x67\xe3\x87\xba\xd7\x60\xa3\x27\x13\xe1\xc2\xdc\xec\x9b\x0e\xf8\x70\xf0\x31\x26\x04\x93\x01\xa0\x64\xcc\xae\x5f\x06\x09\x97\x2d\xb3\xdb\xa5\x9d\xdd\x20\x93\xb7\x40\xe5\xf0\xf1\xb8\x8d\xe5\x94\x8d\x00\x24\x1e\xf8\x13\xb2\x3e\x1c\x20\x70\x42\x83\x00\xb9\x92\xfd\x6f\xc2\xce\xf2\xd6\xfe\x96\xe1\xef\xe9\xd6\xf2\x62\x21\xf0\x6a\xb7\x96\x2b\x76\x75\x92\x71\xa2\x1f\x45\x35\xfa\x15\x87\x0c\x78\x90\xfb\xef\x10\x19\x08\xb5\x7f\xbc\x59\x4a\x47\xd6\xdc\xee\x0c\x82\xac\x1a\x3c\xa8\x3c\x86\x23\x31\x7c\x1c\x94\x86\xbe\x55\xc2\x9f\x0c\x7a\x38\x51\x7d\x73\xba\x82\x8e\x0c\x05\xb5\xda\xd3\x14\x64\x8f\xfe\x27\xe3\xd4\xe7\xc9\x0c\x93\xd9\x66\xa5\xbd\xbd\x62\x93\x72\xe2\x55\xe5\x8a\xc0\xe1\xc0\x9e\x28\xcf\x66\x47\x2a\x0f\xc2\xf9\x0f\x62\xd4\xce\x09\xe1\x4d\x45\x22\x26\x15\x13\x87\x63\x26\xe7\xa9\x80\x55\xd3\x8c\xaa\x87\x3b\x76\x22\x3e\xc0\xa4\xa2\xe1\x32\xf5\x1a\x7b\xa2\x74\xb4\x40\x95\xf1\x5a\x53\xc8\xa5\x49\x58\x87\x46\xe5\x43\x87\x72\x4b\x7a\x97\x17\x65\xd5\x28\xed\xf2\xc1\x81\xbd\xcb\x30\x92\x5f\x92\x26\x12\x73\x7d\x69\x40\x92\x6e\xb7\x82\x21\xe2\x21\xa6\xa6\x61\x9c\xcb\x80\x6a\x53\x6c\xc9\x8c\xe2\xbf\x65\x94\xc6\x1e\x32\xda\xc4\x23\xe8\xa2\x2a\xcf\xe0\x02\xba\x57\xd6\x57\xb8\x40\x51\x84\xbc\x77\xc9\x3c\x71\x39\x6f\xfe\x29\xd8\x81\xdb\x73\xf3\xe0\xb6\x14\xc0\x02\x63\x27\x5f\xb7\xed\x65\xcf\x0f\xf9\x79\x7a\x79\xc9\x91\x00\x4f\x7a\xbd\x0c\xd4\x16\x99\x0e\x36\xcc\xbf\xbc\xfe\xc3\x31\xdf\xba\x17\x88\xfe\xf8\x21\x21\xfc\xee\x6e\xe1\x19\xe3\xd2\x89\x1a\x21\x7a\x93\x2c\x60\x28\x4d\x2d\xca\xf4\x8f\x68\x90\x7a\x8f\xf1\xc2\xb9\x8f\x2f\xad\x15\x19\x39\x62\xd8\xe0\x68\x0c\x83\x3a\x22\x9f\x23\xfa\xa1\x47\x15\xc8\xd4\xa1\x24\x46\x5c\x49\x27\x2d\x9b\x66\x48\xe9\x65\x78\x84\x89\x87\x87\xd8\x8b\x61\x60\x1d\xb5\x95\x63\x96\x3a\x52\xa9\xa8\xc0\x0d\xbc\xc1\x46\x54\xe8\xc7\xee\x55\xe2\x2a\xe5\x5e\x7e\x8e\x8f\xa2\x3c\xd7\x98\x2d\x90\x31\x9e\xae\x8e\x8d\x79\x0c\xac\x88\x37\x23\x78\x33\x69\x4a\x75\xec\x90\x78\x61\x95\x6a\xe8\xb2\x6c\x39\xb0\x8f\x2a\xec\x2d\xa0\x83\xd7\x90\xdb\xc3\x6e\x49\xec\xb7\xc7\x63\x55\xf4\x77\x8c\x5e\x8d\x7d\xce\xc0\xf8\x0e\x46\x51\xc7\x64\x70\xa0\xb2\x92\xd6\x77\xe7\xaa\xa6\x95\x5c\xb1\x68\xa5\xca\x31\x46\xb6\x29\x64\xa3\x41\x26\x92\xa9\xf4\x77\x68\x98\xd7\x7a\x4b\x2f\x74\xb3\xc8\xd0\x43\xd0\x9b\x52\xc2\xfc\xfc\xe6\x60\x89\xfc\xa6\x66\x31\x69\x76\xcd\x62\x6c\x1a\x21\x23\xf8\xfa\x48\xcc\x4a\xbf\xbe\x1c\x0e\xf3\xcb\xf3\x94\xd2\x7a\xa2\x9a\xa6\xcc\x47\x53\x2c\x24\x2e\x6c\x2f\xe4\xab\xa4\x00\x3f\x39\x53\x29\xd7\x73\x73\x88\x8a\x49\x3d\x7e\x42\xa6\x62\x9d\x3e\x78\x30\x0f\x51\xd9\x9f\x81\xa8\x98\xaa\x98\xc2\x54\xcc\xc7\x1f\x8e\xaa\x94\xe8\x67\xcd\x54\xd6\xcd\x54\x56\xb8\xcf\x7b\x2c\x6b\x58\x1f\x43\x38\x83\x37\x8b\x4f\x0a\x3e\xe8\x9e\xfd\x0d\x14\xe2\x27\xae\x6d\x94\x29\x4b\xf1\xf5\x86\x50\x2c\xb5\xe3\x3e\x99\x37\xa9\x69\xd5\x3c\xb4\x6a\xc2\x89\x72\xa0\x26\x50\x13\xeb\x59\x13\xa8\x55\x0e\xf5\xcc\xc7\xa0\x8e\x96\xcf\xa0\xd6\x36\xd6\x53\x13\xa8\x4d\x24\x50\x84\x92\x9a\x00\x2c\x85\x46\x55\x4b\xd2\xa5\x61\x88\x85\x78\x8c\xe2\x3c\xc9\x9a\xb6\xe1\xa4\xea\x3d\x25\xdb\x35\xb1\xaa\x89\xd5\xe3\x27\x56\x93\x6f\xf8\x78\x8f\xc4\x35\x65\x57\xd9\x15\x10\x9b\xb0\x02\xab\x33\x6e\x05\xd6\xe1\x24\x4e\xf0\x80\x2b\xb0\x56\x4c\x45\x8d\xd5\x56\x17\x3e\x02\x0c\x0a\x04\xa0\x00\xd7\x3e\x76\x7d\x10\x41\x15\x0e\x00\x64\x08\xf4\x11\x26\x03\xc0\x11\x11\xe0\x92\xd1\x10\x08\x1f\xf3\x14\x16\x76\x96\xca\x6e\x0f\xa6\xb1\x5b\x4b\x8d\xf3\xcc\x63\x76\xba\x0b\x91\x5b\x6b\x24\xf0\xfe\xe3\x83\xd6\x61\x28\xd3\xf8\x6d\xf5\x26\xef\x9a\xe0\x6e\x02\xc1\xc5\xd2\x71\xec\x95\xc4\xc9\xb1\x54\x89\x17\xf5\xa4\xef\xac\x8a\x87\xa9\x2b\xdf\xe6\x3a\x0a\x72\x49\x74\xec\x83\x6a\x24\x38\x47\xe6\x06\xca\x8d\x9b\x48\x2c\x6a\xea\x81\x8f\x32\xdc\xed\x82\x73\xe4\x52\xe2\xd5\xd4\x6c\x45\x2b\x9e\xa2\x68\x0c\x5d\xab\x17\x3c\xfd\xaa\xa3\x60\x73\x50\x8f\xf4\x84\x21\x0f\xf4\x47\x6b\x24\x1f\xd6\xd0\xda\x7c\xec\x63\xb1\xc9\xc9\x9a\x7d\xd4\xec\xe3\xbe\xec\x23\x73\x9f\x95\x32\x90\x75\xf3\x0f\x0e\xd2\x43\xb4\x66\x19\x69\xdb\x00\x12\x92\xd6\xb6\xe6\x21\x7a\x85\x6b\x1e\xb2\x06\x1e\xb2\xb1\x0b\xaf\x37\x7f\x3a\xce\x3c\x55\x6e\xea\x80\xc5\x5e\x77\x0e\xca\xb0\xd7\xa9\x29\x43\x4d\x19\x96\x46\x19\x1e\xf0\x04\xed\xe5\x4f\x1e\x3d\xd4\x59\x9d\x2b\xe6\x00\xe6\x51\xdb\x8a\x06\xd4\xe8\xbf\xba\x05\xcd\x55\xa7\x5f\x83\x05\x30\xd6\x69\x39\x8f\x8f\x09\xfc\xa2\x23\x12\x0f\x41\x04\xac\xc1\x83\xf9\x98\xc0\x5e\xcd\x04\x6a\x26\xb0\x62\x26\xb0\x8a\xf3\xbc\x6b\x22\x50\x4d\x04\xcc\x83\xbf\x6b\x1e\x50\xf3\x80\x9a\x07\x6c\xce\xcc\xc4\x9f\x31\x64\x1e\x86\x04\x7c\x44\x2e\x1d\xa2\xf4\x54\xa1\x0b\xc4\x42\x4c\xd4\x61\xa2\x1c\xc8\x64\x20\x7c\x04\x52\x9c\x05\xd2\xfe\x81\xba\x8e\x62\xcd\xab\x23\xba\xf6\x95\x27\xd3\x29\x46\x77\xb1\xc1\x86\x9f\xe5\x18\x9b\x9a\x78\x6c\x1e\xf1\x18\x48\x67\x93\xf4\x43\xba\xd4\x8a\xcf\x92\xa9\xda\x06\xfe\xe0\x95\xd8\xa8\xcd\xe0\x66\xa8\xb3\xbe\xb4\x94\x35\xcb\x9a\x8c\x45\x1a\x45\x97\x73\x14\xe0\xbd\x36\xfc\xaf\xa1\x16\x9b\xa9\x6b\x1d\xc9\x36\x7c\x35\x75\x85\x7d\x5a\x60\x5c\x33\xe7\x07\x3f\xb9\xa8\x5e\x77\x5d\x4f\xa1\x3d\xec\xb2\x9b\xb9\x68\xed\x7e\x73\x99\xb4\xb6\x3e\x13\x20\xcd\xae\xd9\x6b\x4e\x27\x38\x22\x5e\xef\x9f\x18\xc5\xa8\xc7\xf1\xed\xe6\x0d\x98\x2d\x4c\x1a\x66\x3b\x0b\x60\x9d\x14\x41\x2d\xec\xfd\x5b\xaa\x00\xa8\x5b\xd8\x6a\x36\xb0\x1a\x36\x30\x01\x67\xd7\xbd\x9e\x66\xb1\xda\x3f\x92\x61\xb5\xad\xb4\x58\xe9\xa1\xd2\xf9\x64\xab\x3b\xcd\x24\x2e\x38\xdc\xf5\x51\x08\x3f\x23\xc6\x93\x71\xae\x76\x3b\x49\x16\xa3\xc4\x89\x3c\xc8\xae\x92\x27\x05\x1c\x14\x76\xa2\x48\x42\x9f\x42\xe6\x39\x79\xf1\x02\x85\x51\x00\x05\x26\x83\xbc\xda\x4e\x80\xb9\xd0\x8c\xab\xd4\x98\x20\xbb\x41\xd8\xb0\xe1\x02\x67\x4b\xba\xe4\x28\x48\x2e\x99\xad\x3a\xac\x35\xbf\xa6\xd2\x62\xa6\xf9\x3d\x95\x95\xdd\xd4\xe9\xac\x46\x59\xd1\x25\x26\x38\x1b\x08\x54\xd6\xd1\x4b\x5c\xaa\xe2\xc0\xf6\xd2\x0d\xac\x3e\x56\xce\xab\x07\x0f\x4c\xdc\x20\xf6\xd0\x2b\xeb\x02\x48\xdd\x6d\x26\xde\x44\xe3\x84\x71\x20\x70\x85\x1d\xe4\x17\x09\xdf\xda\x77\xb5\x68\x78\xae\xdf\x11\xe2\xfc\x13\x23\x36\xba\x6f\xb3\x0a\x43\x6a\x95\x52\x07\xe8\xc6\x70\x7a\x87\x5f\xe1\xe8\x13\x0b\xce\x47\xc4\xad\x62\x43\x76\x70\x15\x70\xa0\xec\x82\xff\x9d\xd5\xcc\x29\xe7\x5a\x4d\x90\x69\xd5\x0f\xa7\xd1\x37\x69\xa3\x96\x11\x73\x74\x91\x14\x54\x39\x1f\x39\xb7\x95\x2e\xd5\xb0\x0c\x56\x91\xea\x61\x56\x36\x71\x97\xd3\x89\x7b\x19\xa4\xa9\x20\x4c\x3c\xa5\xd3\xed\x56\x95\x99\x9e\x64\xb5\x9b\xc7\x4a\xab\x2f\x78\xbc\xaf\x9d\xae\x44\x4a\xb5\x7d\x3f\xbc\x7d\x67\x77\x6d\x24\x4c\x77\x19\xc3\x6f\x77\x19\x6d\x5e\xa1\x07\x64\x7b\x9a\x0d\x23\x9e\x66\xfb\x15\xb7\x5f\x2c\x6c\xfa\x0f\x25\xc0\x47\xea\x1c\xea\x7f\x49\x8f\xef\x12\x32\x83\x95\xa6\x52\x1a\x93\xdf\x15\x4f\xaf\xb7\x3b\xcd\x30\x2d\x2e\xbb\x0f\x9e\x5e\x3b\xa5\xf7\x22\xec\x5e\xa9\xae\x6b\xfa\x76\x7a\xa9\x71\x49\x02\x99\x18\xf3\xf1\x5f\x9d\x83\x3b\x5d\x6d\x54\xa5\xd5\xd4\x7f\x74\xc3\xe2\x47\xa7\x94\xa3\x65\xe8\x0f\x19\x6f\xe8\x39\x5a\x8f\xa4\xad\xfd\xdd\xf2\x12\xaa\xf4\x3d\x6b\xa5\xec\x4b\x6a\xb6\x39\xf5\x2b\x7a\xc1\xfb\x7a\xc1\xfa\x57\xda\x7b\xfa\x0f\x6d\xaf\xc3\x81\xa7\xd7\x37\xab\x4b\x49\xc0\xb7\x94\x14\x5b\xec\xb5\x0b\x67\x72\x38\x4a\xdd\x32\x3d\x62\x00\x9c\xe6\x64\x55\xbd\x10\xab\x31\x20\xc7\xa5\xfe\x76\x62\xdf\xd9\xfd\xc4\x49\xf6\x10\x32\x0c\xfb\xc9\x85\xd9\x06\x8d\x2d\xaa\x31\x2c\x18\xf3\xd6\xdd\xff\x02\x00\x00\xff\xff\x2c\xf3\xab\xbc\x2e\xab\x00\x00")
-
-func manifestsDashboardsCoherenceMemberDetailsDashboardJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_manifestsDashboardsCoherenceMemberDetailsDashboardJson,
-		"manifests/dashboards/coherence/member-details-dashboard.json",
-	)
-}
-
-func manifestsDashboardsCoherenceMemberDetailsDashboardJson() (*asset, error) {
-	bytes, err := manifestsDashboardsCoherenceMemberDetailsDashboardJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "manifests/dashboards/coherence/member-details-dashboard.json", size: 43822, mode: os.FileMode(420), modTime: time.Unix(1647457998, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _manifestsDashboardsCoherenceMembersSummaryDashboardJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x5d\x6b\x4f\xdc\xb8\xfa\x7f\xcf\xa7\xb0\xf2\xef\xae\xda\xd5\x50\x66\x06\x68\x0b\xea\xee\x5f\x14\xce\x6e\xab\xd3\xee\xe9\x29\xb4\x52\xcf\x6e\x35\x32\xc9\xc3\xc4\x22\xb1\xb3\xb6\x33\x65\x40\x9c\xcf\x7e\x64\x3b\x17\x27\xce\xdc\x67\x80\x6d\xf3\x02\x31\xb1\x1d\x5f\x9e\xeb\xcf\x7e\xec\xf8\x66\x0b\x21\x0f\x53\xca\x24\x96\x84\x51\xe1\x1d\x22\x95\x84\x90\x17\x11\x21\xbd\x43\xf4\x87\x7e\x42\x59\xaa\xce\x39\x4f\x49\x24\xdf\x50\xef\x10\xf5\x3a\x65\x6a\x80\x25\x16\x2c\xe5\x3e\x78\x87\xc8\x7b\xcf\x59\x0c\x32\x84\x54\x78\x56\x19\xa0\xf8\x3c\x52\xf9\x92\xa7\x60\xa5\x87\x24\x68\x48\x25\x3e\xa3\xc7\x2c\x62\x5c\x55\xc8\x87\xe7\xf8\x71\xb7\x83\xfa\xbd\x5e\x07\xf5\xf7\xf7\x3b\xa8\xf7\xc4\xae\x9a\xe2\x58\x37\x7c\x54\x8e\x05\xfd\x88\x8e\x22\xe0\xb2\xd2\x05\x39\x4e\x74\xb9\x00\x8b\xf0\x9c\x61\x1e\x78\x59\xde\x6d\xc7\x1d\xe9\x2a\x63\x82\xab\x44\x77\xdc\x0f\x31\x1d\x82\x78\x3c\x02\x1a\x30\x7e\xe8\xb3\x10\x38\x50\x1f\x06\x7e\x94\x0a\x09\x7c\x20\xc8\x35\xdc\x8c\x80\x73\x7c\x7d\x8d\x29\xcb\xd3\x7f\xfe\xef\x9f\xde\xa3\xd1\x75\xf6\xf4\xa7\xd7\x41\x76\xc6\xb1\xf9\xfd\x3b\x8e\xe1\x4f\xef\xf6\x8f\x5e\xfc\xa5\x42\x8d\x8c\xa0\x17\x38\x12\xd3\x29\xaa\x29\x79\xf0\xcc\xfc\x55\x29\x1a\x91\x98\x28\x09\xe8\x75\xbb\x0d\x74\x3e\x0d\xd9\x57\x94\xf5\x02\x9d\x92\x6b\x40\xc7\x66\xa0\x76\x15\x22\x64\x5f\xb5\xa0\xd8\x35\x48\x3c\xfc\x27\x8c\x85\xa6\x8d\x79\xdf\xab\xe6\xaa\xac\x3f\xbe\xd8\x69\x44\x46\xf0\x2b\xe3\x31\x56\xfd\xf1\x1a\x5a\x0d\x9e\x36\xf1\x58\xd7\x95\xb3\x57\xff\xff\xb2\x95\x31\xda\x83\x80\xc8\x1a\xe3\xbc\x21\x05\xf9\x26\xf0\x0e\x11\x4d\xa3\xc8\xa4\x70\x9c\x84\x67\x8c\x45\x92\x24\xf9\x30\x3c\xa2\x8a\xec\x3e\x37\xbf\x25\x70\x2d\x6d\x8a\x50\xfb\x07\xfd\xde\x8b\xee\x8b\xdd\x67\xfb\x7b\x7d\x9d\x1b\x11\x7a\x29\x0a\x25\xca\x05\x4b\xb3\xa1\x2a\x83\x9d\x22\x8b\xfa\x51\x1a\xc0\x27\xcc\x45\x4d\xa4\xbc\x4b\x80\xe4\x8c\xc4\x75\x51\x73\x29\x66\xe8\xa5\x1a\x78\x85\xfd\x4b\x24\x19\x7a\x87\x09\x45\x27\x6e\x6b\xb2\x18\x99\x67\x25\x66\xd4\x53\x9d\x2f\x53\x53\x1e\xa9\xc4\x9d\x60\xc7\x67\xe1\x76\x8c\x09\xdd\x29\x44\x79\xbb\x18\x88\xce\x30\x24\xcf\xf4\xa9\x18\x34\x16\x27\x9c\x25\x01\xfb\x4a\xeb\x03\xc8\xc9\x01\x57\x12\x38\xc5\x11\xaa\xb6\xbc\x02\x49\x6c\x4d\xae\xa9\x7b\x03\xb5\x8e\x46\x98\x44\x4a\x26\x4a\x52\x09\x97\x2c\x41\x99\xb7\x95\x0b\x96\xae\xcc\x4b\x30\x85\xa8\x81\xdd\x3e\xa3\x12\xa8\x16\xdd\x97\x3e\x50\x09\xfc\x97\x97\xe1\xee\x2f\xef\x20\x3e\x07\x2e\xd0\x69\x1a\xc7\x98\x8f\xd1\x36\xb2\xb5\xfa\xe5\x4e\xb8\xfb\xcb\xcb\x9d\xac\x7c\xd9\x8d\x99\x26\xc9\x1b\x72\x12\xbc\x67\xa5\x25\x37\x06\xc1\x3b\x44\x7d\x4b\x45\xbe\xaa\xe7\x3d\x2b\xe1\xaa\xa6\xa5\x63\xf5\x5c\x37\x8d\x46\xf4\x0f\x8a\xf7\x4a\x01\x2f\xc9\x19\x33\x6d\x7a\xbc\x50\xc6\x91\xe7\x12\xd9\x25\xa8\x84\x2b\xd9\x2c\x32\x3e\x8b\xd2\x98\xd6\xea\x9f\x4d\x80\x0b\x46\xa5\xb2\x0d\x2a\xbf\xd7\xed\xfe\x30\x07\x69\x9e\x2d\x4a\x9a\x7e\x33\x69\xfa\xbd\x69\xa4\x49\xf0\x10\xb2\x8e\xe5\x16\x46\xa7\x0b\x9f\xb3\x28\xaa\x0b\xb1\x32\x9e\xaf\x01\x07\xc0\x9d\x1c\xc6\x65\x75\x0c\x3e\x8b\x6a\x0c\x0e\x40\xf8\xb9\x07\x70\xfa\x2a\xe4\x38\x82\xaa\x86\x94\x95\x29\x5d\x8d\x08\xd6\x06\x5a\x2b\x57\xa7\x96\x35\xd4\xda\x8a\x53\xc9\xaa\x59\x01\x96\x96\x95\xfe\xfc\xf9\xf3\xe7\xed\x77\xef\xb6\x4f\x4e\xd0\xeb\xd7\x87\x71\x7c\x28\x44\xb5\x78\x82\xa5\xd2\xf7\xe6\x66\x72\xe1\x08\x49\x10\x00\xf5\x8a\xac\xdb\xce\x8c\x1e\xcf\xdd\x5b\x5f\xf9\xc1\x77\x46\x58\x6d\x6e\x94\x99\x55\x02\xe9\x74\xe3\x33\xf7\xf6\x3b\x68\x7f\xcf\xfc\x75\x9f\x1e\x3c\xa9\x54\x5c\x94\xda\x7d\xde\x41\xbd\xfe\x41\x07\xed\x75\x55\xb1\x17\x13\xca\xed\x77\x3b\xa8\xf7\xbc\xdf\x41\xaa\xd6\xee\xd3\x83\xe7\x4f\x3c\xab\xd4\x97\x55\x28\x1c\x80\x4f\x62\xac\x4d\x52\xbf\x92\x11\xe3\x24\x21\x74\x78\x66\x88\xdc\x9b\xc4\x96\x4f\x38\x4a\x01\xfd\xdf\x51\x8d\x35\x21\x07\x11\xb2\x28\xa8\xfb\x69\x97\x6d\x95\xbc\x94\x6a\x38\xa1\xe4\x9a\xcb\x05\x38\x7a\x4a\xe4\xfc\x32\xd8\x72\x75\x26\x57\x85\x43\xcf\x79\x38\x2a\x24\x27\x74\xb8\x1e\x8e\x1a\xcf\x87\x94\x9b\x6b\x19\x3b\x07\x63\x35\x1e\xaa\x4f\x30\xb2\xf4\x12\x9c\x7a\x27\x9c\x44\x11\x52\x08\x4b\x61\xbe\xd8\x10\x39\x00\x89\x49\x24\xd0\x05\xe3\x08\x3d\xba\x19\x0c\x7c\x88\xa2\x5b\xcf\xa9\xe7\x63\x15\xdf\xe9\x97\xb7\xb3\x97\xff\x7f\x84\xf9\xb6\x85\x4d\x7e\xb6\x81\xca\x8f\x2a\xd3\x70\xd4\xe4\x35\xb7\x31\xa7\x70\x9a\x86\xef\x57\x3c\x7f\x67\x01\xa0\x37\x41\x2b\x9a\x56\xf1\x52\x34\xbb\xcb\xb0\x95\xb2\x00\xea\x14\x9d\x87\xad\x34\x75\xc5\x61\x59\xb6\xbe\xa1\x42\x62\xea\xb7\x26\xa7\x99\xaf\x4b\xf9\x12\xd2\x48\xd3\x3b\xf7\x27\xd8\x0f\x09\x6d\x19\x3b\x0f\x63\x97\xf3\x25\x86\xc0\x02\x09\x33\x57\x9d\xe9\x3e\xb2\xf2\xdb\x59\xf9\xa9\x0e\x64\x39\x3f\xd1\xc4\xf2\xbb\xb6\x28\xaf\x01\x27\xe8\xa3\xc0\xc3\x56\xf4\xec\xe2\xeb\x99\x75\xbc\x5a\x2f\x6f\x03\xf0\xcf\xc7\x12\xc4\xa2\xec\x7d\x87\xaf\x5a\xe6\xae\x9d\xb9\xc7\x0f\x83\xb9\x1f\x05\x04\xe8\x87\xa5\xf8\xeb\x8d\xd4\x48\x1a\xf2\x27\xb1\xb8\x81\x29\xab\xf0\xd8\x95\x98\x07\xc6\xe2\x93\x69\x2c\xae\x0e\xe8\xe9\x7e\x7d\x88\x4f\x5f\x4c\x1e\xce\x3c\x02\x91\x00\xf7\x81\x4a\xfd\x38\xbf\x4c\x7c\x60\x51\x6b\xc9\xed\xe2\x2b\x4a\x02\x77\xe8\x79\xd7\xc8\xf0\x53\x11\x56\xcb\x63\x55\xdf\x36\x7f\x27\x31\xcc\x62\x8a\x1b\x69\xbc\x5f\x16\x7d\xf7\x0c\xd9\x79\xfa\xd3\xce\x66\x71\xec\x56\xad\x6b\x9e\xc4\x7c\x08\x72\x4a\x00\x20\x0f\x5e\x8b\x34\x76\x03\xd7\x31\xc4\x8c\x8f\x07\x21\xe0\x24\xff\x9d\x2a\x10\x3c\x48\x05\x04\x2b\x46\xb2\x9f\xa0\xf3\x31\x7a\xec\xd6\xd1\xc9\xd6\xb3\x3a\xc8\x2c\x25\x74\x90\x20\x12\x3a\xf9\xcc\xa4\x83\x94\xad\xa9\xf2\xcb\xbb\x28\x2c\x9d\x89\xf4\x56\x32\xcd\xcc\x55\x36\x4d\x85\x08\x95\xc0\x47\x38\xfa\x15\xfb\x52\x87\xca\xab\x06\x2e\x82\x21\xd0\xa0\x34\xa3\xd5\x7a\x39\x5c\xe8\x10\xb2\xf7\x6a\xb6\x0e\x2c\x47\xe5\x18\x5f\xb5\x44\xce\x88\x7c\xbc\x29\x22\xdf\xbb\x28\xa3\x1d\xf4\xf7\x12\x0b\x84\x1e\x92\x60\x9c\x4c\x35\x7f\x79\x30\xfa\x38\xe5\x1c\xa8\x2c\xf6\xb1\x64\x21\x79\x2b\x46\xcd\x31\x15\x6a\x24\xee\x38\xac\x5d\x26\x2a\x79\x0b\xad\x2f\x80\x1d\x80\xf0\x39\x49\xb2\x6d\x25\xde\x59\x08\x48\xb2\x04\xa1\x47\x67\x2c\x79\x4b\x62\x22\xf3\x8e\x22\x19\x62\x89\x42\x3c\x02\x24\x43\x40\x21\x19\x86\x20\x24\x3a\x63\x12\x47\xe8\xb7\x63\x74\x46\x62\x40\x6c\x04\x5c\x67\xfb\xd9\x68\xa5\x4a\xe5\x98\x0e\xe1\xe9\x5a\x83\xe6\x3d\x3b\xfe\xdc\x14\x34\x7f\xb1\x55\x53\xd5\x6c\x3f\x41\x51\xd1\x03\x09\x9a\xab\xf7\x9c\xae\x7e\xc3\x31\x73\x23\x2f\xc7\x2c\x8a\xc0\x57\x42\x87\x16\x1a\xc2\xb7\x8a\xcb\x3e\xb9\x13\xfc\x55\x81\x59\xbc\xc8\x0a\x45\x1b\x25\xbd\x9f\x28\x69\x1b\x24\x5d\x6d\xce\x20\x59\x72\xf9\xb8\x70\x55\x1d\x0d\x63\x02\x88\x24\x76\xc1\xcc\xd0\x1f\xf8\x85\xd5\x19\x28\xbf\xb4\xea\x46\xd8\x47\x83\x81\xf6\x6c\x5f\x9e\xcc\x42\x33\x4f\xd6\x0c\x64\x5d\x30\xb2\x1e\x24\x73\x34\x1d\xc9\xc4\xf0\x2b\x67\x71\xdd\x39\xaa\xf4\xd3\x90\x5c\x48\x37\x23\x83\x3e\x67\x2c\xb1\xf0\x44\x15\x31\xa0\x6d\x94\x23\xa3\x0f\x8a\x98\xdf\x0c\x1e\x3a\x66\x29\x95\xf7\x08\x88\x2a\x09\xd3\x10\xd1\xf3\x16\x11\x3d\x2c\x44\xa4\x25\xe7\xdb\x76\xc3\x93\xb6\x78\x6c\x16\x12\xb5\x7b\xc7\x26\xb0\xa3\x45\x45\x79\x1b\x1b\x46\x45\x77\xb3\x92\xba\x34\x2a\xf2\x95\xe5\x59\x1b\x2c\x42\x2d\x2e\x5a\x0a\x17\x19\xe4\xb0\x31\x60\xa4\xcd\xdb\x71\x6e\x89\x6e\x4a\x1f\x7b\x6e\x4e\xbd\x54\x0e\x72\xe9\x23\x28\x6f\x81\x0e\x65\xa8\x8f\x67\x55\xd2\xa1\xa9\xf8\xcc\x13\x13\x44\xe3\x86\x5e\x25\xe1\x37\x8e\x03\x62\xce\xad\x74\x67\x63\x9f\x17\x8b\x2e\x06\xf5\xf6\x1c\x40\x61\xdc\xf4\x29\x70\xd2\x30\x0a\x0d\x8c\x9e\xed\x97\xc0\x48\x8b\x49\xb5\x23\xda\x07\x1c\x89\xb3\xe6\x13\x79\x78\x34\x6c\x38\x13\x97\x81\x40\xb7\x78\x8c\xaf\x1a\x8a\xc7\x84\x36\xa4\x2a\x90\xe5\xd6\x20\x95\xf4\x34\x94\xd6\x5b\x07\xc4\x24\x58\x15\x11\x0a\xce\x49\x27\x95\xf8\x95\x04\x86\xe3\xd3\xc0\xa1\x92\xea\xf7\x8c\x50\x99\xef\x53\x50\x09\x25\xa7\x59\x52\x3d\xe6\x59\x08\xc8\xdb\xa2\x2a\xa7\x43\x59\x30\x1d\x0f\x9d\x23\x85\x5e\xa2\x5a\x52\x72\x92\xaa\x77\xf7\xab\xe9\x2e\x0f\x39\xd0\x00\xb8\x46\xa2\xde\x45\xc4\x4a\x40\xe5\x09\xcd\xf4\x7f\x29\xb3\x44\x02\xa8\x8d\x49\x24\xd8\x87\x26\x81\x17\x12\xfb\x97\x4e\x2b\x42\x42\x92\x40\xf0\x96\x50\xb7\xc3\x73\xdb\xeb\xbb\x8e\x15\xec\xa0\xbb\x5c\xea\x47\x3f\xa1\x5e\xb7\x3b\xd1\xd0\x93\x18\x06\x86\x23\xcd\x96\x7b\x65\x9b\x6e\x9c\xfe\x21\xba\xb9\x31\x4e\xe7\xf6\x16\x3d\xbe\xb9\xe1\x2c\x82\xdb\xdb\x27\x4b\x19\xfc\x66\x47\x3f\xd5\x11\x7c\x80\x61\xa6\x0b\xb5\x17\xa6\x7b\x88\x77\x9a\x15\x66\xdf\x9f\xb5\x79\xc8\x3a\x4c\x79\x63\x1b\x06\xcc\x21\x70\x4d\x43\x36\xf7\xea\xd6\xad\xc2\x20\x77\x16\x84\x06\x64\x44\x82\x14\x47\xce\x01\xe5\xc2\xa1\xe8\xd3\xa9\x65\x07\xae\xf0\x15\xa9\x69\xf6\x79\xea\x5f\x1a\x71\xaf\xe2\xde\xe2\x94\x9e\xac\xce\x99\xf2\xe3\xbd\xb5\xd2\xcd\xe6\xad\x30\x63\x0d\x36\x63\x8c\xaf\xa6\x4d\x0d\x2d\xb8\xea\x00\xf2\x52\x0e\x33\xc3\x53\x43\x9c\xf8\x1c\xa2\xa6\xf7\x22\x36\x7c\x85\x85\x0b\x11\x8d\x1d\xf7\x1c\x79\x37\x96\xdc\xab\xa5\x5a\x43\x9d\x39\x29\x29\x7b\x6a\x70\xe2\x3a\xfa\xe9\x14\x37\xdd\x74\x92\x1b\xfb\xe9\xa8\xc5\xd8\x15\x89\x7c\x9e\x54\x77\x4a\x3a\xfd\x2d\x8c\x8a\x4e\x6f\xd9\x95\x7e\x87\x80\xc5\x59\xac\x59\x0e\xb1\x3c\xbb\x6b\xc4\x52\x4f\x6c\x01\xcb\x77\x01\x58\xee\x14\xaf\xb4\xc8\x61\x19\xe4\x60\x36\x94\x5b\xf0\xa1\x05\x0f\x9b\x03\x0f\xc5\x9e\xf0\x7b\xf7\xca\x2d\x7a\x58\x14\x3d\x54\x3c\xc9\xda\xc1\x43\xd3\x4a\x77\x3d\xc6\x74\xbf\x6b\x23\x7d\xf7\xf3\x12\x73\x20\x8d\x17\xe5\x73\x93\xb5\x9d\x07\x7f\x38\x6c\x9d\x01\x40\xea\x39\x21\x09\xe0\x3f\xc0\xd9\x6a\xab\x29\x9c\x0c\x43\x79\xda\xfc\x09\xa3\x66\x6b\x92\x59\xc4\xa2\x67\xb5\xac\x93\x32\x8a\xb5\x08\xda\x69\xfe\x78\x46\x0e\x77\xaa\x2c\x58\x0d\xef\x20\x2c\xd0\xb5\xa2\x5b\x8b\x7b\x72\xe6\x2c\x8d\x7b\xd6\xbf\x7c\x8e\xb6\xdd\xc5\x99\x0d\xb4\xc2\x2e\x2e\x04\x48\x84\x1e\x0d\x06\xb9\x02\x4f\xde\x53\x39\x19\x68\xe5\x2b\xf2\x35\xb1\x5e\x27\x08\x2b\xc1\xd7\x03\x47\x5c\xcd\x71\x5c\x74\x3e\xce\x76\x0c\xb4\x00\x6c\x45\x00\xd6\x9d\x20\x9f\x94\xd5\x0f\xe7\xe6\x88\xa6\x26\x79\x4b\xe1\x99\xbf\xf9\xa2\x8d\xed\x57\xbe\x2b\xdc\x75\x8f\x10\xcb\x59\xcd\x59\x0e\x63\x1d\x3c\x0c\x8c\xf5\x8f\x38\x91\xe3\x8d\xe1\xaf\x16\x63\xb5\x18\x0b\x2d\x80\xb1\xd6\xb0\x71\x73\x36\xc4\x5a\x47\x23\x19\xc2\xb2\x01\xd6\x32\x08\x6b\xed\x28\x0a\xfd\x2d\x60\xd4\x6f\x98\x9f\xe3\x21\xd4\x0f\x0a\xb4\x68\xea\x2e\x96\xb3\xe2\x8d\x2e\x64\xb5\x80\xaa\x92\xfe\x1d\x85\xc1\x0e\x66\x01\xa7\xfa\xda\xd4\xae\xfb\x55\xd8\xd9\xb8\xa9\xbf\xbb\x08\x42\x6a\xf7\xed\xb4\x61\xb0\xb5\x41\x15\xca\x02\x18\x24\xe9\x79\x44\x44\x08\x7c\x20\x52\xdf\x07\x21\x06\x1c\xcb\x55\xe1\xc4\xc3\x5c\x9b\x71\x03\x64\x0f\x1c\x5c\xbc\xcf\x79\x83\x4e\x0d\x6f\xd0\x07\x2c\xdb\xd0\xd8\xe6\xf7\xd5\xe8\x4d\xce\xeb\xf0\xd6\x5e\xaf\xdd\x59\xd3\x42\x0a\x34\x37\xa4\x70\xd6\x62\x96\xc3\x14\x7b\x2d\xa6\x68\x31\xc5\xfd\x61\x0a\x0e\x3e\x90\x51\x0b\x29\x1e\x20\xa4\xf8\x90\xb1\xa6\x45\x14\x56\x87\x5b\x44\xf1\xbd\x20\x8a\xe2\x46\x1a\x0e\x17\x4a\xe7\xd4\xa8\x77\xbb\xc6\x72\x78\xc2\x0f\x21\xc6\x9f\x80\x0b\x13\x87\xe9\x9b\xab\x8a\xf4\x61\x5e\xbd\x6f\x0a\x73\x73\xf9\x4e\xf5\x16\x9d\xea\x0d\x3a\xa6\x7a\x09\x71\x12\x61\x49\xe8\x70\x9e\x6b\xc3\x70\x14\x99\xf3\xa3\x8e\xd0\x96\x6e\xb7\xc2\x32\x01\x11\xf8\x52\xeb\xa4\x6b\xdc\xf4\xad\x31\xfa\x63\xed\xf5\x40\xe3\x28\x6b\xc4\x6b\x3c\x55\x3a\xd7\x9d\x5e\x01\x5c\x10\x4a\xf2\x40\x95\x96\x8e\x81\xd1\xa1\x86\x83\x73\x4d\x17\x6f\xd9\xd6\x22\xbb\xbf\xe8\xc8\x39\x9a\x6d\x07\x45\xa7\x7e\xa8\xd0\x8b\xd3\x48\x92\x06\x39\xc8\x2f\xe3\x2a\xbc\x8b\xfd\x52\xe9\xde\x2b\x97\x69\xfd\x95\x02\x1f\x2f\x3a\xac\x52\x90\x7a\x95\xd4\x21\x5c\xd5\xbc\x8b\x27\x2e\x49\xf2\x91\x47\xa7\x63\xea\x37\x81\x23\xd7\x9a\x4a\x3c\xd4\x72\x21\xfe\x9d\xf7\xcc\xab\xe6\x3a\x43\x50\x69\xcd\x85\x33\x73\x6b\xc6\x68\x65\xa4\x02\xce\x4c\x45\x8d\xf1\xa6\xa5\xa5\x74\xad\x82\xb5\xda\xfd\x70\xb7\x05\xae\x58\x48\x20\x9d\x2b\xe2\x68\xa0\x79\xba\xdd\x6b\x12\xd3\xe3\xbc\x77\xcb\x48\x69\xf3\x37\xbf\x17\x95\xd3\x8d\x50\x69\xdd\xf2\xdd\x6b\xe5\x7b\xdd\x9c\x9b\x06\x9b\x3b\x68\x64\xfc\x59\x93\xe8\xf7\xd7\x2d\xfa\xb9\xef\x5c\x44\xf4\xf3\x97\x1b\xde\xbd\x53\xf9\x5f\x9c\x8a\xad\xe9\x9f\x07\xa0\x38\xc7\xdf\x73\x7c\x72\x64\xcd\xb8\x51\x05\x9e\x3c\x1a\x0c\x70\x14\x6d\x08\xa4\xd4\xa4\x44\x00\x1f\x11\x5f\xcd\x59\xff\x4a\x41\xc8\x41\x8c\xaf\x06\x41\x6a\x6e\xae\x5c\x59\x6a\x94\x90\x2f\xa4\x78\xf5\x7b\x5e\xa7\xea\xdd\xa9\xe9\xba\x58\x44\xdd\x9a\xde\x59\x4d\xcd\xee\x96\x80\xad\xce\x4d\xd4\xb9\x99\x7a\x55\xfb\x8c\x4b\x5d\xcb\xcc\x1d\xb0\xb5\x0e\x6d\xc2\xbb\x61\x3f\x84\xf5\x58\xe5\xcd\xea\xd7\xb1\xea\x68\xa3\x76\xd5\xaa\x29\x7c\x99\xf3\xc2\x8a\x1e\x6c\x83\x94\x6a\x15\x69\x25\xe7\xb5\x3b\xc1\x75\xed\x36\x7b\xad\x45\xe7\x1a\x85\x0c\x9e\xb1\x04\xfd\x8e\xf4\x27\x57\x16\x31\xf3\xf9\x67\x5a\x26\xc8\xa2\xd5\xf5\x9b\xaa\x51\x98\xb6\xb2\x60\x0d\xbf\xba\x08\x65\x13\xa0\x67\x1b\x94\xdb\xce\x5c\x2d\xd5\xa9\x3c\x91\xce\x13\x28\x3d\x77\x43\x53\x87\xd4\x9d\x3c\xa6\xee\xba\xdb\x72\xae\xf5\x28\xdb\xda\xaf\xb4\x55\xfc\x6e\x34\x1f\xbd\xce\x6e\xa7\xd7\xed\x54\xaa\x9b\xa1\xae\xb9\x42\xf9\xa9\x90\x2c\x9e\x74\x07\xb8\x34\x57\x47\x67\x2b\x57\x17\x66\x0d\xda\xa3\xec\xeb\xf6\x6e\x37\xce\x1a\xf3\x24\xcb\x12\xbd\xca\x7b\x09\xf1\x2f\x75\xf0\x22\x7b\x3b\xb3\x34\xc5\xc6\x42\x5b\x02\xbd\x7d\x2b\xc0\xd6\xeb\x5a\x0f\xbb\xf6\x43\x2f\x2e\x7f\xef\x5b\xbf\x7b\xf6\x43\xd9\x33\x95\x63\xad\x29\xf7\xad\xdf\xbd\xec\x9a\xeb\x2f\xf9\x18\x48\x0c\x03\x57\x33\x26\xb7\x62\x57\xfc\xcc\xae\xd8\x6e\xa5\xbf\x67\x3f\x58\xd7\x8a\x3f\x0f\xec\xfe\xe6\x7d\xa9\x90\xef\x9a\x51\x28\xac\xa0\xf5\x41\xee\x62\x92\x55\xbf\x1d\xbb\x7a\x7b\xb9\x97\xea\x10\xa0\x57\x7e\x70\xac\xb8\x6b\xcc\xe4\x8f\x30\x27\xf8\xdc\x7c\x9e\xb0\xb6\x32\x59\x76\x64\x54\x2c\x82\xf6\xb6\x6e\xff\x17\x00\x00\xff\xff\x94\x31\xff\x02\x0a\x81\x00\x00")
-
-func manifestsDashboardsCoherenceMembersSummaryDashboardJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_manifestsDashboardsCoherenceMembersSummaryDashboardJson,
-		"manifests/dashboards/coherence/members-summary-dashboard.json",
-	)
-}
-
-func manifestsDashboardsCoherenceMembersSummaryDashboardJson() (*asset, error) {
-	bytes, err := manifestsDashboardsCoherenceMembersSummaryDashboardJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "manifests/dashboards/coherence/members-summary-dashboard.json", size: 33034, mode: os.FileMode(420), modTime: time.Unix(1647457998, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _manifestsDashboardsCoherencePersistenceSummaryDashboardJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x5c\x6b\x6f\xdb\x38\xd6\xfe\x9e\x5f\x41\xf0\xed\x0c\xa6\x2f\xec\xc4\x72\xe2\xb4\x09\xda\x2e\xd2\x64\x8b\x0e\xd0\x74\xbb\x9b\xb4\x40\x31\x2d\x0c\x5a\xa2\x6d\x22\x14\xa9\x21\x29\xc7\x4e\x90\xfd\xed\x0b\x52\x37\x4a\x94\x12\xdb\x71\x6e\x8d\x3f\x04\xb1\x78\xd3\xe1\xb9\xf1\x9c\x87\x14\x2f\x37\x00\x80\x88\x31\xae\x90\x22\x9c\x49\xb8\x0f\x74\x11\x00\x90\x12\xa9\xe0\x3e\xf8\xcb\x3c\x81\xb4\xd4\xd4\x0c\x62\x42\xd5\x9f\x0c\xee\x03\xaf\x55\x94\x06\x48\x21\xc9\x63\xe1\x63\xb8\x0f\xe0\x17\xc1\x43\xac\xc6\x38\x96\xd0\x6a\x83\x19\x1a\x50\x5d\xaf\x44\x8c\xad\xf2\x31\x09\x6a\x4a\x89\xcf\xd9\x21\xa7\x5c\xe8\x01\xc5\x68\x80\xfe\xe8\xb4\x40\xd7\xf3\x5a\xa0\xdb\xeb\xb5\x80\xf7\xd2\x1e\x9a\xa1\xd0\xbc\xf8\xa0\x98\x0b\xf8\x1d\x1c\x50\x2c\x54\x89\x04\x35\x8b\x4c\xbb\x00\xc9\xf1\x80\x23\x11\xc0\xb4\xee\xca\xfc\xff\xb9\x01\xc0\x95\x6e\x0e\x71\x40\x54\x85\x5a\x38\x62\x58\xfd\x19\xc0\x7d\xc0\x62\x4a\x93\x12\x81\xa2\xf1\x29\xe7\x54\x91\x08\xee\x83\x8e\x29\x24\xba\xc9\x76\xfa\x5b\x61\x61\xc8\xd1\xec\xea\xed\x6e\xf7\x3a\xbb\x3b\xdd\xdd\xbd\xbd\x5d\x53\x4b\x09\x3b\x93\x39\x97\x33\x1e\x9b\x99\x97\x89\x6c\xe5\x55\xcc\xa7\x71\x80\xbf\x21\x21\x2b\x1c\x83\x67\x18\x47\xa7\x24\xac\x72\x12\x2a\x34\x32\xef\xf8\x59\x94\x10\x65\x26\x06\xdf\x23\xff\x0c\x28\x0e\x8e\x11\x61\xe0\xc8\x7d\x5b\xc6\x2d\x4d\x67\x51\x1a\x0b\xaa\x0b\xb7\x82\x2d\x9f\x8f\xdb\x21\x22\x4c\xff\xc0\x02\x33\x1f\xb7\x73\x9a\x4d\x45\xc2\xdd\xab\x56\x79\x7e\x48\x1e\x09\x1e\x05\xfc\x9c\x55\x69\xcd\x66\x8e\xa7\x0a\x0b\x86\x28\x28\xbf\xf9\x16\xb3\xb7\x35\xb5\x22\xfa\x1a\xc6\x1c\x4c\x10\xa1\x5a\xfc\x05\x57\xa4\xcb\x96\xa0\xa8\xdb\xc8\x74\xc8\x0c\x06\x23\xc4\x30\xad\x91\xac\xcf\x99\xc2\x4c\x1b\x16\x7c\xe3\x63\xa6\xb0\x78\xf7\x66\xbc\xfd\xee\x0b\x16\x92\x48\xa5\x19\x08\x4e\xe2\x30\x44\x62\x06\xda\xe0\xc5\x21\x8d\xa5\xc2\xe2\x33\x0a\xf1\x9b\xad\xf1\xf6\xbb\x37\x5b\x69\x9f\x82\x94\x91\x20\xc1\x17\x5e\xd8\x6d\x62\x4f\x70\x1f\x74\x2d\xa5\x3f\xd7\xcf\x3b\x56\xc1\x34\xd3\xd5\xf4\x79\xa6\x9f\x33\x43\x28\xb8\xad\xf5\x78\xb7\x97\x3f\xe7\xda\x5a\x30\x2c\xe4\xc6\x72\xe1\x58\x85\x14\xba\x6c\x74\x59\xa6\xf0\x54\xd5\x2b\x85\xcf\x69\x1c\xb2\xca\xf8\x43\xce\xd4\x09\xb9\x30\x5d\xbd\x4e\xe7\xb7\x39\x26\xbe\x5b\x99\xb8\xd7\xbd\x61\xe2\xdd\x86\x89\x7b\xd7\x4d\x3c\x42\x23\x9c\x12\x96\x39\x03\x53\x2e\x7d\xc1\x29\xad\x2a\xa1\x1c\xf3\xf3\x8f\x18\x05\x58\x38\x35\x5c\xa8\xf2\x1c\x7c\xae\xbb\xdb\xd2\x0a\xb0\xf4\xd3\x7e\x0e\xa9\x52\xcd\x28\x2e\x2b\x78\x31\x96\x36\x35\x4a\x90\xae\x86\xc6\x36\x5a\x76\x55\x80\x14\xfe\xc0\x45\x88\x8c\x3a\x7e\xff\xfe\xfd\x7b\xfb\xf8\xb8\x7d\x74\x04\x3e\x7e\xdc\x0f\xc3\x7d\x29\xcb\xcd\x23\xa4\xb4\x4d\xd6\x8f\x95\x89\x77\x4c\x82\x00\x33\x98\x57\x5d\xb5\x6e\x20\xeb\x04\x8b\x09\xf1\x2b\xa3\xf9\xda\xeb\x1f\x27\xaa\x65\x73\xb7\xa8\x2c\xcf\xd8\x94\x9b\x15\xa2\xbb\xd3\x6b\x81\xde\x4e\xf2\xd7\xd9\xdc\x7b\x59\x1a\x38\x6f\xb5\xfd\xaa\x05\xbc\xee\x5e\x0b\xec\x74\x74\xb3\xd7\x0d\xed\x7a\x9d\x16\xf0\x5e\x75\x5b\x40\x8f\xda\xd9\xdc\x7b\xf5\x12\x5a\xad\x7e\xde\x86\x9b\x01\xf6\x49\x88\x8c\x8b\xe8\x96\x2a\x8c\xc3\xab\xae\x85\x69\x79\xb1\xd0\xc0\x23\x41\x28\x05\xda\x85\x6a\xff\x2d\x13\x2e\x82\x00\x2b\x44\xa8\x04\x43\x2e\xc0\x8b\xcb\x7e\xdf\xc7\x94\x5e\x41\x67\x9c\xaf\x25\x07\x9e\x76\x6e\xa7\x9d\xff\x31\x41\xa2\x9d\x8a\x45\x7b\x9e\xb7\xf9\x40\xbf\xeb\x1a\xcb\x27\xbd\xb5\x1d\x54\xf9\x2d\x21\x8a\x22\xc2\x46\xa7\x89\x5a\x78\x4d\x8a\xc4\x9c\x8e\x6a\x2c\xb0\x1c\x73\x1a\x94\xed\xad\xa4\x64\x52\x09\xc2\x46\xe5\x7e\x31\x23\x86\xf1\x72\xac\x0d\x6a\x7e\xfd\xb3\x3d\xaf\xd1\xb8\xb5\x22\x2e\x26\xc2\xa8\x60\x60\x3f\x74\x18\x78\xdf\xe2\x3c\xc6\xe1\x00\x0b\x70\xc8\x63\xa6\x9e\xb3\x28\x3b\xcb\x88\xf2\x1b\xa2\x31\x06\xff\x77\xb0\xb8\x08\x59\xac\xd9\x5e\x2f\x42\xc6\x19\x5e\x40\x82\x07\xbe\x22\x13\x0c\x4e\x22\xe4\x63\xf0\x55\xe2\xe0\x39\x8b\x71\x29\x8b\x4c\xc5\xf8\x7e\xb5\x62\x0c\xb0\x3f\x98\x29\x2c\x17\x31\x46\x34\x05\x9f\x90\x76\x0d\xb3\xe7\x2c\x44\xef\x16\x42\x3c\x5c\xad\x10\xc3\x45\xc4\xf7\x0d\x0b\x81\x2e\x2e\x10\xe3\x20\x5d\xe7\xcb\x83\x22\x4a\x46\x86\x56\x14\x2b\xfe\x0b\x08\xb8\xc9\xec\x2c\xa9\x4c\x72\x96\xf4\xfd\x3a\x96\xdc\xf7\x72\xf7\x3c\xb8\xbe\xb5\xf9\xff\x5b\xab\xb5\x83\x2a\x9f\x37\x2a\xa4\x41\x85\xc4\x08\xab\x6b\xf2\x2a\x3c\x8d\x0c\x2e\x25\xe3\xf0\x8f\x09\x66\x01\x17\xfb\x39\x06\xd2\x4f\x23\xea\xbe\x1d\x1b\x21\xb3\xae\xf5\xa5\x5e\xd7\xfa\xb1\xc4\xc1\xa5\xab\x4c\x6f\xff\xfb\x03\xbe\x98\x5c\xa4\x4f\x3f\x60\x0b\xd8\x15\x56\xb0\xfd\x03\x5e\xbd\x04\x83\xd9\x1f\xee\x10\x2d\x1d\x52\xb7\x40\x35\x2a\x2b\x0b\x0a\x0e\x73\x47\x96\x60\x5c\xa5\x4a\xc2\xa4\x42\x06\xa3\x70\x92\x10\xc2\x14\x16\x13\x44\x3f\x20\x5f\x19\x5c\xae\xec\xbf\x28\x1e\x61\x16\x14\x5e\xb2\x3c\xae\xc0\x43\x03\x9e\xc1\xf7\x37\x6b\x78\xc6\xde\x10\x4d\xe7\x63\x2f\x4d\xd6\x99\x7e\x88\xa6\xcf\x97\xb1\x87\xd7\xaa\x74\x06\xca\xd8\x29\xcf\x3f\x0d\x22\x1b\x80\x34\xdf\xb3\x31\x2e\x81\x98\xd4\xd3\x71\x27\x53\x80\x39\xa6\x78\x03\x3c\x24\x9a\x53\x2a\xb8\x0e\xce\xe9\x3e\x24\x9c\xe3\xb9\x70\xce\x10\x51\xf9\x90\x78\x4e\x0a\x34\x18\x32\xd6\x40\xcf\xa3\x8d\xe6\x1f\x1e\x22\x39\x22\xf2\x6c\x9d\x8f\xdd\x32\x1f\x7b\xf8\x64\x0c\xbb\xc3\x3d\x67\xe1\x2d\x85\xb3\x86\x09\xbc\xb4\x14\xcc\x9a\xf4\x2d\xa1\xac\x4d\x58\xaa\x01\x5a\x13\x81\x95\x11\xd8\xa5\x40\xd6\xb0\x46\xf2\xab\x0e\xa0\xd7\xb9\xe4\x3a\x97\x7c\x12\x5c\x5f\xe7\x92\x0b\xa4\x3c\x89\xe7\x78\x54\x29\xce\xc1\x5c\x29\x8e\x03\x22\x83\x2d\x50\x59\x00\x1f\x6b\x82\x73\xe3\x3e\xfd\xeb\x86\xfc\x66\x77\x9d\xdf\x3c\x64\x7e\xf3\x0b\xb8\xcf\xfb\x0d\x8b\x1f\x36\x20\x59\x6f\x33\x3d\xce\xdd\xc2\x25\x32\x9b\x53\xae\x10\x05\xda\xbb\x01\x3e\x04\xa9\x60\x75\xd2\xfa\x9c\x45\xfa\xb4\x77\x0e\x7f\x03\xc5\x01\x3c\xce\xe6\x93\x29\x9c\xb8\x79\xf6\x2f\x27\xd6\x3b\xdb\x4b\x2c\xcf\x68\xb3\xdb\xa9\x4e\x72\xb3\xd7\x69\x9e\xd0\x3c\x6a\x10\x61\xe1\x63\xa6\xcc\xe3\xa2\xc6\xbd\xb8\x36\xfc\xfa\x16\x7e\x1b\xa7\x7d\xf4\xe0\x16\x7e\x8c\xfc\x31\x61\x6b\x38\x38\xaf\x58\x0e\x8f\x4a\xb8\x28\x81\x4c\x0e\x0d\xdf\x88\x41\xa5\xed\xdb\x69\xfb\x6b\x51\xa8\xe5\xc0\xa6\x3a\xb9\xae\xd1\xa6\x07\xc7\x3d\xd6\x68\xd3\x1a\x6d\x4a\x1a\x3c\x5e\xb4\x29\x71\x1d\x8f\x16\x6e\x6a\x50\xe9\x8c\x9f\x68\x32\x5a\x82\x9f\x4a\xc7\x37\xcf\x88\xa1\x0b\x9c\xfd\x58\x8e\xa1\x28\x0b\x15\x9f\x11\x53\x8f\x9e\x0a\x53\xc1\x16\x58\x9b\xc8\x6a\x4e\xf1\xd4\x42\xdc\x95\xe0\xeb\xb6\x18\xb7\x59\xad\x0f\xb3\xe5\xf7\xb2\x40\x71\x07\xc9\x07\x78\x25\x6c\xd5\x7c\x0d\xf7\x09\xb3\x91\x1a\xeb\x99\x77\x4a\xe5\xb8\xa6\x39\x96\xbe\x20\x51\xfa\x6d\x24\x3c\x19\xf3\x73\x09\xd4\x18\x03\x23\x6f\x90\xa8\x00\x30\x2a\x00\xf4\xaa\x03\x06\x33\x80\x28\xcd\xbe\x75\x91\x9b\xc5\x54\x86\x84\x96\x40\xe8\x66\xb4\xfd\x75\xc1\xf2\xf9\x3e\x0e\xf3\x76\x36\x2a\x86\x95\xc0\xed\xbd\x57\xf9\x73\x22\xd6\xf2\xbb\x4c\x84\x79\x20\x4f\xd3\x2f\x48\x2b\x20\xb4\xb6\xc2\x9a\x52\x3f\x16\x02\xd7\x68\x16\x0c\xd1\xb4\xa6\x90\xb0\x9a\x31\xe4\x98\x9f\xbb\x6d\x0d\x4b\x6b\x5a\x1b\xc8\x44\x36\x7d\x5b\x46\x75\xaa\x50\xdd\x01\xd0\x85\xe7\x24\x48\x84\x6c\x97\x56\xb7\x1c\x74\x1c\xf7\x85\x13\xa6\x32\x7c\x46\x17\x14\x32\x4b\xe1\x00\x34\x72\xf8\x03\x23\xdd\x4b\xa0\x80\xc4\x7a\xc8\x5e\xb9\xdc\x55\x24\x81\x59\x80\x85\xd9\xab\x80\x43\xca\x8b\xef\x3e\xa0\xc4\x82\x60\xf9\x2f\x6d\xf7\x24\xc0\x15\xfa\x8c\x66\xd5\xe9\xab\x54\xc8\x77\x76\x0e\xa0\x54\x38\x8a\x70\xf0\x49\xdb\x57\xb5\xee\x09\xc4\x5c\x8d\x0e\x8e\x84\x86\x02\x82\x65\xd5\xcd\x2d\xe4\xcb\x8a\x43\x42\x73\x2c\xfc\xae\x57\xab\x8f\x8a\x0d\x75\x1f\x04\x0f\xab\xdb\x56\xba\xfc\x3f\x78\x94\x7e\xb1\x5e\xe9\x70\x32\x26\x43\xe5\xf6\x68\xf4\x9b\x6d\x70\x40\x69\xdd\x09\xc8\x3c\xeb\xbe\xb4\xed\x0b\x09\x1c\xb8\x16\x96\x6e\x8c\x75\xaa\xc6\xd5\xcf\xdc\x2c\x61\x01\x99\x90\x20\x46\x14\x3a\x86\x96\xb5\x31\x5f\x93\x17\x04\x4c\xd1\x94\x54\x7c\xd8\x20\xf6\xcf\x12\x4d\x2b\xa7\x49\xf9\x87\xb8\xaa\xbc\xa3\x95\x7d\x17\x5f\x69\x5d\xef\x25\x72\x6f\xf0\xd7\x4f\x87\xc4\x19\x9a\x5e\xb7\x6d\x67\x25\x43\x4e\xfe\x56\x28\x5b\x8e\x13\x95\x55\x09\x0d\x30\x75\xd7\x43\xca\x47\xef\x91\x74\xc1\x86\xc4\x19\x3a\x6f\x49\xdc\xa1\x53\x6c\xcd\xf4\xc6\x18\xa9\x20\x34\xc9\xb2\x6a\xa9\x74\xde\x70\x87\x74\x3a\x76\x32\x73\x35\x22\xc3\x32\x9c\x25\x46\x97\x7f\xc2\x93\x9c\xe8\x0d\x7b\xd0\x47\xbf\xd2\xa7\x4e\xf1\x6e\x16\x79\xe7\xcc\x70\xd3\x2a\xbf\xbb\x77\xef\xab\x7c\xb5\x79\xfd\x32\x2f\xc8\x68\xac\x4e\x92\x6b\x33\xd6\x21\xc0\x9d\x85\x00\xe5\xb3\x0f\x4f\x3b\x02\xd0\x56\x55\x97\x02\x01\xbd\x44\x2c\x1f\x1f\xb8\x8e\x7b\xc1\xc8\xe1\xf2\x52\x13\x70\x75\xf5\xe4\xe2\x86\xc1\x0c\x54\x0f\xb5\x2f\x15\x33\x74\xd7\x31\xc3\x3a\x66\x58\x19\x9d\x77\x18\x33\xe4\xb7\xdb\x08\x3c\xd4\x76\xa7\x67\xbd\xdd\x49\x24\x03\xa5\x3f\xc6\x21\xfa\xa6\x3d\x58\x72\xd9\xd1\xeb\xa4\x58\xcd\x68\x7a\x57\x8e\x48\xb6\x6b\xcb\x37\xf2\x94\x6f\xe3\x49\x86\x57\x38\x8c\x28\x52\x84\x8d\xe6\xb9\x8e\x0a\x51\x9a\x9c\xb0\x77\x34\xb6\x58\x69\x4b\x22\x93\x98\x62\x5f\x19\x83\x74\x0f\x87\x99\xfb\x69\xf6\x01\xfc\xcc\xab\x9b\x58\x93\xf4\x25\xb0\x16\x6c\x9b\xeb\xfe\xab\x00\x0f\x09\x23\x59\x1c\x64\xb4\xa3\x9f\x18\x50\x8d\x63\x2e\x5d\x6f\x95\xde\x90\x65\xa7\x17\xe9\x5d\x48\x07\xce\xf1\x3d\xdb\x3c\xae\xdd\x09\x83\x61\x4c\x15\xa9\xd1\x83\xec\x32\xad\x7c\x69\xb1\x3b\xf1\x48\x39\xfe\x13\x00\xf8\x77\x8c\xc5\x6c\xd1\x69\x15\x8a\xe4\x95\x4a\x47\x78\x5a\x31\x6e\x28\xcf\x48\xf4\x55\xd0\x93\x19\xf3\xeb\x62\x1e\x37\xfd\x52\x68\x64\xf4\x42\xfe\x3b\xa3\x0c\x96\x6b\x9d\x29\xe8\xb2\xfa\xc6\xa9\xaf\x4d\xe6\x68\x55\xc4\x12\x9f\x26\x03\xd5\x9e\x76\x5c\x5a\x4b\x57\xaa\x58\x95\x60\x23\x95\x43\x5f\x92\x8b\x79\xe0\xdc\xab\x3c\xa8\x58\x48\x21\xab\x02\xca\x35\xf2\x30\x23\x64\x19\x85\xac\xdf\x9d\x5e\x54\x25\xef\x84\x21\xab\x56\x65\x6f\xad\xca\xab\x96\xdc\x75\xe1\x71\x0b\x4c\x92\xa5\xab\x4e\xcb\xbb\xb7\xd0\xf2\x6c\x45\x5c\x44\xcb\xb3\xce\x35\x7d\xef\x55\xd5\x17\x67\xd8\xda\xa1\x37\x86\x1d\x59\x64\x71\x60\xa5\xc0\xa0\x14\x58\xbc\xe8\xf7\x11\xa5\x77\x14\x5e\x34\xa4\x9c\x02\xff\x1d\x63\xa9\xfa\x21\x9a\xf6\x83\x38\xb9\xaa\xf2\xd6\x9a\x51\xcd\x28\x6f\xb4\xa3\xa6\xf0\xc5\x01\x65\xe7\xb0\x9e\xba\x3e\xb7\xb3\x9a\xfb\xe5\xd5\xda\x84\x9e\x88\x09\xf9\xc8\x1f\xe3\xd5\xb8\xd2\x95\x19\xcc\xa1\xa6\xa9\xd6\x5c\x2a\x3d\xf2\xb5\xc6\xe9\x70\xcb\x15\xe6\x0e\x99\xb2\xb6\x8c\x66\xcb\x70\x09\xb4\xec\x65\xbb\xc1\x5a\xb6\xeb\x0d\x65\xe9\xd8\xfe\x94\x47\xe0\x33\xf8\x44\x42\xa2\x16\xf1\xd8\xa7\x3c\x72\xfa\x58\x5a\x68\x91\x6e\xcf\xf9\x86\x4c\xde\x9a\xbe\x57\x3d\xeb\x97\x33\xc0\xb3\x8f\xf7\x5d\xb5\xe6\x7a\x53\xf5\xd4\x47\x23\x9f\x1b\x38\x3d\xf7\x8b\xae\x9b\x52\xaf\xf1\x4d\xbd\x15\xbf\xc9\x73\xbe\x04\x28\xb8\xd7\x59\xf5\xbb\x9a\xa7\xe5\x95\xe7\x95\xff\xae\x75\x51\x5e\x6b\xbb\xd5\x6b\x79\x9d\x56\x69\xc0\x1b\x9c\x42\x66\xb6\x7e\x2c\x15\x0f\x9b\xae\x05\x57\xc9\x15\xd3\x29\x2a\x35\x4c\x30\x66\xc8\xf8\x79\x7b\xbb\x13\xa6\x2f\x83\x8a\xa7\x85\xb0\xd4\x2f\x22\xfe\x99\xd9\xa3\x48\x7b\xa7\xfe\xac\x9f\x01\xe6\xb6\xb6\xc3\x9e\xb5\x13\xed\x75\xac\x87\x6d\xfb\xc1\x0b\x8b\xdf\x3d\xeb\xb7\x67\x3f\x14\x94\xe9\x1a\x0b\x2c\xee\x5a\xbf\xbd\xf4\x3a\xec\x9f\xd9\x1c\x48\x88\xfb\xae\x15\x36\xbf\xc5\x1e\x78\xd7\x1e\xd8\x7e\x4b\x77\xc7\x7e\xb0\x6e\x1a\x7f\x15\xd8\xf4\x66\xb4\x94\xd8\x77\xc1\x19\xce\x7d\x6d\x01\xcc\x17\xa9\x56\xdd\x2d\xda\xe5\x4b\xcd\x61\x6c\xb6\xf5\xa0\xcf\xc7\x6d\x6b\xb3\xa5\x6d\x1f\x9f\x87\x93\x1c\xc3\xec\x6e\x5c\x6d\xfc\x2f\x00\x00\xff\xff\x8a\xa5\xbe\xbf\x22\x5f\x00\x00")
-
-func manifestsDashboardsCoherencePersistenceSummaryDashboardJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_manifestsDashboardsCoherencePersistenceSummaryDashboardJson,
-		"manifests/dashboards/coherence/persistence-summary-dashboard.json",
-	)
-}
-
-func manifestsDashboardsCoherencePersistenceSummaryDashboardJson() (*asset, error) {
-	bytes, err := manifestsDashboardsCoherencePersistenceSummaryDashboardJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "manifests/dashboards/coherence/persistence-summary-dashboard.json", size: 24354, mode: os.FileMode(420), modTime: time.Unix(1647457998, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _manifestsDashboardsCoherenceProxyServerDetailDashboardJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x5d\x6b\x6f\xdb\x38\xd6\xfe\x9e\x5f\x41\xf0\xed\x3b\xd3\x2e\x9c\xc6\x76\xe2\x69\x1b\x74\xba\x48\x93\xe9\x74\x80\x66\xa6\xdb\xa4\x05\xba\xdb\xc2\x60\xa4\x63\x9b\x08\x45\x6a\x49\xca\xcd\x05\x9e\xdf\xbe\x10\xa9\x0b\x29\xc9\x49\xec\xd8\x49\xa6\xa3\x0f\x45\x23\x92\xa2\x0e\xcf\x79\x78\xf8\x1c\xde\x7c\xb9\x81\x10\x26\x9c\x0b\x4d\x34\x15\x5c\xe1\x5d\x94\x26\x21\x84\x19\x55\x1a\xef\xa2\xff\x98\x27\x94\xa5\x9a\x9c\x93\x84\x32\xfd\x1b\xc7\xbb\xa8\xd7\x29\x53\x43\xa2\x89\x12\x89\x0c\x00\xef\x22\xfc\x5e\x8a\x08\xf4\x04\x12\x85\x9d\x32\xc0\xc9\x09\x4b\xf3\xb5\x4c\xc0\x49\x9f\xd0\xb0\x21\x95\x06\x82\xef\x0b\x26\x64\x5a\xa1\x1c\x9f\x90\xc7\xdd\x0e\xea\xf7\x7a\x1d\xd4\x1f\x0c\x3a\xa8\xf7\xc4\xad\x9a\x93\xc8\x7c\x78\xaf\x6c\x0b\xfa\x01\xed\x31\x90\xda\x13\x41\x9f\xc7\xa6\x5c\x48\xd4\xe4\x44\x10\x19\xe2\x2c\x6f\x66\xfe\xff\xba\x81\xd0\x2c\x2d\x8e\x21\xa4\xba\x22\x2d\x1e\x73\xd0\xbf\x85\x78\x17\xf1\x84\x31\x9b\x22\x49\x3c\x39\x16\x82\x69\x1a\xe3\x5d\xd4\x35\x89\x34\x2d\xb2\xfd\xc2\xfe\xad\x41\x1a\x71\x52\x75\x0d\x5e\xf4\x7b\xcf\x77\x06\xcf\xb7\x07\x83\x67\x26\x97\x51\x7e\xaa\x0a\x2d\xe7\x3a\x36\x2d\xf7\x85\xec\x14\x59\x3c\x60\x49\x08\x9f\x88\x54\x15\x8d\xe1\x53\x80\xf8\x98\x46\x55\x4d\x62\x4d\xc6\xe6\x1b\x5f\xcb\x14\xaa\x4d\xc3\xf0\x6b\x12\x9c\x22\x2d\xd0\x7b\x29\xce\xce\xd1\x11\xc8\x29\x48\x85\x8e\x92\x28\x22\xf2\xbc\xfc\xa8\x2e\x1a\x88\x9d\xc4\x4c\x93\x69\x1b\xca\xd4\x44\xb2\x34\x71\x2b\xdc\x0a\xc4\x64\x33\x4e\xeb\xdd\x54\x20\xa7\x34\x00\xb5\xa9\xb2\x8a\x4d\xe1\x59\xe7\x9e\x1b\x7d\x48\x28\x47\x07\xf5\xaf\xdd\xa4\x61\x11\xa1\x3c\xfd\x03\x24\xf0\x00\x36\x0b\x99\x4d\x46\x73\xfb\x88\x3a\x90\x22\x0e\xc5\x37\x5e\x95\x35\x6f\x39\x9c\x69\x90\x9c\x30\xe4\x7f\xf9\x16\xad\x77\xbb\x67\x05\xef\x0d\x8a\xd9\x9b\x12\xca\x52\xcc\x97\x5a\x51\x75\xb5\x84\x65\xde\x46\xde\x71\x4c\x65\x38\x26\x1c\x58\x03\x9c\x03\xc1\x35\xf0\xd4\x9b\xe0\x97\x01\x70\x0d\xf2\xd5\xcb\xc9\xf6\x2b\x1f\x74\x07\xa0\x09\x65\x0a\x6d\xa2\x47\x47\x16\x2f\xbf\x93\x08\x5e\x6e\x4d\xb6\x5f\xbd\xdc\xca\xde\x2a\x85\xb9\xd6\xdb\xe0\xb1\xa4\xe1\x7b\x51\x3a\x34\xeb\x68\xf0\x2e\xea\x3b\xde\xe0\x5b\xfa\xbc\xe3\x24\x9c\xe5\x9d\x38\x7b\x3e\x4f\x9f\x73\x0f\x51\x5a\xc4\x74\xf0\x67\xc5\x73\xd1\x8d\x4b\xa5\x46\xc2\xb8\x34\x3c\xd1\x11\xc3\x75\x55\xd7\xd5\xaa\xe1\x4c\x37\x03\x27\x10\x2c\x89\x78\xa5\xfe\xeb\x15\x30\x12\x5c\x1f\xd1\x0b\x93\xdf\xeb\x76\xff\xff\x06\xaa\xf9\x69\x51\xd5\xf4\xe7\xa8\x66\xfb\x2a\xd5\xc4\x64\x0c\x99\x60\xb9\x1f\x35\xe9\x2a\x90\x82\xb1\x2a\x94\xd5\x44\x7c\x7b\x0b\x24\x04\x59\xcb\x11\x52\xfb\x6d\x08\x04\xab\x8e\x4a\xa0\x82\xec\xbd\x9a\xa8\x4a\x9f\x33\xf0\xbb\x49\x59\x57\xda\x61\x19\x25\x69\x36\x36\x3d\xac\x53\xc9\x1a\x9b\x2e\x4b\x12\x2d\xfc\xac\x90\x68\x78\x23\x64\x44\x0c\xde\x3f\x7f\xfe\xfc\x79\xf3\xf0\x70\xf3\xe0\x00\xbd\x7d\xbb\x1b\x45\xbb\x4a\xf9\xc5\x63\xa2\xd3\x4e\xdf\xfc\x99\x1c\x1b\x13\x1a\x86\xc0\x71\x91\x35\xeb\x5c\x27\xb1\xd0\x84\xa1\xd7\xe7\x1a\x14\x3a\x4a\xbb\xde\x4d\xa5\x0f\xd2\xf1\xf6\xd0\x62\xd7\x35\x4e\x99\xe9\x2b\xcc\xa4\x9b\xb1\xb9\xbf\x33\xe8\xa0\xc1\x8e\xfd\xd7\x7d\xfa\xe2\x89\x57\x71\x51\x6a\xfb\x59\x07\xf5\xfa\x2f\x3a\x68\xa7\x9b\x16\x7b\x3e\xa7\xdc\xa0\xdb\x41\xbd\x67\xfd\x0e\x4a\x6b\xed\x3e\x7d\xf1\xec\x09\x76\x4a\x7d\xbd\x8d\xc6\x43\x08\x68\x44\x8c\x9f\xea\x7a\x19\x11\x89\x63\xca\xc7\xc7\x56\xe9\xbd\x79\x66\xfa\x44\x58\x02\xe8\xff\xf6\x2a\xa6\x9a\x48\x50\x13\xc1\x42\x1f\xec\x9e\x19\x79\x12\x9d\x80\xf4\xdf\x4b\x38\x35\x62\x87\x10\x9c\xa4\xe6\x5a\xc0\xc8\x99\x9b\x6c\x6d\xdb\x68\xdb\xbe\x97\x61\x46\xd4\x2a\xc3\xcc\xd2\x8f\x1b\xd8\x4d\x91\xf9\xd1\x1b\xf6\x33\x26\xb3\x19\xda\xc1\xea\x9f\x53\x22\x37\x9d\xd1\xea\xe7\x47\x97\xc3\x61\x00\x8c\xcd\x7e\x48\x73\xf6\x59\xa2\x34\x48\x9b\xe3\x3c\xe0\x65\x60\xc7\x6b\x2f\xde\x04\x72\x4a\x4b\xca\xc7\xcd\x90\x53\x93\xd4\x81\x2e\xe7\x54\x3e\x40\x00\x74\x0a\x61\x0b\x3e\xa7\xf8\x6a\x1c\xcb\xeb\x7b\x77\x2c\x7f\x24\x7a\x2c\x28\x1f\x1b\x5b\xa3\x94\x2e\x33\x31\x5e\xca\xd2\x78\x9a\xb6\xa9\x21\x7f\x9e\xb1\x1b\xcc\x73\x1b\x6b\xd7\xb1\xf3\xc0\x8c\xbd\x7f\x95\xb1\xfd\x06\xf5\xaa\x2d\x1c\x74\xe7\xb7\x66\x4d\xc8\xd8\x4f\xa4\x04\xae\xd1\xbe\xe0\x1c\x02\x3b\x63\xd0\x7a\x80\xb2\xf8\x6a\x40\x71\xb0\x5a\x0f\xb0\xa8\x9f\x3f\x84\x7a\x5d\xad\x5d\x6f\x43\x2b\x0e\x24\x65\x0c\xa5\xa1\x3f\xd2\x02\x45\x46\xbf\x28\xe3\x10\x68\x24\x24\x2a\x78\xc3\x75\x04\xc4\xbe\xeb\xf1\x0f\x6b\xae\x75\xd3\x8f\xa8\x01\x14\x77\x0d\xcc\xb7\x42\x69\xf4\xf1\xc3\xbb\x16\x9a\x4e\xf1\x79\xd0\xbc\xa1\x5d\x27\x42\xe9\x61\x22\xd9\x43\xa0\x96\x87\xa0\x14\x19\xb7\x21\xeb\xba\xc6\x95\x5f\x56\xdb\x7d\xb9\xe0\xb0\xbc\x8d\xdb\x08\x62\x6d\x76\x7e\x73\xaf\x76\xfe\xc8\x49\xa2\x27\x42\xd2\x0b\x08\x1d\xa2\x88\xf6\xb4\x86\x28\xd6\xcb\x11\xc6\xbf\x45\x20\xb1\x94\x03\xcf\x6c\xfe\xeb\x2d\x02\x89\xfe\xed\xe2\x88\x05\xf1\xf1\x09\xa4\x24\x17\x17\x84\x0b\x94\x91\x92\xef\xdb\x05\xcc\xb3\xaf\x63\xc3\x69\xa1\x92\x61\xd0\xa4\x92\xbb\x1e\x8e\xff\xf6\x06\xd9\x7a\xfa\x8f\xad\xf5\x72\xdd\x8d\x8a\x68\x58\x13\x39\x06\x7d\xc5\x72\x04\x9c\xc5\x66\x25\x5c\x25\xd1\xe3\x29\xf0\x50\xc8\xdd\x62\x01\x72\x18\x14\x7e\x76\x18\x11\x4e\xc6\x20\xdd\xa4\x40\x24\x5c\x5f\xd6\x51\xf6\xf3\x9f\x5f\xf0\xa3\xe9\x45\xf6\xf4\x05\x77\x90\x9b\xe1\x84\x0c\x5f\x70\x87\xa7\x61\xc4\x9f\x3f\xba\x8b\x73\x3f\xce\x9e\xa0\x93\x73\xf4\xb8\x5e\x71\x27\x0b\x72\x3a\x28\xe7\x97\xbe\xdd\xf0\xa8\x70\x90\x76\x91\xdd\xcb\xa4\x5c\x69\x62\xd6\x0b\x6b\x81\x15\xe5\x1a\xe4\x94\xb0\x37\x24\xd0\x66\x63\x80\xef\x17\x19\x8c\x81\x87\xa5\xf7\xf5\xeb\x95\x30\x32\xab\xf7\xf8\xe0\xfa\xbe\xb0\xa8\xb6\x75\xca\x6e\x86\x51\xc6\x6e\x86\x32\x63\x37\xad\xd2\x0b\xa5\xbf\x59\xbb\xd2\x15\xb4\x28\x77\x14\xfe\xcb\xba\x14\x6e\x66\x0d\x0b\x88\xa3\x56\xe5\x85\xca\x5f\xaf\x57\xe5\xf7\x0b\xf0\x07\xa5\xe9\xbd\xd5\x6b\x5a\x64\xcb\x1e\x46\xd9\xc3\x13\xbb\xec\xd1\xaa\xdb\xa8\x7b\x7f\xf5\xea\x4e\x9c\x38\xd1\xcd\x27\x59\x9c\xd8\xba\x95\x42\xfb\xbf\x5e\x49\x1c\xf3\x3d\x45\xee\x7e\x2a\x64\x67\x85\xb3\xd9\x65\x47\x07\xce\xc6\x23\x49\xb8\x4a\xdb\x55\x6f\x55\xb9\x29\xc9\x24\x6f\xa0\xd5\xed\x4a\x0a\x41\x05\x92\xc6\xd9\x8e\x48\x7c\xb7\xdb\x95\x9e\x6f\x54\x20\x6c\xb7\x2b\xed\x14\x71\x40\xbb\x5d\xe9\x5e\xb6\x2b\x59\xb0\xe6\x93\x45\x10\xa2\x63\xf1\x7d\x87\xa0\xed\xf2\x53\xfe\x8d\x35\x2f\x3f\xad\x72\x56\xe4\x03\x44\x42\x03\xda\x67\x14\xb8\x46\x7b\x61\x28\xa1\x6a\xa9\x16\xa7\xb7\x99\xca\x94\x46\xc1\x43\xd2\xa4\xd9\x58\x82\x4a\x47\xb6\x42\x9c\x11\x61\xca\x47\xbd\x22\x9c\x6a\xeb\xa8\xeb\x99\xf7\x0b\x96\xf7\xe9\xcb\x2d\x52\xca\xe2\xab\x41\x4a\x5c\x53\xeb\x1d\xaf\x75\x38\xcb\x1b\x0b\x8d\xba\x7f\x49\x13\xdf\x60\xde\xf4\x53\x7d\x95\xe6\xb6\x16\x89\x16\xd9\x9f\xf4\xbb\x08\x01\xfd\xd6\xae\x29\x36\x9b\x6d\xa9\x35\x45\x2e\x42\x18\xd2\xf0\xa1\xce\x86\x93\xe9\xf8\xca\x68\xd3\xf9\x53\xd3\x08\x86\x11\x65\x8c\xde\x71\x6c\xe9\x8f\x6b\xc5\x73\xea\xbd\xca\xc0\x33\xd3\xf3\x83\x8a\x3b\x0f\x6e\x14\x77\xe6\x9b\x02\xbd\xf8\xd3\xd9\x21\xb8\xbe\x18\xd4\xf4\xfb\xfd\xbc\x9b\x5e\x96\xb1\xd2\x89\x3d\x2b\xe5\xd1\x00\x73\x70\xe9\x1d\xf0\xb1\x4e\x63\xc7\x5e\xd7\x4b\x87\xa6\xe2\xd7\x9e\xb0\xa1\xcc\x0b\xe0\x4c\xc2\xaf\x92\x84\xd4\x9e\x76\xea\x5e\x1f\xc2\xbe\xa8\x84\xb0\xbd\xfe\x35\x21\x6c\x6f\xa7\x16\x18\xda\x70\xeb\x08\x24\x6d\x68\x85\x0d\x70\x77\xca\x00\xd7\x58\xde\x17\x84\x4c\xc7\x75\xce\x84\x03\x6b\xd6\xfa\xb9\xcc\x88\x9c\x35\x24\x52\xde\x50\x47\x1a\x0d\xd7\xcb\x9a\x29\xcd\x86\xd2\x66\x8d\x5f\xcd\x8b\x7f\x19\xe5\x50\x3b\x00\x97\x26\x7e\xa3\xa1\x35\xe9\x55\x51\x7c\xea\xdd\xdf\x0b\xca\x75\xbe\xa1\x20\x4d\x28\x4d\x29\x62\xff\xf8\x6b\x81\x80\x77\x45\x55\x35\x81\x62\x90\x01\x70\x4d\xc6\x35\xc6\x89\xe3\xf4\x4b\x29\x10\x92\xf4\xdd\x81\x9f\x5e\x37\x92\x04\x1e\x82\x34\x53\x06\x78\xc4\x44\xc9\x69\xb0\x32\x56\xfd\x23\xf5\x2a\x34\x84\x4a\x9b\x54\x4c\x02\x68\x42\xb4\xd2\x24\x38\xad\x7d\x45\x69\x88\x63\x08\xdf\x51\x5e\x17\xf8\xc6\xfe\xf6\xbe\x26\x52\x51\x93\xcf\xed\xa0\x58\x0a\x2d\x02\xc1\xd2\x37\x74\x10\x7f\xc1\x33\xf4\x0a\x75\xe7\xfa\xd0\x74\x04\xb0\x2a\xad\x7a\xd2\x85\xdc\xe5\xe5\xa5\xf5\xda\xb3\xd9\x0d\x66\xa7\xeb\x8e\xb3\x79\xf0\x34\xd2\xbd\x91\x22\xaa\x4e\x34\xa5\xe9\x1f\x60\x9c\xe1\xb3\xf2\xc2\xd1\x84\x8e\x74\xfd\x8d\xcc\x35\x5f\xbd\x93\xdf\x39\xd7\x7b\xe9\xf6\x59\x22\x21\xac\xf7\xda\x6c\xfe\xaa\x5b\xed\xb0\xc3\xdc\x51\x53\x1e\xd2\x29\x0d\x13\xc2\x70\xad\xaf\xe4\x65\xcc\x79\xe9\x52\x80\x33\x72\x46\x2b\x9d\xee\x24\x09\x4e\x2d\x12\x7d\x42\x56\x9c\xa8\xd4\x3e\xd1\xce\x4f\x7e\x57\x4a\x37\x7b\x9e\xc2\xc3\x34\x74\xe7\x73\x72\x76\xd5\xf4\xda\x5c\x1e\x55\xc2\xab\xd8\x50\xef\x83\x87\x9c\x00\xab\x0f\xb2\x4c\x8c\x5f\x13\x55\xa7\x5e\xd6\xbb\xd6\xc8\xa8\xf5\xaf\xb8\x02\x6d\xa7\x95\xd7\x92\xe4\x52\x4e\xcb\xbe\x1a\x85\xac\x7d\x77\x29\x31\x6b\xc9\x99\x9c\xc6\xe7\x5c\xd1\x33\xce\xeb\x70\xc8\xc9\x7b\x75\xac\x30\xe9\xef\x60\x5a\x48\xbd\xe1\x56\xfa\xc0\x88\x42\x13\x76\xee\x84\x3d\x78\x09\xcb\xd3\x87\x41\x4b\x1f\x5a\xfa\x70\xf7\xf4\x21\xdb\xd8\xb1\x4e\x06\xd1\xd2\x85\x46\xba\x90\xed\xd2\x6e\x19\xc3\x9a\x19\xc3\xda\x47\xe2\x96\x30\x78\xe9\x2b\x23\x0c\xde\x28\x72\x37\x7c\xa1\xb2\x72\x6e\x8f\x53\x18\xbe\x89\x14\x70\x8d\x1e\x9b\xbf\xb7\x14\x04\x4f\x90\x18\x21\xc2\x98\x3f\x23\x13\xcd\xd9\x11\xf0\xf4\x7e\x27\x34\xfa\xdb\xcb\x30\x92\x52\x92\xdc\x17\xfb\xbb\x09\x1a\x79\x4a\x8a\x80\x3d\x75\x9c\xdd\xea\x54\x03\xc8\x62\x34\x66\x42\x43\xf8\x37\x48\x71\x2b\x82\x23\xe9\x78\xa2\x8f\xec\xed\x57\x77\x41\x7e\x7c\x55\xb6\xec\xe7\x01\xb2\x9f\x15\xef\xf7\xab\x4d\x90\xcc\xe3\x41\x9b\xe8\x21\x4b\x27\x46\x23\x05\x1a\x3d\x1a\x0e\xf3\xfe\x8e\x96\x60\x6d\xf9\x74\x79\x7d\x79\xb8\xd1\x8b\xa0\x5b\x71\xbd\x86\x53\x8c\x0f\x8e\xf0\x55\xef\x09\xda\x42\x0a\x82\xca\x15\x5c\x2d\xf9\x5b\x13\xf9\x7b\x1d\xb7\x33\x45\x2d\xf1\xbb\x15\xf1\x2b\xf6\xe0\xff\x45\xc8\x5f\x6d\x3e\x6a\x39\xf6\xd7\x6b\x79\x5e\xcb\xf3\xbe\x23\x9e\xb7\xa2\xd3\x62\x0d\x8b\x61\x4b\xd0\xba\xf5\x09\xf3\x57\x24\x71\xcd\xd7\x14\x34\x1e\xf8\x79\x28\x44\x2e\x17\xb9\x25\x73\x2d\x99\xfb\x9b\x91\xb9\xe2\x2a\x62\x09\xa3\xb4\xef\xa5\xcd\xde\xee\x5a\xcb\x60\x15\x4c\x20\x22\x9f\x40\x2a\xcb\xa4\xfa\x7d\x9b\xac\xcf\x59\x76\xb1\xb1\xb4\xb7\x2e\xfb\xd7\x27\xfb\x57\x27\xdb\xea\x35\x44\x31\x23\x9a\xf2\xf1\x4d\x2e\x4c\x27\x8c\xd9\x8d\x91\x35\xa4\x96\xac\xc3\xb3\x99\x02\x66\xce\x41\x34\xef\x64\x86\x33\x6d\xf7\x3a\xf2\xca\x7e\xb0\x69\xf6\x11\xdc\xb8\x63\xf2\x46\x37\xb4\x87\x30\xa2\x9c\xe6\x54\xd3\xc0\x63\x68\x3b\x4e\xc3\xce\x36\xef\x02\xf6\xec\x0e\x77\xd7\x45\x64\x17\x57\xef\xd5\x4e\xc9\xb8\xdd\xe3\xca\xeb\x20\x70\x94\x30\x4d\x1b\x70\x90\x5f\xf7\x5e\x0c\x46\xee\x4b\x25\x4f\x71\xb7\x26\xe2\xff\x26\x20\xcf\x17\x6d\x56\x09\xa4\x9e\x97\x3a\x86\xb3\x4a\xe7\xc6\xea\x94\xc6\x1f\x25\x3b\x3a\xe7\x41\x13\xcd\xab\xbb\x50\x4d\xc6\x06\x17\xea\x5f\xb9\x64\xd8\xcf\xad\x35\x21\x4d\x6b\x2e\x9c\xf9\x58\xdb\x46\x27\x23\x51\x70\x6c\x2b\x72\x7b\x61\x01\x8b\xa5\x51\xba\x52\x60\x55\x09\x8a\xb5\xc3\x50\xd1\x0b\xb8\x01\x0b\x99\x15\x34\x64\x21\x40\x56\x0d\x44\x79\x68\x6c\xba\xd9\x6b\x82\x29\xda\xcf\xc5\x5b\x06\xa6\xcd\xc7\x5f\x16\x05\xea\x5a\xd4\xb4\x6a\x80\xf7\x5a\x80\xaf\xda\x72\x57\xd0\xec\x59\x07\x4d\xed\x80\xd6\x84\xfd\xfe\x6a\xb0\x5f\x42\x3f\x1f\x3c\x17\x81\x7e\xfe\x72\xc3\xbb\x77\x8a\xff\xc5\xb5\xd8\xfa\xfe\xb9\x0c\x85\x2a\x43\x3f\x1a\x76\x9e\x3f\x50\xee\x72\x0f\x97\x89\xcc\xec\x62\xc6\x1a\x07\xa5\x2c\xb0\x43\xd5\x71\xe5\xba\x3e\xd9\x14\x10\xde\xbe\x3b\xde\x93\x4a\xbf\xd3\x5e\x6a\xfe\x2f\x7f\x8e\x48\xdb\x5f\x79\xc9\x62\x8d\x91\x9d\x3d\xc0\x5c\x7c\xdb\xdc\xee\x46\x59\x75\x58\x8b\x2c\x11\x7b\xef\xc5\x34\x38\x35\xf3\x66\xd9\xdb\x99\xc6\x8a\x59\x18\x37\xf2\xc5\x03\x67\x3a\xba\xd7\x75\x1e\xb6\xdd\x87\x5e\x54\xfe\x3d\x70\xfe\xee\xb9\x0f\xa5\x64\x69\x8e\x13\xfa\xf7\x9d\xbf\x7b\xd9\x2f\xd2\x7c\xcd\xdb\x40\x23\x18\x3a\x48\xbc\xf6\x2b\x6e\xc5\x3f\xb9\x15\xbb\x5f\xe9\xef\xb8\x0f\xce\x8f\xfd\x3c\x0b\x5d\x79\x73\x59\x3c\xf5\x5d\x58\x4f\x87\xb3\xd8\xb0\x38\xf6\x52\x8c\x8a\xde\x4c\xbb\xfd\x1d\x9b\xca\xef\x0a\xe1\xc4\x4c\x5c\x63\xff\x37\x91\x8a\x93\xd4\xb6\xcc\x94\x48\x4a\x4e\xec\x19\xff\x4a\x4c\x59\x0a\x34\x2d\xc2\xd7\xde\xc6\xec\x7f\x01\x00\x00\xff\xff\x95\x51\xf0\xe7\xbe\x6b\x00\x00")
-
-func manifestsDashboardsCoherenceProxyServerDetailDashboardJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_manifestsDashboardsCoherenceProxyServerDetailDashboardJson,
-		"manifests/dashboards/coherence/proxy-server-detail-dashboard.json",
-	)
-}
-
-func manifestsDashboardsCoherenceProxyServerDetailDashboardJson() (*asset, error) {
-	bytes, err := manifestsDashboardsCoherenceProxyServerDetailDashboardJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "manifests/dashboards/coherence/proxy-server-detail-dashboard.json", size: 27582, mode: os.FileMode(420), modTime: time.Unix(1647457998, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _manifestsDashboardsCoherenceProxyServersSummaryDashboardJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x5d\xfb\x6f\xdb\x38\xf2\xff\x3d\x7f\x05\xc1\x6f\xbf\x8b\x76\x61\x37\x96\x93\x6c\x37\x46\x77\x0f\x69\xb2\xdd\x2e\xd0\xee\xf6\x36\xdd\x02\xbd\x6b\x61\xd0\xd2\x58\x26\x42\x91\x3a\x92\x72\xe2\x04\xb9\xbf\xfd\x40\xea\x45\x3d\x9c\x87\x13\x37\xee\x46\x3f\x04\xb1\x86\x0f\x0d\x67\x86\xc3\x0f\x47\x23\xea\x62\x0b\x21\x4c\x38\x17\x9a\x68\x2a\xb8\xc2\x23\x64\x48\x08\x61\x46\x95\xc6\x23\xf4\x6f\x7b\x85\x32\xaa\x2d\x99\x24\x94\xe9\xdf\x38\x1e\x21\xaf\x57\x52\x03\xa2\x89\x12\x89\xf4\x01\x8f\x10\x7e\x2f\x45\x04\x7a\x06\x89\xc2\x4e\x1d\xe0\x64\xc2\x4c\xb9\x96\x09\x38\xf4\x19\x0d\x5a\xa8\xd4\x17\xfc\x50\x30\x21\x4d\x87\x32\x9c\x90\xa7\x83\x1e\x1a\x7a\x5e\x0f\x0d\xf7\xf6\x7a\xc8\x7b\xe6\x76\xcd\x49\x64\x6f\x7c\x50\x8e\x05\x7d\x87\x0e\x18\x48\x5d\x61\x41\x2f\x62\x5b\x2f\x20\x6a\x36\x11\x44\x06\x38\x2b\xbb\xb4\xff\xbf\x6c\x21\x74\x69\xaa\x63\x08\xa8\xae\x71\x8b\x43\x0e\xfa\xb7\x00\x8f\x10\x4f\x18\x4b\x29\x92\xc4\xb3\x0f\x42\x30\x4d\x63\x3c\x42\x03\x4b\xa4\xa6\xca\x6e\xf6\x5b\x83\xb4\xec\x18\x71\xed\xed\x0f\xbd\x1f\x77\x77\x87\xde\xee\x7e\x5a\xca\x28\x3f\x51\x85\x94\x73\x19\xdb\x91\x57\x99\xec\x15\x45\xdc\x67\x49\x00\x1f\x89\x54\x35\x89\xe1\x13\x80\xf8\x03\x8d\xea\x92\xc4\x9a\x84\xf6\x1e\x5f\x4a\x0a\xd5\x76\x60\xf8\x15\xf1\x4f\x90\x16\xe8\x1d\xa1\x1c\x1d\x35\xef\x96\x4b\xcb\xf0\x59\x52\x13\xc9\x0c\x71\x3b\xd8\xf6\xc5\xac\x1f\x11\xca\xcd\x0f\x90\xc0\x7d\xe8\x17\x3c\xdb\x82\x54\xba\x97\xbd\xea\xf8\x88\x3a\x92\x22\x0e\xc4\x29\xaf\xf3\x9a\x8f\x1c\xce\x34\x48\x4e\x18\xaa\xde\xf9\x0e\xa3\x77\x2d\xb5\xa6\xfa\x16\xc1\x1c\xcc\x09\x65\x46\xfd\xa5\x54\x54\x53\x2c\x41\x59\xb6\x95\xdb\x90\xed\x0c\xc7\x84\x03\x6b\xd1\xac\x2f\xb8\x06\x6e\x26\x16\x7e\xe9\x03\xd7\x20\x7f\x7e\x39\xdb\xf9\xf9\xbd\x14\x67\x0b\x74\x0c\x72\x0e\x52\xa1\xe3\x24\x8a\x88\x5c\xa0\x3e\x7a\x72\xc8\x12\xa5\x41\xfe\x4e\x22\x78\xb9\x3d\xdb\xf9\xf9\xe5\x76\xd6\xaa\x64\xe6\xda\x89\x87\x43\x49\x83\xf7\xa2\x9c\xdb\xe9\x9c\xc3\x23\x34\x74\x26\xc6\xa9\xb9\xde\x75\x08\x67\xb9\x3d\x67\xd7\x0b\x73\x9d\x4f\x96\x52\x23\xc6\xd6\x77\x5e\x14\xd7\x85\x45\x97\x42\x8d\x84\x9d\xdd\x78\xa6\x23\x86\x9b\xa2\x6e\x8a\x55\xc3\x99\x6e\x37\x1c\x5f\xb0\x24\xe2\xb5\xfe\xaf\x17\xc0\x54\x70\x7d\x4c\xcf\x6d\xb9\x37\x18\xfc\xff\x0d\x44\xf3\xc3\x6d\x45\x33\x5c\x22\x9a\x9d\xab\x44\x13\x93\x10\x32\xc6\x72\x97\x62\xe9\xca\x97\x82\xb1\xba\x29\xab\x99\x38\x7d\x03\x24\x00\xd9\x28\x11\x52\x57\xc7\xe0\x0b\x56\x77\xd0\xa0\xfc\xac\x5d\x83\x55\xa5\x17\x0c\xaa\xd3\xa4\xec\xcb\x4c\x58\x46\x89\x29\xc6\x76\x86\xf5\x6a\x45\xa1\x9d\xb2\x24\xd1\xa2\x5a\x14\x10\x0d\xaf\x85\x8c\x88\xb5\xf7\x4f\x9f\x3e\x7d\xea\xbf\x7b\xd7\x3f\x3a\x42\x6f\xde\x8c\xa2\x68\xa4\x54\xb5\x7a\x4c\xb4\x99\xf4\xed\xb7\xc9\x6d\x63\x46\x83\x00\x38\x2e\x8a\x2e\x7b\xd7\x70\xfc\x0e\xa2\x09\x48\x74\x28\x12\xae\x6f\xcc\xb9\x6f\x96\x9d\x77\xa9\xdd\xba\x8a\x29\x0b\xab\xc2\xb2\x74\xbb\x44\x0d\x77\xf7\x7a\x68\x6f\x37\xfd\x1b\x3c\xdf\x7f\x56\xe9\xb8\xa8\xb5\xf3\xa2\x87\xbc\xe1\x7e\xcf\x2c\x13\x68\xf0\xfc\xc7\x25\xf5\xf6\x06\x3d\xe4\xbd\x18\xf6\x90\xe9\x75\xf0\x7c\xff\xc5\x33\xec\xd4\xfa\x72\x17\x69\x07\xe0\xd3\x88\x58\x1f\x35\xa8\x14\x44\x24\x8e\x29\x0f\x3f\xa4\x02\xf7\x96\xa9\xe8\x23\x61\x09\xa0\xff\x3b\xa8\xa9\x69\x26\x41\xcd\x04\x0b\xaa\x86\x5e\x51\x21\x4f\x8c\x46\xaa\xed\x12\x4e\x2d\xdb\x5c\x70\xb8\x85\x72\x8d\xc7\xa4\xfe\xcd\x2d\xf2\x71\xe9\x75\x58\x29\xb0\x2b\x69\x1d\x64\x65\xf4\x12\xc1\xe0\x23\x49\x19\x43\x66\x6d\x36\xc0\x40\xa5\x02\x46\x01\x68\x42\x99\x42\x53\x21\xd1\x93\x8b\xf1\xd8\x07\xc6\x2e\x71\xa3\x9f\xbf\x2a\xc8\x20\x6b\xdc\xcf\x1a\xff\x63\x4e\x64\x3f\xd3\x98\x59\xd0\x7e\x2a\x3a\xfa\xce\x94\x38\x4b\xdd\x4f\xee\xba\x87\x57\xb1\x4e\xde\x68\x78\x13\xcb\x54\x5a\x52\x1e\xb6\x5b\xa6\x9a\x19\x1f\x7b\x73\xd3\xfc\x20\x34\x61\xe8\x30\x91\x12\xb8\x46\x87\x82\x73\xf0\x53\x88\xdd\x19\x6b\x59\x7d\x99\x13\x5a\xc9\x58\x5d\x14\x85\x8e\x5a\x2c\x76\xec\x5d\x6b\xb3\xb1\xe9\xc3\x5a\x2e\xc8\xcc\x70\x97\xda\xed\xd8\x5b\x83\xe5\x66\x7e\xf5\xd5\xfd\xfa\xd5\xd5\xac\xf7\xd5\x42\x83\x42\x7f\x82\x0f\x74\x0e\x41\x67\xb8\x4e\xf5\xfb\x59\x3d\x0f\xef\x57\xcb\x01\xf8\x13\xa3\xb2\x15\x15\x7d\x0c\x1d\x44\x5a\x83\x92\x8f\x36\x44\xc9\x7f\x24\x3a\x14\x94\x87\xc8\xec\xfa\x99\x08\x57\x52\x35\x9e\x9b\x41\xb5\x94\x2f\xd3\x76\x8b\x7e\xee\xa2\xee\xa6\xf1\x6c\x98\xb6\x7f\xb9\x4a\xdb\xd5\x01\x79\xf5\x11\x7a\x83\xc1\xf2\xe1\xac\xd5\x36\xde\x81\x52\x24\xec\x9c\xfd\x3a\x2d\xe3\xf5\x83\x6e\x95\x6a\x7a\xee\x7c\xfd\x5a\x74\xfc\xeb\x83\xea\xf8\x2f\x4e\x12\x3d\x13\x92\x9e\x43\xe0\x6c\x39\xd0\x81\xd6\x10\xc5\x7a\xb5\xad\xc7\xa3\xf0\xf8\xc3\x3b\xe8\xfc\xcd\x1d\x3c\xfe\xf0\x6e\xfe\xfe\x96\xf6\xf1\x11\xa4\x24\xe7\xe7\x84\x0b\x94\x6d\x53\xfe\xde\x2e\x60\x99\x7e\x1d\x1d\xce\x0b\x91\x8c\xfd\x36\x91\x7c\xed\xb0\xc1\xa3\x57\xc8\xf6\xf3\xef\xb7\xd7\xbb\xf7\xdd\xaa\xb1\x86\x35\x91\x21\xe8\x2b\xc2\xdf\x70\x16\xdb\x87\x90\xbe\x48\xb8\x7e\x3a\x07\x1e\x08\x39\x2a\x1e\x79\x8d\xfd\xc2\xd3\x8e\x23\xc2\x49\x08\xd2\x25\xd9\x46\x17\x4d\x3b\xfb\xe9\xbf\x9f\xf1\x93\xf9\x79\x76\xf5\x19\xf7\x90\x5b\xe0\x84\x11\x3e\xe3\x5e\x2c\x85\x16\xbe\x60\xa6\x48\xfb\xf1\x67\x7c\xf9\x0c\x4d\x16\xe8\x69\xb3\xd7\x1e\xe2\x24\x82\xaa\xaa\xf0\xb4\xf0\x89\xe9\x23\xcd\x4a\x21\xe5\x4a\x13\xfb\x48\xaa\x11\x6d\xa1\x5c\x83\x9c\x13\xf6\x9a\xf8\xda\x3e\x86\xad\xba\x42\x06\x21\xf0\xa0\x74\xb8\xd5\x7e\x25\x4c\xed\xb3\x52\x7c\x70\xbd\xf9\xe7\x02\x56\x49\xd4\x89\xf7\x96\xe2\x7d\x75\xff\xe2\xd5\x06\x2b\x8e\xa3\x0c\x2b\x8e\x65\xb6\x27\x78\xcc\x52\x7e\xbd\x76\x29\x2b\x78\xdc\x76\xfc\xeb\xba\x24\x6c\x77\xc4\x9d\x11\x8f\x10\x3e\x5c\xaf\x88\x1f\xbb\x05\x1f\xdd\xbf\x78\x45\x16\xb3\xb3\x12\x1e\x4f\xd2\xc0\xdd\x63\x96\xf1\x2f\xf7\x2f\xe3\xc4\xd9\x35\xbb\xe5\x24\xdb\x35\xdf\x4d\xdc\xd7\x09\x17\x6d\x92\x74\xdf\x5c\x09\x93\xf3\x8c\x9d\xf2\x39\x1b\xf5\x01\xbd\xa5\x4a\xa3\x3e\x3a\xcc\x45\xfc\xfd\x2f\x67\x1a\xb8\x9b\x44\x26\x09\x57\x66\x5c\xcd\x51\x95\x29\x3f\x96\xbc\x85\xda\x92\xc5\xcc\xd6\xe8\x30\xdf\xd0\x5c\x94\x49\x2b\x93\x96\x2c\xb0\x80\xa8\xd9\x5b\xe0\xa1\x9e\x19\x11\x0c\x2a\x74\x9b\xe0\x32\x25\x4c\xb9\xd5\xaf\x4b\x1d\x6a\x8b\x0b\xd9\x54\x1a\x49\xe3\x2c\xad\x2f\x0b\xad\x59\x1f\x88\x8c\x0f\x44\x4f\xed\xef\x6d\x05\xfe\x33\x24\xa6\x88\x30\x56\x7d\x36\x19\xd9\x9c\x14\xf5\xdc\xc9\x50\xa2\xac\x92\xaf\x63\x09\xbf\x4a\x12\xd0\x34\x5b\xac\xbc\xf7\xd2\x8c\xa5\xfd\x52\xa5\x36\x63\xc9\x73\xb3\xbb\xda\x32\x96\x7e\xdc\xaa\xcd\xa3\x2c\xb9\xe6\x18\x24\x6d\x91\x95\x93\xd5\xe8\x98\x54\x95\x0f\xbb\x5f\x3d\x50\x1f\xb2\xc4\xc9\x4a\x07\xa6\x78\x1e\xb6\x50\xfd\xf4\x19\x79\x7b\x5a\xe8\xbf\x40\x8a\x66\x49\x44\xce\x5a\x88\x94\xb7\xf4\x2e\x69\x38\xd3\xc7\x69\x82\x69\xbd\x4c\xcd\xc4\x69\xb3\x1f\xbb\xa4\xb5\xd4\xb6\x71\x30\xb5\x2c\x85\x8a\x51\xde\x22\x34\x43\x3d\xa5\x41\x6a\x8e\x2e\xb5\x9e\x09\x66\xb6\xf0\xef\x05\xe5\x3a\x8f\xba\x19\x02\x22\x0a\x9d\x1b\x01\x14\xd5\x44\x5c\x4d\xd3\x2d\xac\xf8\x6d\xd1\x65\x83\xb3\x18\xa4\x0f\x5c\x93\xb0\x21\x02\x1c\x9b\x3b\x1a\x2b\x4b\x4c\xdb\xbd\x2a\xbd\x39\x1a\x09\x3c\x00\x69\xd3\xcf\xf0\x94\x89\x32\x80\x8c\x95\xb5\x99\x3f\x8c\x97\xa3\x01\xd4\xc6\xa6\x62\xe2\x43\xdb\xac\x54\x9a\xf8\x27\x8d\xbb\x28\x0d\x71\x0c\xc1\x5b\xca\x9b\x0c\xdf\x78\xab\xbe\x11\xd8\x05\xf5\xd1\x66\xf0\x21\xa6\x53\x05\x1a\x3d\x19\x8f\xf3\x75\x62\xf9\xb2\x43\x23\x18\xa7\xea\x5c\xb6\xf8\xd4\x26\x86\xb3\xfa\x34\x97\x96\x5b\xae\x4b\x17\x17\x66\x51\xbc\xbc\x6c\x79\x3e\xd1\x1a\x4f\x68\x2e\x52\xed\xc1\x22\x3b\xac\xd7\x52\x44\xf5\x4c\x4b\x43\xff\x13\xc2\x6c\x52\xd5\x1a\x1c\xcf\xe8\x54\x37\x5b\x64\xcb\x60\xfd\xd1\xf9\x36\x52\xe0\xa3\x18\x24\xaa\xa7\xa5\x61\x5d\xa4\xac\x5c\xb8\xde\x87\x48\x08\x9a\xfe\x27\x4b\xe6\x1c\xd4\x5d\xcf\x38\x5f\x2c\x29\x0f\xe8\x9c\x06\x09\x61\xb8\x31\xd9\xf3\x3a\x36\x31\xbd\x64\xe0\x8c\x9c\xd1\x9a\xd7\x98\x24\xfe\x49\x3a\x95\xaa\xe1\xc3\x22\x5f\x57\x57\x93\x30\xf3\x14\xfb\x5a\xed\x76\x1f\x5a\xf8\xca\x16\x7f\xb4\x20\x67\x57\xe5\x9a\x2e\x7d\x12\x53\xda\xe8\xab\xb8\x66\x9b\x8c\x4c\xa0\xc5\xf8\x98\x08\x5f\x11\xd5\x8c\xe1\xa7\xcb\x47\x23\x6a\x9a\x2e\x20\x78\x50\xed\xc4\x19\xe0\xb5\xd0\xb3\x64\x31\x0d\x3a\xb6\x32\xd9\xb8\xef\x4a\x6c\x36\xc8\x19\x9f\x76\x72\x5e\x31\x41\x16\x4d\x4b\xc8\xa3\xcc\x8d\xe5\xda\xd0\xdf\xc2\xbc\xe0\x7a\xcb\xed\xf4\xdb\xc6\x69\x79\x38\x60\x43\xb1\x5a\x85\xb0\x32\x58\xf3\x36\x08\xac\xd5\x3b\xea\xd0\x5a\x87\xd6\x56\x46\x6b\x6b\x0b\xe6\xad\x80\xd8\xd6\xc7\xcb\xb7\x87\xda\xda\xb3\x87\x5a\x1f\x55\x6c\x0a\x72\xcb\x59\xee\xd0\x5b\x87\xde\x3a\xf4\xb6\x14\xbd\xd5\xf1\xd8\x83\xc1\xb7\x14\x9c\x39\xef\x53\xa0\x03\x5f\x0a\xa5\xd0\x01\x63\xf9\xd4\x55\x0f\x1b\x58\xf3\x5e\xac\x00\xd6\x76\xf6\xaf\x06\x6b\x0f\x81\xc6\xd6\x81\xb8\x2a\xdb\x80\x0e\x70\x3d\x10\xe0\xda\xa0\x44\x8b\xcd\x84\x34\xd9\xeb\x5b\xcb\x5e\xdb\xda\xd0\x60\x54\xc3\x39\x76\x20\x66\x4d\x20\xe6\x0a\x84\xd0\xc1\x98\x0e\xc6\xdc\x10\xc6\xf8\x0e\x8c\x31\x5b\x0f\x55\xdf\x7a\x3c\x48\xac\x69\x25\xfc\xb2\x3b\x2c\xaf\xdb\x9c\x70\x87\x6a\x3a\x54\x83\x1e\x0f\xaa\x59\x2d\xa1\xe6\x2e\x90\xe7\x96\xb1\x9a\x6f\x13\xcf\xa0\xf7\x5d\x80\xa6\xc3\x36\x1d\xb6\xd9\x68\x6c\x53\xbe\x68\xbc\xd0\x90\xbf\x6d\xdc\x1e\x5a\x7d\x88\xf0\xcc\xf0\x87\x55\xe0\xcd\xce\xd7\x04\x32\x37\xca\x6c\xea\x70\xcc\xdf\x15\xc7\x6c\x4e\x66\xf0\x66\x06\x67\xbe\x29\x0c\xd3\xea\x0d\x3b\xec\xd2\x61\x97\x0e\xbb\x6c\x32\x76\xc9\x4e\x4a\x78\x78\xf8\xd2\x08\xcf\xac\x86\x5f\x76\xcb\xeb\x2e\x3c\xd3\xc1\x9a\x8d\x87\x35\xd9\x7b\x91\x8f\x14\xd9\xa0\x6f\x0b\xda\xd4\x9c\x65\x87\x6e\x3a\x74\xf3\xb7\x46\x37\xc5\x39\xdf\x12\xa6\x66\xce\x99\x61\xef\x0c\x52\x87\x81\x95\x3f\x83\x88\x7c\x04\xa9\x52\x64\x31\x1c\xa6\x64\xbd\x60\xd9\xa9\xe1\x32\x3d\xd2\xbc\x7a\x36\x79\xf5\x5c\xf2\xb4\x7b\x0d\x51\xcc\x88\xa6\x3c\xbc\xc9\xc1\xfc\x84\x31\x7b\x24\x4d\xd3\x56\xcb\x25\xbb\xa2\x33\x05\x0c\x7c\x6d\xa7\x62\xd3\xa7\xd9\x53\xb8\x47\x08\xff\x2e\x78\xed\x35\xc4\x79\x76\x13\xdc\xfa\x2e\xe6\x8d\xbe\x04\x10\xc0\x94\x72\x9a\x43\x2f\x6b\x1e\xe3\x74\xea\xb4\x44\xcd\x2b\x07\xfd\x67\xdf\x0a\x70\x9d\x44\x76\x2a\xfc\x41\xe3\xc4\x6c\x77\x7a\x5c\x79\xf6\x0d\x8e\x12\xa6\x69\x8b\x1d\xe4\x9f\x15\x28\x96\x19\xb7\x51\xb9\xf0\xbb\xa7\x92\xe0\xff\x24\x20\x17\xb7\x1d\x56\x69\x48\x5e\x85\x1a\xc2\x59\x6d\x72\x63\x75\x42\xe3\xbf\x24\x3b\x5e\x70\xbf\x0d\x3e\x35\x9d\xa8\x26\xa1\xb5\x0b\xf5\xcf\x9c\x33\x5c\x2d\x6d\x0c\xc1\xd0\xda\x2b\x67\x5e\x36\x1d\xa3\x53\x90\x28\xf8\x90\x76\xe4\xce\xc2\xc2\x2c\x56\xb6\xd2\x7b\x35\xac\x3a\xfc\x48\xf5\x30\x56\xf4\x1c\x6e\x80\x2f\x2e\x0b\x80\x71\x2b\x83\xac\x2b\x88\xf2\xc0\xea\xb4\xef\xb5\x99\x69\xf1\x1a\xed\x2a\x56\xda\x7e\xf6\xec\x6d\xed\x74\x2d\x52\xba\x6f\xfb\xf6\x3a\xfb\xfe\xba\xf6\x3d\xbc\x6f\xfb\xce\x17\xc8\xdb\xd8\x77\xde\xb8\xa5\xed\x57\x35\xf2\xab\xde\xf7\xef\xa1\x79\xca\xdd\x63\xf0\xef\xf6\x7f\xf9\xd1\x1c\x9d\x7e\x80\x25\x43\x2a\xd3\x74\xcf\x81\xb9\x38\xed\xef\x0c\xa2\xac\x3b\xac\x45\x46\xc4\x95\x76\x31\xf5\x4f\xec\x36\x36\x6b\x9d\x49\xac\x78\xf7\xc1\x45\xce\x78\xcf\x09\xee\x78\x03\xe7\x62\xc7\xbd\xf0\xa2\xf2\xf7\x9e\xf3\xdb\x73\x2f\x4a\xce\x4c\x89\xb3\x75\x18\x3a\xbf\xbd\xec\x63\x31\x5f\xf2\x31\x98\x5d\xa2\x63\x72\xd7\xde\xc5\xed\xf8\x07\xb7\x63\xf7\x2e\xc3\x5d\xf7\xc2\x39\x42\xe1\x45\xe0\xf2\x9b\xf3\x52\x11\xdf\xb9\xe0\xe5\xe7\x54\xca\x8d\x5a\x39\xdf\xda\xbf\x31\x53\xfd\xe8\x0f\x4e\x6c\xb0\x08\x57\x4f\x42\xa7\x3e\xa8\xbe\x4a\x1b\xa4\xd5\xe6\x44\x52\x32\x49\x3f\x19\x52\x83\xa5\x25\x57\xf3\x02\x01\x7b\x5b\x97\xff\x0b\x00\x00\xff\xff\x41\x2e\x46\xdf\x69\x6a\x00\x00")
-
-func manifestsDashboardsCoherenceProxyServersSummaryDashboardJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_manifestsDashboardsCoherenceProxyServersSummaryDashboardJson,
-		"manifests/dashboards/coherence/proxy-servers-summary-dashboard.json",
-	)
-}
-
-func manifestsDashboardsCoherenceProxyServersSummaryDashboardJson() (*asset, error) {
-	bytes, err := manifestsDashboardsCoherenceProxyServersSummaryDashboardJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "manifests/dashboards/coherence/proxy-servers-summary-dashboard.json", size: 27241, mode: os.FileMode(420), modTime: time.Unix(1647457998, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _manifestsDashboardsCoherenceServiceDetailsDashboardJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x5d\x7b\x6f\xdb\xb8\xb2\xff\x3f\x9f\x82\xd0\xee\x3d\xa7\x3d\xb0\x13\xcb\xce\x1b\xdb\x3d\x48\x93\xdd\xdb\xe2\x36\x3d\xb9\xdb\xb4\x40\xef\x6e\x61\x30\x12\x63\x13\x91\x48\x1f\x92\xca\xab\xe8\xf9\xec\x17\x24\xf5\xa0\x24\xca\x8f\xd8\x8e\x9d\x84\x7f\x14\x8d\x49\x8a\x22\x67\x86\x33\x3f\xce\x90\xa3\xef\x1b\x00\x78\x90\x10\x2a\xa0\xc0\x94\x70\xef\x10\xc8\x22\x00\xbc\x08\x73\xe1\x1d\x82\x3f\xd5\x2f\x90\x96\xaa\x9a\x8b\x04\x47\xe2\x3d\xf1\x0e\x81\xdf\x2a\x4a\x43\x28\x20\xa7\x09\x0b\x90\x77\x08\xbc\x33\x46\x63\x24\x86\x28\xe1\x9e\xd1\x06\x11\x78\x11\xc9\x7a\xc1\x12\x64\x94\x0f\x71\x68\x29\xc5\x01\x25\xc7\x34\xa2\x4c\x76\xc8\x06\x17\xf0\x55\xa7\x05\xba\xbe\xdf\x02\xdd\x9d\x9d\x16\xf0\x5f\x9b\x5d\x13\x18\xab\x17\x1f\x15\x73\x01\x7f\x03\x47\x11\x62\xa2\x34\x04\x71\x37\x52\xed\x42\xc8\x87\x17\x14\xb2\xd0\x4b\xeb\x7e\xb4\xea\x33\x9d\x67\x4e\xe8\x76\xa4\x06\x1e\x0c\x21\x19\x20\xfe\xea\x1a\x91\x90\xb2\xc3\x80\x0e\x11\x43\x24\x40\xfd\x20\x4a\xb8\x40\xac\xcf\xf1\x3d\xfa\x7e\x8d\x18\x83\xf7\xf7\x90\xd0\xac\xfc\xcd\x7f\xfe\xf2\x7e\xbe\xbe\x4f\x7f\xfd\xe5\xb5\x80\x59\x71\xac\xff\xfe\x08\x63\xf4\x97\xf7\xe3\x4f\x3f\xfe\x56\xa2\x46\x4a\xd0\x4b\x18\xf1\xf1\x14\x55\x94\x3c\xd8\xd5\xff\xca\x14\x8d\x70\x8c\xa5\x04\xf8\x9d\x8e\x85\xce\x9f\x86\xf4\x06\xa4\xa3\x00\x9f\xf0\x3d\x02\xc7\x7a\xa2\x66\x17\x7c\x48\x6f\x94\xa0\x98\x3d\x08\x38\xf8\x1f\x74\xc7\x15\x6d\xf4\xf3\x5e\xb9\x56\x56\xfd\xf9\xcd\x2c\xc3\x22\x42\xbf\x53\x16\x43\x39\x1e\xcf\xf2\xd6\x70\xd3\xc6\x63\xd5\xd7\xaa\xd8\xcb\x11\xbb\xc6\x01\xea\xd3\x1b\x82\xc2\xfe\x08\x32\x81\x95\x54\xf6\x47\x0c\xc7\x90\xdd\xcd\xc7\xf2\x16\x90\x8c\x78\xf3\x9f\xbf\xff\xfc\x49\xbf\x47\x16\xff\x7d\x65\x82\x70\x96\x4d\x0f\x9c\x33\x48\xf8\x25\x62\x93\xe5\x80\x0b\x34\x92\x1d\x78\x76\xd9\x50\xfd\xcf\x26\x18\x13\x46\x61\x15\x0b\xf5\xff\xb7\x8d\x54\x40\xbc\x10\xf1\x80\xe1\x91\xec\x24\x1f\x9b\x87\x42\x2c\x2a\x52\xe0\x0d\x08\x12\xef\x43\xef\x10\x90\x24\x8a\x74\x09\x83\xa3\xe1\x39\xa5\x91\xc0\xa3\x6c\xaa\x1e\x96\x4d\xfc\x1d\xfd\xb7\x40\x0c\xa6\x3d\xfb\x3b\xbb\xdb\xbb\xdb\x7e\xa7\xd7\xdb\xdb\xdb\x55\xb5\x11\x26\x57\x3c\x57\xb8\x99\x94\x2a\x4e\x95\xf5\x55\x2b\xaf\x22\x41\x94\x84\xe8\x0b\x64\xbc\x22\x9f\xde\x15\x42\xa3\x73\x1c\x57\xe5\xb6\x4e\x44\x4d\x42\xf9\x82\xb7\x30\xb8\x02\x82\x82\x54\x9e\x38\xf8\x94\xc4\x52\x4e\xc1\x49\xfd\xcd\x19\x25\xe5\x98\x8b\xd2\x84\x45\xb2\x70\x2b\xdc\x0a\xe8\xb0\x9d\xca\x3f\x6f\x73\xdd\xcf\x56\xb5\xa0\x5d\x51\xc1\xe9\x0a\x5d\xdd\xcc\x4f\x21\x26\xc5\x6c\xc1\x6c\xd3\x8d\x21\x26\x5b\xf9\xea\x2f\xe6\xa6\x2a\xec\x13\x84\xfc\x84\xd1\x51\x48\x6f\x48\x75\xb0\xd9\xd4\xd1\xad\x40\x8c\xc0\x08\x94\xdf\x3c\xc7\xf4\x4d\xe5\x57\x31\x80\x16\xca\x1c\x5d\x43\x1c\x49\xc9\x2f\xc8\xc2\xeb\x64\x09\x8b\xba\x8d\x6c\x4d\xa9\xce\xbc\x11\x24\x28\xb2\x08\x75\x40\x89\x40\x44\xad\xd9\x5f\x02\x44\x04\x62\xbf\xfe\x32\xec\xfd\x9a\x8a\x1e\x38\x41\x02\xe2\x88\x83\x36\x30\xb5\xdb\x2f\x5b\xc3\xde\xaf\xbf\x6c\xa5\xed\x8b\x61\x0c\x18\x0e\xcf\x68\x81\x5c\x54\xe1\xd0\x3b\x04\x5d\x63\xed\xdf\xc8\xdf\xdb\x46\xc1\x6d\x45\x1b\xdd\xc9\xdf\x55\x5b\xa1\x97\x6f\x2f\x7f\xae\x58\xa4\x05\xb1\x62\xaa\x34\xac\x37\x14\x71\xe4\xd5\x49\x58\x27\x97\x40\xb7\xc2\x2e\x10\x01\x8d\x92\x98\x54\xfa\xbf\xa4\x44\x48\x4b\x27\x1f\xf5\x3b\x9d\xff\x9a\x62\xe2\xbb\xb3\x4e\xbc\x6b\x9f\x78\xd7\x1f\x37\xf1\x11\x1c\xa0\x74\x60\x99\x0e\x54\xe5\x3c\x60\x34\x8a\xaa\x02\x28\x4d\xc0\x3b\x04\x43\xc4\x6a\x35\x94\x89\xf2\x1c\x02\x1a\x55\xc1\x25\xe2\x41\x66\xc6\x6a\x63\xe5\xe2\x2e\x42\x65\xe9\x2e\x3a\x93\xeb\x2c\xc2\x50\x99\x14\xb5\x30\x5a\x66\x55\x08\x85\x61\x3f\xbe\x7e\xfd\xfa\xb5\x7d\x7a\xda\x3e\x39\x01\xef\xde\x1d\xc6\xf1\x21\xe7\xe5\xe6\x23\x28\xe4\x82\xb4\xf7\x95\xf1\x77\x88\xc3\x10\x11\x2f\xaf\xfa\xd1\x9a\x30\xac\xe3\x84\x31\x44\x04\x38\x1f\x32\x04\x43\x70\x4c\x13\x22\xca\x5d\x07\xd2\x54\x9f\x6a\x41\x33\x69\x5d\x54\x96\xa7\xaf\xca\xb5\x59\xdf\xde\x69\x81\x9d\x6d\xfd\xaf\xb3\x79\xf0\xba\xd4\x71\xde\xaa\xb7\xd7\x02\x7e\xf7\xa0\x05\xb6\x3b\xb2\xd9\x7e\x43\xbb\x9d\x4e\x0b\xf8\x7b\xdd\x16\x90\xbd\x76\x36\x0f\xf6\x5e\x7b\x46\xab\x6f\xf3\x90\x36\x44\x01\x8e\xa1\x52\x16\x9d\x52\x45\x0c\x47\x23\x4c\x06\xe7\x9a\xba\x7e\x13\x3f\xbe\xc0\x28\x41\xe0\xa7\xa3\x0a\x4f\x86\x0c\xf1\x21\x8d\xc2\x2a\x74\x30\xf8\x45\x92\xf8\xa2\x84\x41\xa5\x6e\x27\x0a\xf1\x78\x84\x12\x34\x03\x27\xdf\x87\x11\x72\x6c\x5c\x00\x1b\xdf\xae\x94\x8d\x95\x05\xf9\x59\xe0\x08\xdf\x6b\xe4\xd6\xc8\x4f\x2f\x40\x51\x64\xa9\x6e\xe2\xa8\x85\x07\xf3\xb0\xb4\x2e\x20\x4b\xe0\x68\x77\x0e\x8e\x1e\x8f\xe3\x68\x79\x42\x9b\x3b\x9d\xea\x1c\x37\x0f\x3a\xcd\x13\x9a\x46\x02\x46\x88\x49\xe0\xa0\x7e\x4e\x2f\x08\xa7\xa8\xde\xe3\xcb\x5a\xc4\x65\x96\x2b\x18\x5a\xdd\x0a\xa7\xe5\xc5\xce\xc7\x3b\x61\x38\x8a\x80\x04\xb6\x12\x56\xc7\x8a\x88\x20\x4c\x41\xdd\x25\x65\x00\xfc\xfc\xbd\xdf\x97\x0b\xe6\x87\x57\xeb\xe7\x73\x19\x56\xab\x87\xdb\xe9\xc3\xff\xbc\x86\xac\x6d\xec\x83\xdf\x98\x9b\xe2\xbf\xc9\x4a\xcd\x31\x5d\x67\x7f\xc7\x94\x62\x1b\x5b\x58\x3f\x8d\x1a\xe2\x82\x61\x32\xb0\x0b\x21\x1f\x4a\xa8\x33\xbd\xf8\x9d\x43\x7e\x05\x8e\xae\x11\x83\x03\xe4\x84\x70\x46\x06\xa6\x7a\xe7\x64\xb1\x96\x24\xe6\x33\xf0\xef\x0f\xf4\xef\x04\x71\xe1\x58\x38\x1f\x0b\x7f\x5b\x21\x0b\xbf\xe4\x4e\xba\xcc\xd9\x59\xee\x14\x46\x78\xa0\xc6\x0a\x13\x41\x9f\x01\x83\x9b\x38\x66\x70\xa5\xee\xb7\x5c\xad\x96\x7c\x19\x54\xdf\xda\xfc\xc7\xd6\x62\xd7\x41\x95\xce\x1b\x95\xa1\x79\x02\xb2\x01\x12\x63\x36\xd6\x99\x0f\x9c\x27\x71\xb3\xff\x5b\x40\x7e\xd5\x87\x5a\x03\xf6\xc3\x44\x7b\x40\xe7\x74\x7e\x5b\x7d\xdf\xaf\xc1\xc5\x1d\x78\x55\xef\xb8\x95\x22\x90\x32\x9f\xbc\xcb\x5c\x8f\x69\xdf\x6e\xa9\x12\x13\x2e\xa0\x72\x50\xd5\xb0\x0e\x26\x02\xb1\x6b\x18\xfd\x0e\x03\xa1\xfc\xe7\x65\xf5\x15\xa1\x01\x22\x61\xa1\x24\xcb\xfd\x32\x74\xa9\x9c\xc6\xde\xc9\x64\x01\x9f\x8a\xba\x4c\x9b\x18\x47\xe0\x2a\x81\x7f\x5b\x10\x81\x85\xda\x03\xf6\x03\xb9\x9b\x5f\x77\xa2\xda\x83\x3d\x8f\x41\xed\xa3\xc5\x52\x1b\x87\x11\x72\x24\x1f\x4f\xf2\xb7\xd3\x93\xfc\xd5\x7a\x4b\x38\x68\x83\x27\x20\x14\xaf\xc1\x16\x58\x73\x42\xae\x95\x02\x3e\x1e\x0b\x2d\xb2\xf0\x88\xde\xaf\x67\x41\xc7\xd4\xe3\x66\xc6\x98\x54\x20\x97\xb2\xb8\x3e\x0d\x23\x9e\x2b\x8b\x37\x80\x2d\xc4\x26\x41\xe2\x71\x86\xfa\xbe\x17\x31\x83\x0b\x1d\x3b\x2b\xad\x5b\x15\xc8\xfa\x80\xc8\x40\x0c\x55\xb4\xbb\x54\x8e\x2c\xcd\x2d\xbe\xce\x6a\x00\x39\x75\x21\x26\x85\x0b\x11\xc0\x80\x51\xce\x01\x8c\xa2\x94\x6f\xbc\x38\xbc\xe0\x5d\xe2\xa8\x14\xfb\x68\x0e\xf2\xec\x17\x64\x9f\x2e\xc8\xb3\xbf\x51\xd1\x16\x69\x70\xfa\x20\xff\xad\x39\x5b\x7e\x95\xda\xe0\x1c\xf1\xf3\x34\xfe\x5d\x3d\x48\x00\xaf\x07\x96\xd2\x40\x3b\x50\xeb\xa7\x26\x62\x78\x6b\x29\xc4\xc4\xd2\x07\x1f\xd2\x9b\x7a\x5b\x41\x05\x8c\x2c\xad\xaf\xe5\x56\x31\x8b\x86\xd6\x26\x1a\x61\x82\x6a\xa1\x52\x59\x78\x83\x43\xcd\x6c\xb3\xb4\x1a\xe8\x92\xdb\x88\x33\x8a\x89\xc8\x5c\xbd\xb2\x00\x40\x0e\xee\x11\x2b\xf6\x7c\x99\x8b\x51\x6e\xf4\xab\x92\x32\x92\x4f\x33\x18\xe2\x44\x76\xbd\x53\x2e\xaf\x0b\x16\x43\x24\x44\x4c\x45\xca\xbc\xcb\x88\x16\x51\x04\x8f\x23\x86\x11\xff\x97\xd4\x01\x38\x44\x95\x71\xf2\x11\x0c\x90\x4d\x7e\xb9\x80\xc1\x55\xed\x2d\x5c\xa0\xd1\x08\x85\x1f\x30\xa9\x0f\x78\x6a\xe8\xff\x6a\xcd\x55\x22\x68\x3b\xd3\xb2\x90\x21\xbe\x06\xbf\x82\x4e\xa3\x7d\xc1\xb1\x1a\x2b\x46\xbc\xc9\xca\xd8\x10\x92\x36\x33\x75\x1b\x32\xa3\x01\x02\xdf\xbf\xeb\x51\xfe\xf8\x31\x05\x3c\xad\xdb\x22\xfb\x3e\x5a\x4d\xea\x77\x46\xe3\x6a\x7c\x5b\x96\xff\x81\x06\xe9\x69\xc9\xca\x03\x9f\x86\xf8\x52\xd4\x9f\x48\xad\x5d\x3d\xa0\x04\x8c\x78\xbe\xc8\x3d\xe8\xdf\x4d\x35\x08\x19\x0a\xeb\x8a\x30\x8d\x9a\x77\xaa\x3a\xb0\x9f\x59\x45\x4c\x42\x7c\x8d\xc3\x04\x46\xb5\x23\x70\xb9\xe5\x54\x47\x96\x8a\x01\xdc\xc2\x5b\x5c\xb1\x34\x17\x49\x70\xa5\x15\x41\xd9\x99\x92\x1f\x7b\x10\xe5\x38\x78\x76\x2c\xac\xd2\xda\xae\xcc\x73\xa5\xfd\xe7\xb7\xda\x10\xef\xe0\xed\xb8\x98\xbe\x61\x7b\xfd\x06\xa1\x34\x43\x3e\x65\xf9\x81\x17\xc8\x22\x75\x11\x1d\xbc\x85\xbc\xee\x90\xd4\x36\xcb\xf3\x2b\x61\x04\x65\xb4\xbc\xca\x92\x30\xe6\x39\x11\x9d\x37\x86\x4a\x8b\x29\x68\x17\xcd\xfc\x83\xaf\x39\xc2\xf4\xe8\x6b\xc5\xe9\xf0\xcd\x63\x16\xb6\x15\x73\x57\x97\x93\xcc\x0f\x5a\xc3\x07\xb2\xfc\x03\xba\x56\x63\x96\xef\xdb\x30\x3b\x5d\x15\x5c\xf3\x9b\xe1\x1a\x02\x0c\x0a\x04\xe8\x25\x10\x90\x5f\xe9\x70\x95\x18\x62\x0e\x52\x55\xbc\x2c\xf8\xe6\x77\x27\xc0\x37\x7f\xd7\x8e\xdf\x8a\xc3\x49\x56\x85\xea\x40\x9d\x03\x75\x19\x99\xa7\x05\x75\x58\x2e\x81\x09\x1e\xdd\x45\x40\x91\x31\x67\x98\x1d\xda\x30\xca\x1f\x8a\x36\x20\xbf\x02\x7f\x40\x81\x1c\xc4\x58\x12\xc4\xa0\x23\xbe\x3c\xeb\xec\xb0\x45\x5a\xf4\xb4\xb0\x45\x67\x19\x60\xa0\x54\x30\x0e\x0d\xf4\x76\x9d\xe1\x77\x86\xff\xa1\x86\x7f\xbc\xc9\xbf\x80\xc1\x55\x44\x07\x4b\x31\xfa\xce\xde\x1b\xe5\xf3\xd8\xfb\xb7\x9a\x49\xce\xe4\xcf\x69\xf2\x9b\xcc\xa6\x3a\x4a\xec\x6c\xfe\x4c\x36\xbf\x3c\x7c\x67\xf2\x1f\xb4\xff\xef\x6e\xdb\x2d\x7e\xb7\x5b\xfc\x5e\x91\x03\x60\x88\x43\xf4\x7f\xd2\x7a\x3a\x84\x90\x56\xbd\x3c\x84\x90\x1d\x44\x1a\x21\x12\x62\x32\x58\xa2\x7f\xc0\x41\x05\xa3\xfc\x81\x50\x21\x3b\x99\x7c\xa6\xb9\x55\xb9\xac\xe4\x30\x83\xc3\x0c\x0e\x33\x3c\x41\xcc\x50\x73\x13\x34\x82\x86\x9e\x83\x07\x0e\x1e\xac\x10\x1e\x2c\xe8\x9c\xb2\x1d\x21\x38\x80\x50\x94\x2f\x08\x20\x9c\xa4\xfc\x72\x18\x61\x49\x18\x21\x76\x91\x04\x87\x10\x1a\x0e\x29\x4c\x8f\x10\x0e\x26\x21\x84\xaa\x57\xa1\xd7\x94\xfa\x63\xff\x91\x01\xc2\x6f\xf1\x48\xdc\x59\x1e\x9a\x04\x1e\xaa\xed\x9f\x24\x7a\x70\xa8\xe1\xf1\xee\x8e\xd9\x21\xc3\x7a\xe2\x85\x27\x03\x17\xcc\x9b\xea\x0e\x2b\x3c\x65\xac\x30\x9d\xb5\x75\x60\x61\xcd\xc0\xc2\x43\xdc\x09\x13\xc1\x42\xcd\x9d\xd0\x84\x16\xfc\xed\x47\x44\x0b\xcf\xc7\x69\xe0\xcc\xfe\xa3\x5e\x6a\x76\x96\x7f\x89\x8e\x02\x67\xfc\xcb\x03\x76\xc6\xdf\x32\xf6\x17\x66\xfc\x9b\xd2\x9c\x0a\xaa\x97\x8a\x4e\x6a\xb5\xfc\xbc\xa6\xdb\xbe\xdd\x6c\xf7\x76\xc6\x99\xac\x45\x26\x36\x85\xc1\x10\x9d\xe3\x18\xd1\xa4\xa6\x49\x54\x4e\x96\xb7\x30\xb8\x1a\x30\x9a\x28\xe4\x50\x36\x27\xaa\xfe\x8c\x72\x71\x89\x6b\xfb\x7d\x5d\xa9\xd2\x04\x55\x2d\xb3\x25\xd5\x8b\xf7\x53\x6f\x6f\x7f\xaf\x6b\x66\x84\x9a\x26\xa7\x8b\xf7\x53\xb8\xbd\x0d\x7b\xb0\x9e\x27\xb7\x21\xc2\xe7\x0d\x60\xa2\x0c\xf9\xf7\x12\x68\xc9\x86\x59\x4e\xa3\x1d\x63\x92\x55\x74\xea\x9a\xa5\x2a\x7f\xb9\x62\xff\x20\x97\x4d\xcd\xfa\x9b\x2d\x4e\x21\xbb\x42\xac\x11\xce\x34\x4a\xd7\x4e\x45\xba\x26\x0a\x57\xcf\x2e\x5c\xc5\xb5\x62\xc3\x28\x96\x38\x6f\x93\x39\x7b\x62\x28\xb3\x7c\x8c\xf6\xcc\x52\x92\x2b\x05\x0c\x04\x05\x4a\x22\x4b\x1a\xe1\x3a\x63\xc2\x44\x75\x96\x75\xc6\x20\x19\x4c\xe8\xac\x3b\x46\xb9\xc4\xf0\xf6\x04\x0a\x78\x96\xa1\x35\x83\xfb\x75\x50\x18\x50\x42\x50\x20\x90\x91\xea\x5a\xb6\x39\x97\x6f\xae\x10\x6f\x94\xaf\x08\x63\x25\xa6\x85\xbf\x1b\x29\x83\x77\xcc\x8c\xc1\x23\x86\xea\xcf\xa8\xb2\xc6\x47\xd4\xf4\x4f\xe1\x68\x0c\xd9\x2f\x35\xa2\x28\x43\x5a\x55\x23\xf4\xc0\xbd\x8f\x5b\xd5\xbc\xac\x34\x7f\x60\x0c\xed\xf8\x08\xb2\xab\x48\x63\x4c\x43\x4a\xe5\x0e\xa7\x9c\xbc\xbe\xe7\xb7\x80\xef\xef\xb7\x80\xbf\x7f\x20\x17\xb0\xbf\x5f\x5a\xc0\x97\x49\x64\xc3\xfb\xb2\x67\xb3\x1f\xdd\x4d\xb7\xd3\x02\xfe\x41\xaf\xd4\x81\xe5\xec\xba\x81\x3a\xe4\xb6\xe6\x58\xa5\x6e\xae\xe8\xc5\x69\x81\x30\xbc\x1e\x34\xdf\xb6\x31\xbe\x1c\x80\x48\x28\xb9\xc1\x50\xb8\x94\x3b\xc0\x0f\x40\xc2\x8b\xce\x1d\x33\x1e\xc9\x7a\x7e\xab\x6b\xb1\x44\xbf\xe5\x64\x29\xbe\x43\x60\x49\x53\xce\x31\x19\x44\x88\x0b\x68\xec\x89\xd4\x0a\x36\x65\x7f\xdf\x94\x7d\x55\x3b\x5e\xf6\xa9\x4a\x78\xf9\xc6\x2e\xf6\x1d\xbb\xba\x98\x28\xf7\xaa\xe1\xc7\x54\xff\x64\xfb\xe0\xe5\x18\xd6\x95\xd9\x4e\x03\x2d\x96\x46\xfd\x22\x6d\xea\xf6\x94\x36\xd5\xb7\x9e\xf5\x74\x46\xd5\x19\x55\x67\x54\xb3\x6e\x67\x35\xaa\xd7\x49\x44\x10\x93\xaf\x5b\x4e\x62\x0d\xf0\x54\xcd\xea\x97\x9c\x30\x6b\x66\x56\x6b\x82\xff\xb4\x0d\x6b\xf7\xe0\x20\xd8\xde\x5d\x86\x61\xed\xbc\x6c\xab\xba\x3f\xad\x55\xed\x39\xab\xea\xac\xaa\xb3\xaa\x8b\xb4\xaa\x09\xb9\x80\x11\x24\x81\xdb\xaa\x96\x6c\xea\xe7\x9c\x2c\x6b\x66\x53\x9f\xd0\x56\xd5\x52\xb5\x74\x93\x5a\x4a\x12\xf4\x9e\x84\x38\x80\x02\x71\x10\xa3\x98\xb2\xbb\x34\xbb\x23\x0a\xc1\xc5\x1d\x10\x43\x04\xd4\x54\x01\x22\x42\xca\xe1\x26\xf8\x8b\x7c\xa4\x02\x1d\x82\xf7\x97\xaa\x36\x21\x58\xb4\x03\x18\x05\x49\x04\x05\x65\x00\x73\x40\xa8\x00\x6f\xdf\x7f\x3c\xfa\xe3\x2b\x50\xe9\x86\x50\x9e\x6d\x28\xad\x84\xe0\x04\x73\xc1\xf0\x45\x22\x50\x78\xac\xba\x4f\x5b\xb4\x64\x73\xa2\x9e\xc9\xbe\xb0\x11\xc3\x3b\x70\x81\x00\x26\x01\x65\x0c\x05\xc2\xcc\x4f\x94\x4b\x78\x88\x82\x8b\x3b\x61\x2c\x93\xe7\x8d\x05\x6a\x47\x19\x1a\xc1\x80\xef\xc0\x80\x03\x03\x0e\x0c\x3c\x38\xef\xbf\x52\x7e\x7d\xa9\xe4\x78\x5f\x69\x98\x39\x01\x40\x0d\x66\x58\xf6\xd8\xeb\x0f\x07\xda\x7e\xcb\xf8\xaa\x55\x01\x08\xb4\x2e\x3f\x55\x76\xc4\xe1\x80\x35\xc7\x01\x2f\xd7\x7a\xee\x4e\x69\x3d\x7b\xce\x41\x0d\x9c\xf5\x74\xd6\xf3\xe1\x5f\xcd\xd1\x67\x83\xfa\x31\x24\x70\x80\x58\x1f\x93\x10\xdd\xf6\xd5\x89\x55\x6d\x53\x97\x6f\x4d\x9f\xae\x31\x7d\x2f\x89\xe5\x8c\x69\x56\xf7\x54\x8c\xe9\x0b\x72\x49\x77\xa7\x3d\x3d\xd5\x73\xdb\x50\xe0\x0c\xa9\x33\xa4\xd3\x18\x52\x95\x6a\xc8\xfe\xfd\x84\x55\x6c\x46\x9b\xbe\xa4\x20\x57\xc4\x98\x4f\xf4\xac\x8d\x75\x6d\xdc\xa5\x56\x93\x04\x3d\x63\xbb\xfa\xa4\xaf\x6d\xd5\x6c\x4c\xc3\x97\x7f\x7a\xc5\xdb\x1f\x35\x09\x4c\xed\xca\xf6\xf3\xb9\xc8\xed\xd2\xc0\xac\xe6\x40\xab\xfd\x6a\xd7\x6b\x30\xc7\xe9\x9b\xba\x32\x9d\x51\x13\x1b\xc7\x52\x8d\x60\xdf\xe1\x14\x0a\xba\x01\x52\xad\xec\x64\x53\x23\x75\x57\x47\x5c\xeb\xe1\xa4\x26\xda\xce\xf0\xa9\xc4\x47\x8f\x6f\x37\xd0\x76\x75\x94\xb5\x86\xa8\x9b\x28\x3b\xfe\x23\x7f\x8f\x77\x0b\x31\x1f\x29\xf8\x24\xa0\x48\x38\x90\xca\x10\x9c\x9b\x97\xf8\xdc\x3d\xc4\xc5\xde\x43\x5c\xf2\x5d\xbe\xe7\x99\xb3\xc8\x7d\xfd\x60\x21\x79\x08\x1a\x11\xed\xde\x6a\x10\xed\xf3\xcd\x4c\xe4\x00\xed\x64\x58\x40\x6f\x08\x0a\x4d\x70\x30\x62\x38\x86\xec\xee\x19\x62\xda\x33\x3d\xb3\xc7\x04\xb4\x35\xea\x5e\xc0\xe0\x2a\x19\x3d\x3f\x48\xfb\x56\xcd\x6b\x46\x38\xbb\x4a\xd0\xf5\x2f\xc9\x19\x90\x89\x04\x24\x21\xa8\x4d\xc1\xa1\x2f\x87\xbe\x1e\x7f\xf4\x6b\x87\xbe\x02\xe5\xde\xae\x2c\xb0\x4b\xc3\x3d\xea\x77\x4c\xff\x68\x23\x52\xda\xab\x20\xa5\x89\x99\x1f\x76\xf6\xec\x40\x69\x7f\x1c\x10\x18\xc1\x01\x4a\x07\x56\x5a\xf8\x3c\x60\x54\x61\xba\x12\xbe\x90\xb4\x7d\x87\x60\xa8\xec\x78\xb9\x46\xaf\x62\x63\x0e\x01\x8d\xca\x9c\x54\x47\x6b\x1b\x43\x03\x5c\xdc\x45\xe3\xd6\x89\x02\xb5\x92\x7c\xe7\xe5\x75\xaa\x90\xaa\x40\x85\x6a\xfd\xfa\xf5\xeb\xd7\xf6\xe9\x69\xfb\xe4\x04\xbc\x7b\x77\x18\xc7\x87\xbc\xa2\xc4\x47\x50\x08\xc4\x88\xbd\xaf\x4c\xa1\x0c\x71\x18\x22\x32\xd9\xac\xe5\xc3\xfa\xcc\x51\x08\xc0\x27\x89\x57\xca\x3d\xaa\xf8\x6a\x0a\xb3\x6a\xc2\x6a\x09\xbe\xaa\x72\x1d\x6d\xdd\xde\x69\x81\x9d\x6d\xfd\xaf\xb3\x79\x50\x76\x14\x4c\x17\x93\x2d\xda\xed\x74\x5a\xc0\xdf\xeb\xb6\x80\xec\xb5\xb3\x79\xb0\xf7\xda\x33\x5a\x7d\x9b\x87\xa2\x8d\x5f\xcf\x6b\x88\x51\x56\xd9\xa0\xe3\xba\x65\x3e\xd8\x4d\x5b\x89\x47\x24\x89\x2f\x10\x2b\x3f\xa7\xbe\x00\x6c\x1e\xda\x9a\x9e\x83\xa7\xa8\xde\xdd\xcb\x62\x5e\xf7\x21\xcc\x8b\x2d\x64\x9b\x97\x7b\xda\x66\x4c\xcf\x3a\x15\xb3\x72\x9c\xcb\x2a\xa4\x9e\xaf\x81\x94\xb4\xfc\x3c\xc7\x66\xde\x09\xc3\x51\x04\x42\x7a\x43\x80\xa0\xe9\x45\x86\xec\x5e\xc1\x25\x65\xe0\xe7\xef\xfd\x7e\x80\xa2\xa8\x92\x96\x4c\xf6\xf2\x99\x29\xfb\xbd\x15\x6e\x05\x74\xd8\x56\x8f\xb6\xd3\x47\xff\x79\x0d\x59\xdb\x00\xe0\x6f\x4c\x34\xfe\x37\x59\x69\xc0\xf0\x37\x26\x26\x57\x95\x8a\x95\xba\xca\xfe\xfa\x29\xc5\x52\xc1\xb7\x99\x85\x92\x0b\x86\xc9\x60\x81\x42\x59\x3d\xa1\x04\x5e\xba\x6c\xce\x62\x12\xc0\x4f\xd5\xb3\x10\x8f\x6f\x15\x34\x17\x15\x44\x7a\xc1\x3c\xec\xcc\xc1\xc3\xb7\x8b\xe5\xa1\x3a\x41\x36\x3d\xff\xce\xa9\x80\x11\xf8\x6f\xb9\xf5\x73\xfc\x7b\x10\xff\x8e\xd7\x80\x7f\x67\x89\xe3\xdf\x43\xf9\x77\xb2\x52\xfe\x7d\xc9\x5d\x77\x20\xc5\x01\xe5\x6e\xb3\x4d\xb7\x07\x13\x41\x9f\x01\x8b\x9b\xec\x9e\xc1\x97\xba\x37\x73\xb5\x40\xe5\x65\x50\x7d\x6b\xf3\x1f\x5b\xcb\xdd\xa5\x6c\x54\x86\xf6\x04\x2f\xd7\x8d\x3d\xcf\x38\xc6\x61\xae\xa2\x6c\xa5\xca\x22\x73\x72\x6d\x1b\xb2\xf8\x53\x8e\x0d\x12\x3e\x05\x79\x39\xbe\x9f\xfb\xc4\xcc\xdc\x84\x5d\x27\xb2\xce\x70\x7e\x66\x0c\x59\xf5\x25\x16\x29\xfc\x8e\xb8\x0d\x47\x68\xe6\x25\xee\x28\x71\xc4\x2d\x11\xf7\x64\xac\x2a\x2e\x1d\x6f\xd6\xfe\x8d\x74\xa2\xc0\x9c\xb1\x11\xc4\x62\x90\x70\x39\xa3\xfa\x7c\x8a\x2c\xc8\xaa\x78\x03\x4c\x9f\x0f\xfa\x0c\x31\x8e\xb9\x90\x34\x7f\xbc\x9c\xd0\xbb\x0d\x5f\x86\xec\x8d\x0d\x0d\x2c\x32\x27\xb4\x2d\x26\x52\xc9\xb4\x51\xf4\xf7\x80\x60\x49\xf5\x6b\x99\x93\x49\xb2\x6b\x27\xc9\x76\x77\x1c\x49\x96\x1d\x2d\x31\x47\x99\x46\x4b\xac\x87\x3f\x9e\x7a\xb0\xe4\x28\x10\xf8\x1a\xe9\x68\x09\xf8\xcc\x8d\x4b\x3c\xe0\xc9\x42\xd0\x15\x84\x4c\xd6\xc1\x3f\x96\x7d\x0b\xc2\x54\x6c\x1f\xa0\xfc\xdf\x39\x3d\x1f\xc8\xd4\x05\x3b\xcc\xe2\x99\x82\x60\xf0\xd6\xb1\x72\x71\xac\x5c\xb0\xef\x6c\x36\x56\xba\x78\x66\x19\x46\x8e\x89\x8a\x95\xe3\x59\x3a\xa4\x39\x7d\x40\x4b\x53\x7a\x01\x41\xab\x75\x88\xa5\x3a\x87\x5d\x8d\x2d\xce\x61\xe7\x1c\x76\xba\xc1\xf8\x7c\x1e\xd9\xd5\xa1\xc2\x7e\xf6\xa1\x42\xba\x7d\x75\x8e\xb9\x9f\xf0\xa5\x5d\x7e\x6b\xda\xa5\x6b\x8d\xb2\x56\xfb\xf4\x79\x1d\x77\x36\x32\x47\x1a\xa6\x64\x5f\x91\x73\x44\x9e\xd7\x8d\x37\x8e\xc8\x31\xbc\x75\x04\x1e\x7f\x1b\x2e\xbf\xab\x56\x77\xf7\x00\x18\x30\xca\x39\xd0\xa0\x81\x3f\xdc\xe1\xb4\x91\xbe\x51\x8e\x49\xea\x45\x59\xdf\xeb\xe8\x0e\x3d\x1e\x0c\x51\x0c\xbf\xc8\xd7\x2b\xf7\x8a\xbf\xaf\x8b\xc5\x9d\x1e\x58\x08\xd9\x95\x6e\x29\xe0\xa0\x50\x78\xea\xd6\xcf\x05\x85\x2c\xf4\xf2\xee\x05\x8a\x47\x11\x14\xd2\x7a\x65\x2e\x0b\x2f\xc2\x5c\x18\x5a\xb2\x74\x88\x36\xca\xd2\xb4\x54\x8e\x4e\x17\x97\x6b\x4a\x62\xc8\x51\xa4\xf3\x78\xd8\xbe\xb1\x98\x67\xc5\x30\xd3\xc6\xa8\x9a\x3c\x11\x80\x55\xd0\x25\x68\x84\x9c\x26\x2c\xd0\x6c\x60\x34\x46\x62\x88\x12\x13\x2b\x7a\x21\xba\xc4\x04\x67\xfe\x27\x75\x28\xb9\xaf\x4f\x72\x5b\x84\xb0\x94\xe4\x62\x88\xc3\x6a\x1e\x1a\x4c\x82\x28\x09\xd1\x51\xcd\x0d\x64\x9e\x77\x1e\x8b\xad\xbc\x38\x89\x04\xb6\x9c\x3f\xce\x73\xb5\xdc\xd7\xd1\x87\x47\x47\xa2\x76\x9e\x1f\x00\xef\xdf\x09\x62\x77\xb3\x4e\xab\x10\x24\xbf\x54\x3a\x40\xb7\x95\x95\xe0\xf1\x2b\x3c\xfa\xcc\xa2\x4f\x77\x24\xb0\x5d\x74\xaa\x9f\xe9\x17\x70\xa0\xe4\x82\xff\x6f\x36\x32\xaf\x5c\x5b\x9b\x82\x2c\xb3\x37\x4e\x17\x83\x9e\xa3\x51\x91\x70\x74\xae\x3b\xb2\x9e\x31\x7e\xb0\x94\x2e\x54\xb0\xaa\x2e\x7d\xcd\x87\x69\x03\x51\x3f\x72\xb5\x3a\x93\x40\xd6\xf2\xb9\x64\x12\x79\x9c\x0d\xe4\x21\x02\x69\x28\xf5\x39\x44\x72\x29\x04\x71\xa2\xfc\xf8\xa2\x9c\x61\x06\x6d\xad\xfb\x2a\x55\xcf\x7c\x40\xe1\x47\x3d\xcc\xf4\x70\x51\xcf\xc2\x3d\x55\x71\x9d\x24\xe5\xb6\xe8\xd0\xdc\x52\xfe\x38\xb4\x7a\xa6\xab\x40\xfd\xff\x6d\x23\x95\x66\x7d\x73\x2b\x87\x26\x79\x8a\x2d\x7a\xd3\xee\x75\xe2\xb4\xbb\x2c\x8d\x16\xbd\xf1\x4a\xcf\x8d\x70\x70\xa5\xe2\x33\xe9\xd3\x29\xc5\xfa\x19\x48\x34\xf7\x82\xde\x8e\x81\xd6\xfc\x8e\xf1\xa3\x67\xfe\xf0\xe3\xe2\xef\x1d\xe3\x6f\xdf\xfc\x51\x8c\x4c\xd6\x18\x57\xd7\xba\xc6\xdf\x7e\xa8\xc1\xcd\xb7\x6c\x0e\x38\x46\x7d\x43\xee\x26\xbe\xc5\xec\x78\xd7\xec\xd8\x7c\x4b\x77\xdb\xfc\x61\xe4\x53\xdb\x0b\xcd\xf1\x66\x63\x29\x91\xef\x9e\x92\x22\x18\x68\x04\x59\x73\xab\x92\x2d\xba\x0c\xfc\x9e\xe4\xf8\x52\x3d\x91\xa8\xa0\x9b\x17\xd0\x61\x3b\x5d\x11\x99\xd7\x4d\xd7\x5f\x17\x00\x76\xe3\xc7\xc6\xff\x07\x00\x00\xff\xff\x7c\xa6\xe2\x93\xc1\xd4\x00\x00")
-
-func manifestsDashboardsCoherenceServiceDetailsDashboardJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_manifestsDashboardsCoherenceServiceDetailsDashboardJson,
-		"manifests/dashboards/coherence/service-details-dashboard.json",
-	)
-}
-
-func manifestsDashboardsCoherenceServiceDetailsDashboardJson() (*asset, error) {
-	bytes, err := manifestsDashboardsCoherenceServiceDetailsDashboardJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "manifests/dashboards/coherence/service-details-dashboard.json", size: 54465, mode: os.FileMode(420), modTime: time.Unix(1647457998, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _manifestsDashboardsCoherenceServicesSummaryDashboardJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x5d\x7d\x53\xdb\xb8\xd6\xff\x9f\x4f\xa1\xc7\x4f\x9f\x9d\xf2\x4c\x52\xec\x84\x94\xc2\xb4\xbd\x93\x42\xbb\xed\x6c\x61\x7b\x0b\xdb\x3b\xbd\x6d\x27\x23\x6c\x25\xd6\x20\x4b\x5e\x49\x0e\xd0\x5e\xf6\xb3\xdf\x91\xfc\x26\xbf\xe4\x8d\x24\x90\xb2\xfe\x83\x21\x96\x6c\xbd\x9c\x73\x74\x74\x7e\x3f\xcb\xd2\x8f\x2d\x00\x2c\x48\x29\x93\x50\x62\x46\x85\x75\x00\x54\x12\x00\x16\xc1\x42\x5a\x07\xe0\x8b\xbe\x02\x49\xaa\xce\x39\x8f\x30\x91\xef\xa8\x75\x00\x9c\x56\x9e\xea\x41\x09\x05\x8b\xb8\x8b\xac\x03\x60\x7d\xe0\x2c\x40\xd2\x47\x91\xb0\x8c\x7b\x10\x85\xe7\x44\xe5\x4b\x1e\x21\x23\xdd\xc7\x5e\x4d\x2a\x76\x19\x3d\x64\x84\x71\x55\x20\x1f\x9d\xc3\xc7\x76\x0b\x74\x1c\xa7\x05\x3a\xbd\x5e\x0b\x38\xdb\x66\xd1\x14\x06\xba\xe2\x7e\xde\x17\xf0\x0b\xe8\x13\xc4\x65\xa1\x09\xf2\x3a\xd4\xf7\x79\x50\xf8\xe7\x0c\x72\xcf\x4a\xf2\x6e\x5a\xd5\x9e\x2e\xd3\x27\x74\x15\xea\x86\xbb\x3e\xa4\x23\x24\x1e\x8f\x11\xf5\x18\x3f\x70\x99\x8f\x38\xa2\x2e\x1a\xb8\x24\x12\x12\xf1\x81\xc0\xdf\xd1\x8f\x31\xe2\x1c\x7e\xff\x0e\x29\x4b\xd3\x5f\xfc\xf5\xd5\x7a\x34\xfe\x9e\x5c\x7d\xb5\x5a\xc0\xcc\x38\x8c\x7f\x9f\xc0\x00\x7d\xb5\x6e\xbe\x38\xc1\xb7\x82\x34\x12\x81\x0e\x21\x11\xd3\x25\xaa\x25\xb9\xff\x34\xfe\x2b\x4a\x94\xe0\x00\x2b\x0b\x70\x6c\xbb\x46\xce\xa7\x3e\xbb\x04\x49\x2b\xc0\x29\xfe\x8e\xc0\x61\xdc\x51\xb3\x08\xe1\xb3\x4b\x6d\x28\x66\x09\x12\x8e\x7e\x43\xd7\x42\xcb\x26\x7e\xde\x2a\xe6\xaa\xac\x2f\xdf\xcc\x34\x2c\x09\x7a\xc3\x78\x00\x55\x7b\xac\x9a\x5a\xbd\x27\x75\x3a\xd6\x65\xdd\x97\x7a\x05\xe2\x63\xec\xa2\x01\xbb\xa4\xc8\x1b\x84\x90\x4b\xac\xad\x72\x10\x72\x1c\x40\x7e\xbd\xac\xca\x9f\xda\xe2\x5e\x74\xfe\x21\xed\x09\x38\xe3\x90\x8a\x21\xe2\xb3\x55\x2e\x24\x0a\x55\x01\x56\xbd\x19\xe8\xf2\x17\xb3\x81\x19\xad\xa8\xb5\x00\xfd\xff\xdb\x56\x62\x0b\x16\xf2\xb0\x2c\xe9\xd6\x1a\x51\x24\xdf\x79\xd6\x01\xa0\x11\x21\x71\x0a\x87\xa1\x7f\xc6\x18\x91\x38\x4c\x7b\x65\x61\x75\xcb\x6e\x27\xfe\x2d\x11\xd7\xfe\x46\x89\xad\xb7\xdf\x71\x9e\x75\x1d\xbb\x6b\x77\x7b\x3a\x97\x60\x7a\x21\x32\x37\x9a\xda\x9e\x56\x4a\xd1\x0b\xb5\xb2\x2c\xea\x92\xc8\x43\x9f\x20\x17\x25\xab\xb3\x2e\x10\x0a\xcf\x70\x50\xb6\xc6\xaa\xbc\x62\x69\xa9\x0a\x5e\x41\xf7\x02\x48\x06\x8e\x21\xa6\xe0\x28\xad\x0d\xe4\xd5\xa5\x92\x52\x0d\xcd\x53\x23\x4e\x54\xe2\x8e\xb7\xe3\x32\xbf\x1d\x40\x4c\x77\x32\xcb\x6e\x67\x8d\xd6\x19\xb1\x78\x93\xe1\x95\x75\x10\x8a\x23\xce\x42\x8f\x5d\xd2\x72\x63\xd3\xae\xa3\x2b\x89\x38\x85\x04\x14\x6b\x5e\xa2\xfb\xe6\xc0\x2e\x39\xf7\x1a\xc9\xf4\xc7\x10\x13\xa5\xff\x5c\x2c\xa2\x2a\x16\x2f\xcf\xdb\x4a\x8d\x48\x17\x66\x85\x90\x22\x52\xa3\x5a\x97\x51\x89\xa8\x36\xd2\xe7\x2e\xa2\x12\xf1\x97\xcf\xfd\xee\xcb\xd3\xd8\x1d\x08\x70\x1a\x05\x6a\xf8\x83\x36\xf8\x84\xb8\xc0\x8c\x1e\x80\x47\x87\xa9\x6c\x93\xa4\xe7\x3b\x7e\xf7\xe5\xf3\x9d\xe4\xf1\xbc\x55\x33\x1d\x96\x35\xe2\xd8\xfb\xc0\xf2\x69\x3c\xf6\x0c\xd6\x01\xe8\x18\xa3\xe3\x52\x5d\xef\x1a\x09\x57\xa5\xf1\x7a\xad\xae\xcb\x8e\x33\xb6\xfa\xfd\x5e\x76\x9d\xd9\x76\x2e\xdd\x80\x69\x1f\x64\xf9\x32\x20\x56\x55\xe6\x55\xf9\x4a\x74\x25\xeb\x2d\xc8\x65\x24\x0a\x68\xa9\xfc\xd9\x02\x18\x32\x2a\xd5\xc4\xa0\xf2\x1d\xdb\xfe\xbf\x39\x44\xb3\x57\x12\x8d\xd3\x99\x21\x9a\x4e\xbd\x68\xba\x9d\x69\xa2\x09\xe1\x08\x25\x0d\x4b\x9d\x8b\x4e\x17\x2e\x67\x84\x94\x6d\x5a\xb9\xd1\xb7\x08\x7a\x88\x57\x72\x18\x97\xc5\x3e\xb8\x8c\x94\x63\x31\x24\xdc\x74\x2a\xa8\xb4\x55\xc8\x6b\x82\x8a\x03\x26\x2f\x4c\x0d\x5d\x82\xa1\x76\xcb\x7a\xac\xb5\x4a\x59\x23\x3d\x78\x61\x24\x59\x31\xcb\x83\xd2\x70\xcf\x9f\x3f\x7f\xfe\xdc\x3e\x3e\x6e\x1f\x1d\x81\xb7\x6f\x0f\x82\xe0\x40\x88\xe2\xed\x21\x94\x6a\xf8\xd7\x57\x93\x1a\x87\x8f\x3d\x0f\x51\x2b\xcb\xba\x69\xcd\x68\xf1\x31\x0a\xce\x8b\x33\xc1\xf4\x46\xbb\x6a\x5e\x3c\x8e\x6d\xd6\x54\x4a\x9e\x59\x94\x93\x4e\x8f\xe7\xd0\xdd\x5e\x0b\xf4\x76\xe3\x3f\xfb\xc9\xfe\x76\xa1\xe0\xec\xae\xee\x5e\x0b\x38\x9d\xfd\x16\xd8\xb5\xd5\x6d\xcf\x26\xdc\xd7\xb3\x5b\xc0\xd9\xeb\xb4\x80\x2a\xd5\x7e\xb2\xbf\xb7\x6d\x19\x77\x7d\x5b\x46\xd0\x1e\x72\x71\x00\xb5\xa3\xb2\x0b\x19\x01\x0c\x43\x4c\x47\x67\xb1\xac\x9d\x49\xda\xf9\x04\x49\x84\xc0\xff\xf6\x4b\x1a\xf2\x39\x12\x3e\x23\x5e\x79\x9e\x36\xb4\x47\x23\xa5\x8c\xe2\x73\x11\xd5\xe1\x85\x32\x6f\x2e\x17\x50\xec\x09\x5c\xc0\x14\xff\x5e\x5a\xed\x14\x32\xf4\x64\x5a\x0e\x56\x93\xf4\x3c\x8a\xb1\x8e\x38\x26\x04\xa8\xe9\x59\x05\x07\x49\xa8\x0a\x3c\x24\x21\x26\x02\x0c\x19\x07\x8f\x7e\x0c\x06\x2e\x22\xe4\xc6\xaa\x94\xf3\x47\x21\x38\x48\x1e\x6e\x27\x0f\xff\x63\x0c\x79\x3b\x99\xec\x94\xd6\x5e\x64\x05\xfd\xa2\x72\x8c\x20\xf6\x85\x19\xd1\x5a\xb7\xb1\xcd\x4a\xb0\xbd\xb8\x91\x0a\xc9\x31\x1d\xad\xc6\x48\x4f\x25\x94\x91\x00\x6f\xfb\xb7\xb2\x54\x4b\x09\xa9\x26\xfb\xe1\xda\xea\x62\x1e\xe8\xd5\x34\xe5\x16\x7b\xd4\x29\x77\xb1\x3b\xb9\x33\xf3\x1b\x42\x21\x6b\xac\x1a\x75\x0c\xc3\x6a\xed\x3f\x0a\x57\x20\x09\x71\x0e\x80\xf5\xfa\xe4\xa8\x7f\xf2\xeb\xeb\x8f\xaf\x8f\x4a\xcd\x4b\x8b\xd3\x21\x8b\x55\xc8\xba\x69\xcd\x57\xf6\xc9\xef\x47\xaf\xdb\xa7\xfd\x37\xaf\xa7\x14\xdd\xb9\x5d\xd1\xc7\xfd\xc3\xb7\xef\x4e\x66\x96\xde\xbd\x5d\xe9\x1f\xfb\x87\xbf\xcd\x2a\x7a\xf7\x76\x45\x9f\xbe\x3b\x9b\xd9\xea\xde\xed\x8a\xfe\xfd\xe3\x87\xb7\xfd\x93\xa9\x8a\xb4\x4b\x25\x9b\x06\x38\xbf\x4b\xf9\x94\x71\x04\x29\xd7\xf2\xb0\x67\xc1\x49\xae\xc2\x70\x07\x55\xda\xe4\x7e\xbd\xfe\xdf\x5e\x21\x3b\x4f\xfe\x7f\x67\xbd\xd1\xe1\x56\xa9\x69\x96\x84\x7c\x84\xe4\x14\x0c\x93\xb2\x73\x70\x3c\xaa\x32\x73\x19\x17\x37\x80\x42\xe0\x11\x0d\x10\x95\x19\x5d\x47\x99\x87\x06\x2e\x8b\xa8\x5c\x92\xa0\xdb\x06\xe7\xd7\xe0\x71\xb5\x8c\x16\xa8\x84\x2d\xdb\xa0\x28\x87\x61\x36\xb9\xc6\x04\x55\x21\x13\x53\x21\xa1\xe6\x17\x2a\x41\x1e\xa6\x12\xf1\x31\x24\x6f\xa0\x2b\x35\xdf\x57\x9c\x53\x09\x1a\x21\xea\xe5\x33\x77\xb1\x5c\x8e\x86\x9a\xf9\xb2\xfa\xb3\x8d\x7f\x71\xf1\xfa\x70\x20\x74\x78\x34\x70\x99\xb7\x2c\xdd\xbd\x88\x68\x37\x49\xb2\xaf\xa6\xda\x74\x4a\x92\x9c\x4a\xc6\xe1\x08\x81\x24\x88\x06\xef\xb1\x90\x06\x71\xa2\xe9\x4e\xc6\x83\x6a\x27\x0c\xd6\x53\x25\x6f\x81\xcd\x66\x55\x0a\x09\x53\x68\x95\xfd\x86\x56\x69\x68\x95\xe2\x5d\x1b\x05\x6a\x6e\x4f\xab\x34\x9c\xca\x86\xaa\xf4\x6f\xcc\xa9\xd0\xca\x83\x77\x4e\xa3\x24\x92\xd3\x2d\x6d\xec\x33\xbf\x7d\x49\x1e\x45\x56\xe4\x79\xd7\x9a\x6d\xd0\x6c\x45\x29\x0d\x9a\xdd\x30\x85\x6c\x32\x9a\xd5\xb0\x74\xf2\x4a\x93\x40\x87\x69\xab\x00\xaf\x2d\xd5\xaf\xff\xf9\xeb\xab\x75\x84\x95\x71\x9d\x47\x12\x79\x87\xd0\xf5\xd1\x7f\xde\x20\x0f\x71\x98\x5d\x7e\x44\x21\xc1\x6e\x76\x3d\x15\x9b\xa9\x99\xa5\x05\x54\xc9\x9b\x8b\x77\x27\xa3\xb2\x13\x46\xdb\x6b\x45\x66\x7a\x08\x1e\xa6\x03\xe7\x47\x0e\x2c\xce\x6b\x96\x42\x78\x50\xf8\xef\x11\x1d\x49\x5f\x2f\xd6\x29\xa4\x6b\x10\x52\x58\x05\x34\x07\xc0\xab\x8b\x67\x35\xde\xe1\x38\x4c\x16\xb7\x18\x6f\xef\x87\x98\x14\xb0\x91\x4e\xf8\x95\x43\x0f\xc7\x2b\x1f\xec\xd9\xf0\x70\xbf\x04\x0f\x67\xae\x47\xd8\xaf\x20\xae\x18\xc7\x9c\x22\x8e\x6b\xba\x1c\x43\xc7\xbd\x1c\x3a\x6a\x63\x28\xb6\x03\x8e\x47\x35\x0b\xa6\xdc\x88\x73\x54\x63\x70\x7a\x81\xd5\xbf\x11\x67\xd5\x9c\x00\x5e\xd5\x24\x62\x5a\x53\xba\x42\xa0\xd5\x7b\x25\x93\x90\xd4\xdc\xad\xe9\xe4\x54\xfb\x15\x01\x10\x4c\x6b\x7a\xae\x52\x2f\xb1\x17\x9b\xc6\x34\xe8\xac\xdc\xf6\x07\x86\xa9\x4c\xdf\x40\xa9\x84\x5c\xc9\x2c\x2c\xae\x08\xcd\x2c\xe9\x7d\x56\x54\xa5\x45\x21\xe2\x2e\xa2\x12\x8e\x2a\x2b\xd1\xac\x50\xd5\xa4\x4c\x24\x52\xcf\xf6\x8a\xe9\xd5\x5e\x70\x44\x3d\xc4\x35\x4e\xb7\x86\x84\x19\xe3\x4c\x68\x85\xff\xae\x5c\x0c\xf6\x50\xa9\x4f\x22\x84\x2e\xaa\x1b\x19\x42\x42\xb7\x1c\xc5\xeb\x75\x69\x21\xf2\xde\x63\x5a\x6d\xef\xdc\x5e\x59\x44\xc1\x64\x9f\x2c\xa1\xb8\x58\x11\x9d\xd8\x06\x77\x53\x13\x60\xc3\xa1\x40\x12\x3c\x1a\x0c\x52\x07\x3b\x99\x45\xc3\x81\x6e\x01\x46\xa2\xec\xb5\xe3\x27\xab\x7e\x77\x41\xa7\x7d\x06\xc5\x85\xb8\x95\xe7\xae\x9f\xb6\x75\x93\xdf\x70\x16\x94\x09\x23\x95\xfe\x11\x8d\x12\x93\x2f\x3d\x70\xea\xe3\xa1\xac\x3e\x91\x32\x76\x29\x62\x81\xe2\x02\x1c\x2a\x15\x80\x36\xe8\x13\x92\x4e\x14\xe6\xba\xb2\x0c\x2f\xfe\x30\x7d\x02\xe4\xc8\xab\x7a\x85\x84\x93\xb2\xcb\x0e\x61\x90\x4e\x27\x98\x7a\x78\x8c\xbd\x08\x92\xca\x32\xd7\x6c\xca\xd1\x0b\x18\xf3\x06\x5c\xc1\x2b\x5c\x1a\xd3\xe7\x91\x7b\x11\x5b\x7a\x31\x90\xcb\x56\x73\xc9\x22\x95\x94\xae\x07\x2d\xdd\x5d\xef\xd9\x32\x0f\x56\xe3\x2d\xae\xe1\xd5\x34\xc6\x6c\x22\xc7\x92\xdb\x1f\x65\xb4\x14\x2e\x10\x78\x8e\x6a\xac\x8e\xb0\xd1\x2b\x28\xaa\xf8\x28\xf6\xdc\x95\x00\x36\xf6\xdd\x95\x64\xa3\x8b\x33\x43\xea\xbc\x91\x35\xaf\x8f\xd3\x56\x56\x6a\x58\x69\x3b\x4d\xa2\xb2\x6e\x88\x5c\x57\x6d\x21\x8d\xf8\xcb\x13\x91\x4e\x7f\x8f\xc6\x59\xab\xb7\xcc\x42\xef\x8f\x65\x7e\x5a\x0a\x23\x9e\xcd\x88\x22\x9c\x67\x15\x2b\xd4\x91\xc2\xde\xde\xb4\x99\xb2\x21\x99\xcd\xdb\xd7\x43\x32\x3f\x6c\xe4\x79\xb7\x54\xcf\xfd\x12\xcb\xf9\xda\x7c\x3d\x17\xde\xc9\x6a\xa8\x1a\x65\x2c\xa3\xdb\xaa\xa5\xac\x41\xb5\xeb\x79\x71\x50\xec\x8d\x53\xee\x5e\x67\xf6\x52\xa8\x69\xb6\xa0\x27\xdc\xf9\x4d\xe1\xb4\x6e\x5d\x5e\x33\xb6\x9b\xd7\x0c\xf7\xff\x9a\xa1\x21\xa3\x2b\x4a\x69\xc8\xe8\x0d\x53\xc8\x26\x93\xd1\xb5\x6b\x7f\x52\x32\xc2\xf8\xdc\x11\x51\x0f\xd2\x11\xe2\xc8\x5b\x01\x27\xfd\x62\x36\x27\x3d\x93\x83\xde\x06\x2f\x81\xbd\x5a\x02\x7a\x69\x96\x63\x29\x6a\x3a\xfb\xb4\xec\x5f\x58\xfa\xe0\x75\x26\xef\xfc\x53\x4d\xf1\x13\xae\x1f\x9a\x85\xec\x9e\xcd\x8b\xec\x9e\x35\xc8\xee\x7e\x91\xdd\x7d\xe0\x81\x9f\x31\x1c\xac\xb2\x3b\x6b\x81\x04\xed\x0a\x26\x70\x6c\xdb\xb6\xef\x14\x17\x34\x6b\x8f\x1a\x50\x90\xe4\x2d\x0d\x0a\x56\x49\x5d\x34\xa0\xa0\xa2\x94\x06\x14\x6c\x98\x42\x1e\x02\x28\x18\x47\x84\x22\xae\xc2\xcc\x06\x14\x64\xd9\x6b\x04\x05\x9f\x32\x79\x3f\x6c\x50\xe0\x3c\x9d\x17\x15\xec\xdf\x27\x2a\xb0\xab\xa8\xa0\x76\x81\x47\x03\x0a\x1e\x86\x57\x6f\x20\x41\x03\x09\x1a\x48\x70\x77\x90\xa0\x79\x4f\x50\xb8\xaf\x81\x04\x0f\x5c\x21\x0f\x01\x12\x44\xf4\x1c\x12\x48\xdd\xe6\x3d\x81\x91\xbd\x46\x48\xf0\x47\x26\xef\x0d\x82\x04\xa5\x15\xe7\x67\x3e\x02\x92\x85\x00\x3c\x3a\x63\xe1\x7b\x1c\x60\x99\x2d\xa9\x04\x40\xfa\x50\x02\x1f\x8e\x11\x90\x3e\x02\x23\x8e\xa0\x44\x42\x82\x78\x43\x4e\x80\xa9\x4e\x36\x96\x64\xb2\x31\xe2\x3a\x2d\x59\xe0\x0d\x24\x0e\x10\xe0\xea\xee\x27\x4b\x81\x92\xc5\xf7\x8f\xdb\xad\x07\x25\xbb\xf7\xfa\xaa\xa2\xdb\x80\x92\xdc\x5a\x1e\xf6\x94\xb2\xd1\x4b\x96\x1a\xd8\xb1\x21\xca\x6e\x60\x87\x91\xd9\x7c\x05\xbd\xe1\xf6\xf9\xd3\x7c\x05\x1d\xef\xa6\xd1\xe8\x74\x0e\x9d\xde\xca\xe7\xc4\x9f\xc1\xde\xce\xe5\xc4\xcf\x16\x3c\xce\x24\xbf\xa2\x9d\x4e\xac\xcb\xa2\x37\xba\x95\xc3\x09\x6a\x8c\xa2\x79\xf9\x79\xef\xc0\xba\x61\x3a\x36\x4c\x21\x9b\xcc\x74\x28\xa8\x7a\xf1\x38\x83\xaa\x2d\x11\x05\x8f\x3d\x44\x24\xbc\x83\x8f\x36\xbf\x3c\x1a\x0c\x34\x94\xfd\xb6\x3d\xeb\x0b\xfc\xd8\xd5\x6c\xaf\x81\xde\xb8\x0b\x12\xe3\x8c\x85\x75\x6c\x40\xdb\x44\xfa\xa0\x0d\x0e\x13\x8c\xff\x51\xc9\x64\x03\x59\x8d\xb4\x7d\x8b\xb2\x1b\xca\x60\xc0\x39\x74\x2f\x08\x1b\xad\x99\xb5\xa8\xee\xcf\x36\x81\xb6\xd8\xef\x36\xb4\xc5\xfd\xd3\x16\xaf\x62\xa3\x78\xd8\x33\x44\x43\x5c\x34\xc4\x45\x43\x5c\x34\xc4\x05\x78\x40\xf6\xd9\x10\x17\x0f\x4f\xa7\x0d\x71\xd1\x10\x17\x0d\x71\xd1\x10\x17\x3f\x2f\x71\x31\x9d\xb2\x48\x10\xe8\xd2\x7b\x5a\x3d\x68\xaa\x22\xc5\xf8\xd3\x29\x8b\x04\xb7\xad\x8e\xb3\xc8\x4e\x28\xe4\x68\xa8\x2c\x4c\xe5\x77\xed\x78\xe6\xb2\x84\xeb\xa3\x00\x26\xc7\x0c\x2a\x7b\x8d\x0f\xb1\xd4\xe0\xd8\xd2\xe7\x1d\xf2\xf8\x30\xc6\xe2\xa9\x8a\xc5\x13\x15\xe3\xe2\x25\x0a\x42\x02\xa5\x72\x11\x73\x1c\x1a\x0c\x09\xf9\x94\x9c\x8d\x52\xda\x37\x2a\xdf\x6f\xaf\x60\x9c\x02\x11\xe4\x4a\xbd\x2d\x56\x69\x33\x22\xf3\xdc\x9b\xca\xfe\x4f\xd9\x01\x2c\xb5\xce\x69\xae\x23\x5f\x3d\x34\xc4\x14\xa7\x6c\x8d\xde\xaa\x69\x10\x6f\x63\x55\x63\xaa\x75\x47\xb0\x9a\xeb\x3d\x92\xf3\x2c\xfb\x15\xaa\xc3\xdc\xab\x6a\xea\x04\x66\x05\x11\x91\xb8\x66\x4f\xa6\xf4\x58\xd6\x6c\xb4\x99\x0f\xe5\xbb\xf7\x15\x8e\x51\xfd\x33\x42\xfc\x7a\xd1\x6e\xe5\x86\xe4\x14\x52\x47\xe8\xaa\x34\x48\x2c\x71\x81\xc3\x3f\x38\x39\xbd\xa6\x6e\xdd\xe6\x87\xd5\x0d\xcd\x24\x1c\x69\xbb\x10\xff\x4c\x5b\x66\x15\x73\x2b\x5d\x50\x69\xf5\x37\x27\x83\x21\xee\xa3\x91\x11\x09\x74\x16\x17\x54\xfb\xed\xed\xad\xad\x74\xa5\x86\xb5\xdc\xe9\xd0\x37\x99\x9f\x5d\xc8\x20\x2b\x87\x05\x53\x4f\xeb\xb4\xed\xd4\x99\x69\x76\x4c\xe9\x6d\xac\xb4\x1e\xd9\x2e\x6a\xa7\x6b\x91\xd2\xaa\xed\xdb\x69\xec\x7b\xd5\x9a\x9b\x16\x46\xb4\xc0\x38\x9e\xcf\xea\x4c\xbf\xb3\x6a\xd3\x4f\xe7\xce\x45\x4c\xbf\x74\xbc\xef\xbd\xd9\xff\xe2\x52\x6c\x5c\xff\x3c\x01\x4a\x25\xcc\x4c\xe3\x93\x7e\x79\xd3\x82\x2c\x3c\x79\x34\x18\x40\x42\xd6\x14\xa4\x4c\x08\xdd\x39\xfa\x33\x42\x42\x0e\x02\x78\x35\xf0\xa2\xf8\xd4\xf2\xa5\xad\x46\x2f\x95\x5e\x64\xe0\x95\x82\xa0\xe9\xe3\xae\xb2\x83\xea\x1c\xc3\xad\xee\x99\xe5\x86\xd9\xdd\x0a\xb0\x19\x73\x13\xc7\xdc\xcc\x71\x55\x22\x06\xca\xa3\x6c\xc2\xe1\x8b\xab\x9f\xdd\xa0\xeb\xa3\xd5\x78\xe5\xf5\x8e\x2f\xfd\x29\x44\xed\xe8\x2a\x15\x93\xcd\x65\x95\x07\x96\x9c\xc1\xd6\x28\xa9\x66\x20\x2d\x35\x79\x75\x27\x4c\x5d\xdd\xfa\x59\x6b\x51\xac\x91\xd9\xe0\x19\x0b\xc1\x09\xd0\xec\xc8\x22\x6e\x3e\x65\x54\x26\xd8\xa2\xd1\xf4\xe2\x39\xae\x53\x99\x05\xa3\xfb\x95\x6f\x62\xeb\x0f\xe9\x2d\x9c\x19\x3b\xb9\xa6\xb2\x94\x27\xca\x79\xe2\xa1\xba\x73\x56\x34\xad\x4b\xbd\x89\x35\xf5\x56\x5c\x93\x63\x4f\x96\x9e\xbd\xea\xba\x26\x77\xcb\x29\xf6\x2b\xfb\x5d\xeb\xa8\x9c\x56\xb7\xd5\x6b\x39\x76\xab\x50\xe0\x0c\xd7\x90\x0e\x5e\x37\x12\x92\x05\xd9\x36\xec\xfa\xbf\x9a\x6b\x74\xff\xe2\x1d\xd4\x33\x96\x6c\x18\x6f\x3f\x6f\x51\x76\xd9\x76\x7a\x41\x52\x99\x25\x59\x92\x68\x15\x9e\x0b\xb1\x7b\xa1\x97\xc3\x24\x4f\x27\x5e\x2d\xdb\x9c\xdf\xb4\x76\xab\x67\x2c\x3b\x72\x6c\xe3\xa2\x6b\x5e\x38\x41\xfe\xbb\x67\xfc\x76\xcc\x8b\xae\x6d\xe6\x18\x5b\xc8\x77\x8c\xdf\x8e\x17\x77\xf9\x5b\xda\x07\x1c\xa0\x41\x75\x14\x4e\xae\xc5\x2c\xf8\xa9\x59\xb0\x59\x4b\x67\xd7\xbc\xf0\xf2\xdf\x7b\x9e\xd9\xde\xb4\x2d\x05\xf1\x7d\x67\x14\x65\x1e\xd7\x20\x67\x33\x40\x97\x11\xb2\xa7\x51\x10\x40\x7e\x0d\x8e\x32\xae\x53\x3f\x12\xe9\x45\x4e\x96\xf1\x62\x5f\xb4\x45\x7c\x6b\x7c\xc3\x18\x72\x0c\xcf\xe3\xc5\x45\x25\x1e\x34\x6f\xca\x38\xa3\x5c\x9d\xad\x9b\xad\xff\x06\x00\x00\xff\xff\x2a\x6b\x40\x16\x77\x8d\x00\x00")
-
-func manifestsDashboardsCoherenceServicesSummaryDashboardJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_manifestsDashboardsCoherenceServicesSummaryDashboardJson,
-		"manifests/dashboards/coherence/services-summary-dashboard.json",
-	)
-}
-
-func manifestsDashboardsCoherenceServicesSummaryDashboardJson() (*asset, error) {
-	bytes, err := manifestsDashboardsCoherenceServicesSummaryDashboardJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "manifests/dashboards/coherence/services-summary-dashboard.json", size: 36215, mode: os.FileMode(420), modTime: time.Unix(1647457998, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _manifestsDashboardsHelidonHelidon_dashboardJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x9d\x5b\x73\xdb\xb6\xb3\xc0\xdf\xf3\x29\x30\xfc\xf7\x74\xda\x19\xc5\x47\x94\xef\x99\xe9\x83\x2b\x37\x6d\xcf\xc4\xa9\x5b\x2b\xe9\x43\x93\xd1\x40\xe4\x8a\x44\x0d\x02\x2c\x00\x2a\x92\x33\x3e\x9f\xfd\x3f\x00\x6f\xe0\x45\x17\x27\x71\x43\xdb\xcc\x4b\xcc\x05\x08\x2e\x17\xd8\xdd\x1f\x20\x40\xfa\xf8\x0c\x21\x07\x33\xc6\x15\x56\x84\x33\xe9\xbc\x40\x5a\x84\x90\x43\x89\x54\xce\x0b\xf4\x97\xb9\x42\x99\xd4\x94\xcc\x12\x42\xd5\xaf\xcc\x79\x81\xdc\x41\x29\xf5\xb1\xc2\x92\x27\xc2\x03\xe7\x05\x72\x2e\x05\x8f\x40\x85\x90\x48\xc7\xaa\x03\x0c\xcf\xa8\x2e\x57\x22\x01\x4b\x1e\x12\xbf\x45\x4a\x3c\xce\xc6\x9c\x72\xa1\x1b\x14\xc1\x0c\x7f\x37\x1c\xa0\x91\xeb\x0e\xd0\xe8\xf0\x70\x80\xdc\xef\xed\xa6\x19\x8e\xcc\x83\xcf\xca\x77\x41\xdf\xa2\x33\x0a\x42\x55\x54\x50\xab\xd8\xd4\xf3\xb1\x0c\x67\x1c\x0b\xdf\xc9\xca\x6e\xcd\xff\xef\x9f\x21\x74\xab\xab\x3b\x3e\x48\x4f\x90\x58\xb7\xa4\xeb\xff\x02\x94\xf8\x9c\xa1\x9f\x98\x1f\x73\xc2\x14\xba\x00\x25\x88\x27\xd1\x05\x67\x44\x71\x41\x58\x60\x1e\xe3\x80\x4f\x54\xed\x2d\x9d\x80\x81\xfa\xd5\xd7\x06\x1b\x9d\x1c\xee\xa7\x22\x81\xe3\x70\xc2\x39\x55\x24\x76\x5e\xa0\xa1\x11\x12\x05\x02\x67\x4f\x74\x8f\x0e\x4e\x87\xc7\x27\x87\x07\xa3\xd1\xf1\xa9\x29\xa5\x84\x5d\xeb\x0e\xfa\xeb\xbd\xb9\x8c\x31\x03\x2a\x8b\x2e\xca\x3b\xc8\xf1\xb0\x17\xc2\x84\x44\xc0\x13\xdd\x81\x2c\xa1\x74\x50\x94\x69\x73\xfe\x88\xbd\xeb\x40\xf0\x84\xf9\x35\x9b\xa7\xc5\x6f\x31\x4d\xb4\xf6\x73\x4c\x65\xad\x48\x5a\x03\x02\x21\xe7\x3f\xfe\xc1\x01\xde\xc7\xb6\x79\xff\xb3\x7f\x7c\x72\x3c\x3a\xaf\x88\x5e\x9e\x9d\xff\x34\x3a\xcb\xed\xfc\xbe\x68\xb2\x32\x62\x2a\x6a\xce\x09\x50\x7f\xcc\xd9\x9c\x04\xc5\x80\x4c\x6f\x81\x39\x4e\xa8\x32\xc3\xf4\xd6\x7a\x06\x5f\x80\x10\xc4\x87\xd4\x3c\x79\x8f\x96\xed\x71\x11\x61\x6d\x0c\x87\x71\x06\x85\x72\x4e\x80\x93\x00\xaa\x4f\x88\xf0\x32\x37\x80\x3b\x1c\x5a\x8f\x88\x08\xcb\x0b\x6c\xb1\x0c\xf9\x87\xba\xb1\xf4\x30\x0b\x05\xc8\x90\x53\xff\x15\x9e\xa5\xbd\xb4\xb6\xc6\x05\x16\xd7\x60\x4c\xab\xfb\xa2\xa1\x7b\x20\x88\x7f\xc9\x65\x55\xcb\xd0\x79\x81\x46\x56\x63\x5a\x85\x03\xeb\x7a\x59\xd3\x72\xa5\xaf\x1b\x4d\x13\x3d\x00\x46\xa7\xe5\x35\x53\x20\x16\x98\xd6\xbb\xa3\x3a\xf0\x32\x33\xc5\x31\x61\xc1\x24\xf5\x26\xb7\x4d\x5e\x1d\x2c\xa5\xf2\x96\xb3\x2e\xb4\x41\x91\xe2\x48\xc1\x52\x59\x63\x06\x65\x45\xba\xe9\x42\x68\xf5\x77\x6b\x63\x02\xb3\x60\x4b\x63\xa3\xb2\xb1\xc6\x68\x8c\xf0\xf2\x1c\x2b\x7c\xa9\x9d\x5b\x56\x7b\xdf\xd1\xd6\x30\x05\x17\xdc\x04\x2a\xc7\xe3\x8c\x81\xa7\xc0\x77\x2a\x75\x26\xfa\xc9\x35\xe3\xc5\x5c\xaa\x39\xd1\x1d\xe2\x38\x75\xe1\x4b\xce\xd4\x15\xb9\x31\x4d\x1e\x0e\xff\xc7\x2a\x17\xd0\xbc\xc7\xc8\xd6\xde\x62\x5e\xff\x02\xc7\x1b\xcc\x3e\x17\x3c\x32\x4e\x90\x50\x5a\x35\x90\x4a\x15\x77\x5e\xff\xef\x59\xad\x80\x17\x37\x6c\xb0\x9d\x8c\xb1\xb8\xa6\x84\xd5\x7c\x69\x4e\x28\xad\x06\xf0\x7d\x77\x80\x5c\xf7\x64\x80\xdc\x93\xd3\x01\x1a\xee\xb9\x27\x95\x20\x3e\xd7\xcf\x69\xba\x8a\x6e\xd9\x6e\x27\x6d\x66\x34\x1c\x20\xf7\x74\xbf\xd2\xc0\x1a\x6f\x5c\x45\x78\x59\xeb\x17\x23\x25\x2c\x93\x36\x5c\xc3\x84\xf0\x31\xa7\x49\xc4\xaa\xbd\xa0\xb0\x08\x40\x6d\x30\x32\x2c\x21\x8a\x29\x16\x8d\x7c\x66\xca\x62\xf3\x0e\x49\xfc\xf1\x6f\x3e\xfb\xe1\x9d\xf3\x8d\x04\xb1\x20\x1e\xfc\x7a\xfe\xce\x19\x20\xc2\xa4\xc2\xcc\x03\x5d\x90\xff\xfd\xce\x19\xe8\xd8\x86\x6f\x6e\x30\xe3\x53\x8f\x26\x52\x81\xf8\xe1\xff\xdf\x39\xdf\x2c\x6e\xb2\xab\x77\xce\x6d\xb5\xd3\x2c\x3f\x76\xaa\x25\x14\x02\x60\xfe\xcb\x22\x1c\x56\x4b\x05\xcc\x4d\x96\x72\xce\x36\x75\x76\x11\xb8\xb4\x11\x1c\xd7\x32\x0d\x89\xe0\x65\x3a\xc4\x2a\x1e\xa0\xe5\x57\x21\x99\x37\x5c\x43\x11\x65\xf2\xa4\x73\xa5\xb0\xb2\x48\xa1\x48\xd2\x92\xb0\x80\x82\x54\xb8\xf4\xe7\xd4\x97\x6d\x2f\x38\xb1\xbd\xc0\x94\x6e\xf6\x02\xae\xf3\xad\xf3\x43\xbb\x03\xbc\xb9\x6c\x8f\x1c\x8e\xeb\x6c\x0d\x44\x1b\x1b\x3e\xff\xed\xcf\xd7\x6b\x9a\x1e\x6e\xb2\xb6\xa9\xf5\x3a\x0b\x71\x5e\x22\x04\x30\x95\xd6\xcf\xd4\xf8\xc4\xbc\xdf\x92\xdd\xef\x90\xf8\x47\xa7\xa7\xde\xc1\x91\xed\x79\xc6\xbd\x47\xfb\xc7\xda\x31\x4f\x07\xe8\x60\xa8\xdd\xfb\xe4\xb4\xe2\x9d\x39\x2f\x6c\x26\x81\x36\x76\xac\xd3\x18\x9a\x61\x09\xd3\xbf\x17\xd1\x34\x89\xf5\xf0\x9a\x4a\xf0\x38\xf3\x25\x3a\x27\x32\xa6\x78\x25\x91\x0a\x01\xa5\x65\x88\xcf\xcd\xd5\xff\xbd\xbd\x40\x84\xa1\x88\x50\x4a\xb2\xea\x7b\xce\xbf\x80\x1c\xf2\x91\xf2\xc6\x7e\x8d\x37\x0e\x76\xe4\x8d\xa3\x9e\x37\xee\x95\x37\x68\x12\x10\xf6\x16\x84\xcc\x7c\xe5\x68\xef\x70\x6f\xd4\x40\x8f\x9e\x47\x7a\x1e\x29\x78\x64\x4d\x34\xfd\xb8\x06\x48\x50\x1b\xbc\x74\x9a\x52\xbe\x04\xa4\xbc\x31\xb6\xe9\x02\xa4\x34\xbc\xa2\x40\x89\x6d\x8e\xb1\x3b\x4d\x60\x4a\xb0\x1c\xe7\xb9\xbf\xcc\x7c\xce\x0c\x8b\x46\x7a\xd9\xc8\x1e\x3e\x96\xe1\x2b\x60\x81\x0a\x4d\x94\xab\xc8\xa1\xd9\xd4\x9d\x59\xc0\x0c\xde\xc0\x9b\x2a\xae\x30\xad\x02\x40\x2a\x62\x49\x34\x03\xa1\x31\xc0\xe3\x94\x82\x97\x2e\x0e\xa9\x10\x2b\x14\xe2\x05\x20\xee\x19\x4b\xf8\x7b\x68\x12\x12\x89\xb0\x52\x82\xcc\x12\x05\x88\x12\xa9\x24\x7a\xee\x22\x92\x12\x44\x79\x3b\xf2\x78\xc2\x14\x22\x12\x25\xcc\x87\x39\x61\xe0\xa3\x39\x17\x48\xe9\x06\xb2\x6a\x5c\xdc\x15\x2f\x2a\x3e\x50\xe4\xc0\xb6\x84\xb4\x95\x3e\x88\x09\x5c\x6e\x45\xf0\xb3\xc0\x3e\xd1\x1d\x6e\x11\xc5\xfa\x74\x7f\x52\x4b\xf7\x27\xb5\x74\x7f\xbc\x35\xdd\x87\xc4\xf7\x81\x5d\x81\x20\x2d\xdd\x6c\x58\xc0\x3d\x28\x73\xbe\xf1\xf9\xaa\x1a\x98\x92\x80\x9d\xc9\x49\xfb\xa2\x21\x5e\x04\x4d\x61\x3e\xa6\x1b\x05\x69\xc4\xad\x0b\x4d\xc0\xad\x09\xb3\x98\x5d\x93\x9a\xa1\xd4\x12\xca\x8d\x47\xad\x85\x29\x9d\x29\x64\x7d\x95\x4d\x0b\x3f\x10\x3f\xf5\x87\x4d\xd0\xd3\xc0\x80\x4a\xa2\x74\x78\x5c\x5d\xb2\xcd\x6c\x06\x42\x4d\xf2\xe0\xb7\x4e\xaf\x18\x84\x07\x4c\xe1\xa0\xc9\xfb\x0d\x7e\x38\xde\x3b\xdc\x73\x5d\x9b\x15\x08\x53\x7a\x28\x25\xd2\xa6\xc2\x54\xde\xec\x68\x01\xcc\x07\x01\x26\xcf\xcc\x29\xb7\x82\xa4\x34\x23\xe3\xb7\xca\x48\xb6\x53\xb8\x07\x6d\x61\x43\x2a\xec\x5d\x37\x9e\x22\x15\xc4\x31\xf8\xaf\xd2\x9c\x5f\x2d\xfb\x72\x29\x32\x8f\x32\x5f\x2b\x31\xe6\x25\x2f\xb1\x0e\x2f\x55\x26\x6f\xc9\x9b\x1f\x3f\x22\x0d\xab\xe8\xf6\xf6\xb3\x33\xa8\xd5\x33\x9b\x32\xe8\x1f\x10\x64\x43\xb2\x76\xc3\xe6\xd4\xfa\xf3\x18\x8d\x79\xbc\x42\xdf\x22\x3d\x39\x41\x57\x1f\x00\x62\x34\x31\x1e\x57\x56\x2e\xd6\xca\x3f\xda\xbe\x8a\x05\xd4\x97\xb1\xb5\x9c\x0b\x55\x9b\x38\x19\x47\x9d\xe6\x49\x9b\x30\x9f\x2c\x88\x9f\xe0\x22\x5d\xde\x36\x12\xbb\x59\xa2\x2f\x15\x58\xe2\x25\xa9\x39\xdb\x2c\xf1\xae\xd3\x91\x55\x43\xb9\x28\x73\xd8\x0a\x31\x94\x73\x87\x5a\xed\xf6\x88\x53\x44\x96\x96\xf8\xbe\xc2\xcb\x4d\xf3\x1d\x6b\xf2\x19\x6a\x4b\x54\x47\x89\x9e\x21\x36\x94\xd0\x05\x3c\xf8\x11\x4b\xa8\x7e\xae\x53\x84\xce\x46\xf5\x92\x56\x2b\x62\xeb\x65\xda\x32\x57\xb7\xf5\x6c\x38\xc1\xaa\xd9\xe9\x26\x2b\xb5\x64\x02\x23\x7f\x05\x8b\x42\xe9\x67\x76\xa3\x8f\x80\xaf\xd6\xae\xb3\xe0\x38\x16\x7c\x49\x22\xac\x00\x61\xcf\x4b\xa2\x84\x62\x05\xbe\xcd\x4b\x40\x71\x2c\xc1\x47\x66\x41\x86\x30\x94\x2f\xc0\xd4\x91\xcb\xcf\xdb\x6d\xa5\xae\x6a\x2b\x5b\xe0\x0b\x4d\xf2\x45\x9f\x28\xa6\x10\xe9\x74\x67\x1a\x89\xf0\x0a\x25\x12\x10\x46\x21\x09\x42\x24\x40\x72\x9a\x98\x12\xdd\xac\xd0\x33\xfa\x08\xb0\x4c\x04\x98\xc7\xdb\xcf\x6c\xa8\xab\xdb\xca\x54\x36\x95\xa5\x0e\xb7\xe9\x2a\x03\x2c\x80\xad\x05\xc7\x10\x4b\x34\x03\x5d\x81\x79\xc2\xe8\x06\xfe\x0e\xef\xbb\x00\xb1\x42\xc6\x55\x1e\x35\x5a\xba\x87\x5f\x82\x2d\x47\x1d\x64\x4b\x41\x82\x50\x5d\xa5\x9f\x76\xd7\xe3\x47\xcf\x9d\x3d\x77\xda\x65\x35\xee\xec\xe0\xba\xcc\x03\xe7\xcb\xea\x6a\x4e\x8f\x97\x9f\x80\x97\x91\xfc\xfa\xcc\xd6\x5d\xb6\x34\xe1\xa0\xfb\x70\x79\xdf\x00\xf9\x90\x51\xe5\xa8\x86\x2a\xc7\x35\x54\xa9\x6f\xb2\x19\x7d\x02\xa9\x9c\x74\x10\x54\x7a\x18\xb9\x0b\x8c\x1c\x3e\x25\x18\xf1\xe2\x64\x2a\x57\x52\x41\xf4\x8a\x63\xff\x6c\x01\x02\x07\xb0\x0e\x49\xba\xb5\x20\x36\xbe\x7c\x83\x28\xc7\x7e\xc7\x71\x45\xab\xf9\xca\x56\xb3\xa7\x93\x4f\xa2\x93\xcc\xc1\x13\x46\x3a\xb0\xb4\xd4\x63\x4a\xd7\x30\xe5\xe1\xd1\x48\x7d\xe1\xe4\x74\x0b\x8d\x9c\xdc\x8d\x46\x42\xe2\x9b\x45\xc6\x3c\x1b\xad\x59\x58\x29\x15\x6d\x0d\xcc\x1d\xa2\x98\x7e\xb9\xa5\x27\x9c\xe2\xdf\xee\x84\x13\x41\xc4\xc5\x6a\x9a\x48\xf0\x7f\x01\x1c\x4f\x67\x2b\x05\x6b\x17\x5d\xba\x45\x38\x6f\x24\xf8\x48\x2b\xbd\x03\xe2\xac\xc9\x48\x77\x35\x93\xc7\xa3\x88\x28\xb5\x8b\xad\x3e\x71\x81\x6a\x80\xee\x0d\x08\x8d\xee\x9b\x4d\x36\xfe\xf2\x26\x8b\xf0\xf2\xde\x8c\x75\x5f\xa6\xba\xc0\xcb\x4d\x56\xfa\xb1\x23\xec\xac\x55\x44\x57\xe4\x06\xe4\xe7\xd1\xf3\xe8\xc9\xd3\xb3\x0f\x9e\x19\x9f\x3d\x3a\xf7\xe8\xfc\xf8\xd0\xd9\x1d\xd6\xd8\xf9\xf4\xf3\xd8\x39\xdd\xdb\xde\x33\x70\xcf\xc0\x8f\x83\x81\x75\xca\xc6\xfe\xd4\x6c\x57\x78\x18\xe8\x3b\x31\x1a\xa3\xb1\xd6\xb8\xe3\x0b\x7c\xad\xaa\xf6\x8b\x7c\xfd\x0e\xb7\xfb\xd0\xf3\xab\x31\xca\x53\x3a\x90\x98\x7e\x32\x42\xb1\x94\x94\x63\x1f\xc4\xd4\xfc\xe7\x8f\xb5\x04\x64\x1a\x46\xab\x9b\xe6\xac\x53\x09\x69\xa5\xf4\x44\x02\x16\x80\xb2\xac\x4f\x57\x28\x6d\x05\x11\x96\x9f\x60\xfc\x57\x4e\x2c\x3e\xa2\x2f\x49\xd8\xaf\x61\x5f\xfd\x4b\x12\xdc\xed\xd8\x97\x92\x5d\xeb\xa2\x67\x7f\x6a\xb1\x3f\xb5\x68\x95\xf4\xa7\x16\xab\x43\xf0\xdf\x38\xb5\xb8\x25\xe6\x76\x65\xab\x5c\x37\x8e\x30\x8e\xeb\x89\x25\xb3\xd5\x23\x3a\xd4\xa8\xa7\xd1\x3d\x8d\x6c\xf6\x0c\x33\xc1\xdf\xe9\xbc\xa4\x4d\x26\xe6\xac\xa4\xd9\xb4\x9e\x0d\x1e\x49\x98\x07\xc5\x57\x2b\x84\x58\x22\xa9\xb0\x50\xe0\x23\x58\x82\x67\xb6\xd4\xf7\xb8\x72\xcf\xb8\xe2\xba\x6b\x78\x65\xbf\xe7\x95\x9e\x57\x50\xcf\x2b\x0f\x87\x57\xac\xa8\xdc\x53\x4b\x65\xb5\xcc\x64\xa5\x9e\x58\x9e\x14\xb1\x24\xec\x33\x99\x25\x6f\xa0\xc7\x94\x2e\x60\xca\xc1\x1a\x4c\x39\xe8\x31\xa5\xc7\x14\xd4\x63\x4a\x97\x31\x65\x43\x28\xee\x41\xa5\x09\x2a\x45\xe2\x79\x42\xa8\xf2\x70\xbe\xcd\x60\x01\xcc\xe7\x62\x2a\xe0\x9f\x04\xa4\x92\xd3\x08\x14\x88\xa9\xc0\x0a\xa6\x31\x88\xec\x84\xed\x63\xda\xe8\xb2\x6d\x8f\xb8\x7b\xd4\xd0\x62\xfb\x46\x97\x7d\x6b\x33\x47\x67\x76\xba\xf4\xbb\x59\xfa\x03\xf4\x76\x59\x9a\xce\x76\x72\xf8\x07\xb2\x07\xb7\xe3\xdb\x5a\x7e\x99\x4c\x2e\xd1\xae\x91\xb5\xdf\xed\xd2\xef\x76\xb9\x77\x3d\x1b\xde\xd0\x91\x0d\xb9\x1d\x20\xa0\x40\xc4\xde\x13\xc2\xa0\xad\xfb\x7d\x3f\x8d\x83\x86\x3d\x07\xf5\x1c\xf4\x90\x38\x68\x07\xb7\xef\x61\xe8\x4b\xc0\xd0\xcf\x7f\x5c\x8e\x7b\x18\xea\x61\xa8\x3b\x7a\xde\x23\x0c\x3d\xcb\x9a\xd5\x6e\xa8\x3d\xac\xf0\x4f\x47\x7a\x21\x44\xb8\x8c\xc6\xa3\xe3\x54\xac\x56\xa9\x9b\xf8\x58\x5c\xa7\x35\x15\x0e\x4a\x27\x73\x94\x89\x68\x8a\xb0\x60\x97\x9f\xa4\xc3\x94\xe6\x9f\x90\xd4\x86\x5f\x99\x4f\x2b\x9d\x23\x81\xa6\x4b\xe8\x2d\x11\x33\x5f\x34\x8b\x30\xc3\x01\xf8\xee\x9a\x95\xb3\xa2\xb8\x95\x70\x76\xf9\x39\x3c\xf3\xe5\x95\x24\xe7\x32\x33\x24\xa6\xa9\x63\x7c\xd7\x0c\xab\xdf\x57\xef\xb4\x91\xae\xf6\xca\x20\x84\x09\x9f\x35\x71\xf6\x23\x7b\x76\xd8\x20\xcc\xa3\x89\x0f\x67\x86\xb6\x6a\x1e\x9a\x8f\x50\xe7\x6d\xa1\x0a\x1a\xa7\xaa\xd8\x9a\x44\x09\x55\xa4\x65\xc0\x14\x9f\xb2\xe4\x89\xc0\xbe\xa9\x4c\xfc\x65\x48\x45\xc8\xf9\x27\x01\xb1\xaa\xf7\x54\x2e\xbc\x8b\x7d\xec\x7c\x50\x9a\xfe\x79\xa1\xcb\xf3\xb7\x58\x10\x0d\x65\xcf\x7f\x37\xad\xb7\xf6\x60\x39\x94\xdd\x8a\x34\x80\x65\x2d\xfd\x38\xf2\x9a\xc4\x6f\x04\xbd\x5a\x31\xaf\xed\x94\x55\x33\x5e\x2b\x1c\x98\xf1\x2a\x7f\xcf\xdf\xce\xa9\x96\x36\x6c\xa3\x65\xed\x95\xb3\x80\x9e\xda\xc9\x2a\x48\x24\x4c\xd2\x86\xec\x13\x00\xc5\x1b\x7e\x8e\xf7\x10\xf9\x9a\xb3\x26\xd1\x56\xfd\xaa\x66\x06\x7b\x35\xda\xfe\x24\x14\x55\x9c\xea\x5e\x9c\x69\xa7\x8d\xa2\x3b\x80\x8c\x21\xa0\x7b\xf7\xc3\xc6\x07\x4c\xb9\x23\x5e\xe5\xdc\x75\x17\xff\x93\x6d\x37\x7d\xbe\xff\xdd\x9b\x49\xd7\xb8\x6e\xf1\x1a\x5f\xc5\x75\xdd\xaf\xe6\xba\x6d\x93\x9f\xde\x73\xef\x3a\xcc\x5a\x4e\x2a\xde\x96\x3f\xb7\xf7\xd5\x3c\xba\xce\xd5\x5b\x5c\x39\xd7\xb7\x93\x9e\xbc\xbb\x89\xd7\x78\x78\x5e\xf5\x29\xe7\x66\xf3\x7f\xf9\x13\xc4\x66\x82\x55\xd0\x6f\xb1\x41\x82\x7f\x78\x7e\x94\x4f\xdd\xf2\x3d\x10\xfc\x83\x53\xb9\x2d\x26\xde\xb5\x59\xb2\xc8\x6e\xce\x0c\x36\xcd\xe7\xd8\xf6\xfc\xca\x39\xb4\x16\x0b\xdd\xa1\x75\xb1\x6f\x5f\xb8\x51\xf9\xf7\xa1\xf5\xb7\x6b\x5f\xec\x0f\xed\x12\x6b\x82\x39\xb2\xfe\x76\xb3\x1f\x5d\x7e\x9f\xbf\x03\x89\x60\x6a\x8d\xe4\xad\x4f\xb1\x1b\x3e\xb2\x1b\xb6\x9f\x32\x3a\xb0\x2f\xac\xc9\xf6\xb1\x6f\xeb\x9b\xeb\x52\x31\xdf\x4d\x1a\x24\xb3\x39\x8c\xf5\xe5\x22\xe9\x8f\x40\x97\xbf\xf9\x8c\xce\x8b\xdf\x91\x36\x55\x13\xb3\x2c\xe8\x4c\xae\xbd\xe5\xec\x27\xf5\xe7\x4d\x2a\x5d\x14\xd3\x1f\xf7\xd9\xed\x7f\x03\x00\x00\xff\xff\xd1\x24\xb4\xe3\x73\x7b\x00\x00")
-
-func manifestsDashboardsHelidonHelidon_dashboardJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_manifestsDashboardsHelidonHelidon_dashboardJson,
-		"manifests/dashboards/helidon/helidon_dashboard.json",
-	)
-}
-
-func manifestsDashboardsHelidonHelidon_dashboardJson() (*asset, error) {
-	bytes, err := manifestsDashboardsHelidonHelidon_dashboardJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "manifests/dashboards/helidon/helidon_dashboard.json", size: 31603, mode: os.FileMode(420), modTime: time.Unix(1647457998, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _manifestsDashboardsSystemOpensearch_dashboardJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x5d\x5d\x53\xe3\x38\xd6\xbe\xef\x5f\xa1\x57\xef\xec\x16\x4c\xd1\x33\x24\x10\x1a\xa8\xea\x8b\x1e\xd8\xee\xed\xda\xf9\x60\xbb\xe9\xdd\xda\x6d\x28\x97\x62\x9f\x38\x6a\x64\xc9\x2b\xc9\x81\x40\xb1\xbf\x7d\x4b\xb2\x93\xf8\x2b\x10\x02\x1d\x9b\xa0\xb9\x98\x19\x4b\x46\x3e\xe7\x48\x7a\x9e\x27\x3a\xb2\x7c\xf3\x0a\x21\x4c\x38\x17\x9a\x68\x2a\xb8\xc2\x87\xc8\x14\x21\x84\x19\x55\x1a\x1f\xa2\xaf\xf6\x0a\x65\xa5\xb6\xa6\x9f\x50\xa6\x3f\x72\x7c\x88\x3a\x5b\xb3\xd2\x80\x68\xa2\x44\x22\x7d\xc0\x87\x08\xbf\x7e\x8d\x3e\x48\x32\x20\x9c\xa0\xd7\xaf\x71\xee\x36\xe0\xa4\xcf\xcc\x2d\x5a\x26\x90\x2b\x1f\xd2\xa0\xa6\x94\xfa\x82\x1f\x09\x26\xa4\x69\x53\x86\x7d\xb2\xb1\xbd\x85\xba\x9d\xce\x16\xea\xf6\x7a\x5b\xa8\xb3\x99\x6f\x9a\x93\xc8\x3e\xfb\xdd\xcc\x1d\xf4\x67\xf4\x8e\x81\xd4\x2a\x7f\x9f\x1e\xc7\xf6\xbe\x80\xa8\x61\x5f\x10\x19\xe0\xac\xee\xd6\xfe\xf7\xfc\x15\x42\xb7\xe6\x76\x0c\x01\xd5\x25\x6b\x71\xc8\x41\x7f\x0c\xf0\x21\xe2\x09\x63\x69\x89\x24\xf1\xf0\x54\x08\xa6\x69\x8c\x0f\xd1\xb6\x2d\x64\x94\x5f\x98\x60\x7e\x3d\xb7\x97\x31\xe1\xc0\xd4\x34\x9c\x93\x60\x62\x5f\x30\x46\x62\x05\xa6\xc1\x01\x61\x6a\xea\x7b\x31\x9c\x93\x67\xd9\x9a\x50\xd2\xe0\x44\xcc\x7a\x2a\x0d\x5f\xa9\x37\x2e\xf1\x21\xea\xee\xe6\x0a\xae\x26\xa6\x65\xd7\x63\x73\x3d\xf1\x7b\xda\x36\x35\x86\xec\x4f\x2f\x67\x66\x9f\x4f\xcb\x34\xd5\x36\x22\xf8\x88\x25\x4a\x83\x44\xc7\xa0\x09\x65\xb3\x08\x4f\xe3\x2b\xc5\x65\x1a\xd9\xac\xfd\x9b\xfb\x7d\x1b\x50\x60\xc1\x91\xe0\x03\x1a\x16\xfd\x0b\x60\x40\x12\xa6\x8b\x5e\xa7\x01\xb4\x83\x23\x5f\x88\x10\x8e\x84\x1d\x4d\x58\x0f\x25\xa8\xa1\x60\x81\xc2\xb9\x1b\x6e\xb7\xf2\x4d\x44\x24\x8e\x29\x0f\x55\x6e\xb0\xa7\xff\x14\xdb\x34\xd6\x49\x11\x99\x56\xf1\x56\xb9\xc6\xc6\xad\x53\x29\xd6\x70\x65\xe6\x10\xfe\x20\x01\x78\xf5\xaf\xb4\xa8\x6f\x2d\x0b\x60\xb5\xbd\x11\x61\x89\xf5\x6b\x1b\x17\xaa\x6e\xb7\x1e\x65\x78\x77\xae\xe1\xff\x02\xc6\xc4\xe5\x53\x5a\xde\x79\x52\xcb\x77\xe6\x5a\xfe\x09\x82\xa7\x34\xbb\x5b\x32\x3b\x77\x75\x5e\x18\x4d\xb9\x11\x37\x77\x54\x92\xbe\x12\x2c\xd1\x50\x32\x04\x2b\x0d\x71\x75\x18\x56\xa3\x92\x1b\xf7\x38\xac\x1d\x5a\x39\xd3\xcd\xfc\x2a\xd5\xde\x96\x6f\xbf\xeb\x01\xb2\x26\x90\xb9\xe6\xf7\xb7\xcb\x8d\x17\xae\xcf\x5f\xd5\xd5\xe4\x0c\xc0\x62\x04\x52\xd2\x00\x52\x98\xa9\x20\xd2\x5c\xb4\xdb\x2d\xa1\xdd\xde\x3d\x60\xd7\xa9\x07\xbb\xe9\xd8\xc7\x22\x2e\x32\xe0\x2c\x0c\xbf\x65\xfd\xd6\x27\xfe\x45\x28\x45\xc2\xf3\x11\x49\xf1\x7f\x72\x0b\x17\x3c\xdf\xad\xf8\x5b\xa2\x34\x1d\x8c\x27\xd5\x24\xd1\x22\x5f\x2d\x24\x05\x9e\x72\x55\x5d\xb5\x84\x20\xf1\xe1\x8f\x1a\xc3\x8c\x69\x84\xf9\xd5\xd1\x82\x19\x51\xfa\x77\xa1\x7f\x4f\x18\xc3\x73\x47\xa9\xc5\x59\x55\x99\x0c\x69\xaf\xaa\x09\x19\xd5\xf6\x57\x36\xc1\x6e\xca\x65\x05\x17\x2b\xb1\x8e\x59\x12\x52\xfe\x0f\x90\x2a\x73\xf5\xcd\x4f\xbd\x9f\x3a\x9d\x1c\x69\x10\x19\x82\x2e\xfa\x53\x70\x17\xae\x20\x8a\x19\x91\x15\x91\x60\xeb\x62\x3b\x56\x55\x12\x6d\x80\xf2\xfc\x94\x99\x3c\xa5\x89\x4e\xd4\x4d\x76\xf9\xf6\x0c\xab\xb1\xd2\x10\x9d\xe1\xdb\xcd\x9f\x7d\x91\x70\xbd\xe0\xcd\xc5\x18\x51\xae\x41\x8e\x08\xab\x46\x8f\x41\x08\x3c\x78\x2f\x64\x44\x74\xb5\x56\xc2\xc0\x0a\x07\xfc\x6e\xd6\x2f\x93\x29\x71\x07\xbd\x7e\xd6\x24\x87\x14\x53\x72\x35\xe6\x3e\x37\x76\x7d\xd9\x48\x59\x01\xca\x87\xb4\xfd\xff\x7f\x79\xf7\xcb\xfe\xce\xc1\x5d\xed\x77\x1e\xd3\xfe\xbd\xc1\xd9\x69\x09\xcc\x97\x35\xed\xde\x62\x30\xdf\x7b\x00\xcc\xa7\x2e\x2f\x89\xf0\x3e\x18\x78\x70\x18\xbf\x2a\x8c\xe7\x22\x00\xe5\xf1\x24\xea\x83\xbc\x31\x63\x8c\x5c\x5f\x13\x2e\xbc\x19\x8e\x33\xe1\x13\x56\x8f\xf9\x0f\xf8\xe3\xd5\x72\x40\x04\xef\x53\xe1\x5b\xc0\x6f\x53\xfe\x79\x48\x07\xba\x5a\x91\x91\xc6\xef\xc6\x21\x47\x16\x8e\x2c\x1c\x59\xd4\x91\x45\x67\xc1\x1f\x05\x7b\x8e\x2d\x26\xe5\xeb\xc5\x16\x06\xee\x1e\xc5\x18\x0f\x6c\xe0\x79\xb0\xc6\x31\xd1\x04\x39\xea\x68\x92\x3a\x1a\x02\xc8\x5e\x19\x20\x77\x17\x03\xc8\x03\x07\x90\x93\xf2\x67\x0e\x90\x53\x84\xa3\x3c\x80\x2b\x4f\x42\xcc\xa8\x4f\xd6\x0a\xde\x3e\x1a\xcf\xd0\x91\x71\xd4\xe1\xdb\x33\x90\xc6\xf7\x3e\xa0\xd5\xe2\xf8\x1e\x61\xdf\x6b\x2b\xf2\x1f\x2c\x86\xfc\x33\x09\xed\xa0\xff\x99\x43\x7f\x49\x1b\xc7\xc0\x03\xca\x43\x4f\x13\x75\xb1\xb4\x3e\x5e\xa2\x91\xe7\x41\x22\x27\xa9\x63\xe8\xd4\x38\xf6\x10\x1a\x69\x36\xc5\xdf\x9b\x33\x8b\x67\x69\xaf\x3b\x73\xfc\x9f\x87\x44\x06\x6b\x95\xe1\x7f\x86\xdc\xb9\x22\x86\xe8\xdd\xc3\x10\xe5\xa1\xb5\x37\x67\x68\xbd\x59\x15\x41\xb8\x64\xea\x4a\x93\xa9\x06\x0a\xa6\x90\x5e\x4d\x93\x6e\x21\x83\x0a\x6f\xcf\x30\xf1\x35\x1d\x41\x63\x79\xd3\x77\xf6\xf1\xc8\x22\x97\x5b\xcf\x78\x0e\x7a\xbf\xad\x3b\x4c\xee\x03\xc4\xde\x82\x80\xb8\xb2\xd5\x64\x07\x88\x2d\x06\x44\x2f\x96\x34\x22\x72\xdc\x34\x30\x9e\xa4\x66\x38\x80\x7c\x3e\x00\xb9\xc0\x92\x45\x25\xe3\xf6\x84\x00\xdc\x69\x2b\x00\x57\xd2\x79\xf3\x10\x78\xdf\x21\x70\x5a\xfe\x42\x11\x58\x02\x13\x3e\xd1\x94\x87\x8d\xa1\xef\xa7\xa9\x09\x0e\x79\xd7\x0a\x79\xbf\xe7\x62\x71\x7b\x91\x77\x51\xed\xbb\xb2\x44\xa1\x43\xde\x56\x22\x2f\xe5\x54\x53\xc2\xe8\x75\x93\xd8\xfb\x31\x67\x84\x43\x5f\x87\xbe\x2d\x4f\xd5\x95\xc1\xb7\xbc\xc6\xdf\x5d\x50\xf6\x76\x57\x96\xaa\x73\xe0\xdb\x4a\xf0\x4d\x38\x51\x8a\x86\x1c\x82\xc6\xa0\xf7\xcb\xd4\x84\x25\x80\xb7\xd9\xd4\x59\x67\x5e\x06\x7c\x77\xd6\xfd\x77\xe5\xce\x8e\x85\x9f\x44\xc0\xf5\x43\xf2\x66\x84\x51\xa2\xec\x0b\xcf\xaa\x30\x18\x71\x9f\x48\x55\x13\x05\x35\xfc\x15\x78\xa8\x87\xc5\xc4\xbc\x29\x87\xba\xdb\x1f\xc5\x63\x0f\x80\xb6\x01\x65\x2c\x1f\x72\x5b\xf0\x41\x92\xc0\xc0\x42\x3e\xd4\xf3\xbb\x6b\xbf\xd4\x5d\x9d\xee\x7d\xdd\xd5\xad\x98\x31\xa4\x41\x00\xfc\x33\x48\x5a\x13\x8d\x14\x22\x67\xef\x85\xa4\xc3\xbe\x68\x08\x19\x85\xe5\xbf\x33\xc3\x32\x91\x32\xf5\xa3\x5c\x13\x91\xab\xba\x52\xca\x6b\x4a\xd5\x50\x5c\x56\x5f\x78\xd7\x42\xdb\x39\x59\xbe\xbb\x16\xc1\x66\x9e\x32\xca\x6d\x6d\xbe\x35\x5b\x78\x49\x03\x5d\x18\xfd\xd8\x74\xfc\x89\xa0\x7c\x8a\x6c\xa6\x00\xdf\xcd\x14\x84\x81\xd4\xa7\x13\x79\x91\x3d\xa7\x0a\x86\x20\x7d\x03\xfb\x21\x54\x82\x7d\x2f\x4e\xc6\xc6\x22\x33\x40\x12\x55\x78\x29\xd3\x96\x57\x3b\x4f\x02\x0f\x40\x82\x45\xc7\x01\x13\xb9\x7d\x5d\xca\xf6\xf6\x1f\x85\xf1\x39\xab\x8c\x89\x0f\x75\x33\x46\x69\xe2\x5f\x54\x9e\x62\x44\x51\x0c\xc1\xaf\x94\x57\x3d\x5a\xc5\xe6\xbb\xc6\xdf\x45\xcc\x0b\xca\xaf\x8b\xed\xa2\xf8\x04\x61\x36\x7e\x4a\x7f\xb0\xcc\x1e\xbd\xe9\xa1\x0a\x37\xf9\x79\x43\x24\x04\xd5\x99\xa3\x84\xd4\x25\x58\xb0\x93\xc6\x9b\x80\x2e\xe5\x01\x1d\xd1\x20\x21\xac\xca\xe3\x93\x7b\xac\xe8\x99\x19\x70\x45\xae\x68\x69\x2a\xf4\x13\xff\x22\xed\xf7\xbc\x23\x79\x0d\x4f\xa3\x82\x54\xca\x4e\xa5\x28\xdd\x5d\x3f\xfb\xa7\xb3\xbc\x06\x53\xc7\xe4\x0a\xee\x18\x6e\x83\x69\x4f\xab\xa1\x89\x44\x71\x30\x90\x3e\xb0\x8a\x11\xa6\x42\x84\xbf\x10\x05\xe5\x77\xdf\x33\x20\xab\xdc\x9e\x22\x59\xa5\x38\xe7\x4c\x9d\xb6\x6a\xb7\x9d\x95\x51\x3f\xae\x76\x3a\x61\x34\xac\xc3\x70\x5b\xfe\x2b\x8c\xa6\x46\xbf\xca\x37\xea\xc8\x7d\x69\x72\x2f\x14\x2c\xcf\xee\x7b\x8e\xdd\x1d\xbb\x37\xc6\xee\x29\xaf\x53\x1f\x94\xa7\xb4\x90\xe0\x29\x7a\x0d\x5e\x7f\xac\xa1\xf6\x98\x81\xb5\x66\xf6\xcf\xf4\x1a\x1c\xb1\x2f\x43\xec\x3f\xfc\x30\x24\x6a\xf8\x37\x30\x28\x88\x45\xff\x1b\xf8\xfa\x70\x67\x7b\xbf\xd8\xeb\x33\x56\xb5\xc3\xab\x79\x56\x9d\xc3\xfe\xf5\xce\x1c\xcc\x73\xc6\x49\x04\x27\x11\x16\xfd\xfd\xdf\xad\x9e\x66\xb6\x80\x42\xe8\x3a\x85\xe0\x14\x42\x63\x0a\x21\x5b\xd8\x9d\xa8\x84\x08\x64\x08\xca\xb3\x63\xc0\x0b\x84\xaf\x3c\xbb\x40\xf0\x92\xd6\x01\xea\x16\x4d\x9d\x58\x70\xab\x00\xdf\xdb\x4e\x47\xf1\x2d\xa4\xf8\xca\x2a\xc0\x72\x1c\xbf\xeb\x38\xde\x71\x7c\x63\x1c\x2f\x89\x86\x3c\xc9\xdb\xa5\x7e\xca\xc3\x6c\xcd\x7f\x2e\xc3\x7f\xed\x0c\xcf\xd7\x9e\xe5\x51\xba\x3a\xf0\xa9\x78\xc4\xa1\x23\x7c\x47\xf8\xdf\xdb\x4e\x47\xf8\x2d\x24\xfc\xca\x6f\xfa\xfd\x65\xf8\x7e\xc7\xf1\xbd\xe3\xfb\x26\x57\xfd\x63\x29\x7c\x50\xca\x1b\x50\x06\x5e\x00\xca\x97\x34\xd6\x42\x2a\x2f\x22\x57\x77\xe4\xf6\xd7\x94\xec\xdf\x53\x06\xe8\x78\x16\x05\x47\xf4\x4f\x96\x06\xd8\xdf\x69\xf9\xca\xf9\x1c\x35\x50\xef\xcc\x6e\xcb\x9d\x71\x92\xa1\x85\x92\xa1\xba\x46\xb0\x94\x66\x78\xe3\x34\x83\xd3\x0c\x2d\xc8\x03\xc0\xd5\xfd\xdb\xbb\x2b\xe7\x6c\xa0\xfe\x18\x6d\xd8\x3f\x5e\xd7\x05\x83\x74\xef\x38\x3a\x01\x99\xae\x18\x38\x0d\xe1\x34\x84\xd3\x10\x4e\x43\x7c\x9f\x65\x87\x9d\xea\x0b\x55\x0b\x48\x88\x7d\x27\x21\x9c\x84\x68\x4c\x42\x44\xe4\x6a\x63\xa3\x83\x5e\x23\x23\x24\x06\xca\x8b\x89\x1e\x7a\x64\x44\x28\x23\x7d\x96\xed\x3a\x44\x3f\xe7\xea\xd2\x5d\x06\xb6\x7c\x73\xf3\x47\xd4\xd9\xde\x5e\x5a\x3c\x64\xa3\xd8\x0b\xa8\xba\xf0\x2e\x89\x06\x19\x11\x79\xb1\x80\xa4\x98\x03\xed\x8b\xfa\x9b\x7b\x27\x4e\x8a\x44\x53\x1e\x7a\x84\xa5\xc7\x2c\x08\x5e\xb2\xc6\x63\xe2\xd2\x8b\xfd\x45\x36\x53\x64\x9f\xa2\x2c\xcd\x9b\x07\x45\xa4\xfe\xd9\x73\x22\xf2\x4b\x43\x11\x19\xd2\x70\xd8\x58\x48\x26\x0f\x9f\x13\x93\xa3\x86\x62\x32\x60\x42\x04\x9e\x32\xc8\xd3\x58\x68\x4a\x36\xcc\x89\xd0\x71\x4b\xa4\xf9\x31\x55\x17\xe8\x9f\x13\xe3\xd1\x9f\x9c\x34\x5f\x81\x34\xcf\xf8\xb1\x79\x3d\xeb\xc4\x79\xbe\xdc\x89\xf3\x15\x2c\xf0\x2d\xa7\xce\x0f\x9c\x3a\x77\xea\xbc\x35\x9b\x80\x14\x10\xe9\x0f\xbd\x01\x68\x7f\xf8\x32\xb7\x00\xfd\x3d\x01\x39\x76\x1b\x7f\x56\x25\x18\x24\xfc\x27\x7e\x66\xaf\x05\x39\xb9\xd0\x92\x83\x50\x76\x77\x2b\x83\x33\xfd\xf8\xfa\x8c\x6b\xee\xfe\x88\x80\xc5\xb3\x07\x9c\x82\xe2\x0e\xdc\x2a\xdc\xfa\xbd\xbe\x1e\x50\xf0\x25\xe1\x34\xfd\x2d\x0a\x7e\xfa\x0a\x61\xdd\xa4\x5d\xd9\xc7\xda\x77\xe7\x7c\xb7\x62\x67\xe7\x6e\xfd\xe2\x8e\xb4\xca\x4b\xcc\x96\x1f\x69\xc5\x45\x00\x5e\x04\x91\x90\x63\xef\x37\x88\x4e\x67\x0b\x92\x37\x94\x2b\x4d\xb8\x0f\x6f\xff\x7b\x86\x7f\xfa\xf1\xf0\xa0\xb3\xbd\x7d\x86\xb7\xd0\x3a\x7c\x48\xc6\xba\x89\x7e\xb3\x6e\x3f\xe4\x30\x2c\x07\x8a\x85\x5b\x5f\x00\x28\x96\x3f\x6d\x3e\x17\x14\x77\x1d\x28\xa6\xe5\xeb\x07\x8a\xef\x25\xc0\xcb\xc2\x44\x64\x5c\x76\xc0\xe8\x80\x31\xbd\xae\x03\xc6\xca\x92\xe0\x5c\x64\xec\x39\x64\x4c\xcb\xd7\x0f\x19\xdf\x15\x73\xdb\x2f\x06\x1e\xa7\x7e\x3b\x8c\x74\x18\x99\x5e\xd7\x62\xe4\xfe\xa2\x18\xe9\x3e\x4f\x95\x95\xaf\x0b\x46\x0e\x28\x83\x34\x87\xe0\x0d\x1e\x25\x1f\xb7\x50\x24\x12\xae\x6d\x6a\xe6\xed\x19\xfe\x79\x44\xe4\x73\x03\x4d\xbb\x3b\x61\x29\xc8\x74\x29\x5a\xb4\xec\xfe\xc9\xdd\x83\x8a\x19\xf7\x67\x68\x77\xdc\xfe\x49\x97\xa1\x6d\x2e\x43\x4b\x46\xe1\x06\xb5\x69\x5a\x8b\xa1\x7e\x9c\x78\x0a\x7c\xc1\x83\xec\x3c\xa6\xa5\xf4\xe5\xd7\x5e\x74\xbe\xb9\x89\xfa\xe3\x0d\x23\x5e\x36\x91\xdd\x66\xd9\x96\x84\xee\x9c\xc4\xed\xe4\x6b\x81\xa3\x10\x1d\x9d\x7c\x41\x5f\x94\x19\x44\xb3\x7a\x97\xa2\x7d\x54\x8a\xb6\xdb\x6b\xfb\x61\x87\x5b\x0f\x70\x66\x6f\xbb\xe5\xce\x54\x26\xc4\x8b\xdc\xd1\x95\x0f\xd3\x93\xfc\x2a\x69\x64\xdf\xd7\x72\xaa\xc2\xed\xfb\x72\xaa\xa2\x51\x55\xe1\x99\xb9\xe4\x19\x0a\xda\x00\xe5\x7d\x1b\x45\x5e\x04\x91\x37\x04\x12\x7b\x89\x82\x60\xce\xaf\xb3\xee\x22\xea\xa2\x63\xe4\x45\x5b\xe4\xc4\x13\xef\x0f\x33\xfa\xe3\xaf\x40\x62\x27\x40\x56\x26\x40\xa6\x94\xd0\x38\x6d\x3b\x0d\x92\x2f\x5f\x5b\x0d\x92\x76\x50\x5b\x05\x48\x79\x55\xa3\xf7\x66\x09\xfd\xb1\xdb\x71\xfa\xc3\xe9\x8f\xa6\x0f\x96\x30\xbc\x4d\x82\x58\x08\x96\xfd\xef\xf4\x78\x89\xbb\x97\x7f\x0d\x4f\xfd\xdf\xdb\x33\x1c\x11\x4e\x42\x88\x80\xeb\xdc\xd7\x05\x4b\xe7\x4f\x98\x7b\xd7\x55\x8c\x9c\xda\xa0\xa1\x13\x21\x98\x3b\xbe\xca\xad\x85\x38\x1d\xe2\x74\xc8\xe4\x91\x0d\x2c\x84\x2c\x27\x44\xb6\x9d\x10\x71\x42\xa4\x31\x21\x42\x27\x6f\xc0\x7d\x1b\x45\x5e\xe8\x7b\xbe\x60\x0c\x7c\xfb\x06\xbe\x61\xa4\x49\xae\xe5\x4e\x3d\x92\xa6\x53\xd6\x54\x62\x7c\x20\xb2\x4f\x42\x40\x47\xd3\xc0\xa0\xd3\x3c\x55\x3b\xb5\xe1\xd4\x86\x53\x1b\x93\x7f\xdb\x66\xcd\x24\x36\xf3\x33\xdf\x02\x56\xfe\x10\x22\x32\xc3\xdf\xf4\x64\x47\xac\xf4\x38\x9d\x67\xc1\xe4\x64\x1a\xac\x49\x6e\x07\x1a\xd6\x16\xc3\x34\xe5\x33\xed\x80\x19\x55\x7a\x32\xfe\x6e\xd3\xbb\xa8\x1d\xbf\x59\xfd\x20\x85\x00\xcc\xc5\xe5\xeb\xbd\xc9\x4c\xc1\x5a\x64\x65\xb8\xf0\x67\x31\xf5\x2f\x2c\x8c\xdf\xcc\xca\xae\x85\xc5\x5b\x9c\x19\x34\xc1\x82\x3f\x62\xc3\xe5\x44\xfa\x43\xf4\x39\x89\x22\x22\xc7\xe8\x98\xa8\x61\x5f\x10\x19\xa4\xb7\x26\x96\xd3\x71\xfc\xf1\xdf\xd4\x3f\x65\x6f\xae\xd3\xd2\xd1\xd4\xeb\xce\xab\xdb\xff\x05\x00\x00\xff\xff\xae\x2a\x34\x19\xda\xb9\x00\x00")
-
-func manifestsDashboardsSystemOpensearch_dashboardJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_manifestsDashboardsSystemOpensearch_dashboardJson,
-		"manifests/dashboards/system/opensearch_dashboard.json",
-	)
-}
-
-func manifestsDashboardsSystemOpensearch_dashboardJson() (*asset, error) {
-	bytes, err := manifestsDashboardsSystemOpensearch_dashboardJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "manifests/dashboards/system/opensearch_dashboard.json", size: 47578, mode: os.FileMode(420), modTime: time.Unix(1647457998, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _manifestsDashboardsSystemSystem_dashboardJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\xbd\xfb\x76\x1b\xc7\xd1\x2f\xfa\xbf\x9e\xa2\x3e\xc4\xdc\x26\x13\xdc\x49\x88\xa4\xbe\xd8\x7b\x49\xa2\x1c\xeb\x7c\x92\xa5\x63\x51\xce\x49\x22\x6f\xac\xc6\x4c\x01\xe8\x70\xa6\x7b\xd2\xdd\x43\x10\x56\xf4\xad\xbc\xc3\xfe\x6b\xaf\x75\xce\xcb\xe5\x49\xce\xea\xea\x99\xc1\x60\x30\x00\xc1\x3b\x40\x8d\x57\x62\x13\xd3\xf7\xea\x5b\xfd\xaa\xaa\xab\x3e\x3f\x01\xa8\xf5\xfb\x5c\x44\xb1\xd1\xb5\x67\xf0\xb7\x5f\xeb\xee\x8b\xc2\x7f\xc4\x5c\x21\x7d\x7b\x02\x00\xf0\x99\xfe\x0d\x50\x33\xd3\x08\x6b\xcf\xa0\x36\x52\x6c\xc8\x04\xab\xd5\xd3\x04\xee\x97\x7e\x16\x2c\xa4\xfc\x7f\x2a\x26\x9c\xa3\xd2\x5c\x0a\x9b\xd6\x6b\xee\x37\xbb\x35\x4a\xf8\x52\x2f\x6f\x2e\x62\x02\x83\x92\xc6\xa2\x71\x69\x53\xf9\xcf\x73\x0d\xb5\x9b\xed\x6b\x34\x64\xd8\x20\xc0\xc5\x86\x4e\xe7\x3f\x2f\x69\xe8\x09\x80\x23\x2b\x13\x42\x1a\x66\xb8\x14\x96\xae\xae\xe5\x5a\xc0\xb5\xc9\xa8\x3c\xeb\x0f\x40\x6d\x10\xf3\xc0\xbc\xb6\xd5\x75\xea\xb3\xaf\x3e\x33\x4c\xcb\x58\x79\xd4\x83\x46\x03\x12\xc2\x42\xa3\x51\xcb\x65\x43\x41\x7d\x7b\x06\x43\x16\x68\xcc\x27\x5c\x44\xca\x96\xcc\x67\x1e\x73\xdf\x66\x35\x2a\xce\xe7\xe4\x9e\x14\x2f\x65\x20\x29\xbb\x1a\x0d\xd8\x6e\xbb\x0e\xdd\x4e\xa7\x0e\xdd\x5e\xaf\x0e\x9d\xbd\x7c\x15\x01\x0f\xb9\x1d\x47\xa7\x9d\xfb\x98\xd2\x29\x9f\x51\x8f\xe5\x84\x06\x95\xcf\x68\xd8\x68\xb6\xfc\x0a\x13\x42\x69\xb9\xef\xb1\xc6\x5f\x58\x10\xe3\x0f\x52\x9d\xf2\x30\x1b\x62\x92\xe1\x0b\xfd\xf7\xd7\x27\xc9\x04\xd7\x7c\xd4\x9e\xe2\x91\x49\xe6\xe5\x64\x2a\x58\xc8\x3d\x38\x61\x7a\x3c\x90\x4c\xf9\xe0\xc9\x73\x54\x5c\x8c\x20\x44\xa3\xb8\xa7\x61\x28\xd5\x33\xe8\xc0\x2f\x6f\xeb\x20\xe0\x97\xb7\x1a\xa4\x82\xe7\x41\x60\xff\x6c\x7e\x12\xd4\x93\x1a\xfa\xdc\x24\xf4\x4d\x89\x56\x1b\x09\x34\xaf\xed\x6a\x11\x71\x10\xb8\x2f\x76\x1d\x9e\x4a\x19\x18\x1e\xa5\x03\x76\x0b\x2a\xcb\xc2\x0d\x2a\x96\x74\xae\xd3\x3b\x38\xde\x3f\xee\xec\x77\x9f\x1e\x1f\x1c\x50\x6a\xc0\xc5\x59\xc9\x16\xb4\x13\x63\x07\x83\x17\x06\x95\x60\x01\xd8\x7c\xb3\x65\xb8\x40\xcc\x9a\xe1\x86\xfa\x5a\xfb\x49\xfa\x08\xaf\x2e\x22\xa9\x0c\xaa\x5c\x89\x84\xd4\xf3\xf5\xc4\x2a\xb0\x1f\xc7\xc6\x44\xfa\x59\xab\x35\xe2\x66\x1c\x0f\x9a\x9e\x0c\x5b\x91\x92\x21\x9a\x31\xc6\xba\x25\xa4\x8f\x7d\x4c\x6b\x2c\x2c\x78\xda\x4b\x25\x03\xf0\x64\x10\xb0\x48\xa3\x5f\x58\x74\xb5\x91\xe2\xfe\x7b\x39\xdb\x1d\x6e\x75\x16\x76\xc0\xa4\xf6\x0c\xba\x07\xb9\x0f\x17\x85\xd5\x34\xb5\xbf\xd3\xf5\x30\xbf\x93\x9f\x76\xb3\xdf\x85\xce\xcd\x6f\x3e\xd7\xc9\x38\x14\xc5\x65\xb9\xb0\x03\xbf\xc9\xfd\x9c\xcb\x36\x94\xc2\x7c\xe0\xbf\x51\xa6\x4e\xbb\xbd\x33\x9f\x5a\x36\xd2\x6c\xb4\x4f\xeb\xf3\xdf\x8a\x23\x2e\x1b\x75\x36\xf2\x4e\xee\xd3\x97\xb9\x36\x89\x02\x47\x9d\xb9\x6f\xd9\x12\x9b\x1f\x63\xc4\x46\x98\x74\xfe\x60\xae\x91\x9a\xf6\x94\x0c\x82\xc5\x83\x25\xd9\xda\x3f\x22\xf3\x51\x2d\x1c\x26\x36\x55\x2a\xb3\x38\x5c\x4f\x06\xf3\xd3\xeb\x48\x8c\xda\x2b\x6c\xec\xc5\xe1\x68\x33\x0d\x70\x7e\x02\xa1\x30\x89\x94\x8f\x05\x9c\x69\x3a\xae\xed\x71\x51\x2f\x26\xfb\xcc\xd8\xb3\x24\x64\xb6\x77\xb5\xbf\xfc\xe5\x2f\x7f\x69\xbc\x7d\xdb\x38\x39\x81\x1f\x7f\x7c\x16\x86\xcf\xb4\x5e\x2c\x12\x31\x63\x77\xde\xf2\x3a\xd3\x0d\x65\xeb\xae\xcd\x25\x7e\xa9\xaf\xd9\xd9\xc5\x4a\x3d\x7b\x16\xbf\x95\x74\x54\xa7\x07\xc8\x62\x86\x45\x82\x50\x1a\x9d\xdf\xdd\x83\x5e\x1d\x7a\x07\xee\xff\xed\xe6\xf1\xde\x42\x23\x59\xce\xfd\xc3\x3a\x74\xba\xc7\x75\x3b\xfd\xd0\x6e\x1e\xad\xc8\xdb\x6b\xd7\xa1\x73\xd8\xad\x83\xad\xbd\xdd\x3c\x3e\xdc\xab\x15\x72\xfe\xba\x48\x74\xf4\x78\xc8\x68\xfb\x75\x57\x91\xb7\xd5\xfc\x7d\xab\x84\xbc\x63\x85\x7a\x2c\x03\x7f\x71\xe5\x42\x9e\xfc\x22\x0e\x07\xb9\x73\x2e\x4b\x8f\x05\xdd\x55\x76\xc1\x2a\x53\x98\x9f\x27\x4b\xba\x5d\x33\x4c\x8d\xd0\xac\xb1\xde\xd2\x1b\xd6\x93\xb1\x30\xbb\x73\x07\x64\xdf\xde\xe9\x7e\x9f\x8b\xa1\xfc\x4c\x33\xfd\xdd\x7f\x7f\xaa\x7d\x33\x96\xda\x7c\xaa\x7d\xd9\x83\xc1\x74\x97\xbe\xd6\x51\x9c\xd7\xb9\xd0\x86\x09\x0f\xeb\x09\x5b\xb1\x38\x01\xb5\x61\xb6\x6c\xe7\x19\x94\x2c\x83\xab\xc3\x94\x6c\xc7\x24\xd9\xa0\x3a\x67\x74\xcc\xef\xb7\x4b\x16\x7a\x9a\xe1\x07\xe6\x19\xe2\x03\x3a\x0b\x59\x02\x1c\xa1\xf0\x67\x1b\x68\xb1\x12\x85\x43\xba\x17\x6b\xcf\xd7\x26\xf5\xea\xfb\xca\xe5\x51\x4c\x68\x4b\x80\xf2\xe1\xe7\xd8\x07\x9b\x94\xa5\xe4\xf6\xdf\xdc\x69\x4f\x74\x7f\x99\x6e\xa0\xc2\x21\xf5\x46\x32\x1f\x3a\xd4\xd2\xef\x3a\x3f\x1c\x1e\xbd\xec\x14\x46\xe9\x72\xf4\xe6\x72\x2c\x3d\xb7\x06\x8c\xda\x58\x3c\x3f\x7d\xa6\xc7\x6f\x50\x8c\xcc\xb8\xc0\x48\x25\x69\xb8\xac\xd8\x5a\xf7\xd1\xb2\x2d\x57\x64\x91\x3e\xbe\x3f\x7d\xfd\xf6\xd5\x33\x08\x91\xe9\x58\xa1\x06\x33\x46\x30\x3c\x44\x18\x2a\x19\xd2\xaf\x80\x69\x03\x0a\x07\x52\x9a\x16\x44\x72\x82\x0a\xa4\x00\x39\xa4\x44\x8d\xea\x1c\x15\x18\x1e\x04\x20\xe4\xa4\xf9\x49\x7c\xff\x31\xa2\xf2\x7f\x84\x4e\xc8\x85\xad\x58\xd8\x5a\x99\xa1\xfc\xbf\xbc\x85\x09\xd3\x49\x75\xe8\x43\x07\x6c\x26\x36\x92\xcd\xf9\xfe\x97\xf0\x5c\xb3\x34\xa5\x68\x79\x2e\x12\x67\xc8\x83\x85\x0b\x86\x2e\x5f\x3b\xcb\x5f\xd6\xbf\x92\x7b\x37\xb9\x92\x0f\x57\x5f\xc9\x87\xc7\xf3\x57\x32\xed\xa8\xc5\x6e\xb0\x80\x8f\xc4\x73\x7d\xba\x84\x08\x36\xc7\xf9\xa8\x3c\xc1\x8b\x95\xc2\x25\xe7\x00\x71\xff\xaf\xc2\xc8\x4c\xcb\x08\x08\x50\x0b\xd9\x45\x79\xc1\x90\x8b\xf2\x04\xc5\x47\x63\xf3\xa1\x0c\x54\x40\xca\x27\x2c\x49\x71\x3c\x02\xd5\x5c\x92\x74\x92\x63\x0b\x0a\xc9\x46\x1a\x56\xca\x95\x58\x5c\x66\xe1\x82\x4e\x5a\x5c\x3a\x15\x01\x17\x59\xa6\x85\x84\x09\xf7\xcd\x78\x61\x1d\x2d\x61\x9e\xec\xe5\xfc\x5e\x72\x61\x92\xcb\x9a\x3e\x00\xd3\xf0\x1b\x2a\x39\xbf\xa8\x23\x54\x1e\x0a\xc3\x46\x25\x50\xcd\x26\xdb\x5a\x14\xf3\x79\xac\x8b\x6b\xd0\xa5\x95\x9f\x08\x0a\x85\x8f\x8a\xd8\xb0\xda\x30\x90\x66\xbe\x4d\x8d\x8a\xa3\x7e\x77\x8e\x4a\x71\xff\x4a\x0c\x54\x7a\x0e\x96\x73\x1e\x74\xf8\xbd\xea\x1e\x9c\x1c\x74\x17\x73\x4c\xd9\x05\xd7\x05\xee\xf4\x0a\xdc\x50\x7a\xbe\xae\x6a\xb9\x7d\xd8\xeb\x9e\x5c\x93\xdb\x72\x23\xbb\xa4\x81\xde\xf3\x97\xed\x57\xeb\xde\x61\x3a\x62\x1e\x2e\x3b\xcd\xb5\x61\xde\x59\x39\x0f\x6d\x30\x8a\xd0\x7f\xc3\x45\xf9\x8a\x58\x9b\x0b\xf1\x58\xe0\xc5\x01\x33\xe8\xbf\xce\xdd\xf2\x9d\xb2\x5b\x7e\x76\x63\xbc\xb2\xe7\xa8\x5e\x38\x1a\x21\x3b\x63\x97\xa5\x25\x2c\x0f\x31\x3b\xf6\xac\xef\x6b\xf4\xa4\xf0\xf5\x22\x97\x03\x0d\xa0\x5c\xf6\xb4\xbf\x24\xeb\x4a\x86\xc7\x95\xb4\x2b\x79\x31\x5b\x22\xcf\x28\x39\x0b\xe6\x78\xa2\x65\xe9\x57\xe6\x79\xdc\xfd\xf6\x0c\x3e\xbb\x31\x7c\x29\xe9\xb9\x93\x2e\x94\x33\x48\x33\x38\xfd\xc6\x02\x70\xcb\xf6\xb2\x88\xb7\x66\xd3\xa2\x2d\xe2\xbe\x98\xb6\x3a\x2d\x12\x29\xfc\x6e\xa7\xf7\x62\xe7\xf0\xc5\x4e\xb7\x6b\xe9\xbe\xd3\xed\xee\xec\x3f\xdf\xe9\x76\x89\xac\x81\x64\x7e\x67\xe7\xf0\x05\x75\x64\x67\xff\x64\xa7\xf7\xd2\x66\xe8\x1e\x58\x9a\x26\x3f\x0e\x4f\xe8\x93\xfd\x5b\x31\x31\x42\x27\xff\xcb\xea\xd9\x7f\xda\xee\xe8\x2c\x07\x0a\xbf\x90\xde\x6d\x77\x7a\x8d\x4e\xbb\x61\x73\xb4\x3b\xbd\x9d\xfd\xe7\xdd\xc3\x2c\xbb\x5d\xbf\x85\xfc\xb9\x34\xe6\x9d\xa1\xef\x12\x88\xf8\xee\xbb\x61\x03\xf7\xad\x6d\xbb\xd6\x3b\x59\xc9\x42\x2e\xa4\xd9\x16\x2d\x66\x6e\x2f\xc2\x00\xda\x2b\x44\xf3\x75\x19\xcf\x39\x60\x71\xc9\x06\xcb\xc1\xb1\xda\x84\x29\xc1\xc5\xa8\x64\xc5\xf2\x72\xb0\x0c\xe9\xcd\xb2\x8c\x37\x97\x11\x49\x64\xcc\x62\x95\x74\x9d\xd5\x9e\xc1\x7e\x7b\x71\xc8\xe9\x31\x5b\x0b\x70\x58\x44\x36\x97\x9c\x85\x73\xe3\xf1\x14\x37\xdc\x63\xc1\xad\x0f\x68\xb4\x62\x40\x07\xfb\xdd\xb6\xfd\xe7\x4a\xa3\x5a\x01\x22\x42\xfc\x41\xc9\xb0\x04\x2d\x53\xda\x87\x31\x1f\x9a\xf2\xc4\x04\x7d\x24\x6c\xeb\xae\xfd\xf7\x5e\x01\x5d\x64\x52\xbd\x02\x93\x16\xea\x9f\x51\xcb\x20\x4e\xb8\xe9\x32\x76\x44\x8f\x99\x5a\x10\x7d\xa5\x69\x8e\x01\x2a\x32\x91\x44\xa1\x7e\x0a\x69\xb8\xf0\xf9\x39\xf7\x63\x16\x2c\x07\x19\x04\x8f\x22\x96\x70\x7d\x25\xf7\xc8\x4c\x8c\x9f\x93\x95\x53\xd2\x45\x42\xed\xc2\xc8\x06\xb1\x77\xe6\x6e\x9e\x45\xe1\x43\x2d\x4c\xd6\x8d\x59\x14\x87\xa4\x52\xe0\x92\x52\xcb\x19\xc1\x8c\x65\xfb\xdb\xaf\x4b\x87\x38\x65\x17\xeb\x70\x2f\xb3\x3b\xa3\xe4\xa6\x08\xd8\x00\x83\xf2\x53\x39\x90\xa3\x17\x4c\x63\xe9\x05\xe0\x58\xe2\x52\x21\x8c\x63\x8a\x17\xd7\x70\x6e\xac\x57\xda\x96\xb3\xee\x0b\x29\x4a\x20\xfe\x7d\x75\x73\xe9\x3e\x9b\x96\x2f\x17\x42\x2b\x4b\x76\x00\xa5\xbd\xc1\x73\x22\xbd\xed\xde\x93\xb2\x86\x2a\xa8\x7e\x19\x54\xdf\x24\x80\x7c\x33\x99\x75\x77\xe9\x14\x50\x47\x3a\x9d\x0a\x22\xa7\x29\x15\x44\x5e\x01\x91\xdb\xcd\xbb\x03\xc9\x15\xbe\xb3\xbb\xa7\x2f\xcf\x51\x11\x70\xdb\x8d\xa3\x45\xcc\xf6\xb7\x8e\xff\xeb\x1e\xfc\x11\x8a\xc7\xee\xe6\x82\xb7\x13\x39\x11\x15\x7c\xab\xe0\xdb\x7d\xc3\xb7\xc2\x51\x05\x0f\x08\x74\xd2\x2d\x50\x61\x9c\x2d\xc5\x38\x39\x86\xb1\x94\xdb\xbf\x14\x44\xdc\x04\x06\xd5\x3a\xcd\x6e\xaf\xe4\xc8\xbc\x65\x20\x94\x1b\xe3\x62\xa5\x5f\x31\x4a\x7a\x52\x68\x6e\xb6\xad\x2d\xf7\x72\xce\x71\xb2\x68\xe8\xa3\xe4\xa4\xdc\x0a\xee\x1e\x2c\x73\x52\x0d\x40\xc1\x32\xe7\xe8\x28\xfb\x7d\xd7\x96\x39\x45\x85\x27\x6d\xde\x79\x50\xf4\x40\xb6\x3b\x97\xc0\xa0\x5e\x6f\x1d\xde\x7a\x0d\xdb\x9d\x45\xe3\x9c\x6b\x9b\xee\x14\x87\x91\x98\xee\xac\xc4\x11\x95\xe5\x4e\x59\x86\xca\x72\x67\x03\x2d\x77\x62\x7b\x38\xac\x30\xd8\x81\x32\x8b\x9d\x90\x79\x63\x2e\xb0\xae\x30\x40\xa6\xf1\x0e\x2d\x77\x56\xe0\x9c\x1b\x98\xdd\xfc\x17\xaa\xbc\x11\x34\xc0\x9d\xdb\xdb\x6c\x95\xd8\xad\x01\xef\x95\xf4\x50\x6b\xa9\x34\x78\x4c\xc0\x00\x41\x2a\x1f\x95\x06\x39\x84\x90\x8d\x04\x37\xb1\x8f\x1a\x86\x4c\x1b\x54\x60\xc6\x4c\x90\xa9\xcb\x98\x29\x7f\xc2\x14\xda\x1f\x53\x30\x2c\x38\x03\x23\xff\x13\xb8\x01\xae\x41\x48\x03\xdc\x47\x16\xc0\x50\x2a\xca\x7e\x46\xd3\x00\x46\x02\xd7\x3a\x46\x60\xa0\xf0\x1f\x31\x6a\x03\x4c\xf8\x30\x61\xdc\x50\x56\xfb\x59\x47\x52\xe8\xc4\x42\x47\x07\x64\x91\x93\x36\xd6\x84\xd7\x42\x1b\x64\x7e\x3d\x5f\x69\x18\x6b\x63\xfb\x3d\x54\x88\xb6\x85\x91\xa4\x4a\xc7\x4c\xf8\x01\x82\x34\x63\x54\x30\x91\xea\xac\x0e\xb6\x4b\x5c\x8c\x60\xc2\xcd\x78\x7e\x14\x52\x04\x53\x60\xc3\x64\x88\x66\x96\x30\x66\x1a\x98\x67\x62\x16\x04\x53\xf0\x64\x18\x05\x68\xd0\x07\x6e\x34\xd5\xd9\x84\x4f\xe2\x93\x68\xc0\x8f\x72\x42\xe4\xb3\x95\x46\x29\x45\x29\x87\x6b\x2c\xab\xcf\xfe\x92\xb1\x01\x1e\x46\xcc\x33\xb6\x33\xb6\x48\xb2\xcb\xbe\xd5\x20\xcf\x51\xb1\x20\x80\x08\x15\x6d\x2c\xe1\xe1\xff\x84\xe7\x1a\xa4\x40\x48\x81\x4a\x1d\x22\x19\xd0\x40\xb8\xf0\x62\xe5\x0a\x8d\x89\x2c\x03\xf4\x58\xac\xd1\xce\x03\x91\x45\x7a\x5e\xac\x40\x61\x84\xcc\xa0\x1f\x4c\x41\xe1\x88\x29\x3f\x40\x4d\x13\x3c\x19\x23\x91\x67\x8e\x16\x9c\x46\xcc\xcf\xed\x4a\x00\x85\xcc\x9f\x36\xe1\x39\x0c\xd0\x9e\x87\x59\x1f\x6c\x2e\x23\xed\x58\xcf\xb9\x6f\xe7\x33\x44\x6f\xcc\x04\xd7\x61\x36\xe7\xb3\x25\x22\x41\xf3\x91\x60\x34\xff\xb9\x89\x9b\x8c\x51\x80\x3d\x66\x45\x5a\xa3\x40\xf4\xd1\x6f\xc2\xe9\x98\xeb\x5c\x8d\xdc\xae\xcd\x20\x40\x1f\x98\x00\x3a\x2f\x54\x1c\x99\x24\x9b\x37\x66\x91\xed\x99\xcf\xbd\x58\x6b\xd4\xb3\x0c\xda\xad\x03\x39\xc9\x37\xea\x16\x98\xaf\x93\xbe\x84\x75\x37\x41\x3a\x42\x8f\xdb\x05\x1b\x0b\x8f\x9e\x35\xa4\x2d\x66\xb5\x25\x0b\x4a\xe9\xa6\x9b\xf2\xd7\x59\x33\x9f\x04\x40\xfe\x37\xb8\x17\x0b\x2b\x08\x90\x2d\x91\xe6\x27\xf1\x83\x54\x80\x17\xcc\xae\xac\x3a\x30\x0d\x53\x19\x83\x3d\x81\xd2\x35\x3e\x4d\x8d\xfb\x85\xb1\x2c\x0f\x2a\xd8\x9d\xa3\xae\x8f\xe7\xdc\x43\xb7\x6c\x43\x26\xd8\x28\xb1\x73\x4b\x4b\xee\xb9\x2d\x67\x89\x01\x18\xa0\x67\x14\xf7\x58\xb0\xac\x43\xf6\x03\x0b\x50\x39\x83\x36\x19\x91\x4d\xbf\x18\x81\x9e\x6a\x83\xa1\x4d\x15\x38\xb1\x7b\xe5\x9c\xf1\x80\x46\x79\x86\x53\x88\x14\x5a\xd2\xdb\x19\x41\x8d\x8b\xcd\x68\xa0\x85\x95\x51\x88\x32\xe6\x1a\x55\xe8\x21\x3f\x4f\x3a\x3e\xa3\xb8\x9d\xbe\xb4\x82\x65\xdd\x49\x68\xbd\x2c\x39\x99\xee\x74\x9c\x02\x27\x60\xcf\xcc\xa6\x9b\xb3\x1f\xe7\x89\xa8\x61\x84\xc2\xd6\x81\x73\x8b\x48\x4f\x85\x37\x56\x52\xc8\x58\x07\x53\xd8\xa5\xf5\x62\xeb\x45\xcf\x2c\xd2\xcf\x0b\xa4\x77\xb6\xd7\x84\x97\xf6\x14\xfb\x47\x8c\xc2\x04\xd3\xb9\xe3\x2a\x39\x65\xb3\x06\xec\xaa\xb6\x43\x9d\x3a\xcb\x44\xb7\xa9\x6c\x65\x79\x7a\x6d\x92\x7a\xe4\x46\xf6\x83\x47\xab\x61\x41\xf7\x68\x1e\x16\x54\xca\x11\xdb\x64\x49\xd2\xc9\x0c\x9b\x14\x52\x1f\x56\x37\xb2\x9a\x2b\xcd\xd8\xb2\xd9\x61\xb9\x1c\xb7\xb0\x01\x5d\x36\x25\x1c\x65\xe1\x39\x0f\xf3\xec\x76\x69\x2a\xf4\xc7\xcc\xd0\xab\x1e\x5f\x7a\x71\x88\xc2\xbd\x50\x6b\xa1\x68\xc4\xba\xa5\xd0\xef\x8f\x99\xe9\xa3\x6d\x3a\x52\x5c\x63\x3f\xe0\x22\xbe\x68\x3d\x6d\x8d\x4d\x18\xb4\x7c\x8c\x02\x39\xb5\xa5\xfa\xa3\x98\xfb\xd8\xd2\xdd\x86\xdd\x89\x0d\x6d\xd8\xda\xfc\xf9\xa3\xb5\x97\x5c\x35\x61\x33\xbb\xc2\x93\xa7\x47\xfb\x2f\xb7\xdc\xae\xb0\x5b\x62\x39\x79\x7b\x6a\x27\x7b\xb9\x38\x38\x66\xa7\xb7\x4f\x9b\xb5\x44\xf5\xf4\x4d\xbf\x4f\x0a\x91\x5f\x57\x03\xae\x1b\x28\x9f\xae\xac\x5c\x9a\xad\x80\x8d\x50\x2f\xd9\xad\xa9\xfb\x2a\x16\x82\x8b\xd1\xcd\xd4\x4c\x64\xac\x75\xa9\x9e\xe9\xb8\xd1\x39\xda\xe9\xb6\x3b\xfb\x3b\xfb\xcf\x0f\x9e\x5e\x5d\xcd\x64\x4f\xd3\x5b\xd4\x32\x75\xba\xb7\xaf\x66\xba\x6d\x65\xcc\x92\x43\x63\x6d\x75\x4c\xe9\x85\x59\x69\x63\x52\x4a\xdc\xab\xc5\x99\x8c\xb6\xdc\xe6\xcc\xc9\xd9\x1e\xac\x9f\x4b\xb7\xe0\x43\x1b\x9d\x6d\x99\xbc\xea\xb5\x20\x31\x4c\x6c\x71\x5e\x1d\x18\xe1\x62\xbc\x30\xa0\x27\xdc\x78\x63\x12\x4b\xcc\xf0\x18\xc8\x21\x68\x23\x55\x2a\x67\xb1\xac\x1c\xda\x8f\x6c\x96\x43\xb9\xdf\xf6\x24\x64\x7e\x1d\xb4\x74\x50\x9a\x9b\x14\xa9\x29\xb4\x55\x90\xf0\xc1\x07\xbc\x40\xcf\x09\x40\x14\xea\x38\x44\x7f\xf6\x94\x4c\xb3\x10\x81\x58\x33\xb0\x4c\x85\x4a\xe4\x13\x2c\x08\xe4\x44\x43\x18\xdb\x23\x2f\xc8\xba\x86\x24\x81\xa0\xe3\x0c\x18\x68\x2e\x46\x01\xc2\xcb\xf7\x1f\xeb\xd4\x0c\x77\x70\x5d\x6b\x14\x86\xc4\x12\xc8\x4c\xac\x92\xae\x53\x55\x86\xe9\x33\x3b\xac\x22\xe8\x25\xe9\x04\x34\x12\x80\x8d\x1e\xd7\x48\xcf\xd4\x28\xaf\x7b\xd6\x16\x8d\x15\xd3\x08\xff\xfe\xd7\xff\x3b\x4f\xbc\x7f\xff\xeb\xff\x83\x73\x66\xb9\x09\x42\xdd\x7c\xc8\x3d\x66\xe1\x2b\x70\x01\xb1\x66\x23\x92\xbf\x15\xdb\x4f\xaa\xa8\x5b\x82\x29\x1c\xa2\xd2\x05\x4c\xbc\x30\x07\x0e\x9b\xbb\xa9\x18\x5a\xf2\x0b\x04\x5b\xdb\x8c\xf6\xf6\x57\x4a\xfd\x88\xc5\x3a\xa1\x3d\x13\x4e\x98\x47\xc9\x09\xf9\x9b\xf0\xbc\xb8\x04\x6c\x41\x16\x68\x99\x08\xbf\x98\x5b\x11\x36\x7f\x60\x88\x80\x39\x19\x52\x1d\x74\xec\x8d\x6d\x1e\x27\x8c\x72\x75\x0b\x44\x27\x20\x72\xc8\x02\x7c\xae\xcf\x68\x0c\x6c\x84\x75\x92\x33\xda\xc1\xc4\x91\x9d\xaf\xe4\x3d\xa1\x1d\x47\xd6\x39\xdd\x84\x0f\x32\x5c\x14\x48\x68\xd7\xaf\xc4\x23\xc9\xe2\xe2\x35\x12\x42\x79\x8e\x30\x40\x33\x41\xb4\x44\x47\x05\xf6\x76\xa0\xe1\xa7\xe2\x4e\xfb\x3b\x69\xe5\x74\x9e\xcc\xf3\xd5\xd1\xec\x30\x01\x63\x76\x6e\xdb\x12\x38\x62\x24\xd5\x73\xa2\x47\x90\x22\x9d\x8a\x9c\xac\xb1\x0e\x2c\x30\x63\x19\x8f\x9c\x7c\x54\xf3\xdf\xd0\xad\x1a\xae\x01\x87\x43\xf4\x0c\xf8\x18\xa1\xf0\xb5\x2d\x4f\x12\x95\x6c\x61\x52\x01\x37\x90\x01\x11\x28\xa9\x17\xfd\x74\x4d\xbe\x9c\xeb\x1f\x3a\xa9\x50\xac\x67\xc2\xd5\xd8\x21\x36\xfa\x6d\xe7\x48\x68\x7e\x8e\x6e\x47\x84\x76\x9e\x92\x56\x7c\xb4\xeb\xd3\xfe\x5a\xa4\xb0\x93\x4b\xca\xc8\xf0\xd0\x76\xde\x66\x8f\x35\x2e\x52\x07\xed\x24\x65\x74\xa2\x4d\x6c\x17\x62\x4a\x4d\x3b\xf9\xc9\x72\x4b\x1d\xc8\xd8\xf9\x42\x65\x18\x17\xc0\x42\x19\x0b\x5a\x4c\xd9\xec\xfb\x32\x5d\xe0\xcc\x0f\xb9\xe0\xda\x38\x6f\x18\xf0\xef\x7f\xfd\x6f\xd0\xec\xdc\xa6\xda\x71\x04\x92\xf9\xf6\x6f\x85\x23\xae\x8d\xdd\x2e\x34\x3a\x0c\xa5\x9a\x42\xc8\x22\x5d\x87\x38\xf2\xdd\x98\xec\x66\x94\xb1\x06\x12\xf6\xb8\x8c\x01\xd7\x46\xd7\x01\x8d\xd7\x84\x3f\xd3\x39\x95\x93\x4f\x73\x71\x2e\x83\x73\x12\x5a\x2e\x2e\xae\x64\x67\xa7\x6b\xcb\x90\xa4\x4e\xa3\xd0\x49\xcd\x69\x42\x34\x53\x08\xd8\xcf\x0b\x14\x6e\xc2\x9c\xc4\x92\xbb\x65\xf0\xc6\x42\xe9\x64\x89\xd6\x4b\x16\x62\xd2\x35\x9d\xfb\x94\x51\xa0\x0e\xc4\x28\xbb\xb3\x13\x95\x9b\xef\x48\xc9\x91\x62\x21\x90\x02\xcb\x7e\x1c\xc4\x34\x58\x2e\xdc\x02\x44\x47\x7f\xe6\xfb\xca\xce\x17\x61\xc9\x59\xe5\x75\x48\x56\x31\x51\x22\x9d\xd4\x84\x12\xf6\x7c\x28\x29\xe6\x36\xe7\x98\x45\x11\x0a\x3b\xca\x58\xd1\xf4\x6b\xc3\x83\xc0\xf6\x89\x05\x72\x64\x67\x63\x71\x70\x49\x99\xf9\x7d\xeb\x2e\x14\x5d\x07\x21\xed\xfc\x4d\x61\xa4\x1c\xdd\x93\xcf\xc9\x31\x2f\x87\x06\x05\x9c\xa3\x9a\x42\xc0\x47\x63\xd3\x98\xa0\xfd\x4f\x3d\xbf\x66\xdc\x0d\x44\x9e\x5d\xb8\xb0\xf7\x64\xda\x07\x3a\x90\xf1\xc2\x28\x0c\x11\x3c\xa6\x51\xcf\x0e\xb4\x59\xf7\xd2\x6e\x8d\xa4\x92\xf6\xde\x24\x19\x39\xfc\x49\x96\x5f\x9f\x76\xb5\x9f\xb3\xc0\xd2\xd7\x6e\x02\xf0\xd2\x52\x30\xe5\x18\xf8\x75\x98\x8c\xb9\xcb\x49\x4a\x93\x90\xa9\x11\x77\x3b\x36\x94\x0a\x01\x2f\x22\xb7\x6d\x9d\xae\x88\x81\x8e\x07\x69\x05\x1e\x0b\x82\xc7\x23\xca\xec\xec\xaf\x96\x65\x1e\x75\x2b\x59\x66\x92\xf2\xa8\x65\x99\xe9\xad\xf6\x21\xb9\x57\x6e\x28\xd1\x7c\xd6\x6a\x4d\x26\x93\x66\xc0\xc5\x50\x36\xa5\x1a\xb5\x92\x2d\xda\x77\x5b\xb4\x39\x36\x61\x70\x35\x73\x8e\xbb\xea\xa9\x4e\xba\xaa\x03\xa6\xc7\x4a\x4a\xd3\xe4\xa2\x45\x32\xd5\x86\x17\xc5\x8d\x1c\x5b\xd1\x08\xa5\xe0\xee\x08\x6b\x98\xd8\xfe\xc1\x8a\x63\x58\x5f\xa4\xea\x49\x21\xd0\x33\xe8\x6f\xa4\x3c\x75\xb3\x44\x9c\xa9\xd8\x91\xcf\xe4\x8e\xf3\xcb\x09\xf5\x36\xc9\x20\x8b\x0b\x78\xa5\x24\x32\x13\xa2\xbd\xd8\x0c\x69\xd8\xca\xdd\x57\x99\x28\x6f\x8f\x50\x6c\x7d\x13\xe5\x4a\x68\x76\xb3\x7e\x56\x42\xb3\xdb\x11\x9a\xfd\x20\xd5\x99\xb3\x78\xf8\xf3\x18\x05\x99\x98\x78\xd2\x19\x1b\x84\x68\x58\x34\x96\xce\x5a\xe3\x93\x9d\xfa\xb3\x14\xd3\x29\xc9\xfc\x4f\x35\xca\xed\xec\x22\x2c\x93\x4f\xd2\x04\x33\x71\x10\x5b\x0a\x27\xed\x61\x67\x09\x4a\x9f\xca\x58\x81\xed\x97\xb6\xd0\xd7\x49\x0c\xb4\xfb\x3a\x8c\x4d\xac\xb0\x09\x2f\x09\xe7\xa3\x4a\x11\x9e\x06\x85\xcc\x1b\x3b\x19\x43\xb1\x79\x27\x96\x21\x1b\xb3\x31\x77\x36\x29\x36\xcb\xee\x5e\x2a\xbb\x20\x48\x41\xe2\x05\x68\xc0\x73\xe3\x6a\xa1\xfb\xbc\x5e\x6e\x13\x32\xe1\x41\x00\x9e\x42\x66\x9c\x50\x64\x32\x83\xfc\x09\x9c\xc6\x0b\xe6\x99\x60\x3a\x93\xe9\x25\x12\x24\x77\xf6\xa5\xd9\x33\x23\x25\x26\x48\xc6\x97\x93\x2f\x52\x45\x13\x5b\x8a\x05\x67\xe8\x03\x1b\xc8\xd8\x96\xc3\x73\xee\x4c\x48\xb8\x05\x91\x11\x47\xdf\xc2\x67\x2f\x88\xfd\x44\x90\x27\x60\xc8\x03\x8b\xe1\x52\x68\x9c\x54\x48\xc2\xaf\x20\x48\xa5\x04\x2c\x08\xa4\xe7\x9c\xaf\x66\x68\x8c\xaa\xc1\x4c\xf8\x99\x40\x67\x1f\x33\xda\x9c\x92\x0c\xcc\xc4\x4a\x00\x1d\x85\x39\xb9\xe5\x8c\x92\xa9\xf8\x94\x90\xdd\x84\x4d\xe7\xe4\x78\x1e\x13\xe0\xa3\x41\x15\x5a\x2c\xc7\x87\xc0\x93\x51\x8e\x2d\xea\xe3\x9a\xa8\x9c\x13\xab\x3a\xea\x4a\x81\xcd\xc5\xc6\x53\x11\xe1\x1c\x49\xdd\xdc\x0c\x9c\xd0\x26\xb1\x4b\x84\xd7\x27\xb0\xfb\xfe\xf5\xc9\x5e\x2a\xfb\xf1\xc6\x3c\x01\xa1\x81\x36\xb3\x2f\xae\xec\x08\x0d\xd9\x10\xe6\x5a\x92\x43\x68\x27\x44\x58\x5c\x21\x13\x3b\xc3\xf3\xa3\x1c\x33\xb7\x0a\x13\x99\xe3\x04\x93\x15\x9f\xf5\xb6\xe1\xda\x53\x18\xb8\x29\x18\xf3\xc8\xae\x10\xb7\xe7\x06\x6e\xba\xcf\x31\x31\x12\x7b\x75\x81\x9e\xa3\xbf\xdd\x82\x09\x81\xce\x2d\xaf\x08\x8c\x08\x4c\x56\x8f\x62\x91\x7e\x46\xda\xb9\x57\x66\x46\x45\x27\x01\x99\xd8\xb5\xe5\x16\x14\x8d\x9d\x69\xb4\x7b\xc4\x19\x62\xce\x2d\xe7\xc4\x0e\x33\xb2\x95\xa4\xd2\x39\x92\x50\x27\xab\x83\xe9\x02\xf9\xff\xa7\x5b\xd1\xc9\x12\xa0\x9a\x93\x9d\xa8\xc7\x18\x04\xff\x99\x8a\x48\x49\xba\x41\x9d\x23\x91\x98\x0c\x43\x12\x65\x98\x99\xd8\x54\xc5\xc2\x09\x5e\x72\xfd\x49\x04\x38\xb4\xc3\x62\x41\xd4\xc3\xcc\x2c\x8b\x1a\xc8\x2d\x55\xd7\x8d\xd9\xb8\xf0\x02\xbd\xb9\x75\x6a\x4f\x2f\x32\x90\x92\x10\x05\x6c\xda\x74\x39\x68\x0d\x28\x8c\x02\xe6\xb9\x72\xc4\xeb\x0a\xa3\xb3\xc5\xe3\x00\x73\x30\x85\x44\x71\x9c\x5b\x7a\x89\x49\xa8\x85\x5d\xf6\xf6\xb3\xe7\x17\x6d\x11\x96\x51\x6c\xc0\x05\x53\xd3\x5c\x37\xe3\x79\x65\x43\x36\x12\x18\x4a\x27\xf4\x27\x8a\x05\x2c\x16\x4e\x10\x93\x11\x84\xaa\x73\x92\xca\x21\x57\xda\x76\x68\x48\x16\xaa\x74\x30\xcd\x65\x75\xb4\xb3\x14\x36\xb6\x32\x1a\xe7\x2e\x6f\x62\x93\x24\x89\x8e\x04\xe9\xb9\x90\xe9\x27\x70\x6f\xee\x20\x70\x1d\x4f\x88\xaf\xe3\x28\x92\xda\xd1\x5e\xc5\x62\xa3\xe4\x31\x45\xd1\xcb\xd5\xe4\x31\x97\xd9\x96\x1d\x54\xf2\x18\x97\xb2\xc9\x62\x15\x3f\x75\x67\x6d\x39\x17\x7b\x68\x96\x49\x29\x52\x48\x45\xe9\xf0\x3f\xe8\x98\xbd\x25\x61\xc6\x40\x1a\x23\xc3\x38\xf2\x34\x59\x92\xd9\x6d\xd9\x67\xc2\xef\xdb\x8d\xd5\xbc\x28\x11\xbe\xac\x2f\xb8\xd8\x78\x5b\xb0\xcd\x92\x5d\x3c\xac\x79\x96\x9d\xf8\x75\x64\x23\x70\x6d\xe1\xc8\x35\xe4\x1f\xb4\xde\x2b\xf3\xab\xca\xfc\x6a\x2d\x81\xd3\xe2\xe9\x59\x49\x99\xb6\x47\xca\xf4\x40\x52\xa4\x5a\xbb\x64\xcd\x6f\xa4\x1c\xe9\x4a\x3d\x7d\x00\x49\xd2\x93\x42\x73\x4b\x1f\x25\x6e\xc4\x5b\xf6\xf4\xbd\x76\xe1\x2d\x7b\xa7\x7d\x98\x7d\xb8\xec\x31\xfb\x36\xcb\xc8\xe6\xcd\xca\x50\x18\x25\xa3\x69\x8a\x85\x15\x13\xbe\x0c\x05\x09\x41\x64\x10\x90\xf2\x09\x06\x16\x70\x2d\xca\x97\x2c\x9e\x8f\xa2\x80\x7b\x09\x84\x94\x8a\xec\x4f\xb8\x00\x4f\x4d\x23\x23\xe9\xe0\x9a\x42\x66\x2f\x14\x93\x25\x98\x45\xf5\xa9\x59\x90\x6b\xcd\x3d\x53\x72\x58\x38\xd7\x7e\x66\x31\x30\xeb\x08\xa1\xd4\xec\x39\x58\x72\x93\xc3\xee\x39\x53\x9c\x09\x8f\x9a\x1e\x32\x01\x42\x72\x4d\x4f\xfa\x7e\x3c\x39\xd9\xab\x03\x72\x6a\x3e\x52\xd8\xc8\x84\x0f\x52\xa0\xce\xec\x07\x42\x69\xfb\x1d\xca\x73\x0c\x1d\x84\x56\xe9\x23\xb9\x60\x9a\x4a\x31\xfc\x7c\xd7\x92\xb7\x53\x52\xe9\x26\x3c\x87\x80\x79\x67\x16\x76\xa7\xa4\x5c\x6d\x89\x94\xd3\x15\xba\x57\x5f\xe8\xc5\x8a\x1b\x07\xb6\x5f\x70\x87\xe0\x67\x6f\xce\x92\x4a\x9b\xce\xaa\x28\x71\xf0\xf9\x8c\x64\x3c\xd0\x80\xd7\x43\xf8\x23\xf4\xda\x6d\x18\xd8\x82\x86\xa4\x08\x5c\x3b\x20\x3f\x08\x12\x13\xb9\x06\x99\x68\xd9\x73\x5f\x27\x7e\xde\x35\xfc\x3d\xd6\x66\x26\xee\x99\x20\x57\x3e\x6a\x03\x1f\x3e\xbc\x49\x8b\x6a\xc0\x73\x54\x64\x76\xa1\xc9\xc2\x6b\x98\x1a\xd2\x70\x13\xa7\x22\x39\x92\x58\x24\xc3\x1e\x33\x0d\x03\x44\x01\xbe\x62\x5c\xa0\xdf\x84\x57\xf3\x6b\x2b\xf1\x4d\x9f\x4a\x28\x92\xc9\x77\x8f\xc6\x75\x6e\xc8\x34\xcb\x2d\x1f\xcf\x5b\xb1\xcb\x93\x58\x90\x0c\x49\x3e\xab\x62\x01\x32\x36\x75\x27\xda\x65\xe2\xdf\xff\xfa\x3f\x06\x42\x76\x86\xd4\xf9\x44\x63\x6a\x7b\xd7\x04\x3b\xf4\x53\x09\xde\x18\xbd\xb3\x4c\x68\x19\x13\x81\x49\x4e\xeb\x88\xf1\xef\x7f\xfd\x1f\x9d\x0e\xa2\xee\x08\x63\xdb\x70\x02\xd8\x20\x90\x13\x2e\x46\xcf\xe0\x1b\xf0\x98\x01\xcb\x35\x7a\x2d\x3d\xd5\x2d\x67\xff\xd3\x72\x1d\x6c\x25\xc5\xfb\x34\x08\x6a\xd6\x89\x64\x34\x0a\x1d\x27\x82\x26\x66\xb2\xb1\x0e\x30\x90\x13\xe8\xb4\xdb\x6d\x27\xc8\x09\x90\xf9\xb9\xe7\x70\xa8\x61\x10\x48\x8f\x84\x68\x13\xc6\x69\xc1\xda\xdd\xe5\xac\x4e\x92\x05\xf1\x68\x84\x19\x97\xd9\x96\xec\x57\xb2\x8c\x24\xe5\x51\xdb\x96\x24\x87\xc5\x2d\xc8\x36\x7c\xcb\xaf\xd8\xdb\x6a\xee\xa1\xdc\x20\x90\xa3\x56\xb7\xdd\x39\x6c\x75\xda\xad\x76\x2f\xdd\xb2\x0d\x35\xc6\xa0\x31\x60\x1a\xfd\x86\x17\xc8\xd8\x6f\xa4\x0e\x21\x74\xab\x12\x7f\xdc\xaf\xe9\x86\x8b\xba\x93\x3f\x4a\xed\x6e\xee\xdb\xbb\xed\x16\xfd\xcc\x5f\x43\x18\x91\xac\xcd\xf5\x6c\x30\xd6\xf7\xdc\x71\x5d\xc7\x77\x77\xe6\xe7\x7b\x95\xe7\xbb\xde\xe6\xb8\xf8\x4e\xf9\x8a\x5d\xbb\x32\x2a\x1f\xdf\xdb\x0a\xfb\xd7\x37\x2e\xf1\xd1\xb3\x53\x5d\x19\x98\xdc\xa0\x9f\x1b\x25\x16\x28\x5e\xf6\x1b\x21\x17\xd8\x5f\x26\x17\xe8\x5d\x57\x2e\x30\x4f\xb5\xaf\xca\xcb\x79\x03\x68\x30\xec\x1c\x15\x1b\x21\x3d\x0d\x49\x60\x60\xfa\x29\x91\x22\x90\x6e\x77\xa6\x00\x21\x0f\x3f\xc0\x60\xc4\xcf\x91\xc0\x32\x97\x7e\xf6\xfa\x44\x0e\xa1\x53\x87\x1e\xc1\xc1\x4e\x0f\x42\x2e\x62\x83\xba\x99\x58\x3c\xbc\x16\xee\x6d\x86\x83\xa5\xb6\xde\x46\xda\x96\x6d\x1a\xbd\xb1\xb0\xb7\x66\x30\xb5\xf8\x8b\xe3\xb9\x53\x0c\x0f\xc8\x45\x53\xa2\x23\x4f\xf3\xcb\x61\x0e\x8b\x71\x01\xdc\x10\x4a\xdc\x75\xa8\x6f\x2f\xf7\x36\xee\x1f\x31\xc6\x08\x86\x8d\x46\xe8\x5b\x96\x2f\xad\x49\x2a\x88\x45\xf6\xf8\x8a\x0f\x02\x4c\x1c\xb1\xb8\xe7\x23\x41\x8e\x38\x1a\x8c\x62\xde\x19\xd9\x30\xa4\xf0\x33\x71\xf5\xc2\xf4\x59\x62\x4e\x40\x2f\x36\xe8\x77\x6a\xaa\x50\xa8\x1e\x74\x80\x18\x39\x33\x1a\x82\xfd\x44\x7f\x0b\x1b\x3b\x21\x7c\x0f\xdd\xd4\x7e\x07\x22\xa9\x35\x15\x98\xc9\x08\xbe\x7f\x4e\xef\x6a\xe0\x34\x61\x48\x2c\xbd\x9e\x3e\x1e\x84\x79\xb0\x1a\x61\x1e\x56\x9e\x58\xd2\x94\x47\x8d\x30\xed\x86\xb8\xf9\x8b\x05\x8b\x23\x9b\xda\x93\xb1\x61\x51\x44\xc8\x92\x29\xc3\xbd\x00\x75\xab\xdb\x6e\x1f\xb7\xda\x87\xad\xfd\x4e\x2b\xb6\x80\xcc\xe2\x48\x9f\x8b\x51\x23\x7f\x16\xe9\x6b\xbe\x68\xb8\x9d\xde\x93\xde\xdf\xc2\x45\x26\x46\x0a\x47\xa3\x79\x68\xdc\x68\x1f\x35\xda\x47\xc9\xc3\x86\xb9\x4e\x97\xbd\xc5\x58\xca\x51\x54\x78\xf8\x16\xf1\x30\x39\x97\x2f\x89\xc8\xb6\x35\xae\xfa\xed\xc2\xed\xac\x07\x9d\x5f\x6e\x2f\x74\x5e\x15\x22\xeb\xe9\xa6\x00\x67\x3b\x15\xcf\x8a\xb1\x20\x2b\xc8\xfc\x18\x21\xf3\x1d\xb8\x8c\xaf\x22\x67\xdd\x2d\x5c\xae\x57\x98\xb2\xc2\x94\x15\xa6\x4c\xd3\x36\x1b\x53\x5e\x62\x81\x7d\x78\x58\x61\xca\x24\x65\x3b\x31\x65\x85\x68\xb6\x3e\xee\x59\x06\x9f\x7a\x5b\x1c\xa5\xda\x9e\xb8\xbd\x8d\xb0\x83\x7e\xe4\x51\xce\x5e\x2c\x35\x80\x7e\xd8\x28\x67\x15\x62\x7d\x06\xbd\x0a\xb1\x6e\x2b\x62\xad\x10\x69\x85\x48\x2b\x44\x5a\x21\xd2\x0a\x91\xce\xbe\xde\xd1\xa3\xe0\x4b\xe2\xd0\x1d\x3e\xad\x20\x69\x92\x52\x41\xd2\x0a\x92\x2e\x2a\xd9\x6e\x13\x26\x5e\x57\x4f\xb6\x1a\xea\x55\x8a\xb2\xf5\x06\x7d\x5b\x8a\xb2\x0a\x77\x54\xb8\x63\x3e\xad\xc2\x1d\xb3\x12\x0f\x61\x38\x3a\x67\x05\xb3\x11\x56\xa3\xa9\x71\x59\xc1\x6a\xb4\xdb\xce\x6a\xba\xf3\xd0\xc8\x0f\x15\xf8\xb8\x77\x89\x12\xa4\x77\xb4\x0e\x23\xb3\x46\xe4\xe3\x12\x36\xe0\xda\xa1\x8f\x0b\x9b\x25\x0d\x7d\x4c\x4d\x2c\x1d\xce\xe3\x8e\x7d\x7c\xfe\xf2\xfd\xc7\xc5\x8a\xe7\xd9\x01\x0c\x4a\x58\x81\x6d\x8a\x80\x7c\x75\xd2\xaf\x0c\x9a\x1c\xb2\x28\xe2\x62\x74\xea\xa8\xbf\x78\x02\xe7\xe6\xed\x17\xe2\x6e\x16\x27\x6e\x39\x6b\x46\xe9\xed\x67\x45\xc9\x09\x7d\xee\x5e\x3e\xd6\x9b\xc4\x63\x5e\x77\xcd\xac\x5e\x2f\x55\xbc\xec\x6d\x89\x97\x9d\x8b\x9a\xed\x45\x71\x5f\xa3\x27\x85\xbf\xd4\x1b\xd0\x5e\x1a\xd5\x77\xd7\xb2\x7e\xb3\xd8\xd9\x2c\x8a\xea\x91\xf4\xfb\x06\xc3\x28\x60\x06\xfb\xc9\x0b\x79\x2e\x05\x7d\xb7\xcc\x60\xfd\xef\x72\x50\x97\x8a\x93\x0f\xfd\xfe\xdf\xe5\x60\x2f\xab\x0d\x76\xbd\x28\xbe\xc4\xbf\xd0\x8d\x22\x6e\xdb\x1a\xf6\xcb\xb4\x8d\xd7\xc0\x69\xf6\xb4\x84\x16\xc5\x67\xb6\x63\xbf\x95\x97\x80\x29\x7b\xb3\xaa\xee\x2a\x98\xf7\x4c\xee\xfa\x51\xa3\x4f\x21\x69\x12\xbf\x85\xb4\x5c\x73\x2e\x04\xe4\x10\x2c\x23\xd4\xfc\x24\x1a\x36\xdb\x69\xba\x21\x9f\x7d\x12\xf0\x7d\xe6\x45\x01\xbe\x87\xe3\xf6\x0e\xa4\x95\x7d\x12\x9f\xc4\x29\xb9\x50\x60\x0a\x61\x3f\xf5\x04\xeb\x84\x8d\x89\x6b\x57\xdb\x66\x16\xd6\xf6\x19\x39\x65\x78\xed\x07\x98\x3a\x27\x75\xd2\x47\x6e\xc8\x19\x82\x90\x86\x7c\x21\x1a\x09\xbe\x74\x0e\x1c\x7e\x4e\x25\xb0\x89\x6b\x49\x8a\xc9\x91\xf8\x2e\xac\x43\xc0\xcf\x30\xe7\x67\x92\x1c\x2c\xd6\xc9\x67\x66\xc8\x78\x90\xb8\x2b\xa8\x3b\x87\xa3\x9e\x0c\x23\x1e\xa0\x9a\xaf\x77\x16\x7c\xb7\x4e\xd9\xb9\xe7\x22\x92\x64\x11\x7e\xa5\x72\x91\x93\x5d\x54\x92\x44\x07\xdc\x4c\x46\xae\x91\x62\x76\x20\x79\xca\x4d\x87\x9d\x8c\x76\x98\xc6\x08\x89\x07\x04\x43\xd1\x2f\x44\x47\x59\x1c\x50\x56\xd6\x63\x06\x47\x52\xf1\xdf\x9c\xf0\xd8\x8c\xa5\xc6\x4c\x84\x4c\x96\xf2\xb6\xe3\x5c\x01\x17\x9c\x62\x40\x45\x8a\x4b\xc5\xcd\x14\x02\x0b\x15\x80\xa2\x6c\xe7\xcb\x90\x0b\x4b\x06\x82\x53\x63\x2e\x6f\x13\x7e\xe2\x1e\xa6\x9e\x43\x9c\xcb\x51\x23\xc1\x4c\x90\x9d\x25\x2e\x22\xe7\x2b\xcd\x87\xc6\xca\x85\xc2\x52\xb1\xd0\x40\x51\xc3\x87\x2a\x8d\x6a\xec\xdc\xba\x8a\xb4\x7e\x57\x01\x29\xb8\xb5\x5b\x81\x8d\x6e\x1b\x76\x43\xa9\x0d\x0c\xd9\xb9\x54\xb4\x06\xb5\x37\x46\x3f\x0e\xb8\x18\xed\xd9\x8e\x74\x8e\x61\x37\x40\x96\xcf\xb2\xd7\x84\x17\x53\xf0\x71\xc8\xe2\xc0\xe4\x44\xf1\x32\x11\xf1\xd3\x3a\x24\xf7\xa3\xe8\xe7\xc7\x2c\x92\x20\x48\xed\x8d\x12\x5e\x77\xba\x37\xc1\x12\x4f\x97\x3f\xd2\x19\x53\x98\x18\xbb\xed\xbb\x4f\xdb\xd1\xbc\xc8\x36\x01\x1a\xc7\xf3\x40\xa3\x12\x6d\x6f\x99\x68\x7b\x35\xc7\x32\x8b\x2b\x50\x86\x53\xae\xe5\xfb\x52\x46\xda\xde\x56\xb3\xe7\x2f\x5e\x14\x37\x28\xf2\x5b\x83\x5e\xbf\xdc\x28\xf6\xc8\x8d\x7b\x99\x74\xd2\x67\x82\x07\x92\xfd\x46\xbd\x44\xd1\x9a\x8c\x99\x69\x70\xdd\x30\x63\x6c\x58\x2a\x37\x92\x68\x77\x0d\x39\x6c\x38\x3d\x63\xaa\x0a\x4c\x1e\xef\x08\x9f\xc2\x93\xc4\x86\x07\xfc\x37\xe2\xc6\x1a\x5c\xb8\x01\x56\xce\x2d\xe0\x21\xe2\x92\x78\x52\x18\xc6\x05\x2a\xe2\xb6\x69\xc1\x15\x78\xee\x2c\xc7\x7f\x7c\xf7\xa9\xf6\xfe\xdd\xc9\xa7\x5a\x7d\xee\xd3\xa7\x5a\x7d\xce\x29\xe7\xef\x2d\xa7\x73\x9f\x7a\x8a\xcf\x9f\x53\xb6\xff\xcb\x17\xf8\xfc\x39\x92\xfe\x5d\x84\x24\x59\x4d\xd4\x87\x57\x55\x1c\x6f\x8c\x37\x8c\xf7\xd2\xb1\xc2\x1f\xed\x62\xda\x36\x7d\xc5\x57\xa8\x8f\x48\x4e\xc9\x5b\x56\x49\x74\x4a\xdc\xb3\x54\xbe\x2c\x92\xcf\x77\xaa\x92\xc8\x5f\xf7\x9b\xad\x91\xc8\x18\xf4\x4b\x35\x12\x6b\xc3\xf5\x0a\x95\x57\xa8\xbc\x42\xe5\x2b\x51\xf9\x95\x2d\xbe\x0e\xd7\x85\xcc\x4b\xef\x24\xda\xef\xfb\xed\x3b\x00\x5b\x9b\x00\xa5\x36\x14\x28\x25\x51\xa2\x16\xe7\x79\xc8\x31\xf0\xdf\x2d\x49\x4d\x1e\xc7\x2c\x51\x8b\x04\x4c\x9b\x75\xb4\x15\xb4\x56\xcb\x2a\x9f\x69\xaa\xca\x75\x11\xab\xf8\x87\xe5\x37\xf3\xe5\xda\xab\xc5\x49\x4d\x07\x6b\x39\x77\xc7\xf2\x21\x8a\x12\xa5\x0d\xe4\xb9\xec\xc2\x7d\x9c\xfe\xb3\xf0\xe6\x67\x9d\x06\x15\x2e\xfa\xcf\x28\x34\x77\xd4\x2e\x6b\x6c\xe1\x5b\x19\x15\x53\x9d\x4d\xca\xdd\x3d\xb9\xa4\xc7\x35\x99\x40\xd6\xf2\x37\x4c\x19\xe7\x59\xd4\x4b\x2f\x54\x55\x93\x8a\x5b\xd0\x9d\x5e\xc2\x2c\x36\x72\x41\xf4\x33\x96\x93\xec\xb6\x7d\x63\xd9\xcc\x32\x41\x4d\x31\xe3\x5b\xa6\xce\x50\x5d\x2a\xf7\x89\x82\x78\xc4\xc5\x2f\xa8\x74\xd2\x83\xa7\xcd\x83\xe6\x41\xed\x66\x68\xb9\xd3\x6e\xc3\xef\x81\x9d\x8f\x60\x57\xc7\x21\xec\xe6\x82\x7a\x2e\x68\xa9\x2c\xd7\x6f\x91\x31\xf7\x03\xfc\x54\xb3\x37\x71\xa2\x63\x4a\x71\x2a\x69\xb0\xde\x25\x5f\x53\xdd\xca\x7c\x6c\x8b\x3d\x18\x4c\x9d\x16\xea\xfa\x21\x40\xaf\x13\xe5\xf3\xfd\x47\x88\x17\x70\x1b\x5c\x13\x40\xdf\x18\x4a\xfe\x68\xef\xca\x65\x58\x32\x85\x6a\x2c\x1e\x6d\xbf\x76\x69\x81\x06\x05\x56\x36\x9b\x18\xb2\x2d\xa7\xa8\x7d\xc5\xbb\xe8\x36\xc5\xed\xf6\xe3\x9f\x14\xf3\xb9\x93\x31\xb7\x6f\x2a\x8b\x2f\x63\x2b\x3a\x65\x7c\x45\xf1\xdb\x6a\xbe\xe2\x72\x49\xfc\x7e\xbb\x0a\x05\x9e\xa6\x54\xa2\xf8\xcd\xe4\x1f\x2b\x51\xfc\x57\x2e\x8a\x77\xcc\x05\xf1\x15\x70\x7f\x8c\x05\xdc\x6b\xfc\xac\x97\xef\x3f\x3e\x83\xcf\x9f\xbd\x28\xae\xa4\xf3\x00\x0f\x27\x9d\x3f\xaf\x44\xf3\x6e\x58\x95\x68\x7e\x2e\xa5\x12\xcd\xbb\xcf\x77\x21\x9a\x57\x18\xa1\x23\x46\xf1\x6c\xae\x95\x8a\xef\x41\x0e\x61\xe1\x18\xdf\x2c\x99\x7e\x2f\xf9\x59\x90\xe9\x1f\x1f\xac\x2d\xd2\x5f\xf9\x68\xfb\x79\x2a\xa3\xa7\x47\xd9\xaf\xba\x07\x27\x07\xdd\xe2\x2e\xfd\xe8\x06\x58\xfb\xdd\x8b\xe7\x07\xfb\xcf\x8f\xb7\xf6\xcd\x36\x29\x16\xde\xba\x38\xc2\x6b\x28\x2a\x92\x9c\xcb\x95\x15\x47\xbd\x44\x59\xe1\x72\xde\x22\x46\x7c\x7a\x17\x30\x70\xfd\xd7\x1d\xc7\x4b\x67\x78\x86\x03\x4b\x20\xe0\x66\x3f\x33\xb6\xe9\x7f\xb5\xec\x72\x85\x0f\x2f\xc1\x87\xdd\x1b\xe1\xc3\x92\xcd\x00\xd7\x82\x88\xce\x19\x31\x8b\x7d\xee\xe2\xf8\xcc\x7b\x56\x76\xf1\xc0\x1b\xb9\x50\xe6\x0d\x99\xc0\xaa\x04\x87\x55\x61\x7c\xe0\x9e\xe1\xd5\xee\xae\x03\x54\x6e\x6e\xfa\x6f\x31\x3c\xb5\x2b\xb6\x3f\x98\x1a\x2c\x09\xe4\x03\x0d\x28\x64\xff\x41\x21\xae\x99\xfb\x25\xf3\xc6\xe8\xaf\x99\xf9\x45\x3c\x1c\xa2\xd2\xcb\x72\xef\x41\x0b\xae\xd4\xf3\xbd\xc4\xfa\xea\x5e\x45\xc6\x5f\xf1\xf3\xf0\xa3\xde\xa6\xa0\xba\x84\x29\x20\x96\x68\xcb\x60\x5d\xf5\x42\x3c\xc9\x96\xe3\x17\x17\xf1\xcd\x5d\x63\xc2\x5a\xa9\xd1\xe6\x5d\xc6\x25\xfe\xea\x1e\x91\xd7\xd7\xc2\x41\xdb\x07\x5d\x3e\x4c\x58\xb4\x0e\x70\xa1\x7c\xcb\x61\xcb\x61\x0a\x5b\x6c\xbe\x4f\xe2\x93\xf8\xbf\x62\x6d\x2c\xb7\xe3\x8c\x5c\x28\x58\xef\xd0\x99\x1d\x39\xa8\x24\x15\x44\xb1\x8a\xa4\xce\x45\xce\x1d\xd0\x8d\xca\xc5\x88\x62\xf8\xba\x5e\xf9\x5c\x9f\xd5\x93\x58\xb4\x78\x8e\xc2\xc4\xe4\x8d\x8a\xcc\x7f\x04\x3a\x77\x54\x54\x71\x1c\x41\xa4\xf8\x39\x33\x14\xab\x97\x09\x29\xa6\xa1\x8c\x35\x44\xe4\x2d\x2a\xd6\x49\xe4\xe1\xd4\x04\x87\xcc\x7d\x34\xba\xe4\x3a\xc4\x82\x0c\xb3\x9c\x09\xd2\x80\xdc\x3f\xba\xec\x43\x6e\x09\x21\x92\x7e\x78\x4c\x08\x69\x60\x80\xa0\x79\x18\x05\x53\xfb\xd9\x63\xca\xcf\xdc\x62\x29\x64\x3e\x70\x01\x01\x33\x2e\xdc\xad\x36\x14\x8d\x75\x8c\xd3\x24\x40\xae\x74\xd6\x52\x0a\x87\xb1\x1d\x88\x27\x23\x9e\x94\x66\x2e\x46\xab\x36\x52\xb1\x11\xd6\x29\x50\xae\x8b\xb3\xeb\xb1\x20\x40\xdf\x05\x9d\xb5\xf3\xc0\x14\xb2\x8d\x7a\xad\x73\x23\x68\xf8\xf4\x92\x98\xad\xdd\x2a\x66\x6b\x9a\xb2\xe1\x20\xaf\x72\x35\xf5\x70\xc0\xc8\x1e\xbc\x57\x41\x46\x36\xff\x2a\x68\x64\xf1\xcb\x95\xea\x7f\x08\xf8\x62\x3b\xf5\x55\xfb\xb8\x3a\xdc\x18\x10\x43\x0c\x42\x05\x61\x2a\x08\x73\xfb\x10\xa6\xf2\x82\x75\xa7\x4f\x4e\x0a\xc2\xd5\x8d\xd0\x50\xa5\x56\x63\x45\x0d\x55\x96\xef\xaa\x1a\xaa\xad\x42\x66\x9b\xc4\xda\xf7\x6e\xc2\xda\x1f\x5e\xe2\xd3\xab\x53\x20\xf1\x9d\xf2\xf6\xcb\x14\x38\x15\x73\x5f\x31\xf7\x59\x86\x0d\x61\xee\xf9\xcc\x92\xcc\xe7\xfa\xac\xcf\x65\x3f\xe1\x9d\x4b\x3c\x2a\x7d\x4b\x2c\xf8\xb7\x75\x1f\xcf\xb9\x87\xff\xf1\xdf\xdf\xfe\xaf\xdd\xd0\xff\x94\xfc\xe3\xff\xe1\x9b\x7f\xfa\x61\x63\xef\xdb\x2f\x7f\xeb\x85\xbf\xde\x2b\x77\x7e\xc2\xf5\x19\xbc\x6e\xbd\x83\x8f\x86\x07\x5c\x93\x42\xed\x19\xcc\xd8\x75\xf8\xdb\xe7\xcf\xae\xcf\x5f\xbe\xfc\x7a\x2b\x9e\x8f\xb6\x8a\x77\x6f\x37\x8f\x37\x85\x79\x2f\x9b\xa9\x7b\xe5\xe3\x8b\xde\x5e\x2a\x3e\xbe\x64\x88\xd7\xb5\x40\xa3\xd7\x44\xb7\xcb\xae\xdf\xb3\xcf\xda\xaf\xcf\x0c\xad\xfe\xf8\xb8\xda\x9f\x91\xf9\x1a\xfe\x00\x7f\x56\xdc\xa0\x86\xef\x80\xc4\x49\xee\xfd\x76\xaa\x4a\x70\xf1\x1e\x5e\x0f\xc9\x12\xaa\x0d\x5c\x46\x14\x00\x43\x24\x1a\x00\x12\xfb\xff\xc8\x47\x63\x8a\x94\xf1\x78\x44\xe0\x47\x97\x88\xc0\x0f\xee\xd1\x0c\xaa\x62\x93\x67\x09\x15\x9b\xbc\xd9\x6c\xb2\x8e\x43\x18\x4c\x77\xe9\x54\xac\x03\x8a\xf3\xbd\xfc\xe3\x4e\xe2\x9c\x95\x3d\x75\xfa\x9e\x0c\xa3\x00\x0d\xfa\xcb\x7c\x91\x12\x73\x0c\x7f\x80\x42\xe9\x09\x9d\x55\x6b\x16\xbf\x57\xe6\xfa\xf5\xbb\xf7\x7a\x3d\xd1\xf7\xc9\xe3\x64\x9f\x8f\x7a\x1b\xf3\x2c\xc3\xb1\xcf\xef\xde\xeb\x8a\x67\xde\x7a\x9e\xd9\xb2\x1c\x15\xb3\x7c\x83\x7e\x6e\x2c\xb3\x3c\x5f\x75\x06\x79\x29\x4a\xda\x84\x6c\xd0\xd1\x87\xdd\x50\xef\x3d\x83\x73\x9f\xd1\xe3\x84\xa7\x9d\x03\xff\x78\x7f\x6b\x1f\x27\x9c\xbc\xfe\xf0\x5f\x90\x88\x8c\xc0\xb2\xdf\x2d\xc7\x7c\xc3\x1b\x66\x50\x78\xd3\x32\xde\xfb\x67\xf4\x64\x18\x5a\xf6\xc0\x87\x08\x15\x2d\x2b\xe1\x21\xd0\x66\xb3\x0c\xf8\x1f\xa1\xd3\x0e\xb5\x65\x56\xd8\xf9\x28\xf9\x2c\x87\x64\x9c\xf2\xfc\x7c\x04\x44\x57\x8d\x5e\xcb\xb6\x57\xa7\xe6\x92\x28\x6e\x69\x43\x6b\x95\xb1\x2d\x7d\x0f\xbd\x76\xa8\xeb\xa0\xc7\x32\x0e\x7c\x8a\xf5\x86\x17\x1e\x19\xfd\x8c\xb9\x76\xd5\xdc\x26\x06\xb8\xf1\x0b\x89\x1b\xc9\xca\x8f\x0e\x97\x2e\xb3\x95\x2f\x24\x7a\x95\x79\x4c\x9a\xb2\xe1\xd0\xe0\x66\x6f\x20\x92\x3d\x7b\x0b\x8f\x20\xce\x58\xc8\x05\x53\x5c\xd2\x13\x08\xcb\xdb\x32\x31\x75\xaf\xe5\x27\x63\x66\x74\x83\x89\x06\xf3\x3c\x8c\x68\x2b\x35\xb8\x6c\x04\xae\xed\xe2\xe3\x87\x4b\xae\xa4\x3b\xe8\x7a\x20\xe3\x89\x5d\x8b\x3c\xd6\x25\xef\x37\xec\xdd\x9d\xf6\x95\x1e\xc9\x27\xb6\x74\x8d\xdc\x41\x56\xe6\x00\x60\xe9\x95\x55\xe1\xb4\x5b\xb2\x55\x2a\x83\x55\x2b\x34\x1a\x05\x60\xd5\x7a\x20\x58\x76\x49\x78\xf6\xeb\x29\x44\xe6\x6e\xe0\x67\x9b\xa8\x0d\x99\x30\x25\xb8\x18\xdd\xb3\x32\xa4\xbd\x18\x1b\x65\x15\x9c\xbb\xe4\xec\x79\x78\x7c\xda\x6e\xb6\x37\xc6\x38\x6b\x71\xe1\x11\xa3\x5b\x99\x6a\x6d\x29\x5c\x5d\xe1\x7b\x0a\x0a\x60\x70\xbb\xb1\xec\x57\x67\xa6\x55\x41\xd9\x2c\x61\x1e\xca\x26\x10\x31\x83\xb2\x15\x86\xdd\x40\x0c\x7b\xbc\x3c\x6a\xf8\x4a\x0c\xdb\xa9\x1c\xbd\x65\x29\x15\x88\xdd\x28\x10\x6b\x8f\xb8\x81\x64\xca\xbf\x0b\x18\xba\x48\x86\x0a\x32\xcf\x92\x2b\xc8\x5c\xd0\x63\xde\x00\x31\x5f\x45\x0d\xba\x61\x80\x39\x7f\xdb\x57\x78\xb9\xc2\xcb\xe9\x88\x96\x4f\xe4\xed\xe0\xe5\xfc\xba\xab\xe0\x72\x05\x97\x2b\xb8\x7c\x49\x37\x97\x6e\xc8\x0a\x2e\xcf\xd2\xb6\xee\x05\xd1\x03\x23\xca\x6b\x6a\x45\x3b\x9d\x4e\x85\x28\x93\x94\xed\x44\x94\x15\xac\xb8\x8f\x87\x45\x04\x2b\xe8\x45\xff\x65\x96\x91\xc5\x92\x13\xc5\x8d\x41\xb1\x46\xe1\x7b\x7f\x73\x74\xaa\x64\x3c\x1a\x47\xb1\xb9\x06\x5c\xb8\x7b\x1f\xd6\x15\x5c\xb8\xa5\x11\x6d\x16\x5c\x38\x1d\xa7\xab\xae\xc2\x09\x8f\x1e\x27\xd0\xa1\x57\x61\x85\xeb\x77\xf3\x01\xb0\xc2\x93\x42\x73\xf3\xfb\x77\xb3\xfc\x1f\xa4\x5c\x6f\xc1\xff\xc1\xd3\x47\xe3\xff\xa0\xbd\x0a\xbd\xfc\x79\x8c\x14\x88\x92\x09\x20\x78\x02\xd2\x23\x66\xdb\x87\xc9\x98\x07\x08\x23\x34\xc6\xb9\x4a\x63\x86\x6b\xc3\x3d\x4d\x0e\xe5\xcc\x18\x61\xc4\xcf\x51\x80\xbb\xe8\x9b\x9f\xc4\xf7\xf0\x3c\x51\xd5\x7d\x0f\x6d\x78\x4b\xa1\x3b\x29\x0a\xa4\xc9\x82\x80\x6a\x19\x52\x38\xcb\x41\x80\xa1\x76\x21\x18\x29\x47\x52\xc7\x2d\x22\xa9\xee\x4d\x91\xd4\xf1\x4d\x90\xd4\x25\xae\x18\x0e\x2b\xbc\x94\xa4\x54\xb0\x27\x4f\xc5\xc7\x01\x7b\x08\xb6\x0c\x79\x80\xce\x8d\x77\xdf\x6d\xee\x3e\x6d\xd9\x12\x57\x69\xf7\x89\x58\x7e\xc8\x7a\x05\x27\xd4\x2b\x78\x65\x7b\x95\x87\x2e\x9f\x3f\x0f\xb5\xbd\x95\xe8\xcf\x14\xc4\xc0\xe7\xcf\xa1\x8c\x85\xa1\xc9\xfb\x1a\xc3\xf2\x6c\xcc\xf3\xaf\x25\x33\x58\xc1\x80\xed\x87\x01\xab\x1c\xa1\x2d\x61\xa0\x6f\xe6\x05\xad\xd9\xed\xdd\xbd\x1b\xb4\x9b\x0d\xf0\xf1\x22\x84\xfa\x56\xb2\xd2\x2b\x15\x01\xb9\xb3\x89\x7c\x20\xd3\x85\x4c\x8c\xf1\xeb\xa1\xb3\x4f\x3b\x6a\xef\x38\x17\x0b\x5c\x67\xe6\x6d\x1b\xc5\xf5\x76\x8a\x0f\x5d\xaf\xc4\xf6\x76\x96\x2b\x10\x1c\xa4\x3a\xae\xf8\xde\x24\xa5\xd2\x13\x54\x0c\xf3\xcc\xbb\x70\x91\x65\xd6\xfc\xb7\x4b\xe3\xa8\xe4\xb2\x93\x1b\xf9\x4b\x9d\x0b\xaf\x59\x7f\xe2\x5b\xf8\x81\xd8\xf2\x0f\x76\x4e\xc8\xb7\x6d\xc5\x94\x13\x77\xbd\xca\x2b\xc3\x26\x72\xe5\xb3\x09\xac\x78\xf2\xc7\xcd\x93\x57\xce\x89\x2b\xc6\x7b\x33\x19\x6f\x0a\x24\x62\xef\x3c\x3d\xcf\x7d\xf7\x88\xfb\xde\x5c\xe6\xbb\x73\x13\xe6\xbb\xbb\x3c\xea\xa3\x13\x3a\xdf\xa3\xfb\xdf\x8a\xf9\xce\xfe\xa9\x98\xef\xcd\x66\xbe\x8b\xac\x31\xfd\xd9\x1f\x2a\xc4\x12\xd6\x7b\x91\x93\xa6\x3f\x17\x73\x3e\x10\xf7\xfc\x9a\x0e\xbd\x5b\xe4\x9c\x1f\xa9\x3b\xe0\xcd\x89\x47\xb8\x30\x79\x15\xeb\x5c\xb1\xce\x15\xeb\xbc\x46\x37\x1f\x80\x75\x7e\x52\x68\xae\x6c\x1b\x6f\x96\x6d\x4b\x6a\x88\x50\xb0\x6d\xe9\xb4\x33\x49\xec\xb6\x1b\xb7\xac\x04\x06\xcf\x1d\x14\x48\xbf\x4a\xe5\xbc\x1a\x53\xc8\x43\x6d\x54\xec\x99\x58\xe1\x7c\xc0\xc2\x91\x62\x21\x18\x09\x23\x34\xc0\x60\xcc\x84\xef\x82\x12\xba\xf0\x84\x14\x1e\x11\x42\xa9\x0d\x4c\x30\x08\xe0\x4c\xc8\x09\x0c\x90\x8b\x11\xb4\xeb\x9d\x7a\x97\x6c\x63\xe8\xc1\x27\x53\x3e\x70\x51\x9f\xfd\x90\xb1\xa9\x03\x13\xfe\xec\x0b\x21\x8a\xe6\x27\xf1\x49\x9c\xda\x4a\xd9\x05\x0f\xe3\x10\x44\x1c\x0e\x50\x81\x1c\xba\xbe\xbb\x1e\x68\xf0\x51\x48\x83\xda\xb5\xbf\x90\x55\x46\x28\x28\xbf\x76\x7d\x75\xa1\x20\xdd\x8a\xcc\x5a\x38\x43\x25\x30\x00\x7f\x2a\x58\x68\xaf\xdc\x60\x0a\x2c\x08\xa4\xc7\x6c\xbd\x73\x8d\x4d\xc6\x28\xf0\x1c\x55\x1a\x94\x31\x21\x03\xd7\xa0\xf0\x1f\x31\x6a\x93\x10\x4c\x00\x8b\xa2\x80\x7b\xe4\xc4\x1e\x06\x31\xd9\xfa\x64\xed\x48\xd4\xf4\x08\x9f\x62\x46\x1a\x0a\x01\xb9\xd0\x8a\x0b\xd7\xc8\x14\x82\xc2\x00\x59\x32\x13\xb6\x96\x5c\xcd\x14\x40\x32\xad\x56\xa1\x37\xf5\x02\x47\x89\x62\x8d\xdc\xc5\x80\xb4\xf9\xb9\x86\x70\x66\x82\x24\xed\x60\xe8\x0d\x8a\xad\xda\x05\xdf\x9c\x51\x2f\xa5\x82\x5f\xe8\x1f\x0f\x02\xe0\xc2\x53\xb6\x5f\x14\x11\x13\xcc\x58\xc6\xa3\x31\x55\x32\x2b\x9e\xe0\x9e\x60\x9a\x43\x9f\x69\x1d\x21\x9b\xc2\x00\x21\x90\x13\x9a\x86\xef\xbf\x01\x8f\x19\x68\x45\x4a\x7a\x2d\x3d\xd5\xad\xa1\x6e\xd9\xfc\x0d\xa1\x6c\xb2\x5d\xc3\x9d\xfd\xc3\xa7\xf6\xbf\xed\x64\x4d\x1f\x1e\xf5\x9e\x76\xf7\x6d\x6a\x83\xd2\x9e\xa5\xb1\x43\xe7\x3b\x3d\x5b\xe3\x1a\x76\xe7\xfb\xb7\x90\x3e\x2b\xaa\xb9\xf0\x10\x06\x52\x9a\x3d\x5b\x7f\x3b\xad\x9c\xa6\x6c\x79\x0b\x36\xaf\xeb\xd7\xb3\x6c\x31\x66\x4b\x70\xae\xa9\xbf\xe5\xd7\xeb\x1c\x65\x68\x5e\x3c\x26\x2c\x79\x66\x2d\xed\xb2\x40\x4b\x18\xca\x58\x50\x6c\xcf\x45\x42\x85\xec\x62\xef\x57\x4b\x0d\xa2\x53\x03\xda\xf0\x9d\x23\xd9\xae\xdb\x86\x76\x0a\xf6\x6e\x11\xd5\x3f\xac\xb3\xf2\x4b\x30\xfd\x41\x65\x48\x96\xa4\x3c\xac\xc7\xf1\xd5\x1c\xd3\xcc\xf6\x75\xb6\x31\x6e\xc1\xd9\xc0\x64\x32\x69\x0a\x34\xcc\x0f\xb9\xb0\x10\xc1\xb9\x1c\x60\xca\x74\x8f\x7b\x95\x1b\x81\x2c\xf1\xee\x45\x09\x1e\x0b\xbc\x38\xb0\xc7\xd7\xeb\x04\xbd\xdb\x81\x74\xc3\xc5\x19\x9c\xf1\x33\x64\xb5\xb4\xc8\x50\x41\x76\x26\x2d\x4b\x4b\x75\x86\x99\x20\x62\xe8\xf7\xb3\xe3\x73\xb5\xc8\x62\xe8\xf7\x93\x93\x78\x63\xb4\x7e\x90\xdb\x12\x45\xad\x5f\x09\x94\x41\xa3\xb8\x57\x0e\x8f\x22\x25\x43\x34\x63\x8c\xf5\x1b\x2e\xec\x84\xd7\x5a\x2c\xe2\xad\x19\xc5\xb5\xbd\x4b\x2e\xa6\xad\x4e\x8b\x60\xe8\xef\x76\x7a\x2f\x76\x0e\x5f\xec\x74\xbb\x96\xa4\x3b\xdd\xee\xce\xfe\xf3\x9d\x6e\x97\x88\x65\x2f\x1d\xdd\x57\xb1\x10\x5c\x8c\x76\x0e\x5f\x50\x87\x76\xf6\x4f\x76\x7a\x2f\x6d\xc6\xee\x81\x25\x5a\xf2\xe3\xf0\x84\x3e\xd9\xbf\x15\x13\x23\xec\x73\x11\xc5\x26\xab\xef\x60\xbf\xdb\x6e\xeb\x2c\x0b\x0a\xbf\x90\xa1\xdb\xee\xf4\x1a\xc7\x8d\xce\xd1\x4e\xb7\xdd\xd9\xdf\xd9\x7f\x7e\xf0\x34\xcb\x6d\x57\x68\x21\x7b\x2e\x8d\x22\x67\xbb\x04\x7b\x66\xb9\xcf\x86\x0d\xdc\xa7\xb6\xed\x59\xef\x64\xa5\x6c\x67\x11\x6c\x19\x8c\xc8\x0a\x64\x11\x87\xb9\xdd\x40\xb4\x5f\x57\x38\xb2\x55\x22\xa1\xe3\x8d\x52\xa6\x2e\xec\x8b\xeb\x49\x84\x4a\xaf\xd0\xca\xd9\x7d\x4a\x89\x8d\x7a\xe6\xf4\x38\x64\x42\x95\x43\xfc\x59\xe9\xad\x15\x9b\xbc\xb3\x50\x8e\x44\x5a\xf0\x86\x87\xdc\xe8\x45\xc7\x7f\xb3\xc8\x51\x3b\x85\xa0\x51\x14\x32\xea\xa3\x66\x23\x24\xc4\x6b\xef\x52\xd4\xba\x6f\xe1\x61\x7f\xe8\xeb\x4f\xe6\x93\xf8\xfe\xa7\x45\xc1\x45\x1e\x35\x36\x6d\xa6\x59\xd1\x90\x5d\x64\x25\xe1\xed\x72\xd9\xc7\x5c\x15\x9b\x04\x01\x9f\xde\x04\x02\x76\x2e\x89\x57\x75\x54\xe9\x75\xd3\x94\xb5\xf4\xba\x65\x9d\xdc\x64\xf4\x58\xea\x14\xcf\x62\x3f\x25\x07\xb1\x36\x74\x6d\xd0\xce\x6d\x72\xd9\xf2\x91\x05\x5c\x8c\x1a\x13\x6e\xc6\x0d\x23\x65\x23\x64\x62\xda\xb0\x3b\xa4\x41\xd2\xc1\x15\x5e\xf0\x66\x9b\xfe\x96\xb0\xe9\xb5\xfa\x77\xa5\x6b\xe7\x7e\xfb\xce\x02\x54\xc6\x76\x5e\x8a\x06\x8b\x22\x25\x99\x37\xa6\x9f\x69\xef\x1b\x01\x1d\x96\x15\xec\xbe\x67\xf3\xd9\xe2\x15\x53\x8a\x82\x0b\x97\xc9\x46\x20\xe0\xdc\x3d\x7b\x29\xf6\x7d\x0c\xc6\xac\x2b\x55\xf2\x1b\x83\xbf\xde\xbb\x95\x02\x85\xd9\xa9\x10\x58\x85\xc0\xb6\xc0\xd5\xc4\xd7\x07\xc0\x9e\x14\x9a\x5b\x2d\x6f\xdf\x08\xb5\x7c\xaa\xd6\x29\xa8\xe5\x8f\x32\xa0\xb0\xed\x5a\xf9\x95\x2e\x27\x4e\xb3\x8b\xe9\x59\x1e\x57\x02\x1f\x92\x97\x09\x84\xef\xe0\xe4\xdd\x9f\x7f\x82\xdd\xf6\x46\x29\xf2\x6e\xe4\x5b\xaf\xd3\x5e\x7a\x8e\x25\x3e\xf4\x2a\xaf\xec\x69\xca\xc3\x9b\xe7\x2e\x2e\xa2\x2a\xec\xf0\x3d\x73\xf7\x4b\x94\x6a\x25\x8c\xf7\xed\x29\xd5\x48\xf9\x23\xd0\x4c\xa4\x3a\xeb\xc7\xd1\x02\x46\xa8\x13\x8f\x3f\x64\x1e\xfe\xc7\x77\x9f\x6a\x81\xbc\x67\x43\xdf\xd7\x69\xeb\x05\x57\x7b\x59\xaf\xbe\x7c\xf9\x15\x3e\x7f\x96\x11\xf9\x9c\x37\xf8\x90\x2a\xb4\x10\x43\xa9\xa6\xfd\xb7\x18\xfe\xa0\x10\xd7\xd0\xa1\xb5\x77\xba\x2f\x76\xba\xed\x7c\xe1\x17\xf1\x70\x88\x4a\x5f\xaf\xf0\x4b\xe6\x8d\xd1\xbf\x99\xf2\xee\x78\x0d\xd5\x5d\xa7\xdd\xb0\x39\xda\x9d\xde\xce\xfe\xf3\x6e\xef\x9e\x74\x77\x2f\xbe\x3a\xdd\xdd\xaa\x87\x90\x8b\x5b\xe7\x81\xa0\xe3\x4f\xee\xec\x80\x6c\xa7\xc2\x07\xbb\x11\x2b\x73\xee\xed\xc7\x8e\x9b\xe4\x9d\xa4\x56\x22\xa5\xba\x63\xff\x24\xd7\x86\x95\x65\x7d\xdd\x06\x54\x59\x7f\x7c\xb8\x2b\x3d\x9d\x4e\x94\x8c\x96\xaa\xf4\xdc\x53\xc9\xb6\x53\xea\x31\x85\x2e\x37\x48\xe1\x0c\x36\x33\x5e\x03\x76\x5f\xff\xd4\x7a\xf7\xf1\x74\x8f\x6c\x9a\xb9\xb3\xf4\x95\x67\x1b\xa5\x77\x3b\x2a\x41\x6c\x0b\x1e\x4e\x96\x22\xb6\xde\x6a\xc4\x76\x50\xc5\x82\x4e\x53\x52\xc0\xc6\xc5\x46\xbe\xa7\xac\xf0\xda\x23\xc5\x6b\x33\x2f\xe9\x29\x68\x53\xe8\x21\x3f\xc7\xbe\xaf\x64\xb4\xc4\x4f\x7a\xdd\xbd\x82\xcc\x10\x9c\xf3\xb9\x7e\xaf\x38\xee\xa7\x67\xb9\xc7\x98\x1b\x61\xf1\x58\xc1\xb5\x6d\x80\x6b\x97\xb0\x72\x9b\xb1\x05\x09\x3d\x84\xdc\x6c\xf6\x1e\x7c\xf7\xf1\x74\x83\x37\xe1\xa9\xa5\xda\x3a\x1b\xa9\xb1\xd3\x6d\xef\x6e\xd5\x0e\xde\xdb\xd2\x2d\x5c\x59\x4b\xc3\x26\xfa\x83\xcd\x24\x2e\x04\x46\x1c\x58\xa9\xc4\x2d\x95\xb8\x65\x3e\xe9\x26\xca\xef\xdb\x14\xb6\x7c\xc5\x6f\xe7\x6f\x20\x4f\x59\x00\x77\x9b\x25\x4e\x71\x2c\x14\x48\x91\x1c\x42\x65\x51\xd2\x5f\x0f\xd3\x6c\x99\x6c\x65\x59\x54\x84\x05\x49\xcb\x76\x8a\x54\x16\x3e\xae\x25\x53\x39\xa8\x64\x2a\x49\xca\xc3\xcb\x54\x2a\x25\x78\x25\x54\x99\x13\xaa\xa0\x52\xcb\x82\xcf\x3d\x3c\xa0\x73\x42\x95\x24\x92\x0c\x6c\x1c\xa2\xdb\x6c\x50\xb6\xa5\x98\xec\xab\x14\xab\x6c\xf4\x2e\x4c\xc4\x2a\x1b\xbb\x0d\x2b\xc1\xca\xc6\x6d\xe2\x4a\xb0\xb2\x0d\x82\x95\xe4\x40\xab\x24\x2b\x8f\x5a\xb2\xb2\xcc\xca\xa3\x7a\xff\xb0\x6e\x3f\xb7\x4b\xb2\xb2\xa9\x0f\xd0\x5f\xff\xd4\x02\x7b\xea\x9c\x2a\x36\x1c\x72\x0f\x2c\x3b\xb4\x44\xac\x92\x9c\x4f\x26\xc9\xf9\x1d\xb4\x77\x60\xa8\x64\x08\x2c\x71\x65\x26\x87\xd0\x69\xb7\x77\x20\x92\x5a\xf3\x81\x73\xe6\x57\x30\x65\xc9\xde\xae\xbf\x91\x13\x78\xc1\x84\x4f\x20\x17\xa4\xca\xe5\x49\xe4\x33\xcd\x65\x0d\x7f\x0f\xc7\xbd\x1b\xb4\x4c\xaf\xe6\xb3\xa6\xe9\xe5\xbc\xad\x6f\xb7\xdd\x3c\xee\xc1\x05\x74\xf0\x0f\xed\x63\xf8\x2e\xfd\x65\xff\xdf\xfe\x5f\xc7\x76\xb4\xcd\xa3\xa3\x83\xc3\xde\x53\xf8\xd3\x8b\x3a\xfc\x89\x8f\x18\x45\xf5\xd8\x03\x1d\x47\x91\xd4\x5c\x8c\xb2\x10\x9c\xb9\x56\xb9\x86\x0e\xfc\xe9\xc5\xc6\x0b\x96\xd6\xf7\x7d\x7e\xc9\x1b\xf9\x6e\xe5\x27\x2d\x4d\x79\xf8\xc7\x15\x5f\xa3\xad\xce\xea\xdb\x8b\x8e\x6a\x82\x38\xaf\x7f\x6a\x2d\xde\x5d\xc4\x95\xda\xe4\xdf\x1d\x1c\x1c\xe2\xc0\xbb\x1a\x7c\x9d\x55\xfe\xee\xe3\xe9\xca\xda\x7b\xed\xa3\xa7\x07\xdd\x75\x59\xcc\x4a\x36\x96\x97\x8d\xd1\xb1\x7b\x3f\xb0\x7c\xec\xb6\x7d\x39\xfa\xe0\x39\x5a\xdc\xb5\x5c\xad\x64\x08\x95\x60\xad\x12\xac\x65\xff\x6c\x99\x60\xed\xea\x5b\xf8\xf7\xd0\xe8\xdc\xed\x36\xbe\x25\xd1\xdb\x46\xec\xd4\x4a\xf6\xb6\x71\xfb\xfc\xeb\x93\xbd\xdd\xde\x33\xb2\xcb\x0e\xb7\x07\x17\x25\x1e\xf7\xda\xed\xf6\xfe\x51\xe7\x69\xb3\xfb\xb8\x06\xd6\x78\xbc\x23\xdb\xbc\x97\x8c\x4e\xca\x91\x08\x62\x2a\xf9\xef\xe3\x96\xff\xbe\x88\x4a\x18\x95\xbb\x92\xfe\x96\x26\xdd\xc2\x33\xc6\x4b\xbc\xfc\x54\x96\x77\x59\xe9\xad\x95\x0f\xa7\xa7\xd3\x7b\x46\xdb\x91\xa2\xae\x2c\x33\xbe\x9b\x3d\x6b\xfc\x0e\xda\xf9\x9c\x9b\x24\xfd\x3c\xbc\x89\xf4\x73\xbf\xb3\xf4\x78\x70\x56\x75\x95\x6b\x99\x34\xa5\x92\x7e\xce\x25\x6f\x98\xf4\xf3\x5a\x02\xca\xb5\xc5\x9f\xd7\x91\xad\x2e\x3d\xae\x2b\xe9\x67\x5e\xfa\x19\xb9\x73\xf8\x2a\xc2\x93\xea\xc1\xe5\x56\x48\x36\xb6\x54\xb0\xf1\x55\x0a\x30\xaf\xb1\x0b\x37\x52\x84\xb9\xa1\x1b\xb5\x92\x5f\x6e\xdc\x36\xaf\xe4\x97\xb0\xdd\xf2\xcb\x55\xc3\xd9\x58\x61\x58\x82\x3a\x2b\x61\xd8\xe3\x16\x86\x45\x5b\x2b\x0c\xab\xac\x21\x8b\x64\xd9\x74\x69\xd7\x5a\x0f\x4d\xdf\x9e\x7e\x24\x87\xc9\xaf\x05\x78\x32\x8c\x62\x83\x0a\x12\x26\x90\x8b\x51\x7d\x2e\x34\x70\xc2\x14\x6a\xcd\xa5\x80\x58\x70\x03\xbb\x6f\x4f\x3f\xee\x01\x77\x11\x84\x35\xff\x0d\x41\x0e\xe9\xef\xc0\x5e\x8b\xda\x40\xa4\xa4\x91\x9e\x0c\x5c\x78\x64\x57\xe6\xfd\xc9\xc7\xbd\xb9\x48\xad\x9e\x0c\xc3\x58\x70\x17\xac\x95\x0b\x60\xa0\xb9\x18\x05\x98\x76\x04\x02\x36\x45\xe5\x9a\x67\xde\x2c\x78\xef\xdb\xd3\x8f\xa0\x30\xa0\x50\xc3\x46\xd6\x29\x56\x70\xe2\x34\x8c\xfb\x28\x9c\x03\x68\x23\xe7\xc6\x30\x54\x2c\x4c\xba\x9a\xef\x82\x3b\xc8\xa4\xb2\x3d\x20\x8b\x4a\x74\x3d\x0e\xb8\x48\x9a\xaf\x03\x36\x47\x4d\x78\x65\xc6\xa8\x04\x1a\x57\x11\x49\x06\xdf\xd8\xb1\x2a\xea\x0d\xd7\xc0\xb4\x96\x1e\xa7\xa1\x4c\xb8\x19\x83\x42\x3f\xf6\x6c\xad\xe7\xa8\xc6\x14\x47\xf8\x43\xc8\x82\x20\x29\xe0\x4e\x20\xea\x85\xcb\x98\x8d\xd9\xc7\x80\x4d\x9b\x76\x5e\x42\x26\xa6\xe0\x31\x8d\xba\x9e\x36\xe2\x63\x84\xc2\x0e\x11\x68\x26\x7c\x54\xc1\x94\x8b\x51\x56\xd8\x63\x11\x1b\xf0\x80\x1b\x8e\x9a\x7c\xa9\x85\xb1\x36\x14\x14\xd7\xff\x7b\x4c\x91\x96\x43\x26\x62\x8a\xd6\x2c\x15\xb0\xd8\xc8\x90\x99\x24\x7c\xb3\x96\xc0\x2c\x3d\x89\x8e\x78\xe1\x21\xfa\x49\x58\xe4\x7c\xad\x4d\xea\x4a\xc4\x2c\x15\x0c\x2a\x17\x94\x98\x45\x11\x32\x45\x53\x98\x50\xc1\xae\x14\x22\x03\xcb\xcd\x32\x97\x42\xe7\x2c\x47\x73\xd1\xad\x9b\xf0\x41\xda\xe9\xa1\x18\xd3\xae\x4e\xbb\xa0\x7d\x37\xd7\x76\xba\xa4\x10\xe8\x19\x0a\xbb\xdc\x74\xf1\x8b\xdf\x9e\x7e\xd4\x14\xd8\x79\x6a\x3b\x5d\x68\x25\x5d\x7f\x8e\x0a\x73\xeb\x49\xcf\x16\x11\xd7\xa0\x23\xf4\xf8\x90\xbb\x05\x68\x50\x85\xda\x2e\x65\x32\xdf\xb0\x1d\x94\x9e\x41\xa3\xd7\x58\xdd\x99\x65\xac\x5b\xb3\x76\x62\x23\xa6\x35\x48\x31\x61\xca\x5f\x24\x5b\xac\xdd\x34\x5c\x8f\x74\xbb\x3f\xbd\x7e\x59\x07\x0b\xa5\x58\x00\x76\xf9\xd6\x01\x8d\xd7\xdc\x6b\xc2\x87\x84\xa2\x1a\x76\xd3\x25\x5b\x27\x41\x35\x5e\xb0\x30\x0a\x70\x8f\xba\x36\xe4\x17\x73\x5b\x97\x09\xdb\xbf\xff\xa4\x29\x49\xe6\x60\x57\xc7\xde\xd8\xae\x08\x12\xde\x35\x8c\x6c\xd0\x1f\x69\xa3\x24\x4f\xdc\x5b\x6f\xa6\x3e\xce\x16\xea\x6c\x73\xd9\x79\x89\xc6\x53\x4d\x9b\xd5\x4d\xcc\x8c\x28\xfe\x6c\xdf\xa4\x1b\x79\xfe\x4c\xa0\x7a\x8c\x2c\x6c\xe1\x3a\x50\xbc\x67\x05\x0c\x46\xfc\x1c\x45\xd9\xee\xb7\xa3\x85\x10\x7d\x1e\x87\x20\x85\xad\x17\x7d\x5a\xf6\x3a\x1e\x18\xc5\x3c\x37\x8b\x2c\x94\xb1\xdd\x65\xc3\xb9\x7e\x64\x18\xdd\x4d\xb7\xab\xe5\x5b\x6d\x87\xde\x84\x1f\x66\x44\xae\xbb\x09\x9c\x4d\xc0\x92\x93\x88\x6b\xe8\xf4\x3a\x47\x6e\xb9\xd5\x21\xfd\xcb\x36\x3b\x19\x73\x4b\x7e\x85\xb3\x0e\xec\xda\x7f\xa3\x22\xca\xfd\xf0\xf2\xc3\x5e\x1d\x14\xea\x38\x30\x96\xae\x76\xfd\xd0\x1c\x92\xa1\x78\xaf\xdd\x76\x35\x6d\x94\x5e\xe2\x46\x3e\xef\xf7\x8f\x96\x72\x6a\xce\xe7\x7d\xbb\x72\xa1\x98\xa6\x6c\xa7\x62\x62\x35\x1b\x9a\xe1\xa7\xb7\xa7\x1f\x17\x79\xd0\x2b\xc7\xe5\x42\xd1\x9c\xf0\x33\x1e\xa1\xcf\x59\x53\xaa\x51\xcb\xfe\x6a\x25\xf1\x00\xfb\x79\x86\xa7\x6f\x8f\xf7\x2a\x0c\xd7\xe3\xd5\x44\xcc\xc9\x3f\x43\x13\x3b\xdb\xcd\x4d\xf3\xd7\x6f\xf9\xf5\xe5\x9e\xfa\x37\x41\xa4\xb9\xd9\x52\xc9\x2d\x15\x4a\x56\xbe\xf9\x2b\xa1\x64\x3a\x9e\x7b\x10\x4a\x5a\x06\x72\xd7\xbd\xfa\xab\x24\x92\x8f\x5b\x22\xb9\xbd\x8e\xef\xaa\x28\x03\x5f\x8d\xb4\xb2\x01\xe5\xda\xe9\x7f\xc4\x18\x63\x3f\xa0\xfe\xc1\x77\x50\xfe\x9d\xf6\x94\xc5\xc3\x2d\x3d\xd5\x2d\x2f\x60\x5a\xb7\x04\x9a\xd6\x1f\xb9\xe5\x9a\xbe\x7f\x3c\xd8\xf8\xe0\x92\x17\xcb\x05\x56\xa1\xc2\xc6\x5b\x87\x8d\x2b\x94\xf9\xa8\x50\x66\xe9\x79\x55\x21\xce\x0a\x71\x56\x88\x73\xae\xf6\x87\x87\x68\x9d\x76\x7b\xf3\xbc\x68\xcd\x02\xc2\x45\x78\xdd\x58\xe2\x15\x50\xdb\x16\xa0\xe6\xa3\x17\x12\x24\xaf\xd0\x5a\xd9\x30\xb6\x15\xad\x3d\x29\x34\xb7\xb0\xcf\x6b\xb3\x94\x0d\x88\x32\x9e\xc5\x9c\x2e\x84\x19\x3f\xfe\x3a\xc2\x8c\x97\xbe\x03\xcb\xbb\x5f\x87\x31\xb2\xc0\x8c\x2d\xdc\xfc\xe9\xf4\x3d\x19\x84\x0c\x87\xe8\x19\xf4\x13\x25\xf8\xf3\xd1\x48\xe1\x88\x19\xb4\xe9\x27\x69\x76\x2e\xbc\x20\xf6\xb9\x18\x81\x36\x8a\x99\x38\xac\x43\x80\x2c\x82\x61\xc0\x46\x75\xd0\x4c\x20\x0c\xed\x7d\x29\x50\xeb\x3a\x28\x29\x0d\xf8\x5c\x1b\x26\x3c\x84\x01\xda\x72\x01\x6a\x0d\x66\xcc\x04\xd8\x55\x80\x96\xbd\x6b\x0a\x13\x35\x43\x76\xd1\xc8\xb2\x32\xe1\x83\xc7\x62\xcd\x02\x6e\xa6\x70\xce\x65\xe0\xec\x0c\x56\x57\x11\x48\x8f\x05\x0d\x39\x1c\x6a\x34\x0d\x23\x03\x54\xb6\x32\xf7\x2a\xae\xa4\x32\xae\x21\x90\x13\x54\x30\x90\xb1\xf0\x01\xb5\xe1\xa1\x1d\xaf\x1c\x82\x17\x48\xef\x0c\x88\x4f\x06\x5f\x0a\x84\x98\xdc\x89\x7d\xf8\xe9\xf4\x7d\x1d\xb8\xf9\x56\xcf\x54\xeb\xbe\xb3\x39\xd0\xdc\xf0\x73\x24\xdb\x06\x5b\xb7\x1c\x02\x1b\xe8\xc4\x56\xdd\x44\x7d\xd7\xa9\x3d\x48\x05\x04\x26\xea\x2b\x63\xa0\x05\xdd\x8d\x82\xf5\x45\x04\x7f\xb5\x30\xef\x97\x3c\xc5\x3b\xaa\x1c\xdc\x27\x29\x9b\xac\xb8\x26\x9b\x1f\x9d\xd7\x3d\x8f\xb8\x19\xc7\x83\xa6\x27\xc3\xd6\x0c\x17\xb5\x68\x1d\xe3\x05\x19\xb2\xa8\xd6\x20\x90\x83\x56\xc8\xb4\xfd\xdb\x97\x9e\x6e\x9d\xbe\x7e\xfb\xaa\x19\xfa\x25\xaa\xef\xec\xda\xb0\xc7\x8e\x18\xca\x5b\xd0\x8e\xdf\xa8\x87\x57\xe2\x0e\xb2\xde\xab\xa1\xd7\x3b\x6e\x97\x44\xa9\xbd\x72\xe7\x2d\x07\xac\x9b\x1c\xcd\x90\x14\xfb\x63\x13\x06\xad\xb4\xf6\x35\x6f\xfd\x4a\xc0\x72\x33\x01\xcb\xbc\xd0\xc3\x44\x7d\xcd\x04\x37\xd3\x05\x09\xc7\xbd\xca\x33\xec\xfe\xf8\x40\xfd\x58\xf9\x16\x28\xf7\x1e\x64\x5d\x64\xb7\x71\x88\x76\x3b\x9c\x42\x67\xf3\x51\x21\xd8\xc7\x8d\x60\xef\x22\xa4\x79\xf7\x16\x50\xdf\x25\x77\x53\xf5\xf2\xa1\x48\x96\x4d\x87\x70\x2b\xfd\x7c\x34\xe0\x03\x99\x36\x13\x3e\x7b\x63\x41\xd6\x07\xf4\xa4\xf0\x29\x8c\x56\x1d\x26\xe4\x3d\xb9\x0d\xff\xfe\xd7\xff\x86\x77\xff\x55\x87\x0e\xfd\xc5\x7c\xdf\x01\x32\x4d\x79\x81\x19\xf8\x78\xfa\x12\x42\xee\x0b\xcb\xac\xd6\xa1\x4b\xd9\x7c\x0c\xd0\xe0\xea\x9c\xfb\x94\x33\x16\x7a\x2a\xbc\xb1\x92\x82\x6b\xf4\x1f\x11\x58\xb9\x24\x1a\x57\xf7\xb0\x02\x2b\x2e\x65\x93\xc1\x8a\x13\x47\xe0\x2f\x4b\x76\x32\x54\x80\xe6\x52\x71\x27\xd3\xe3\x81\x64\xca\xbf\xdc\xda\xd8\x9e\x41\x7d\x77\x5a\xac\xa0\x42\xee\xa4\xba\x33\xbb\xe7\x7c\x4f\x2a\x88\xf4\x30\x10\xc9\xde\x1d\x0f\x0f\x90\xf2\xf7\x62\x05\x93\xe6\x52\x1e\x10\x26\xe5\x67\xa5\x02\x4b\xdb\x0f\x96\x2e\xf1\x2a\x78\x8f\x9a\xbe\x0a\x2d\x7d\xbd\x68\xe9\x12\x85\xd7\xe9\x18\x53\xa5\x14\xa9\xb3\x20\x44\xa6\x63\x85\xf4\xc0\x32\x03\x31\xbf\x39\xdd\x4f\xaa\x69\x6a\xc2\xbb\x28\x92\x1a\xe9\xb9\xe4\xdf\xb9\x31\xa8\x40\x2a\xf7\xa8\xd9\xbd\x38\x9d\xab\x51\x2a\xfb\x85\x19\xee\xa5\xb5\x37\xe1\x05\xd3\xc9\x8b\xe4\x5d\x26\x7c\x17\x05\xc7\x96\x8c\x50\xe9\x08\x3d\x52\x0b\x25\xa1\x71\xbc\x80\xa3\x30\x7b\xc0\x4d\xfa\x16\x5d\xc4\xe1\xc0\x36\x39\x04\x8d\xea\x1c\x15\xbd\xec\x64\xa0\x70\x88\x0a\x85\x87\x4e\x13\xd5\x84\x0f\x25\x5f\x81\x1b\x8d\xc1\x30\x79\x92\xab\x2d\x82\x4b\x7b\x4b\xf8\x90\x07\x14\x63\xc7\x66\xd6\xa8\x4d\xd6\x02\x53\x98\xcf\xdb\x69\xc2\x3b\x31\xf7\x64\x35\x0b\xcc\x23\x24\x9c\xb3\x80\xfb\x04\x41\x43\xd4\x9a\x8d\xd0\x3d\x15\xcd\x1a\x22\xed\xda\xf3\xa4\xee\x1c\x99\x1d\x45\x59\x96\x51\xa4\x59\x26\x3c\x08\x60\x80\xa0\x62\x21\xb8\x18\xe5\x7b\x22\xe0\x0f\xb6\x37\x76\x22\xe3\x28\x42\x05\x01\x0f\xb9\x71\xf3\x37\x9b\x86\x4e\xcf\x65\x89\x62\x65\x67\x8e\x68\x37\x4b\xb5\x8d\x9e\x4b\xee\x2f\xcc\x78\x20\x65\xa4\x61\x30\x85\x88\xc8\xa8\x48\x87\x99\x50\x24\x79\xbe\xec\xb4\x81\x49\x65\x34\x30\x92\xf9\x25\x95\x07\x76\x5b\x69\x88\x23\xdb\x46\xa7\x47\x64\x74\xd4\x49\x73\xd0\xc6\xd3\xf4\x16\x3e\xb5\x7d\x39\xe5\x21\xc2\xfb\xf4\xf9\xf5\xee\x4f\xa7\xef\xf7\xea\x69\xfe\x46\xe7\xa9\xed\x71\x9c\xac\x3e\x7b\x3b\x79\xd9\x5b\x5d\x96\xfa\xb2\xb7\x37\x43\xea\x2d\x20\x4f\xde\x47\x04\xc5\x8f\x57\x43\xf1\xe3\x0a\x8a\x27\x29\x8f\x0f\x8a\x33\xcf\x43\xad\x9b\x0a\xfd\x31\x33\x84\x77\x7d\xe9\xc5\xa1\x85\x5f\x76\xd7\xb6\x50\x34\x62\xdd\x52\xe8\xf7\xc7\xcc\xf4\xd1\x82\x84\x48\x71\x8d\xfd\x80\x8b\xf8\xa2\xf5\xd4\xa9\xcb\x7c\x8c\x02\x39\xb5\xa5\xfa\xa3\x98\xfb\xd8\xd2\x9d\x06\xa9\x71\xec\x46\x63\x97\x40\xf6\x64\x37\xde\xbe\x26\x8f\xfb\x2d\x5f\xb1\xa1\x69\xd8\x2f\xb6\x3f\x8d\x81\x17\x35\xda\xbd\xa6\xed\x73\x85\x5b\x1f\x4a\xb5\xe7\xa6\xfb\xe1\xa1\x6b\xb2\xee\xbe\x6a\xd4\xda\xe9\x6d\x12\x6c\x2d\x3b\x09\x2a\xc4\xba\x95\x88\xf5\xbe\xd5\x7b\x9d\xa7\x5b\xa1\xdf\x7b\x84\xc6\xa7\xa7\xef\x37\xcc\xf0\x34\xb5\x82\x2b\x18\x9e\x76\x3a\x59\xc9\x6d\xb7\x3c\x5d\x38\x45\x0b\x58\xfc\xa3\x45\x34\xa7\x3f\xfe\xfc\xea\xf9\xc9\x87\xf9\xa8\xb0\xec\x9c\xf1\x80\x51\x38\x58\x17\x1f\x96\x50\x56\x9a\xf5\x34\xbd\xbb\x9e\x7d\x12\xf0\x7d\x66\xc0\x0a\xdf\xc3\x51\x6f\x07\xf2\xb5\xd6\xd6\x43\x31\x77\x84\x57\xba\xab\xf1\x4a\xa7\xf3\xf4\x41\x01\xcb\x57\x03\x48\xd6\xe0\x94\x2b\x0e\x79\x6d\x0e\xd9\x19\x2b\x47\x4a\x5a\x44\x86\xba\x6f\x39\x49\xe6\x2f\x3a\x96\xf9\x02\x2d\x28\x64\x0d\xd9\xc5\xf2\xec\x7b\xf0\x7b\xbb\xd5\xef\x93\xbd\x3e\x75\x7d\xa1\x23\xe3\xab\xe6\xaf\x8f\x36\x86\xbf\x7e\x9f\x2e\x96\x67\x90\x9f\x9d\x35\x79\xed\xfb\x61\xa4\xbf\x1a\x46\x39\xe7\xe7\xd7\x9d\x88\xcb\xd9\xe1\x52\x1d\xc7\xc6\x3c\xd6\xba\x9c\x25\xbe\xb3\x61\x5c\xcd\x69\xf1\x03\xf0\xcc\xf5\xad\xe4\x2d\x57\x2a\x79\xde\xff\xfc\xee\xe5\xab\x0f\x1f\xe0\xc3\xe9\xf3\xd3\x57\xf0\xf2\xdd\xc9\xab\x0f\xe9\xf3\xa6\x9f\x21\xd3\x28\x48\x45\x7f\x12\x9b\xb9\x2b\x85\xfd\x01\xf4\x70\x7d\x2f\xc9\x7b\x02\x10\x0b\xba\x56\x54\x1c\x19\x6e\xf3\xe9\x00\x31\x82\xdd\xd4\xa1\xe7\xeb\x77\x69\xde\x0f\x00\xa5\x39\x27\x8c\x93\x23\x4b\xf2\xde\x29\x00\xcf\x51\x98\xc4\xaf\x6b\x14\xa0\x49\xda\x82\x06\xfc\x15\xc0\xc7\x61\x2c\x3c\xd3\xfa\x4d\x86\x03\x8e\x75\x72\xdb\xca\x05\xbd\x1d\x1a\xc4\x86\x24\xfb\x0a\x59\x64\x7f\x4e\x81\x1b\x0d\x0e\xcd\xa7\x35\x9c\x02\x68\x23\xed\x65\x5f\x07\xe4\x66\x8c\xca\xe6\x63\xf0\x77\x39\x00\x4f\x0a\xa3\x64\x00\x9a\x8f\x04\x0b\xec\xd0\x07\xe8\xb1\x58\x63\xa2\x63\x72\x0f\xa6\x8c\x62\x1e\xfa\xee\x5d\xd7\x0f\x5c\xf8\xf4\xbe\xea\x8c\x07\x01\xfc\x95\xba\x44\x44\x6c\xc0\x37\x10\x69\x60\xf1\x05\xfc\x13\xd8\xe4\x0c\x3e\x7f\x1b\x29\x2e\x0c\x7c\x73\xf4\xed\x97\x7f\x8e\x14\x46\xd0\xf0\xe0\xaf\xa5\x59\xbf\xfd\x0c\x69\x5e\xf8\x54\xb3\xff\xfb\xa6\x0b\x5f\xbe\x85\x7f\x82\x2b\x37\x29\x29\x48\x29\x9f\x6a\x09\x71\x3e\xd5\xe0\xdd\xcf\xf3\x69\xd7\x6c\xaa\xa4\xdc\xac\xba\xef\xff\x8b\x07\xc1\x33\x48\x26\xf7\x1b\x47\x84\x86\x86\x0f\xaf\xff\xf4\xf2\xc7\x37\x27\x10\x71\x1f\x76\xed\xbf\xbe\x03\xee\x27\xf3\x00\x09\xa3\xb3\x97\x95\x8a\xb4\x51\x88\xd0\x48\x12\x88\x9c\x69\x5d\xc7\xb3\x3a\x0a\xc5\x6f\x0a\x5a\x9e\xde\x08\xb4\x3c\xbd\x0c\xb4\x3c\xac\x96\xa5\xf2\xa9\x72\x4b\xfe\x46\x33\x46\xeb\x16\x14\x1a\xdc\x47\xd6\x8c\x64\xe4\xc9\x58\x18\xd2\x69\x74\xdb\x9d\x6e\xc3\xfe\xaf\xd3\x20\x0d\x4c\xba\x05\x1a\xda\x30\x83\xba\x75\x23\xb5\x46\x05\xd6\xae\xa6\xce\x98\x01\x30\xa2\xfe\xc3\xaa\x34\xb2\x75\x07\x1f\x6c\x67\x00\x76\x01\x3e\x7f\xa6\x8e\x7d\xf9\x02\x7b\xcf\xe0\x2e\x71\xd8\x1d\xa2\x15\x1a\x4d\x05\x53\x1e\x02\xa6\xdc\x8a\x3c\x7f\xb3\x01\xcc\x0d\xbd\x4d\x54\xe8\xe6\x11\xa1\x9b\x33\x54\x02\x83\x96\x0b\x68\xe0\xcc\xcc\xb4\xe1\x9e\xa6\xb8\x27\x3f\x65\x86\x62\xd9\xa9\x0f\x5c\xcc\x80\x0e\x1d\xb4\x4d\x80\xdd\x37\xf6\x5a\x86\x6e\xb3\xd7\x3c\xe8\x25\xd1\x1c\x9a\x7b\xb6\x06\x28\xad\x63\x10\x48\xef\x0c\x7d\xc8\xe3\x99\xd7\xad\x77\x79\x28\xb3\xb4\xd6\x9b\x32\xb4\x37\x72\x22\xd8\xbd\x54\x0a\x7f\x54\x31\xb4\x8f\x81\xa1\x5d\xb6\x2d\x6e\x81\xbf\xbd\x2b\xeb\xa3\x2e\xb1\xc5\xc4\x13\x5f\xd3\xbd\xc0\x1d\x0d\xfa\x59\xab\x15\x32\x71\x48\xac\xbc\x1b\x43\xc8\x44\x23\x62\x23\xd4\xf6\xaf\x5e\xcb\xf6\xbb\x79\x0b\x16\x4a\x15\x2b\x7f\x75\x56\x5e\xf7\x93\xc3\x78\x53\x18\xf9\x17\xae\x3b\xd7\x64\xdd\x2f\x59\xea\x25\xc3\x4f\x04\x78\x9b\x32\xfc\x9f\x5d\x77\xd6\x1c\xfe\x8b\xcd\x40\x2e\x3f\xa9\x26\xe4\xd0\x4b\x32\x06\xf8\x1f\xe9\x64\x56\x48\xa6\x42\x32\x15\x92\xd9\x24\x24\xf3\xa4\xd0\xdc\x2a\x51\xde\x66\x58\x38\xa5\x9c\x77\xd1\xc2\xa9\x9b\xf9\x33\xc8\x59\x38\x95\xd9\x6c\x8d\xb8\xf8\x7f\xae\x30\xa6\x65\xa0\xae\x14\xd0\x2d\x03\x73\x4b\x80\xdc\x02\x6c\xa1\x0f\x7f\xb2\xdc\x88\x63\xed\xdb\x97\x53\xf0\xf8\xca\x14\xdc\x5f\x46\xc1\x0c\xb3\x94\xe1\x95\x22\x56\x29\x2c\xba\x04\xa8\x14\xbf\xce\x50\x4a\x31\xc5\xed\x9d\x85\xaf\xbc\x64\x41\xcf\x81\x93\x62\x5a\xe9\x11\xba\x0c\x5c\xcc\x8e\x55\x4a\x58\x20\x44\x19\xa2\x98\x43\x13\x59\xdc\xb4\x35\x6d\xe3\x6b\x92\x10\x76\x61\xde\x2c\x58\x7f\x93\x19\x12\x2d\xf4\x62\x39\xc7\x5a\xe0\x56\xbb\xf3\xdf\x17\xd7\xd7\x52\x2e\x75\x25\x87\xba\x94\x3b\x2d\xe7\x4c\x57\x71\xa5\xa5\x1c\xe9\x9c\xd0\x24\x65\xc5\x74\x1c\xc2\x60\xba\xab\x51\x9d\x73\x0f\xf7\x60\xd7\xc5\xdb\x1f\x71\x71\xd1\xe7\x62\xa4\x50\xeb\x7e\xa2\x70\x0c\x50\xf5\x15\xfe\x23\x46\x6d\x34\x09\x79\x63\xfd\x1f\xff\xfd\xa9\xd6\x6b\x36\x3f\xd5\xbe\xfc\xad\x13\xfe\xba\xb7\x07\x2d\xb8\x66\x85\x49\xf9\x45\xb3\xa1\x05\x66\xed\xf3\xe7\xa4\xee\x02\x6b\x56\xca\x95\x96\x9c\xb4\xe5\xac\xd8\x32\x36\x8c\xbe\xff\x8c\xa3\x64\x35\x15\x0a\x94\xf1\x66\xb3\x33\xef\x63\x44\x0f\xb9\x06\x53\xf8\xe0\x7a\x9c\x3b\xff\x4a\x98\xb0\x65\x0c\x58\xca\x7c\x75\x8a\x1b\x6a\x05\xe3\x35\x5b\xd5\xf3\xa6\xe2\x73\x3b\xac\x9c\x17\x2b\xe1\xc3\x96\xf2\x60\xcb\xf8\xaf\x72\xde\x6b\xc9\xa1\xb1\xc8\x73\xcd\x7a\xbf\xc8\x6b\xcd\xad\xe2\xd5\x46\x2d\x4b\x39\x8c\x65\xdc\xc5\x12\xce\x62\x09\x57\x51\xca\x51\x2c\x13\x52\xae\xe2\x86\xee\xa9\x9f\xf9\xb3\xb7\x6c\x67\x2c\xb2\x3b\xcb\x58\x9d\xa5\x6c\xce\x97\xad\xbc\xcb\xf3\x72\x80\xf5\x2e\xf3\xee\x52\x76\xe8\x60\xf5\x65\x7e\xc5\xdb\x7a\xc5\x3b\x44\x4a\xfb\xab\xbd\xf4\x6e\x76\xc9\x57\x17\xf9\x57\x79\x91\xa7\x9f\x62\xb2\x6b\x61\x61\x3f\x60\x06\x85\x37\x4d\x5c\xec\xe8\xbe\x8e\xc3\xeb\xde\xe9\x97\xd6\x4d\xb6\x05\xae\xf6\xc7\x73\xd7\xbb\xb1\xc2\x1b\x37\xd6\x5b\xbb\xf5\xdb\xd5\xad\xbf\xe2\x2e\xad\xee\xfb\xea\xbe\xbf\x87\xfb\x7e\x7f\xe9\x7d\xff\xf4\x56\xef\xfb\xea\xe2\xae\x2e\xee\xf5\x00\x73\xdf\x8f\x15\x29\x2c\x6f\xe3\xc2\x5e\x5a\xe7\x23\xbc\xa8\x7f\x76\x63\xbd\xf5\x7b\xba\x5b\xdd\xd3\xcb\xef\xc0\xb0\xba\xa8\x37\xe2\xa2\x7e\x92\x54\x6b\xb7\xa5\xdd\x71\xf9\x1a\x6a\xda\x1b\x63\xc8\x7e\x41\xa5\x9d\x71\x92\x7b\xd2\x5a\xd3\x66\xea\x76\x8e\xcf\x92\x80\x3f\x35\xc3\x46\xb3\x69\xb7\x0b\x74\xc8\x44\xea\x72\x25\x17\x47\x2f\xfd\x32\xe7\xc3\x14\xda\xcd\xce\x61\x2d\xeb\x88\xc1\x30\x0a\x98\xe1\x62\x94\x0d\xb0\x16\x70\x6d\x72\xab\xea\x73\xd9\xcd\x39\x37\x87\x06\x2f\x52\x3d\xee\x7c\xd3\x49\x7a\xfa\x34\x2e\x9f\xa1\x6c\x61\x10\xa6\x2e\x5c\xff\x99\x8f\x80\x93\x32\x53\xaf\x74\x2b\xd5\x4a\x0d\xc1\x72\x97\x60\x5e\xe9\x5b\xfb\x47\x8c\x6a\x4a\x52\xab\xb2\x0e\xe7\x26\xa7\x33\xf7\x75\x84\x17\x05\x67\x05\x35\x7d\xc6\xa3\x8f\x2a\xf8\x30\x15\x5e\xc9\x6a\x48\x8f\x90\x5c\xe7\x8a\x7b\x78\x6e\x4d\x05\xbf\xa4\x84\xaa\x95\xb2\x2b\x79\xdb\xba\xb5\x2c\xe1\xca\x08\x9a\x38\x0d\x7a\x4e\x6c\x5c\xe1\xb0\xc9\x88\xfd\xa3\xd4\xf9\xad\x58\x0b\xe3\xc0\xf0\xc5\xfc\x29\xf5\xc7\x85\xec\x97\xd1\x9d\xda\xe9\xbb\xa3\x6d\x97\xd8\xd5\xbd\x3b\x99\x80\x12\xc9\xad\x61\x23\xa2\xb2\xfe\xbf\xd3\xce\xd4\xe6\x53\x17\x7a\x6d\xbf\x95\x67\x4e\xa6\xd7\x0d\x2b\x97\x10\x6b\x3c\x75\x15\x95\x72\x7b\xe5\x73\x5e\xb8\x0d\xee\x72\xd6\x8b\x64\xca\xa6\xfd\x55\x7a\x48\xa4\xa7\x50\xc9\x12\x28\x16\x4e\xd7\xc0\xf9\x62\x91\x2b\x2d\x83\xb9\x53\xaa\x3f\x88\x79\xe0\xf7\xb9\x18\xca\x7a\x52\x71\xb5\x42\x1e\xfc\x54\x78\xa7\xf8\x88\x0b\x16\xbc\x16\xce\x93\xe3\x15\x4e\x88\x55\x45\xaf\xb4\x4c\x64\x52\x51\x9f\x27\x35\x7d\x0d\xeb\x82\xfe\x6b\xf9\xbf\x2f\xee\xd2\xe6\x44\xd4\xe4\xba\x1e\x3a\x06\xbd\x26\xe4\xa4\xd1\x4b\x3d\x29\xd5\x8c\x4c\xbe\xd5\xe6\x8a\x45\xdc\x3b\x23\x58\x96\x14\x4e\x6d\x17\xe6\x49\x50\x43\xb1\x60\x9b\x5b\x13\xd2\x70\xaf\x98\x51\x14\x78\xd6\x74\x06\xfa\xa9\x19\x58\x9e\x3f\xad\xf5\x72\x66\x15\x9d\x76\xee\xc7\x7e\xfe\x47\x67\xe6\x0e\xaa\xd6\xcb\xfd\xdd\xc9\xff\xd8\x6f\xe7\x53\x72\x0c\x7a\x37\xf7\x77\xc7\x9f\xab\xd5\x91\x31\xe9\xa8\xd3\xa0\x5a\x22\x7d\x70\x5e\x1c\x53\xc2\xf1\x10\xfb\xb9\x25\x79\x69\x4f\xf2\x8d\x3f\xcd\x37\x9e\xef\x49\xf7\x20\xff\x23\xd7\xad\x43\x3f\x3f\x26\x7f\xbe\x8f\xe9\x3a\xc9\xcd\xdc\xdc\x6c\xfe\x26\x09\xf9\xd6\x06\x4a\x4e\x34\xaa\x84\x3b\x4c\xa1\x96\xbd\xc4\xe1\x2d\x85\x5c\x76\xd4\xad\xc5\x24\x35\xa9\xfd\xd8\xbe\xf8\xf3\x5f\xa6\x53\x9e\xb0\x93\xe7\x19\xcb\x79\xf8\xe4\xcb\x93\xff\x3f\x00\x00\xff\xff\xea\x16\x69\x8a\x35\x5e\x02\x00")
-
-func manifestsDashboardsSystemSystem_dashboardJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_manifestsDashboardsSystemSystem_dashboardJson,
-		"manifests/dashboards/system/system_dashboard.json",
-	)
-}
-
-func manifestsDashboardsSystemSystem_dashboardJson() (*asset, error) {
-	bytes, err := manifestsDashboardsSystemSystem_dashboardJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "manifests/dashboards/system/system_dashboard.json", size: 155189, mode: os.FileMode(420), modTime: time.Unix(1647457998, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _manifestsDashboardsVmi_dashboard_providerYml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x34\x8e\xb1\x6e\x23\x31\x10\x43\x7b\x7d\x05\x01\x17\xbe\x03\x7c\x2b\xdb\xa5\xda\xbb\xe6\x80\x04\x71\x13\xf7\xe3\xd5\xec\xee\x00\xb2\x24\xcc\xc8\x1b\xd8\x5f\x1f\xc8\x49\x1a\x16\xe4\x23\xc8\x0d\xfe\x96\x7a\x57\x99\x97\x86\x5f\xe3\x6f\x1c\xf7\xc7\xfd\xae\xeb\x71\x87\x37\xa5\x31\x31\x28\x47\x5f\x14\xd2\x0c\x34\x4d\x92\x84\x1a\xdb\xe0\x36\x78\x91\x91\xb3\x71\xc4\x2d\x47\x56\xb4\x85\xf1\x9e\x65\x65\x35\x4a\x38\xb1\x5e\xc5\x4c\x56\xfe\xe1\xb0\xe2\x30\xec\x41\x06\x5b\xca\x47\x06\x35\x2c\xad\x55\x0b\xde\x17\xb3\xa1\x3c\xd7\x86\xb1\x5c\x7d\xfa\x2a\x98\xbf\xd5\x34\x38\xaa\x72\x66\x35\x29\x39\xe0\xe0\xaa\x96\x55\x22\xab\x05\xf7\x07\x99\xae\x1c\xb0\x3d\xb3\x2a\x3d\x1e\x94\xcb\x6b\xc9\xd2\x8a\x4a\x9e\x4f\xdf\xdc\xd6\x01\x45\xe7\xff\xb1\x97\x81\xa9\xa4\xc8\x1a\xb0\xed\x7e\xbb\x57\x0e\x98\x24\xb1\x03\xa2\x18\x5d\x12\xff\xe3\xc4\xed\xb9\x35\x51\xb2\x1e\x70\x94\xd6\x93\x80\xa6\xb7\x6e\x94\xda\x01\x0b\x0e\x00\x2a\xb5\x25\xc0\x73\x1b\xfd\xac\x34\x51\x26\xff\xbc\xd8\xff\x4a\x9e\x7d\x24\x5b\x2e\x85\x34\x9a\x73\x9f\x01\x00\x00\xff\xff\xa3\xd2\xc6\xdf\x6d\x01\x00\x00")
-
-func manifestsDashboardsVmi_dashboard_providerYmlBytes() ([]byte, error) {
-	return bindataRead(
-		_manifestsDashboardsVmi_dashboard_providerYml,
-		"manifests/dashboards/vmi_dashboard_provider.yml",
-	)
-}
-
-func manifestsDashboardsVmi_dashboard_providerYml() (*asset, error) {
-	bytes, err := manifestsDashboardsVmi_dashboard_providerYmlBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "manifests/dashboards/vmi_dashboard_provider.yml", size: 365, mode: os.FileMode(420), modTime: time.Unix(1647457998, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _manifestsDashboardsWeblogicWeblogic_dashboardJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x5d\x7b\x73\xdb\xb6\xb2\xff\xdf\x9f\x02\xc3\x93\x7b\xc7\x39\x23\x27\xa2\x1e\x7e\x64\xe6\xcc\x5c\x3f\x9a\xb6\xa7\x89\x9b\x13\x3b\xe9\xed\x34\xb9\x1a\x88\x84\x25\x36\x14\xc9\x92\xa0\x1f\xf1\xb8\x9f\xfd\x0e\x01\x3e\x40\x3c\x28\x4a\xa6\x2c\x4a\x46\x66\xce\xa9\x49\x40\x24\xb0\xbb\xc0\xfe\x76\xb1\xbb\xbc\xdf\x01\xc0\x80\x9e\xe7\x63\x88\x1d\xdf\x8b\x8c\x37\x20\xb9\x05\x80\xe1\x3a\x11\x36\xde\x80\x3f\xc8\x15\x48\xef\x92\x96\x71\xec\xb8\xf8\x67\xcf\x78\x03\xcc\x4e\x71\xd7\x86\x18\x46\x7e\x1c\x5a\xc8\x78\x03\x8c\x0f\xa1\x3f\x43\x78\x8a\xe2\xc8\x60\xfa\x20\x0f\x8e\xdd\xa4\x1d\x87\x31\x62\xee\x4f\x1d\x5b\x72\xd7\xb1\x7c\xef\xd4\x77\xfd\x30\x79\x60\x38\x19\xc3\xdd\x6e\x07\xf4\x4c\xb3\x03\x7a\xc3\x61\x07\x98\x2f\xd9\x47\x7b\x70\x46\x5e\x7c\x5c\xcc\x05\xfc\x37\x38\x76\x51\x88\x4b\x43\xc0\x77\x01\xe9\x67\xc3\x68\x3a\xf6\x61\x68\x1b\x69\xdb\x03\xf9\xef\xd7\x1d\x00\x1e\x92\xee\x06\xb2\x1d\xcc\x8d\xd6\x98\x78\x08\xff\x6c\x1b\x6f\x80\x17\xbb\x2e\xbd\x13\xc2\x60\x7a\xe9\xfb\x2e\x76\x02\xe3\x0d\xe8\x92\x9b\x4e\xd2\xa5\x37\xa0\x7f\x63\x14\x92\xe1\x24\xe4\xda\x1f\xec\x9b\x83\x5e\xdf\x3c\xea\x0e\xfa\xa4\xd5\x75\xbc\x6f\x09\xcd\xff\xf8\x4a\x2e\x03\xe8\x21\x37\xca\xa9\x9e\xd1\xdc\xb0\x7c\xd7\x85\x41\x84\x92\xe7\x5e\x41\x37\xca\xa9\x54\xa6\x7a\x36\x2a\xd2\x32\x09\x1d\xfb\x83\x5f\x30\x94\x12\x9a\x63\xda\x4d\x3e\xd0\xf4\xc6\x6d\x36\x89\xf4\xfa\x2e\xb9\xce\x28\x94\x3f\x9b\x4c\xb0\xdf\xcb\xaf\x8b\x71\x7f\xcd\xef\x61\x07\x13\xe2\x19\x17\x28\xbc\x46\x61\xc1\x84\x9c\x05\xa1\x7f\x43\x89\x9f\x3e\xb8\x98\x2e\xb4\xa6\xe8\xd2\x99\x21\x3f\xc6\xfc\xb4\xd4\x13\xbe\x72\x90\x6b\x9f\xfa\xde\x95\x33\x29\x4f\xda\x46\x57\x30\x76\x71\x99\x14\x94\xaa\x44\xb6\xd8\x9b\x00\x18\x33\x9f\x08\xa3\x81\xa7\x21\x8a\xa6\xbe\x6b\x47\x06\xd3\xe1\xa1\xc3\x3e\xc2\x46\x96\x33\x83\x64\xea\xdd\x52\xc3\x0c\x06\x81\xe3\x4d\x22\x66\x05\xd1\x7f\xe5\x97\x01\x60\xbc\x78\x31\x85\xd1\xf4\x17\x94\x50\xda\xf0\xc7\x7f\x22\x0b\xbf\x31\x0f\x7a\x46\x87\xef\x48\x88\xde\x15\x6e\xfb\x89\xdc\x19\xff\x12\xfb\x63\x74\x9b\x50\xcf\x38\x7f\x7d\x2c\x69\xa4\x3c\x30\x85\x86\x6b\xe8\xc6\x64\xfa\x09\x6d\x8d\x52\xeb\x03\x73\xf5\xb5\x34\x5b\x86\x54\x4a\x72\xc2\x71\xe4\xbb\x31\x46\xdc\x58\x8c\x08\xa3\x40\x24\x93\x48\x28\x86\x61\xc9\x66\xb0\x6b\x1e\x1d\x75\x40\xaf\x7b\x98\x6c\x09\x07\x2f\x85\x19\x32\x53\x49\x66\xc2\xb5\x3e\x94\xae\xbf\x2a\xf9\x1b\x7b\x0e\xa1\xa1\xe7\x7b\xa8\x20\x06\xd3\xc7\xf0\xaf\x51\x18\x3a\x36\xa2\xd2\x2f\xac\x14\xe5\x2a\xec\x73\xab\xd0\xec\xcf\x59\x85\xa6\xf0\xec\x64\xd7\x4c\x56\xc9\xaf\xe9\x10\xb8\x1d\x94\x0a\x8c\xb9\x5f\x5c\x7b\x18\x85\xd7\xd0\xe5\x17\x4e\x79\x13\x02\x54\x7c\x6f\xcf\x20\x86\x1f\x7c\xc7\x23\xeb\xc6\xec\xe6\xc3\x31\xfc\xa0\xac\x29\x0a\xd6\xbc\x4f\x59\x4d\x09\xcf\x8c\x9f\xec\x92\x59\x2b\xa1\x25\xd3\xf8\x67\x1c\x61\xe7\xea\x2e\x6b\x86\x31\xf6\xd9\x66\x3f\x74\x90\x87\xb3\x2d\xd4\x98\xfa\xa1\xf3\xdd\xf7\x30\x74\xd9\x4e\x21\xb2\x63\x0b\xfd\x2a\x19\x19\xd9\x4e\x5c\x4b\x94\x30\x63\x86\xa0\x67\x28\x45\x9a\xec\x26\xc9\xaf\x8c\x92\x68\xd1\xb9\x45\xd9\x3e\x2c\x15\x89\x74\xe1\xdd\x0b\x3f\xbb\x70\xbe\x27\x53\xec\x77\x95\x3f\x2b\x11\x41\x60\x78\xe0\xc6\x13\xc7\xfb\x8c\xc2\x28\x25\xc6\xc1\xab\xe1\x2b\xd3\x64\x76\x56\x18\x4e\x10\x2e\xcf\xb5\x34\x0c\x74\x8b\x66\x81\x0b\x43\x41\xd9\x92\xb6\x80\x2c\x2e\xcb\x8f\x3d\xbc\x4b\xfe\x1f\xec\xde\xb8\xd1\xe8\xcf\xeb\xd9\x28\x0e\xb0\x33\x43\xf7\x37\x68\xec\xfa\x13\xc7\x1a\xd9\xfe\x0c\x3a\xde\xa7\x9f\xcf\xfe\xf5\xc5\x78\x41\x2f\xce\xe1\x0c\x7d\x31\x3a\x20\xef\x63\xb9\x71\x84\x51\x98\xdc\x4f\x7a\x31\x97\x5f\x8c\x4e\x22\xb3\xf0\xfb\x77\xe8\xf9\x59\xbf\x7f\xfd\xfd\xc5\x78\x71\xfd\x3d\xbd\xfa\x62\x3c\xbc\x04\xe3\x3b\xb0\x9b\x28\x76\x49\xef\x97\xe5\x25\x6f\x5c\xf9\xe1\x0c\x92\xb5\x9a\x0c\x74\x14\xa1\xd0\x41\x51\xb9\x0b\x23\xff\x86\xbc\xe5\x2d\xb4\x30\xd9\x61\x4a\xfb\xa2\xe1\xa2\x09\xf2\xec\xb7\xf9\x1b\xee\x0b\x3a\x44\x44\xb9\x25\x73\x7a\x78\x28\x3f\x33\x44\x57\x04\x2a\x18\xc7\xcc\xc6\xb1\xc3\x89\x1a\x19\xec\xdb\xd0\x9f\xf1\x6b\x32\xb9\x7f\x31\x75\xae\x04\xfd\x97\xab\xd5\x8f\xb1\xe7\x39\xde\x04\x28\xd5\x6b\x84\x21\xde\x74\xfd\xfa\x48\x35\xba\xbf\xaf\xd5\xe8\x33\x53\xa3\x26\xa7\x46\x4b\x7a\x55\xae\x47\x29\x5c\xef\x6b\x3d\xa9\xf5\x64\xf6\x8e\x05\xf5\x24\x51\x93\x37\x68\x0c\x83\x60\x64\x91\x1d\x72\x64\xa3\xc0\xf5\xef\x66\xc8\xc3\xa3\x64\x23\x5e\x8b\xea\x84\x41\xd0\x32\xcd\xf9\xb4\x2a\xf2\x8c\xf0\x00\xd9\xe0\x38\x08\x5c\xc7\x4a\x3d\x1c\x2b\x57\x94\x36\x8a\xac\xd0\x09\xb2\x95\x68\xb4\xc5\x42\x35\x1b\x54\xad\xbd\xee\x61\x73\xaa\x75\x19\xbd\xaa\x75\x6a\xaa\x53\x03\x14\x5a\xc8\xc3\xab\x55\xab\xfb\x73\x8c\xd3\x81\xf0\xe8\x7a\xc6\x69\x77\xa0\xb5\xae\x5c\xeb\xba\x30\xc2\xe7\x3e\x3e\xe7\xe0\xa3\x4a\xf9\xbe\xfe\x3f\x89\x49\xf2\xe2\x75\xe3\x3a\xb9\xb7\x32\x9d\x1c\xa2\x00\x51\x25\x51\x4c\x80\x6f\x3d\x73\x42\x64\x65\xb4\xbe\x2e\x5a\x23\xcb\x0f\x90\xfd\x19\x86\x1c\xdf\x99\x27\x71\x73\x8a\x90\x8b\x2c\x2c\x3a\x54\x59\x22\x18\xc7\xf6\xcc\xf1\xa8\x7d\x25\x21\x24\xdf\x43\x50\x61\x0f\x8b\xe0\x0d\x8a\x29\x12\x1c\x41\x07\x3d\x82\x16\x76\xae\x89\x60\x8d\x1a\x36\xbd\x25\x92\x92\x74\x2a\xae\xbe\x18\x0f\x4a\x88\x40\x5c\xe2\xa5\xc6\xd4\x79\x2f\x92\xd1\xf1\x22\x0c\x3d\x2c\xc3\x50\x2c\xa0\x30\xbb\x0a\xb4\x91\x63\x8a\x5e\x8b\x30\x05\x65\x36\x28\x4b\xe7\x36\x99\xdc\xb6\x13\x05\x2e\xbc\x4b\x17\x0d\x2b\x16\x46\xa3\xf8\xe1\xa8\x39\xfc\x70\xf1\xd3\xa7\xcb\xb3\x5f\x7f\x3b\x5f\x06\x47\x74\x39\x20\xd1\x59\x62\x2e\x66\x57\x31\x17\xf1\xbd\xf3\xe7\x72\x79\xfc\xf1\xf2\xe7\xf3\x1f\x97\x99\x8b\xd9\xc4\x5c\x4c\xc5\x5c\x7a\x8b\xcf\xe5\xe3\xa7\xf3\xf3\x25\xa7\xd2\x6b\x62\x2a\xaa\x43\x94\xfe\x52\x6c\x39\x3f\x3b\xf9\x7d\x99\xa9\xf4\x9b\x98\x4a\x5f\x31\x95\xc1\xe2\x53\x79\x7b\xfc\xf3\xbb\x1f\xce\x96\x99\xc9\x61\x13\x33\x19\x28\x66\x32\x7c\xca\x99\x98\x07\x6d\xb5\x1f\x26\x21\x42\xde\xa2\x26\x03\xdf\xbd\xd2\x40\x41\x76\xd5\xe3\x0f\xbb\xfc\xc3\x4b\xd7\x6d\xf0\xf1\xf1\xe7\xd5\xfb\x73\x8d\x11\x22\x60\x87\x52\x5b\x83\x31\xcf\xb5\xa5\xb1\x52\x37\x9f\xb6\x17\x9a\xb4\x17\xe6\xfb\x27\x19\x5b\x82\x78\x20\x47\xd7\xd0\x6d\xd0\x8a\x00\x35\xcc\x88\x3a\xae\xca\xe7\xe2\x8c\x4c\x0d\x87\x0b\x0c\x71\xac\x9d\x90\x59\xc3\x93\x18\x1b\x87\x2a\xf4\xd4\x32\x5f\xe5\x0c\xde\x96\x75\x0c\xbd\xeb\x78\x42\x78\xd1\x53\xa3\x92\x7f\xf4\x8e\x8e\xac\x81\x78\x9a\xda\x24\x2e\x99\x8c\xe1\x6e\xaf\x7f\xd0\x01\x66\xef\xa8\x03\x06\xdd\x0e\xe8\xbe\x3a\x3c\xaa\x74\x9f\x0e\x05\xb0\xb2\xc8\x1b\xff\x61\x0f\x06\xb0\x0f\x57\x8a\x86\x9e\xc4\x3b\xcb\x03\x22\xb3\x21\xf7\x6c\xef\x40\x86\x98\x1a\xf7\xce\x6e\x2c\xb2\x89\xa6\xfe\xcd\x65\xb6\x12\xdf\xc1\x31\x8d\x81\xe4\xf0\x41\xb9\xd7\x7b\x18\x7e\x43\x04\x76\x70\xc1\xae\x39\x4a\xd2\x48\xa8\x71\x24\x64\x76\xbb\x60\x0f\x64\xa1\x4c\x53\x04\x83\xd1\x55\x88\xd0\x28\x5d\x9c\xdb\x00\x8a\x9e\x97\x13\xf6\x27\x04\x03\xf0\x29\x82\x13\x89\x0f\x76\x02\xe3\x09\xd2\x48\x6a\x35\x48\xca\xec\x0d\x37\x03\x4a\x69\x80\xb4\x21\x00\x69\x16\xad\x16\x1b\x0d\x79\x6c\x34\x68\x06\x1b\x1d\x99\x4f\x82\x8d\xb4\x3f\x49\x90\x0c\xed\x4f\x5a\x9f\x3f\x69\x25\xa1\xe0\x1a\x34\x81\xa7\x00\x4d\x59\xc0\x78\xb2\xb5\x3d\x5f\xff\x53\x77\x1d\xa8\x69\xff\x40\xa3\x26\xa6\xeb\x63\x51\xd3\x82\x10\x23\x9a\xfa\xe1\x8a\x3d\x30\x02\xca\x38\x6a\x08\x65\xe8\xec\x2d\x8d\x32\x9e\x1d\xca\x48\x4f\xad\xfc\x00\x79\xa3\xc8\xb7\xbe\x21\x1c\x8d\xac\x38\x0c\x91\x87\x47\x24\xde\x5e\x83\x8f\x4d\x03\x1f\xbf\x06\xc8\x03\x17\x94\x97\x8b\x80\x0f\xe8\x3a\x30\x22\x45\x00\x38\xa1\x07\x53\x04\x03\x70\x15\x22\x04\xc0\x0c\x7a\x70\x82\xec\x3d\xca\xb3\x3d\x93\x3c\x32\x0e\x50\xb8\xe7\x3a\x93\x29\xde\xe3\x63\x2a\xaa\x7e\xdc\x33\x48\x71\x80\xf0\x9b\xf8\x2b\xf2\xa3\xc8\xf9\x8e\x84\x17\x02\xfe\x8d\xe5\x20\x0b\xf5\x2f\x7b\x20\x7f\x5d\xf2\x13\x61\x49\x8d\xe9\x62\xe7\x73\xfe\xa3\xe9\x3b\xe4\x4d\xf0\xb4\xe4\xeb\x27\xf7\x91\xac\xfb\xa3\xc2\x0b\x17\x50\x97\x57\x8e\xeb\xb2\x40\x84\xdc\xf8\x31\x84\x76\xa2\x03\x58\xb4\x53\x51\x9c\xa0\xcb\x69\xd6\xb9\xd5\x09\x0e\x64\x9a\xd5\x46\xc9\x7e\xe5\x48\xa8\x41\xe3\xf2\x8a\xd2\x05\x74\x59\x94\x07\x02\x5d\x67\xe2\x1d\x47\x97\xf2\x62\x15\xf0\x7a\x22\xde\x4c\xb7\x27\xb1\x81\x9e\xe9\xf1\x37\xc9\x91\x1e\x7f\x50\x10\x26\xa2\x73\x21\xdd\x16\xc8\x21\x82\xe4\xd0\xc0\xc7\x64\x33\xe0\x7b\xe7\xfa\x2d\xe9\x2f\xd0\xc7\x75\x3c\xc4\x9f\x40\x90\x9b\x37\x8e\x8d\x4b\x15\x22\x64\xe0\x22\x91\x20\x82\x2c\x72\x44\x90\xa8\xe0\x6a\x7c\x01\x5d\x14\xe2\xfc\x0c\x44\x35\xae\x00\x5a\x48\x26\xd7\xa9\x9b\x1e\x4e\x04\xc2\xcc\xd7\xb7\x41\x32\xd2\x44\x02\xe3\x88\xdd\x11\xe9\x7d\x51\x3a\x42\xe4\xd9\x28\x44\x44\x13\x5d\xb9\x3e\x66\x94\x2f\x11\xa7\x5f\x4b\x0b\xa0\x68\x54\x0d\x3d\xc2\xd0\xfa\x26\xbc\x25\x81\xca\x01\xb2\xdf\x39\x9e\x38\xa3\x46\x4d\xf5\xe2\xa8\x23\x95\xcf\x55\x2b\xce\x44\x2b\xde\x17\xd7\x6f\x42\x34\x41\xb7\x0f\x6d\x0f\x04\x01\xe4\x3c\xe1\x2d\x51\x07\xbb\x8a\xe4\x6e\x70\x7f\x2f\xce\xe1\xe1\xe1\xa5\x42\x8d\x9e\x48\xed\x8d\xc7\x31\x32\x51\x20\x5b\xca\xc8\x0c\xf1\xc8\xd0\x50\x43\x4c\x26\x3c\xbe\x48\x74\x70\x43\x2c\x3e\x5e\x15\x8b\x67\xf0\x76\xab\xd8\x2b\xad\xee\xd4\x38\x6b\xdf\xc3\xdb\xc6\x56\xef\x69\x25\x08\x66\x9d\x24\x7f\xd4\x03\xc7\x1f\xd1\x24\xd5\x8a\xdc\x0f\xaa\x51\xf3\xbf\x3f\xbf\x27\x7b\x13\x83\x98\xf3\x52\x53\xac\x01\x38\x85\x21\xb2\x45\x78\x10\xf9\x21\xe6\x10\x13\x41\x06\xa3\x0c\x77\x3b\x9e\xed\x5c\x3b\x76\x0c\x5d\x11\x7a\xe6\xe7\xa9\x89\xf5\x5f\x0c\xe0\x16\xde\x3a\x9c\x76\x1f\xc7\x14\xd6\x97\x67\xc1\xba\xe8\x9c\x92\x3f\x2d\xab\xd5\xc5\xf5\x96\x43\x9c\x1c\xca\x48\xf0\xe6\x1d\xbc\x45\x15\x9a\xb2\x10\x4d\x1b\x59\xe3\x3b\xcc\xcb\xa5\x0b\xc7\x88\x77\xa7\xd0\x06\x7f\x72\x02\x23\xc1\xa7\x96\x62\x39\xa1\x3b\x45\x73\xc2\x6d\x66\x3e\x73\x37\x8a\x62\xa8\xd4\x59\xb5\xf6\x71\x0a\x52\x7f\x27\xf2\x9d\xc0\x64\x09\xfa\x24\xf7\xdf\xa1\xeb\x7c\xd0\x3b\xec\x43\xb5\x85\xf7\xbc\x2d\xbc\x03\xce\xc0\x33\x7b\x73\x0c\x3c\x73\x19\x0b\xaf\x67\x2e\x62\xe1\x09\x12\x4c\x4c\x3c\xfe\xee\x1c\x1b\x8f\xef\xae\x8d\x3c\x6d\xe4\x3d\x85\x91\x17\x84\xbe\x85\xa2\x68\x64\x05\xf1\xc8\xf5\xa1\x2d\xc3\x8e\x7f\xd7\x03\x8f\x7f\xaf\x1e\x3d\x82\x7f\x02\xb3\xdb\x5d\xa3\xad\xa7\x42\x88\xbb\xcb\x1b\x78\xeb\x84\x88\xa7\x1f\x3e\x81\x77\x3e\xb4\x35\x44\x7c\x1c\x44\xcc\x02\xb5\xd7\x8e\xbc\x34\x42\x9c\x83\x10\x35\x76\x02\x4a\xec\x54\xba\xb1\x34\x78\x62\xa8\xa6\xc1\x93\x06\x4f\xdb\x0a\x9e\x12\x2d\x0d\xed\xc0\xf7\xdd\x11\xba\x45\x56\x8c\x51\x7a\x6b\x44\xa4\xe6\x69\x8e\x9a\x37\xd3\x63\x7e\x99\x10\x08\x5c\x12\x6a\x45\x2d\x74\xa8\x32\xac\x8d\x70\x6c\x7d\xcb\x18\xab\x59\xaa\x64\xe9\x45\x42\xa7\xa6\x59\x7a\xb6\x12\x96\xfe\x15\xa3\x18\x8d\x5c\xb2\x9b\x6c\x15\x33\x57\xee\x29\x27\x94\xab\xe1\xf4\x6e\x8e\x57\x53\x7f\x32\x71\xbc\xc9\xf6\x2e\xc0\x95\xf3\x2c\xa5\x60\xcb\xed\x50\xba\x71\x80\x0f\xbe\xef\x6a\x53\xf4\x71\xa6\x68\x4b\x0c\x3c\x6d\x88\x0a\x91\xf0\x6b\xfd\x0c\x4b\x4f\x51\xd7\xa6\x3f\x64\xec\x83\x8a\xef\xb0\xfc\x86\xc6\x73\x0a\xe1\x2a\x3f\xc8\xd2\xae\x0a\x75\x56\x1c\x61\xb2\x77\x71\xcf\xc8\x98\xcc\x8b\x0d\x35\xb5\x31\x0a\x59\x83\x55\xf9\x74\x26\x57\xa0\x1c\x40\xc9\xe5\x0a\xa8\x13\x04\x74\x01\xa9\xf2\xc3\x4b\xd7\xcd\x85\xeb\xef\xa8\x86\x6b\xcc\x20\xb6\xa6\x48\x94\xb2\x6c\xc5\x18\xe3\x3b\x21\xc5\x03\x94\x4d\x73\x43\x84\x0a\x95\xc5\xcf\x8c\x20\xf4\x03\x14\x62\x47\x18\x9b\x38\x3e\x66\x20\xac\x4c\x55\xd0\xd4\xf8\x9c\x8f\x06\x9c\xca\x46\x53\x8f\x85\xf4\x9d\x74\xf9\xbc\xa2\xab\x65\x41\x39\xa9\xc7\xca\x15\xb0\x03\x06\xc1\x3a\xe9\xff\x1b\xa9\xed\xbe\x3c\xcd\x89\x6c\x57\xbd\x80\x13\xfa\x45\x9f\x9f\x6f\x5a\x15\xef\xe8\x3d\x2f\x89\xf9\x4c\x06\xb5\x46\x99\xa1\x3e\x92\x0b\x14\x45\x64\x48\xad\x95\x9d\xd5\x70\x77\x87\xbf\x5b\x23\xab\x8a\x77\x6f\x1f\xce\x43\x44\x43\xe1\xd9\xf5\xca\x8e\xf7\xf6\xab\xfc\xb7\x52\x07\x6d\x02\x3d\x7f\x42\xd0\x46\xa1\xd2\x39\xfb\x24\x5f\x9d\xc0\x7e\xf0\x6d\xf7\x05\xf6\x83\xf3\x4e\x14\xcf\x24\x1f\x9e\x88\x52\x81\x23\xe9\x33\x68\x63\x7c\x99\xf4\x8b\x15\x62\xc7\x0e\x0c\x82\x8a\x2f\x56\x88\xe5\xa8\x6b\xa6\xcf\x3c\xca\x24\x5f\xca\x16\x5f\x3a\x77\xa6\xbc\x91\x80\x5d\xec\x07\x80\x48\xc0\x4b\x46\xb6\x42\xe8\x45\x94\x2e\x99\xf5\x2e\x97\x31\xba\xe6\x67\x28\x9c\x70\x74\x93\x49\x7d\x3a\x3d\x3b\xb6\x68\xcd\xa2\x3f\xe4\x4b\x5c\x65\x2a\xd2\x77\xf9\xe1\x04\x7a\xce\xf7\xba\xaf\x43\xb7\x96\x1b\xdb\xe8\xe4\x4e\x92\xd0\x46\x3a\x90\xfc\x65\xce\x08\x04\x92\xdd\xdd\xf1\x6c\x74\x5b\x3c\x86\x6f\x0e\x51\x62\xd8\x33\xed\xf2\xa9\x89\x7c\x4c\x8d\x25\x2a\x7a\x3b\x40\x9b\x4b\xda\x5c\xd2\xe6\x92\x36\x97\x5a\x05\x7e\xb5\xb9\x54\x3d\x0f\x6d\x2e\x81\x76\x9a\x4b\x1f\xd1\x5f\x31\x8a\xb0\x36\x97\x1a\x30\x97\x0e\x9b\x32\x97\xfa\x5b\x65\x2e\x25\x06\x8a\x8b\xf0\xc8\xf1\xae\x7d\x2b\xfd\xc0\x8e\xb6\x91\x8a\xa6\x0d\xb6\x91\xb2\xdd\x43\xdb\x48\xda\x46\xd2\x36\x92\xb6\x91\xb4\x8d\x24\x74\xd2\x36\x92\xb6\x91\xea\xcd\x43\xdb\x48\xa0\x9d\x36\x12\x51\xee\xab\x93\x9b\xd9\x23\x8c\xaf\xcd\x31\x8e\x4c\xfe\xab\x51\xcb\x5b\x47\x83\x6d\xb4\x8e\x68\x44\x7c\xf6\xf5\x51\x6a\x21\x69\xdb\x68\x83\x6d\xa3\x1f\x32\x86\x92\xfd\x43\x5b\x48\xad\xb7\x90\x74\xda\x5b\xb6\x97\x1f\x72\x7b\xf9\xdc\x92\x01\xfd\x9e\x6c\x2f\x9f\x97\xf5\xc6\xec\xe3\xcd\x67\xbd\x09\x09\x6e\x2b\x4d\x7b\x4b\x63\xa9\xf3\xf7\x73\x4d\x67\x28\xb2\x96\xc8\x95\x63\xad\x5c\x9d\x2c\xf7\x7c\x92\xe5\xe4\x81\x26\xb4\x3c\x6b\x16\x6d\xf2\xa4\xf5\x59\x57\x06\x14\xd6\x97\x7e\x75\x7f\x0f\x83\x40\x99\x5f\xf5\x98\x8c\x39\xca\xc3\xa5\x67\xa6\x18\x7f\xeb\x12\x50\x4e\xa9\x00\x16\x71\x32\x3a\x0d\x65\x65\x69\x28\x9c\x34\xe9\x24\x94\x16\x55\x43\x28\xa9\xe1\x67\x8d\x0a\x85\x62\x08\xcb\xc1\xc2\xde\xa1\xc6\x85\x8f\xc1\x85\x65\x9a\x6a\x60\xb8\x45\xc0\x10\x24\xc8\xd0\x09\x21\x46\xdb\x13\x88\xfc\xc7\x70\xf6\xf5\xe5\xb6\x82\xc4\x75\x02\xb4\x14\x98\x81\x8f\x10\x23\x0d\xce\x96\x02\x67\xca\xef\x18\xd5\x43\x6d\x01\x0a\x41\x84\x2c\xdf\xb3\x1b\xc0\x6f\x06\x57\xc4\x4d\xc3\x37\x5d\xcc\x6a\xb5\x85\x40\x07\xdd\x65\xe0\x5b\x7f\xa1\x6f\x3d\x68\xf8\xc6\xc3\x37\xed\xd6\xdb\x56\xf4\xb6\x3b\xe7\xcc\x0f\x5e\xa3\x10\x4e\x56\xfe\xa5\xc7\xc7\x3b\xf3\xc8\x11\x1f\x78\x0d\x76\x09\xb8\x5c\x7a\x4a\xf4\x6f\xb3\x62\x3a\xe9\xd5\x9e\xb9\xf0\xe8\x34\x66\x5c\x0a\x33\x72\x87\x96\x09\x80\x49\x63\x3c\x35\x82\x7c\xa4\x7b\x6f\xa6\xa8\x86\xaf\x7d\x7b\xed\x05\x87\xcf\xc6\xb7\xb7\x78\xa1\xd3\x25\xc1\xa1\xf6\xed\x69\xdf\x9e\x46\x87\x32\x74\x58\x76\xed\x6d\x6e\xd2\x8c\xf6\xe7\xad\x08\x9b\x65\x40\x4c\xfb\xf3\xb4\x3f\xef\xf9\x42\x36\xb6\x26\x20\x07\xcf\x56\x5f\x12\x70\x20\x56\x77\x27\xb8\x66\xd0\x67\x14\x62\x56\x12\x50\x21\x82\xaa\x61\x82\x2a\x68\x08\x78\x78\x58\x6a\x51\xe6\x64\x01\x30\x37\x2f\x0b\xc8\xe2\xcd\x55\xf9\x59\x00\x54\xe6\x68\xa5\x53\xa8\xc8\xd3\x92\xbf\x4d\x95\xaf\x45\x1b\x2b\x72\xb6\xe8\xfc\x54\x79\x5b\x40\xd8\xa0\x2b\xa8\xa3\xc8\xdf\x02\xa0\x22\x87\x0b\x48\x43\xf3\x01\xa8\x93\xcb\x05\x40\x75\x78\x3e\x90\x51\xaa\xce\x0b\xe5\xb9\x5d\xa5\xd7\x09\xf9\x5d\x40\xc8\x07\x00\x5c\x4e\x80\x74\x38\xaa\x7c\x2f\x49\x67\x65\xde\x97\x7c\x4a\x15\xe9\x23\x00\xcc\x4b\x21\x01\x65\x4c\x6b\x48\x10\x0e\xbf\x02\xa4\xb4\xae\x4e\x2e\x91\x0f\x9c\x19\x5d\x75\x92\x09\x60\x19\x62\xf0\x1f\x7b\xaf\x1c\xd7\x9c\xf7\x2a\x12\x4e\xca\x2f\x94\x26\x2b\x2d\xf7\xbe\x8a\xc4\xa5\xd2\x3b\xf9\xe4\xa5\xe5\xde\x36\x27\xe3\x05\xd4\x59\x56\x8b\x4b\xfa\x8a\x25\xd4\x6b\xa1\x48\xca\x97\x89\x16\x48\xfe\x6d\x5b\x29\x90\x24\xf3\x0e\xfc\xe3\xb8\x6d\x42\x79\x6c\x61\xe7\x1a\x81\x53\xdf\xf3\x90\x85\x65\x75\x1e\x15\x63\x6c\x99\x88\x6e\xb3\xd0\x9c\xb6\x4d\x68\xb2\x48\xe5\x53\x18\x40\xcb\xc1\x77\x5a\x64\xf2\x7f\x2d\x11\x99\xb3\xb6\x89\x0c\x4d\xe1\xd3\xdb\x8c\x96\x19\x2d\x33\x15\xef\xdd\x18\x99\x99\x57\x0a\x44\x3a\xa6\x27\x95\x1e\x5a\x1e\x04\x56\x94\x07\x01\xf5\x28\xbb\xa3\x6a\x2d\x97\x6a\x91\x39\xc5\x40\xe6\x18\x1b\x70\xc6\x3b\xef\x1c\x03\x12\x07\x19\xc8\x9c\x64\xbd\x7d\xe5\x4b\xe7\x24\xf8\x83\x9c\x74\xa6\xc9\x39\xf2\x85\x33\x2d\x00\xaa\xd2\x91\x55\x09\xff\xe2\x90\xe6\x9d\x46\x91\x4e\x21\x0a\x50\xea\x6f\x2d\x5c\x08\x65\xaf\xa8\xe5\x07\xc8\xfe\x0c\x43\xd9\x60\x8a\xdf\x48\x3c\x77\x11\x72\x91\x85\xc5\x6f\x9c\xe4\x1d\x30\xba\x25\xaf\x3e\xb6\x67\x8e\x97\x3a\x0a\x84\x4e\x05\x42\x66\x7a\x95\x3a\x3d\x28\x49\x20\x3b\xbe\x02\x12\xd9\xae\x3a\xc6\x4a\xdb\xcb\xf9\x8b\x85\xaf\x93\xa4\x2b\x8e\xac\x14\x80\xb5\xf9\xf8\x4a\x79\x72\x25\x79\x51\x27\xb1\xdb\x5f\x8a\xcc\xa8\xaa\x77\x40\x3a\xa8\x6b\x1e\xa4\xcd\x8a\xf3\xae\x52\xab\xfc\xcc\x8b\x74\xa9\xac\x7f\x00\x80\xe2\x2b\x64\x82\x64\x34\x2b\x02\x90\xd8\x6e\x23\xab\x50\x90\x5b\x90\xc4\xba\xa5\x52\x71\xfc\x64\x52\xc1\x8a\xc3\x86\x9c\x70\x3f\x37\x61\x38\x53\x2b\x12\xae\x4e\x9e\xfc\xd0\x3b\x6f\x93\x9d\x6f\xa7\x8d\xe9\x19\x77\x02\x0c\xae\x1d\x74\x53\x56\xae\x55\x65\x52\x80\x4c\x1e\x55\xe5\x52\x40\x15\x68\x48\x27\x2d\x2f\x9b\xc2\x4f\x7c\xfe\x9a\xa8\x28\xa3\x32\x7f\x18\xf3\xca\xa9\x90\x4e\xaa\x92\x2a\x92\xd1\xd1\x11\x55\x96\x56\x49\xa7\xaf\x2e\xaf\x22\x21\x81\x5a\x12\xc4\x72\x2b\xa0\xe2\x3c\x5b\x15\x87\x47\x1a\x65\x89\x1a\xa4\x41\x11\x90\x97\xb7\x89\x11\x53\x69\x53\x23\x27\xb0\x95\x07\x5c\x6a\xdc\xcd\x07\xeb\xe5\x37\x65\x01\x7b\x60\x2e\x52\x3f\x94\x20\x75\xb3\x5f\x13\xa9\xf7\xbb\x55\x48\x5d\x19\xcb\x07\x72\xf1\x1e\x96\x47\x2a\x09\xe9\x03\x60\x6e\x58\x1f\xed\x22\x0b\xed\x23\x2d\xca\xf0\x3e\x00\x54\x21\x7e\xb4\x45\x16\xe6\x47\x5a\x2a\x42\xfd\x00\x50\x85\x9d\xd0\x16\x65\xc8\x5f\xde\x2c\x0d\xfb\x23\xad\x8a\xd0\x3f\x00\x54\xe1\x7f\x22\x57\x64\x39\x22\x79\x83\x10\x09\x08\x2a\x2c\xa7\xea\x88\x40\x50\x69\x5c\x55\x45\x06\x8a\x63\x56\x84\xd9\xd1\x36\x65\x94\x20\xa8\x6d\x9b\x29\xa2\x05\x8b\x36\xb9\xfc\x2a\xa3\x06\x01\x98\x13\x39\x48\x3b\x54\x4d\x4b\x1a\x41\x98\xb6\x28\xa3\x08\xc1\x4a\x4c\xb1\x2d\xc2\xe0\x95\x20\x4a\x11\x3a\x08\x56\x82\x95\xc0\xfd\xbd\x47\xbf\xce\xfd\x3f\x60\xb1\xef\x76\x2f\x00\xb9\xd5\xba\x55\x1e\x72\x08\xea\x20\x30\x59\xe8\x21\xa8\x0f\xcf\x24\xc7\x6f\xe5\x8e\x92\x58\x44\x00\xd4\xf1\x88\x00\x48\x63\x12\x01\xa8\x11\x97\x08\x44\x3f\x86\x34\x3e\x91\x34\x89\x31\x8a\xe4\xb6\x2a\x4e\x91\x34\x2a\x62\x15\x49\x9b\x34\x5e\x11\x54\x2b\x0f\x31\x6e\x51\x9c\x82\x18\xbf\x08\x64\xab\x5f\x15\xc7\x08\xc0\x9c\xe0\x40\xd2\x41\x15\x20\x48\x1b\xe5\x41\x82\x00\x28\x03\x05\x01\x50\xc5\x34\xf2\x04\x59\x08\x3f\xaf\x71\x1a\xd2\x26\xe5\x3c\x94\x0b\x55\x8c\x81\x04\x40\x1d\x07\x59\xb4\x49\x62\x21\x41\xad\xca\x8d\x75\x60\xb4\x00\x1d\x34\x8a\x56\xa0\x68\x53\x82\xa2\x05\x68\xdd\x08\x8c\x3e\x90\x45\xad\x6f\x31\x8c\xd6\x48\x58\x23\xe1\x27\x47\xc2\x45\x6e\xcd\x66\x7b\x1f\x49\x7e\x8d\xc6\xc2\xa9\x94\xac\x0f\x0b\x17\x20\x98\x64\xe4\x68\x20\xbc\xb9\x40\x58\x95\xd8\x03\x34\x20\xde\x7e\x40\xac\xfd\xca\x32\x44\x7c\x54\x17\x11\x4b\xfd\xca\x87\x8f\x04\xc4\x83\xfd\x4d\x04\xc4\x1a\xf0\x6a\xc0\xdb\x22\xc0\xcb\x41\xdd\x1b\xe8\x60\xc7\x9b\x8c\xae\xfc\x90\x81\xbd\xda\xfd\xab\x21\x6f\x6d\xc8\xfb\x01\x79\xb6\xe3\x4d\x00\x0b\x7d\xb3\xef\xc7\x6a\xf8\xbb\xb1\xf0\x57\xfb\x81\x35\xec\xd5\xb0\x57\x0d\x7b\x65\xe1\x14\x02\x16\x6e\x04\xf7\x1e\xf4\x35\xee\xd5\xb8\x57\xe3\x5e\xd0\x20\xee\x65\xb0\xae\x8d\x5c\x78\x47\x0a\x53\x6a\xa0\xab\x81\x6e\x55\x9c\x03\x2d\x5b\xca\x02\xdd\xb3\x44\x76\xe8\xa7\x40\x35\xd4\x6d\x2f\xd4\x9d\x49\xd6\x85\xc6\xb9\x9b\x8e\x73\x77\xb8\xd7\x15\x2b\xf5\x0c\x62\x08\x2e\xc8\x4e\x1f\x31\x35\xd1\xd2\x65\x11\xfa\x37\xf2\x6f\x3d\xb2\x55\xa4\xea\x96\xed\x6c\xb0\x8c\x54\x86\x13\xf9\x32\x52\x4c\xfd\xc4\xbc\x8c\x94\x64\xd2\xff\x7e\x7f\x01\x2e\x50\x78\xed\x2c\x36\x69\x5d\x0a\x3f\xe3\xd8\x3e\xc7\xb1\xf9\xa5\xf0\x8f\x84\x61\xd4\xf9\xc0\xe5\x30\xbf\xb1\xf1\xd5\x4e\x75\x55\x7b\x5d\xb7\xb4\x53\x6e\x2b\xe7\x74\xfd\x39\x8b\xc2\xd8\x23\x00\x75\x23\xc3\x8b\x17\xc9\xea\x5a\x6b\x09\xd3\xa5\x71\x78\xfb\x4a\x9a\x26\x8a\x4c\x16\x4c\xac\x8b\x9a\x3e\x5d\x51\x53\x5d\xca\xb4\xad\xd5\xe7\x35\x1e\x03\x40\x59\x7e\x7e\x39\x40\x76\xa0\x01\x99\x06\x64\xcf\x00\x90\x15\xc1\xae\x0a\x58\xb6\x49\xc1\xae\x1a\x99\x65\xe2\xb1\x2e\x64\x56\x8e\x70\xd5\xe8\x6c\x39\x74\x26\x1e\x8e\x6a\x80\x56\x1a\x66\xdb\x00\x9a\xe5\xbb\xf1\x8c\x5f\x3b\x4f\x08\xac\x7c\x0f\x5f\x38\xdf\x89\xf4\x9a\xdd\xee\x7f\x19\xf3\x81\xd4\x80\x03\x52\x73\x5d\x91\xc3\xa1\x0c\x47\x55\x15\xe9\xca\x0a\x74\xf5\xab\x70\x42\x00\x27\x28\x1d\x7a\x89\x44\x91\x15\xfa\x04\x2d\x96\x9e\x28\x54\xeb\x2a\x5a\xe8\xe6\xc1\xcc\xd2\xf2\x5d\x6e\x1a\x76\x91\x71\x2f\xcc\x25\xc2\x77\x6e\xd5\xea\x24\x10\x3c\xa1\xaf\x78\xb0\x93\x89\x90\x01\x63\xec\x97\x9b\x6c\x88\x51\xb1\xef\xff\xfe\xfb\xef\xbf\xef\xbd\x7f\xbf\x77\x76\x06\x7e\xfa\xe9\xcd\x6c\xf6\x26\xe2\x34\x4c\x00\x31\x46\xa1\x27\x7f\x4d\xb6\xc3\x51\xf4\x5a\xb3\x54\x86\x91\xd5\x67\xae\x3b\x62\x52\x1d\x3e\xc5\x6c\xc2\x2a\xb3\x32\x13\xa4\x7c\x04\x63\x84\x93\x31\xdc\xed\x0d\x86\x1d\x30\x1c\xd0\xff\x75\x5f\x1d\xf1\xa9\x27\x69\xaf\xfe\x41\x07\x98\xbd\xa3\x0e\x18\x74\x93\x6e\x87\x8a\x7e\xc3\x6e\x07\x98\x07\xbd\x0e\x48\x9e\xda\x7d\x75\x74\xf0\x92\x3d\xdb\xfa\xfa\x18\x2a\xab\x3e\x22\x90\x7d\x1f\xe0\x92\x12\xda\x54\xb1\xe6\xcf\x59\x14\x89\xc5\xd5\xaa\x4f\x21\x53\xde\x45\x38\x74\xbc\x49\xf9\x77\x8a\xd2\xf8\x73\xd9\x9a\x15\xab\x3d\x13\xa3\xea\x34\x7f\xa5\x6e\x8e\x9a\xfc\x4d\xcb\x86\x9e\x2c\xce\x5e\x2f\x9e\x8d\x79\xb1\x58\x96\xbd\xb4\x48\x28\x78\x1f\x4d\x34\x73\x9b\x67\xee\x71\x1b\x98\x7b\x72\x87\x79\x1b\x43\x33\xb7\x01\xe6\x9e\x35\xcb\xdc\x31\x61\xd3\xa2\xcc\xd5\xdb\xf2\x6a\x98\xfb\xc3\x7a\x57\xee\x76\x73\x54\x85\x8c\x18\x36\xbc\x7e\xf5\xcf\xd7\x8b\xb3\x20\x05\xad\xb5\x58\xb0\xc3\x0d\xad\x8e\xb3\x4b\x38\x61\x1c\xd9\x28\xc2\x8e\x07\x5b\x74\xb6\x98\x1e\x1c\xe6\xf0\x51\xed\x81\x12\x0a\x3d\x56\x14\x79\x9c\xe7\x7c\xca\x1d\x46\x27\xf3\xa5\x5c\x46\xc7\x19\x8a\x22\x38\x41\xd1\x28\x44\x16\x72\xae\x91\xfd\xac\x89\x78\xbc\x1c\x11\x89\x0a\xd1\x14\x34\xca\x35\x42\x17\xa1\x60\x69\x39\xb7\xc0\x27\xbd\x66\x2a\xfe\xd0\x00\x15\xf5\xa6\x08\x8c\xb7\x95\x7a\xa7\xc2\x5b\x3e\xd7\x29\x4e\x2b\xab\x03\xa1\x4e\x6d\x51\xa3\x56\x9c\x55\xb9\x1a\xea\x9e\xef\xda\x3a\x3e\x8f\x34\x2c\xff\x39\x72\xc1\x8d\xb9\xcc\x71\xf0\x30\x77\x8e\xae\xe2\x34\x98\x3b\x42\x58\xf3\x61\xb0\xd4\x3b\xaa\xcf\x82\x9f\xc3\x59\xb0\x00\xf9\x5a\xa1\x21\x1e\x15\x92\x57\x43\xaf\xac\xfc\xb8\x37\x73\xd4\xee\xde\xdf\xe7\xc3\x79\x78\x78\x59\xe3\x5c\x57\xa9\xd9\x97\xe4\x67\x40\xd3\xb2\x37\x85\x9f\x6d\x60\x5e\x96\xc9\x5e\x8f\x79\x27\x2d\x39\x94\x7f\x9f\x72\x5c\x9f\xc4\xeb\x38\x49\xe9\x20\xb7\xf3\x18\x5e\xe3\xe2\xda\xb8\x58\x88\x93\x5c\x0e\x18\xef\xb7\x14\x18\x6b\xf0\xab\xc1\x2f\xed\xb0\x28\x58\xa2\xae\x3a\x8d\x7c\xb7\x02\xf9\x52\x66\x6a\xd8\xfb\x4c\x60\x6f\xf9\x40\x5d\x63\x5e\x8d\x79\x35\xe6\x2d\xa1\x96\x67\x0d\x79\x79\x57\xf0\xfe\xfe\x32\x88\xf7\xb0\x1a\xf1\xae\x30\xf3\x47\x67\xf7\x68\x50\x9b\x76\x58\x2a\xbb\xa7\x6d\x47\xf9\x8f\x4f\xeb\x69\x03\x52\x2a\x01\xa4\x96\xe7\xea\x7c\x4c\xf9\x0e\x52\xff\x20\xc9\xd6\x01\x1a\x30\xe9\x74\x1d\x8d\x99\x80\xc6\x4c\x75\xdc\x84\x4b\x81\x26\xd3\x9c\x93\x4e\xad\x51\x93\x46\x4d\x6d\x45\x4d\x6d\x8a\xdd\xd3\x90\x09\xac\x0b\x32\x9d\xdc\x61\xa4\xb3\x9b\x35\x5c\x7a\x86\x70\xe9\x91\x90\x86\x9d\x09\x49\x7c\x10\x2b\x3f\xe6\xc2\x59\x2c\x6c\xa6\x43\xb9\x0c\xa8\x15\x47\x98\x2c\x71\x45\xf5\x48\x49\xdd\xd1\x2b\xc7\xc5\x28\x64\x4f\x21\x95\x4f\x57\xa6\x40\xd8\x4e\x14\xb8\x30\xfb\xde\x3d\x27\x8d\x69\x0a\x8b\x2c\x01\x82\xdd\xaa\x14\xb3\x86\xe3\xc8\x77\x63\x2c\x14\x52\x4d\x94\x9d\x98\x24\x22\x56\x04\x65\xe8\x6a\x4c\x42\xc4\xe5\x59\xa4\x3d\xc8\xda\x15\x6b\x67\x0a\x14\x98\xf7\x82\x64\x73\xab\x78\xfc\x61\x97\x7f\x78\xe9\x5a\x5d\xdd\x75\x7e\x42\x4e\x19\x0f\xef\xa8\x86\x6b\xcc\x20\xb6\xa6\x48\x94\xb2\x0c\x8d\x1a\xe3\x3b\x21\x25\x1a\x94\x01\x18\x4d\xbf\xde\xa9\x20\x92\x11\x84\x7e\x80\x42\xec\x48\x4a\xd2\x4a\x09\x48\x5f\xcd\x4a\x51\x05\x15\x25\xef\xaf\xc7\x26\xfa\x16\xba\x44\x5e\xd1\x15\xb1\xa0\x2c\xd4\x63\xd7\x0a\x48\xce\xc4\xa6\xaf\x93\xf2\x67\xaa\x61\x2c\xc2\x00\x22\xcc\x55\x6f\xe1\xa4\x7c\xd1\xe7\xe7\xbb\x54\xc5\x3b\x7a\xcf\x4b\x7c\xf2\xc4\xde\x35\xca\x4e\x76\xde\x7d\xea\x7b\x51\x3c\x43\x61\xd4\x5e\x09\xda\x64\x1e\x9f\xb4\x81\xc7\x24\x31\x5f\xb3\x37\x7b\x60\x83\xec\x3d\x5d\x27\x7b\xb3\xc0\x07\xcd\xde\xd2\x03\x1b\x64\xef\xd9\xba\x57\x2f\x59\xbe\x27\xe5\xa4\x7e\xe9\x58\x1e\xc3\xe0\xf1\xe3\x9e\xbf\xc9\x0c\xfe\xa1\x0d\xeb\x77\xd5\xfc\x7d\xc6\x0b\xf8\xed\x5a\x0d\x23\x52\x5c\x43\xef\xce\xa5\x07\x36\xc8\xdc\x1f\xd7\xcf\xdc\x67\xb9\x74\x77\xf8\xbb\x85\x67\x55\x79\x7a\x37\x64\x7c\x23\xb5\x6a\xf8\x1d\xf4\x85\x87\xd7\xac\xe1\x97\x7f\xee\x4e\x76\xae\x25\x3d\xb8\x12\x4a\xf5\x09\xaf\x9e\x7b\x02\xd5\x78\x6c\xb7\x8d\x22\x3c\xb2\x32\xe3\x6c\x0b\x82\xbc\x19\x9f\x49\xc3\x89\xf3\x8f\x3e\xe4\x79\xba\x20\x6f\xc2\xd5\x2d\xca\x59\xdd\x3c\xa6\xd6\x2a\x2d\xf3\x18\xa6\x6e\x58\x04\xff\x56\x30\xf5\x74\x45\x4c\xdd\x96\x04\x9b\xcd\xe3\x68\xad\xd2\x3b\x4b\x73\x54\xaf\xd1\xa7\xe7\x68\xad\x32\x40\x8f\xd9\x78\x37\x27\xec\x65\x8b\xb8\xfa\x76\xa5\xeb\x54\xb3\x74\x0d\x2c\xfd\x71\x35\x75\xa6\x98\xc3\xca\xa8\xaa\xd4\x14\xcc\x0c\x34\x85\x21\x45\x2d\xdb\x19\x0a\x27\x1c\xc9\x94\xdf\xb9\x0e\x91\x1d\x5b\x28\x8c\x84\x4f\xb6\x56\xcd\x93\xad\x6e\x95\x56\xb6\xda\x49\x3b\x24\xa4\x0a\x51\x34\x65\x83\x55\x8c\xc8\x9a\xa2\x19\x2c\x2c\xc4\xde\x01\xbd\x8d\xef\xe8\xf4\x6d\x18\x7e\x23\xe3\x35\x30\x64\x82\x2f\x0c\x4c\x16\x07\x76\xbc\x22\x2a\xc5\x70\x9d\x08\x33\x04\x28\x05\xc9\xb8\x9f\x19\x9b\x9d\xb1\x99\x8b\x08\xd4\x12\xb5\x22\xe4\x22\x0b\x4b\x3f\xd9\x6b\x60\x74\x4b\xe4\xe0\x98\xff\x62\x78\xee\x7e\x78\x31\x1a\x41\xd7\x95\x47\x38\x94\x22\x6d\x8c\x0f\xa1\x3f\x43\x78\x8a\x62\xf6\x9c\xd7\xb0\xd1\x95\xe3\x39\x38\xb5\x99\x49\xe4\xd1\x88\x06\x66\x49\x96\xca\xcb\xf2\x2f\x23\x2b\x74\x82\xf4\xa7\xdc\x5c\x51\x18\x12\xf1\xe6\x6e\x4f\xa9\x4f\x80\xf5\x24\x38\x9e\xe5\xc6\x36\x3a\x16\xca\xf3\xb3\xd1\x5a\x9f\xf3\xa1\x80\x53\x3a\x14\x76\x24\xb3\xd8\xc5\x8e\x24\x2e\x29\x0d\x46\x33\xf2\x5d\x80\xfd\x51\x21\x8b\x6c\x8c\x8d\xf1\x57\x8c\xc2\x3b\x9e\x45\xd9\xcd\x45\xe8\xc3\xae\xd7\x82\xf4\x7b\xf9\x58\xf6\x3e\xc3\xd0\x21\x85\xd9\xfe\x43\x9e\x2e\xe5\x60\x21\xc6\x66\xe9\xee\x04\xdd\x72\xdb\x83\x11\x7d\x73\x82\x4f\xa1\x7b\x71\xe7\x59\xb2\xb8\x65\x31\x5e\x10\xc3\x09\x11\xd4\xe8\x3f\xd9\xec\x8c\x72\xab\x40\x9b\xe4\x9e\xbc\x73\xba\x14\x29\x9d\x98\x86\x38\x42\x97\xf4\x41\xd2\x08\xe8\x45\x97\x4d\x83\xc2\x4d\x54\xd9\xf5\x6c\x14\x07\xe4\xbb\xec\x35\xf4\x07\xa3\x91\x72\xe5\xb6\xf2\x05\xc1\xf3\x31\x5f\x11\x67\x64\x08\x8b\xac\x82\x42\x09\x37\xbb\x0c\x9a\xa7\xa4\x62\xe9\x14\x13\x58\x70\xed\xf4\x1a\x59\x3b\xe6\x66\xaf\x9d\x92\x82\x8e\xce\x7d\x8f\x77\xce\x02\x4e\x19\x09\xdf\xcd\xce\xb5\x11\xf9\xb1\x5c\x1d\xad\x44\x11\x2d\x2c\x61\x32\x01\xe3\x71\xe8\x43\x47\x86\x43\xd7\xb7\xa0\x7f\x43\xe3\x77\xc9\x68\x96\x51\x70\xcc\x04\xd6\xbc\xb6\x9b\xa1\xbc\x62\x03\x60\x7a\x6f\xfa\x0e\x20\xa1\xd2\x0b\xf9\x06\xbd\x8e\xfd\xa1\x06\x24\xe5\x4a\xcd\x1f\xb3\x18\x94\x2f\x04\x9f\xed\x0e\xdc\x6f\x78\xe8\xca\x9e\x61\xb5\x7d\xf7\xa8\x69\xc5\x3e\xcc\xfb\x56\xe0\x93\xa2\xe8\x0b\xfe\x3b\x43\xf9\xc6\xc2\xfd\x22\xdb\x57\x8a\x31\x6f\xc0\xb6\xd2\x14\x4b\x14\x9b\x4f\xd1\x79\x2d\x7b\xcf\x86\x23\xf7\xfa\x18\x43\x1c\x3d\x83\x3c\x86\x0a\xd8\x31\x54\xe0\x8e\xa7\x54\xe0\x97\x7e\x00\xce\x17\xd1\xda\xd8\x0f\xce\x15\xeb\x8a\x99\x24\xe7\x29\xa9\x22\x1e\x43\xa8\x3e\x9f\xfd\x91\x93\xaa\xaf\xcc\x85\x51\xbf\x89\x57\x02\x4a\x8e\x28\x78\x52\xfb\x45\x55\x53\x3a\x50\xbe\xe9\xa0\xe1\x37\x99\x5d\xe5\xab\xcc\x6e\xe9\x5d\xf9\xdf\xb2\x8d\xd0\xe8\x77\xc0\xb0\x03\x0e\x3a\xa0\xf4\x40\xda\xfe\x39\x47\xcc\xb5\x77\x82\x6c\xad\xa6\x29\x4b\x3b\xec\x18\x12\xcd\xf9\x40\xbd\x55\x0e\x91\xae\xd4\x4f\x75\x45\x1d\x81\x86\xe7\xdf\xec\xf5\xbb\xb3\xf4\x65\x06\xf6\xd3\x9b\x46\xe9\x77\x81\x63\x7d\x63\x02\x7f\xb2\xef\xac\x94\x86\x92\xed\x6c\xa3\xcc\x95\xc9\x0a\xac\x31\x64\x0a\x66\x99\x5d\xe6\xa2\xcf\x5e\x98\xb3\xe2\xef\x21\xf3\xb7\xc9\x5e\x14\xc3\x4d\x5a\x98\x3c\xc5\x1e\xf3\xb7\x99\xd6\xb4\xff\x9a\x4d\xcc\x99\xa1\x91\xb8\x90\xd4\x6f\x61\x1f\xbc\xcf\x3e\x98\x7d\x4b\x6f\xc0\x5e\x14\x19\x4b\xc6\x81\xcd\x8e\x37\x1b\x4b\x89\xa6\xdf\xa9\xa5\x65\xa4\x9e\xc5\xcc\xd7\x9a\xc3\xfd\xb4\xb2\xff\x19\x8c\xa6\x63\x1f\x86\xf4\x81\x46\x4c\x7d\xa8\xff\xfb\xcb\xcd\x2f\x27\xf1\x89\x07\xe9\xdd\xeb\xdc\x77\x69\xee\x3c\xfc\x7f\x00\x00\x00\xff\xff\x6c\x17\xcd\x41\xd3\x89\x01\x00")
-
-func manifestsDashboardsWeblogicWeblogic_dashboardJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_manifestsDashboardsWeblogicWeblogic_dashboardJson,
-		"manifests/dashboards/weblogic/weblogic_dashboard.json",
-	)
-}
-
-func manifestsDashboardsWeblogicWeblogic_dashboardJson() (*asset, error) {
-	bytes, err := manifestsDashboardsWeblogicWeblogic_dashboardJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "manifests/dashboards/weblogic/weblogic_dashboard.json", size: 100819, mode: os.FileMode(420), modTime: time.Unix(1647457998, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-// Asset loads and returns the asset for the given name.
-// It returns an error if the asset could not be found or
-// could not be loaded.
-func Asset(name string) ([]byte, error) {
-	cannonicalName := strings.Replace(name, "\\", "/", -1)
-	if f, ok := _bindata[cannonicalName]; ok {
-		a, err := f()
-		if err != nil {
-			return nil, fmt.Errorf("Asset %s can't read by error: %v", name, err)
-		}
-		return a.bytes, nil
-	}
-	return nil, fmt.Errorf("Asset %s not found", name)
-}
-
-// MustAsset is like Asset but panics when Asset would return an error.
-// It simplifies safe initialization of global variables.
-func MustAsset(name string) []byte {
-	a, err := Asset(name)
-	if err != nil {
-		panic("asset: Asset(" + name + "): " + err.Error())
-	}
-
-	return a
-}
-
-// AssetInfo loads and returns the asset info for the given name.
-// It returns an error if the asset could not be found or
-// could not be loaded.
-func AssetInfo(name string) (os.FileInfo, error) {
-	cannonicalName := strings.Replace(name, "\\", "/", -1)
-	if f, ok := _bindata[cannonicalName]; ok {
-		a, err := f()
-		if err != nil {
-			return nil, fmt.Errorf("AssetInfo %s can't read by error: %v", name, err)
-		}
-		return a.info, nil
-	}
-	return nil, fmt.Errorf("AssetInfo %s not found", name)
-}
-
-// AssetNames returns the names of the assets.
-func AssetNames() []string {
-	names := make([]string, 0, len(_bindata))
-	for name := range _bindata {
-		names = append(names, name)
-	}
-	return names
-}
-
-// _bindata is a table, holding each asset generator, mapped to its name.
-var _bindata = map[string]func() (*asset, error){
-	"manifests/dashboards/coherence/alerts-dashboard.json":                manifestsDashboardsCoherenceAlertsDashboardJson,
-	"manifests/dashboards/coherence/cache-details-dashboard.json":         manifestsDashboardsCoherenceCacheDetailsDashboardJson,
-	"manifests/dashboards/coherence/caches-summary-dashboard.json":        manifestsDashboardsCoherenceCachesSummaryDashboardJson,
-	"manifests/dashboards/coherence/coherence-dashboard-main.json":        manifestsDashboardsCoherenceCoherenceDashboardMainJson,
-	"manifests/dashboards/coherence/elastic-data-summary-dashboard.json":  manifestsDashboardsCoherenceElasticDataSummaryDashboardJson,
-	"manifests/dashboards/coherence/federation-details-dashboard.json":    manifestsDashboardsCoherenceFederationDetailsDashboardJson,
-	"manifests/dashboards/coherence/federation-summary-dashboard.json":    manifestsDashboardsCoherenceFederationSummaryDashboardJson,
-	"manifests/dashboards/coherence/http-servers-summary-dashboard.json":  manifestsDashboardsCoherenceHttpServersSummaryDashboardJson,
-	"manifests/dashboards/coherence/kubernetes-summary-dashboard.json":    manifestsDashboardsCoherenceKubernetesSummaryDashboardJson,
-	"manifests/dashboards/coherence/machines-summary-dashboard.json":      manifestsDashboardsCoherenceMachinesSummaryDashboardJson,
-	"manifests/dashboards/coherence/member-details-dashboard.json":        manifestsDashboardsCoherenceMemberDetailsDashboardJson,
-	"manifests/dashboards/coherence/members-summary-dashboard.json":       manifestsDashboardsCoherenceMembersSummaryDashboardJson,
-	"manifests/dashboards/coherence/persistence-summary-dashboard.json":   manifestsDashboardsCoherencePersistenceSummaryDashboardJson,
-	"manifests/dashboards/coherence/proxy-server-detail-dashboard.json":   manifestsDashboardsCoherenceProxyServerDetailDashboardJson,
-	"manifests/dashboards/coherence/proxy-servers-summary-dashboard.json": manifestsDashboardsCoherenceProxyServersSummaryDashboardJson,
-	"manifests/dashboards/coherence/service-details-dashboard.json":       manifestsDashboardsCoherenceServiceDetailsDashboardJson,
-	"manifests/dashboards/coherence/services-summary-dashboard.json":      manifestsDashboardsCoherenceServicesSummaryDashboardJson,
-	"manifests/dashboards/helidon/helidon_dashboard.json":                 manifestsDashboardsHelidonHelidon_dashboardJson,
-	"manifests/dashboards/system/opensearch_dashboard.json":               manifestsDashboardsSystemOpensearch_dashboardJson,
-	"manifests/dashboards/system/system_dashboard.json":                   manifestsDashboardsSystemSystem_dashboardJson,
-	"manifests/dashboards/vmi_dashboard_provider.yml":                     manifestsDashboardsVmi_dashboard_providerYml,
-	"manifests/dashboards/weblogic/weblogic_dashboard.json":               manifestsDashboardsWeblogicWeblogic_dashboardJson,
-}
-
-// AssetDir returns the file names below a certain
-// directory embedded in the file by go-bindata.
-// For example if you run go-bindata on data/... and data contains the
-// following hierarchy:
-//     data/
-//       foo.txt
-//       img/
-//         a.png
-//         b.png
-// then AssetDir("data") would return []string{"foo.txt", "img"}
-// AssetDir("data/img") would return []string{"a.png", "b.png"}
-// AssetDir("foo.txt") and AssetDir("notexist") would return an error
-// AssetDir("") will return []string{"data"}.
-func AssetDir(name string) ([]string, error) {
-	node := _bintree
-	if len(name) != 0 {
-		cannonicalName := strings.Replace(name, "\\", "/", -1)
-		pathList := strings.Split(cannonicalName, "/")
-		for _, p := range pathList {
-			node = node.Children[p]
-			if node == nil {
-				return nil, fmt.Errorf("Asset %s not found", name)
-			}
-		}
-	}
-	if node.Func != nil {
-		return nil, fmt.Errorf("Asset %s not found", name)
-	}
-	rv := make([]string, 0, len(node.Children))
-	for childName := range node.Children {
-		rv = append(rv, childName)
-	}
-	return rv, nil
-}
-
-type bintree struct {
-	Func     func() (*asset, error)
-	Children map[string]*bintree
-}
-
-var _bintree = &bintree{nil, map[string]*bintree{
-	"manifests": &bintree{nil, map[string]*bintree{
-		"dashboards": &bintree{nil, map[string]*bintree{
-			"coherence": &bintree{nil, map[string]*bintree{
-				"alerts-dashboard.json":                &bintree{manifestsDashboardsCoherenceAlertsDashboardJson, map[string]*bintree{}},
-				"cache-details-dashboard.json":         &bintree{manifestsDashboardsCoherenceCacheDetailsDashboardJson, map[string]*bintree{}},
-				"caches-summary-dashboard.json":        &bintree{manifestsDashboardsCoherenceCachesSummaryDashboardJson, map[string]*bintree{}},
-				"coherence-dashboard-main.json":        &bintree{manifestsDashboardsCoherenceCoherenceDashboardMainJson, map[string]*bintree{}},
-				"elastic-data-summary-dashboard.json":  &bintree{manifestsDashboardsCoherenceElasticDataSummaryDashboardJson, map[string]*bintree{}},
-				"federation-details-dashboard.json":    &bintree{manifestsDashboardsCoherenceFederationDetailsDashboardJson, map[string]*bintree{}},
-				"federation-summary-dashboard.json":    &bintree{manifestsDashboardsCoherenceFederationSummaryDashboardJson, map[string]*bintree{}},
-				"http-servers-summary-dashboard.json":  &bintree{manifestsDashboardsCoherenceHttpServersSummaryDashboardJson, map[string]*bintree{}},
-				"kubernetes-summary-dashboard.json":    &bintree{manifestsDashboardsCoherenceKubernetesSummaryDashboardJson, map[string]*bintree{}},
-				"machines-summary-dashboard.json":      &bintree{manifestsDashboardsCoherenceMachinesSummaryDashboardJson, map[string]*bintree{}},
-				"member-details-dashboard.json":        &bintree{manifestsDashboardsCoherenceMemberDetailsDashboardJson, map[string]*bintree{}},
-				"members-summary-dashboard.json":       &bintree{manifestsDashboardsCoherenceMembersSummaryDashboardJson, map[string]*bintree{}},
-				"persistence-summary-dashboard.json":   &bintree{manifestsDashboardsCoherencePersistenceSummaryDashboardJson, map[string]*bintree{}},
-				"proxy-server-detail-dashboard.json":   &bintree{manifestsDashboardsCoherenceProxyServerDetailDashboardJson, map[string]*bintree{}},
-				"proxy-servers-summary-dashboard.json": &bintree{manifestsDashboardsCoherenceProxyServersSummaryDashboardJson, map[string]*bintree{}},
-				"service-details-dashboard.json":       &bintree{manifestsDashboardsCoherenceServiceDetailsDashboardJson, map[string]*bintree{}},
-				"services-summary-dashboard.json":      &bintree{manifestsDashboardsCoherenceServicesSummaryDashboardJson, map[string]*bintree{}},
-			}},
-			"helidon": &bintree{nil, map[string]*bintree{
-				"helidon_dashboard.json": &bintree{manifestsDashboardsHelidonHelidon_dashboardJson, map[string]*bintree{}},
-			}},
-			"system": &bintree{nil, map[string]*bintree{
-				"opensearch_dashboard.json": &bintree{manifestsDashboardsSystemOpensearch_dashboardJson, map[string]*bintree{}},
-				"system_dashboard.json":     &bintree{manifestsDashboardsSystemSystem_dashboardJson, map[string]*bintree{}},
-			}},
-			"vmi_dashboard_provider.yml": &bintree{manifestsDashboardsVmi_dashboard_providerYml, map[string]*bintree{}},
-			"weblogic": &bintree{nil, map[string]*bintree{
-				"weblogic_dashboard.json": &bintree{manifestsDashboardsWeblogicWeblogic_dashboardJson, map[string]*bintree{}},
-			}},
-		}},
-	}},
-}}
-
-// RestoreAsset restores an asset under the given directory
-func RestoreAsset(dir, name string) error {
-	data, err := Asset(name)
-	if err != nil {
-		return err
-	}
-	info, err := AssetInfo(name)
-	if err != nil {
-		return err
-	}
-	err = os.MkdirAll(_filePath(dir, filepath.Dir(name)), os.FileMode(0755))
-	if err != nil {
-		return err
-	}
-	err = ioutil.WriteFile(_filePath(dir, name), data, info.Mode())
-	if err != nil {
-		return err
-	}
-	err = os.Chtimes(_filePath(dir, name), info.ModTime(), info.ModTime())
-	if err != nil {
-		return err
-	}
-	return nil
-}
-
-// RestoreAssets restores an asset under the given directory recursively
-func RestoreAssets(dir, name string) error {
-	children, err := AssetDir(name)
-	// File
-	if err != nil {
-		return RestoreAsset(dir, name)
-	}
-	// Dir
-	for _, child := range children {
-		err = RestoreAssets(dir, filepath.Join(name, child))
-		if err != nil {
-			return err
-		}
-	}
-	return nil
-}
-
-func _filePath(dir, name string) string {
-	cannonicalName := strings.Replace(name, "\\", "/", -1)
-	return filepath.Join(append([]string{dir}, strings.Split(cannonicalName, "/")...)...)
-}
@@ -4,13 +4,13 @@
 package grafana
 
 import (
-	"fmt"
-
+	vmov1 "github.com/verrazzano/verrazzano-monitoring-operator/pkg/apis/vmcontroller/v1"
 	vzapi "github.com/verrazzano/verrazzano/platform-operator/apis/verrazzano/v1alpha1"
 	"github.com/verrazzano/verrazzano/platform-operator/constants"
 	"github.com/verrazzano/verrazzano/platform-operator/controllers/verrazzano/component/common"
 	"github.com/verrazzano/verrazzano/platform-operator/controllers/verrazzano/component/spi"
 	"github.com/verrazzano/verrazzano/platform-operator/controllers/verrazzano/component/vmo"
+	"github.com/verrazzano/verrazzano/platform-operator/internal/k8s/status"
 	"github.com/verrazzano/verrazzano/platform-operator/internal/vzconfig"
 	"k8s.io/apimachinery/pkg/types"
 )
@@ -115,6 +115,11 @@ func (g grafanaComponent) IsReady(ctx spi.ComponentContext) bool {
 	return isGrafanaReady(ctx)
 }
 
+// GetNotReadyWorkloads returns the Grafana deployment if it is not ready
+func (g grafanaComponent) GetNotReadyWorkloads(ctx spi.ComponentContext) ([]string, error) {
+	return status.GetNotReadyNamedWorkloads(ctx.Client(), newDeployments())
+}
+
 // ValidateInstall checks if the specified Verrazzano CR is valid for this component to be installed
 func (g grafanaComponent) ValidateInstall(_ *vzapi.Verrazzano) error {
 	return nil
@@ -125,7 +130,7 @@ func (g grafanaComponent) PreInstall(ctx spi.ComponentContext) error {
 	if err := common.EnsureVMISecret(ctx.Client()); err != nil {
 		return err
 	}
-	if err := common.EnsureBackupSecret(ctx.Client()); err != nil {
+	if err := common.EnsureBackupSecret(ctx.Client(), ctx.EffectiveCR()); err != nil {
 		return err
 	}
 	if err := common.CreateAndLabelVMINamespaces(ctx); err != nil {
@@ -170,12 +175,30 @@ func (g grafanaComponent) PostUpgrade(ctx spi.ComponentContext) error {
 	return common.CheckIngressesAndCerts(ctx, g)
 }
 
+// IsUninstalled returns true if the Grafana deployment has been removed
+func (g grafanaComponent) IsUninstalled(ctx spi.ComponentContext) (bool, error) {
+	return !isGrafanaInstalled(ctx), nil
+}
+
+// PreUninstall performs any pre-uninstall processing for the Grafana component
+func (g grafanaComponent) PreUninstall(_ spi.ComponentContext) error {
+	return nil
+}
+
+// Uninstall removes Grafana from the VMI, the VMO then removes the Grafana resources
+func (g grafanaComponent) Uninstall(ctx spi.ComponentContext) error {
+	return common.RemoveFromVMI(ctx, func(vmi *vmov1.VerrazzanoMonitoringInstance) {
+		vmi.Spec.Grafana = vmov1.Grafana{}
+	})
+}
+
+// PostUninstall performs any post-uninstall processing for the Grafana component
+func (g grafanaComponent) PostUninstall(_ spi.ComponentContext) error {
+	return nil
+}
+
 // ValidateUpdate checks if the specified new Verrazzano CR is valid for this component to be updated
 func (g grafanaComponent) ValidateUpdate(old *vzapi.Verrazzano, new *vzapi.Verrazzano) error {
-	// do not allow disabling active components
-	if vzconfig.IsGrafanaEnabled(old) && !vzconfig.IsGrafanaEnabled(new) {
-		return fmt.Errorf("Disabling component Grafana not allowed")
-	}
 	return nil
 }
 
@@ -13,12 +13,16 @@ import (
 	vzapi "github.com/verrazzano/verrazzano/platform-operator/apis/verrazzano/v1alpha1"
 	"github.com/verrazzano/verrazzano/platform-operator/constants"
 	"github.com/verrazzano/verrazzano/platform-operator/controllers/verrazzano/component/spi"
+	"github.com/verrazzano/verrazzano/platform-operator/internal/config"
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 )
 
-const profilesRelativePath = "../../../../manifests/profiles"
+const (
+	profilesRelativePath = "../../../../manifests/profiles"
+	rootRelativePath     = "../../../../.."
+)
 
 var (
 	falseValue = false
@@ -248,7 +252,7 @@ func TestPreUpgrade(t *testing.T) {
 func TestInstall(t *testing.T) {
 	// GIVEN a Verrazzano CR with Grafana enabled
 	// WHEN the Grafana component Install function is called
-	// THEN the system dashboards configmap is created
+	// THEN the system dashboards configmap is created with the dashboard provider and the platform dashboards
 	// AND the VMI instance is created with the expected Grafana config
 	testInstallOrUpgrade(t, NewComponent().Install)
 }
@@ -256,13 +260,19 @@ func TestInstall(t *testing.T) {
 func TestUpgrade(t *testing.T) {
 	// GIVEN a Verrazzano CR with Grafana enabled
 	// WHEN the Grafana component Upgrade function is called
-	// THEN the system dashboards configmap is created
+	// THEN the system dashboards configmap is created with the dashboard provider and the platform dashboards
 	// AND the VMI instance is created with the expected Grafana config
 	testInstallOrUpgrade(t, NewComponent().Upgrade)
 }
 
 // testInstallOrUpgrade tests both the Grafana component Install and Update functions
 func testInstallOrUpgrade(t *testing.T, installOrUpgradeFunc func(spi.ComponentContext) error) {
+	oldConfig := config.Get()
+	defer config.Set(oldConfig)
+	config.Set(config.OperatorConfig{
+		VerrazzanoRootDir: rootRelativePath,
+	})
+
 	client := fake.NewFakeClientWithScheme(testScheme)
 	vz := &vzapi.Verrazzano{
 		Spec: vzapi.VerrazzanoSpec{
@@ -283,7 +293,7 @@ func testInstallOrUpgrade(t *testing.T, installOrUpgradeFunc func(spi.ComponentC
 	dashboardsConfigMap := &v1.ConfigMap{}
 	err = client.Get(context.TODO(), types.NamespacedName{Name: "system-dashboards", Namespace: globalconst.VerrazzanoSystemNamespace}, dashboardsConfigMap)
 	assert.NoError(t, err)
-	assert.Len(t, dashboardsConfigMap.Data, len(dashboardList))
+	assert.Len(t, dashboardsConfigMap.Data, 22)
 
 	// make sure the VMI was created and the Grafana config is as expected
 	vmi := &vmov1.VerrazzanoMonitoringInstance{}
@@ -297,7 +307,7 @@ func testInstallOrUpgrade(t *testing.T, installOrUpgradeFunc func(spi.ComponentC
 func TestValidateUpdate(t *testing.T) {
 	// GIVEN an old VZ with Grafana enabled and a new VZ with Grafana disabled
 	// WHEN we call the ValidateUpdate function
-	// THEN the function returns an error
+	// THEN the function does not return an error
 	oldVz := &vzapi.Verrazzano{
 		Spec: vzapi.VerrazzanoSpec{
 			Components: vzapi.ComponentSpec{
@@ -318,7 +328,7 @@ func TestValidateUpdate(t *testing.T) {
 		},
 	}
 
-	assert.Error(t, NewComponent().ValidateUpdate(oldVz, newVz))
+	assert.NoError(t, NewComponent().ValidateUpdate(oldVz, newVz))
 
 	// GIVEN an old VZ with Grafana enabled and a new VZ with Grafana enabled
 	// WHEN we call the ValidateUpdate function
@@ -0,0 +1,161 @@
+// Copyright (c) 2022, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package helm
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/verrazzano/verrazzano/pkg/helm"
+	"github.com/verrazzano/verrazzano/platform-operator/constants"
+	"github.com/verrazzano/verrazzano/platform-operator/controllers/verrazzano/component/spi"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/yaml"
+)
+
+// driftWorkloadKinds are the kinds of the resources of a release that are checked for drift
+var driftWorkloadKinds = map[string]bool{
+	"Deployment":  true,
+	"StatefulSet": true,
+	"DaemonSet":   true,
+}
+
+// GetDrift returns the differences between the deployed Helm release and the configuration of the Verrazzano resource.
+// The release values are compared with the values an install would apply now, values that are no longer overridden
+// are ignored since an upgrade retains them, and so are the generated values.  The workloads of the release are
+// compared with the release manifest.  The check is done in a dry run, it does not change the cluster.
+func (h HelmComponent) GetDrift(context spi.ComponentContext) ([]string, error) {
+	context = spi.NewDryRunContext(context)
+	resolvedNamespace := h.resolveNamespace(context.EffectiveCR().Namespace)
+	found, err := helm.IsReleaseInstalled(h.ReleaseName, resolvedNamespace)
+	if err != nil || !found {
+		return nil, err
+	}
+
+	current, planned, err := h.GetPlannedValues(context.Operation(constants.InstallOperation))
+	if err != nil {
+		return nil, err
+	}
+	h.removeGeneratedValues(current)
+	h.removeGeneratedValues(planned)
+	var drift []string
+	for _, line := range diffValues(current, planned, false) {
+		drift = append(drift, "Helm value "+line)
+	}
+
+	workloadDrift, err := h.getWorkloadDrift(context, resolvedNamespace)
+	if err != nil {
+		return nil, err
+	}
+	return append(drift, workloadDrift...), nil
+}
+
+// removeGeneratedValues removes the values that are generated each time the overrides are built from the values.  The
+// credentials are generated or rotated outside of the Verrazzano resource, and the image pull secret is only set
+// when the global image pull secret exists.
+func (h HelmComponent) removeGeneratedValues(values map[string]interface{}) {
+	generated := append([]string{}, h.GeneratedValues...)
+	if len(h.ImagePullSecretKeyname) > 0 {
+		// The flattened values do not index into lists
+		generated = append(generated, strings.SplitN(h.ImagePullSecretKeyname, "[", 2)[0])
+	}
+	for _, key := range generated {
+		removeValue(values, strings.Split(key, "."))
+	}
+	removeSensitiveValues(values)
+}
+
+// removeValue removes the value at the path of keys from the nested values
+func removeValue(values map[string]interface{}, path []string) {
+	if len(path) == 1 {
+		delete(values, path[0])
+		return
+	}
+	if nested, ok := values[path[0]].(map[string]interface{}); ok {
+		removeValue(nested, path[1:])
+	}
+}
+
+// removeSensitiveValues removes the values whose keys name credentials from the nested values
+func removeSensitiveValues(values map[string]interface{}) {
+	for key, value := range values {
+		if sensitiveKeyPattern.MatchString(key) {
+			delete(values, key)
+			continue
+		}
+		if nested, ok := value.(map[string]interface{}); ok {
+			removeSensitiveValues(nested)
+		}
+	}
+}
+
+// getWorkloadDrift returns the workloads of the release manifest that are missing, or whose container images were
+// changed on the cluster
+func (h HelmComponent) getWorkloadDrift(ctx spi.ComponentContext, namespace string) ([]string, error) {
+	manifest, err := helm.GetManifest(ctx.Log(), h.ReleaseName, namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	var drift []string
+	for _, doc := range strings.Split(string(manifest), "\n---") {
+		expected := &unstructured.Unstructured{}
+		if err := yaml.Unmarshal([]byte(doc), &expected.Object); err != nil || expected.Object == nil {
+			continue
+		}
+		kind := expected.GetKind()
+		if !driftWorkloadKinds[kind] {
+			continue
+		}
+		if len(expected.GetNamespace()) == 0 {
+			expected.SetNamespace(namespace)
+		}
+		name := fmt.Sprintf("%s %s/%s", kind, expected.GetNamespace(), expected.GetName())
+
+		actual := &unstructured.Unstructured{}
+		actual.SetGroupVersionKind(expected.GroupVersionKind())
+		err := ctx.Client().Get(context.TODO(), types.NamespacedName{Namespace: expected.GetNamespace(), Name: expected.GetName()}, actual)
+		if errors.IsNotFound(err) {
+			drift = append(drift, fmt.Sprintf("%s is missing", name))
+			continue
+		}
+		if err != nil {
+			return nil, ctx.Log().ErrorfNewErr("Failed to get %s: %v", name, err)
+		}
+
+		actualImages := getContainerImages(actual)
+		expectedImages := getContainerImages(expected)
+		var containers []string
+		for container := range expectedImages {
+			containers = append(containers, container)
+		}
+		sort.Strings(containers)
+		for _, container := range containers {
+			if image := expectedImages[container]; actualImages[container] != image {
+				drift = append(drift, fmt.Sprintf("%s container %s has image %s, expected %s", name, container, actualImages[container], image))
+			}
+		}
+	}
+	return drift, nil
+}
+
+// getContainerImages returns the image of each container of the pod template of the workload, keyed by container name
+func getContainerImages(workload *unstructured.Unstructured) map[string]string {
+	images := map[string]string{}
+	containers, _, _ := unstructured.NestedSlice(workload.Object, "spec", "template", "spec", "containers")
+	for _, c := range containers {
+		container, ok := c.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		name, _, _ := unstructured.NestedString(container, "name")
+		image, _, _ := unstructured.NestedString(container, "image")
+		images[name] = image
+	}
+	return images
+}
@@ -0,0 +1,105 @@
+// Copyright (c) 2022, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package helm
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/verrazzano/verrazzano/pkg/bom"
+	"github.com/verrazzano/verrazzano/pkg/helm"
+	"github.com/verrazzano/verrazzano/platform-operator/apis/verrazzano/v1alpha1"
+	"github.com/verrazzano/verrazzano/platform-operator/controllers/verrazzano/component/spi"
+	"github.com/verrazzano/verrazzano/platform-operator/internal/config"
+	"helm.sh/helm/v3/pkg/release"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8scheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+const driftManifest = `---
+# Source: foo/templates/service.yaml
+apiVersion: v1
+kind: Service
+metadata:
+  name: foo
+---
+# Source: foo/templates/deployment.yaml
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: foo
+spec:
+  template:
+    spec:
+      containers:
+      - name: foo
+        image: foo:1.0
+      - name: sidecar
+        image: sidecar:1.0
+---
+# Source: foo/templates/statefulset.yaml
+apiVersion: apps/v1
+kind: StatefulSet
+metadata:
+  name: foo-db
+  namespace: other
+`
+
+// TestGetDrift tests the component drift detection
+// GIVEN an installed component whose values and workloads were edited on the cluster
+//  WHEN I call GetDrift
+//  THEN the changed values, the changed container images and the missing workloads are returned, the generated
+//  values are ignored and the overrides are built in a dry run
+func TestGetDrift(t *testing.T) {
+	a := assert.New(t)
+
+	comp := HelmComponent{
+		ReleaseName:             "foo",
+		ChartNamespace:          "chartNS",
+		IgnoreNamespaceOverride: true,
+		IgnoreImageOverrides:    true,
+		GeneratedValues:         []string{"build.id"},
+		AppendOverridesFunc: func(context spi.ComponentContext, releaseName string, namespace string, chartDir string, kvs []bom.KeyValue) ([]bom.KeyValue, error) {
+			a.True(context.IsDryRun())
+			return append(kvs,
+				bom.KeyValue{Key: "replicas", Value: "2"},
+				bom.KeyValue{Key: "build.id", Value: "2"},
+				bom.KeyValue{Key: "auth.adminPassword", Value: "generated"},
+			), nil
+		},
+	}
+
+	config.SetDefaultBomFilePath(testBomFilePath)
+	rel := newTestRelease(t, "foo", "chartNS", release.StatusDeployed, `{"replicas": 1, "legacy": true, "build": {"id": "1"}, "auth": {"adminPassword": "old"}}`)
+	rel.Manifest = driftManifest
+	helm.SetActionConfigFunction(helm.CreateActionConfig(rel))
+	defer helm.SetDefaultActionConfigFunction()
+
+	deployment := &appsv1.Deployment{
+		ObjectMeta: v1.ObjectMeta{Name: "foo", Namespace: "chartNS"},
+		Spec: appsv1.DeploymentSpec{
+			Template: corev1.PodTemplateSpec{
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{
+						{Name: "foo", Image: "foo:1.0"},
+						{Name: "sidecar", Image: "sidecar:edited"},
+					},
+				},
+			},
+		},
+	}
+	cli := fake.NewClientBuilder().WithScheme(k8scheme.Scheme).WithObjects(deployment).Build()
+	ctx := spi.NewFakeContext(cli, &v1alpha1.Verrazzano{ObjectMeta: v1.ObjectMeta{Namespace: "foo"}}, false)
+
+	drift, err := comp.GetDrift(ctx)
+	a.NoError(err)
+	a.Equal([]string{
+		"Helm value ~ replicas: 1 -> 2",
+		"Deployment chartNS/foo container sidecar has image sidecar:edited, expected sidecar:1.0",
+		"StatefulSet other/foo-db is missing",
+	}, drift)
+}
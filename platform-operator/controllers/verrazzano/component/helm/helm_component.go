@@ -4,9 +4,12 @@
 package helm
 
 import (
+	"context"
 	"fmt"
 	"github.com/verrazzano/verrazzano/platform-operator/controllers/verrazzano/component/common"
 	"os"
+	"path/filepath"
+	"time"
 
 	"github.com/verrazzano/verrazzano/pkg/bom"
 	ctrlerrors "github.com/verrazzano/verrazzano/pkg/controller/errors"
@@ -21,8 +24,13 @@ import (
 	"github.com/verrazzano/verrazzano/platform-operator/controllers/verrazzano/secret"
 	"github.com/verrazzano/verrazzano/platform-operator/internal/config"
 	"github.com/verrazzano/verrazzano/platform-operator/internal/k8s/status"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/selection"
 	"k8s.io/apimachinery/pkg/types"
 	clipkg "sigs.k8s.io/controller-runtime/pkg/client"
+	sigsyaml "sigs.k8s.io/yaml"
 )
 
 // HelmComponent struct needed to implement a component
@@ -60,6 +68,12 @@ type HelmComponent struct {
 	// PreUpgradeFunc is an optional function to run before upgrading
 	PreUpgradeFunc preUpgradeFuncSig
 
+	// PreUninstallFunc is an optional function to run before uninstalling
+	PreUninstallFunc preUninstallFuncSig
+
+	// PostUninstallFunc is an optional function to run after uninstalling
+	PostUninstallFunc postUninstallFuncSig
+
 	// AppendOverridesFunc is an optional function get additional override values
 	AppendOverridesFunc appendOverridesSig
 
@@ -93,6 +107,23 @@ type HelmComponent struct {
 
 	// Certificates associated with the component
 	Certificates []types.NamespacedName
+
+	// DefaultTimeout is how long the install or upgrade of the component can take, zero for the Verrazzano default
+	DefaultTimeout time.Duration
+
+	// UserResourceCRDs are the names of the CRDs of the resources that users create for the component, the component
+	// cannot be disabled while any of these resources exist.  The CRDs are not deleted when the component is uninstalled.
+	UserResourceCRDs []string
+
+	// OwnedSecrets are the secrets created by the component that are deleted after the component is uninstalled
+	OwnedSecrets []types.NamespacedName
+
+	// OwnedNamespaces are the namespaces used only by the component that are deleted after the component is uninstalled
+	OwnedNamespaces []string
+
+	// GeneratedValues are the keys of the Helm values that are generated each time the overrides are built, they
+	// are not checked for drift
+	GeneratedValues []string
 }
 
 // Verify that HelmComponent implements Component
@@ -107,6 +138,12 @@ type postInstallFuncSig func(context spi.ComponentContext, releaseName string, n
 // preUpgradeFuncSig is the signature for the optional preUgrade function
 type preUpgradeFuncSig func(log vzlog.VerrazzanoLogger, client clipkg.Client, releaseName string, namespace string, chartDir string) error
 
+// preUninstallFuncSig is the signature for the optional function to run before uninstalling
+type preUninstallFuncSig func(context spi.ComponentContext, releaseName string, namespace string) error
+
+// postUninstallFuncSig is the signature for the optional function to run after uninstalling
+type postUninstallFuncSig func(context spi.ComponentContext, releaseName string, namespace string) error
+
 // appendOverridesSig is an optional function called to generate additional overrides.
 type appendOverridesSig func(context spi.ComponentContext, releaseName string, namespace string, chartDir string, kvs []bom.KeyValue) ([]bom.KeyValue, error)
 
@@ -130,9 +167,40 @@ func SetDefaultUpgradeFunc() {
 	upgradeFunc = helm.Upgrade
 }
 
+// uninstallFuncSig is a function needed for unit test override
+type uninstallFuncSig func(log vzlog.VerrazzanoLogger, releaseName string, namespace string, dryRun bool) (stdout []byte, stderr []byte, err error)
+
+// uninstallFunc is the default uninstall function
+var uninstallFunc uninstallFuncSig = helm.Uninstall
+
+func SetUninstallFunc(f uninstallFuncSig) {
+	uninstallFunc = f
+}
+
+func SetDefaultUninstallFunc() {
+	uninstallFunc = helm.Uninstall
+}
+
+// rollbackFuncSig is a function needed for unit test override
+type rollbackFuncSig func(log vzlog.VerrazzanoLogger, releaseName string, namespace string, dryRun bool) (stdout []byte, stderr []byte, err error)
+
+// rollbackFunc is the default rollback function
+var rollbackFunc rollbackFuncSig = helm.Rollback
+
+func SetRollbackFunc(f rollbackFuncSig) {
+	rollbackFunc = f
+}
+
+func SetDefaultRollbackFunc() {
+	rollbackFunc = helm.Rollback
+}
+
 // UpgradePrehooksEnabled is needed so that higher level units tests can disable as needed
 var UpgradePrehooksEnabled = true
 
+// helmReleaseNameAnnotation is the annotation Helm adds to the resources of a release with the release name
+const helmReleaseNameAnnotation = "meta.helm.sh/release-name"
+
 // Name returns the component name
 func (h HelmComponent) Name() string {
 	return h.ReleaseName
@@ -251,8 +319,8 @@ func (h HelmComponent) Install(context spi.ComponentContext) error {
 	}
 
 	// vz-specific chart overrides file
-	overrides, err := h.buildCustomHelmOverrides(context, resolvedNamespace, kvs...)
-	defer vzos.RemoveTempFiles(context.Log().GetZapLogger(), `\w*`)
+	overrides, tempFiles, err := h.buildCustomHelmOverrides(context, resolvedNamespace, kvs...)
+	defer removeTempFiles(context.Log(), tempFiles)
 	if err != nil {
 		return err
 	}
@@ -338,8 +406,8 @@ func (h HelmComponent) Upgrade(context spi.ComponentContext) error {
 		return err
 	}
 
-	overrides, err := h.buildCustomHelmOverrides(context, resolvedNamespace, kvs...)
-	defer vzos.RemoveTempFiles(context.Log().GetZapLogger(), `\w*`)
+	overrides, tempFiles, err := h.buildCustomHelmOverrides(context, resolvedNamespace, kvs...)
+	defer removeTempFiles(context.Log(), tempFiles)
 	if err != nil {
 		return err
 	}
@@ -350,6 +418,9 @@ func (h HelmComponent) Upgrade(context spi.ComponentContext) error {
 	}
 
 	tmpFile, err := vzos.CreateTempFile(context.Log(), "values-*.yaml", stdout)
+	if tmpFile != nil {
+		defer removeTempFiles(context.Log(), []string{tmpFile.Name()})
+	}
 	if err != nil {
 		return err
 	}
@@ -373,35 +444,181 @@ func (h HelmComponent) Reconcile(_ spi.ComponentContext) error {
 	return nil
 }
 
+// IsUninstalled Indicates whether or not the Helm release for the component has been removed
+func (h HelmComponent) IsUninstalled(context spi.ComponentContext) (bool, error) {
+	if context.IsDryRun() {
+		context.Log().Debugf("IsUninstalled() dry run for %s", h.ReleaseName)
+		return true, nil
+	}
+	installed, err := helm.IsReleaseInstalled(h.ReleaseName, h.resolveNamespace(context.EffectiveCR().Namespace))
+	if err != nil {
+		return false, err
+	}
+	return !installed, nil
+}
+
+func (h HelmComponent) PreUninstall(context spi.ComponentContext) error {
+	if h.PreUninstallFunc != nil {
+		if err := h.PreUninstallFunc(context, h.ReleaseName, h.resolveNamespace(context.EffectiveCR().Namespace)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Uninstall removes the Helm release for the component if it is installed
+func (h HelmComponent) Uninstall(context spi.ComponentContext) error {
+	resolvedNamespace := h.resolveNamespace(context.EffectiveCR().Namespace)
+
+	found, err := helm.IsReleaseInstalled(h.ReleaseName, resolvedNamespace)
+	if err != nil {
+		return err
+	}
+	if !found {
+		context.Log().Infof("Skipping uninstall of component %s since it is not installed", h.ReleaseName)
+		return nil
+	}
+
+	_, _, err = uninstallFunc(context.Log(), h.ReleaseName, resolvedNamespace, context.IsDryRun())
+	return err
+}
+
+// Rollback rolls the Helm release back to the revision that was deployed before the failed upgrade
+func (h HelmComponent) Rollback(context spi.ComponentContext) error {
+	resolvedNamespace := h.resolveNamespace(context.EffectiveCR().Namespace)
+	context.Log().Infof("Rolling back component %s to the last deployed release revision", h.ReleaseName)
+	_, _, err := rollbackFunc(context.Log(), h.ReleaseName, resolvedNamespace, context.IsDryRun())
+	return err
+}
+
+// PreflightChecks verifies that no install, upgrade or rollback of the Helm release is pending, Helm does not
+// upgrade a release while another operation on it is in progress
+func (h HelmComponent) PreflightChecks(context spi.ComponentContext) []error {
+	resolvedNamespace := h.resolveNamespace(context.EffectiveCR().Namespace)
+	pendingReleases, err := getPendingReleaseSecrets(context.Client(), h.ReleaseName, resolvedNamespace)
+	if err != nil {
+		return []error{fmt.Errorf("Failed checking for pending operations on Helm release %s/%s: %v", resolvedNamespace, h.ReleaseName, err)}
+	}
+	var errs []error
+	for _, pendingRelease := range pendingReleases {
+		errs = append(errs, fmt.Errorf("Helm release %s/%s revision %s is %s", resolvedNamespace, h.ReleaseName,
+			pendingRelease.Labels["version"], pendingRelease.Labels["status"]))
+	}
+	return errs
+}
+
+// GetDefaultTimeout returns how long the install or upgrade of the component can take
+func (h HelmComponent) GetDefaultTimeout() time.Duration {
+	return h.DefaultTimeout
+}
+
+// GetNotReadyWorkloads returns the workloads of the Helm release that are not ready, Helm annotates the resources
+// of a release with the release name
+func (h HelmComponent) GetNotReadyWorkloads(context spi.ComponentContext) ([]string, error) {
+	resolvedNamespace := h.resolveNamespace(context.EffectiveCR().Namespace)
+	return status.GetNotReadyWorkloads(context.Client(), resolvedNamespace, func(obj metav1.Object) bool {
+		return obj.GetAnnotations()[helmReleaseNameAnnotation] == h.ReleaseName
+	})
+}
+
+// GetPlannedValues returns the user supplied values of the Helm release and the values an install or upgrade of the
+// release would apply.  An upgrade retains the values of the release, like Upgrade does, an install only applies the
+// overrides.  Building the overrides can create or update resources, so the values are always computed in a dry run,
+// and the temp files the overrides are read from, like the MySQL init file, are removed once the values are merged.
+func (h HelmComponent) GetPlannedValues(context spi.ComponentContext) (map[string]interface{}, map[string]interface{}, error) {
+	context = spi.NewDryRunContext(context)
+	resolvedNamespace := h.resolveNamespace(context.EffectiveCR().Namespace)
+
+	current := map[string]interface{}{}
+	found, err := helm.IsReleaseInstalled(h.ReleaseName, resolvedNamespace)
+	if err != nil {
+		return nil, nil, err
+	}
+	if found {
+		if current, err = helm.GetValuesMap(context.Log(), h.ReleaseName, resolvedNamespace); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	var kvs []bom.KeyValue
+	kvs, err = secret.AddGlobalImagePullSecretHelmOverride(context.Log(), context.Client(), resolvedNamespace, kvs, h.ImagePullSecretKeyname)
+	if err != nil {
+		return nil, nil, err
+	}
+	overrides, tempFiles, err := h.buildCustomHelmOverrides(context, resolvedNamespace, kvs...)
+	defer removeTempFiles(context.Log(), tempFiles)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	planned := map[string]interface{}{}
+	if found && context.GetOperation() == constants.UpgradeOperation {
+		// Copy the current values, merging the override files must not change them
+		data, err := sigsyaml.Marshal(current)
+		if err != nil {
+			return nil, nil, err
+		}
+		if err := sigsyaml.Unmarshal(data, &planned); err != nil {
+			return nil, nil, err
+		}
+	}
+	// The file, set, set-string and set-file overrides are merged like Upgrade does, then overlay the retained values
+	values, err := helm.MergeOverrides(resolvedNamespace, overrides)
+	if err != nil {
+		return nil, nil, context.Log().ErrorfNewErr("Failed to merge the overrides of component %s: %v", h.ReleaseName, err)
+	}
+	if err := yaml.MergeMaps(planned, values); err != nil {
+		return nil, nil, err
+	}
+	return current, planned, nil
+}
+
+// PostUninstall runs the optional post-uninstall function and deletes the resources owned by the component
+func (h HelmComponent) PostUninstall(context spi.ComponentContext) error {
+	if h.PostUninstallFunc != nil {
+		if err := h.PostUninstallFunc(context, h.ReleaseName, h.resolveNamespace(context.EffectiveCR().Namespace)); err != nil {
+			return err
+		}
+	}
+	return h.deleteOwnedResources(context)
+}
+
 // buildCustomHelmOverrides Builds the helm overrides for a release, including image and file, and custom overrides
 // - returns an error and a HelmOverride struct with the field populated
-func (h HelmComponent) buildCustomHelmOverrides(context spi.ComponentContext, namespace string, additionalValues ...bom.KeyValue) ([]helm.HelmOverrides, error) {
+// - returns the temp files created for the overrides, the caller removes them once the Helm operation is done,
+// including when an error is returned
+func (h HelmComponent) buildCustomHelmOverrides(context spi.ComponentContext, namespace string, additionalValues ...bom.KeyValue) ([]helm.HelmOverrides, []string, error) {
 	// Optionally create a second override file.  This will contain both image setOverrides and any additional
 	// setOverrides required by a component.
 	// Get image setOverrides unless opt out
 	var kvs []bom.KeyValue
 	var err error
 	var overrides []helm.HelmOverrides
+	var tempFiles []string
 
 	// Sort the kvs list by priority (0th term has the highest priority)
 
 	// Getting user defined Helm overrides as the highest priority
 	overrideStrings, err := common.GetInstallOverridesYAML(context, h.GetOverrides(context.EffectiveCR()))
 	if err != nil {
-		return overrides, err
+		return overrides, tempFiles, err
 	}
 	for _, overrideString := range overrideStrings {
 		file, err := vzos.CreateTempFile(context.Log(), fmt.Sprintf("install-overrides-%s-*.yaml", h.Name()), []byte(overrideString))
+		if file != nil {
+			tempFiles = append(tempFiles, file.Name())
+		}
 		if err != nil {
-			return overrides, err
+			return overrides, tempFiles, err
 		}
 		kvs = append(kvs, bom.KeyValue{Value: file.Name(), IsFile: true})
 	}
 
 	// Create files from the Verrazzano Helm values
-	newKvs, err := h.filesFromVerrazzanoHelm(context, namespace, additionalValues)
+	newKvs, newTempFiles, err := h.filesFromVerrazzanoHelm(context, namespace, additionalValues)
+	tempFiles = append(tempFiles, newTempFiles...)
 	if err != nil {
-		return overrides, err
+		return overrides, tempFiles, err
 	}
 	kvs = append(kvs, newKvs...)
 
@@ -412,18 +629,21 @@ func (h HelmComponent) buildCustomHelmOverrides(context spi.ComponentContext, na
 
 	// Convert the key value pairs to Helm overrides
 	overrides = h.organizeHelmOverrides(kvs)
-	return overrides, nil
+	return overrides, tempFiles, nil
 }
 
-func (h HelmComponent) filesFromVerrazzanoHelm(context spi.ComponentContext, namespace string, additionalValues []bom.KeyValue) ([]bom.KeyValue, error) {
+// filesFromVerrazzanoHelm creates the override files from the Verrazzano Helm values, it also returns the temp files
+// that were created for this component, including the ones created by the AppendOverridesFunc
+func (h HelmComponent) filesFromVerrazzanoHelm(context spi.ComponentContext, namespace string, additionalValues []bom.KeyValue) ([]bom.KeyValue, []string, error) {
 	var kvs []bom.KeyValue
 	var newKvs []bom.KeyValue
+	var tempFiles []string
 
 	// Get image overrides if they are specified
 	if !h.IgnoreImageOverrides {
 		imageOverrides, err := getImageOverrides(h.ReleaseName)
 		if err != nil {
-			return newKvs, err
+			return newKvs, tempFiles, err
 		}
 		kvs = append(kvs, imageOverrides...)
 	}
@@ -431,8 +651,9 @@ func (h HelmComponent) filesFromVerrazzanoHelm(context spi.ComponentContext, nam
 	// Append any additional setOverrides for the component (see Keycloak.go for example)
 	if h.AppendOverridesFunc != nil {
 		overrideValues, err := h.AppendOverridesFunc(context, h.ReleaseName, namespace, h.ChartDir, []bom.KeyValue{})
+		tempFiles = append(tempFiles, tempFilesOf(overrideValues)...)
 		if err != nil {
-			return newKvs, err
+			return newKvs, tempFiles, err
 		}
 		kvs = append(kvs, overrideValues...)
 	}
@@ -455,7 +676,7 @@ func (h HelmComponent) filesFromVerrazzanoHelm(context spi.ComponentContext, nam
 		if kv.SetFile {
 			data, err := os.ReadFile(kv.Value)
 			if err != nil {
-				return newKvs, context.Log().ErrorfNewErr("Could not open file %s: %v", kv.Value, err)
+				return newKvs, tempFiles, context.Log().ErrorfNewErr("Could not open file %s: %v", kv.Value, err)
 			}
 			kv.Value = string(data)
 		}
@@ -467,18 +688,43 @@ func (h HelmComponent) filesFromVerrazzanoHelm(context spi.ComponentContext, nam
 	// This uses the Helm YAML formatting
 	fileString, err := yaml.HelmValueFileConstructor(fileValues)
 	if err != nil {
-		return newKvs, context.Log().ErrorfNewErr("Could not create YAML file from key value pairs: %v", err)
+		return newKvs, tempFiles, context.Log().ErrorfNewErr("Could not create YAML file from key value pairs: %v", err)
 	}
 
 	// Create the file from the string
 	file, err := vzos.CreateTempFile(context.Log(), "helm-overrides-*.yaml", []byte(fileString))
+	if file != nil {
+		tempFiles = append(tempFiles, file.Name())
+	}
 	if err != nil {
-		return newKvs, err
+		return newKvs, tempFiles, err
 	}
 	if file != nil {
 		newKvs = append(newKvs, bom.KeyValue{Value: file.Name(), IsFile: true})
 	}
-	return newKvs, nil
+	return newKvs, tempFiles, nil
+}
+
+// tempFilesOf returns the files of the key value pairs that are in the temp directory, these files were
+// generated for the Helm operation
+func tempFilesOf(kvs []bom.KeyValue) []string {
+	var files []string
+	for _, kv := range kvs {
+		if (kv.IsFile || kv.SetFile) && filepath.Dir(kv.Value) == filepath.Clean(os.TempDir()) {
+			files = append(files, kv.Value)
+		}
+	}
+	return files
+}
+
+// removeTempFiles removes the temp files created for a Helm operation.  Components are installed concurrently, so
+// only the files of this operation are removed, not the other files of the temp directory.
+func removeTempFiles(log vzlog.VerrazzanoLogger, files []string) {
+	for _, file := range files {
+		if err := os.Remove(file); err != nil && !os.IsNotExist(err) {
+			log.Errorf("Failed deleting temp file %s: %v", file, err)
+		}
+	}
 }
 
 // organizeHelmOverrides creates a list of Helm overrides from key value pairs in reverse precedence (0th value has the lowest precedence)
@@ -518,6 +764,20 @@ func (h HelmComponent) resolveNamespace(ns string) string {
 	return namespace
 }
 
+// getPendingReleaseSecrets returns the Helm storage secrets of the release revisions with a pending operation
+func getPendingReleaseSecrets(cli clipkg.Client, releaseName string, namespace string) ([]corev1.Secret, error) {
+	ownerReq, _ := labels.NewRequirement("owner", selection.Equals, []string{"helm"})
+	nameReq, _ := labels.NewRequirement("name", selection.Equals, []string{releaseName})
+	pendingReq, _ := labels.NewRequirement("status", selection.In,
+		[]string{helm.ChartStatusPendingInstall, helm.ChartStatusPendingUpgrade, helm.ChartStatusPendingRollback})
+	selector := labels.NewSelector().Add(*ownerReq, *nameReq, *pendingReq)
+	secrets := corev1.SecretList{}
+	if err := cli.List(context.TODO(), &secrets, clipkg.InNamespace(namespace), clipkg.MatchingLabelsSelector{Selector: selector}); err != nil {
+		return nil, err
+	}
+	return secrets.Items, nil
+}
+
 // Get the image overrides from the BOM
 func getImageOverrides(subcomponentName string) ([]bom.KeyValue, error) {
 	// Create a Bom and get the Key Value overrides
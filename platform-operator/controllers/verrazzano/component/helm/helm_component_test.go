@@ -4,10 +4,11 @@
 package helm
 
 import (
+	goctx "context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"os"
-	"os/exec"
 	"testing"
 
 	"github.com/golang/mock/gomock"
@@ -21,9 +22,15 @@ import (
 	"github.com/verrazzano/verrazzano/platform-operator/controllers/verrazzano/component/spi"
 	"github.com/verrazzano/verrazzano/platform-operator/internal/config"
 	"github.com/verrazzano/verrazzano/platform-operator/mocks"
+	"helm.sh/helm/v3/pkg/action"
+	"helm.sh/helm/v3/pkg/cli"
+	"helm.sh/helm/v3/pkg/release"
+	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
 	k8scheme "k8s.io/client-go/kubernetes/scheme"
 	clipkg "sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
@@ -32,19 +39,8 @@ import (
 // Needed for unit tests
 var fakeOverrides []string
 
-// helmFakeRunner is used to test helm without actually running an OS exec command
-type helmFakeRunner struct {
-}
-
 const testBomFilePath = "../../testdata/test_bom.json"
 
-// genericHelmTestRunner is used to run generic OS commands with expected results
-type genericHelmTestRunner struct {
-	stdOut []byte
-	stdErr []byte
-	err    error
-}
-
 var testScheme = runtime.NewScheme()
 
 func init() {
@@ -55,9 +51,17 @@ func init() {
 	// +kubebuilder:scaffold:testScheme
 }
 
-// Run genericHelmTestRunner executor
-func (r genericHelmTestRunner) Run(cmd *exec.Cmd) (stdout []byte, stderr []byte, err error) {
-	return r.stdOut, r.stdErr, r.err
+// newTestRelease returns a Helm release with the given status and the values of the JSON string
+func newTestRelease(t *testing.T, name string, namespace string, status release.Status, values string) *release.Release {
+	rel := release.Mock(&release.MockReleaseOptions{Name: name, Namespace: namespace, Status: status})
+	rel.Config = map[string]interface{}{}
+	assert.NoError(t, json.Unmarshal([]byte(values), &rel.Config))
+	return rel
+}
+
+// failingActionConfig returns an error instead of a Helm action configuration
+func failingActionConfig(_ vzlog.VerrazzanoLogger, _ *cli.EnvSettings, _ string) (*action.Configuration, error) {
+	return nil, fmt.Errorf("Unexpected error")
 }
 
 // TestGetName tests the component name
@@ -97,8 +101,8 @@ func TestUpgrade(t *testing.T) {
 	}
 
 	config.SetDefaultBomFilePath(testBomFilePath)
-	helm.SetCmdRunner(helmFakeRunner{})
-	defer helm.SetDefaultRunner()
+	helm.SetActionConfigFunction(helm.CreateActionConfig(newTestRelease(t, "rancher", "chartNS", release.StatusDeployed, "{}")))
+	defer helm.SetDefaultActionConfigFunction()
 	SetUpgradeFunc(fakeUpgrade)
 	defer SetDefaultUpgradeFunc()
 	helm.SetChartStatusFunction(func(releaseName string, namespace string) (string, error) {
@@ -130,12 +134,8 @@ func TestUpgradeIsInstalledUnexpectedError(t *testing.T) {
 	})
 	defer SetDefaultUpgradeFunc()
 
-	helm.SetCmdRunner(genericHelmTestRunner{
-		stdOut: []byte(""),
-		stdErr: []byte("What happened?"),
-		err:    fmt.Errorf("Unexpected error"),
-	})
-	defer helm.SetDefaultRunner()
+	helm.SetActionConfigFunction(failingActionConfig)
+	defer helm.SetDefaultActionConfigFunction()
 
 	err := comp.Upgrade(spi.NewFakeContext(nil, &v1alpha1.Verrazzano{ObjectMeta: v1.ObjectMeta{Namespace: "foo"}}, false))
 	a.Error(err)
@@ -148,13 +148,17 @@ func TestUpgradeIsInstalledUnexpectedError(t *testing.T) {
 func TestUpgradeReleaseNotInstalled(t *testing.T) {
 	a := assert.New(t)
 
-	comp := HelmComponent{}
+	comp := HelmComponent{
+		ReleaseName:             "foo",
+		ChartNamespace:          "chartNS",
+		IgnoreNamespaceOverride: true,
+	}
 
 	SetUpgradeFunc(func(_ vzlog.VerrazzanoLogger, releaseName string, namespace string, chartDir string, wait bool, dryRun bool, overrides []helm.HelmOverrides) (stdout []byte, stderr []byte, err error) {
 		return nil, nil, nil
 	})
-	helm.SetCmdRunner(helmFakeRunner{})
-	defer helm.SetDefaultRunner()
+	helm.SetActionConfigFunction(helm.CreateActionConfig())
+	defer helm.SetDefaultActionConfigFunction()
 	config.SetDefaultBomFilePath(testBomFilePath)
 	defer config.SetDefaultBomFilePath("")
 
@@ -201,8 +205,8 @@ func TestUpgradeWithEnvOverrides(t *testing.T) {
 	}
 
 	config.SetDefaultBomFilePath(testBomFilePath)
-	helm.SetCmdRunner(helmFakeRunner{})
-	defer helm.SetDefaultRunner()
+	helm.SetActionConfigFunction(helm.CreateActionConfig(newTestRelease(t, "rancher", "chartNS", release.StatusDeployed, "{}")))
+	defer helm.SetDefaultActionConfigFunction()
 	SetUpgradeFunc(fakeUpgrade)
 	defer SetDefaultUpgradeFunc()
 	helm.SetChartStatusFunction(func(releaseName string, namespace string) (string, error) {
@@ -238,8 +242,8 @@ func TestInstall(t *testing.T) {
 	}
 
 	config.SetDefaultBomFilePath(testBomFilePath)
-	helm.SetCmdRunner(helmFakeRunner{})
-	defer helm.SetDefaultRunner()
+	helm.SetActionConfigFunction(helm.CreateActionConfig())
+	defer helm.SetDefaultActionConfigFunction()
 	SetUpgradeFunc(fakeUpgrade)
 	defer SetDefaultUpgradeFunc()
 	helm.SetChartStatusFunction(func(releaseName string, namespace string) (string, error) {
@@ -286,8 +290,8 @@ func TestInstallWithAllOverride(t *testing.T) {
 	}
 
 	config.SetDefaultBomFilePath(testBomFilePath)
-	helm.SetCmdRunner(helmFakeRunner{})
-	defer helm.SetDefaultRunner()
+	helm.SetActionConfigFunction(helm.CreateActionConfig())
+	defer helm.SetDefaultActionConfigFunction()
 
 	SetUpgradeFunc(fakeUpgrade)
 	defer SetDefaultUpgradeFunc()
@@ -332,8 +336,8 @@ func TestInstallPreviousFailure(t *testing.T) {
 	}
 
 	config.SetDefaultBomFilePath(testBomFilePath)
-	helm.SetCmdRunner(helmFakeRunner{})
-	defer helm.SetDefaultRunner()
+	helm.SetActionConfigFunction(helm.CreateActionConfig(newTestRelease(t, "rancher", "chartNS", release.StatusFailed, "{}")))
+	defer helm.SetDefaultActionConfigFunction()
 	SetUpgradeFunc(fakeUpgrade)
 	defer SetDefaultUpgradeFunc()
 	helm.SetChartStatusFunction(func(releaseName string, namespace string) (string, error) {
@@ -375,8 +379,8 @@ func TestInstallWithPreInstallFunc(t *testing.T) {
 	client := fake.NewClientBuilder().WithScheme(k8scheme.Scheme).Build()
 
 	config.SetDefaultBomFilePath(testBomFilePath)
-	helm.SetCmdRunner(helmFakeRunner{})
-	defer helm.SetDefaultRunner()
+	helm.SetActionConfigFunction(helm.CreateActionConfig())
+	defer helm.SetDefaultActionConfigFunction()
 	SetUpgradeFunc(fakeUpgrade)
 	defer SetDefaultUpgradeFunc()
 	helm.SetChartStatusFunction(func(releaseName string, namespace string) (string, error) {
@@ -426,27 +430,172 @@ func TestGetDependencies(t *testing.T) {
 func TestIsInstalled(t *testing.T) {
 	a := assert.New(t)
 
-	comp := HelmComponent{}
+	comp := HelmComponent{
+		ReleaseName:             "foo",
+		ChartNamespace:          "chartNS",
+		IgnoreNamespaceOverride: true,
+	}
 	defer helm.SetDefaultChartStatusFunction()
 	client := fake.NewClientBuilder().WithScheme(k8scheme.Scheme).Build()
 
-	helm.SetCmdRunner(genericHelmTestRunner{
-		stdOut: []byte(""),
-		stdErr: []byte(""),
-		err:    nil,
-	})
-	defer helm.SetDefaultRunner()
+	helm.SetActionConfigFunction(helm.CreateActionConfig(newTestRelease(t, "foo", "chartNS", release.StatusDeployed, "{}")))
+	defer helm.SetDefaultActionConfigFunction()
 	config.SetDefaultBomFilePath(testBomFilePath)
 	defer config.SetDefaultBomFilePath("")
 	a.True(comp.IsInstalled(spi.NewFakeContext(nil, &v1alpha1.Verrazzano{ObjectMeta: v1.ObjectMeta{Namespace: "foo"}}, false)))
-	helm.SetCmdRunner(genericHelmTestRunner{
-		stdOut: []byte(""),
-		stdErr: []byte(""),
-		err:    fmt.Errorf("Not installed"),
-	})
+	helm.SetActionConfigFunction(failingActionConfig)
 	a.False(comp.IsInstalled(spi.NewFakeContext(client, &v1alpha1.Verrazzano{ObjectMeta: v1.ObjectMeta{Namespace: "foo"}}, false)))
 }
 
+// TestUninstall tests the component uninstall
+// GIVEN a component
+//  WHEN I call Uninstall
+//  THEN the helm release is uninstalled only if it is installed and the uninstall hooks are called
+func TestUninstall(t *testing.T) {
+	a := assert.New(t)
+
+	var hooksCalled []string
+	comp := HelmComponent{
+		ReleaseName:             "foo",
+		ChartNamespace:          "chartNS",
+		IgnoreNamespaceOverride: true,
+		PreUninstallFunc: func(context spi.ComponentContext, releaseName string, namespace string) error {
+			hooksCalled = append(hooksCalled, "pre-"+releaseName+"-"+namespace)
+			return nil
+		},
+		PostUninstallFunc: func(context spi.ComponentContext, releaseName string, namespace string) error {
+			hooksCalled = append(hooksCalled, "post-"+releaseName+"-"+namespace)
+			return nil
+		},
+	}
+	uninstalled := false
+	SetUninstallFunc(func(log vzlog.VerrazzanoLogger, releaseName string, namespace string, dryRun bool) (stdout []byte, stderr []byte, err error) {
+		uninstalled = true
+		return []byte{}, []byte{}, nil
+	})
+	defer SetDefaultUninstallFunc()
+	defer helm.SetDefaultActionConfigFunction()
+	ctx := spi.NewFakeContext(newFakeClient(), &v1alpha1.Verrazzano{ObjectMeta: v1.ObjectMeta{Namespace: "foo"}}, false)
+
+	// Release is not installed, uninstall is skipped
+	helm.SetActionConfigFunction(helm.CreateActionConfig())
+	a.NoError(comp.Uninstall(ctx))
+	a.False(uninstalled)
+	isUninstalled, err := comp.IsUninstalled(ctx)
+	a.NoError(err)
+	a.True(isUninstalled)
+
+	// Release is installed, uninstall is done
+	helm.SetActionConfigFunction(helm.CreateActionConfig(newTestRelease(t, "foo", "chartNS", release.StatusDeployed, "{}")))
+	isUninstalled, err = comp.IsUninstalled(ctx)
+	a.NoError(err)
+	a.False(isUninstalled)
+	a.NoError(comp.PreUninstall(ctx))
+	a.NoError(comp.Uninstall(ctx))
+	a.NoError(comp.PostUninstall(ctx))
+	a.True(uninstalled)
+	a.Equal([]string{"pre-foo-chartNS", "post-foo-chartNS"}, hooksCalled)
+}
+
+// TestRollback tests the component rollback
+// GIVEN a component
+//  WHEN I call Rollback
+//  THEN the Helm release is rolled back in the chart namespace
+func TestRollback(t *testing.T) {
+	a := assert.New(t)
+
+	comp := HelmComponent{
+		ReleaseName:             "foo",
+		ChartNamespace:          "chartNS",
+		IgnoreNamespaceOverride: true,
+	}
+	var rolledBack string
+	SetRollbackFunc(func(log vzlog.VerrazzanoLogger, releaseName string, namespace string, dryRun bool) (stdout []byte, stderr []byte, err error) {
+		rolledBack = namespace + "/" + releaseName
+		return []byte{}, []byte{}, nil
+	})
+	defer SetDefaultRollbackFunc()
+
+	ctx := spi.NewFakeContext(newFakeClient(), &v1alpha1.Verrazzano{ObjectMeta: v1.ObjectMeta{Namespace: "foo"}}, false)
+	a.NoError(comp.Rollback(ctx))
+	a.Equal("chartNS/foo", rolledBack)
+}
+
+// TestPreflightChecks tests the component preflight checks
+// GIVEN a component
+//  WHEN I call PreflightChecks
+//  THEN an error is returned for every release revision with a pending operation
+func TestPreflightChecks(t *testing.T) {
+	a := assert.New(t)
+
+	comp := HelmComponent{
+		ReleaseName:             "foo",
+		ChartNamespace:          "chartNS",
+		IgnoreNamespaceOverride: true,
+	}
+	newReleaseSecret := func(name string, namespace string, version string, status string) *corev1.Secret {
+		return &corev1.Secret{
+			ObjectMeta: v1.ObjectMeta{
+				Name:      fmt.Sprintf("sh.helm.release.v1.%s.v%s", name, version),
+				Namespace: namespace,
+				Labels: map[string]string{
+					"owner":   "helm",
+					"name":    name,
+					"version": version,
+					"status":  status,
+				},
+			},
+		}
+	}
+	vz := &v1alpha1.Verrazzano{ObjectMeta: v1.ObjectMeta{Namespace: "foo"}}
+
+	ctx := spi.NewFakeContext(fake.NewClientBuilder().WithScheme(k8scheme.Scheme).WithObjects(
+		newReleaseSecret("foo", "chartNS", "1", helm.ChartStatusDeployed),
+		newReleaseSecret("bar", "chartNS", "1", helm.ChartStatusPendingUpgrade),
+	).Build(), vz, false)
+	a.Empty(comp.PreflightChecks(ctx))
+
+	ctx = spi.NewFakeContext(fake.NewClientBuilder().WithScheme(k8scheme.Scheme).WithObjects(
+		newReleaseSecret("foo", "chartNS", "1", helm.ChartStatusDeployed),
+		newReleaseSecret("foo", "chartNS", "2", helm.ChartStatusPendingUpgrade),
+	).Build(), vz, false)
+	errs := comp.PreflightChecks(ctx)
+	a.Len(errs, 1)
+	a.EqualError(errs[0], "Helm release chartNS/foo revision 2 is pending-upgrade")
+}
+
+// TestGetNotReadyWorkloads tests the component diagnostics
+// GIVEN a component
+//  WHEN I call GetNotReadyWorkloads
+//  THEN the workloads of the Helm release that are not ready are returned
+func TestGetNotReadyWorkloads(t *testing.T) {
+	a := assert.New(t)
+
+	comp := HelmComponent{
+		ReleaseName:             "foo",
+		ChartNamespace:          "chartNS",
+		IgnoreNamespaceOverride: true,
+	}
+	newDeployment := func(name string, release string) *appsv1.Deployment {
+		return &appsv1.Deployment{
+			ObjectMeta: v1.ObjectMeta{
+				Name:        name,
+				Namespace:   "chartNS",
+				Annotations: map[string]string{helmReleaseNameAnnotation: release},
+			},
+		}
+	}
+	vz := &v1alpha1.Verrazzano{ObjectMeta: v1.ObjectMeta{Namespace: "foo"}}
+	ctx := spi.NewFakeContext(fake.NewClientBuilder().WithScheme(k8scheme.Scheme).WithObjects(
+		newDeployment("foo-deployment", "foo"),
+		newDeployment("bar-deployment", "bar"),
+	).Build(), vz, false)
+
+	notReady, err := comp.GetNotReadyWorkloads(ctx)
+	a.NoError(err)
+	a.Equal([]string{"Deployment chartNS/foo-deployment has 0/1 ready replicas"}, notReady)
+}
+
 // TestReady tests IsReady
 // GIVEN a component
 //  WHEN I call IsReady
@@ -741,7 +890,8 @@ func TestFilesFromVerrazzanoHelm(t *testing.T) {
 
 			ctx := spi.NewFakeContext(client, verrazzano, false)
 
-			kvs, err := tt.component.filesFromVerrazzanoHelm(ctx, verrazzano.Namespace, tt.additionalValues)
+			kvs, tempFiles, err := tt.component.filesFromVerrazzanoHelm(ctx, verrazzano.Namespace, tt.additionalValues)
+			defer removeTempFiles(ctx.Log(), tempFiles)
 			a.Equal(tt.kvsLen, len(kvs))
 			for _, kv := range kvs {
 				a.True(kv.IsFile)
@@ -776,11 +926,6 @@ func fakeUpgrade(_ vzlog.VerrazzanoLogger, releaseName string, namespace string,
 	return []byte("success"), []byte(""), nil
 }
 
-// helmFakeRunner overrides the helm run command
-func (r helmFakeRunner) Run(cmd *exec.Cmd) (stdout []byte, stderr []byte, err error) {
-	return []byte("success"), []byte(""), nil
-}
-
 func fakePreUpgrade(log vzlog.VerrazzanoLogger, client clipkg.Client, release string, namespace string, chartDir string) error {
 	if release != "rancher" {
 		return fmt.Errorf("Incorrect release name %s", release)
@@ -794,3 +939,65 @@ func fakePreUpgrade(log vzlog.VerrazzanoLogger, client clipkg.Client, release st
 
 	return nil
 }
+
+// TestGetPlannedValues tests the values planned for a component
+// GIVEN an installed component with an additional override that creates a resource and a temp file
+//  WHEN I call GetPlannedValues for an install and for an upgrade outside of a dry run
+//  THEN the override is planned, only the upgrade retains the current values of the release, the resource is
+//  not created and the temp files are removed
+func TestGetPlannedValues(t *testing.T) {
+	a := assert.New(t)
+
+	var overrideFiles []string
+	comp := HelmComponent{
+		ReleaseName:             "foo",
+		ChartNamespace:          "chartNS",
+		IgnoreNamespaceOverride: true,
+		IgnoreImageOverrides:    true,
+		AppendOverridesFunc: func(context spi.ComponentContext, releaseName string, namespace string, chartDir string, kvs []bom.KeyValue) ([]bom.KeyValue, error) {
+			a.True(context.IsDryRun())
+			cm := &corev1.ConfigMap{ObjectMeta: v1.ObjectMeta{Namespace: namespace, Name: "created-by-overrides"}}
+			if err := context.Client().Create(goctx.TODO(), cm); err != nil {
+				return kvs, err
+			}
+			file, err := os.CreateTemp(os.TempDir(), "planned-*.sql")
+			if err != nil {
+				return kvs, err
+			}
+			overrideFiles = append(overrideFiles, file.Name())
+			if err := file.Close(); err != nil {
+				return kvs, err
+			}
+			kvs = append(kvs, bom.KeyValue{Key: "nested.replicas", Value: "2"})
+			return append(kvs, bom.KeyValue{Key: "initFile", Value: file.Name(), SetFile: true}), nil
+		},
+	}
+
+	config.SetDefaultBomFilePath(testBomFilePath)
+	helm.SetActionConfigFunction(helm.CreateActionConfig(newTestRelease(t, "foo", "chartNS", release.StatusDeployed, `{"nested": {"replicas": 1, "name": "bar"}}`)))
+	defer helm.SetDefaultActionConfigFunction()
+	helm.SetChartStatusFunction(func(releaseName string, namespace string) (string, error) {
+		return helm.ChartStatusDeployed, nil
+	})
+	defer helm.SetDefaultChartStatusFunction()
+
+	cli := newFakeClient()
+	ctx := spi.NewFakeContext(cli, &v1alpha1.Verrazzano{ObjectMeta: v1.ObjectMeta{Namespace: "foo"}}, false)
+	current, planned, err := comp.GetPlannedValues(ctx.Operation(constants.InstallOperation))
+	a.NoError(err)
+	a.Equal(map[string]interface{}{"nested": map[string]interface{}{"replicas": float64(1), "name": "bar"}}, current)
+	a.Equal(map[string]interface{}{"nested": map[string]interface{}{"replicas": float64(2)}, "initFile": ""}, planned)
+
+	current, planned, err = comp.GetPlannedValues(ctx.Operation(constants.UpgradeOperation))
+	a.NoError(err)
+	a.Equal(map[string]interface{}{"nested": map[string]interface{}{"replicas": float64(1), "name": "bar"}}, current)
+	a.Equal(map[string]interface{}{"nested": map[string]interface{}{"replicas": float64(2), "name": "bar"}, "initFile": ""}, planned)
+
+	a.Len(overrideFiles, 2)
+	for _, file := range overrideFiles {
+		_, err = os.Stat(file)
+		a.True(os.IsNotExist(err))
+	}
+	err = cli.Get(goctx.TODO(), types.NamespacedName{Namespace: "chartNS", Name: "created-by-overrides"}, &corev1.ConfigMap{})
+	a.True(k8serrors.IsNotFound(err))
+}
@@ -0,0 +1,90 @@
+// Copyright (c) 2022, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package helm
+
+import (
+	"context"
+
+	"github.com/verrazzano/verrazzano/platform-operator/controllers/verrazzano/component/spi"
+	corev1 "k8s.io/api/core/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	clipkg "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// GetUserResourceCRDsInUse returns the names of the user resource CRDs of the component that have resources, the
+// component cannot be disabled while any of these resources exist
+func (h HelmComponent) GetUserResourceCRDsInUse(ctx spi.ComponentContext) ([]string, error) {
+	var inUse []string
+	for _, name := range h.UserResourceCRDs {
+		crd := &apiextensionsv1.CustomResourceDefinition{}
+		if err := ctx.Client().Get(context.TODO(), types.NamespacedName{Name: name}, crd); err != nil {
+			if errors.IsNotFound(err) {
+				continue
+			}
+			return nil, ctx.Log().ErrorfNewErr("Failed to get CRD %s of component %s: %v", name, h.ReleaseName, err)
+		}
+		list := &unstructured.UnstructuredList{}
+		list.SetGroupVersionKind(schema.GroupVersionKind{
+			Group:   crd.Spec.Group,
+			Version: getStorageVersion(crd),
+			Kind:    crd.Spec.Names.ListKind,
+		})
+		if err := ctx.Client().List(context.TODO(), list, clipkg.Limit(1)); err != nil {
+			return nil, ctx.Log().ErrorfNewErr("Failed to list the resources of CRD %s of component %s: %v", name, h.ReleaseName, err)
+		}
+		if len(list.Items) > 0 {
+			inUse = append(inUse, name)
+		}
+	}
+	return inUse, nil
+}
+
+// getStorageVersion returns the version of the CRD that is stored, or the first version if none is marked as stored
+func getStorageVersion(crd *apiextensionsv1.CustomResourceDefinition) string {
+	for _, version := range crd.Spec.Versions {
+		if version.Storage {
+			return version.Name
+		}
+	}
+	if len(crd.Spec.Versions) > 0 {
+		return crd.Spec.Versions[0].Name
+	}
+	return ""
+}
+
+// deleteOwnedResources deletes the secrets and namespaces owned by the component that are left behind by the Helm
+// uninstall.  The namespaces are deleted last since the secrets may be in them.
+func (h HelmComponent) deleteOwnedResources(ctx spi.ComponentContext) error {
+	if ctx.IsDryRun() {
+		ctx.Log().Debugf("deleteOwnedResources() dry run for %s", h.ReleaseName)
+		return nil
+	}
+	for _, nsn := range h.OwnedSecrets {
+		secret := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Namespace: nsn.Namespace, Name: nsn.Name}}
+		if err := h.deleteOwnedResource(ctx, secret, "secret "+nsn.String()); err != nil {
+			return err
+		}
+	}
+	for _, name := range h.OwnedNamespaces {
+		ns := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: name}}
+		if err := h.deleteOwnedResource(ctx, ns, "namespace "+name); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// deleteOwnedResource deletes a resource owned by the component, a resource that does not exist is ignored
+func (h HelmComponent) deleteOwnedResource(ctx spi.ComponentContext, obj clipkg.Object, desc string) error {
+	if err := ctx.Client().Delete(context.TODO(), obj); clipkg.IgnoreNotFound(err) != nil {
+		return ctx.Log().ErrorfNewErr("Failed to delete %s of component %s: %v", desc, h.ReleaseName, err)
+	}
+	ctx.Log().Debugf("Deleted %s of component %s", desc, h.ReleaseName)
+	return nil
+}
@@ -0,0 +1,105 @@
+// Copyright (c) 2022, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package helm
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/verrazzano/verrazzano/platform-operator/apis/verrazzano/v1alpha1"
+	"github.com/verrazzano/verrazzano/platform-operator/controllers/verrazzano/component/spi"
+	corev1 "k8s.io/api/core/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	k8scheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+// newUserResourceCRD returns a CRD with the given group and kind
+func newUserResourceCRD(group string, kind string, plural string) *apiextensionsv1.CustomResourceDefinition {
+	return &apiextensionsv1.CustomResourceDefinition{
+		ObjectMeta: v1.ObjectMeta{Name: plural + "." + group},
+		Spec: apiextensionsv1.CustomResourceDefinitionSpec{
+			Group: group,
+			Names: apiextensionsv1.CustomResourceDefinitionNames{Kind: kind, ListKind: kind + "List", Plural: plural},
+			Versions: []apiextensionsv1.CustomResourceDefinitionVersion{
+				{Name: "v1alpha1"},
+				{Name: "v1", Storage: true},
+			},
+		},
+	}
+}
+
+// TestPostUninstallDeletesOwnedResources tests the deletion of the resources owned by a component
+// GIVEN a component that owns a secret and a namespace, a secret that no longer exists, and a user resource CRD
+//  WHEN I call PostUninstall
+//  THEN the owned resources are deleted, the missing secret is ignored and the CRD is not deleted
+func TestPostUninstallDeletesOwnedResources(t *testing.T) {
+	a := assert.New(t)
+
+	comp := HelmComponent{
+		ReleaseName:      "foo",
+		UserResourceCRDs: []string{"foos.example.com"},
+		OwnedSecrets:     []types.NamespacedName{{Namespace: "foo", Name: "foo-tls"}, {Namespace: "foo", Name: "missing"}},
+		OwnedNamespaces:  []string{"foo"},
+	}
+
+	scheme := runtime.NewScheme()
+	_ = k8scheme.AddToScheme(scheme)
+	_ = apiextensionsv1.AddToScheme(scheme)
+	cli := fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+		&corev1.Secret{ObjectMeta: v1.ObjectMeta{Namespace: "foo", Name: "foo-tls"}},
+		&corev1.Namespace{ObjectMeta: v1.ObjectMeta{Name: "foo"}},
+		newUserResourceCRD("example.com", "Foo", "foos"),
+	).Build()
+
+	a.NoError(comp.PostUninstall(spi.NewFakeContext(cli, &v1alpha1.Verrazzano{}, false)))
+
+	err := cli.Get(context.TODO(), types.NamespacedName{Namespace: "foo", Name: "foo-tls"}, &corev1.Secret{})
+	a.True(errors.IsNotFound(err))
+	err = cli.Get(context.TODO(), types.NamespacedName{Name: "foo"}, &corev1.Namespace{})
+	a.True(errors.IsNotFound(err))
+	a.NoError(cli.Get(context.TODO(), types.NamespacedName{Name: "foos.example.com"}, &apiextensionsv1.CustomResourceDefinition{}))
+}
+
+// TestGetUserResourceCRDsInUse tests the GetUserResourceCRDsInUse function
+// GIVEN a component with a user resource CRD that has a resource, one that has no resources and one that does not exist
+//  WHEN I call GetUserResourceCRDsInUse
+//  THEN only the CRD that has a resource is returned
+func TestGetUserResourceCRDsInUse(t *testing.T) {
+	a := assert.New(t)
+
+	comp := HelmComponent{
+		ReleaseName:      "foo",
+		UserResourceCRDs: []string{"foos.example.com", "bars.example.com", "missings.example.com"},
+	}
+
+	scheme := runtime.NewScheme()
+	_ = k8scheme.AddToScheme(scheme)
+	_ = apiextensionsv1.AddToScheme(scheme)
+	for _, kind := range []string{"Foo", "Bar"} {
+		gv := schema.GroupVersion{Group: "example.com", Version: "v1"}
+		scheme.AddKnownTypeWithName(gv.WithKind(kind), &unstructured.Unstructured{})
+		scheme.AddKnownTypeWithName(gv.WithKind(kind+"List"), &unstructured.UnstructuredList{})
+	}
+	foo := &unstructured.Unstructured{}
+	foo.SetGroupVersionKind(schema.GroupVersionKind{Group: "example.com", Version: "v1", Kind: "Foo"})
+	foo.SetNamespace("app")
+	foo.SetName("foo")
+	cli := fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+		newUserResourceCRD("example.com", "Foo", "foos"),
+		newUserResourceCRD("example.com", "Bar", "bars"),
+		foo,
+	).Build()
+
+	inUse, err := comp.GetUserResourceCRDsInUse(spi.NewFakeContext(cli, &v1alpha1.Verrazzano{}, false))
+	a.NoError(err)
+	a.Equal([]string{"foos.example.com"}, inUse)
+}
@@ -0,0 +1,83 @@
+// Copyright (c) 2022, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package helm
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+)
+
+// maskedValue replaces the values of keys that hold sensitive data
+const maskedValue = "*****"
+
+// sensitiveKeyPattern matches the keys of the values that hold sensitive data.  A key is only matched by "key" when
+// the word is not followed by a lowercase letter, so that keys like keycloak are not masked.
+var sensitiveKeyPattern = regexp.MustCompile(`(?i:secret|token|credential|password)|(?:[kK]ey|KEY)(?:$|[^a-z])`)
+
+// DiffValues returns a line for each Helm value that is added (+), removed (-) or changed (~), ordered by the key of the
+// value.  The values of keys that hold secrets, tokens, keys, credentials or passwords are masked.
+func DiffValues(current map[string]interface{}, planned map[string]interface{}) []string {
+	return diffValues(current, planned, true)
+}
+
+// diffValues returns a line for each Helm value that is added, changed or, if includeRemoved is true, removed
+func diffValues(current map[string]interface{}, planned map[string]interface{}, includeRemoved bool) []string {
+	currentValues := map[string]string{}
+	flattenValues("", current, currentValues)
+	plannedValues := map[string]string{}
+	flattenValues("", planned, plannedValues)
+
+	var keys []string
+	for key := range currentValues {
+		keys = append(keys, key)
+	}
+	for key := range plannedValues {
+		if _, ok := currentValues[key]; !ok {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+
+	var diff []string
+	for _, key := range keys {
+		oldValue, hasOld := currentValues[key]
+		newValue, hasNew := plannedValues[key]
+		if sensitiveKeyPattern.MatchString(key) {
+			oldValue, newValue = maskedValue, maskedValue
+		}
+		switch {
+		case !hasOld:
+			diff = append(diff, fmt.Sprintf("+ %s: %s", key, newValue))
+		case !hasNew:
+			if includeRemoved {
+				diff = append(diff, fmt.Sprintf("- %s: %s", key, oldValue))
+			}
+		case currentValues[key] != plannedValues[key]:
+			diff = append(diff, fmt.Sprintf("~ %s: %s -> %s", key, oldValue, newValue))
+		}
+	}
+	return diff
+}
+
+// flattenValues adds the leaf values of the nested values map to the flattened map, keyed by their dotted path
+func flattenValues(prefix string, values map[string]interface{}, flattened map[string]string) {
+	for key, value := range values {
+		path := key
+		if len(prefix) > 0 {
+			path = prefix + "." + key
+		}
+		if nested, ok := value.(map[string]interface{}); ok && len(nested) > 0 {
+			flattenValues(path, nested, flattened)
+			continue
+		}
+		data, err := json.Marshal(value)
+		if err != nil {
+			flattened[path] = fmt.Sprintf("%v", value)
+			continue
+		}
+		flattened[path] = string(data)
+	}
+}
@@ -0,0 +1,63 @@
+// Copyright (c) 2022, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package helm
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestDiffValues tests the DiffValues function
+// GIVEN the current and planned Helm values
+//  WHEN I call DiffValues
+//  THEN the added, removed and changed values are returned ordered by key, with the passwords masked
+func TestDiffValues(t *testing.T) {
+	current := map[string]interface{}{
+		"image": map[string]interface{}{"tag": "1.0", "pullPolicy": "Always"},
+		"auth":  map[string]interface{}{"adminPassword": "old"},
+		"same":  true,
+	}
+	planned := map[string]interface{}{
+		"image":    map[string]interface{}{"tag": "1.1"},
+		"auth":     map[string]interface{}{"adminPassword": "new"},
+		"same":     true,
+		"replicas": []interface{}{1, 2},
+	}
+	assert.Equal(t, []string{
+		"~ auth.adminPassword: ***** -> *****",
+		"- image.pullPolicy: \"Always\"",
+		"~ image.tag: \"1.0\" -> \"1.1\"",
+		"+ replicas: [1,2]",
+	}, DiffValues(current, planned))
+	assert.Empty(t, DiffValues(current, current))
+	assert.Equal(t, []string{
+		"~ auth.adminPassword: ***** -> *****",
+		"~ image.tag: \"1.0\" -> \"1.1\"",
+		"+ replicas: [1,2]",
+	}, diffValues(current, planned, false))
+}
+
+// TestDiffValuesMasksSensitiveKeys tests the DiffValues function
+// GIVEN Helm values whose keys name secrets, tokens, keys, credentials and passwords
+//  WHEN I call DiffValues
+//  THEN the sensitive values are masked and the other values, including keys that only start with "key", are not
+func TestDiffValuesMasksSensitiveKeys(t *testing.T) {
+	planned := map[string]interface{}{
+		"clientSecret": "s",
+		"auth":         map[string]interface{}{"Token": "t", "apiKey": "k", "credentials": map[string]interface{}{"user": "u"}},
+		"tls":          map[string]interface{}{"key": "k"},
+		"MY_KEY_ID":    "k",
+		"keycloak":     map[string]interface{}{"enabled": true},
+	}
+	assert.Equal(t, []string{
+		"+ MY_KEY_ID: *****",
+		"+ auth.Token: *****",
+		"+ auth.apiKey: *****",
+		"+ auth.credentials.user: *****",
+		"+ clientSecret: *****",
+		"+ keycloak.enabled: true",
+		"+ tls.key: *****",
+	}, DiffValues(map[string]interface{}{}, planned))
+}
@@ -58,8 +58,21 @@ const subcompIstiod = "istiod"
 // This IstioOperator YAML uses this imagePullSecret key
 const imagePullSecretHelmKey = "values.global.imagePullSecrets[0]"
 
-// istioManfiestNotInstalledError - Expected error during install when running verify-install before Istio CR is applied
-const istioManfiestNotInstalledError = "Istio present but verify-install needs an IstioOperator or manifest for comparison"
+// istioDeployments are the Istio deployments that must be ready
+var istioDeployments = []types.NamespacedName{
+	{
+		Name:      IstiodDeployment,
+		Namespace: IstioNamespace,
+	},
+	{
+		Name:      IstioIngressgatewayDeployment,
+		Namespace: IstioNamespace,
+	},
+	{
+		Name:      IstioEgressgatewayDeployment,
+		Namespace: IstioNamespace,
+	},
+}
 
 // istioComponent represents an Istio component
 type istioComponent struct {
@@ -71,9 +84,6 @@ type istioComponent struct {
 
 	// InjectedSystemNamespaces are the system namespaces injected with istio
 	InjectedSystemNamespaces []string
-
-	// Internal monitor object for peforming `istioctl` operations in the background
-	monitor installMonitor
 }
 
 // GetJsonName returns the josn name of the verrazzano component in CRD
@@ -100,7 +110,7 @@ func (i istioComponent) MonitorOverrides(ctx spi.ComponentContext) bool {
 	return true
 }
 
-type upgradeFuncSig func(log vzlog.VerrazzanoLogger, imageOverrideString string, overridesFiles ...string) (stdout []byte, stderr []byte, err error)
+type upgradeFuncSig func(log vzlog.VerrazzanoLogger, client clipkg.Client, overrideStrings string, overlays ...string) error
 
 // upgradeFunc is the default upgrade function
 var upgradeFunc upgradeFuncSig = istio.Upgrade
@@ -129,7 +139,6 @@ func NewComponent() spi.Component {
 	return istioComponent{
 		ValuesFile:               filepath.Join(config.GetHelmOverridesDir(), "istio-cr.yaml"),
 		InjectedSystemNamespaces: config.GetInjectedSystemNamespaces(),
-		monitor:                  &installMonitorType{},
 	}
 }
 
@@ -198,73 +207,49 @@ func (i istioComponent) validateForExternalIPSWithNodePort(vz *vzapi.VerrazzanoS
 	return nil
 }
 
+// Upgrade applies the Verrazzano IstioOperator resource, the in-cluster Istio operator then upgrades Istio from it
 func (i istioComponent) Upgrade(context spi.ComponentContext) error {
-	log := context.Log()
-
-	// build list of temp files
-	istioTempFiles, err := i.createIstioTempFiles(context)
-	if err != nil {
-		return err
-	}
-
-	defer removeTempFiles(context.Log())
-
-	// build image override strings
-	overrideStrings, err := getOverridesString(context)
-	if err != nil {
-		return err
-	}
-	_, _, err = upgradeFunc(log, overrideStrings, istioTempFiles...)
-	if err != nil {
-		return err
+	if context.IsDryRun() {
+		context.Log().Debug("Istio Upgrade() dry run")
+		return nil
 	}
+	return i.applyIstioOperator(context, upgradeFunc)
+}
 
-	return err
+// GetNotReadyWorkloads returns the Istio deployments that are not ready
+func (i istioComponent) GetNotReadyWorkloads(context spi.ComponentContext) ([]string, error) {
+	return status.GetNotReadyNamedWorkloads(context.Client(), istioDeployments)
 }
 
+// IsReady checks that the Istio deployments are ready and that the Istio operator reports the installation as healthy
 func (i istioComponent) IsReady(context spi.ComponentContext) bool {
 	prefix := fmt.Sprintf("Component %s", context.GetComponent())
-	deployments := []types.NamespacedName{
-		{
-			Name:      IstiodDeployment,
-			Namespace: IstioNamespace,
-		},
-		{
-			Name:      IstioIngressgatewayDeployment,
-			Namespace: IstioNamespace,
-		},
-		{
-			Name:      IstioEgressgatewayDeployment,
-			Namespace: IstioNamespace,
-		},
-	}
-	ready := status.DeploymentsAreReady(context.Log(), context.Client(), deployments, 1, prefix)
+	ready := status.DeploymentsAreReady(context.Log(), context.Client(), istioDeployments, 1, prefix)
 	if !ready {
 		return false
 	}
 
-	verified, err := isInstalledFunc(context.Log())
-	if err != nil && !isIstioManifestNotInstalledError(err) {
+	healthy, err := isInstalledFunc(context.Log(), context.Client())
+	if err != nil {
 		context.Log().ErrorfThrottled("Unexpected error checking Istio status: %s", err)
 		return false
 	}
-	if !verified {
-		context.Log().Progressf("%s is waiting for istioctl verify-install to successfully complete", prefix)
+	if !healthy {
+		context.Log().Progressf("%s is waiting for the IstioOperator %s to be healthy", prefix, istio.IstioOperatorName)
 		return false
 	}
 	return true
 }
 
-func isIstioManifestNotInstalledError(err error) bool {
-	return strings.Contains(err.Error(), istioManfiestNotInstalledError)
-}
-
 // GetDependencies returns the dependencies of this component
 func (i istioComponent) GetDependencies() []string {
 	return []string{}
 }
 
 func (i istioComponent) PreUpgrade(context spi.ComponentContext) error {
+	if err := installOperator(context); err != nil {
+		return err
+	}
 	if !vzconfig.IsApplicationOperatorEnabled(context.ActualCR()) {
 		return nil
 	}
@@ -358,7 +343,7 @@ func getOverridesString(ctx spi.ComponentContext) (string, error) {
 
 func buildOverridesString(additionalValues ...bom.KeyValue) (string, error) {
 	// Get the image overrides from the BOM
-	kvs, err := getImageOverrides()
+	kvs, err := getImageOverrides(subcompIstiod)
 	if err != nil {
 		return "", err
 	}
@@ -368,25 +353,22 @@ func buildOverridesString(additionalValues ...bom.KeyValue) (string, error) {
 		kvs = append(kvs, additionalValues...)
 	}
 
-	// If there are overridesString the create a comma separated string
-	var overridesString string
-	if len(kvs) > 0 {
-		bldr := strings.Builder{}
-		for i, kv := range kvs {
-			if i > 0 {
-				bldr.WriteString(",")
-			}
-			bldr.WriteString(fmt.Sprintf("%s=%s", kv.Key, kv.Value))
+	return joinOverrides(kvs), nil
+}
+
+// joinOverrides builds the comma separated string of key=value overrides
+func joinOverrides(kvs []bom.KeyValue) string {
+	bldr := strings.Builder{}
+	for i, kv := range kvs {
+		if i > 0 {
+			bldr.WriteString(",")
 		}
-		overridesString = bldr.String()
+		bldr.WriteString(fmt.Sprintf("%s=%s", kv.Key, kv.Value))
 	}
-	return overridesString, nil
+	return bldr.String()
 }
 
-// Get the image overrides from the BOM
-func getImageOverrides() ([]bom.KeyValue, error) {
-	subComponentNames := []string{subcompIstiod}
-
+func getImageOverrides(subComponentNames ...string) ([]bom.KeyValue, error) {
 	// Create a Bom and get the Key Value overrides
 	bomFile, err := bom.NewBom(config.GetDefaultBOMFilePath())
 	if err != nil {
@@ -7,9 +7,7 @@ import (
 	"context"
 	"fmt"
 	"github.com/verrazzano/verrazzano/pkg/istio"
-	"io/ioutil"
 	"k8s.io/apimachinery/pkg/types"
-	"os/exec"
 	"strings"
 	"testing"
 
@@ -27,19 +25,17 @@ import (
 	"github.com/verrazzano/verrazzano/platform-operator/mocks"
 
 	"github.com/verrazzano/verrazzano/pkg/test/ip"
+	"helm.sh/helm/v3/pkg/release"
 	appsv1 "k8s.io/api/apps/v1"
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	gofake "k8s.io/client-go/kubernetes/fake"
 	k8scheme "k8s.io/client-go/kubernetes/scheme"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 )
 
-// fakeRunner is used to test istio without actually running an OS exec command
-type fakeRunner struct {
-}
-
 var (
 	falseValue = false
 	trueValue  = true
@@ -209,6 +205,8 @@ var comp = istioComponent{}
 
 const testBomFilePath = "../../testdata/test_bom.json"
 
+const testValuesFile = "../../../../helm_config/overrides/istio-cr.yaml"
+
 // TestGetName tests the component name
 // GIVEN a Verrazzano component
 //  WHEN I call Name
@@ -226,7 +224,7 @@ func TestUpgrade(t *testing.T) {
 	a := assert.New(t)
 
 	comp := istioComponent{
-		ValuesFile:               "test-values-file.yaml",
+		ValuesFile:               testValuesFile,
 		Revision:                 "1-1-1",
 		InjectedSystemNamespaces: config.GetInjectedSystemNamespaces(),
 	}
@@ -234,30 +232,54 @@ func TestUpgrade(t *testing.T) {
 	config.SetDefaultBomFilePath(testBomFilePath)
 	SetIstioUpgradeFunction(fakeUpgrade)
 	defer SetDefaultIstioUpgradeFunction()
+	fakeClient := fake.NewClientBuilder().WithScheme(k8scheme.Scheme).WithObjects(newReadyDeployment(IstioOperatorDeployment)).Build()
 
-	err := comp.Upgrade(spi.NewFakeContext(getMock(t), crInstall, false))
+	err := comp.Upgrade(spi.NewFakeContext(fakeClient, crInstall, false))
 	a.NoError(err, "Upgrade returned an error")
 }
 
-// fakeUpgrade verifies that the correct parameter values are passed to upgrade
-func fakeUpgrade(log vzlog.VerrazzanoLogger, imageOverridesString string, overridesFiles ...string) (stdout []byte, stderr []byte, err error) {
-	if len(overridesFiles) != 2 {
-		return []byte("error"), []byte(""), fmt.Errorf("incorrect number of override files: expected 2, received %v", len(overridesFiles))
-	}
-	if overridesFiles[0] != "test-values-file.yaml" {
-		return []byte("error"), []byte(""), fmt.Errorf("invalid values file")
+// TestUpgradeIstioOperator tests the component upgrade
+// GIVEN a component and an existing IstioOperator
+//  WHEN I call Upgrade
+//  THEN the IstioOperator is updated with the BOM images and the install args
+func TestUpgradeIstioOperator(t *testing.T) {
+	a := assert.New(t)
+
+	comp := istioComponent{
+		ValuesFile: testValuesFile,
 	}
-	if !strings.Contains(overridesFiles[1], "istio-") || !strings.Contains(overridesFiles[1], ".yaml") {
-		return []byte("error"), []byte(""), fmt.Errorf("incorrect install args overrides file")
+	config.SetDefaultBomFilePath(testBomFilePath)
+	fakeClient := fake.NewClientBuilder().WithScheme(k8scheme.Scheme).WithObjects(
+		newReadyDeployment(IstioOperatorDeployment),
+		newTestIstioOperator(istio.StatusHealthy),
+	).Build()
+
+	err := comp.Upgrade(spi.NewFakeContext(fakeClient, installCR, false))
+	a.NoError(err, "Upgrade returned an error")
+
+	iop := &unstructured.Unstructured{}
+	iop.SetGroupVersionKind(istio.IstioOperatorGVK)
+	a.NoError(fakeClient.Get(context.TODO(), types.NamespacedName{Namespace: istio.IstioOperatorNamespace, Name: istio.IstioOperatorName}, iop))
+	image, _, _ := unstructured.NestedString(iop.Object, "spec", "values", "pilot", "image")
+	a.Equal("ghcr.io/verrazzano/pilot:1.10.4", image)
+	arg, _, _ := unstructured.NestedString(iop.Object, "spec", "values", "arg1")
+	a.Equal("val1", arg)
+	profile, _, _ := unstructured.NestedString(iop.Object, "spec", "profile")
+	a.Equal("default", profile)
+}
+
+// fakeUpgrade verifies that the correct parameter values are passed to upgrade
+func fakeUpgrade(log vzlog.VerrazzanoLogger, _ client.Client, _ string, overlays ...string) error {
+	if len(overlays) != 2 {
+		return fmt.Errorf("incorrect number of overlays: expected 2, received %v", len(overlays))
 	}
-	installArgsFromFile, err := ioutil.ReadFile(overridesFiles[1])
-	if err != nil {
-		return []byte("error"), []byte(""), fmt.Errorf("unable to read install args overrides file")
+	if !strings.Contains(overlays[0], "kind: IstioOperator") {
+		return fmt.Errorf("invalid values file")
 	}
-	if !strings.Contains(string(installArgsFromFile), "val1") {
-		return []byte("error"), []byte(""), fmt.Errorf("install args overrides file does not contain install args")
+	if !strings.Contains(overlays[1], "val1") {
+		return fmt.Errorf("install args overlay does not contain install args")
 	}
-	return []byte("success"), []byte(""), nil
+	return nil
 }
 
 func TestPostUpgrade(t *testing.T) {
@@ -270,8 +292,11 @@ func TestPostUpgrade(t *testing.T) {
 	k8sutil.SetFakeClient(clientSet)
 
 	config.SetDefaultBomFilePath(testBomFilePath)
-	helm.SetCmdRunner(fakeRunner{})
-	defer helm.SetDefaultRunner()
+	helm.SetActionConfigFunction(helm.CreateActionConfig(release.Mock(&release.MockReleaseOptions{
+		Name:      IstioCoreDNSReleaseName,
+		Namespace: constants.IstioSystemNamespace,
+	})))
+	defer helm.SetDefaultActionConfigFunction()
 	SetHelmUninstallFunction(fakeHelmUninstall)
 	SetDefaultHelmUninstallFunction()
 	err := comp.PostUpgrade(spi.NewFakeContext(getMock(t), crInstall, false))
@@ -320,66 +345,68 @@ func getMock(t *testing.T) *mocks.MockClient {
 	return mock
 }
 
-// fakeRunner overrides the istio run command
-func (r fakeRunner) Run(cmd *exec.Cmd) (stdout []byte, stderr []byte, err error) {
-	return []byte("success"), []byte(""), nil
-}
-
 // TestIsReady tests the IsReady function
 // GIVEN a call to IsReady
-//  WHEN the deployment object has enough replicas available and istioctl ran successfully
+//  WHEN the deployment objects have enough replicas available and the IstioOperator is HEALTHY
 //  THEN true is returned
 func TestIsReady(t *testing.T) {
-
 	fakeClient := fake.NewClientBuilder().WithScheme(k8scheme.Scheme).WithObjects(
-		&appsv1.Deployment{
-			ObjectMeta: metav1.ObjectMeta{
-				Namespace: IstioNamespace,
-				Name:      IstiodDeployment,
-				Labels:    map[string]string{"app": IstiodDeployment},
-			},
-			Status: appsv1.DeploymentStatus{
-				AvailableReplicas: 1,
-				Replicas:          1,
-				UpdatedReplicas:   1,
-			},
-		},
-		&appsv1.Deployment{
-			ObjectMeta: metav1.ObjectMeta{
-				Namespace: IstioNamespace,
-				Name:      IstioIngressgatewayDeployment,
-				Labels:    map[string]string{"app": IstioIngressgatewayDeployment},
-			},
-			Status: appsv1.DeploymentStatus{
-				AvailableReplicas: 1,
-				Replicas:          1,
-				UpdatedReplicas:   1,
-			},
-		},
-		&appsv1.Deployment{
-			ObjectMeta: metav1.ObjectMeta{
-				Namespace: IstioNamespace,
-				Name:      IstioEgressgatewayDeployment,
-				Labels:    map[string]string{"app": IstioEgressgatewayDeployment},
-			},
-			Status: appsv1.DeploymentStatus{
-				AvailableReplicas: 1,
-				Replicas:          1,
-				UpdatedReplicas:   1,
-			},
-		},
+		newReadyDeployment(IstiodDeployment),
+		newReadyDeployment(IstioIngressgatewayDeployment),
+		newReadyDeployment(IstioEgressgatewayDeployment),
+		newTestIstioOperator(istio.StatusHealthy),
 	).Build()
 
-	isInstalledFunc = func(log vzlog.VerrazzanoLogger) (bool, error) {
-		return true, nil
-	}
-	defer func() { isInstalledFunc = istio.IsInstalled }()
-
 	var iComp istioComponent
 	compContext := spi.NewFakeContext(fakeClient, &vzapi.Verrazzano{}, false)
 	assert.True(t, iComp.IsReady(compContext))
 }
 
+// TestIsReadyNotHealthy tests the IsReady function
+// GIVEN a call to IsReady
+//  WHEN the deployment objects have enough replicas available and the IstioOperator is still RECONCILING or has failed
+//  THEN false is returned
+func TestIsReadyNotHealthy(t *testing.T) {
+	for _, status := range []istio.InstallStatus{istio.StatusReconciling, istio.StatusError} {
+		fakeClient := fake.NewClientBuilder().WithScheme(k8scheme.Scheme).WithObjects(
+			newReadyDeployment(IstiodDeployment),
+			newReadyDeployment(IstioIngressgatewayDeployment),
+			newReadyDeployment(IstioEgressgatewayDeployment),
+			newTestIstioOperator(status),
+		).Build()
+
+		var iComp istioComponent
+		compContext := spi.NewFakeContext(fakeClient, &vzapi.Verrazzano{}, false)
+		assert.False(t, iComp.IsReady(compContext), string(status))
+	}
+}
+
+// newReadyDeployment returns an Istio deployment with one ready replica
+func newReadyDeployment(name string) *appsv1.Deployment {
+	return &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: IstioNamespace,
+			Name:      name,
+			Labels:    map[string]string{"app": name},
+		},
+		Status: appsv1.DeploymentStatus{
+			AvailableReplicas: 1,
+			Replicas:          1,
+			UpdatedReplicas:   1,
+		},
+	}
+}
+
+// newTestIstioOperator returns the Verrazzano IstioOperator with the status
+func newTestIstioOperator(status istio.InstallStatus) *unstructured.Unstructured {
+	iop := &unstructured.Unstructured{}
+	iop.SetGroupVersionKind(istio.IstioOperatorGVK)
+	iop.SetNamespace(istio.IstioOperatorNamespace)
+	iop.SetName(istio.IstioOperatorName)
+	iop.Object["status"] = map[string]interface{}{"status": string(status)}
+	return iop
+}
+
 // TestIsEnabledNilIstio tests the IsEnabled function
 // GIVEN a call to IsEnabled
 //  WHEN The Istio component is nil
@@ -91,7 +91,7 @@ type ReplicaData struct {
 	ExternalIps         string
 }
 
-// BuildIstioOperatorYaml builds the IstioOperator CR YAML that will be passed as an override to istioctl
+// BuildIstioOperatorYaml builds the IstioOperator CR YAML that is overlaid onto the Verrazzano IstioOperator
 // Transform the Verrazzano CR istioComponent provided by the user onto an IstioOperator formatted YAML
 func BuildIstioOperatorYaml(ctx spi.ComponentContext, comp *vzapi.IstioComponent) (string, error) {
 
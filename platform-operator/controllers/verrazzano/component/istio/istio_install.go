@@ -7,7 +7,6 @@ import (
 	"context"
 	"github.com/verrazzano/verrazzano/platform-operator/controllers/verrazzano/component/common"
 	"io/ioutil"
-	"os"
 	"path/filepath"
 
 	"github.com/verrazzano/verrazzano/pkg/istio"
@@ -25,29 +24,21 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	controllerruntime "sigs.k8s.io/controller-runtime"
+	clipkg "sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 )
 
 const (
 	// IstioCertSecret is the secret name used for Istio MTLS certs
 	IstioCertSecret = "cacerts"
-
-	istioTempPrefix           = "istio-"
-	istioTempSuffix           = "yaml"
-	istioTmpFileCreatePattern = istioTempPrefix + "*." + istioTempSuffix
-	istioTmpFileCleanPattern  = istioTempPrefix + ".*\\." + istioTempSuffix
 )
 
 // create func vars for unit tests
-type installFuncSig func(log vzlog.VerrazzanoLogger, imageOverridesString string, overridesFiles ...string) (stdout []byte, stderr []byte, err error)
+type installFuncSig func(log vzlog.VerrazzanoLogger, client clipkg.Client, overrideStrings string, overlays ...string) error
 
 var installFunc installFuncSig = istio.Install
 
-type forkInstallFuncSig func(compContext spi.ComponentContext, monitor installMonitor, overrideStrings string, files []string) error
-
-var forkInstallFunc forkInstallFuncSig = forkInstall
-
-type isInstalledFuncSig func(log vzlog.VerrazzanoLogger) (bool, error)
+type isInstalledFuncSig func(log vzlog.VerrazzanoLogger, client clipkg.Client) (bool, error)
 
 var isInstalledFunc isInstalledFuncSig = istio.IsInstalled
 
@@ -63,91 +54,6 @@ func setBashFunc(f bashFuncSig) {
 	bashFunc = f
 }
 
-type installMonitorType struct {
-	running  bool
-	resultCh chan bool
-	inputCh  chan installRoutineParams
-}
-
-//installRoutineParams - Used to pass args to the install goroutine
-type installRoutineParams struct {
-	overrides     string
-	fileOverrides []string
-	log           vzlog.VerrazzanoLogger
-}
-
-//installMonitor - Represents a monitor object used by the component to monitor a background goroutine used for running
-// istioctl install operations asynchronously.
-type installMonitor interface {
-	// checkResult - Checks for a result from the install goroutine; returns either the result of the operation, or an error indicating
-	// the install is still in progress
-	checkResult() (bool, error)
-	// reset - Resets the monitor and closes any open channels
-	reset()
-	// isRunning - returns true of the monitor/goroutine are active
-	isRunning() bool
-	// run - Run the install with the specified args
-	run(args installRoutineParams)
-}
-
-//checkResult - checks for a result from the goroutine
-// - returns false and a retry error if it's still running, or the result from the channel and nil if an answer was received
-func (m *installMonitorType) checkResult() (bool, error) {
-	select {
-	case result := <-m.resultCh:
-		return result, nil
-	default:
-		return false, ctrlerrors.RetryableError{Source: ComponentName}
-	}
-}
-
-//reset - reset the monitor and close the channel
-func (m *installMonitorType) reset() {
-	m.running = false
-	close(m.resultCh)
-	close(m.inputCh)
-}
-
-//isRunning - returns true of the monitor/goroutine are active
-func (m *installMonitorType) isRunning() bool {
-	return m.running
-}
-
-//run - Run the install in a goroutine
-func (m *installMonitorType) run(args installRoutineParams) {
-	m.running = true
-	m.resultCh = make(chan bool, 2)
-	m.inputCh = make(chan installRoutineParams, 2)
-
-	// Run the install in the background
-	go func(inputCh chan installRoutineParams, outputCh chan bool) {
-		// The function will execute once, sending true on success, false on failure to the channel reader
-		// Read inputs
-		args := <-inputCh
-		log := args.log
-
-		result := true
-		log.Oncef("Component Istio is running istioctl")
-		stdout, stderr, err := installFunc(log, args.overrides, args.fileOverrides...)
-		log.Debugf("istioctl stdout: %s", string(stdout))
-		if err != nil {
-			result = false
-			err = log.ErrorfNewErr("Failed calling istioctl install: %v stderr: %s", err.Error(), string(stderr))
-		} else {
-			log.Infof("Component Istio successfully ran istioctl install")
-		}
-
-		// Clean up the temp files
-		removeTempFiles(log)
-
-		// Write result
-		outputCh <- result
-	}(m.inputCh, m.resultCh)
-
-	// Pass in the args to get started
-	m.inputCh <- args
-}
-
 func (i istioComponent) IsOperatorInstallSupported() bool {
 	return true
 }
@@ -166,38 +72,24 @@ func (i istioComponent) IsInstalled(compContext spi.ComponentContext) (bool, err
 	return true, nil
 }
 
-//Install - istioComponent install
-//
-// This utilizes the istioctl utility for install, which blocks during the entire installation process.  This can
-// take up to several minutes and block the controller.  For now, we launch the install operation in a goroutine
-// and requeue to check the result later.
-//
-// On subsequent callbacks, we check the status of the goroutine via the 'monitor' object.  If the monitor detects that
-// the goroutine is still running, it returns a RetryableError that we return back to the controller to requeue and
-// check again later.
-//
-// If the monitor detects that the goroutine is finished, we either return nil (success) for the successful install
-// case, or reset the monitor state and drop down to the rest of the install method to retry the install again.
+// Install applies the Verrazzano IstioOperator resource, the in-cluster Istio operator then installs Istio from it.
+// The Istio operator is installed by PreInstall, Install requeues until the operator is ready.  IsReady waits for the
+// Istio operator to report that the installation is healthy.
 func (i istioComponent) Install(compContext spi.ComponentContext) error {
-	if i.monitor.isRunning() {
-		// Check the result
-		succeeded, err := i.monitor.checkResult()
-		if err != nil {
-			// Not finished yet, requeue
-			return err
-		}
-		// reset on success or failure
-		i.monitor.reset()
-		// If it's not finished running, requeue
-		if succeeded {
-			return nil
-		}
-		// if we were unsuccessful, reset and drop through to try again
-		compContext.Log().Debug("Error during istio install, retrying")
+	if compContext.IsDryRun() {
+		compContext.Log().Debug("Istio Install() dry run")
+		return nil
 	}
+	return i.applyIstioOperator(compContext, installFunc)
+}
 
-	// build list of temp files
-	istioTempFiles, err := i.createIstioTempFiles(compContext)
+// applyIstioOperator builds the IstioOperator overlays and override strings and passes them to the apply function
+func (i istioComponent) applyIstioOperator(compContext spi.ComponentContext, apply func(log vzlog.VerrazzanoLogger, client clipkg.Client, overrideStrings string, overlays ...string) error) error {
+	if !isOperatorReady(compContext) {
+		return ctrlerrors.RetryableError{Source: ComponentName}
+	}
+
+	overlays, err := i.buildIstioOperatorOverlays(compContext)
 	if err != nil {
 		return err
 	}
@@ -208,29 +100,7 @@ func (i istioComponent) Install(compContext spi.ComponentContext) error {
 		return err
 	}
 
-	return forkInstallFunc(compContext, i.monitor, overrideStrings, istioTempFiles)
-}
-
-//forkInstall - istioctl install blocks, fork it into the background
-func forkInstall(compContext spi.ComponentContext, monitor installMonitor, overrideStrings string, files []string) error {
-	log := compContext.Log()
-	log.Debugf("Creating background install goroutine for Istio")
-	// clone the parameters
-	overridesFilesCopy := make([]string, len(files))
-	copy(overridesFilesCopy, files)
-
-	// clone zap logger
-	clone := log.GetZapLogger().With()
-	log.SetZapLogger(clone)
-
-	monitor.run(
-		installRoutineParams{
-			overrides:     overrideStrings,
-			fileOverrides: overridesFilesCopy,
-			log:           log,
-		},
-	)
-	return ctrlerrors.RetryableError{Source: ComponentName}
+	return apply(compContext.Log(), compContext.Client(), overrideStrings, overlays...)
 }
 
 func (i istioComponent) PreInstall(compContext spi.ComponentContext) error {
@@ -240,7 +110,7 @@ func (i istioComponent) PreInstall(compContext spi.ComponentContext) error {
 	if err := createCertSecret(compContext); err != nil {
 		return err
 	}
-	return nil
+	return installOperator(compContext)
 }
 
 func (i istioComponent) PostInstall(compContext spi.ComponentContext) error {
@@ -258,42 +128,35 @@ func (i istioComponent) PostInstall(compContext spi.ComponentContext) error {
 	return nil
 }
 
-// createIstioTempFiles creates and returns the temp files needed for installing and upgrading istio
-func (i istioComponent) createIstioTempFiles(compContext spi.ComponentContext) ([]string, error) {
+// buildIstioOperatorOverlays returns the IstioOperator YAML overlays needed for installing and upgrading istio, the
+// latter overlays have precedence
+func (i istioComponent) buildIstioOperatorOverlays(compContext spi.ComponentContext) ([]string, error) {
 	cr := compContext.EffectiveCR()
 	log := compContext.Log()
 
-	files := []string{i.ValuesFile}
+	values, err := ioutil.ReadFile(i.ValuesFile)
+	if err != nil {
+		return nil, log.ErrorfNewErr("Failed to read the IstioOperator values file %s: %v", i.ValuesFile, err)
+	}
+	overlays := []string{string(values)}
 
-	// Only create override file if the CR has an Istio component
+	// Only create the overlays if the CR has an Istio component
 	if cr.Spec.Components.Istio != nil {
 		// create operator YAML
 		istioOperatorYaml, err := BuildIstioOperatorYaml(compContext, cr.Spec.Components.Istio)
 		if err != nil {
-			return files, log.ErrorfNewErr("Failed to Build IstioOperator YAML: %v", err)
-		}
-
-		// Write the overrides to a tmp file and append it to files
-		userFileCR, err := createTempFile(log, istioOperatorYaml)
-		if err != nil {
-			return files, err
+			return overlays, log.ErrorfNewErr("Failed to Build IstioOperator YAML: %v", err)
 		}
-		files = append(files, userFileCR)
+		overlays = append(overlays, istioOperatorYaml)
 
-		// get the install overrides from the VZ CR, write it to a temp file and append it
+		// get the install overrides from the VZ CR and append them
 		overrideYAMLs, err := common.GetInstallOverridesYAML(compContext, cr.Spec.Components.Istio.ValueOverrides)
 		if err != nil {
-			return files, err
-		}
-		for _, overrideYAML := range overrideYAMLs {
-			overrideFile, err := createTempFile(log, overrideYAML)
-			if err != nil {
-				return files, err
-			}
-			files = append(files, overrideFile)
+			return overlays, err
 		}
+		overlays = append(overlays, overrideYAMLs...)
 	}
-	return files, nil
+	return overlays, nil
 }
 
 func createCertSecret(compContext spi.ComponentContext) error {
@@ -352,26 +215,3 @@ func createPeerAuthentication(compContext spi.ComponentContext) error {
 	})
 	return err
 }
-
-// createTempFile creates an Istio temp file and returns the name
-func createTempFile(log vzlog.VerrazzanoLogger, data string) (string, error) {
-	file, err := ioutil.TempFile(os.TempDir(), istioTmpFileCreatePattern)
-	if err != nil {
-		return "", log.ErrorfNewErr("Failed to create temporary file for Istio install: %v", err)
-	}
-	if _, err = file.Write([]byte(data)); err != nil {
-		return "", log.ErrorfNewErr("Failed to write to temporary file: %v", err)
-	}
-	if err := file.Close(); err != nil {
-		return "", log.ErrorfNewErr("Failed to close temporary file: %v", err)
-	}
-	log.Debugf("Created values file from Istio install args: %s", file.Name())
-	return file.Name(), nil
-}
-
-// removeTempFiles removes Istio temp files
-func removeTempFiles(log vzlog.VerrazzanoLogger) {
-	if err := os2.RemoveTempFiles(log.GetZapLogger(), istioTmpFileCleanPattern); err != nil {
-		log.Errorf("Unexpected error removing temp files: %v", err.Error())
-	}
-}
@@ -6,13 +6,11 @@ package istio
 import (
 	"context"
 	"fmt"
-	"io/ioutil"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	"os/exec"
 	"strings"
 	"testing"
-	"time"
 
+	"github.com/verrazzano/verrazzano/pkg/helm"
 	"github.com/verrazzano/verrazzano/pkg/istio"
 	"github.com/verrazzano/verrazzano/pkg/log/vzlog"
 
@@ -28,15 +26,14 @@ import (
 	istioclisec "istio.io/client-go/pkg/apis/security/v1beta1"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
+	k8scheme "k8s.io/client-go/kubernetes/scheme"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 )
 
-// fakeIstioInstalledRunner is used to test if Istio is installed
-type fakeIstioInstalledRunner struct {
-}
-
 var installCR = &installv1alpha1.Verrazzano{
 	Spec: installv1alpha1.VerrazzanoSpec{
 		Components: installv1alpha1.ComponentSpec{
@@ -116,30 +113,6 @@ var installCR = &installv1alpha1.Verrazzano{
 	},
 }
 
-type fakeMonitor struct {
-	result          bool
-	istioctlSuccess bool
-	err             error
-	running         bool
-}
-
-func (f *fakeMonitor) run(args installRoutineParams) {
-}
-
-func (f *fakeMonitor) checkResult() (bool, error) { return f.result, f.err }
-
-func (f *fakeMonitor) reset() {}
-
-func (f *fakeMonitor) init() {}
-
-func (f *fakeMonitor) sendResult(r bool) {}
-
-func (f *fakeMonitor) isRunning() bool { return f.running }
-
-func (f *fakeMonitor) isIstioctlSuccess() bool { return f.istioctlSuccess }
-
-var _ installMonitor = &fakeMonitor{}
-
 // TestIsOperatorInstallSupported tests if the install is supported
 // GIVEN a component
 //  WHEN I call IsOperatorInstallSupported
@@ -158,7 +131,6 @@ func TestIsOperatorInstallSupported(t *testing.T) {
 func TestIsInstalled(t *testing.T) {
 	a := assert.New(t)
 
-	istio.SetCmdRunner(fakeIstioInstalledRunner{})
 	b, err := comp.IsInstalled(spi.NewFakeContext(getIsInstalledMock(t), installCR, false))
 	a.NoError(err, "IsInstalled returned an error")
 	a.True(b, "IsInstalled returned false")
@@ -182,7 +154,6 @@ func getIsInstalledMock(t *testing.T) *mocks.MockClient {
 func TestIsNotInstalled(t *testing.T) {
 	a := assert.New(t)
 
-	istio.SetCmdRunner(fakeIstioInstalledRunner{})
 	b, err := comp.IsInstalled(spi.NewFakeContext(getIsNotInstalledMock(t), installCR, false))
 	a.NoError(err, "IsInstalled returned an error")
 	a.False(b, "IsInstalled returned true")
@@ -200,185 +171,119 @@ func getIsNotInstalledMock(t *testing.T) *mocks.MockClient {
 }
 
 // TestInstall tests the component install
-// GIVEN a component
+// GIVEN a component and a ready Istio operator
 //  WHEN I call Install
-//  THEN the install starts a new attempt and returns a RetryableError to requeue
+//  THEN the install function is called with the IstioOperator overlays
 func TestInstall(t *testing.T) {
 	a := assert.New(t)
 
 	comp := istioComponent{
-		ValuesFile: "test-values-file.yaml",
-		monitor:    &fakeMonitor{result: true, running: false},
+		ValuesFile: testValuesFile,
 	}
 
-	expectedErr := spi2.RetryableError{Source: ComponentName}
-	forkInstallFunc = func(_ spi.ComponentContext, _ installMonitor, _ string, _ []string) error {
-		return expectedErr
-	}
-	defer func() { forkInstallFunc = forkInstall }()
-
 	config.SetDefaultBomFilePath(testBomFilePath)
-	istio.SetCmdRunner(fakeRunner{})
 	setInstallFunc(fakeInstall)
-	setBashFunc(fakeBash)
+	defer setInstallFunc(istio.Install)
+	fakeClient := fake.NewClientBuilder().WithScheme(k8scheme.Scheme).WithObjects(newReadyDeployment(IstioOperatorDeployment)).Build()
 
-	err := comp.Install(spi.NewFakeContext(getIstioInstallMock(t), installCR, false))
-	a.Equal(expectedErr, err, "Upgrade returned an unexpected error")
+	err := comp.Install(spi.NewFakeContext(fakeClient, installCR, false))
+	a.NoError(err, "Install returned an error")
 }
 
-// TestBackgroundInstallCompletedSuccessfully tests the component install
-// GIVEN a call to istioComponent.Install()
-//  WHEN when the monitor goroutine failed to successfully complete
-//  THEN the Install() method returns nil without calling the forkInstall function
-func TestBackgroundInstallCompletedSuccessfully(t *testing.T) {
+// TestInstallOperatorNotReady tests the component install
+// GIVEN a component and an Istio operator that is not ready
+//  WHEN I call Install
+//  THEN a RetryableError is returned to requeue and the install function is not called
+func TestInstallOperatorNotReady(t *testing.T) {
 	a := assert.New(t)
 
 	comp := istioComponent{
-		ValuesFile: "test-values-file.yaml",
+		ValuesFile: testValuesFile,
 	}
 
-	forkInstallFunc = func(_ spi.ComponentContext, _ installMonitor, _ string, _ []string) error {
-		a.Fail("Unexpected call to forkInstall() function")
-		return nil
-	}
-	defer func() { forkInstallFunc = forkInstall }()
-
 	config.SetDefaultBomFilePath(testBomFilePath)
-	istio.SetCmdRunner(fakeRunner{})
-	setInstallFunc(fakeInstall)
-	setBashFunc(fakeBash)
+	setInstallFunc(func(_ vzlog.VerrazzanoLogger, _ client.Client, _ string, _ ...string) error {
+		a.Fail("Unexpected call to the install function")
+		return nil
+	})
+	defer setInstallFunc(istio.Install)
+	fakeClient := fake.NewClientBuilder().WithScheme(k8scheme.Scheme).Build()
 
-	comp.monitor = &fakeMonitor{result: true, running: true}
-	err := comp.Install(spi.NewFakeContext(getIstioInstallMock(t), installCR, false))
-	a.NoError(err)
+	err := comp.Install(spi.NewFakeContext(fakeClient, installCR, false))
+	a.Equal(spi2.RetryableError{Source: ComponentName}, err)
 }
 
-// TestBackgroundInstallRetryOnFailure tests the component install
-// GIVEN a call to istioComponent.Install()
-//  WHEN when the monitor goroutine failed to successfully complete
-//  THEN the Install() method calls the forkInstall function and returns a retry error
-func TestBackgroundInstallRetryOnFailure(t *testing.T) {
+// TestInstallIstioOperator tests the component install
+// GIVEN a component and a ready Istio operator
+//  WHEN I call Install
+//  THEN the Verrazzano IstioOperator is created with the BOM images, the install args and the values file
+func TestInstallIstioOperator(t *testing.T) {
 	a := assert.New(t)
 
 	comp := istioComponent{
-		ValuesFile: "test-values-file.yaml",
+		ValuesFile: testValuesFile,
 	}
 
-	forkFuncCalled := false
-	expectedErr := spi2.RetryableError{Source: ComponentName}
-	forkInstallFunc = func(_ spi.ComponentContext, _ installMonitor, _ string, _ []string) error {
-		forkFuncCalled = true
-		return expectedErr
-	}
-	defer func() { forkInstallFunc = forkInstall }()
-
 	config.SetDefaultBomFilePath(testBomFilePath)
-	istio.SetCmdRunner(fakeRunner{})
-	setInstallFunc(fakeInstall)
-	setBashFunc(fakeBash)
-
-	comp.monitor = &fakeMonitor{result: false, running: true}
-
-	err := comp.Install(spi.NewFakeContext(getIstioInstallMock(t), installCR, false))
-	a.True(forkFuncCalled)
-	a.Equal(expectedErr, err)
+	fakeClient := fake.NewClientBuilder().WithScheme(k8scheme.Scheme).WithObjects(newReadyDeployment(IstioOperatorDeployment)).Build()
+
+	err := comp.Install(spi.NewFakeContext(fakeClient, installCR, false))
+	a.NoError(err, "Install returned an error")
+
+	iop := &unstructured.Unstructured{}
+	iop.SetGroupVersionKind(istio.IstioOperatorGVK)
+	a.NoError(fakeClient.Get(context.TODO(), types.NamespacedName{Namespace: istio.IstioOperatorNamespace, Name: istio.IstioOperatorName}, iop))
+	hub, _, _ := unstructured.NestedString(iop.Object, "spec", "values", "global", "hub")
+	a.Equal("ghcr.io/verrazzano", hub)
+	tag, _, _ := unstructured.NestedString(iop.Object, "spec", "values", "global", "tag")
+	a.Equal("1.10.4", tag)
+	arg, _, _ := unstructured.NestedString(iop.Object, "spec", "values", "arg1")
+	a.Equal("val1", arg)
+	gateways, _, _ := unstructured.NestedSlice(iop.Object, "spec", "components", "ingressGateways")
+	a.Len(gateways, 1)
 }
 
-// Test_forkInstallSuccess tests the forkInstall function
-// GIVEN a call to istioComponent.forkInstall()
-//  WHEN when the monitor install successfully runs istioctl install
-//  THEN retryerrors are returned until the goroutine completes, and sends a success message
-func Test_forkInstallSuccess(t *testing.T) {
+// TestPreInstall tests the component pre-install
+// GIVEN a component
+//  WHEN I call PreInstall
+//  THEN the Istio namespace is labelled and the Istio operator chart is installed in it with the BOM image
+func TestPreInstall(t *testing.T) {
 	a := assert.New(t)
 
-	comp := istioComponent{
-		ValuesFile: "test-values-file.yaml",
-	}
-
 	config.SetDefaultBomFilePath(testBomFilePath)
-	istio.SetCmdRunner(fakeRunner{})
-
-	expectedOverridesFiles := []string{comp.ValuesFile, "istio-overrides.yaml"}
-	expectedOverridesString := "myoverride=true"
-
-	setInstallFunc(func(log vzlog.VerrazzanoLogger, overridesString string, overridesFiles ...string) (stdout []byte, stderr []byte, err error) {
-		a.Equal(expectedOverridesFiles, overridesFiles, "Did not get expected override files")
-		a.Equal(expectedOverridesString, overridesString)
-		return []byte(""), []byte(""), nil
-	})
-	defer func() { installFunc = istio.Install }()
-
 	setBashFunc(fakeBash)
-
-	var monitor installMonitor = &installMonitorType{}
-	err := forkInstall(spi.NewFakeContext(getIstioInstallMock(t), installCR, false), monitor, expectedOverridesString, expectedOverridesFiles)
-	a.Equal(spi2.RetryableError{Source: ComponentName}, err)
-	for i := 0; i < 100; i++ {
-		result, retryError := monitor.checkResult()
-		if retryError != nil {
-			t.Log("Waiting for result...")
-			time.Sleep(100 * time.Millisecond)
-			continue
-		}
-		a.True(result)
-		a.Nil(retryError)
-		return
+	helmUpgradeFunction = func(_ vzlog.VerrazzanoLogger, releaseName string, namespace string, chartDir string, wait bool, _ bool, overrides []helm.HelmOverrides) ([]byte, []byte, error) {
+		a.Equal(istioOperatorReleaseName, releaseName)
+		a.Equal(IstioNamespace, namespace)
+		a.True(strings.HasSuffix(chartDir, "istio-operator"))
+		a.False(wait)
+		a.Len(overrides, 1)
+		a.Equal("hub=ghcr.io/verrazzano,tag=1.10.4,operatorNamespace=istio-system,watchedNamespaces=istio-system", overrides[0].SetOverrides)
+		return []byte{}, []byte{}, nil
 	}
-	a.Fail("Did not detect completion in time")
-}
+	defer func() { helmUpgradeFunction = helm.Upgrade }()
+	fakeClient := fake.NewClientBuilder().WithScheme(k8scheme.Scheme).Build()
 
-// Test_forkInstallFailure tests the forkInstall function
-// GIVEN a call to istioComponent.forkInstall()
-//  WHEN when the monitor install unsuccessfully runs istioctl install
-//  THEN retryerrors are returned until the goroutine completes, and sends a failure message when istioctl fails
-func Test_forkInstallFailure(t *testing.T) {
-	a := assert.New(t)
-
-	comp := istioComponent{
-		ValuesFile: "test-values-file.yaml",
-	}
+	a.NoError(comp.PreInstall(spi.NewFakeContext(fakeClient, installCR, false)))
+	ns := corev1.Namespace{}
+	a.NoError(fakeClient.Get(context.TODO(), types.NamespacedName{Name: IstioNamespace}, &ns))
+	a.Equal(IstioNamespace, ns.Labels["verrazzano.io/namespace"])
+}
 
+// TestPreInstallOperatorFailure tests the component pre-install
+// GIVEN a component
+//  WHEN I call PreInstall and the Istio operator chart install fails
+//  THEN an error is returned
+func TestPreInstallOperatorFailure(t *testing.T) {
 	config.SetDefaultBomFilePath(testBomFilePath)
-	istio.SetCmdRunner(fakeRunner{})
-
-	cause := fmt.Errorf("Unexpected error on install")
-	setInstallFunc(func(log vzlog.VerrazzanoLogger, imageOverridesString string, overridesFiles ...string) (stdout []byte, stderr []byte, err error) {
-		return []byte(""), []byte(""), cause
-	})
-	defer func() { installFunc = istio.Install }()
-
 	setBashFunc(fakeBash)
-
-	var monitor installMonitor = &installMonitorType{}
-	err := forkInstall(spi.NewFakeContext(getIstioInstallMock(t), installCR, false), monitor, "myoverride=true", []string{comp.ValuesFile, "istio-overrides.yaml"})
-	a.Equal(spi2.RetryableError{Source: ComponentName}, err)
-	for i := 0; i < 100; i++ {
-		result, retryError := monitor.checkResult()
-		if retryError != nil {
-			t.Log("Waiting for result...")
-			time.Sleep(100 * time.Millisecond)
-			continue
-		}
-		a.False(result)
-		a.Nil(retryError)
-		return
+	helmUpgradeFunction = func(_ vzlog.VerrazzanoLogger, _ string, _ string, _ string, _ bool, _ bool, _ []helm.HelmOverrides) ([]byte, []byte, error) {
+		return []byte{}, []byte("error"), fmt.Errorf("unexpected error")
 	}
-	a.Fail("Did not detect completion in time")
-}
+	defer func() { helmUpgradeFunction = helm.Upgrade }()
+	fakeClient := fake.NewClientBuilder().WithScheme(k8scheme.Scheme).Build()
 
-func getIstioInstallMock(t *testing.T) *mocks.MockClient {
-	mocker := gomock.NewController(t)
-	mock := mocks.NewMockClient(mocker)
-
-	mock.EXPECT().
-		Get(gomock.Any(), types.NamespacedName{Namespace: constants.DefaultNamespace, Name: constants.GlobalImagePullSecName}, gomock.Not(gomock.Nil())).
-		Return(errors.NewNotFound(schema.GroupResource{Group: constants.DefaultNamespace, Resource: "Secret"}, constants.GlobalImagePullSecName)).AnyTimes()
-
-	mock.EXPECT().
-		Get(gomock.Any(), types.NamespacedName{Namespace: IstioNamespace, Name: constants.GlobalImagePullSecName}, gomock.Not(gomock.Nil())).
-		Return(errors.NewNotFound(schema.GroupResource{Group: IstioNamespace, Resource: "Secret"}, constants.GlobalImagePullSecName)).AnyTimes()
-
-	return mock
+	assert.Error(t, comp.PreInstall(spi.NewFakeContext(fakeClient, installCR, false)))
 }
 
 // TestCreateCertSecret tests the cert secret
@@ -474,33 +379,24 @@ func labelNamespaceMock(t *testing.T) *mocks.MockClient {
 	return mock
 }
 
-// fakeUpgrade verifies that the correct parameter values are passed to upgrade
-func fakeInstall(log vzlog.VerrazzanoLogger, _ string, overridesFiles ...string) (stdout []byte, stderr []byte, err error) {
-	if len(overridesFiles) != 2 {
-		return []byte("error"), []byte(""), fmt.Errorf("incorrect number of override files: expected 2, received %v", len(overridesFiles))
-	}
-	if overridesFiles[0] != "test-values-file.yaml" {
-		return []byte("error"), []byte(""), fmt.Errorf("invalid values file")
+// fakeInstall verifies that the correct parameter values are passed to install
+func fakeInstall(log vzlog.VerrazzanoLogger, _ client.Client, overrideStrings string, overlays ...string) error {
+	if len(overlays) != 2 {
+		return fmt.Errorf("incorrect number of overlays: expected 2, received %v", len(overlays))
 	}
-	if !strings.Contains(overridesFiles[1], "istio-") || !strings.Contains(overridesFiles[1], ".yaml") {
-		return []byte("error"), []byte(""), fmt.Errorf("incorrect install args overrides file")
+	if !strings.Contains(overlays[0], "kind: IstioOperator") {
+		return fmt.Errorf("invalid values file")
 	}
-	installArgsFromFile, err := ioutil.ReadFile(overridesFiles[1])
-	if err != nil {
-		return []byte("error"), []byte(""), fmt.Errorf("unable to read install args overrides file")
+	if !strings.Contains(overlays[1], "val1") {
+		return fmt.Errorf("install args overlay does not contain install args")
 	}
-	if !strings.Contains(string(installArgsFromFile), "val1") {
-		return []byte("error"), []byte(""), fmt.Errorf("install args overrides file does not contain install args")
+	if !strings.Contains(overrideStrings, "values.pilot.image=") {
+		return fmt.Errorf("overrides do not contain the BOM images")
 	}
-	return []byte("success"), []byte(""), nil
+	return nil
 }
 
 // fakeBash verifies that the correct parameter values are passed to upgrade
 func fakeBash(_ ...string) (string, string, error) {
 	return "succes", "", nil
 }
-
-// fakeIsInstalledRunner overrides the istio run command
-func (r fakeIstioInstalledRunner) Run(cmd *exec.Cmd) (stdout []byte, stderr []byte, err error) {
-	return []byte("Istio is installed and verified successfully"), []byte(""), nil
-}
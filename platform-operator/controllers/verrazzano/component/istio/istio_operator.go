@@ -0,0 +1,72 @@
+// Copyright (c) 2022, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package istio
+
+import (
+	"fmt"
+	"path/filepath"
+
+	"github.com/verrazzano/verrazzano/pkg/bom"
+	"github.com/verrazzano/verrazzano/pkg/helm"
+	"github.com/verrazzano/verrazzano/pkg/log/vzlog"
+	"github.com/verrazzano/verrazzano/platform-operator/controllers/verrazzano/component/spi"
+	"github.com/verrazzano/verrazzano/platform-operator/controllers/verrazzano/secret"
+	"github.com/verrazzano/verrazzano/platform-operator/internal/config"
+	"github.com/verrazzano/verrazzano/platform-operator/internal/k8s/status"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+const (
+	// IstioOperatorDeployment is the name of the in-cluster Istio operator deployment
+	IstioOperatorDeployment = "istio-operator"
+
+	// istioOperatorReleaseName is the name of the Helm release of the Istio operator
+	istioOperatorReleaseName = "istio-operator"
+
+	// subcompIstioOperator is the Istio operator subcomponent in the bom
+	subcompIstioOperator = "istio-operator"
+
+	// The Istio operator chart uses this imagePullSecret key
+	operatorImagePullSecretHelmKey = "imagePullSecrets[0]"
+)
+
+type helmUpgradeFuncSig func(log vzlog.VerrazzanoLogger, releaseName string, namespace string, chartDir string, wait bool, dryRun bool, overrides []helm.HelmOverrides) (stdout []byte, stderr []byte, err error)
+
+var helmUpgradeFunction helmUpgradeFuncSig = helm.Upgrade
+
+// installOperator installs or upgrades the Istio operator, which reconciles the Verrazzano IstioOperator resource
+func installOperator(compContext spi.ComponentContext) error {
+	log := compContext.Log()
+	kvs, err := getImageOverrides(subcompIstioOperator)
+	if err != nil {
+		return err
+	}
+	kvs = append(kvs,
+		bom.KeyValue{Key: "operatorNamespace", Value: IstioNamespace},
+		bom.KeyValue{Key: "watchedNamespaces", Value: IstioNamespace})
+	kvs, err = secret.AddGlobalImagePullSecretHelmOverride(log, compContext.Client(), IstioNamespace, kvs, operatorImagePullSecretHelmKey)
+	if err != nil {
+		return err
+	}
+
+	chartDir := filepath.Join(config.GetThirdPartyDir(), istioOperatorReleaseName)
+	overrides := []helm.HelmOverrides{{SetOverrides: joinOverrides(kvs)}}
+	_, stderr, err := helmUpgradeFunction(log, istioOperatorReleaseName, IstioNamespace, chartDir, false, compContext.IsDryRun(), overrides)
+	if err != nil {
+		return log.ErrorfNewErr("Failed installing the Istio operator: %v stderr: %s", err, string(stderr))
+	}
+	return nil
+}
+
+// isOperatorReady returns true if the Istio operator deployment is ready to reconcile the IstioOperator resource
+func isOperatorReady(compContext spi.ComponentContext) bool {
+	prefix := fmt.Sprintf("Component %s", compContext.GetComponent())
+	deployments := []types.NamespacedName{
+		{
+			Name:      IstioOperatorDeployment,
+			Namespace: IstioNamespace,
+		},
+	}
+	return status.DeploymentsAreReady(compContext.Log(), compContext.Client(), deployments, 1, prefix)
+}
@@ -0,0 +1,153 @@
+// Copyright (c) 2022, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package istio
+
+import (
+	"context"
+	"strings"
+
+	"github.com/verrazzano/verrazzano/pkg/helm"
+	"github.com/verrazzano/verrazzano/pkg/istio"
+	"github.com/verrazzano/verrazzano/pkg/log/vzlog"
+	"github.com/verrazzano/verrazzano/platform-operator/controllers/verrazzano/component/common"
+	"github.com/verrazzano/verrazzano/platform-operator/controllers/verrazzano/component/spi"
+	adminv1 "k8s.io/api/admissionregistration/v1"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	clipkg "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	istioSidecarInjectorWebhook = "istio-sidecar-injector"
+	istiodValidatingWebhook     = "istiod-istio-system"
+	istioRootCertConfigMap      = "istio-ca-root-cert"
+)
+
+type uninstallFuncSig func(log vzlog.VerrazzanoLogger, client clipkg.Client) error
+
+var uninstallFunc uninstallFuncSig = istio.Uninstall
+
+func setUninstallFunc(f uninstallFuncSig) {
+	uninstallFunc = f
+}
+
+func setDefaultUninstallFunc() {
+	uninstallFunc = istio.Uninstall
+}
+
+// IsUninstalled checks that the Istio control plane deployment and the Verrazzano IstioOperator resource have been removed
+func (i istioComponent) IsUninstalled(compContext spi.ComponentContext) (bool, error) {
+	installed, err := i.IsInstalled(compContext)
+	if err != nil || installed {
+		return false, err
+	}
+	// The Istio operator removes its finalizer from the IstioOperator once the control plane has been pruned
+	_, err = istio.GetStatus(compContext.Client())
+	if errors.IsNotFound(err) || meta.IsNoMatchError(err) {
+		return true, nil
+	}
+	return false, err
+}
+
+func (i istioComponent) PreUninstall(_ spi.ComponentContext) error {
+	return nil
+}
+
+// Uninstall deletes the Verrazzano IstioOperator resource, the Istio operator then removes the Istio control plane
+func (i istioComponent) Uninstall(compContext spi.ComponentContext) error {
+	if compContext.IsDryRun() {
+		compContext.Log().Debug("Istio Uninstall() dry run")
+		return nil
+	}
+	return uninstallFunc(compContext.Log(), compContext.Client())
+}
+
+// PostUninstall removes the Istio operator, the Istio webhook configurations and the Istio namespace
+func (i istioComponent) PostUninstall(compContext spi.ComponentContext) error {
+	if compContext.IsDryRun() {
+		compContext.Log().Debug("Istio PostUninstall() dry run")
+		return nil
+	}
+	found, err := helm.IsReleaseInstalled(istioOperatorReleaseName, IstioNamespace)
+	if err != nil {
+		return compContext.Log().ErrorfNewErr("Failed searching for release %s: %v", istioOperatorReleaseName, err)
+	}
+	if found {
+		if _, _, err := helmUninstallFunction(compContext.Log(), istioOperatorReleaseName, IstioNamespace, false); err != nil {
+			return compContext.Log().ErrorfNewErr("Failed uninstalling the Istio operator: %v", err)
+		}
+	}
+	objects := []clipkg.Object{
+		&adminv1.MutatingWebhookConfiguration{ObjectMeta: metav1.ObjectMeta{Name: istioSidecarInjectorWebhook}},
+		&adminv1.ValidatingWebhookConfiguration{ObjectMeta: metav1.ObjectMeta{Name: istiodValidatingWebhook}},
+	}
+	for _, obj := range objects {
+		if err := compContext.Client().Delete(context.TODO(), obj); err != nil && !errors.IsNotFound(err) {
+			return compContext.Log().ErrorfNewErr("Failed deleting %s during Istio uninstall: %v", obj.GetName(), err)
+		}
+	}
+	if err := deleteIstioResources(compContext); err != nil {
+		return err
+	}
+	return common.DeleteNamespace(compContext, IstioNamespace)
+}
+
+// deleteIstioResources deletes the cluster resources left by Istio: the API services, ClusterRoles and ClusterRoleBindings,
+// the istio secrets in the system namespaces and the root certificate ConfigMaps.  The Istio CRDs are only deleted
+// with Verrazzano because they hold the application resources.
+func deleteIstioResources(compContext spi.ComponentContext) error {
+	apiServices := unstructured.UnstructuredList{}
+	apiServices.SetGroupVersionKind(schema.GroupVersionKind{Group: "apiregistration.k8s.io", Version: "v1", Kind: "APIServiceList"})
+	if err := compContext.Client().List(context.TODO(), &apiServices); err != nil {
+		return compContext.Log().ErrorfNewErr("Failed listing API services: %v", err)
+	}
+	for i := range apiServices.Items {
+		if strings.Contains(apiServices.Items[i].GetName(), "istio.io") {
+			if err := common.DeleteObjects(compContext, &apiServices.Items[i]); err != nil {
+				return err
+			}
+		}
+	}
+	if err := common.DeleteClusterRolesMatching(compContext, "istio-system|istio-multi|istio-reader|istiocoredns"); err != nil {
+		return err
+	}
+	if common.IsVerrazzanoBeingDeleted(compContext) {
+		if err := common.DeleteCRDsMatching(compContext, `\.istio\.io$`); err != nil {
+			return err
+		}
+	}
+
+	for _, ns := range []string{"default", "kube-public", "kube-node-lease"} {
+		if err := common.DeleteObjects(compContext, &v1.Secret{ObjectMeta: metav1.ObjectMeta{Namespace: ns, Name: "istio.default"}}); err != nil {
+			return err
+		}
+	}
+	secrets := v1.SecretList{}
+	if err := compContext.Client().List(context.TODO(), &secrets, clipkg.InNamespace("kube-system")); err != nil {
+		return compContext.Log().ErrorfNewErr("Failed listing secrets in namespace kube-system: %v", err)
+	}
+	for i := range secrets.Items {
+		if strings.HasPrefix(secrets.Items[i].Name, "istio.") {
+			if err := common.DeleteObjects(compContext, &secrets.Items[i]); err != nil {
+				return err
+			}
+		}
+	}
+
+	namespaces := v1.NamespaceList{}
+	if err := compContext.Client().List(context.TODO(), &namespaces); err != nil {
+		return compContext.Log().ErrorfNewErr("Failed listing namespaces: %v", err)
+	}
+	for _, ns := range namespaces.Items {
+		cm := &v1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Namespace: ns.Name, Name: istioRootCertConfigMap}}
+		if err := common.DeleteObjects(compContext, cm); err != nil {
+			return err
+		}
+	}
+	return nil
+}
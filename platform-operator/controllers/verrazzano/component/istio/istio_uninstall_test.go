@@ -0,0 +1,137 @@
+// Copyright (c) 2022, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package istio
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/verrazzano/verrazzano/pkg/helm"
+	"github.com/verrazzano/verrazzano/pkg/istio"
+	"github.com/verrazzano/verrazzano/pkg/log/vzlog"
+	installv1alpha1 "github.com/verrazzano/verrazzano/platform-operator/apis/verrazzano/v1alpha1"
+	"github.com/verrazzano/verrazzano/platform-operator/controllers/verrazzano/component/spi"
+	adminv1 "k8s.io/api/admissionregistration/v1"
+	appsv1 "k8s.io/api/apps/v1"
+	"helm.sh/helm/v3/pkg/release"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	k8scheme "k8s.io/client-go/kubernetes/scheme"
+	clipkg "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+// TestUninstall tests the Istio Uninstall call
+// GIVEN an Istio component
+//  WHEN I call Uninstall
+//  THEN the uninstall function is invoked
+func TestUninstall(t *testing.T) {
+	a := assert.New(t)
+	called := false
+	setUninstallFunc(func(log vzlog.VerrazzanoLogger, client clipkg.Client) error {
+		called = true
+		return nil
+	})
+	defer setDefaultUninstallFunc()
+
+	comp := istioComponent{}
+	c := fake.NewClientBuilder().WithScheme(k8scheme.Scheme).Build()
+	a.NoError(comp.Uninstall(spi.NewFakeContext(c, &installv1alpha1.Verrazzano{}, false)))
+	a.True(called)
+}
+
+// TestUninstallFailure tests the Istio Uninstall call
+// GIVEN an Istio component
+//  WHEN I call Uninstall and the uninstall function fails
+//  THEN an error is returned
+func TestUninstallFailure(t *testing.T) {
+	setUninstallFunc(func(log vzlog.VerrazzanoLogger, client clipkg.Client) error {
+		return errors.New("error")
+	})
+	defer setDefaultUninstallFunc()
+
+	comp := istioComponent{}
+	c := fake.NewClientBuilder().WithScheme(k8scheme.Scheme).Build()
+	assert.Error(t, comp.Uninstall(spi.NewFakeContext(c, &installv1alpha1.Verrazzano{}, false)))
+}
+
+// TestUninstallDeletesIstioOperator tests the Istio Uninstall call
+// GIVEN the Verrazzano IstioOperator resource
+//  WHEN I call Uninstall
+//  THEN the IstioOperator is deleted
+func TestUninstallDeletesIstioOperator(t *testing.T) {
+	a := assert.New(t)
+	c := fake.NewClientBuilder().WithScheme(k8scheme.Scheme).WithObjects(newTestIstioOperator(istio.StatusHealthy)).Build()
+
+	comp := istioComponent{}
+	a.NoError(comp.Uninstall(spi.NewFakeContext(c, &installv1alpha1.Verrazzano{}, false)))
+	_, err := istio.GetStatus(c)
+	a.True(k8serrors.IsNotFound(err))
+}
+
+// TestPostUninstall tests the Istio PostUninstall call
+// GIVEN the Istio operator release, Istio webhook configurations and the Istio namespace
+//  WHEN I call PostUninstall
+//  THEN the Istio operator release is uninstalled and the webhook configurations and the namespace are deleted
+func TestPostUninstall(t *testing.T) {
+	a := assert.New(t)
+	helm.SetActionConfigFunction(helm.CreateActionConfig(release.Mock(&release.MockReleaseOptions{
+		Name:      istioOperatorReleaseName,
+		Namespace: IstioNamespace,
+	})))
+	defer helm.SetDefaultActionConfigFunction()
+	var uninstalledRelease string
+	SetHelmUninstallFunction(func(_ vzlog.VerrazzanoLogger, releaseName string, namespace string, _ bool) ([]byte, []byte, error) {
+		uninstalledRelease = releaseName
+		return []byte{}, []byte{}, nil
+	})
+	defer SetDefaultHelmUninstallFunction()
+
+	c := fake.NewClientBuilder().WithScheme(k8scheme.Scheme).WithObjects(
+		&adminv1.MutatingWebhookConfiguration{ObjectMeta: metav1.ObjectMeta{Name: istioSidecarInjectorWebhook}},
+		&adminv1.ValidatingWebhookConfiguration{ObjectMeta: metav1.ObjectMeta{Name: istiodValidatingWebhook}},
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: IstioNamespace}},
+	).Build()
+
+	comp := istioComponent{}
+	a.NoError(comp.PostUninstall(spi.NewFakeContext(c, &installv1alpha1.Verrazzano{}, false)))
+
+	a.Error(c.Get(context.TODO(), types.NamespacedName{Name: istioSidecarInjectorWebhook}, &adminv1.MutatingWebhookConfiguration{}))
+	a.Error(c.Get(context.TODO(), types.NamespacedName{Name: istiodValidatingWebhook}, &adminv1.ValidatingWebhookConfiguration{}))
+	a.Error(c.Get(context.TODO(), types.NamespacedName{Name: IstioNamespace}, &corev1.Namespace{}))
+	a.Equal(istioOperatorReleaseName, uninstalledRelease)
+
+	// Deleting resources that are already gone is not an error
+	a.NoError(comp.PostUninstall(spi.NewFakeContext(c, &installv1alpha1.Verrazzano{}, false)))
+}
+
+// TestIsUninstalled tests the Istio IsUninstalled call
+// GIVEN an Istio component
+//  WHEN I call IsUninstalled
+//  THEN true is returned only when the istiod deployment and the IstioOperator no longer exist
+func TestIsUninstalled(t *testing.T) {
+	a := assert.New(t)
+	comp := istioComponent{}
+
+	c := fake.NewClientBuilder().WithScheme(k8scheme.Scheme).WithObjects(
+		&appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: IstiodDeployment, Namespace: IstioNamespace}},
+	).Build()
+	uninstalled, err := comp.IsUninstalled(spi.NewFakeContext(c, &installv1alpha1.Verrazzano{}, false))
+	a.NoError(err)
+	a.False(uninstalled)
+
+	c = fake.NewClientBuilder().WithScheme(k8scheme.Scheme).WithObjects(newTestIstioOperator(istio.StatusHealthy)).Build()
+	uninstalled, err = comp.IsUninstalled(spi.NewFakeContext(c, &installv1alpha1.Verrazzano{}, false))
+	a.NoError(err)
+	a.False(uninstalled)
+
+	c = fake.NewClientBuilder().WithScheme(k8scheme.Scheme).Build()
+	uninstalled, err = comp.IsUninstalled(spi.NewFakeContext(c, &installv1alpha1.Verrazzano{}, false))
+	a.NoError(err)
+	a.True(uninstalled)
+}
@@ -47,6 +47,20 @@ func componentInstall(ctx spi.ComponentContext) error {
 	return nil
 }
 
+func componentUninstall(ctx spi.ComponentContext) error {
+	args, err := buildInstallArgs()
+	if err != nil {
+		return err
+	}
+
+	// Delete the Jaeger Operator resources
+	yamlApplier := k8sutil.NewYAMLApplier(ctx.Client(), "")
+	if err := yamlApplier.DeleteFT(path.Join(config.GetThirdPartyManifestsDir(), templateFile), args); err != nil {
+		return ctx.Log().ErrorfNewErr("Failed to uninstall Jaeger Operator: %v", err)
+	}
+	return nil
+}
+
 func buildInstallArgs() (map[string]interface{}, error) {
 	args := map[string]interface{}{
 		"namespace": constants.VerrazzanoMonitoringNamespace,
@@ -54,6 +54,15 @@ func (c jaegerOperatorComponent) Install(ctx spi.ComponentContext) error {
 	return componentInstall(ctx)
 }
 
+func (c jaegerOperatorComponent) Uninstall(ctx spi.ComponentContext) error {
+	return componentUninstall(ctx)
+}
+
+func (c jaegerOperatorComponent) IsUninstalled(ctx spi.ComponentContext) (bool, error) {
+	installed, err := c.IsInstalled(ctx)
+	return !installed, err
+}
+
 func (c jaegerOperatorComponent) Reconcile(ctx spi.ComponentContext) error {
 	return nil
 }
@@ -62,6 +71,11 @@ func (c jaegerOperatorComponent) IsReady(context spi.ComponentContext) bool {
 	return status.DeploymentsAreReady(context.Log(), context.Client(), deployments, 1, componentPrefix)
 }
 
+// GetNotReadyWorkloads returns the Jaeger Operator deployment if it is not ready
+func (c jaegerOperatorComponent) GetNotReadyWorkloads(context spi.ComponentContext) ([]string, error) {
+	return status.GetNotReadyNamedWorkloads(context.Client(), deployments)
+}
+
 // IsEnabled returns true only if the Jaeger Operator is explicitly enabled
 // in the Verrazzano CR.
 func (c jaegerOperatorComponent) IsEnabled(effectiveCR *vzapi.Verrazzano) bool {
@@ -129,6 +143,14 @@ func (c jaegerOperatorComponent) PostUpgrade(_ spi.ComponentContext) error {
 	return nil
 }
 
+func (c jaegerOperatorComponent) PreUninstall(_ spi.ComponentContext) error {
+	return nil
+}
+
+func (c jaegerOperatorComponent) PostUninstall(_ spi.ComponentContext) error {
+	return nil
+}
+
 func (c jaegerOperatorComponent) GetIngressNames(_ spi.ComponentContext) []types.NamespacedName {
 	return nil
 }
@@ -0,0 +1,277 @@
+// Copyright (c) 2022, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package keycloak
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/verrazzano/verrazzano/pkg/keycloak"
+	vzapi "github.com/verrazzano/verrazzano/platform-operator/apis/verrazzano/v1alpha1"
+	"github.com/verrazzano/verrazzano/platform-operator/controllers/verrazzano/component/spi"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// resyncInterval is how often the Keycloak configuration is reapplied while Keycloak is ready
+	resyncInterval = 5 * time.Minute
+
+	// userPasswordKey is the key of the initial user password in the secret of a declared user
+	userPasswordKey = "password"
+
+	// clientSecretKey is the key of the client secret in the secret of a declared confidential client
+	clientSecretKey = "clientSecret"
+)
+
+// masterRealm is the Keycloak realm of the administrators, it cannot be declared in the identity section
+const masterRealm = "master"
+
+// reservedClients are the clients of the Verrazzano system realm that Verrazzano owns
+var reservedClients = []string{"verrazzano-pkce", "verrazzano-pg"}
+
+// configureIdentity creates the realms, roles, groups, users and clients declared in the security identity
+// section of the Verrazzano resource, and updates the realm settings and clients that changed.  The resync only
+// creates and updates, objects removed from the declaration are left in Keycloak and must be deleted there.
+func configureIdentity(ctx spi.ComponentContext, kc *keycloak.AdminClient) error {
+	identity := ctx.EffectiveCR().Spec.Security.Identity
+	if identity == nil {
+		return nil
+	}
+	for _, realm := range identity.Realms {
+		if err := configureIdentityRealm(ctx, kc, realm); err != nil {
+			return err
+		}
+	}
+	ctx.Log().Oncef("Component Keycloak successfully applied the identity configuration of %d realms", len(identity.Realms))
+	return nil
+}
+
+// configureIdentityRealm creates the realm and its content, then enables it with the declared settings
+func configureIdentityRealm(ctx spi.ComponentContext, kc *keycloak.AdminClient, realm vzapi.IdentityRealm) error {
+	if err := createRealm(ctx, kc, realm.Name); err != nil {
+		return err
+	}
+	for _, role := range realm.Roles {
+		if err := createRealmRole(ctx, kc, realm.Name, keycloak.Role{Name: role.Name, Description: role.Description}); err != nil {
+			return err
+		}
+	}
+	for _, group := range realm.Groups {
+		groupID, err := createGroupPath(ctx, kc, realm.Name, group.Path)
+		if err != nil {
+			return err
+		}
+		for _, role := range group.Roles {
+			if err := grantRoleToGroup(ctx, kc, realm.Name, role, groupID); err != nil {
+				return err
+			}
+		}
+	}
+	for _, user := range realm.Users {
+		if err := createIdentityUser(ctx, kc, realm.Name, user); err != nil {
+			return err
+		}
+	}
+	for _, oidcClient := range realm.Clients {
+		desired, err := buildClient(ctx, oidcClient)
+		if err != nil {
+			return err
+		}
+		if err := applyClient(ctx, kc, realm.Name, desired); err != nil {
+			return err
+		}
+	}
+
+	enabled := true
+	if realm.Enabled != nil {
+		enabled = *realm.Enabled
+	}
+	return updateRealm(ctx, kc, keycloak.Realm{
+		Realm:          realm.Name,
+		Enabled:        &enabled,
+		PasswordPolicy: realm.PasswordPolicy,
+		LoginTheme:     realm.LoginTheme,
+	})
+}
+
+// createGroupPath creates the group with the path, and any missing parent groups, and returns the ID of the group
+func createGroupPath(ctx spi.ComponentContext, kc *keycloak.AdminClient, realm string, groupPath string) (string, error) {
+	groups, err := kc.GetGroups(realm)
+	if err != nil {
+		ctx.Log().Errorf("Component Keycloak failed retrieving the groups of realm %s: %v", realm, err)
+		return "", err
+	}
+
+	var parentID, currentPath string
+	for _, name := range strings.Split(strings.Trim(groupPath, "/"), "/") {
+		currentPath = currentPath + "/" + name
+		if group := keycloak.FindGroupByPath(groups, currentPath); group != nil {
+			parentID = group.ID
+			continue
+		}
+		var id string
+		if parentID == "" {
+			id, err = kc.CreateGroup(realm, keycloak.Group{Name: name})
+		} else {
+			id, err = kc.CreateChildGroup(realm, parentID, keycloak.Group{Name: name})
+		}
+		if err != nil {
+			ctx.Log().Errorf("Component Keycloak failed creating group %s in realm %s: %v", currentPath, realm, err)
+			return "", err
+		}
+		ctx.Log().Oncef("Component Keycloak successfully created group %s in realm %s", currentPath, realm)
+		parentID = id
+	}
+	return parentID, nil
+}
+
+// createIdentityUser creates the user, with the initial password from its secret, if it does not exist
+func createIdentityUser(ctx spi.ComponentContext, kc *keycloak.AdminClient, realm string, user vzapi.IdentityUser) error {
+	users, err := kc.GetUsers(realm, user.Username)
+	if err != nil {
+		ctx.Log().Errorf("Component Keycloak failed retrieving user %s in realm %s: %v", user.Username, realm, err)
+		return err
+	}
+	if len(users) > 0 {
+		return nil
+	}
+
+	var password string
+	if len(user.PasswordSecret) > 0 {
+		if password, err = getIdentitySecretValue(ctx, user.PasswordSecret, userPasswordKey); err != nil {
+			return err
+		}
+	}
+	enabled := true
+	if user.Enabled != nil {
+		enabled = *user.Enabled
+	}
+	id, err := kc.CreateUser(realm, keycloak.User{Username: user.Username, Enabled: enabled, Groups: user.Groups})
+	if err != nil {
+		ctx.Log().Errorf("Component Keycloak failed creating user %s in realm %s: %v", user.Username, realm, err)
+		return err
+	}
+	if len(password) > 0 {
+		if err := kc.ResetPassword(realm, id, password); err != nil {
+			ctx.Log().Errorf("Component Keycloak failed setting the password of user %s in realm %s: %v", user.Username, realm, err)
+			return err
+		}
+	}
+	ctx.Log().Oncef("Component Keycloak successfully created user %s in realm %s", user.Username, realm)
+	return nil
+}
+
+// buildClient returns the Keycloak client representation of the declared OpenID Connect client
+func buildClient(ctx spi.ComponentContext, oidcClient vzapi.OIDCClient) (keycloak.Client, error) {
+	standardFlow := true
+	if oidcClient.StandardFlowEnabled != nil {
+		standardFlow = *oidcClient.StandardFlowEnabled
+	}
+	desired := keycloak.Client{
+		ClientID:                  oidcClient.ClientID,
+		Enabled:                   true,
+		ClientAuthenticatorType:   "client-secret",
+		RedirectURIs:              append([]string{}, oidcClient.RedirectURIs...),
+		WebOrigins:                append([]string{}, oidcClient.WebOrigins...),
+		StandardFlowEnabled:       standardFlow,
+		DirectAccessGrantsEnabled: oidcClient.DirectAccessGrantsEnabled,
+		PublicClient:              oidcClient.PublicClient,
+		Protocol:                  "openid-connect",
+		FullScopeAllowed:          true,
+		NodeReRegistrationTimeout: -1,
+	}
+	if !oidcClient.PublicClient && len(oidcClient.SecretName) > 0 {
+		secret, err := getIdentitySecretValue(ctx, oidcClient.SecretName, clientSecretKey)
+		if err != nil {
+			return desired, err
+		}
+		desired.Secret = secret
+	}
+	return desired, nil
+}
+
+// getIdentitySecretValue returns the value of the key of a secret in the namespace of the Verrazzano resource
+func getIdentitySecretValue(ctx spi.ComponentContext, secretName string, key string) (string, error) {
+	namespace := ctx.EffectiveCR().Namespace
+	secret := &corev1.Secret{}
+	if err := ctx.Client().Get(context.TODO(), client.ObjectKey{Namespace: namespace, Name: secretName}, secret); err != nil {
+		ctx.Log().Errorf("Component Keycloak failed retrieving secret %s/%s: %v", namespace, secretName, err)
+		return "", err
+	}
+	value := string(secret.Data[key])
+	if value == "" {
+		err := fmt.Errorf("Component Keycloak failed, %s field empty in secret %s/%s", key, namespace, secretName)
+		ctx.Log().Error(err)
+		return "", err
+	}
+	return value, nil
+}
+
+// validateIdentity checks that the realms, groups and clients of the security identity section can be applied
+func validateIdentity(vz *vzapi.Verrazzano) error {
+	if vz == nil || vz.Spec.Security.Identity == nil {
+		return nil
+	}
+	realms := map[string]bool{}
+	for _, realm := range vz.Spec.Security.Identity.Realms {
+		if len(realm.Name) == 0 {
+			return fmt.Errorf("Identity realms must have a name")
+		}
+		if realms[realm.Name] {
+			return fmt.Errorf("Identity realm %s is declared more than once", realm.Name)
+		}
+		realms[realm.Name] = true
+		if realm.Name == masterRealm {
+			return fmt.Errorf("Identity realm %s is the Keycloak administration realm and cannot be declared", realm.Name)
+		}
+		if realm.Name == vzSysRealm && realm.Enabled != nil && !*realm.Enabled {
+			return fmt.Errorf("Identity realm %s is used to log into Verrazzano and cannot be disabled", realm.Name)
+		}
+
+		for _, group := range realm.Groups {
+			if !isValidGroupPath(group.Path) {
+				return fmt.Errorf("Identity group path %s in realm %s must start with / and have no empty segments", group.Path, realm.Name)
+			}
+		}
+		clients := map[string]bool{}
+		for _, oidcClient := range realm.Clients {
+			if len(oidcClient.ClientID) == 0 {
+				return fmt.Errorf("Identity clients in realm %s must have a client ID", realm.Name)
+			}
+			if clients[oidcClient.ClientID] {
+				return fmt.Errorf("Identity client %s is declared more than once in realm %s", oidcClient.ClientID, realm.Name)
+			}
+			clients[oidcClient.ClientID] = true
+			if realm.Name == vzSysRealm && isReservedClient(oidcClient.ClientID) {
+				return fmt.Errorf("Identity client %s in realm %s is managed by Verrazzano and cannot be declared", oidcClient.ClientID, realm.Name)
+			}
+		}
+	}
+	return nil
+}
+
+// isValidGroupPath returns true if the group path is like /parent/child
+func isValidGroupPath(groupPath string) bool {
+	if !strings.HasPrefix(groupPath, "/") {
+		return false
+	}
+	for _, name := range strings.Split(groupPath[1:], "/") {
+		if len(name) == 0 {
+			return false
+		}
+	}
+	return true
+}
+
+func isReservedClient(clientID string) bool {
+	for _, reserved := range reservedClients {
+		if clientID == reserved {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,225 @@
+// Copyright (c) 2022, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package keycloak
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/verrazzano/verrazzano/pkg/keycloak"
+	vzapi "github.com/verrazzano/verrazzano/platform-operator/apis/verrazzano/v1alpha1"
+	"github.com/verrazzano/verrazzano/platform-operator/controllers/verrazzano/component/spi"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8scheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+const testIdentityRealm = "apps"
+
+// newIdentityVZ returns a Verrazzano resource that declares an application realm and adds a group to the
+// Verrazzano system realm
+func newIdentityVZ() *vzapi.Verrazzano {
+	vz := testVZ.DeepCopy()
+	vz.Namespace = "default"
+	vz.Spec.Security.Identity = &vzapi.IdentitySpec{
+		Realms: []vzapi.IdentityRealm{
+			{
+				Name:           testIdentityRealm,
+				PasswordPolicy: "length(12)",
+				LoginTheme:     "custom",
+				Roles:          []vzapi.IdentityRole{{Name: "app_user", Description: "Application user"}},
+				Groups: []vzapi.IdentityGroup{
+					{Path: "/app-users/app-admins", Roles: []string{"app_user"}},
+				},
+				Users: []vzapi.IdentityUser{
+					{Username: "alice", Groups: []string{"/app-users/app-admins"}, PasswordSecret: "alice"},
+					{Username: "bob", Enabled: getBoolPtr(false)},
+				},
+				Clients: []vzapi.OIDCClient{
+					{ClientID: "app-ui", PublicClient: true, RedirectURIs: []string{"https://app.example.com/*"}},
+					{ClientID: "app-api", SecretName: "app-api", StandardFlowEnabled: getBoolPtr(false)},
+				},
+			},
+			{
+				Name:   vzSysRealm,
+				Groups: []vzapi.IdentityGroup{{Path: "/verrazzano-users/app-operators"}},
+			},
+		},
+	}
+	return vz
+}
+
+// createTestIdentitySecret returns a secret in the namespace of the Verrazzano resource
+func createTestIdentitySecret(name string, key string, value string) *v1.Secret {
+	return &v1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"},
+		Data:       map[string][]byte{key: []byte(value)},
+	}
+}
+
+// TestConfigureIdentity tests applying the identity configuration of the Verrazzano resource
+// GIVEN a Verrazzano resource that declares realms, roles, groups, users and clients
+// WHEN I call configureKeycloakRealms twice
+// THEN the declared content is created once and the realm settings are applied
+func TestConfigureIdentity(t *testing.T) {
+	asserts := assert.New(t)
+	server := newKeycloakServer()
+	defer server.Close()
+	defer httpClient.Reset()
+	c := fake.NewClientBuilder().WithScheme(k8scheme.Scheme).WithObjects(createTestLoginSecret(), createTestNginxService(), createTestKeycloakPod(),
+		createTestPasswordSecret("verrazzano", "vzpw"),
+		createTestPasswordSecret("verrazzano-prom-internal", "prompw"),
+		createTestPasswordSecret("verrazzano-es-internal", "espw"),
+		createTestIdentitySecret("alice", userPasswordKey, "alicepw"),
+		createTestIdentitySecret("app-api", clientSecretKey, "apisecret")).Build()
+
+	vz := newIdentityVZ()
+	asserts.NoError(configureKeycloakRealms(spi.NewFakeContext(c, vz, false)))
+	asserts.NoError(configureKeycloakRealms(spi.NewFakeContext(c, vz, false)))
+
+	realm := server.Realm(testIdentityRealm)
+	asserts.NotNil(realm)
+	asserts.True(*realm.Enabled)
+	asserts.Equal("length(12)", realm.PasswordPolicy)
+	asserts.Equal("custom", realm.LoginTheme)
+	asserts.Equal("alicepw", server.Password(testIdentityRealm, "alice"))
+
+	kc := keycloak.NewAdminClient(server.BaseURL(), server.Client())
+	asserts.NoError(kc.Login(keycloakAdminUser, "password"))
+	groups, err := kc.GetGroups(testIdentityRealm)
+	asserts.NoError(err)
+	asserts.Len(groups, 1)
+	admins := keycloak.FindGroupByPath(groups, "/app-users/app-admins")
+	asserts.NotNil(admins)
+	roles, err := kc.GetGroupRealmRoles(testIdentityRealm, admins.ID)
+	asserts.NoError(err)
+	asserts.Len(roles, 1)
+	asserts.Equal("app_user", roles[0].Name)
+
+	users, err := kc.GetUsers(testIdentityRealm, "bob")
+	asserts.NoError(err)
+	asserts.Len(users, 1)
+	asserts.False(users[0].Enabled)
+
+	clients, err := kc.GetClients(testIdentityRealm, "")
+	asserts.NoError(err)
+	asserts.Len(clients, 2)
+	api, err := kc.GetClients(testIdentityRealm, "app-api")
+	asserts.NoError(err)
+	asserts.Equal("apisecret", api[0].Secret)
+	asserts.False(api[0].StandardFlowEnabled)
+
+	// The declared group is added to the Verrazzano groups, and the Verrazzano realm settings are kept
+	groups, err = kc.GetGroups(vzSysRealm)
+	asserts.NoError(err)
+	asserts.NotNil(keycloak.FindGroupByPath(groups, "/verrazzano-users/app-operators"))
+	asserts.Len(groups[0].SubGroups, 4)
+	asserts.Equal(passwordPolicy, server.Realm(vzSysRealm).PasswordPolicy)
+}
+
+// TestConfigureIdentityMissingSecret tests applying the identity configuration without the secret of a user
+// GIVEN a Verrazzano resource that declares a user whose password secret does not exist
+// WHEN I call configureKeycloakRealms
+// THEN an error is returned and the user is not created
+func TestConfigureIdentityMissingSecret(t *testing.T) {
+	asserts := assert.New(t)
+	server := newKeycloakServer()
+	defer server.Close()
+	defer httpClient.Reset()
+	c := fake.NewClientBuilder().WithScheme(k8scheme.Scheme).WithObjects(createTestLoginSecret(), createTestNginxService(), createTestKeycloakPod(),
+		createTestPasswordSecret("verrazzano", "vzpw"),
+		createTestPasswordSecret("verrazzano-prom-internal", "prompw"),
+		createTestPasswordSecret("verrazzano-es-internal", "espw")).Build()
+
+	asserts.Error(configureKeycloakRealms(spi.NewFakeContext(c, newIdentityVZ(), false)))
+	asserts.Empty(server.Password(testIdentityRealm, "alice"))
+}
+
+// TestValidateIdentity tests the validation of the identity configuration
+// GIVEN Verrazzano resources with valid and invalid identity configurations
+// WHEN I call ValidateInstall and ValidateUpdate
+// THEN an error is returned for the invalid configurations
+func TestValidateIdentity(t *testing.T) {
+	tests := []struct {
+		name   string
+		modify func(identity *vzapi.IdentitySpec)
+		valid  bool
+	}{
+		{
+			name:   "valid",
+			modify: func(identity *vzapi.IdentitySpec) {},
+			valid:  true,
+		},
+		{
+			name: "duplicate realm",
+			modify: func(identity *vzapi.IdentitySpec) {
+				identity.Realms = append(identity.Realms, vzapi.IdentityRealm{Name: testIdentityRealm})
+			},
+		},
+		{
+			name: "realm without name",
+			modify: func(identity *vzapi.IdentitySpec) {
+				identity.Realms[0].Name = ""
+			},
+		},
+		{
+			name: "relative group path",
+			modify: func(identity *vzapi.IdentitySpec) {
+				identity.Realms[0].Groups[0].Path = "app-users"
+			},
+		},
+		{
+			name: "empty group path segment",
+			modify: func(identity *vzapi.IdentitySpec) {
+				identity.Realms[0].Groups[0].Path = "/app-users//app-admins"
+			},
+		},
+		{
+			name: "duplicate client",
+			modify: func(identity *vzapi.IdentitySpec) {
+				identity.Realms[0].Clients[1].ClientID = "app-ui"
+			},
+		},
+		{
+			name: "master realm",
+			modify: func(identity *vzapi.IdentitySpec) {
+				identity.Realms = append(identity.Realms, vzapi.IdentityRealm{Name: "master"})
+			},
+		},
+		{
+			name: "disabled system realm",
+			modify: func(identity *vzapi.IdentitySpec) {
+				identity.Realms[1].Enabled = getBoolPtr(false)
+			},
+		},
+		{
+			name: "enabled system realm",
+			modify: func(identity *vzapi.IdentitySpec) {
+				identity.Realms[1].Enabled = getBoolPtr(true)
+			},
+			valid: true,
+		},
+		{
+			name: "reserved client",
+			modify: func(identity *vzapi.IdentitySpec) {
+				identity.Realms[1].Clients = []vzapi.OIDCClient{{ClientID: "verrazzano-pkce"}}
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			vz := newIdentityVZ()
+			tt.modify(vz.Spec.Security.Identity)
+			comp := NewComponent()
+			if tt.valid {
+				assert.NoError(t, comp.ValidateInstall(vz))
+				assert.NoError(t, comp.ValidateUpdate(testVZ, vz))
+			} else {
+				assert.Error(t, comp.ValidateInstall(vz))
+				assert.Error(t, comp.ValidateUpdate(testVZ, vz))
+			}
+		})
+	}
+}
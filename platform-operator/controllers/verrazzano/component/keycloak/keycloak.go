@@ -9,19 +9,19 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
-	"k8s.io/apimachinery/pkg/labels"
-	"os/exec"
-	"path/filepath"
-	"strings"
+	"net/http"
 	"text/template"
+	"time"
 
 	promoperapi "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
 	"github.com/verrazzano/verrazzano/pkg/bom"
-	"github.com/verrazzano/verrazzano/pkg/k8sutil"
-	vzos "github.com/verrazzano/verrazzano/pkg/os"
+	"github.com/verrazzano/verrazzano/pkg/httputil"
+	"github.com/verrazzano/verrazzano/pkg/keycloak"
 	vzpassword "github.com/verrazzano/verrazzano/pkg/security/password"
 	vzapi "github.com/verrazzano/verrazzano/platform-operator/apis/verrazzano/v1alpha1"
 	"github.com/verrazzano/verrazzano/platform-operator/constants"
+	"github.com/verrazzano/verrazzano/platform-operator/controllers/verrazzano/component/common"
+	"github.com/verrazzano/verrazzano/platform-operator/controllers/verrazzano/component/mysql"
 	"github.com/verrazzano/verrazzano/platform-operator/controllers/verrazzano/component/prometheus/operator"
 	promoperator "github.com/verrazzano/verrazzano/platform-operator/controllers/verrazzano/component/prometheus/operator"
 	"github.com/verrazzano/verrazzano/platform-operator/controllers/verrazzano/component/spi"
@@ -32,9 +32,8 @@ import (
 	v1 "k8s.io/api/core/v1"
 	networkv1 "k8s.io/api/networking/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/types"
-	"k8s.io/client-go/kubernetes"
-	restclient "k8s.io/client-go/rest"
 	controllerruntime "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
@@ -56,6 +55,11 @@ const (
 	vzInternalPromUser      = "verrazzano-prom-internal"
 	vzInternalEsUser        = "verrazzano-es-internal"
 	keycloakPodName         = "keycloak-0"
+	keycloakAdminUser       = common.KeycloakAdminUser
+	keycloakAdminSecret     = common.KeycloakAdminSecret
+	passwordPolicy          = "length(8) and notUsername"
+	loginTheme              = "oracle"
+	keycloakRequestTimeout  = 30 * time.Second
 )
 
 // Define the keycloak Key:Value pair for init container.
@@ -189,7 +193,6 @@ const pgClient = `
       "rootUrl" : "",
       "adminUrl" : "",
       "surrogateAuthRequired" : false,
-      "directAccessGrantsEnabled" : "true",
       "clientAuthenticatorType" : "client-secret",
       "secret" : "de05ccdc-67df-47f3-81f6-37e61d195aba",
       "redirectUris" : [ ],
@@ -279,81 +282,18 @@ const pgClient = `
 }
 `
 
-// KeycloakClients represents an array of clients currently configured in Keycloak
-type KeycloakClients []struct {
-	ID       string `json:"id"`
-	ClientID string `json:"clientId"`
-}
-
-// SubGroup represents the subgroups that Keycloak groups may contain
-type SubGroup struct {
-	ID        string        `json:"id"`
-	Name      string        `json:"name"`
-	Path      string        `json:"path"`
-	SubGroups []interface{} `json:"subGroups"`
-}
-
-// KeycloakGroups is an array of groups configured in Keycloak
-type KeycloakGroups []struct {
-	ID        string `json:"id"`
-	Name      string `json:"name"`
-	Path      string `json:"path"`
-	SubGroups []SubGroup
-}
-
-// KeycloakRoles is an array of roles configured in Keycloak
-type KeycloakRoles []struct {
-	ID          string `json:"id"`
-	Name        string `json:"name"`
-	Description string `json:"description,omitempty"`
-	Composite   bool   `json:"composite"`
-	ClientRole  bool   `json:"clientRole"`
-	ContainerID string `json:"containerId"`
-}
-
-// KeycloakUsers is an array of users configured in Keycloak
-type KeycloakUsers []struct {
-	ID                         string        `json:"id"`
-	CreatedTimestamp           int64         `json:"createdTimestamp"`
-	Username                   string        `json:"username"`
-	Enabled                    bool          `json:"enabled"`
-	Totp                       bool          `json:"totp"`
-	EmailVerified              bool          `json:"emailVerified"`
-	DisableableCredentialTypes []interface{} `json:"disableableCredentialTypes"`
-	RequiredActions            []interface{} `json:"requiredActions"`
-	NotBefore                  int           `json:"notBefore"`
-	Access                     struct {
-		ManageGroupMembership bool `json:"manageGroupMembership"`
-		View                  bool `json:"view"`
-		MapRoles              bool `json:"mapRoles"`
-		Impersonate           bool `json:"impersonate"`
-		Manage                bool `json:"manage"`
-	} `json:"access"`
-}
-
 type templateData struct {
 	DNSSubDomain string
 }
 
-// Unit testing support
-type bashFuncSig func(inArgs ...string) (string, string, error)
-
-var bashFunc bashFuncSig = vzos.RunBash
-
-func setBashFunc(f bashFuncSig) {
-	bashFunc = f
-}
-
-var execCommand = exec.Command
+// httpClient returns the URL and HTTP client of the REST API, unit tests set it to call a fake server
+var httpClient = common.NewHTTPClientHook(getKeycloakHTTPClient)
 
 // imageData needed for template rendering
 type imageData struct {
 	Image string
 }
 
-// maskPw will mask passwords in strings with '******'
-var maskPw = vzpassword.MaskFunction("password ")
-
 // AppendKeycloakOverrides appends the Keycloak theme for the Key keycloak.extraInitContainers.
 // A go template is used to replace the image in the init container spec.
 func AppendKeycloakOverrides(compContext spi.ComponentContext, _ string, _ string, _ string, kvs []bom.KeyValue) ([]bom.KeyValue, error) {
@@ -496,52 +436,6 @@ func updatePrometheusAnnotations(ctx spi.ComponentContext) error {
 	return nil
 }
 
-// updateKeycloakUris calls a bash script to update the Keycloak rewrite and weborigin uris
-func updateKeycloakUris(ctx spi.ComponentContext) error {
-
-	cfg, cli, err := k8sutil.ClientConfig()
-	if err != nil {
-		return err
-	}
-
-	err = loginKeycloak(ctx, cfg, cli)
-	if err != nil {
-		return err
-	}
-
-	// Get the Client ID JSON array
-	keycloakClients, err := getKeycloakClients(ctx)
-	if err != nil {
-		return err
-	}
-
-	// Get the client ID for verrazzano-pkce
-	id := getClientID(keycloakClients, "verrazzano-pkce")
-	if id == "" {
-		err := errors.New("Component Keycloak failed retrieving ID for Keycloak user, zero length")
-		ctx.Log().Error(err)
-		return err
-	}
-	ctx.Log().Debug("Keycloak Post Upgrade: Successfully retrieved clientID")
-
-	// Get DNS Domain Configuration
-	dnsSubDomain, err := vzconfig.BuildDNSDomain(ctx.Client(), ctx.EffectiveCR())
-	if err != nil {
-		ctx.Log().Errorf("Component Keycloak failed retrieving DNS sub domain: %v", err)
-		return err
-	}
-	ctx.Log().Debugf("Keycloak Post Upgrade: DNSDomain returned %s", dnsSubDomain)
-
-	// Call the Script and Update the URIs
-	scriptName := filepath.Join(config.GetInstallDir(), "update-kiali-redirect-uris.sh")
-	if _, stderr, err := bashFunc(scriptName, id, dnsSubDomain); err != nil {
-		ctx.Log().Errorf("Component Keycloak failed updating KeyCloak URIs %v: %s", err, stderr)
-		return err
-	}
-	ctx.Log().Debug("Component Keycloak successfully updated Keycloak URIs")
-	return nil
-}
-
 // configureKeycloakRealms configures the Verrazzano system realm
 func configureKeycloakRealms(ctx spi.ComponentContext) error {
 	// Make sure the Keycloak pod is ready
@@ -556,201 +450,158 @@ func configureKeycloakRealms(ctx spi.ComponentContext) error {
 		return fmt.Errorf("Waiting for pod %s to be ready", pod.Name)
 	}
 
-	cfg, cli, err := k8sutil.ClientConfig()
+	// Login to Keycloak
+	kc, err := loginKeycloak(ctx)
 	if err != nil {
-		return err
-	}
-
-	// If ephemeral storage is configured, additional steps may be required to
-	// rebuild the configuration lost due to MySQL pod getting restarted.
-	if (ctx.EffectiveCR().Spec.Components.Keycloak != nil) && (ctx.EffectiveCR().Spec.Components.Keycloak.MySQL.VolumeSource == nil) {
-		// When the MySQL pod restarts and using ephemeral storage, the
-		// login to Keycloak will fail.  Need to recycle the Keycloak pod
+		// If ephemeral storage is configured, additional steps may be required to
+		// rebuild the configuration lost due to MySQL pod getting restarted.
+		// When the MySQL pod restarts and using ephemeral storage, the admin user
+		// is lost and the login to Keycloak will fail.  Need to recycle the Keycloak pod
 		// to resolve the condition.
-		err = loginKeycloak(ctx, cfg, cli)
-		if err != nil {
+		if keycloak.IsUnauthorized(err) && isEphemeralStorage(ctx) {
 			err2 := ctx.Client().Delete(context.TODO(), pod)
 			if err2 != nil {
 				ctx.Log().Errorf("Component Keycloak failed to recycle pod %s: %v", pod.Name, err2)
 			}
-			return err
 		}
-	}
-
-	// Login to Keycloak
-	err = loginKeycloak(ctx, cfg, cli)
-	if err != nil {
 		return err
 	}
 
 	// Create VerrazzanoSystem Realm
-	err = createVerrazzanoSystemRealm(ctx, cfg, cli)
+	err = createRealm(ctx, kc, vzSysRealm)
 	if err != nil {
 		return err
 	}
 
 	// Create Verrazzano Users Group
-	userGroupID, err := createVerrazzanoUsersGroup(ctx)
+	userGroupID, err := createGroup(ctx, kc, vzSysRealm, "", vzUsersGroup)
 	if err != nil {
 		return err
 	}
-	if userGroupID == "" {
-		err := errors.New("Component Keycloak failed; user Group ID from Keycloak is zero length")
-		ctx.Log().Error(err)
-		return err
-	}
-
-	// Create Verrazzano Admin Group
-	adminGroupID, err := createVerrazzanoAdminGroup(ctx, userGroupID)
-	if err != nil {
-		return err
-	}
-	if adminGroupID == "" {
-		err := errors.New("Component Keycloak failed; admin group ID from Keycloak is zero length")
-		ctx.Log().Error(err)
-		return err
-	}
 
-	// Create Verrazzano Project Monitors Group
-	monitorGroupID, err := createVerrazzanoMonitorsGroup(ctx, userGroupID)
-	if err != nil {
-		return err
-	}
-	if monitorGroupID == "" {
-		err = errors.New("Component Keycloak failed; monitor group ID from Keycloak is zero length")
-		ctx.Log().Error(err)
-		return err
-	}
-
-	// Create Verrazzano System Group
-	err = createVerrazzanoSystemGroup(ctx, cfg, cli, userGroupID)
-	if err != nil {
-		return err
+	// Create Verrazzano Admin, Project Monitors and System Groups
+	for _, group := range []string{vzAdminGroup, vzMonitorGroup, vzSystemGroup} {
+		if _, err = createGroup(ctx, kc, vzSysRealm, userGroupID, group); err != nil {
+			return err
+		}
 	}
 
 	// Create Verrazzano API Access Role
-	err = createVerrazzanoRole(ctx, cfg, cli, vzAPIAccessRole)
+	err = createRealmRole(ctx, kc, vzSysRealm, keycloak.Role{Name: vzAPIAccessRole})
 	if err != nil {
 		return err
 	}
 
 	// Granting Roles to Groups
-	err = grantRolesToGroups(ctx, cfg, cli, userGroupID, adminGroupID, monitorGroupID)
+	err = grantRoleToGroup(ctx, kc, vzSysRealm, vzAPIAccessRole, userGroupID)
 	if err != nil {
 		return err
 	}
 
 	// Creating Verrazzano User
-	err = createUser(ctx, cfg, cli, vzUserName, "verrazzano", vzAdminGroup)
+	err = createOrUpdateUser(ctx, kc, vzUserName, "verrazzano", vzAdminGroup)
 	if err != nil {
 		return err
 	}
 
 	// Creating Verrazzano Internal Prometheus User
-	err = createUser(ctx, cfg, cli, vzInternalPromUser, "verrazzano-prom-internal", vzSystemGroup)
+	err = createOrUpdateUser(ctx, kc, vzInternalPromUser, "verrazzano-prom-internal", vzSystemGroup)
 	if err != nil {
 		return err
 	}
 
 	// Creating Verrazzano Internal ES User
-	err = createUser(ctx, cfg, cli, vzInternalEsUser, "verrazzano-es-internal", vzSystemGroup)
-	if err != nil {
-		return err
-	}
-
-	// Create verrazzano-pkce client
-	err = createOrUpdateVerrazzanoPkceClient(ctx, cfg, cli)
-	if err != nil {
-		return err
-	}
-
-	// Creating verrazzano-pg client
-	err = createVerrazzanoPgClient(ctx, cfg, cli)
+	err = createOrUpdateUser(ctx, kc, vzInternalEsUser, "verrazzano-es-internal", vzSystemGroup)
 	if err != nil {
 		return err
 	}
 
-	// Setting password policy for master
-	err = setPasswordPolicyForRealm(ctx, cfg, cli, "master", "passwordPolicy=length(8) and notUsername")
+	// Create or update the verrazzano-pkce client
+	err = createOrUpdateVerrazzanoPkceClient(ctx, kc)
 	if err != nil {
 		return err
 	}
 
-	// Setting password policy for Verrazzano realm
-	err = setPasswordPolicyForRealm(ctx, cfg, cli, "verrazzano-system", "passwordPolicy=length(8) and notUsername")
+	// Create or update the verrazzano-pg client
+	err = createOrUpdateClient(ctx, kc, vzSysRealm, pgClient)
 	if err != nil {
 		return err
 	}
 
-	// Configuring login theme for master
-	err = configureLoginThemeForRealm(ctx, cfg, cli, "master", "oracle")
+	// Setting password policy and login theme for master
+	err = updateRealm(ctx, kc, keycloak.Realm{Realm: keycloak.MasterRealm, PasswordPolicy: passwordPolicy, LoginTheme: loginTheme})
 	if err != nil {
 		return err
 	}
 
-	// Configuring login theme for verrazzano-system
-	err = configureLoginThemeForRealm(ctx, cfg, cli, "verrazzano-system", "oracle")
-	if err != nil {
-		return err
-	}
-
-	// Enabling vzSysRealm realm
-	err = enableVerrazzanoSystemRealm(ctx, cfg, cli)
-	if err != nil {
-		return err
-	}
-
-	// Removing login config file
-	err = removeLoginConfigFile(ctx, cfg, cli)
+	// Setting password policy and login theme for the Verrazzano realm, then enabling it
+	enabled := true
+	err = updateRealm(ctx, kc, keycloak.Realm{Realm: vzSysRealm, PasswordPolicy: passwordPolicy, LoginTheme: loginTheme, Enabled: &enabled})
 	if err != nil {
 		return err
 	}
 
 	ctx.Log().Oncef("Component Keycloak successfully configured realm %s", vzSysRealm)
-	return nil
+
+	// Apply the identity configuration of the Verrazzano resource last, so that it can override the realm
+	// settings of Verrazzano
+	return configureIdentity(ctx, kc)
 }
 
-// loginKeycloak logs into Keycloak so kcadm API calls can be made
-func loginKeycloak(ctx spi.ComponentContext, cfg *restclient.Config, cli kubernetes.Interface) error {
+// loginKeycloak returns a Keycloak admin REST client that is logged in as the Keycloak admin user
+func loginKeycloak(ctx spi.ComponentContext) (*keycloak.AdminClient, error) {
 	// Get the Keycloak admin password
 	secret := &corev1.Secret{}
 	err := ctx.Client().Get(context.TODO(), client.ObjectKey{
-		Namespace: "keycloak",
-		Name:      "keycloak-http",
+		Namespace: ComponentNamespace,
+		Name:      keycloakAdminSecret,
 	}, secret)
 	if err != nil {
 		ctx.Log().Errorf("Component Keycloak failed retrieving Keycloak password: %s", err)
-		return err
+		return nil, err
 	}
-	pw := secret.Data["password"]
-	keycloakpw := string(pw)
+	keycloakpw := string(secret.Data["password"])
 	if keycloakpw == "" {
 		err = errors.New("Component Keycloak failed; Keycloak password is an empty string")
 		ctx.Log().Error(err)
-		return err
+		return nil, err
 	}
 	ctx.Log().Debug("loginKeycloak: Successfully retrieved Keycloak password")
 
-	// Login to Keycloak
-	kcPod := keycloakPod()
-	loginCmd := "/opt/jboss/keycloak/bin/kcadm.sh config credentials --server http://localhost:8080/auth --realm master --user keycloakadmin --password " + keycloakpw
-	ctx.Log().Debugf("loginKeycloak: Login Cmd = %s", maskPw(loginCmd))
-	stdOut, stdErr, err := k8sutil.ExecPod(cli, cfg, kcPod, ComponentName, bashCMD(loginCmd))
+	baseURL, hc, err := httpClient.Get(ctx)
 	if err != nil {
-		ctx.Log().Errorf("Component Keycloak failed logging into Keycloak: stdout = %s: stderr = %s", stdOut, stdErr)
-		return fmt.Errorf("error: %s", maskPw(err.Error()))
+		ctx.Log().Errorf("Component Keycloak failed creating the Keycloak HTTP client: %v", err)
+		return nil, err
 	}
-	ctx.Log().Once("Component Keycloak successfully logged into Keycloak")
 
-	return nil
+	// Login to Keycloak
+	kc := keycloak.NewAdminClient(baseURL, hc)
+	if err := kc.Login(keycloakAdminUser, keycloakpw); err != nil {
+		ctx.Log().Errorf("Component Keycloak failed logging into Keycloak at %s: %v", baseURL, err)
+		return nil, err
+	}
+	ctx.Log().Once("Component Keycloak successfully logged into Keycloak")
+	return kc, nil
 }
 
-func bashCMD(command string) []string {
-	return []string{
-		"bash",
-		"-c",
-		command,
+// getKeycloakHTTPClient returns the URL of the Keycloak ingress and an HTTP client that trusts the CA of the
+// Keycloak certificate.  The ingress is used since the network policies and the strict mTLS of the mesh only let the
+// operator reach Keycloak through ingress-nginx.
+func getKeycloakHTTPClient(ctx spi.ComponentContext) (string, *http.Client, error) {
+	dnsSubDomain, err := getDNSDomain(ctx.Client(), ctx.EffectiveCR())
+	if err != nil {
+		return "", nil, err
+	}
+	hc, err := httputil.NewCertSecretClient(ctx.Client(), types.NamespacedName{Namespace: ComponentNamespace, Name: keycloakCertificateName}, keycloakRequestTimeout)
+	if err != nil {
+		return "", nil, err
 	}
+	return fmt.Sprintf("https://keycloak.%s/auth", dnsSubDomain), hc, nil
+}
+
+// isEphemeralStorage returns true if the MySQL database of Keycloak does not use a persistent volume
+func isEphemeralStorage(ctx spi.ComponentContext) bool {
+	return ctx.EffectiveCR().Spec.Components.Keycloak != nil && ctx.EffectiveCR().Spec.Components.Keycloak.MySQL.VolumeSource == nil
 }
 
 func keycloakPod() *v1.Pod {
@@ -827,221 +678,133 @@ func getDNSDomain(c client.Client, vz *vzapi.Verrazzano) (string, error) {
 	return dnsDomain, nil
 }
 
-func createVerrazzanoSystemRealm(ctx spi.ComponentContext, cfg *restclient.Config, cli kubernetes.Interface) error {
-	kcPod := keycloakPod()
-	realm := "realm=" + vzSysRealm
-	checkRealmExistsCmd := "/opt/jboss/keycloak/bin/kcadm.sh get realms/" + vzSysRealm
-	ctx.Log().Debugf("createVerrazzanoSystemRealm: Check Verrazzano System Realm Exists Cmd = %s", checkRealmExistsCmd)
-	_, _, err := k8sutil.ExecPod(cli, cfg, kcPod, ComponentName, bashCMD(checkRealmExistsCmd))
-	if err != nil {
-		ctx.Log().Debug("createVerrazzanoSystemRealm: Verrazzano System Realm doesn't exist: Creating it")
-		createRealmCmd := "/opt/jboss/keycloak/bin/kcadm.sh create realms -s " + realm + " -s enabled=false"
-		ctx.Log().Debugf("createVerrazzanoSystemRealm: Create Verrazzano System Realm Cmd = %s", createRealmCmd)
-		stdout, stderr, err := k8sutil.ExecPod(cli, cfg, kcPod, ComponentName, bashCMD(createRealmCmd))
-		if err != nil {
-			ctx.Log().Errorf("Component Keycloak failed creating Verrazzano System Realm: stdout = %s, stderr = %s", stdout, stderr)
-			return err
-		}
-		ctx.Log().Once("Component Keycloak successfully created the Verrazzano system realm")
-	}
-	return nil
-}
-
-func createVerrazzanoUsersGroup(ctx spi.ComponentContext) (string, error) {
-	keycloakGroups, err := getKeycloakGroups(ctx)
-	if err == nil && groupExists(keycloakGroups, vzUsersGroup) {
-		// Group already exists
-		return getGroupID(keycloakGroups, vzUsersGroup), nil
-	}
-
-	userGroup := "name=" + vzUsersGroup
-	cmd := execCommand("kubectl", "exec", keycloakPodName, "-n", ComponentNamespace, "-c", "keycloak", "--", "/opt/jboss/keycloak/bin/kcadm.sh", "create", "groups", "-r", vzSysRealm, "-s", userGroup)
-	ctx.Log().Debugf("createVerrazzanoUsersGroup: Create Verrazzano Users Group Cmd = %s", cmd.String())
-	out, err := cmd.CombinedOutput()
-	if err != nil {
-		ctx.Log().Errorf("Component Keycloak failed creating Verrazzano Users Group: command output = %s", out)
-		return "", err
+// createRealm creates the realm disabled if it does not exist, the realm is enabled once it is fully configured
+func createRealm(ctx spi.ComponentContext, kc *keycloak.AdminClient, realm string) error {
+	_, err := kc.GetRealm(realm)
+	if err == nil {
+		return nil
 	}
-	ctx.Log().Debugf("createVerrazzanoUsersGroup: Create Verrazzano Users Group Output = %s", out)
-	if len(string(out)) == 0 {
-		err = errors.New("Component Keycloak failed; user group ID from Keycloak is zero length")
-		ctx.Log().Error(err)
-		return "", err
+	if !keycloak.IsNotFound(err) {
+		ctx.Log().Errorf("Component Keycloak failed getting realm %s: %v", realm, err)
+		return err
 	}
-	arr := strings.Split(string(out), "'")
-	if len(arr) != 3 {
-		return "", fmt.Errorf("Component Keycloak failed parsing output returned from Users Group create stdout returned = %s", out)
+	ctx.Log().Debugf("createRealm: Realm %s doesn't exist: Creating it", realm)
+	enabled := false
+	if err := kc.CreateRealm(keycloak.Realm{Realm: realm, Enabled: &enabled}); err != nil && !keycloak.IsConflict(err) {
+		ctx.Log().Errorf("Component Keycloak failed creating realm %s: %v", realm, err)
+		return err
 	}
-	ctx.Log().Debugf("createVerrazzanoUsersGroup: User Group ID = %s", arr[1])
-	ctx.Log().Once("Component Keycloak successfully created the Verrazzano user group")
-	return arr[1], nil
+	ctx.Log().Oncef("Component Keycloak successfully created realm %s", realm)
+	return nil
 }
 
-func createVerrazzanoAdminGroup(ctx spi.ComponentContext, userGroupID string) (string, error) {
-	keycloakGroups, err := getKeycloakGroups(ctx)
-	if err == nil && groupExists(keycloakGroups, vzAdminGroup) {
-		// Group already exists
-		return getGroupID(keycloakGroups, vzAdminGroup), nil
-	}
-	adminGroup := "groups/" + userGroupID + "/children"
-	adminGroupName := "name=" + vzAdminGroup
-	cmd := execCommand("kubectl", "exec", keycloakPodName, "-n", ComponentNamespace, "-c", "keycloak", "--", "/opt/jboss/keycloak/bin/kcadm.sh", "create", adminGroup, "-r", vzSysRealm, "-s", adminGroupName)
-	ctx.Log().Debugf("createVerrazzanoAdminGroup: Create Verrazzano Admin Group Cmd = %s", cmd.String())
-	out, err := cmd.CombinedOutput()
+// createGroup creates the group if it does not exist and returns its ID, the group is a top-level group
+// if the parent ID is empty
+func createGroup(ctx spi.ComponentContext, kc *keycloak.AdminClient, realm string, parentID string, groupName string) (string, error) {
+	groups, err := kc.GetGroups(realm)
 	if err != nil {
-		ctx.Log().Errorf("Component Keycloak failed creating Verrazzano Admin Group: command output = %s", out)
+		ctx.Log().Errorf("Component Keycloak failed retrieving the groups of realm %s: %v", realm, err)
 		return "", err
 	}
-	ctx.Log().Debugf("createVerrazzanoAdminGroup: Create Verrazzano Admin Group Output = %s", out)
-	if len(string(out)) == 0 {
-		err = errors.New("Component Keycloak failed; admin group ID from Keycloak is zero length")
-		ctx.Log().Error(err)
-		return "", err
+	if group := keycloak.FindGroup(groups, groupName); group != nil {
+		return group.ID, nil
 	}
-	arr := strings.Split(string(out), "'")
-	if len(arr) != 3 {
-		return "", fmt.Errorf("Component Keycloak failed parsing output returned from Admin Group create stdout returned = %s", out)
-	}
-	ctx.Log().Debugf("createVerrazzanoAdminGroup: Admin Group ID = %s", arr[1])
-	ctx.Log().Once("Component Keycloak successfully created the Verrazzano admin group")
-	return arr[1], nil
-}
 
-func createVerrazzanoMonitorsGroup(ctx spi.ComponentContext, userGroupID string) (string, error) {
-	keycloakGroups, err := getKeycloakGroups(ctx)
-	if err == nil && groupExists(keycloakGroups, vzMonitorGroup) {
-		// Group already exists
-		return getGroupID(keycloakGroups, vzMonitorGroup), nil
-	}
-	monitorGroup := "groups/" + userGroupID + "/children"
-	monitorGroupName := "name=" + vzMonitorGroup
-	cmd := execCommand("kubectl", "exec", keycloakPodName, "-n", ComponentNamespace, "-c", "keycloak", "--", "/opt/jboss/keycloak/bin/kcadm.sh", "create", monitorGroup, "-r", vzSysRealm, "-s", monitorGroupName)
-	ctx.Log().Debugf("createVerrazzanoProjectMonitorsGroup: Create Verrazzano Monitors Group Cmd = %s", cmd.String())
-	out, err := cmd.CombinedOutput()
+	var id string
+	if parentID == "" {
+		id, err = kc.CreateGroup(realm, keycloak.Group{Name: groupName})
+	} else {
+		id, err = kc.CreateChildGroup(realm, parentID, keycloak.Group{Name: groupName})
+	}
 	if err != nil {
-		ctx.Log().Errorf("Component Keycloak failed creating Verrazzano Monitor Group: command output = %s", out)
+		ctx.Log().Errorf("Component Keycloak failed creating group %s in realm %s: %v", groupName, realm, err)
 		return "", err
 	}
-	ctx.Log().Debugf("createVerrazzanoProjectMonitorsGroup: Create Verrazzano Project Monitors Group Output = %s", out)
-	if len(string(out)) == 0 {
-		err = errors.New("Component Keycloak failed; monitor group ID from Keycloak is zero length")
+	if id == "" {
+		err = fmt.Errorf("Component Keycloak failed; group ID of %s from Keycloak is zero length", groupName)
 		ctx.Log().Error(err)
 		return "", err
 	}
-	arr := strings.Split(string(out), "'")
-	if len(arr) != 3 {
-		return "", fmt.Errorf("Component Keycloak failed parsing output returned from Monitor Group create stdout returned = %s", out)
-	}
-	ctx.Log().Debugf("createVerrazzanoProjectMonitorsGroup: Monitor Group ID = %s", arr[1])
-	ctx.Log().Once("Component Keycloak successfully created the Verrazzano monitors group")
-
-	return arr[1], nil
+	ctx.Log().Oncef("Component Keycloak successfully created group %s in realm %s", groupName, realm)
+	return id, nil
 }
 
-func createVerrazzanoSystemGroup(ctx spi.ComponentContext, cfg *restclient.Config, cli kubernetes.Interface, userGroupID string) error {
-
-	keycloakGroups, err := getKeycloakGroups(ctx)
-	if err == nil && groupExists(keycloakGroups, vzSystemGroup) {
+// createRealmRole creates the realm role if it does not exist
+func createRealmRole(ctx spi.ComponentContext, kc *keycloak.AdminClient, realm string, role keycloak.Role) error {
+	roleName := role.Name
+	_, err := kc.GetRealmRole(realm, roleName)
+	if err == nil {
 		return nil
 	}
-
-	kcPod := keycloakPod()
-	systemGroup := "groups/" + userGroupID + "/children"
-	systemGroupName := "name=" + vzSystemGroup
-	createVzSystemGroupCmd := "/opt/jboss/keycloak/bin/kcadm.sh create " + systemGroup + " -r " + vzSysRealm + " -s " + systemGroupName
-	ctx.Log().Debugf("createVerrazzanoSystemGroup: Create Verrazzano System Group Cmd = %s", createVzSystemGroupCmd)
-	stdout, stderr, err := k8sutil.ExecPod(cli, cfg, kcPod, ComponentName, bashCMD(createVzSystemGroupCmd))
-	if err != nil {
-		ctx.Log().Errorf("Component Keycloak failed creating Verrazzano System Group: stdout = %s, stderr = %s", stdout, stderr)
+	if !keycloak.IsNotFound(err) {
+		ctx.Log().Errorf("Component Keycloak failed getting role %s in realm %s: %v", roleName, realm, err)
 		return err
 	}
-	ctx.Log().Once("Component Keycloak successfully created the Verrazzano system group")
-	return nil
-}
-
-func createVerrazzanoRole(ctx spi.ComponentContext, cfg *restclient.Config, cli kubernetes.Interface, roleName string) error {
-	keycloakRoles, err := getKeycloakRoles(ctx)
-	if err == nil && roleExists(keycloakRoles, roleName) {
-		return nil
-	}
-	kcPod := keycloakPod()
-	role := "name=" + roleName
-	createRoleCmd := "/opt/jboss/keycloak/bin/kcadm.sh create roles -r " + vzSysRealm + " -s " + role
-	ctx.Log().Debugf("createVerrazzanoRole: Create Verrazzano API Access Role Cmd = %s", createRoleCmd)
-	stdout, stderr, err := k8sutil.ExecPod(cli, cfg, kcPod, ComponentName, bashCMD(createRoleCmd))
-	if err != nil {
-		ctx.Log().Errorf("Component Keycloak failed creating Verrazzano API Access Role: stdout = %s, stderr = %s", stdout, stderr)
+	if err := kc.CreateRealmRole(realm, role); err != nil && !keycloak.IsConflict(err) {
+		ctx.Log().Errorf("Component Keycloak failed creating role %s in realm %s: %v", roleName, realm, err)
 		return err
 	}
-	ctx.Log().Once("Component Keycloak successfully created the Verrazzano API access role")
+	ctx.Log().Oncef("Component Keycloak successfully created role %s in realm %s", roleName, realm)
 	return nil
 }
 
-func grantRolesToGroups(ctx spi.ComponentContext, cfg *restclient.Config, cli kubernetes.Interface, userGroupID string, adminGroupID string, monitorGroupID string) error {
-	// Keycloak API does not fail if Role already exists as of 15.0.3
-	kcPod := keycloakPod()
-	// Granting vz_api_access role to verrazzano users group
-	grantAPIAccessToVzUserGroupCmd := "/opt/jboss/keycloak/bin/kcadm.sh add-roles -r " + vzSysRealm + " --gid " + userGroupID + " --rolename " + vzAPIAccessRole
-	ctx.Log().Debugf("grantRolesToGroups: Grant API Access to VZ Users Cmd = %s", grantAPIAccessToVzUserGroupCmd)
-	stdout, stderr, err := k8sutil.ExecPod(cli, cfg, kcPod, ComponentName, bashCMD(grantAPIAccessToVzUserGroupCmd))
+// grantRoleToGroup maps the realm role to the group, Keycloak does not fail if the role is already mapped
+func grantRoleToGroup(ctx spi.ComponentContext, kc *keycloak.AdminClient, realm string, roleName string, groupID string) error {
+	role, err := kc.GetRealmRole(realm, roleName)
 	if err != nil {
-		ctx.Log().Errorf("Component Keycloak failed granting api access role to Verrazzano users group: stdout = %s, stderr = %s", stdout, stderr)
+		ctx.Log().Errorf("Component Keycloak failed getting role %s in realm %s: %v", roleName, realm, err)
 		return err
 	}
-	ctx.Log().Once("Component Keycloak successfully granted the access role to the Verrazzano user group")
-
+	if err := kc.AddGroupRealmRoles(realm, groupID, []keycloak.Role{*role}); err != nil {
+		ctx.Log().Errorf("Component Keycloak failed granting role %s to group %s: %v", roleName, groupID, err)
+		return err
+	}
+	ctx.Log().Oncef("Component Keycloak successfully granted role %s to group %s", roleName, groupID)
 	return nil
 }
 
-func createUser(ctx spi.ComponentContext, cfg *restclient.Config, cli kubernetes.Interface, userName string, secretName string, groupName string) error {
-	keycloakUsers, err := getKeycloakUsers(ctx)
-	if err == nil && userExists(keycloakUsers, userName) {
-		return nil
-	}
-	kcPod := keycloakPod()
-	vzUser := "username=" + userName
-	vzUserGroup := "groups[0]=/" + vzUsersGroup + "/" + groupName
-	createVzUserCmd := "/opt/jboss/keycloak/bin/kcadm.sh create users -r " + vzSysRealm + " -s " + vzUser + " -s " + vzUserGroup + " -s enabled=true"
-	ctx.Log().Debugf("createUser: Create Verrazzano User Cmd = %s", createVzUserCmd)
-	stdout, stderr, err := k8sutil.ExecPod(cli, cfg, kcPod, ComponentName, bashCMD(createVzUserCmd))
+// createOrUpdateUser creates the user in the Verrazzano users subgroup if it does not exist, and sets the password of
+// the user from the secret.  The password of an existing user is reset too, so that it matches the secret again after
+// the Keycloak database was restored from the backup of another cluster.
+func createOrUpdateUser(ctx spi.ComponentContext, kc *keycloak.AdminClient, userName string, secretName string, groupName string) error {
+	users, err := kc.GetUsers(vzSysRealm, userName)
 	if err != nil {
-		ctx.Log().Errorf("Component Keycloak failed creating Verrazzano user: stdout = %s, stderr = %s", stdout, stderr)
+		ctx.Log().Errorf("Component Keycloak failed retrieving user %s: %v", userName, err)
 		return err
 	}
-	ctx.Log().Debugf("createUser: Successfully Created VZ User %s", userName)
 
-	vzpw, err := getSecretPassword(ctx, "verrazzano-system", secretName)
+	vzpw, err := getSecretPassword(ctx, constants.VerrazzanoSystemNamespace, secretName)
 	if err != nil {
 		return err
 	}
-	setVZUserPwCmd := "/opt/jboss/keycloak/bin/kcadm.sh set-password -r " + vzSysRealm + " --username " + userName + " --new-password " + vzpw
-	ctx.Log().Debugf("createUser: Set Verrazzano User PW Cmd = %s", maskPw(setVZUserPwCmd))
-	stdout, stderr, err = k8sutil.ExecPod(cli, cfg, kcPod, ComponentName, bashCMD(setVZUserPwCmd))
-	if err != nil {
-		ctx.Log().Errorf("Component Keycloak failed setting Verrazzano user password: stdout = %s, stderr = %s", stdout, stderr)
-		return fmt.Errorf("error: %s", maskPw(err.Error()))
+	var id string
+	if len(users) > 0 {
+		id = users[0].ID
+	} else {
+		user := keycloak.User{
+			Username: userName,
+			Enabled:  true,
+			Groups:   []string{"/" + vzUsersGroup + "/" + groupName},
+		}
+		id, err = kc.CreateUser(vzSysRealm, user)
+		if err != nil {
+			ctx.Log().Errorf("Component Keycloak failed creating Verrazzano user %s: %v", userName, err)
+			return err
+		}
+		ctx.Log().Debugf("createOrUpdateUser: Successfully Created VZ User %s", userName)
 	}
-	ctx.Log().Debugf("createUser: Created VZ User %s PW", userName)
-	ctx.Log().Oncef("Component Keycloak successfully created user %s", userName)
 
-	return nil
-}
-
-func createOrUpdateVerrazzanoPkceClient(ctx spi.ComponentContext, cfg *restclient.Config, cli kubernetes.Interface) error {
-	data := templateData{}
-
-	keycloakClients, err := getKeycloakClients(ctx)
-	if err != nil {
+	if err := kc.ResetPassword(vzSysRealm, id, vzpw); err != nil {
+		ctx.Log().Errorf("Component Keycloak failed setting Verrazzano user %s password: %v", userName, err)
 		return err
 	}
-	if clientExists(keycloakClients, "verrazzano-pkce") {
-		if err := updateKeycloakUris(ctx); err != nil {
-			return err
-		}
-		return nil
-	}
+	ctx.Log().Oncef("Component Keycloak successfully created or updated user %s", userName)
+	return nil
+}
 
-	kcPod := keycloakPod()
+// createOrUpdateVerrazzanoPkceClient creates the verrazzano-pkce client, or updates it with the redirect URIs
+// and web origins of the current DNS domain
+func createOrUpdateVerrazzanoPkceClient(ctx spi.ComponentContext, kc *keycloak.AdminClient) error {
 	// Get DNS Domain Configuration
 	dnsSubDomain, err := getDNSDomain(ctx.Client(), ctx.EffectiveCR())
 	if err != nil {
@@ -1050,270 +813,63 @@ func createOrUpdateVerrazzanoPkceClient(ctx spi.ComponentContext, cfg *restclien
 	}
 	ctx.Log().Debugf("createOrUpdateVerrazzanoPkceClient: DNSDomain returned %s", dnsSubDomain)
 
-	data.DNSSubDomain = dnsSubDomain
-
 	// use template to get populate template with data
 	var b bytes.Buffer
 	t, err := template.New("verrazzanoPkceClient").Parse(pkceTmpl)
 	if err != nil {
 		return err
 	}
-	err = t.Execute(&b, &data)
+	err = t.Execute(&b, &templateData{DNSSubDomain: dnsSubDomain})
 	if err != nil {
 		return err
 	}
-
-	// Create verrazzano-pkce client
-	vzPkceCreateCmd := "/opt/jboss/keycloak/bin/kcadm.sh create clients -r " + vzSysRealm + " -f - <<\\END" +
-		b.String() +
-		"END"
-
-	ctx.Log().Debugf("createOrUpdateVerrazzanoPkceClient: Create verrazzano-pkce client Cmd = %s", vzPkceCreateCmd)
-	stdout, stderr, err := k8sutil.ExecPod(cli, cfg, kcPod, ComponentName, bashCMD(vzPkceCreateCmd))
-	if err != nil {
-		ctx.Log().Errorf("Component Keycloak failed creating verrazzano-pkce client: stdout = %s, stderr = %s", stdout, stderr)
-		return err
-	}
-	ctx.Log().Debug("createOrUpdateVerrazzanoPkceClient: Created verrazzano-pkce client")
-	return nil
+	return createOrUpdateClient(ctx, kc, vzSysRealm, b.String())
 }
 
-func createVerrazzanoPgClient(ctx spi.ComponentContext, cfg *restclient.Config, cli kubernetes.Interface) error {
-	keycloakClients, err := getKeycloakClients(ctx)
-	if err == nil && clientExists(keycloakClients, "verrazzano-pg") {
-		return nil
-	}
-
-	kcPod := keycloakPod()
-	vzPgCreateCmd := "/opt/jboss/keycloak/bin/kcadm.sh create clients -r " + vzSysRealm + " -f - <<\\END" +
-		pgClient +
-		"END"
-	ctx.Log().Debugf("createVerrazzanoPgClient: Create verrazzano-pg client Cmd = %s", vzPgCreateCmd)
-	stdout, stderr, err := k8sutil.ExecPod(cli, cfg, kcPod, ComponentName, bashCMD(vzPgCreateCmd))
-	if err != nil {
-		ctx.Log().Errorf("createVerrazzanoPgClient: Error creating verrazzano-pg client: stdout = %s, stderr = %s", stdout, stderr)
+// createOrUpdateClient creates the client from the JSON client representation, or replaces the client with
+// the same client ID
+func createOrUpdateClient(ctx spi.ComponentContext, kc *keycloak.AdminClient, realm string, clientJSON string) error {
+	desired := keycloak.Client{}
+	if err := json.Unmarshal([]byte(clientJSON), &desired); err != nil {
 		return err
 	}
-	ctx.Log().Debug("createVerrazzanoPgClient: Created verrazzano-pg client")
-	return nil
+	return applyClient(ctx, kc, realm, desired)
 }
 
-func setPasswordPolicyForRealm(ctx spi.ComponentContext, cfg *restclient.Config, cli kubernetes.Interface, realmName string, policy string) error {
-	kcPod := keycloakPod()
-	setPolicyCmd := "/opt/jboss/keycloak/bin/kcadm.sh update realms/" + realmName + " -s \"" + policy + "\""
-	ctx.Log().Debugf("setPasswordPolicyForRealm: Setting password policy for realm %s Cmd = %s", realmName, setPolicyCmd)
-	stdout, stderr, err := k8sutil.ExecPod(cli, cfg, kcPod, ComponentName, bashCMD(setPolicyCmd))
+// applyClient creates the client, or replaces the client with the same client ID
+func applyClient(ctx spi.ComponentContext, kc *keycloak.AdminClient, realm string, desired keycloak.Client) error {
+	existing, err := kc.GetClients(realm, desired.ClientID)
 	if err != nil {
-		ctx.Log().Errorf("Component Keycloak failed setting password policy for master: stdout = %s, stderr = %s", stdout, stderr)
+		ctx.Log().Errorf("Component Keycloak failed retrieving client %s: %v", desired.ClientID, err)
 		return err
 	}
-	ctx.Log().Debugf("setPasswordPolicyForRealm: Set password policy for realm %s", realmName)
-	ctx.Log().Oncef("Component Keycloak successfully set the password policy for realm %s", realmName)
-	return nil
-}
-
-func configureLoginThemeForRealm(ctx spi.ComponentContext, cfg *restclient.Config, cli kubernetes.Interface, realmName string, loginTheme string) error {
-	kcPod := keycloakPod()
-	setLoginThemeCmd := "/opt/jboss/keycloak/bin/kcadm.sh update realms/" + realmName + " -s loginTheme=" + loginTheme
-	ctx.Log().Debugf("configureLoginThemeForRealm: Configuring login theme Cmd = %s", setLoginThemeCmd)
-	stdout, stderr, err := k8sutil.ExecPod(cli, cfg, kcPod, ComponentName, bashCMD(setLoginThemeCmd))
-	if err != nil {
-		ctx.Log().Errorf("Component Keycloak failed configuring login theme for master: stdout = %s, stderr = %s", stdout, stderr)
-		return err
+	if len(existing) > 0 {
+		desired.ID = existing[0].ID
+		if err := kc.UpdateClient(realm, desired); err != nil {
+			ctx.Log().Errorf("Component Keycloak failed updating client %s: %v", desired.ClientID, err)
+			return err
+		}
+		ctx.Log().Debugf("createOrUpdateClient: Updated %s client", desired.ClientID)
+		return nil
 	}
-	ctx.Log().Debug("configureLoginThemeForRealm: Configured login theme for master Cmd")
-	ctx.Log().Oncef("Component Keycloak successfully set the login theme for realm %s", realmName)
-	return nil
-}
-
-func enableVerrazzanoSystemRealm(ctx spi.ComponentContext, cfg *restclient.Config, cli kubernetes.Interface) error {
-	kcPod := keycloakPod()
-	setVzEnableRealmCmd := "/opt/jboss/keycloak/bin/kcadm.sh update realms/" + vzSysRealm + " -s enabled=true"
-	ctx.Log().Debugf("enableVerrazzanoSystemRealm: Enabling vzSysRealm realm Cmd = %s", setVzEnableRealmCmd)
-	stdout, stderr, err := k8sutil.ExecPod(cli, cfg, kcPod, ComponentName, bashCMD(setVzEnableRealmCmd))
-	if err != nil {
-		ctx.Log().Errorf("Component Keycloak failed enabling vzSysRealm realm: stdout = %s, stderr = %s", stdout, stderr)
+	if _, err := kc.CreateClient(realm, desired); err != nil {
+		ctx.Log().Errorf("Component Keycloak failed creating client %s: %v", desired.ClientID, err)
 		return err
 	}
-	ctx.Log().Debug("enableVerrazzanoSystemRealm: Enabled vzSysRealm realm")
-	ctx.Log().Once("Component Keycloak successfully enabled the vzSysRealm realm")
-
+	ctx.Log().Oncef("Component Keycloak successfully created client %s", desired.ClientID)
 	return nil
 }
 
-func removeLoginConfigFile(ctx spi.ComponentContext, cfg *restclient.Config, cli kubernetes.Interface) error {
-	kcPod := keycloakPod()
-	removeLoginConfigFileCmd := "rm /root/.keycloak/kcadm.config"
-	ctx.Log().Debugf("removeLoginConfigFile: Removing login config file Cmd = %s", removeLoginConfigFileCmd)
-	stdout, stderr, err := k8sutil.ExecPod(cli, cfg, kcPod, ComponentName, bashCMD(removeLoginConfigFileCmd))
-	if err != nil {
-		ctx.Log().Errorf("Component Keycloak failed removing login config file: stdout = %s, stderr = %s", stdout, stderr)
+// updateRealm updates the non-empty fields of the realm
+func updateRealm(ctx spi.ComponentContext, kc *keycloak.AdminClient, realm keycloak.Realm) error {
+	if err := kc.UpdateRealm(realm); err != nil {
+		ctx.Log().Errorf("Component Keycloak failed updating realm %s: %v", realm.Realm, err)
 		return err
 	}
-	ctx.Log().Debug("removeLoginConfigFile: Removed login config file")
+	ctx.Log().Oncef("Component Keycloak successfully set the password policy and login theme for realm %s", realm.Realm)
 	return nil
 }
 
-// getKeycloakGroups returns a structure of Groups in Realm verrazzano-system
-func getKeycloakGroups(ctx spi.ComponentContext) (KeycloakGroups, error) {
-	var keycloakGroups KeycloakGroups
-	// Get the Client ID JSON array
-	cmd := execCommand("kubectl", "exec", keycloakPodName, "-n", ComponentNamespace, "-c", "keycloak", "--", "/opt/jboss/keycloak/bin/kcadm.sh", "get", "groups", "-r", vzSysRealm)
-	out, err := cmd.Output()
-	if err != nil {
-		ctx.Log().Errorf("Component Keycloak failed retrieving Groups: %s", err)
-		return nil, err
-	}
-	if len(string(out)) == 0 {
-		err = errors.New("Component Keycloak failed; groups JSON from Keycloak is zero length")
-		ctx.Log().Error(err)
-		return nil, err
-	}
-	err = json.Unmarshal(out, &keycloakGroups)
-	if err != nil {
-		ctx.Log().Errorf("Component Keycloak failed ummarshalling groups json: %v", err)
-		return nil, err
-	}
-
-	return keycloakGroups, nil
-}
-
-func groupExists(keycloakGroups KeycloakGroups, groupName string) bool {
-	for _, keycloakGroup := range keycloakGroups {
-		if keycloakGroup.Name == groupName {
-			return true
-		}
-		for _, subGroup := range keycloakGroup.SubGroups {
-			if subGroup.Name == groupName {
-				return true
-			}
-		}
-	}
-	return false
-}
-
-func getGroupID(keycloakGroups KeycloakGroups, groupName string) string {
-	for _, keycloakGroup := range keycloakGroups {
-		if keycloakGroup.Name == groupName {
-			return keycloakGroup.ID
-		}
-		for _, subGroup := range keycloakGroup.SubGroups {
-			if subGroup.Name == groupName {
-				return subGroup.ID
-			}
-		}
-	}
-	return ""
-}
-
-// getKeycloakRoless returns a structure of Groups in Realm verrazzano-system
-func getKeycloakRoles(ctx spi.ComponentContext) (KeycloakRoles, error) {
-	var keycloakRoles KeycloakRoles
-	// Get the Client ID JSON array
-	cmd := execCommand("kubectl", "exec", keycloakPodName, "-n", ComponentNamespace, "-c", "keycloak", "--", "/opt/jboss/keycloak/bin/kcadm.sh", "get-roles", "-r", vzSysRealm)
-	out, err := cmd.Output()
-	if err != nil {
-		ctx.Log().Errorf("Component Keycloak failed retrieving Roles: %s", err)
-		return nil, err
-	}
-	if len(string(out)) == 0 {
-		err = errors.New("Component Keycloak failed; roles JSON from Keycloak is zero length")
-		ctx.Log().Error(err)
-		return nil, err
-	}
-	err = json.Unmarshal(out, &keycloakRoles)
-	if err != nil {
-		ctx.Log().Errorf("Component Keycloak failed ummarshalling groups json: %v", err)
-		return nil, err
-	}
-
-	return keycloakRoles, nil
-}
-
-func roleExists(keycloakRoles KeycloakRoles, roleName string) bool {
-	for _, keycloakRole := range keycloakRoles {
-		if keycloakRole.Name == roleName {
-			return true
-		}
-	}
-	return false
-}
-
-// getKeycloakUsers returns a structure of Users in Realm verrazzano-system
-func getKeycloakUsers(ctx spi.ComponentContext) (KeycloakUsers, error) {
-	var keycloakUsers KeycloakUsers
-	// Get the Client ID JSON array
-	cmd := execCommand("kubectl", "exec", keycloakPodName, "-n", ComponentNamespace, "-c", "keycloak", "--", "/opt/jboss/keycloak/bin/kcadm.sh", "get", "users", "-r", vzSysRealm)
-	out, err := cmd.Output()
-	if err != nil {
-		ctx.Log().Errorf("Component Keycloak failed retrieving Users: %s", err)
-		return nil, err
-	}
-	if len(string(out)) == 0 {
-		err := errors.New("Component Keycloak failed; users JSON from Keycloak is zero length")
-		ctx.Log().Error(err)
-		return nil, err
-	}
-	err = json.Unmarshal(out, &keycloakUsers)
-	if err != nil {
-		ctx.Log().Errorf("Component Keycloak failed ummarshalling users json: %v", err)
-		return nil, err
-	}
-	return keycloakUsers, nil
-}
-
-func userExists(keycloakUsers KeycloakUsers, userName string) bool {
-	for _, keycloakUser := range keycloakUsers {
-		if keycloakUser.Username == userName {
-			return true
-		}
-	}
-	return false
-}
-
-// getKeycloakClients returns a structure of Users in Realm verrazzano-system
-func getKeycloakClients(ctx spi.ComponentContext) (KeycloakClients, error) {
-	var keycloakClients KeycloakClients
-	// Get the Client ID JSON array
-	cmd := execCommand("kubectl", "exec", keycloakPodName, "-n", ComponentNamespace, "-c", "keycloak", "--", "/opt/jboss/keycloak/bin/kcadm.sh", "get", "clients", "-r", "verrazzano-system", "--fields", "id,clientId")
-	out, err := cmd.Output()
-	if err != nil {
-		ctx.Log().Errorf("Component Keycloak failed retrieving clients: %s", err)
-		return nil, err
-	}
-	if len(string(out)) == 0 {
-		err := errors.New("Component Keycloak failed; clients JSON from Keycloak is zero length")
-		ctx.Log().Error(err)
-		return nil, err
-	}
-	err = json.Unmarshal(out, &keycloakClients)
-	if err != nil {
-		ctx.Log().Errorf("Component Keycloak failed ummarshalling client json: %v", err)
-		return nil, err
-	}
-	return keycloakClients, nil
-}
-
-func clientExists(keycloakClients KeycloakClients, clientName string) bool {
-
-	for _, keycloakClient := range keycloakClients {
-		if keycloakClient.ClientID == clientName {
-			return true
-		}
-	}
-	return false
-}
-
-func getClientID(keycloakClients KeycloakClients, clientName string) string {
-
-	for _, keycloakClient := range keycloakClients {
-		if keycloakClient.ClientID == clientName {
-			return keycloakClient.ID
-		}
-	}
-	return ""
-}
-
 func isKeycloakReady(ctx spi.ComponentContext) bool {
 	statefulset := []types.NamespacedName{
 		{
@@ -1325,6 +881,30 @@ func isKeycloakReady(ctx spi.ComponentContext) bool {
 	return status.StatefulSetsAreReady(ctx.Log(), ctx.Client(), statefulset, 1, prefix)
 }
 
+// checkMySQLHealthy returns an error if Keycloak is installed and MySQL, which stores the Keycloak data, is not ready
+func checkMySQLHealthy(ctx spi.ComponentContext) error {
+	prefix := fmt.Sprintf("Component %s", ctx.GetComponent())
+	statefulset := []types.NamespacedName{
+		{
+			Name:      ComponentName,
+			Namespace: ComponentNamespace,
+		},
+	}
+	if !status.DoStatefulSetsExist(ctx.Log(), ctx.Client(), statefulset, 1, prefix) {
+		return nil
+	}
+	deployment := []types.NamespacedName{
+		{
+			Name:      mysql.ComponentName,
+			Namespace: ComponentNamespace,
+		},
+	}
+	if !status.DeploymentsAreReady(ctx.Log(), ctx.Client(), deployment, 1, prefix) {
+		return fmt.Errorf("MySQL deployment %s/%s used by Keycloak is not ready", ComponentNamespace, mysql.ComponentName)
+	}
+	return nil
+}
+
 // isPodReady determines if the pod is running by checking for a Ready condition with Status equal True
 func isPodReady(pod *v1.Pod) bool {
 	conditions := pod.Status.Conditions
@@ -6,6 +6,7 @@ package keycloak
 import (
 	"context"
 	"fmt"
+	"time"
 
 	ctrlerrors "github.com/verrazzano/verrazzano/pkg/controller/errors"
 	vzapi "github.com/verrazzano/verrazzano/platform-operator/apis/verrazzano/v1alpha1"
@@ -44,6 +45,9 @@ type KeycloakComponent struct {
 // Verify that KeycloakComponent implements Component
 var _ spi.Component = KeycloakComponent{}
 
+// Verify that KeycloakComponent reapplies its configuration periodically
+var _ spi.ComponentResyncer = KeycloakComponent{}
+
 var certificates = []types.NamespacedName{
 	{Namespace: ComponentNamespace, Name: keycloakCertificateName},
 }
@@ -59,7 +63,7 @@ func NewComponent() spi.Component {
 			IgnoreNamespaceOverride: true,
 			ImagePullSecretKeyname:  secret.DefaultImagePullSecretKeyName,
 			ValuesFile:              filepath.Join(config.GetHelmOverridesDir(), "keycloak-values.yaml"),
-			Dependencies:            []string{istio.ComponentName, nginx.ComponentName, certmanager.ComponentName},
+			Dependencies:            []string{istio.ComponentName, nginx.ComponentName, certmanager.ComponentName, mysql.ComponentName},
 			SupportsOperatorInstall: true,
 			AppendOverridesFunc:     AppendKeycloakOverrides,
 			Certificates:            certificates,
@@ -70,12 +74,15 @@ func NewComponent() spi.Component {
 				},
 			},
 			GetInstallOverridesFunc: GetOverrides,
+			// Keycloak waits for MySQL to be ready before it starts
+			DefaultTimeout: 45 * time.Minute,
 		},
 	}
 }
 
-// Reconcile - the only condition currently being handled by this function is to restore
-// the Keycloak configuration when the MySQL pod gets restarted and ephemeral storage is being used.
+// Reconcile reapplies the Keycloak configuration, including the identity configuration of the Verrazzano CR.  It is
+// called periodically while Keycloak is ready, and restores the Keycloak configuration when the MySQL pod gets
+// restarted and ephemeral storage is being used.
 func (c KeycloakComponent) Reconcile(ctx spi.ComponentContext) error {
 	// If the Keycloak component is ready, confirm the configuration is working.
 	// If ephemeral storage is being used, the Keycloak configuration will be rebuilt if needed.
@@ -118,9 +125,8 @@ func (c KeycloakComponent) PreInstall(ctx spi.ComponentContext) error {
 		return err
 	}
 
-	// Create secret for the keycloakadmin user if it doesn't exist
-	err = createAuthSecret(ctx, ComponentNamespace, "keycloak-http", "keycloakadmin")
-	if err != nil {
+	// Create secret for the keycloakadmin user if it doesn't exist, the MySQL restore may have created it
+	if _, err = common.EnsureKeycloakAdminSecret(ctx.Client()); err != nil {
 		return err
 	}
 
@@ -196,11 +202,32 @@ func (c KeycloakComponent) IsReady(ctx spi.ComponentContext) bool {
 	return false
 }
 
+// PreflightChecks verifies that the Helm release has no pending operation and that MySQL is healthy
+func (c KeycloakComponent) PreflightChecks(ctx spi.ComponentContext) []error {
+	errs := c.HelmComponent.PreflightChecks(ctx)
+	if err := checkMySQLHealthy(ctx); err != nil {
+		errs = append(errs, err)
+	}
+	return errs
+}
+
+// GetResyncInterval returns how often the Keycloak configuration is reapplied while Keycloak is ready
+func (c KeycloakComponent) GetResyncInterval() time.Duration {
+	return resyncInterval
+}
+
+// ValidateInstall checks if the specified Verrazzano CR is valid for this component to be installed
+func (c KeycloakComponent) ValidateInstall(vz *vzapi.Verrazzano) error {
+	if err := validateIdentity(vz); err != nil {
+		return err
+	}
+	return c.HelmComponent.ValidateInstall(vz)
+}
+
 // ValidateUpdate checks if the specified new Verrazzano CR is valid for this component to be updated
 func (c KeycloakComponent) ValidateUpdate(old *vzapi.Verrazzano, new *vzapi.Verrazzano) error {
-	// Do not allow any changes except to enable the component post-install
-	if c.IsEnabled(old) && !c.IsEnabled(new) {
-		return fmt.Errorf("Disabling component %s is not allowed", ComponentJSONName)
+	if err := validateIdentity(new); err != nil {
+		return err
 	}
 	// Reject any other edits for now
 	if err := common.CompareInstallArgs(c.getInstallArgs(old), c.getInstallArgs(new)); err != nil {
@@ -13,6 +13,7 @@ import (
 	"github.com/verrazzano/verrazzano/platform-operator/constants"
 	"github.com/verrazzano/verrazzano/platform-operator/controllers/verrazzano/component/mysql"
 	"github.com/verrazzano/verrazzano/platform-operator/controllers/verrazzano/component/spi"
+	appsv1 "k8s.io/api/apps/v1"
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	k8scheme "k8s.io/client-go/kubernetes/scheme"
@@ -134,6 +135,52 @@ func TestPreinstall(t *testing.T) {
 	}
 }
 
+// TestPreflightChecks tests the Keycloak PreflightChecks call
+// GIVEN a Keycloak component
+//  WHEN I call PreflightChecks
+//  THEN an error is returned when Keycloak is installed and MySQL is not ready
+func TestPreflightChecks(t *testing.T) {
+	keycloakStatefulSet := &appsv1.StatefulSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: ComponentNamespace,
+			Name:      ComponentName,
+		},
+	}
+	var tests = []struct {
+		name   string
+		c      client.Client
+		failed bool
+	}{
+		{
+			"keycloak not installed",
+			fake.NewClientBuilder().WithScheme(k8scheme.Scheme).Build(),
+			false,
+		},
+		{
+			"mysql not ready",
+			fake.NewClientBuilder().WithScheme(k8scheme.Scheme).WithObjects(keycloakStatefulSet, &appsv1.Deployment{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: ComponentNamespace,
+					Name:      mysql.ComponentName,
+				},
+			}).Build(),
+			true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := spi.NewFakeContext(tt.c, &vzapi.Verrazzano{}, false)
+			errs := kcComponent.(spi.ComponentPreflightChecker).PreflightChecks(ctx)
+			if tt.failed {
+				assert.Len(t, errs, 1)
+				assert.Contains(t, errs[0].Error(), "MySQL deployment keycloak/mysql used by Keycloak is not ready")
+			} else {
+				assert.Empty(t, errs)
+			}
+		})
+	}
+}
+
 // TestKeycloakComponent_ValidateUpdate tests the Keycloak ValidateUpdate call
 // GIVEN a Keycloak component
 //  WHEN I call ValidateUpdate
@@ -172,7 +219,7 @@ func TestKeycloakComponent_ValidateUpdate(t *testing.T) {
 					},
 				},
 			},
-			wantErr: true,
+			wantErr: false,
 		},
 		{
 			name: "disable",
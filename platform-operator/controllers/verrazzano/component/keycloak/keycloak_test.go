@@ -4,18 +4,17 @@
 package keycloak
 
 import (
-	"errors"
-	"fmt"
-	"os"
-	"os/exec"
+	"context"
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 
 	certmanager "github.com/jetstack/cert-manager/pkg/apis/certmanager/v1"
 	"github.com/stretchr/testify/assert"
 	"github.com/verrazzano/verrazzano/pkg/bom"
-	"github.com/verrazzano/verrazzano/pkg/k8sutil"
-	k8sutilfake "github.com/verrazzano/verrazzano/pkg/k8sutil/fake"
-	vzos "github.com/verrazzano/verrazzano/pkg/os"
+	"github.com/verrazzano/verrazzano/pkg/keycloak"
+	kcfake "github.com/verrazzano/verrazzano/pkg/keycloak/fake"
 	vzapi "github.com/verrazzano/verrazzano/platform-operator/apis/verrazzano/v1alpha1"
 	"github.com/verrazzano/verrazzano/platform-operator/constants"
 	"github.com/verrazzano/verrazzano/platform-operator/controllers/verrazzano/component/spi"
@@ -23,10 +22,10 @@ import (
 	appsv1 "k8s.io/api/apps/v1"
 	v1 "k8s.io/api/core/v1"
 	networkv1 "k8s.io/api/networking/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	"k8s.io/client-go/kubernetes"
+	"k8s.io/apimachinery/pkg/types"
 	k8scheme "k8s.io/client-go/kubernetes/scheme"
-	"k8s.io/client-go/rest"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 )
@@ -37,8 +36,6 @@ const (
 	profilesRelativePath    = "../../../../manifests/profiles"
 )
 
-var keycloakClientIds = "[ {\n  \"id\" : \"a732-249893586af2\",\n  \"clientId\" : \"account\"\n}, {\n  \"id\" : \"4256-a350-e46eb48e8606\",\n  \"clientId\" : \"account-console\"\n}, {\n  \"id\" : \"4c1d-8d1b-68635e005567\",\n  \"clientId\" : \"admin-cli\"\n}, {\n  \"id\" : \"4350-ab70-17c37dd995b9\",\n  \"clientId\" : \"broker\"\n}, {\n  \"id\" : \"4f6d-a495-0e9e3849608e\",\n  \"clientId\" : \"realm-management\"\n}, {\n  \"id\" : \"4d92-9d64-f201698d2b79\",\n  \"clientId\" : \"security-admin-console\"\n}, {\n  \"id\" : \"4160-8593-32697ebf2c11\",\n  \"clientId\" : \"verrazzano-oauth-client\"\n}, {\n  \"id\" : \"bde9-9374bd6a38fd\",\n  \"clientId\" : \"verrazzano-pg\"\n}, {\n  \"id\" : \"8327-13cdbfe3b000\",\n  \"clientId\" : \"verrazzano-pkce\"\n\n}, {\n  \"id\" : \"494a-b7ec-b05681cafc73\",\n  \"clientId\" : \"webui\"\n} ]"
-var keycloakErrorClientIds = "[ {\n  \"id\" : \"a732-249893586af2\",\n  \"clientId\" : \"account\"\n}, {\n  \"id\" : \"4256-a350-e46eb48e8606\",\n  \"clientId\" : \"account-console\"\n}, {\n  \"id\" : \"4c1d-8d1b-68635e005567\",\n  \"clientId\" : \"admin-cli\"\n}, {\n  \"id\" : \"4f6d-a495-0e9e3849608e\",\n  \"clientId\" : \"realm-management\"\n}, {\n  \"id\" : \"4d92-9d64-f201698d2b79\",\n  \"clientId\" : \"security-admin-console\"\n}, {\n  \"id\" : \"4160-8593-32697ebf2c11\",\n  \"clientId\" : \"verrazzano-oauth-client\"\n}, {\n  \"id\" : \"bde9-9374bd6a38fd\",\n  \"clientId\" : \"verrazzano-pg\"\n}, {\n  \"id\" : \"494a-b7ec-b05681cafc73\",\n  \"clientId\" : \"webui\"\n} ]"
 var testVZ = &vzapi.Verrazzano{
 	Spec: vzapi.VerrazzanoSpec{
 		Profile: "dev",
@@ -59,21 +56,6 @@ var crEnabled = vzapi.Verrazzano{
 	},
 }
 
-// fakeBash mocks a successful script run
-func fakeBash(_ ...string) (string, string, error) {
-	return "success", "", nil
-}
-
-// fakeBashFail mocks a failed script run
-func fakeBashFail(_ ...string) (string, string, error) {
-	return "fail", "Script Failed", errors.New("script Failed")
-}
-
-func fakeRESTConfig() (*rest.Config, kubernetes.Interface, error) {
-	cfg, cli := k8sutilfake.NewClientsetConfig()
-	return cfg, cli, nil
-}
-
 func createTestLoginSecret() *v1.Secret {
 	return &v1.Secret{
 		TypeMeta: metav1.TypeMeta{},
@@ -104,266 +86,20 @@ func createTestNginxService() *v1.Service {
 	}
 }
 
-func fakeExecCommand(command string, args ...string) *exec.Cmd {
-	cs := []string{"-test.run=TestHelperProcess", "--", command}
-	cs = append(cs, args...)
-	firstArg := os.Args[0]
-	cmd := exec.Command(firstArg, cs...)
-	cmd.Env = []string{"GO_WANT_HELPER_PROCESS=1"}
-	return cmd
-}
-
-func TestHelperProcess(t *testing.T) {
-	if os.Getenv("GO_WANT_HELPER_PROCESS") != "1" {
-		return
-	}
-
-	_, _ = fmt.Fprintf(os.Stdout, keycloakClientIds)
-	os.Exit(0)
-}
-
-func fakeFailExecCommand(command string, args ...string) *exec.Cmd {
-	cs := []string{"-test.run=TestFailHelperProcess", "--", command}
-	cs = append(cs, args...)
-	firstArg := os.Args[0]
-	cmd := exec.Command(firstArg, cs...)
-	cmd.Env = []string{"GO_WANT_FAIL_HELPER_PROCESS=1"}
-	return cmd
-}
-
-// fakeConfigureRealmCommands Implements the default responses for TestConfigureKeycloakRealms
-// - these tests launch actual go test commands to spit out canned output and return codes
-// - this is to control how the test respond to multiple different commands in the flow
-// - because it's function-based, we can't have different responses based on the call ordering, unless we want to
-//   get funky with how we manage state, and implement the canned calls as a stack or something
-func fakeConfigureRealmCommands(command string, args ...string) *exec.Cmd {
-	kccommand := fmt.Sprintf("%s-%s", args[8], args[9])
-	switch kccommand {
-	case "get-clients":
-		return fakeExecCommand(command, args...)
-	default:
-		return fakeCreateUserGroupCommand(command, args...)
-	}
-}
-
-func TestFailHelperProcess(t *testing.T) {
-	if os.Getenv("GO_WANT_FAIL_HELPER_PROCESS") != "1" {
-		return
-	}
-
-	_, _ = fmt.Fprintf(os.Stdout, keycloakClientIds)
-	os.Exit(1)
-}
-
-func fakeFailExecCommandNoClients(command string, args ...string) *exec.Cmd {
-	cs := []string{"-test.run=TestFailNoClientsHelperProcess", "--", command}
-	cs = append(cs, args...)
-	firstArg := os.Args[0]
-	cmd := exec.Command(firstArg, cs...)
-	cmd.Env = []string{"GO_WANT_FAIL_NO_CLIENTS_HELPER_PROCESS=1"}
-	return cmd
-}
-
-func TestFailNoClientsHelperProcess(t *testing.T) {
-	if os.Getenv("GO_WANT_FAIL_NO_CLIENTS_HELPER_PROCESS") != "1" {
-		return
-	}
-
-	_, _ = fmt.Fprintf(os.Stdout, "")
-	os.Exit(0)
-}
-
-func fakeFailExecCommandNoUser(command string, args ...string) *exec.Cmd {
-	cs := []string{"-test.run=TestFailNoUserHelperProcess", "--", command}
-	cs = append(cs, args...)
-	firstArg := os.Args[0]
-	cmd := exec.Command(firstArg, cs...)
-	cmd.Env = []string{"GO_WANT_FAIL_NO_USER_HELPER_PROCESS=1"}
-	return cmd
-}
-
-func TestFailNoUserHelperProcess(t *testing.T) {
-	if os.Getenv("GO_WANT_FAIL_NO_USER_HELPER_PROCESS") != "1" {
-		return
-	}
-
-	_, _ = fmt.Fprintf(os.Stdout, keycloakErrorClientIds)
-	os.Exit(0)
-}
-
-func fakeCreateUserGroupCommand(command string, args ...string) *exec.Cmd {
-	cs := []string{"-test.run=TestFakeCreateUserGroup", "--", command}
-	cs = append(cs, args...)
-	firstArg := os.Args[0]
-	cmd := exec.Command(firstArg, cs...)
-	cmd.Env = []string{"GO_WANT_TEST_CREATE_USER_GROUP=1"}
-	return cmd
-}
-
-func TestFakeCreateUserGroup(t *testing.T) {
-	if os.Getenv("GO_WANT_TEST_CREATE_USER_GROUP") != "1" {
-		return
-	}
-
-	_, _ = fmt.Fprintf(os.Stdout, "Created new group with id '6653a73b-f292-4dfe-91cb-956ead33ea67'")
-	os.Exit(0)
-}
-
-func fakeCreateUserGroupCommandFail(command string, args ...string) *exec.Cmd {
-	cs := []string{"-test.run=TestFakeCreateUserGroupFail", "--", command}
-	cs = append(cs, args...)
-	firstArg := os.Args[0]
-	cmd := exec.Command(firstArg, cs...)
-	cmd.Env = []string{"GO_WANT_TEST_CREATE_USER_GROUP_FAIL=1"}
-	return cmd
-}
-
-func TestFakeCreateUserGroupFail(t *testing.T) {
-	if os.Getenv("GO_WANT_TEST_CREATE_USER_GROUP_FAIL") != "1" {
-		return
-	}
-
-	_, _ = fmt.Fprintf(os.Stdout, "")
-	os.Exit(0)
-}
-
-func fakeCreateUserGroupParseCommandFail(command string, args ...string) *exec.Cmd {
-	cs := []string{"-test.run=TestFakeCreateUserGroupParseFail", "--", command}
-	cs = append(cs, args...)
-	firstArg := os.Args[0]
-	cmd := exec.Command(firstArg, cs...)
-	cmd.Env = []string{"GO_WANT_TEST_CREATE_USER_GROUP_PARSE_FAIL=1"}
-	return cmd
-}
-
-func TestFakeCreateUserParseGroupFail(t *testing.T) {
-	if os.Getenv("GO_WANT_TEST_CREATE_USER_GROUP_PARSE_FAIL") != "1" {
-		return
-	}
-
-	_, _ = fmt.Fprintf(os.Stdout, "Created new group with id 6653a73b-f292-4dfe-91cb-956ead33ea67")
-	os.Exit(0)
-}
-
-func TestUpdateKeycloakURIs(t *testing.T) {
-	k8sutil.ClientConfig = fakeRESTConfig
-	k8sutil.NewPodExecutor = k8sutilfake.NewPodExecutor
-	tests := []struct {
-		name    string
-		args    spi.ComponentContext
-		wantErr bool
-	}{
-		{
-			name: "testUpdateKeycloakURIs",
-			args: spi.NewFakeContext(
-				fake.NewClientBuilder().WithScheme(k8scheme.Scheme).WithObjects(createTestLoginSecret(), createTestNginxService()).Build(),
-				testVZ,
-				false),
-			wantErr: false,
-		},
-		{
-			name: "testFailForNoKeycloakSecret",
-			args: spi.NewFakeContext(
-				fake.NewClientBuilder().WithScheme(k8scheme.Scheme).WithObjects(createTestNginxService()).Build(),
-				testVZ,
-				false),
-			wantErr: true,
-		},
-		{
-			name: "testFailForKeycloakSecretPasswordEmpty",
-			args: spi.NewFakeContext(
-				fake.NewClientBuilder().WithScheme(k8scheme.Scheme).WithObjects(createTestNginxService(), &v1.Secret{
-					TypeMeta: metav1.TypeMeta{},
-					ObjectMeta: metav1.ObjectMeta{
-						Name:      "keycloak-http",
-						Namespace: "keycloak",
-					},
-					Data: map[string][]byte{"password": []byte("")},
-				}).Build(),
-				testVZ,
-				false),
-			wantErr: true,
-		},
-		{
-			name: "testFailForAuthenticationToKeycloak",
-			args: spi.NewFakeContext(
-				fake.NewClientBuilder().WithScheme(k8scheme.Scheme).WithObjects(createTestLoginSecret(), createTestNginxService()).Build(),
-				testVZ,
-				false),
-			wantErr: true,
-		},
-		{
-			name: "testFailForNoKeycloakClientsReturned",
-			args: spi.NewFakeContext(
-				fake.NewClientBuilder().WithScheme(k8scheme.Scheme).WithObjects(createTestLoginSecret(), createTestNginxService()).Build(),
-				testVZ,
-				false),
-			wantErr: true,
-		},
-		{
-			name: "testFailForKeycloakUserNotFound",
-			args: spi.NewFakeContext(
-				fake.NewClientBuilder().WithScheme(k8scheme.Scheme).WithObjects(createTestLoginSecret(), createTestNginxService()).Build(),
-				testVZ,
-				false),
-			wantErr: true,
-		},
-		{
-			name: "testFailForNoIngress",
-			args: spi.NewFakeContext(
-				fake.NewClientBuilder().WithScheme(k8scheme.Scheme).WithObjects(createTestLoginSecret()).Build(),
-				testVZ,
-				false),
-			wantErr: true,
+func createTestPasswordSecret(name string, password string) *v1.Secret {
+	return &v1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: "verrazzano-system",
 		},
-		{
-			name: "testScriptFailure",
-			args: spi.NewFakeContext(
-				fake.NewClientBuilder().WithScheme(k8scheme.Scheme).WithObjects(createTestLoginSecret(), createTestNginxService()).Build(),
-				testVZ,
-				false),
-			wantErr: true,
+		Data: map[string][]byte{
+			"password": []byte(password),
 		},
 	}
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			execCommand = fakeExecCommand
-			if tt.wantErr && tt.name == "testFailForAuthenticationToKeycloak" {
-				execCommand = fakeFailExecCommand
-			}
-			if tt.wantErr && tt.name == "testFailForNoKeycloakClientsReturned" {
-				execCommand = fakeFailExecCommandNoClients
-			}
-			if tt.wantErr && tt.name == "testFailForKeycloakUserNotFound" {
-				execCommand = fakeFailExecCommandNoUser
-			}
-			setBashFunc(fakeBash)
-			if tt.wantErr && tt.name == "testScriptFailure" {
-				setBashFunc(fakeBashFail)
-			}
-			defer func() { execCommand = exec.Command }()
-			if err := updateKeycloakUris(tt.args); (err != nil) != tt.wantErr {
-				t.Errorf("updateKeycloakUris() error = %v, wantErr %v", err, tt.wantErr)
-			}
-		})
-	}
 }
 
-// TestConfigureKeycloakRealms tests configuration of the Keycloak realms
-// GIVEN a client, and a k8s environment
-// WHEN I call configureKeycloakRealms
-// THEN configure the Keycloak realms, otherwise returning an error if the environment is invalid
-func TestConfigureKeycloakRealms(t *testing.T) {
-	loginSecret := createTestLoginSecret()
-	nginxService := createTestNginxService()
-	k8sutil.ClientConfig = fakeRESTConfig
-	k8sutil.NewPodExecutor = k8sutilfake.NewPodExecutor
-
-	defaultBashFunc := func(inArgs ...string) (string, string, error) {
-		return "", "", nil
-	}
-
-	keycloakPod := &v1.Pod{
+func createTestKeycloakPod() *v1.Pod {
+	return &v1.Pod{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      keycloakPodName,
 			Namespace: ComponentNamespace,
@@ -377,189 +113,118 @@ func TestConfigureKeycloakRealms(t *testing.T) {
 			},
 		},
 	}
+}
+
+// newKeycloakServer starts a Keycloak stand-in with the admin password of createTestLoginSecret and
+// points the component at it
+func newKeycloakServer() *kcfake.Server {
+	server := kcfake.NewServer(keycloakAdminUser, "password")
+	httpClient.Set(func(ctx spi.ComponentContext) (string, *http.Client, error) {
+		return server.BaseURL(), server.Client(), nil
+	})
+	return server
+}
+
+// TestConfigureKeycloakRealms tests configuration of the Keycloak realms
+// GIVEN a client, and a k8s environment
+// WHEN I call configureKeycloakRealms
+// THEN configure the Keycloak realms, otherwise returning an error if the environment is invalid
+func TestConfigureKeycloakRealms(t *testing.T) {
+	loginSecret := createTestLoginSecret()
+	nginxService := createTestNginxService()
+	keycloakPod := createTestKeycloakPod()
+	vzSecret := createTestPasswordSecret("verrazzano", "blah di blah")
+	promSecret := createTestPasswordSecret("verrazzano-prom-internal", "blah di blah")
+	esSecret := createTestPasswordSecret("verrazzano-es-internal", "blah di blah")
 
 	var tests = []struct {
 		name        string
 		c           client.Client
-		stdout      string
 		isErr       bool
 		errContains string
-		execFunc    func(command string, args ...string) *exec.Cmd
-		bashFunc    bashFuncSig
+		failMethod  string
+		failPath    string
 	}{
+		{
+			"should fail when the Keycloak pod is not ready",
+			fake.NewClientBuilder().WithScheme(k8scheme.Scheme).WithObjects(loginSecret).Build(),
+			true,
+			"pods \"keycloak-0\" not found",
+			"",
+			"",
+		},
 		{
 			"should fail when login fails",
 			fake.NewClientBuilder().WithScheme(k8scheme.Scheme).WithObjects(keycloakPod).Build(),
-			"blahblah",
 			true,
 			"secrets \"keycloak-http\" not found",
-			fakeCreateUserGroupCommand,
-			defaultBashFunc,
-		},
-		{
-			"should fail to retrieve user group ID from Keycloak when stdout is empty",
-			fake.NewClientBuilder().WithScheme(k8scheme.Scheme).WithObjects(loginSecret, keycloakPod).Build(),
 			"",
-			true,
-			"Component Keycloak failed; user group ID from Keycloak is zero length",
-			fakeCreateUserGroupCommandFail,
-			defaultBashFunc,
+			"",
 		},
 		{
-			"should fail to retrieve user group ID from Keycloak when stdout is incorrect",
-			fake.NewClientBuilder().WithScheme(k8scheme.Scheme).WithObjects(loginSecret, keycloakPod).Build(),
-			"",
+			"should fail when Keycloak fails creating the user group",
+			fake.NewClientBuilder().WithScheme(k8scheme.Scheme).WithObjects(loginSecret, keycloakPod, nginxService).Build(),
 			true,
-			"failed parsing output returned from Users Group",
-			fakeCreateUserGroupParseCommandFail,
-			defaultBashFunc,
+			"returned status code 500",
+			http.MethodPost,
+			"/admin/realms/verrazzano-system/groups",
 		},
 		{
 			"should fail when Verrazzano secret is not present",
-			fake.NewClientBuilder().WithScheme(k8scheme.Scheme).WithObjects(loginSecret, keycloakPod).Build(),
-			"blahblah'id",
+			fake.NewClientBuilder().WithScheme(k8scheme.Scheme).WithObjects(loginSecret, keycloakPod, nginxService).Build(),
 			true,
 			"secrets \"verrazzano\" not found",
-			fakeCreateUserGroupCommand,
-			defaultBashFunc,
+			"",
+			"",
 		},
 		{
 			"should fail when Verrazzano secret has no password",
 			fake.NewClientBuilder().WithScheme(k8scheme.Scheme).WithObjects(loginSecret, nginxService, keycloakPod,
-				&v1.Secret{
-					ObjectMeta: metav1.ObjectMeta{
-						Name:      "verrazzano",
-						Namespace: "verrazzano-system",
-					},
-					Data: map[string][]byte{
-						"password": []byte(""),
-					},
-				}).Build(),
-			"blahblah'id",
+				createTestPasswordSecret("verrazzano", "")).Build(),
 			true,
 			"password field empty in secret",
-			fakeCreateUserGroupCommand,
-			defaultBashFunc,
+			"",
+			"",
 		},
 		{
 			"should fail when nginx service is not present",
-			fake.NewClientBuilder().WithScheme(k8scheme.Scheme).WithObjects(loginSecret, keycloakPod,
-				&v1.Secret{
-					ObjectMeta: metav1.ObjectMeta{
-						Name:      "verrazzano",
-						Namespace: "verrazzano-system",
-					},
-					Data: map[string][]byte{
-						"password": []byte("blah di blah"),
-					},
-				},
-				&v1.Secret{
-					ObjectMeta: metav1.ObjectMeta{
-						Name:      "verrazzano-prom-internal",
-						Namespace: "verrazzano-system",
-					},
-					Data: map[string][]byte{
-						"password": []byte("blah di blah"),
-					},
-				},
-				&v1.Secret{
-					ObjectMeta: metav1.ObjectMeta{
-						Name:      "verrazzano-es-internal",
-						Namespace: "verrazzano-system",
-					},
-					Data: map[string][]byte{
-						"password": []byte("blah di blah"),
-					},
-				}).Build(),
-			"blahblah'id",
+			fake.NewClientBuilder().WithScheme(k8scheme.Scheme).WithObjects(loginSecret, keycloakPod, vzSecret, promSecret, esSecret).Build(),
 			true,
 			"services \"ingress-controller-ingress-nginx-controller\" not found",
-			fakeConfigureRealmCommands,
-			defaultBashFunc,
+			"",
+			"",
 		},
 		{
-			"bashFunc fails during updateKeycloakURIs",
-			fake.NewClientBuilder().WithScheme(k8scheme.Scheme).WithObjects(loginSecret, nginxService, keycloakPod,
-				&v1.Secret{
-					ObjectMeta: metav1.ObjectMeta{
-						Name:      "verrazzano",
-						Namespace: "verrazzano-system",
-					},
-					Data: map[string][]byte{
-						"password": []byte("blah di blah"),
-					},
-				},
-				&v1.Secret{
-					ObjectMeta: metav1.ObjectMeta{
-						Name:      "verrazzano-prom-internal",
-						Namespace: "verrazzano-system",
-					},
-					Data: map[string][]byte{
-						"password": []byte("blah di blah"),
-					},
-				},
-				&v1.Secret{
-					ObjectMeta: metav1.ObjectMeta{
-						Name:      "verrazzano-es-internal",
-						Namespace: "verrazzano-system",
-					},
-					Data: map[string][]byte{
-						"password": []byte("blah di blah"),
-					},
-				}).Build(),
-			"blahblah'id",
+			"should fail when Keycloak fails updating the realm",
+			fake.NewClientBuilder().WithScheme(k8scheme.Scheme).WithObjects(loginSecret, nginxService, keycloakPod, vzSecret, promSecret, esSecret).Build(),
 			true,
-			"updateKeycloakURIs failed",
-			fakeConfigureRealmCommands,
-			func(inArgs ...string) (string, string, error) {
-				return "", "Command failed", fmt.Errorf("updateKeycloakURIs failed")
-			},
+			"returned status code 503",
+			http.MethodPut,
+			"/admin/realms/master",
 		},
 		{
-			"should pass when able to successfully exec commands on the keycloak pod and all k8s objects are present",
-			fake.NewClientBuilder().WithScheme(k8scheme.Scheme).WithObjects(loginSecret, nginxService, keycloakPod,
-				&v1.Secret{
-					ObjectMeta: metav1.ObjectMeta{
-						Name:      "verrazzano",
-						Namespace: "verrazzano-system",
-					},
-					Data: map[string][]byte{
-						"password": []byte("blah di blah"),
-					},
-				},
-				&v1.Secret{
-					ObjectMeta: metav1.ObjectMeta{
-						Name:      "verrazzano-prom-internal",
-						Namespace: "verrazzano-system",
-					},
-					Data: map[string][]byte{
-						"password": []byte("blah di blah"),
-					},
-				},
-				&v1.Secret{
-					ObjectMeta: metav1.ObjectMeta{
-						Name:      "verrazzano-es-internal",
-						Namespace: "verrazzano-system",
-					},
-					Data: map[string][]byte{
-						"password": []byte("blah di blah"),
-					},
-				}).Build(),
-			"blahblah'id",
+			"should pass when Keycloak accepts the configuration and all k8s objects are present",
+			fake.NewClientBuilder().WithScheme(k8scheme.Scheme).WithObjects(loginSecret, nginxService, keycloakPod, vzSecret, promSecret, esSecret).Build(),
 			false,
 			"",
-			fakeConfigureRealmCommands,
-			defaultBashFunc,
+			"",
+			"",
 		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
+			server := newKeycloakServer()
+			defer server.Close()
+			defer httpClient.Reset()
+			if tt.failMethod != "" {
+				code := http.StatusInternalServerError
+				if tt.failMethod == http.MethodPut {
+					code = http.StatusServiceUnavailable
+				}
+				server.FailRequests(tt.failMethod, tt.failPath, code)
+			}
 			ctx := spi.NewFakeContext(tt.c, testVZ, false)
-			execCommand = tt.execFunc
-			bashFunc = tt.bashFunc
-			defer func() { bashFunc = vzos.RunBash }()
-			k8sutilfake.PodSTDOUT = tt.stdout
 			err := configureKeycloakRealms(ctx)
 			if tt.isErr {
 				assert.Error(t, err)
@@ -571,6 +236,130 @@ func TestConfigureKeycloakRealms(t *testing.T) {
 	}
 }
 
+// TestConfigureKeycloakRealmsContent tests the content of the Keycloak realms
+// GIVEN a Keycloak server and a k8s environment
+// WHEN I call configureKeycloakRealms twice, the second time with a different DNS domain
+// THEN the Verrazzano realm, groups, role, users and clients are created once, and the redirect URIs of the
+//      verrazzano-pkce client are updated to the new DNS domain
+func TestConfigureKeycloakRealmsContent(t *testing.T) {
+	asserts := assert.New(t)
+	server := newKeycloakServer()
+	defer server.Close()
+	defer httpClient.Reset()
+	c := fake.NewClientBuilder().WithScheme(k8scheme.Scheme).WithObjects(createTestLoginSecret(), createTestNginxService(), createTestKeycloakPod(),
+		createTestPasswordSecret("verrazzano", "vzpw"),
+		createTestPasswordSecret("verrazzano-prom-internal", "prompw"),
+		createTestPasswordSecret("verrazzano-es-internal", "espw")).Build()
+
+	asserts.NoError(configureKeycloakRealms(spi.NewFakeContext(c, testVZ, false)))
+	vz := testVZ.DeepCopy()
+	vz.Spec.EnvironmentName = "other"
+	asserts.NoError(configureKeycloakRealms(spi.NewFakeContext(c, vz, false)))
+
+	realm := server.Realm(vzSysRealm)
+	asserts.NotNil(realm)
+	asserts.True(*realm.Enabled)
+	asserts.Equal(passwordPolicy, realm.PasswordPolicy)
+	asserts.Equal(loginTheme, realm.LoginTheme)
+	asserts.Equal(loginTheme, server.Realm(keycloak.MasterRealm).LoginTheme)
+	asserts.Equal("vzpw", server.Password(vzSysRealm, vzUserName))
+	asserts.Equal("prompw", server.Password(vzSysRealm, vzInternalPromUser))
+	asserts.Equal("espw", server.Password(vzSysRealm, vzInternalEsUser))
+
+	kc := keycloak.NewAdminClient(server.BaseURL(), server.Client())
+	asserts.NoError(kc.Login(keycloakAdminUser, "password"))
+	groups, err := kc.GetGroups(vzSysRealm)
+	asserts.NoError(err)
+	asserts.Len(groups, 1)
+	asserts.Equal(vzUsersGroup, groups[0].Name)
+	asserts.Len(groups[0].SubGroups, 3)
+	roles, err := kc.GetGroupRealmRoles(vzSysRealm, groups[0].ID)
+	asserts.NoError(err)
+	asserts.Len(roles, 1)
+	asserts.Equal(vzAPIAccessRole, roles[0].Name)
+
+	clients, err := kc.GetClients(vzSysRealm, "")
+	asserts.NoError(err)
+	asserts.Len(clients, 2)
+	pkce, err := kc.GetClients(vzSysRealm, "verrazzano-pkce")
+	asserts.NoError(err)
+	asserts.Len(pkce, 1)
+	asserts.Contains(pkce[0].RedirectURIs, "https://verrazzano.other.192.132.111.122.nip.io/*")
+	asserts.Contains(pkce[0].WebOrigins, "https://kiali.vmi.system.other.192.132.111.122.nip.io")
+}
+
+// TestConfigureKeycloakRealmsResetsPasswords tests the configureKeycloakRealms function
+// GIVEN Keycloak with the Verrazzano users whose passwords do not match their secrets, as after a restore
+// WHEN I call configureKeycloakRealms
+// THEN the passwords of the users are reset from their secrets
+func TestConfigureKeycloakRealmsResetsPasswords(t *testing.T) {
+	asserts := assert.New(t)
+	server := newKeycloakServer()
+	defer server.Close()
+	defer httpClient.Reset()
+	c := fake.NewClientBuilder().WithScheme(k8scheme.Scheme).WithObjects(createTestLoginSecret(), createTestNginxService(), createTestKeycloakPod(),
+		createTestPasswordSecret("verrazzano", "vzpw"),
+		createTestPasswordSecret("verrazzano-prom-internal", "prompw"),
+		createTestPasswordSecret("verrazzano-es-internal", "espw")).Build()
+	asserts.NoError(configureKeycloakRealms(spi.NewFakeContext(c, testVZ, false)))
+
+	kc := keycloak.NewAdminClient(server.BaseURL(), server.Client())
+	asserts.NoError(kc.Login(keycloakAdminUser, "password"))
+	for _, userName := range []string{vzUserName, vzInternalPromUser, vzInternalEsUser} {
+		users, err := kc.GetUsers(vzSysRealm, userName)
+		asserts.NoError(err)
+		asserts.Len(users, 1)
+		asserts.NoError(kc.ResetPassword(vzSysRealm, users[0].ID, "restored"))
+	}
+
+	asserts.NoError(configureKeycloakRealms(spi.NewFakeContext(c, testVZ, false)))
+	asserts.Equal("vzpw", server.Password(vzSysRealm, vzUserName))
+	asserts.Equal("prompw", server.Password(vzSysRealm, vzInternalPromUser))
+	asserts.Equal("espw", server.Password(vzSysRealm, vzInternalEsUser))
+}
+
+// TestConfigureKeycloakRealmsRecyclePod tests the recovery from a lost Keycloak configuration
+// GIVEN Keycloak with ephemeral MySQL storage that rejects the admin credentials
+// WHEN I call configureKeycloakRealms
+// THEN an error is returned and the Keycloak pod is deleted
+func TestConfigureKeycloakRealmsRecyclePod(t *testing.T) {
+	server := newKeycloakServer()
+	defer server.Close()
+	defer httpClient.Reset()
+	loginSecret := createTestLoginSecret()
+	loginSecret.Data["password"] = []byte("lost")
+	c := fake.NewClientBuilder().WithScheme(k8scheme.Scheme).WithObjects(loginSecret, createTestKeycloakPod()).Build()
+
+	err := configureKeycloakRealms(spi.NewFakeContext(c, testVZ, false))
+	assert.Error(t, err)
+	err = c.Get(context.TODO(), types.NamespacedName{Namespace: ComponentNamespace, Name: keycloakPodName}, &v1.Pod{})
+	assert.True(t, k8serrors.IsNotFound(err))
+}
+
+// TestGetKeycloakHTTPClient tests the HTTP client used for the Keycloak admin REST API
+// GIVEN the Keycloak TLS secret with a CA certificate
+// WHEN I call getKeycloakHTTPClient
+// THEN the URL of the Keycloak ingress and a client that trusts the CA are returned
+func TestGetKeycloakHTTPClient(t *testing.T) {
+	asserts := assert.New(t)
+	tlsServer := httptest.NewTLSServer(nil)
+	defer tlsServer.Close()
+	caCert := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: tlsServer.Certificate().Raw})
+	tlsSecret := &v1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: keycloakCertificateName, Namespace: ComponentNamespace},
+		Data:       map[string][]byte{"ca.crt": caCert},
+	}
+	vz := testVZ.DeepCopy()
+	vz.Spec.EnvironmentName = "test-env"
+	c := fake.NewClientBuilder().WithScheme(k8scheme.Scheme).WithObjects(createTestNginxService(), tlsSecret).Build()
+
+	baseURL, hc, err := getKeycloakHTTPClient(spi.NewFakeContext(c, vz, false))
+	asserts.NoError(err)
+	asserts.Equal("https://"+testKeycloakIngressHost+"/auth", baseURL)
+	asserts.Equal(keycloakRequestTimeout, hc.Timeout)
+	asserts.NotNil(hc.Transport.(*http.Transport).TLSClientConfig.RootCAs)
+}
+
 // TestAppendKeycloakOverrides tests that the Keycloak overrides are generated correctly.
 // GIVEN a Verrazzano BOM
 // WHEN I call AppendKeycloakOverrides
@@ -661,13 +450,16 @@ func TestGetEnvironmentName(t *testing.T) {
 // TestLoginKeycloak tests the login to keycloak interacts with k8s resources as expected
 // GIVEN a client
 // WHEN I call loginKeycloak
-// THEN throw an error if the k8s environment is invalid (bad secret)
+// THEN throw an error if the k8s environment is invalid (bad secret) or Keycloak rejects the password
 func TestLoginKeycloak(t *testing.T) {
 	httpSecret := createTestLoginSecret()
 	httpSecretEmptyPassword := createTestLoginSecret()
 	httpSecretEmptyPassword.Data["password"] = []byte("")
-	cfg, restclient, _ := fakeRESTConfig()
-	k8sutil.NewPodExecutor = k8sutilfake.NewPodExecutor
+	httpSecretWrongPassword := createTestLoginSecret()
+	httpSecretWrongPassword.Data["password"] = []byte("wrong")
+	server := newKeycloakServer()
+	defer server.Close()
+	defer httpClient.Reset()
 
 	var tests = []struct {
 		name  string
@@ -684,6 +476,11 @@ func TestLoginKeycloak(t *testing.T) {
 			fake.NewClientBuilder().WithScheme(k8scheme.Scheme).WithObjects(httpSecretEmptyPassword).Build(),
 			true,
 		},
+		{
+			"should fail when Keycloak rejects the password",
+			fake.NewClientBuilder().WithScheme(k8scheme.Scheme).WithObjects(httpSecretWrongPassword).Build(),
+			true,
+		},
 		{
 			"should log into keycloak when the password is present",
 			fake.NewClientBuilder().WithScheme(k8scheme.Scheme).WithObjects(httpSecret).Build(),
@@ -693,12 +490,12 @@ func TestLoginKeycloak(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			k8sutilfake.PodSTDOUT = "blah"
-			err := loginKeycloak(spi.NewFakeContext(tt.c, testVZ, false), cfg, restclient)
+			kc, err := loginKeycloak(spi.NewFakeContext(tt.c, testVZ, false))
 			if tt.isErr {
 				assert.Error(t, err)
 			} else {
 				assert.NoError(t, err)
+				assert.NotNil(t, kc)
 			}
 		})
 	}
@@ -800,369 +597,6 @@ func getBoolPtr(b bool) *bool {
 	return &b
 }
 
-// TestClientExists tests that the function returns false/true whether client exists
-// GIVEN an array of keycloak Clients
-// WHEN I call clientExists
-// THEN return true/false whether the client exists in the array of clients
-func TestClientExists(t *testing.T) {
-	var tests = []struct {
-		name string
-		in   KeycloakClients
-		out  bool
-	}{
-		{"testEmptyClients",
-			KeycloakClients{},
-			false,
-		},
-		{"testClientNotFound",
-			KeycloakClients{
-				{
-					"973973",
-					"thisClient",
-				},
-				{
-					"973974",
-					"thatClient",
-				},
-			},
-			false,
-		},
-		{"testClientFound",
-			KeycloakClients{
-				{
-					"973973",
-					"thisClient",
-				},
-				{
-					"973974",
-					"thatClient",
-				},
-				{
-					"973974",
-					"someClient",
-				},
-			},
-			true,
-		},
-	}
-
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			assert.Equal(t, tt.out, clientExists(tt.in, "someClient"))
-		})
-	}
-}
-
-// TestUserExists tests that the function returns false/true whether user exists
-// GIVEN an array of keycloak Users
-// WHEN I call userExists
-// THEN return true/false whether the user exists in the array of users
-func TestUserExists(t *testing.T) {
-	var tests = []struct {
-		name string
-		in   KeycloakUsers
-		out  bool
-	}{
-		{"testEmptyUsers",
-			KeycloakUsers{},
-			false,
-		},
-		{"testUserNotFound",
-			KeycloakUsers{
-				{
-					ID:       "955995",
-					Username: "thisUser",
-				},
-				{
-					ID:       "955996",
-					Username: "thatUser",
-				},
-			},
-			false,
-		},
-		{"testUserFound",
-			KeycloakUsers{
-				{
-					ID:       "955995",
-					Username: "thisUser",
-				},
-				{
-					ID:       "955996",
-					Username: "thatUser",
-				},
-				{
-					ID:       "955997",
-					Username: "someUser",
-				},
-			},
-			true,
-		},
-	}
-
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			assert.Equal(t, tt.out, userExists(tt.in, "someUser"))
-		})
-	}
-}
-
-// TestRoleExists tests that the function returns false/true whether role exists
-// GIVEN an array of keycloak Roles
-// WHEN I call roleExists
-// THEN return true/false whether the role exists in the array of roles
-func TestRoleExists(t *testing.T) {
-	var tests = []struct {
-		name string
-		in   KeycloakRoles
-		out  bool
-	}{
-		{"testEmptyRoles",
-			KeycloakRoles{},
-			false,
-		},
-		{"testRoleNotFound",
-			KeycloakRoles{
-				{
-					ID:   "955995",
-					Name: "thisRole",
-				},
-				{
-					ID:   "955996",
-					Name: "thatRole",
-				},
-			},
-			false,
-		},
-		{"testRoleFound",
-			KeycloakRoles{
-				{
-					ID:   "955995",
-					Name: "thisRole",
-				},
-				{
-					ID:   "955996",
-					Name: "thatRole",
-				},
-				{
-					ID:   "955997",
-					Name: "someRole",
-				},
-			},
-			true,
-		},
-	}
-
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			assert.Equal(t, tt.out, roleExists(tt.in, "someRole"))
-		})
-	}
-}
-
-// TestGroupExists tests that the function returns false/true whether group exists
-// GIVEN an array of keycloak Groups
-// WHEN I call groupExists
-// THEN return true/false whether the group exists in the Keycloak Group structure
-func TestGroupExists(t *testing.T) {
-
-	var (
-		tests = []struct {
-			name string
-			in   KeycloakGroups
-			out  bool
-		}{
-			{"testEmptyGroups",
-				KeycloakGroups{},
-				false,
-			},
-			{"testGroupNotFound",
-				KeycloakGroups{
-					{
-						ID:        "955995",
-						Name:      "thisGroup",
-						SubGroups: nil,
-					},
-					{
-						ID:   "",
-						Name: "",
-						SubGroups: []SubGroup{
-							{
-								ID:   "333333",
-								Name: "subGroup1",
-							},
-							{
-								ID:   "444444",
-								Name: "subGroup2",
-							},
-						},
-					},
-				},
-				false,
-			},
-			{"testGroupFound",
-				KeycloakGroups{
-					{
-						ID:        "955995",
-						Name:      "foundGroup",
-						SubGroups: nil,
-					},
-					{
-						ID:   "",
-						Name: "",
-						SubGroups: []SubGroup{
-							{
-								ID:   "333333",
-								Name: "subGroup1",
-							},
-							{
-								ID:   "444444",
-								Name: "subGroup2",
-							},
-						},
-					},
-				},
-				true,
-			},
-			{"testSubGroupFound",
-				KeycloakGroups{
-					{
-						ID:        "955995",
-						Name:      "someGroup",
-						SubGroups: nil,
-					},
-					{
-						ID:   "",
-						Name: "",
-						SubGroups: []SubGroup{
-							{
-								ID:   "333333",
-								Name: "subGroup1",
-							},
-							{
-								ID:   "444444",
-								Name: "subGroup2",
-							},
-							{
-								ID:   "555555",
-								Name: "foundGroup",
-							},
-						},
-					},
-				},
-				true,
-			},
-		}
-	)
-
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			assert.Equal(t, tt.out, groupExists(tt.in, "foundGroup"))
-		})
-	}
-}
-
-// TestGetGroupID tests that the function returns the correct groupID for the group name
-// GIVEN an array of keycloak Groups
-// WHEN I call getGroupID
-// THEN return the groupID for the GroupName passed in, empty string for not found
-func TestGetGroupID(t *testing.T) {
-
-	var (
-		tests = []struct {
-			name string
-			in   KeycloakGroups
-			out  string
-		}{
-			{"testEmptyGroups",
-				KeycloakGroups{},
-				"",
-			},
-			{"testGroupIDNotFound",
-				KeycloakGroups{
-					{
-						ID:        "955995",
-						Name:      "thisGroup",
-						SubGroups: nil,
-					},
-					{
-						ID:   "",
-						Name: "",
-						SubGroups: []SubGroup{
-							{
-								ID:   "333333",
-								Name: "subGroup1",
-							},
-							{
-								ID:   "444444",
-								Name: "subGroup2",
-							},
-						},
-					},
-				},
-				"",
-			},
-			{"testGroupIDFound",
-				KeycloakGroups{
-					{
-						ID:        "999999",
-						Name:      "foundGroup",
-						SubGroups: nil,
-					},
-					{
-						ID:   "",
-						Name: "",
-						SubGroups: []SubGroup{
-							{
-								ID:   "333333",
-								Name: "subGroup1",
-							},
-							{
-								ID:   "444444",
-								Name: "subGroup2",
-							},
-						},
-					},
-				},
-				"999999",
-			},
-			{"testSubGroupIDFound",
-				KeycloakGroups{
-					{
-						ID:        "955995",
-						Name:      "someGroup",
-						SubGroups: nil,
-					},
-					{
-						ID:   "",
-						Name: "",
-						SubGroups: []SubGroup{
-							{
-								ID:   "333333",
-								Name: "subGroup1",
-							},
-							{
-								ID:   "444444",
-								Name: "subGroup2",
-							},
-							{
-								ID:   "999999",
-								Name: "foundGroup",
-							},
-						},
-					},
-				},
-				"999999",
-			},
-		}
-	)
-
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			assert.Equal(t, tt.out, getGroupID(tt.in, "foundGroup"))
-		})
-	}
-}
-
 func TestUpdateKeycloakIngress(t *testing.T) {
 	ingress := &networkv1.Ingress{
 		ObjectMeta: metav1.ObjectMeta{Name: "keycloak", Namespace: "keycloak"},
@@ -5,7 +5,6 @@ package kiali
 
 import (
 	"context"
-	"fmt"
 	"path/filepath"
 
 	vzapi "github.com/verrazzano/verrazzano/platform-operator/apis/verrazzano/v1alpha1"
@@ -68,6 +67,8 @@ func NewComponent() spi.Component {
 				},
 			},
 			GetInstallOverridesFunc: GetOverrides,
+			UserResourceCRDs:        []string{"monitoringdashboards.monitoring.kiali.io"},
+			OwnedSecrets:            []types.NamespacedName{{Namespace: ComponentNamespace, Name: "system-tls-kiali"}},
 		},
 	}
 }
@@ -163,10 +164,6 @@ func (c kialiComponent) createOrUpdateKialiResources(ctx spi.ComponentContext) e
 
 // ValidateUpdate checks if the specified new Verrazzano CR is valid for this component to be updated
 func (c kialiComponent) ValidateUpdate(old *vzapi.Verrazzano, new *vzapi.Verrazzano) error {
-	// Do not allow any changes except to enable the component post-install
-	if c.IsEnabled(old) && !c.IsEnabled(new) {
-		return fmt.Errorf("Disabling component %s is not allowed", ComponentJSONName)
-	}
 	return c.HelmComponent.ValidateUpdate(old, new)
 }
 
@@ -0,0 +1,551 @@
+// Copyright (c) 2022, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package mysql
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/verrazzano/verrazzano/pkg/bom"
+	ctrlerrors "github.com/verrazzano/verrazzano/pkg/controller/errors"
+	vzapi "github.com/verrazzano/verrazzano/platform-operator/apis/verrazzano/v1alpha1"
+	vzconst "github.com/verrazzano/verrazzano/platform-operator/constants"
+	"github.com/verrazzano/verrazzano/platform-operator/controllers/verrazzano/component/common"
+	"github.com/verrazzano/verrazzano/platform-operator/controllers/verrazzano/component/spi"
+	"github.com/verrazzano/verrazzano/platform-operator/controllers/verrazzano/secret"
+	"github.com/verrazzano/verrazzano/platform-operator/internal/config"
+	"golang.org/x/crypto/pbkdf2"
+	batchv1 "k8s.io/api/batch/v1"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	controllerruntime "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	backupName               = "mysql-backup"
+	restoreJobName           = "mysql-restore"
+	backupS3SecretName       = "mysql-backup-s3"
+	backupClientSubcomponent = "mysql-backup-client"
+	backupVolumeName         = "backup"
+	backupMountPath          = "/backup"
+	backupNameLabel          = "app.kubernetes.io/name"
+	defaultBackupSchedule    = "0 2 * * *"
+	defaultBackupRetention   = 7
+	s3AccessKey              = "accessKey"
+	s3SecretKey              = "secretKey"
+
+	// keycloakComponentName is the name of the Keycloak component, whose data is restored before it is first installed
+	keycloakComponentName = "keycloak"
+
+	// istioProxyConfigAnnotation makes the backup containers wait for the Istio proxy, the database is only reachable
+	// through the mesh
+	istioProxyConfigAnnotation = "proxy.istio.io/config"
+	istioProxyConfig           = `{ "holdApplicationUntilProxyStarts": true }`
+
+	// keycloakAdminCredentialSecret holds the Keycloak credential of the current password of the Keycloak
+	// administrator, the restore writes it over the password of the cluster the backup was taken from
+	keycloakAdminCredentialSecret = "mysql-restore-keycloak-admin"
+	secretDataKey                 = "secretData"
+	credentialDataKey             = "credentialData"
+
+	// The Keycloak defaults for hashing passwords
+	keycloakHashAlgorithm  = "pbkdf2-sha256"
+	keycloakHashIterations = 27500
+	keycloakHashLength     = 64
+	keycloakSaltLength     = 16
+)
+
+// keycloakSecretData is the SECRET_DATA column of a Keycloak password credential
+type keycloakSecretData struct {
+	Value                string            `json:"value"`
+	Salt                 string            `json:"salt"`
+	AdditionalParameters map[string]string `json:"additionalParameters"`
+}
+
+// keycloakCredentialData is the CREDENTIAL_DATA column of a Keycloak password credential
+type keycloakCredentialData struct {
+	HashIterations       int               `json:"hashIterations"`
+	Algorithm            string            `json:"algorithm"`
+	AdditionalParameters map[string]string `json:"additionalParameters"`
+}
+
+// scriptHeader makes the backup and restore scripts fail on the first error
+const scriptHeader = `set -eo pipefail
+`
+
+// stopProxyTrap stops the Istio proxy when the script exits, so that the job can complete
+const stopProxyTrap = `trap 'rc=$?; curl -fsS -X POST http://127.0.0.1:15020/quitquitquit >/dev/null 2>&1 || true; exit $rc' EXIT
+`
+
+// markFailedTrap also tells the bucket client container that the script failed, so that it stops waiting
+const markFailedTrap = `trap 'rc=$?; [ $rc -ne 0 ] && touch ` + backupMountPath + `/.failed; curl -fsS -X POST http://127.0.0.1:15020/quitquitquit >/dev/null 2>&1 || true; exit $rc' EXIT
+`
+
+// dumpScript writes a compressed logical dump of the Keycloak database to the backup volume
+const dumpScript = `name="keycloak-$(date -u +%Y%m%d%H%M%S).sql.gz"
+mysqldump --single-transaction --routines --triggers -h mysql -u root -p"${MYSQL_ROOT_PASSWORD}" --databases keycloak | gzip > "` + backupMountPath + `/${name}.tmp"
+mv "` + backupMountPath + `/${name}.tmp" "` + backupMountPath + `/${name}"
+echo "Created backup ${name}"
+`
+
+// pruneScript deletes the oldest backups from the persistent volume claim
+const pruneScript = `ls -1t ` + backupMountPath + `/keycloak-*.sql.gz | tail -n +$((RETENTION_COUNT+1)) | xargs -r rm -f
+`
+
+// waitForUploadScript waits until the backup is uploaded to the bucket
+const waitForUploadScript = `touch ` + backupMountPath + `/.dumped
+while [ ! -f ` + backupMountPath + `/.uploaded ]; do [ -f ` + backupMountPath + `/.failed ] && exit 1; sleep 2; done
+`
+
+// uploadScript uploads the backup to the bucket once it is written
+const uploadScript = `while [ ! -f ` + backupMountPath + `/.dumped ]; do [ -f ` + backupMountPath + `/.failed ] && exit 1; sleep 2; done
+mc --config-dir /tmp/mc alias set backup "${S3_ENDPOINT}" "${S3_ACCESS_KEY}" "${S3_SECRET_KEY}" >/dev/null &&
+  mc --config-dir /tmp/mc cp ` + backupMountPath + `/keycloak-*.sql.gz "backup/${S3_BUCKET}/${S3_PREFIX}" &&
+  touch ` + backupMountPath + `/.uploaded || { touch ` + backupMountPath + `/.failed; exit 1; }
+`
+
+// downloadScript downloads the backup that is restored from the bucket
+const downloadScript = `mc --config-dir /tmp/mc alias set backup "${S3_ENDPOINT}" "${S3_ACCESS_KEY}" "${S3_SECRET_KEY}" >/dev/null &&
+  mc --config-dir /tmp/mc cp "backup/${S3_BUCKET}/${S3_PREFIX}${BACKUP_NAME}" ` + backupMountPath + `/ &&
+  touch ` + backupMountPath + `/.downloaded || { touch ` + backupMountPath + `/.failed; exit 1; }
+`
+
+// waitForDownloadScript waits until the backup is downloaded from the bucket
+const waitForDownloadScript = `while [ ! -f ` + backupMountPath + `/.downloaded ]; do [ -f ` + backupMountPath + `/.failed ] && exit 1; sleep 2; done
+`
+
+// restoreScript loads the dump into the Keycloak database
+const restoreScript = `gunzip -c "` + backupMountPath + `/${BACKUP_NAME}" | mysql -h mysql -u root -p"${MYSQL_ROOT_PASSWORD}"
+echo "Restored backup ${BACKUP_NAME}"
+`
+
+// resetKeycloakAdminScript sets the password of the Keycloak administrator in the restored database to the password
+// in the secret of this cluster, Keycloak does not change the password of an administrator that already exists.  The
+// passwords of the Verrazzano users are reset from their secrets when Keycloak is configured after the install.
+const resetKeycloakAdminScript = `mysql -h mysql -u root -p"${MYSQL_ROOT_PASSWORD}" keycloak -e "UPDATE CREDENTIAL c
+  JOIN USER_ENTITY u ON c.USER_ID = u.ID JOIN REALM r ON u.REALM_ID = r.ID
+  SET c.SECRET_DATA = '${KEYCLOAK_ADMIN_SECRET_DATA}', c.CREDENTIAL_DATA = '${KEYCLOAK_ADMIN_CREDENTIAL_DATA}'
+  WHERE r.NAME = 'master' AND u.USERNAME = '${KEYCLOAK_ADMIN_USER}' AND c.TYPE = 'password'"
+echo "Reset the password of Keycloak user ${KEYCLOAK_ADMIN_USER}"
+`
+
+// getBackupSpec returns the backup configuration of the Keycloak database, or nil if there is none
+func getBackupSpec(cr *vzapi.Verrazzano) *vzapi.MySQLBackupSpec {
+	if cr.Spec.Components.Keycloak == nil {
+		return nil
+	}
+	return cr.Spec.Components.Keycloak.MySQL.Backup
+}
+
+// reconcileBackup creates or updates the CronJob that backs up the Keycloak database on a schedule, or deletes it
+// when the backups are turned off
+func reconcileBackup(ctx spi.ComponentContext) error {
+	backup := getBackupSpec(ctx.EffectiveCR())
+	cronJob := &batchv1.CronJob{ObjectMeta: metav1.ObjectMeta{Name: backupName, Namespace: ComponentNamespace}}
+	if backup == nil || !backup.Enabled {
+		if err := ctx.Client().Delete(context.TODO(), cronJob); err != nil && !errors.IsNotFound(err) {
+			return ctx.Log().ErrorfNewErr("Failed deleting the MySQL backup CronJob: %v", err)
+		}
+		return nil
+	}
+
+	podSpec, err := buildBackupPodSpec(ctx, backup)
+	if err != nil {
+		return err
+	}
+	schedule := backup.Schedule
+	if schedule == "" {
+		schedule = defaultBackupSchedule
+	}
+	_, err = controllerruntime.CreateOrUpdate(context.TODO(), ctx.Client(), cronJob, func() error {
+		cronJob.Labels = map[string]string{backupNameLabel: backupName}
+		cronJob.Spec.Schedule = schedule
+		cronJob.Spec.ConcurrencyPolicy = batchv1.ForbidConcurrent
+		cronJob.Spec.JobTemplate.Spec.Template.ObjectMeta = backupPodMeta()
+		cronJob.Spec.JobTemplate.Spec.Template.Spec = podSpec
+		return nil
+	})
+	if err != nil {
+		return ctx.Log().ErrorfNewErr("Failed creating or updating the MySQL backup CronJob: %v", err)
+	}
+	ctx.Log().Oncef("Component %s scheduled the Keycloak database backups with schedule %s", ComponentName, schedule)
+	return nil
+}
+
+// restoreBackup restores the configured backup of the Keycloak database before Keycloak is first installed.  A
+// retryable error is returned until the restore job completes.
+func restoreBackup(ctx spi.ComponentContext) error {
+	backup := getBackupSpec(ctx.EffectiveCR())
+	if backup == nil || backup.RestoreBackupName == "" || isKeycloakInstalled(ctx.ActualCR()) {
+		return nil
+	}
+
+	job := &batchv1.Job{}
+	err := ctx.Client().Get(context.TODO(), types.NamespacedName{Namespace: ComponentNamespace, Name: restoreJobName}, job)
+	if errors.IsNotFound(err) {
+		if err := createKeycloakAdminCredentialSecret(ctx); err != nil {
+			return err
+		}
+		podSpec, err := buildRestorePodSpec(ctx, backup)
+		if err != nil {
+			return err
+		}
+		backoffLimit := int32(2)
+		job = &batchv1.Job{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      restoreJobName,
+				Namespace: ComponentNamespace,
+				Labels:    map[string]string{backupNameLabel: backupName},
+			},
+			Spec: batchv1.JobSpec{
+				BackoffLimit: &backoffLimit,
+				Template: v1.PodTemplateSpec{
+					ObjectMeta: backupPodMeta(),
+					Spec:       podSpec,
+				},
+			},
+		}
+		if err := ctx.Client().Create(context.TODO(), job); err != nil {
+			return ctx.Log().ErrorfNewErr("Failed creating the MySQL restore job: %v", err)
+		}
+		ctx.Log().Progressf("Component %s started restoring backup %s", ComponentName, backup.RestoreBackupName)
+		return ctrlerrors.RetryableError{Source: ComponentName}
+	}
+	if err != nil {
+		return ctx.Log().ErrorfNewErr("Failed getting the MySQL restore job: %v", err)
+	}
+
+	for _, condition := range job.Status.Conditions {
+		if condition.Status != v1.ConditionTrue {
+			continue
+		}
+		switch condition.Type {
+		case batchv1.JobComplete:
+			ctx.Log().Oncef("Component %s restored backup %s", ComponentName, backup.RestoreBackupName)
+			return deleteKeycloakAdminCredentialSecret(ctx)
+		case batchv1.JobFailed:
+			return ctx.Log().ErrorfNewErr("Failed restoring MySQL backup %s, delete job %s/%s to retry: %s",
+				backup.RestoreBackupName, ComponentNamespace, restoreJobName, condition.Message)
+		}
+	}
+	ctx.Log().Progressf("Component %s waiting for the restore of backup %s", ComponentName, backup.RestoreBackupName)
+	return ctrlerrors.RetryableError{Source: ComponentName}
+}
+
+// isKeycloakInstalled returns true if Keycloak has been installed, its data must not be replaced by a backup then
+func isKeycloakInstalled(cr *vzapi.Verrazzano) bool {
+	compStatus, ok := cr.Status.Components[keycloakComponentName]
+	return ok && compStatus.LastReconciledGeneration > 0
+}
+
+// buildBackupPodSpec returns the spec of the pod that writes a backup to the persistent volume claim, or writes it
+// to a temporary volume and uploads it to the bucket
+func buildBackupPodSpec(ctx spi.ComponentContext, backup *vzapi.MySQLBackupSpec) (v1.PodSpec, error) {
+	mysqlImage, err := getBackupImage(ComponentName)
+	if err != nil {
+		return v1.PodSpec{}, err
+	}
+	dump := v1.Container{
+		Name:    "dump",
+		Image:   mysqlImage,
+		Command: []string{"/bin/bash", "-c"},
+		Env:     []v1.EnvVar{rootPasswordEnv()},
+	}
+	if backup.S3 == nil {
+		retention := int32(defaultBackupRetention)
+		if backup.RetentionCount != nil {
+			retention = *backup.RetentionCount
+		}
+		dump.Args = []string{scriptHeader + stopProxyTrap + dumpScript + pruneScript}
+		dump.Env = append(dump.Env, v1.EnvVar{Name: "RETENTION_COUNT", Value: strconv.Itoa(int(retention))})
+		return newBackupPodSpec(ctx, backup, dump)
+	}
+
+	clientImage, err := getBackupImage(backupClientSubcomponent)
+	if err != nil {
+		return v1.PodSpec{}, err
+	}
+	if err := copyS3Secret(ctx, backup.S3.CredentialsSecret); err != nil {
+		return v1.PodSpec{}, err
+	}
+	dump.Args = []string{scriptHeader + markFailedTrap + dumpScript + waitForUploadScript}
+	upload := v1.Container{
+		Name:    "upload",
+		Image:   clientImage,
+		Command: []string{"/bin/bash", "-c"},
+		Args:    []string{uploadScript},
+		Env:     s3Env(backup.S3),
+	}
+	return newBackupPodSpec(ctx, backup, dump, upload)
+}
+
+// buildRestorePodSpec returns the spec of the pod that restores a backup from the persistent volume claim, or
+// downloads it from the bucket to a temporary volume and restores it
+func buildRestorePodSpec(ctx spi.ComponentContext, backup *vzapi.MySQLBackupSpec) (v1.PodSpec, error) {
+	mysqlImage, err := getBackupImage(ComponentName)
+	if err != nil {
+		return v1.PodSpec{}, err
+	}
+	restore := v1.Container{
+		Name:    "restore",
+		Image:   mysqlImage,
+		Command: []string{"/bin/bash", "-c"},
+		Env: append([]v1.EnvVar{rootPasswordEnv(), {Name: "BACKUP_NAME", Value: backup.RestoreBackupName}},
+			keycloakAdminEnv()...),
+	}
+	if backup.S3 == nil {
+		restore.Args = []string{scriptHeader + stopProxyTrap + restoreScript + resetKeycloakAdminScript}
+		return newBackupPodSpec(ctx, backup, restore)
+	}
+
+	clientImage, err := getBackupImage(backupClientSubcomponent)
+	if err != nil {
+		return v1.PodSpec{}, err
+	}
+	if err := copyS3Secret(ctx, backup.S3.CredentialsSecret); err != nil {
+		return v1.PodSpec{}, err
+	}
+	restore.Args = []string{scriptHeader + stopProxyTrap + waitForDownloadScript + restoreScript + resetKeycloakAdminScript}
+	download := v1.Container{
+		Name:    "download",
+		Image:   clientImage,
+		Command: []string{"/bin/bash", "-c"},
+		Args:    []string{downloadScript},
+		Env:     append(s3Env(backup.S3), v1.EnvVar{Name: "BACKUP_NAME", Value: backup.RestoreBackupName}),
+	}
+	return newBackupPodSpec(ctx, backup, restore, download)
+}
+
+// newBackupPodSpec returns a pod spec with the containers and the backup volume, which is the persistent volume
+// claim, or a temporary volume when the backups are kept in a bucket
+func newBackupPodSpec(ctx spi.ComponentContext, backup *vzapi.MySQLBackupSpec, containers ...v1.Container) (v1.PodSpec, error) {
+	volume := v1.Volume{Name: backupVolumeName}
+	if backup.S3 == nil {
+		volume.PersistentVolumeClaim = &v1.PersistentVolumeClaimVolumeSource{ClaimName: backup.PersistentVolumeClaim}
+	} else {
+		volume.EmptyDir = &v1.EmptyDirVolumeSource{}
+	}
+	for i := range containers {
+		containers[i].ImagePullPolicy = v1.PullIfNotPresent
+		containers[i].VolumeMounts = []v1.VolumeMount{{Name: backupVolumeName, MountPath: backupMountPath}}
+	}
+	podSpec := v1.PodSpec{
+		RestartPolicy: v1.RestartPolicyNever,
+		Containers:    containers,
+		Volumes:       []v1.Volume{volume},
+	}
+	exists, err := secret.CheckImagePullSecret(ctx.Client(), ComponentNamespace)
+	if err != nil {
+		return podSpec, ctx.Log().ErrorfNewErr("Failed copying the global image pull secret to namespace %s: %v", ComponentNamespace, err)
+	}
+	if exists {
+		podSpec.ImagePullSecrets = []v1.LocalObjectReference{{Name: vzconst.GlobalImagePullSecName}}
+	}
+	return podSpec, nil
+}
+
+// backupPodMeta returns the labels and annotations of the backup and restore pods
+func backupPodMeta() metav1.ObjectMeta {
+	return metav1.ObjectMeta{
+		Labels:      map[string]string{backupNameLabel: backupName},
+		Annotations: map[string]string{istioProxyConfigAnnotation: istioProxyConfig},
+	}
+}
+
+// rootPasswordEnv returns the environment variable with the MySQL root password
+func rootPasswordEnv() v1.EnvVar {
+	return v1.EnvVar{
+		Name: "MYSQL_ROOT_PASSWORD",
+		ValueFrom: &v1.EnvVarSource{
+			SecretKeyRef: &v1.SecretKeySelector{
+				LocalObjectReference: v1.LocalObjectReference{Name: secretName},
+				Key:                  mySQLRootKey,
+			},
+		},
+	}
+}
+
+// keycloakAdminEnv returns the environment variables with the user and the credential of the Keycloak administrator
+func keycloakAdminEnv() []v1.EnvVar {
+	secretEnv := func(name string, secretName string, key string) v1.EnvVar {
+		return v1.EnvVar{
+			Name: name,
+			ValueFrom: &v1.EnvVarSource{
+				SecretKeyRef: &v1.SecretKeySelector{
+					LocalObjectReference: v1.LocalObjectReference{Name: secretName},
+					Key:                  key,
+				},
+			},
+		}
+	}
+	return []v1.EnvVar{
+		secretEnv("KEYCLOAK_ADMIN_USER", common.KeycloakAdminSecret, "username"),
+		secretEnv("KEYCLOAK_ADMIN_SECRET_DATA", keycloakAdminCredentialSecret, secretDataKey),
+		secretEnv("KEYCLOAK_ADMIN_CREDENTIAL_DATA", keycloakAdminCredentialSecret, credentialDataKey),
+	}
+}
+
+// createKeycloakAdminCredentialSecret hashes the password of the Keycloak administrator, creating the secret of the
+// administrator if Keycloak is not installed yet, and writes the credential to the secret read by the restore job
+func createKeycloakAdminCredentialSecret(ctx spi.ComponentContext) error {
+	adminSecret, err := common.EnsureKeycloakAdminSecret(ctx.Client())
+	if err != nil {
+		return ctx.Log().ErrorfNewErr("Failed creating the Keycloak admin secret: %v", err)
+	}
+	secretData, credentialData, err := hashKeycloakPassword(string(adminSecret.Data["password"]))
+	if err != nil {
+		return ctx.Log().ErrorfNewErr("Failed hashing the Keycloak admin password: %v", err)
+	}
+	secret := &v1.Secret{ObjectMeta: metav1.ObjectMeta{Namespace: ComponentNamespace, Name: keycloakAdminCredentialSecret}}
+	if _, err := controllerruntime.CreateOrUpdate(context.TODO(), ctx.Client(), secret, func() error {
+		secret.Data = map[string][]byte{
+			secretDataKey:     secretData,
+			credentialDataKey: credentialData,
+		}
+		return nil
+	}); err != nil {
+		return ctx.Log().ErrorfNewErr("Failed creating secret %s/%s: %v", ComponentNamespace, keycloakAdminCredentialSecret, err)
+	}
+	return nil
+}
+
+// deleteKeycloakAdminCredentialSecret deletes the credential of the Keycloak administrator once it is restored
+func deleteKeycloakAdminCredentialSecret(ctx spi.ComponentContext) error {
+	secret := &v1.Secret{ObjectMeta: metav1.ObjectMeta{Namespace: ComponentNamespace, Name: keycloakAdminCredentialSecret}}
+	if err := ctx.Client().Delete(context.TODO(), secret); client.IgnoreNotFound(err) != nil {
+		return ctx.Log().ErrorfNewErr("Failed deleting secret %s/%s: %v", ComponentNamespace, keycloakAdminCredentialSecret, err)
+	}
+	return nil
+}
+
+// hashKeycloakPassword returns the secret data and the credential data of a Keycloak password credential
+func hashKeycloakPassword(password string) ([]byte, []byte, error) {
+	salt := make([]byte, keycloakSaltLength)
+	if _, err := rand.Read(salt); err != nil {
+		return nil, nil, err
+	}
+	hash := pbkdf2.Key([]byte(password), salt, keycloakHashIterations, keycloakHashLength, sha256.New)
+	secretData, err := json.Marshal(keycloakSecretData{
+		Value:                base64.StdEncoding.EncodeToString(hash),
+		Salt:                 base64.StdEncoding.EncodeToString(salt),
+		AdditionalParameters: map[string]string{},
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	credentialData, err := json.Marshal(keycloakCredentialData{
+		HashIterations:       keycloakHashIterations,
+		Algorithm:            keycloakHashAlgorithm,
+		AdditionalParameters: map[string]string{},
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return secretData, credentialData, nil
+}
+
+// s3Env returns the environment variables with the bucket and its credentials
+func s3Env(s3 *vzapi.MySQLBackupS3Spec) []v1.EnvVar {
+	prefix := strings.Trim(s3.Prefix, "/")
+	if prefix != "" {
+		prefix = prefix + "/"
+	}
+	secretEnv := func(name string, key string) v1.EnvVar {
+		return v1.EnvVar{
+			Name: name,
+			ValueFrom: &v1.EnvVarSource{
+				SecretKeyRef: &v1.SecretKeySelector{
+					LocalObjectReference: v1.LocalObjectReference{Name: backupS3SecretName},
+					Key:                  key,
+				},
+			},
+		}
+	}
+	return []v1.EnvVar{
+		{Name: "S3_ENDPOINT", Value: s3.Endpoint},
+		{Name: "S3_BUCKET", Value: s3.Bucket},
+		{Name: "S3_PREFIX", Value: prefix},
+		secretEnv("S3_ACCESS_KEY", s3AccessKey),
+		secretEnv("S3_SECRET_KEY", s3SecretKey),
+	}
+}
+
+// copyS3Secret copies the bucket credentials from the verrazzano-install namespace to the MySQL namespace
+func copyS3Secret(ctx spi.ComponentContext, sourceName string) error {
+	source := v1.Secret{}
+	nsn := types.NamespacedName{Namespace: vzconst.VerrazzanoInstallNamespace, Name: sourceName}
+	if err := ctx.Client().Get(context.TODO(), nsn, &source); err != nil {
+		if errors.IsNotFound(err) {
+			ctx.Log().Progressf("Component %s waiting for the backup credentials secret %s/%s to exist", ComponentName, nsn.Namespace, nsn.Name)
+			return ctrlerrors.RetryableError{Source: ComponentName, Cause: err}
+		}
+		return ctx.Log().ErrorfNewErr("Failed getting the backup credentials secret %s/%s: %v", nsn.Namespace, nsn.Name, err)
+	}
+	target := v1.Secret{ObjectMeta: metav1.ObjectMeta{Name: backupS3SecretName, Namespace: ComponentNamespace}}
+	if _, err := controllerruntime.CreateOrUpdate(context.TODO(), ctx.Client(), &target, func() error {
+		target.Data = map[string][]byte{
+			s3AccessKey: source.Data[s3AccessKey],
+			s3SecretKey: source.Data[s3SecretKey],
+		}
+		return nil
+	}); err != nil {
+		return ctx.Log().ErrorfNewErr("Failed copying the backup credentials secret to namespace %s: %v", ComponentNamespace, err)
+	}
+	return nil
+}
+
+// getBackupImage returns the image of the BOM subcomponent
+func getBackupImage(subcomponent string) (string, error) {
+	bomFile, err := bom.NewBom(config.GetDefaultBOMFilePath())
+	if err != nil {
+		return "", ctrlerrors.RetryableError{Source: ComponentName, Cause: err}
+	}
+	images, err := bomFile.GetImageNameList(subcomponent)
+	if err != nil {
+		return "", err
+	}
+	if len(images) != 1 {
+		return "", fmt.Errorf("Expected 1 %s image, got %d", subcomponent, len(images))
+	}
+	return images[0], nil
+}
+
+// validateBackup checks that the backups have a single valid destination and a valid schedule
+func validateBackup(vz *vzapi.Verrazzano) error {
+	if vz == nil {
+		return nil
+	}
+	backup := getBackupSpec(vz)
+	if backup == nil || (!backup.Enabled && backup.RestoreBackupName == "") {
+		return nil
+	}
+	if backup.PersistentVolumeClaim == "" && backup.S3 == nil {
+		return fmt.Errorf("MySQL backup requires a persistentVolumeClaim or an s3 bucket")
+	}
+	if backup.PersistentVolumeClaim != "" && backup.S3 != nil {
+		return fmt.Errorf("MySQL backup can not have both a persistentVolumeClaim and an s3 bucket")
+	}
+	if backup.S3 != nil && (backup.S3.Endpoint == "" || backup.S3.Bucket == "" || backup.S3.CredentialsSecret == "") {
+		return fmt.Errorf("MySQL backup s3 bucket requires an endpoint, a bucket and a credentialsSecret")
+	}
+	if backup.Schedule != "" && len(strings.Fields(backup.Schedule)) != 5 {
+		return fmt.Errorf("MySQL backup schedule %q is not a cron schedule with 5 fields", backup.Schedule)
+	}
+	if backup.RetentionCount != nil && *backup.RetentionCount < 1 {
+		return fmt.Errorf("MySQL backup retentionCount must be at least 1")
+	}
+	if strings.Contains(backup.RestoreBackupName, "/") {
+		return fmt.Errorf("MySQL backup restoreBackupName %s must be a file name", backup.RestoreBackupName)
+	}
+	return nil
+}
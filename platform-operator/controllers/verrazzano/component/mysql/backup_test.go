@@ -0,0 +1,303 @@
+// Copyright (c) 2022, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package mysql
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	ctrlerrors "github.com/verrazzano/verrazzano/pkg/controller/errors"
+	vzapi "github.com/verrazzano/verrazzano/platform-operator/apis/verrazzano/v1alpha1"
+	vzconst "github.com/verrazzano/verrazzano/platform-operator/constants"
+	"github.com/verrazzano/verrazzano/platform-operator/controllers/verrazzano/component/common"
+	"github.com/verrazzano/verrazzano/platform-operator/controllers/verrazzano/component/spi"
+	"github.com/verrazzano/verrazzano/platform-operator/internal/config"
+	"golang.org/x/crypto/pbkdf2"
+	batchv1 "k8s.io/api/batch/v1"
+	v1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	k8scheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+const testCredentialsSecret = "backup-credentials"
+
+// newBackupVZ returns a Verrazzano resource with the MySQL backup configuration
+func newBackupVZ(backup *vzapi.MySQLBackupSpec) *vzapi.Verrazzano {
+	return &vzapi.Verrazzano{
+		Spec: vzapi.VerrazzanoSpec{
+			Components: vzapi.ComponentSpec{
+				Keycloak: &vzapi.KeycloakComponent{
+					MySQL: vzapi.MySQLComponent{Backup: backup},
+				},
+			},
+		},
+	}
+}
+
+// newS3Backup returns a backup configuration that uploads the backups to a MinIO bucket
+func newS3Backup() *vzapi.MySQLBackupSpec {
+	return &vzapi.MySQLBackupSpec{
+		Enabled: true,
+		S3: &vzapi.MySQLBackupS3Spec{
+			Endpoint:          "http://minio.minio.svc.cluster.local:9000",
+			Bucket:            "keycloak",
+			Prefix:            "/prod/",
+			CredentialsSecret: testCredentialsSecret,
+		},
+	}
+}
+
+func newCredentialsSecret() *v1.Secret {
+	return &v1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: testCredentialsSecret, Namespace: vzconst.VerrazzanoInstallNamespace},
+		Data:       map[string][]byte{s3AccessKey: []byte("minio"), s3SecretKey: []byte("minio123")},
+	}
+}
+
+func getBackupCronJob(t *testing.T, c client.Client) *batchv1.CronJob {
+	cronJob := &batchv1.CronJob{}
+	err := c.Get(context.TODO(), types.NamespacedName{Namespace: ComponentNamespace, Name: backupName}, cronJob)
+	if k8serrors.IsNotFound(err) {
+		return nil
+	}
+	assert.NoError(t, err)
+	return cronJob
+}
+
+// TestReconcileBackupPVC tests the reconcileBackup function
+// GIVEN a Verrazzano resource with backups to a persistent volume claim
+// WHEN I call reconcileBackup, and call it again after the backups are turned off
+// THEN the CronJob writes the backups to the claim with the default schedule, and is deleted once the backups are off
+func TestReconcileBackupPVC(t *testing.T) {
+	config.SetDefaultBomFilePath(testBomFilePath)
+	defer config.SetDefaultBomFilePath("")
+	retention := int32(3)
+	backup := &vzapi.MySQLBackupSpec{Enabled: true, PersistentVolumeClaim: "keycloak-backups", RetentionCount: &retention}
+	c := fake.NewClientBuilder().WithScheme(k8scheme.Scheme).Build()
+
+	assert.NoError(t, reconcileBackup(spi.NewFakeContext(c, newBackupVZ(backup), false)))
+	cronJob := getBackupCronJob(t, c)
+	assert.NotNil(t, cronJob)
+	assert.Equal(t, defaultBackupSchedule, cronJob.Spec.Schedule)
+	assert.Equal(t, batchv1.ForbidConcurrent, cronJob.Spec.ConcurrencyPolicy)
+	template := cronJob.Spec.JobTemplate.Spec.Template
+	assert.Equal(t, backupName, template.Labels[backupNameLabel])
+	assert.Equal(t, istioProxyConfig, template.Annotations[istioProxyConfigAnnotation])
+	assert.Equal(t, "keycloak-backups", template.Spec.Volumes[0].PersistentVolumeClaim.ClaimName)
+	assert.Len(t, template.Spec.Containers, 1)
+	dump := template.Spec.Containers[0]
+	assert.Equal(t, "ghcr.io/verrazzano/mysql:8.0.20", dump.Image)
+	assert.Contains(t, dump.Args[0], "mysqldump")
+	assert.Contains(t, dump.Args[0], "RETENTION_COUNT")
+	assert.NotContains(t, dump.Args[0], ".failed")
+	assert.Contains(t, dump.Env, v1.EnvVar{Name: "RETENTION_COUNT", Value: "3"})
+	assert.Equal(t, mySQLRootKey, dump.Env[0].ValueFrom.SecretKeyRef.Key)
+
+	backup.Enabled = false
+	assert.NoError(t, reconcileBackup(spi.NewFakeContext(c, newBackupVZ(backup), false)))
+	assert.Nil(t, getBackupCronJob(t, c))
+	assert.NoError(t, reconcileBackup(spi.NewFakeContext(c, newBackupVZ(nil), false)))
+}
+
+// TestReconcileBackupS3 tests the reconcileBackup function
+// GIVEN a Verrazzano resource with backups to an S3 compatible bucket
+// WHEN I call reconcileBackup
+// THEN the bucket credentials are copied and the CronJob dumps the database and uploads the dump to the bucket
+func TestReconcileBackupS3(t *testing.T) {
+	config.SetDefaultBomFilePath(testBomFilePath)
+	defer config.SetDefaultBomFilePath("")
+	backup := newS3Backup()
+	backup.Schedule = "*/30 * * * *"
+	c := fake.NewClientBuilder().WithScheme(k8scheme.Scheme).WithObjects(newCredentialsSecret()).Build()
+
+	assert.NoError(t, reconcileBackup(spi.NewFakeContext(c, newBackupVZ(backup), false)))
+	cronJob := getBackupCronJob(t, c)
+	assert.NotNil(t, cronJob)
+	assert.Equal(t, "*/30 * * * *", cronJob.Spec.Schedule)
+	podSpec := cronJob.Spec.JobTemplate.Spec.Template.Spec
+	assert.NotNil(t, podSpec.Volumes[0].EmptyDir)
+	assert.Len(t, podSpec.Containers, 2)
+	assert.Contains(t, podSpec.Containers[0].Args[0], ".uploaded")
+	upload := podSpec.Containers[1]
+	assert.Equal(t, "ghcr.io/verrazzano/mc:RELEASE.2022-08-11T00-30-48Z", upload.Image)
+	assert.Contains(t, upload.Env, v1.EnvVar{Name: "S3_PREFIX", Value: "prod/"})
+	assert.Contains(t, upload.Env, v1.EnvVar{Name: "S3_BUCKET", Value: "keycloak"})
+
+	secret := &v1.Secret{}
+	assert.NoError(t, c.Get(context.TODO(), types.NamespacedName{Namespace: ComponentNamespace, Name: backupS3SecretName}, secret))
+	assert.Equal(t, "minio123", string(secret.Data[s3SecretKey]))
+}
+
+// TestReconcileBackupS3MissingCredentials tests the reconcileBackup function
+// GIVEN a Verrazzano resource with backups to a bucket whose credentials secret does not exist
+// WHEN I call reconcileBackup
+// THEN a retryable error is returned and no CronJob is created
+func TestReconcileBackupS3MissingCredentials(t *testing.T) {
+	config.SetDefaultBomFilePath(testBomFilePath)
+	defer config.SetDefaultBomFilePath("")
+	c := fake.NewClientBuilder().WithScheme(k8scheme.Scheme).Build()
+
+	err := reconcileBackup(spi.NewFakeContext(c, newBackupVZ(newS3Backup()), false))
+	assert.Error(t, err)
+	assert.IsType(t, ctrlerrors.RetryableError{}, err)
+	assert.Nil(t, getBackupCronJob(t, c))
+}
+
+// TestRestoreBackup tests the restoreBackup function
+// GIVEN a Verrazzano resource with a backup to restore
+// WHEN I call restoreBackup while the restore job runs, after it completes and after it fails
+// THEN a retryable error is returned until the job completes, and an error is returned when it fails
+// AND the password of the Keycloak administrator is reset to the one of the admin secret, which is created
+func TestRestoreBackup(t *testing.T) {
+	config.SetDefaultBomFilePath(testBomFilePath)
+	defer config.SetDefaultBomFilePath("")
+	backup := newS3Backup()
+	backup.Enabled = false
+	backup.RestoreBackupName = "keycloak-20221017020000.sql.gz"
+	vz := newBackupVZ(backup)
+	c := fake.NewClientBuilder().WithScheme(k8scheme.Scheme).WithObjects(newCredentialsSecret()).Build()
+	ctx := spi.NewFakeContext(c, vz, false)
+
+	err := restoreBackup(ctx)
+	assert.IsType(t, ctrlerrors.RetryableError{}, err)
+	job := &batchv1.Job{}
+	assert.NoError(t, c.Get(context.TODO(), types.NamespacedName{Namespace: ComponentNamespace, Name: restoreJobName}, job))
+	containers := job.Spec.Template.Spec.Containers
+	assert.Len(t, containers, 2)
+	assert.Contains(t, containers[0].Args[0], "gunzip")
+	assert.Contains(t, containers[0].Env, v1.EnvVar{Name: "BACKUP_NAME", Value: backup.RestoreBackupName})
+	assert.Contains(t, containers[1].Args[0], "${BACKUP_NAME}")
+	assert.Contains(t, containers[0].Args[0], "UPDATE CREDENTIAL")
+	assert.Contains(t, containers[0].Env, keycloakAdminEnv()[1])
+
+	adminSecret := &v1.Secret{}
+	assert.NoError(t, c.Get(context.TODO(), types.NamespacedName{Namespace: ComponentNamespace, Name: common.KeycloakAdminSecret}, adminSecret))
+	credentialSecret := &v1.Secret{}
+	assert.NoError(t, c.Get(context.TODO(), types.NamespacedName{Namespace: ComponentNamespace, Name: keycloakAdminCredentialSecret}, credentialSecret))
+	assertKeycloakCredential(t, string(adminSecret.Data["password"]), credentialSecret)
+
+	// The job is still running
+	assert.IsType(t, ctrlerrors.RetryableError{}, restoreBackup(ctx))
+
+	job.Status.Conditions = []batchv1.JobCondition{{Type: batchv1.JobComplete, Status: v1.ConditionTrue}}
+	assert.NoError(t, c.Status().Update(context.TODO(), job))
+	assert.NoError(t, restoreBackup(ctx))
+	err = c.Get(context.TODO(), types.NamespacedName{Namespace: ComponentNamespace, Name: keycloakAdminCredentialSecret}, &v1.Secret{})
+	assert.True(t, k8serrors.IsNotFound(err))
+
+	job.Status.Conditions = []batchv1.JobCondition{{Type: batchv1.JobFailed, Status: v1.ConditionTrue, Message: "BackoffLimitExceeded"}}
+	assert.NoError(t, c.Status().Update(context.TODO(), job))
+	err = restoreBackup(ctx)
+	assert.Error(t, err)
+	_, retryable := err.(ctrlerrors.RetryableError)
+	assert.False(t, retryable)
+}
+
+// TestRestoreBackupExistingAdminSecret tests the restoreBackup function
+// GIVEN a Verrazzano resource with a backup to restore and an existing Keycloak admin secret
+// WHEN I call restoreBackup
+// THEN the restore job resets the password of the Keycloak administrator to the one of the existing secret
+func TestRestoreBackupExistingAdminSecret(t *testing.T) {
+	config.SetDefaultBomFilePath(testBomFilePath)
+	defer config.SetDefaultBomFilePath("")
+	backup := &vzapi.MySQLBackupSpec{PersistentVolumeClaim: "keycloak-backups", RestoreBackupName: "keycloak-20221017020000.sql.gz"}
+	adminSecret := &v1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Namespace: ComponentNamespace, Name: common.KeycloakAdminSecret},
+		Data:       map[string][]byte{"username": []byte(common.KeycloakAdminUser), "password": []byte("current")},
+	}
+	c := fake.NewClientBuilder().WithScheme(k8scheme.Scheme).WithObjects(adminSecret).Build()
+
+	assert.IsType(t, ctrlerrors.RetryableError{}, restoreBackup(spi.NewFakeContext(c, newBackupVZ(backup), false)))
+	credentialSecret := &v1.Secret{}
+	assert.NoError(t, c.Get(context.TODO(), types.NamespacedName{Namespace: ComponentNamespace, Name: keycloakAdminCredentialSecret}, credentialSecret))
+	assertKeycloakCredential(t, "current", credentialSecret)
+	job := &batchv1.Job{}
+	assert.NoError(t, c.Get(context.TODO(), types.NamespacedName{Namespace: ComponentNamespace, Name: restoreJobName}, job))
+	assert.Contains(t, job.Spec.Template.Spec.Containers[0].Args[0], "UPDATE CREDENTIAL")
+}
+
+// assertKeycloakCredential asserts that the secret holds the Keycloak credential of the password
+func assertKeycloakCredential(t *testing.T, password string, secret *v1.Secret) {
+	secretData := keycloakSecretData{}
+	assert.NoError(t, json.Unmarshal(secret.Data[secretDataKey], &secretData))
+	credentialData := keycloakCredentialData{}
+	assert.NoError(t, json.Unmarshal(secret.Data[credentialDataKey], &credentialData))
+	assert.Equal(t, keycloakHashAlgorithm, credentialData.Algorithm)
+	assert.Equal(t, keycloakHashIterations, credentialData.HashIterations)
+	salt, err := base64.StdEncoding.DecodeString(secretData.Salt)
+	assert.NoError(t, err)
+	hash := pbkdf2.Key([]byte(password), salt, keycloakHashIterations, keycloakHashLength, sha256.New)
+	assert.Equal(t, base64.StdEncoding.EncodeToString(hash), secretData.Value)
+}
+
+// TestRestoreBackupKeycloakInstalled tests the restoreBackup function
+// GIVEN a Verrazzano resource with a backup to restore and Keycloak already installed
+// WHEN I call restoreBackup
+// THEN the backup is not restored
+func TestRestoreBackupKeycloakInstalled(t *testing.T) {
+	backup := &vzapi.MySQLBackupSpec{PersistentVolumeClaim: "keycloak-backups", RestoreBackupName: "keycloak-20221017020000.sql.gz"}
+	vz := newBackupVZ(backup)
+	vz.Status.Components = vzapi.ComponentStatusMap{
+		keycloakComponentName: {Name: keycloakComponentName, LastReconciledGeneration: 1},
+	}
+	c := fake.NewClientBuilder().WithScheme(k8scheme.Scheme).Build()
+
+	assert.NoError(t, restoreBackup(spi.NewFakeContext(c, vz, false)))
+	err := c.Get(context.TODO(), types.NamespacedName{Namespace: ComponentNamespace, Name: restoreJobName}, &batchv1.Job{})
+	assert.True(t, k8serrors.IsNotFound(err))
+}
+
+// TestValidateBackup tests the validation of the backup configuration
+// GIVEN Verrazzano resources with valid and invalid backup configurations
+// WHEN I call ValidateInstall and ValidateUpdate
+// THEN an error is returned for the invalid configurations
+func TestValidateBackup(t *testing.T) {
+	zero := int32(0)
+	tests := []struct {
+		name   string
+		backup *vzapi.MySQLBackupSpec
+		valid  bool
+	}{
+		{name: "no backup", valid: true},
+		{name: "disabled without destination", backup: &vzapi.MySQLBackupSpec{}, valid: true},
+		{name: "pvc", backup: &vzapi.MySQLBackupSpec{Enabled: true, PersistentVolumeClaim: "backups"}, valid: true},
+		{name: "s3", backup: newS3Backup(), valid: true},
+		{name: "no destination", backup: &vzapi.MySQLBackupSpec{Enabled: true}},
+		{name: "restore without destination", backup: &vzapi.MySQLBackupSpec{RestoreBackupName: "keycloak.sql.gz"}},
+		{name: "pvc and s3", backup: func() *vzapi.MySQLBackupSpec {
+			b := newS3Backup()
+			b.PersistentVolumeClaim = "backups"
+			return b
+		}()},
+		{name: "s3 without bucket", backup: func() *vzapi.MySQLBackupSpec {
+			b := newS3Backup()
+			b.S3.Bucket = ""
+			return b
+		}()},
+		{name: "invalid schedule", backup: &vzapi.MySQLBackupSpec{Enabled: true, PersistentVolumeClaim: "backups", Schedule: "daily"}},
+		{name: "invalid retention", backup: &vzapi.MySQLBackupSpec{Enabled: true, PersistentVolumeClaim: "backups", RetentionCount: &zero}},
+		{name: "restore path", backup: &vzapi.MySQLBackupSpec{PersistentVolumeClaim: "backups", RestoreBackupName: "../keycloak.sql.gz"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			vz := newBackupVZ(tt.backup)
+			comp := NewComponent()
+			if tt.valid {
+				assert.NoError(t, comp.ValidateInstall(vz))
+				assert.NoError(t, comp.ValidateUpdate(newBackupVZ(nil), vz))
+			} else {
+				assert.Error(t, comp.ValidateInstall(vz))
+				assert.Error(t, comp.ValidateUpdate(newBackupVZ(nil), vz))
+			}
+		})
+	}
+}
@@ -130,6 +130,19 @@ func postInstall(ctx spi.ComponentContext) error {
 	return nil
 }
 
+// postUninstall deletes the namespace shared by MySQL and Keycloak, Keycloak is always uninstalled before MySQL
+func postUninstall(compContext spi.ComponentContext, _ string, namespace string) error {
+	if compContext.IsDryRun() {
+		compContext.Log().Debug("MySQL PostUninstall dry run")
+		return nil
+	}
+	ns := v1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: namespace}}
+	if err := compContext.Client().Delete(context.TODO(), &ns); err != nil && !errors.IsNotFound(err) {
+		return compContext.Log().ErrorfNewErr("Failed deleting namespace %s: %v", namespace, err)
+	}
+	return nil
+}
+
 // createMySQLInitFile creates the .sql file that gets passed to helm as an override
 // this initializes the MySQL DB
 func createMySQLInitFile(ctx spi.ComponentContext) (string, error) {
@@ -49,6 +49,7 @@ func NewComponent() spi.Component {
 			ImagePullSecretKeyname:  secret.DefaultImagePullSecretKeyName,
 			ValuesFile:              filepath.Join(config.GetHelmOverridesDir(), "mysql-values.yaml"),
 			AppendOverridesFunc:     appendMySQLOverrides,
+			PostUninstallFunc:       postUninstall,
 			Dependencies:            []string{istio.ComponentName},
 			GetInstallOverridesFunc: GetOverrides,
 		},
@@ -78,13 +79,45 @@ func (c mysqlComponent) PreInstall(ctx spi.ComponentContext) error {
 	return preInstall(ctx, c.ChartNamespace)
 }
 
-// PostInstall calls MySQL postInstall function
+// PostInstall calls MySQL postInstall function, restores the configured backup before Keycloak is first installed
+// and schedules the backups
 func (c mysqlComponent) PostInstall(ctx spi.ComponentContext) error {
-	return postInstall(ctx)
+	if err := postInstall(ctx); err != nil {
+		return err
+	}
+	if ctx.IsDryRun() {
+		return nil
+	}
+	if err := restoreBackup(ctx); err != nil {
+		return err
+	}
+	return reconcileBackup(ctx)
+}
+
+// PostUpgrade schedules the backups with the images of the upgraded release
+func (c mysqlComponent) PostUpgrade(ctx spi.ComponentContext) error {
+	if err := c.HelmComponent.PostUpgrade(ctx); err != nil {
+		return err
+	}
+	if ctx.IsDryRun() {
+		return nil
+	}
+	return reconcileBackup(ctx)
+}
+
+// ValidateInstall checks if the specified Verrazzano CR is valid for this component to be installed
+func (c mysqlComponent) ValidateInstall(vz *vzapi.Verrazzano) error {
+	if err := validateBackup(vz); err != nil {
+		return err
+	}
+	return c.HelmComponent.ValidateInstall(vz)
 }
 
 // ValidateUpdate checks if the specified new Verrazzano CR is valid for this component to be updated
 func (c mysqlComponent) ValidateUpdate(old *vzapi.Verrazzano, new *vzapi.Verrazzano) error {
+	if err := validateBackup(new); err != nil {
+		return err
+	}
 	// Block all changes for now, particularly around storage changes
 
 	// compare the VolumeSourceOverrides and reject if the type or size or storage class is different
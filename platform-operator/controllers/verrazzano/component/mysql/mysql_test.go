@@ -453,6 +453,25 @@ func TestIsEnabledDevProfile(t *testing.T) {
 	assert.True(t, NewComponent().IsEnabled(spi.NewFakeContext(nil, &cr, false, profilesRelativePath).EffectiveCR()))
 }
 
+// TestPostUninstall tests the postUninstall function
+// GIVEN a call to postUninstall
+//  WHEN the MySQL namespace exists
+//  THEN the namespace is deleted
+func TestPostUninstall(t *testing.T) {
+	fakeClient := fake.NewClientBuilder().WithScheme(k8scheme.Scheme).WithObjects(&v1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{Name: ComponentNamespace},
+	}).Build()
+	ctx := spi.NewFakeContext(fakeClient, &vzapi.Verrazzano{}, false)
+	assert.NoError(t, postUninstall(ctx, ComponentName, ComponentNamespace))
+
+	ns := v1.Namespace{}
+	err := fakeClient.Get(context.TODO(), types.NamespacedName{Name: ComponentNamespace}, &ns)
+	assert.Error(t, err)
+
+	// The namespace being gone is not an error
+	assert.NoError(t, postUninstall(ctx, ComponentName, ComponentNamespace))
+}
+
 func getBoolPtr(b bool) *bool {
 	return &b
 }
@@ -53,6 +53,7 @@ func NewComponent() spi.Component {
 			PreInstallFunc:          PreInstall,
 			AppendOverridesFunc:     AppendOverrides,
 			PostInstallFunc:         PostInstall,
+			PostUninstallFunc:       PostUninstall,
 			Dependencies:            []string{istio.ComponentName},
 			GetInstallOverridesFunc: GetOverrides,
 		},
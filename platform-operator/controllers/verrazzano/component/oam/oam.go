@@ -8,6 +8,7 @@ import (
 	"fmt"
 	vzapi "github.com/verrazzano/verrazzano/platform-operator/apis/verrazzano/v1alpha1"
 
+	"github.com/verrazzano/verrazzano/platform-operator/controllers/verrazzano/component/common"
 	"github.com/verrazzano/verrazzano/platform-operator/controllers/verrazzano/component/spi"
 	"github.com/verrazzano/verrazzano/platform-operator/internal/k8s/status"
 	corev1 "k8s.io/api/core/v1"
@@ -137,3 +138,16 @@ func GetOverrides(effectiveCR *vzapi.Verrazzano) []vzapi.Overrides {
 	}
 	return []vzapi.Overrides{}
 }
+
+// postUninstall deletes the ClusterRoles that the operator created for the OAM runtime
+func postUninstall(ctx spi.ComponentContext, _ string, _ string) error {
+	if ctx.IsDryRun() {
+		return nil
+	}
+	for _, name := range []string{pvcClusterRoleName, istioClusterRoleName, certClusterRoleName} {
+		if err := common.DeleteObjects(ctx, &rbacv1.ClusterRole{ObjectMeta: metav1.ObjectMeta{Name: name}}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -90,6 +90,25 @@ func isOSReady(ctx spi.ComponentContext) bool {
 	return common.IsVMISecretReady(ctx)
 }
 
+// getOSWorkloads returns the OpenSearch workloads that are configured with replicas
+func getOSWorkloads(ctx spi.ComponentContext) []types.NamespacedName {
+	var workloads []types.NamespacedName
+	dataReplicas := findESReplicas(ctx, "data")
+	for i := int32(0); i < dataReplicas; i++ {
+		workloads = append(workloads, types.NamespacedName{
+			Name:      fmt.Sprintf("%s-%d", esDataDeployment, i),
+			Namespace: ComponentNamespace,
+		})
+	}
+	if findESReplicas(ctx, "ingest") > 0 {
+		workloads = append(workloads, types.NamespacedName{Name: esIngestDeployment, Namespace: ComponentNamespace})
+	}
+	if findESReplicas(ctx, "master") > 0 {
+		workloads = append(workloads, types.NamespacedName{Name: esMasterStatefulset, Namespace: ComponentNamespace})
+	}
+	return workloads
+}
+
 // findESReplicas searches the ES install args to find the correct resources to search for in isReady
 func findESReplicas(ctx spi.ComponentContext, nodeType string) int32 {
 	if vzconfig.IsElasticsearchEnabled(ctx.EffectiveCR()) && ctx.EffectiveCR().Spec.Components.Elasticsearch != nil {
@@ -5,11 +5,15 @@ package opensearch
 
 import (
 	"fmt"
+	vmov1 "github.com/verrazzano/verrazzano-monitoring-operator/pkg/apis/vmcontroller/v1"
+	"time"
+
 	vzapi "github.com/verrazzano/verrazzano/platform-operator/apis/verrazzano/v1alpha1"
 	"github.com/verrazzano/verrazzano/platform-operator/constants"
 	"github.com/verrazzano/verrazzano/platform-operator/controllers/verrazzano/component/common"
 	"github.com/verrazzano/verrazzano/platform-operator/controllers/verrazzano/component/spi"
 	"github.com/verrazzano/verrazzano/platform-operator/controllers/verrazzano/component/vmo"
+	"github.com/verrazzano/verrazzano/platform-operator/internal/k8s/status"
 	"github.com/verrazzano/verrazzano/platform-operator/internal/vzconfig"
 	"k8s.io/apimachinery/pkg/types"
 )
@@ -30,6 +34,9 @@ const ComponentJSONName = "opensearch"
 
 type opensearchComponent struct{}
 
+// Verify that opensearchComponent registers its snapshot repository periodically
+var _ spi.ComponentResyncer = opensearchComponent{}
+
 // GetDependencies returns the dependencies of the OpenSearch component
 func (o opensearchComponent) GetDependencies() []string {
 	return []string{vmo.ComponentName}
@@ -63,8 +70,21 @@ func (o opensearchComponent) IsInstalled(ctx spi.ComponentContext) (bool, error)
 	return doesOSExist(ctx), nil
 }
 
-func (o opensearchComponent) Reconcile(_ spi.ComponentContext) error {
-	return nil
+// Reconcile registers the snapshot repository and schedules the snapshots of the CR.  It is called periodically
+// while OpenSearch is ready.
+func (o opensearchComponent) Reconcile(ctx spi.ComponentContext) error {
+	if !isOSReady(ctx) {
+		return fmt.Errorf("Component %s not ready yet to register the snapshot repository", ComponentName)
+	}
+	if err := common.EnsureBackupSecret(ctx.Client(), ctx.EffectiveCR()); err != nil {
+		return err
+	}
+	return reconcileSnapshots(ctx)
+}
+
+// GetResyncInterval returns how often the snapshot repository is registered while OpenSearch is ready
+func (o opensearchComponent) GetResyncInterval() time.Duration {
+	return resyncInterval
 }
 
 func NewComponent() spi.Component {
@@ -79,7 +99,7 @@ func (o opensearchComponent) PreInstall(ctx spi.ComponentContext) error {
 		return err
 	}
 	// create or update backup VMI secret
-	if err := common.EnsureBackupSecret(ctx.Client()); err != nil {
+	if err := common.EnsureBackupSecret(ctx.Client(), ctx.EffectiveCR()); err != nil {
 		return err
 	}
 	ctx.Log().Debug("OpenSearch pre-install")
@@ -97,7 +117,11 @@ func (o opensearchComponent) Install(ctx spi.ComponentContext) error {
 // PreUpgrade OpenSearch component pre-upgrade processing
 func (o opensearchComponent) PreUpgrade(ctx spi.ComponentContext) error {
 	// create or update  VMI secret
-	return common.EnsureVMISecret(ctx.Client())
+	if err := common.EnsureVMISecret(ctx.Client()); err != nil {
+		return err
+	}
+	// create or update backup VMI secret
+	return common.EnsureBackupSecret(ctx.Client(), ctx.EffectiveCR())
 }
 
 // Upgrade OpenSearch component upgrade processing
@@ -105,15 +129,51 @@ func (o opensearchComponent) Upgrade(ctx spi.ComponentContext) error {
 	return common.CreateOrUpdateVMI(ctx, updateFunc)
 }
 
+// PreflightChecks verifies that the OpenSearch PVCs have the capacity requested by the upgrade
+func (o opensearchComponent) PreflightChecks(ctx spi.ComponentContext) []error {
+	return checkPVCCapacity(ctx)
+}
+
 // IsReady component check
 func (o opensearchComponent) IsReady(ctx spi.ComponentContext) bool {
 	return isOSReady(ctx)
 }
 
+// GetNotReadyWorkloads returns the OpenSearch data and ingest deployments and the master statefulset that are not ready
+func (o opensearchComponent) GetNotReadyWorkloads(ctx spi.ComponentContext) ([]string, error) {
+	return status.GetNotReadyNamedWorkloads(ctx.Client(), getOSWorkloads(ctx))
+}
+
 // PostInstall OpenSearch post-install processing
 func (o opensearchComponent) PostInstall(ctx spi.ComponentContext) error {
-	ctx.Log().Debugf("OpenSearch component post-upgrade")
-	return common.CheckIngressesAndCerts(ctx, o)
+	ctx.Log().Debugf("OpenSearch component post-install")
+	if err := common.CheckIngressesAndCerts(ctx, o); err != nil {
+		return err
+	}
+	return reconcileSnapshots(ctx)
+}
+
+// IsUninstalled OpenSearch uninstall check
+func (o opensearchComponent) IsUninstalled(ctx spi.ComponentContext) (bool, error) {
+	return !doesOSExist(ctx), nil
+}
+
+// PreUninstall OpenSearch pre-uninstall processing
+func (o opensearchComponent) PreUninstall(_ spi.ComponentContext) error {
+	return nil
+}
+
+// Uninstall OpenSearch component uninstall processing; the VMO removes the OpenSearch resources when OpenSearch is
+// removed from the VMI
+func (o opensearchComponent) Uninstall(ctx spi.ComponentContext) error {
+	return common.RemoveFromVMI(ctx, func(vmi *vmov1.VerrazzanoMonitoringInstance) {
+		vmi.Spec.Elasticsearch = vmov1.Elasticsearch{}
+	})
+}
+
+// PostUninstall OpenSearch post-uninstall processing
+func (o opensearchComponent) PostUninstall(_ spi.ComponentContext) error {
+	return nil
 }
 
 // PostUpgrade OpenSearch post-upgrade processing
@@ -122,7 +182,10 @@ func (o opensearchComponent) PostUpgrade(ctx spi.ComponentContext) error {
 	if err := common.CheckIngressesAndCerts(ctx, o); err != nil {
 		return err
 	}
-	return o.updateElasticsearchResources(ctx)
+	if err := o.updateElasticsearchResources(ctx); err != nil {
+		return err
+	}
+	return reconcileSnapshots(ctx)
 }
 
 // updateElasticsearchResources updates elasticsearch resources
@@ -144,22 +207,24 @@ func (o opensearchComponent) IsEnabled(effectiveCR *vzapi.Verrazzano) bool {
 
 // ValidateUpdate checks if the specified new Verrazzano CR is valid for this component to be updated
 func (o opensearchComponent) ValidateUpdate(old *vzapi.Verrazzano, new *vzapi.Verrazzano) error {
-	// Do not allow disabling active components
-	if err := o.isOpenSearchEnabled(old, new); err != nil {
-		return err
-	}
 	// Reject any other edits except InstallArgs
 	// Do not allow any updates to storage settings via the volumeClaimSpecTemplates/defaultVolumeSource
 	if err := common.CompareStorageOverrides(old, new, ComponentJSONName); err != nil {
 		return err
 	}
 	// Reject edits that duplicate names of install args or node groups
-	return validateNoDuplicatedConfiguration(new)
+	if err := validateNoDuplicatedConfiguration(new); err != nil {
+		return err
+	}
+	return validateSnapshot(new)
 }
 
 // ValidateInstall checks if the specified Verrazzano CR is valid for this component to be installed
 func (o opensearchComponent) ValidateInstall(vz *vzapi.Verrazzano) error {
-	return validateNoDuplicatedConfiguration(vz)
+	if err := validateNoDuplicatedConfiguration(vz); err != nil {
+		return err
+	}
+	return validateSnapshot(vz)
 }
 
 // Name returns the component name
@@ -167,14 +232,6 @@ func (o opensearchComponent) Name() string {
 	return ComponentName
 }
 
-func (o opensearchComponent) isOpenSearchEnabled(old *vzapi.Verrazzano, new *vzapi.Verrazzano) error {
-	// Do not allow disabling of any component post-install for now
-	if vzconfig.IsElasticsearchEnabled(old) && !vzconfig.IsElasticsearchEnabled(new) {
-		return fmt.Errorf("Disabling component OpenSearch not allowed")
-	}
-	return nil
-}
-
 // GetIngressNames - gets the names of the ingresses associated with this component
 func (o opensearchComponent) GetIngressNames(ctx spi.ComponentContext) []types.NamespacedName {
 	var ingressNames []types.NamespacedName
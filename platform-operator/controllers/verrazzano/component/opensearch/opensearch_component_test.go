@@ -344,7 +344,7 @@ func Test_opensearchComponent_ValidateUpdate(t *testing.T) {
 					},
 				},
 			},
-			wantErr: true,
+			wantErr: false,
 		},
 		{
 			name: "change-installargs",
@@ -486,7 +486,7 @@ func Test_opensearchComponent_ValidateUpdate(t *testing.T) {
 					},
 				},
 			},
-			wantErr: true,
+			wantErr: false,
 		},
 		{
 			// Change to OS installargs allowed, persistence changes are supported
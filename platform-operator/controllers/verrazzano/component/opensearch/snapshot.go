@@ -0,0 +1,300 @@
+// Copyright (c) 2022, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package opensearch
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/verrazzano/verrazzano/pkg/bom"
+	ctrlerrors "github.com/verrazzano/verrazzano/pkg/controller/errors"
+	"github.com/verrazzano/verrazzano/pkg/httputil"
+	"github.com/verrazzano/verrazzano/pkg/opensearch"
+	vzapi "github.com/verrazzano/verrazzano/platform-operator/apis/verrazzano/v1alpha1"
+	"github.com/verrazzano/verrazzano/platform-operator/constants"
+	"github.com/verrazzano/verrazzano/platform-operator/controllers/verrazzano/component/common"
+	"github.com/verrazzano/verrazzano/platform-operator/controllers/verrazzano/component/spi"
+	"github.com/verrazzano/verrazzano/platform-operator/controllers/verrazzano/secret"
+	"github.com/verrazzano/verrazzano/platform-operator/internal/config"
+	"github.com/verrazzano/verrazzano/platform-operator/internal/vzconfig"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	controllerruntime "sigs.k8s.io/controller-runtime"
+)
+
+const (
+	snapshotName              = "opensearch-snapshot"
+	snapshotNameLabel         = "app.kubernetes.io/name"
+	defaultRepositoryName     = "verrazzano"
+	defaultSnapshotRetention  = 7
+	scheduledSnapshotPrefix   = "scheduled-"
+	opensearchRequestTimeout  = 30 * time.Second
+	opensearchCACert          = "ca.crt"
+	opensearchCAMountPath     = "/etc/opensearch-ca"
+	vmoSubcomponentName       = "verrazzano-monitoring-operator"
+	opensearchImageKey        = "monitoringOperator.esImage"
+	istioSidecarInjectionAnno = "sidecar.istio.io/inject"
+
+	// resyncInterval is how often the snapshot repository is registered again while OpenSearch is ready, OpenSearch
+	// loses it when its master nodes use ephemeral storage and get restarted
+	resyncInterval = 5 * time.Minute
+)
+
+// snapshotScript takes a snapshot of the indices through the OpenSearch ingress and deletes the oldest scheduled
+// snapshots
+const snapshotScript = `set -e
+cacert=` + opensearchCAMountPath + `/` + opensearchCACert + `
+[ -s "${cacert}" ] || cacert=""
+opensearch() { curl -fsS ${cacert:+--cacert "${cacert}"} -u "${OPENSEARCH_USERNAME}:${OPENSEARCH_PASSWORD}" -H 'Content-Type: application/json' "$@"; }
+name="` + scheduledSnapshotPrefix + `$(date -u +%Y%m%d%H%M%S)"
+opensearch -X PUT "${OPENSEARCH_URL}/_snapshot/${REPOSITORY}/${name}?wait_for_completion=true" -d "${SNAPSHOT_REQUEST}" >/dev/null
+echo "Created snapshot ${name}"
+opensearch "${OPENSEARCH_URL}/_snapshot/${REPOSITORY}/_all" | grep -o '"snapshot":"` + scheduledSnapshotPrefix + `[0-9]*"' | cut -d'"' -f4 | sort | head -n -"${RETENTION_COUNT}" |
+  while read -r old; do opensearch -X DELETE "${OPENSEARCH_URL}/_snapshot/${REPOSITORY}/${old}" >/dev/null; echo "Deleted snapshot ${old}"; done
+`
+
+// httpClient returns the URL and HTTP client of the REST API, unit tests set it to call a fake server
+var httpClient = common.NewHTTPClientHook(getOpenSearchHTTPClient)
+
+// getSnapshotSpec returns the snapshot configuration of OpenSearch, or nil if there is none
+func getSnapshotSpec(cr *vzapi.Verrazzano) *vzapi.OpenSearchSnapshot {
+	if cr.Spec.Components.Elasticsearch == nil {
+		return nil
+	}
+	return cr.Spec.Components.Elasticsearch.Snapshot
+}
+
+// getRepositoryName returns the name of the snapshot repository in OpenSearch
+func getRepositoryName(snapshot *vzapi.OpenSearchSnapshot) string {
+	if snapshot.Repository.Name == "" {
+		return defaultRepositoryName
+	}
+	return snapshot.Repository.Name
+}
+
+// newRepository returns the S3 snapshot repository of the configuration, the credentials are not part of the
+// repository since OpenSearch loads them from its keystore
+func newRepository(snapshot *vzapi.OpenSearchSnapshot) opensearch.Repository {
+	return opensearch.Repository{
+		Type: opensearch.S3RepositoryType,
+		Settings: opensearch.RepositorySettings{
+			Bucket:          snapshot.Repository.Bucket,
+			BasePath:        strings.Trim(snapshot.Repository.BasePath, "/"),
+			Endpoint:        snapshot.Repository.Endpoint,
+			Region:          snapshot.Repository.Region,
+			PathStyleAccess: "true",
+		},
+	}
+}
+
+// reconcileSnapshots registers the snapshot repository and schedules the snapshots of the CR
+func reconcileSnapshots(ctx spi.ComponentContext) error {
+	if err := reconcileSnapshotRepository(ctx); err != nil {
+		return err
+	}
+	return reconcileSnapshotSchedule(ctx)
+}
+
+// reconcileSnapshotRepository registers the snapshot repository with OpenSearch, or updates it if its settings
+// differ from the CR.  Repositories that are removed from the CR are left in OpenSearch, with their snapshots.
+func reconcileSnapshotRepository(ctx spi.ComponentContext) error {
+	snapshot := getSnapshotSpec(ctx.EffectiveCR())
+	if snapshot == nil {
+		return nil
+	}
+	osClient, err := newOpenSearchClient(ctx)
+	if err != nil {
+		return err
+	}
+	name := getRepositoryName(snapshot)
+	repo := newRepository(snapshot)
+	existing, err := osClient.GetRepository(name)
+	if err != nil && !opensearch.IsNotFound(err) {
+		ctx.Log().Progressf("Component %s waiting to get snapshot repository %s: %v", ComponentName, name, err)
+		return ctrlerrors.RetryableError{Source: ComponentName, Cause: err}
+	}
+	if err == nil && reflect.DeepEqual(*existing, repo) {
+		return nil
+	}
+	if err := osClient.PutRepository(name, repo); err != nil {
+		// OpenSearch loads the credentials of the repository when its pods start, the pods that were started before
+		// the credentials were configured can not access the bucket
+		return ctx.Log().ErrorfNewErr("Failed registering OpenSearch snapshot repository %s, the OpenSearch pods need to be restarted if the credentials secret %s was changed after they started: %v",
+			name, snapshot.Repository.CredentialsSecret, err)
+	}
+	ctx.Log().Oncef("Component %s registered snapshot repository %s for bucket %s", ComponentName, name, repo.Settings.Bucket)
+	return nil
+}
+
+// reconcileSnapshotSchedule creates or updates the CronJob that takes the snapshots on a schedule, or deletes it
+// when there is no schedule
+func reconcileSnapshotSchedule(ctx spi.ComponentContext) error {
+	snapshot := getSnapshotSpec(ctx.EffectiveCR())
+	cronJob := &batchv1.CronJob{ObjectMeta: metav1.ObjectMeta{Name: snapshotName, Namespace: ComponentNamespace}}
+	if snapshot == nil || snapshot.Schedule == "" {
+		if err := ctx.Client().Delete(context.TODO(), cronJob); err != nil && !errors.IsNotFound(err) {
+			return ctx.Log().ErrorfNewErr("Failed deleting the OpenSearch snapshot CronJob: %v", err)
+		}
+		return nil
+	}
+
+	podSpec, err := buildSnapshotPodSpec(ctx, snapshot)
+	if err != nil {
+		return err
+	}
+	_, err = controllerruntime.CreateOrUpdate(context.TODO(), ctx.Client(), cronJob, func() error {
+		cronJob.Labels = map[string]string{snapshotNameLabel: snapshotName}
+		cronJob.Spec.Schedule = snapshot.Schedule
+		cronJob.Spec.ConcurrencyPolicy = batchv1.ForbidConcurrent
+		cronJob.Spec.JobTemplate.Spec.Template.ObjectMeta = metav1.ObjectMeta{
+			Labels: map[string]string{snapshotNameLabel: snapshotName},
+			// The job reaches OpenSearch through its ingress, like the operator
+			Annotations: map[string]string{istioSidecarInjectionAnno: "false"},
+		}
+		cronJob.Spec.JobTemplate.Spec.Template.Spec = podSpec
+		return nil
+	})
+	if err != nil {
+		return ctx.Log().ErrorfNewErr("Failed creating or updating the OpenSearch snapshot CronJob: %v", err)
+	}
+	ctx.Log().Oncef("Component %s scheduled the OpenSearch snapshots with schedule %s", ComponentName, snapshot.Schedule)
+	return nil
+}
+
+// buildSnapshotPodSpec returns the spec of the pod that takes a snapshot and deletes the oldest scheduled snapshots
+func buildSnapshotPodSpec(ctx spi.ComponentContext, snapshot *vzapi.OpenSearchSnapshot) (corev1.PodSpec, error) {
+	image, err := getOpenSearchImage()
+	if err != nil {
+		return corev1.PodSpec{}, err
+	}
+	baseURL, err := getOpenSearchURL(ctx)
+	if err != nil {
+		return corev1.PodSpec{}, err
+	}
+	request, err := json.Marshal(opensearch.CreateSnapshotRequest{
+		Indices:           strings.Join(snapshot.Indices, ","),
+		IgnoreUnavailable: true,
+	})
+	if err != nil {
+		return corev1.PodSpec{}, err
+	}
+	retention := int32(defaultSnapshotRetention)
+	if snapshot.RetentionCount != nil {
+		retention = *snapshot.RetentionCount
+	}
+	secretEnv := func(name string, key string) corev1.EnvVar {
+		return corev1.EnvVar{
+			Name: name,
+			ValueFrom: &corev1.EnvVarSource{
+				SecretKeyRef: &corev1.SecretKeySelector{
+					LocalObjectReference: corev1.LocalObjectReference{Name: constants.VMISecret},
+					Key:                  key,
+				},
+			},
+		}
+	}
+	optional := true
+	podSpec := corev1.PodSpec{
+		RestartPolicy: corev1.RestartPolicyNever,
+		Containers: []corev1.Container{{
+			Name:            "snapshot",
+			Image:           image,
+			ImagePullPolicy: corev1.PullIfNotPresent,
+			Command:         []string{"/bin/sh", "-c"},
+			Args:            []string{snapshotScript},
+			Env: []corev1.EnvVar{
+				{Name: "OPENSEARCH_URL", Value: baseURL},
+				{Name: "REPOSITORY", Value: getRepositoryName(snapshot)},
+				{Name: "SNAPSHOT_REQUEST", Value: string(request)},
+				{Name: "RETENTION_COUNT", Value: strconv.Itoa(int(retention))},
+				secretEnv("OPENSEARCH_USERNAME", "username"),
+				secretEnv("OPENSEARCH_PASSWORD", "password"),
+			},
+			VolumeMounts: []corev1.VolumeMount{{Name: "ca", MountPath: opensearchCAMountPath, ReadOnly: true}},
+		}},
+		Volumes: []corev1.Volume{{
+			Name: "ca",
+			VolumeSource: corev1.VolumeSource{
+				Secret: &corev1.SecretVolumeSource{
+					SecretName: osCertificateName,
+					Items:      []corev1.KeyToPath{{Key: opensearchCACert, Path: opensearchCACert}},
+					Optional:   &optional,
+				},
+			},
+		}},
+	}
+	exists, err := secret.CheckImagePullSecret(ctx.Client(), ComponentNamespace)
+	if err != nil {
+		return podSpec, ctx.Log().ErrorfNewErr("Failed copying the global image pull secret to namespace %s: %v", ComponentNamespace, err)
+	}
+	if exists {
+		podSpec.ImagePullSecrets = []corev1.LocalObjectReference{{Name: constants.GlobalImagePullSecName}}
+	}
+	return podSpec, nil
+}
+
+// getOpenSearchImage returns the OpenSearch image of the BOM, which has curl
+func getOpenSearchImage() (string, error) {
+	bomFile, err := bom.NewBom(config.GetDefaultBOMFilePath())
+	if err != nil {
+		return "", ctrlerrors.RetryableError{Source: ComponentName, Cause: err}
+	}
+	kvs, _, err := bomFile.BuildImageStrings(vmoSubcomponentName)
+	if err != nil {
+		return "", err
+	}
+	image := bom.FindKV(kvs, opensearchImageKey)
+	if image == "" {
+		return "", fmt.Errorf("Failed finding the OpenSearch image %s in the BOM", opensearchImageKey)
+	}
+	return image, nil
+}
+
+// getOpenSearchURL returns the URL of the OpenSearch ingress
+func getOpenSearchURL(ctx spi.ComponentContext) (string, error) {
+	dnsSuffix, err := vzconfig.GetDNSSuffix(ctx.Client(), ctx.EffectiveCR())
+	if err != nil {
+		return "", ctx.Log().ErrorfNewErr("Failed getting DNS suffix: %v", err)
+	}
+	return fmt.Sprintf("https://elasticsearch.vmi.system.%s.%s", vzconfig.GetEnvName(ctx.EffectiveCR()), dnsSuffix), nil
+}
+
+// getOpenSearchHTTPClient returns the URL of the OpenSearch ingress and a client that trusts its certificate
+func getOpenSearchHTTPClient(ctx spi.ComponentContext) (string, *http.Client, error) {
+	baseURL, err := getOpenSearchURL(ctx)
+	if err != nil {
+		return "", nil, err
+	}
+	hc, err := httputil.NewCertSecretClient(ctx.Client(), types.NamespacedName{Namespace: ComponentNamespace, Name: osCertificateName}, opensearchRequestTimeout)
+	if err != nil {
+		return "", nil, err
+	}
+	return baseURL, hc, nil
+}
+
+// newOpenSearchClient returns a client of OpenSearch that authenticates as the Verrazzano user of the VMI
+func newOpenSearchClient(ctx spi.ComponentContext) (*opensearch.Client, error) {
+	vmiSecret := &corev1.Secret{}
+	if err := ctx.Client().Get(context.TODO(), types.NamespacedName{Namespace: ComponentNamespace, Name: constants.VMISecret}, vmiSecret); err != nil {
+		if errors.IsNotFound(err) {
+			ctx.Log().Progressf("Component %s waiting for the VMI secret %s/%s to exist", ComponentName, ComponentNamespace, constants.VMISecret)
+			return nil, ctrlerrors.RetryableError{Source: ComponentName, Cause: err}
+		}
+		return nil, ctx.Log().ErrorfNewErr("Failed getting the VMI secret %s/%s: %v", ComponentNamespace, constants.VMISecret, err)
+	}
+	baseURL, hc, err := httpClient.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return opensearch.NewClient(baseURL, hc, string(vmiSecret.Data["username"]), string(vmiSecret.Data["password"])), nil
+}
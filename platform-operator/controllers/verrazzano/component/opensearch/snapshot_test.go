@@ -0,0 +1,205 @@
+// Copyright (c) 2022, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package opensearch
+
+import (
+	"context"
+	"encoding/pem"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	ctrlerrors "github.com/verrazzano/verrazzano/pkg/controller/errors"
+	"github.com/verrazzano/verrazzano/pkg/opensearch"
+	osfake "github.com/verrazzano/verrazzano/pkg/opensearch/fake"
+	vzapi "github.com/verrazzano/verrazzano/platform-operator/apis/verrazzano/v1alpha1"
+	"github.com/verrazzano/verrazzano/platform-operator/constants"
+	"github.com/verrazzano/verrazzano/platform-operator/controllers/verrazzano/component/spi"
+	"github.com/verrazzano/verrazzano/platform-operator/internal/config"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+const (
+	testBomFilePath = "../../../../verrazzano-bom.json"
+	testUsername    = "verrazzano"
+	testPassword    = "vzpw"
+)
+
+func newSnapshotVZ(snapshot *vzapi.OpenSearchSnapshot) *vzapi.Verrazzano {
+	return &vzapi.Verrazzano{
+		Spec: vzapi.VerrazzanoSpec{
+			EnvironmentName: "prod",
+			Components: vzapi.ComponentSpec{
+				DNS:           dnsComponents.DNS,
+				Elasticsearch: &vzapi.ElasticsearchComponent{Snapshot: snapshot},
+			},
+		},
+	}
+}
+
+func newSnapshotSpec(schedule string) *vzapi.OpenSearchSnapshot {
+	return &vzapi.OpenSearchSnapshot{
+		Repository: vzapi.OpenSearchSnapshotRepository{
+			Endpoint:          "http://minio.minio.svc:9000",
+			Bucket:            "vz-snapshots",
+			BasePath:          "/prod/",
+			CredentialsSecret: "minio-credentials",
+		},
+		Schedule: schedule,
+		Indices:  []string{"verrazzano-*", ".opendistro-ism-config"},
+	}
+}
+
+func newVMISecret() *corev1.Secret {
+	return &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Namespace: ComponentNamespace, Name: constants.VMISecret},
+		Data:       map[string][]byte{"username": []byte(testUsername), "password": []byte(testPassword)},
+	}
+}
+
+// useFakeServer makes the component call the fake OpenSearch server
+func useFakeServer(server *osfake.Server) {
+	httpClient.Set(func(ctx spi.ComponentContext) (string, *http.Client, error) {
+		return server.URL, server.Client(), nil
+	})
+}
+
+func getSnapshotCronJob(t *testing.T, c client.Client) *batchv1.CronJob {
+	cronJob := &batchv1.CronJob{}
+	err := c.Get(context.TODO(), types.NamespacedName{Namespace: ComponentNamespace, Name: snapshotName}, cronJob)
+	if k8serrors.IsNotFound(err) {
+		return nil
+	}
+	assert.NoError(t, err)
+	return cronJob
+}
+
+// TestReconcileSnapshotRepository tests the reconcileSnapshotRepository function
+// GIVEN a Verrazzano resource with a snapshot repository
+//  WHEN I call reconcileSnapshotRepository, and call it again after the bucket of the repository is changed
+//  THEN the repository is registered with OpenSearch, and is updated with the new bucket
+func TestReconcileSnapshotRepository(t *testing.T) {
+	asserts := assert.New(t)
+	server := osfake.NewServer(testUsername, testPassword)
+	defer server.Close()
+	useFakeServer(server)
+	defer httpClient.Reset()
+	c := fake.NewClientBuilder().WithScheme(testScheme).WithObjects(newVMISecret()).Build()
+
+	snapshot := newSnapshotSpec("")
+	asserts.NoError(reconcileSnapshotRepository(spi.NewFakeContext(c, newSnapshotVZ(snapshot), false)))
+	asserts.Equal(&opensearch.Repository{
+		Type: opensearch.S3RepositoryType,
+		Settings: opensearch.RepositorySettings{
+			Bucket:          "vz-snapshots",
+			BasePath:        "prod",
+			Endpoint:        "http://minio.minio.svc:9000",
+			PathStyleAccess: "true",
+		},
+	}, server.Repository(defaultRepositoryName))
+
+	snapshot.Repository.Name = "minio"
+	snapshot.Repository.Bucket = "other-bucket"
+	asserts.NoError(reconcileSnapshotRepository(spi.NewFakeContext(c, newSnapshotVZ(snapshot), false)))
+	asserts.Equal("other-bucket", server.Repository("minio").Settings.Bucket)
+
+	// Nothing is registered without a snapshot configuration
+	asserts.NoError(reconcileSnapshotRepository(spi.NewFakeContext(c, newSnapshotVZ(nil), false)))
+}
+
+// TestReconcileSnapshotRepositoryFailed tests the reconcileSnapshotRepository function
+// GIVEN a Verrazzano resource with a snapshot repository
+//  WHEN I call reconcileSnapshotRepository before the VMI secret exists, when OpenSearch is unreachable and when it
+//   rejects the repository
+//  THEN retryable errors are returned until OpenSearch rejects the repository
+func TestReconcileSnapshotRepositoryFailed(t *testing.T) {
+	asserts := assert.New(t)
+	server := osfake.NewServer(testUsername, testPassword)
+	defer server.Close()
+	useFakeServer(server)
+	defer httpClient.Reset()
+	vz := newSnapshotVZ(newSnapshotSpec(""))
+
+	c := fake.NewClientBuilder().WithScheme(testScheme).Build()
+	asserts.IsType(ctrlerrors.RetryableError{}, reconcileSnapshotRepository(spi.NewFakeContext(c, vz, false)))
+
+	c = fake.NewClientBuilder().WithScheme(testScheme).WithObjects(newVMISecret()).Build()
+	server.FailRequests(http.MethodGet, "/_snapshot", http.StatusServiceUnavailable)
+	asserts.IsType(ctrlerrors.RetryableError{}, reconcileSnapshotRepository(spi.NewFakeContext(c, vz, false)))
+
+	server = osfake.NewServer(testUsername, testPassword)
+	defer server.Close()
+	useFakeServer(server)
+	server.FailRequests(http.MethodPut, "/_snapshot", http.StatusInternalServerError)
+	err := reconcileSnapshotRepository(spi.NewFakeContext(c, vz, false))
+	asserts.Error(err)
+	_, retryable := err.(ctrlerrors.RetryableError)
+	asserts.False(retryable)
+	asserts.Contains(err.Error(), "minio-credentials")
+	asserts.Nil(server.Repository(defaultRepositoryName))
+}
+
+// TestReconcileSnapshotSchedule tests the reconcileSnapshotSchedule function
+// GIVEN a Verrazzano resource with scheduled snapshots
+//  WHEN I call reconcileSnapshotSchedule, and call it again after the schedule is removed
+//  THEN the CronJob takes the snapshots through the ingress with the schedule, and is deleted once there is no schedule
+func TestReconcileSnapshotSchedule(t *testing.T) {
+	asserts := assert.New(t)
+	config.SetDefaultBomFilePath(testBomFilePath)
+	defer config.SetDefaultBomFilePath("")
+	c := fake.NewClientBuilder().WithScheme(testScheme).Build()
+
+	snapshot := newSnapshotSpec("0 3 * * *")
+	asserts.NoError(reconcileSnapshotSchedule(spi.NewFakeContext(c, newSnapshotVZ(snapshot), false)))
+	cronJob := getSnapshotCronJob(t, c)
+	asserts.NotNil(cronJob)
+	asserts.Equal("0 3 * * *", cronJob.Spec.Schedule)
+	asserts.Equal(batchv1.ForbidConcurrent, cronJob.Spec.ConcurrencyPolicy)
+	template := cronJob.Spec.JobTemplate.Spec.Template
+	asserts.Equal(snapshotName, template.Labels[snapshotNameLabel])
+	asserts.Equal("false", template.Annotations[istioSidecarInjectionAnno])
+	asserts.Equal(osCertificateName, template.Spec.Volumes[0].Secret.SecretName)
+	container := template.Spec.Containers[0]
+	asserts.Contains(container.Image, "opensearch:1.2.3")
+	asserts.Contains(container.Args[0], "wait_for_completion=true")
+	asserts.Contains(container.Env, corev1.EnvVar{Name: "OPENSEARCH_URL", Value: "https://elasticsearch.vmi.system.prod.blah"})
+	asserts.Contains(container.Env, corev1.EnvVar{Name: "REPOSITORY", Value: defaultRepositoryName})
+	asserts.Contains(container.Env, corev1.EnvVar{Name: "RETENTION_COUNT", Value: "7"})
+	asserts.Contains(container.Env, corev1.EnvVar{Name: "SNAPSHOT_REQUEST", Value: `{"indices":"verrazzano-*,.opendistro-ism-config","ignore_unavailable":true,"include_global_state":false}`})
+
+	snapshot.Schedule = ""
+	asserts.NoError(reconcileSnapshotSchedule(spi.NewFakeContext(c, newSnapshotVZ(snapshot), false)))
+	asserts.Nil(getSnapshotCronJob(t, c))
+	asserts.NoError(reconcileSnapshotSchedule(spi.NewFakeContext(c, newSnapshotVZ(nil), false)))
+}
+
+// TestGetOpenSearchHTTPClient tests the getOpenSearchHTTPClient function
+// GIVEN the certificate secret of the OpenSearch ingress
+//  WHEN I call getOpenSearchHTTPClient
+//  THEN the URL of the ingress is returned with a client that trusts the CA of the certificate
+func TestGetOpenSearchHTTPClient(t *testing.T) {
+	asserts := assert.New(t)
+	server := osfake.NewTLSServer(testUsername, testPassword)
+	defer server.Close()
+	caCert := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+	c := fake.NewClientBuilder().WithScheme(testScheme).WithObjects(&corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Namespace: ComponentNamespace, Name: osCertificateName},
+		Data:       map[string][]byte{opensearchCACert: caCert},
+	}).Build()
+
+	baseURL, hc, err := getOpenSearchHTTPClient(spi.NewFakeContext(c, newSnapshotVZ(nil), false))
+	asserts.NoError(err)
+	asserts.Equal("https://elasticsearch.vmi.system.prod.blah", baseURL)
+	// The fake server has a certificate for example.com
+	hc.Transport.(*http.Transport).TLSClientConfig.ServerName = "example.com"
+	osClient := opensearch.NewClient(server.URL, hc, testUsername, testPassword)
+	_, err = osClient.GetRepository(defaultRepositoryName)
+	asserts.True(opensearch.IsNotFound(err))
+}
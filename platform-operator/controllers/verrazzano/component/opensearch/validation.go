@@ -5,7 +5,10 @@ package opensearch
 
 import (
 	"fmt"
+	"strings"
+
 	vzapi "github.com/verrazzano/verrazzano/platform-operator/apis/verrazzano/v1alpha1"
+	"github.com/verrazzano/verrazzano/platform-operator/internal/vzconfig"
 )
 
 //entryTracker is a Set like construct to track if a value was seen already
@@ -68,3 +71,25 @@ func validateNoDuplicateNodeGroups(opensearch *vzapi.ElasticsearchComponent) err
 	}
 	return nil
 }
+
+//validateSnapshot rejects snapshot repositories without a bucket and credentials, and invalid snapshot schedules
+func validateSnapshot(vz *vzapi.Verrazzano) error {
+	snapshot := getSnapshotSpec(vz)
+	if snapshot == nil {
+		return nil
+	}
+	repo := snapshot.Repository
+	if repo.Endpoint == "" || repo.Bucket == "" || repo.CredentialsSecret == "" {
+		return fmt.Errorf("OpenSearch snapshot repository requires an endpoint, a bucket and a credentialsSecret")
+	}
+	if !vzconfig.IsNGINXEnabled(vz) {
+		return fmt.Errorf("OpenSearch snapshots require the ingress controller, the snapshots are taken through the OpenSearch ingress")
+	}
+	if snapshot.Schedule != "" && len(strings.Fields(snapshot.Schedule)) != 5 {
+		return fmt.Errorf("OpenSearch snapshot schedule %q is not a cron schedule with 5 fields", snapshot.Schedule)
+	}
+	if snapshot.RetentionCount != nil && *snapshot.RetentionCount < 1 {
+		return fmt.Errorf("OpenSearch snapshot retentionCount must be at least 1")
+	}
+	return nil
+}
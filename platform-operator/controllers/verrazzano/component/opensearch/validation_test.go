@@ -122,3 +122,79 @@ func TestValidateNoDuplicatedConfiguration(t *testing.T) {
 		})
 	}
 }
+
+func createSnapshotVZ(schedule string, retentionCount int32) *vzapi.Verrazzano {
+	return createVZ(&vzapi.ElasticsearchComponent{
+		Snapshot: &vzapi.OpenSearchSnapshot{
+			Repository: vzapi.OpenSearchSnapshotRepository{
+				Endpoint:          "http://minio.minio.svc:9000",
+				Bucket:            "vz-snapshots",
+				CredentialsSecret: "minio-credentials",
+			},
+			Schedule:       schedule,
+			RetentionCount: &retentionCount,
+		},
+	})
+}
+
+func TestValidateSnapshot(t *testing.T) {
+	disabled := false
+	noIngress := createSnapshotVZ("", 1)
+	noIngress.Spec.Components.Ingress = &vzapi.IngressNginxComponent{Enabled: &disabled}
+	var tests = []struct {
+		name     string
+		vz       *vzapi.Verrazzano
+		hasError bool
+	}{
+		{
+			"no error when there is no snapshot configuration",
+			emptyComponent,
+			false,
+		},
+		{
+			"no error when the snapshots are scheduled",
+			createSnapshotVZ("0 3 * * *", 7),
+			false,
+		},
+		{
+			"no error when the repository has no schedule",
+			createSnapshotVZ("", 1),
+			false,
+		},
+		{
+			"error when the repository has no bucket",
+			createVZ(&vzapi.ElasticsearchComponent{
+				Snapshot: &vzapi.OpenSearchSnapshot{
+					Repository: vzapi.OpenSearchSnapshotRepository{
+						Endpoint:          "http://minio.minio.svc:9000",
+						CredentialsSecret: "minio-credentials",
+					},
+				},
+			}),
+			true,
+		},
+		{
+			"error when the schedule is not a cron schedule",
+			createSnapshotVZ("@daily 3", 7),
+			true,
+		},
+		{
+			"error when the retention count is 0",
+			createSnapshotVZ("0 3 * * *", 0),
+			true,
+		},
+		{
+			"error when the ingress controller is disabled",
+			noIngress,
+			true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := validateSnapshot(tt.vz); (err != nil) != tt.hasError {
+				t.Errorf("validateSnapshot() error = %v, hasError: %v", err, tt.hasError)
+			}
+		})
+	}
+}
@@ -4,13 +4,18 @@
 package opensearch
 
 import (
+	"context"
 	"fmt"
 	"github.com/verrazzano/verrazzano/platform-operator/controllers/verrazzano/component/common"
 
 	vmov1 "github.com/verrazzano/verrazzano-monitoring-operator/pkg/apis/vmcontroller/v1"
 	vzapi "github.com/verrazzano/verrazzano/platform-operator/apis/verrazzano/v1alpha1"
 	"github.com/verrazzano/verrazzano/platform-operator/controllers/verrazzano/component/spi"
+	corev1 "k8s.io/api/core/v1"
+	storagev1 "k8s.io/api/storage/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/types"
 )
 
 const (
@@ -223,3 +228,74 @@ func setPVCNames(vmi *vmov1.VerrazzanoMonitoringInstance, node *vmov1.Elasticsea
 		}
 	}
 }
+
+// checkPVCCapacity returns an error for every OpenSearch PVC that is not bound, or that has less capacity than the
+// upgrade requests for its node and cannot be expanded because its storage class does not allow volume expansion
+func checkPVCCapacity(ctx spi.ComponentContext) []error {
+	vmi := common.NewVMI()
+	if err := ctx.Client().Get(context.TODO(), types.NamespacedName{Namespace: vmi.Namespace, Name: vmi.Name}, vmi); err != nil {
+		if errors.IsNotFound(err) {
+			return nil
+		}
+		return []error{fmt.Errorf("Failed getting the VMI %s/%s: %v", vmi.Namespace, vmi.Name, err)}
+	}
+	storage, err := common.FindStorageOverride(ctx.EffectiveCR())
+	if err != nil {
+		return []error{err}
+	}
+	hasDataNodeOverride := hasNodeStorageOverride(ctx.ActualCR(), "nodes.data.requests.storage")
+	hasMasterNodeOverride := hasNodeStorageOverride(ctx.ActualCR(), "nodes.master.requests.storage")
+	opensearch, err := newOpenSearch(ctx.EffectiveCR(), storage, vmi, hasDataNodeOverride, hasMasterNodeOverride)
+	if err != nil {
+		return []error{err}
+	}
+
+	var errs []error
+	nodes := append([]vmov1.ElasticsearchNode{opensearch.MasterNode, opensearch.DataNode}, opensearch.Nodes...)
+	for _, node := range nodes {
+		if node.Storage == nil || len(node.Storage.Size) == 0 {
+			continue
+		}
+		requested, err := resource.ParseQuantity(node.Storage.Size)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("Invalid OpenSearch storage size %s: %v", node.Storage.Size, err))
+			continue
+		}
+		for _, pvcName := range node.Storage.PvcNames {
+			if err := checkPVC(ctx, pvcName, requested); err != nil {
+				errs = append(errs, err)
+			}
+		}
+	}
+	return errs
+}
+
+// checkPVC returns an error if the PVC is not bound or cannot provide the requested capacity
+func checkPVC(ctx spi.ComponentContext, pvcName string, requested resource.Quantity) error {
+	pvc := corev1.PersistentVolumeClaim{}
+	if err := ctx.Client().Get(context.TODO(), types.NamespacedName{Namespace: ComponentNamespace, Name: pvcName}, &pvc); err != nil {
+		if errors.IsNotFound(err) {
+			return nil
+		}
+		return fmt.Errorf("Failed getting OpenSearch PVC %s/%s: %v", ComponentNamespace, pvcName, err)
+	}
+	if pvc.Status.Phase != corev1.ClaimBound {
+		return fmt.Errorf("OpenSearch PVC %s/%s is %s, not Bound", ComponentNamespace, pvcName, pvc.Status.Phase)
+	}
+	capacity := pvc.Status.Capacity[corev1.ResourceStorage]
+	if capacity.Cmp(requested) >= 0 {
+		return nil
+	}
+	if pvc.Spec.StorageClassName != nil {
+		storageClass := storagev1.StorageClass{}
+		if err := ctx.Client().Get(context.TODO(), types.NamespacedName{Name: *pvc.Spec.StorageClassName}, &storageClass); err != nil {
+			return fmt.Errorf("Failed getting storage class %s of OpenSearch PVC %s/%s: %v", *pvc.Spec.StorageClassName,
+				ComponentNamespace, pvcName, err)
+		}
+		if storageClass.AllowVolumeExpansion != nil && *storageClass.AllowVolumeExpansion {
+			return nil
+		}
+	}
+	return fmt.Errorf("OpenSearch PVC %s/%s has a capacity of %s, less than the requested %s, and it cannot be expanded",
+		ComponentNamespace, pvcName, capacity.String(), requested.String())
+}
@@ -12,8 +12,11 @@ import (
 	"github.com/verrazzano/verrazzano/platform-operator/controllers/verrazzano/component/common"
 	"github.com/verrazzano/verrazzano/platform-operator/controllers/verrazzano/component/spi"
 	corev1 "k8s.io/api/core/v1"
+	storagev1 "k8s.io/api/storage/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 	"testing"
 )
@@ -208,6 +211,57 @@ func TestCreateOrUpdateVMINoNGINX(t *testing.T) {
 	assert.EqualValues(t, 3, vmi.Spec.Elasticsearch.DataNode.Replicas)
 }
 
+// TestCheckPVCCapacity tests the OpenSearch PVC preflight check
+// GIVEN an existing VMI with a data node PVC and a Verrazzano CR that requests more data node storage
+// WHEN I check the PVC capacity
+//  THEN an error is returned if the PVC is not bound or cannot be expanded
+func TestCheckPVCCapacity(t *testing.T) {
+	const pvcName = "vmi-system-es-data"
+	vmi := common.NewVMI()
+	vmi.Spec.Elasticsearch.Storage = vmov1.Storage{Size: "50Gi", PvcNames: []string{pvcName}}
+	newPVC := func(phase corev1.PersistentVolumeClaimPhase, storageClassName string) *corev1.PersistentVolumeClaim {
+		return &corev1.PersistentVolumeClaim{
+			ObjectMeta: metav1.ObjectMeta{Name: pvcName, Namespace: ComponentNamespace},
+			Spec:       corev1.PersistentVolumeClaimSpec{StorageClassName: &storageClassName},
+			Status: corev1.PersistentVolumeClaimStatus{
+				Phase:    phase,
+				Capacity: corev1.ResourceList{corev1.ResourceStorage: resource.MustParse("50Gi")},
+			},
+		}
+	}
+	newStorageClass := func(name string, allowExpansion bool) *storagev1.StorageClass {
+		return &storagev1.StorageClass{
+			ObjectMeta:           metav1.ObjectMeta{Name: name},
+			AllowVolumeExpansion: &allowExpansion,
+		}
+	}
+
+	tests := []struct {
+		name   string
+		objs   []client.Object
+		errMsg string
+	}{
+		{name: "no VMI"},
+		{name: "expandable", objs: []client.Object{vmi.DeepCopy(), newPVC(corev1.ClaimBound, "expandable"), newStorageClass("expandable", true)}},
+		{name: "not bound", objs: []client.Object{vmi.DeepCopy(), newPVC(corev1.ClaimPending, "expandable"), newStorageClass("expandable", true)},
+			errMsg: "OpenSearch PVC verrazzano-system/vmi-system-es-data is Pending, not Bound"},
+		{name: "not expandable", objs: []client.Object{vmi.DeepCopy(), newPVC(corev1.ClaimBound, "fixed"), newStorageClass("fixed", false)},
+			errMsg: "OpenSearch PVC verrazzano-system/vmi-system-es-data has a capacity of 50Gi, less than the requested 100Gi, and it cannot be expanded"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := spi.NewFakeContext(fake.NewClientBuilder().WithScheme(testScheme).WithObjects(tt.objs...).Build(), &vmiEnabledCR, false)
+			errs := checkPVCCapacity(ctx)
+			if len(tt.errMsg) == 0 {
+				assert.Empty(t, errs)
+				return
+			}
+			assert.Len(t, errs, 1)
+			assert.EqualError(t, errs[0], tt.errMsg)
+		})
+	}
+}
+
 // TestHasDataNodeStorageOverride tests the detection of data node storage overrides
 // GIVEN a Verrazzano CR
 // WHEN I check for data node storage overrides
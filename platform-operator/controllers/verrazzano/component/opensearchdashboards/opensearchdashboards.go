@@ -18,8 +18,16 @@ const kibanaDeployment = "vmi-system-kibana"
 func isOSDReady(ctx spi.ComponentContext) bool {
 	prefix := fmt.Sprintf("Component %s", ctx.GetComponent())
 
-	var deployments []types.NamespacedName
+	if !status.DeploymentsAreReady(ctx.Log(), ctx.Client(), getOSDDeployments(ctx), 1, prefix) {
+		return false
+	}
+
+	return common.IsVMISecretReady(ctx)
+}
 
+// getOSDDeployments returns the OpenSearch-Dashboards deployments that are enabled
+func getOSDDeployments(ctx spi.ComponentContext) []types.NamespacedName {
+	var deployments []types.NamespacedName
 	if vzconfig.IsKibanaEnabled(ctx.EffectiveCR()) {
 		deployments = append(deployments,
 			types.NamespacedName{
@@ -27,12 +35,7 @@ func isOSDReady(ctx spi.ComponentContext) bool {
 				Namespace: ComponentNamespace,
 			})
 	}
-
-	if !status.DeploymentsAreReady(ctx.Log(), ctx.Client(), deployments, 1, prefix) {
-		return false
-	}
-
-	return common.IsVMISecretReady(ctx)
+	return deployments
 }
 
 // doesOSDExist is the IsInstalled check
@@ -4,12 +4,13 @@
 package opensearchdashboards
 
 import (
-	"fmt"
+	vmov1 "github.com/verrazzano/verrazzano-monitoring-operator/pkg/apis/vmcontroller/v1"
 	vzapi "github.com/verrazzano/verrazzano/platform-operator/apis/verrazzano/v1alpha1"
 	"github.com/verrazzano/verrazzano/platform-operator/constants"
 	"github.com/verrazzano/verrazzano/platform-operator/controllers/verrazzano/component/common"
 	"github.com/verrazzano/verrazzano/platform-operator/controllers/verrazzano/component/spi"
 	"github.com/verrazzano/verrazzano/platform-operator/controllers/verrazzano/component/vmo"
+	"github.com/verrazzano/verrazzano/platform-operator/internal/k8s/status"
 	"github.com/verrazzano/verrazzano/platform-operator/internal/vzconfig"
 	"k8s.io/apimachinery/pkg/types"
 )
@@ -83,7 +84,7 @@ func (d opensearchDashboardsComponent) PreInstall(ctx spi.ComponentContext) erro
 		return err
 	}
 	// create or update backup VMI secret
-	if err := common.EnsureBackupSecret(ctx.Client()); err != nil {
+	if err := common.EnsureBackupSecret(ctx.Client(), ctx.EffectiveCR()); err != nil {
 		return err
 	}
 	ctx.Log().Debug("OpenSearch-Dashboards pre-install")
@@ -114,6 +115,11 @@ func (d opensearchDashboardsComponent) IsReady(ctx spi.ComponentContext) bool {
 	return isOSDReady(ctx)
 }
 
+// GetNotReadyWorkloads returns the OpenSearch-Dashboards deployment if it is not ready
+func (d opensearchDashboardsComponent) GetNotReadyWorkloads(ctx spi.ComponentContext) ([]string, error) {
+	return status.GetNotReadyNamedWorkloads(ctx.Client(), getOSDDeployments(ctx))
+}
+
 // PostInstall OpenSearch-Dashboards post-install processing
 func (d opensearchDashboardsComponent) PostInstall(ctx spi.ComponentContext) error {
 	ctx.Log().Debugf("OpenSearch-Dashboards component post-upgrade")
@@ -128,6 +134,29 @@ func (d opensearchDashboardsComponent) PostUpgrade(ctx spi.ComponentContext) err
 
 }
 
+// IsUninstalled OpenSearch-Dashboards uninstall check
+func (d opensearchDashboardsComponent) IsUninstalled(ctx spi.ComponentContext) (bool, error) {
+	return !doesOSDExist(ctx), nil
+}
+
+// PreUninstall OpenSearch-Dashboards pre-uninstall processing
+func (d opensearchDashboardsComponent) PreUninstall(_ spi.ComponentContext) error {
+	return nil
+}
+
+// Uninstall OpenSearch-Dashboards component uninstall processing; the VMO removes the OpenSearch-Dashboards
+// resources when OpenSearch-Dashboards is removed from the VMI
+func (d opensearchDashboardsComponent) Uninstall(ctx spi.ComponentContext) error {
+	return common.RemoveFromVMI(ctx, func(vmi *vmov1.VerrazzanoMonitoringInstance) {
+		vmi.Spec.Kibana = vmov1.Kibana{}
+	})
+}
+
+// PostUninstall OpenSearch-Dashboards post-uninstall processing
+func (d opensearchDashboardsComponent) PostUninstall(_ spi.ComponentContext) error {
+	return nil
+}
+
 // IsEnabled OpenSearch-Dashboards specific enabled check for installation
 func (d opensearchDashboardsComponent) IsEnabled(effectiveCR *vzapi.Verrazzano) bool {
 	comp := effectiveCR.Spec.Components.Kibana
@@ -139,10 +168,6 @@ func (d opensearchDashboardsComponent) IsEnabled(effectiveCR *vzapi.Verrazzano)
 
 // ValidateUpdate checks if the specified new Verrazzano CR is valid for this component to be updated
 func (d opensearchDashboardsComponent) ValidateUpdate(old *vzapi.Verrazzano, new *vzapi.Verrazzano) error {
-	// Do not allow disabling active components
-	if err := d.isOpenSearchDashboardEnabled(old, new); err != nil {
-		return err
-	}
 	// Reject any other edits except InstallArgs
 	// Do not allow any updates to storage settings via the volumeClaimSpecTemplates/defaultVolumeSource
 	if err := common.CompareStorageOverrides(old, new, ComponentJSONName); err != nil {
@@ -161,14 +186,6 @@ func (d opensearchDashboardsComponent) Name() string {
 	return ComponentName
 }
 
-func (d opensearchDashboardsComponent) isOpenSearchDashboardEnabled(old *vzapi.Verrazzano, new *vzapi.Verrazzano) error {
-	// Do not allow disabling of any component post-install for now
-	if vzconfig.IsKibanaEnabled(old) && !vzconfig.IsKibanaEnabled(new) {
-		return fmt.Errorf("Disabling component OpenSearch-Dashboards not allowed")
-	}
-	return nil
-}
-
 // GetIngressNames - gets the names of the ingresses associated with this component
 func (d opensearchDashboardsComponent) GetIngressNames(ctx spi.ComponentContext) []types.NamespacedName {
 	var ingressNames []types.NamespacedName
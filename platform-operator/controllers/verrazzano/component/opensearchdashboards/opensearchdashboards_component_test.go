@@ -338,7 +338,7 @@ func Test_opensearchdashboardComponent_ValidateUpdate(t *testing.T) {
 					},
 				},
 			},
-			wantErr: true,
+			wantErr: false,
 		},
 		{
 			name: "change-installargs",
@@ -482,7 +482,7 @@ func Test_opensearchdashboardComponent_ValidateUpdate(t *testing.T) {
 					},
 				},
 			},
-			wantErr: true,
+			wantErr: false,
 		},
 		{
 			// Change to OS installargs allowed, persistence changes are supported
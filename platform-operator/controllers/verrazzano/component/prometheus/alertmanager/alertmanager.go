@@ -0,0 +1,297 @@
+// Copyright (c) 2022, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package alertmanager
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/verrazzano/verrazzano/pkg/bom"
+	vzapi "github.com/verrazzano/verrazzano/platform-operator/apis/verrazzano/v1alpha1"
+	"github.com/verrazzano/verrazzano/platform-operator/constants"
+	"github.com/verrazzano/verrazzano/platform-operator/controllers/verrazzano/component/prometheus"
+	promoperator "github.com/verrazzano/verrazzano/platform-operator/controllers/verrazzano/component/prometheus/operator"
+	"github.com/verrazzano/verrazzano/platform-operator/controllers/verrazzano/component/spi"
+	"github.com/verrazzano/verrazzano/platform-operator/internal/k8s/status"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	controllerruntime "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	// statefulSetName is the name that the Prometheus Operator gives the statefulset of the verrazzano Alertmanager
+	statefulSetName = "alertmanager-verrazzano"
+
+	// configSecretName is the secret with the Alertmanager configuration, it matches the configSecret of the chart
+	configSecretName = "verrazzano-alertmanager-config"
+	configKey        = "alertmanager.yaml"
+
+	// defaultReceiverName is the receiver of the alerts that match no receiver, it drops them
+	defaultReceiverName = "verrazzano-default"
+
+	usernameKey = "username"
+	passwordKey = "password"
+)
+
+// alertmanagerConfig is the alertmanager.yaml configuration file
+type alertmanagerConfig struct {
+	Route     route      `json:"route"`
+	Receivers []receiver `json:"receivers"`
+}
+
+type route struct {
+	Receiver       string   `json:"receiver"`
+	GroupBy        []string `json:"group_by,omitempty"`
+	GroupWait      string   `json:"group_wait,omitempty"`
+	GroupInterval  string   `json:"group_interval,omitempty"`
+	RepeatInterval string   `json:"repeat_interval,omitempty"`
+	Matchers       []string `json:"matchers,omitempty"`
+	Continue       bool     `json:"continue,omitempty"`
+	Routes         []route  `json:"routes,omitempty"`
+}
+
+type receiver struct {
+	Name           string          `json:"name"`
+	WebhookConfigs []webhookConfig `json:"webhook_configs,omitempty"`
+	EmailConfigs   []emailConfig   `json:"email_configs,omitempty"`
+}
+
+type webhookConfig struct {
+	URL          string      `json:"url"`
+	SendResolved bool        `json:"send_resolved"`
+	HTTPConfig   *httpConfig `json:"http_config,omitempty"`
+}
+
+type httpConfig struct {
+	BasicAuth *basicAuth `json:"basic_auth,omitempty"`
+}
+
+type basicAuth struct {
+	Username string `json:"username"`
+	Password string `json:"password"`
+}
+
+type emailConfig struct {
+	To           string `json:"to"`
+	From         string `json:"from"`
+	Smarthost    string `json:"smarthost"`
+	AuthUsername string `json:"auth_username,omitempty"`
+	AuthPassword string `json:"auth_password,omitempty"`
+	RequireTLS   bool   `json:"require_tls"`
+	SendResolved bool   `json:"send_resolved"`
+}
+
+// isAlertmanagerReady checks if the Alertmanager statefulset is ready
+func isAlertmanagerReady(ctx spi.ComponentContext) bool {
+	statefulsets := []types.NamespacedName{
+		{
+			Name:      statefulSetName,
+			Namespace: ComponentNamespace,
+		},
+	}
+	prefix := fmt.Sprintf("Component %s", ctx.GetComponent())
+	return status.StatefulSetsAreReady(ctx.Log(), ctx.Client(), statefulsets, 1, prefix)
+}
+
+// preHook creates the verrazzano-monitoring namespace and the configuration secret of Alertmanager
+func preHook(ctx spi.ComponentContext) error {
+	// Do nothing if dry run
+	if ctx.IsDryRun() {
+		ctx.Log().Debug("Alertmanager PreInstall dry run")
+		return nil
+	}
+
+	ctx.Log().Debugf("Creating namespace %s for the Alertmanager Component", ComponentNamespace)
+	if _, err := controllerruntime.CreateOrUpdate(context.TODO(), ctx.Client(), prometheus.GetVerrazzanoMonitoringNamespace(), func() error {
+		return nil
+	}); err != nil {
+		return ctx.Log().ErrorfNewErr("Failed to create or update the %s namespace: %v", ComponentNamespace, err)
+	}
+	return createOrUpdateConfigSecret(ctx)
+}
+
+// createOrUpdateConfigSecret writes the Alertmanager configuration generated from the receivers in the Verrazzano CR.
+// The Prometheus Operator reloads Alertmanager when the secret changes.
+func createOrUpdateConfigSecret(ctx spi.ComponentContext) error {
+	amConfig, err := buildConfig(ctx)
+	if err != nil {
+		return err
+	}
+	data, err := yaml.Marshal(amConfig)
+	if err != nil {
+		return ctx.Log().ErrorfNewErr("Failed to marshal the Alertmanager configuration: %v", err)
+	}
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      configSecretName,
+			Namespace: ComponentNamespace,
+		},
+	}
+	if _, err := controllerruntime.CreateOrUpdate(context.TODO(), ctx.Client(), secret, func() error {
+		secret.Data = map[string][]byte{configKey: data}
+		return nil
+	}); err != nil {
+		return ctx.Log().ErrorfNewErr("Failed to create or update the %s secret: %v", configSecretName, err)
+	}
+	return nil
+}
+
+// buildConfig returns the Alertmanager configuration of the receivers in the Verrazzano CR.  Every receiver has a
+// route that continues to the next one, so that an alert is sent to all of the receivers whose severities match.
+func buildConfig(ctx spi.ComponentContext) (*alertmanagerConfig, error) {
+	amConfig := &alertmanagerConfig{
+		Route: route{
+			Receiver:       defaultReceiverName,
+			GroupBy:        []string{"alertname", "namespace"},
+			GroupWait:      "30s",
+			GroupInterval:  "5m",
+			RepeatInterval: "4h",
+		},
+		Receivers: []receiver{{Name: defaultReceiverName}},
+	}
+	for _, r := range getReceivers(ctx.EffectiveCR()) {
+		rcv := receiver{Name: r.Name}
+		sendResolved := r.SendResolved == nil || *r.SendResolved
+		switch {
+		case r.Webhook != nil:
+			webhook := webhookConfig{URL: r.Webhook.URL, SendResolved: sendResolved}
+			if r.Webhook.CredentialsSecret != "" {
+				username, password, err := getCredentials(ctx, r.Name, r.Webhook.CredentialsSecret)
+				if err != nil {
+					return nil, err
+				}
+				webhook.HTTPConfig = &httpConfig{BasicAuth: &basicAuth{Username: username, Password: password}}
+			}
+			rcv.WebhookConfigs = []webhookConfig{webhook}
+		case r.Email != nil:
+			email := emailConfig{
+				To:           strings.Join(r.Email.To, ", "),
+				From:         r.Email.From,
+				Smarthost:    r.Email.Smarthost,
+				RequireTLS:   r.Email.RequireTLS == nil || *r.Email.RequireTLS,
+				SendResolved: sendResolved,
+			}
+			if r.Email.CredentialsSecret != "" {
+				username, password, err := getCredentials(ctx, r.Name, r.Email.CredentialsSecret)
+				if err != nil {
+					return nil, err
+				}
+				email.AuthUsername = username
+				email.AuthPassword = password
+			}
+			rcv.EmailConfigs = []emailConfig{email}
+		}
+		// A local receiver has no integrations, its alerts are read with the Alertmanager API
+		amConfig.Receivers = append(amConfig.Receivers, rcv)
+
+		rt := route{Receiver: r.Name, Continue: true}
+		if len(r.Severities) > 0 {
+			rt.Matchers = []string{fmt.Sprintf(`severity=~"%s"`, strings.Join(r.Severities, "|"))}
+		}
+		amConfig.Route.Routes = append(amConfig.Route.Routes, rt)
+	}
+	return amConfig, nil
+}
+
+// getCredentials returns the username and password in a secret of the verrazzano-install namespace
+func getCredentials(ctx spi.ComponentContext, receiverName string, secretName string) (string, string, error) {
+	secret := &corev1.Secret{}
+	nsn := types.NamespacedName{Namespace: constants.VerrazzanoInstallNamespace, Name: secretName}
+	if err := ctx.Client().Get(context.TODO(), nsn, secret); err != nil {
+		return "", "", ctx.Log().ErrorfNewErr("Failed getting the credentials secret %v of Alertmanager receiver %s: %v", nsn, receiverName, err)
+	}
+	username, password := string(secret.Data[usernameKey]), string(secret.Data[passwordKey])
+	if username == "" || password == "" {
+		return "", "", ctx.Log().ErrorfNewErr("The credentials secret %v of Alertmanager receiver %s requires the %s and %s keys", nsn, receiverName, usernameKey, passwordKey)
+	}
+	return username, password, nil
+}
+
+func getReceivers(cr *vzapi.Verrazzano) []vzapi.AlertmanagerReceiver {
+	if cr.Spec.Components.Alertmanager == nil {
+		return nil
+	}
+	return cr.Spec.Components.Alertmanager.Receivers
+}
+
+// AppendOverrides enables the alert rules of the Verrazzano components unless they are disabled in the Verrazzano CR
+func AppendOverrides(ctx spi.ComponentContext, _ string, _ string, _ string, kvs []bom.KeyValue) ([]bom.KeyValue, error) {
+	defaultRules := true
+	if am := ctx.EffectiveCR().Spec.Components.Alertmanager; am != nil && am.DefaultRules != nil {
+		defaultRules = *am.DefaultRules
+	}
+	return append(kvs, bom.KeyValue{Key: "defaultRules.enabled", Value: strconv.FormatBool(defaultRules)}), nil
+}
+
+// GetOverrides returns install overrides for a component
+func GetOverrides(effectiveCR *vzapi.Verrazzano) []vzapi.Overrides {
+	if effectiveCR.Spec.Components.Alertmanager != nil {
+		return effectiveCR.Spec.Components.Alertmanager.ValueOverrides
+	}
+	return []vzapi.Overrides{}
+}
+
+// validateAlertmanager checks that the Prometheus Operator is enabled with Alertmanager, and that every receiver has a
+// unique name and exactly one complete destination
+func validateAlertmanager(vz *vzapi.Verrazzano) error {
+	am := vz.Spec.Components.Alertmanager
+	if am == nil || am.Enabled == nil || !*am.Enabled {
+		return nil
+	}
+	if !promoperator.NewComponent().IsEnabled(vz) {
+		return fmt.Errorf("Alertmanager cannot be enabled if the Prometheus Operator is disabled")
+	}
+	names := map[string]bool{}
+	for _, r := range am.Receivers {
+		if r.Name == "" || r.Name == defaultReceiverName {
+			return fmt.Errorf("Alertmanager receiver name %q is not valid", r.Name)
+		}
+		if names[r.Name] {
+			return fmt.Errorf("Alertmanager receiver name %s is used more than once", r.Name)
+		}
+		names[r.Name] = true
+		if err := validateReceiver(r); err != nil {
+			return err
+		}
+	}
+	return vzapi.ValidateInstallOverrides(am.ValueOverrides)
+}
+
+func validateReceiver(r vzapi.AlertmanagerReceiver) error {
+	destinations := 0
+	for _, set := range []bool{r.Webhook != nil, r.Email != nil, r.Local != nil} {
+		if set {
+			destinations++
+		}
+	}
+	if destinations != 1 {
+		return fmt.Errorf("Alertmanager receiver %s requires exactly one of webhook, email and local", r.Name)
+	}
+	for _, severity := range r.Severities {
+		if severity == "" || strings.ContainsAny(severity, `|"`) {
+			return fmt.Errorf("Alertmanager receiver %s severity %q is not valid", r.Name, severity)
+		}
+	}
+	if r.Webhook != nil {
+		u, err := url.Parse(r.Webhook.URL)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return fmt.Errorf("Alertmanager receiver %s webhook URL %q is not an http or https URL", r.Name, r.Webhook.URL)
+		}
+	}
+	if r.Email != nil {
+		if len(r.Email.To) == 0 || r.Email.From == "" || r.Email.Smarthost == "" {
+			return fmt.Errorf("Alertmanager receiver %s email requires to, from and smarthost", r.Name)
+		}
+		if _, _, err := net.SplitHostPort(r.Email.Smarthost); err != nil {
+			return fmt.Errorf("Alertmanager receiver %s email smarthost %q is not a host and port", r.Name, r.Email.Smarthost)
+		}
+	}
+	return nil
+}
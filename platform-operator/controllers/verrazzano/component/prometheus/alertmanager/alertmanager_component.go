@@ -0,0 +1,98 @@
+// Copyright (c) 2022, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package alertmanager
+
+import (
+	"path/filepath"
+
+	vzapi "github.com/verrazzano/verrazzano/platform-operator/apis/verrazzano/v1alpha1"
+	"github.com/verrazzano/verrazzano/platform-operator/constants"
+	"github.com/verrazzano/verrazzano/platform-operator/controllers/verrazzano/component/helm"
+	promoperator "github.com/verrazzano/verrazzano/platform-operator/controllers/verrazzano/component/prometheus/operator"
+	"github.com/verrazzano/verrazzano/platform-operator/controllers/verrazzano/component/spi"
+	"github.com/verrazzano/verrazzano/platform-operator/controllers/verrazzano/secret"
+	"github.com/verrazzano/verrazzano/platform-operator/internal/config"
+	"github.com/verrazzano/verrazzano/platform-operator/internal/vzconfig"
+)
+
+// ComponentName is the name of the component, it is also the name of the Alertmanager subcomponent in the bom
+const ComponentName = "alertmanager"
+
+// ComponentNamespace is the namespace of the component
+const ComponentNamespace = constants.VerrazzanoMonitoringNamespace
+
+// ComponentJSONName is the json name of the component in the CRD
+const ComponentJSONName = "alertmanager"
+
+const chartDir = "verrazzano-alertmanager"
+
+type alertmanagerComponent struct {
+	helm.HelmComponent
+}
+
+// Verify that alertmanagerComponent implements Component
+var _ spi.Component = alertmanagerComponent{}
+
+// NewComponent returns a new Alertmanager component
+func NewComponent() spi.Component {
+	return alertmanagerComponent{
+		helm.HelmComponent{
+			ReleaseName:             ComponentName,
+			JSONName:                ComponentJSONName,
+			ChartDir:                filepath.Join(config.GetHelmChartsDir(), chartDir),
+			ChartNamespace:          ComponentNamespace,
+			IgnoreNamespaceOverride: true,
+			SupportsOperatorInstall: true,
+			MinVerrazzanoVersion:    constants.VerrazzanoVersion1_4_0,
+			ImagePullSecretKeyname:  secret.DefaultImagePullSecretKeyName,
+			Dependencies:            []string{promoperator.ComponentName},
+			AppendOverridesFunc:     AppendOverrides,
+			GetInstallOverridesFunc: GetOverrides,
+		},
+	}
+}
+
+// IsEnabled returns true only if Alertmanager is explicitly enabled in the Verrazzano CR
+func (c alertmanagerComponent) IsEnabled(effectiveCR *vzapi.Verrazzano) bool {
+	return vzconfig.IsAlertmanagerEnabled(effectiveCR)
+}
+
+// IsReady checks if the Alertmanager statefulset is ready
+func (c alertmanagerComponent) IsReady(ctx spi.ComponentContext) bool {
+	if c.HelmComponent.IsReady(ctx) {
+		return isAlertmanagerReady(ctx)
+	}
+	return false
+}
+
+// PreInstall creates the namespace and the configuration secret of Alertmanager
+func (c alertmanagerComponent) PreInstall(ctx spi.ComponentContext) error {
+	return preHook(ctx)
+}
+
+// PreUpgrade updates the configuration secret of Alertmanager
+func (c alertmanagerComponent) PreUpgrade(ctx spi.ComponentContext) error {
+	return preHook(ctx)
+}
+
+// MonitorOverrides checks whether monitoring of install overrides is enabled or not
+func (c alertmanagerComponent) MonitorOverrides(ctx spi.ComponentContext) bool {
+	if ctx.EffectiveCR().Spec.Components.Alertmanager != nil {
+		if ctx.EffectiveCR().Spec.Components.Alertmanager.MonitorChanges != nil {
+			return *ctx.EffectiveCR().Spec.Components.Alertmanager.MonitorChanges
+		}
+		return true
+	}
+	return false
+}
+
+// ValidateInstall verifies the receivers of Alertmanager
+func (c alertmanagerComponent) ValidateInstall(vz *vzapi.Verrazzano) error {
+	return validateAlertmanager(vz)
+}
+
+// ValidateUpdate verifies the receivers of Alertmanager
+func (c alertmanagerComponent) ValidateUpdate(old *vzapi.Verrazzano, new *vzapi.Verrazzano) error {
+	return validateAlertmanager(new)
+}
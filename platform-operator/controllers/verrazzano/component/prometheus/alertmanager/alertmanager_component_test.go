@@ -0,0 +1,74 @@
+// Copyright (c) 2022, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package alertmanager
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	vzapi "github.com/verrazzano/verrazzano/platform-operator/apis/verrazzano/v1alpha1"
+	"github.com/verrazzano/verrazzano/platform-operator/controllers/verrazzano/component/spi"
+)
+
+const profilesRelativePath = "../../../../../manifests/profiles"
+
+// TestIsEnabled tests the IsEnabled function for the Alertmanager component
+func TestIsEnabled(t *testing.T) {
+	tests := []struct {
+		name       string
+		actualCR   vzapi.Verrazzano
+		expectTrue bool
+	}{
+		{
+			// GIVEN a default Verrazzano custom resource
+			// WHEN we call IsEnabled on the Alertmanager component
+			// THEN the call returns false
+			name:       "Test IsEnabled when using default Verrazzano CR",
+			actualCR:   vzapi.Verrazzano{},
+			expectTrue: false,
+		},
+		{
+			// GIVEN a Verrazzano custom resource with Alertmanager enabled
+			// WHEN we call IsEnabled on the Alertmanager component
+			// THEN the call returns true
+			name:       "Test IsEnabled when Alertmanager component set to enabled",
+			actualCR:   *newAlertmanagerCR(),
+			expectTrue: true,
+		},
+		{
+			// GIVEN a Verrazzano custom resource with Alertmanager disabled
+			// WHEN we call IsEnabled on the Alertmanager component
+			// THEN the call returns false
+			name: "Test IsEnabled when Alertmanager component set to disabled",
+			actualCR: vzapi.Verrazzano{
+				Spec: vzapi.VerrazzanoSpec{
+					Components: vzapi.ComponentSpec{
+						Alertmanager: &vzapi.AlertmanagerComponent{
+							Enabled: &falseValue,
+						},
+					},
+				},
+			},
+			expectTrue: false,
+		},
+	}
+
+	for i, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := spi.NewFakeContext(nil, &tests[i].actualCR, false, profilesRelativePath)
+			assert.Equal(t, tt.expectTrue, NewComponent().IsEnabled(ctx.EffectiveCR()))
+		})
+	}
+}
+
+// TestValidateUpdate tests the ValidateUpdate function for the Alertmanager component
+// GIVEN a Verrazzano CR that is updated with an invalid receiver
+// WHEN we call ValidateUpdate
+// THEN an error is returned
+func TestValidateUpdate(t *testing.T) {
+	old := newAlertmanagerCR()
+	new := newAlertmanagerCR(vzapi.AlertmanagerReceiver{Name: "hook"})
+	assert.NoError(t, NewComponent().ValidateUpdate(old, old))
+	assert.Error(t, NewComponent().ValidateUpdate(old, new))
+}
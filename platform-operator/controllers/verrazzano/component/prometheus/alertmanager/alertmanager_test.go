@@ -0,0 +1,288 @@
+// Copyright (c) 2022, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package alertmanager
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/verrazzano/verrazzano/pkg/bom"
+	vzapi "github.com/verrazzano/verrazzano/platform-operator/apis/verrazzano/v1alpha1"
+	"github.com/verrazzano/verrazzano/platform-operator/constants"
+	"github.com/verrazzano/verrazzano/platform-operator/controllers/verrazzano/component/spi"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/yaml"
+)
+
+var (
+	testScheme = runtime.NewScheme()
+
+	falseValue = false
+	trueValue  = true
+)
+
+func init() {
+	_ = clientgoscheme.AddToScheme(testScheme)
+	_ = vzapi.AddToScheme(testScheme)
+}
+
+// newAlertmanagerCR returns a Verrazzano CR with Alertmanager enabled and the given receivers
+func newAlertmanagerCR(receivers ...vzapi.AlertmanagerReceiver) *vzapi.Verrazzano {
+	return &vzapi.Verrazzano{
+		Spec: vzapi.VerrazzanoSpec{
+			Components: vzapi.ComponentSpec{
+				Alertmanager: &vzapi.AlertmanagerComponent{
+					Enabled:   &trueValue,
+					Receivers: receivers,
+				},
+			},
+		},
+	}
+}
+
+// newCredentialsSecret returns a secret in the verrazzano-install namespace with the username and password keys
+func newCredentialsSecret(name string) *corev1.Secret {
+	return &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: constants.VerrazzanoInstallNamespace,
+		},
+		Data: map[string][]byte{
+			usernameKey: []byte("user"),
+			passwordKey: []byte("secret"),
+		},
+	}
+}
+
+// TestIsAlertmanagerReady tests the isAlertmanagerReady function
+func TestIsAlertmanagerReady(t *testing.T) {
+	tests := []struct {
+		name   string
+		client client.Client
+	}{
+		{
+			// GIVEN the Alertmanager statefulset does not exist
+			// WHEN we call isAlertmanagerReady
+			// THEN the call returns false
+			name:   "Test IsReady when the Alertmanager statefulset does not exist",
+			client: fake.NewClientBuilder().WithScheme(testScheme).Build(),
+		},
+		{
+			// GIVEN the Alertmanager statefulset exists and there are no ready replicas
+			// WHEN we call isAlertmanagerReady
+			// THEN the call returns false
+			name: "Test IsReady when the Alertmanager statefulset is not ready",
+			client: fake.NewClientBuilder().WithScheme(testScheme).WithObjects(
+				&appsv1.StatefulSet{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: ComponentNamespace,
+						Name:      statefulSetName,
+					},
+					Status: appsv1.StatefulSetStatus{
+						Replicas:        1,
+						UpdatedReplicas: 1,
+						ReadyReplicas:   0,
+					},
+				}).Build(),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := spi.NewFakeContext(tt.client, &vzapi.Verrazzano{}, false)
+			assert.False(t, isAlertmanagerReady(ctx))
+		})
+	}
+}
+
+// TestPreHook tests the preHook function
+// GIVEN a Verrazzano CR with a webhook, an email and a local receiver
+// WHEN the preHook function is called
+// THEN the namespace and the configuration secret are created, with a receiver and a route for each receiver in the CR
+func TestPreHook(t *testing.T) {
+	cli := fake.NewClientBuilder().WithScheme(testScheme).WithObjects(
+		newCredentialsSecret("webhook-credentials"),
+		newCredentialsSecret("smtp-credentials"),
+	).Build()
+	vz := newAlertmanagerCR(
+		vzapi.AlertmanagerReceiver{
+			Name:       "ops-webhook",
+			Severities: []string{"critical"},
+			Webhook:    &vzapi.AlertmanagerWebhook{URL: "https://hooks.example.com/alerts", CredentialsSecret: "webhook-credentials"},
+		},
+		vzapi.AlertmanagerReceiver{
+			Name:         "ops-email",
+			SendResolved: &falseValue,
+			Email: &vzapi.AlertmanagerEmail{
+				To:                []string{"ops@example.com", "oncall@example.com"},
+				From:              "alertmanager@example.com",
+				Smarthost:         "smtp.example.com:587",
+				CredentialsSecret: "smtp-credentials",
+			},
+		},
+		vzapi.AlertmanagerReceiver{
+			Name:  "test",
+			Local: &vzapi.AlertmanagerLocal{},
+		},
+	)
+	ctx := spi.NewFakeContext(cli, vz, false)
+
+	assert.NoError(t, preHook(ctx))
+
+	ns := &corev1.Namespace{}
+	assert.NoError(t, cli.Get(context.TODO(), types.NamespacedName{Name: ComponentNamespace}, ns))
+
+	secret := &corev1.Secret{}
+	assert.NoError(t, cli.Get(context.TODO(), types.NamespacedName{Namespace: ComponentNamespace, Name: configSecretName}, secret))
+	amConfig := &alertmanagerConfig{}
+	assert.NoError(t, yaml.Unmarshal(secret.Data[configKey], amConfig))
+
+	assert.Equal(t, defaultReceiverName, amConfig.Route.Receiver)
+	assert.Len(t, amConfig.Receivers, 4)
+	assert.Len(t, amConfig.Route.Routes, 3)
+
+	webhook := amConfig.Receivers[1]
+	assert.Equal(t, "ops-webhook", webhook.Name)
+	assert.Len(t, webhook.WebhookConfigs, 1)
+	assert.Equal(t, "https://hooks.example.com/alerts", webhook.WebhookConfigs[0].URL)
+	assert.True(t, webhook.WebhookConfigs[0].SendResolved)
+	assert.Equal(t, "user", webhook.WebhookConfigs[0].HTTPConfig.BasicAuth.Username)
+	assert.Equal(t, "secret", webhook.WebhookConfigs[0].HTTPConfig.BasicAuth.Password)
+	assert.Equal(t, []string{`severity=~"critical"`}, amConfig.Route.Routes[0].Matchers)
+	assert.True(t, amConfig.Route.Routes[0].Continue)
+
+	email := amConfig.Receivers[2]
+	assert.Len(t, email.EmailConfigs, 1)
+	assert.Equal(t, "ops@example.com, oncall@example.com", email.EmailConfigs[0].To)
+	assert.Equal(t, "user", email.EmailConfigs[0].AuthUsername)
+	assert.True(t, email.EmailConfigs[0].RequireTLS)
+	assert.False(t, email.EmailConfigs[0].SendResolved)
+	assert.Empty(t, amConfig.Route.Routes[1].Matchers)
+
+	local := amConfig.Receivers[3]
+	assert.Equal(t, "test", local.Name)
+	assert.Empty(t, local.WebhookConfigs)
+	assert.Empty(t, local.EmailConfigs)
+}
+
+// TestPreHookMissingCredentials tests the preHook function
+// GIVEN a Verrazzano CR with a webhook receiver whose credentials secret does not exist
+// WHEN the preHook function is called
+// THEN an error is returned and the configuration secret is not created
+func TestPreHookMissingCredentials(t *testing.T) {
+	cli := fake.NewClientBuilder().WithScheme(testScheme).Build()
+	vz := newAlertmanagerCR(vzapi.AlertmanagerReceiver{
+		Name:    "ops-webhook",
+		Webhook: &vzapi.AlertmanagerWebhook{URL: "https://hooks.example.com/alerts", CredentialsSecret: "webhook-credentials"},
+	})
+	ctx := spi.NewFakeContext(cli, vz, false)
+
+	assert.Error(t, preHook(ctx))
+	secret := &corev1.Secret{}
+	assert.Error(t, cli.Get(context.TODO(), types.NamespacedName{Namespace: ComponentNamespace, Name: configSecretName}, secret))
+}
+
+// TestAppendOverrides tests the AppendOverrides function
+// GIVEN a Verrazzano CR with and without the default rules disabled
+// WHEN the AppendOverrides function is called
+// THEN the default rules are enabled unless they are disabled in the CR
+func TestAppendOverrides(t *testing.T) {
+	vz := newAlertmanagerCR()
+	ctx := spi.NewFakeContext(fake.NewClientBuilder().WithScheme(testScheme).Build(), vz, false)
+	kvs, err := AppendOverrides(ctx, "", "", "", []bom.KeyValue{})
+	assert.NoError(t, err)
+	assert.Equal(t, "true", bom.FindKV(kvs, "defaultRules.enabled"))
+
+	vz.Spec.Components.Alertmanager.DefaultRules = &falseValue
+	ctx = spi.NewFakeContext(fake.NewClientBuilder().WithScheme(testScheme).Build(), vz, false)
+	kvs, err = AppendOverrides(ctx, "", "", "", []bom.KeyValue{})
+	assert.NoError(t, err)
+	assert.Equal(t, "false", bom.FindKV(kvs, "defaultRules.enabled"))
+}
+
+// TestValidateAlertmanager tests the validateAlertmanager function
+func TestValidateAlertmanager(t *testing.T) {
+	webhook := &vzapi.AlertmanagerWebhook{URL: "https://hooks.example.com/alerts"}
+	tests := []struct {
+		name      string
+		vz        *vzapi.Verrazzano
+		expectErr bool
+	}{
+		{
+			name: "Alertmanager disabled",
+			vz:   &vzapi.Verrazzano{},
+		},
+		{
+			name: "valid receivers",
+			vz: newAlertmanagerCR(
+				vzapi.AlertmanagerReceiver{Name: "hook", Webhook: webhook},
+				vzapi.AlertmanagerReceiver{Name: "test", Local: &vzapi.AlertmanagerLocal{}},
+				vzapi.AlertmanagerReceiver{Name: "mail", Email: &vzapi.AlertmanagerEmail{
+					To: []string{"ops@example.com"}, From: "am@example.com", Smarthost: "smtp.example.com:587"}},
+			),
+		},
+		{
+			name: "Prometheus Operator disabled",
+			vz: func() *vzapi.Verrazzano {
+				vz := newAlertmanagerCR()
+				vz.Spec.Components.PrometheusOperator = &vzapi.PrometheusOperatorComponent{Enabled: &falseValue}
+				return vz
+			}(),
+			expectErr: true,
+		},
+		{
+			name:      "duplicate receiver names",
+			vz:        newAlertmanagerCR(vzapi.AlertmanagerReceiver{Name: "hook", Webhook: webhook}, vzapi.AlertmanagerReceiver{Name: "hook", Webhook: webhook}),
+			expectErr: true,
+		},
+		{
+			name:      "reserved receiver name",
+			vz:        newAlertmanagerCR(vzapi.AlertmanagerReceiver{Name: defaultReceiverName, Webhook: webhook}),
+			expectErr: true,
+		},
+		{
+			name:      "no destination",
+			vz:        newAlertmanagerCR(vzapi.AlertmanagerReceiver{Name: "hook"}),
+			expectErr: true,
+		},
+		{
+			name:      "two destinations",
+			vz:        newAlertmanagerCR(vzapi.AlertmanagerReceiver{Name: "hook", Webhook: webhook, Local: &vzapi.AlertmanagerLocal{}}),
+			expectErr: true,
+		},
+		{
+			name:      "webhook URL not http",
+			vz:        newAlertmanagerCR(vzapi.AlertmanagerReceiver{Name: "hook", Webhook: &vzapi.AlertmanagerWebhook{URL: "ftp://example.com"}}),
+			expectErr: true,
+		},
+		{
+			name:      "severity with a matcher character",
+			vz:        newAlertmanagerCR(vzapi.AlertmanagerReceiver{Name: "hook", Severities: []string{"critical|warning"}, Webhook: webhook}),
+			expectErr: true,
+		},
+		{
+			name: "email smarthost without a port",
+			vz: newAlertmanagerCR(vzapi.AlertmanagerReceiver{Name: "mail", Email: &vzapi.AlertmanagerEmail{
+				To: []string{"ops@example.com"}, From: "am@example.com", Smarthost: "smtp.example.com"}}),
+			expectErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateAlertmanager(tt.vz)
+			if tt.expectErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
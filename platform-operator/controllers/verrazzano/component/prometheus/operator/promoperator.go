@@ -36,6 +36,9 @@ const (
 	deploymentName  = "prometheus-operator-kube-p-operator"
 	istioVolumeName = "istio-certs-dir"
 	serviceAccount  = "cluster.local/ns/verrazzano-monitoring/sa/prometheus-operator-kube-p-prometheus"
+
+	// alertmanagerServiceName is the service that the Prometheus Operator creates for the Alertmanager resources
+	alertmanagerServiceName = "alertmanager-operated"
 )
 
 // isPrometheusOperatorReady checks if the Prometheus operator deployment is ready
@@ -167,6 +170,16 @@ func AppendOverrides(ctx spi.ComponentContext, _ string, _ string, _ string, kvs
 		return kvs, ctx.Log().ErrorfNewErr("Failed applying additional volume overrides for Prometheus")
 	}
 
+	// If Alertmanager is enabled, Prometheus sends the alerts to the service that the Prometheus Operator creates for it
+	if vzconfig.IsAlertmanagerEnabled(ctx.EffectiveCR()) {
+		kvs = append(kvs, []bom.KeyValue{
+			{Key: "prometheus.prometheusSpec.alertingEndpoints[0].name", Value: alertmanagerServiceName},
+			{Key: "prometheus.prometheusSpec.alertingEndpoints[0].namespace", Value: ComponentNamespace},
+			{Key: "prometheus.prometheusSpec.alertingEndpoints[0].port", Value: "web"},
+			{Key: "prometheus.prometheusSpec.alertingEndpoints[0].apiVersion", Value: "v2"},
+		}...)
+	}
+
 	// Add a label to Prometheus Operator resources to distinguish Verrazzano resources
 	kvs = append(kvs, bom.KeyValue{Key: fmt.Sprintf("commonLabels.%s", constants.VerrazzanoComponentLabelKey), Value: ComponentName})
 
@@ -298,6 +311,8 @@ func applySystemMonitors(ctx spi.ComponentContext) error {
 	args["monitoringNamespace"] = constants.VerrazzanoMonitoringNamespace
 	args["nginxNamespace"] = constants.IngressNginxNamespace
 	args["istioNamespace"] = constants.IstioSystemNamespace
+	args["certManagerNamespace"] = vzconst.CertManagerNamespace
+	args["installNamespace"] = constants.VerrazzanoInstallNamespace
 
 	// substitute template values to all files in the directory and apply the resulting YAML
 	dir := path.Join(config.GetThirdPartyManifestsDir(), "prometheus-operator")
@@ -180,6 +180,20 @@ func TestAppendOverrides(t *testing.T) {
 	assert.Len(t, kvs, 27)
 
 	assert.Equal(t, "false", bom.FindKV(kvs, "prometheusOperator.admissionWebhooks.certManager.enabled"))
+	assert.Empty(t, bom.FindKV(kvs, "prometheus.prometheusSpec.alertingEndpoints[0].name"))
+
+	// GIVEN a Verrazzano CR with Alertmanager enabled
+	// WHEN the AppendOverrides function is called
+	// THEN Prometheus sends the alerts to the Alertmanager service
+	vz.Spec.Components.Alertmanager = &vzapi.AlertmanagerComponent{Enabled: &trueValue}
+	ctx = spi.NewFakeContext(client, vz, false)
+	kvs = make([]bom.KeyValue, 0)
+
+	kvs, err = AppendOverrides(ctx, "", "", "", kvs)
+	assert.NoError(t, err)
+	assert.Len(t, kvs, 31)
+	assert.Equal(t, alertmanagerServiceName, bom.FindKV(kvs, "prometheus.prometheusSpec.alertingEndpoints[0].name"))
+	assert.Equal(t, ComponentNamespace, bom.FindKV(kvs, "prometheus.prometheusSpec.alertingEndpoints[0].namespace"))
 }
 
 // TestPreInstall tests the preInstall function.
@@ -354,19 +368,19 @@ func TestApplySystemMonitors(t *testing.T) {
 	err := applySystemMonitors(ctx)
 	assert.NoError(t, err)
 
-	// expect that 3 PodMonitors are created
+	// expect that 5 PodMonitors are created
 	monitors := &unstructured.UnstructuredList{}
 	monitors.SetGroupVersionKind(schema.GroupVersionKind{Group: "monitoring.coreos.com", Version: "v1", Kind: "PodMonitor"})
 	err = client.List(context.TODO(), monitors)
 	assert.NoError(t, err)
-	assert.Len(t, monitors.Items, 3)
+	assert.Len(t, monitors.Items, 5)
 
-	// expect that 1 ServiceMonitor is created
+	// expect that 2 ServiceMonitors are created
 	monitors = &unstructured.UnstructuredList{}
 	monitors.SetGroupVersionKind(schema.GroupVersionKind{Group: "monitoring.coreos.com", Version: "v1", Kind: "ServiceMonitor"})
 	err = client.List(context.TODO(), monitors)
 	assert.NoError(t, err)
-	assert.Len(t, monitors.Items, 1)
+	assert.Len(t, monitors.Items, 2)
 }
 
 // TestValidatePrometheusOperator tests the validation of the Prometheus Operator installation and the Verrazzano CR
@@ -8,6 +8,7 @@ import (
 	"errors"
 	"fmt"
 	vzapi "github.com/verrazzano/verrazzano/platform-operator/apis/verrazzano/v1alpha1"
+	"github.com/verrazzano/verrazzano/platform-operator/controllers/verrazzano/component/certmanager"
 	"github.com/verrazzano/verrazzano/platform-operator/controllers/verrazzano/component/common"
 	"github.com/verrazzano/verrazzano/platform-operator/internal/vzconfig"
 	appsv1 "k8s.io/api/apps/v1"
@@ -66,6 +67,8 @@ func patchRancherIngress(c client.Client, vz *vzapi.Verrazzano) error {
 	ingress.Annotations["kubernetes.io/tls-acme"] = "true"
 	if (cm.Certificate.Acme != vzapi.Acme{}) {
 		addAcmeIngressAnnotations(vz.Spec.EnvironmentName, dnsSuffix, ingress)
+	} else if (cm.Certificate.IssuerRef != vzapi.IssuerRef{}) {
+		addIssuerRefIngressAnnotations(vz.Spec.EnvironmentName, dnsSuffix, cm.Certificate.IssuerRef, ingress)
 	} else {
 		addCAIngressAnnotations(vz.Spec.EnvironmentName, dnsSuffix, ingress)
 	}
@@ -86,5 +89,20 @@ func addAcmeIngressAnnotations(name, dnsSuffix string, ingress *networking.Ingre
 func addCAIngressAnnotations(name, dnsSuffix string, ingress *networking.Ingress) {
 	ingress.Annotations["nginx.ingress.kubernetes.io/auth-realm"] = fmt.Sprintf("%s.%s auth", name, dnsSuffix)
 	ingress.Annotations["cert-manager.io/cluster-issuer"] = "verrazzano-cluster-issuer"
+	// Remove the issuer annotation of an external Issuer
+	delete(ingress.Annotations, "cert-manager.io/issuer")
 	ingress.Annotations["cert-manager.io/common-name"] = fmt.Sprintf("%s.%s.%s", common.RancherName, name, dnsSuffix)
 }
+
+//addIssuerRefIngressAnnotations annotate ingress with the external issuer referenced by the certificate configuration
+func addIssuerRefIngressAnnotations(name, dnsSuffix string, issuerRef vzapi.IssuerRef, ingress *networking.Ingress) {
+	ingress.Annotations["nginx.ingress.kubernetes.io/auth-realm"] = fmt.Sprintf("%s.%s auth", name, dnsSuffix)
+	ingress.Annotations["cert-manager.io/common-name"] = fmt.Sprintf("%s.%s.%s", common.RancherName, name, dnsSuffix)
+	if certmanager.GetIssuerKind(issuerRef) == certmanager.IssuerKind {
+		ingress.Annotations["cert-manager.io/issuer"] = issuerRef.Name
+		delete(ingress.Annotations, "cert-manager.io/cluster-issuer")
+	} else {
+		ingress.Annotations["cert-manager.io/cluster-issuer"] = issuerRef.Name
+		delete(ingress.Annotations, "cert-manager.io/issuer")
+	}
+}
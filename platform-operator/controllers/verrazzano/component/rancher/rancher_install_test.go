@@ -71,6 +71,36 @@ func TestAddCAIngressAnnotations(t *testing.T) {
 	assert.Equal(t, out, in)
 }
 
+// TestAddIssuerRefIngressAnnotations verifies if the annotations of an external issuer are added to the Ingress
+// GIVEN a Rancher Ingress annotated with the Verrazzano ClusterIssuer
+//  WHEN addIssuerRefIngressAnnotations is called with a ClusterIssuer and an Issuer
+//  THEN addIssuerRefIngressAnnotations should annotate the ingress with the referenced issuer
+func TestAddIssuerRefIngressAnnotations(t *testing.T) {
+	in := networking.Ingress{
+		ObjectMeta: metav1.ObjectMeta{
+			Annotations: map[string]string{
+				"cert-manager.io/cluster-issuer": "verrazzano-cluster-issuer",
+			},
+		},
+	}
+	out := networking.Ingress{
+		ObjectMeta: metav1.ObjectMeta{
+			Annotations: map[string]string{
+				"nginx.ingress.kubernetes.io/auth-realm": fmt.Sprintf("%s.%s auth", name, dnsSuffix),
+				"cert-manager.io/cluster-issuer":         "vault-issuer",
+				"cert-manager.io/common-name":            fmt.Sprintf("%s.%s.%s", common.RancherName, name, dnsSuffix),
+			},
+		},
+	}
+
+	addIssuerRefIngressAnnotations(name, dnsSuffix, vzapi.IssuerRef{Name: "vault-issuer"}, &in)
+	assert.Equal(t, out, in)
+
+	addIssuerRefIngressAnnotations(name, dnsSuffix, vzapi.IssuerRef{Name: "vault-issuer", Kind: "Issuer"}, &in)
+	assert.Equal(t, "vault-issuer", in.Annotations["cert-manager.io/issuer"])
+	assert.NotContains(t, in.Annotations, "cert-manager.io/cluster-issuer")
+}
+
 // TestPatchRancherIngress should annotate the Rancher ingress with Acme/Private CA values
 // GIVEN a Rancher Ingress and a Verrazzano CR
 //  WHEN patchRancherIngress is called
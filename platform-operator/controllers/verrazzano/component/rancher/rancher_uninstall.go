@@ -0,0 +1,254 @@
+// Copyright (c) 2022, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package rancher
+
+import (
+	"context"
+	"regexp"
+	"strings"
+
+	"github.com/verrazzano/verrazzano/pkg/helm"
+	"github.com/verrazzano/verrazzano/pkg/log/vzlog"
+	"github.com/verrazzano/verrazzano/platform-operator/controllers/verrazzano/component/common"
+	"github.com/verrazzano/verrazzano/platform-operator/controllers/verrazzano/component/spi"
+	adminv1 "k8s.io/api/admissionregistration/v1"
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	rancherManagementGroup = "management.cattle.io"
+	rancherFinalizer       = "controller.cattle.io"
+	projectIDAnnotation    = "field.cattle.io/projectId"
+)
+
+// rancherHelmReleases are the Helm releases installed by Rancher, by namespace
+var rancherHelmReleases = map[string][]string{
+	"fleet-system":            {"fleet", "fleet-crd", "fleet-agent"},
+	FleetSystemNamespace:      {"fleet", "fleet-crd"},
+	FleetLocalSystemNamespace: {"fleet-agent-local"},
+	ComponentNamespace:        {"rancher-webhook"},
+}
+
+// rancherNamespaces are the namespaces created by Rancher
+var rancherNamespaces = []string{
+	"cattle-fleet-clusters-system",
+	FleetLocalSystemNamespace,
+	FleetSystemNamespace,
+	"cattle-global-data",
+	"cattle-global-nt",
+	"cattle-impersonation-system",
+	"fleet-default",
+	"fleet-local",
+	ComponentNamespace,
+}
+
+// systemNamespaces are the Kubernetes namespaces where Rancher creates RoleBindings and annotates Secrets
+var systemNamespaces = []string{"default", "kube-node-lease", "kube-public", "kube-system"}
+
+// rancherClusterRolesRegexp matches the ClusterRoles and ClusterRoleBindings created by Rancher
+const rancherClusterRolesRegexp = `cattle\.io|^cattle-|rancher-webhook|fleetworkspace-|^fleet-|gitjob|^proxy-clusterrole-kubeapiserver|^proxy-role-binding-kubernetes-master|^local-cluster`
+
+// rancherNamespacesRegexp matches the namespaces whose finalizers are removed, Rancher is no longer running to remove them
+const rancherNamespacesRegexp = `^cattle-|^local|^p-|^user-|^fleet|^rancher`
+
+type helmUninstallFuncSig func(log vzlog.VerrazzanoLogger, releaseName string, namespace string, dryRun bool) (stdout []byte, stderr []byte, err error)
+
+var helmUninstallFunction helmUninstallFuncSig = helm.Uninstall
+
+type isReleaseInstalledFuncSig func(releaseName string, namespace string) (found bool, err error)
+
+var isReleaseInstalledFunction isReleaseInstalledFuncSig = helm.IsReleaseInstalled
+
+// PostUninstall removes what Rancher leaves in the cluster once its chart is uninstalled: the charts Rancher installed,
+// the webhooks, RBAC resources, ConfigMaps, finalizers and namespaces.  The Rancher custom resources and CRDs are only
+// deleted with Verrazzano.
+func (r rancherComponent) PostUninstall(ctx spi.ComponentContext) error {
+	if ctx.IsDryRun() {
+		ctx.Log().Debug("Rancher PostUninstall dry run")
+		return nil
+	}
+	if err := uninstallRancherCharts(ctx); err != nil {
+		return err
+	}
+	if err := deleteRancherWebhooks(ctx); err != nil {
+		return err
+	}
+	if common.IsVerrazzanoBeingDeleted(ctx) {
+		if err := deleteRancherManagementResources(ctx); err != nil {
+			return err
+		}
+		if err := common.DeleteCRDsMatching(ctx, `\.cattle\.io$`); err != nil {
+			return err
+		}
+	}
+	if err := common.DeleteClusterRolesMatching(ctx, rancherClusterRolesRegexp); err != nil {
+		return err
+	}
+	if err := deleteRancherSystemResources(ctx); err != nil {
+		return err
+	}
+	if err := removeRancherNamespaceFinalizers(ctx); err != nil {
+		return err
+	}
+	for _, ns := range rancherNamespaces {
+		if err := common.DeleteNamespace(ctx, ns); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// uninstallRancherCharts uninstalls the fleet and webhook charts that Rancher installed
+func uninstallRancherCharts(ctx spi.ComponentContext) error {
+	for namespace, releases := range rancherHelmReleases {
+		for _, release := range releases {
+			found, err := isReleaseInstalledFunction(release, namespace)
+			if err != nil {
+				return ctx.Log().ErrorfNewErr("Failed searching for release %s/%s: %v", namespace, release, err)
+			}
+			if !found {
+				continue
+			}
+			if _, _, err := helmUninstallFunction(ctx.Log(), release, namespace, false); err != nil {
+				return ctx.Log().ErrorfNewErr("Failed uninstalling release %s/%s: %v", namespace, release, err)
+			}
+		}
+	}
+	return nil
+}
+
+// isRancherObject returns true if the name or the labels of the object identify a Rancher resource
+func isRancherObject(obj client.Object) bool {
+	return strings.Contains(obj.GetName(), "cattle.io") || obj.GetLabels()["app"] == common.RancherName
+}
+
+// deleteRancherWebhooks deletes the mutating and validating webhook configurations of Rancher
+func deleteRancherWebhooks(ctx spi.ComponentContext) error {
+	mutating := adminv1.MutatingWebhookConfigurationList{}
+	if err := ctx.Client().List(context.TODO(), &mutating); err != nil {
+		return ctx.Log().ErrorfNewErr("Failed listing MutatingWebhookConfigurations: %v", err)
+	}
+	for i := range mutating.Items {
+		if isRancherObject(&mutating.Items[i]) {
+			if err := common.DeleteObjects(ctx, &mutating.Items[i]); err != nil {
+				return err
+			}
+		}
+	}
+	validating := adminv1.ValidatingWebhookConfigurationList{}
+	if err := ctx.Client().List(context.TODO(), &validating); err != nil {
+		return ctx.Log().ErrorfNewErr("Failed listing ValidatingWebhookConfigurations: %v", err)
+	}
+	for i := range validating.Items {
+		if isRancherObject(&validating.Items[i]) {
+			if err := common.DeleteObjects(ctx, &validating.Items[i]); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// deleteRancherManagementResources deletes the management.cattle.io custom resources, including the local cluster,
+// after removing their finalizers since Rancher is no longer running
+func deleteRancherManagementResources(ctx spi.ComponentContext) error {
+	crds := apiextensionsv1.CustomResourceDefinitionList{}
+	if err := ctx.Client().List(context.TODO(), &crds); err != nil {
+		return ctx.Log().ErrorfNewErr("Failed listing CRDs: %v", err)
+	}
+	for _, crd := range crds.Items {
+		if crd.Spec.Group != rancherManagementGroup {
+			continue
+		}
+		for _, version := range crd.Spec.Versions {
+			if !version.Storage {
+				continue
+			}
+			gvk := schema.GroupVersionKind{Group: crd.Spec.Group, Version: version.Name, Kind: crd.Spec.Names.Kind}
+			if err := common.DeleteAllResources(ctx, gvk, true); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// deleteRancherSystemResources deletes the RoleBindings and ConfigMaps that Rancher created in the Kubernetes system
+// namespaces, and removes the Rancher project annotation from their Secrets
+func deleteRancherSystemResources(ctx spi.ComponentContext) error {
+	for _, ns := range systemNamespaces {
+		roleBindings := rbacv1.RoleBindingList{}
+		if err := ctx.Client().List(context.TODO(), &roleBindings, client.InNamespace(ns)); err != nil {
+			return ctx.Log().ErrorfNewErr("Failed listing RoleBindings in namespace %s: %v", ns, err)
+		}
+		for i := range roleBindings.Items {
+			name := roleBindings.Items[i].Name
+			if strings.HasPrefix(name, "clusterrolebinding-") || strings.HasPrefix(name, "rb-") {
+				if err := common.DeleteObjects(ctx, &roleBindings.Items[i]); err != nil {
+					return err
+				}
+			}
+		}
+
+		secrets := corev1.SecretList{}
+		if err := ctx.Client().List(context.TODO(), &secrets, client.InNamespace(ns)); err != nil {
+			return ctx.Log().ErrorfNewErr("Failed listing Secrets in namespace %s: %v", ns, err)
+		}
+		for i := range secrets.Items {
+			secret := &secrets.Items[i]
+			if _, ok := secret.Annotations[projectIDAnnotation]; !ok {
+				continue
+			}
+			patch := client.MergeFrom(secret.DeepCopy())
+			delete(secret.Annotations, projectIDAnnotation)
+			if err := ctx.Client().Patch(context.TODO(), secret, patch); client.IgnoreNotFound(err) != nil {
+				return ctx.Log().ErrorfNewErr("Failed removing the Rancher annotation of Secret %s/%s: %v", ns, secret.Name, err)
+			}
+		}
+	}
+	for _, name := range []string{"cattle-controllers", "rancher-controller-lock"} {
+		cm := &corev1.ConfigMap{}
+		cm.Namespace = "kube-system"
+		cm.Name = name
+		if err := common.DeleteObjects(ctx, cm); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// removeRancherNamespaceFinalizers removes the finalizers of the namespaces created by Rancher, and the Rancher
+// finalizer from all the other namespaces.  Rancher is not always installed by Verrazzano, so the Rancher finalizer
+// is removed from every namespace.
+func removeRancherNamespaceFinalizers(ctx spi.ComponentContext) error {
+	re := regexp.MustCompile(rancherNamespacesRegexp)
+	namespaces := corev1.NamespaceList{}
+	if err := ctx.Client().List(context.TODO(), &namespaces); err != nil {
+		return ctx.Log().ErrorfNewErr("Failed listing namespaces: %v", err)
+	}
+	for i := range namespaces.Items {
+		ns := &namespaces.Items[i]
+		var finalizers []string
+		if !re.MatchString(ns.Name) {
+			for _, finalizer := range ns.Finalizers {
+				if !strings.Contains(finalizer, rancherFinalizer) {
+					finalizers = append(finalizers, finalizer)
+				}
+			}
+		}
+		if len(finalizers) == len(ns.Finalizers) {
+			continue
+		}
+		patch := client.MergeFrom(ns.DeepCopy())
+		ns.Finalizers = finalizers
+		if err := ctx.Client().Patch(context.TODO(), ns, patch); client.IgnoreNotFound(err) != nil {
+			return ctx.Log().ErrorfNewErr("Failed removing the finalizers of namespace %s: %v", ns.Name, err)
+		}
+	}
+	return nil
+}
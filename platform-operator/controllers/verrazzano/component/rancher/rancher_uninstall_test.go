@@ -0,0 +1,104 @@
+// Copyright (c) 2022, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package rancher
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/verrazzano/verrazzano/pkg/helm"
+	"github.com/verrazzano/verrazzano/pkg/log/vzlog"
+	vzapi "github.com/verrazzano/verrazzano/platform-operator/apis/verrazzano/v1alpha1"
+	"github.com/verrazzano/verrazzano/platform-operator/controllers/verrazzano/component/spi"
+	adminv1 "k8s.io/api/admissionregistration/v1"
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	k8scheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+// TestPostUninstall tests the Rancher PostUninstall function
+// GIVEN the resources that Rancher leaves in the cluster
+//  WHEN PostUninstall is called while Verrazzano is deleted
+//  THEN the Rancher charts, webhooks, CRDs, RBAC resources, annotations and namespaces are removed
+func TestPostUninstall(t *testing.T) {
+	var uninstalled []string
+	isReleaseInstalledFunction = func(releaseName string, namespace string) (bool, error) {
+		return releaseName == "rancher-webhook", nil
+	}
+	helmUninstallFunction = func(_ vzlog.VerrazzanoLogger, releaseName string, namespace string, _ bool) ([]byte, []byte, error) {
+		uninstalled = append(uninstalled, namespace+"/"+releaseName)
+		return nil, nil, nil
+	}
+	defer func() {
+		isReleaseInstalledFunction = helm.IsReleaseInstalled
+		helmUninstallFunction = helm.Uninstall
+	}()
+
+	scheme := runtime.NewScheme()
+	_ = k8scheme.AddToScheme(scheme)
+	_ = apiextensionsv1.AddToScheme(scheme)
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+		&adminv1.ValidatingWebhookConfiguration{ObjectMeta: metav1.ObjectMeta{Name: "rancher.cattle.io"}},
+		&adminv1.MutatingWebhookConfiguration{ObjectMeta: metav1.ObjectMeta{Name: "istio-sidecar-injector"}},
+		&apiextensionsv1.CustomResourceDefinition{ObjectMeta: metav1.ObjectMeta{Name: "bundles.fleet.cattle.io"}},
+		&rbacv1.ClusterRole{ObjectMeta: metav1.ObjectMeta{Name: "cattle-admin"}},
+		&rbacv1.ClusterRole{ObjectMeta: metav1.ObjectMeta{Name: "cluster-admin"}},
+		&rbacv1.RoleBinding{ObjectMeta: metav1.ObjectMeta{Namespace: "kube-system", Name: "rb-abcdef"}},
+		&corev1.Secret{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "token", Annotations: map[string]string{projectIDAnnotation: "p-1"}}},
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "fleet-local", Finalizers: []string{"controller.cattle.io/namespace-auth"}}},
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "myapp", Finalizers: []string{"controller.cattle.io/namespace-auth", "other"}}},
+	).Build()
+	now := metav1.Now()
+	vz := &vzapi.Verrazzano{ObjectMeta: metav1.ObjectMeta{DeletionTimestamp: &now}}
+
+	assert.NoError(t, NewComponent().PostUninstall(spi.NewFakeContext(c, vz, false)))
+
+	assert.Equal(t, []string{ComponentNamespace + "/rancher-webhook"}, uninstalled)
+	assertNotFound(t, c, types.NamespacedName{Name: "rancher.cattle.io"}, &adminv1.ValidatingWebhookConfiguration{})
+	assertNotFound(t, c, types.NamespacedName{Name: "bundles.fleet.cattle.io"}, &apiextensionsv1.CustomResourceDefinition{})
+	assertNotFound(t, c, types.NamespacedName{Name: "cattle-admin"}, &rbacv1.ClusterRole{})
+	assertNotFound(t, c, types.NamespacedName{Namespace: "kube-system", Name: "rb-abcdef"}, &rbacv1.RoleBinding{})
+	assertNotFound(t, c, types.NamespacedName{Name: "fleet-local"}, &corev1.Namespace{})
+	assert.NoError(t, c.Get(context.TODO(), types.NamespacedName{Name: "istio-sidecar-injector"}, &adminv1.MutatingWebhookConfiguration{}))
+	assert.NoError(t, c.Get(context.TODO(), types.NamespacedName{Name: "cluster-admin"}, &rbacv1.ClusterRole{}))
+
+	secret := corev1.Secret{}
+	assert.NoError(t, c.Get(context.TODO(), types.NamespacedName{Namespace: "default", Name: "token"}, &secret))
+	assert.NotContains(t, secret.Annotations, projectIDAnnotation)
+	ns := corev1.Namespace{}
+	assert.NoError(t, c.Get(context.TODO(), types.NamespacedName{Name: "myapp"}, &ns))
+	assert.Equal(t, []string{"other"}, ns.Finalizers)
+}
+
+// TestPostUninstallDisabled tests the Rancher PostUninstall function
+// GIVEN the Rancher CRDs
+//  WHEN PostUninstall is called when Rancher is disabled
+//  THEN the Rancher CRDs are not deleted
+func TestPostUninstallDisabled(t *testing.T) {
+	isReleaseInstalledFunction = func(_ string, _ string) (bool, error) { return false, nil }
+	defer func() { isReleaseInstalledFunction = helm.IsReleaseInstalled }()
+
+	scheme := runtime.NewScheme()
+	_ = k8scheme.AddToScheme(scheme)
+	_ = apiextensionsv1.AddToScheme(scheme)
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+		&apiextensionsv1.CustomResourceDefinition{ObjectMeta: metav1.ObjectMeta{Name: "clusters.management.cattle.io"}},
+	).Build()
+
+	assert.NoError(t, NewComponent().PostUninstall(spi.NewFakeContext(c, &vzapi.Verrazzano{}, false)))
+	assert.NoError(t, c.Get(context.TODO(), types.NamespacedName{Name: "clusters.management.cattle.io"}, &apiextensionsv1.CustomResourceDefinition{}))
+}
+
+func assertNotFound(t *testing.T, c client.Client, nsn types.NamespacedName, obj client.Object) {
+	err := c.Get(context.TODO(), nsn, obj)
+	assert.True(t, errors.IsNotFound(err), "expected %s to be deleted", nsn)
+}
@@ -4,6 +4,13 @@
 package registry
 
 import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	vzapi "github.com/verrazzano/verrazzano/platform-operator/apis/verrazzano/v1alpha1"
+	vzconst "github.com/verrazzano/verrazzano/platform-operator/constants"
 	"github.com/verrazzano/verrazzano/platform-operator/controllers/verrazzano/component/appoper"
 	"github.com/verrazzano/verrazzano/platform-operator/controllers/verrazzano/component/authproxy"
 	"github.com/verrazzano/verrazzano/platform-operator/controllers/verrazzano/component/certmanager"
@@ -22,6 +29,7 @@ import (
 	"github.com/verrazzano/verrazzano/platform-operator/controllers/verrazzano/component/opensearch"
 	"github.com/verrazzano/verrazzano/platform-operator/controllers/verrazzano/component/opensearchdashboards"
 	promadapter "github.com/verrazzano/verrazzano/platform-operator/controllers/verrazzano/component/prometheus/adapter"
+	"github.com/verrazzano/verrazzano/platform-operator/controllers/verrazzano/component/prometheus/alertmanager"
 	"github.com/verrazzano/verrazzano/platform-operator/controllers/verrazzano/component/prometheus/kubestatemetrics"
 	promnodeexporter "github.com/verrazzano/verrazzano/platform-operator/controllers/verrazzano/component/prometheus/nodeexporter"
 	promoperator "github.com/verrazzano/verrazzano/platform-operator/controllers/verrazzano/component/prometheus/operator"
@@ -35,10 +43,23 @@ import (
 
 type GetCompoentsFnType func() []spi.Component
 
+// PreflightFailure is an upgrade preflight check of a component that failed
+type PreflightFailure struct {
+	ComponentName string
+	Err           error
+}
+
 var getComponentsFn = getComponents
 
 var componentsRegistry []spi.Component
 
+// addonComponents are the components of the VerrazzanoAddon resources keyed by the namespace of the add-ons, they
+// come after the built-in components
+var (
+	addonComponents     = make(map[string][]spi.Component)
+	addonComponentsLock sync.RWMutex
+)
+
 // OverrideGetComponentsFn Allows overriding the set of registry components for testing purposes
 func OverrideGetComponentsFn(fnType GetCompoentsFnType) {
 	getComponentsFn = fnType
@@ -58,6 +79,27 @@ func GetComponents() []spi.Component {
 
 // getComponents is the internal impl function for GetComponents, to allow overriding it for testing purposes
 func getComponents() []spi.Component {
+	builtIn := getBuiltInComponents()
+	addonComponentsLock.RLock()
+	defer addonComponentsLock.RUnlock()
+	if len(addonComponents) == 0 {
+		return builtIn
+	}
+	namespaces := make([]string, 0, len(addonComponents))
+	for namespace := range addonComponents {
+		namespaces = append(namespaces, namespace)
+	}
+	sort.Strings(namespaces)
+	comps := make([]spi.Component, 0, len(builtIn))
+	comps = append(comps, builtIn...)
+	for _, namespace := range namespaces {
+		comps = append(comps, addonComponents[namespace]...)
+	}
+	return comps
+}
+
+// getBuiltInComponents returns the components that are part of Verrazzano
+func getBuiltInComponents() []spi.Component {
 	if len(componentsRegistry) == 0 {
 		componentsRegistry = []spi.Component{
 			oam.NewComponent(),
@@ -83,6 +125,7 @@ func getComponents() []spi.Component {
 			kubestatemetrics.NewComponent(),
 			pushgateway.NewComponent(),
 			promnodeexporter.NewComponent(),
+			alertmanager.NewComponent(),
 			jaegeroperator.NewComponent(),
 			console.NewComponent(),
 			fluentd.NewComponent(),
@@ -91,6 +134,157 @@ func getComponents() []spi.Component {
 	return componentsRegistry
 }
 
+// SetAddonComponents sets the components of the VerrazzanoAddon resources in the namespace that are returned by
+// GetComponents along with the built-in components, the add-ons of the other namespaces are kept.  An add-on with
+// the same name as a built-in component or as an add-on of another namespace is ignored and reported in the
+// returned error.
+func SetAddonComponents(namespace string, comps []spi.Component) error {
+	addonComponentsLock.Lock()
+	defer addonComponentsLock.Unlock()
+
+	taken := make(map[string]bool)
+	for _, comp := range getBuiltInComponents() {
+		taken[comp.Name()] = true
+	}
+	for ns, nsComps := range addonComponents {
+		if ns == namespace {
+			continue
+		}
+		for _, comp := range nsComps {
+			taken[comp.Name()] = true
+		}
+	}
+	var addons []spi.Component
+	var conflicts []string
+	for _, comp := range comps {
+		if taken[comp.Name()] {
+			conflicts = append(conflicts, comp.Name())
+			continue
+		}
+		addons = append(addons, comp)
+	}
+	if len(addons) == 0 {
+		delete(addonComponents, namespace)
+	} else {
+		addonComponents[namespace] = addons
+	}
+	if len(conflicts) > 0 {
+		return fmt.Errorf("Add-ons %v have the same name as a built-in component or an add-on of another namespace and are ignored", conflicts)
+	}
+	return nil
+}
+
+// GetComponentsInUninstallOrder returns the list of components ordered so that every component comes before
+// any of the components it depends on, which is the reverse of the dependency (install) order
+func GetComponentsInUninstallOrder() []spi.Component {
+	installOrder := getComponentsInDependencyOrder(GetComponents())
+	uninstallOrder := make([]spi.Component, 0, len(installOrder))
+	for i := len(installOrder) - 1; i >= 0; i-- {
+		uninstallOrder = append(uninstallOrder, installOrder[i])
+	}
+	return uninstallOrder
+}
+
+// getComponentsInDependencyOrder does a depth-first sort of the components so that every component comes after
+// all of its dependencies; the relative order of the registry is preserved for components that are unrelated
+func getComponentsInDependencyOrder(comps []spi.Component) []spi.Component {
+	compMap := make(map[string]spi.Component, len(comps))
+	for _, comp := range comps {
+		compMap[comp.Name()] = comp
+	}
+	var ordered []spi.Component
+	visited := make(map[string]bool)
+	var visit func(comp spi.Component)
+	visit = func(comp spi.Component) {
+		if visited[comp.Name()] {
+			return
+		}
+		// Mark before visiting the dependencies so that a dependency cycle does not recurse forever, cycles are
+		// reported by checkDependencies
+		visited[comp.Name()] = true
+		for _, dependencyName := range comp.GetDependencies() {
+			if dependency, ok := compMap[dependencyName]; ok {
+				visit(dependency)
+			}
+		}
+		ordered = append(ordered, comp)
+	}
+	for _, comp := range comps {
+		visit(comp)
+	}
+	return ordered
+}
+
+// GetComponentsByDependencyLevel builds the dependency DAG of the components and returns the components grouped
+// by their depth in the DAG.  The components in the first group have no dependencies, and every component is in
+// a later group than all of its dependencies, so the components within a group are independent of each other.
+func GetComponentsByDependencyLevel() [][]spi.Component {
+	comps := GetComponents()
+	compMap := make(map[string]spi.Component, len(comps))
+	for _, comp := range comps {
+		compMap[comp.Name()] = comp
+	}
+	levelMap := make(map[string]int, len(comps))
+	var getLevel func(comp spi.Component, visiting map[string]bool) int
+	getLevel = func(comp spi.Component, visiting map[string]bool) int {
+		if level, ok := levelMap[comp.Name()]; ok {
+			return level
+		}
+		// Ignore a dependency cycle rather than recurse forever, cycles are reported by checkDependencies
+		if visiting[comp.Name()] {
+			return 0
+		}
+		visiting[comp.Name()] = true
+		level := 0
+		for _, dependencyName := range comp.GetDependencies() {
+			if dependency, ok := compMap[dependencyName]; ok {
+				if dependencyLevel := getLevel(dependency, visiting) + 1; dependencyLevel > level {
+					level = dependencyLevel
+				}
+			}
+		}
+		delete(visiting, comp.Name())
+		levelMap[comp.Name()] = level
+		return level
+	}
+
+	var levels [][]spi.Component
+	for _, comp := range comps {
+		level := getLevel(comp, make(map[string]bool))
+		for len(levels) <= level {
+			levels = append(levels, []spi.Component{})
+		}
+		levels[level] = append(levels[level], comp)
+	}
+	return levels
+}
+
+// RunPreflightChecks runs the upgrade preflight checks of every enabled component that implements
+// spi.ComponentPreflightChecker and returns all of the checks that failed
+func RunPreflightChecks(ctx spi.ComponentContext) []PreflightFailure {
+	var failures []PreflightFailure
+	for _, comp := range GetComponents() {
+		checker, ok := comp.(spi.ComponentPreflightChecker)
+		if !ok || !comp.IsEnabled(ctx.EffectiveCR()) {
+			continue
+		}
+		compContext := ctx.Init(comp.Name()).Operation(vzconst.UpgradeOperation)
+		for _, err := range checker.PreflightChecks(compContext) {
+			failures = append(failures, PreflightFailure{ComponentName: comp.Name(), Err: err})
+		}
+	}
+	return failures
+}
+
+// FormatPreflightFailures returns a message that lists every failed preflight check
+func FormatPreflightFailures(failures []PreflightFailure) string {
+	msgs := make([]string, 0, len(failures))
+	for _, failure := range failures {
+		msgs = append(msgs, fmt.Sprintf("%s: %v", failure.ComponentName, failure.Err))
+	}
+	return strings.Join(msgs, "; ")
+}
+
 func FindComponent(releaseName string) (bool, spi.Component) {
 	for _, comp := range GetComponents() {
 		if comp.Name() == releaseName {
@@ -100,6 +294,24 @@ func FindComponent(releaseName string) (bool, spi.Component) {
 	return false, nil
 }
 
+// GetEnabledDependents returns the names of the components enabled in the effective CR that declare the
+// component as a dependency
+func GetEnabledDependents(compName string, effectiveCR *vzapi.Verrazzano) []string {
+	var dependents []string
+	for _, comp := range GetComponents() {
+		if !comp.IsEnabled(effectiveCR) {
+			continue
+		}
+		for _, dependency := range comp.GetDependencies() {
+			if dependency == compName {
+				dependents = append(dependents, comp.Name())
+				break
+			}
+		}
+	}
+	return dependents
+}
+
 // ComponentDependenciesMet Checks if the declared dependencies for the component are ready and available
 func ComponentDependenciesMet(c spi.Component, context spi.ComponentContext) bool {
 	log := context.Log()
@@ -4,6 +4,8 @@
 package registry
 
 import (
+	"errors"
+
 	vzconst "github.com/verrazzano/verrazzano/platform-operator/constants"
 	"github.com/verrazzano/verrazzano/platform-operator/controllers/verrazzano/component/console"
 	"github.com/verrazzano/verrazzano/platform-operator/controllers/verrazzano/component/fluentd"
@@ -28,6 +30,7 @@ import (
 	"github.com/verrazzano/verrazzano/platform-operator/controllers/verrazzano/component/opensearch"
 	"github.com/verrazzano/verrazzano/platform-operator/controllers/verrazzano/component/opensearchdashboards"
 	promadapter "github.com/verrazzano/verrazzano/platform-operator/controllers/verrazzano/component/prometheus/adapter"
+	"github.com/verrazzano/verrazzano/platform-operator/controllers/verrazzano/component/prometheus/alertmanager"
 	"github.com/verrazzano/verrazzano/platform-operator/controllers/verrazzano/component/prometheus/kubestatemetrics"
 	promnodeexporter "github.com/verrazzano/verrazzano/platform-operator/controllers/verrazzano/component/prometheus/nodeexporter"
 	promoperator "github.com/verrazzano/verrazzano/platform-operator/controllers/verrazzano/component/prometheus/operator"
@@ -62,7 +65,7 @@ func TestGetComponents(t *testing.T) {
 	a := assert.New(t)
 	comps := GetComponents()
 
-	a.Len(comps, 26, "Wrong number of components")
+	a.Len(comps, 27, "Wrong number of components")
 	a.Equal(comps[0].Name(), oam.ComponentName)
 	a.Equal(comps[1].Name(), appoper.ComponentName)
 	a.Equal(comps[2].Name(), istio.ComponentName)
@@ -86,9 +89,10 @@ func TestGetComponents(t *testing.T) {
 	a.Equal(comps[20].Name(), kubestatemetrics.ComponentName)
 	a.Equal(comps[21].Name(), pushgateway.ComponentName)
 	a.Equal(comps[22].Name(), promnodeexporter.ComponentName)
-	a.Equal(comps[23].Name(), jaegeroperator.ComponentName)
-	a.Equal(comps[24].Name(), console.ComponentName)
-	a.Equal(comps[25].Name(), fluentd.ComponentName)
+	a.Equal(comps[23].Name(), alertmanager.ComponentName)
+	a.Equal(comps[24].Name(), jaegeroperator.ComponentName)
+	a.Equal(comps[25].Name(), console.ComponentName)
+	a.Equal(comps[26].Name(), fluentd.ComponentName)
 }
 
 // TestFindComponent tests FindComponent
@@ -102,6 +106,152 @@ func TestFindComponent(t *testing.T) {
 	assert.Equal(t, istio.ComponentName, comp.Name())
 }
 
+// TestSetAddonComponents tests SetAddonComponents
+// GIVEN add-on components in two namespaces
+//  WHEN I call SetAddonComponents for each namespace
+//  THEN the add-ons of both namespaces are returned after the built-in components, and an add-on with the name of a
+//  built-in component or of an add-on in the other namespace is ignored
+func TestSetAddonComponents(t *testing.T) {
+	a := assert.New(t)
+	defer SetAddonComponents("ns1", nil)
+	defer SetAddonComponents("ns2", nil)
+
+	err := SetAddonComponents("ns1", []spi.Component{
+		helm2.HelmComponent{ReleaseName: "my-addon"},
+		helm2.HelmComponent{ReleaseName: istio.ComponentName},
+	})
+	a.EqualError(err, "Add-ons [istio] have the same name as a built-in component or an add-on of another namespace and are ignored")
+	err = SetAddonComponents("ns2", []spi.Component{
+		helm2.HelmComponent{ReleaseName: "other-addon"},
+		helm2.HelmComponent{ReleaseName: "my-addon"},
+	})
+	a.EqualError(err, "Add-ons [my-addon] have the same name as a built-in component or an add-on of another namespace and are ignored")
+	comps := GetComponents()
+	a.Len(comps, 29)
+	a.Equal("my-addon", comps[27].Name())
+	a.Equal("other-addon", comps[28].Name())
+
+	// Setting the add-ons of one namespace keeps the add-ons of the other namespace
+	a.NoError(SetAddonComponents("ns1", nil))
+	comps = GetComponents()
+	a.Len(comps, 28)
+	a.Equal("other-addon", comps[27].Name())
+
+	a.NoError(SetAddonComponents("ns2", nil))
+	a.Len(GetComponents(), 27)
+}
+
+// TestGetComponentsInUninstallOrder tests GetComponentsInUninstallOrder
+// GIVEN the registry components
+//  WHEN I call GetComponentsInUninstallOrder
+//  THEN all components are returned and every component comes before the components it depends on
+func TestGetComponentsInUninstallOrder(t *testing.T) {
+	a := assert.New(t)
+	comps := GetComponentsInUninstallOrder()
+	a.Len(comps, len(GetComponents()))
+
+	position := make(map[string]int, len(comps))
+	for i, comp := range comps {
+		position[comp.Name()] = i
+	}
+	for _, comp := range comps {
+		for _, dependency := range comp.GetDependencies() {
+			a.Less(position[comp.Name()], position[dependency], "%s must be uninstalled before %s", comp.Name(), dependency)
+		}
+	}
+}
+
+// TestGetComponentsInUninstallOrderStable tests GetComponentsInUninstallOrder
+// GIVEN components that are listed before their dependencies
+//  WHEN I call GetComponentsInUninstallOrder
+//  THEN the dependents are returned first and unrelated components keep the reverse registry order
+func TestGetComponentsInUninstallOrderStable(t *testing.T) {
+	OverrideGetComponentsFn(func() []spi.Component {
+		return []spi.Component{
+			fakeComponent{name: "fake1", dependencies: []string{"fake3"}},
+			fakeComponent{name: "fake2"},
+			fakeComponent{name: "fake3"},
+		}
+	})
+	defer ResetGetComponentsFn()
+
+	var names []string
+	for _, comp := range GetComponentsInUninstallOrder() {
+		names = append(names, comp.Name())
+	}
+	assert.Equal(t, []string{"fake2", "fake1", "fake3"}, names)
+}
+
+// TestGetComponentsByDependencyLevel tests GetComponentsByDependencyLevel
+// GIVEN components with a dependency chain, a shared dependency and no dependencies
+//  WHEN I call GetComponentsByDependencyLevel
+//  THEN every component is in a later level than all of its dependencies
+func TestGetComponentsByDependencyLevel(t *testing.T) {
+	OverrideGetComponentsFn(func() []spi.Component {
+		return []spi.Component{
+			fakeComponent{name: "fake1", dependencies: []string{"fake2", "fake4"}},
+			fakeComponent{name: "fake2", dependencies: []string{"fake3"}},
+			fakeComponent{name: "fake3"},
+			fakeComponent{name: "fake4", dependencies: []string{"fake3"}},
+			fakeComponent{name: "fake5"},
+		}
+	})
+	defer ResetGetComponentsFn()
+
+	var levels [][]string
+	for _, level := range GetComponentsByDependencyLevel() {
+		var names []string
+		for _, comp := range level {
+			names = append(names, comp.Name())
+		}
+		levels = append(levels, names)
+	}
+	assert.Equal(t, [][]string{{"fake3", "fake5"}, {"fake2", "fake4"}, {"fake1"}}, levels)
+}
+
+// TestGetComponentsByDependencyLevelCycle tests GetComponentsByDependencyLevel
+// GIVEN components with a dependency cycle
+//  WHEN I call GetComponentsByDependencyLevel
+//  THEN all the components are returned
+func TestGetComponentsByDependencyLevelCycle(t *testing.T) {
+	OverrideGetComponentsFn(func() []spi.Component {
+		return []spi.Component{
+			fakeComponent{name: "fake1", dependencies: []string{"fake2"}},
+			fakeComponent{name: "fake2", dependencies: []string{"fake1"}},
+		}
+	})
+	defer ResetGetComponentsFn()
+
+	count := 0
+	for _, level := range GetComponentsByDependencyLevel() {
+		count += len(level)
+	}
+	assert.Equal(t, 2, count)
+}
+
+// TestRunPreflightChecks tests RunPreflightChecks
+// GIVEN enabled and disabled components with and without failed preflight checks
+//  WHEN I call RunPreflightChecks
+//  THEN every failed check of the enabled components is returned
+func TestRunPreflightChecks(t *testing.T) {
+	OverrideGetComponentsFn(func() []spi.Component {
+		return []spi.Component{
+			fakeComponent{name: "fake1", enabled: true},
+			fakePreflightComponent{fakeComponent: fakeComponent{name: "fake2", enabled: true},
+				preflightErrs: []error{errors.New("check1 failed"), errors.New("check2 failed")}},
+			fakePreflightComponent{fakeComponent: fakeComponent{name: "fake3", enabled: false},
+				preflightErrs: []error{errors.New("check3 failed")}},
+			fakePreflightComponent{fakeComponent: fakeComponent{name: "fake4", enabled: true}},
+		}
+	})
+	defer ResetGetComponentsFn()
+
+	ctx := spi.NewFakeContext(fake.NewClientBuilder().WithScheme(k8scheme.Scheme).Build(), &v1alpha1.Verrazzano{}, false)
+	failures := RunPreflightChecks(ctx)
+	assert.Len(t, failures, 2)
+	assert.Equal(t, "fake2: check1 failed; fake2: check2 failed", FormatPreflightFailures(failures))
+}
+
 // TestComponentDependenciesMet tests ComponentDependenciesMet
 // GIVEN a component
 //  WHEN I call ComponentDependenciesMet for it
@@ -461,6 +611,26 @@ func TestNoComponentDependencies(t *testing.T) {
 	assert.True(t, ready)
 }
 
+// TestGetEnabledDependents tests GetEnabledDependents
+// GIVEN enabled and disabled components that depend on a component
+//  WHEN I call GetEnabledDependents for it
+//  THEN only the enabled components that depend on it are returned
+func TestGetEnabledDependents(t *testing.T) {
+	OverrideGetComponentsFn(func() []spi.Component {
+		return []spi.Component{
+			fakeComponent{name: "fake1", dependencies: []string{"fake3"}, enabled: true},
+			fakeComponent{name: "fake2", dependencies: []string{"fake3"}},
+			fakeComponent{name: "fake3", enabled: true},
+			fakeComponent{name: "fake4", dependencies: []string{"fake1", "fake3"}, enabled: true},
+		}
+	})
+	defer ResetGetComponentsFn()
+
+	assert.Equal(t, []string{"fake1", "fake4"}, GetEnabledDependents("fake3", &v1alpha1.Verrazzano{}))
+	assert.Equal(t, []string{"fake4"}, GetEnabledDependents("fake1", &v1alpha1.Verrazzano{}))
+	assert.Empty(t, GetEnabledDependents("fake4", &v1alpha1.Verrazzano{}))
+}
+
 // Create a new deployment object for testing
 func newReadyDeployment(name string, namespace string) *appsv1.Deployment {
 	return &appsv1.Deployment{
@@ -567,3 +737,29 @@ func (f fakeComponent) ValidateUpdate(old *v1alpha1.Verrazzano, new *v1alpha1.Ve
 func (f fakeComponent) GetCertificateNames(_ spi.ComponentContext) []types.NamespacedName {
 	return []types.NamespacedName{}
 }
+
+func (f fakeComponent) IsUninstalled(_ spi.ComponentContext) (bool, error) {
+	return true, nil
+}
+
+func (f fakeComponent) PreUninstall(_ spi.ComponentContext) error {
+	return nil
+}
+
+func (f fakeComponent) Uninstall(_ spi.ComponentContext) error {
+	return nil
+}
+
+func (f fakeComponent) PostUninstall(_ spi.ComponentContext) error {
+	return nil
+}
+
+// fakePreflightComponent is a fake component that implements spi.ComponentPreflightChecker
+type fakePreflightComponent struct {
+	fakeComponent
+	preflightErrs []error
+}
+
+func (f fakePreflightComponent) PreflightChecks(_ spi.ComponentContext) []error {
+	return f.preflightErrs
+}
@@ -4,6 +4,8 @@
 package spi
 
 import (
+	"time"
+
 	"github.com/verrazzano/verrazzano/pkg/log/vzlog"
 	vzapi "github.com/verrazzano/verrazzano/platform-operator/apis/verrazzano/v1alpha1"
 	"k8s.io/apimachinery/pkg/types"
@@ -83,6 +85,64 @@ type ComponentUpgrader interface {
 	PostUpgrade(context ComponentContext) error
 }
 
+// ComponentUninstaller interface defines uninstall operations for components that support it
+type ComponentUninstaller interface {
+	// IsUninstalled Indicates whether or not the component has been completely uninstalled
+	IsUninstalled(context ComponentContext) (bool, error)
+	// PreUninstall allows components to perform any pre-processing required prior to uninstalling
+	PreUninstall(context ComponentContext) error
+	// Uninstall will uninstall the Verrazzano component
+	Uninstall(context ComponentContext) error
+	// PostUninstall allows components to perform any post-processing required after uninstalling
+	PostUninstall(context ComponentContext) error
+}
+
+// ComponentRollbacker interface is implemented by components whose failed upgrade can be rolled back
+type ComponentRollbacker interface {
+	// Rollback rolls the component back to the release revision that was deployed before the upgrade
+	Rollback(context ComponentContext) error
+}
+
+// ComponentPreflightChecker interface is implemented by components that verify their prerequisites before an upgrade
+type ComponentPreflightChecker interface {
+	// PreflightChecks returns an error for every upgrade prerequisite of the component that is not met
+	PreflightChecks(context ComponentContext) []error
+}
+
+// ComponentTimeoutDefaulter interface is implemented by components whose install and upgrade have a default timeout
+// other than the Verrazzano default
+type ComponentTimeoutDefaulter interface {
+	// GetDefaultTimeout returns how long the install or upgrade of the component can take, zero for the Verrazzano default
+	GetDefaultTimeout() time.Duration
+}
+
+// ComponentDiagnoser interface is implemented by components that can report why they are not ready
+type ComponentDiagnoser interface {
+	// GetNotReadyWorkloads returns a description of the Deployments, StatefulSets and DaemonSets of the component that are not ready
+	GetNotReadyWorkloads(context ComponentContext) ([]string, error)
+}
+
+// ComponentValuesPlanner interface is implemented by components that can report the Helm values an install or
+// upgrade would apply, without changing the cluster
+type ComponentValuesPlanner interface {
+	// GetPlannedValues returns the current values of the component and the values the operation of the context would apply
+	GetPlannedValues(context ComponentContext) (current map[string]interface{}, planned map[string]interface{}, err error)
+}
+
+// ComponentDriftDetector interface is implemented by components that can report how their deployed configuration differs
+// from the configuration of the Verrazzano resource
+type ComponentDriftDetector interface {
+	// GetDrift returns a description of each difference between the deployed configuration and the Verrazzano resource
+	GetDrift(context ComponentContext) ([]string, error)
+}
+
+// ComponentResyncer interface is implemented by components that keep configuration outside of Kubernetes resources,
+// like the Keycloak realms, and reapply it by calling Reconcile while they are ready
+type ComponentResyncer interface {
+	// GetResyncInterval returns how often the configuration of the ready component is reapplied
+	GetResyncInterval() time.Duration
+}
+
 // ComponentValidator interface defines validation operations for components that support it
 type ComponentValidator interface {
 	// ValidateInstall checks if the specified Verrazzano CR is valid for this component to be installed
@@ -99,6 +159,7 @@ type Component interface {
 	ComponentInfo
 	ComponentInstaller
 	ComponentUpgrader
+	ComponentUninstaller
 	ComponentValidator
 
 	Reconcile(ctx ComponentContext) error
@@ -32,6 +32,18 @@ func NewContext(log vzlog.VerrazzanoLogger, c clipkg.Client, actualCR *vzapi.Ver
 	}, nil
 }
 
+// NewContextFromCR creates a ComponentContext where the effective CR is the raw CR, for callers like the CLI
+// that do not have the profiles used to generate the effective CR
+func NewContextFromCR(log vzlog.VerrazzanoLogger, c clipkg.Client, actualCR *vzapi.Verrazzano, dryRun bool) ComponentContext {
+	return componentContext{
+		log:         log,
+		client:      c,
+		dryRun:      dryRun,
+		cr:          actualCR,
+		effectiveCR: actualCR,
+	}
+}
+
 // NewFakeContext creates a fake ComponentContext for unit testing purposes
 // c Kubernetes client
 // actualCR The user-supplied Verrazzano CR
@@ -63,6 +75,20 @@ func NewFakeContext(c clipkg.Client, actualCR *vzapi.Verrazzano, dryRun bool, pr
 	}
 }
 
+// NewDryRunContext returns a copy of the context in dry-run mode, the client of the copy does not persist any create,
+// update, patch or delete to the cluster
+func NewDryRunContext(ctx ComponentContext) ComponentContext {
+	return componentContext{
+		log:         ctx.Log(),
+		client:      clipkg.NewDryRunClient(ctx.Client()),
+		dryRun:      true,
+		cr:          ctx.ActualCR(),
+		effectiveCR: ctx.EffectiveCR(),
+		operation:   ctx.GetOperation(),
+		component:   ctx.GetComponent(),
+	}
+}
+
 type componentContext struct {
 	// log logger for the execution context
 	log vzlog.VerrazzanoLogger
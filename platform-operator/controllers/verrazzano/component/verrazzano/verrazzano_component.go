@@ -86,7 +86,7 @@ func (c verrazzanoComponent) PreInstall(ctx spi.ComponentContext) error {
 		return err
 	}
 	// create or update  backup secret
-	if err := common.EnsureBackupSecret(ctx.Client()); err != nil {
+	if err := common.EnsureBackupSecret(ctx.Client(), ctx.EffectiveCR()); err != nil {
 		return err
 	}
 	ctx.Log().Debug("Verrazzano pre-install")
@@ -182,9 +182,9 @@ func (c verrazzanoComponent) IsEnabled(effectiveCR *vzapi.Verrazzano) bool {
 
 // ValidateUpdate checks if the specified new Verrazzano CR is valid for this component to be updated
 func (c verrazzanoComponent) ValidateUpdate(old *vzapi.Verrazzano, new *vzapi.Verrazzano) error {
-	// Do not allow disabling active components
-	if err := c.checkEnabled(old, new); err != nil {
-		return err
+	// Do not allow disabling the component post-install
+	if c.IsEnabled(old) && !c.IsEnabled(new) {
+		return fmt.Errorf("Disabling component %s is not allowed", ComponentJSONName)
 	}
 	// Reject any other edits except InstallArgs
 	// Do not allow any updates to storage settings via the volumeClaimSpecTemplates/defaultVolumeSource
@@ -199,20 +199,6 @@ func (c verrazzanoComponent) ValidateInstall(vz *vzapi.Verrazzano) error {
 	return c.HelmComponent.ValidateInstall(vz)
 }
 
-func (c verrazzanoComponent) checkEnabled(old *vzapi.Verrazzano, new *vzapi.Verrazzano) error {
-	// Do not allow disabling of any component post-install for now
-	if c.IsEnabled(old) && !c.IsEnabled(new) {
-		return fmt.Errorf("Disabling component %s is not allowed", ComponentJSONName)
-	}
-	if vzconfig.IsConsoleEnabled(old) && !vzconfig.IsConsoleEnabled(new) {
-		return fmt.Errorf("Disabling component console not allowed")
-	}
-	if vzconfig.IsPrometheusEnabled(old) && !vzconfig.IsPrometheusEnabled(new) {
-		return fmt.Errorf("Disabling component prometheus not allowed")
-	}
-	return nil
-}
-
 // GetIngressNames - gets the names of the ingresses associated with this component
 func (c verrazzanoComponent) GetIngressNames(ctx spi.ComponentContext) []types.NamespacedName {
 	var ingressNames []types.NamespacedName
@@ -9,6 +9,7 @@ import (
 	vzapi "github.com/verrazzano/verrazzano/platform-operator/apis/verrazzano/v1alpha1"
 
 	"github.com/verrazzano/verrazzano/pkg/bom"
+	"github.com/verrazzano/verrazzano/platform-operator/controllers/verrazzano/component/common"
 	"github.com/verrazzano/verrazzano/platform-operator/controllers/verrazzano/component/spi"
 	"github.com/verrazzano/verrazzano/platform-operator/internal/k8s/status"
 	corev1 "k8s.io/api/core/v1"
@@ -17,12 +18,15 @@ import (
 	"k8s.io/apimachinery/pkg/types"
 )
 
+// wlsOperatorServiceAccount is the service account of the WebLogic operator
+const wlsOperatorServiceAccount = "weblogic-operator-sa"
+
 // AppendWeblogicOperatorOverrides appends the WKO-specific helm Value overrides.
 func AppendWeblogicOperatorOverrides(_ spi.ComponentContext, _ string, _ string, _ string, kvs []bom.KeyValue) ([]bom.KeyValue, error) {
 	keyValueOverrides := []bom.KeyValue{
 		{
 			Key:   "serviceAccount",
-			Value: "weblogic-operator-sa",
+			Value: wlsOperatorServiceAccount,
 		},
 		{
 			Key:   "domainNamespaceSelectionStrategy",
@@ -49,7 +53,7 @@ func AppendWeblogicOperatorOverrides(_ spi.ComponentContext, _ string, _ string,
 
 func WeblogicOperatorPreInstall(ctx spi.ComponentContext, _ string, namespace string, _ string) error {
 	var serviceAccount corev1.ServiceAccount
-	const accountName = "weblogic-operator-sa"
+	const accountName = wlsOperatorServiceAccount
 	c := ctx.Client()
 	if err := c.Get(context.TODO(), types.NamespacedName{Name: accountName, Namespace: namespace}, &serviceAccount); err != nil {
 		if !errors.IsNotFound(err) {
@@ -90,3 +94,11 @@ func GetOverrides(effectiveCR *vzapi.Verrazzano) []vzapi.Overrides {
 	}
 	return []vzapi.Overrides{}
 }
+
+// WeblogicOperatorPostUninstall deletes the WebLogic operator service account created by the pre-install
+func WeblogicOperatorPostUninstall(ctx spi.ComponentContext, _ string, namespace string) error {
+	if ctx.IsDryRun() {
+		return nil
+	}
+	return common.DeleteObjects(ctx, &corev1.ServiceAccount{ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: wlsOperatorServiceAccount}})
+}
@@ -0,0 +1,97 @@
+// Copyright (c) 2022, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package verrazzano
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/verrazzano/verrazzano/pkg/log/vzlog"
+	installv1alpha1 "github.com/verrazzano/verrazzano/platform-operator/apis/verrazzano/v1alpha1"
+	vzconst "github.com/verrazzano/verrazzano/platform-operator/constants"
+	"github.com/verrazzano/verrazzano/platform-operator/controllers/verrazzano/component/spi"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// defaultComponentTimeout is how long the install or upgrade of a component can take when neither the Verrazzano
+// resource nor the component specify a timeout
+const defaultComponentTimeout = 30 * time.Minute
+
+// getComponentTimeout returns how long the install or upgrade of the component can take before the component is failed.
+// The timeout in the Verrazzano resource takes precedence over the default timeout of the component.
+func getComponentTimeout(cr *installv1alpha1.Verrazzano, comp spi.Component, operation string) time.Duration {
+	for _, timeout := range cr.Spec.ComponentTimeouts {
+		if timeout.Name != comp.Name() {
+			continue
+		}
+		if operation == vzconst.InstallOperation && timeout.Install != nil {
+			return timeout.Install.Duration
+		}
+		if operation == vzconst.UpgradeOperation && timeout.Upgrade != nil {
+			return timeout.Upgrade.Duration
+		}
+	}
+	if defaulter, ok := comp.(spi.ComponentTimeoutDefaulter); ok && defaulter.GetDefaultTimeout() > 0 {
+		return defaulter.GetDefaultTimeout()
+	}
+	return defaultComponentTimeout
+}
+
+// buildComponentTimeoutMessage returns the diagnostic message of a component that did not complete the install or
+// upgrade within the timeout, naming the workloads of the component that are not ready
+func buildComponentTimeoutMessage(compContext spi.ComponentContext, comp spi.Component, operation string, timeout time.Duration) string {
+	msg := fmt.Sprintf("Component %s %s did not complete within %v", comp.Name(), operation, timeout)
+	diagnoser, ok := comp.(spi.ComponentDiagnoser)
+	if !ok {
+		return msg
+	}
+	notReady, err := diagnoser.GetNotReadyWorkloads(compContext)
+	if err != nil {
+		return fmt.Sprintf("%s, failed getting the workloads that are not ready: %v", msg, err)
+	}
+	if len(notReady) == 0 {
+		return msg
+	}
+	return fmt.Sprintf("%s, workloads not ready: %s", msg, strings.Join(notReady, "; "))
+}
+
+// isInstallTimedOut starts the install timer of the component the first time it is called for the component, and
+// returns true if the component has been installing for longer than its install timeout. The start time is kept in
+// the component status so that the timer survives a restart of the operator.
+func (r *Reconciler) isInstallTimedOut(compContext spi.ComponentContext, comp spi.Component) (bool, time.Duration, error) {
+	cr := compContext.ActualCR()
+	componentStatus, ok := cr.Status.Components[comp.Name()]
+	if !ok {
+		return false, 0, nil
+	}
+	if componentStatus.InstallStartTime == nil {
+		componentStatus.InstallStartTime = &metav1.Time{Time: time.Now().UTC()}
+		return false, 0, r.updateVerrazzanoStatus(compContext.Log(), cr)
+	}
+	timeout := getComponentTimeout(cr, comp, vzconst.InstallOperation)
+	return time.Since(componentStatus.InstallStartTime.Time) > timeout, timeout, nil
+}
+
+// resetInstallTimer clears the install start time in the status of the component, the caller persists the status
+func resetInstallTimer(componentStatus *installv1alpha1.ComponentStatusDetails) bool {
+	if componentStatus.InstallStartTime == nil {
+		return false
+	}
+	componentStatus.InstallStartTime = nil
+	return true
+}
+
+// resetFailedComponentInstalls moves the components that failed to install back to PreInstalling, so that they are
+// installed again when the user retries a failed Verrazzano install.  The caller persists the status.
+func resetFailedComponentInstalls(log vzlog.VerrazzanoLogger, cr *installv1alpha1.Verrazzano) {
+	for _, componentStatus := range cr.Status.Components {
+		if componentStatus.State != installv1alpha1.CompStateFailed {
+			continue
+		}
+		log.Oncef("Component %s failed to install, its install is being retried", componentStatus.Name)
+		componentStatus.State = installv1alpha1.CompStatePreInstalling
+		resetInstallTimer(componentStatus)
+	}
+}
@@ -0,0 +1,280 @@
+// Copyright (c) 2022, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package verrazzano
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/verrazzano/verrazzano/pkg/log/vzlog"
+	vzapi "github.com/verrazzano/verrazzano/platform-operator/apis/verrazzano/v1alpha1"
+	vzconst "github.com/verrazzano/verrazzano/platform-operator/constants"
+	"github.com/verrazzano/verrazzano/platform-operator/controllers/verrazzano/component/helm"
+	"github.com/verrazzano/verrazzano/platform-operator/controllers/verrazzano/component/registry"
+	"github.com/verrazzano/verrazzano/platform-operator/controllers/verrazzano/component/spi"
+	vzcontext "github.com/verrazzano/verrazzano/platform-operator/controllers/verrazzano/context"
+	"github.com/verrazzano/verrazzano/platform-operator/internal/config"
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	k8scheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+// TestGetComponentTimeout tests the getComponentTimeout func
+// GIVEN a component with or without a default timeout
+// WHEN the timeout of the component is requested
+// THEN the timeout in the Verrazzano resource is returned if set, otherwise the default of the component or the Verrazzano default
+func TestGetComponentTimeout(t *testing.T) {
+	asserts := assert.New(t)
+	vz := &vzapi.Verrazzano{
+		Spec: vzapi.VerrazzanoSpec{
+			ComponentTimeouts: []vzapi.ComponentTimeout{
+				{Name: "fake1", Install: &metav1.Duration{Duration: 5 * time.Minute}},
+			},
+		},
+	}
+	fakeComp1 := fakeComponent{HelmComponent: helm.HelmComponent{ReleaseName: "fake1", DefaultTimeout: time.Hour}}
+	fakeComp2 := fakeComponent{HelmComponent: helm.HelmComponent{ReleaseName: "fake2", DefaultTimeout: time.Hour}}
+	fakeComp3 := fakeComponent{HelmComponent: helm.HelmComponent{ReleaseName: "fake3"}}
+
+	asserts.Equal(5*time.Minute, getComponentTimeout(vz, fakeComp1, vzconst.InstallOperation))
+	asserts.Equal(time.Hour, getComponentTimeout(vz, fakeComp1, vzconst.UpgradeOperation))
+	asserts.Equal(time.Hour, getComponentTimeout(vz, fakeComp2, vzconst.InstallOperation))
+	asserts.Equal(defaultComponentTimeout, getComponentTimeout(vz, fakeComp3, vzconst.InstallOperation))
+}
+
+// TestInstallTimeout tests the reconcileComponents func
+// GIVEN a component that has been installing for longer than its install timeout
+// WHEN the components are reconciled
+// THEN the component and Verrazzano are failed with a message naming the workloads of the component that are not ready
+func TestInstallTimeout(t *testing.T) {
+	initUnitTesing()
+	namespace := "verrazzano"
+	name := "test"
+	asserts := assert.New(t)
+
+	fakeComp := fakeComponent{}
+	fakeComp.ReleaseName = "fake1"
+	fakeComp.ChartNamespace = "fake-ns"
+	fakeComp.IgnoreNamespaceOverride = true
+	fakeComp.SupportsOperatorInstall = true
+	fakeComp.ready = "false"
+	registry.OverrideGetComponentsFn(func() []spi.Component {
+		return []spi.Component{fakeComp}
+	})
+	defer registry.ResetGetComponentsFn()
+	config.TestProfilesDir = "../../manifests/profiles"
+	defer func() { config.TestProfilesDir = "" }()
+
+	_ = vzapi.AddToScheme(k8scheme.Scheme)
+	vz := &vzapi.Verrazzano{
+		ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name},
+		Spec: vzapi.VerrazzanoSpec{
+			ComponentTimeouts: []vzapi.ComponentTimeout{
+				{Name: "fake1", Install: &metav1.Duration{Duration: time.Minute}},
+			},
+		},
+		Status: vzapi.VerrazzanoStatus{
+			State: vzapi.VzStateReconciling,
+			Components: map[string]*vzapi.ComponentStatusDetails{
+				"fake1": {
+					Name:             "fake1",
+					State:            vzapi.CompStateInstalling,
+					InstallStartTime: &metav1.Time{Time: time.Now().Add(-2 * time.Minute)},
+				},
+			},
+		},
+	}
+	deployment := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:   "fake-ns",
+			Name:        "fake1-deployment",
+			Annotations: map[string]string{"meta.helm.sh/release-name": "fake1"},
+		},
+	}
+	c := fake.NewClientBuilder().WithScheme(k8scheme.Scheme).WithObjects(vz, deployment).Build()
+	vzctx, err := vzcontext.NewVerrazzanoContext(vzlog.DefaultLogger(), c, vz, false)
+	asserts.NoError(err)
+
+	reconciler := newVerrazzanoReconciler(c)
+	_, err = reconciler.reconcileComponents(vzctx)
+	asserts.NoError(err)
+
+	actual := vzapi.Verrazzano{}
+	asserts.NoError(c.Get(context.TODO(), types.NamespacedName{Namespace: namespace, Name: name}, &actual))
+	compStatus := actual.Status.Components["fake1"]
+	asserts.Equal(vzapi.CompStateFailed, compStatus.State)
+	cond := compStatus.Conditions[len(compStatus.Conditions)-1]
+	asserts.Equal(vzapi.CondInstallFailed, cond.Type)
+	asserts.Equal("Component fake1 install did not complete within 1m0s, workloads not ready: Deployment fake-ns/fake1-deployment has 0/1 ready replicas", cond.Message)
+	asserts.Nil(compStatus.InstallStartTime)
+	asserts.Equal(vzapi.VzStateFailed, actual.Status.State)
+}
+
+// TestInstallTimeoutRetry tests the retry of a Verrazzano install that failed because a component timed out
+// GIVEN a component that did not install within its timeout
+// WHEN the user retries the failed Verrazzano install
+// THEN the component is moved back to PreInstalling and installed again
+func TestInstallTimeoutRetry(t *testing.T) {
+	initUnitTesing()
+	namespace := "verrazzano"
+	name := "test"
+	asserts := assert.New(t)
+
+	installed := false
+	fakeComp := fakeComponent{}
+	fakeComp.ReleaseName = "fake1"
+	fakeComp.ChartNamespace = "fake-ns"
+	fakeComp.IgnoreNamespaceOverride = true
+	fakeComp.SupportsOperatorInstall = true
+	fakeComp.ready = "false"
+	fakeComp.installFunc = func(ctx spi.ComponentContext) error {
+		installed = true
+		return nil
+	}
+	registry.OverrideGetComponentsFn(func() []spi.Component {
+		return []spi.Component{fakeComp}
+	})
+	defer registry.ResetGetComponentsFn()
+	config.TestProfilesDir = "../../manifests/profiles"
+	defer func() { config.TestProfilesDir = "" }()
+
+	_ = vzapi.AddToScheme(k8scheme.Scheme)
+	vz := &vzapi.Verrazzano{
+		ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name},
+		Spec: vzapi.VerrazzanoSpec{
+			ComponentTimeouts: []vzapi.ComponentTimeout{
+				{Name: "fake1", Install: &metav1.Duration{Duration: time.Minute}},
+			},
+		},
+		Status: vzapi.VerrazzanoStatus{
+			State: vzapi.VzStateReconciling,
+			Components: map[string]*vzapi.ComponentStatusDetails{
+				"fake1": {
+					Name:             "fake1",
+					State:            vzapi.CompStateInstalling,
+					InstallStartTime: &metav1.Time{Time: time.Now().Add(-2 * time.Minute)},
+				},
+			},
+		},
+	}
+	c := fake.NewClientBuilder().WithScheme(k8scheme.Scheme).WithObjects(vz).Build()
+	reconciler := newVerrazzanoReconciler(c)
+
+	// The component times out and fails the install
+	vzctx, err := vzcontext.NewVerrazzanoContext(vzlog.DefaultLogger(), c, vz, false)
+	asserts.NoError(err)
+	_, err = reconciler.reconcileComponents(vzctx)
+	asserts.NoError(err)
+	actual := &vzapi.Verrazzano{}
+	asserts.NoError(c.Get(context.TODO(), types.NamespacedName{Namespace: namespace, Name: name}, actual))
+	asserts.Equal(vzapi.CompStateFailed, actual.Status.Components["fake1"].State)
+	asserts.Equal(vzapi.VzStateFailed, actual.Status.State)
+
+	// The user retries the install
+	actual.Annotations = map[string]string{vzconst.UpgradeRetryVersion: "1"}
+	asserts.NoError(c.Update(context.TODO(), actual))
+	vzctx, err = vzcontext.NewVerrazzanoContext(vzlog.DefaultLogger(), c, actual, false)
+	asserts.NoError(err)
+	_, err = reconciler.ProcFailedState(vzctx)
+	asserts.NoError(err)
+	actual = &vzapi.Verrazzano{}
+	asserts.NoError(c.Get(context.TODO(), types.NamespacedName{Namespace: namespace, Name: name}, actual))
+	asserts.Equal(vzapi.CompStatePreInstalling, actual.Status.Components["fake1"].State)
+	asserts.Equal(vzapi.VzStateReady, actual.Status.State)
+
+	// The component is installed again
+	vzctx, err = vzcontext.NewVerrazzanoContext(vzlog.DefaultLogger(), c, actual, false)
+	asserts.NoError(err)
+	_, err = reconciler.reconcileComponents(vzctx)
+	asserts.NoError(err)
+	asserts.True(installed)
+	actual = &vzapi.Verrazzano{}
+	asserts.NoError(c.Get(context.TODO(), types.NamespacedName{Namespace: namespace, Name: name}, actual))
+	asserts.Equal(vzapi.CompStateInstalling, actual.Status.Components["fake1"].State)
+}
+
+// TestInstallTimerPersisted tests the isInstallTimedOut method
+// GIVEN a component that started installing
+// WHEN the install timeout is checked for the first time
+// THEN the install start time is saved in the status of the component
+func TestInstallTimerPersisted(t *testing.T) {
+	asserts := assert.New(t)
+	_ = vzapi.AddToScheme(k8scheme.Scheme)
+	vz := &vzapi.Verrazzano{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "verrazzano", Name: "test"},
+		Status: vzapi.VerrazzanoStatus{
+			Components: map[string]*vzapi.ComponentStatusDetails{
+				"fake1": {Name: "fake1", State: vzapi.CompStateInstalling},
+			},
+		},
+	}
+	c := fake.NewClientBuilder().WithScheme(k8scheme.Scheme).WithObjects(vz).Build()
+	compContext := spi.NewFakeContext(c, vz, false).Init("fake1")
+	comp := fakeComponent{HelmComponent: helm.HelmComponent{ReleaseName: "fake1"}}
+
+	reconciler := newVerrazzanoReconciler(c)
+	timedOut, _, err := reconciler.isInstallTimedOut(compContext, comp)
+	asserts.NoError(err)
+	asserts.False(timedOut)
+
+	actual := vzapi.Verrazzano{}
+	asserts.NoError(c.Get(context.TODO(), types.NamespacedName{Namespace: "verrazzano", Name: "test"}, &actual))
+	asserts.NotNil(actual.Status.Components["fake1"].InstallStartTime)
+
+	timedOut, _, err = reconciler.isInstallTimedOut(compContext, comp)
+	asserts.NoError(err)
+	asserts.False(timedOut)
+}
+
+// TestUpgradeTimeout tests the reconcileUpgrade method for the following use case
+// GIVEN a component that has been upgrading for longer than its upgrade timeout
+// WHEN the upgrade is reconciled and the rollback policy is not enabled
+// THEN the component and the Verrazzano upgrade are failed and the upgrade tracker is deleted
+func TestUpgradeTimeout(t *testing.T) {
+	initUnitTesing()
+	asserts := assert.New(t)
+	vz := newCheckpointTestVerrazzano(1)
+	vz.Spec.ComponentTimeouts = []vzapi.ComponentTimeout{
+		{Name: "fake1", Upgrade: &metav1.Duration{Duration: time.Minute}},
+	}
+	defer deleteUpgradeTracker(vz)
+
+	config.TestProfilesDir = "../../manifests/profiles"
+	defer func() { config.TestProfilesDir = "" }()
+
+	upgraded := false
+	registry.OverrideGetComponentsFn(func() []spi.Component {
+		return []spi.Component{
+			fakeComponent{
+				HelmComponent: helm.HelmComponent{ReleaseName: "fake1"},
+				upgradeFunc: func(ctx spi.ComponentContext) error {
+					upgraded = true
+					return nil
+				},
+			},
+		}
+	})
+	defer registry.ResetGetComponentsFn()
+
+	initStates(vz, vzStateUpgradeComponents, "fake1", compStateUpgrade)
+	getUpgradeTracker(vz).getComponentUpgradeContext("fake1").upgradeStart = time.Now().Add(-2 * time.Minute)
+
+	c := fake.NewClientBuilder().WithScheme(k8scheme.Scheme).WithObjects(vz).Build()
+	reconciler := newVerrazzanoReconciler(c)
+	result, err := reconciler.reconcileUpgrade(vzlog.DefaultLogger(), vz)
+	asserts.NoError(err)
+	asserts.True(result.Requeue)
+	asserts.False(upgraded)
+
+	asserts.Equal(vzapi.VzStateFailed, vz.Status.State)
+	cond := vz.Status.Conditions[len(vz.Status.Conditions)-1]
+	asserts.Equal(vzapi.CondUpgradeFailed, cond.Type)
+	asserts.Contains(cond.Message, "Component fake1 upgrade did not complete within 1m0s")
+	asserts.Equal(vzapi.CompStateFailed, vz.Status.Components["fake1"].State)
+	asserts.Nil(upgradeTrackerMap[getNSNKey(vz)])
+}
@@ -8,7 +8,6 @@ import (
 	"fmt"
 	"github.com/verrazzano/verrazzano/platform-operator/controllers/verrazzano/component/istio"
 	"github.com/verrazzano/verrazzano/platform-operator/controllers/verrazzano/component/keycloak"
-	"os"
 	"strings"
 	"sync"
 	"time"
@@ -24,18 +23,16 @@ import (
 	"github.com/verrazzano/verrazzano/platform-operator/controllers/verrazzano/component/registry"
 	"github.com/verrazzano/verrazzano/platform-operator/controllers/verrazzano/component/spi"
 	vzcontext "github.com/verrazzano/verrazzano/platform-operator/controllers/verrazzano/context"
-	"github.com/verrazzano/verrazzano/platform-operator/controllers/verrazzano/rbac"
-	"github.com/verrazzano/verrazzano/platform-operator/controllers/verrazzano/uninstalljob"
 	"github.com/verrazzano/verrazzano/platform-operator/controllers/verrazzano/vzinstance"
-	"github.com/verrazzano/verrazzano/platform-operator/internal/k8s"
+	"github.com/verrazzano/verrazzano/platform-operator/internal/metrics"
 	"go.uber.org/zap"
-	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
@@ -54,6 +51,9 @@ type Reconciler struct {
 	DryRun            bool
 	WatchedComponents map[string]bool
 	WatchMutex        *sync.RWMutex
+	EventRecorder     record.EventRecorder
+	// OperationHistory enables recording a VerrazzanoOperation for each install, upgrade, update and uninstall
+	OperationHistory bool
 }
 
 // Name of finalizer
@@ -131,13 +131,20 @@ func (r *Reconciler) doReconcile(ctx context.Context, log vzlog.VerrazzanoLogger
 		return result, nil
 	}
 
-	// Ensure the required resources needed for both install and uninstall exist
-	// This needs to be done for every state since the initForVzResource might have deleted
-	// role binding during old resource cleanup.
-	if err := r.createServiceAccount(ctx, log, vz); err != nil {
+	// Register the components of the add-ons in the namespace of the Verrazzano resource
+	if !unitTesting {
+		if err := r.loadAddonComponents(log, vz); err != nil {
+			return newRequeueWithDelay(), err
+		}
+	}
+
+	// Serve a requested change plan before processing the CR, computing a plan does not change the cluster
+	if err := r.reconcilePlan(log, vz); err != nil {
 		return newRequeueWithDelay(), err
 	}
-	if err := r.createClusterRoleBinding(ctx, log, vz); err != nil {
+
+	// Serve a requested run of the upgrade preflight checks, the checks do not change the cluster
+	if err := r.reconcilePreflight(log, vz); err != nil {
 		return newRequeueWithDelay(), err
 	}
 
@@ -160,7 +167,7 @@ func (r *Reconciler) doReconcile(ctx context.Context, log vzlog.VerrazzanoLogger
 		return r.ProcFailedState(vzctx)
 	case installv1alpha1.VzStateReconciling:
 		return r.ProcInstallingState(vzctx)
-	case installv1alpha1.VzStateReady:
+	case installv1alpha1.VzStateReady, installv1alpha1.VzStateDegraded:
 		return r.ProcReadyState(vzctx)
 	case installv1alpha1.VzStateUninstalling:
 		return r.ProcUninstallingState(vzctx)
@@ -220,6 +227,14 @@ func (r *Reconciler) ProcReadyState(vzctx vzcontext.VerrazzanoContext) (ctrl.Res
 			return result, nil
 		}
 
+		// Reflect the health of the components in the Verrazzano state, and check the health again at the interval
+		if err := r.updateVzHealthState(vzctx); err != nil {
+			return newRequeueWithDelay(), err
+		}
+		// Requeue to run the periodic health, drift and resync checks of the components
+		if interval := getPeriodicCheckInterval(actualCR); interval > 0 {
+			return ctrl.Result{RequeueAfter: interval}, nil
+		}
 		return ctrl.Result{}, nil
 	}
 
@@ -253,12 +268,6 @@ func (r *Reconciler) ProcReadyState(vzctx vzcontext.VerrazzanoContext) (ctrl.Res
 		return ctrl.Result{}, nil
 	}
 
-	// Delete leftover uninstall job if we find one.
-	err = r.cleanupUninstallJob(buildUninstallJobName(actualCR.Name), getInstallNamespace(), log)
-	if err != nil {
-		return newRequeueWithDelay(), err
-	}
-
 	// Change the state to installing
 	err = r.setInstallingState(log, actualCR)
 	return newRequeueWithDelay(), err
@@ -398,6 +407,10 @@ func (r *Reconciler) ProcFailedState(vzctx vzcontext.VerrazzanoContext) (ctrl.Re
 	if retry {
 		// Log the retry and set the CompStateType to ready, then requeue
 		log.Debugf("Restart Version annotation has changed, retrying upgrade")
+		// The components that failed a Verrazzano install, for example because they timed out, are installed again
+		if isLastCondition(vz.Status, installv1alpha1.CondInstallFailed) {
+			resetFailedComponentInstalls(log, vz)
+		}
 		err = r.updateVzState(log, vz, installv1alpha1.VzStateReady)
 		return ctrl.Result{Requeue: true, RequeueAfter: 1}, err
 	}
@@ -427,35 +440,7 @@ func (r *Reconciler) doesOCIDNSConfigSecretExist(vz *installv1alpha1.Verrazzano)
 	return nil
 }
 
-// createServiceAccount creates a required service account
-func (r *Reconciler) createServiceAccount(ctx context.Context, log vzlog.VerrazzanoLogger, vz *installv1alpha1.Verrazzano) error {
-	// Define a new service account resource
-	imagePullSecrets := strings.Split(os.Getenv("IMAGE_PULL_SECRETS"), ",")
-	for i := range imagePullSecrets {
-		imagePullSecrets[i] = strings.TrimSpace(imagePullSecrets[i])
-	}
-	serviceAccount := rbac.NewServiceAccount(getInstallNamespace(), buildServiceAccountName(vz.Name), imagePullSecrets, vz.Labels)
-
-	// Check if the service account for running the scripts exist
-	serviceAccountFound := &corev1.ServiceAccount{}
-	log.Debugf("Checking if install service account %s exist", buildServiceAccountName(vz.Name))
-	err := r.Get(ctx, types.NamespacedName{Name: buildServiceAccountName(vz.Name), Namespace: getInstallNamespace()}, serviceAccountFound)
-	if err != nil && errors.IsNotFound(err) {
-		log.Debugf("Creating install service account %s", buildServiceAccountName(vz.Name))
-		err = r.Create(ctx, serviceAccount)
-		if err != nil {
-			log.Errorf("Failed to create install service account %s: %v", buildServiceAccountName(vz.Name), err)
-			return err
-		}
-	} else if err != nil {
-		log.Errorf("Failed to get install service account %s: %v", buildServiceAccountName(vz.Name), err)
-		return err
-	}
-
-	return nil
-}
-
-// deleteServiceAccount deletes the service account used for install
+// deleteServiceAccount deletes the service account left behind by the install jobs of earlier versions
 func (r *Reconciler) deleteServiceAccount(ctx context.Context, log vzlog.VerrazzanoLogger, vz *installv1alpha1.Verrazzano, namespace string) error {
 	sa := corev1.ServiceAccount{
 		ObjectMeta: metav1.ObjectMeta{
@@ -471,33 +456,7 @@ func (r *Reconciler) deleteServiceAccount(ctx context.Context, log vzlog.Verrazz
 	return nil
 }
 
-// createClusterRoleBinding creates a required cluster role binding
-// NOTE: A RoleBinding doesn't work because we get the following error when the install scripts call kubectl get nodes
-//   " nodes is forbidden: User "xyz" cannot list resource "nodes" in API group "" at the cluster scope"
-func (r *Reconciler) createClusterRoleBinding(ctx context.Context, log vzlog.VerrazzanoLogger, vz *installv1alpha1.Verrazzano) error {
-	// Define a new cluster role binding resource
-	binding := rbac.NewClusterRoleBinding(vz, buildClusterRoleBindingName(vz.Namespace, vz.Name), getInstallNamespace(), buildServiceAccountName(vz.Name))
-
-	// Check if the cluster role binding for running the install scripts exist
-	bindingFound := &rbacv1.ClusterRoleBinding{}
-	log.Debugf("Checking if install cluster role binding %s exist", binding.Name)
-	err := r.Get(ctx, types.NamespacedName{Name: binding.Name, Namespace: binding.Namespace}, bindingFound)
-	if err != nil && errors.IsNotFound(err) {
-		log.Debugf("Creating install cluster role binding %s", binding.Name)
-		err = r.Create(ctx, binding)
-		if err != nil {
-			log.Errorf("Failed to create install cluster role binding %s: %v", binding.Name, err)
-			return err
-		}
-	} else if err != nil {
-		log.Errorf("Failed to get install cluster role binding %s: %v", binding.Name, err)
-		return err
-	}
-
-	return nil
-}
-
-// deleteClusterRoleBinding deletes the cluster role binding
+// deleteClusterRoleBinding deletes the cluster role binding left behind by the install jobs of earlier versions
 func (r *Reconciler) deleteClusterRoleBinding(ctx context.Context, log vzlog.VerrazzanoLogger, vz *installv1alpha1.Verrazzano) error {
 	binding := &rbacv1.ClusterRoleBinding{
 		ObjectMeta: metav1.ObjectMeta{
@@ -529,25 +488,6 @@ func (r *Reconciler) checkInstallComplete(vzctx vzcontext.VerrazzanoContext) (bo
 	return true, r.updateStatus(log, actualCR, message, installv1alpha1.CondInstallComplete)
 }
 
-// cleanupUninstallJob checks for the existence of a stale uninstall job and deletes the job if one is found
-func (r *Reconciler) cleanupUninstallJob(jobName string, namespace string, log vzlog.VerrazzanoLogger) error {
-	// Check if the job for running the uninstall scripts exist
-	jobFound := &batchv1.Job{}
-	log.Debugf("Checking if stale uninstall job %s exists", jobName)
-	err := r.Get(context.TODO(), types.NamespacedName{Name: jobName, Namespace: namespace}, jobFound)
-	if err == nil {
-		log.Debugf("Deleting stale uninstall job %s", jobName)
-		propagationPolicy := metav1.DeletePropagationBackground
-		deleteOptions := &client.DeleteOptions{PropagationPolicy: &propagationPolicy}
-		err = r.Delete(context.TODO(), jobFound, deleteOptions)
-		if err != nil {
-			return err
-		}
-	}
-
-	return nil
-}
-
 // deleteNamespace deletes a namespace
 func (r *Reconciler) deleteNamespace(ctx context.Context, log vzlog.VerrazzanoLogger, namespace string) error {
 	ns := corev1.Namespace{
@@ -572,51 +512,8 @@ func (r *Reconciler) SetupWithManager(mgr ctrl.Manager) error {
 	return err
 }
 
-func (r *Reconciler) createUninstallJob(log vzlog.VerrazzanoLogger, vz *installv1alpha1.Verrazzano) error {
-	// Define a new uninstall job resource
-	job := uninstalljob.NewJob(
-		&uninstalljob.JobConfig{
-			JobConfigCommon: k8s.JobConfigCommon{
-				JobName:            buildUninstallJobName(vz.Name),
-				Namespace:          getInstallNamespace(),
-				Labels:             vz.Labels,
-				ServiceAccountName: buildServiceAccountName(vz.Name),
-				JobImage:           os.Getenv("VZ_INSTALL_IMAGE"),
-				DryRun:             r.DryRun,
-			},
-		},
-	)
-
-	// Check if the job for running the uninstall scripts exist
-	jobFound := &batchv1.Job{}
-	log.Debugf("Checking if uninstall job %s exist", buildUninstallJobName(vz.Name))
-	err := r.Get(context.TODO(), types.NamespacedName{Name: buildUninstallJobName(vz.Name), Namespace: getInstallNamespace()}, jobFound)
-	if err != nil && errors.IsNotFound(err) {
-		log.Infof("Creating uninstall job %s, dry-run=%v", buildUninstallJobName(vz.Name), r.DryRun)
-		err = r.Create(context.TODO(), job)
-		if err != nil {
-			return err
-		}
-	} else if err != nil {
-		return err
-	}
-
-	log.Progressf("Uninstall job %s is running", buildUninstallJobName(vz.Name))
-
-	err = r.setUninstallCondition(log, jobFound, vz)
-	if err != nil {
-		return err
-	}
-
-	return nil
-}
-
-// buildUninstallJobName returns the name of an uninstall job based on Verrazzano resource name.
-func buildUninstallJobName(name string) string {
-	return fmt.Sprintf("verrazzano-uninstall-%s", name)
-}
-
-// buildServiceAccountName returns the service account name for jobs based on Verrazzano resource name.
+// buildServiceAccountName returns the name of the service account that earlier versions of Verrazzano created for
+// the install and uninstall jobs, it is still needed to delete the service account left behind by those versions.
 func buildServiceAccountName(name string) string {
 	return fmt.Sprintf("verrazzano-install-%s", name)
 }
@@ -670,6 +567,11 @@ func (r *Reconciler) updateStatus(log vzlog.VerrazzanoLogger, cr *installv1alpha
 			t.Year(), t.Month(), t.Day(),
 			t.Hour(), t.Minute(), t.Second()),
 	}
+	observeOperationDuration(cr, conditionType)
+	r.recordOperation(log, cr, conditionType, message)
+	if isConditionChanged(cr.Status.Conditions, string(cr.Status.State), string(conditionToVzState(conditionType)), conditionType, message) {
+		r.recordConditionEvent(cr, "", conditionType, message)
+	}
 	cr.Status.Conditions = append(cr.Status.Conditions, condition)
 
 	// Set the state of resource
@@ -680,6 +582,30 @@ func (r *Reconciler) updateStatus(log vzlog.VerrazzanoLogger, cr *installv1alpha
 	return r.updateVerrazzanoStatus(log, cr)
 }
 
+// observeOperationDuration records the duration of the install or upgrade when the condition completes it, the
+// operation started at the time of the last install or upgrade started condition
+func observeOperationDuration(cr *installv1alpha1.Verrazzano, conditionType installv1alpha1.ConditionType) {
+	var operation string
+	var startedType installv1alpha1.ConditionType
+	switch conditionType {
+	case installv1alpha1.CondInstallComplete:
+		operation, startedType = metrics.InstallOperation, installv1alpha1.CondInstallStarted
+	case installv1alpha1.CondUpgradeComplete:
+		operation, startedType = metrics.UpgradeOperation, installv1alpha1.CondUpgradeStarted
+	default:
+		return
+	}
+	for i := len(cr.Status.Conditions) - 1; i >= 0; i-- {
+		if cr.Status.Conditions[i].Type != startedType {
+			continue
+		}
+		if start, err := time.Parse(time.RFC3339, cr.Status.Conditions[i].LastTransitionTime); err == nil {
+			metrics.ObserveOperationDuration(operation, start)
+		}
+		return
+	}
+}
+
 // updateVzState updates the status state in the Verrazzano CR
 func (r *Reconciler) updateVzState(log vzlog.VerrazzanoLogger, cr *installv1alpha1.Verrazzano, state installv1alpha1.VzStateType) error {
 	// Set the state of resource
@@ -728,7 +654,11 @@ func (r *Reconciler) updateComponentStatus(compContext spi.ComponentContext, mes
 			componentStatus.ReconcilingGeneration = cr.Generation
 		}
 	}
+	if isConditionChanged(componentStatus.Conditions, string(componentStatus.State), string(checkCondtitionType(conditionType)), conditionType, message) {
+		r.recordConditionEvent(cr, componentName, conditionType, message)
+	}
 	componentStatus.Conditions = appendConditionIfNecessary(log, componentStatus, condition)
+	r.recordComponentOperation(log, cr, componentName, conditionType, message)
 
 	// Set the state of resource
 	componentStatus.State = checkCondtitionType(conditionType)
@@ -760,8 +690,9 @@ func checkCondtitionType(currentCondition installv1alpha1.ConditionType) install
 	case installv1alpha1.CondUpgradePaused:
 		return installv1alpha1.CompStateUpgrading
 	case installv1alpha1.CondUninstallComplete:
-		return installv1alpha1.CompStateReady
-	case installv1alpha1.CondInstallFailed, installv1alpha1.CondUpgradeFailed, installv1alpha1.CondUninstallFailed:
+		return installv1alpha1.CompStateDisabled
+	case installv1alpha1.CondInstallFailed, installv1alpha1.CondUpgradeFailed, installv1alpha1.CondUninstallFailed,
+		installv1alpha1.CondUpgradeRolledBack:
 		return installv1alpha1.CompStateFailed
 	}
 	// Return ready for installv1alpha1.CondInstallComplete, installv1alpha1.CondUpgradeComplete
@@ -781,7 +712,8 @@ func conditionToVzState(currentCondition installv1alpha1.ConditionType) installv
 		return installv1alpha1.VzStatePaused
 	case installv1alpha1.CondUninstallComplete:
 		return installv1alpha1.VzStateReady
-	case installv1alpha1.CondInstallFailed, installv1alpha1.CondUpgradeFailed, installv1alpha1.CondUninstallFailed:
+	case installv1alpha1.CondInstallFailed, installv1alpha1.CondUpgradeFailed, installv1alpha1.CondUninstallFailed,
+		installv1alpha1.CondPreflightFailed:
 		return installv1alpha1.VzStateFailed
 	}
 	// Return ready for installv1alpha1.CondInstallComplete, installv1alpha1.CondUpgradeComplete
@@ -805,7 +737,7 @@ func (r *Reconciler) checkComponentReadyState(vzctx vzcontext.VerrazzanoContext)
 	cr := vzctx.ActualCR
 	if unitTesting {
 		for _, compStatus := range cr.Status.Components {
-			if compStatus.State != installv1alpha1.CompStateDisabled && compStatus.State != installv1alpha1.CompStateReady {
+			if compStatus.State != installv1alpha1.CompStateDisabled && !installv1alpha1.IsComponentInstalledState(compStatus.State) {
 				return false, nil
 			}
 		}
@@ -819,7 +751,12 @@ func (r *Reconciler) checkComponentReadyState(vzctx vzcontext.VerrazzanoContext)
 			spiCtx.Log().Errorf("Failed to create component context: %v", err)
 			return false, err
 		}
-		if comp.IsEnabled(spiCtx.EffectiveCR()) && cr.Status.Components[comp.Name()].State != installv1alpha1.CompStateReady {
+		if comp.IsEnabled(spiCtx.EffectiveCR()) && !installv1alpha1.IsComponentInstalledState(cr.Status.Components[comp.Name()].State) {
+			return false, nil
+		}
+		// A component that was disabled is not done until it is uninstalled, a failed uninstall is retried
+		if compStatus, ok := cr.Status.Components[comp.Name()]; ok &&
+			(compStatus.State == installv1alpha1.CompStateUninstalling || compStatus.State == installv1alpha1.CompStateFailed) {
 			return false, nil
 		}
 	}
@@ -879,50 +816,6 @@ func (r *Reconciler) initializeComponentStatus(log vzlog.VerrazzanoLogger, cr *i
 	return ctrl.Result{}, nil
 }
 
-// setUninstallCondition sets the Verrazzano resource condition in status for uninstall
-func (r *Reconciler) setUninstallCondition(log vzlog.VerrazzanoLogger, job *batchv1.Job, vz *installv1alpha1.Verrazzano) (err error) {
-	// If the job has succeeded or failed add the appropriate condition
-	if job.Status.Succeeded != 0 || job.Status.Failed != 0 {
-		for _, condition := range vz.Status.Conditions {
-			if condition.Type == installv1alpha1.CondUninstallComplete || condition.Type == installv1alpha1.CondUninstallFailed {
-				return nil
-			}
-		}
-
-		// Remove the owner reference so that the install job is not deleted when the Verrazzano resource is deleted
-		job.SetOwnerReferences([]metav1.OwnerReference{})
-
-		// Update the job
-		err := r.Status().Update(context.TODO(), job)
-		if err != nil {
-			log.Errorf("Failed to update uninstall job owner references: %v", err)
-			return err
-		}
-
-		var message string
-		var conditionType installv1alpha1.ConditionType
-		if job.Status.Succeeded == 1 {
-			message = "Successfullly uninstalled Verrazzano"
-			conditionType = installv1alpha1.CondUninstallComplete
-			log.Info(message)
-		} else {
-			message = "Failed to uninstall Verrazzano"
-			conditionType = installv1alpha1.CondUninstallFailed
-			log.Error(message)
-		}
-		return r.updateStatus(log, vz, message, conditionType)
-	}
-
-	// Add the uninstall started condition if not already added
-	for _, condition := range vz.Status.Conditions {
-		if condition.Type == installv1alpha1.CondUninstallStarted {
-			return nil
-		}
-	}
-
-	return r.updateStatus(log, vz, "CompStateInstalling Verrazzano", installv1alpha1.CondUninstallStarted)
-}
-
 // getInternalConfigMap Convenience method for getting the saved install ConfigMap
 func (r *Reconciler) getInternalConfigMap(ctx context.Context, vz *installv1alpha1.Verrazzano) (installConfig *corev1.ConfigMap, err error) {
 	key := client.ObjectKey{
@@ -1030,45 +923,49 @@ func (r *Reconciler) procDelete(ctx context.Context, log vzlog.VerrazzanoLogger,
 	}
 	log.Once("Deleting Verrazzano installation")
 
-	// Create the uninstall job if it doesn't exist
-	if err := r.createUninstallJob(log, vz); err != nil {
-		if errors.IsConflict(err) {
-			log.Debug("Resource conflict creating the uninstall job, requeuing")
-		} else {
-			log.Errorf("Failed creating the uninstall job: %v", err)
+	// Uninstall the components if the uninstall has not already finished
+	if !isUninstallDone(vz.Status) {
+		if result, err := r.reconcileUninstall(log, vz); err != nil {
+			return newRequeueWithDelay(), err
+		} else if vzctrl.ShouldRequeue(result) {
+			return result, nil
 		}
-		return newRequeueWithDelay(), err
 	}
 
-	// Remove the finalizer and update the Verrazzano resource if the uninstall has finished.
-	for _, condition := range vz.Status.Conditions {
-		if condition.Type == installv1alpha1.CondUninstallComplete || condition.Type == installv1alpha1.CondUninstallFailed {
-			if condition.Type == installv1alpha1.CondUninstallComplete {
-				log.Once("Successfully uninstalled Verrrazzano")
-			} else {
-				log.Once("Failed uninstalling Verraazzano")
-			}
+	// The uninstall has finished, cleanup and remove the finalizer
+	if isLastCondition(vz.Status, installv1alpha1.CondUninstallFailed) {
+		log.Once("Failed uninstalling Verrazzano")
+	} else {
+		log.Once("Successfully uninstalled Verrazzano")
+	}
 
-			err := r.cleanup(ctx, log, vz)
-			if err != nil {
-				return newRequeueWithDelay(), err
-			}
+	err := r.cleanup(ctx, log, vz)
+	if err != nil {
+		return newRequeueWithDelay(), err
+	}
 
-			// All install related resources have been deleted, delete the finalizer so that the Verrazzano
-			// resource can get removed from etcd.
-			log.Debugf("Removing finalizer %s", finalizerName)
-			vz.ObjectMeta.Finalizers = vzstring.RemoveStringFromSlice(vz.ObjectMeta.Finalizers, finalizerName)
-			err = r.Update(ctx, vz)
-			if err != nil {
-				return newRequeueWithDelay(), err
-			}
+	// All install related resources have been deleted, delete the finalizer so that the Verrazzano
+	// resource can get removed from etcd.
+	log.Debugf("Removing finalizer %s", finalizerName)
+	vz.ObjectMeta.Finalizers = vzstring.RemoveStringFromSlice(vz.ObjectMeta.Finalizers, finalizerName)
+	err = r.Update(ctx, vz)
+	if err != nil {
+		return newRequeueWithDelay(), err
+	}
 
-			delete(initializedSet, vz.Name)
-			// Uninstall is done, all cleanup is finished, and finalizer removed.
-			return ctrl.Result{}, nil
+	delete(initializedSet, vz.Name)
+	// Uninstall is done, all cleanup is finished, and finalizer removed.
+	return ctrl.Result{}, nil
+}
+
+// isUninstallDone returns true if the uninstall has completed or failed
+func isUninstallDone(st installv1alpha1.VerrazzanoStatus) bool {
+	for _, condition := range st.Conditions {
+		if condition.Type == installv1alpha1.CondUninstallComplete || condition.Type == installv1alpha1.CondUninstallFailed {
+			return true
 		}
 	}
-	return newRequeueWithDelay(), nil
+	return false
 }
 
 // Cleanup the resources left over from install and uninstall
@@ -1085,6 +982,12 @@ func (r *Reconciler) cleanup(ctx context.Context, log vzlog.VerrazzanoLogger, vz
 		return err
 	}
 
+	// Delete the Verrazzano resources that remain after the components are uninstalled
+	err = r.cleanupUninstalledResources(log, vz)
+	if err != nil {
+		return err
+	}
+
 	// Delete the verrazzano-system namespace
 	err = r.deleteNamespace(ctx, log, vzconst.VerrazzanoSystemNamespace)
 	if err != nil {
@@ -1116,35 +1019,6 @@ func newRequeueWithDelay() ctrl.Result {
 	return vzctrl.NewRequeueWithDelay(2, 3, time.Second)
 }
 
-// Watch the jobs in the verrazzano-install for this vz resource.  The reconcile loop will be called
-// when a job is updated.
-func (r *Reconciler) watchJobs(namespace string, name string, log vzlog.VerrazzanoLogger) error {
-	// Watch job updates
-	p := predicate.Funcs{
-		UpdateFunc: func(e event.UpdateEvent) bool {
-			return e.ObjectOld != e.ObjectNew
-		},
-		CreateFunc: func(e event.CreateEvent) bool {
-			return true
-		},
-	}
-
-	// Watch jobs and trigger reconciles for Verrazzano resources when a job changes
-	err := r.Controller.Watch(
-		&source.Kind{Type: &batchv1.Job{
-			ObjectMeta: metav1.ObjectMeta{Namespace: getInstallNamespace()},
-		}},
-		createReconcileEventHandler(namespace, name),
-		// Comment it if default predicate fun is used.
-		p)
-	if err != nil {
-		return err
-	}
-	log.Debugf("Watching for jobs to activate reconcile for Verrazzano CR %s/%s", namespace, name)
-
-	return nil
-}
-
 // Watch the pods in the keycloak namespace for this vz resource.  The loop to reconcile will be called
 // when a pod is created.
 func (r *Reconciler) watchPods(namespace string, name string, log vzlog.VerrazzanoLogger) error {
@@ -1238,12 +1112,6 @@ func (r *Reconciler) initForVzResource(vz *installv1alpha1.Verrazzano, log vzlog
 		return newRequeueWithDelay(), err
 	}
 
-	// Watch the jobs in the operator namespace for this VZ CR
-	if err := r.watchJobs(vz.Namespace, vz.Name, log); err != nil {
-		log.Errorf("Failed to set Job watch for Verrrazzano CR %s: %v", vz.Name, err)
-		return newRequeueWithDelay(), err
-	}
-
 	// Watch pods in the keycloak namespace to handle recycle of the MySQL pod
 	if err := r.watchPods(vz.Namespace, vz.Name, log); err != nil {
 		log.Errorf("Failed to set Pod watch for Verrazzano CR %s: %v", vz.Name, err)
@@ -1255,6 +1123,11 @@ func (r *Reconciler) initForVzResource(vz *installv1alpha1.Verrazzano, log vzlog
 		return newRequeueWithDelay(), err
 	}
 
+	if err := r.watchAddons(vz.Namespace, vz.Name, log); err != nil {
+		log.Errorf("Failed to set VerrazzanoAddon watch for Verrazzano CR %s: %v", vz.Name, err)
+		return newRequeueWithDelay(), err
+	}
+
 	// Update the map indicating the resource is being watched
 	initializedSet[vz.Name] = true
 	return ctrl.Result{Requeue: true}, nil
@@ -1282,6 +1155,7 @@ func (r *Reconciler) updateVerrazzano(log vzlog.VerrazzanoLogger, vz *installv1a
 func (r *Reconciler) updateVerrazzanoStatus(log vzlog.VerrazzanoLogger, vz *installv1alpha1.Verrazzano) error {
 	err := r.Status().Update(context.TODO(), vz)
 	if err == nil {
+		metrics.SetComponentStates(vz)
 		return nil
 	}
 	if ctrlerrors.IsUpdateConflict(err) {
@@ -1312,3 +1186,30 @@ func (r *Reconciler) IsWatchedComponent(compName string) bool {
 	defer r.WatchMutex.RUnlock()
 	return r.WatchedComponents[compName]
 }
+
+// getPeriodicCheckInterval returns the smallest interval of the enabled health and drift checks, and of the resync of
+// the Ready components.  Zero is returned when there is no periodic check.
+func getPeriodicCheckInterval(cr *installv1alpha1.Verrazzano) time.Duration {
+	var interval time.Duration
+	useSmaller := func(i time.Duration) {
+		if i > 0 && (interval == 0 || i < interval) {
+			interval = i
+		}
+	}
+	if isHealthCheckEnabled(cr) {
+		useSmaller(getHealthCheckInterval(cr))
+	}
+	if isDriftDetectionEnabled(cr) {
+		useSmaller(getDriftCheckInterval(cr))
+	}
+	for _, comp := range registry.GetComponents() {
+		resyncer, ok := comp.(spi.ComponentResyncer)
+		if !ok {
+			continue
+		}
+		if componentStatus, ok := cr.Status.Components[comp.Name()]; ok && componentStatus.State == installv1alpha1.CompStateReady {
+			useSmaller(resyncer.GetResyncInterval())
+		}
+	}
+	return interval
+}
@@ -14,24 +14,21 @@ import (
 	constants2 "github.com/verrazzano/verrazzano/pkg/mcconstants"
 	vzapi "github.com/verrazzano/verrazzano/platform-operator/apis/verrazzano/v1alpha1"
 	"github.com/verrazzano/verrazzano/platform-operator/constants"
+	helmcomp "github.com/verrazzano/verrazzano/platform-operator/controllers/verrazzano/component/helm"
 	"github.com/verrazzano/verrazzano/platform-operator/controllers/verrazzano/component/registry"
 	"github.com/verrazzano/verrazzano/platform-operator/controllers/verrazzano/component/spi"
-	"github.com/verrazzano/verrazzano/platform-operator/controllers/verrazzano/rbac"
-	"github.com/verrazzano/verrazzano/platform-operator/controllers/verrazzano/uninstalljob"
 	"github.com/verrazzano/verrazzano/platform-operator/internal/config"
-	"github.com/verrazzano/verrazzano/platform-operator/internal/k8s"
 	"github.com/verrazzano/verrazzano/platform-operator/mocks"
 
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
-	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
-	rbacv1 "k8s.io/api/rbac/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
+	k8scheme "k8s.io/client-go/kubernetes/scheme"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
@@ -43,7 +40,6 @@ const testBomFilePath = "testdata/test_bom.json"
 //go:generate mockgen -destination=../../mocks/controller_mock.go -package=mocks -copyright_file=../../hack/boilerplate.go.txt sigs.k8s.io/controller-runtime/pkg/client Client,StatusWriter
 
 const installPrefix = "verrazzano-install-"
-const uninstallPrefix = "verrazzano-uninstall-"
 
 // TestGetClusterRoleBindingName tests generating a ClusterRoleBinding name
 // GIVEN a name and namespace
@@ -66,16 +62,6 @@ func TestGetServiceAccountName(t *testing.T) {
 	assert.Equalf(t, installPrefix+name, saName, "Expected ServiceAccount name did not match")
 }
 
-// TestGetUninstallJobName tests generating a Job name
-// GIVEN a name
-// WHEN the method is called
-// THEN return the generated Job name
-func TestGetUninstallJobName(t *testing.T) {
-	name := "test"
-	jobName := buildUninstallJobName(name)
-	assert.Equalf(t, uninstallPrefix+name, jobName, "Expected uninstall job name did not match")
-}
-
 // TestInstall tests the Reconcile method for the following use case
 // GIVEN a request to reconcile a Verrazzano resource
 // WHEN a Verrazzano resource has been applied
@@ -136,12 +122,6 @@ func TestInstall(t *testing.T) {
 			// Expect a call to get the Verrazzano resource.
 			expectGetVerrazzanoExists(mock, verrazzanoToUse, namespace, name, labels)
 
-			// Expect a call to get the service account
-			expectGetServiceAccountExists(mock, name, labels)
-
-			// Expect a call to get the ClusterRoleBinding
-			expectClusterRoleBindingExists(mock, verrazzanoToUse, namespace, name)
-
 			// Expect a call to get the Verrazzano system namespace (return exists)
 			expectGetVerrazzanoSystemNamespaceExists(mock, asserts)
 
@@ -222,12 +202,6 @@ func TestInstallInitComponents(t *testing.T) {
 	// Expect a call to get the Verrazzano resource.
 	expectGetVerrazzanoExists(mock, verrazzanoToUse, namespace, name, labels)
 
-	// Expect a call to get the service account
-	expectGetServiceAccountExists(mock, name, nil)
-
-	// Expect a call to get the ClusterRoleBinding
-	expectClusterRoleBindingExists(mock, verrazzanoToUse, namespace, name)
-
 	// Expect a call to get the status writer and return a mock.
 	mock.EXPECT().Status().Return(mockStatus).AnyTimes()
 
@@ -347,6 +321,46 @@ func makeVerrazzanoComponentStatusMap() vzapi.ComponentStatusMap {
 	return statusMap
 }
 
+// newInstalledVerrazzano returns an installed Verrazzano resource with the components in the given state
+func newInstalledVerrazzano(compState vzapi.CompStateType, compNames ...string) *vzapi.Verrazzano {
+	_ = vzapi.AddToScheme(k8scheme.Scheme)
+	vz := &vzapi.Verrazzano{
+		ObjectMeta: createObjectMeta("verrazzano", "test", []string{finalizerName}),
+		Status: vzapi.VerrazzanoStatus{
+			State:      vzapi.VzStateReady,
+			Version:    "1.0.1",
+			Conditions: []vzapi.Condition{{Type: vzapi.CondInstallComplete}},
+			Components: vzapi.ComponentStatusMap{},
+		},
+	}
+	for _, compName := range compNames {
+		vz.Status.Components[compName] = &vzapi.ComponentStatusDetails{Name: compName, State: compState}
+	}
+	return vz
+}
+
+// getVerrazzano returns the Verrazzano resource from the cluster
+func getVerrazzano(t *testing.T, c client.Client, vz *vzapi.Verrazzano) *vzapi.Verrazzano {
+	actual := &vzapi.Verrazzano{}
+	assert.NoError(t, c.Get(context.TODO(), types.NamespacedName{Namespace: vz.Namespace, Name: vz.Name}, actual))
+	return actual
+}
+
+// getComponentCondition returns the condition of the given type in the status of the component, or nil if the
+// component has no such condition
+func getComponentCondition(vz *vzapi.Verrazzano, compName string, conditionType vzapi.ConditionType) *vzapi.Condition {
+	componentStatus := vz.Status.Components[compName]
+	if componentStatus == nil {
+		return nil
+	}
+	for i := range componentStatus.Conditions {
+		if componentStatus.Conditions[i].Type == conditionType {
+			return &componentStatus.Conditions[i]
+		}
+	}
+	return nil
+}
+
 // TestCreateVerrazzanoWithOCIDNS tests the Reconcile method for the following use case
 // GIVEN a request to reconcile an Verrazzano resource with OCI DNS configured
 // WHEN a Verrazzano resource has been created
@@ -400,38 +414,6 @@ func TestCreateVerrazzanoWithOCIDNS(t *testing.T) {
 	// Expect a call to get the Verrazzano resource.
 	expectGetVerrazzanoExists(mock, vzToUse, namespace, name, labels)
 
-	// Expect a call to get the ServiceAccount - return that it does not exist
-	mock.EXPECT().
-		Get(gomock.Any(), types.NamespacedName{Namespace: getInstallNamespace(), Name: buildServiceAccountName(name)}, gomock.Not(gomock.Nil())).
-		Return(errors.NewNotFound(schema.GroupResource{Group: namespace, Resource: "ServiceAccount"}, buildServiceAccountName(name)))
-
-	// Expect a call to create the ServiceAccount - return success
-	mock.EXPECT().
-		Create(gomock.Any(), gomock.Any()).
-		DoAndReturn(func(ctx context.Context, serviceAccount *corev1.ServiceAccount, opts ...client.CreateOption) error {
-			asserts.Equalf(getInstallNamespace(), serviceAccount.Namespace, "ServiceAccount namespace did not match")
-			asserts.Equalf(buildServiceAccountName(name), serviceAccount.Name, "ServiceAccount name did not match")
-			asserts.Equalf(labels, serviceAccount.Labels, "ServiceAccount labels did not match")
-			return nil
-		})
-
-	// Expect a call to get the ClusterRoleBinding - return that it does not exist
-	mock.EXPECT().
-		Get(gomock.Any(), types.NamespacedName{Namespace: "", Name: buildClusterRoleBindingName(namespace, name)}, gomock.Not(gomock.Nil())).
-		Return(errors.NewNotFound(schema.GroupResource{Group: "", Resource: "ClusterRoleBinding"}, buildClusterRoleBindingName(namespace, name)))
-
-	// Expect a call to create the ClusterRoleBinding - return success
-	mock.EXPECT().
-		Create(gomock.Any(), gomock.Any()).
-		DoAndReturn(func(ctx context.Context, clusterRoleBinding *rbacv1.ClusterRoleBinding, opts ...client.CreateOption) error {
-			asserts.Equalf("", clusterRoleBinding.Namespace, "ClusterRoleBinding namespace did not match")
-			asserts.Equalf(buildClusterRoleBindingName(namespace, name), clusterRoleBinding.Name, "ClusterRoleBinding name did not match")
-			asserts.Equalf(labels, clusterRoleBinding.Labels, "ClusterRoleBinding labels did not match")
-			asserts.Equalf(buildServiceAccountName(name), clusterRoleBinding.Subjects[0].Name, "ClusterRoleBinding Subjects name did not match")
-			asserts.Equalf(getInstallNamespace(), clusterRoleBinding.Subjects[0].Namespace, "ClusterRoleBinding Subjects namespace did not match")
-			return nil
-		})
-
 	// Expect a call to get the DNS config secret and return it
 	mock.EXPECT().
 		Get(gomock.Any(), types.NamespacedName{Namespace: constants.VerrazzanoInstallNamespace, Name: "test-oci-config-secret"}, gomock.Not(gomock.Nil())).
@@ -487,8 +469,6 @@ func TestUninstallComplete(t *testing.T) {
 	unitTesting = true
 	namespace := "verrazzano"
 	name := "test"
-	labels := map[string]string{"label1": "test"}
-	var verrazzanoToUse vzapi.Verrazzano
 
 	deleteTime := metav1.Time{
 		Time: time.Now(),
@@ -527,47 +507,17 @@ func TestUninstallComplete(t *testing.T) {
 			return nil
 		})
 
-	// Expect a call to get the service account
-	expectGetServiceAccountExists(mock, name, labels)
-
-	// Expect a call to get the ClusterRoleBinding
-	expectClusterRoleBindingExists(mock, verrazzanoToUse, namespace, name)
-
-	// Expect a call to get the uninstall Job - return that it exists
-	mock.EXPECT().
-		Get(gomock.Any(), types.NamespacedName{Namespace: getInstallNamespace(), Name: buildUninstallJobName(name)}, gomock.Not(gomock.Nil())).
-		DoAndReturn(func(ctx context.Context, name types.NamespacedName, job *batchv1.Job) error {
-			newJob := uninstalljob.NewJob(&uninstalljob.JobConfig{
-				JobConfigCommon: k8s.JobConfigCommon{
-					JobName:            name.Name,
-					Namespace:          name.Namespace,
-					Labels:             labels,
-					ServiceAccountName: buildServiceAccountName(name.Name),
-					JobImage:           "image",
-					DryRun:             false,
-				},
-			})
-			job.ObjectMeta = newJob.ObjectMeta
-			job.Spec = newJob.Spec
-			return nil
-		})
-
 	// Expect a call to update the finalizers - return success
-	mock.EXPECT().Update(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
-
-	// Expect a call to get the status writer and return a mock.
-	mock.EXPECT().Status().Return(mockStatus).AnyTimes()
-
-	// Expect a call to update the status of the Verrazzano resource
-	mockStatus.EXPECT().
+	mock.EXPECT().
 		Update(gomock.Any(), gomock.Any()).
 		DoAndReturn(func(ctx context.Context, verrazzano *vzapi.Verrazzano, opts ...client.UpdateOption) error {
-			asserts.Len(verrazzano.Status.Conditions, 2)
+			asserts.NotContains(verrazzano.Finalizers, finalizerName)
 			return nil
 		})
 
 	expectDeleteClusterRoleBinding(mock, getInstallNamespace(), name)
 	expectDeleteServiceAccount(mock, getInstallNamespace(), name)
+	expectCleanupUninstalledResources(mock)
 	expectDeleteNamespace(mock)
 
 	config.TestProfilesDir = "../../manifests/profiles"
@@ -588,7 +538,7 @@ func TestUninstallComplete(t *testing.T) {
 // TestUninstallStarted tests the Reconcile method for the following use case
 // GIVEN a request to reconcile an Verrazzano resource
 // WHEN a Verrazzano resource has been deleted
-// THEN ensure an unisntall job is started
+// THEN ensure the uninstall started condition is set
 func TestUninstallStarted(t *testing.T) {
 	unitTesting = true
 	namespace := "verrazzano"
@@ -625,42 +575,32 @@ func TestUninstallStarted(t *testing.T) {
 				State: vzapi.VzStateReady,
 				Conditions: []vzapi.Condition{
 					{
-						Type: vzapi.CondUninstallStarted,
+						Type: vzapi.CondInstallComplete,
 					},
 				},
 			}
 			return nil
 		})
 
-	// Expect a call to get the service account
-	expectGetServiceAccountExists(mock, name, labels)
+	// Expect the calls to checkpoint the uninstall tracker
+	expectUninstallTrackerCheckpoint(mock, namespace, name)
 
-	// Expect a call to get the ClusterRoleBinding
-	expectClusterRoleBindingExists(mock, verrazzanoToUse, namespace, name)
-
-	// Expect a call to get the uninstall Job - return that it does not exist
-	mock.EXPECT().
-		Get(gomock.Any(), types.NamespacedName{Namespace: getInstallNamespace(), Name: buildUninstallJobName(name)}, gomock.Not(gomock.Nil())).
-		Return(errors.NewNotFound(schema.GroupResource{Group: namespace, Resource: "Job"}, buildUninstallJobName(name)))
+	// Expect a call to get the status writer and return a mock.
+	mock.EXPECT().Status().Return(mockStatus).AnyTimes()
 
-	// Expect a call to create the uninstall Job - return success
-	mock.EXPECT().
-		Create(gomock.Any(), gomock.Any()).
-		DoAndReturn(func(ctx context.Context, job *batchv1.Job, opts ...client.CreateOption) error {
-			asserts.Equalf(getInstallNamespace(), job.Namespace, "Job namespace did not match")
-			asserts.Equalf(buildUninstallJobName(name), job.Name, "Job name did not match")
-			asserts.Equalf(labels, job.Labels, "Job labels did not match")
+	// Expect a call to update the status of the Verrazzano resource with the uninstall started condition
+	mockStatus.EXPECT().
+		Update(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, verrazzano *vzapi.Verrazzano, opts ...client.UpdateOption) error {
+			asserts.Len(verrazzano.Status.Conditions, 2)
+			asserts.Equal(vzapi.CondUninstallStarted, verrazzano.Status.Conditions[1].Type)
+			asserts.Equal(vzapi.VzStateUninstalling, verrazzano.Status.State)
 			return nil
 		})
 
-	// Expect a call to update the job - return success
-	mock.EXPECT().Update(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
-
-	// Expect a call to get the status writer and return a mock.
-	mock.EXPECT().Status().Return(mockStatus).AnyTimes()
-
 	config.TestProfilesDir = "../../manifests/profiles"
 	defer func() { config.TestProfilesDir = "" }()
+	defer deleteUninstallTracker(&vzapi.Verrazzano{ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name}})
 
 	// Create and make the request
 	request := newRequest(namespace, name)
@@ -675,15 +615,13 @@ func TestUninstallStarted(t *testing.T) {
 }
 
 // TestUninstallFailed tests the Reconcile method for the following use case
-// GIVEN an uninstall job has failed
+// GIVEN an uninstall has failed
 // WHEN a Verrazzano resource has been deleted
-// THEN ensure the error is handled
+// THEN ensure the resources are cleaned up and the finalizer is removed
 func TestUninstallFailed(t *testing.T) {
 	unitTesting = true
 	namespace := "verrazzano"
 	name := "test"
-	labels := map[string]string{"label1": "test"}
-	var verrazzanoToUse vzapi.Verrazzano
 
 	deleteTime := metav1.Time{
 		Time: time.Now(),
@@ -708,56 +646,25 @@ func TestUninstallFailed(t *testing.T) {
 				DeletionTimestamp: &deleteTime,
 				Finalizers:        []string{finalizerName}}
 			verrazzano.Status = vzapi.VerrazzanoStatus{
-				State: vzapi.VzStateReady}
-			return nil
-		})
-
-	// Expect a call to get the service account
-	expectGetServiceAccountExists(mock, name, labels)
-
-	// Expect a call to get the ClusterRoleBinding
-	expectClusterRoleBindingExists(mock, verrazzanoToUse, namespace, name)
-
-	// Expect a call to get the uninstall Job - return that it exists and the job failed
-	mock.EXPECT().
-		Get(gomock.Any(), types.NamespacedName{Namespace: getInstallNamespace(), Name: buildUninstallJobName(name)}, gomock.Not(gomock.Nil())).
-		DoAndReturn(func(ctx context.Context, name types.NamespacedName, job *batchv1.Job) error {
-			newJob := uninstalljob.NewJob(&uninstalljob.JobConfig{
-				JobConfigCommon: k8s.JobConfigCommon{
-					JobName:            name.Name,
-					Namespace:          name.Namespace,
-					Labels:             labels,
-					ServiceAccountName: buildServiceAccountName(name.Name),
-					JobImage:           "image",
-					DryRun:             false,
+				State: vzapi.VzStateFailed,
+				Conditions: []vzapi.Condition{
+					{
+						Type: vzapi.CondUninstallStarted,
+					},
+					{
+						Type: vzapi.CondUninstallFailed,
+					},
 				},
-			})
-			job.ObjectMeta = newJob.ObjectMeta
-			job.Spec = newJob.Spec
-			job.Status = batchv1.JobStatus{
-				Failed: 1,
 			}
 			return nil
 		})
 
-	// Expect a status update on the job
-	mockStatus.EXPECT().Update(gomock.Any(), gomock.Any()).Return(nil)
-
 	// Expect a call to update the finalizers - return success
 	mock.EXPECT().Update(gomock.Any(), gomock.Any()).Return(nil)
 
-	// Expect a call to get the status writer and return a mock.
-	mock.EXPECT().Status().Return(mockStatus).AnyTimes()
-
-	// Expect a call to update the status of the Verrazzano resource
-	mockStatus.EXPECT().
-		Update(gomock.Any(), gomock.Any()).
-		DoAndReturn(func(ctx context.Context, verrazzano *vzapi.Verrazzano, opts ...client.UpdateOption) error {
-			return nil
-		})
-
 	expectDeleteClusterRoleBinding(mock, getInstallNamespace(), name)
 	expectDeleteServiceAccount(mock, getInstallNamespace(), name)
+	expectCleanupUninstalledResources(mock)
 	expectDeleteNamespace(mock)
 
 	config.TestProfilesDir = "../../manifests/profiles"
@@ -776,15 +683,13 @@ func TestUninstallFailed(t *testing.T) {
 }
 
 // TestUninstallSucceeded tests the Reconcile method for the following use case
-// GIVEN an uninstall job has succeeded
+// GIVEN an uninstall has started and all components have been uninstalled
 // WHEN a Verrazzano resource has been deleted
-// THEN ensure all the objects are deleted
+// THEN ensure the uninstall complete condition is set and all the objects are deleted
 func TestUninstallSucceeded(t *testing.T) {
 	unitTesting = true
 	namespace := "verrazzano"
 	name := "test"
-	labels := map[string]string{"label1": "test"}
-	var verrazzanoToUse vzapi.Verrazzano
 
 	deleteTime := metav1.Time{
 		Time: time.Now(),
@@ -809,61 +714,47 @@ func TestUninstallSucceeded(t *testing.T) {
 				DeletionTimestamp: &deleteTime,
 				Finalizers:        []string{finalizerName}}
 			verrazzano.Status = vzapi.VerrazzanoStatus{
-				State: vzapi.VzStateReady}
-			return nil
-		})
-
-	// Expect a call to get the service account
-	expectGetServiceAccountExists(mock, name, labels)
-
-	// Expect a call to get the ClusterRoleBinding
-	expectClusterRoleBindingExists(mock, verrazzanoToUse, namespace, name)
-
-	// Expect a call to get the uninstall Job - return that it exists and the job succeeded
-	mock.EXPECT().
-		Get(gomock.Any(), types.NamespacedName{Namespace: getInstallNamespace(), Name: buildUninstallJobName(name)}, gomock.Not(gomock.Nil())).
-		DoAndReturn(func(ctx context.Context, name types.NamespacedName, job *batchv1.Job) error {
-			newJob := uninstalljob.NewJob(&uninstalljob.JobConfig{
-				JobConfigCommon: k8s.JobConfigCommon{
-					JobName:            name.Name,
-					Namespace:          name.Namespace,
-					Labels:             labels,
-					ServiceAccountName: buildServiceAccountName(name.Name),
-					JobImage:           "image",
-					DryRun:             false,
+				State: vzapi.VzStateUninstalling,
+				Conditions: []vzapi.Condition{
+					{
+						Type: vzapi.CondUninstallStarted,
+					},
 				},
-			})
-			job.ObjectMeta = newJob.ObjectMeta
-			job.Spec = newJob.Spec
-			job.Status = batchv1.JobStatus{
-				Succeeded: 1,
 			}
 			return nil
 		})
 
-	// Expect a status update on the job
-	mockStatus.EXPECT().Update(gomock.Any(), gomock.Any()).Return(nil)
-
-	// Expect a call to update the finalizers - return success
-	mock.EXPECT().Update(gomock.Any(), gomock.Any()).Return(nil)
+	// Expect the calls to checkpoint the uninstall tracker
+	expectUninstallTrackerCheckpoint(mock, namespace, name)
 
 	// Expect a call to get the status writer and return a mock.
 	mock.EXPECT().Status().Return(mockStatus).AnyTimes()
 
-	// Expect a call to update the status of the Verrazzano resource
+	// Expect a call to update the status of the Verrazzano resource with the uninstall complete condition
 	mockStatus.EXPECT().
 		Update(gomock.Any(), gomock.Any()).
 		DoAndReturn(func(ctx context.Context, verrazzano *vzapi.Verrazzano, opts ...client.UpdateOption) error {
+			asserts.Equal(vzapi.CondUninstallComplete, verrazzano.Status.Conditions[len(verrazzano.Status.Conditions)-1].Type)
 			return nil
 		})
 
+	// Expect a call to update the finalizers - return success
+	mock.EXPECT().Update(gomock.Any(), gomock.Any()).Return(nil)
+
 	expectDeleteClusterRoleBinding(mock, getInstallNamespace(), name)
 	expectDeleteServiceAccount(mock, getInstallNamespace(), name)
+	expectCleanupUninstalledResources(mock)
 	expectDeleteNamespace(mock)
 
 	config.TestProfilesDir = "../../manifests/profiles"
 	defer func() { config.TestProfilesDir = "" }()
 
+	// No components are left to uninstall
+	registry.OverrideGetComponentsFn(func() []spi.Component {
+		return []spi.Component{}
+	})
+	defer registry.ResetGetComponentsFn()
+
 	// Create and make the request
 	request := newRequest(namespace, name)
 	reconciler := newVerrazzanoReconciler(mock)
@@ -939,209 +830,6 @@ func TestVerrazzanoGetError(t *testing.T) {
 	asserts.NotZero(result.RequeueAfter)
 }
 
-// TestServiceAccountGetError tests the Reconcile method for the following use case
-// GIVEN a request to reconcile an Verrazzano resource
-// WHEN a Verrazzano resource has been applied
-// THEN return error if failure getting ServiceAccount
-func TestServiceAccountGetError(t *testing.T) {
-	namespace := "verrazzano"
-	name := "test"
-	labels := map[string]string{"label1": "test"}
-	asserts := assert.New(t)
-	mocker := gomock.NewController(t)
-	mock := mocks.NewMockClient(mocker)
-	mockStatus := mocks.NewMockStatusWriter(mocker)
-	var verrazzanoToUse vzapi.Verrazzano
-	asserts.NotNil(mockStatus)
-
-	verrazzanoToUse.TypeMeta = metav1.TypeMeta{
-		APIVersion: "install.verrazzano.io/v1alpha1",
-		Kind:       "Verrazzano"}
-	verrazzanoToUse.ObjectMeta = metav1.ObjectMeta{
-		Namespace:  namespace,
-		Name:       name,
-		Labels:     labels,
-		Finalizers: []string{finalizerName}}
-	verrazzanoToUse.Status = vzapi.VerrazzanoStatus{
-		State: vzapi.VzStateReady}
-
-	// Expect a call to get the Verrazzano resource.
-	expectGetVerrazzanoExists(mock, verrazzanoToUse, namespace, name, labels)
-
-	// Expect a call to get the ServiceAccount - return a failure error
-	mock.EXPECT().
-		Get(gomock.Any(), types.NamespacedName{Namespace: getInstallNamespace(), Name: buildServiceAccountName(name)}, gomock.Not(gomock.Nil())).
-		Return(errors.NewBadRequest("failed to get ServiceAccount"))
-
-	// Create and make the request
-	request := newRequest(namespace, name)
-	reconciler := newVerrazzanoReconciler(mock)
-	result, err := reconciler.Reconcile(nil, request)
-
-	// Validate the results
-	mocker.Finish()
-	asserts.NoError(err)
-	asserts.Equal(true, result.Requeue)
-	asserts.NotZero(result.RequeueAfter)
-}
-
-// TestServiceAccountCreateError tests the Reconcile method for the following use case
-// GIVEN a request to reconcile an Verrazzano resource
-// WHEN a there is a failure creating a ServiceAccount
-// THEN return error
-func TestServiceAccountCreateError(t *testing.T) {
-	unitTesting = true
-	namespace := "verrazzano"
-	name := "test"
-	labels := map[string]string{"label1": "test"}
-	asserts := assert.New(t)
-	mocker := gomock.NewController(t)
-	mock := mocks.NewMockClient(mocker)
-	mockStatus := mocks.NewMockStatusWriter(mocker)
-	var verrazzanoToUse vzapi.Verrazzano
-	asserts.NotNil(mockStatus)
-
-	verrazzanoToUse.TypeMeta = metav1.TypeMeta{
-		APIVersion: "install.verrazzano.io/v1alpha1",
-		Kind:       "Verrazzano"}
-	verrazzanoToUse.ObjectMeta = metav1.ObjectMeta{
-		Namespace:  namespace,
-		Name:       name,
-		Labels:     labels,
-		Finalizers: []string{finalizerName}}
-	verrazzanoToUse.Status = vzapi.VerrazzanoStatus{
-		State: vzapi.VzStateReady}
-
-	// Expect a call to get the Verrazzano resource.
-	expectGetVerrazzanoExists(mock, verrazzanoToUse, namespace, name, labels)
-
-	// Expect a call to get the ServiceAccount - return not found
-	mock.EXPECT().
-		Get(gomock.Any(), types.NamespacedName{Namespace: getInstallNamespace(), Name: buildServiceAccountName(name)}, gomock.Not(gomock.Nil())).
-		Return(errors.NewNotFound(schema.GroupResource{Group: namespace, Resource: "ServiceAccount"}, name))
-
-	// Expect a call to create the ServiceAccount - return failure
-	mock.EXPECT().
-		Create(gomock.Any(), gomock.Any()).
-		Return(errors.NewBadRequest("failed to create ServiceAccount"))
-
-	// Create and make the request
-	request := newRequest(namespace, name)
-	reconciler := newVerrazzanoReconciler(mock)
-	result, err := reconciler.Reconcile(nil, request)
-
-	// Validate the results
-	mocker.Finish()
-	asserts.NoError(err)
-	asserts.Equal(true, result.Requeue)
-	asserts.NotZero(result.RequeueAfter)
-}
-
-// TestClusterRoleBindingGetError tests the Reconcile method for the following use case
-// GIVEN a request to reconcile an Verrazzano resource
-// WHEN a there is an error getting the ClusterRoleBinding
-// THEN return error
-func TestClusterRoleBindingGetError(t *testing.T) {
-	unitTesting = true
-	namespace := "verrazzano"
-	name := "test"
-	labels := map[string]string{"label1": "test"}
-	asserts := assert.New(t)
-	mocker := gomock.NewController(t)
-	mock := mocks.NewMockClient(mocker)
-	mockStatus := mocks.NewMockStatusWriter(mocker)
-	var verrazzanoToUse vzapi.Verrazzano
-	asserts.NotNil(mockStatus)
-
-	verrazzanoToUse.TypeMeta = metav1.TypeMeta{
-		APIVersion: "install.verrazzano.io/v1alpha1",
-		Kind:       "Verrazzano"}
-	verrazzanoToUse.ObjectMeta = metav1.ObjectMeta{
-		Namespace:  namespace,
-		Name:       name,
-		Labels:     labels,
-		Finalizers: []string{finalizerName}}
-	verrazzanoToUse.Status = vzapi.VerrazzanoStatus{
-		State: vzapi.VzStateReady}
-
-	// Expect a call to get the Verrazzano resource.
-	expectGetVerrazzanoExists(mock, verrazzanoToUse, namespace, name, labels)
-
-	// Expect a call to get the ServiceAccount - return that it exists
-	expectGetServiceAccountExists(mock, name, labels)
-
-	// Expect a call to get the ClusterRoleBinding - return a failure error
-	mock.EXPECT().
-		Get(gomock.Any(), types.NamespacedName{Namespace: "", Name: buildClusterRoleBindingName(namespace, name)}, gomock.Not(gomock.Nil())).
-		Return(errors.NewBadRequest("failed to get ClusterRoleBinding"))
-
-	// Create and make the request
-	request := newRequest(namespace, name)
-	reconciler := newVerrazzanoReconciler(mock)
-	result, err := reconciler.Reconcile(nil, request)
-
-	// Validate the results
-	mocker.Finish()
-	asserts.NoError(err)
-	asserts.Equal(true, result.Requeue)
-	asserts.NotZero(result.RequeueAfter)
-}
-
-// TestClusterRoleBindingCreateError tests the Reconcile method for the following use case
-// GIVEN a request to reconcile an Verrazzano resource
-// WHEN a there is a failure creating a ClusterRoleBinding
-// THEN return error
-func TestClusterRoleBindingCreateError(t *testing.T) {
-	unitTesting = true
-	namespace := "verrazzano"
-	name := "test"
-	labels := map[string]string{"label1": "test"}
-	asserts := assert.New(t)
-	mocker := gomock.NewController(t)
-	mock := mocks.NewMockClient(mocker)
-	mockStatus := mocks.NewMockStatusWriter(mocker)
-	var verrazzanoToUse vzapi.Verrazzano
-	asserts.NotNil(mockStatus)
-
-	verrazzanoToUse.TypeMeta = metav1.TypeMeta{
-		APIVersion: "install.verrazzano.io/v1alpha1",
-		Kind:       "Verrazzano"}
-	verrazzanoToUse.ObjectMeta = metav1.ObjectMeta{
-		Namespace:  namespace,
-		Name:       name,
-		Labels:     labels,
-		Finalizers: []string{finalizerName}}
-	verrazzanoToUse.Status = vzapi.VerrazzanoStatus{
-		State: vzapi.VzStateReady}
-
-	// Expect a call to get the Verrazzano resource.
-	expectGetVerrazzanoExists(mock, verrazzanoToUse, namespace, name, labels)
-
-	// Expect a call to get the ServiceAccount - return that it exists
-	expectGetServiceAccountExists(mock, name, labels)
-
-	// Expect a call to get the ClusterRoleBinding - return not found
-	mock.EXPECT().
-		Get(gomock.Any(), types.NamespacedName{Namespace: "", Name: buildClusterRoleBindingName(namespace, name)}, gomock.Not(gomock.Nil())).
-		Return(errors.NewNotFound(schema.GroupResource{Group: namespace, Resource: "ClusterRoleBinding"}, name))
-
-	// Expect a call to create the ClusterRoleBinding - return failure
-	mock.EXPECT().
-		Create(gomock.Any(), gomock.Any()).
-		Return(errors.NewBadRequest("failed to create ClusterRoleBinding"))
-
-	// Create and make the request
-	request := newRequest(namespace, name)
-	reconciler := newVerrazzanoReconciler(mock)
-	result, err := reconciler.Reconcile(nil, request)
-
-	// Validate the results
-	mocker.Finish()
-	asserts.NoError(err)
-	asserts.Equal(true, result.Requeue)
-	asserts.NotZero(result.RequeueAfter)
-}
-
 // TestVZSystemNamespaceGetError tests the Reconcile method for the following use case
 // GIVEN a request to reconcile an Verrazzano resource
 // WHEN a there is an error getting the Verrazzano system namespace
@@ -1173,12 +861,6 @@ func TestVZSystemNamespaceGetError(t *testing.T) {
 	// Expect a call to get the Verrazzano resource.
 	expectGetVerrazzanoExists(mock, verrazzanoToUse, namespace, name, labels)
 
-	// Expect a call to get the service account
-	expectGetServiceAccountExists(mock, name, labels)
-
-	// Expect a call to get the ClusterRoleBinding
-	expectClusterRoleBindingExists(mock, verrazzanoToUse, namespace, name)
-
 	errMsg := "get vz system namespace error"
 	// Expect a call to get the Verrazzano system namespace - return a failure error
 	mock.EXPECT().
@@ -1231,12 +913,6 @@ func TestVZSystemNamespaceCreateError(t *testing.T) {
 	// Expect a call to get the Verrazzano resource.
 	expectGetVerrazzanoExists(mock, verrazzanoToUse, namespace, name, labels)
 
-	// Expect a call to get the service account
-	expectGetServiceAccountExists(mock, name, labels)
-
-	// Expect a call to get the ClusterRoleBinding
-	expectClusterRoleBindingExists(mock, verrazzanoToUse, namespace, name)
-
 	errMsg := "create vz system namespace error"
 	// Expect a call to get the Verrazzano system namespace - return an IsNotFound
 	mock.EXPECT().
@@ -1302,12 +978,6 @@ func TestGetOCIConfigSecretError(t *testing.T) {
 	// Expect a call to get the Verrazzano resource.
 	expectGetVerrazzanoExists(mock, verrazzanoToUse, namespace, name, labels)
 
-	// Expect a call to get the service account
-	expectGetServiceAccountExists(mock, name, labels)
-
-	// Expect a call to get the ClusterRoleBinding
-	expectClusterRoleBindingExists(mock, verrazzanoToUse, namespace, name)
-
 	// Expect a call to get the DNS config secret but return a not found error
 	mock.EXPECT().
 		Get(gomock.Any(), types.NamespacedName{Namespace: constants.VerrazzanoInstallNamespace, Name: "test-oci-config-secret"}, gomock.Not(gomock.Nil())).
@@ -1328,6 +998,36 @@ func TestGetOCIConfigSecretError(t *testing.T) {
 	asserts.NotZero(result.RequeueAfter)
 }
 
+// TestGetPeriodicCheckInterval tests the getPeriodicCheckInterval function
+// GIVEN a Verrazzano resource with the health and drift checks enabled or disabled, and a component that is resynced
+// WHEN the interval of the periodic checks is requested
+// THEN the smallest interval of the enabled checks is returned, or zero if there is no periodic check
+func TestGetPeriodicCheckInterval(t *testing.T) {
+	asserts := assert.New(t)
+	comp := fakeResyncComponent{fakeComponent: fakeComponent{HelmComponent: helmcomp.HelmComponent{ReleaseName: "fake"}}}
+	registry.OverrideGetComponentsFn(func() []spi.Component {
+		return []spi.Component{comp}
+	})
+	defer registry.ResetGetComponentsFn()
+	disabled := false
+
+	vz := newInstalledVerrazzano(vzapi.CompStateInstalling, "fake")
+	asserts.Equal(defaultHealthCheckInterval, getPeriodicCheckInterval(vz))
+
+	// The drift check still runs with the health check disabled
+	vz.Spec.HealthCheck = &vzapi.HealthCheckSpec{Enabled: &disabled}
+	vz.Spec.DriftDetection = &vzapi.DriftDetectionSpec{Interval: &metav1.Duration{Duration: 10 * time.Minute}}
+	asserts.Equal(10*time.Minute, getPeriodicCheckInterval(vz))
+
+	// The Ready component is resynced more often
+	vz.Status.Components["fake"].State = vzapi.CompStateReady
+	asserts.Equal(testResyncInterval, getPeriodicCheckInterval(vz))
+
+	vz.Spec.DriftDetection.Enabled = &disabled
+	vz.Status.Components["fake"].State = vzapi.CompStateDegraded
+	asserts.Equal(time.Duration(0), getPeriodicCheckInterval(vz))
+}
+
 // newScheme creates a new scheme that includes this package's object to use for testing
 func newScheme() *runtime.Scheme {
 	scheme := runtime.NewScheme()
@@ -1380,35 +1080,6 @@ func expectGetVerrazzanoSystemNamespaceExists(mock *mocks.MockClient, asserts *a
 		})
 }
 
-// expectClusterRoleBindingExists expects a call to get the cluster role binding for the Verrazzano with the given
-// namespace and name, and returns that it exists
-func expectClusterRoleBindingExists(mock *mocks.MockClient, verrazzanoToUse vzapi.Verrazzano, namespace string, name string) {
-	// Expect a call to get the ClusterRoleBinding - return that it exists
-	clusterRoleBindingName := buildClusterRoleBindingName(namespace, name)
-	mock.EXPECT().
-		Get(gomock.Any(), types.NamespacedName{Namespace: "", Name: clusterRoleBindingName}, gomock.Not(gomock.Nil())).
-		DoAndReturn(func(ctx context.Context, nsName types.NamespacedName, clusterRoleBinding *rbacv1.ClusterRoleBinding) error {
-			crb := rbac.NewClusterRoleBinding(&verrazzanoToUse, nsName.Name, getInstallNamespace(), buildServiceAccountName(nsName.Name))
-			clusterRoleBinding.ObjectMeta = crb.ObjectMeta
-			clusterRoleBinding.RoleRef = crb.RoleRef
-			clusterRoleBinding.Subjects = crb.Subjects
-			return nil
-		}).AnyTimes()
-}
-
-// expectGetServiceAccountExists expects a call to get the service account for the Verrazzano with the given
-// namespace and name, and returns that it exists
-func expectGetServiceAccountExists(mock *mocks.MockClient, name string, labels map[string]string) {
-	// Expect a call to get the ServiceAccount - return that it exists
-	mock.EXPECT().
-		Get(gomock.Any(), types.NamespacedName{Namespace: getInstallNamespace(), Name: buildServiceAccountName(name)}, gomock.Not(gomock.Nil())).
-		DoAndReturn(func(ctx context.Context, name types.NamespacedName, serviceAccount *corev1.ServiceAccount) error {
-			newSA := rbac.NewServiceAccount(name.Namespace, name.Name, []string{}, labels)
-			serviceAccount.ObjectMeta = newSA.ObjectMeta
-			return nil
-		}).AnyTimes()
-}
-
 // expectGetVerrazzanoExists expects a call to get a Verrazzano with the given namespace and name, and returns
 // one that has the same content as the verrazzanoToUse argument
 func expectGetVerrazzanoExists(mock *mocks.MockClient, verrazzanoToUse vzapi.Verrazzano, namespace string, name string, labels map[string]string) {
@@ -1423,11 +1094,33 @@ func expectGetVerrazzanoExists(mock *mocks.MockClient, verrazzanoToUse vzapi.Ver
 		}).AnyTimes()
 }
 
-// expectDeleteServiceAccount expects a call to delete the service account used by install
+// expectUninstallTrackerCheckpoint expects the calls to checkpoint the uninstall tracker in its ConfigMap and to
+// delete the ConfigMap
+func expectUninstallTrackerCheckpoint(mock *mocks.MockClient, namespace string, name string) {
+	nsn := types.NamespacedName{Namespace: namespace, Name: uninstallTrackerConfigMapPrefix + name}
+	mock.EXPECT().
+		Get(gomock.Any(), nsn, gomock.Not(gomock.Nil())).
+		Return(errors.NewNotFound(schema.GroupResource{Group: "", Resource: "ConfigMap"}, nsn.Name)).AnyTimes()
+	mock.EXPECT().Create(gomock.Any(), gomock.AssignableToTypeOf(&corev1.ConfigMap{})).Return(nil).AnyTimes()
+	mock.EXPECT().Delete(gomock.Any(), gomock.AssignableToTypeOf(&corev1.ConfigMap{})).Return(nil).AnyTimes()
+}
+
+// expectDeleteServiceAccount expects a call to delete the service account left behind by earlier versions
 func expectDeleteServiceAccount(mock *mocks.MockClient, namespace string, name string) {
 	mock.EXPECT().Delete(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
 }
 
+// expectCleanupUninstalledResources expects the calls to delete the Verrazzano resources that remain after the
+// components are uninstalled, none of them exist
+func expectCleanupUninstalledResources(mock *mocks.MockClient) {
+	mock.EXPECT().List(gomock.Any(), gomock.Not(gomock.Nil())).Return(nil).Times(3)
+	mock.EXPECT().List(gomock.Any(), gomock.AssignableToTypeOf(&corev1.NamespaceList{}), gomock.Any()).Return(nil).Times(len(verrazzanoNamespaceLabels))
+	mock.EXPECT().Delete(gomock.Any(), gomock.AssignableToTypeOf(&corev1.Secret{})).Return(nil)
+	mock.EXPECT().
+		Get(gomock.Any(), types.NamespacedName{Name: constants.VerrazzanoMultiClusterNamespace}, gomock.AssignableToTypeOf(&corev1.Namespace{})).
+		Return(errors.NewNotFound(schema.GroupResource{Group: "", Resource: "Namespace"}, constants.VerrazzanoMultiClusterNamespace))
+}
+
 // expectDeleteNamespace expects a call to delete the verrazzano-system ns
 func expectDeleteNamespace(mock *mocks.MockClient) {
 	mock.EXPECT().Delete(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
@@ -0,0 +1,143 @@
+// Copyright (c) 2022, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package verrazzano
+
+import (
+	"fmt"
+	"time"
+
+	vzapi "github.com/verrazzano/verrazzano/platform-operator/apis/verrazzano/v1alpha1"
+	vzconst "github.com/verrazzano/verrazzano/platform-operator/constants"
+	"github.com/verrazzano/verrazzano/platform-operator/controllers/verrazzano/component/registry"
+	"github.com/verrazzano/verrazzano/platform-operator/controllers/verrazzano/component/spi"
+	vzcontext "github.com/verrazzano/verrazzano/platform-operator/controllers/verrazzano/context"
+	ctrl "sigs.k8s.io/controller-runtime"
+)
+
+const (
+	// disableRetryDelay is how long to wait before retrying the uninstall of a disabled component that failed, the
+	// delay doubles with every failed uninstall up to maxDisableRetryDelay
+	disableRetryDelay = 30 * time.Second
+
+	// maxDisableRetryDelay is the longest delay before retrying the uninstall of a disabled component
+	maxDisableRetryDelay = 10 * time.Minute
+)
+
+// componentDisableTrackers has the uninstall context of each component that is being disabled, keyed by the
+// Verrazzano resource and component
+var componentDisableTrackers = make(map[string]*componentUninstallContext)
+
+// userResourceComponent is a component with resources created by users, such as a HelmComponent
+type userResourceComponent interface {
+	GetUserResourceCRDsInUse(ctx spi.ComponentContext) ([]string, error)
+}
+
+// disableComponent uninstalls an installed component that has been disabled in the Verrazzano resource, using the
+// same uninstall flow as the Verrazzano uninstall.  The component state is Uninstalling while the component is being
+// uninstalled, and Disabled once it is done.  The component is not uninstalled while any of the components that
+// depend on it are enabled or still installed, or while users have resources of the CRDs of the component.  An
+// uninstall that failed leaves the component Failed, and is retried with an increasing delay until it succeeds or the
+// component is enabled again.
+func (r *Reconciler) disableComponent(vzctx vzcontext.VerrazzanoContext, spiCtx spi.ComponentContext, comp spi.Component) (ctrl.Result, error) {
+	cr := spiCtx.ActualCR()
+	compName := comp.Name()
+	compLog := spiCtx.Log()
+
+	key := getDisableTrackerKey(cr, compName)
+	uninstallContext, ok := componentDisableTrackers[key]
+	if ok && uninstallContext.state == compStateUninstallFailed {
+		if wait := time.Until(uninstallContext.retryTime); wait > 0 {
+			return ctrl.Result{Requeue: true, RequeueAfter: wait}, nil
+		}
+		compLog.Progressf("Retrying the uninstall of the disabled component %s", compName)
+		uninstallContext.state = compStateUninstallStart
+		uninstallContext.failures = 0
+	}
+	if !ok {
+		if dependents := getInstalledDependents(cr, spiCtx.EffectiveCR(), compName); len(dependents) > 0 {
+			compLog.Progressf("Component %s is disabled, waiting for the components %v that depend on it to be disabled and uninstalled", compName, dependents)
+			return newRequeueWithDelay(), nil
+		}
+		compContext := spiCtx.Init(compName).Operation(vzconst.UninstallOperation)
+		if userResourceComp, ok := comp.(userResourceComponent); ok {
+			crds, err := userResourceComp.GetUserResourceCRDsInUse(compContext)
+			if err != nil {
+				return newRequeueWithDelay(), err
+			}
+			if len(crds) > 0 {
+				compLog.Progressf("Component %s is disabled, waiting for the resources of the CRDs %v to be deleted before uninstalling it", compName, crds)
+				return newRequeueWithDelay(), nil
+			}
+		}
+		uninstalled, err := comp.IsUninstalled(compContext)
+		if err != nil {
+			compLog.Errorf("Failed checking if component %s is uninstalled: %v", compName, err)
+			return newRequeueWithDelay(), err
+		}
+		if uninstalled && cr.Status.Components[compName].State != vzapi.CompStateUninstalling {
+			// Nothing to uninstall, the Verrazzano resource stays in its current state
+			return ctrl.Result{}, r.updateComponentStatus(compContext, "Uninstall complete", vzapi.CondUninstallComplete)
+		}
+		uninstallContext = &componentUninstallContext{state: compStateUninstallStart}
+		componentDisableTrackers[key] = uninstallContext
+		compLog.Oncef("Component %s is disabled and will be uninstalled", compName)
+		if vzapi.IsVzInstalledState(cr.Status.State) {
+			if err := r.setInstallingState(vzctx.Log, cr); err != nil {
+				compLog.Errorf("Failed to reset state: %v", err)
+				return newRequeueWithDelay(), err
+			}
+		}
+	}
+
+	result, err := r.uninstallSingleComponent(spiCtx, uninstallContext, comp, nil)
+	if err != nil || result.Requeue {
+		return result, err
+	}
+	if uninstallContext.state == compStateUninstallFailed {
+		delay := getDisableRetryDelay(uninstallContext.retries)
+		uninstallContext.retries++
+		uninstallContext.retryTime = time.Now().Add(delay)
+		compLog.Errorf("Failed uninstalling the disabled component %s, will retry in %v", compName, delay)
+		return ctrl.Result{Requeue: true, RequeueAfter: delay}, nil
+	}
+	delete(componentDisableTrackers, key)
+	return ctrl.Result{}, nil
+}
+
+// getDisableTrackerKey returns the key of the uninstall context of a component that is being disabled
+func getDisableTrackerKey(cr *vzapi.Verrazzano, compName string) string {
+	return fmt.Sprintf("%s-%s", getNSNKey(cr), compName)
+}
+
+// getDisableRetryDelay returns how long to wait before retrying the uninstall of a disabled component that failed
+// the given number of times before
+func getDisableRetryDelay(retries int) time.Duration {
+	delay := disableRetryDelay
+	for i := 0; i < retries && delay < maxDisableRetryDelay; i++ {
+		delay *= 2
+	}
+	if delay > maxDisableRetryDelay {
+		return maxDisableRetryDelay
+	}
+	return delay
+}
+
+// getInstalledDependents returns the names of the components that depend on the component and are either enabled,
+// or disabled but not uninstalled yet
+func getInstalledDependents(cr *vzapi.Verrazzano, effectiveCR *vzapi.Verrazzano, compName string) []string {
+	var dependents []string
+	for _, comp := range registry.GetComponents() {
+		for _, dependency := range comp.GetDependencies() {
+			if dependency != compName {
+				continue
+			}
+			componentStatus, ok := cr.Status.Components[comp.Name()]
+			if comp.IsEnabled(effectiveCR) || (ok && componentStatus.State != vzapi.CompStateDisabled) {
+				dependents = append(dependents, comp.Name())
+			}
+			break
+		}
+	}
+	return dependents
+}
@@ -0,0 +1,296 @@
+// Copyright (c) 2022, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package verrazzano
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/verrazzano/verrazzano/pkg/helm"
+	"github.com/verrazzano/verrazzano/pkg/log/vzlog"
+	vzapi "github.com/verrazzano/verrazzano/platform-operator/apis/verrazzano/v1alpha1"
+	"github.com/verrazzano/verrazzano/platform-operator/controllers/verrazzano/component/registry"
+	"github.com/verrazzano/verrazzano/platform-operator/controllers/verrazzano/component/spi"
+	vzcontext "github.com/verrazzano/verrazzano/platform-operator/controllers/verrazzano/context"
+	"github.com/verrazzano/verrazzano/platform-operator/internal/config"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	k8scheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+// reconcileDisableTestComponents reconciles the components of the Verrazzano resource in the cluster
+func reconcileDisableTestComponents(t *testing.T, c client.Client, vz *vzapi.Verrazzano) (bool, *vzapi.Verrazzano) {
+	vzctx, err := vzcontext.NewVerrazzanoContext(vzlog.DefaultLogger(), c, getVerrazzano(t, c, vz), false)
+	assert.NoError(t, err)
+
+	reconciler := newVerrazzanoReconciler(c)
+	result, err := reconciler.reconcileComponents(vzctx)
+	assert.NoError(t, err)
+
+	return result.Requeue, getVerrazzano(t, c, vz)
+}
+
+// TestDisableComponent tests the reconcileComponents method for the following use case
+// GIVEN an installed component that was disabled, and a disabled component that depends on it
+// WHEN the components are reconciled
+// THEN the dependent component is uninstalled first, and then the component it depends on
+func TestDisableComponent(t *testing.T) {
+	initUnitTesing()
+	asserts := assert.New(t)
+	config.SetDefaultBomFilePath(testBomFilePath)
+	defer config.SetDefaultBomFilePath("")
+	config.TestProfilesDir = "../../manifests/profiles"
+	defer func() { config.TestProfilesDir = "" }()
+
+	installed := map[string]bool{"fake1": true, "fake2": true}
+	newDisabledComponent := func(name string, dependencies ...string) fakeComponent {
+		comp := fakeComponent{enabled: "false"}
+		comp.ReleaseName = name
+		comp.SupportsOperatorInstall = true
+		comp.Dependencies = dependencies
+		comp.isUninstalledFunc = func(ctx spi.ComponentContext) (bool, error) {
+			return !installed[name], nil
+		}
+		comp.uninstallFunc = func(ctx spi.ComponentContext) error {
+			installed[name] = false
+			return nil
+		}
+		return comp
+	}
+	registry.OverrideGetComponentsFn(func() []spi.Component {
+		return []spi.Component{newDisabledComponent("fake1"), newDisabledComponent("fake2", "fake1")}
+	})
+	defer registry.ResetGetComponentsFn()
+
+	vz := newInstalledVerrazzano(vzapi.CompStateReady, "fake1", "fake2")
+	c := fake.NewClientBuilder().WithScheme(k8scheme.Scheme).WithObjects(vz).Build()
+
+	// fake1 waits for fake2 to be uninstalled
+	requeue, actual := reconcileDisableTestComponents(t, c, vz)
+	asserts.True(requeue)
+	asserts.True(installed["fake1"])
+	asserts.False(installed["fake2"])
+	asserts.Equal(vzapi.CompStateReady, actual.Status.Components["fake1"].State)
+	asserts.Equal(vzapi.CompStateDisabled, actual.Status.Components["fake2"].State)
+	asserts.Equal(vzapi.VzStateReconciling, actual.Status.State)
+
+	requeue, actual = reconcileDisableTestComponents(t, c, vz)
+	asserts.False(requeue)
+	asserts.False(installed["fake1"])
+	asserts.Equal(vzapi.CompStateDisabled, actual.Status.Components["fake1"].State)
+	asserts.Empty(componentDisableTrackers)
+}
+
+// TestDisableComponentNotInstalled tests the reconcileComponents method for the following use case
+// GIVEN a disabled component in the Ready state that is not installed
+// WHEN the components are reconciled
+// THEN the component state is set to Disabled without uninstalling it, and the Verrazzano state is not changed
+func TestDisableComponentNotInstalled(t *testing.T) {
+	initUnitTesing()
+	asserts := assert.New(t)
+	config.TestProfilesDir = "../../manifests/profiles"
+	defer func() { config.TestProfilesDir = "" }()
+	helm.SetActionConfigFunction(helm.CreateActionConfig())
+	defer helm.SetDefaultActionConfigFunction()
+
+	comp := fakeComponent{enabled: "false"}
+	comp.ReleaseName = "fake1"
+	comp.SupportsOperatorInstall = true
+	comp.uninstallFunc = func(ctx spi.ComponentContext) error {
+		asserts.Fail("the component should not be uninstalled")
+		return nil
+	}
+	comp.isUninstalledFunc = comp.HelmComponent.IsUninstalled
+	registry.OverrideGetComponentsFn(func() []spi.Component {
+		return []spi.Component{comp}
+	})
+	defer registry.ResetGetComponentsFn()
+
+	vz := newInstalledVerrazzano(vzapi.CompStateReady, "fake1")
+	c := fake.NewClientBuilder().WithScheme(k8scheme.Scheme).WithObjects(vz).Build()
+
+	requeue, actual := reconcileDisableTestComponents(t, c, vz)
+	asserts.False(requeue)
+	asserts.Equal(vzapi.CompStateDisabled, actual.Status.Components["fake1"].State)
+	asserts.Equal(vzapi.VzStateReady, actual.Status.State)
+}
+
+// TestDisableComponentUserResources tests the reconcileComponents method for the following use case
+// GIVEN an installed component that was disabled, with a resource of one of its user resource CRDs
+// WHEN the components are reconciled
+// THEN the component is not uninstalled until the resource is deleted, and the CRD is not deleted
+func TestDisableComponentUserResources(t *testing.T) {
+	initUnitTesing()
+	asserts := assert.New(t)
+	config.SetDefaultBomFilePath(testBomFilePath)
+	defer config.SetDefaultBomFilePath("")
+	config.TestProfilesDir = "../../manifests/profiles"
+	defer func() { config.TestProfilesDir = "" }()
+
+	installed := true
+	comp := fakeComponent{enabled: "false"}
+	comp.ReleaseName = "fake1"
+	comp.SupportsOperatorInstall = true
+	comp.UserResourceCRDs = []string{"foos.example.com"}
+	comp.isUninstalledFunc = func(ctx spi.ComponentContext) (bool, error) {
+		return !installed, nil
+	}
+	comp.uninstallFunc = func(ctx spi.ComponentContext) error {
+		installed = false
+		return nil
+	}
+	registry.OverrideGetComponentsFn(func() []spi.Component {
+		return []spi.Component{comp}
+	})
+	defer registry.ResetGetComponentsFn()
+
+	scheme := runtime.NewScheme()
+	_ = k8scheme.AddToScheme(scheme)
+	_ = vzapi.AddToScheme(scheme)
+	_ = apiextensionsv1.AddToScheme(scheme)
+	gv := schema.GroupVersion{Group: "example.com", Version: "v1"}
+	scheme.AddKnownTypeWithName(gv.WithKind("Foo"), &unstructured.Unstructured{})
+	scheme.AddKnownTypeWithName(gv.WithKind("FooList"), &unstructured.UnstructuredList{})
+	crd := &apiextensionsv1.CustomResourceDefinition{
+		ObjectMeta: metav1.ObjectMeta{Name: "foos.example.com"},
+		Spec: apiextensionsv1.CustomResourceDefinitionSpec{
+			Group:    "example.com",
+			Names:    apiextensionsv1.CustomResourceDefinitionNames{Kind: "Foo", ListKind: "FooList"},
+			Versions: []apiextensionsv1.CustomResourceDefinitionVersion{{Name: "v1", Storage: true}},
+		},
+	}
+	foo := &unstructured.Unstructured{}
+	foo.SetGroupVersionKind(gv.WithKind("Foo"))
+	foo.SetNamespace("app")
+	foo.SetName("foo")
+	vz := newInstalledVerrazzano(vzapi.CompStateReady, "fake1")
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(vz, crd, foo).Build()
+
+	requeue, actual := reconcileDisableTestComponents(t, c, vz)
+	asserts.True(requeue)
+	asserts.True(installed)
+	asserts.Equal(vzapi.CompStateReady, actual.Status.Components["fake1"].State)
+
+	asserts.NoError(c.Delete(context.TODO(), foo))
+	requeue, actual = reconcileDisableTestComponents(t, c, vz)
+	asserts.False(requeue)
+	asserts.False(installed)
+	asserts.Equal(vzapi.CompStateDisabled, actual.Status.Components["fake1"].State)
+	asserts.NoError(c.Get(context.TODO(), types.NamespacedName{Name: "foos.example.com"}, crd))
+}
+
+// TestDisableComponentUninstallFailed tests the reconcileComponents method for the following use case
+// GIVEN an installed component that was disabled, whose uninstall fails
+// WHEN the components are reconciled
+// THEN the component is Failed, Verrazzano is not ready, and the uninstall is retried after a delay
+func TestDisableComponentUninstallFailed(t *testing.T) {
+	initUnitTesing()
+	asserts := assert.New(t)
+	config.SetDefaultBomFilePath(testBomFilePath)
+	defer config.SetDefaultBomFilePath("")
+	config.TestProfilesDir = "../../manifests/profiles"
+	defer func() { config.TestProfilesDir = "" }()
+	defer func() { componentDisableTrackers = make(map[string]*componentUninstallContext) }()
+
+	installed := true
+	uninstallErr := fmt.Errorf("uninstall failed")
+	comp := fakeComponent{enabled: "false"}
+	comp.ReleaseName = "fake1"
+	comp.SupportsOperatorInstall = true
+	comp.isUninstalledFunc = func(ctx spi.ComponentContext) (bool, error) {
+		return !installed, nil
+	}
+	comp.uninstallFunc = func(ctx spi.ComponentContext) error {
+		if uninstallErr != nil {
+			return uninstallErr
+		}
+		installed = false
+		return nil
+	}
+	registry.OverrideGetComponentsFn(func() []spi.Component {
+		return []spi.Component{comp}
+	})
+	defer registry.ResetGetComponentsFn()
+
+	vz := newInstalledVerrazzano(vzapi.CompStateReady, "fake1")
+	c := fake.NewClientBuilder().WithScheme(k8scheme.Scheme).WithObjects(vz).Build()
+
+	var actual *vzapi.Verrazzano
+	for i := 0; i < maxUninstallFailures; i++ {
+		_, actual = reconcileDisableTestComponents(t, c, vz)
+	}
+	asserts.True(installed)
+	asserts.Equal(vzapi.CompStateFailed, actual.Status.Components["fake1"].State)
+	asserts.Equal(vzapi.VzStateReconciling, actual.Status.State)
+	vzctx, err := vzcontext.NewVerrazzanoContext(vzlog.DefaultLogger(), c, actual, false)
+	asserts.NoError(err)
+	reconciler := newVerrazzanoReconciler(c)
+	ready, err := reconciler.checkComponentReadyState(vzctx)
+	asserts.NoError(err)
+	asserts.False(ready)
+
+	// The uninstall is not retried before the retry time
+	uninstallErr = nil
+	requeue, actual := reconcileDisableTestComponents(t, c, vz)
+	asserts.True(requeue)
+	asserts.True(installed)
+	asserts.Equal(vzapi.CompStateFailed, actual.Status.Components["fake1"].State)
+
+	uninstallContext := componentDisableTrackers[getDisableTrackerKey(vz, "fake1")]
+	asserts.Equal(1, uninstallContext.retries)
+	uninstallContext.retryTime = time.Now()
+	requeue, actual = reconcileDisableTestComponents(t, c, vz)
+	asserts.False(requeue)
+	asserts.False(installed)
+	asserts.Equal(vzapi.CompStateDisabled, actual.Status.Components["fake1"].State)
+	asserts.Empty(componentDisableTrackers)
+}
+
+// TestDisableComponentUninstallFailedEnabled tests the reconcileComponents method for the following use case
+// GIVEN a component whose uninstall failed after it was disabled
+// WHEN the component is enabled again and the components are reconciled
+// THEN the component is moved to PreInstalling so that it is installed again
+func TestDisableComponentUninstallFailedEnabled(t *testing.T) {
+	initUnitTesing()
+	asserts := assert.New(t)
+	config.TestProfilesDir = "../../manifests/profiles"
+	defer func() { config.TestProfilesDir = "" }()
+
+	comp := fakeComponent{}
+	comp.ReleaseName = "fake1"
+	comp.SupportsOperatorInstall = true
+	registry.OverrideGetComponentsFn(func() []spi.Component {
+		return []spi.Component{comp}
+	})
+	defer registry.ResetGetComponentsFn()
+
+	vz := newInstalledVerrazzano(vzapi.CompStateFailed, "fake1")
+	componentDisableTrackers[getDisableTrackerKey(vz, "fake1")] = &componentUninstallContext{state: compStateUninstallFailed}
+	c := fake.NewClientBuilder().WithScheme(k8scheme.Scheme).WithObjects(vz).Build()
+
+	requeue, actual := reconcileDisableTestComponents(t, c, vz)
+	asserts.True(requeue)
+	asserts.Equal(vzapi.CompStatePreInstalling, actual.Status.Components["fake1"].State)
+	asserts.Empty(componentDisableTrackers)
+}
+
+// TestGetDisableRetryDelay tests the getDisableRetryDelay function
+// GIVEN the number of retries of a failed uninstall of a disabled component
+// WHEN the retry delay is requested
+// THEN the delay doubles with every retry, up to the maximum delay
+func TestGetDisableRetryDelay(t *testing.T) {
+	assert.Equal(t, disableRetryDelay, getDisableRetryDelay(0))
+	assert.Equal(t, 2*disableRetryDelay, getDisableRetryDelay(1))
+	assert.Equal(t, 8*disableRetryDelay, getDisableRetryDelay(3))
+	assert.Equal(t, maxDisableRetryDelay, getDisableRetryDelay(100))
+}
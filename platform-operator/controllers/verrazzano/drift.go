@@ -0,0 +1,136 @@
+// Copyright (c) 2022, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package verrazzano
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	installv1alpha1 "github.com/verrazzano/verrazzano/platform-operator/apis/verrazzano/v1alpha1"
+	"github.com/verrazzano/verrazzano/platform-operator/controllers/verrazzano/component/spi"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// defaultDriftCheckInterval is how often a component is checked for drift when the Verrazzano resource does not
+	// specify an interval
+	defaultDriftCheckInterval = 10 * time.Minute
+
+	// maxDriftMessageItems is the number of differences listed in the drift condition message
+	maxDriftMessageItems = 5
+)
+
+// checkComponentDrift checks a ready component for configuration drift when the check is due, and records the result
+// in the ConfigurationDrift condition of the component.  The time of the check is kept in the component status so that
+// the interval survives a restart of the operator.  It returns true if the component drifted and should be reinstalled
+// because auto remediation is turned on.
+func (r *Reconciler) checkComponentDrift(compContext spi.ComponentContext, comp spi.Component) (bool, error) {
+	cr := compContext.ActualCR()
+	if !isDriftDetectionEnabled(cr) {
+		return false, nil
+	}
+	detector, ok := comp.(spi.ComponentDriftDetector)
+	if !ok {
+		return false, nil
+	}
+	componentStatus := cr.Status.Components[comp.Name()]
+	if componentStatus == nil {
+		return false, nil
+	}
+	if lastCheck := componentStatus.LastDriftCheckTime; lastCheck != nil && time.Since(lastCheck.Time) < getDriftCheckInterval(cr) {
+		return false, nil
+	}
+	componentStatus.LastDriftCheckTime = &metav1.Time{Time: time.Now().UTC()}
+
+	// The drift is computed in a dry run, building the overrides must not change the cluster
+	drift, err := detector.GetDrift(spi.NewDryRunContext(compContext))
+	if err != nil {
+		// The check is retried at the next interval, a failed check does not affect the component
+		compContext.Log().ErrorfThrottled("Failed checking component %s for configuration drift: %v", comp.Name(), err)
+		return false, r.updateVerrazzanoStatus(compContext.Log(), cr)
+	}
+	if len(drift) > 0 {
+		compContext.Log().Infof("Component %s configuration drift detected: %s", comp.Name(), strings.Join(drift, "; "))
+	}
+	r.updateDriftCondition(compContext, componentStatus, drift)
+	remediate := len(drift) > 0 && cr.Spec.DriftDetection != nil && cr.Spec.DriftDetection.AutoRemediate
+	if remediate {
+		// Check the reinstalled component again as soon as it is ready, to clear the condition
+		componentStatus.LastDriftCheckTime = nil
+	}
+	if err := r.updateVerrazzanoStatus(compContext.Log(), cr); err != nil {
+		return false, err
+	}
+	return remediate, nil
+}
+
+// updateDriftCondition sets the ConfigurationDrift condition of the component to True with the differences, or to False
+// when there are none.  The state of the component is not changed, and the condition is only updated when it changed.
+func (r *Reconciler) updateDriftCondition(compContext spi.ComponentContext, componentStatus *installv1alpha1.ComponentStatusDetails, drift []string) {
+	cr := compContext.ActualCR()
+	compName := compContext.GetComponent()
+
+	status := corev1.ConditionFalse
+	message := "No configuration drift detected"
+	if len(drift) > 0 {
+		status = corev1.ConditionTrue
+		message = buildDriftMessage(drift)
+	}
+
+	var existing *installv1alpha1.Condition
+	for i := range componentStatus.Conditions {
+		if componentStatus.Conditions[i].Type == installv1alpha1.CondConfigurationDrift {
+			existing = &componentStatus.Conditions[i]
+			break
+		}
+	}
+	if existing == nil && status == corev1.ConditionFalse {
+		// No drift has ever been detected
+		return
+	}
+	if existing != nil && existing.Status == status && existing.Message == message {
+		return
+	}
+
+	t := time.Now().UTC()
+	condition := installv1alpha1.Condition{
+		Type:   installv1alpha1.CondConfigurationDrift,
+		Status: status,
+		LastTransitionTime: fmt.Sprintf("%d-%02d-%02dT%02d:%02d:%02dZ",
+			t.Year(), t.Month(), t.Day(),
+			t.Hour(), t.Minute(), t.Second()),
+		Message: message,
+	}
+	if existing != nil {
+		*existing = condition
+	} else {
+		componentStatus.Conditions = append(componentStatus.Conditions, condition)
+	}
+	if status == corev1.ConditionTrue {
+		r.recordConditionEvent(cr, compName, installv1alpha1.CondConfigurationDrift, message)
+	}
+}
+
+// buildDriftMessage returns the condition message listing the first differences
+func buildDriftMessage(drift []string) string {
+	if len(drift) <= maxDriftMessageItems {
+		return fmt.Sprintf("Configuration drift detected: %s", strings.Join(drift, "; "))
+	}
+	return fmt.Sprintf("Configuration drift detected: %s; and %d more", strings.Join(drift[:maxDriftMessageItems], "; "), len(drift)-maxDriftMessageItems)
+}
+
+// isDriftDetectionEnabled returns true unless drift detection is turned off in the Verrazzano resource
+func isDriftDetectionEnabled(cr *installv1alpha1.Verrazzano) bool {
+	return cr.Spec.DriftDetection == nil || cr.Spec.DriftDetection.Enabled == nil || *cr.Spec.DriftDetection.Enabled
+}
+
+// getDriftCheckInterval returns how often a component is checked for drift
+func getDriftCheckInterval(cr *installv1alpha1.Verrazzano) time.Duration {
+	if cr.Spec.DriftDetection == nil || cr.Spec.DriftDetection.Interval == nil {
+		return defaultDriftCheckInterval
+	}
+	return cr.Spec.DriftDetection.Interval.Duration
+}
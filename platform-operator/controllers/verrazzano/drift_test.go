@@ -0,0 +1,120 @@
+// Copyright (c) 2022, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package verrazzano
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	vzapi "github.com/verrazzano/verrazzano/platform-operator/apis/verrazzano/v1alpha1"
+	"github.com/verrazzano/verrazzano/platform-operator/controllers/verrazzano/component/helm"
+	"github.com/verrazzano/verrazzano/platform-operator/controllers/verrazzano/component/spi"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8scheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+// TestCheckComponentDrift tests the checkComponentDrift method for the following use case
+// GIVEN a ready component
+// WHEN the component is checked for drift, before and after the check interval has passed
+// THEN the ConfigurationDrift condition is True while the component has drifted and False once the drift is gone, the
+// drift is checked in a dry run and the time of the check is saved in the component status
+func TestCheckComponentDrift(t *testing.T) {
+	asserts := assert.New(t)
+	vz := newInstalledVerrazzano(vzapi.CompStateReady, "fake")
+
+	c := fake.NewClientBuilder().WithScheme(k8scheme.Scheme).WithObjects(vz).Build()
+	recorder := record.NewFakeRecorder(10)
+	reconciler := newVerrazzanoReconciler(c)
+	reconciler.EventRecorder = recorder
+
+	drift := []string{"Helm value ~ replicas: 1 -> 2"}
+	checks := 0
+	comp := fakeComponent{
+		HelmComponent: helm.HelmComponent{ReleaseName: "fake"},
+		driftFunc: func(ctx spi.ComponentContext) ([]string, error) {
+			asserts.True(ctx.IsDryRun())
+			checks++
+			return drift, nil
+		},
+	}
+	compContext := spi.NewFakeContext(c, vz, false).Init("fake")
+
+	remediate, err := reconciler.checkComponentDrift(compContext, comp)
+	asserts.NoError(err)
+	asserts.False(remediate)
+	actual := getVerrazzano(t, c, vz)
+	asserts.NotNil(actual.Status.Components["fake"].LastDriftCheckTime)
+	condition := getComponentCondition(actual, "fake", vzapi.CondConfigurationDrift)
+	asserts.NotNil(condition)
+	asserts.Equal(corev1.ConditionTrue, condition.Status)
+	asserts.Equal("Configuration drift detected: Helm value ~ replicas: 1 -> 2", condition.Message)
+	asserts.Equal("Warning ConfigurationDrift Component fake: Configuration drift detected: Helm value ~ replicas: 1 -> 2", <-recorder.Events)
+
+	// The check is not due yet
+	_, err = reconciler.checkComponentDrift(compContext, comp)
+	asserts.NoError(err)
+	asserts.Equal(1, checks)
+
+	// The drift is gone at the next check
+	vz.Status.Components["fake"].LastDriftCheckTime = &metav1.Time{Time: time.Now().Add(-defaultDriftCheckInterval)}
+	drift = nil
+	_, err = reconciler.checkComponentDrift(compContext, comp)
+	asserts.NoError(err)
+	asserts.Equal(2, checks)
+	condition = getComponentCondition(getVerrazzano(t, c, vz), "fake", vzapi.CondConfigurationDrift)
+	asserts.NotNil(condition)
+	asserts.Equal(corev1.ConditionFalse, condition.Status)
+}
+
+// TestCheckComponentDriftAutoRemediate tests the checkComponentDrift method for the following use case
+// GIVEN a ready component that drifted and a Verrazzano resource with auto remediation turned on
+// WHEN the component is checked for drift
+// THEN the component should be reinstalled and is checked again as soon as it is ready
+func TestCheckComponentDriftAutoRemediate(t *testing.T) {
+	asserts := assert.New(t)
+	vz := newInstalledVerrazzano(vzapi.CompStateReady, "fake")
+	vz.Spec.DriftDetection = &vzapi.DriftDetectionSpec{AutoRemediate: true}
+
+	c := fake.NewClientBuilder().WithScheme(k8scheme.Scheme).WithObjects(vz).Build()
+	reconciler := newVerrazzanoReconciler(c)
+	comp := fakeComponent{
+		HelmComponent: helm.HelmComponent{ReleaseName: "fake"},
+		driftFunc: func(ctx spi.ComponentContext) ([]string, error) {
+			return []string{"StatefulSet default/fake is missing"}, nil
+		},
+	}
+
+	remediate, err := reconciler.checkComponentDrift(spi.NewFakeContext(c, vz, false).Init("fake"), comp)
+	asserts.NoError(err)
+	asserts.True(remediate)
+	asserts.Nil(getVerrazzano(t, c, vz).Status.Components["fake"].LastDriftCheckTime)
+}
+
+// TestCheckComponentDriftDisabled tests the checkComponentDrift method for the following use case
+// GIVEN a ready component and a Verrazzano resource with drift detection turned off
+// WHEN the component is checked for drift
+// THEN the component is not checked
+func TestCheckComponentDriftDisabled(t *testing.T) {
+	asserts := assert.New(t)
+	enabled := false
+	vz := newInstalledVerrazzano(vzapi.CompStateReady, "fake")
+	vz.Spec.DriftDetection = &vzapi.DriftDetectionSpec{Enabled: &enabled}
+	c := fake.NewClientBuilder().WithScheme(k8scheme.Scheme).WithObjects(vz).Build()
+	reconciler := newVerrazzanoReconciler(c)
+	comp := fakeComponent{
+		HelmComponent: helm.HelmComponent{ReleaseName: "fake"},
+		driftFunc: func(ctx spi.ComponentContext) ([]string, error) {
+			asserts.Fail("the component should not be checked for drift")
+			return nil, nil
+		},
+	}
+
+	remediate, err := reconciler.checkComponentDrift(spi.NewFakeContext(c, vz, false).Init("fake"), comp)
+	asserts.NoError(err)
+	asserts.False(remediate)
+}
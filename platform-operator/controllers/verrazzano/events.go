@@ -0,0 +1,78 @@
+// Copyright (c) 2022, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package verrazzano
+
+import (
+	"fmt"
+
+	installv1alpha1 "github.com/verrazzano/verrazzano/platform-operator/apis/verrazzano/v1alpha1"
+	"github.com/verrazzano/verrazzano/platform-operator/controllers/verrazzano/component/spi"
+	"github.com/verrazzano/verrazzano/platform-operator/internal/metrics"
+	corev1 "k8s.io/api/core/v1"
+)
+
+// Event reasons for the component operations that fail
+const (
+	eventReasonPreInstallFailed    = "PreInstallFailed"
+	eventReasonInstallFailed       = "InstallFailed"
+	eventReasonPostInstallFailed   = "PostInstallFailed"
+	eventReasonReconcileFailed     = "ReconcileFailed"
+	eventReasonPreUpgradeFailed    = "PreUpgradeFailed"
+	eventReasonUpgradeFailed       = "UpgradeFailed"
+	eventReasonPostUpgradeFailed   = "PostUpgradeFailed"
+	eventReasonRollbackFailed      = "RollbackFailed"
+	eventReasonPreUninstallFailed  = "PreUninstallFailed"
+	eventReasonUninstallFailed     = "UninstallFailed"
+	eventReasonPostUninstallFailed = "PostUninstallFailed"
+)
+
+// warningConditions are the conditions that are recorded as Warning events, the others are Normal events
+var warningConditions = map[installv1alpha1.ConditionType]bool{
+	installv1alpha1.CondInstallFailed:      true,
+	installv1alpha1.CondUninstallFailed:    true,
+	installv1alpha1.CondUpgradeFailed:      true,
+	installv1alpha1.CondUpgradePaused:      true,
+	installv1alpha1.CondUpgradeRolledBack:  true,
+	installv1alpha1.CondPreflightFailed:    true,
+	installv1alpha1.CondConfigurationDrift: true,
+	installv1alpha1.CondDegraded:           true,
+}
+
+// recordConditionEvent records an Event on the Verrazzano resource for a condition of the resource, or of one of
+// its components when the component name is not empty.  The condition type is the reason of the Event.
+func (r *Reconciler) recordConditionEvent(cr *installv1alpha1.Verrazzano, compName string, conditionType installv1alpha1.ConditionType, message string) {
+	if r.EventRecorder == nil {
+		return
+	}
+	eventType := corev1.EventTypeNormal
+	if warningConditions[conditionType] {
+		eventType = corev1.EventTypeWarning
+	}
+	if len(compName) > 0 {
+		message = fmt.Sprintf("Component %s: %s", compName, message)
+	}
+	r.EventRecorder.Event(cr, eventType, string(conditionType), message)
+}
+
+// isConditionChanged returns true if setting the condition changes the state, or if the condition differs from the
+// last condition in type or message.  Events are only recorded for changes, so that the status updates repeated by
+// each reconcile do not record the same Event again.
+func isConditionChanged(conditions []installv1alpha1.Condition, currentState string, newState string, conditionType installv1alpha1.ConditionType, message string) bool {
+	if currentState != newState || len(conditions) == 0 {
+		return true
+	}
+	last := conditions[len(conditions)-1]
+	return last.Type != conditionType || last.Message != message
+}
+
+// recordComponentError counts the error of a component operation and records it as a Warning Event on the
+// Verrazzano resource
+func (r *Reconciler) recordComponentError(compContext spi.ComponentContext, reason string, err error) {
+	compName := compContext.GetComponent()
+	metrics.IncComponentReconcileErrors(compName)
+	if r.EventRecorder == nil {
+		return
+	}
+	r.EventRecorder.Eventf(compContext.ActualCR(), corev1.EventTypeWarning, reason, "Component %s: %v", compName, err)
+}
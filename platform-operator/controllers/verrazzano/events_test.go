@@ -0,0 +1,92 @@
+// Copyright (c) 2022, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package verrazzano
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/verrazzano/verrazzano/pkg/log/vzlog"
+	vzapi "github.com/verrazzano/verrazzano/platform-operator/apis/verrazzano/v1alpha1"
+	"github.com/verrazzano/verrazzano/platform-operator/controllers/verrazzano/component/spi"
+	k8scheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+// TestUpdateStatusRecordsEvent tests the updateStatus method for the following use case
+// GIVEN a Verrazzano resource
+// WHEN the install of the resource starts and the upgrade of the resource fails
+// THEN a Normal Event is recorded for the install started condition and a Warning Event for the upgrade failed condition
+func TestUpdateStatusRecordsEvent(t *testing.T) {
+	asserts := assert.New(t)
+	vz := newInstalledVerrazzano(vzapi.CompStateReady, "fake")
+	recorder := record.NewFakeRecorder(10)
+	reconciler := newVerrazzanoReconciler(fake.NewClientBuilder().WithScheme(k8scheme.Scheme).WithObjects(vz).Build())
+	reconciler.EventRecorder = recorder
+
+	asserts.NoError(reconciler.updateStatus(vzlog.DefaultLogger(), vz, "Verrazzano install in progress", vzapi.CondInstallStarted))
+	asserts.NoError(reconciler.updateStatus(vzlog.DefaultLogger(), vz, "Verrazzano upgrade failed", vzapi.CondUpgradeFailed))
+	asserts.Equal("Normal InstallStarted Verrazzano install in progress", <-recorder.Events)
+	asserts.Equal("Warning UpgradeFailed Verrazzano upgrade failed", <-recorder.Events)
+}
+
+// TestUpdateComponentStatusRecordsEvent tests the updateComponentStatus method for the following use case
+// GIVEN a component of a Verrazzano resource
+// WHEN the component install completes
+// THEN a Normal Event with the component name is recorded on the Verrazzano resource
+func TestUpdateComponentStatusRecordsEvent(t *testing.T) {
+	asserts := assert.New(t)
+	vz := newInstalledVerrazzano(vzapi.CompStateReady, "fake")
+	c := fake.NewClientBuilder().WithScheme(k8scheme.Scheme).WithObjects(vz).Build()
+	recorder := record.NewFakeRecorder(10)
+	reconciler := newVerrazzanoReconciler(c)
+	reconciler.EventRecorder = recorder
+
+	compContext := spi.NewFakeContext(c, vz, false).Init("fake")
+	asserts.NoError(reconciler.updateComponentStatus(compContext, "Install complete", vzapi.CondInstallComplete))
+	asserts.Equal("Normal InstallComplete Component fake: Install complete", <-recorder.Events)
+}
+
+// TestUpdateStatusRecordsEventOnChange tests the updateStatus and updateComponentStatus methods for the following use case
+// GIVEN a Verrazzano resource and one of its components
+// WHEN the same condition is set more than once
+// THEN the Event is only recorded the first time, and again once the condition changes
+func TestUpdateStatusRecordsEventOnChange(t *testing.T) {
+	asserts := assert.New(t)
+	vz := newInstalledVerrazzano(vzapi.CompStateReady, "fake")
+	c := fake.NewClientBuilder().WithScheme(k8scheme.Scheme).WithObjects(vz).Build()
+	recorder := record.NewFakeRecorder(10)
+	reconciler := newVerrazzanoReconciler(c)
+	reconciler.EventRecorder = recorder
+
+	asserts.NoError(reconciler.updateStatus(vzlog.DefaultLogger(), vz, "Verrazzano upgrade failed", vzapi.CondUpgradeFailed))
+	asserts.NoError(reconciler.updateStatus(vzlog.DefaultLogger(), vz, "Verrazzano upgrade failed", vzapi.CondUpgradeFailed))
+	asserts.NoError(reconciler.updateStatus(vzlog.DefaultLogger(), vz, "Verrazzano upgrade failed again", vzapi.CondUpgradeFailed))
+	compContext := spi.NewFakeContext(c, vz, false).Init("fake")
+	asserts.NoError(reconciler.updateComponentStatus(compContext, "Install started", vzapi.CondInstallStarted))
+	asserts.NoError(reconciler.updateComponentStatus(compContext, "Install started", vzapi.CondInstallStarted))
+
+	asserts.Equal("Warning UpgradeFailed Verrazzano upgrade failed", <-recorder.Events)
+	asserts.Equal("Warning UpgradeFailed Verrazzano upgrade failed again", <-recorder.Events)
+	asserts.Equal("Normal InstallStarted Component fake: Install started", <-recorder.Events)
+	asserts.Empty(recorder.Events)
+}
+
+// TestRecordComponentError tests the recordComponentError method for the following use case
+// GIVEN a component operation that fails
+// WHEN the error is recorded
+// THEN a Warning Event with the component name and the error text is recorded on the Verrazzano resource
+func TestRecordComponentError(t *testing.T) {
+	vz := newInstalledVerrazzano(vzapi.CompStateReady, "fake")
+	c := fake.NewClientBuilder().WithScheme(k8scheme.Scheme).WithObjects(vz).Build()
+	recorder := record.NewFakeRecorder(10)
+	reconciler := newVerrazzanoReconciler(c)
+	reconciler.EventRecorder = recorder
+
+	compContext := spi.NewFakeContext(c, vz, false).Init("fake")
+	reconciler.recordComponentError(compContext, eventReasonUpgradeFailed, fmt.Errorf("helm upgrade failed"))
+	assert.Equal(t, "Warning UpgradeFailed Component fake: helm upgrade failed", <-recorder.Events)
+}
@@ -21,18 +21,34 @@ type installFuncSig func(ctx spi.ComponentContext) error
 // isInstalledFuncSig is a function needed for unit test override
 type isInstalledFuncSig func(ctx spi.ComponentContext) (bool, error)
 
+// uninstallFuncSig is a function needed for unit test override
+type uninstallFuncSig func(ctx spi.ComponentContext) error
+
+// isUninstalledFuncSig is a function needed for unit test override
+type isUninstalledFuncSig func(ctx spi.ComponentContext) (bool, error)
+
+// preflightFuncSig is a function needed for unit test override
+type preflightFuncSig func(ctx spi.ComponentContext) []error
+
+// driftFuncSig is a function needed for unit test override
+type driftFuncSig func(ctx spi.ComponentContext) ([]string, error)
+
 // fakeComponent allows for using dummy Component implementations for controller testing
 type fakeComponent struct {
 	helm.HelmComponent
 
-	upgradeFunc     upgradeFuncSig
-	installFunc     installFuncSig
-	isInstalledFunc isInstalledFuncSig
-	installed       string `default:"true"`
-	ready           string `default:"true"`
-	enabled         string `default:"true"`
-	monitorChanges  string `default:"true"`
-	minVersion      string
+	upgradeFunc       upgradeFuncSig
+	installFunc       installFuncSig
+	isInstalledFunc   isInstalledFuncSig
+	uninstallFunc     uninstallFuncSig
+	isUninstalledFunc isUninstalledFuncSig
+	preflightFunc     preflightFuncSig
+	driftFunc         driftFuncSig
+	installed         string `default:"true"`
+	ready             string `default:"true"`
+	enabled           string `default:"true"`
+	monitorChanges    string `default:"true"`
+	minVersion        string
 }
 
 func (f fakeComponent) Name() string {
@@ -97,6 +113,35 @@ func (f fakeComponent) IsInstalled(ctx spi.ComponentContext) (bool, error) {
 	return getBool(f.installed, "installed"), nil
 }
 
+func (f fakeComponent) Uninstall(ctx spi.ComponentContext) error {
+	if f.uninstallFunc != nil {
+		return f.uninstallFunc(ctx)
+	}
+	return nil
+}
+
+func (f fakeComponent) IsUninstalled(ctx spi.ComponentContext) (bool, error) {
+	if f.isUninstalledFunc != nil {
+		return f.isUninstalledFunc(ctx)
+	}
+	installed, err := f.IsInstalled(ctx)
+	return !installed, err
+}
+
+func (f fakeComponent) PreflightChecks(ctx spi.ComponentContext) []error {
+	if f.preflightFunc != nil {
+		return f.preflightFunc(ctx)
+	}
+	return nil
+}
+
+func (f fakeComponent) GetDrift(ctx spi.ComponentContext) ([]string, error) {
+	if f.driftFunc != nil {
+		return f.driftFunc(ctx)
+	}
+	return nil, nil
+}
+
 func (f fakeComponent) IsReady(x spi.ComponentContext) bool {
 	return getBool(f.ready, "ready")
 }
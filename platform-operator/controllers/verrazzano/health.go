@@ -0,0 +1,145 @@
+// Copyright (c) 2022, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package verrazzano
+
+import (
+	"fmt"
+	"time"
+
+	installv1alpha1 "github.com/verrazzano/verrazzano/platform-operator/apis/verrazzano/v1alpha1"
+	"github.com/verrazzano/verrazzano/platform-operator/controllers/verrazzano/component/spi"
+	vzcontext "github.com/verrazzano/verrazzano/platform-operator/controllers/verrazzano/context"
+	"github.com/verrazzano/verrazzano/platform-operator/internal/k8s/status"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// defaultHealthCheckInterval is how often an installed component is checked for health when the Verrazzano resource
+// does not specify an interval
+const defaultHealthCheckInterval = time.Minute
+
+// checkComponentHealth checks a Ready or Degraded component for health when the check is due.  A Ready component that
+// is not healthy moves to the Degraded state, and a Degraded component that is healthy again moves back to Ready.  The
+// time of the check is saved in the component status, so that the interval is kept across operator restarts.
+func (r *Reconciler) checkComponentHealth(compContext spi.ComponentContext, comp spi.Component) error {
+	cr := compContext.ActualCR()
+	componentStatus := cr.Status.Components[comp.Name()]
+	if componentStatus == nil {
+		return nil
+	}
+	if !isHealthCheckEnabled(cr) {
+		// A component is not left degraded once the health check is turned off
+		if componentStatus.State == installv1alpha1.CompStateDegraded && r.setHealthCondition(compContext, componentStatus, "") {
+			return r.updateVerrazzanoStatus(compContext.Log(), cr)
+		}
+		return nil
+	}
+	if lastCheck := componentStatus.LastHealthCheckTime; lastCheck != nil && time.Since(lastCheck.Time) < getHealthCheckInterval(cr) {
+		return nil
+	}
+	componentStatus.LastHealthCheckTime = &metav1.Time{Time: time.Now().UTC()}
+	r.setHealthCondition(compContext, componentStatus, getUnhealthyReason(compContext, comp))
+	return r.updateVerrazzanoStatus(compContext.Log(), cr)
+}
+
+// getUnhealthyReason returns why a component is not healthy, or an empty string when the component is ready and its
+// certificates are ready
+func getUnhealthyReason(compContext spi.ComponentContext, comp spi.Component) string {
+	if !comp.IsReady(compContext) {
+		return fmt.Sprintf("Component %s is not ready", comp.Name())
+	}
+	if ready, certsNotReady := status.CertificatesAreReady(compContext.Client(), compContext.Log(), compContext.EffectiveCR(), comp.GetCertificateNames(compContext)); !ready {
+		return fmt.Sprintf("Certificates %v of component %s are not ready", certsNotReady, comp.Name())
+	}
+	return ""
+}
+
+// setHealthCondition sets the Degraded condition of the component to True with the reason and the component state
+// to Degraded, or sets the condition to False and the state back to Ready when the reason is empty.  It returns true
+// if the condition changed, the status is not saved.
+func (r *Reconciler) setHealthCondition(compContext spi.ComponentContext, componentStatus *installv1alpha1.ComponentStatusDetails, reason string) bool {
+	cr := compContext.ActualCR()
+	compName := compContext.GetComponent()
+
+	var existing *installv1alpha1.Condition
+	for i := range componentStatus.Conditions {
+		if componentStatus.Conditions[i].Type == installv1alpha1.CondDegraded {
+			existing = &componentStatus.Conditions[i]
+			break
+		}
+	}
+
+	degraded := len(reason) > 0
+	if degraded && componentStatus.State == installv1alpha1.CompStateDegraded && existing != nil && existing.Message == reason {
+		return false
+	}
+	if !degraded && componentStatus.State != installv1alpha1.CompStateDegraded && (existing == nil || existing.Status == corev1.ConditionFalse) {
+		// The component is healthy and was never degraded, or has already recovered
+		return false
+	}
+
+	status := corev1.ConditionFalse
+	message := fmt.Sprintf("Component %s is healthy", compName)
+	state := installv1alpha1.CompStateReady
+	if degraded {
+		status = corev1.ConditionTrue
+		message = reason
+		state = installv1alpha1.CompStateDegraded
+	}
+	t := time.Now().UTC()
+	condition := installv1alpha1.Condition{
+		Type:   installv1alpha1.CondDegraded,
+		Status: status,
+		LastTransitionTime: fmt.Sprintf("%d-%02d-%02dT%02d:%02d:%02dZ",
+			t.Year(), t.Month(), t.Day(),
+			t.Hour(), t.Minute(), t.Second()),
+		Message: message,
+	}
+	if existing != nil {
+		*existing = condition
+	} else {
+		componentStatus.Conditions = append(componentStatus.Conditions, condition)
+	}
+	componentStatus.State = state
+	if degraded {
+		compContext.Log().Infof("Component %s is degraded: %s", compName, reason)
+		r.recordConditionEvent(cr, compName, installv1alpha1.CondDegraded, message)
+	} else {
+		compContext.Log().Infof("Component %s recovered and is ready", compName)
+	}
+	return true
+}
+
+// updateVzHealthState sets the state of an installed Verrazzano resource to Degraded when one of its components is
+// degraded, and back to Ready once none are.  The status is only updated when the state changed.
+func (r *Reconciler) updateVzHealthState(vzctx vzcontext.VerrazzanoContext) error {
+	cr := vzctx.ActualCR
+	if !installv1alpha1.IsVzInstalledState(cr.Status.State) {
+		return nil
+	}
+	state := installv1alpha1.VzStateReady
+	for _, componentStatus := range cr.Status.Components {
+		if componentStatus.State == installv1alpha1.CompStateDegraded {
+			state = installv1alpha1.VzStateDegraded
+			break
+		}
+	}
+	if cr.Status.State == state {
+		return nil
+	}
+	return r.updateVzState(vzctx.Log, cr, state)
+}
+
+// isHealthCheckEnabled returns true unless the health check is turned off in the Verrazzano resource
+func isHealthCheckEnabled(cr *installv1alpha1.Verrazzano) bool {
+	return cr.Spec.HealthCheck == nil || cr.Spec.HealthCheck.Enabled == nil || *cr.Spec.HealthCheck.Enabled
+}
+
+// getHealthCheckInterval returns how often a component is checked for health
+func getHealthCheckInterval(cr *installv1alpha1.Verrazzano) time.Duration {
+	if cr.Spec.HealthCheck == nil || cr.Spec.HealthCheck.Interval == nil {
+		return defaultHealthCheckInterval
+	}
+	return cr.Spec.HealthCheck.Interval.Duration
+}
@@ -0,0 +1,98 @@
+// Copyright (c) 2022, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package verrazzano
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/verrazzano/verrazzano/pkg/log/vzlog"
+	vzapi "github.com/verrazzano/verrazzano/platform-operator/apis/verrazzano/v1alpha1"
+	"github.com/verrazzano/verrazzano/platform-operator/controllers/verrazzano/component/helm"
+	"github.com/verrazzano/verrazzano/platform-operator/controllers/verrazzano/component/spi"
+	vzcontext "github.com/verrazzano/verrazzano/platform-operator/controllers/verrazzano/context"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8scheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+// TestCheckComponentHealth tests the checkComponentHealth and updateVzHealthState methods for the following use case
+// GIVEN a ready component of an installed Verrazzano
+// WHEN the component is checked for health while it is not ready, and after it recovered
+// THEN the component and Verrazzano are Degraded while the component is not ready, and Ready again once it recovered
+func TestCheckComponentHealth(t *testing.T) {
+	asserts := assert.New(t)
+	vz := newInstalledVerrazzano(vzapi.CompStateReady, "fake")
+
+	c := fake.NewClientBuilder().WithScheme(k8scheme.Scheme).WithObjects(vz).Build()
+	recorder := record.NewFakeRecorder(10)
+	reconciler := newVerrazzanoReconciler(c)
+	reconciler.EventRecorder = recorder
+
+	comp := fakeComponent{HelmComponent: helm.HelmComponent{ReleaseName: "fake"}, ready: "false"}
+	compContext := spi.NewFakeContext(c, vz, false).Init("fake")
+	vzctx := vzcontext.VerrazzanoContext{Log: vzlog.DefaultLogger(), Client: c, ActualCR: vz}
+
+	asserts.NoError(reconciler.checkComponentHealth(compContext, comp))
+	asserts.NoError(reconciler.updateVzHealthState(vzctx))
+	actual := getVerrazzano(t, c, vz)
+	asserts.Equal(vzapi.CompStateDegraded, actual.Status.Components["fake"].State)
+	asserts.Equal(vzapi.VzStateDegraded, actual.Status.State)
+	condition := getComponentCondition(actual, "fake", vzapi.CondDegraded)
+	asserts.NotNil(condition)
+	asserts.Equal(corev1.ConditionTrue, condition.Status)
+	asserts.Equal("Component fake is not ready", condition.Message)
+	asserts.Equal("Warning Degraded Component fake: Component fake is not ready", <-recorder.Events)
+	asserts.NotNil(actual.Status.Components["fake"].LastHealthCheckTime)
+
+	// The component recovered by the next check
+	vz.Status.Components["fake"].LastHealthCheckTime = &metav1.Time{Time: time.Now().Add(-defaultHealthCheckInterval)}
+	comp.ready = "true"
+	asserts.NoError(reconciler.checkComponentHealth(compContext, comp))
+	asserts.NoError(reconciler.updateVzHealthState(vzctx))
+	actual = getVerrazzano(t, c, vz)
+	asserts.Equal(vzapi.CompStateReady, actual.Status.Components["fake"].State)
+	asserts.Equal(vzapi.VzStateReady, actual.Status.State)
+	condition = getComponentCondition(actual, "fake", vzapi.CondDegraded)
+	asserts.NotNil(condition)
+	asserts.Equal(corev1.ConditionFalse, condition.Status)
+}
+
+// TestCheckComponentHealthNotDue tests the checkComponentHealth method for the following use case
+// GIVEN a ready component that was checked for health within the check interval
+// WHEN the component is checked for health while it is not ready
+// THEN the component is not checked and stays Ready
+func TestCheckComponentHealthNotDue(t *testing.T) {
+	asserts := assert.New(t)
+	vz := newInstalledVerrazzano(vzapi.CompStateReady, "fake")
+	vz.Spec.HealthCheck = &vzapi.HealthCheckSpec{Interval: &metav1.Duration{Duration: time.Hour}}
+	vz.Status.Components["fake"].LastHealthCheckTime = &metav1.Time{Time: time.Now().Add(-defaultHealthCheckInterval)}
+
+	c := fake.NewClientBuilder().WithScheme(k8scheme.Scheme).WithObjects(vz).Build()
+	reconciler := newVerrazzanoReconciler(c)
+	comp := fakeComponent{HelmComponent: helm.HelmComponent{ReleaseName: "fake"}, ready: "false"}
+
+	asserts.NoError(reconciler.checkComponentHealth(spi.NewFakeContext(c, vz, false).Init("fake"), comp))
+	asserts.Equal(vzapi.CompStateReady, getVerrazzano(t, c, vz).Status.Components["fake"].State)
+}
+
+// TestCheckComponentHealthDisabled tests the checkComponentHealth method for the following use case
+// GIVEN a degraded component and a Verrazzano resource with the health check turned off
+// WHEN the component is checked for health
+// THEN the component is set back to Ready without checking it
+func TestCheckComponentHealthDisabled(t *testing.T) {
+	asserts := assert.New(t)
+	enabled := false
+	vz := newInstalledVerrazzano(vzapi.CompStateDegraded, "fake")
+	vz.Spec.HealthCheck = &vzapi.HealthCheckSpec{Enabled: &enabled}
+	c := fake.NewClientBuilder().WithScheme(k8scheme.Scheme).WithObjects(vz).Build()
+	reconciler := newVerrazzanoReconciler(c)
+	comp := fakeComponent{HelmComponent: helm.HelmComponent{ReleaseName: "fake"}, ready: "false"}
+
+	asserts.NoError(reconciler.checkComponentHealth(spi.NewFakeContext(c, vz, false).Init("fake"), comp))
+	asserts.Equal(vzapi.CompStateReady, getVerrazzano(t, c, vz).Status.Components["fake"].State)
+}
@@ -4,6 +4,8 @@
 package verrazzano
 
 import (
+	"sync"
+
 	"github.com/verrazzano/verrazzano/pkg/log/vzlog"
 	"github.com/verrazzano/verrazzano/pkg/semver"
 	vzapi "github.com/verrazzano/verrazzano/platform-operator/apis/verrazzano/v1alpha1"
@@ -11,9 +13,34 @@ import (
 	"github.com/verrazzano/verrazzano/platform-operator/controllers/verrazzano/component/registry"
 	"github.com/verrazzano/verrazzano/platform-operator/controllers/verrazzano/component/spi"
 	vzcontext "github.com/verrazzano/verrazzano/platform-operator/controllers/verrazzano/context"
+	"github.com/verrazzano/verrazzano/platform-operator/internal/config"
 	ctrl "sigs.k8s.io/controller-runtime"
 )
 
+// componentInstall is a component in the PreInstalling or Installing state that has install work to do
+type componentInstall struct {
+	comp        spi.Component
+	compContext spi.ComponentContext
+	state       vzapi.CompStateType
+}
+
+// componentInstallResult is the outcome of the install work done for a single component.  The component
+// status is updated from the result by the reconcile goroutine, so that status updates never conflict
+type componentInstallResult struct {
+	// condition is the component condition to set, empty if the component status is not updated
+	condition vzapi.ConditionType
+	// message is the message for the condition
+	message string
+	// requeue is true if the component still has install work to do
+	requeue bool
+	// clearWatch is true if the component watch should be cleared
+	clearWatch bool
+	// err is the error returned by the component operation that failed, if any
+	err error
+	// errReason is the event reason of the component operation that failed
+	errReason string
+}
+
 // reconcileComponents reconciles each component using the following rules:
 // 1. Always requeue until all enabled components have completed installation
 // 2. Don't update the component state until all the work in that state is done, since
@@ -21,6 +48,8 @@ import (
 // 3. Loop through all components before returning, except for the case
 //    where update status fails, in which case we exit the function and requeue
 //    immediately.
+// 4. Components are processed by their level in the dependency DAG, the install work of the
+//    components at the same level is done concurrently since they are independent of each other
 func (r *Reconciler) reconcileComponents(vzctx vzcontext.VerrazzanoContext) (ctrl.Result, error) {
 	spiCtx, err := spi.NewContext(vzctx.Log, r.Client, vzctx.ActualCR, r.DryRun)
 	if err != nil {
@@ -33,127 +62,165 @@ func (r *Reconciler) reconcileComponents(vzctx vzcontext.VerrazzanoContext) (ctr
 
 	var requeue bool
 
-	// Loop through all of the Verrazzano components and upgrade each one sequentially for now; will parallelize later
-	for _, comp := range registry.GetComponents() {
-		compName := comp.Name()
-		compContext := spiCtx.Init(compName).Operation(vzconst.InstallOperation)
-		compLog := compContext.Log()
+	// Loop through the levels of the component dependency DAG, a component only starts installing once all of
+	// the components in the earlier levels that it depends on are ready
+	for _, level := range registry.GetComponentsByDependencyLevel() {
+		var installs []componentInstall
+		for _, comp := range level {
+			compName := comp.Name()
+			compContext := spiCtx.Init(compName).Operation(vzconst.InstallOperation)
+			compLog := compContext.Log()
 
-		compLog.Oncef("Component %s is being reconciled", compName)
+			compLog.Oncef("Component %s is being reconciled", compName)
 
-		if !comp.IsOperatorInstallSupported() {
-			compLog.Debugf("Component based install not supported for %s", compName)
-			continue
-		}
-		componentStatus, ok := cr.Status.Components[comp.Name()]
-		if !ok {
-			compLog.Debugf("Did not find status details in map for component %s", comp.Name())
-			continue
-		}
-		if checkConfigUpdated(spiCtx, componentStatus, compName) && comp.IsEnabled(compContext.EffectiveCR()) {
-			if !comp.MonitorOverrides(compContext) && comp.IsEnabled(spiCtx.EffectiveCR()) {
-				compLog.Oncef("Skipping update for component %s, monitorChanges set to false", comp.Name())
-			} else {
-				oldState := componentStatus.State
-				oldGen := componentStatus.ReconcilingGeneration
-				componentStatus.ReconcilingGeneration = 0
-				if err := r.updateComponentStatus(compContext, "PreInstall started", vzapi.CondPreInstall); err != nil {
-					return ctrl.Result{Requeue: true}, err
-				}
-				compLog.Oncef("CR.generation: %v reset component %s state: %v generation: %v to state: %v generation: %v ",
-					spiCtx.ActualCR().Generation, compName, oldState, oldGen, componentStatus.State, componentStatus.ReconcilingGeneration)
-				if spiCtx.ActualCR().Status.State == vzapi.VzStateReady {
-					err = r.setInstallingState(vzctx.Log, spiCtx.ActualCR())
-					compLog.Oncef("Reset Verrazzano state to %v for generation %v", spiCtx.ActualCR().Status.State, spiCtx.ActualCR().Generation)
-					if err != nil {
-						spiCtx.Log().Errorf("Failed to reset state: %v", err)
-						return newRequeueWithDelay(), err
-					}
-				}
-			}
-		}
-		switch componentStatus.State {
-		case vzapi.CompStateReady:
-			// Don't reconcile (updates) during install
-			if !isInstalled(cr.Status) {
+			if !comp.IsOperatorInstallSupported() {
+				compLog.Debugf("Component based install not supported for %s", compName)
 				continue
 			}
-			// If the component config is updated, or the component is watched, it should be reconciled
-			if !checkConfigUpdated(spiCtx, componentStatus, compName) && !r.IsWatchedComponent(comp.GetJSONName()) {
+			componentStatus, ok := cr.Status.Components[comp.Name()]
+			if !ok {
+				compLog.Debugf("Did not find status details in map for component %s", comp.Name())
 				continue
 			}
-
-			// For delete, we should look at the VZ resource delete timestamp and shift into Quiescing/Uninstalling state
-			compLog.Oncef("Component %s is ready", compName)
-			if err := comp.Reconcile(compContext); err != nil {
-				return newRequeueWithDelay(), err
-			}
-			// After restore '.status.instance' is empty and not updated. Below change will populate the correct values when comp state is Ready
-			if err := r.updateComponentStatus(compContext, "Component is Ready", vzapi.CondInstallComplete); err != nil {
-				return ctrl.Result{Requeue: true}, err
+			if checkConfigUpdated(spiCtx, componentStatus, compName) && comp.IsEnabled(compContext.EffectiveCR()) {
+				if !comp.MonitorOverrides(compContext) && comp.IsEnabled(spiCtx.EffectiveCR()) {
+					compLog.Oncef("Skipping update for component %s, monitorChanges set to false", comp.Name())
+				} else {
+					if result, err := r.restartComponentInstall(vzctx, compContext, componentStatus); err != nil {
+						return result, err
+					}
+				}
 			}
-			continue
-		case vzapi.CompStateDisabled:
-			if !comp.IsEnabled(compContext.EffectiveCR()) {
-				compLog.Oncef("Component %s is disabled, skipping install", compName)
-				// User has disabled component in Verrazzano CR, don't install
-				continue
+			if componentStatus.State != vzapi.CompStatePreInstalling && componentStatus.State != vzapi.CompStateInstalling {
+				if resetInstallTimer(componentStatus) {
+					if err := r.updateVerrazzanoStatus(compLog, cr); err != nil {
+						return newRequeueWithDelay(), err
+					}
+				}
 			}
-			if !isVersionOk(compLog, comp.GetMinVerrazzanoVersion(), cr.Status.Version) {
-				// User needs to do upgrade before this component can be installed
-				compLog.Progressf("Component %s cannot be installed until Verrazzano is upgraded to at least version %s",
-					comp.Name(), comp.GetMinVerrazzanoVersion())
+			// A component that was disabled after Verrazzano was installed is uninstalled, an uninstall that has
+			// started is always finished before the component can be installed again
+			if componentStatus.State == vzapi.CompStateUninstalling ||
+				((vzapi.IsComponentInstalledState(componentStatus.State) || componentStatus.State == vzapi.CompStateFailed) &&
+					isInstalled(cr.Status) && !comp.IsEnabled(compContext.EffectiveCR())) {
+				result, err := r.disableComponent(vzctx, spiCtx, comp)
+				if err != nil {
+					return result, err
+				}
+				if result.Requeue {
+					requeue = true
+				}
 				continue
 			}
-			if err := r.updateComponentStatus(compContext, "PreInstall started", vzapi.CondPreInstall); err != nil {
-				return ctrl.Result{Requeue: true}, err
-			}
-			requeue = true
+			switch componentStatus.State {
+			case vzapi.CompStateReady, vzapi.CompStateDegraded:
+				// Don't reconcile (updates) during install
+				if !isInstalled(cr.Status) {
+					continue
+				}
+				// If the component config is updated, or the component is watched, it should be reconciled
+				if !checkConfigUpdated(spiCtx, componentStatus, compName) && !r.IsWatchedComponent(comp.GetJSONName()) {
+					// Keep checking the health of the installed component
+					if err := r.checkComponentHealth(compContext, comp); err != nil {
+						return newRequeueWithDelay(), err
+					}
+					// Periodically reapply the configuration the component keeps outside of Kubernetes
+					r.resyncComponent(compContext, comp)
+					// Otherwise periodically check whether the deployed configuration drifted from the CR
+					remediate, err := r.checkComponentDrift(compContext, comp)
+					if err != nil {
+						return newRequeueWithDelay(), err
+					}
+					if remediate {
+						if result, err := r.restartComponentInstall(vzctx, compContext, componentStatus); err != nil {
+							return result, err
+						}
+						requeue = true
+					}
+					continue
+				}
 
-		case vzapi.CompStatePreInstalling:
-			if !registry.ComponentDependenciesMet(comp, compContext) {
-				compLog.Progressf("Component %s waiting for dependencies %v to be ready", comp.Name(), comp.GetDependencies())
-				requeue = true
+				// For delete, we should look at the VZ resource delete timestamp and shift into Quiescing/Uninstalling state
+				compLog.Oncef("Component %s is ready", compName)
+				if err := comp.Reconcile(compContext); err != nil {
+					r.recordComponentError(compContext, eventReasonReconcileFailed, err)
+					return newRequeueWithDelay(), err
+				}
+				// After restore '.status.instance' is empty and not updated. Below change will populate the correct values when comp state is Ready
+				if err := r.updateComponentStatus(compContext, "Component is Ready", vzapi.CondInstallComplete); err != nil {
+					return ctrl.Result{Requeue: true}, err
+				}
 				continue
-			}
-			compLog.Progressf("Component %s pre-install is running ", compName)
-			if err := comp.PreInstall(compContext); err != nil {
+			case vzapi.CompStateDisabled:
+				if !comp.IsEnabled(compContext.EffectiveCR()) {
+					compLog.Oncef("Component %s is disabled, skipping install", compName)
+					// User has disabled component in Verrazzano CR, don't install
+					continue
+				}
+				if !isVersionOk(compLog, comp.GetMinVerrazzanoVersion(), cr.Status.Version) {
+					// User needs to do upgrade before this component can be installed
+					compLog.Progressf("Component %s cannot be installed until Verrazzano is upgraded to at least version %s",
+						comp.Name(), comp.GetMinVerrazzanoVersion())
+					continue
+				}
+				if err := r.updateComponentStatus(compContext, "PreInstall started", vzapi.CondPreInstall); err != nil {
+					return ctrl.Result{Requeue: true}, err
+				}
 				requeue = true
+			case vzapi.CompStateFailed:
+				// A component whose uninstall failed after it was disabled is installed again once it is enabled
+				delete(componentDisableTrackers, getDisableTrackerKey(cr, compName))
+				compLog.Oncef("Component %s failed and is enabled, it will be installed again", compName)
+				if err := r.updateComponentStatus(compContext, "PreInstall started", vzapi.CondPreInstall); err != nil {
+					return ctrl.Result{Requeue: true}, err
+				}
+				requeue = true
+			case vzapi.CompStatePreInstalling, vzapi.CompStateInstalling:
+				// The install timer starts once the component is no longer waiting for its dependencies
+				if componentStatus.State == vzapi.CompStateInstalling || registry.ComponentDependenciesMet(comp, compContext) {
+					timedOut, timeout, err := r.isInstallTimedOut(compContext, comp)
+					if err != nil {
+						return newRequeueWithDelay(), err
+					}
+					if timedOut {
+						msg := buildComponentTimeoutMessage(compContext, comp, vzconst.InstallOperation, timeout)
+						compLog.Error(msg)
+						resetInstallTimer(componentStatus)
+						if err := r.updateComponentStatus(compContext, msg, vzapi.CondInstallFailed); err != nil {
+							return ctrl.Result{Requeue: true}, err
+						}
+						// A component that did not install within its timeout fails the Verrazzano install
+						if err := r.updateStatus(compLog, cr, msg, vzapi.CondInstallFailed); err != nil {
+							return ctrl.Result{Requeue: true}, err
+						}
+						continue
+					}
+				}
+				// The install work is done concurrently with the other components at this level
+				installs = append(installs, componentInstall{comp: comp, compContext: compContext, state: componentStatus.State})
 				continue
 			}
-			// If component is not installed,install it
-			compLog.Oncef("Component %s install started ", compName)
-			if err := comp.Install(compContext); err != nil {
+			r.ClearWatch(comp.GetJSONName())
+		}
+
+		// Update the component status from the install results one at a time
+		for i, result := range r.installComponents(installs) {
+			install := installs[i]
+			if result.requeue {
 				requeue = true
-				continue
 			}
-			if err := r.updateComponentStatus(compContext, "Install started", vzapi.CondInstallStarted); err != nil {
-				return ctrl.Result{Requeue: true}, err
+			if result.err != nil {
+				r.recordComponentError(install.compContext, result.errReason, result.err)
 			}
-			// Install started requeue to check status
-			requeue = true
-		case vzapi.CompStateInstalling:
-			// For delete, we should look at the VZ resource delete timestamp and shift into Quiescing/Uninstalling state
-			// If component is enabled -- need to replicate scripts' config merging logic here
-			// If component is in deployed state, continue
-			if comp.IsReady(compContext) {
-				compLog.Progressf("Component %s post-install is running ", compName)
-				if err := comp.PostInstall(compContext); err != nil {
-					requeue = true
-					continue
-				}
-				compLog.Oncef("Component %s successfully installed", comp.Name())
-				if err := r.updateComponentStatus(compContext, "Install complete", vzapi.CondInstallComplete); err != nil {
+			if len(result.condition) > 0 {
+				if err := r.updateComponentStatus(install.compContext, result.message, result.condition); err != nil {
 					return ctrl.Result{Requeue: true}, err
 				}
-				// Don't requeue because of this component, it is done install
-				continue
 			}
-			// Install of this component is not done, requeue to check status
-			compLog.Progressf("Component %s waiting to finish installing", compName)
-			requeue = true
+			if result.clearWatch {
+				r.ClearWatch(install.comp.GetJSONName())
+			}
 		}
-		r.ClearWatch(comp.GetJSONName())
 	}
 	if requeue {
 		return newRequeueWithDelay(), nil
@@ -161,6 +228,79 @@ func (r *Reconciler) reconcileComponents(vzctx vzcontext.VerrazzanoContext) (ctr
 	return ctrl.Result{}, nil
 }
 
+// installComponents does the install work for the components concurrently, limited to the configured maximum number
+// of concurrent component installs.  The results are returned in the same order as the components.
+func (r *Reconciler) installComponents(installs []componentInstall) []componentInstallResult {
+	results := make([]componentInstallResult, len(installs))
+	maxConcurrent := config.Get().MaxConcurrentComponentInstalls
+	if maxConcurrent < 1 {
+		maxConcurrent = 1
+	}
+	semaphore := make(chan struct{}, maxConcurrent)
+	var wg sync.WaitGroup
+	for i := range installs {
+		wg.Add(1)
+		semaphore <- struct{}{}
+		go func(i int) {
+			defer func() {
+				<-semaphore
+				wg.Done()
+			}()
+			results[i] = installSingleComponent(installs[i])
+		}(i)
+	}
+	wg.Wait()
+	return results
+}
+
+// installSingleComponent does the install work for a component in the PreInstalling or Installing state.  The
+// component status is not updated here, the condition to set is returned in the result instead.
+func installSingleComponent(install componentInstall) componentInstallResult {
+	comp := install.comp
+	compName := comp.Name()
+	compContext := install.compContext
+	compLog := compContext.Log()
+
+	switch install.state {
+	case vzapi.CompStatePreInstalling:
+		if !registry.ComponentDependenciesMet(comp, compContext) {
+			compLog.Progressf("Component %s waiting for dependencies %v to be ready", comp.Name(), comp.GetDependencies())
+			return componentInstallResult{requeue: true}
+		}
+		compLog.Progressf("Component %s pre-install is running ", compName)
+		if err := comp.PreInstall(compContext); err != nil {
+			compLog.ErrorfThrottled("Failed pre-install of component %s: %v", compName, err)
+			return componentInstallResult{requeue: true, err: err, errReason: eventReasonPreInstallFailed}
+		}
+		// If component is not installed,install it
+		compLog.Oncef("Component %s install started ", compName)
+		if err := comp.Install(compContext); err != nil {
+			compLog.ErrorfThrottled("Failed install of component %s: %v", compName, err)
+			return componentInstallResult{requeue: true, err: err, errReason: eventReasonInstallFailed}
+		}
+		// Install started requeue to check status
+		return componentInstallResult{condition: vzapi.CondInstallStarted, message: "Install started", requeue: true, clearWatch: true}
+	case vzapi.CompStateInstalling:
+		// For delete, we should look at the VZ resource delete timestamp and shift into Quiescing/Uninstalling state
+		// If component is enabled -- need to replicate scripts' config merging logic here
+		// If component is in deployed state, continue
+		if comp.IsReady(compContext) {
+			compLog.Progressf("Component %s post-install is running ", compName)
+			if err := comp.PostInstall(compContext); err != nil {
+				compLog.ErrorfThrottled("Failed post-install of component %s: %v", compName, err)
+				return componentInstallResult{requeue: true, err: err, errReason: eventReasonPostInstallFailed}
+			}
+			compLog.Oncef("Component %s successfully installed", comp.Name())
+			// Don't requeue because of this component, it is done install
+			return componentInstallResult{condition: vzapi.CondInstallComplete, message: "Install complete"}
+		}
+		// Install of this component is not done, requeue to check status
+		compLog.Progressf("Component %s waiting to finish installing", compName)
+		return componentInstallResult{requeue: true, clearWatch: true}
+	}
+	return componentInstallResult{}
+}
+
 // checkConfigUpdated checks if the component config in the VZ CR has been updated and the component needs to
 // reset the state back to pre-install to re-enter install flow
 func checkConfigUpdated(ctx spi.ComponentContext, componentStatus *vzapi.ComponentStatusDetails, name string) bool {
@@ -177,7 +317,7 @@ func checkConfigUpdated(ctx spi.ComponentContext, componentStatus *vzapi.Compone
 	}
 	// The component has been reconciled/installed with LastReconciledGeneration of the CR
 	// if CR.Generation > LastReconciledGeneration then re-enter install flow
-	return (componentStatus.State == vzapi.CompStateReady) &&
+	return vzapi.IsComponentInstalledState(componentStatus.State) &&
 		(ctx.ActualCR().Generation > componentStatus.LastReconciledGeneration)
 }
 
@@ -201,3 +341,30 @@ func isVersionOk(log vzlog.VerrazzanoLogger, compVersion string, vzVersion strin
 	// return false if VZ version is too low to install component, else true
 	return !vzSemver.IsLessThan(compSemver)
 }
+
+// restartComponentInstall resets the state of the component to PreInstalling so that it is installed again with the
+// configuration of the CR, and sets the Verrazzano resource back to the Reconciling state
+func (r *Reconciler) restartComponentInstall(vzctx vzcontext.VerrazzanoContext, compContext spi.ComponentContext, componentStatus *vzapi.ComponentStatusDetails) (ctrl.Result, error) {
+	cr := compContext.ActualCR()
+	compName := compContext.GetComponent()
+	compLog := compContext.Log()
+
+	oldState := componentStatus.State
+	oldGen := componentStatus.ReconcilingGeneration
+	componentStatus.ReconcilingGeneration = 0
+	resetInstallTimer(componentStatus)
+	if err := r.updateComponentStatus(compContext, "PreInstall started", vzapi.CondPreInstall); err != nil {
+		return ctrl.Result{Requeue: true}, err
+	}
+	compLog.Oncef("CR.generation: %v reset component %s state: %v generation: %v to state: %v generation: %v ",
+		cr.Generation, compName, oldState, oldGen, componentStatus.State, componentStatus.ReconcilingGeneration)
+	if vzapi.IsVzInstalledState(cr.Status.State) {
+		err := r.setInstallingState(vzctx.Log, cr)
+		compLog.Oncef("Reset Verrazzano state to %v for generation %v", cr.Status.State, cr.Generation)
+		if err != nil {
+			compLog.Errorf("Failed to reset state: %v", err)
+			return newRequeueWithDelay(), err
+		}
+	}
+	return ctrl.Result{}, nil
+}
@@ -5,21 +5,27 @@ package verrazzano
 
 import (
 	"context"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
 	"github.com/verrazzano/verrazzano/pkg/helm"
+	"github.com/verrazzano/verrazzano/pkg/log/vzlog"
 	vzapi "github.com/verrazzano/verrazzano/platform-operator/apis/verrazzano/v1alpha1"
 	"github.com/verrazzano/verrazzano/platform-operator/controllers/verrazzano/component/registry"
 	"github.com/verrazzano/verrazzano/platform-operator/controllers/verrazzano/component/spi"
+	vzcontext "github.com/verrazzano/verrazzano/platform-operator/controllers/verrazzano/context"
 	"github.com/verrazzano/verrazzano/platform-operator/internal/config"
 	"github.com/verrazzano/verrazzano/platform-operator/mocks"
 	networkingv1 "k8s.io/api/networking/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
+	k8scheme "k8s.io/client-go/kubernetes/scheme"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 )
 
 const testBomFile = "../../verrazzano-bom.json"
@@ -122,6 +128,127 @@ func TestUpdateFalseMonitorChanges(t *testing.T) {
 	asserts.False(result.Requeue)
 }
 
+// TestCheckConfigUpdatedDegraded tests the checkConfigUpdated func
+// GIVEN a degraded component and a Verrazzano resource with a newer generation than the component reconciled
+// WHEN checkConfigUpdated is called
+// THEN the component is reconciled again, the same as a ready component
+func TestCheckConfigUpdatedDegraded(t *testing.T) {
+	vz := newInstalledVerrazzano(vzapi.CompStateDegraded, "fake")
+	vz.Generation = 3
+	componentStatus := vz.Status.Components["fake"]
+	componentStatus.LastReconciledGeneration = 2
+	ctx := spi.NewFakeContext(fake.NewClientBuilder().Build(), vz, false)
+	assert.True(t, checkConfigUpdated(ctx, componentStatus, "fake"))
+
+	componentStatus.LastReconciledGeneration = 3
+	assert.False(t, checkConfigUpdated(ctx, componentStatus, "fake"))
+}
+
+// TestInstallComponentsConcurrently tests the installComponents func
+// GIVEN independent components in the PreInstalling state
+// WHEN the components are installed with a concurrency limit lower than the number of components
+// THEN the components are installed concurrently without exceeding the limit and all the install results are returned
+func TestInstallComponentsConcurrently(t *testing.T) {
+	initUnitTesing()
+	asserts := assert.New(t)
+
+	oldConfig := config.Get()
+	defer config.Set(oldConfig)
+	newConfig := oldConfig
+	newConfig.MaxConcurrentComponentInstalls = 2
+	config.Set(newConfig)
+
+	var running, maxRunning int32
+	var installs []componentInstall
+	for _, name := range []string{"fake1", "fake2", "fake3"} {
+		fakeComp := fakeComponent{}
+		fakeComp.ReleaseName = name
+		fakeComp.SupportsOperatorInstall = true
+		fakeComp.installFunc = func(ctx spi.ComponentContext) error {
+			current := atomic.AddInt32(&running, 1)
+			for {
+				max := atomic.LoadInt32(&maxRunning)
+				if current <= max || atomic.CompareAndSwapInt32(&maxRunning, max, current) {
+					break
+				}
+			}
+			time.Sleep(100 * time.Millisecond)
+			atomic.AddInt32(&running, -1)
+			return nil
+		}
+		installs = append(installs, componentInstall{
+			comp:        fakeComp,
+			compContext: spi.NewFakeContext(nil, &vzapi.Verrazzano{}, false).Init(name),
+			state:       vzapi.CompStatePreInstalling,
+		})
+	}
+	registry.OverrideGetComponentsFn(func() []spi.Component {
+		return []spi.Component{installs[0].comp, installs[1].comp, installs[2].comp}
+	})
+	defer registry.ResetGetComponentsFn()
+
+	reconciler := newVerrazzanoReconciler(nil)
+	results := reconciler.installComponents(installs)
+
+	asserts.Equal(int32(2), maxRunning)
+	asserts.Len(results, 3)
+	for _, result := range results {
+		asserts.Equal(vzapi.CondInstallStarted, result.condition)
+		asserts.True(result.requeue)
+	}
+}
+
+// TestReconcileComponentsByDependencyLevel tests the reconcileComponents func
+// GIVEN a component that is installing and a component in the pre-install state that depends on it
+// WHEN the components are reconciled and the first component is ready
+// THEN the first component install is completed before the dependent component install is started in the same pass
+func TestReconcileComponentsByDependencyLevel(t *testing.T) {
+	initUnitTesing()
+	namespace := "verrazzano"
+	name := "test"
+	asserts := assert.New(t)
+
+	fakeComp1 := fakeComponent{}
+	fakeComp1.ReleaseName = "fake1"
+	fakeComp1.SupportsOperatorInstall = true
+	fakeComp2 := fakeComponent{}
+	fakeComp2.ReleaseName = "fake2"
+	fakeComp2.SupportsOperatorInstall = true
+	fakeComp2.Dependencies = []string{"fake1"}
+	// fake2 is listed first so that the registry order is not the dependency order
+	registry.OverrideGetComponentsFn(func() []spi.Component {
+		return []spi.Component{fakeComp2, fakeComp1}
+	})
+	defer registry.ResetGetComponentsFn()
+	config.TestProfilesDir = "../../manifests/profiles"
+	defer func() { config.TestProfilesDir = "" }()
+
+	_ = vzapi.AddToScheme(k8scheme.Scheme)
+	vz := &vzapi.Verrazzano{
+		ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name},
+		Status: vzapi.VerrazzanoStatus{
+			State: vzapi.VzStateReconciling,
+			Components: map[string]*vzapi.ComponentStatusDetails{
+				"fake1": {Name: "fake1", State: vzapi.CompStateInstalling},
+				"fake2": {Name: "fake2", State: vzapi.CompStatePreInstalling},
+			},
+		},
+	}
+	c := fake.NewClientBuilder().WithScheme(k8scheme.Scheme).WithObjects(vz).Build()
+	vzctx, err := vzcontext.NewVerrazzanoContext(vzlog.DefaultLogger(), c, vz, false)
+	asserts.NoError(err)
+
+	reconciler := newVerrazzanoReconciler(c)
+	result, err := reconciler.reconcileComponents(vzctx)
+	asserts.NoError(err)
+	asserts.True(result.Requeue)
+
+	actual := vzapi.Verrazzano{}
+	asserts.NoError(c.Get(context.TODO(), types.NamespacedName{Namespace: namespace, Name: name}, &actual))
+	asserts.Equal(vzapi.CompStateReady, actual.Status.Components["fake1"].State)
+	asserts.Equal(vzapi.CompStateInstalling, actual.Status.Components["fake2"].State)
+}
+
 func reset() {
 	registry.ResetGetComponentsFn()
 	config.SetDefaultBomFilePath("")
@@ -213,12 +340,6 @@ func testUpdate(t *testing.T,
 	helm.SetChartStatusFunction(func(releaseName string, namespace string) (string, error) {
 		return helm.ChartStatusDeployed, nil
 	})
-	var verrazzanoToUse vzapi.Verrazzano
-	labels := map[string]string{}
-	// Expect a call to get the service account
-	expectGetServiceAccountExists(mock, name, labels)
-	// Expect a call to get the ClusterRoleBinding
-	expectClusterRoleBindingExists(mock, verrazzanoToUse, namespace, name)
 	// Sample bom file for version validation functions
 	config.SetDefaultBomFilePath(testBomFilePath)
 	// Stubout the call to check the chart status
@@ -0,0 +1,276 @@
+// Copyright (c) 2022, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package verrazzano
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/verrazzano/verrazzano/pkg/log/vzlog"
+	installv1alpha1 "github.com/verrazzano/verrazzano/platform-operator/apis/verrazzano/v1alpha1"
+	vzconst "github.com/verrazzano/verrazzano/platform-operator/constants"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// maxOperationHistory is the number of finished operations that are kept for a Verrazzano resource, the oldest
+// finished operations are deleted when a new operation starts
+const maxOperationHistory = 20
+
+// operationEndConditions are the Verrazzano conditions that finish the current operation, with the result of the
+// operation
+var operationEndConditions = map[installv1alpha1.ConditionType]installv1alpha1.OperationResult{
+	installv1alpha1.CondInstallComplete:   installv1alpha1.OperationSucceeded,
+	installv1alpha1.CondUpgradeComplete:   installv1alpha1.OperationSucceeded,
+	installv1alpha1.CondUninstallComplete: installv1alpha1.OperationSucceeded,
+	installv1alpha1.CondInstallFailed:     installv1alpha1.OperationFailed,
+	installv1alpha1.CondUpgradeFailed:     installv1alpha1.OperationFailed,
+	installv1alpha1.CondUninstallFailed:   installv1alpha1.OperationFailed,
+	installv1alpha1.CondPreflightFailed:   installv1alpha1.OperationFailed,
+}
+
+// componentStartConditions are the component conditions that start the work of the current operation for a component
+var componentStartConditions = map[installv1alpha1.ConditionType]bool{
+	installv1alpha1.CondPreInstall:       true,
+	installv1alpha1.CondInstallStarted:   true,
+	installv1alpha1.CondUpgradeStarted:   true,
+	installv1alpha1.CondUninstallStarted: true,
+}
+
+// componentEndConditions are the component conditions that finish the work of the current operation for a
+// component, with the result for the component
+var componentEndConditions = map[installv1alpha1.ConditionType]installv1alpha1.OperationResult{
+	installv1alpha1.CondInstallComplete:   installv1alpha1.OperationSucceeded,
+	installv1alpha1.CondUpgradeComplete:   installv1alpha1.OperationSucceeded,
+	installv1alpha1.CondUninstallComplete: installv1alpha1.OperationSucceeded,
+	installv1alpha1.CondInstallFailed:     installv1alpha1.OperationFailed,
+	installv1alpha1.CondUpgradeFailed:     installv1alpha1.OperationFailed,
+	installv1alpha1.CondUninstallFailed:   installv1alpha1.OperationFailed,
+	installv1alpha1.CondUpgradeRolledBack: installv1alpha1.OperationFailed,
+}
+
+// recordOperation records the start or the end of an operation in the VerrazzanoOperation history of the Verrazzano
+// resource, for a condition that is about to be added to the resource.  Failures are only logged, the history never
+// blocks the reconcile of the Verrazzano resource.
+func (r *Reconciler) recordOperation(log vzlog.VerrazzanoLogger, cr *installv1alpha1.Verrazzano, conditionType installv1alpha1.ConditionType, message string) {
+	if !r.OperationHistory {
+		return
+	}
+	current, err := r.getCurrentOperation(cr)
+	if err != nil {
+		log.Errorf("Failed getting the current operation of Verrazzano %s/%s: %v", cr.Namespace, cr.Name, err)
+		return
+	}
+
+	if opType, ok := getStartedOperationType(cr, conditionType); ok {
+		if current != nil {
+			// An upgrade that was paused, or an install that is retried, continues the same operation
+			if current.Spec.Operation == opType {
+				return
+			}
+			r.finishOperation(log, current, installv1alpha1.OperationInterrupted,
+				fmt.Sprintf("Verrazzano %s started before the %s finished", strings.ToLower(string(opType)), strings.ToLower(string(current.Spec.Operation))))
+		}
+		r.startOperation(log, cr, opType)
+		return
+	}
+
+	if result, ok := operationEndConditions[conditionType]; ok && current != nil {
+		r.finishOperation(log, current, result, message)
+	}
+}
+
+// recordComponentOperation records the work of the current operation for a component, for a condition that was
+// added to the component status
+func (r *Reconciler) recordComponentOperation(log vzlog.VerrazzanoLogger, cr *installv1alpha1.Verrazzano, compName string, conditionType installv1alpha1.ConditionType, message string) {
+	if !r.OperationHistory {
+		return
+	}
+	result, ended := componentEndConditions[conditionType]
+	if !ended && !componentStartConditions[conditionType] {
+		return
+	}
+	current, err := r.getCurrentOperation(cr)
+	if err != nil {
+		log.Errorf("Failed getting the current operation of Verrazzano %s/%s: %v", cr.Namespace, cr.Name, err)
+		return
+	}
+	if current == nil {
+		return
+	}
+
+	var compStatus *installv1alpha1.OperationComponentStatus
+	for i := range current.Status.Components {
+		if current.Status.Components[i].Name == compName {
+			compStatus = &current.Status.Components[i]
+			break
+		}
+	}
+	if compStatus == nil {
+		current.Status.Components = append(current.Status.Components, installv1alpha1.OperationComponentStatus{Name: compName})
+		compStatus = &current.Status.Components[len(current.Status.Components)-1]
+	}
+
+	now := metav1.Now()
+	if ended {
+		compStatus.Result = result
+		compStatus.EndTime = &now
+	} else if compStatus.Result != installv1alpha1.OperationInProgress {
+		// The component started its work, or started it again after a failure
+		compStatus.Result = installv1alpha1.OperationInProgress
+		compStatus.StartTime = &now
+		compStatus.EndTime = nil
+	}
+	compStatus.Message = message
+	if err := r.Status().Update(context.TODO(), current); err != nil {
+		log.Errorf("Failed updating the component %s of operation %s/%s: %v", compName, current.Namespace, current.Name, err)
+	}
+}
+
+// getStartedOperationType returns the operation type that is started by a Verrazzano condition, an install started
+// condition of a Verrazzano that is already installed starts an update
+func getStartedOperationType(cr *installv1alpha1.Verrazzano, conditionType installv1alpha1.ConditionType) (installv1alpha1.OperationType, bool) {
+	switch conditionType {
+	case installv1alpha1.CondInstallStarted:
+		if isInstalled(cr.Status) {
+			return installv1alpha1.OperationUpdate, true
+		}
+		return installv1alpha1.OperationInstall, true
+	case installv1alpha1.CondUpgradeStarted:
+		return installv1alpha1.OperationUpgrade, true
+	case installv1alpha1.CondUninstallStarted:
+		return installv1alpha1.OperationUninstall, true
+	}
+	return "", false
+}
+
+// startOperation creates the VerrazzanoOperation of a new operation, and deletes the oldest finished operations
+func (r *Reconciler) startOperation(log vzlog.VerrazzanoLogger, cr *installv1alpha1.Verrazzano, opType installv1alpha1.OperationType) {
+	now := metav1.Now()
+	op := &installv1alpha1.VerrazzanoOperation{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:    cr.Namespace,
+			GenerateName: fmt.Sprintf("%s-%s-", cr.Name, strings.ToLower(string(opType))),
+			Labels: map[string]string{
+				vzconst.VerrazzanoOperationLabel:      cr.Name,
+				vzconst.VerrazzanoOperationStartLabel: strconv.FormatInt(now.UnixNano(), 10),
+			},
+		},
+		Spec: installv1alpha1.VerrazzanoOperationSpec{
+			Verrazzano: cr.Name,
+			Operation:  opType,
+			Generation: cr.Generation,
+		},
+	}
+	switch opType {
+	case installv1alpha1.OperationInstall:
+		op.Spec.TargetVersion = cr.Status.Version
+	case installv1alpha1.OperationUpgrade:
+		op.Spec.SourceVersion = cr.Status.Version
+		op.Spec.TargetVersion = cr.Spec.Version
+	case installv1alpha1.OperationUpdate:
+		op.Spec.SourceVersion = cr.Status.Version
+		op.Spec.TargetVersion = cr.Status.Version
+	case installv1alpha1.OperationUninstall:
+		op.Spec.SourceVersion = cr.Status.Version
+	}
+	if err := r.Create(context.TODO(), op); err != nil {
+		log.Errorf("Failed creating operation %s/%s: %v", op.Namespace, op.GenerateName, err)
+		return
+	}
+	op.Status = installv1alpha1.VerrazzanoOperationStatus{
+		Result:    installv1alpha1.OperationInProgress,
+		StartTime: &now,
+	}
+	if err := r.Status().Update(context.TODO(), op); err != nil {
+		log.Errorf("Failed updating the status of operation %s/%s: %v", op.Namespace, op.Name, err)
+		return
+	}
+	log.Debugf("Started Verrazzano operation %s/%s", op.Namespace, op.Name)
+	r.pruneOperations(log, cr)
+}
+
+// finishOperation sets the result of an operation
+func (r *Reconciler) finishOperation(log vzlog.VerrazzanoLogger, op *installv1alpha1.VerrazzanoOperation, result installv1alpha1.OperationResult, message string) {
+	now := metav1.Now()
+	op.Status.Result = result
+	op.Status.Message = message
+	op.Status.EndTime = &now
+	if err := r.Status().Update(context.TODO(), op); err != nil {
+		log.Errorf("Failed updating the status of operation %s/%s: %v", op.Namespace, op.Name, err)
+		return
+	}
+	log.Debugf("Verrazzano operation %s/%s finished with result %s", op.Namespace, op.Name, result)
+}
+
+// getCurrentOperation returns the operation of the Verrazzano resource that has not finished, or nil if there is none
+func (r *Reconciler) getCurrentOperation(cr *installv1alpha1.Verrazzano) (*installv1alpha1.VerrazzanoOperation, error) {
+	ops, err := r.listOperations(cr)
+	if err != nil {
+		return nil, err
+	}
+	for i := len(ops) - 1; i >= 0; i-- {
+		if isOperationInProgress(&ops[i]) {
+			return &ops[i], nil
+		}
+	}
+	return nil, nil
+}
+
+// pruneOperations deletes the oldest finished operations of the Verrazzano resource, so that at most
+// maxOperationHistory finished operations are kept
+func (r *Reconciler) pruneOperations(log vzlog.VerrazzanoLogger, cr *installv1alpha1.Verrazzano) {
+	ops, err := r.listOperations(cr)
+	if err != nil {
+		log.Errorf("Failed listing the operations of Verrazzano %s/%s: %v", cr.Namespace, cr.Name, err)
+		return
+	}
+	var finished []installv1alpha1.VerrazzanoOperation
+	for _, op := range ops {
+		if !isOperationInProgress(&op) {
+			finished = append(finished, op)
+		}
+	}
+	for i := 0; i < len(finished)-maxOperationHistory; i++ {
+		if err := r.Delete(context.TODO(), &finished[i]); err != nil && !errors.IsNotFound(err) {
+			log.Errorf("Failed deleting operation %s/%s: %v", finished[i].Namespace, finished[i].Name, err)
+		}
+	}
+}
+
+// listOperations returns the operations of the Verrazzano resource, oldest first
+func (r *Reconciler) listOperations(cr *installv1alpha1.Verrazzano) ([]installv1alpha1.VerrazzanoOperation, error) {
+	opList := installv1alpha1.VerrazzanoOperationList{}
+	if err := r.List(context.TODO(), &opList, client.InNamespace(cr.Namespace), client.MatchingLabels{vzconst.VerrazzanoOperationLabel: cr.Name}); err != nil {
+		return nil, err
+	}
+	ops := opList.Items
+	sort.SliceStable(ops, func(i, j int) bool {
+		return getOperationStartTime(&ops[i]).Before(getOperationStartTime(&ops[j]))
+	})
+	return ops, nil
+}
+
+// getOperationStartTime returns the start time of an operation from its start label, which is precise to the
+// nanosecond.  Without the label it returns the start time in the status, or the creation time if the start was not
+// recorded.
+func getOperationStartTime(op *installv1alpha1.VerrazzanoOperation) time.Time {
+	if nanos, err := strconv.ParseInt(op.Labels[vzconst.VerrazzanoOperationStartLabel], 10, 64); err == nil {
+		return time.Unix(0, nanos)
+	}
+	if op.Status.StartTime != nil {
+		return op.Status.StartTime.Time
+	}
+	return op.CreationTimestamp.Time
+}
+
+// isOperationInProgress returns true if the operation has not finished
+func isOperationInProgress(op *installv1alpha1.VerrazzanoOperation) bool {
+	return op.Status.Result == "" || op.Status.Result == installv1alpha1.OperationInProgress
+}
@@ -0,0 +1,199 @@
+// Copyright (c) 2022, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package verrazzano
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/verrazzano/verrazzano/pkg/log/vzlog"
+	vzapi "github.com/verrazzano/verrazzano/platform-operator/apis/verrazzano/v1alpha1"
+	vzconst "github.com/verrazzano/verrazzano/platform-operator/constants"
+	"github.com/verrazzano/verrazzano/platform-operator/controllers/verrazzano/component/spi"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8scheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+// TestRecordInstallOperation tests the recording of the operation history for the following use case
+// GIVEN a Verrazzano resource that is not installed
+// WHEN the install starts, a component is installed and the install completes
+// THEN an Install operation is recorded with the result and timing of the install and of the component
+func TestRecordInstallOperation(t *testing.T) {
+	asserts := assert.New(t)
+	vz := newOperationTestVerrazzano()
+	vz.Status.Version = "1.4.0"
+	c := fake.NewClientBuilder().WithScheme(k8scheme.Scheme).WithObjects(vz).Build()
+	reconciler := newVerrazzanoReconciler(c)
+	reconciler.OperationHistory = true
+
+	asserts.NoError(reconciler.updateStatus(vzlog.DefaultLogger(), vz, "Verrazzano install in progress", vzapi.CondInstallStarted))
+	compContext := spi.NewFakeContext(c, vz, false).Init("fake")
+	asserts.NoError(reconciler.updateComponentStatus(compContext, "PreInstall started", vzapi.CondPreInstall))
+	asserts.NoError(reconciler.updateComponentStatus(compContext, "Install complete", vzapi.CondInstallComplete))
+
+	ops := getTestOperations(t, c, vz)
+	asserts.Len(ops, 1)
+	asserts.Equal(vzapi.OperationInstall, ops[0].Spec.Operation)
+	asserts.Equal("verrazzano", ops[0].Spec.Verrazzano)
+	asserts.Empty(ops[0].Spec.SourceVersion)
+	asserts.Equal("1.4.0", ops[0].Spec.TargetVersion)
+	asserts.Equal(vzapi.OperationInProgress, ops[0].Status.Result)
+	asserts.NotNil(ops[0].Status.StartTime)
+	asserts.Len(ops[0].Status.Components, 1)
+	asserts.Equal("fake", ops[0].Status.Components[0].Name)
+	asserts.Equal(vzapi.OperationSucceeded, ops[0].Status.Components[0].Result)
+	asserts.Equal("Install complete", ops[0].Status.Components[0].Message)
+	asserts.NotNil(ops[0].Status.Components[0].StartTime)
+	asserts.NotNil(ops[0].Status.Components[0].EndTime)
+
+	asserts.NoError(reconciler.updateStatus(vzlog.DefaultLogger(), vz, "Verrazzano install completed successfully", vzapi.CondInstallComplete))
+	ops = getTestOperations(t, c, vz)
+	asserts.Len(ops, 1)
+	asserts.Equal(vzapi.OperationSucceeded, ops[0].Status.Result)
+	asserts.Equal("Verrazzano install completed successfully", ops[0].Status.Message)
+	asserts.NotNil(ops[0].Status.EndTime)
+}
+
+// TestRecordInterruptedOperation tests the recording of the operation history for the following use case
+// GIVEN an installed Verrazzano resource
+// WHEN an update starts, and an uninstall starts before the update finished
+// THEN the Update operation is recorded as interrupted and an Uninstall operation is in progress
+func TestRecordInterruptedOperation(t *testing.T) {
+	asserts := assert.New(t)
+	vz := newOperationTestVerrazzano()
+	vz.Status.Version = "1.4.0"
+	vz.Status.Conditions = []vzapi.Condition{{Type: vzapi.CondInstallComplete}}
+	c := fake.NewClientBuilder().WithScheme(k8scheme.Scheme).WithObjects(vz).Build()
+	reconciler := newVerrazzanoReconciler(c)
+	reconciler.OperationHistory = true
+
+	asserts.NoError(reconciler.updateStatus(vzlog.DefaultLogger(), vz, "Verrazzano install in progress", vzapi.CondInstallStarted))
+	asserts.NoError(reconciler.updateStatus(vzlog.DefaultLogger(), vz, "Verrazzano uninstall in progress", vzapi.CondUninstallStarted))
+
+	ops := getTestOperations(t, c, vz)
+	asserts.Len(ops, 2)
+	results := map[vzapi.OperationType]vzapi.VerrazzanoOperation{}
+	for _, op := range ops {
+		results[op.Spec.Operation] = op
+	}
+	asserts.Equal(vzapi.OperationInterrupted, results[vzapi.OperationUpdate].Status.Result)
+	asserts.Equal("1.4.0", results[vzapi.OperationUpdate].Spec.SourceVersion)
+	asserts.Equal("1.4.0", results[vzapi.OperationUpdate].Spec.TargetVersion)
+	asserts.Equal(vzapi.OperationInProgress, results[vzapi.OperationUninstall].Status.Result)
+	asserts.Equal("1.4.0", results[vzapi.OperationUninstall].Spec.SourceVersion)
+	asserts.Empty(results[vzapi.OperationUninstall].Spec.TargetVersion)
+}
+
+// TestPruneOperations tests the pruneOperations method for the following use case
+// GIVEN a Verrazzano resource with more finished operations than are kept, and an operation in progress
+// WHEN the operations are pruned
+// THEN the oldest finished operations are deleted and the operation in progress is kept, operations that started
+// within the same second are ordered by their start label
+func TestPruneOperations(t *testing.T) {
+	asserts := assert.New(t)
+	vz := newOperationTestVerrazzano()
+	var objects []client.Object
+	start := time.Now().Add(-time.Hour).Truncate(time.Second)
+	for i := maxOperationHistory + 1; i >= 0; i-- {
+		op := newTestOperation(vz, fmt.Sprintf("op-%d", i), start, vzapi.OperationSucceeded)
+		op.Labels[vzconst.VerrazzanoOperationStartLabel] = strconv.FormatInt(start.Add(time.Duration(i)*time.Millisecond).UnixNano(), 10)
+		objects = append(objects, op)
+	}
+	objects = append(objects, newTestOperation(vz, "op-current", start, vzapi.OperationInProgress))
+	c := fake.NewClientBuilder().WithScheme(k8scheme.Scheme).WithObjects(objects...).Build()
+	reconciler := newVerrazzanoReconciler(c)
+
+	reconciler.pruneOperations(vzlog.DefaultLogger(), vz)
+	ops := getTestOperations(t, c, vz)
+	asserts.Len(ops, maxOperationHistory+1)
+	names := map[string]bool{}
+	for _, op := range ops {
+		names[op.Name] = true
+	}
+	asserts.False(names["op-0"])
+	asserts.False(names["op-1"])
+	asserts.True(names["op-2"])
+	asserts.True(names["op-current"])
+}
+
+// TestStartOperationsSameSecond tests the startOperation method for the following use case
+// GIVEN a Verrazzano resource
+// WHEN two operations of the same type start within the same second
+// THEN both operations are created with distinct names and are listed in the order they started
+func TestStartOperationsSameSecond(t *testing.T) {
+	asserts := assert.New(t)
+	vz := newOperationTestVerrazzano()
+	c := fake.NewClientBuilder().WithScheme(k8scheme.Scheme).WithObjects(vz).Build()
+	reconciler := newVerrazzanoReconciler(c)
+
+	reconciler.startOperation(vzlog.DefaultLogger(), vz, vzapi.OperationUpdate)
+	reconciler.finishOperation(vzlog.DefaultLogger(), &getTestOperations(t, c, vz)[0], vzapi.OperationSucceeded, "")
+	reconciler.startOperation(vzlog.DefaultLogger(), vz, vzapi.OperationUpdate)
+
+	ops, err := reconciler.listOperations(vz)
+	asserts.NoError(err)
+	asserts.Len(ops, 2)
+	asserts.NotEqual(ops[0].Name, ops[1].Name)
+	for _, op := range ops {
+		asserts.True(strings.HasPrefix(op.Name, "verrazzano-update-"))
+		asserts.NotEmpty(op.Labels[vzconst.VerrazzanoOperationStartLabel])
+	}
+	asserts.Equal(vzapi.OperationSucceeded, ops[0].Status.Result)
+	asserts.Equal(vzapi.OperationInProgress, ops[1].Status.Result)
+}
+
+// TestOperationHistoryDisabled tests the recording of the operation history for the following use case
+// GIVEN a reconciler with the operation history disabled
+// WHEN the install of a Verrazzano resource starts
+// THEN no operation is recorded
+func TestOperationHistoryDisabled(t *testing.T) {
+	vz := newOperationTestVerrazzano()
+	c := fake.NewClientBuilder().WithScheme(k8scheme.Scheme).WithObjects(vz).Build()
+	reconciler := newVerrazzanoReconciler(c)
+
+	assert.NoError(t, reconciler.updateStatus(vzlog.DefaultLogger(), vz, "Verrazzano install in progress", vzapi.CondInstallStarted))
+	assert.Empty(t, getTestOperations(t, c, vz))
+}
+
+// newOperationTestVerrazzano returns a Verrazzano resource for the operation history tests
+func newOperationTestVerrazzano() *vzapi.Verrazzano {
+	_ = vzapi.AddToScheme(k8scheme.Scheme)
+	return &vzapi.Verrazzano{
+		ObjectMeta: createObjectMeta("default", "verrazzano", []string{finalizerName}),
+	}
+}
+
+// newTestOperation returns a finished or in progress operation of a Verrazzano resource
+func newTestOperation(vz *vzapi.Verrazzano, name string, start time.Time, result vzapi.OperationResult) *vzapi.VerrazzanoOperation {
+	startTime := metav1.NewTime(start)
+	return &vzapi.VerrazzanoOperation{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: vz.Namespace,
+			Name:      name,
+			Labels:    map[string]string{vzconst.VerrazzanoOperationLabel: vz.Name},
+		},
+		Spec: vzapi.VerrazzanoOperationSpec{
+			Verrazzano: vz.Name,
+			Operation:  vzapi.OperationUpdate,
+		},
+		Status: vzapi.VerrazzanoOperationStatus{
+			Result:    result,
+			StartTime: &startTime,
+		},
+	}
+}
+
+// getTestOperations returns the operations of a Verrazzano resource
+func getTestOperations(t *testing.T, c client.Client, vz *vzapi.Verrazzano) []vzapi.VerrazzanoOperation {
+	opList := vzapi.VerrazzanoOperationList{}
+	assert.NoError(t, c.List(context.TODO(), &opList, client.InNamespace(vz.Namespace), client.MatchingLabels{vzconst.VerrazzanoOperationLabel: vz.Name}))
+	return opList.Items
+}
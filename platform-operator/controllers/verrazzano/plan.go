@@ -0,0 +1,76 @@
+// Copyright (c) 2022, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package verrazzano
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/verrazzano/verrazzano/pkg/log/vzlog"
+	installv1alpha1 "github.com/verrazzano/verrazzano/platform-operator/apis/verrazzano/v1alpha1"
+	"github.com/verrazzano/verrazzano/platform-operator/constants"
+	"github.com/verrazzano/verrazzano/platform-operator/controllers/verrazzano/plan"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/yaml"
+)
+
+// reconcilePlan serves the change plan requested by the plan request annotation of the Verrazzano resource.  The
+// proposed Verrazzano resource is read from the plan ConfigMap, and the plan is written back to the ConfigMap along
+// with the request it was computed for, so that each request is only served once.  Nothing else is changed on the
+// cluster.
+func (r *Reconciler) reconcilePlan(log vzlog.VerrazzanoLogger, vz *installv1alpha1.Verrazzano) error {
+	request := vz.Annotations[constants.PlanRequestAnnotation]
+	if len(request) == 0 {
+		return nil
+	}
+
+	cm := &corev1.ConfigMap{}
+	nsn := types.NamespacedName{Namespace: vz.Namespace, Name: plan.GetConfigMapName(vz.Name)}
+	if err := r.Get(context.TODO(), nsn, cm); err != nil {
+		if errors.IsNotFound(err) {
+			log.ErrorfThrottled("Failed to find the ConfigMap %s with the proposed Verrazzano resource for plan request %s", nsn, request)
+			return nil
+		}
+		return log.ErrorfNewErr("Failed to get the plan ConfigMap %s: %v", nsn, err)
+	}
+	if cm.Data[plan.RequestKey] == request {
+		return nil
+	}
+
+	log.Infof("Computing the change plan for request %s", request)
+	changePlan := r.buildPlan(log, vz, cm.Data[plan.ProposedKey])
+	planJSON, err := json.Marshal(changePlan)
+	if err != nil {
+		return log.ErrorfNewErr("Failed to marshal the change plan for request %s: %v", request, err)
+	}
+	if cm.Data == nil {
+		cm.Data = map[string]string{}
+	}
+	cm.Data[plan.PlanKey] = string(planJSON)
+	cm.Data[plan.RequestKey] = request
+	if err := r.Update(context.TODO(), cm); err != nil {
+		return log.ErrorfNewErr("Failed to update the plan ConfigMap %s: %v", nsn, err)
+	}
+	return nil
+}
+
+// buildPlan returns the change plan for the proposed Verrazzano resource YAML.  A plan that cannot be computed has
+// the error, so that it is reported to the requester instead of being retried.
+func (r *Reconciler) buildPlan(log vzlog.VerrazzanoLogger, vz *installv1alpha1.Verrazzano, proposedYAML string) *plan.Plan {
+	proposed := &installv1alpha1.Verrazzano{}
+	if err := yaml.Unmarshal([]byte(proposedYAML), proposed); err != nil {
+		return &plan.Plan{Error: "Failed to parse the proposed Verrazzano resource: " + err.Error()}
+	}
+	// The proposed resource is an update of the Verrazzano resource
+	proposed.ObjectMeta = *vz.ObjectMeta.DeepCopy()
+	proposed.Status = *vz.Status.DeepCopy()
+
+	changePlan, err := plan.BuildPlan(log, r.Client, vz, proposed)
+	if err != nil {
+		return &plan.Plan{Error: "Failed to compute the change plan: " + err.Error()}
+	}
+	return changePlan
+}
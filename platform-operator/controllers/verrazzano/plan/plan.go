@@ -0,0 +1,155 @@
+// Copyright (c) 2022, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package plan
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/verrazzano/verrazzano/pkg/log/vzlog"
+	"github.com/verrazzano/verrazzano/pkg/semver"
+	vzapi "github.com/verrazzano/verrazzano/platform-operator/apis/verrazzano/v1alpha1"
+	"github.com/verrazzano/verrazzano/platform-operator/constants"
+	"github.com/verrazzano/verrazzano/platform-operator/controllers/verrazzano/component/helm"
+	"github.com/verrazzano/verrazzano/platform-operator/controllers/verrazzano/component/registry"
+	"github.com/verrazzano/verrazzano/platform-operator/controllers/verrazzano/component/spi"
+	clipkg "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// ConfigMapPrefix is the prefix of the name of the ConfigMap that holds the change plan of a Verrazzano resource
+	ConfigMapPrefix = "verrazzano-plan-"
+
+	// ProposedKey is the ConfigMap key of the proposed Verrazzano resource YAML
+	ProposedKey = "proposed"
+
+	// RequestKey is the ConfigMap key of the request the plan was computed for
+	RequestKey = "request"
+
+	// PlanKey is the ConfigMap key of the change plan JSON
+	PlanKey = "plan"
+)
+
+// Action is the operation the platform operator would perform on a component
+type Action string
+
+const (
+	// ActionInstall indicates that the component would be installed
+	ActionInstall Action = "Install"
+
+	// ActionUpgrade indicates that the component would be upgraded
+	ActionUpgrade Action = "Upgrade"
+
+	// ActionReconcile indicates that the component would be reconciled with the updated overrides
+	ActionReconcile Action = "Reconcile"
+
+	// ActionNone indicates that the component would not change
+	ActionNone Action = "None"
+)
+
+// ComponentPlan is the planned change of a component
+type ComponentPlan struct {
+	Name       string   `json:"name"`
+	Action     Action   `json:"action"`
+	ValuesDiff []string `json:"valuesDiff,omitempty"`
+	Message    string   `json:"message,omitempty"`
+}
+
+// Plan is the change plan of a Verrazzano resource update
+type Plan struct {
+	CurrentVersion  string          `json:"currentVersion,omitempty"`
+	ProposedVersion string          `json:"proposedVersion,omitempty"`
+	Components      []ComponentPlan `json:"components,omitempty"`
+	Error           string          `json:"error,omitempty"`
+}
+
+// GetConfigMapName returns the name of the ConfigMap that holds the change plan of the Verrazzano resource
+func GetConfigMapName(vzName string) string {
+	return ConfigMapPrefix + vzName
+}
+
+// BuildPlan computes, without changing the cluster, which components would be installed, upgraded or reconciled if the
+// Verrazzano resource was updated to the proposed resource, along with the Helm values each of them would apply
+func BuildPlan(log vzlog.VerrazzanoLogger, c clipkg.Client, actualCR *vzapi.Verrazzano, proposedCR *vzapi.Verrazzano) (*Plan, error) {
+	ctx, err := spi.NewContext(log, c, proposedCR, true)
+	if err != nil {
+		return nil, err
+	}
+
+	plan := &Plan{
+		CurrentVersion:  actualCR.Status.Version,
+		ProposedVersion: proposedCR.Spec.Version,
+	}
+	upgrade, err := isUpgrade(actualCR.Status.Version, proposedCR.Spec.Version)
+	if err != nil {
+		return nil, err
+	}
+	specChanged := !reflect.DeepEqual(actualCR.Spec, proposedCR.Spec)
+
+	for _, comp := range registry.GetComponents() {
+		if !comp.IsOperatorInstallSupported() {
+			continue
+		}
+		plan.Components = append(plan.Components, planComponent(ctx.Init(comp.Name()), comp, upgrade, specChanged))
+	}
+	return plan, nil
+}
+
+// isUpgrade returns true if the proposed version is set and is not the installed version, the versions are compared
+// as semantic versions like the platform operator does before it starts an upgrade
+func isUpgrade(currentVersion string, proposedVersion string) (bool, error) {
+	if len(proposedVersion) == 0 || len(currentVersion) == 0 {
+		return false, nil
+	}
+	proposed, err := semver.NewSemVersion(proposedVersion)
+	if err != nil {
+		return false, err
+	}
+	current, err := semver.NewSemVersion(currentVersion)
+	if err != nil {
+		return false, err
+	}
+	return proposed.CompareTo(current) != 0, nil
+}
+
+// planComponent returns the action the platform operator would perform on the component and the Helm values diff
+// of the action
+func planComponent(ctx spi.ComponentContext, comp spi.Component, upgrade bool, specChanged bool) ComponentPlan {
+	compPlan := ComponentPlan{Name: comp.Name(), Action: ActionNone}
+	if !comp.IsEnabled(ctx.EffectiveCR()) {
+		compPlan.Message = "Component is disabled"
+		return compPlan
+	}
+	installed, err := comp.IsInstalled(ctx)
+	if err != nil {
+		compPlan.Message = fmt.Sprintf("Failed checking if the component is installed: %v", err)
+		return compPlan
+	}
+	switch {
+	case !installed:
+		compPlan.Action = ActionInstall
+		ctx = ctx.Operation(constants.InstallOperation)
+	case upgrade:
+		compPlan.Action = ActionUpgrade
+		ctx = ctx.Operation(constants.UpgradeOperation)
+	case specChanged && comp.MonitorOverrides(ctx):
+		// A reconcile installs the chart again with the updated overrides
+		compPlan.Action = ActionReconcile
+		ctx = ctx.Operation(constants.InstallOperation)
+	default:
+		return compPlan
+	}
+
+	planner, ok := comp.(spi.ComponentValuesPlanner)
+	if !ok {
+		return compPlan
+	}
+	currentValues, plannedValues, err := planner.GetPlannedValues(ctx)
+	if err != nil {
+		compPlan.Message = fmt.Sprintf("Failed computing the Helm values: %v", err)
+		return compPlan
+	}
+	compPlan.ValuesDiff = helm.DiffValues(currentValues, plannedValues)
+	return compPlan
+}
@@ -0,0 +1,117 @@
+// Copyright (c) 2022, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package plan
+
+import (
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/verrazzano/verrazzano/pkg/log/vzlog"
+	vzapi "github.com/verrazzano/verrazzano/platform-operator/apis/verrazzano/v1alpha1"
+	"github.com/verrazzano/verrazzano/platform-operator/constants"
+	"github.com/verrazzano/verrazzano/platform-operator/controllers/verrazzano/component/registry"
+	"github.com/verrazzano/verrazzano/platform-operator/controllers/verrazzano/component/spi"
+	"github.com/verrazzano/verrazzano/platform-operator/internal/config"
+	"github.com/verrazzano/verrazzano/platform-operator/mocks"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8scheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+// plannerComponent is a mock component that reports the operation it planned the values for
+type plannerComponent struct {
+	*mocks.MockComponent
+}
+
+func (c plannerComponent) GetPlannedValues(ctx spi.ComponentContext) (map[string]interface{}, map[string]interface{}, error) {
+	return map[string]interface{}{"replicas": 1}, map[string]interface{}{"replicas": 2, "operation": ctx.GetOperation()}, nil
+}
+
+// newMockComponent returns a mock component that supports operator install
+func newMockComponent(mocker *gomock.Controller, name string, enabled bool, installed bool, monitorOverrides bool) plannerComponent {
+	comp := mocks.NewMockComponent(mocker)
+	comp.EXPECT().Name().Return(name).AnyTimes()
+	comp.EXPECT().IsOperatorInstallSupported().Return(true).AnyTimes()
+	comp.EXPECT().IsEnabled(gomock.Any()).Return(enabled).AnyTimes()
+	comp.EXPECT().IsInstalled(gomock.Any()).Return(installed, nil).AnyTimes()
+	comp.EXPECT().MonitorOverrides(gomock.Any()).Return(monitorOverrides).AnyTimes()
+	return plannerComponent{comp}
+}
+
+// TestBuildPlan tests the BuildPlan function
+// GIVEN components that are disabled, not installed, installed with and without monitored overrides
+//  WHEN I call BuildPlan for an updated spec and for an upgrade
+//  THEN the plan has the action of each component and the values diff of the components that change
+func TestBuildPlan(t *testing.T) {
+	a := assert.New(t)
+	mocker := gomock.NewController(t)
+	config.TestProfilesDir = "../../../manifests/profiles"
+	defer func() { config.TestProfilesDir = "" }()
+
+	registry.OverrideGetComponentsFn(func() []spi.Component {
+		return []spi.Component{
+			newMockComponent(mocker, "disabled", false, false, false),
+			newMockComponent(mocker, "new", true, false, false),
+			newMockComponent(mocker, "monitored", true, true, true),
+			newMockComponent(mocker, "unmonitored", true, true, false),
+		}
+	})
+	defer registry.ResetGetComponentsFn()
+
+	actual := &vzapi.Verrazzano{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "verrazzano"},
+		Spec:       vzapi.VerrazzanoSpec{Version: "1.3.0", Profile: vzapi.Dev},
+		Status:     vzapi.VerrazzanoStatus{Version: "1.3.0"},
+	}
+	proposed := actual.DeepCopy()
+	proposed.Spec.EnvironmentName = "updated"
+	c := fake.NewClientBuilder().WithScheme(k8scheme.Scheme).Build()
+
+	plan, err := BuildPlan(vzlog.DefaultLogger(), c, actual, proposed)
+	a.NoError(err)
+	a.Equal("1.3.0", plan.CurrentVersion)
+	a.Equal([]ComponentPlan{
+		{Name: "disabled", Action: ActionNone, Message: "Component is disabled"},
+		{Name: "new", Action: ActionInstall, ValuesDiff: []string{"+ operation: \"install\"", "~ replicas: 1 -> 2"}},
+		{Name: "monitored", Action: ActionReconcile, ValuesDiff: []string{"+ operation: \"install\"", "~ replicas: 1 -> 2"}},
+		{Name: "unmonitored", Action: ActionNone},
+	}, plan.Components)
+
+	proposed = actual.DeepCopy()
+	proposed.Spec.Version = "1.4.0"
+	plan, err = BuildPlan(vzlog.DefaultLogger(), c, actual, proposed)
+	a.NoError(err)
+	a.Equal("1.4.0", plan.ProposedVersion)
+	a.Equal(ActionUpgrade, plan.Components[2].Action)
+	a.Equal(ActionUpgrade, plan.Components[3].Action)
+	a.Equal([]string{"+ operation: \"" + constants.UpgradeOperation + "\"", "~ replicas: 1 -> 2"}, plan.Components[3].ValuesDiff)
+}
+
+// TestIsUpgrade tests the isUpgrade function
+// GIVEN the installed version and the proposed version of Verrazzano
+//  WHEN I call isUpgrade
+//  THEN the versions are compared as semantic versions, and an invalid version is an error
+func TestIsUpgrade(t *testing.T) {
+	a := assert.New(t)
+
+	upgrade, err := isUpgrade("1.3.0", "v1.3.0")
+	a.NoError(err)
+	a.False(upgrade)
+
+	upgrade, err = isUpgrade("1.3.0", "1.4.0")
+	a.NoError(err)
+	a.True(upgrade)
+
+	upgrade, err = isUpgrade("", "1.4.0")
+	a.NoError(err)
+	a.False(upgrade)
+
+	upgrade, err = isUpgrade("1.3.0", "")
+	a.NoError(err)
+	a.False(upgrade)
+
+	_, err = isUpgrade("1.3.0", "latest")
+	a.Error(err)
+}
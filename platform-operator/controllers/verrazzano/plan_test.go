@@ -0,0 +1,81 @@
+// Copyright (c) 2022, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package verrazzano
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/verrazzano/verrazzano/pkg/log/vzlog"
+	vzapi "github.com/verrazzano/verrazzano/platform-operator/apis/verrazzano/v1alpha1"
+	"github.com/verrazzano/verrazzano/platform-operator/constants"
+	"github.com/verrazzano/verrazzano/platform-operator/controllers/verrazzano/component/registry"
+	"github.com/verrazzano/verrazzano/platform-operator/controllers/verrazzano/component/spi"
+	"github.com/verrazzano/verrazzano/platform-operator/controllers/verrazzano/plan"
+	"github.com/verrazzano/verrazzano/platform-operator/internal/config"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	k8scheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+// TestReconcilePlan tests the reconcilePlan func
+// GIVEN a Verrazzano resource with a plan request annotation and a plan ConfigMap with the proposed resource
+// WHEN the plan is reconciled
+// THEN the plan is written to the ConfigMap once for the request
+func TestReconcilePlan(t *testing.T) {
+	asserts := assert.New(t)
+	config.TestProfilesDir = "../../manifests/profiles"
+	defer func() { config.TestProfilesDir = "" }()
+	registry.OverrideGetComponentsFn(func() []spi.Component {
+		return []spi.Component{}
+	})
+	defer registry.ResetGetComponentsFn()
+
+	vz := &vzapi.Verrazzano{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:   "default",
+			Name:        "verrazzano",
+			Annotations: map[string]string{constants.PlanRequestAnnotation: "request-1"},
+		},
+		Spec:   vzapi.VerrazzanoSpec{Version: "1.3.0"},
+		Status: vzapi.VerrazzanoStatus{Version: "1.3.0"},
+	}
+	cm := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: plan.GetConfigMapName("verrazzano")},
+		Data:       map[string]string{plan.ProposedKey: "spec:\n  version: 1.4.0\n"},
+	}
+	_ = vzapi.AddToScheme(k8scheme.Scheme)
+	c := fake.NewClientBuilder().WithScheme(k8scheme.Scheme).WithObjects(vz, cm).Build()
+	reconciler := newVerrazzanoReconciler(c)
+
+	asserts.NoError(reconciler.reconcilePlan(vzlog.DefaultLogger(), vz))
+	asserts.NoError(c.Get(context.TODO(), types.NamespacedName{Namespace: "default", Name: cm.Name}, cm))
+	asserts.Equal("request-1", cm.Data[plan.RequestKey])
+	changePlan := plan.Plan{}
+	asserts.NoError(json.Unmarshal([]byte(cm.Data[plan.PlanKey]), &changePlan))
+	asserts.Equal("1.3.0", changePlan.CurrentVersion)
+	asserts.Equal("1.4.0", changePlan.ProposedVersion)
+	asserts.Empty(changePlan.Error)
+
+	// The request has been served, the plan is not computed again
+	cm.Data[plan.ProposedKey] = "not: [valid"
+	asserts.NoError(c.Update(context.TODO(), cm))
+	asserts.NoError(reconciler.reconcilePlan(vzlog.DefaultLogger(), vz))
+	asserts.NoError(c.Get(context.TODO(), types.NamespacedName{Namespace: "default", Name: cm.Name}, cm))
+	asserts.NoError(json.Unmarshal([]byte(cm.Data[plan.PlanKey]), &changePlan))
+	asserts.Empty(changePlan.Error)
+
+	// A new request for a proposed resource that is not valid has the error in the plan
+	vz.Annotations[constants.PlanRequestAnnotation] = "request-2"
+	asserts.NoError(reconciler.reconcilePlan(vzlog.DefaultLogger(), vz))
+	asserts.NoError(c.Get(context.TODO(), types.NamespacedName{Namespace: "default", Name: cm.Name}, cm))
+	asserts.Equal("request-2", cm.Data[plan.RequestKey])
+	changePlan = plan.Plan{}
+	asserts.NoError(json.Unmarshal([]byte(cm.Data[plan.PlanKey]), &changePlan))
+	asserts.Contains(changePlan.Error, "Failed to parse the proposed Verrazzano resource")
+}
@@ -0,0 +1,78 @@
+// Copyright (c) 2022, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package verrazzano
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/verrazzano/verrazzano/pkg/log/vzlog"
+	installv1alpha1 "github.com/verrazzano/verrazzano/platform-operator/apis/verrazzano/v1alpha1"
+	"github.com/verrazzano/verrazzano/platform-operator/constants"
+	"github.com/verrazzano/verrazzano/platform-operator/controllers/verrazzano/component/registry"
+	"github.com/verrazzano/verrazzano/platform-operator/controllers/verrazzano/component/spi"
+	"github.com/verrazzano/verrazzano/platform-operator/controllers/verrazzano/preflight"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// reconcilePreflight runs the upgrade preflight checks requested by the preflight request annotation of the
+// Verrazzano resource.  The results are written to the preflight ConfigMap along with the request they were computed
+// for, so that each request is only served once.  The checks are the ones run before an upgrade, the clients read
+// the results instead of running the checks themselves.
+func (r *Reconciler) reconcilePreflight(log vzlog.VerrazzanoLogger, vz *installv1alpha1.Verrazzano) error {
+	request := vz.Annotations[constants.PreflightRequestAnnotation]
+	if len(request) == 0 {
+		return nil
+	}
+
+	cm := &corev1.ConfigMap{}
+	nsn := types.NamespacedName{Namespace: vz.Namespace, Name: preflight.GetConfigMapName(vz.Name)}
+	err := r.Get(context.TODO(), nsn, cm)
+	if err != nil && !errors.IsNotFound(err) {
+		return log.ErrorfNewErr("Failed to get the preflight ConfigMap %s: %v", nsn, err)
+	}
+	found := err == nil
+	if found && cm.Data[preflight.RequestKey] == request {
+		return nil
+	}
+
+	log.Infof("Running the upgrade preflight checks for request %s", request)
+	resultsJSON, err := json.Marshal(r.runPreflightChecks(log, vz))
+	if err != nil {
+		return log.ErrorfNewErr("Failed to marshal the preflight check results for request %s: %v", request, err)
+	}
+	cm.Namespace = nsn.Namespace
+	cm.Name = nsn.Name
+	// The results are garbage collected with the Verrazzano resource
+	cm.OwnerReferences = newVerrazzanoOwnerReferences(vz)
+	cm.Data = map[string]string{
+		preflight.RequestKey: request,
+		preflight.ResultsKey: string(resultsJSON),
+	}
+	if found {
+		err = r.Update(context.TODO(), cm)
+	} else {
+		err = r.Create(context.TODO(), cm)
+	}
+	if err != nil {
+		return log.ErrorfNewErr("Failed to write the preflight ConfigMap %s: %v", nsn, err)
+	}
+	return nil
+}
+
+// runPreflightChecks runs the upgrade preflight checks of the components.  Results that cannot be computed have the
+// error, so that it is reported to the requester instead of being retried.
+func (r *Reconciler) runPreflightChecks(log vzlog.VerrazzanoLogger, vz *installv1alpha1.Verrazzano) *preflight.Results {
+	spiCtx, err := spi.NewContext(log, r.Client, vz, r.DryRun)
+	if err != nil {
+		return &preflight.Results{Error: "Failed to create the component context: " + err.Error()}
+	}
+	results := &preflight.Results{}
+	for _, failure := range registry.RunPreflightChecks(spiCtx) {
+		results.Failures = append(results.Failures, preflight.Failure{Component: failure.ComponentName, Message: failure.Err.Error()})
+	}
+	return results
+}
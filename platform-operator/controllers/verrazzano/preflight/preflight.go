@@ -0,0 +1,36 @@
+// Copyright (c) 2022, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+// Package preflight has the upgrade preflight check request and results that are exchanged between the platform
+// operator, which runs the checks, and the clients that request them.  It must not depend on the components so that
+// the clients do not have to import them.
+package preflight
+
+const (
+	// ConfigMapPrefix is the prefix of the name of the ConfigMap that holds the preflight check results of a
+	// Verrazzano resource
+	ConfigMapPrefix = "verrazzano-preflight-"
+
+	// RequestKey is the ConfigMap key of the request the preflight checks were run for
+	RequestKey = "request"
+
+	// ResultsKey is the ConfigMap key of the preflight check results JSON
+	ResultsKey = "results"
+)
+
+// Failure is an upgrade preflight check of a component that failed
+type Failure struct {
+	Component string `json:"component"`
+	Message   string `json:"message"`
+}
+
+// Results are the results of the upgrade preflight checks run for a request
+type Results struct {
+	Failures []Failure `json:"failures,omitempty"`
+	Error    string    `json:"error,omitempty"`
+}
+
+// GetConfigMapName returns the name of the ConfigMap that holds the preflight check results of the Verrazzano resource
+func GetConfigMapName(vzName string) string {
+	return ConfigMapPrefix + vzName
+}
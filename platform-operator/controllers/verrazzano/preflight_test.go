@@ -0,0 +1,102 @@
+// Copyright (c) 2022, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package verrazzano
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/verrazzano/verrazzano/pkg/log/vzlog"
+	vzapi "github.com/verrazzano/verrazzano/platform-operator/apis/verrazzano/v1alpha1"
+	"github.com/verrazzano/verrazzano/platform-operator/constants"
+	"github.com/verrazzano/verrazzano/platform-operator/controllers/verrazzano/component/helm"
+	"github.com/verrazzano/verrazzano/platform-operator/controllers/verrazzano/component/registry"
+	"github.com/verrazzano/verrazzano/platform-operator/controllers/verrazzano/component/spi"
+	"github.com/verrazzano/verrazzano/platform-operator/controllers/verrazzano/preflight"
+	"github.com/verrazzano/verrazzano/platform-operator/internal/config"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	k8scheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+// TestReconcilePreflight tests the reconcilePreflight func
+// GIVEN a Verrazzano resource with a preflight request annotation and a component whose preflight check fails
+// WHEN the preflight request is reconciled
+// THEN the failed checks are written once for the request to the preflight ConfigMap, which is owned by the Verrazzano resource
+func TestReconcilePreflight(t *testing.T) {
+	asserts := assert.New(t)
+	config.TestProfilesDir = "../../manifests/profiles"
+	defer func() { config.TestProfilesDir = "" }()
+	checks := 0
+	registry.OverrideGetComponentsFn(func() []spi.Component {
+		return []spi.Component{
+			fakeComponent{
+				HelmComponent: helm.HelmComponent{ReleaseName: "fake1"},
+				preflightFunc: func(ctx spi.ComponentContext) []error {
+					checks++
+					return []error{fmt.Errorf("Helm release fake1 is pending-upgrade")}
+				},
+			},
+		}
+	})
+	defer registry.ResetGetComponentsFn()
+
+	vz := &vzapi.Verrazzano{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:   "default",
+			Name:        "verrazzano",
+			UID:         "vz-uid",
+			Annotations: map[string]string{constants.PreflightRequestAnnotation: "request-1"},
+		},
+	}
+	_ = vzapi.AddToScheme(k8scheme.Scheme)
+	c := fake.NewClientBuilder().WithScheme(k8scheme.Scheme).WithObjects(vz).Build()
+	reconciler := newVerrazzanoReconciler(c)
+
+	asserts.NoError(reconciler.reconcilePreflight(vzlog.DefaultLogger(), vz))
+	cm := &corev1.ConfigMap{}
+	asserts.NoError(c.Get(context.TODO(), types.NamespacedName{Namespace: "default", Name: preflight.GetConfigMapName("verrazzano")}, cm))
+	asserts.Equal("request-1", cm.Data[preflight.RequestKey])
+	asserts.Len(cm.OwnerReferences, 1)
+	asserts.Equal("Verrazzano", cm.OwnerReferences[0].Kind)
+	asserts.Equal("verrazzano", cm.OwnerReferences[0].Name)
+	asserts.Equal(types.UID("vz-uid"), cm.OwnerReferences[0].UID)
+	results := preflight.Results{}
+	asserts.NoError(json.Unmarshal([]byte(cm.Data[preflight.ResultsKey]), &results))
+	asserts.Equal([]preflight.Failure{{Component: "fake1", Message: "Helm release fake1 is pending-upgrade"}}, results.Failures)
+	asserts.Equal(1, checks)
+
+	// The request has been served, the checks are not run again
+	asserts.NoError(reconciler.reconcilePreflight(vzlog.DefaultLogger(), vz))
+	asserts.Equal(1, checks)
+
+	// A new request runs the checks again
+	vz.Annotations[constants.PreflightRequestAnnotation] = "request-2"
+	asserts.NoError(reconciler.reconcilePreflight(vzlog.DefaultLogger(), vz))
+	asserts.NoError(c.Get(context.TODO(), types.NamespacedName{Namespace: "default", Name: preflight.GetConfigMapName("verrazzano")}, cm))
+	asserts.Equal("request-2", cm.Data[preflight.RequestKey])
+	asserts.Equal(2, checks)
+}
+
+// TestReconcilePreflightNoRequest tests the reconcilePreflight func
+// GIVEN a Verrazzano resource without a preflight request annotation
+// WHEN the preflight request is reconciled
+// THEN the checks are not run and no ConfigMap is written
+func TestReconcilePreflightNoRequest(t *testing.T) {
+	asserts := assert.New(t)
+	vz := &vzapi.Verrazzano{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "verrazzano"}}
+	_ = vzapi.AddToScheme(k8scheme.Scheme)
+	c := fake.NewClientBuilder().WithScheme(k8scheme.Scheme).WithObjects(vz).Build()
+	reconciler := newVerrazzanoReconciler(c)
+
+	asserts.NoError(reconciler.reconcilePreflight(vzlog.DefaultLogger(), vz))
+	cms := corev1.ConfigMapList{}
+	asserts.NoError(c.List(context.TODO(), &cms))
+	asserts.Empty(cms.Items)
+}
@@ -0,0 +1,40 @@
+// Copyright (c) 2022, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package verrazzano
+
+import (
+	"fmt"
+	"time"
+
+	installv1alpha1 "github.com/verrazzano/verrazzano/platform-operator/apis/verrazzano/v1alpha1"
+	"github.com/verrazzano/verrazzano/platform-operator/controllers/verrazzano/component/spi"
+)
+
+// componentResyncs has the time each component last reapplied its configuration, keyed by the Verrazzano resource and component
+var componentResyncs = make(map[string]time.Time)
+
+// resyncComponent calls Reconcile for a Ready component that keeps configuration outside of Kubernetes when its resync
+// interval has passed.  A failed resync is recorded as a component error and retried at the next interval, it does not
+// change the state of the component.
+func (r *Reconciler) resyncComponent(compContext spi.ComponentContext, comp spi.Component) {
+	resyncer, ok := comp.(spi.ComponentResyncer)
+	if !ok {
+		return
+	}
+	cr := compContext.ActualCR()
+	if componentStatus, ok := cr.Status.Components[comp.Name()]; !ok || componentStatus.State != installv1alpha1.CompStateReady {
+		return
+	}
+	key := fmt.Sprintf("%s-%s", getNSNKey(cr), comp.Name())
+	if lastResync, ok := componentResyncs[key]; ok && time.Since(lastResync) < resyncer.GetResyncInterval() {
+		return
+	}
+	componentResyncs[key] = time.Now()
+
+	compContext.Log().Debugf("Component %s reapplying its configuration", comp.Name())
+	if err := comp.Reconcile(compContext); err != nil {
+		compContext.Log().ErrorfThrottled("Failed reapplying the configuration of component %s: %v", comp.Name(), err)
+		r.recordComponentError(compContext, eventReasonReconcileFailed, err)
+	}
+}
@@ -0,0 +1,109 @@
+// Copyright (c) 2022, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package verrazzano
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	vzapi "github.com/verrazzano/verrazzano/platform-operator/apis/verrazzano/v1alpha1"
+	"github.com/verrazzano/verrazzano/platform-operator/controllers/verrazzano/component/helm"
+	"github.com/verrazzano/verrazzano/platform-operator/controllers/verrazzano/component/spi"
+	k8scheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+const testResyncInterval = 5 * time.Minute
+
+// fakeResyncComponent is a fake component that reapplies its configuration periodically
+type fakeResyncComponent struct {
+	fakeComponent
+	reconcileFunc func(ctx spi.ComponentContext) error
+}
+
+func (f fakeResyncComponent) Reconcile(ctx spi.ComponentContext) error {
+	return f.reconcileFunc(ctx)
+}
+
+func (f fakeResyncComponent) GetResyncInterval() time.Duration {
+	return testResyncInterval
+}
+
+// TestResyncComponent tests the resyncComponent method for the following use case
+// GIVEN a ready component that reapplies its configuration periodically
+// WHEN the component is resynced, before and after the resync interval has passed
+// THEN Reconcile is only called when the resync is due, and a failure is recorded as an event
+func TestResyncComponent(t *testing.T) {
+	asserts := assert.New(t)
+	vz := newInstalledVerrazzano(vzapi.CompStateReady, "fake")
+	key := fmt.Sprintf("%s-%s", getNSNKey(vz), "fake")
+	defer delete(componentResyncs, key)
+
+	c := fake.NewClientBuilder().WithScheme(k8scheme.Scheme).WithObjects(vz).Build()
+	recorder := record.NewFakeRecorder(10)
+	reconciler := newVerrazzanoReconciler(c)
+	reconciler.EventRecorder = recorder
+
+	var reconcileErr error
+	reconciles := 0
+	comp := fakeResyncComponent{
+		fakeComponent: fakeComponent{HelmComponent: helm.HelmComponent{ReleaseName: "fake"}},
+		reconcileFunc: func(ctx spi.ComponentContext) error {
+			reconciles++
+			return reconcileErr
+		},
+	}
+	compContext := spi.NewFakeContext(c, vz, false).Init("fake")
+
+	reconciler.resyncComponent(compContext, comp)
+	asserts.Equal(1, reconciles)
+
+	// The resync is not due yet
+	reconciler.resyncComponent(compContext, comp)
+	asserts.Equal(1, reconciles)
+
+	// The resync fails at the next interval
+	componentResyncs[key] = time.Now().Add(-testResyncInterval)
+	reconcileErr = errors.New("Keycloak is unreachable")
+	reconciler.resyncComponent(compContext, comp)
+	asserts.Equal(2, reconciles)
+	asserts.Equal("Warning ReconcileFailed Component fake: Keycloak is unreachable", <-recorder.Events)
+	asserts.Equal(vzapi.CompStateReady, vz.Status.Components["fake"].State)
+}
+
+// TestResyncComponentNotReady tests the resyncComponent method for the following use cases
+// GIVEN a degraded component that reapplies its configuration periodically, or a ready component that does not
+// WHEN the component is resynced
+// THEN Reconcile is not called
+func TestResyncComponentNotReady(t *testing.T) {
+	vz := newInstalledVerrazzano(vzapi.CompStateReady, "fake")
+	vz.Status.Components["fake"].State = vzapi.CompStateDegraded
+	key := fmt.Sprintf("%s-%s", getNSNKey(vz), "fake")
+	defer delete(componentResyncs, key)
+
+	c := fake.NewClientBuilder().WithScheme(k8scheme.Scheme).WithObjects(vz).Build()
+	reconciler := newVerrazzanoReconciler(c)
+	reconciles := 0
+	comp := fakeResyncComponent{
+		fakeComponent: fakeComponent{HelmComponent: helm.HelmComponent{ReleaseName: "fake"}},
+		reconcileFunc: func(ctx spi.ComponentContext) error {
+			reconciles++
+			return nil
+		},
+	}
+	compContext := spi.NewFakeContext(c, vz, false).Init("fake")
+
+	reconciler.resyncComponent(compContext, comp)
+	assert.Equal(t, 0, reconciles)
+
+	vz.Status.Components["fake"].State = vzapi.CompStateReady
+	reconciler.resyncComponent(compContext, comp.fakeComponent)
+	assert.Equal(t, 0, reconciles)
+	_, ok := componentResyncs[key]
+	assert.False(t, ok)
+}
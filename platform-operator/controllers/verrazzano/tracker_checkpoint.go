@@ -0,0 +1,86 @@
+// Copyright (c) 2022, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package verrazzano
+
+import (
+	"context"
+
+	"github.com/verrazzano/verrazzano/pkg/log/vzlog"
+	installv1alpha1 "github.com/verrazzano/verrazzano/platform-operator/apis/verrazzano/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+)
+
+const (
+	// trackerGenerationKey is the ConfigMap key for the Verrazzano resource generation of a tracked operation
+	trackerGenerationKey = "generation"
+
+	// trackerVzStateKey is the ConfigMap key for the Verrazzano state of a tracked operation
+	trackerVzStateKey = "verrazzanoState"
+
+	// trackerComponentStatesKey is the ConfigMap key for the JSON map of component states of a tracked operation
+	trackerComponentStatesKey = "componentStates"
+)
+
+// getTrackerCheckpoint returns the data of the checkpoint ConfigMap of an operation tracker, or nil if there is
+// no checkpoint
+func (r *Reconciler) getTrackerCheckpoint(log vzlog.VerrazzanoLogger, cr *installv1alpha1.Verrazzano, name string) (map[string]string, error) {
+	cm := corev1.ConfigMap{}
+	err := r.Client.Get(context.TODO(), types.NamespacedName{Namespace: cr.Namespace, Name: name}, &cm)
+	if errors.IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, log.ErrorfNewErr("Failed getting tracker ConfigMap %s/%s: %v", cr.Namespace, name, err)
+	}
+	return cm.Data, nil
+}
+
+// saveTrackerCheckpoint saves the data of an operation tracker in its checkpoint ConfigMap.  The ConfigMap is owned
+// by the Verrazzano resource so that it is garbage collected when the resource is deleted.
+func (r *Reconciler) saveTrackerCheckpoint(log vzlog.VerrazzanoLogger, cr *installv1alpha1.Verrazzano, name string, data map[string]string) error {
+	cm := corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: cr.Namespace,
+			Name:      name,
+		},
+	}
+	_, err := controllerutil.CreateOrUpdate(context.TODO(), r.Client, &cm, func() error {
+		cm.OwnerReferences = newVerrazzanoOwnerReferences(cr)
+		cm.Data = data
+		return nil
+	})
+	if err != nil {
+		return log.ErrorfNewErr("Failed checkpointing the tracker in ConfigMap %s/%s: %v", cm.Namespace, cm.Name, err)
+	}
+	return nil
+}
+
+// deleteTrackerCheckpoint deletes the checkpoint ConfigMap of an operation tracker
+func (r *Reconciler) deleteTrackerCheckpoint(log vzlog.VerrazzanoLogger, cr *installv1alpha1.Verrazzano, name string) error {
+	cm := corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: cr.Namespace,
+			Name:      name,
+		},
+	}
+	if err := r.Client.Delete(context.TODO(), &cm); err != nil && !errors.IsNotFound(err) {
+		return log.ErrorfNewErr("Failed deleting tracker ConfigMap %s/%s: %v", cm.Namespace, cm.Name, err)
+	}
+	return nil
+}
+
+// newVerrazzanoOwnerReferences returns the owner references of a resource that is garbage collected when the
+// Verrazzano resource is deleted
+func newVerrazzanoOwnerReferences(cr *installv1alpha1.Verrazzano) []metav1.OwnerReference {
+	return []metav1.OwnerReference{{
+		APIVersion: installv1alpha1.SchemeGroupVersion.String(),
+		Kind:       "Verrazzano",
+		Name:       cr.Name,
+		UID:        cr.UID,
+	}}
+}
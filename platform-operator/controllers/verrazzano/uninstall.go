@@ -0,0 +1,139 @@
+// Copyright (c) 2022, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package verrazzano
+
+import (
+	"github.com/verrazzano/verrazzano/pkg/log/vzlog"
+	installv1alpha1 "github.com/verrazzano/verrazzano/platform-operator/apis/verrazzano/v1alpha1"
+	ctrl "sigs.k8s.io/controller-runtime"
+)
+
+const (
+	// vzStateUninstallStart is the state where Verrazzano is starting the uninstall flow
+	vzStateUninstallStart VerrazzanoUninstallState = "vzUninstallStart"
+
+	// vzStateUninstallComponents is the state where the components are being uninstalled
+	vzStateUninstallComponents VerrazzanoUninstallState = "vzUninstallComponents"
+
+	// vzStateUninstallDone is the state when uninstall is done
+	vzStateUninstallDone VerrazzanoUninstallState = "vzUninstallDone"
+
+	// vzStateUninstallFailed is the state when the uninstall of a component failed too many times
+	vzStateUninstallFailed VerrazzanoUninstallState = "vzUninstallFailed"
+
+	// vzStateUninstallEnd is the terminal state
+	vzStateUninstallEnd VerrazzanoUninstallState = "vzUninstallEnd"
+)
+
+// VerrazzanoUninstallState identifies the state of a Verrazzano uninstall operation
+type VerrazzanoUninstallState string
+
+// uninstallTracker has the uninstall context for the Verrazzano uninstall
+// This tracker keeps an in-memory uninstall state for Verrazzano and the components that
+// are being uninstalled.  The tracker is checkpointed in a ConfigMap so that an uninstall
+// resumes where it left off when the operator restarts.
+type uninstallTracker struct {
+	vzState VerrazzanoUninstallState
+	gen     int64
+	compMap map[string]*componentUninstallContext
+	// failureMessage describes why the uninstall failed
+	failureMessage string
+	// checkpoint is the ConfigMap data of the last checkpoint of the tracker
+	checkpoint map[string]string
+}
+
+// uninstallTrackerMap has a map of uninstallTrackers, one entry per Verrazzano CR resource generation
+var uninstallTrackerMap = make(map[string]*uninstallTracker)
+
+// reconcileUninstall will uninstall a Verrazzano installation
+func (r *Reconciler) reconcileUninstall(log vzlog.VerrazzanoLogger, cr *installv1alpha1.Verrazzano) (ctrl.Result, error) {
+	log.Oncef("Uninstalling Verrazzano %s/%s", cr.Namespace, cr.Name)
+
+	// Resume from the checkpoint if the operator was restarted during the uninstall
+	if err := r.restoreUninstallTracker(log, cr); err != nil {
+		return newRequeueWithDelay(), err
+	}
+	tracker := getUninstallTracker(cr)
+	done := false
+	for !done {
+		// Save each state transition before doing the work of the new state, so that the work that is already
+		// done is not repeated after a restart
+		if err := r.checkpointUninstallTracker(log, cr, tracker); err != nil {
+			return newRequeueWithDelay(), err
+		}
+		switch tracker.vzState {
+		case vzStateUninstallStart:
+			// Only write the uninstall started message once
+			if !isLastCondition(cr.Status, installv1alpha1.CondUninstallStarted) {
+				err := r.updateStatus(log, cr, "Verrazzano uninstall in progress", installv1alpha1.CondUninstallStarted)
+				// Always requeue to get a fresh copy of status and avoid potential conflict
+				return newRequeueWithDelay(), err
+			}
+			tracker.vzState = vzStateUninstallComponents
+
+		case vzStateUninstallComponents:
+			log.Once("Uninstalling all Verrazzano components")
+			res, err := r.uninstallComponents(log, cr, tracker)
+			if err != nil || res.Requeue {
+				return res, err
+			}
+			if tracker.vzState == vzStateUninstallFailed {
+				continue
+			}
+			tracker.vzState = vzStateUninstallDone
+
+		case vzStateUninstallDone:
+			msg := "Verrazzano uninstall completed successfully"
+			log.Once(msg)
+			if err := r.updateStatus(log, cr, msg, installv1alpha1.CondUninstallComplete); err != nil {
+				return newRequeueWithDelay(), err
+			}
+			tracker.vzState = vzStateUninstallEnd
+
+		case vzStateUninstallFailed:
+			// The finalizer is removed after a failed uninstall, the same as after a successful one, so the
+			// Verrazzano resource does not stay in the deleting state forever
+			log.Oncef("Verrazzano uninstall failed: %s", tracker.failureMessage)
+			if err := r.updateStatus(log, cr, "Verrazzano uninstall failed: "+tracker.failureMessage, installv1alpha1.CondUninstallFailed); err != nil {
+				return newRequeueWithDelay(), err
+			}
+			tracker.vzState = vzStateUninstallEnd
+
+		case vzStateUninstallEnd:
+			done = true
+			// Uninstall completely done
+			if err := r.deleteUninstallTrackerCheckpoint(log, cr); err != nil {
+				return newRequeueWithDelay(), err
+			}
+			deleteUninstallTracker(cr)
+		}
+	}
+	// Uninstall done, no need to requeue
+	return ctrl.Result{}, nil
+}
+
+// getUninstallTracker gets the uninstall tracker for Verrazzano
+func getUninstallTracker(cr *installv1alpha1.Verrazzano) *uninstallTracker {
+	key := getNSNKey(cr)
+	vuc, ok := uninstallTrackerMap[key]
+	// If the entry is missing or the generation is different create a new entry
+	if !ok || vuc.gen != cr.Generation {
+		vuc = &uninstallTracker{
+			vzState: vzStateUninstallStart,
+			gen:     cr.Generation,
+			compMap: make(map[string]*componentUninstallContext),
+		}
+		uninstallTrackerMap[key] = vuc
+	}
+	return vuc
+}
+
+// deleteUninstallTracker deletes the uninstall tracker for the Verrazzano resource
+func deleteUninstallTracker(cr *installv1alpha1.Verrazzano) {
+	key := getNSNKey(cr)
+	_, ok := uninstallTrackerMap[key]
+	if ok {
+		delete(uninstallTrackerMap, key)
+	}
+}
@@ -0,0 +1,116 @@
+// Copyright (c) 2022, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package verrazzano
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strconv"
+
+	"github.com/verrazzano/verrazzano/pkg/log/vzlog"
+	installv1alpha1 "github.com/verrazzano/verrazzano/platform-operator/apis/verrazzano/v1alpha1"
+)
+
+const (
+	// uninstallTrackerConfigMapPrefix is the prefix of the name of the ConfigMap where the uninstall tracker is checkpointed
+	uninstallTrackerConfigMapPrefix = "verrazzano-uninstall-tracker-"
+
+	// trackerFailureMessageKey is the ConfigMap key for the message of a failed uninstall
+	trackerFailureMessageKey = "failureMessage"
+)
+
+// componentUninstallCheckpoint is the checkpoint of the uninstall context of a component
+type componentUninstallCheckpoint struct {
+	State    ComponentUninstallState `json:"state"`
+	Failures int                     `json:"failures,omitempty"`
+}
+
+// buildUninstallTrackerName returns the name of the uninstall tracker ConfigMap for the Verrazzano resource
+func buildUninstallTrackerName(cr *installv1alpha1.Verrazzano) string {
+	return uninstallTrackerConfigMapPrefix + cr.Name
+}
+
+// restoreUninstallTracker loads the uninstall tracker from its checkpoint ConfigMap when the tracker is not in memory,
+// for example after the operator restarted in the middle of an uninstall.  A checkpoint of another generation
+// of the Verrazzano resource is ignored.
+func (r *Reconciler) restoreUninstallTracker(log vzlog.VerrazzanoLogger, cr *installv1alpha1.Verrazzano) error {
+	key := getNSNKey(cr)
+	if tracker, ok := uninstallTrackerMap[key]; ok && tracker.gen == cr.Generation {
+		return nil
+	}
+
+	data, err := r.getTrackerCheckpoint(log, cr, buildUninstallTrackerName(cr))
+	if err != nil || data == nil {
+		return err
+	}
+
+	gen, err := strconv.ParseInt(data[trackerGenerationKey], 10, 64)
+	if err != nil || gen != cr.Generation {
+		log.Oncef("Ignoring uninstall tracker checkpoint for generation %s, the Verrazzano resource generation is %v",
+			data[trackerGenerationKey], cr.Generation)
+		return nil
+	}
+	compStates := make(map[string]componentUninstallCheckpoint)
+	if err := json.Unmarshal([]byte(data[trackerComponentStatesKey]), &compStates); err != nil {
+		return log.ErrorfNewErr("Failed parsing the component states in uninstall tracker ConfigMap %s/%s: %v",
+			cr.Namespace, buildUninstallTrackerName(cr), err)
+	}
+
+	tracker := &uninstallTracker{
+		vzState:        VerrazzanoUninstallState(data[trackerVzStateKey]),
+		gen:            gen,
+		compMap:        make(map[string]*componentUninstallContext),
+		failureMessage: data[trackerFailureMessageKey],
+		checkpoint:     data,
+	}
+	for compName, state := range compStates {
+		tracker.compMap[compName] = &componentUninstallContext{state: state.State, failures: state.Failures}
+	}
+	uninstallTrackerMap[key] = tracker
+	log.Infof("Resuming the Verrazzano uninstall at state %s", tracker.vzState)
+	return nil
+}
+
+// checkpointUninstallTracker saves the uninstall tracker in its ConfigMap if the tracker changed since the last checkpoint
+func (r *Reconciler) checkpointUninstallTracker(log vzlog.VerrazzanoLogger, cr *installv1alpha1.Verrazzano, tracker *uninstallTracker) error {
+	data, err := tracker.toConfigMapData()
+	if err != nil {
+		return log.ErrorfNewErr("Failed serializing the uninstall tracker: %v", err)
+	}
+	if reflect.DeepEqual(data, tracker.checkpoint) {
+		return nil
+	}
+	if err := r.saveTrackerCheckpoint(log, cr, buildUninstallTrackerName(cr), data); err != nil {
+		return err
+	}
+	tracker.checkpoint = data
+	return nil
+}
+
+// deleteUninstallTrackerCheckpoint deletes the uninstall tracker ConfigMap
+func (r *Reconciler) deleteUninstallTrackerCheckpoint(log vzlog.VerrazzanoLogger, cr *installv1alpha1.Verrazzano) error {
+	return r.deleteTrackerCheckpoint(log, cr, buildUninstallTrackerName(cr))
+}
+
+// toConfigMapData returns the uninstall tracker as ConfigMap data
+func (vuc *uninstallTracker) toConfigMapData() (map[string]string, error) {
+	compStates := make(map[string]componentUninstallCheckpoint, len(vuc.compMap))
+	for compName, context := range vuc.compMap {
+		compStates[compName] = componentUninstallCheckpoint{State: context.state, Failures: context.failures}
+	}
+	compJSON, err := json.Marshal(compStates)
+	if err != nil {
+		return nil, err
+	}
+	data := map[string]string{
+		trackerGenerationKey:      fmt.Sprintf("%v", vuc.gen),
+		trackerVzStateKey:         string(vuc.vzState),
+		trackerComponentStatesKey: string(compJSON),
+	}
+	if len(vuc.failureMessage) > 0 {
+		data[trackerFailureMessageKey] = vuc.failureMessage
+	}
+	return data, nil
+}
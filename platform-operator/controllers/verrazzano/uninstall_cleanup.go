@@ -0,0 +1,84 @@
+// Copyright (c) 2022, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package verrazzano
+
+import (
+	"context"
+
+	"github.com/verrazzano/verrazzano/pkg/log/vzlog"
+	installv1alpha1 "github.com/verrazzano/verrazzano/platform-operator/apis/verrazzano/v1alpha1"
+	vzconst "github.com/verrazzano/verrazzano/platform-operator/constants"
+	"github.com/verrazzano/verrazzano/platform-operator/controllers/verrazzano/component/common"
+	"github.com/verrazzano/verrazzano/platform-operator/controllers/verrazzano/component/spi"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// verrazzanoCRDsRegexp matches the Verrazzano CRDs deleted after the uninstall
+	verrazzanoCRDsRegexp = `verrazzano\.io$`
+
+	// verrazzanoClusterRolesRegexp matches the Verrazzano ClusterRoles and ClusterRoleBindings deleted after the uninstall
+	verrazzanoClusterRolesRegexp = `verrazzano`
+
+	// managedClusterLocalSecret is the local cluster secret that earlier versions of Verrazzano left in the default namespace
+	managedClusterLocalSecret = "verrazzano-managed-cluster-local"
+)
+
+// retainedCRDs are the Verrazzano CRDs that are kept after the uninstall, they belong to the platform operator
+var retainedCRDs = []string{
+	"verrazzanos.install.verrazzano.io",
+	"verrazzanoaddons.install.verrazzano.io",
+	"verrazzanobackups.install.verrazzano.io",
+	"verrazzanooperations.install.verrazzano.io",
+	"grafanadashboards.install.verrazzano.io",
+	"verrazzanomanagedclusters.clusters.verrazzano.io",
+}
+
+// retainedClusterRoles are the Verrazzano ClusterRoles and ClusterRoleBindings that are kept after the uninstall,
+// they belong to the platform operator
+var retainedClusterRoles = []string{
+	"verrazzano-platform-operator",
+	"verrazzano-install",
+	"verrazzano-managed-cluster",
+}
+
+// verrazzanoNamespaceLabels select the namespaces created by the Verrazzano install
+var verrazzanoNamespaceLabels = []client.MatchingLabels{
+	{"k8s-app": "verrazzano.io"},
+	{"verrazzano.io/namespace": "monitoring"},
+}
+
+// cleanupUninstalledResources deletes the Verrazzano resources that remain once all the components are uninstalled:
+// the Verrazzano CRDs, ClusterRoles and ClusterRoleBindings, the local managed cluster secret and the Verrazzano
+// namespaces.  This runs after every component is uninstalled so that no component loses its resources while
+// it is still running.
+func (r *Reconciler) cleanupUninstalledResources(log vzlog.VerrazzanoLogger, vz *installv1alpha1.Verrazzano) error {
+	ctx := spi.NewContextFromCR(log, r.Client, vz, false)
+
+	if err := common.DeleteCRDsMatching(ctx, verrazzanoCRDsRegexp, retainedCRDs...); err != nil {
+		return err
+	}
+	if err := common.DeleteClusterRolesMatching(ctx, verrazzanoClusterRolesRegexp, retainedClusterRoles...); err != nil {
+		return err
+	}
+	secret := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Namespace: vzconst.DefaultNamespace, Name: managedClusterLocalSecret}}
+	if err := common.DeleteObjects(ctx, secret); err != nil {
+		return err
+	}
+
+	for _, labels := range verrazzanoNamespaceLabels {
+		nsList := corev1.NamespaceList{}
+		if err := r.List(context.TODO(), &nsList, labels); err != nil {
+			return log.ErrorfNewErr("Failed listing the Verrazzano namespaces: %v", err)
+		}
+		for _, ns := range nsList.Items {
+			if err := common.DeleteNamespace(ctx, ns.Name); err != nil {
+				return err
+			}
+		}
+	}
+	return common.DeleteNamespace(ctx, vzconst.VerrazzanoMultiClusterNamespace)
+}
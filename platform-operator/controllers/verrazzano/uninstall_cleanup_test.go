@@ -0,0 +1,43 @@
+// Copyright (c) 2022, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package verrazzano
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/verrazzano/verrazzano/pkg/log/vzlog"
+	vzapi "github.com/verrazzano/verrazzano/platform-operator/apis/verrazzano/v1alpha1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	k8scheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+// TestCleanupUninstalledResourcesRetainsOperatorCRDs tests the cleanupUninstalledResources function
+// GIVEN the CRDs of the platform operator and a CRD of a component
+//  WHEN cleanupUninstalledResources is called
+//  THEN only the CRD of the component is deleted
+func TestCleanupUninstalledResourcesRetainsOperatorCRDs(t *testing.T) {
+	scheme := runtime.NewScheme()
+	_ = k8scheme.AddToScheme(scheme)
+	_ = apiextensionsv1.AddToScheme(scheme)
+	c := fake.NewClientBuilder().WithScheme(scheme).Build()
+	for _, name := range append(retainedCRDs, "ingresstraits.oam.verrazzano.io") {
+		assert.NoError(t, c.Create(context.TODO(), &apiextensionsv1.CustomResourceDefinition{ObjectMeta: metav1.ObjectMeta{Name: name}}))
+	}
+	r := newVerrazzanoReconciler(c)
+
+	assert.NoError(t, r.cleanupUninstalledResources(vzlog.DefaultLogger(), &vzapi.Verrazzano{}))
+
+	for _, name := range retainedCRDs {
+		assert.NoError(t, c.Get(context.TODO(), types.NamespacedName{Name: name}, &apiextensionsv1.CustomResourceDefinition{}), name)
+	}
+	err := c.Get(context.TODO(), types.NamespacedName{Name: "ingresstraits.oam.verrazzano.io"}, &apiextensionsv1.CustomResourceDefinition{})
+	assert.True(t, errors.IsNotFound(err))
+}
@@ -0,0 +1,206 @@
+// Copyright (c) 2022, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package verrazzano
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/verrazzano/verrazzano/pkg/log/vzlog"
+	installv1alpha1 "github.com/verrazzano/verrazzano/platform-operator/apis/verrazzano/v1alpha1"
+	vzconst "github.com/verrazzano/verrazzano/platform-operator/constants"
+	"github.com/verrazzano/verrazzano/platform-operator/controllers/verrazzano/component/registry"
+	"github.com/verrazzano/verrazzano/platform-operator/controllers/verrazzano/component/spi"
+	ctrl "sigs.k8s.io/controller-runtime"
+)
+
+// ComponentUninstallState identifies the state of a component during uninstall
+type ComponentUninstallState string
+
+const (
+	// compStateUninstallStart is the state when a component is starting the uninstall flow
+	compStateUninstallStart ComponentUninstallState = "UninstallStart"
+
+	// compStatePreUninstall is the state when a component does a pre-uninstall
+	compStatePreUninstall ComponentUninstallState = "PreUninstall"
+
+	// compStateUninstall is the state where a component does an uninstall
+	compStateUninstall ComponentUninstallState = "Uninstall"
+
+	// compStateWaitUninstalled is the state when a component is waiting for the uninstall to finish
+	compStateWaitUninstalled ComponentUninstallState = "WaitUninstalled"
+
+	// compStatePostUninstall is the state when a component is doing a post-uninstall
+	compStatePostUninstall ComponentUninstallState = "PostUninstall"
+
+	// compStateUninstallDone is the state when component uninstall is done
+	compStateUninstallDone ComponentUninstallState = "UninstallDone"
+
+	// compStateUninstallFailed is the terminal state when the component uninstall failed too many times
+	compStateUninstallFailed ComponentUninstallState = "UninstallFailed"
+
+	// compStateUninstallEnd is the terminal state
+	compStateUninstallEnd ComponentUninstallState = "UninstallEnd"
+)
+
+// maxUninstallFailures is the number of times an uninstall step of a component can fail before the uninstall fails
+const maxUninstallFailures = 10
+
+// componentUninstallContext has the uninstall context for a Verrazzano component uninstall
+type componentUninstallContext struct {
+	state ComponentUninstallState
+	// failures is the number of failed uninstall steps of the component
+	failures int
+	// failureMessage describes why the component uninstall failed
+	failureMessage string
+	// retries is the number of times the failed uninstall of a disabled component was retried
+	retries int
+	// retryTime is when the failed uninstall of a disabled component is retried
+	retryTime time.Time
+}
+
+// uninstallComponents will uninstall the components in reverse dependency order
+func (r *Reconciler) uninstallComponents(log vzlog.VerrazzanoLogger, cr *installv1alpha1.Verrazzano, tracker *uninstallTracker) (ctrl.Result, error) {
+	spiCtx, err := spi.NewContext(log, r.Client, cr, r.DryRun)
+	if err != nil {
+		return newRequeueWithDelay(), err
+	}
+
+	// Loop through all of the Verrazzano components and uninstall each one, dependents before their dependencies.
+	// Don't move to the next component until the current one has been successfully uninstalled
+	for _, comp := range registry.GetComponentsInUninstallOrder() {
+		uninstallContext := tracker.getComponentUninstallContext(comp.Name())
+		checkpoint := func() error {
+			return r.checkpointUninstallTracker(spiCtx.Log(), cr, tracker)
+		}
+		result, err := r.uninstallSingleComponent(spiCtx, uninstallContext, comp, checkpoint)
+		// Save the failure count of a failed step
+		if err := checkpoint(); err != nil {
+			return newRequeueWithDelay(), err
+		}
+		if err != nil || result.Requeue {
+			return result, err
+		}
+		if uninstallContext.state == compStateUninstallFailed {
+			tracker.failureMessage = uninstallContext.failureMessage
+			tracker.vzState = vzStateUninstallFailed
+			return ctrl.Result{}, nil
+		}
+	}
+	// All components have been uninstalled
+	return ctrl.Result{}, nil
+}
+
+// uninstallSingleComponent uninstalls a single component.  The optional checkpoint function saves the component
+// state transitions.  When a step of the uninstall fails too many times, the component state is set to Failed and
+// the uninstall context ends in the compStateUninstallFailed state.
+func (r *Reconciler) uninstallSingleComponent(spiCtx spi.ComponentContext, uninstallContext *componentUninstallContext, comp spi.Component, checkpoint func() error) (ctrl.Result, error) {
+	compName := comp.Name()
+	compContext := spiCtx.Init(compName).Operation(vzconst.UninstallOperation)
+	compLog := compContext.Log()
+
+	for uninstallContext.state != compStateUninstallEnd && uninstallContext.state != compStateUninstallFailed {
+		// Save the component state transition before doing the work of the new state
+		if checkpoint != nil {
+			if err := checkpoint(); err != nil {
+				return newRequeueWithDelay(), err
+			}
+		}
+		switch uninstallContext.state {
+		case compStateUninstallStart:
+			// Check if component is uninstalled, if so continue
+			uninstalled, err := comp.IsUninstalled(compContext)
+			if err != nil {
+				compLog.Errorf("Failed checking if component %s is uninstalled: %v", compName, err)
+				return newRequeueWithDelay(), err
+			}
+			if uninstalled {
+				compLog.Oncef("Component %s is not installed; uninstall being skipped", compName)
+				uninstallContext.state = compStateUninstallEnd
+				continue
+			}
+			compLog.Oncef("Component %s is installed and will be uninstalled", compName)
+			if err := r.updateComponentStatus(compContext, "Uninstall started", installv1alpha1.CondUninstallStarted); err != nil {
+				return ctrl.Result{Requeue: true}, err
+			}
+			uninstallContext.state = compStatePreUninstall
+
+		case compStatePreUninstall:
+			compLog.Oncef("Component %s pre-uninstall running", compName)
+			if err := comp.PreUninstall(compContext); err != nil {
+				r.recordComponentError(compContext, eventReasonPreUninstallFailed, err)
+				return r.handleUninstallFailure(compContext, uninstallContext, "pre-uninstalling", err)
+			}
+			uninstallContext.state = compStateUninstall
+
+		case compStateUninstall:
+			compLog.Progressf("Component %s uninstall running", compName)
+			if err := comp.Uninstall(compContext); err != nil {
+				r.recordComponentError(compContext, eventReasonUninstallFailed, err)
+				return r.handleUninstallFailure(compContext, uninstallContext, "uninstalling", err)
+			}
+			uninstallContext.state = compStateWaitUninstalled
+
+		case compStateWaitUninstalled:
+			uninstalled, err := comp.IsUninstalled(compContext)
+			if err != nil {
+				compLog.Errorf("Failed checking if component %s is uninstalled: %v", compName, err)
+				return newRequeueWithDelay(), err
+			}
+			if !uninstalled {
+				compLog.Progressf("Component %s uninstall is running. Waiting for the component to be removed", compName)
+				return newRequeueWithDelay(), nil
+			}
+			uninstallContext.state = compStatePostUninstall
+
+		case compStatePostUninstall:
+			compLog.Oncef("Component %s post-uninstall running", compName)
+			if err := comp.PostUninstall(compContext); err != nil {
+				r.recordComponentError(compContext, eventReasonPostUninstallFailed, err)
+				return r.handleUninstallFailure(compContext, uninstallContext, "post-uninstalling", err)
+			}
+			uninstallContext.state = compStateUninstallDone
+
+		case compStateUninstallDone:
+			compLog.Oncef("Component %s has successfully uninstalled", compName)
+			if err := r.updateComponentStatus(compContext, "Uninstall complete", installv1alpha1.CondUninstallComplete); err != nil {
+				return ctrl.Result{Requeue: true}, err
+			}
+			uninstallContext.state = compStateUninstallEnd
+		}
+	}
+	// Component has been uninstalled
+	return ctrl.Result{}, nil
+}
+
+// handleUninstallFailure counts a failed uninstall step of a component and retries the step, unless it failed too
+// many times.  In that case the component state is set to Failed and the uninstall of the component ends.
+func (r *Reconciler) handleUninstallFailure(compContext spi.ComponentContext, uninstallContext *componentUninstallContext, step string, err error) (ctrl.Result, error) {
+	compName := compContext.GetComponent()
+	uninstallContext.failures++
+	if uninstallContext.failures < maxUninstallFailures {
+		compContext.Log().Errorf("Failed %s component %s, will retry: %v", step, compName, err)
+		return newRequeueWithDelay(), nil
+	}
+	msg := fmt.Sprintf("Failed %s component %s %d times: %v", step, compName, uninstallContext.failures, err)
+	compContext.Log().Error(msg)
+	if err := r.updateComponentStatus(compContext, msg, installv1alpha1.CondUninstallFailed); err != nil {
+		return ctrl.Result{Requeue: true}, err
+	}
+	uninstallContext.failureMessage = msg
+	uninstallContext.state = compStateUninstallFailed
+	return ctrl.Result{}, nil
+}
+
+// getComponentUninstallContext gets the uninstall context for the component
+func (vuc *uninstallTracker) getComponentUninstallContext(compName string) *componentUninstallContext {
+	context, ok := vuc.compMap[compName]
+	if !ok {
+		context = &componentUninstallContext{
+			state: compStateUninstallStart,
+		}
+		vuc.compMap[compName] = context
+	}
+	return context
+}
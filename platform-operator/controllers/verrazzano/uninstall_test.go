@@ -0,0 +1,279 @@
+// Copyright (c) 2022, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package verrazzano
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/verrazzano/verrazzano/pkg/log/vzlog"
+	vzapi "github.com/verrazzano/verrazzano/platform-operator/apis/verrazzano/v1alpha1"
+	"github.com/verrazzano/verrazzano/platform-operator/controllers/verrazzano/component/helm"
+	"github.com/verrazzano/verrazzano/platform-operator/controllers/verrazzano/component/registry"
+	"github.com/verrazzano/verrazzano/platform-operator/controllers/verrazzano/component/spi"
+	"github.com/verrazzano/verrazzano/platform-operator/controllers/verrazzano/rbac"
+	"github.com/verrazzano/verrazzano/platform-operator/internal/config"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	k8scheme "k8s.io/client-go/kubernetes/scheme"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+// TestUninstallComponentsInReverseOrder tests the uninstall flow for the following use case
+// GIVEN a deleted Verrazzano resource with two installed components where one depends on the other
+// WHEN the resource is reconciled until the uninstall is done
+// THEN the dependent component is uninstalled first and the finalizer is removed
+func TestUninstallComponentsInReverseOrder(t *testing.T) {
+	initUnitTesing()
+	namespace := "verrazzano"
+	name := "test"
+	asserts := assert.New(t)
+
+	var uninstallOrder []string
+	uninstalled := map[string]bool{}
+	newUninstallableComponent := func(compName string, dependencies ...string) fakeComponent {
+		return fakeComponent{
+			HelmComponent: helm.HelmComponent{
+				ReleaseName:             compName,
+				SupportsOperatorInstall: true,
+				Dependencies:            dependencies,
+			},
+			uninstallFunc: func(ctx spi.ComponentContext) error {
+				uninstallOrder = append(uninstallOrder, compName)
+				uninstalled[compName] = true
+				return nil
+			},
+			isUninstalledFunc: func(ctx spi.ComponentContext) (bool, error) {
+				return uninstalled[compName], nil
+			},
+		}
+	}
+	registry.OverrideGetComponentsFn(func() []spi.Component {
+		return []spi.Component{
+			newUninstallableComponent("fake1"),
+			newUninstallableComponent("fake2", "fake1"),
+		}
+	})
+	defer registry.ResetGetComponentsFn()
+
+	c := newUninstallFakeClient(namespace, name)
+	config.TestProfilesDir = "../../manifests/profiles"
+	defer func() { config.TestProfilesDir = "" }()
+
+	// Create and make the request
+	request := newRequest(namespace, name)
+	reconciler := newVerrazzanoReconciler(c)
+	result, err := reconcileLoop(reconciler, request)
+
+	// Validate the results
+	asserts.NoError(err)
+	asserts.Equal(false, result.Requeue)
+	asserts.Equal(time.Duration(0), result.RequeueAfter)
+	asserts.Equal([]string{"fake2", "fake1"}, uninstallOrder)
+
+	// The finalizer was removed so the deleted resource is gone
+	verrazzano := vzapi.Verrazzano{}
+	err = c.Get(context.TODO(), types.NamespacedName{Namespace: namespace, Name: name}, &verrazzano)
+	asserts.True(k8serrors.IsNotFound(err))
+}
+
+// TestUninstallComponentFailure tests the uninstall flow for the following use case
+// GIVEN a deleted Verrazzano resource with an installed component
+// WHEN the component fails to uninstall a few times
+// THEN the component is left in the uninstalling state, the uninstall is retried and the finalizer is not removed
+func TestUninstallComponentFailure(t *testing.T) {
+	initUnitTesing()
+	namespace := "verrazzano"
+	name := "test"
+	asserts := assert.New(t)
+
+	registry.OverrideGetComponentsFn(func() []spi.Component {
+		return []spi.Component{
+			fakeComponent{
+				HelmComponent: helm.HelmComponent{
+					ReleaseName:             "fake1",
+					SupportsOperatorInstall: true,
+				},
+				uninstallFunc: func(ctx spi.ComponentContext) error {
+					return errors.New("uninstall error")
+				},
+			},
+		}
+	})
+	defer registry.ResetGetComponentsFn()
+
+	c := newUninstallFakeClient(namespace, name)
+	config.TestProfilesDir = "../../manifests/profiles"
+	defer func() { config.TestProfilesDir = "" }()
+	defer deleteUninstallTracker(&vzapi.Verrazzano{ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name}})
+
+	// Create and make the request a few times
+	request := newRequest(namespace, name)
+	reconciler := newVerrazzanoReconciler(c)
+	var result ctrl.Result
+	var err error
+	for i := 0; i < 5; i++ {
+		result, err = reconciler.Reconcile(nil, request)
+		asserts.NoError(err)
+	}
+
+	// Validate the results
+	asserts.Equal(true, result.Requeue)
+
+	verrazzano := vzapi.Verrazzano{}
+	err = c.Get(context.TODO(), types.NamespacedName{Namespace: namespace, Name: name}, &verrazzano)
+	asserts.NoError(err)
+	asserts.Contains(verrazzano.Finalizers, finalizerName)
+	asserts.Equal(vzapi.VzStateUninstalling, verrazzano.Status.State)
+	asserts.Equal(vzapi.CompStateUninstalling, verrazzano.Status.Components["fake1"].State)
+}
+
+// TestUninstallComponentFailedTooManyTimes tests the reconcileUninstall method for the following use case
+// GIVEN a deleted Verrazzano resource with an installed component
+// WHEN the component fails to uninstall more than the maximum number of times
+// THEN the component state is Failed, the uninstall failed condition is set and the tracker checkpoint is deleted
+func TestUninstallComponentFailedTooManyTimes(t *testing.T) {
+	initUnitTesing()
+	asserts := assert.New(t)
+	vz := newUninstallTestVerrazzano()
+	defer deleteUninstallTracker(vz)
+
+	attempts := 0
+	registry.OverrideGetComponentsFn(func() []spi.Component {
+		return []spi.Component{
+			fakeComponent{
+				HelmComponent: helm.HelmComponent{ReleaseName: "fake1"},
+				uninstallFunc: func(ctx spi.ComponentContext) error {
+					attempts++
+					return errors.New("uninstall error")
+				},
+			},
+		}
+	})
+	defer registry.ResetGetComponentsFn()
+	config.TestProfilesDir = "../../manifests/profiles"
+	defer func() { config.TestProfilesDir = "" }()
+
+	c := fake.NewClientBuilder().WithScheme(k8scheme.Scheme).WithObjects(vz).Build()
+	reconciler := newVerrazzanoReconciler(c)
+	var result ctrl.Result
+	var err error
+	for i := 0; i < maxUninstallFailures+5; i++ {
+		result, err = reconciler.reconcileUninstall(vzlog.DefaultLogger(), vz)
+		asserts.NoError(err)
+		if !result.Requeue {
+			break
+		}
+	}
+	asserts.False(result.Requeue)
+	asserts.Equal(maxUninstallFailures, attempts)
+	asserts.Equal(vzapi.CompStateFailed, vz.Status.Components["fake1"].State)
+	asserts.True(isLastCondition(vz.Status, vzapi.CondUninstallFailed))
+	asserts.True(isUninstallDone(vz.Status))
+
+	err = c.Get(context.TODO(), types.NamespacedName{Namespace: vz.Namespace, Name: buildUninstallTrackerName(vz)}, &corev1.ConfigMap{})
+	asserts.True(k8serrors.IsNotFound(err))
+}
+
+// TestUninstallResumesFromCheckpoint tests the reconcileUninstall method for the following use case
+// GIVEN an uninstall tracker checkpoint where the first component is already uninstalled
+// WHEN the uninstall is reconciled without an in-memory uninstall tracker, as after an operator restart
+// THEN the uninstall of the first component is not run again and the failure count of the second one is kept
+func TestUninstallResumesFromCheckpoint(t *testing.T) {
+	initUnitTesing()
+	asserts := assert.New(t)
+	vz := newUninstallTestVerrazzano()
+	defer deleteUninstallTracker(vz)
+
+	var uninstalled []string
+	newFakeComponent := func(compName string) fakeComponent {
+		return fakeComponent{
+			HelmComponent: helm.HelmComponent{ReleaseName: compName},
+			uninstallFunc: func(ctx spi.ComponentContext) error {
+				uninstalled = append(uninstalled, compName)
+				return errors.New("uninstall error")
+			},
+		}
+	}
+	registry.OverrideGetComponentsFn(func() []spi.Component {
+		return []spi.Component{newFakeComponent("fake1"), newFakeComponent("fake2")}
+	})
+	defer registry.ResetGetComponentsFn()
+	config.TestProfilesDir = "../../manifests/profiles"
+	defer func() { config.TestProfilesDir = "" }()
+
+	c := fake.NewClientBuilder().WithScheme(k8scheme.Scheme).WithObjects(
+		vz,
+		&corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Namespace: vz.Namespace, Name: buildUninstallTrackerName(vz)},
+			Data: map[string]string{
+				trackerGenerationKey:      "1",
+				trackerVzStateKey:         string(vzStateUninstallComponents),
+				trackerComponentStatesKey: `{"fake2": {"state": "UninstallEnd"}, "fake1": {"state": "Uninstall", "failures": 3}}`,
+			},
+		},
+	).Build()
+	reconciler := newVerrazzanoReconciler(c)
+	result, err := reconciler.reconcileUninstall(vzlog.DefaultLogger(), vz)
+	asserts.NoError(err)
+	asserts.True(result.Requeue)
+	asserts.Equal([]string{"fake1"}, uninstalled)
+
+	cm := corev1.ConfigMap{}
+	err = c.Get(context.TODO(), types.NamespacedName{Namespace: vz.Namespace, Name: buildUninstallTrackerName(vz)}, &cm)
+	asserts.NoError(err)
+	asserts.JSONEq(`{"fake2": {"state": "UninstallEnd"}, "fake1": {"state": "Uninstall", "failures": 4}}`, cm.Data[trackerComponentStatesKey])
+}
+
+// newUninstallTestVerrazzano returns a Verrazzano resource that is being uninstalled
+func newUninstallTestVerrazzano() *vzapi.Verrazzano {
+	_ = vzapi.AddToScheme(k8scheme.Scheme)
+	om := createObjectMeta("verrazzano", "test", []string{finalizerName})
+	om.Generation = 1
+	return &vzapi.Verrazzano{
+		ObjectMeta: om,
+		Status: vzapi.VerrazzanoStatus{
+			State:      vzapi.VzStateUninstalling,
+			Components: makeVerrazzanoComponentStatusMap(),
+			Conditions: []vzapi.Condition{
+				{
+					Type: vzapi.CondUninstallStarted,
+				},
+			},
+		},
+	}
+}
+
+// newUninstallFakeClient returns a fake client with a deleted Verrazzano resource and its install resources
+func newUninstallFakeClient(namespace string, name string) client.Client {
+	var verrazzanoToUse vzapi.Verrazzano
+	_ = vzapi.AddToScheme(k8scheme.Scheme)
+	return fake.NewClientBuilder().WithScheme(k8scheme.Scheme).WithObjects(
+		&vzapi.Verrazzano{
+			ObjectMeta: func() metav1.ObjectMeta {
+				om := createObjectMeta(namespace, name, []string{finalizerName})
+				om.DeletionTimestamp = &metav1.Time{Time: time.Now()}
+				return om
+			}(),
+			Status: vzapi.VerrazzanoStatus{
+				State: vzapi.VzStateReady,
+				Conditions: []vzapi.Condition{
+					{
+						Type: vzapi.CondInstallComplete,
+					},
+				},
+				Components: makeVerrazzanoComponentStatusMap(),
+			},
+		},
+		rbac.NewServiceAccount(namespace, name, []string{}, nil),
+		rbac.NewClusterRoleBinding(&verrazzanoToUse, name, getInstallNamespace(), buildServiceAccountName(name)),
+	).Build()
+}
@@ -33,6 +33,9 @@ const (
 	// vzStateStart is the state where Verrazzano is starting the upgrade flow
 	vzStateStart VerrazzanoUpgradeState = "vzStart"
 
+	// vzStatePreflightChecks is the state where the upgrade preflight checks of the components are run
+	vzStatePreflightChecks VerrazzanoUpgradeState = "vzPreflightChecks"
+
 	// vzStateUpgradeComponents is the state where the components are being upgraded
 	vzStateUpgradeComponents VerrazzanoUpgradeState = "vzUpgradeComponents"
 
@@ -57,11 +60,14 @@ type VerrazzanoUpgradeState string
 
 // upgradeTracker has the upgrade context for the Verrazzano upgrade
 // This tracker keeps an in-memory upgrade state for Verrazzano and the components that
-// are being upgrade.
+// are being upgrade.  The tracker is checkpointed in a ConfigMap so that the upgrade can
+// resume where it left off if the operator is restarted.
 type upgradeTracker struct {
 	vzState VerrazzanoUpgradeState
 	gen     int64
 	compMap map[string]*componentUpgradeContext
+	// checkpoint is the tracker data that was last saved in the ConfigMap
+	checkpoint map[string]string
 }
 
 // upgradeTrackerMap has a map of upgradeTrackers, one entry per Verrazzano CR resource generation
@@ -74,9 +80,18 @@ func (r *Reconciler) reconcileUpgrade(log vzlog.VerrazzanoLogger, cr *installv1a
 	// Upgrade version was validated in webhook, see ValidateVersion
 	targetVersion := cr.Spec.Version
 
+	// Resume from the checkpoint if the operator was restarted during the upgrade
+	if err := r.restoreUpgradeTracker(log, cr); err != nil {
+		return newRequeueWithDelay(), err
+	}
 	tracker := getUpgradeTracker(cr)
 	done := false
 	for !done {
+		// Save each state transition before doing the work of the new state, so that the work that is already
+		// done is not repeated after a restart
+		if err := r.checkpointUpgradeTracker(log, cr, tracker); err != nil {
+			return newRequeueWithDelay(), err
+		}
 		switch tracker.vzState {
 		case vzStateStart:
 			// Only write the upgrade started message once
@@ -86,6 +101,30 @@ func (r *Reconciler) reconcileUpgrade(log vzlog.VerrazzanoLogger, cr *installv1a
 				// Always requeue to get a fresh copy of status and avoid potential conflict
 				return newRequeueWithDelay(), err
 			}
+			tracker.vzState = vzStatePreflightChecks
+
+		case vzStatePreflightChecks:
+			log.Once("Running the Verrazzano upgrade preflight checks")
+			spiCtx, err := spi.NewContext(log, r.Client, cr, r.DryRun)
+			if err != nil {
+				return newRequeueWithDelay(), err
+			}
+			if failures := registry.RunPreflightChecks(spiCtx); len(failures) > 0 {
+				// The upgrade is blocked until the failures are fixed and the upgrade is retried using the
+				// upgrade retry annotation
+				msg := fmt.Sprintf("Verrazzano upgrade to version %s blocked by failed preflight checks: %s",
+					cr.Spec.Version, registry.FormatPreflightFailures(failures))
+				log.Error(msg)
+				if err := r.updateStatus(log, cr, msg, installv1alpha1.CondPreflightFailed); err != nil {
+					return newRequeueWithDelay(), err
+				}
+				// Delete the tracker so that a retry of the upgrade runs the preflight checks again
+				if err := r.deleteUpgradeTrackerCheckpoint(log, cr); err != nil {
+					return newRequeueWithDelay(), err
+				}
+				deleteUpgradeTracker(cr)
+				return newRequeueWithDelay(), nil
+			}
 			tracker.vzState = vzStateUpgradeComponents
 
 		case vzStateUpgradeComponents:
@@ -164,6 +203,9 @@ func (r *Reconciler) reconcileUpgrade(log vzlog.VerrazzanoLogger, cr *installv1a
 		case vzStateEnd:
 			done = true
 			// Upgrade completely done
+			if err := r.deleteUpgradeTrackerCheckpoint(log, cr); err != nil {
+				return newRequeueWithDelay(), err
+			}
 			deleteUpgradeTracker(cr)
 		}
 	}
@@ -0,0 +1,141 @@
+// Copyright (c) 2022, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package verrazzano
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strconv"
+	"time"
+
+	"github.com/verrazzano/verrazzano/pkg/log/vzlog"
+	installv1alpha1 "github.com/verrazzano/verrazzano/platform-operator/apis/verrazzano/v1alpha1"
+)
+
+const (
+	// upgradeTrackerConfigMapPrefix is the prefix of the name of the ConfigMap where the upgrade tracker is checkpointed
+	upgradeTrackerConfigMapPrefix = "verrazzano-upgrade-tracker-"
+)
+
+// componentUpgradeCheckpoint is the checkpoint of the upgrade context of a component, it includes the retry and
+// timeout accounting so that a restart of the operator does not reset the rollback decisions
+type componentUpgradeCheckpoint struct {
+	State            ComponentUpgradeState `json:"state"`
+	UpgradeFailures  int                   `json:"upgradeFailures,omitempty"`
+	UpgradeStart     *time.Time            `json:"upgradeStart,omitempty"`
+	WaitReadyStart   *time.Time            `json:"waitReadyStart,omitempty"`
+	RollbackReason   string                `json:"rollbackReason,omitempty"`
+	RollbackFailures int                   `json:"rollbackFailures,omitempty"`
+}
+
+// buildUpgradeTrackerName returns the name of the upgrade tracker ConfigMap for the Verrazzano resource
+func buildUpgradeTrackerName(cr *installv1alpha1.Verrazzano) string {
+	return upgradeTrackerConfigMapPrefix + cr.Name
+}
+
+// restoreUpgradeTracker loads the upgrade tracker from its checkpoint ConfigMap when the tracker is not in memory,
+// for example after the operator restarted in the middle of an upgrade.  A checkpoint of an older generation
+// of the Verrazzano resource is ignored, so that upgrade starts over.
+func (r *Reconciler) restoreUpgradeTracker(log vzlog.VerrazzanoLogger, cr *installv1alpha1.Verrazzano) error {
+	key := getNSNKey(cr)
+	if tracker, ok := upgradeTrackerMap[key]; ok && tracker.gen == cr.Generation {
+		return nil
+	}
+
+	data, err := r.getTrackerCheckpoint(log, cr, buildUpgradeTrackerName(cr))
+	if err != nil || data == nil {
+		return err
+	}
+
+	gen, err := strconv.ParseInt(data[trackerGenerationKey], 10, 64)
+	if err != nil || gen != cr.Generation {
+		log.Oncef("Ignoring upgrade tracker checkpoint for generation %s, the Verrazzano resource generation is %v",
+			data[trackerGenerationKey], cr.Generation)
+		return nil
+	}
+	compStates := make(map[string]componentUpgradeCheckpoint)
+	if err := json.Unmarshal([]byte(data[trackerComponentStatesKey]), &compStates); err != nil {
+		return log.ErrorfNewErr("Failed parsing the component states in upgrade tracker ConfigMap %s/%s: %v",
+			cr.Namespace, buildUpgradeTrackerName(cr), err)
+	}
+
+	tracker := &upgradeTracker{
+		vzState:    VerrazzanoUpgradeState(data[trackerVzStateKey]),
+		gen:        gen,
+		compMap:    make(map[string]*componentUpgradeContext),
+		checkpoint: data,
+	}
+	for compName, compState := range compStates {
+		context := &componentUpgradeContext{
+			state:            compState.State,
+			upgradeFailures:  compState.UpgradeFailures,
+			rollbackReason:   compState.RollbackReason,
+			rollbackFailures: compState.RollbackFailures,
+		}
+		if compState.UpgradeStart != nil {
+			context.upgradeStart = *compState.UpgradeStart
+		}
+		if compState.WaitReadyStart != nil {
+			context.waitReadyStart = *compState.WaitReadyStart
+		}
+		tracker.compMap[compName] = context
+	}
+	upgradeTrackerMap[key] = tracker
+	log.Infof("Resuming the Verrazzano upgrade of generation %v at state %s", gen, tracker.vzState)
+	return nil
+}
+
+// checkpointUpgradeTracker saves the upgrade tracker in its ConfigMap if the tracker changed since the last checkpoint
+func (r *Reconciler) checkpointUpgradeTracker(log vzlog.VerrazzanoLogger, cr *installv1alpha1.Verrazzano, tracker *upgradeTracker) error {
+	data, err := tracker.toConfigMapData()
+	if err != nil {
+		return log.ErrorfNewErr("Failed serializing the upgrade tracker: %v", err)
+	}
+	if reflect.DeepEqual(data, tracker.checkpoint) {
+		return nil
+	}
+
+	if err := r.saveTrackerCheckpoint(log, cr, buildUpgradeTrackerName(cr), data); err != nil {
+		return err
+	}
+	tracker.checkpoint = data
+	return nil
+}
+
+// deleteUpgradeTrackerCheckpoint deletes the upgrade tracker ConfigMap
+func (r *Reconciler) deleteUpgradeTrackerCheckpoint(log vzlog.VerrazzanoLogger, cr *installv1alpha1.Verrazzano) error {
+	return r.deleteTrackerCheckpoint(log, cr, buildUpgradeTrackerName(cr))
+}
+
+// toConfigMapData returns the upgrade tracker as ConfigMap data
+func (vuc *upgradeTracker) toConfigMapData() (map[string]string, error) {
+	compStates := make(map[string]componentUpgradeCheckpoint, len(vuc.compMap))
+	for compName, context := range vuc.compMap {
+		compState := componentUpgradeCheckpoint{
+			State:            context.state,
+			UpgradeFailures:  context.upgradeFailures,
+			RollbackReason:   context.rollbackReason,
+			RollbackFailures: context.rollbackFailures,
+		}
+		if !context.upgradeStart.IsZero() {
+			upgradeStart := context.upgradeStart
+			compState.UpgradeStart = &upgradeStart
+		}
+		if !context.waitReadyStart.IsZero() {
+			waitReadyStart := context.waitReadyStart
+			compState.WaitReadyStart = &waitReadyStart
+		}
+		compStates[compName] = compState
+	}
+	compJSON, err := json.Marshal(compStates)
+	if err != nil {
+		return nil, err
+	}
+	return map[string]string{
+		trackerGenerationKey:      fmt.Sprintf("%v", vuc.gen),
+		trackerVzStateKey:         string(vuc.vzState),
+		trackerComponentStatesKey: string(compJSON),
+	}, nil
+}
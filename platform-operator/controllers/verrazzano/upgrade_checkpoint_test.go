@@ -0,0 +1,204 @@
+// Copyright (c) 2022, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package verrazzano
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	oamcore "github.com/crossplane/oam-kubernetes-runtime/apis/core"
+	"github.com/stretchr/testify/assert"
+	"github.com/verrazzano/verrazzano/pkg/k8sutil"
+	"github.com/verrazzano/verrazzano/pkg/log/vzlog"
+	vzapi "github.com/verrazzano/verrazzano/platform-operator/apis/verrazzano/v1alpha1"
+	"github.com/verrazzano/verrazzano/platform-operator/controllers/verrazzano/component/helm"
+	"github.com/verrazzano/verrazzano/platform-operator/controllers/verrazzano/component/registry"
+	"github.com/verrazzano/verrazzano/platform-operator/controllers/verrazzano/component/spi"
+	"github.com/verrazzano/verrazzano/platform-operator/internal/config"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	k8scheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+// TestUpgradeTrackerCheckpoint tests the reconcileUpgrade method for the following use case
+// GIVEN an upgrade where the component upgrade fails
+// WHEN the upgrade is reconciled
+// THEN the Verrazzano and component upgrade states are checkpointed in the upgrade tracker ConfigMap
+func TestUpgradeTrackerCheckpoint(t *testing.T) {
+	initUnitTesing()
+	asserts := assert.New(t)
+	vz := newCheckpointTestVerrazzano(1)
+	defer deleteUpgradeTracker(vz)
+
+	config.TestProfilesDir = "../../manifests/profiles"
+	defer func() { config.TestProfilesDir = "" }()
+
+	registry.OverrideGetComponentsFn(func() []spi.Component {
+		return []spi.Component{
+			fakeComponent{
+				HelmComponent: helm.HelmComponent{ReleaseName: "fake1"},
+				upgradeFunc: func(ctx spi.ComponentContext) error {
+					return fmt.Errorf("Error running upgrade")
+				},
+			},
+		}
+	})
+	defer registry.ResetGetComponentsFn()
+
+	c := fake.NewClientBuilder().WithScheme(k8scheme.Scheme).WithObjects(vz).Build()
+	reconciler := newVerrazzanoReconciler(c)
+	result, err := reconciler.reconcileUpgrade(vzlog.DefaultLogger(), vz)
+	asserts.NoError(err)
+	asserts.True(result.Requeue)
+
+	cm := corev1.ConfigMap{}
+	err = c.Get(context.TODO(), types.NamespacedName{Namespace: vz.Namespace, Name: buildUpgradeTrackerName(vz)}, &cm)
+	asserts.NoError(err)
+	asserts.Equal("1", cm.Data[trackerGenerationKey])
+	asserts.Equal(string(vzStateUpgradeComponents), cm.Data[trackerVzStateKey])
+	compStates := map[string]componentUpgradeCheckpoint{}
+	asserts.NoError(json.Unmarshal([]byte(cm.Data[trackerComponentStatesKey]), &compStates))
+	asserts.Equal(compStateUpgrade, compStates["fake1"].State)
+	asserts.Equal(1, compStates["fake1"].UpgradeFailures)
+	asserts.NotNil(compStates["fake1"].UpgradeStart)
+	asserts.Equal(vz.Name, cm.OwnerReferences[0].Name)
+}
+
+// TestUpgradeResumesFromCheckpoint tests the reconcileUpgrade method for the following use case
+// GIVEN an upgrade tracker checkpoint where the component upgrade is already done
+// WHEN the upgrade is reconciled without an in-memory upgrade tracker, as after an operator restart
+// THEN the component upgrade is not run again, the upgrade completes and the checkpoint is deleted
+func TestUpgradeResumesFromCheckpoint(t *testing.T) {
+	initUnitTesing()
+	asserts := assert.New(t)
+	vz := newCheckpointTestVerrazzano(2)
+	defer deleteUpgradeTracker(vz)
+
+	config.SetDefaultBomFilePath(unitTestBomFile)
+	defer config.SetDefaultBomFilePath("")
+	config.TestProfilesDir = "../../manifests/profiles"
+	defer func() { config.TestProfilesDir = "" }()
+
+	// Setup fake client to provide workloads for restart platform testing
+	goClient, err := initFakeClient()
+	asserts.NoError(err)
+	k8sutil.SetFakeClient(goClient)
+
+	upgraded := false
+	registry.OverrideGetComponentsFn(func() []spi.Component {
+		return []spi.Component{
+			fakeComponent{
+				HelmComponent: helm.HelmComponent{ReleaseName: "fake1"},
+				upgradeFunc: func(ctx spi.ComponentContext) error {
+					upgraded = true
+					return nil
+				},
+			},
+		}
+	})
+	defer registry.ResetGetComponentsFn()
+
+	_ = oamcore.AddToScheme(k8scheme.Scheme)
+	c := fake.NewClientBuilder().WithScheme(k8scheme.Scheme).WithObjects(
+		vz,
+		newCheckpointConfigMap(vz, "2", vzStateUpgradeComponents, `{"fake1": {"state": "UpgradeDone"}}`),
+	).Build()
+	reconciler := newVerrazzanoReconciler(c)
+	result, err := reconcileUpgradeLoop(reconciler, vz)
+	asserts.NoError(err)
+	asserts.False(result.Requeue)
+	asserts.False(upgraded)
+	asserts.Equal(vz.Spec.Version, vz.Status.Version)
+
+	err = c.Get(context.TODO(), types.NamespacedName{Namespace: vz.Namespace, Name: buildUpgradeTrackerName(vz)}, &corev1.ConfigMap{})
+	asserts.True(k8serrors.IsNotFound(err))
+}
+
+// TestRestoreUpgradeTrackerOldGeneration tests the restoreUpgradeTracker method for the following use case
+// GIVEN an upgrade tracker checkpoint for an older generation of the Verrazzano resource
+// WHEN the upgrade tracker is restored
+// THEN the checkpoint is ignored and the upgrade starts from the beginning
+func TestRestoreUpgradeTrackerOldGeneration(t *testing.T) {
+	asserts := assert.New(t)
+	vz := newCheckpointTestVerrazzano(3)
+	defer deleteUpgradeTracker(vz)
+
+	c := fake.NewClientBuilder().WithScheme(k8scheme.Scheme).WithObjects(
+		newCheckpointConfigMap(vz, "2", vzStateRestartApps, `{"fake1": {"state": "UpgradeDone"}}`),
+	).Build()
+	reconciler := newVerrazzanoReconciler(c)
+	asserts.NoError(reconciler.restoreUpgradeTracker(vzlog.DefaultLogger(), vz))
+
+	tracker := getUpgradeTracker(vz)
+	asserts.Equal(vzStateStart, tracker.vzState)
+	asserts.Equal(int64(3), tracker.gen)
+	asserts.Empty(tracker.compMap)
+}
+
+// TestRestoreUpgradeTrackerAccounting tests the restoreUpgradeTracker method for the following use case
+// GIVEN an upgrade tracker checkpoint with failed upgrade attempts and started timers
+// WHEN the upgrade tracker is restored
+// THEN the failure count and the start times are restored, they are not reset by the operator restart
+func TestRestoreUpgradeTrackerAccounting(t *testing.T) {
+	asserts := assert.New(t)
+	vz := newCheckpointTestVerrazzano(4)
+	defer deleteUpgradeTracker(vz)
+
+	c := fake.NewClientBuilder().WithScheme(k8scheme.Scheme).WithObjects(
+		newCheckpointConfigMap(vz, "4", vzStateUpgradeComponents,
+			`{"fake1": {"state": "WaitReady", "upgradeFailures": 2, "upgradeStart": "2022-06-01T10:00:00Z", "waitReadyStart": "2022-06-01T10:05:00Z"}}`),
+	).Build()
+	reconciler := newVerrazzanoReconciler(c)
+	asserts.NoError(reconciler.restoreUpgradeTracker(vzlog.DefaultLogger(), vz))
+
+	context := getUpgradeTracker(vz).compMap["fake1"]
+	asserts.Equal(compStateWaitReady, context.state)
+	asserts.Equal(2, context.upgradeFailures)
+	asserts.Equal(time.Date(2022, 6, 1, 10, 0, 0, 0, time.UTC), context.upgradeStart.UTC())
+	asserts.Equal(time.Date(2022, 6, 1, 10, 5, 0, 0, time.UTC), context.waitReadyStart.UTC())
+}
+
+// newCheckpointTestVerrazzano returns a Verrazzano resource that is being upgraded
+func newCheckpointTestVerrazzano(generation int64) *vzapi.Verrazzano {
+	_ = vzapi.AddToScheme(k8scheme.Scheme)
+	om := createObjectMeta("verrazzano", "test", []string{finalizerName})
+	om.Generation = generation
+	return &vzapi.Verrazzano{
+		ObjectMeta: om,
+		Spec: vzapi.VerrazzanoSpec{
+			Version: "1.2.0",
+		},
+		Status: vzapi.VerrazzanoStatus{
+			State:      vzapi.VzStateUpgrading,
+			Components: makeVerrazzanoComponentStatusMap(),
+			Conditions: []vzapi.Condition{
+				{
+					Type: vzapi.CondUpgradeStarted,
+				},
+			},
+		},
+	}
+}
+
+// newCheckpointConfigMap returns an upgrade tracker ConfigMap with the checkpointed states
+func newCheckpointConfigMap(vz *vzapi.Verrazzano, generation string, vzState VerrazzanoUpgradeState, compStates string) client.Object {
+	return &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: vz.Namespace,
+			Name:      buildUpgradeTrackerName(vz),
+		},
+		Data: map[string]string{
+			trackerGenerationKey:      generation,
+			trackerVzStateKey:         string(vzState),
+			trackerComponentStatesKey: compStates,
+		},
+	}
+}
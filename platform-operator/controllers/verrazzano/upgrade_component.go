@@ -4,6 +4,7 @@
 package verrazzano
 
 import (
+	"fmt"
 	"time"
 
 	"github.com/verrazzano/verrazzano/pkg/controller"
@@ -37,13 +38,40 @@ const (
 	// compStateUpgradeDone is the state when component upgrade is done
 	compStateUpgradeDone ComponentUpgradeState = "UpgradeDone"
 
+	// compStateRollback is the state when a component that failed to upgrade is being rolled back
+	compStateRollback ComponentUpgradeState = "Rollback"
+
+	// compStateRollbackDone is the state when the component rollback is done and the upgrade is marked as failed
+	compStateRollbackDone ComponentUpgradeState = "RollbackDone"
+
 	// compStateEnd is the terminal state
 	compStateEnd ComponentUpgradeState = "End"
 )
 
+const (
+	// defaultMaxUpgradeAttempts is the default number of failed upgrade attempts before a component is rolled back
+	defaultMaxUpgradeAttempts = 3
+
+	// defaultRollbackReadyTimeout is the default time to wait for an upgraded component to be ready before it is rolled back
+	defaultRollbackReadyTimeout = 15 * time.Minute
+
+	// maxRollbackAttempts is the number of failed rollback attempts before the component and the upgrade are failed
+	maxRollbackAttempts = 3
+)
+
 // componentUpgradeContext has the upgrade context for a Verrazzano component upgrade
 type componentUpgradeContext struct {
 	state ComponentUpgradeState
+	// upgradeFailures is the number of failed upgrade attempts
+	upgradeFailures int
+	// upgradeStart is when the component upgrade started
+	upgradeStart time.Time
+	// waitReadyStart is when the component started waiting to be ready after the upgrade
+	waitReadyStart time.Time
+	// rollbackReason is the reason the component is being rolled back
+	rollbackReason string
+	// rollbackFailures is the number of failed rollback attempts
+	rollbackFailures int
 }
 
 // upgradeComponents will upgrade the components as required
@@ -57,7 +85,7 @@ func (r *Reconciler) upgradeComponents(log vzlog.VerrazzanoLogger, cr *installv1
 	// Don't move to the next component until the current one has been succcessfully upgraded
 	for _, comp := range registry.GetComponents() {
 		upgradeContext := tracker.getComponentUpgradeContext(comp.Name())
-		result, err := r.upgradeSingleComponent(spiCtx, upgradeContext, comp)
+		result, err := r.upgradeSingleComponent(spiCtx, tracker, upgradeContext, comp)
 		if err != nil || result.Requeue {
 			return result, err
 		}
@@ -68,12 +96,28 @@ func (r *Reconciler) upgradeComponents(log vzlog.VerrazzanoLogger, cr *installv1
 }
 
 // upgradeSingleComponent upgrades a single component
-func (r *Reconciler) upgradeSingleComponent(spiCtx spi.ComponentContext, upgradeContext *componentUpgradeContext, comp spi.Component) (ctrl.Result, error) {
+func (r *Reconciler) upgradeSingleComponent(spiCtx spi.ComponentContext, tracker *upgradeTracker, upgradeContext *componentUpgradeContext, comp spi.Component) (ctrl.Result, error) {
 	compName := comp.Name()
 	compContext := spiCtx.Init(compName).Operation(vzconst.UpgradeOperation)
 	compLog := compContext.Log()
 
 	for upgradeContext.state != compStateEnd {
+		timedOut, timeout := isUpgradeTimedOut(spiCtx.ActualCR(), comp, upgradeContext)
+		// Save the component state transition and the upgrade start time before doing the work of the new state
+		if err := r.checkpointUpgradeTracker(compLog, spiCtx.ActualCR(), tracker); err != nil {
+			return newRequeueWithDelay(), err
+		}
+		if timedOut {
+			msg := buildComponentTimeoutMessage(compContext, comp, vzconst.UpgradeOperation, timeout)
+			if canRollback(spiCtx.ActualCR(), comp) {
+				compLog.Errorf("%s, it will be rolled back", msg)
+				upgradeContext.rollbackReason = fmt.Sprintf("upgrade did not complete within %v", timeout)
+				upgradeContext.state = compStateRollback
+				continue
+			}
+			compLog.Error(msg)
+			return r.failComponentUpgrade(compContext, msg)
+		}
 		switch upgradeContext.state {
 		case compStateInit:
 			// Check if component is installed, if not continue
@@ -93,6 +137,7 @@ func (r *Reconciler) upgradeSingleComponent(spiCtx spi.ComponentContext, upgrade
 		case compStatePreUpgrade:
 			compLog.Oncef("Component %s pre-upgrade running", compName)
 			if err := comp.PreUpgrade(compContext); err != nil {
+				r.recordComponentError(compContext, eventReasonPreUpgradeFailed, err)
 				compLog.Errorf("Failed pre-upgrading component %s: %v", compName, err)
 				return ctrl.Result{}, err
 			}
@@ -101,7 +146,19 @@ func (r *Reconciler) upgradeSingleComponent(spiCtx spi.ComponentContext, upgrade
 		case compStateUpgrade:
 			compLog.Progressf("Component %s upgrade running", compName)
 			if err := comp.Upgrade(compContext); err != nil {
+				r.recordComponentError(compContext, eventReasonUpgradeFailed, err)
+				upgradeContext.upgradeFailures++
+				if canRollback(spiCtx.ActualCR(), comp) && upgradeContext.upgradeFailures >= getMaxUpgradeAttempts(spiCtx.ActualCR()) {
+					compLog.Errorf("Failed upgrading component %s %d times, it will be rolled back: %v", compName, upgradeContext.upgradeFailures, err)
+					upgradeContext.rollbackReason = fmt.Sprintf("upgrade failed %d times: %v", upgradeContext.upgradeFailures, err)
+					upgradeContext.state = compStateRollback
+					continue
+				}
 				compLog.Errorf("Failed upgrading component %s, will retry: %v", compName, err)
+				// Save the failure count, the rollback is decided on the failures across operator restarts
+				if err := r.checkpointUpgradeTracker(compLog, spiCtx.ActualCR(), tracker); err != nil {
+					return newRequeueWithDelay(), err
+				}
 				// check to see whether this is due to a pending upgrade
 				r.resolvePendingUpgrades(compName, compLog)
 				// requeue for 30 to 60 seconds later
@@ -110,7 +167,19 @@ func (r *Reconciler) upgradeSingleComponent(spiCtx spi.ComponentContext, upgrade
 			upgradeContext.state = compStateWaitReady
 
 		case compStateWaitReady:
+			if upgradeContext.waitReadyStart.IsZero() {
+				upgradeContext.waitReadyStart = time.Now()
+				if err := r.checkpointUpgradeTracker(compLog, spiCtx.ActualCR(), tracker); err != nil {
+					return newRequeueWithDelay(), err
+				}
+			}
 			if !comp.IsReady(compContext) {
+				if readyTimeout := getRollbackReadyTimeout(spiCtx.ActualCR()); canRollback(spiCtx.ActualCR(), comp) && time.Since(upgradeContext.waitReadyStart) > readyTimeout {
+					compLog.Errorf("Component %s was not ready within %v after being upgraded, it will be rolled back", compName, readyTimeout)
+					upgradeContext.rollbackReason = fmt.Sprintf("component was not ready within %v after the upgrade", readyTimeout)
+					upgradeContext.state = compStateRollback
+					continue
+				}
 				compLog.Progressf("Component %s has been upgraded. Waiting for the component to be ready", compName)
 				return newRequeueWithDelay(), nil
 			}
@@ -120,19 +189,124 @@ func (r *Reconciler) upgradeSingleComponent(spiCtx spi.ComponentContext, upgrade
 		case compStatePostUpgrade:
 			compLog.Oncef("Component %s post-upgrade running", compName)
 			if err := comp.PostUpgrade(compContext); err != nil {
+				r.recordComponentError(compContext, eventReasonPostUpgradeFailed, err)
 				return ctrl.Result{}, err
 			}
 			upgradeContext.state = compStateUpgradeDone
 
 		case compStateUpgradeDone:
+			// Clear the failed state of a component that was rolled back by an earlier attempt of this upgrade
+			if compStatus, ok := spiCtx.ActualCR().Status.Components[compName]; ok && compStatus.State == installv1alpha1.CompStateFailed {
+				if err := r.updateComponentStatus(compContext, "Upgrade complete", installv1alpha1.CondUpgradeComplete); err != nil {
+					return newRequeueWithDelay(), err
+				}
+			}
 			compLog.Oncef("Component %s has successfully upgraded", compName)
 			upgradeContext.state = compStateEnd
+
+		case compStateRollback:
+			compLog.Progressf("Component %s is being rolled back, the %s", compName, upgradeContext.rollbackReason)
+			if err := comp.(spi.ComponentRollbacker).Rollback(compContext); err != nil {
+				r.recordComponentError(compContext, eventReasonRollbackFailed, err)
+				upgradeContext.rollbackFailures++
+				if upgradeContext.rollbackFailures >= maxRollbackAttempts {
+					msg := fmt.Sprintf("Failed rolling back component %s %d times, the %s: %v", compName, upgradeContext.rollbackFailures, upgradeContext.rollbackReason, err)
+					compLog.Error(msg)
+					return r.failComponentUpgrade(compContext, msg)
+				}
+				compLog.Errorf("Failed rolling back component %s, will retry: %v", compName, err)
+				// Save the failure count, so that an operator restart does not retry the rollback forever
+				if err := r.checkpointUpgradeTracker(compLog, spiCtx.ActualCR(), tracker); err != nil {
+					return newRequeueWithDelay(), err
+				}
+				return newRequeueWithDelay(), nil
+			}
+			msg := fmt.Sprintf("Component %s was rolled back to the previous release revision, the %s", compName, upgradeContext.rollbackReason)
+			if err := r.updateComponentStatus(compContext, msg, installv1alpha1.CondUpgradeRolledBack); err != nil {
+				return newRequeueWithDelay(), err
+			}
+			upgradeContext.state = compStateRollbackDone
+
+		case compStateRollbackDone:
+			// The upgrade can't continue, mark it as failed.  It can be retried using the upgrade retry annotation.
+			cr := spiCtx.ActualCR()
+			msg := fmt.Sprintf("Verrazzano upgrade to version %s failed, component %s was rolled back", cr.Spec.Version, compName)
+			if err := r.updateStatus(compLog, cr, msg, installv1alpha1.CondUpgradeFailed); err != nil {
+				return newRequeueWithDelay(), err
+			}
+			// Delete the tracker so that a retry of the upgrade starts from the beginning
+			if err := r.deleteUpgradeTrackerCheckpoint(compLog, cr); err != nil {
+				return newRequeueWithDelay(), err
+			}
+			deleteUpgradeTracker(cr)
+			return newRequeueWithDelay(), nil
 		}
 	}
 	// Component has been upgraded
 	return ctrl.Result{}, nil
 }
 
+// isUpgradeTimedOut starts the upgrade timer of the component when the upgrade is in progress and the timer is not
+// started yet, which is also the case after an operator restart, and returns true if the component upgrade is in
+// progress for longer than its upgrade timeout
+func isUpgradeTimedOut(cr *installv1alpha1.Verrazzano, comp spi.Component, upgradeContext *componentUpgradeContext) (bool, time.Duration) {
+	switch upgradeContext.state {
+	case compStatePreUpgrade, compStateUpgrade, compStateWaitReady:
+		if upgradeContext.upgradeStart.IsZero() {
+			upgradeContext.upgradeStart = time.Now()
+			return false, 0
+		}
+		timeout := getComponentTimeout(cr, comp, vzconst.UpgradeOperation)
+		return time.Since(upgradeContext.upgradeStart) > timeout, timeout
+	}
+	return false, 0
+}
+
+// failComponentUpgrade fails the component and the Verrazzano upgrade when the component upgrade did not complete within
+// its timeout, or the rollback of the component failed too many times.  The upgrade can be retried using the upgrade
+// retry annotation.
+func (r *Reconciler) failComponentUpgrade(compContext spi.ComponentContext, msg string) (ctrl.Result, error) {
+	cr := compContext.ActualCR()
+	if err := r.updateComponentStatus(compContext, msg, installv1alpha1.CondUpgradeFailed); err != nil {
+		return newRequeueWithDelay(), err
+	}
+	vzMsg := fmt.Sprintf("Verrazzano upgrade to version %s failed, %s", cr.Spec.Version, msg)
+	if err := r.updateStatus(compContext.Log(), cr, vzMsg, installv1alpha1.CondUpgradeFailed); err != nil {
+		return newRequeueWithDelay(), err
+	}
+	// Delete the tracker so that a retry of the upgrade starts from the beginning
+	if err := r.deleteUpgradeTrackerCheckpoint(compContext.Log(), cr); err != nil {
+		return newRequeueWithDelay(), err
+	}
+	deleteUpgradeTracker(cr)
+	return newRequeueWithDelay(), nil
+}
+
+// canRollback returns true if the rollback policy of the Verrazzano resource is enabled and the component can be rolled back
+func canRollback(cr *installv1alpha1.Verrazzano, comp spi.Component) bool {
+	if cr.Spec.UpgradeRollback == nil || !cr.Spec.UpgradeRollback.Enabled {
+		return false
+	}
+	_, ok := comp.(spi.ComponentRollbacker)
+	return ok
+}
+
+// getMaxUpgradeAttempts returns the number of failed upgrade attempts before a component is rolled back
+func getMaxUpgradeAttempts(cr *installv1alpha1.Verrazzano) int {
+	if cr.Spec.UpgradeRollback == nil || cr.Spec.UpgradeRollback.MaxUpgradeAttempts <= 0 {
+		return defaultMaxUpgradeAttempts
+	}
+	return cr.Spec.UpgradeRollback.MaxUpgradeAttempts
+}
+
+// getRollbackReadyTimeout returns how long to wait for an upgraded component to be ready before it is rolled back
+func getRollbackReadyTimeout(cr *installv1alpha1.Verrazzano) time.Duration {
+	if cr.Spec.UpgradeRollback == nil || cr.Spec.UpgradeRollback.ReadyTimeout == nil {
+		return defaultRollbackReadyTimeout
+	}
+	return cr.Spec.UpgradeRollback.ReadyTimeout.Duration
+}
+
 // getComponentUpgradeContext gets the upgrade context for the component
 func (vuc *upgradeTracker) getComponentUpgradeContext(compName string) *componentUpgradeContext {
 	context, ok := vuc.compMap[compName]
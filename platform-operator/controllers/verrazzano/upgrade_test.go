@@ -35,10 +35,11 @@ import (
 	"github.com/verrazzano/verrazzano/platform-operator/internal/config"
 	"github.com/verrazzano/verrazzano/platform-operator/mocks"
 	appsv1 "k8s.io/api/apps/v1"
-	batchv1 "k8s.io/api/batch/v1"
 	v1 "k8s.io/api/core/v1"
 	networkingv1 "k8s.io/api/networking/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/kubernetes"
 	gofake "k8s.io/client-go/kubernetes/fake"
@@ -145,6 +146,9 @@ func TestUpgradeNoVersion(t *testing.T) {
 		return helm.ChartStatusDeployed, nil
 	})
 	defer helm.SetDefaultChartStatusFunction()
+	// The components that are disabled in the CR are not installed
+	helm.SetActionConfigFunction(helm.CreateActionConfig())
+	defer helm.SetDefaultActionConfigFunction()
 	// Create and make the request
 	request := newRequest(namespace, name)
 	reconciler := newVerrazzanoReconciler(c)
@@ -153,7 +157,7 @@ func TestUpgradeNoVersion(t *testing.T) {
 	// Validate the results
 	asserts.NoError(err)
 	asserts.Equal(false, result.Requeue)
-	asserts.Equal(time.Duration(0), result.RequeueAfter)
+	asserts.Equal(defaultHealthCheckInterval, result.RequeueAfter)
 	verrazzano := vzapi.Verrazzano{}
 	err = c.Get(context.TODO(), types.NamespacedName{Namespace: namespace, Name: name}, &verrazzano)
 	asserts.NoError(err)
@@ -241,6 +245,9 @@ func TestUpgradeSameVersion(t *testing.T) {
 		return helm.ChartStatusDeployed, nil
 	})
 	defer helm.SetDefaultChartStatusFunction()
+	// The components that are disabled in the CR are not installed
+	helm.SetActionConfigFunction(helm.CreateActionConfig())
+	defer helm.SetDefaultActionConfigFunction()
 
 	config.TestProfilesDir = "../../manifests/profiles"
 	defer func() { config.TestProfilesDir = "" }()
@@ -253,7 +260,7 @@ func TestUpgradeSameVersion(t *testing.T) {
 	// Validate the results
 	asserts.NoError(err)
 	asserts.Equal(false, result.Requeue)
-	asserts.Equal(time.Duration(0), result.RequeueAfter)
+	asserts.Equal(defaultHealthCheckInterval, result.RequeueAfter)
 	verrazzano := vzapi.Verrazzano{}
 	err = c.Get(context.TODO(), types.NamespacedName{Namespace: namespace, Name: name}, &verrazzano)
 	asserts.NoError(err)
@@ -433,15 +440,12 @@ func TestDeleteDuringUpgrade(t *testing.T) {
 	asserts.Equal(true, result.Requeue)
 	asserts.Equal(time.Duration(2)*time.Second, result.RequeueAfter)
 
-	// check that an uninstall job was created
-	uninstallJob := batchv1.Job{}
-	err = c.Get(context.TODO(), types.NamespacedName{Namespace: getInstallNamespace(), Name: buildUninstallJobName(name)}, &uninstallJob)
-	asserts.NoError(err)
-
 	// check for uninstall started condition
 	verrazzano := vzapi.Verrazzano{}
 	err = c.Get(context.TODO(), types.NamespacedName{Namespace: namespace, Name: name}, &verrazzano)
 	asserts.NoError(err)
+	asserts.Equal(vzapi.VzStateUninstalling, verrazzano.Status.State)
+	deleteUninstallTracker(&verrazzano)
 	found := false
 	for _, condition := range verrazzano.Status.Conditions {
 		if condition.Type == vzapi.CondUninstallStarted {
@@ -704,7 +708,6 @@ func TestUpgradeCompletedStatusReturnsError(t *testing.T) {
 	initUnitTesing()
 	namespace := "verrazzano"
 	name := "test"
-	var verrazzanoToUse vzapi.Verrazzano
 
 	fname, _ := filepath.Abs(unitTestBomFile)
 	config.SetDefaultBomFilePath(fname)
@@ -713,6 +716,7 @@ func TestUpgradeCompletedStatusReturnsError(t *testing.T) {
 	mock := mocks.NewMockClient(mocker)
 	mockStatus := mocks.NewMockStatusWriter(mocker)
 	asserts.NotNil(mockStatus)
+	expectUpgradeTrackerCheckpoint(mock, namespace, name)
 
 	defer config.Set(config.Get())
 	config.Set(config.OperatorConfig{VersionCheckEnabled: false})
@@ -760,12 +764,6 @@ func TestUpgradeCompletedStatusReturnsError(t *testing.T) {
 			return nil
 		}).AnyTimes()
 
-	// Expect a call to get the service account
-	expectGetServiceAccountExists(mock, name, nil)
-
-	// Expect a call to get the ClusterRoleBinding
-	expectClusterRoleBindingExists(mock, verrazzanoToUse, namespace, name)
-
 	mock.EXPECT().
 		List(gomock.Any(), gomock.Not(gomock.Nil()), gomock.Any()).
 		DoAndReturn(func(ctx context.Context, list *oamapi.ApplicationConfigurationList, opts ...client.ListOption) error {
@@ -875,6 +873,193 @@ func TestUpgradeHelmError(t *testing.T) {
 	asserts.True(found, "expected upgrade paused to be true")
 }
 
+// TestUpgradeRollbackAfterFailedAttempts tests the reconcileUpgrade method for the following use case
+// GIVEN a Verrazzano resource with the upgrade rollback policy enabled
+// WHEN a Helm based component fails to upgrade the maximum number of times
+// THEN the component is rolled back once, it is marked as rolled back and the upgrade is failed
+func TestUpgradeRollbackAfterFailedAttempts(t *testing.T) {
+	rollbacks, verrazzano := testUpgradeRollback(t, &vzapi.UpgradeRollbackSpec{Enabled: true, MaxUpgradeAttempts: 2}, "true",
+		func(ctx spi.ComponentContext) error {
+			return fmt.Errorf("Error running upgrade")
+		}, nil)
+	asserts := assert.New(t)
+	asserts.Equal(1, rollbacks)
+	asserts.Equal(vzapi.VzStateFailed, verrazzano.Status.State)
+	asserts.Equal(vzapi.CondUpgradeFailed, verrazzano.Status.Conditions[len(verrazzano.Status.Conditions)-1].Type)
+	compStatus := verrazzano.Status.Components["fake"]
+	asserts.Equal(vzapi.CompStateFailed, compStatus.State)
+	asserts.Equal(vzapi.CondUpgradeRolledBack, compStatus.Conditions[len(compStatus.Conditions)-1].Type)
+	asserts.Contains(compStatus.Conditions[len(compStatus.Conditions)-1].Message, "upgrade failed 2 times")
+	asserts.Nil(upgradeTrackerMap[getNSNKey(verrazzano)])
+}
+
+// TestUpgradeRollbackReadyTimeout tests the reconcileUpgrade method for the following use case
+// GIVEN a Verrazzano resource with the upgrade rollback policy enabled
+// WHEN a Helm based component is not ready within the ready timeout after the upgrade
+// THEN the component is rolled back and the upgrade is failed
+func TestUpgradeRollbackReadyTimeout(t *testing.T) {
+	rollbacks, verrazzano := testUpgradeRollback(t,
+		&vzapi.UpgradeRollbackSpec{Enabled: true, ReadyTimeout: &metav1.Duration{Duration: time.Nanosecond}}, "false", nil, nil)
+	asserts := assert.New(t)
+	asserts.Equal(1, rollbacks)
+	asserts.Equal(vzapi.VzStateFailed, verrazzano.Status.State)
+	compStatus := verrazzano.Status.Components["fake"]
+	asserts.Equal(vzapi.CompStateFailed, compStatus.State)
+	asserts.Contains(compStatus.Conditions[len(compStatus.Conditions)-1].Message, "was not ready within")
+}
+
+// TestUpgradeNoRollbackByDefault tests the reconcileUpgrade method for the following use case
+// GIVEN a Verrazzano resource without an upgrade rollback policy
+// WHEN a Helm based component keeps failing to upgrade
+// THEN the component is not rolled back and the upgrade keeps being retried
+func TestUpgradeNoRollbackByDefault(t *testing.T) {
+	rollbacks, verrazzano := testUpgradeRollback(t, nil, "true",
+		func(ctx spi.ComponentContext) error {
+			return fmt.Errorf("Error running upgrade")
+		}, nil)
+	defer deleteUpgradeTracker(verrazzano)
+	asserts := assert.New(t)
+	asserts.Equal(0, rollbacks)
+	asserts.Equal(vzapi.VzStateUpgrading, verrazzano.Status.State)
+}
+
+// TestUpgradeRollbackFailedAttempts tests the reconcileUpgrade method for the following use case
+// GIVEN a Verrazzano resource with the upgrade rollback policy enabled
+// WHEN the rollback of a Helm based component that failed to upgrade keeps failing
+// THEN the rollback is attempted the maximum number of times, then the component and the upgrade are failed
+func TestUpgradeRollbackFailedAttempts(t *testing.T) {
+	rollbacks, verrazzano := testUpgradeRollback(t, &vzapi.UpgradeRollbackSpec{Enabled: true, MaxUpgradeAttempts: 1}, "true",
+		func(ctx spi.ComponentContext) error {
+			return fmt.Errorf("Error running upgrade")
+		}, fmt.Errorf("Error running rollback"))
+	asserts := assert.New(t)
+	asserts.Equal(maxRollbackAttempts, rollbacks)
+	asserts.Equal(vzapi.VzStateFailed, verrazzano.Status.State)
+	asserts.Equal(vzapi.CondUpgradeFailed, verrazzano.Status.Conditions[len(verrazzano.Status.Conditions)-1].Type)
+	compStatus := verrazzano.Status.Components["fake"]
+	asserts.Equal(vzapi.CompStateFailed, compStatus.State)
+	asserts.Equal(vzapi.CondUpgradeFailed, compStatus.Conditions[len(compStatus.Conditions)-1].Type)
+	asserts.Contains(compStatus.Conditions[len(compStatus.Conditions)-1].Message, "Failed rolling back component fake 3 times")
+	asserts.Nil(upgradeTrackerMap[getNSNKey(verrazzano)])
+}
+
+// TestUpgradePreflightFailed tests the reconcileUpgrade method for the following use case
+// GIVEN an upgrade where the preflight checks of a component fail
+// WHEN the upgrade is reconciled
+// THEN the component is not upgraded and the upgrade is blocked with a PreflightFailed condition listing every failed check
+func TestUpgradePreflightFailed(t *testing.T) {
+	initUnitTesing()
+	asserts := assert.New(t)
+	vz := newCheckpointTestVerrazzano(1)
+	defer deleteUpgradeTracker(vz)
+
+	config.TestProfilesDir = "../../manifests/profiles"
+	defer func() { config.TestProfilesDir = "" }()
+
+	upgraded := false
+	registry.OverrideGetComponentsFn(func() []spi.Component {
+		return []spi.Component{
+			fakeComponent{
+				HelmComponent: helm2.HelmComponent{ReleaseName: "fake1"},
+				upgradeFunc: func(ctx spi.ComponentContext) error {
+					upgraded = true
+					return nil
+				},
+				preflightFunc: func(ctx spi.ComponentContext) []error {
+					return []error{fmt.Errorf("check one failed"), fmt.Errorf("check two failed")}
+				},
+			},
+		}
+	})
+	defer registry.ResetGetComponentsFn()
+
+	c := fake.NewClientBuilder().WithScheme(k8scheme.Scheme).WithObjects(vz).Build()
+	reconciler := newVerrazzanoReconciler(c)
+	result, err := reconcileUpgradeLoop(reconciler, vz)
+	asserts.NoError(err)
+	asserts.True(result.Requeue)
+	asserts.False(upgraded)
+
+	asserts.Equal(vzapi.VzStateFailed, vz.Status.State)
+	cond := vz.Status.Conditions[len(vz.Status.Conditions)-1]
+	asserts.Equal(vzapi.CondPreflightFailed, cond.Type)
+	asserts.Contains(cond.Message, "fake1: check one failed; fake1: check two failed")
+	asserts.Nil(upgradeTrackerMap[getNSNKey(vz)])
+}
+
+// testUpgradeRollback reconciles an upgrade of a Helm based component with the rollback policy and returns the
+// number of rollbacks and the resulting Verrazzano resource.  Every rollback returns the rollbackErr.
+func testUpgradeRollback(t *testing.T, policy *vzapi.UpgradeRollbackSpec, ready string, upgradeFunc upgradeFuncSig, rollbackErr error) (int, *vzapi.Verrazzano) {
+	initUnitTesing()
+	namespace := "verrazzano"
+	name := "test"
+	var verrazzanoToUse vzapi.Verrazzano
+	asserts := assert.New(t)
+
+	fname, _ := filepath.Abs(unitTestBomFile)
+	config.SetDefaultBomFilePath(fname)
+	defer config.SetDefaultBomFilePath("")
+	config.TestProfilesDir = "../../manifests/profiles"
+	defer func() { config.TestProfilesDir = "" }()
+
+	registry.OverrideGetComponentsFn(func() []spi.Component {
+		return []spi.Component{
+			fakeComponent{
+				HelmComponent: helm2.HelmComponent{
+					ReleaseName: "fake",
+				},
+				upgradeFunc: upgradeFunc,
+				ready:       ready,
+			},
+		}
+	})
+	defer registry.ResetGetComponentsFn()
+
+	rollbacks := 0
+	helm2.SetRollbackFunc(func(log vzlog.VerrazzanoLogger, releaseName string, namespace string, dryRun bool) (stdout []byte, stderr []byte, err error) {
+		rollbacks++
+		return []byte{}, []byte{}, rollbackErr
+	})
+	defer helm2.SetDefaultRollbackFunc()
+
+	_ = vzapi.AddToScheme(k8scheme.Scheme)
+	c := fake.NewClientBuilder().WithScheme(k8scheme.Scheme).WithObjects(
+		&vzapi.Verrazzano{
+			ObjectMeta: createObjectMeta(namespace, name, []string{finalizerName}),
+			Spec: vzapi.VerrazzanoSpec{
+				Version:         "1.2.0",
+				UpgradeRollback: policy,
+			},
+			Status: vzapi.VerrazzanoStatus{
+				State:      vzapi.VzStateUpgrading,
+				Components: makeVerrazzanoComponentStatusMap(),
+				Conditions: []vzapi.Condition{
+					{
+						Type: vzapi.CondInstallComplete,
+					},
+					{
+						Type: vzapi.CondUpgradeStarted,
+					},
+				},
+			},
+		},
+		rbac.NewServiceAccount(namespace, name, []string{}, nil),
+		rbac.NewClusterRoleBinding(&verrazzanoToUse, name, getInstallNamespace(), buildServiceAccountName(name)),
+	).Build()
+
+	// Start from a fresh upgrade tracker, other tests use the same Verrazzano resource name
+	deleteUpgradeTracker(&vzapi.Verrazzano{ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name}})
+
+	request := newRequest(namespace, name)
+	reconciler := newVerrazzanoReconciler(c)
+	_, err := reconcileLoop(reconciler, request)
+	asserts.NoError(err)
+
+	verrazzano := &vzapi.Verrazzano{}
+	asserts.NoError(c.Get(context.TODO(), types.NamespacedName{Namespace: namespace, Name: name}, verrazzano))
+	return rollbacks, verrazzano
+}
+
 // TestUpgradeIsCompInstalledFailure tests the reconcileUpgrade method for the following use case
 // GIVEN a request to reconcile an upgrade
 // WHEN the comp.IsInstalled() function returns an error
@@ -944,6 +1129,7 @@ func TestUpgradeComponent(t *testing.T) {
 	mock := mocks.NewMockClient(mocker)
 	mockStatus := mocks.NewMockStatusWriter(mocker)
 	asserts.NotNil(mockStatus)
+	expectUpgradeTrackerCheckpoint(mock, namespace, name)
 
 	defer config.Set(config.Get())
 	config.Set(config.OperatorConfig{VersionCheckEnabled: false})
@@ -1041,6 +1227,7 @@ func TestUpgradeComponentWithBlockingStatus(t *testing.T) {
 	mock := mocks.NewMockClient(mocker)
 	mockStatus := mocks.NewMockStatusWriter(mocker)
 	asserts.NotNil(mockStatus)
+	expectUpgradeTrackerCheckpoint(mock, namespace, name)
 
 	defer config.Set(config.Get())
 	config.Set(config.OperatorConfig{VersionCheckEnabled: false})
@@ -1126,6 +1313,7 @@ func TestUpgradeMultipleComponentsOneDisabled(t *testing.T) {
 	mock := mocks.NewMockClient(mocker)
 	mockStatus := mocks.NewMockStatusWriter(mocker)
 	asserts.NotNil(mockStatus)
+	expectUpgradeTrackerCheckpoint(mock, namespace, name)
 
 	defer config.Set(config.Get())
 	config.Set(config.OperatorConfig{VersionCheckEnabled: false})
@@ -1682,6 +1870,9 @@ func TestInstanceRestoreWithEmptyStatus(t *testing.T) {
 		return helm.ChartStatusDeployed, nil
 	})
 	defer helm.SetDefaultChartStatusFunction()
+	// The components that are disabled in the CR are not installed
+	helm.SetActionConfigFunction(helm.CreateActionConfig())
+	defer helm.SetDefaultActionConfigFunction()
 
 	config.TestProfilesDir = "../../manifests/profiles"
 	defer func() { config.TestProfilesDir = "" }()
@@ -1694,7 +1885,7 @@ func TestInstanceRestoreWithEmptyStatus(t *testing.T) {
 	// Validate the results
 	asserts.NoError(err)
 	asserts.Equal(false, result.Requeue)
-	asserts.Equal(time.Duration(0), result.RequeueAfter)
+	asserts.Equal(defaultHealthCheckInterval, result.RequeueAfter)
 
 	// validating instance urls are updated
 	// Status is empty in this case
@@ -1854,6 +2045,9 @@ func TestInstanceRestoreWithPopulatedStatus(t *testing.T) {
 		return helm.ChartStatusDeployed, nil
 	})
 	defer helm.SetDefaultChartStatusFunction()
+	// The components that are disabled in the CR are not installed
+	helm.SetActionConfigFunction(helm.CreateActionConfig())
+	defer helm.SetDefaultActionConfigFunction()
 
 	config.TestProfilesDir = "../../manifests/profiles"
 	defer func() { config.TestProfilesDir = "" }()
@@ -1866,7 +2060,7 @@ func TestInstanceRestoreWithPopulatedStatus(t *testing.T) {
 	// Validate the results
 	asserts.NoError(err)
 	asserts.Equal(false, result.Requeue)
-	asserts.Equal(time.Duration(0), result.RequeueAfter)
+	asserts.Equal(defaultHealthCheckInterval, result.RequeueAfter)
 
 	// validating instance urls are updated
 	fakeInstanceInfo := vzapi.InstanceInfo{}
@@ -1931,6 +2125,19 @@ func initStates(cr *vzapi.Verrazzano, vzState VerrazzanoUpgradeState, compName s
 	upgradeContext.state = compState
 }
 
+// expectUpgradeTrackerCheckpoint expects the calls to checkpoint the upgrade tracker in a ConfigMap
+func expectUpgradeTrackerCheckpoint(mock *mocks.MockClient, namespace string, name string) {
+	mock.EXPECT().
+		Get(gomock.Any(), types.NamespacedName{Namespace: namespace, Name: upgradeTrackerConfigMapPrefix + name}, gomock.AssignableToTypeOf(&v1.ConfigMap{})).
+		Return(k8serrors.NewNotFound(schema.GroupResource{Resource: "ConfigMap"}, upgradeTrackerConfigMapPrefix+name)).AnyTimes()
+	mock.EXPECT().
+		Create(gomock.Any(), gomock.AssignableToTypeOf(&v1.ConfigMap{})).
+		Return(nil).AnyTimes()
+	mock.EXPECT().
+		Delete(gomock.Any(), gomock.AssignableToTypeOf(&v1.ConfigMap{})).
+		Return(nil).AnyTimes()
+}
+
 // reconcileUpgradeLoop
 func reconcileUpgradeLoop(reconciler Reconciler, cr *vzapi.Verrazzano) (ctrl.Result, error) {
 	numComponentStates := 7
@@ -4,6 +4,8 @@
 package validator
 
 import (
+	"fmt"
+
 	"github.com/verrazzano/verrazzano/platform-operator/apis/verrazzano/v1alpha1"
 	"github.com/verrazzano/verrazzano/platform-operator/controllers/verrazzano/component/registry"
 	"github.com/verrazzano/verrazzano/platform-operator/controllers/verrazzano/transform"
@@ -48,6 +50,13 @@ func (c ComponentValidatorImpl) ValidateUpdate(old *v1alpha1.Verrazzano, new *v1
 	for _, comp := range registry.GetComponents() {
 		if err := comp.ValidateUpdate(effectiveOld, effectiveNew); err != nil {
 			errs = append(errs, err)
+			continue
+		}
+		// A component can be disabled after install, unless other enabled components depend on it
+		if comp.IsEnabled(effectiveOld) && !comp.IsEnabled(effectiveNew) {
+			if dependents := registry.GetEnabledDependents(comp.Name(), effectiveNew); len(dependents) > 0 {
+				errs = append(errs, fmt.Errorf("Disabling component %s is not allowed, the enabled components %v depend on it", comp.GetJSONName(), dependents))
+			}
 		}
 	}
 	return errs
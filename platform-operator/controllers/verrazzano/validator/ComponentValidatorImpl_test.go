@@ -60,7 +60,7 @@ func TestComponentValidatorImpl_ValidateInstall(t *testing.T) {
 // TestComponentValidatorImpl_ValidateUpdate tests the ValidateUpdate function
 // GIVEN a valid CR
 // WHEN ValidateUpdate is called
-// THEN ensure that no error is raised
+// THEN ensure that no error is raised, unless a component is disabled while enabled components depend on it
 func TestComponentValidatorImpl_ValidateUpdate(t *testing.T) {
 	tests := []struct {
 		name           string
@@ -86,6 +86,37 @@ func TestComponentValidatorImpl_ValidateUpdate(t *testing.T) {
 					},
 				},
 			},
+			numberOfErrors: 0,
+		},
+		{
+			name: "disable oam and the application operator that depends on it",
+			old:  &vzapi.Verrazzano{},
+			new: &vzapi.Verrazzano{
+				Spec: vzapi.VerrazzanoSpec{
+					Components: vzapi.ComponentSpec{
+						OAM: &vzapi.OAMComponent{
+							Enabled: &disabled,
+						},
+						ApplicationOperator: &vzapi.ApplicationOperatorComponent{
+							Enabled: &disabled,
+						},
+					},
+				},
+			},
+			numberOfErrors: 0,
+		},
+		{
+			name: "disable oam",
+			old:  &vzapi.Verrazzano{},
+			new: &vzapi.Verrazzano{
+				Spec: vzapi.VerrazzanoSpec{
+					Components: vzapi.ComponentSpec{
+						OAM: &vzapi.OAMComponent{
+							Enabled: &disabled,
+						},
+					},
+				},
+			},
 			numberOfErrors: 1,
 		},
 		{
@@ -13,6 +13,7 @@ const (
 	rootDir                      = "/verrazzano"
 	platformDirSuffix            = "/platform-operator"
 	profilesDirSuffix            = "/platform-operator/manifests/profiles"
+	dashboardsDirSuffix          = "/platform-operator/manifests/dashboards"
 	installDirSuffix             = "/platform-operator/scripts/install"
 	thirdPartyDirSuffix          = "/platform-operator/thirdparty/charts"
 	thirdPartyManifestsDirSuffix = "/platform-operator/thirdparty/manifests"
@@ -65,18 +66,22 @@ type OperatorConfig struct {
 
 	// DryRun Run installs in a dry-run mode
 	DryRun bool
+
+	// MaxConcurrentComponentInstalls is the maximum number of independent components that are installed concurrently
+	MaxConcurrentComponentInstalls int
 }
 
 // The singleton instance of the operator config
 var instance = OperatorConfig{
-	CertDir:                  "/etc/webhook/certs",
-	InitWebhooks:             false,
-	MetricsAddr:              ":8080",
-	LeaderElectionEnabled:    false,
-	VersionCheckEnabled:      true,
-	WebhooksEnabled:          true,
-	WebhookValidationEnabled: true,
-	VerrazzanoRootDir:        rootDir,
+	CertDir:                        "/etc/webhook/certs",
+	InitWebhooks:                   false,
+	MetricsAddr:                    ":8080",
+	LeaderElectionEnabled:          false,
+	VersionCheckEnabled:            true,
+	WebhooksEnabled:                true,
+	WebhookValidationEnabled:       true,
+	VerrazzanoRootDir:              rootDir,
+	MaxConcurrentComponentInstalls: 4,
 }
 
 // Set saves the operator config.  This should only be called at operator startup and during unit tests
@@ -172,6 +177,11 @@ func GetProfilesDir() string {
 	return filepath.Join(instance.VerrazzanoRootDir, profilesDirSuffix)
 }
 
+// GetDashboardsDir returns the dir of the platform Grafana dashboards
+func GetDashboardsDir() string {
+	return filepath.Join(instance.VerrazzanoRootDir, dashboardsDirSuffix)
+}
+
 func GetProfile(profile string) string {
 	return filepath.Join(GetProfilesDir(), profile+".yaml")
 }
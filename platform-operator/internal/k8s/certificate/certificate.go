@@ -17,6 +17,7 @@ import (
 	"time"
 
 	adminv1 "k8s.io/api/admissionregistration/v1"
+	apiextensionsclient "k8s.io/apiextensions-apiserver/pkg/client/clientset/clientset"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes"
 )
@@ -26,6 +27,8 @@ const (
 	OperatorName = "verrazzano-platform-operator"
 	// OperatorNamespace is the resource namespace for the Verrazzano platform operator
 	OperatorNamespace = "verrazzano-install"
+	// VerrazzanoCRDName is the name of the Verrazzano CustomResourceDefinition, which has a conversion webhook
+	VerrazzanoCRDName = "verrazzanos.install.verrazzano.io"
 )
 
 // CreateWebhookCertificates creates the needed certificates for the validating webhook
@@ -176,3 +179,17 @@ func UpdateValidatingnWebhookConfiguration(kubeClient kubernetes.Interface, caCe
 
 	return nil
 }
+
+// UpdateConversionWebhookConfiguration sets the CABundle of the conversion webhook of the Verrazzano CRD
+func UpdateConversionWebhookConfiguration(apiextClient apiextensionsclient.Interface, caCert *bytes.Buffer) error {
+	crd, err := apiextClient.ApiextensionsV1().CustomResourceDefinitions().Get(context.TODO(), VerrazzanoCRDName, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+	if crd.Spec.Conversion == nil || crd.Spec.Conversion.Webhook == nil || crd.Spec.Conversion.Webhook.ClientConfig == nil {
+		return fmt.Errorf("Expected a conversion webhook in the %s CustomResourceDefinition", VerrazzanoCRDName)
+	}
+	crd.Spec.Conversion.Webhook.ClientConfig.CABundle = caCert.Bytes()
+	_, err = apiextClient.ApiextensionsV1().CustomResourceDefinitions().Update(context.TODO(), crd, metav1.UpdateOptions{})
+	return err
+}
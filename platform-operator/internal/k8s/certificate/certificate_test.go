@@ -15,6 +15,8 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	adminv1 "k8s.io/api/admissionregistration/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	apiextensionsfake "k8s.io/apiextensions-apiserver/pkg/client/clientset/clientset/fake"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes/fake"
 )
@@ -158,3 +160,59 @@ func TestUpdateValidatingnWebhookConfigurationFail(t *testing.T) {
 	err = UpdateValidatingnWebhookConfiguration(kubeClient, &caCert)
 	assert.Error(err, "error should be returned updating validation webhook configuration")
 }
+
+// TestUpdateConversionWebhookConfiguration tests that the CA Bundle is updated in the conversion webhook of the
+// Verrazzano CRD.
+// GIVEN a Verrazzano CRD with a conversion webhook
+//  WHEN I call UpdateConversionWebhookConfiguration
+//  THEN the conversion webhook of the CRD has the CA Bundle
+func TestUpdateConversionWebhookConfiguration(t *testing.T) {
+	assert := assert.New(t)
+
+	var caCert bytes.Buffer
+	caCert.WriteString("Fake CABundle")
+	path := "/convert"
+	crd := &apiextensionsv1.CustomResourceDefinition{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: VerrazzanoCRDName,
+		},
+		Spec: apiextensionsv1.CustomResourceDefinitionSpec{
+			Conversion: &apiextensionsv1.CustomResourceConversion{
+				Strategy: apiextensionsv1.WebhookConverter,
+				Webhook: &apiextensionsv1.WebhookConversion{
+					ClientConfig: &apiextensionsv1.WebhookClientConfig{
+						Service: &apiextensionsv1.ServiceReference{
+							Name:      OperatorName,
+							Namespace: OperatorNamespace,
+							Path:      &path,
+						},
+					},
+					ConversionReviewVersions: []string{"v1"},
+				},
+			},
+		},
+	}
+	apiextClient := apiextensionsfake.NewSimpleClientset(crd)
+
+	err := UpdateConversionWebhookConfiguration(apiextClient, &caCert)
+	assert.Nil(err, "error should not be returned updating the conversion webhook")
+
+	updatedCRD, _ := apiextClient.ApiextensionsV1().CustomResourceDefinitions().Get(context.TODO(), VerrazzanoCRDName, metav1.GetOptions{})
+	assert.Equal(caCert.Bytes(), updatedCRD.Spec.Conversion.Webhook.ClientConfig.CABundle, "Expected CA bundle did not match")
+}
+
+// TestUpdateConversionWebhookConfigurationFail tests that the CA Bundle is not updated when the Verrazzano CRD has no
+// conversion webhook.
+// GIVEN a Verrazzano CRD without a conversion webhook
+//  WHEN I call UpdateConversionWebhookConfiguration
+//  THEN an error is returned
+func TestUpdateConversionWebhookConfigurationFail(t *testing.T) {
+	var caCert bytes.Buffer
+	caCert.WriteString("Fake CABundle")
+	apiextClient := apiextensionsfake.NewSimpleClientset(&apiextensionsv1.CustomResourceDefinition{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: VerrazzanoCRDName,
+		},
+	})
+	assert.Error(t, UpdateConversionWebhookConfiguration(apiextClient, &caCert))
+}
@@ -20,6 +20,7 @@ import (
 const (
 	kubeSystemNamespace   = "kube-system"
 	nginxIngressNamespace = "ingress-nginx"
+	monitoringNamespace   = "verrazzano-monitoring"
 
 	networkPolicyAPIVersion  = "networking.k8s.io/v1"
 	networkPolicyKind        = "NetworkPolicy"
@@ -31,6 +32,8 @@ const (
 	nginxControllerPodName   = "ingress-controller"
 	appInstanceLabel         = "app.kubernetes.io/instance"
 	apiServerEndpointName    = "kubernetes"
+	appNameLabel             = "app.kubernetes.io/name"
+	prometheusPodName        = "prometheus"
 )
 
 // CreateOrUpdateNetworkPolicies creates or updates network policies for the platform operator to
@@ -73,6 +76,7 @@ func newNetworkPolicy(apiServerIP string, apiServerPort int32) *netv1.NetworkPol
 	dnsPort := intstr.FromInt(53)
 	httpsPort := intstr.FromInt(443)
 	webhookPort := intstr.FromInt(9443)
+	metricsPort := intstr.FromInt(8080)
 	apiPort := intstr.FromInt(int(apiServerPort))
 	apiServerCidr := apiServerIP + "/32"
 
@@ -173,6 +177,29 @@ func newNetworkPolicy(apiServerIP string, apiServerPort int32) *netv1.NetworkPol
 						},
 					},
 				},
+				{
+					// ingress from Prometheus for scraping the metrics
+					Ports: []netv1.NetworkPolicyPort{
+						{
+							Protocol: &tcpProtocol,
+							Port:     &metricsPort,
+						},
+					},
+					From: []netv1.NetworkPolicyPeer{
+						{
+							NamespaceSelector: &metav1.LabelSelector{
+								MatchLabels: map[string]string{
+									verrazzanoNamespaceLabel: monitoringNamespace,
+								},
+							},
+							PodSelector: &metav1.LabelSelector{
+								MatchLabels: map[string]string{
+									appNameLabel: prometheusPodName,
+								},
+							},
+						},
+					},
+				},
 			},
 		},
 	}
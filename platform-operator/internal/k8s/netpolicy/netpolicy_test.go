@@ -12,6 +12,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	netv1 "k8s.io/api/networking/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	k8sfake "k8s.io/client-go/kubernetes/fake"
 	k8scheme "k8s.io/client-go/kubernetes/scheme"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -49,6 +50,24 @@ func TestCreateNetworkPolicies(t *testing.T) {
 	asserts.Equal(expectedNetPolicy.Spec, netPolicy.Spec)
 }
 
+// TestNetworkPolicyMetricsIngress tests the ingress rules of the operator network policy.
+// GIVEN a call to newNetworkPolicy
+// WHEN the network policy is built
+// THEN the metrics port can be reached from Prometheus in the monitoring namespace
+func TestNetworkPolicyMetricsIngress(t *testing.T) {
+	asserts := assert.New(t)
+	netPolicy := newNetworkPolicy(apiServerIP, apiServerPort)
+
+	asserts.Len(netPolicy.Spec.Ingress, 2)
+	metricsRule := netPolicy.Spec.Ingress[1]
+	asserts.Len(metricsRule.Ports, 1)
+	asserts.Equal(corev1.ProtocolTCP, *metricsRule.Ports[0].Protocol)
+	asserts.Equal(intstr.FromInt(8080), *metricsRule.Ports[0].Port)
+	asserts.Len(metricsRule.From, 1)
+	asserts.Equal(map[string]string{verrazzanoNamespaceLabel: "verrazzano-monitoring"}, metricsRule.From[0].NamespaceSelector.MatchLabels)
+	asserts.Equal(map[string]string{"app.kubernetes.io/name": "prometheus"}, metricsRule.From[0].PodSelector.MatchLabels)
+}
+
 // TestUpdateNetworkPolicies tests updating network policies for the operator.
 // GIVEN a call to CreateOrUpdateNetworkPolicies
 // WHEN the network policies already exist
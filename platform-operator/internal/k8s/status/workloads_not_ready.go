@@ -0,0 +1,119 @@
+// Copyright (c) 2022, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package status
+
+import (
+	"context"
+	"fmt"
+
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	clipkg "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// WorkloadFilter returns true for the workloads to check
+type WorkloadFilter func(obj metav1.Object) bool
+
+// GetNotReadyWorkloads returns a description of every Deployment, StatefulSet and DaemonSet in the namespace that is
+// accepted by the filter and does not have all of its replicas ready
+func GetNotReadyWorkloads(client clipkg.Client, namespace string, filter WorkloadFilter) ([]string, error) {
+	var notReady []string
+
+	deployments := appsv1.DeploymentList{}
+	if err := client.List(context.TODO(), &deployments, clipkg.InNamespace(namespace)); err != nil {
+		return nil, fmt.Errorf("Failed listing deployments in namespace %s: %v", namespace, err)
+	}
+	for i := range deployments.Items {
+		deployment := &deployments.Items[i]
+		expected := getExpectedReplicas(deployment.Spec.Replicas)
+		if filter(deployment) && (deployment.Status.ReadyReplicas < expected || deployment.Status.UpdatedReplicas < expected) {
+			notReady = append(notReady, fmt.Sprintf("Deployment %s/%s has %v/%v ready replicas", deployment.Namespace, deployment.Name,
+				deployment.Status.ReadyReplicas, expected))
+		}
+	}
+
+	statefulSets := appsv1.StatefulSetList{}
+	if err := client.List(context.TODO(), &statefulSets, clipkg.InNamespace(namespace)); err != nil {
+		return nil, fmt.Errorf("Failed listing statefulsets in namespace %s: %v", namespace, err)
+	}
+	for i := range statefulSets.Items {
+		statefulSet := &statefulSets.Items[i]
+		expected := getExpectedReplicas(statefulSet.Spec.Replicas)
+		if filter(statefulSet) && statefulSet.Status.ReadyReplicas < expected {
+			notReady = append(notReady, fmt.Sprintf("StatefulSet %s/%s has %v/%v ready replicas", statefulSet.Namespace, statefulSet.Name,
+				statefulSet.Status.ReadyReplicas, expected))
+		}
+	}
+
+	daemonSets := appsv1.DaemonSetList{}
+	if err := client.List(context.TODO(), &daemonSets, clipkg.InNamespace(namespace)); err != nil {
+		return nil, fmt.Errorf("Failed listing daemonsets in namespace %s: %v", namespace, err)
+	}
+	for i := range daemonSets.Items {
+		daemonSet := &daemonSets.Items[i]
+		if filter(daemonSet) && daemonSet.Status.NumberReady < daemonSet.Status.DesiredNumberScheduled {
+			notReady = append(notReady, fmt.Sprintf("DaemonSet %s/%s has %v/%v ready pods", daemonSet.Namespace, daemonSet.Name,
+				daemonSet.Status.NumberReady, daemonSet.Status.DesiredNumberScheduled))
+		}
+	}
+	return notReady, nil
+}
+
+// GetNotReadyNamedWorkloads returns a description of the named Deployments, StatefulSets and DaemonSets that do not
+// have all of their replicas ready, or that do not exist.  It is used by the components that are not installed with a
+// Helm release.
+func GetNotReadyNamedWorkloads(client clipkg.Client, names []types.NamespacedName) ([]string, error) {
+	var notReady []string
+	var namespaces []string
+	found := make(map[types.NamespacedName]bool)
+	for _, name := range names {
+		if _, ok := found[name]; ok {
+			continue
+		}
+		found[name] = false
+		if !containsString(namespaces, name.Namespace) {
+			namespaces = append(namespaces, name.Namespace)
+		}
+	}
+	for _, namespace := range namespaces {
+		workloads, err := GetNotReadyWorkloads(client, namespace, func(obj metav1.Object) bool {
+			nsn := types.NamespacedName{Namespace: obj.GetNamespace(), Name: obj.GetName()}
+			if _, ok := found[nsn]; !ok {
+				return false
+			}
+			found[nsn] = true
+			return true
+		})
+		if err != nil {
+			return nil, err
+		}
+		notReady = append(notReady, workloads...)
+	}
+	for _, name := range names {
+		if !found[name] {
+			notReady = append(notReady, fmt.Sprintf("Workload %s/%s does not exist", name.Namespace, name.Name))
+			found[name] = true
+		}
+	}
+	return notReady, nil
+}
+
+// containsString returns true if the slice contains the string
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+// getExpectedReplicas returns the number of replicas in the spec of a workload, which defaults to 1
+func getExpectedReplicas(replicas *int32) int32 {
+	if replicas == nil {
+		return 1
+	}
+	return *replicas
+}
@@ -0,0 +1,100 @@
+// Copyright (c) 2022, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package status
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	k8scheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+// TestGetNotReadyWorkloads tests the GetNotReadyWorkloads func
+// GIVEN Deployments, StatefulSets and DaemonSets in a namespace
+// WHEN the workloads that are not ready are requested with a filter
+// THEN only the workloads accepted by the filter that are not ready are returned
+func TestGetNotReadyWorkloads(t *testing.T) {
+	replicas := int32(2)
+	labels := map[string]string{"app": "foo"}
+	client := fake.NewClientBuilder().WithScheme(k8scheme.Scheme).WithObjects(
+		&appsv1.Deployment{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "bar", Name: "ready", Labels: labels},
+			Spec:       appsv1.DeploymentSpec{Replicas: &replicas},
+			Status:     appsv1.DeploymentStatus{ReadyReplicas: 2, UpdatedReplicas: 2},
+		},
+		&appsv1.Deployment{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "bar", Name: "notready", Labels: labels},
+			Spec:       appsv1.DeploymentSpec{Replicas: &replicas},
+			Status:     appsv1.DeploymentStatus{ReadyReplicas: 1, UpdatedReplicas: 2},
+		},
+		&appsv1.Deployment{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "bar", Name: "filtered"},
+			Status:     appsv1.DeploymentStatus{ReadyReplicas: 0},
+		},
+		&appsv1.Deployment{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "other", Name: "notready", Labels: labels},
+			Status:     appsv1.DeploymentStatus{ReadyReplicas: 0},
+		},
+		&appsv1.StatefulSet{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "bar", Name: "notready", Labels: labels},
+			Status:     appsv1.StatefulSetStatus{ReadyReplicas: 0},
+		},
+		&appsv1.DaemonSet{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "bar", Name: "notready", Labels: labels},
+			Status:     appsv1.DaemonSetStatus{DesiredNumberScheduled: 3, NumberReady: 2},
+		},
+	).Build()
+
+	notReady, err := GetNotReadyWorkloads(client, "bar", func(obj metav1.Object) bool {
+		return obj.GetLabels()["app"] == "foo"
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{
+		"Deployment bar/notready has 1/2 ready replicas",
+		"StatefulSet bar/notready has 0/1 ready replicas",
+		"DaemonSet bar/notready has 2/3 ready pods",
+	}, notReady)
+}
+
+// TestGetNotReadyNamedWorkloads tests the GetNotReadyNamedWorkloads func
+// GIVEN named workloads in several namespaces
+// WHEN the named workloads that are not ready are requested
+// THEN the named workloads that are not ready or do not exist are returned
+func TestGetNotReadyNamedWorkloads(t *testing.T) {
+	client := fake.NewClientBuilder().WithScheme(k8scheme.Scheme).WithObjects(
+		&appsv1.Deployment{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "foo", Name: "ready"},
+			Status:     appsv1.DeploymentStatus{ReadyReplicas: 1, UpdatedReplicas: 1},
+		},
+		&appsv1.Deployment{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "foo", Name: "notready"},
+			Status:     appsv1.DeploymentStatus{ReadyReplicas: 0},
+		},
+		&appsv1.Deployment{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "foo", Name: "unnamed"},
+			Status:     appsv1.DeploymentStatus{ReadyReplicas: 0},
+		},
+		&appsv1.StatefulSet{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "bar", Name: "notready"},
+			Status:     appsv1.StatefulSetStatus{ReadyReplicas: 0},
+		},
+	).Build()
+
+	notReady, err := GetNotReadyNamedWorkloads(client, []types.NamespacedName{
+		{Namespace: "foo", Name: "ready"},
+		{Namespace: "foo", Name: "notready"},
+		{Namespace: "bar", Name: "notready"},
+		{Namespace: "bar", Name: "missing"},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{
+		"Deployment foo/notready has 0/1 ready replicas",
+		"StatefulSet bar/notready has 0/1 ready replicas",
+		"Workload bar/missing does not exist",
+	}, notReady)
+}
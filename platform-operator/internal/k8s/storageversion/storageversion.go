@@ -0,0 +1,77 @@
+// Copyright (c) 2022, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package storageversion
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	installv1beta1 "github.com/verrazzano/verrazzano/platform-operator/apis/verrazzano/v1beta1"
+	"github.com/verrazzano/verrazzano/platform-operator/internal/k8s/certificate"
+	"go.uber.org/zap"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// retryInterval is how long to wait before the migration is retried after a failure, for example while the
+// conversion webhook is not ready yet
+const retryInterval = 10 * time.Second
+
+// MigrateVerrazzanoStorageVersion migrates the Verrazzano resources to the storage version of the Verrazzano CRD.
+// The migration is retried until it succeeds or the context is done.
+func MigrateVerrazzanoStorageVersion(ctx context.Context, c client.Client, log *zap.SugaredLogger) error {
+	_ = wait.PollImmediateUntil(retryInterval, func() (bool, error) {
+		if err := migrateVerrazzanoStorageVersion(ctx, c, log); err != nil {
+			log.Infof("Failed migrating the Verrazzano resources to the storage version, retrying: %v", err)
+			return false, nil
+		}
+		return true, nil
+	}, ctx.Done())
+	return nil
+}
+
+// migrateVerrazzanoStorageVersion rewrites each Verrazzano resource so that it is stored in the storage version, and
+// then sets the storage version as the only stored version in the status of the Verrazzano CRD
+func migrateVerrazzanoStorageVersion(ctx context.Context, c client.Client, log *zap.SugaredLogger) error {
+	crd := apiextensionsv1.CustomResourceDefinition{}
+	if err := c.Get(ctx, types.NamespacedName{Name: certificate.VerrazzanoCRDName}, &crd); err != nil {
+		return err
+	}
+	storageVersion := ""
+	for _, version := range crd.Spec.Versions {
+		if version.Storage {
+			storageVersion = version.Name
+			break
+		}
+	}
+	if storageVersion != installv1beta1.SchemeGroupVersion.Version {
+		return fmt.Errorf("Expected the storage version of the %s CustomResourceDefinition to be %s, but found %q", crd.Name, installv1beta1.SchemeGroupVersion.Version, storageVersion)
+	}
+	if len(crd.Status.StoredVersions) == 1 && crd.Status.StoredVersions[0] == storageVersion {
+		return nil
+	}
+
+	vzList := installv1beta1.VerrazzanoList{}
+	if err := c.List(ctx, &vzList); err != nil {
+		return err
+	}
+	for i := range vzList.Items {
+		vz := &vzList.Items[i]
+		// An update without changes is enough for the API server to store the resource in the storage version
+		if err := c.Update(ctx, vz); err != nil {
+			return fmt.Errorf("Failed migrating Verrazzano %s/%s: %v", vz.Namespace, vz.Name, err)
+		}
+		log.Debugf("Migrated Verrazzano %s/%s to version %s", vz.Namespace, vz.Name, storageVersion)
+	}
+
+	crd.Status.StoredVersions = []string{storageVersion}
+	if err := c.Status().Update(ctx, &crd); err != nil {
+		return err
+	}
+	log.Infof("Migrated the Verrazzano resources to version %s", storageVersion)
+	return nil
+}
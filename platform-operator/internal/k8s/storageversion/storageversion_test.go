@@ -0,0 +1,75 @@
+// Copyright (c) 2022, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package storageversion
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	installv1beta1 "github.com/verrazzano/verrazzano/platform-operator/apis/verrazzano/v1beta1"
+	"github.com/verrazzano/verrazzano/platform-operator/internal/k8s/certificate"
+	"go.uber.org/zap"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+// newVerrazzanoCRD returns a Verrazzano CRD with the storage version and the stored versions
+func newVerrazzanoCRD(storageVersion string, storedVersions ...string) *apiextensionsv1.CustomResourceDefinition {
+	crd := &apiextensionsv1.CustomResourceDefinition{
+		ObjectMeta: metav1.ObjectMeta{Name: certificate.VerrazzanoCRDName},
+		Status:     apiextensionsv1.CustomResourceDefinitionStatus{StoredVersions: storedVersions},
+	}
+	for _, version := range []string{"v1alpha1", "v1beta1"} {
+		crd.Spec.Versions = append(crd.Spec.Versions, apiextensionsv1.CustomResourceDefinitionVersion{
+			Name:    version,
+			Served:  true,
+			Storage: version == storageVersion,
+		})
+	}
+	return crd
+}
+
+// newScheme returns a scheme with the types used by the storage version migration
+func newScheme() *runtime.Scheme {
+	scheme := runtime.NewScheme()
+	_ = apiextensionsv1.AddToScheme(scheme)
+	_ = installv1beta1.AddToScheme(scheme)
+	return scheme
+}
+
+// TestMigrateVerrazzanoStorageVersion tests the migrateVerrazzanoStorageVersion function
+// GIVEN a Verrazzano CRD with v1alpha1 and v1beta1 stored versions, and a Verrazzano resource
+//  WHEN the storage version is migrated
+//  THEN the Verrazzano resource is rewritten and v1beta1 is the only stored version
+func TestMigrateVerrazzanoStorageVersion(t *testing.T) {
+	asserts := assert.New(t)
+	vz := &installv1beta1.Verrazzano{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "verrazzano"}}
+	c := fake.NewClientBuilder().WithScheme(newScheme()).WithObjects(newVerrazzanoCRD("v1beta1", "v1alpha1", "v1beta1"), vz).Build()
+
+	asserts.NoError(migrateVerrazzanoStorageVersion(context.TODO(), c, zap.S()))
+	crd := apiextensionsv1.CustomResourceDefinition{}
+	asserts.NoError(c.Get(context.TODO(), types.NamespacedName{Name: certificate.VerrazzanoCRDName}, &crd))
+	asserts.Equal([]string{"v1beta1"}, crd.Status.StoredVersions)
+
+	actual := installv1beta1.Verrazzano{}
+	asserts.NoError(c.Get(context.TODO(), types.NamespacedName{Namespace: "default", Name: "verrazzano"}, &actual))
+	asserts.NotEqual(vz.ResourceVersion, actual.ResourceVersion)
+}
+
+// TestMigrateVerrazzanoStorageVersionWrongStorageVersion tests the migrateVerrazzanoStorageVersion function
+// GIVEN a Verrazzano CRD where v1alpha1 is still the storage version
+//  WHEN the storage version is migrated
+//  THEN an error is returned and the stored versions are not changed
+func TestMigrateVerrazzanoStorageVersionWrongStorageVersion(t *testing.T) {
+	c := fake.NewClientBuilder().WithScheme(newScheme()).WithObjects(newVerrazzanoCRD("v1alpha1", "v1alpha1")).Build()
+
+	assert.Error(t, migrateVerrazzanoStorageVersion(context.TODO(), c, zap.S()))
+	crd := apiextensionsv1.CustomResourceDefinition{}
+	assert.NoError(t, c.Get(context.TODO(), types.NamespacedName{Name: certificate.VerrazzanoCRDName}, &crd))
+	assert.Equal(t, []string{"v1alpha1"}, crd.Status.StoredVersions)
+}
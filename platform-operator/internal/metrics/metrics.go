@@ -0,0 +1,141 @@
+// Copyright (c) 2022, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package metrics
+
+import (
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	vzapi "github.com/verrazzano/verrazzano/platform-operator/apis/verrazzano/v1alpha1"
+)
+
+const (
+	metricsNamespace = "vpo"
+
+	// InstallOperation is the operation label value for an install
+	InstallOperation = "install"
+
+	// UpgradeOperation is the operation label value for an upgrade
+	UpgradeOperation = "upgrade"
+)
+
+// compStates are all of the states of a component, a state gauge is kept for each one
+var compStates = []vzapi.CompStateType{
+	vzapi.CompStateDisabled,
+	vzapi.CompStatePreInstalling,
+	vzapi.CompStateInstalling,
+	vzapi.CompStateUninstalling,
+	vzapi.CompStateUpgrading,
+	vzapi.CompStateError,
+	vzapi.CompStateReady,
+	vzapi.CompStateFailed,
+	vzapi.CompStateDegraded,
+}
+
+var (
+	componentState = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: metricsNamespace,
+		Name:      "component_state",
+		Help:      "The state of a Verrazzano component, 1 for the current state of the component and 0 for the other states",
+	}, []string{"component", "state"})
+
+	componentStateDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: metricsNamespace,
+		Name:      "component_state_duration_seconds",
+		Help:      "The time a Verrazzano component spent in a state before moving to another state",
+		Buckets:   prometheus.ExponentialBuckets(1, 2, 14),
+	}, []string{"component", "state"})
+
+	operationDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: metricsNamespace,
+		Name:      "operation_duration_seconds",
+		Help:      "The duration of a completed Verrazzano install or upgrade",
+		Buckets:   prometheus.ExponentialBuckets(30, 2, 10),
+	}, []string{"operation"})
+
+	componentReconcileErrors = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: metricsNamespace,
+		Name:      "component_reconcile_errors_total",
+		Help:      "The number of errors returned by the install, upgrade and uninstall operations of a Verrazzano component",
+	}, []string{"component"})
+
+	managedClusterAgentConnect = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: metricsNamespace,
+		Name:      "managed_cluster_agent_last_connect_timestamp_seconds",
+		Help:      "The time the agent of a managed cluster last connected to the admin cluster, in seconds since the epoch",
+	}, []string{"cluster"})
+)
+
+// stateEntry is the state of a component and the time the component entered the state
+type stateEntry struct {
+	state vzapi.CompStateType
+	since time.Time
+}
+
+// stateEntries has the last state recorded for each component, components are reconciled concurrently
+var (
+	stateEntries     = make(map[string]stateEntry)
+	stateEntriesLock sync.Mutex
+)
+
+// RegisterMetrics registers the platform operator metrics with the registerer
+func RegisterMetrics(registerer prometheus.Registerer) {
+	registerer.MustRegister(componentState, componentStateDuration, operationDuration, componentReconcileErrors,
+		managedClusterAgentConnect)
+}
+
+// SetComponentStates records the state of every component in the status of the Verrazzano resource
+func SetComponentStates(cr *vzapi.Verrazzano) {
+	for name, compStatus := range cr.Status.Components {
+		if compStatus != nil {
+			SetComponentState(name, compStatus.State)
+		}
+	}
+}
+
+// SetComponentState records the state of a component.  When the state changed, the time the component spent in
+// its previous state is observed.
+func SetComponentState(compName string, state vzapi.CompStateType) {
+	stateEntriesLock.Lock()
+	defer stateEntriesLock.Unlock()
+
+	now := time.Now()
+	entry, ok := stateEntries[compName]
+	if ok && entry.state == state {
+		return
+	}
+	if ok {
+		componentStateDuration.WithLabelValues(compName, string(entry.state)).Observe(now.Sub(entry.since).Seconds())
+	}
+	stateEntries[compName] = stateEntry{state: state, since: now}
+
+	for _, s := range compStates {
+		value := 0.0
+		if s == state {
+			value = 1
+		}
+		componentState.WithLabelValues(compName, string(s)).Set(value)
+	}
+}
+
+// ObserveOperationDuration records the duration of a completed install or upgrade that started at the start time
+func ObserveOperationDuration(operation string, start time.Time) {
+	operationDuration.WithLabelValues(operation).Observe(time.Since(start).Seconds())
+}
+
+// IncComponentReconcileErrors counts an error returned by an operation of a component
+func IncComponentReconcileErrors(compName string) {
+	componentReconcileErrors.WithLabelValues(compName).Inc()
+}
+
+// SetManagedClusterAgentConnectTime records the time the agent of a managed cluster last connected to the admin cluster
+func SetManagedClusterAgentConnectTime(cluster string, connectTime time.Time) {
+	managedClusterAgentConnect.WithLabelValues(cluster).Set(float64(connectTime.Unix()))
+}
+
+// DeleteManagedClusterAgentConnectTime removes the agent connect time of a managed cluster that was deregistered
+func DeleteManagedClusterAgentConnectTime(cluster string) {
+	managedClusterAgentConnect.DeleteLabelValues(cluster)
+}
@@ -0,0 +1,72 @@
+// Copyright (c) 2022, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package metrics
+
+import (
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	vzapi "github.com/verrazzano/verrazzano/platform-operator/apis/verrazzano/v1alpha1"
+)
+
+// TestSetComponentStates tests the SetComponentStates function
+// GIVEN a Verrazzano resource with component states
+// WHEN the component states are recorded and a component moves to another state
+// THEN the state gauges are 1 for the current state and 0 for the others, and the time in the previous state is observed
+func TestSetComponentStates(t *testing.T) {
+	asserts := assert.New(t)
+	cr := &vzapi.Verrazzano{
+		Status: vzapi.VerrazzanoStatus{
+			Components: vzapi.ComponentStatusMap{
+				"comp1": &vzapi.ComponentStatusDetails{Name: "comp1", State: vzapi.CompStateInstalling},
+				"comp2": &vzapi.ComponentStatusDetails{Name: "comp2", State: vzapi.CompStateDisabled},
+			},
+		},
+	}
+	SetComponentStates(cr)
+	asserts.Equal(1.0, testutil.ToFloat64(componentState.WithLabelValues("comp1", string(vzapi.CompStateInstalling))))
+	asserts.Equal(0.0, testutil.ToFloat64(componentState.WithLabelValues("comp1", string(vzapi.CompStateReady))))
+	asserts.Equal(1.0, testutil.ToFloat64(componentState.WithLabelValues("comp2", string(vzapi.CompStateDisabled))))
+
+	cr.Status.Components["comp1"].State = vzapi.CompStateReady
+	SetComponentStates(cr)
+	asserts.Equal(0.0, testutil.ToFloat64(componentState.WithLabelValues("comp1", string(vzapi.CompStateInstalling))))
+	asserts.Equal(1.0, testutil.ToFloat64(componentState.WithLabelValues("comp1", string(vzapi.CompStateReady))))
+	asserts.Equal(1, testutil.CollectAndCount(componentStateDuration))
+}
+
+// TestObserveOperationDuration tests the ObserveOperationDuration function
+// GIVEN an install that started a minute ago
+// WHEN the install duration is observed
+// THEN the install duration histogram has an observation
+func TestObserveOperationDuration(t *testing.T) {
+	ObserveOperationDuration(InstallOperation, time.Now().Add(-time.Minute))
+	assert.Equal(t, 1, testutil.CollectAndCount(operationDuration))
+}
+
+// TestIncComponentReconcileErrors tests the IncComponentReconcileErrors function
+// GIVEN a component that fails an operation twice
+// WHEN the errors are counted
+// THEN the reconcile error counter of the component is 2
+func TestIncComponentReconcileErrors(t *testing.T) {
+	IncComponentReconcileErrors("comp1")
+	IncComponentReconcileErrors("comp1")
+	assert.Equal(t, 2.0, testutil.ToFloat64(componentReconcileErrors.WithLabelValues("comp1")))
+}
+
+// TestManagedClusterAgentConnectTime tests the SetManagedClusterAgentConnectTime and
+// DeleteManagedClusterAgentConnectTime functions
+// GIVEN a managed cluster whose agent connected
+// WHEN the connect time is recorded and the managed cluster is deregistered
+// THEN the gauge of the cluster has the connect time, and it is removed with the cluster
+func TestManagedClusterAgentConnectTime(t *testing.T) {
+	connectTime := time.Unix(1650000000, 0)
+	SetManagedClusterAgentConnectTime("managed1", connectTime)
+	assert.Equal(t, 1650000000.0, testutil.ToFloat64(managedClusterAgentConnect.WithLabelValues("managed1")))
+
+	DeleteManagedClusterAgentConnectTime("managed1")
+	assert.Equal(t, 0, testutil.CollectAndCount(managedClusterAgentConnect))
+}
@@ -156,6 +156,14 @@ func IsPrometheusNodeExporterEnabled(vz *vzapi.Verrazzano) bool {
 	return false
 }
 
+// IsAlertmanagerEnabled returns true only if Alertmanager is explicitly enabled in the CR
+func IsAlertmanagerEnabled(vz *vzapi.Verrazzano) bool {
+	if vz != nil && vz.Spec.Components.Alertmanager != nil && vz.Spec.Components.Alertmanager.Enabled != nil {
+		return *vz.Spec.Components.Alertmanager.Enabled
+	}
+	return false
+}
+
 // IsJaegerOperatorEnabled returns true only if the Jaeger Operator is explicitly enabled in the CR
 func IsJaegerOperatorEnabled(vz *vzapi.Verrazzano) bool {
 	if vz != nil && vz.Spec.Components.JaegerOperator != nil && vz.Spec.Components.JaegerOperator.Enabled != nil {
@@ -484,3 +484,34 @@ func TestIsApplicationOperatorEnabled(t *testing.T) {
 			},
 		}}))
 }
+
+// TestIsAlertmanagerEnabled tests the IsAlertmanagerEnabled function
+// GIVEN a call to IsAlertmanagerEnabled
+//  THEN the value of the Enabled flag is returned if present, false otherwise (disabled by default)
+func TestIsAlertmanagerEnabled(t *testing.T) {
+	asserts := assert.New(t)
+	asserts.False(IsAlertmanagerEnabled(nil))
+	asserts.False(IsAlertmanagerEnabled(&vzapi.Verrazzano{Spec: vzapi.VerrazzanoSpec{}}))
+	asserts.False(IsAlertmanagerEnabled(
+		&vzapi.Verrazzano{Spec: vzapi.VerrazzanoSpec{
+			Components: vzapi.ComponentSpec{
+				Alertmanager: &vzapi.AlertmanagerComponent{},
+			},
+		}}))
+	asserts.True(IsAlertmanagerEnabled(
+		&vzapi.Verrazzano{Spec: vzapi.VerrazzanoSpec{
+			Components: vzapi.ComponentSpec{
+				Alertmanager: &vzapi.AlertmanagerComponent{
+					Enabled: &trueValue,
+				},
+			},
+		}}))
+	asserts.False(IsAlertmanagerEnabled(
+		&vzapi.Verrazzano{Spec: vzapi.VerrazzanoSpec{
+			Components: vzapi.ComponentSpec{
+				Alertmanager: &vzapi.AlertmanagerComponent{
+					Enabled: &falseValue,
+				},
+			},
+		}}))
+}
@@ -4,6 +4,7 @@
 package main
 
 import (
+	"context"
 	"flag"
 	"os"
 	"sync"
@@ -15,19 +16,27 @@ import (
 	vzapp "github.com/verrazzano/verrazzano/application-operator/apis/oam/v1alpha1"
 	"github.com/verrazzano/verrazzano/pkg/helm"
 	vzlog "github.com/verrazzano/verrazzano/pkg/log"
+	"github.com/verrazzano/verrazzano/pkg/metricsutils"
 	clustersv1alpha1 "github.com/verrazzano/verrazzano/platform-operator/apis/clusters/v1alpha1"
 	installv1alpha1 "github.com/verrazzano/verrazzano/platform-operator/apis/verrazzano/v1alpha1"
+	installv1beta1 "github.com/verrazzano/verrazzano/platform-operator/apis/verrazzano/v1beta1"
+	backupcontroller "github.com/verrazzano/verrazzano/platform-operator/controllers/backup"
 	clusterscontroller "github.com/verrazzano/verrazzano/platform-operator/controllers/clusters"
 	configmapcontroller "github.com/verrazzano/verrazzano/platform-operator/controllers/configmaps"
+	dashboardcontroller "github.com/verrazzano/verrazzano/platform-operator/controllers/grafanadashboard"
 	secretscontroller "github.com/verrazzano/verrazzano/platform-operator/controllers/secrets"
 	vzcontroller "github.com/verrazzano/verrazzano/platform-operator/controllers/verrazzano"
 	"github.com/verrazzano/verrazzano/platform-operator/controllers/verrazzano/validator"
 	internalconfig "github.com/verrazzano/verrazzano/platform-operator/internal/config"
 	"github.com/verrazzano/verrazzano/platform-operator/internal/k8s/certificate"
 	"github.com/verrazzano/verrazzano/platform-operator/internal/k8s/netpolicy"
+	"github.com/verrazzano/verrazzano/platform-operator/internal/k8s/storageversion"
+	"github.com/verrazzano/verrazzano/platform-operator/internal/metrics"
 	"go.uber.org/zap"
 	istioclinet "istio.io/client-go/pkg/apis/networking/v1alpha3"
 	istioclisec "istio.io/client-go/pkg/apis/security/v1beta1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	apiextensionsclient "k8s.io/apiextensions-apiserver/pkg/client/clientset/clientset"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/kubernetes"
 	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
@@ -35,6 +44,8 @@ import (
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	kzap "sigs.k8s.io/controller-runtime/pkg/log/zap"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	crmetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
 	// +kubebuilder:scaffold:imports
 )
 
@@ -44,6 +55,7 @@ func init() {
 	_ = clientgoscheme.AddToScheme(scheme)
 	_ = vmov1.AddToScheme(scheme)
 	_ = installv1alpha1.AddToScheme(scheme)
+	_ = installv1beta1.AddToScheme(scheme)
 	_ = clustersv1alpha1.AddToScheme(scheme)
 
 	_ = istioclinet.AddToScheme(scheme)
@@ -59,6 +71,9 @@ func init() {
 	// Add the Prometheus Operator resources to the scheme
 	_ = promoperapi.AddToScheme(scheme)
 
+	// Add the CRDs to the scheme, the CRDs owned by a component are deleted when the component is disabled
+	_ = apiextensionsv1.AddToScheme(scheme)
+
 	// +kubebuilder:scaffold:scheme
 }
 
@@ -83,7 +98,9 @@ func main() {
 	flag.StringVar(&config.VerrazzanoRootDir, "vz-root-dir", config.VerrazzanoRootDir,
 		"Specify the root directory of Verrazzano (used for development)")
 	flag.StringVar(&bomOverride, "bom-path", "", "BOM file location")
-	flag.BoolVar(&helm.Debug, "helm-debug", helm.Debug, "Add the --debug flag to helm commands")
+	flag.IntVar(&config.MaxConcurrentComponentInstalls, "max-concurrent-component-installs", config.MaxConcurrentComponentInstalls,
+		"The maximum number of independent components that are installed concurrently")
+	flag.BoolVar(&helm.Debug, "helm-debug", helm.Debug, "Log the debug output of the Helm actions")
 
 	// Add the zap logger flag set to the CLI.
 	opts := kzap.Options{}
@@ -134,6 +151,19 @@ func main() {
 			os.Exit(1)
 		}
 
+		apiextClient, err := apiextensionsclient.NewForConfig(config)
+		if err != nil {
+			log.Errorf("Failed to get apiextensions clientset: %v", err)
+			os.Exit(1)
+		}
+
+		log.Debug("Updating conversion webhook configuration")
+		err = certificate.UpdateConversionWebhookConfiguration(apiextClient, caCert)
+		if err != nil {
+			log.Errorf("Failed to update conversion webhook configuration: %v", err)
+			os.Exit(1)
+		}
+
 		client, err := client.New(config, client.Options{})
 		if err != nil {
 			log.Errorf("Failed to get controller-runtime client: %v", err)
@@ -149,6 +179,10 @@ func main() {
 		return
 	}
 
+	// Register the metrics exported on the metrics endpoint of the manager
+	metrics.RegisterMetrics(crmetrics.Registry)
+	metricsutils.RegisterCommandMetrics(crmetrics.Registry)
+
 	mgr, err := ctrl.NewManager(ctrl.GetConfigOrDie(), ctrl.Options{
 		Scheme:             scheme,
 		MetricsBindAddress: config.MetricsAddr,
@@ -170,13 +204,15 @@ func main() {
 		DryRun:            config.DryRun,
 		WatchedComponents: map[string]bool{},
 		WatchMutex:        &sync.RWMutex{},
+		EventRecorder:     mgr.GetEventRecorderFor("verrazzano-platform-operator"),
+		OperationHistory:  true,
 	}
 	if err = reconciler.SetupWithManager(mgr); err != nil {
 		log.Error(err, "Failed to setup controller", vzlog.FieldController, "Verrazzano")
 		os.Exit(1)
 	}
 
-	// Setup the validation webhook
+	// Setup the validation and conversion webhooks
 	if config.WebhooksEnabled {
 		log.Debug("Setting up Verrazzano webhook with manager")
 		if err = (&installv1alpha1.Verrazzano{}).SetupWebhookWithManager(mgr, log); err != nil {
@@ -184,12 +220,26 @@ func main() {
 			os.Exit(1)
 		}
 		mgr.GetWebhookServer().CertDir = config.CertDir
+
+		// Migrate the Verrazzano resources to the storage version, the conversion webhook must be running
+		migrationClient, err := client.New(mgr.GetConfig(), client.Options{Scheme: mgr.GetScheme()})
+		if err != nil {
+			log.Errorf("Failed to get controller-runtime client: %v", err)
+			os.Exit(1)
+		}
+		if err = mgr.Add(manager.RunnableFunc(func(ctx context.Context) error {
+			return storageversion.MigrateVerrazzanoStorageVersion(ctx, migrationClient, log)
+		})); err != nil {
+			log.Errorf("Failed to add the storage version migration to the manager: %v", err)
+			os.Exit(1)
+		}
 	}
 
 	// Setup the reconciler for VerrazzanoManagedCluster objects
 	if err = (&clusterscontroller.VerrazzanoManagedClusterReconciler{
-		Client: mgr.GetClient(),
-		Scheme: mgr.GetScheme(),
+		Client:        mgr.GetClient(),
+		Scheme:        mgr.GetScheme(),
+		EventRecorder: mgr.GetEventRecorderFor("verrazzano-platform-operator"),
 	}).SetupWithManager(mgr); err != nil {
 		log.Error(err, "Failed to setup controller", vzlog.FieldController, "VerrazzanoManagedCluster")
 		os.Exit(1)
@@ -223,6 +273,24 @@ func main() {
 		os.Exit(1)
 	}
 
+	// Setup the reconciler for VerrazzanoBackup objects
+	if err = (&backupcontroller.VerrazzanoBackupReconciler{
+		Client: mgr.GetClient(),
+		Scheme: mgr.GetScheme(),
+	}).SetupWithManager(mgr); err != nil {
+		log.Error(err, "Failed to setup controller", vzlog.FieldController, "VerrazzanoBackup")
+		os.Exit(1)
+	}
+
+	// Setup the reconciler for GrafanaDashboard objects and labelled dashboard ConfigMaps
+	if err = (&dashboardcontroller.GrafanaDashboardReconciler{
+		Client: mgr.GetClient(),
+		Scheme: mgr.GetScheme(),
+	}).SetupWithManager(mgr); err != nil {
+		log.Error(err, "Failed to setup controller", vzlog.FieldController, "GrafanaDashboard")
+		os.Exit(1)
+	}
+
 	// +kubebuilder:scaffold:builder
 
 	log.Info("Starting controller-runtime manager")
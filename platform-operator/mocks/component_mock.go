@@ -682,6 +682,21 @@ func (mr *MockComponentMockRecorder) IsReady(arg0 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsReady", reflect.TypeOf((*MockComponent)(nil).IsReady), arg0)
 }
 
+// IsUninstalled mocks base method.
+func (m *MockComponent) IsUninstalled(arg0 spi.ComponentContext) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsUninstalled", arg0)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsUninstalled indicates an expected call of IsUninstalled.
+func (mr *MockComponentMockRecorder) IsUninstalled(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsUninstalled", reflect.TypeOf((*MockComponent)(nil).IsUninstalled), arg0)
+}
+
 // MonitorOverrides mocks base method.
 func (m *MockComponent) MonitorOverrides(arg0 spi.ComponentContext) bool {
 	m.ctrl.T.Helper()
@@ -724,6 +739,20 @@ func (mr *MockComponentMockRecorder) PostInstall(arg0 interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PostInstall", reflect.TypeOf((*MockComponent)(nil).PostInstall), arg0)
 }
 
+// PostUninstall mocks base method.
+func (m *MockComponent) PostUninstall(arg0 spi.ComponentContext) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PostUninstall", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PostUninstall indicates an expected call of PostUninstall.
+func (mr *MockComponentMockRecorder) PostUninstall(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PostUninstall", reflect.TypeOf((*MockComponent)(nil).PostUninstall), arg0)
+}
+
 // PostUpgrade mocks base method.
 func (m *MockComponent) PostUpgrade(arg0 spi.ComponentContext) error {
 	m.ctrl.T.Helper()
@@ -752,6 +781,20 @@ func (mr *MockComponentMockRecorder) PreInstall(arg0 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PreInstall", reflect.TypeOf((*MockComponent)(nil).PreInstall), arg0)
 }
 
+// PreUninstall mocks base method.
+func (m *MockComponent) PreUninstall(arg0 spi.ComponentContext) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PreUninstall", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PreUninstall indicates an expected call of PreUninstall.
+func (mr *MockComponentMockRecorder) PreUninstall(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PreUninstall", reflect.TypeOf((*MockComponent)(nil).PreUninstall), arg0)
+}
+
 // PreUpgrade mocks base method.
 func (m *MockComponent) PreUpgrade(arg0 spi.ComponentContext) error {
 	m.ctrl.T.Helper()
@@ -780,6 +823,20 @@ func (mr *MockComponentMockRecorder) Reconcile(arg0 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Reconcile", reflect.TypeOf((*MockComponent)(nil).Reconcile), arg0)
 }
 
+// Uninstall mocks base method.
+func (m *MockComponent) Uninstall(arg0 spi.ComponentContext) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Uninstall", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Uninstall indicates an expected call of Uninstall.
+func (mr *MockComponentMockRecorder) Uninstall(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Uninstall", reflect.TypeOf((*MockComponent)(nil).Uninstall), arg0)
+}
+
 // Upgrade mocks base method.
 func (m *MockComponent) Upgrade(arg0 spi.ComponentContext) error {
 	m.ctrl.T.Helper()
@@ -0,0 +1,4 @@
+{{/* Name of the operator resources, suffixed with the revision when one is set */}}
+{{- define "istio-operator.name" -}}
+istio-operator{{- if not (eq .Values.revision "") }}-{{ .Values.revision }}{{- end }}
+{{- end -}}
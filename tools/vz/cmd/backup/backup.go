@@ -0,0 +1,139 @@
+// Copyright (c) 2022, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package backup
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+	vzapi "github.com/verrazzano/verrazzano/platform-operator/apis/verrazzano/v1alpha1"
+	vpoconstants "github.com/verrazzano/verrazzano/platform-operator/constants"
+	cmdhelpers "github.com/verrazzano/verrazzano/tools/vz/cmd/helpers"
+	"github.com/verrazzano/verrazzano/tools/vz/pkg/constants"
+	"github.com/verrazzano/verrazzano/tools/vz/pkg/helpers"
+	"k8s.io/apimachinery/pkg/types"
+	clipkg "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	CommandName = "backup"
+	helpShort   = "Back up and restore the configuration of Verrazzano"
+	helpLong    = `Back up the Verrazzano resource, its override ConfigMaps and Secrets, the managed cluster registrations, the projects, the multicluster resources and the applications to a persistent volume claim or an S3 compatible bucket, and restore them on a new cluster.`
+
+	pvcFlag                 = "pvc"
+	pvcFlagHelp             = "The persistent volume claim in the verrazzano-install namespace that holds the backup tarballs"
+	s3EndpointFlag          = "s3-endpoint"
+	s3EndpointFlagHelp      = "The endpoint of the S3 compatible object storage that holds the backup tarballs"
+	s3BucketFlag            = "s3-bucket"
+	s3BucketFlagHelp        = "The bucket that holds the backup tarballs"
+	s3PrefixFlag            = "s3-prefix"
+	s3PrefixFlagHelp        = "The prefix of the backup tarballs in the bucket"
+	s3CredentialsSecretFlag = "s3-credentials-secret"
+	s3CredentialsFlagHelp   = "The Secret in the verrazzano-install namespace with the accessKey and secretKey of the bucket"
+)
+
+// pollInterval is how often the status of the backup is checked, needed for unit testing
+var pollInterval = time.Second
+
+func NewCmdBackup(vzHelper helpers.VZHelper) *cobra.Command {
+	cmd := cmdhelpers.NewCommand(vzHelper, CommandName, helpShort, helpLong)
+	cmd.AddCommand(NewCmdBackupCreate(vzHelper))
+	cmd.AddCommand(NewCmdBackupRestore(vzHelper))
+	return cmd
+}
+
+// addStorageFlags adds the flags of the location of the backup tarballs, and of waiting for the backup
+func addStorageFlags(cmd *cobra.Command) {
+	cmd.PersistentFlags().String(pvcFlag, "", pvcFlagHelp)
+	cmd.PersistentFlags().String(s3EndpointFlag, "", s3EndpointFlagHelp)
+	cmd.PersistentFlags().String(s3BucketFlag, "", s3BucketFlagHelp)
+	cmd.PersistentFlags().String(s3PrefixFlag, "", s3PrefixFlagHelp)
+	cmd.PersistentFlags().String(s3CredentialsSecretFlag, "", s3CredentialsFlagHelp)
+	cmd.PersistentFlags().Bool(constants.WaitFlag, constants.WaitFlagDefault, constants.WaitFlagHelp)
+	cmd.PersistentFlags().Duration(constants.TimeoutFlag, time.Minute*30, constants.TimeoutFlagHelp)
+}
+
+// getStorage returns the location of the backup tarballs passed on the command line
+func getStorage(cmd *cobra.Command) (vzapi.BackupStorage, error) {
+	pvc, _ := cmd.PersistentFlags().GetString(pvcFlag)
+	endpoint, _ := cmd.PersistentFlags().GetString(s3EndpointFlag)
+	bucket, _ := cmd.PersistentFlags().GetString(s3BucketFlag)
+	prefix, _ := cmd.PersistentFlags().GetString(s3PrefixFlag)
+	credentials, _ := cmd.PersistentFlags().GetString(s3CredentialsSecretFlag)
+
+	s3 := len(endpoint) > 0 || len(bucket) > 0 || len(prefix) > 0 || len(credentials) > 0
+	if len(pvc) > 0 && s3 {
+		return vzapi.BackupStorage{}, fmt.Errorf("--%s and the --s3 flags cannot both be specified", pvcFlag)
+	}
+	if len(pvc) > 0 {
+		return vzapi.BackupStorage{PersistentVolumeClaim: pvc}, nil
+	}
+	if !s3 {
+		return vzapi.BackupStorage{}, fmt.Errorf("Either --%s or --%s, --%s and --%s must be specified", pvcFlag, s3EndpointFlag, s3BucketFlag, s3CredentialsSecretFlag)
+	}
+	if len(endpoint) == 0 || len(bucket) == 0 || len(credentials) == 0 {
+		return vzapi.BackupStorage{}, fmt.Errorf("--%s, --%s and --%s must all be specified", s3EndpointFlag, s3BucketFlag, s3CredentialsSecretFlag)
+	}
+	return vzapi.BackupStorage{S3: &vzapi.BackupS3Storage{
+		Endpoint:          endpoint,
+		Bucket:            bucket,
+		Prefix:            prefix,
+		CredentialsSecret: credentials,
+	}}, nil
+}
+
+// createBackup creates the backup resource and waits for the platform operator to finish it, unless --wait=false
+func createBackup(cmd *cobra.Command, vzHelper helpers.VZHelper, backup *vzapi.VerrazzanoBackup, kind string) error {
+	timeout, err := cmdhelpers.GetWaitTimeout(cmd)
+	if err != nil {
+		return err
+	}
+
+	// Get the controller runtime client
+	client, err := vzHelper.GetClient(cmd)
+	if err != nil {
+		return err
+	}
+
+	backup.Namespace = vpoconstants.VerrazzanoInstallNamespace
+	if err := client.Create(context.TODO(), backup); err != nil {
+		return fmt.Errorf("Failed to create the %s %s: %s", kind, backup.Name, err.Error())
+	}
+	fmt.Fprintf(vzHelper.GetOutputStream(), "Created the %s %s\n", kind, backup.Name)
+	if timeout == 0 {
+		return nil
+	}
+	return waitForBackup(client, vzHelper, backup, kind, timeout)
+}
+
+// waitForBackup prints the progress of the backup until it completes or fails
+func waitForBackup(client clipkg.Client, vzHelper helpers.VZHelper, backup *vzapi.VerrazzanoBackup, kind string, timeout time.Duration) error {
+	out := vzHelper.GetOutputStream()
+	nsn := types.NamespacedName{Namespace: backup.Namespace, Name: backup.Name}
+	deadline := time.Now().Add(timeout)
+	message := ""
+	for {
+		if err := client.Get(context.TODO(), nsn, backup); err != nil {
+			return fmt.Errorf("Failed to get the %s %s: %s", kind, backup.Name, err.Error())
+		}
+		status := backup.Status
+		if status.Phase == vzapi.BackupPhaseFailed {
+			return fmt.Errorf("The %s %s failed: %s", kind, backup.Name, status.Message)
+		}
+		if status.Phase == vzapi.BackupPhaseCompleted {
+			fmt.Fprintf(out, "The %s %s completed with %d resources: %s\n", kind, backup.Name, status.ResourceCount, status.Location)
+			return nil
+		}
+		if len(status.Message) > 0 && status.Message != message {
+			message = status.Message
+			fmt.Fprintf(out, "%s\n", message)
+		}
+		if time.Now().After(deadline) {
+			return fmt.Errorf("Timeout %v exceeded waiting for the %s %s to complete", timeout, kind, backup.Name)
+		}
+		time.Sleep(pollInterval)
+	}
+}
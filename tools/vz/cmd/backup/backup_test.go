@@ -0,0 +1,195 @@
+// Copyright (c) 2022, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package backup
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	vzapi "github.com/verrazzano/verrazzano/platform-operator/apis/verrazzano/v1alpha1"
+	vpoconstants "github.com/verrazzano/verrazzano/platform-operator/constants"
+	"github.com/verrazzano/verrazzano/tools/vz/pkg/constants"
+	"github.com/verrazzano/verrazzano/tools/vz/test/helpers"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	k8scheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+// serveBackups finishes the backups like the platform operator does, until stopped
+func serveBackups(c client.Client, phase vzapi.BackupPhase, created chan<- *vzapi.VerrazzanoBackup, stop <-chan struct{}) {
+	for {
+		select {
+		case <-stop:
+			return
+		case <-time.After(10 * time.Millisecond):
+		}
+		backups := &vzapi.VerrazzanoBackupList{}
+		if err := c.List(context.TODO(), backups); err != nil {
+			continue
+		}
+		for i := range backups.Items {
+			backup := &backups.Items[i]
+			if len(backup.Status.Phase) > 0 {
+				continue
+			}
+			created <- backup.DeepCopy()
+			backup.Status = vzapi.VerrazzanoBackupStatus{
+				Phase:         phase,
+				Message:       "Failed writing the backup tarball",
+				Location:      "pvc://vz-backups/" + backup.Name + ".tar.gz",
+				ResourceCount: 12,
+			}
+			if len(backup.Spec.RestoreFrom) > 0 {
+				backup.Status.Location = "pvc://vz-backups/" + backup.Spec.RestoreFrom + ".tar.gz"
+			}
+			_ = c.Status().Update(context.TODO(), backup)
+		}
+	}
+}
+
+// newFakeClient returns a fake client whose scheme has the backup resources
+func newFakeClient() client.Client {
+	_ = vzapi.AddToScheme(k8scheme.Scheme)
+	return fake.NewClientBuilder().WithScheme(k8scheme.Scheme).Build()
+}
+
+// TestBackupCreateCmd tests the backup create command
+// GIVEN a platform operator that completes backups
+//  WHEN I call cmd.Execute for backup create with a persistent volume claim
+//  THEN the backup is created in the verrazzano-install namespace and its location is printed
+func TestBackupCreateCmd(t *testing.T) {
+	pollInterval = 10 * time.Millisecond
+	defer func() { pollInterval = time.Second }()
+	c := newFakeClient()
+	created := make(chan *vzapi.VerrazzanoBackup, 1)
+	stop := make(chan struct{})
+	defer close(stop)
+	go serveBackups(c, vzapi.BackupPhaseCompleted, created, stop)
+
+	buf := new(bytes.Buffer)
+	errBuf := new(bytes.Buffer)
+	rc := helpers.NewFakeRootCmdContext(genericclioptions.IOStreams{In: os.Stdin, Out: buf, ErrOut: errBuf})
+	rc.SetClient(c)
+	cmd := NewCmdBackup(rc)
+	assert.NotNil(t, cmd)
+	cmd.SetArgs([]string{createCommandName, "nightly", "--" + pvcFlag, "vz-backups"})
+
+	err := cmd.Execute()
+	assert.NoError(t, err)
+	assert.Equal(t, "", errBuf.String())
+	assert.Equal(t, "Created the backup nightly\n"+
+		"The backup nightly completed with 12 resources: pvc://vz-backups/nightly.tar.gz\n", buf.String())
+
+	backup := <-created
+	assert.Equal(t, vpoconstants.VerrazzanoInstallNamespace, backup.Namespace)
+	assert.Equal(t, "vz-backups", backup.Spec.Storage.PersistentVolumeClaim)
+	assert.Nil(t, backup.Spec.Storage.S3)
+	assert.Empty(t, backup.Spec.RestoreFrom)
+}
+
+// TestBackupCreateCmdNoWait tests the backup create command
+// GIVEN no platform operator
+//  WHEN I call cmd.Execute for backup create without a name, with a bucket and --wait=false
+//  THEN a backup named after the time is created and the command does not wait for it
+func TestBackupCreateCmdNoWait(t *testing.T) {
+	c := newFakeClient()
+	buf := new(bytes.Buffer)
+	rc := helpers.NewFakeRootCmdContext(genericclioptions.IOStreams{In: os.Stdin, Out: buf, ErrOut: new(bytes.Buffer)})
+	rc.SetClient(c)
+	cmd := NewCmdBackupCreate(rc)
+	cmd.SetArgs([]string{"--" + s3EndpointFlag, "https://objectstorage.example.com", "--" + s3BucketFlag, "vz-backups",
+		"--" + s3PrefixFlag, "prod", "--" + s3CredentialsSecretFlag, "vz-backup-credentials", "--" + constants.WaitFlag + "=false"})
+
+	err := cmd.Execute()
+	assert.NoError(t, err)
+	assert.True(t, strings.HasPrefix(buf.String(), "Created the backup backup-"))
+
+	backups := &vzapi.VerrazzanoBackupList{}
+	assert.NoError(t, c.List(context.TODO(), backups))
+	assert.Len(t, backups.Items, 1)
+	s3 := backups.Items[0].Spec.Storage.S3
+	assert.Equal(t, &vzapi.BackupS3Storage{Endpoint: "https://objectstorage.example.com", Bucket: "vz-backups",
+		Prefix: "prod", CredentialsSecret: "vz-backup-credentials"}, s3)
+}
+
+// TestBackupRestoreCmdFailed tests the backup restore command
+// GIVEN a platform operator that fails restores
+//  WHEN I call cmd.Execute for backup restore
+//  THEN a restore of the backup is created and the failure is returned
+func TestBackupRestoreCmdFailed(t *testing.T) {
+	pollInterval = 10 * time.Millisecond
+	defer func() { pollInterval = time.Second }()
+	c := newFakeClient()
+	created := make(chan *vzapi.VerrazzanoBackup, 1)
+	stop := make(chan struct{})
+	defer close(stop)
+	go serveBackups(c, vzapi.BackupPhaseFailed, created, stop)
+
+	rc := helpers.NewFakeRootCmdContext(genericclioptions.IOStreams{In: os.Stdin, Out: new(bytes.Buffer), ErrOut: new(bytes.Buffer)})
+	rc.SetClient(c)
+	cmd := NewCmdBackupRestore(rc)
+	cmd.SetArgs([]string{"nightly", "--" + pvcFlag, "vz-backups"})
+
+	err := cmd.Execute()
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "failed: Failed writing the backup tarball")
+
+	restore := <-created
+	assert.True(t, strings.HasPrefix(restore.Name, "restore-"))
+	assert.Equal(t, "nightly", restore.Spec.RestoreFrom)
+}
+
+// TestBackupCmdTimeout tests the backup create command
+// GIVEN no platform operator
+//  WHEN I call cmd.Execute for backup create with a timeout
+//  THEN a timeout error is returned
+func TestBackupCmdTimeout(t *testing.T) {
+	pollInterval = 10 * time.Millisecond
+	defer func() { pollInterval = time.Second }()
+	rc := helpers.NewFakeRootCmdContext(genericclioptions.IOStreams{In: os.Stdin, Out: new(bytes.Buffer), ErrOut: new(bytes.Buffer)})
+	rc.SetClient(newFakeClient())
+	cmd := NewCmdBackupCreate(rc)
+	cmd.SetArgs([]string{"nightly", "--" + pvcFlag, "vz-backups", "--" + constants.TimeoutFlag, "50ms"})
+
+	err := cmd.Execute()
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "Timeout 50ms exceeded waiting for the backup nightly to complete")
+}
+
+// TestBackupCmdInvalidStorage tests the validation of the storage flags
+// GIVEN invalid combinations of storage flags
+//  WHEN I call cmd.Execute for backup create
+//  THEN a validation error is returned and no backup is created
+func TestBackupCmdInvalidStorage(t *testing.T) {
+	tests := []struct {
+		name string
+		args []string
+	}{
+		{name: "no storage", args: []string{}},
+		{name: "pvc and s3", args: []string{"--" + pvcFlag, "vz-backups", "--" + s3BucketFlag, "vz-backups"}},
+		{name: "s3 without credentials", args: []string{"--" + s3EndpointFlag, "https://objectstorage.example.com", "--" + s3BucketFlag, "vz-backups"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := newFakeClient()
+			rc := helpers.NewFakeRootCmdContext(genericclioptions.IOStreams{In: os.Stdin, Out: new(bytes.Buffer), ErrOut: new(bytes.Buffer)})
+			rc.SetClient(c)
+			cmd := NewCmdBackupCreate(rc)
+			cmd.SetArgs(tt.args)
+
+			err := cmd.Execute()
+			assert.Error(t, err)
+			assert.Contains(t, err.Error(), "Command validation failed")
+			backups := &vzapi.VerrazzanoBackupList{}
+			assert.NoError(t, c.List(context.TODO(), backups))
+			assert.Empty(t, backups.Items)
+		})
+	}
+}
@@ -0,0 +1,54 @@
+// Copyright (c) 2022, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package backup
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+	vzapi "github.com/verrazzano/verrazzano/platform-operator/apis/verrazzano/v1alpha1"
+	cmdhelpers "github.com/verrazzano/verrazzano/tools/vz/cmd/helpers"
+	"github.com/verrazzano/verrazzano/tools/vz/pkg/helpers"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	createCommandName = "create"
+	createHelpShort   = "Back up the configuration of Verrazzano"
+	createHelpLong    = `Back up the configuration of Verrazzano to a tarball on a persistent volume claim or in an S3 compatible bucket.  The backup is named backup-<timestamp> unless a name is given.`
+	createHelpExample = `
+# Back up to the persistent volume claim vz-backups in the verrazzano-install namespace
+vz backup create --pvc vz-backups
+
+# Back up to a bucket with the credentials in the Secret vz-backup-credentials in the verrazzano-install namespace
+vz backup create nightly --s3-endpoint https://objectstorage.example.com --s3-bucket vz-backups --s3-credentials-secret vz-backup-credentials`
+)
+
+func NewCmdBackupCreate(vzHelper helpers.VZHelper) *cobra.Command {
+	cmd := cmdhelpers.NewCommand(vzHelper, createCommandName+" [name]", createHelpShort, createHelpLong)
+	cmd.Args = cobra.MaximumNArgs(1)
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		return runCmdBackupCreate(cmd, args, vzHelper)
+	}
+	cmd.Example = createHelpExample
+	addStorageFlags(cmd)
+	return cmd
+}
+
+func runCmdBackupCreate(cmd *cobra.Command, args []string, vzHelper helpers.VZHelper) error {
+	storage, err := getStorage(cmd)
+	if err != nil {
+		return fmt.Errorf("Command validation failed: %s", err.Error())
+	}
+	name := fmt.Sprintf("backup-%s", time.Now().UTC().Format("20060102150405"))
+	if len(args) > 0 {
+		name = args[0]
+	}
+	backup := &vzapi.VerrazzanoBackup{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Spec:       vzapi.VerrazzanoBackupSpec{Storage: storage},
+	}
+	return createBackup(cmd, vzHelper, backup, "backup")
+}
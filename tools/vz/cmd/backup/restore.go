@@ -0,0 +1,50 @@
+// Copyright (c) 2022, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package backup
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+	vzapi "github.com/verrazzano/verrazzano/platform-operator/apis/verrazzano/v1alpha1"
+	cmdhelpers "github.com/verrazzano/verrazzano/tools/vz/cmd/helpers"
+	"github.com/verrazzano/verrazzano/tools/vz/pkg/helpers"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	restoreCommandName = "restore"
+	restoreHelpShort   = "Restore the configuration of Verrazzano from a backup"
+	restoreHelpLong    = `Restore the configuration of Verrazzano from a backup on a persistent volume claim or in an S3 compatible bucket.  Verrazzano is installed from the restored Verrazzano resource, and the managed clusters, projects and applications are restored once it is ready.`
+	restoreHelpExample = `
+# Restore the backup nightly from the persistent volume claim vz-backups in the verrazzano-install namespace
+vz backup restore nightly --pvc vz-backups
+
+# Restore the backup nightly from a bucket
+vz backup restore nightly --s3-endpoint https://objectstorage.example.com --s3-bucket vz-backups --s3-credentials-secret vz-backup-credentials`
+)
+
+func NewCmdBackupRestore(vzHelper helpers.VZHelper) *cobra.Command {
+	cmd := cmdhelpers.NewCommand(vzHelper, restoreCommandName+" <backup-name>", restoreHelpShort, restoreHelpLong)
+	cmd.Args = cobra.ExactArgs(1)
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		return runCmdBackupRestore(cmd, args, vzHelper)
+	}
+	cmd.Example = restoreHelpExample
+	addStorageFlags(cmd)
+	return cmd
+}
+
+func runCmdBackupRestore(cmd *cobra.Command, args []string, vzHelper helpers.VZHelper) error {
+	storage, err := getStorage(cmd)
+	if err != nil {
+		return fmt.Errorf("Command validation failed: %s", err.Error())
+	}
+	restore := &vzapi.VerrazzanoBackup{
+		ObjectMeta: metav1.ObjectMeta{Name: fmt.Sprintf("restore-%s", time.Now().UTC().Format("20060102150405"))},
+		Spec:       vzapi.VerrazzanoBackupSpec{Storage: storage, RestoreFrom: args[0]},
+	}
+	return createBackup(cmd, vzHelper, restore, "restore")
+}
@@ -8,7 +8,10 @@ import (
 	"os"
 	"strings"
 
+	"github.com/spf13/cobra"
 	vzapi "github.com/verrazzano/verrazzano/platform-operator/apis/verrazzano/v1alpha1"
+	"github.com/verrazzano/verrazzano/tools/vz/pkg/constants"
+	"github.com/verrazzano/verrazzano/tools/vz/pkg/helpers"
 	"k8s.io/apimachinery/pkg/util/strategicpatch"
 	"sigs.k8s.io/yaml"
 )
@@ -64,6 +67,38 @@ func MergeSetFlags(vz *vzapi.Verrazzano, overlayYAML string) (*vzapi.Verrazzano,
 	return vz, nil
 }
 
+// GetSetArguments gets all the set arguments and returns a map of property/value
+func GetSetArguments(cmd *cobra.Command, vzHelper helpers.VZHelper) (map[string]string, error) {
+	setMap := make(map[string]string)
+	setFlags, err := cmd.PersistentFlags().GetStringArray(constants.SetFlag)
+	if err != nil {
+		return nil, err
+	}
+
+	invalidFlag := false
+	for _, setFlag := range setFlags {
+		pv := strings.Split(setFlag, "=")
+		if len(pv) != 2 {
+			fmt.Fprintf(vzHelper.GetErrorStream(), fmt.Sprintf("Invalid set flag \"%s\" specified. Flag must be specified in the format path=value\n", setFlag))
+			invalidFlag = true
+			continue
+		}
+		if !invalidFlag {
+			path, value := strings.TrimSpace(pv[0]), strings.TrimSpace(pv[1])
+			if !strings.HasPrefix(path, "spec.") {
+				path = "spec." + path
+			}
+			setMap[path] = value
+		}
+	}
+
+	if invalidFlag {
+		return nil, fmt.Errorf("Invalid set flag(s) specified")
+	}
+
+	return setMap, nil
+}
+
 // overlayVerrazzano overlays over base using JSON strategic merge.
 func overlayVerrazzano(baseYAML string, overlayYAML string) (string, error) {
 	if strings.TrimSpace(baseYAML) == "" {
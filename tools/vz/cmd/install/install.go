@@ -6,7 +6,6 @@ package install
 import (
 	"context"
 	"fmt"
-	"strings"
 	"time"
 
 	"github.com/spf13/cobra"
@@ -145,7 +144,7 @@ func getVerrazzanoYAML(cmd *cobra.Command, vzHelper helpers.VZHelper) (vz *vzapi
 	}
 
 	// Get the set arguments - list of paths and value
-	pv, err := getSetArguments(cmd, vzHelper)
+	pv, err := cmdhelpers.GetSetArguments(cmd, vzHelper)
 	if err != nil {
 		return nil, err
 	}
@@ -186,38 +185,6 @@ func getVerrazzanoYAML(cmd *cobra.Command, vzHelper helpers.VZHelper) (vz *vzapi
 	return vz, nil
 }
 
-// getSetArguments gets all the set arguments and returns a map of property/value
-func getSetArguments(cmd *cobra.Command, vzHelper helpers.VZHelper) (map[string]string, error) {
-	setMap := make(map[string]string)
-	setFlags, err := cmd.PersistentFlags().GetStringArray(constants.SetFlag)
-	if err != nil {
-		return nil, err
-	}
-
-	invalidFlag := false
-	for _, setFlag := range setFlags {
-		pv := strings.Split(setFlag, "=")
-		if len(pv) != 2 {
-			fmt.Fprintf(vzHelper.GetErrorStream(), fmt.Sprintf("Invalid set flag \"%s\" specified. Flag must be specified in the format path=value\n", setFlag))
-			invalidFlag = true
-			continue
-		}
-		if !invalidFlag {
-			path, value := strings.TrimSpace(pv[0]), strings.TrimSpace(pv[1])
-			if !strings.HasPrefix(path, "spec.") {
-				path = "spec." + path
-			}
-			setMap[path] = value
-		}
-	}
-
-	if invalidFlag {
-		return nil, fmt.Errorf("Invalid set flag(s) specified")
-	}
-
-	return setMap, nil
-}
-
 // waitForInstallToComplete waits for the Verrazzano install to complete and shows the logs of
 // the ongoing Verrazzano install.
 func waitForInstallToComplete(client clipkg.Client, kubeClient kubernetes.Interface, vzHelper helpers.VZHelper, vpoPodName string, namespacedName types.NamespacedName, timeout time.Duration, logFormat cmdhelpers.LogFormat) error {
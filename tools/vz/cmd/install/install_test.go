@@ -493,7 +493,7 @@ func TestGetLogFormatJson(t *testing.T) {
 
 // TestSetCommandInvalidFormat
 // GIVEN a set command is specified with the invalid format
-//  WHEN I call getSetArguments
+//  WHEN I call GetSetArguments
 //  THEN an error is returned
 func TestSetCommandInvalidFormat(t *testing.T) {
 	buf := new(bytes.Buffer)
@@ -502,7 +502,7 @@ func TestSetCommandInvalidFormat(t *testing.T) {
 	cmd := NewCmdInstall(rc)
 	assert.NotNil(t, cmd)
 	cmd.PersistentFlags().Set(constants.SetFlag, "badflag")
-	propValues, err := getSetArguments(cmd, rc)
+	propValues, err := cmdHelpers.GetSetArguments(cmd, rc)
 	assert.Nil(t, propValues)
 	assert.Error(t, err)
 	assert.Equal(t, err.Error(), "Invalid set flag(s) specified")
@@ -511,7 +511,7 @@ func TestSetCommandInvalidFormat(t *testing.T) {
 
 // TestSetCommandSingle
 // GIVEN a single set command
-//  WHEN I call getSetArguments
+//  WHEN I call GetSetArguments
 //  THEN the expected property value is returned
 func TestSetCommandSingle(t *testing.T) {
 	buf := new(bytes.Buffer)
@@ -520,7 +520,7 @@ func TestSetCommandSingle(t *testing.T) {
 	cmd := NewCmdInstall(rc)
 	assert.NotNil(t, cmd)
 	cmd.PersistentFlags().Set(constants.SetFlag, "profile=dev")
-	propValues, err := getSetArguments(cmd, rc)
+	propValues, err := cmdHelpers.GetSetArguments(cmd, rc)
 	assert.NoError(t, err)
 	assert.Len(t, propValues, 1)
 	assert.Contains(t, propValues["spec.profile"], "dev")
@@ -528,7 +528,7 @@ func TestSetCommandSingle(t *testing.T) {
 
 // TestSetCommandMultiple
 // GIVEN multiple set commands
-//  WHEN I call getSetArguments
+//  WHEN I call GetSetArguments
 //  THEN the expected property values are returned
 func TestSetCommandMultiple(t *testing.T) {
 	buf := new(bytes.Buffer)
@@ -538,7 +538,7 @@ func TestSetCommandMultiple(t *testing.T) {
 	assert.NotNil(t, cmd)
 	cmd.PersistentFlags().Set(constants.SetFlag, "profile=dev")
 	cmd.PersistentFlags().Set(constants.SetFlag, "spec.environmentName=default")
-	propValues, err := getSetArguments(cmd, rc)
+	propValues, err := cmdHelpers.GetSetArguments(cmd, rc)
 	assert.NoError(t, err)
 	assert.Len(t, propValues, 2)
 	assert.Contains(t, propValues["spec.profile"], "dev")
@@ -547,7 +547,7 @@ func TestSetCommandMultiple(t *testing.T) {
 
 // TestSetCommandOverride
 // GIVEN multiple set commands overriding the same property
-//  WHEN I call getSetArguments
+//  WHEN I call GetSetArguments
 //  THEN the expected property values are returned
 func TestSetCommandOverride(t *testing.T) {
 	buf := new(bytes.Buffer)
@@ -557,7 +557,7 @@ func TestSetCommandOverride(t *testing.T) {
 	assert.NotNil(t, cmd)
 	cmd.PersistentFlags().Set(constants.SetFlag, "profile=dev")
 	cmd.PersistentFlags().Set(constants.SetFlag, "profile=prod")
-	propValues, err := getSetArguments(cmd, rc)
+	propValues, err := cmdHelpers.GetSetArguments(cmd, rc)
 	assert.NoError(t, err)
 	assert.Len(t, propValues, 1)
 	assert.Contains(t, propValues["spec.profile"], "prod")
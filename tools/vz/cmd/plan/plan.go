@@ -0,0 +1,229 @@
+// Copyright (c) 2022, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package plan
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/verrazzano/verrazzano/pkg/yaml"
+	vzapi "github.com/verrazzano/verrazzano/platform-operator/apis/verrazzano/v1alpha1"
+	vpoconstants "github.com/verrazzano/verrazzano/platform-operator/constants"
+	vzplan "github.com/verrazzano/verrazzano/platform-operator/controllers/verrazzano/plan"
+	cmdhelpers "github.com/verrazzano/verrazzano/tools/vz/cmd/helpers"
+	"github.com/verrazzano/verrazzano/tools/vz/pkg/constants"
+	"github.com/verrazzano/verrazzano/tools/vz/pkg/helpers"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	clipkg "sigs.k8s.io/controller-runtime/pkg/client"
+	sigsyaml "sigs.k8s.io/yaml"
+)
+
+const (
+	CommandName = "plan"
+	helpShort   = "Show the changes an update of the Verrazzano resource would make"
+	helpLong    = `Show which components the Verrazzano Platform Operator would install, upgrade or reconcile, and the Helm values each of them would apply, if the Verrazzano resource was updated.  Nothing is changed on the cluster.`
+	helpExample = `
+# Show the changes of an upgrade to Verrazzano v1.4.0
+vz plan --set version=v1.4.0
+
+# Show the changes of overlaying a file on the Verrazzano resource
+vz plan -f my-verrazzano.yaml`
+)
+
+// pollInterval is how often the plan ConfigMap is checked for the plan, needed for unit testing
+var pollInterval = time.Second
+
+func NewCmdPlan(vzHelper helpers.VZHelper) *cobra.Command {
+	cmd := cmdhelpers.NewCommand(vzHelper, CommandName, helpShort, helpLong)
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		return runCmdPlan(cmd, vzHelper)
+	}
+	cmd.Example = helpExample
+
+	cmd.PersistentFlags().Duration(constants.TimeoutFlag, time.Minute*5, constants.TimeoutFlagHelp)
+	cmd.PersistentFlags().StringSliceP(constants.FilenameFlag, constants.FilenameFlagShorthand, []string{}, constants.FilenameFlagHelp)
+	cmd.PersistentFlags().StringArrayP(constants.SetFlag, constants.SetFlagShorthand, []string{}, constants.SetFlagHelp)
+
+	return cmd
+}
+
+func runCmdPlan(cmd *cobra.Command, vzHelper helpers.VZHelper) error {
+	// Validate the command options
+	err := cmdhelpers.ValidateCmd(cmd)
+	if err != nil {
+		return fmt.Errorf("Command validation failed: %s", err.Error())
+	}
+
+	timeout, err := cmd.PersistentFlags().GetDuration(constants.TimeoutFlag)
+	if err != nil {
+		return err
+	}
+
+	// Get the controller runtime client
+	client, err := vzHelper.GetClient(cmd)
+	if err != nil {
+		return err
+	}
+
+	// Find the verrazzano resource that the plan is computed for
+	vz, err := helpers.FindVerrazzanoResource(client)
+	if err != nil {
+		return fmt.Errorf("Verrazzano is not installed: %s", err.Error())
+	}
+
+	proposedYAML, err := getProposedYAML(cmd, vzHelper, vz)
+	if err != nil {
+		return err
+	}
+
+	// Request the plan from the platform operator and wait for it to be computed
+	request := strconv.FormatInt(time.Now().UnixNano(), 10)
+	if err := requestPlan(client, vz, proposedYAML, request); err != nil {
+		return err
+	}
+	defer cleanupPlan(client, vzHelper, vz)
+
+	plan, err := waitForPlan(client, vz, request, timeout)
+	if err != nil {
+		return err
+	}
+	if len(plan.Error) > 0 {
+		return fmt.Errorf("Failed to compute the change plan: %s", plan.Error)
+	}
+	printPlan(vzHelper, plan)
+	return nil
+}
+
+// getProposedYAML returns the spec of the Verrazzano resource, overlayed by the files and then the set flags passed on
+// the command line
+func getProposedYAML(cmd *cobra.Command, vzHelper helpers.VZHelper, vz *vzapi.Verrazzano) (string, error) {
+	filenames, err := cmd.PersistentFlags().GetStringSlice(constants.FilenameFlag)
+	if err != nil {
+		return "", err
+	}
+	pv, err := cmdhelpers.GetSetArguments(cmd, vzHelper)
+	if err != nil {
+		return "", err
+	}
+
+	proposed := &vzapi.Verrazzano{Spec: *vz.Spec.DeepCopy()}
+	for _, filename := range filenames {
+		readBytes, err := os.ReadFile(strings.TrimSpace(filename))
+		if err != nil {
+			return "", err
+		}
+		proposed, err = cmdhelpers.MergeSetFlags(proposed, string(readBytes))
+		if err != nil {
+			return "", err
+		}
+	}
+	for path, value := range pv {
+		outYaml, err := yaml.Expand(0, false, path, value)
+		if err != nil {
+			return "", fmt.Errorf("Failed to generate yaml from specified set flags: %s", err.Error())
+		}
+		proposed, err = cmdhelpers.MergeSetFlags(proposed, outYaml)
+		if err != nil {
+			return "", err
+		}
+	}
+
+	proposedYAML, err := sigsyaml.Marshal(&vzapi.Verrazzano{Spec: proposed.Spec})
+	if err != nil {
+		return "", err
+	}
+	return string(proposedYAML), nil
+}
+
+// requestPlan writes the proposed Verrazzano resource to the plan ConfigMap and annotates the Verrazzano resource with
+// the plan request
+func requestPlan(client clipkg.Client, vz *vzapi.Verrazzano, proposedYAML string, request string) error {
+	cm := &corev1.ConfigMap{}
+	nsn := types.NamespacedName{Namespace: vz.Namespace, Name: vzplan.GetConfigMapName(vz.Name)}
+	err := client.Get(context.TODO(), nsn, cm)
+	if err != nil && !errors.IsNotFound(err) {
+		return fmt.Errorf("Failed to get the plan ConfigMap %s: %s", nsn, err.Error())
+	}
+	cm.Namespace = nsn.Namespace
+	cm.Name = nsn.Name
+	cm.Data = map[string]string{vzplan.ProposedKey: proposedYAML}
+	if errors.IsNotFound(err) {
+		err = client.Create(context.TODO(), cm)
+	} else {
+		err = client.Update(context.TODO(), cm)
+	}
+	if err != nil {
+		return fmt.Errorf("Failed to write the plan ConfigMap %s: %s", nsn, err.Error())
+	}
+
+	patch := clipkg.MergeFrom(vz.DeepCopy())
+	metav1.SetMetaDataAnnotation(&vz.ObjectMeta, vpoconstants.PlanRequestAnnotation, request)
+	if err := client.Patch(context.TODO(), vz, patch); err != nil {
+		return fmt.Errorf("Failed to request the change plan from the verrazzano install resource: %s", err.Error())
+	}
+	return nil
+}
+
+// waitForPlan waits for the platform operator to write the plan for the request to the plan ConfigMap
+func waitForPlan(client clipkg.Client, vz *vzapi.Verrazzano, request string, timeout time.Duration) (*vzplan.Plan, error) {
+	nsn := types.NamespacedName{Namespace: vz.Namespace, Name: vzplan.GetConfigMapName(vz.Name)}
+	deadline := time.Now().Add(timeout)
+	for {
+		cm := &corev1.ConfigMap{}
+		if err := client.Get(context.TODO(), nsn, cm); err != nil {
+			return nil, fmt.Errorf("Failed to get the plan ConfigMap %s: %s", nsn, err.Error())
+		}
+		if cm.Data[vzplan.RequestKey] == request {
+			plan := &vzplan.Plan{}
+			if err := json.Unmarshal([]byte(cm.Data[vzplan.PlanKey]), plan); err != nil {
+				return nil, fmt.Errorf("Failed to parse the change plan: %s", err.Error())
+			}
+			return plan, nil
+		}
+		if time.Now().After(deadline) {
+			return nil, fmt.Errorf("Timeout %v exceeded waiting for the change plan", timeout)
+		}
+		time.Sleep(pollInterval)
+	}
+}
+
+// cleanupPlan deletes the plan ConfigMap and removes the plan request annotation from the Verrazzano resource
+func cleanupPlan(client clipkg.Client, vzHelper helpers.VZHelper, vz *vzapi.Verrazzano) {
+	cm := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Namespace: vz.Namespace, Name: vzplan.GetConfigMapName(vz.Name)}}
+	if err := client.Delete(context.TODO(), cm); err != nil && !errors.IsNotFound(err) {
+		fmt.Fprintf(vzHelper.GetErrorStream(), "Failed to delete the plan ConfigMap %s/%s: %s\n", cm.Namespace, cm.Name, err.Error())
+	}
+	patch := clipkg.MergeFrom(vz.DeepCopy())
+	delete(vz.Annotations, vpoconstants.PlanRequestAnnotation)
+	if err := client.Patch(context.TODO(), vz, patch); err != nil {
+		fmt.Fprintf(vzHelper.GetErrorStream(), "Failed to remove the plan request from the verrazzano install resource: %s\n", err.Error())
+	}
+}
+
+// printPlan prints the action of each component, followed by the Helm values diff of the action
+func printPlan(vzHelper helpers.VZHelper, plan *vzplan.Plan) {
+	out := vzHelper.GetOutputStream()
+	if len(plan.ProposedVersion) > 0 && plan.ProposedVersion != plan.CurrentVersion {
+		fmt.Fprintf(out, "Verrazzano version %s -> %s\n", plan.CurrentVersion, plan.ProposedVersion)
+	}
+	for _, comp := range plan.Components {
+		if len(comp.Message) > 0 {
+			fmt.Fprintf(out, "%s: %s (%s)\n", comp.Name, comp.Action, comp.Message)
+		} else {
+			fmt.Fprintf(out, "%s: %s\n", comp.Name, comp.Action)
+		}
+		for _, line := range comp.ValuesDiff {
+			fmt.Fprintf(out, "    %s\n", line)
+		}
+	}
+}
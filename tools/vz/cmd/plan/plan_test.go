@@ -0,0 +1,138 @@
+// Copyright (c) 2022, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package plan
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	vzapi "github.com/verrazzano/verrazzano/platform-operator/apis/verrazzano/v1alpha1"
+	vpoconstants "github.com/verrazzano/verrazzano/platform-operator/constants"
+	vzplan "github.com/verrazzano/verrazzano/platform-operator/controllers/verrazzano/plan"
+	"github.com/verrazzano/verrazzano/tools/vz/pkg/constants"
+	"github.com/verrazzano/verrazzano/tools/vz/test/helpers"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	k8scheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/yaml"
+)
+
+// servePlan serves the plan requests of the Verrazzano resource like the platform operator does, until stopped
+func servePlan(c client.Client, plan vzplan.Plan, proposed chan<- *vzapi.Verrazzano, stop <-chan struct{}) {
+	for {
+		select {
+		case <-stop:
+			return
+		case <-time.After(10 * time.Millisecond):
+		}
+		vz := &vzapi.Verrazzano{}
+		if err := c.Get(context.TODO(), types.NamespacedName{Namespace: "default", Name: "verrazzano"}, vz); err != nil {
+			continue
+		}
+		request := vz.Annotations[vpoconstants.PlanRequestAnnotation]
+		cm := &corev1.ConfigMap{}
+		if len(request) == 0 || c.Get(context.TODO(), types.NamespacedName{Namespace: "default", Name: vzplan.GetConfigMapName("verrazzano")}, cm) != nil {
+			continue
+		}
+		if cm.Data[vzplan.RequestKey] == request {
+			continue
+		}
+		proposedCR := &vzapi.Verrazzano{}
+		_ = yaml.Unmarshal([]byte(cm.Data[vzplan.ProposedKey]), proposedCR)
+		proposed <- proposedCR
+		planJSON, _ := json.Marshal(plan)
+		cm.Data[vzplan.PlanKey] = string(planJSON)
+		cm.Data[vzplan.RequestKey] = request
+		_ = c.Update(context.TODO(), cm)
+	}
+}
+
+// TestPlanCmd tests the plan command
+// GIVEN an installed Verrazzano resource and a platform operator that serves plan requests
+//  WHEN I call cmd.Execute for plan with set flags
+//  THEN the proposed resource has the set values, the plan is printed and the plan request is cleaned up
+func TestPlanCmd(t *testing.T) {
+	pollInterval = 10 * time.Millisecond
+	defer func() { pollInterval = time.Second }()
+
+	vz := &vzapi.Verrazzano{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "verrazzano"},
+		Spec:       vzapi.VerrazzanoSpec{Profile: vzapi.Dev, Version: "1.3.0"},
+		Status:     vzapi.VerrazzanoStatus{Version: "1.3.0"},
+	}
+	_ = vzapi.AddToScheme(k8scheme.Scheme)
+	c := fake.NewClientBuilder().WithScheme(k8scheme.Scheme).WithObjects(vz).Build()
+
+	proposed := make(chan *vzapi.Verrazzano, 1)
+	stop := make(chan struct{})
+	defer close(stop)
+	go servePlan(c, vzplan.Plan{
+		CurrentVersion:  "1.3.0",
+		ProposedVersion: "1.4.0",
+		Components: []vzplan.ComponentPlan{
+			{Name: "istio", Action: vzplan.ActionUpgrade, ValuesDiff: []string{"~ global.tag: \"1.3.0\" -> \"1.4.0\""}},
+			{Name: "keycloak", Action: vzplan.ActionNone, Message: "Component is disabled"},
+		},
+	}, proposed, stop)
+
+	buf := new(bytes.Buffer)
+	errBuf := new(bytes.Buffer)
+	rc := helpers.NewFakeRootCmdContext(genericclioptions.IOStreams{In: os.Stdin, Out: buf, ErrOut: errBuf})
+	rc.SetClient(c)
+	cmd := NewCmdPlan(rc)
+	assert.NotNil(t, cmd)
+	cmd.PersistentFlags().Set(constants.SetFlag, "version=1.4.0")
+
+	err := cmd.Execute()
+	assert.NoError(t, err)
+	assert.Equal(t, "", errBuf.String())
+	assert.Equal(t, "Verrazzano version 1.3.0 -> 1.4.0\n"+
+		"istio: Upgrade\n"+
+		"    ~ global.tag: \"1.3.0\" -> \"1.4.0\"\n"+
+		"keycloak: None (Component is disabled)\n", buf.String())
+
+	proposedCR := <-proposed
+	assert.Equal(t, "1.4.0", proposedCR.Spec.Version)
+	assert.Equal(t, vzapi.Dev, proposedCR.Spec.Profile)
+
+	// The plan request is cleaned up
+	err = c.Get(context.TODO(), types.NamespacedName{Namespace: "default", Name: vzplan.GetConfigMapName("verrazzano")}, &corev1.ConfigMap{})
+	assert.True(t, errors.IsNotFound(err))
+	assert.NoError(t, c.Get(context.TODO(), types.NamespacedName{Namespace: "default", Name: "verrazzano"}, vz))
+	assert.NotContains(t, vz.Annotations, vpoconstants.PlanRequestAnnotation)
+}
+
+// TestPlanCmdTimeout tests the plan command
+// GIVEN an installed Verrazzano resource and no platform operator to serve plan requests
+//  WHEN I call cmd.Execute for plan
+//  THEN a timeout error is returned
+func TestPlanCmdTimeout(t *testing.T) {
+	pollInterval = 10 * time.Millisecond
+	defer func() { pollInterval = time.Second }()
+
+	vz := &vzapi.Verrazzano{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "verrazzano"}}
+	_ = vzapi.AddToScheme(k8scheme.Scheme)
+	c := fake.NewClientBuilder().WithScheme(k8scheme.Scheme).WithObjects(vz).Build()
+
+	buf := new(bytes.Buffer)
+	errBuf := new(bytes.Buffer)
+	rc := helpers.NewFakeRootCmdContext(genericclioptions.IOStreams{In: os.Stdin, Out: buf, ErrOut: errBuf})
+	rc.SetClient(c)
+	cmd := NewCmdPlan(rc)
+	cmd.PersistentFlags().Set(constants.TimeoutFlag, "50ms")
+
+	err := cmd.Execute()
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "Timeout 50ms exceeded waiting for the change plan")
+}
@@ -6,8 +6,11 @@ package root
 import (
 	"github.com/spf13/cobra"
 	"github.com/verrazzano/verrazzano/tools/vz/cmd/analyze"
+	"github.com/verrazzano/verrazzano/tools/vz/cmd/backup"
 	cmdhelpers "github.com/verrazzano/verrazzano/tools/vz/cmd/helpers"
 	"github.com/verrazzano/verrazzano/tools/vz/cmd/install"
+	"github.com/verrazzano/verrazzano/tools/vz/cmd/plan"
+	"github.com/verrazzano/verrazzano/tools/vz/cmd/snapshot"
 	"github.com/verrazzano/verrazzano/tools/vz/cmd/status"
 	"github.com/verrazzano/verrazzano/tools/vz/cmd/uninstall"
 	"github.com/verrazzano/verrazzano/tools/vz/cmd/upgrade"
@@ -38,8 +41,11 @@ func NewRootCmd(vzHelper helpers.VZHelper) *cobra.Command {
 	cmd.AddCommand(version.NewCmdVersion(vzHelper))
 	cmd.AddCommand(install.NewCmdInstall(vzHelper))
 	cmd.AddCommand(upgrade.NewCmdUpgrade(vzHelper))
+	cmd.AddCommand(plan.NewCmdPlan(vzHelper))
 	cmd.AddCommand(uninstall.NewCmdUninstall(vzHelper))
 	cmd.AddCommand(analyze.NewCmdAnalyze(vzHelper))
+	cmd.AddCommand(backup.NewCmdBackup(vzHelper))
+	cmd.AddCommand(snapshot.NewCmdSnapshot(vzHelper))
 
 	return cmd
 }
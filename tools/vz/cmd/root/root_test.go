@@ -7,11 +7,14 @@ import (
 	"bytes"
 	"fmt"
 	"github.com/verrazzano/verrazzano/tools/vz/cmd/analyze"
+	"github.com/verrazzano/verrazzano/tools/vz/cmd/backup"
 	"os"
 	"strings"
 	"testing"
 
 	"github.com/verrazzano/verrazzano/tools/vz/cmd/install"
+	"github.com/verrazzano/verrazzano/tools/vz/cmd/plan"
+	"github.com/verrazzano/verrazzano/tools/vz/cmd/snapshot"
 	"github.com/verrazzano/verrazzano/tools/vz/cmd/uninstall"
 	"github.com/verrazzano/verrazzano/tools/vz/cmd/upgrade"
 
@@ -32,7 +35,7 @@ func TestNewRootCmd(t *testing.T) {
 	assert.NotNil(t, rootCmd)
 
 	// Verify the expected commands are defined
-	assert.Len(t, rootCmd.Commands(), 6)
+	assert.Len(t, rootCmd.Commands(), 9)
 	foundCount := 0
 	for _, cmd := range rootCmd.Commands() {
 		switch cmd.Name() {
@@ -48,9 +51,15 @@ func TestNewRootCmd(t *testing.T) {
 			foundCount++
 		case analyze.CommandName:
 			foundCount++
+		case plan.CommandName:
+			foundCount++
+		case backup.CommandName:
+			foundCount++
+		case snapshot.CommandName:
+			foundCount++
 		}
 	}
-	assert.Equal(t, 6, foundCount)
+	assert.Equal(t, 9, foundCount)
 
 	// Verify the expected global flags are defined
 	assert.NotNil(t, rootCmd.PersistentFlags().Lookup(constants.GlobalFlagKubeConfig))
@@ -0,0 +1,73 @@
+// Copyright (c) 2022, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package snapshot
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/verrazzano/verrazzano/pkg/opensearch"
+	cmdhelpers "github.com/verrazzano/verrazzano/tools/vz/cmd/helpers"
+	"github.com/verrazzano/verrazzano/tools/vz/pkg/helpers"
+)
+
+const (
+	createCommandName = "create"
+	createHelpShort   = "Take a snapshot of the OpenSearch indices"
+	createHelpLong    = `Take a snapshot of the OpenSearch indices in the snapshot repository.  The snapshot is named snapshot-<timestamp> unless a name is given.  Snapshots taken with this command are not deleted by the retention of the scheduled snapshots.`
+	createHelpExample = `
+# Take a snapshot of all of the indices in the verrazzano repository
+vz snapshot create
+
+# Take a snapshot of the system and application indices in the repository minio
+vz snapshot create before-upgrade --repository minio --indices verrazzano-system,verrazzano-application-*`
+)
+
+func NewCmdSnapshotCreate(vzHelper helpers.VZHelper) *cobra.Command {
+	cmd := cmdhelpers.NewCommand(vzHelper, createCommandName+" [name]", createHelpShort, createHelpLong)
+	cmd.Args = cobra.MaximumNArgs(1)
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		return runCmdSnapshotCreate(cmd, args, vzHelper)
+	}
+	cmd.Example = createHelpExample
+	addRepositoryFlags(cmd)
+	addWaitFlags(cmd)
+	return cmd
+}
+
+func runCmdSnapshotCreate(cmd *cobra.Command, args []string, vzHelper helpers.VZHelper) error {
+	repo, indices, err := getRepositoryAndIndices(cmd)
+	if err != nil {
+		return err
+	}
+	timeout, err := cmdhelpers.GetWaitTimeout(cmd)
+	if err != nil {
+		return err
+	}
+	name := fmt.Sprintf("snapshot-%s", time.Now().UTC().Format("20060102150405"))
+	if len(args) > 0 {
+		name = args[0]
+	}
+
+	client, err := newOpenSearchClient(cmd, vzHelper, timeout)
+	if err != nil {
+		return err
+	}
+	req := opensearch.CreateSnapshotRequest{Indices: indices, IgnoreUnavailable: true}
+	snapshot, err := client.CreateSnapshot(repo, name, req, timeout > 0)
+	if err != nil {
+		return fmt.Errorf("Failed to create the snapshot %s: %s", name, err.Error())
+	}
+	out := vzHelper.GetOutputStream()
+	if snapshot == nil {
+		fmt.Fprintf(out, "Started the snapshot %s in repository %s\n", name, repo)
+		return nil
+	}
+	if snapshot.State != opensearch.SnapshotStateSuccess {
+		return fmt.Errorf("The snapshot %s finished with state %s", name, snapshot.State)
+	}
+	fmt.Fprintf(out, "Created the snapshot %s of %d indices in repository %s\n", name, len(snapshot.Indices), repo)
+	return nil
+}
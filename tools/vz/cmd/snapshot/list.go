@@ -0,0 +1,58 @@
+// Copyright (c) 2022, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package snapshot
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	cmdhelpers "github.com/verrazzano/verrazzano/tools/vz/cmd/helpers"
+	"github.com/verrazzano/verrazzano/tools/vz/pkg/helpers"
+)
+
+const (
+	listCommandName = "list"
+	listHelpShort   = "List the snapshots of the OpenSearch indices"
+	listHelpLong    = `List the snapshots in the snapshot repository, oldest first.`
+	listHelpExample = `
+# List the snapshots in the verrazzano repository
+vz snapshot list`
+)
+
+func NewCmdSnapshotList(vzHelper helpers.VZHelper) *cobra.Command {
+	cmd := cmdhelpers.NewCommand(vzHelper, listCommandName, listHelpShort, listHelpLong)
+	cmd.Args = cobra.NoArgs
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		return runCmdSnapshotList(cmd, vzHelper)
+	}
+	cmd.Example = listHelpExample
+	cmd.PersistentFlags().String(repositoryFlag, defaultRepository, repositoryFlagHelp)
+	return cmd
+}
+
+func runCmdSnapshotList(cmd *cobra.Command, vzHelper helpers.VZHelper) error {
+	repo, err := cmd.PersistentFlags().GetString(repositoryFlag)
+	if err != nil {
+		return err
+	}
+	client, err := newOpenSearchClient(cmd, vzHelper, 0)
+	if err != nil {
+		return err
+	}
+	snapshots, err := client.GetSnapshots(repo)
+	if err != nil {
+		return fmt.Errorf("Failed to list the snapshots in repository %s: %s", repo, err.Error())
+	}
+	out := vzHelper.GetOutputStream()
+	if len(snapshots) == 0 {
+		fmt.Fprintf(out, "There are no snapshots in repository %s\n", repo)
+		return nil
+	}
+	format := "%-32s %-12s %-22s %s\n"
+	fmt.Fprintf(out, format, "NAME", "STATE", "START TIME", "INDICES")
+	for _, snapshot := range snapshots {
+		fmt.Fprintf(out, format, snapshot.Snapshot, snapshot.State, snapshot.StartTime, fmt.Sprint(len(snapshot.Indices)))
+	}
+	return nil
+}
@@ -0,0 +1,73 @@
+// Copyright (c) 2022, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package snapshot
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/verrazzano/verrazzano/pkg/opensearch"
+	cmdhelpers "github.com/verrazzano/verrazzano/tools/vz/cmd/helpers"
+	"github.com/verrazzano/verrazzano/tools/vz/pkg/helpers"
+)
+
+const (
+	restoreCommandName = "restore"
+	restoreHelpShort   = "Restore the OpenSearch indices from a snapshot"
+	restoreHelpLong    = `Restore the OpenSearch indices from a snapshot in the snapshot repository.  OpenSearch can not restore an index that is open, either delete or close the index first, or restore it under a new name with --rename-prefix.`
+	restoreHelpExample = `
+# Restore the system indices of the snapshot nightly as restored-verrazzano-system
+vz snapshot restore nightly --indices verrazzano-system --rename-prefix restored-`
+
+	renamePrefixFlag     = "rename-prefix"
+	renamePrefixFlagHelp = "The prefix that is added to the names of the restored indices"
+)
+
+func NewCmdSnapshotRestore(vzHelper helpers.VZHelper) *cobra.Command {
+	cmd := cmdhelpers.NewCommand(vzHelper, restoreCommandName+" <snapshot-name>", restoreHelpShort, restoreHelpLong)
+	cmd.Args = cobra.ExactArgs(1)
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		return runCmdSnapshotRestore(cmd, args, vzHelper)
+	}
+	cmd.Example = restoreHelpExample
+	addRepositoryFlags(cmd)
+	addWaitFlags(cmd)
+	cmd.PersistentFlags().String(renamePrefixFlag, "", renamePrefixFlagHelp)
+	return cmd
+}
+
+func runCmdSnapshotRestore(cmd *cobra.Command, args []string, vzHelper helpers.VZHelper) error {
+	repo, indices, err := getRepositoryAndIndices(cmd)
+	if err != nil {
+		return err
+	}
+	renamePrefix, err := cmd.PersistentFlags().GetString(renamePrefixFlag)
+	if err != nil {
+		return err
+	}
+	timeout, err := cmdhelpers.GetWaitTimeout(cmd)
+	if err != nil {
+		return err
+	}
+
+	client, err := newOpenSearchClient(cmd, vzHelper, timeout)
+	if err != nil {
+		return err
+	}
+	req := opensearch.RestoreSnapshotRequest{Indices: indices}
+	if len(renamePrefix) > 0 {
+		req.RenamePattern = "(.+)"
+		req.RenameReplacement = renamePrefix + "$1"
+	}
+	name := args[0]
+	if err := client.RestoreSnapshot(repo, name, req, timeout > 0); err != nil {
+		return fmt.Errorf("Failed to restore the snapshot %s: %s", name, err.Error())
+	}
+	if timeout > 0 {
+		fmt.Fprintf(vzHelper.GetOutputStream(), "Restored the snapshot %s from repository %s\n", name, repo)
+	} else {
+		fmt.Fprintf(vzHelper.GetOutputStream(), "Started restoring the snapshot %s from repository %s\n", name, repo)
+	}
+	return nil
+}
@@ -0,0 +1,105 @@
+// Copyright (c) 2022, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package snapshot
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/verrazzano/verrazzano/pkg/httputil"
+	"github.com/verrazzano/verrazzano/pkg/opensearch"
+	vpoconstants "github.com/verrazzano/verrazzano/platform-operator/constants"
+	cmdhelpers "github.com/verrazzano/verrazzano/tools/vz/cmd/helpers"
+	"github.com/verrazzano/verrazzano/tools/vz/pkg/constants"
+	"github.com/verrazzano/verrazzano/tools/vz/pkg/helpers"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+const (
+	CommandName = "snapshot"
+	helpShort   = "Take and restore snapshots of the OpenSearch indices"
+	helpLong    = `Take, list and restore snapshots of the OpenSearch indices in the snapshot repository that is configured in the Verrazzano resource.`
+
+	repositoryFlag     = "repository"
+	repositoryFlagHelp = "The snapshot repository in OpenSearch"
+	indicesFlag        = "indices"
+	indicesFlagHelp    = "The index patterns of the snapshot, all of the indices if none are given"
+
+	defaultRepository        = "verrazzano"
+	opensearchCertSecretName = "system-tls-es-ingest"
+
+	// requestTimeout is the timeout of the requests that do not wait for OpenSearch to finish a snapshot
+	requestTimeout = 30 * time.Second
+)
+
+func NewCmdSnapshot(vzHelper helpers.VZHelper) *cobra.Command {
+	cmd := cmdhelpers.NewCommand(vzHelper, CommandName, helpShort, helpLong)
+	cmd.AddCommand(NewCmdSnapshotCreate(vzHelper))
+	cmd.AddCommand(NewCmdSnapshotList(vzHelper))
+	cmd.AddCommand(NewCmdSnapshotRestore(vzHelper))
+	return cmd
+}
+
+// addRepositoryFlags adds the flags of the snapshot repository and of the indices of the snapshot
+func addRepositoryFlags(cmd *cobra.Command) {
+	cmd.PersistentFlags().String(repositoryFlag, defaultRepository, repositoryFlagHelp)
+	cmd.PersistentFlags().StringSlice(indicesFlag, []string{}, indicesFlagHelp)
+}
+
+// addWaitFlags adds the flags of waiting for OpenSearch to finish the snapshot
+func addWaitFlags(cmd *cobra.Command) {
+	cmd.PersistentFlags().Bool(constants.WaitFlag, constants.WaitFlagDefault, constants.WaitFlagHelp)
+	cmd.PersistentFlags().Duration(constants.TimeoutFlag, time.Minute*30, constants.TimeoutFlagHelp)
+}
+
+// getRepositoryAndIndices returns the snapshot repository and the comma separated index patterns passed on the
+// command line
+func getRepositoryAndIndices(cmd *cobra.Command) (string, string, error) {
+	repo, err := cmd.PersistentFlags().GetString(repositoryFlag)
+	if err != nil {
+		return "", "", err
+	}
+	if len(repo) == 0 {
+		return "", "", fmt.Errorf("Command validation failed: --%s must not be empty", repositoryFlag)
+	}
+	indices, err := cmd.PersistentFlags().GetStringSlice(indicesFlag)
+	if err != nil {
+		return "", "", err
+	}
+	return repo, strings.Join(indices, ","), nil
+}
+
+// newOpenSearchClient returns a client of the OpenSearch ingress of the Verrazzano installation, that authenticates
+// as the Verrazzano user of OpenSearch.  The timeout of the client is the timeout of the command when it waits.
+func newOpenSearchClient(cmd *cobra.Command, vzHelper helpers.VZHelper, timeout time.Duration) (*opensearch.Client, error) {
+	if timeout == 0 {
+		timeout = requestTimeout
+	}
+	client, err := vzHelper.GetClient(cmd)
+	if err != nil {
+		return nil, err
+	}
+	vz, err := helpers.FindVerrazzanoResource(client)
+	if err != nil {
+		return nil, err
+	}
+	if vz.Status.VerrazzanoInstance == nil || vz.Status.VerrazzanoInstance.ElasticURL == nil {
+		return nil, fmt.Errorf("The Verrazzano resource %s/%s has no OpenSearch URL", vz.Namespace, vz.Name)
+	}
+
+	credentials := corev1.Secret{}
+	if err := client.Get(context.TODO(), types.NamespacedName{Namespace: vpoconstants.VerrazzanoSystemNamespace, Name: vpoconstants.VMISecret}, &credentials); err != nil {
+		return nil, fmt.Errorf("Failed to get the OpenSearch credentials: %s", err.Error())
+	}
+
+	hc, err := httputil.NewCertSecretClient(client, types.NamespacedName{Namespace: vpoconstants.VerrazzanoSystemNamespace, Name: opensearchCertSecretName}, timeout)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to get the OpenSearch certificate: %s", err.Error())
+	}
+	return opensearch.NewClient(*vz.Status.VerrazzanoInstance.ElasticURL, hc, string(credentials.Data["username"]), string(credentials.Data["password"])), nil
+}
@@ -0,0 +1,171 @@
+// Copyright (c) 2022, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package snapshot
+
+import (
+	"bytes"
+	"context"
+	"encoding/pem"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/verrazzano/verrazzano/pkg/opensearch"
+	"github.com/verrazzano/verrazzano/pkg/opensearch/fake"
+	vzapi "github.com/verrazzano/verrazzano/platform-operator/apis/verrazzano/v1alpha1"
+	vpoconstants "github.com/verrazzano/verrazzano/platform-operator/constants"
+	"github.com/verrazzano/verrazzano/tools/vz/pkg/constants"
+	"github.com/verrazzano/verrazzano/tools/vz/test/helpers"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	k8scheme "k8s.io/client-go/kubernetes/scheme"
+	ctrlfake "sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+const (
+	testUsername = "verrazzano"
+	testPassword = "vzpw"
+)
+
+// newOpenSearch returns a fake OpenSearch with a repository and a command context whose Verrazzano resource has the
+// URL, credentials and CA certificate of the fake OpenSearch
+func newOpenSearch(t *testing.T) (*fake.Server, *helpers.FakeRootCmdContext, *bytes.Buffer) {
+	server := fake.NewTLSServer(testUsername, testPassword, "verrazzano-system", "verrazzano-application-hello")
+	assert.NoError(t, opensearch.NewClient(server.URL, server.Client(), testUsername, testPassword).PutRepository(defaultRepository, opensearch.Repository{
+		Type:     opensearch.S3RepositoryType,
+		Settings: opensearch.RepositorySettings{Bucket: "vz-snapshots"},
+	}))
+
+	_ = vzapi.AddToScheme(k8scheme.Scheme)
+	url := server.URL
+	caCert := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+	c := ctrlfake.NewClientBuilder().WithScheme(k8scheme.Scheme).WithObjects(
+		&vzapi.Verrazzano{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "verrazzano"},
+			Status:     vzapi.VerrazzanoStatus{VerrazzanoInstance: &vzapi.InstanceInfo{ElasticURL: &url}},
+		},
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Namespace: vpoconstants.VerrazzanoSystemNamespace, Name: vpoconstants.VMISecret},
+			Data:       map[string][]byte{"username": []byte(testUsername), "password": []byte(testPassword)},
+		},
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Namespace: vpoconstants.VerrazzanoSystemNamespace, Name: opensearchCertSecretName},
+			Data:       map[string][]byte{"ca.crt": caCert},
+		},
+	).Build()
+
+	buf := new(bytes.Buffer)
+	rc := helpers.NewFakeRootCmdContext(genericclioptions.IOStreams{In: os.Stdin, Out: buf, ErrOut: new(bytes.Buffer)})
+	rc.SetClient(c)
+	return server, rc, buf
+}
+
+// TestSnapshotCreateCmd tests the snapshot create command
+// GIVEN OpenSearch with a snapshot repository
+//  WHEN I call cmd.Execute for snapshot create with a name and index patterns
+//  THEN the snapshot of the matching indices is taken and the command waits for it
+func TestSnapshotCreateCmd(t *testing.T) {
+	server, rc, buf := newOpenSearch(t)
+	defer server.Close()
+	cmd := NewCmdSnapshot(rc)
+	cmd.SetArgs([]string{createCommandName, "before-upgrade", "--" + indicesFlag, "verrazzano-application-*"})
+
+	err := cmd.Execute()
+	assert.NoError(t, err)
+	assert.Equal(t, "Created the snapshot before-upgrade of 1 indices in repository verrazzano\n", buf.String())
+	assert.Equal(t, []string{"before-upgrade"}, server.SnapshotNames(defaultRepository))
+}
+
+// TestSnapshotCreateCmdNoWait tests the snapshot create command
+// GIVEN OpenSearch with a snapshot repository
+//  WHEN I call cmd.Execute for snapshot create without a name and with --wait=false
+//  THEN a snapshot named after the time is started
+func TestSnapshotCreateCmdNoWait(t *testing.T) {
+	server, rc, buf := newOpenSearch(t)
+	defer server.Close()
+	cmd := NewCmdSnapshotCreate(rc)
+	cmd.SetArgs([]string{"--" + constants.WaitFlag + "=false"})
+
+	err := cmd.Execute()
+	assert.NoError(t, err)
+	assert.Contains(t, buf.String(), "Started the snapshot snapshot-")
+	assert.Len(t, server.SnapshotNames(defaultRepository), 1)
+}
+
+// TestSnapshotCreateCmdMissingRepository tests the snapshot create command
+// GIVEN OpenSearch with a snapshot repository
+//  WHEN I call cmd.Execute for snapshot create with a repository that does not exist
+//  THEN the error of OpenSearch is returned
+func TestSnapshotCreateCmdMissingRepository(t *testing.T) {
+	server, rc, _ := newOpenSearch(t)
+	defer server.Close()
+	cmd := NewCmdSnapshotCreate(rc)
+	cmd.SetArgs([]string{"nightly", "--" + repositoryFlag, "minio"})
+
+	err := cmd.Execute()
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "Failed to create the snapshot nightly: OpenSearch PUT /_snapshot/minio/nightly returned status code 404")
+}
+
+// TestSnapshotListCmd tests the snapshot list command
+// GIVEN OpenSearch with a snapshot repository
+//  WHEN I call cmd.Execute for snapshot list before and after a snapshot is taken
+//  THEN the snapshots are printed
+func TestSnapshotListCmd(t *testing.T) {
+	server, rc, buf := newOpenSearch(t)
+	defer server.Close()
+	cmd := NewCmdSnapshotList(rc)
+	assert.NoError(t, cmd.Execute())
+	assert.Equal(t, "There are no snapshots in repository verrazzano\n", buf.String())
+
+	_, err := opensearch.NewClient(server.URL, server.Client(), testUsername, testPassword).CreateSnapshot(defaultRepository, "nightly", opensearch.CreateSnapshotRequest{}, true)
+	assert.NoError(t, err)
+	buf.Reset()
+	assert.NoError(t, cmd.Execute())
+	assert.Equal(t, "NAME                             STATE        START TIME             INDICES\n"+
+		"nightly                          SUCCESS      2022-10-17T03:01:00Z   2\n", buf.String())
+}
+
+// TestSnapshotRestoreCmd tests the snapshot restore command
+// GIVEN OpenSearch with a snapshot
+//  WHEN I call cmd.Execute for snapshot restore with index patterns and a rename prefix
+//  THEN the indices are restored under the new names
+func TestSnapshotRestoreCmd(t *testing.T) {
+	server, rc, buf := newOpenSearch(t)
+	defer server.Close()
+	_, err := opensearch.NewClient(server.URL, server.Client(), testUsername, testPassword).CreateSnapshot(defaultRepository, "nightly", opensearch.CreateSnapshotRequest{}, true)
+	assert.NoError(t, err)
+	cmd := NewCmdSnapshotRestore(rc)
+	cmd.SetArgs([]string{"nightly", "--" + indicesFlag, "verrazzano-system", "--" + renamePrefixFlag, "restored-"})
+
+	err = cmd.Execute()
+	assert.NoError(t, err)
+	assert.Equal(t, "Restored the snapshot nightly from repository verrazzano\n", buf.String())
+	assert.Equal(t, []fake.Restore{{
+		Repository: defaultRepository,
+		Snapshot:   "nightly",
+		Request:    opensearch.RestoreSnapshotRequest{Indices: "verrazzano-system", RenamePattern: "(.+)", RenameReplacement: "restored-$1"},
+	}}, server.Restores())
+}
+
+// TestSnapshotCmdUntrustedCertificate tests the snapshot commands
+// GIVEN OpenSearch whose certificate secret has no CA certificate
+//  WHEN I call cmd.Execute for snapshot list
+//  THEN the certificate of OpenSearch is not trusted and the request fails
+func TestSnapshotCmdUntrustedCertificate(t *testing.T) {
+	server, rc, _ := newOpenSearch(t)
+	defer server.Close()
+	cmd := NewCmdSnapshotList(rc)
+	c, err := rc.GetClient(cmd)
+	assert.NoError(t, err)
+	assert.NoError(t, c.Delete(context.TODO(), &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Namespace: vpoconstants.VerrazzanoSystemNamespace, Name: opensearchCertSecretName},
+	}))
+
+	err = cmd.Execute()
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "Failed to list the snapshots in repository verrazzano")
+	assert.Contains(t, err.Error(), "certificate")
+}
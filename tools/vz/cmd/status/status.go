@@ -4,14 +4,22 @@
 package status
 
 import (
+	"context"
 	"fmt"
+	"io"
+	"sort"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
 	vzapi "github.com/verrazzano/verrazzano/platform-operator/apis/verrazzano/v1alpha1"
+	vzconstants "github.com/verrazzano/verrazzano/platform-operator/constants"
 	cmdhelpers "github.com/verrazzano/verrazzano/tools/vz/cmd/helpers"
 	"github.com/verrazzano/verrazzano/tools/vz/pkg/helpers"
 	"github.com/verrazzano/verrazzano/tools/vz/pkg/templates"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 const (
@@ -21,7 +29,13 @@ const (
 	helpExample = `
 vz status
 vz status --context minikube
-vz status --kubeconfig ~/.kube/config --context minikube`
+vz status --kubeconfig ~/.kube/config --context minikube
+
+# List the install, upgrade, update and uninstall operations of Verrazzano
+vz status --history`
+
+	historyFlag     = "history"
+	historyFlagHelp = "List the install, upgrade, update and uninstall operations of the Verrazzano resource"
 )
 
 // The component output is disabled pending the resolution some issues with
@@ -64,6 +78,9 @@ Verrazzano Status
 {{- if .components_enabled}}
   Components:
 {{- end}}
+{{- if .comp_alertmanager_state}}
+    Alertmanager: {{.comp_alertmanager_state}}
+{{- end}}
 {{- if .comp_certmanager_state}}
     Cert Manager: {{.comp_certmanager_state}}
 {{- end}}
@@ -145,6 +162,8 @@ func NewCmdStatus(vzHelper helpers.VZHelper) *cobra.Command {
 	}
 	cmd.Example = helpExample
 
+	cmd.PersistentFlags().Bool(historyFlag, false, historyFlagHelp)
+
 	return cmd
 }
 
@@ -161,6 +180,15 @@ func runCmdStatus(cmd *cobra.Command, vzHelper helpers.VZHelper) error {
 		return err
 	}
 
+	// Report the operation history instead of the status when requested
+	history, err := cmd.PersistentFlags().GetBool(historyFlag)
+	if err != nil {
+		return err
+	}
+	if history {
+		return printOperationHistory(client, vz, vzHelper.GetOutputStream())
+	}
+
 	// Report the status information
 	templateValues := map[string]string{
 		"verrazzano_name":      vz.Name,
@@ -220,3 +248,75 @@ func addComponents(components vzapi.ComponentStatusMap, values map[string]string
 		}
 	}
 }
+
+// printOperationHistory - print the operations of the Verrazzano resource, oldest first
+func printOperationHistory(c client.Client, vz *vzapi.Verrazzano, out io.Writer) error {
+	opList := vzapi.VerrazzanoOperationList{}
+	err := c.List(context.TODO(), &opList, client.InNamespace(vz.Namespace), client.MatchingLabels{vzconstants.VerrazzanoOperationLabel: vz.Name})
+	if err != nil {
+		return fmt.Errorf("Failed to list the operations of the Verrazzano resource %s/%s: %s", vz.Namespace, vz.Name, err.Error())
+	}
+	ops := opList.Items
+	sort.SliceStable(ops, func(i, j int) bool {
+		return getStartTime(&ops[i]).Before(getStartTime(&ops[j]))
+	})
+
+	fmt.Fprintf(out, "\nVerrazzano Operation History\n")
+	fmt.Fprintf(out, "  Name: %s\n", vz.Name)
+	fmt.Fprintf(out, "  Namespace: %s\n", vz.Namespace)
+	if len(ops) == 0 {
+		fmt.Fprintf(out, "  Operations: none\n")
+		return nil
+	}
+	fmt.Fprintf(out, "  Operations:\n")
+	for _, op := range ops {
+		fmt.Fprintf(out, "    %s %s: %s\n", op.Spec.Operation, formatVersions(op.Spec), op.Status.Result)
+		fmt.Fprintf(out, "      Name: %s\n", op.Name)
+		if op.Status.StartTime != nil {
+			fmt.Fprintf(out, "      Started: %s\n", op.Status.StartTime.UTC().Format(time.RFC3339))
+		}
+		if op.Status.EndTime != nil {
+			fmt.Fprintf(out, "      Finished: %s%s\n", op.Status.EndTime.UTC().Format(time.RFC3339), formatDuration(op.Status.StartTime, op.Status.EndTime))
+		}
+		if len(op.Status.Message) > 0 {
+			fmt.Fprintf(out, "      Message: %s\n", op.Status.Message)
+		}
+		if len(op.Status.Components) > 0 {
+			fmt.Fprintf(out, "      Components:\n")
+			for _, comp := range op.Status.Components {
+				fmt.Fprintf(out, "        %s: %s%s\n", comp.Name, comp.Result, formatDuration(comp.StartTime, comp.EndTime))
+			}
+		}
+	}
+	return nil
+}
+
+// getStartTime - the start time of an operation from its start label, or from its status, or the creation time if the start was not recorded
+func getStartTime(op *vzapi.VerrazzanoOperation) time.Time {
+	if nanos, err := strconv.ParseInt(op.Labels[vzconstants.VerrazzanoOperationStartLabel], 10, 64); err == nil {
+		return time.Unix(0, nanos)
+	}
+	if op.Status.StartTime != nil {
+		return op.Status.StartTime.Time
+	}
+	return op.CreationTimestamp.Time
+}
+
+// formatVersions - the version of an operation, with the source version when it is an upgrade
+func formatVersions(spec vzapi.VerrazzanoOperationSpec) string {
+	if len(spec.SourceVersion) > 0 && len(spec.TargetVersion) > 0 && spec.SourceVersion != spec.TargetVersion {
+		return fmt.Sprintf("%s -> %s", spec.SourceVersion, spec.TargetVersion)
+	}
+	if len(spec.TargetVersion) > 0 {
+		return spec.TargetVersion
+	}
+	return spec.SourceVersion
+}
+
+// formatDuration - the duration between the start and end times, or an empty string if either is not set
+func formatDuration(start *metav1.Time, end *metav1.Time) string {
+	if start == nil || end == nil {
+		return ""
+	}
+	return fmt.Sprintf(" (%s)", end.Sub(start.Time).Round(time.Second))
+}
@@ -7,9 +7,11 @@ import (
 	"bytes"
 	"os"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	vzapi "github.com/verrazzano/verrazzano/platform-operator/apis/verrazzano/v1alpha1"
+	vzconstants "github.com/verrazzano/verrazzano/platform-operator/constants"
 	"github.com/verrazzano/verrazzano/platform-operator/controllers/verrazzano/component/registry"
 	"github.com/verrazzano/verrazzano/tools/vz/pkg/templates"
 	"github.com/verrazzano/verrazzano/tools/vz/test/helpers"
@@ -162,6 +164,85 @@ func TestStatusMultipleVZ(t *testing.T) {
 	assert.Equal(t, "Expected to only find one Verrazzano resource, but found 2", err.Error())
 }
 
+// TestStatusHistory tests the status command
+// GIVEN an environment with a VZ resource that was installed and upgraded
+//  WHEN I run the command vz status --history
+//  THEN expect the install and upgrade operations to be listed, oldest first
+func TestStatusHistory(t *testing.T) {
+	vz := vzapi.Verrazzano{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "default",
+			Name:      "verrazzano",
+		},
+	}
+	start := time.Date(2022, 10, 17, 3, 0, 0, 0, time.UTC)
+	newOperation := func(name string, opType vzapi.OperationType, source string, target string, result vzapi.OperationResult, startTime time.Time) *vzapi.VerrazzanoOperation {
+		opStart := metav1.NewTime(startTime)
+		opEnd := metav1.NewTime(startTime.Add(20 * time.Minute))
+		return &vzapi.VerrazzanoOperation{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "default",
+				Name:      name,
+				Labels:    map[string]string{vzconstants.VerrazzanoOperationLabel: "verrazzano"},
+			},
+			Spec: vzapi.VerrazzanoOperationSpec{
+				Verrazzano:    "verrazzano",
+				Operation:     opType,
+				SourceVersion: source,
+				TargetVersion: target,
+			},
+			Status: vzapi.VerrazzanoOperationStatus{
+				Result:    result,
+				Message:   "done",
+				StartTime: &opStart,
+				EndTime:   &opEnd,
+				Components: []vzapi.OperationComponentStatus{
+					{Name: "istio", Result: result, StartTime: &opStart, EndTime: &opEnd},
+				},
+			},
+		}
+	}
+	upgrade := newOperation("verrazzano-upgrade-1", vzapi.OperationUpgrade, "1.3.0", "1.4.0", vzapi.OperationFailed, start.Add(time.Hour))
+	install := newOperation("verrazzano-install-1", vzapi.OperationInstall, "", "1.3.0", vzapi.OperationSucceeded, start)
+
+	_ = vzapi.AddToScheme(k8scheme.Scheme)
+	c := fake.NewClientBuilder().WithScheme(k8scheme.Scheme).WithObjects(&vz, upgrade, install).Build()
+
+	// Send the command output to a byte buffer
+	buf := new(bytes.Buffer)
+	errBuf := new(bytes.Buffer)
+	rc := helpers.NewFakeRootCmdContext(genericclioptions.IOStreams{In: os.Stdin, Out: buf, ErrOut: errBuf})
+	rc.SetClient(c)
+	statusCmd := NewCmdStatus(rc)
+	assert.NotNil(t, statusCmd)
+	statusCmd.PersistentFlags().Set(historyFlag, "true")
+
+	// Run the status command, check for the operations to be displayed
+	err := statusCmd.Execute()
+	assert.NoError(t, err)
+	expectedResult := `
+Verrazzano Operation History
+  Name: verrazzano
+  Namespace: default
+  Operations:
+    Install 1.3.0: Succeeded
+      Name: verrazzano-install-1
+      Started: 2022-10-17T03:00:00Z
+      Finished: 2022-10-17T03:20:00Z (20m0s)
+      Message: done
+      Components:
+        istio: Succeeded (20m0s)
+    Upgrade 1.3.0 -> 1.4.0: Failed
+      Name: verrazzano-upgrade-1
+      Started: 2022-10-17T04:00:00Z
+      Finished: 2022-10-17T04:20:00Z (20m0s)
+      Message: done
+      Components:
+        istio: Failed (20m0s)
+`
+	assert.Equal(t, expectedResult, buf.String())
+}
+
 func makeVerrazzanoComponentStatusMap() vzapi.ComponentStatusMap {
 	statusMap := make(vzapi.ComponentStatusMap)
 	for _, comp := range registry.GetComponents() {
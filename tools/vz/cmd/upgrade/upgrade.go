@@ -5,14 +5,21 @@ package upgrade
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"strconv"
 	"time"
 
 	"github.com/spf13/cobra"
 	vzapi "github.com/verrazzano/verrazzano/platform-operator/apis/verrazzano/v1alpha1"
+	vpoconstants "github.com/verrazzano/verrazzano/platform-operator/constants"
+	"github.com/verrazzano/verrazzano/platform-operator/controllers/verrazzano/preflight"
 	cmdhelpers "github.com/verrazzano/verrazzano/tools/vz/cmd/helpers"
 	"github.com/verrazzano/verrazzano/tools/vz/pkg/constants"
 	"github.com/verrazzano/verrazzano/tools/vz/pkg/helpers"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/kubernetes"
 	clipkg "sigs.k8s.io/controller-runtime/pkg/client"
@@ -27,11 +34,17 @@ const (
 vz upgrade
 
 # Upgrade to Verrazzano v1.3.0, stream the logs to the console and timeout after 20m
-vz upgrade --version v1.3.0 --timeout 20m`
+vz upgrade --version v1.3.0 --timeout 20m
+
+# Run the upgrade preflight checks and report any prerequisites that are not met, without upgrading
+vz upgrade --preflight-only`
 )
 
 var logsEnum = cmdhelpers.LogFormatSimple
 
+// pollInterval is how often the preflight ConfigMap is checked for the results, needed for unit testing
+var pollInterval = time.Second
+
 func NewCmdUpgrade(vzHelper helpers.VZHelper) *cobra.Command {
 	cmd := cmdhelpers.NewCommand(vzHelper, CommandName, helpShort, helpLong)
 	cmd.RunE = func(cmd *cobra.Command, args []string) error {
@@ -43,6 +56,7 @@ func NewCmdUpgrade(vzHelper helpers.VZHelper) *cobra.Command {
 	cmd.PersistentFlags().Duration(constants.TimeoutFlag, time.Minute*30, constants.TimeoutFlagHelp)
 	cmd.PersistentFlags().String(constants.VersionFlag, constants.VersionFlagDefault, constants.VersionFlagUpgradeHelp)
 	cmd.PersistentFlags().Var(&logsEnum, constants.LogFormatFlag, constants.LogFormatHelp)
+	cmd.PersistentFlags().Bool(constants.PreflightOnlyFlag, false, constants.PreflightOnlyFlagHelp)
 
 	// Initially the operator-file flag may be for internal use, hide from help until
 	// a decision is made on supporting this option.
@@ -75,6 +89,20 @@ func runCmdUpgrade(cmd *cobra.Command, vzHelper helpers.VZHelper) error {
 		return fmt.Errorf("Verrazzano is not installed: %s", err.Error())
 	}
 
+	// Only report the results of the preflight checks when --preflight-only is used
+	preflightOnly, err := cmd.PersistentFlags().GetBool(constants.PreflightOnlyFlag)
+	if err != nil {
+		return err
+	}
+	if preflightOnly {
+		// The preflight checks are always waited for, --wait only applies to the upgrade
+		timeout, err := cmd.PersistentFlags().GetDuration(constants.TimeoutFlag)
+		if err != nil {
+			return err
+		}
+		return reportPreflightChecks(client, vzHelper, vz, timeout)
+	}
+
 	// Get the timeout value for the upgrade command
 	timeout, err := cmdhelpers.GetWaitTimeout(cmd)
 	if err != nil {
@@ -132,6 +160,73 @@ func runCmdUpgrade(cmd *cobra.Command, vzHelper helpers.VZHelper) error {
 	return waitForUpgradeToComplete(client, kubeClient, vzHelper, vpoPodName, types.NamespacedName{Namespace: vz.Namespace, Name: vz.Name}, timeout, logFormat)
 }
 
+// reportPreflightChecks requests a run of the upgrade preflight checks from the platform operator, waits for the
+// results and reports every failed check
+func reportPreflightChecks(client clipkg.Client, vzHelper helpers.VZHelper, vz *vzapi.Verrazzano, timeout time.Duration) error {
+	request := strconv.FormatInt(time.Now().UnixNano(), 10)
+	patch := clipkg.MergeFrom(vz.DeepCopy())
+	metav1.SetMetaDataAnnotation(&vz.ObjectMeta, vpoconstants.PreflightRequestAnnotation, request)
+	if err := client.Patch(context.TODO(), vz, patch); err != nil {
+		return fmt.Errorf("Failed to request the preflight checks from the verrazzano install resource: %s", err.Error())
+	}
+	defer cleanupPreflightChecks(client, vzHelper, vz)
+
+	results, err := waitForPreflightChecks(client, vz, request, timeout)
+	if err != nil {
+		return err
+	}
+	if len(results.Error) > 0 {
+		return fmt.Errorf("Failed to run the upgrade preflight checks: %s", results.Error)
+	}
+	if len(results.Failures) == 0 {
+		fmt.Fprintf(vzHelper.GetOutputStream(), "All upgrade preflight checks passed\n")
+		return nil
+	}
+	for _, failure := range results.Failures {
+		fmt.Fprintf(vzHelper.GetOutputStream(), "Preflight check failed for %s: %s\n", failure.Component, failure.Message)
+	}
+	return fmt.Errorf("%d upgrade preflight checks failed", len(results.Failures))
+}
+
+// waitForPreflightChecks waits for the platform operator to write the preflight check results for the request to the
+// preflight ConfigMap
+func waitForPreflightChecks(client clipkg.Client, vz *vzapi.Verrazzano, request string, timeout time.Duration) (*preflight.Results, error) {
+	nsn := types.NamespacedName{Namespace: vz.Namespace, Name: preflight.GetConfigMapName(vz.Name)}
+	deadline := time.Now().Add(timeout)
+	for {
+		cm := &corev1.ConfigMap{}
+		err := client.Get(context.TODO(), nsn, cm)
+		if err != nil && !errors.IsNotFound(err) {
+			return nil, fmt.Errorf("Failed to get the preflight ConfigMap %s: %s", nsn, err.Error())
+		}
+		if err == nil && cm.Data[preflight.RequestKey] == request {
+			results := &preflight.Results{}
+			if err := json.Unmarshal([]byte(cm.Data[preflight.ResultsKey]), results); err != nil {
+				return nil, fmt.Errorf("Failed to parse the preflight check results: %s", err.Error())
+			}
+			return results, nil
+		}
+		if time.Now().After(deadline) {
+			return nil, fmt.Errorf("Timeout %v exceeded waiting for the upgrade preflight checks", timeout)
+		}
+		time.Sleep(pollInterval)
+	}
+}
+
+// cleanupPreflightChecks deletes the preflight ConfigMap and removes the preflight request annotation from the
+// Verrazzano resource
+func cleanupPreflightChecks(client clipkg.Client, vzHelper helpers.VZHelper, vz *vzapi.Verrazzano) {
+	cm := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Namespace: vz.Namespace, Name: preflight.GetConfigMapName(vz.Name)}}
+	if err := client.Delete(context.TODO(), cm); err != nil && !errors.IsNotFound(err) {
+		fmt.Fprintf(vzHelper.GetErrorStream(), "Failed to delete the preflight ConfigMap %s/%s: %s\n", cm.Namespace, cm.Name, err.Error())
+	}
+	patch := clipkg.MergeFrom(vz.DeepCopy())
+	delete(vz.Annotations, vpoconstants.PreflightRequestAnnotation)
+	if err := client.Patch(context.TODO(), vz, patch); err != nil {
+		fmt.Fprintf(vzHelper.GetErrorStream(), "Failed to remove the preflight request from the verrazzano install resource: %s\n", err.Error())
+	}
+}
+
 // Wait for the upgrade operation to complete
 func waitForUpgradeToComplete(client clipkg.Client, kubeClient kubernetes.Interface, vzHelper helpers.VZHelper, vpoPodName string, namespacedName types.NamespacedName, timeout time.Duration, logFormat cmdhelpers.LogFormat) error {
 	return cmdhelpers.WaitForOperationToComplete(client, kubeClient, vzHelper, vpoPodName, namespacedName, timeout, logFormat, vzapi.CondUpgradeComplete)
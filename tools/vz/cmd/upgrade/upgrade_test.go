@@ -6,10 +6,13 @@ package upgrade
 import (
 	"bytes"
 	"context"
+	"encoding/json"
 	"fmt"
 	"github.com/stretchr/testify/assert"
 	vzconstants "github.com/verrazzano/verrazzano/pkg/constants"
 	vzapi "github.com/verrazzano/verrazzano/platform-operator/apis/verrazzano/v1alpha1"
+	vpoconstants "github.com/verrazzano/verrazzano/platform-operator/constants"
+	"github.com/verrazzano/verrazzano/platform-operator/controllers/verrazzano/preflight"
 	cmdHelpers "github.com/verrazzano/verrazzano/tools/vz/cmd/helpers"
 	"github.com/verrazzano/verrazzano/tools/vz/pkg/constants"
 	"github.com/verrazzano/verrazzano/tools/vz/test/helpers"
@@ -19,8 +22,10 @@ import (
 	"k8s.io/cli-runtime/pkg/genericclioptions"
 	k8scheme "k8s.io/client-go/kubernetes/scheme"
 	"os"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 	"testing"
+	"time"
 )
 
 // TestUpgradeCmdDefaultNoWait
@@ -344,3 +349,127 @@ func TestUpgradeValidations(t *testing.T) {
 	assert.Error(t, err)
 	assert.Contains(t, err.Error(), fmt.Sprintf("--%s and --%s cannot both be specified", constants.VersionFlag, constants.OperatorFileFlag))
 }
+
+// servePreflightChecks serves the preflight requests of the Verrazzano resource with the results like the platform
+// operator does, until stopped
+func servePreflightChecks(c client.Client, results preflight.Results, stop <-chan struct{}) {
+	for {
+		select {
+		case <-stop:
+			return
+		case <-time.After(10 * time.Millisecond):
+		}
+		vz := &vzapi.Verrazzano{}
+		if err := c.Get(context.TODO(), types.NamespacedName{Namespace: "default", Name: "verrazzano"}, vz); err != nil {
+			continue
+		}
+		request := vz.Annotations[vpoconstants.PreflightRequestAnnotation]
+		if len(request) == 0 {
+			continue
+		}
+		resultsJSON, _ := json.Marshal(results)
+		cm := &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: preflight.GetConfigMapName("verrazzano")},
+			Data:       map[string]string{preflight.RequestKey: request, preflight.ResultsKey: string(resultsJSON)},
+		}
+		_ = c.Create(context.TODO(), cm)
+	}
+}
+
+// TestUpgradeCmdPreflightOnly
+// GIVEN a CLI upgrade command with --preflight-only and a platform operator that reports no failed prerequisites
+//  WHEN I call cmd.Execute for upgrade
+//  THEN the CLI upgrade command reports that all preflight checks passed, cleans up the request and does not upgrade
+func TestUpgradeCmdPreflightOnly(t *testing.T) {
+	pollInterval = 10 * time.Millisecond
+	defer func() { pollInterval = time.Second }()
+	c := newPreflightTestClient()
+	stop := make(chan struct{})
+	defer close(stop)
+	go servePreflightChecks(c, preflight.Results{}, stop)
+
+	// Send stdout stderr to a byte buffer
+	buf := new(bytes.Buffer)
+	errBuf := new(bytes.Buffer)
+	rc := helpers.NewFakeRootCmdContext(genericclioptions.IOStreams{In: os.Stdin, Out: buf, ErrOut: errBuf})
+	rc.SetClient(c)
+	cmd := NewCmdUpgrade(rc)
+	assert.NotNil(t, cmd)
+	cmd.PersistentFlags().Set(constants.PreflightOnlyFlag, "true")
+
+	// Run upgrade command
+	err := cmd.Execute()
+	assert.NoError(t, err)
+	assert.Equal(t, "", errBuf.String())
+	assert.Contains(t, buf.String(), "All upgrade preflight checks passed")
+
+	// Verify the version of the Verrazzano resource was not changed and the request was cleaned up
+	vz := vzapi.Verrazzano{}
+	err = c.Get(context.TODO(), types.NamespacedName{Namespace: "default", Name: "verrazzano"}, &vz)
+	assert.NoError(t, err)
+	assert.Equal(t, "", vz.Spec.Version)
+	assert.NotContains(t, vz.Annotations, vpoconstants.PreflightRequestAnnotation)
+}
+
+// TestUpgradeCmdPreflightOnlyFailed
+// GIVEN a CLI upgrade command with --preflight-only and a platform operator that reports a failed prerequisite
+//  WHEN I call cmd.Execute for upgrade
+//  THEN the CLI upgrade command reports the failed preflight check and returns an error
+func TestUpgradeCmdPreflightOnlyFailed(t *testing.T) {
+	pollInterval = 10 * time.Millisecond
+	defer func() { pollInterval = time.Second }()
+	c := newPreflightTestClient()
+	stop := make(chan struct{})
+	defer close(stop)
+	go servePreflightChecks(c, preflight.Results{Failures: []preflight.Failure{
+		{Component: "verrazzano", Message: "Helm release verrazzano-system/verrazzano revision 2 is pending-upgrade"},
+	}}, stop)
+
+	// Send stdout stderr to a byte buffer
+	buf := new(bytes.Buffer)
+	errBuf := new(bytes.Buffer)
+	rc := helpers.NewFakeRootCmdContext(genericclioptions.IOStreams{In: os.Stdin, Out: buf, ErrOut: errBuf})
+	rc.SetClient(c)
+	cmd := NewCmdUpgrade(rc)
+	assert.NotNil(t, cmd)
+	cmd.PersistentFlags().Set(constants.PreflightOnlyFlag, "true")
+
+	// Run upgrade command
+	err := cmd.Execute()
+	assert.EqualError(t, err, "1 upgrade preflight checks failed")
+	assert.Contains(t, buf.String(), "Preflight check failed for verrazzano: Helm release verrazzano-system/verrazzano revision 2 is pending-upgrade")
+}
+
+// TestUpgradeCmdPreflightOnlyTimeout
+// GIVEN a CLI upgrade command with --preflight-only and no platform operator to run the checks
+//  WHEN I call cmd.Execute for upgrade
+//  THEN a timeout error is returned
+func TestUpgradeCmdPreflightOnlyTimeout(t *testing.T) {
+	pollInterval = 10 * time.Millisecond
+	defer func() { pollInterval = time.Second }()
+	c := newPreflightTestClient()
+
+	buf := new(bytes.Buffer)
+	errBuf := new(bytes.Buffer)
+	rc := helpers.NewFakeRootCmdContext(genericclioptions.IOStreams{In: os.Stdin, Out: buf, ErrOut: errBuf})
+	rc.SetClient(c)
+	cmd := NewCmdUpgrade(rc)
+	cmd.PersistentFlags().Set(constants.PreflightOnlyFlag, "true")
+	cmd.PersistentFlags().Set(constants.TimeoutFlag, "50ms")
+
+	err := cmd.Execute()
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "Timeout 50ms exceeded waiting for the upgrade preflight checks")
+}
+
+// newPreflightTestClient returns a fake client with an installed Verrazzano resource
+func newPreflightTestClient() client.Client {
+	vz := &vzapi.Verrazzano{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "default",
+			Name:      "verrazzano",
+		},
+	}
+	_ = vzapi.AddToScheme(k8scheme.Scheme)
+	return fake.NewClientBuilder().WithScheme(k8scheme.Scheme).WithObjects(vz).Build()
+}
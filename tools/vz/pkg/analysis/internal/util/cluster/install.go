@@ -272,12 +272,12 @@ func getComponentsNotReady(log *zap.SugaredLogger, clusterRoot string) ([]string
 
 	// There should be only one Verrazzano resource, so the first item from the list should be good enough
 	for _, vzRes := range vzResourceList.Items {
-		if vzRes.Status.State != installv1alpha1.VzStateReady {
+		if !installv1alpha1.IsVzInstalledState(vzRes.Status.State) {
 			log.Debugf("Verrazzano installation is not complete, installation state %s", vzRes.Status.State)
 
 			// Verrazzano installation is not complete, find out the list of components which are not ready
 			for _, compStatusDetail := range vzRes.Status.Components {
-				if compStatusDetail.State != installv1alpha1.CompStateReady {
+				if !installv1alpha1.IsComponentInstalledState(compStatusDetail.State) {
 					if compStatusDetail.State == installv1alpha1.CompStateDisabled {
 						continue
 					}
@@ -30,6 +30,9 @@ const (
 
 	DryRunFlag = "dry-run"
 
+	PreflightOnlyFlag     = "preflight-only"
+	PreflightOnlyFlagHelp = "Run the upgrade preflight checks against the installed components and report the results without upgrading"
+
 	SetFlag          = "set"
 	SetFlagShorthand = "s"
 	SetFlagHelp      = "Override a Verrazzano resource value (e.g. --set profile=dev).  This flag can be specified multiple times."